@@ -0,0 +1,44 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/kubefleet-dev/kubefleet/apis"
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+// ConvertTo converts this InternalMemberCluster to the hub version (v1beta1).
+func (m *InternalMemberCluster) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*clusterv1beta1.InternalMemberCluster))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this InternalMemberCluster.
+func (m *InternalMemberCluster) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*clusterv1beta1.InternalMemberCluster), m)
+}
+
+// ConvertTo converts this MemberCluster to the hub version (v1beta1).
+func (m *MemberCluster) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*clusterv1beta1.MemberCluster))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this MemberCluster.
+func (m *MemberCluster) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*clusterv1beta1.MemberCluster), m)
+}
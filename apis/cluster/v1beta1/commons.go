@@ -94,6 +94,14 @@ type AgentStatus struct {
 	// Last time we received a heartbeat from the member agent.
 	// +optional
 	LastReceivedHeartbeat metav1.Time `json:"lastReceivedHeartbeat,omitempty"`
+
+	// Version is the build version of the member agent, as reported by the agent itself.
+	// +optional
+	Version string `json:"version,omitempty"`
+
+	// GitCommit is the git commit from which the member agent is built, as reported by the agent itself.
+	// +optional
+	GitCommit string `json:"gitCommit,omitempty"`
 }
 
 // AgentConditionType identifies a specific condition on the Agent.
@@ -112,12 +120,26 @@ const (
 	// - "False" means the member agent is unhealthy.
 	// - "Unknown" means the member agent has an unknown health status.
 	AgentHealthy AgentConditionType = "Healthy"
+	// AgentHubConnectivity indicates whether the given member agent has encountered failures
+	// when connecting to the hub cluster.
+	// Its condition status can be one of the following:
+	// - "True" means the member agent has not encountered any failure since its last heartbeat.
+	// - "False" means the member agent has encountered failures since its last heartbeat, and
+	//   has recovered from them; the reason and message describe the last failure.
+	AgentHubConnectivity AgentConditionType = "HubConnectivity"
+	// AgentUpgraded indicates whether the given member agent runs the version desired by the hub cluster.
+	// Its condition status can be one of the following:
+	// - "True" means the member agent runs the desired version, or no version is desired.
+	// - "False" means the member agent failed to upgrade to the desired version, or has rolled back.
+	// - "Unknown" means the member agent is being upgraded to the desired version.
+	AgentUpgraded AgentConditionType = "Upgraded"
 )
 
 const (
 	MemberClusterKind                = "MemberCluster"
 	MemberClusterResource            = "memberclusters"
 	InternalMemberClusterKind        = "InternalMemberCluster"
+	PropertyCatalogKind              = "PropertyCatalog"
 	ClusterResourcePlacementResource = "clusterresourceplacements"
 )
 
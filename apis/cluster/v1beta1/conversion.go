@@ -0,0 +1,26 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// The v1beta1 API version is the hub version for the conversion between the API versions; the
+// conversion functions are implemented in the v1 API version.
+
+// Hub marks InternalMemberCluster as a conversion hub.
+func (*InternalMemberCluster) Hub() {}
+
+// Hub marks MemberCluster as a conversion hub.
+func (*MemberCluster) Hub() {}
@@ -21,6 +21,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,categories={fleet,fleet-cluster},shortName=imc
 // +kubebuilder:subresource:status
@@ -56,6 +57,20 @@ type InternalMemberClusterSpec struct {
 	// How often (in seconds) for the member cluster to send a heartbeat to the hub cluster. Default: 60 seconds. Min: 1 second. Max: 10 minutes.
 	// +optional
 	HeartbeatPeriodSeconds int32 `json:"heartbeatPeriodSeconds,omitempty"`
+
+	// WorkApplyConcurrency is the maximum number of Work objects that the member agent applies at the
+	// same time. The value is capped by the concurrency configured on the member agent; if not specified,
+	// the configured concurrency is used.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	WorkApplyConcurrency *int32 `json:"workApplyConcurrency,omitempty"`
+
+	// DesiredAgentVersion is the version (image tag) of the member agent that the member cluster
+	// should run. If not specified, the member agent is not upgraded.
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_][a-zA-Z0-9_.-]*$`
+	// +optional
+	DesiredAgentVersion string `json:"desiredAgentVersion,omitempty"`
 }
 
 // InternalMemberClusterStatus defines the observed state of InternalMemberCluster.
@@ -86,6 +101,11 @@ type InternalMemberClusterStatus struct {
 	// AgentStatus is an array of current observed status, each corresponding to one member agent running in the member cluster.
 	// +optional
 	AgentStatus []AgentStatus `json:"agentStatus,omitempty"`
+
+	// WorkApplyConcurrency is the maximum number of Work objects that the member agent applies at the
+	// same time, as acknowledged by the member agent. It is populated by the member agent.
+	// +optional
+	WorkApplyConcurrency *int32 `json:"workApplyConcurrency,omitempty"`
 }
 
 //+kubebuilder:object:root=true
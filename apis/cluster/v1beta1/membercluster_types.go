@@ -23,6 +23,8 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-cluster},shortName=cluster
 // +kubebuilder:subresource:status
@@ -78,6 +80,24 @@ type MemberClusterSpec struct {
 	// DeleteOptions for deleting the MemberCluster.
 	// +optional
 	DeleteOptions *DeleteOptions `json:"deleteOptions,omitempty"`
+
+	// WorkApplyConcurrency is the maximum number of Work objects that the member agent applies at the
+	// same time. It allows hub admins to throttle the member agent of a member cluster (e.g., a small
+	// cluster) without changing the deployment of the agent. The value is capped by the concurrency
+	// configured on the member agent; if not specified, the configured concurrency is used.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	WorkApplyConcurrency *int32 `json:"workApplyConcurrency,omitempty"`
+
+	// DesiredAgentVersion is the version (image tag) of the member agent that the member cluster
+	// should run. It allows hub admins to upgrade the member agents of the fleet centrally; the
+	// member agent rolls out the new version to its own deployment, if agent upgrade is enabled on
+	// the member agent, and rolls back if the new version fails to become available.
+	// If not specified, the member agent is not upgraded.
+	// +kubebuilder:validation:MaxLength=128
+	// +kubebuilder:validation:Pattern=`^[a-zA-Z0-9_][a-zA-Z0-9_.-]*$`
+	// +optional
+	DesiredAgentVersion string `json:"desiredAgentVersion,omitempty"`
 }
 
 // DeleteValidationMode identifies the type of validation when deleting a MemberCluster.
@@ -152,6 +172,11 @@ type MemberClusterStatus struct {
 	// AgentStatus is an array of current observed status, each corresponding to one member agent running in the member cluster.
 	// +optional
 	AgentStatus []AgentStatus `json:"agentStatus,omitempty"`
+
+	// WorkApplyConcurrency is the maximum number of Work objects that the member agent applies at the
+	// same time, as acknowledged by the member agent. It is copied from the corresponding InternalMemberCluster object.
+	// +optional
+	WorkApplyConcurrency *int32 `json:"workApplyConcurrency,omitempty"`
 }
 
 // Taint attached to MemberCluster has the "effect" on
@@ -0,0 +1,116 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// PropertyCatalogName is the name of the PropertyCatalog object that the hub agent maintains.
+	PropertyCatalogName = "default"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-cluster},shortName=pcat
+// +kubebuilder:subresource:status
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.status.memberClusterCount`,name="Member-Clusters",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+
+// PropertyCatalog lists all the properties, resource or non-resource, that are currently
+// published by any member cluster in the fleet, so that policy authors can discover the valid
+// property names for the property selectors and sorters in the cluster affinity terms of their
+// placements.
+//
+// The hub agent maintains a single PropertyCatalog object, named "default", and keeps it up to
+// date as the member clusters report their properties; the object is read-only to users.
+type PropertyCatalog struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Status is the observed state of the PropertyCatalog.
+	// +optional
+	Status PropertyCatalogStatus `json:"status,omitempty"`
+}
+
+// PropertyCatalogStatus is the observed state of the PropertyCatalog.
+type PropertyCatalogStatus struct {
+	// MemberClusterCount is the number of member clusters whose properties have been cataloged.
+	// +optional
+	MemberClusterCount int32 `json:"memberClusterCount,omitempty"`
+
+	// Properties is the list of properties published by the member clusters, sorted by name.
+	// +listType=map
+	// +listMapKey=name
+	// +optional
+	Properties []PropertyCatalogEntry `json:"properties,omitempty"`
+}
+
+// PropertyType is the type of a cluster property.
+// +enum
+type PropertyType string
+
+const (
+	// PropertyTypeNonResource is the type of the properties that member clusters publish in the
+	// Properties field of their status, e.g., the node count.
+	PropertyTypeNonResource PropertyType = "NonResource"
+
+	// PropertyTypeResource is the type of the properties that are derived from the resource usage
+	// that member clusters publish in the ResourceUsage field of their status, e.g., the available CPU.
+	PropertyTypeResource PropertyType = "Resource"
+)
+
+// PropertyCatalogEntry describes a property published by the member clusters.
+type PropertyCatalogEntry struct {
+	// Name is the name of the property, as used in the property selectors and sorters of the
+	// cluster affinity terms.
+	// +required
+	Name PropertyName `json:"name"`
+
+	// Type is the type of the property.
+	// +kubebuilder:validation:Enum=NonResource;Resource
+	// +required
+	Type PropertyType `json:"type"`
+
+	// ClusterCount is the number of member clusters that publish the property.
+	// +required
+	ClusterCount int32 `json:"clusterCount"`
+
+	// SampleValues is a list of distinct values of the property, as published by the member
+	// clusters, sorted alphabetically. At most 5 values are kept.
+	// +kubebuilder:validation:MaxItems=5
+	// +optional
+	SampleValues []string `json:"sampleValues,omitempty"`
+}
+
+// PropertyCatalogList contains a list of PropertyCatalog objects.
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type PropertyCatalogList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of PropertyCatalog objects.
+	Items []PropertyCatalog `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&PropertyCatalog{}, &PropertyCatalogList{})
+}
@@ -69,7 +69,7 @@ func (in *InternalMemberCluster) DeepCopyInto(out *InternalMemberCluster) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	out.Spec = in.Spec
+	in.Spec.DeepCopyInto(&out.Spec)
 	in.Status.DeepCopyInto(&out.Status)
 }
 
@@ -126,6 +126,11 @@ func (in *InternalMemberClusterList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *InternalMemberClusterSpec) DeepCopyInto(out *InternalMemberClusterSpec) {
 	*out = *in
+	if in.WorkApplyConcurrency != nil {
+		in, out := &in.WorkApplyConcurrency, &out.WorkApplyConcurrency
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalMemberClusterSpec.
@@ -170,6 +175,11 @@ func (in *InternalMemberClusterStatus) DeepCopyInto(out *InternalMemberClusterSt
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WorkApplyConcurrency != nil {
+		in, out := &in.WorkApplyConcurrency, &out.WorkApplyConcurrency
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new InternalMemberClusterStatus.
@@ -255,6 +265,11 @@ func (in *MemberClusterSpec) DeepCopyInto(out *MemberClusterSpec) {
 		*out = new(DeleteOptions)
 		**out = **in
 	}
+	if in.WorkApplyConcurrency != nil {
+		in, out := &in.WorkApplyConcurrency, &out.WorkApplyConcurrency
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberClusterSpec.
@@ -299,6 +314,11 @@ func (in *MemberClusterStatus) DeepCopyInto(out *MemberClusterStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WorkApplyConcurrency != nil {
+		in, out := &in.WorkApplyConcurrency, &out.WorkApplyConcurrency
+		*out = new(int32)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MemberClusterStatus.
@@ -311,6 +331,106 @@ func (in *MemberClusterStatus) DeepCopy() *MemberClusterStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropertyCatalog) DeepCopyInto(out *PropertyCatalog) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertyCatalog.
+func (in *PropertyCatalog) DeepCopy() *PropertyCatalog {
+	if in == nil {
+		return nil
+	}
+	out := new(PropertyCatalog)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PropertyCatalog) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropertyCatalogEntry) DeepCopyInto(out *PropertyCatalogEntry) {
+	*out = *in
+	if in.SampleValues != nil {
+		in, out := &in.SampleValues, &out.SampleValues
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertyCatalogEntry.
+func (in *PropertyCatalogEntry) DeepCopy() *PropertyCatalogEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(PropertyCatalogEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropertyCatalogList) DeepCopyInto(out *PropertyCatalogList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PropertyCatalog, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertyCatalogList.
+func (in *PropertyCatalogList) DeepCopy() *PropertyCatalogList {
+	if in == nil {
+		return nil
+	}
+	out := new(PropertyCatalogList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PropertyCatalogList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PropertyCatalogStatus) DeepCopyInto(out *PropertyCatalogStatus) {
+	*out = *in
+	if in.Properties != nil {
+		in, out := &in.Properties, &out.Properties
+		*out = make([]PropertyCatalogEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PropertyCatalogStatus.
+func (in *PropertyCatalogStatus) DeepCopy() *PropertyCatalogStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(PropertyCatalogStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PropertyValue) DeepCopyInto(out *PropertyValue) {
 	*out = *in
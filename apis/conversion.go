@@ -0,0 +1,204 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apis
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"reflect"
+
+	jsonpatch "github.com/evanphx/json-patch/v5"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+)
+
+// ConversionDataAnnotation is the annotation on an object of an older API version, which keeps the
+// fields of the hub version object that the older version cannot represent, so that these fields
+// survive a round trip through the older version.
+const ConversionDataAnnotation = "kubernetes-fleet.io/conversion-data"
+
+// ConvertToHub converts an object of an older (spoke) API version to the hub API version.
+//
+// The spoke API versions of Fleet are subsets of the hub API version, i.e., a field in a spoke
+// version always has the same name and the same type as its counterpart in the hub version; the
+// conversion is therefore performed by way of JSON. Fields that the spoke version cannot represent
+// are restored from the conversion data annotation (if present), unless they have been changed
+// via the spoke version in the meantime.
+func ConvertToHub(spoke client.Object, hub conversion.Hub) error {
+	spokeData, err := toMap(spoke)
+	if err != nil {
+		return fmt.Errorf("failed to convert %T to the hub version: %w", spoke, err)
+	}
+	hubOnlyData, hasHubOnlyData := popConversionData(spokeData)
+
+	hubData := spokeData
+	if hasHubOnlyData {
+		// Rebuild the hub version object from the conversion data, and apply the changes made
+		// via the spoke version on top of it.
+		restoredData, err := unmarshalToMap([]byte(hubOnlyData))
+		if err != nil {
+			return fmt.Errorf("failed to convert %T to the hub version: invalid conversion data: %w", spoke, err)
+		}
+		for _, key := range []string{"apiVersion", "kind", "metadata"} {
+			if v, ok := spokeData[key]; ok {
+				restoredData[key] = v
+			} else {
+				delete(restoredData, key)
+			}
+		}
+		// Find out how the restored object looks like in the spoke version.
+		restoredSpoke := reflect.New(reflect.TypeOf(spoke).Elem()).Interface().(client.Object)
+		if err := fromMap(restoredData, restoredSpoke); err != nil {
+			return fmt.Errorf("failed to convert %T to the hub version: %w", spoke, err)
+		}
+		restoredSpokeData, err := toMap(restoredSpoke)
+		if err != nil {
+			return fmt.Errorf("failed to convert %T to the hub version: %w", spoke, err)
+		}
+		if hubData, err = applyChanges(restoredData, restoredSpokeData, spokeData); err != nil {
+			return fmt.Errorf("failed to convert %T to the hub version: %w", spoke, err)
+		}
+	}
+
+	hubGVK := hub.GetObjectKind().GroupVersionKind()
+	if err := fromMap(hubData, hub); err != nil {
+		return fmt.Errorf("failed to convert %T to the hub version: %w", spoke, err)
+	}
+	hub.GetObjectKind().SetGroupVersionKind(hubGVK)
+	return nil
+}
+
+// ConvertFromHub converts an object of the hub API version to an older (spoke) API version.
+//
+// If the spoke version cannot represent all the fields of the hub version object, the hub version
+// object is kept in the conversion data annotation of the spoke version object.
+func ConvertFromHub(hub conversion.Hub, spoke client.Object) error {
+	hubData, err := toMap(hub)
+	if err != nil {
+		return fmt.Errorf("failed to convert %T from the hub version: %w", spoke, err)
+	}
+	// The hub version object should never carry the conversion data annotation; drop it
+	// just in case.
+	popConversionData(hubData)
+
+	spokeGVK := spoke.GetObjectKind().GroupVersionKind()
+	if err := fromMap(hubData, spoke); err != nil {
+		return fmt.Errorf("failed to convert %T from the hub version: %w", spoke, err)
+	}
+	spoke.GetObjectKind().SetGroupVersionKind(spokeGVK)
+
+	// Check if any of the fields has been lost during the conversion.
+	spokeData, err := toMap(spoke)
+	if err != nil {
+		return fmt.Errorf("failed to convert %T from the hub version: %w", spoke, err)
+	}
+	for _, key := range []string{"apiVersion", "kind", "metadata"} {
+		delete(hubData, key)
+		delete(spokeData, key)
+	}
+	if reflect.DeepEqual(hubData, spokeData) {
+		return nil
+	}
+	data, err := json.Marshal(hubData)
+	if err != nil {
+		return fmt.Errorf("failed to convert %T from the hub version: %w", spoke, err)
+	}
+	annotations := spoke.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+	annotations[ConversionDataAnnotation] = string(data)
+	spoke.SetAnnotations(annotations)
+	return nil
+}
+
+// applyChanges applies the changes between two versions of an object in the spoke version (the
+// original and the modified) to the object in the hub version, by way of a JSON merge patch.
+func applyChanges(hubData, originalSpokeData, modifiedSpokeData map[string]interface{}) (map[string]interface{}, error) {
+	original, err := json.Marshal(originalSpokeData)
+	if err != nil {
+		return nil, err
+	}
+	modified, err := json.Marshal(modifiedSpokeData)
+	if err != nil {
+		return nil, err
+	}
+	patch, err := jsonpatch.CreateMergePatch(original, modified)
+	if err != nil {
+		return nil, err
+	}
+	hub, err := json.Marshal(hubData)
+	if err != nil {
+		return nil, err
+	}
+	patched, err := jsonpatch.MergePatch(hub, patch)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalToMap(patched)
+}
+
+// popConversionData removes the conversion data annotation from the JSON representation of an
+// object, and returns the annotation value.
+func popConversionData(data map[string]interface{}) (string, bool) {
+	metadata, ok := data["metadata"].(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	annotations, ok := metadata["annotations"].(map[string]interface{})
+	if !ok {
+		return "", false
+	}
+	value, ok := annotations[ConversionDataAnnotation].(string)
+	if !ok {
+		return "", false
+	}
+	delete(annotations, ConversionDataAnnotation)
+	if len(annotations) == 0 {
+		delete(metadata, "annotations")
+	}
+	return value, true
+}
+
+func toMap(obj interface{}) (map[string]interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, err
+	}
+	return unmarshalToMap(data)
+}
+
+// unmarshalToMap unmarshals JSON data into a map; numbers are kept as json.Number, so that
+// large integers do not lose precision.
+func unmarshalToMap(data []byte) (map[string]interface{}, error) {
+	decoder := json.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+	m := map[string]interface{}{}
+	if err := decoder.Decode(&m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func fromMap(m map[string]interface{}, obj interface{}) error {
+	data, err := json.Marshal(m)
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, obj)
+}
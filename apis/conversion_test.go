@@ -0,0 +1,324 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apis_test
+
+import (
+	"encoding/json"
+	"fmt"
+	"math/rand"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/apitesting/fuzzer"
+	metafuzzer "k8s.io/apimachinery/pkg/apis/meta/fuzzer"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	runtimeserializer "k8s.io/apimachinery/pkg/runtime/serializer"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+	webhookconversion "sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+	"sigs.k8s.io/randfill"
+
+	"github.com/kubefleet-dev/kubefleet/apis"
+	clusterv1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1"
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// fuzzIterations is the number of random objects to round trip for each kind and direction.
+	fuzzIterations = 50
+
+	fleetAPIPkgPath = "github.com/kubefleet-dev/kubefleet/apis"
+)
+
+var (
+	// spokeToHub maps the spoke API versions to the hub API version.
+	spokeToHub = map[schema.GroupVersion]schema.GroupVersion{
+		placementv1.GroupVersion: placementv1beta1.GroupVersion,
+		clusterv1.GroupVersion:   clusterv1beta1.GroupVersion,
+	}
+
+	lessFunc = func(s1, s2 string) bool {
+		return s1 < s2
+	}
+)
+
+func conversionScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		placementv1.AddToScheme,
+		placementv1beta1.AddToScheme,
+		clusterv1.AddToScheme,
+		clusterv1beta1.AddToScheme,
+	} {
+		if err := addToScheme(scheme); err != nil {
+			t.Fatalf("Failed to set up the scheme: %v", err)
+		}
+	}
+	return scheme
+}
+
+// convertibleKinds returns the kinds served in both a spoke API version and the hub API version.
+func convertibleKinds(scheme *runtime.Scheme) []schema.GroupVersionKind {
+	var gvks []schema.GroupVersionKind
+	for gvk, typ := range scheme.AllKnownTypes() {
+		hubGV, ok := spokeToHub[gvk.GroupVersion()]
+		// Skip the list kinds and the common kinds registered for all API versions (e.g., metav1.CreateOptions).
+		if !ok || strings.HasSuffix(gvk.Kind, "List") || !strings.HasPrefix(typ.PkgPath(), fleetAPIPkgPath) {
+			continue
+		}
+		if scheme.Recognizes(hubGV.WithKind(gvk.Kind)) {
+			gvks = append(gvks, gvk)
+		}
+	}
+	sort.Slice(gvks, func(i, j int) bool {
+		return gvks[i].String() < gvks[j].String()
+	})
+	return gvks
+}
+
+func newFiller(t *testing.T, scheme *runtime.Scheme, seed int64) *randfill.Filler {
+	rawJSON := func(c randfill.Continue) []byte {
+		data, err := json.Marshal(map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name": c.String(0),
+			},
+		})
+		if err != nil {
+			t.Fatalf("Failed to marshal random JSON: %v", err)
+		}
+		return data
+	}
+	funcs := fuzzer.MergeFuzzerFuncs(metafuzzer.Funcs, func(_ runtimeserializer.CodecFactory) []interface{} {
+		return []interface{}{
+			// The raw JSON data are compacted (and have their keys sorted) during the conversion.
+			func(r *runtime.RawExtension, c randfill.Continue) {
+				r.Raw = rawJSON(c)
+			},
+			func(j *apiextensionsv1.JSON, c randfill.Continue) {
+				j.Raw = rawJSON(c)
+			},
+		}
+	})
+	return fuzzer.FuzzerFor(funcs, rand.NewSource(seed), runtimeserializer.NewCodecFactory(scheme))
+}
+
+func newObject(t *testing.T, scheme *runtime.Scheme, gvk schema.GroupVersionKind) client.Object {
+	obj, err := scheme.New(gvk)
+	if err != nil {
+		t.Fatalf("Failed to create an object of %s: %v", gvk, err)
+	}
+	return obj.(client.Object)
+}
+
+// TestConversionRoundTrip tests that objects survive a round trip between the hub API version and
+// the spoke API versions, in both directions.
+func TestConversionRoundTrip(t *testing.T) {
+	scheme := conversionScheme(t)
+	cmpOptions := []cmp.Option{
+		cmpopts.EquateEmpty(),
+		cmpopts.IgnoreTypes(metav1.TypeMeta{}),
+	}
+
+	for _, spokeGVK := range convertibleKinds(scheme) {
+		hubGVK := spokeToHub[spokeGVK.GroupVersion()].WithKind(spokeGVK.Kind)
+		t.Run(spokeGVK.String(), func(t *testing.T) {
+			filler := newFiller(t, scheme, 1)
+			for i := 0; i < fuzzIterations; i++ {
+				// spoke -> hub -> spoke.
+				spoke := newObject(t, scheme, spokeGVK)
+				filler.Fill(spoke)
+				hub := newObject(t, scheme, hubGVK)
+				if err := spoke.(conversion.Convertible).ConvertTo(hub.(conversion.Hub)); err != nil {
+					t.Fatalf("ConvertTo() = %v, want no error", err)
+				}
+				gotSpoke := newObject(t, scheme, spokeGVK)
+				if err := gotSpoke.(conversion.Convertible).ConvertFrom(hub.(conversion.Hub)); err != nil {
+					t.Fatalf("ConvertFrom() = %v, want no error", err)
+				}
+				if diff := cmp.Diff(spoke, gotSpoke, cmpOptions...); diff != "" {
+					t.Fatalf("spoke -> hub -> spoke round trip mismatch (-want, +got):\n%s", diff)
+				}
+
+				// hub -> spoke -> hub.
+				hub = newObject(t, scheme, hubGVK)
+				filler.Fill(hub)
+				spoke = newObject(t, scheme, spokeGVK)
+				if err := spoke.(conversion.Convertible).ConvertFrom(hub.(conversion.Hub)); err != nil {
+					t.Fatalf("ConvertFrom() = %v, want no error", err)
+				}
+				gotHub := newObject(t, scheme, hubGVK)
+				if err := spoke.(conversion.Convertible).ConvertTo(gotHub.(conversion.Hub)); err != nil {
+					t.Fatalf("ConvertTo() = %v, want no error", err)
+				}
+				if diff := cmp.Diff(hub, gotHub, cmpOptions...); diff != "" {
+					t.Fatalf("hub -> spoke -> hub round trip mismatch (-want, +got):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+// TestConvertToHubWithChanges tests that the changes made via a spoke API version are kept when
+// the fields that the spoke version cannot represent are restored.
+func TestConvertToHubWithChanges(t *testing.T) {
+	hub := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "crp",
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{
+				{
+					Group:   "",
+					Version: "v1",
+					Kind:    "Namespace",
+					Name:    "app",
+				},
+			},
+			Strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				DeleteStrategy: &placementv1beta1.DeleteStrategy{
+					PropagationPolicy: placementv1beta1.DeletePropagationPolicyAbandon,
+				},
+			},
+			RevisionHistoryLimit: ptrTo(int32(5)),
+		},
+	}
+	spoke := &placementv1.ClusterResourcePlacement{}
+	if err := spoke.ConvertFrom(hub); err != nil {
+		t.Fatalf("ConvertFrom() = %v, want no error", err)
+	}
+	if _, ok := spoke.Annotations[apis.ConversionDataAnnotation]; !ok {
+		t.Fatalf("ConvertFrom() did not keep the fields that the spoke version cannot represent")
+	}
+
+	// Make changes via the spoke version.
+	spoke.Spec.ResourceSelectors[0].Name = "web"
+	spoke.Spec.RevisionHistoryLimit = nil
+	spoke.Labels = map[string]string{"env": "prod"}
+
+	gotHub := &placementv1beta1.ClusterResourcePlacement{}
+	if err := spoke.ConvertTo(gotHub); err != nil {
+		t.Fatalf("ConvertTo() = %v, want no error", err)
+	}
+	wantHub := hub.DeepCopy()
+	wantHub.Spec.ResourceSelectors[0].Name = "web"
+	wantHub.Spec.RevisionHistoryLimit = nil
+	wantHub.Labels = map[string]string{"env": "prod"}
+	if diff := cmp.Diff(wantHub, gotHub, cmpopts.IgnoreTypes(metav1.TypeMeta{})); diff != "" {
+		t.Errorf("ConvertTo() mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+// TestConversionCoverage verifies that all the kinds served in both a spoke API version and the hub
+// API version are convertible, and reports the fields that are kept only in the conversion data
+// annotation when an object is converted to a spoke API version. Run the test with -v to see the report.
+func TestConversionCoverage(t *testing.T) {
+	scheme := conversionScheme(t)
+	var report strings.Builder
+	fmt.Fprintf(&report, "%-66s %s\n", "KIND", "FIELDS NOT REPRESENTED IN THE SPOKE VERSION")
+	for _, spokeGVK := range convertibleKinds(scheme) {
+		spoke := newObject(t, scheme, spokeGVK)
+		ok, err := webhookconversion.IsConvertible(scheme, spoke)
+		if err != nil || !ok {
+			t.Errorf("IsConvertible(%s) = %t, %v, want true, no error", spokeGVK, ok, err)
+			continue
+		}
+		hubGVK := spokeToHub[spokeGVK.GroupVersion()].WithKind(spokeGVK.Kind)
+		hubFields := jsonFieldPaths(reflect.TypeOf(newObject(t, scheme, hubGVK)), "", map[reflect.Type]bool{})
+		spokeFields := jsonFieldPaths(reflect.TypeOf(spoke), "", map[reflect.Type]bool{})
+		var hubOnlyFields []string
+		for _, field := range hubFields {
+			if !contains(spokeFields, field) {
+				hubOnlyFields = append(hubOnlyFields, field)
+			}
+		}
+		var spokeOnlyFields []string
+		for _, field := range spokeFields {
+			if !contains(hubFields, field) {
+				spokeOnlyFields = append(spokeOnlyFields, field)
+			}
+		}
+		// A spoke version must be a subset of the hub version; otherwise the fields are dropped
+		// silently during the conversion.
+		if diff := cmp.Diff([]string(nil), spokeOnlyFields, cmpopts.EquateEmpty(), cmpopts.SortSlices(lessFunc)); diff != "" {
+			t.Errorf("%s has fields that are not in the hub version (-want, +got):\n%s", spokeGVK, diff)
+		}
+		if len(hubOnlyFields) == 0 {
+			hubOnlyFields = []string{"-"}
+		}
+		fmt.Fprintf(&report, "%-66s %s\n", spokeGVK.String(), strings.Join(hubOnlyFields, ", "))
+	}
+	t.Logf("Conversion coverage report:\n%s", report.String())
+}
+
+// jsonFieldPaths returns the JSON paths of all the fields (leaf or not) in a type.
+func jsonFieldPaths(typ reflect.Type, prefix string, visiting map[reflect.Type]bool) []string {
+	for typ.Kind() == reflect.Pointer || typ.Kind() == reflect.Slice || typ.Kind() == reflect.Map || typ.Kind() == reflect.Array {
+		typ = typ.Elem()
+	}
+	if typ.Kind() != reflect.Struct || visiting[typ] || typ.PkgPath() != "" && !strings.HasPrefix(typ.PkgPath(), fleetAPIPkgPath) {
+		// Types from other packages (e.g., metav1.ObjectMeta) are the same across the API versions.
+		return nil
+	}
+	visiting[typ] = true
+	defer delete(visiting, typ)
+
+	var paths []string
+	for i := 0; i < typ.NumField(); i++ {
+		field := typ.Field(i)
+		name, _, _ := strings.Cut(field.Tag.Get("json"), ",")
+		if name == "-" || !field.IsExported() {
+			continue
+		}
+		if name == "" {
+			if field.Anonymous {
+				// Inlined fields.
+				paths = append(paths, jsonFieldPaths(field.Type, prefix, visiting)...)
+				continue
+			}
+			name = field.Name
+		}
+		path := prefix + "." + name
+		paths = append(paths, path)
+		paths = append(paths, jsonFieldPaths(field.Type, path, visiting)...)
+	}
+	return paths
+}
+
+func contains(items []string, item string) bool {
+	for _, i := range items {
+		if i == item {
+			return true
+		}
+	}
+	return false
+}
+
+func ptrTo[T any](v T) *T {
+	return &v
+}
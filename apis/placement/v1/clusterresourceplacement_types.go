@@ -315,10 +315,12 @@ type ClusterSelector struct {
 }
 
 type PreferredClusterSelector struct {
-	// Weight associated with matching the corresponding clusterSelectorTerm, in the range [-100, 100].
+	// Weight associated with matching the corresponding clusterSelectorTerm, in the range [-1000, 1000].
+	// If the absolute value of any weight in the preferred terms exceeds 100, the scheduler normalizes
+	// all the weights proportionally so that the largest absolute weight becomes 100.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Minimum=-100
-	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=-1000
+	// +kubebuilder:validation:Maximum=1000
 	Weight int32 `json:"weight"`
 
 	// A cluster selector term, associated with the corresponding weight.
@@ -0,0 +1,204 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/conversion"
+
+	"github.com/kubefleet-dev/kubefleet/apis"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// ConvertTo converts this AppliedWork to the hub version (v1beta1).
+func (m *AppliedWork) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.AppliedWork))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this AppliedWork.
+func (m *AppliedWork) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.AppliedWork), m)
+}
+
+// ConvertTo converts this ApprovalRequest to the hub version (v1beta1).
+func (m *ApprovalRequest) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.ApprovalRequest))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ApprovalRequest.
+func (m *ApprovalRequest) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.ApprovalRequest), m)
+}
+
+// ConvertTo converts this ClusterApprovalRequest to the hub version (v1beta1).
+func (m *ClusterApprovalRequest) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.ClusterApprovalRequest))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ClusterApprovalRequest.
+func (m *ClusterApprovalRequest) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.ClusterApprovalRequest), m)
+}
+
+// ConvertTo converts this ClusterResourceBinding to the hub version (v1beta1).
+func (m *ClusterResourceBinding) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.ClusterResourceBinding))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ClusterResourceBinding.
+func (m *ClusterResourceBinding) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.ClusterResourceBinding), m)
+}
+
+// ConvertTo converts this ClusterResourceOverride to the hub version (v1beta1).
+func (m *ClusterResourceOverride) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.ClusterResourceOverride))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ClusterResourceOverride.
+func (m *ClusterResourceOverride) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.ClusterResourceOverride), m)
+}
+
+// ConvertTo converts this ClusterResourceOverrideSnapshot to the hub version (v1beta1).
+func (m *ClusterResourceOverrideSnapshot) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.ClusterResourceOverrideSnapshot))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ClusterResourceOverrideSnapshot.
+func (m *ClusterResourceOverrideSnapshot) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.ClusterResourceOverrideSnapshot), m)
+}
+
+// ConvertTo converts this ClusterResourcePlacement to the hub version (v1beta1).
+func (m *ClusterResourcePlacement) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.ClusterResourcePlacement))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ClusterResourcePlacement.
+func (m *ClusterResourcePlacement) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.ClusterResourcePlacement), m)
+}
+
+// ConvertTo converts this ClusterResourcePlacementStatus to the hub version (v1beta1).
+func (m *ClusterResourcePlacementStatus) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.ClusterResourcePlacementStatus))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ClusterResourcePlacementStatus.
+func (m *ClusterResourcePlacementStatus) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.ClusterResourcePlacementStatus), m)
+}
+
+// ConvertTo converts this ClusterResourceSnapshot to the hub version (v1beta1).
+func (m *ClusterResourceSnapshot) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.ClusterResourceSnapshot))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ClusterResourceSnapshot.
+func (m *ClusterResourceSnapshot) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.ClusterResourceSnapshot), m)
+}
+
+// ConvertTo converts this ClusterSchedulingPolicySnapshot to the hub version (v1beta1).
+func (m *ClusterSchedulingPolicySnapshot) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.ClusterSchedulingPolicySnapshot))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ClusterSchedulingPolicySnapshot.
+func (m *ClusterSchedulingPolicySnapshot) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.ClusterSchedulingPolicySnapshot), m)
+}
+
+// ConvertTo converts this ClusterStagedUpdateRun to the hub version (v1beta1).
+func (m *ClusterStagedUpdateRun) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.ClusterStagedUpdateRun))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ClusterStagedUpdateRun.
+func (m *ClusterStagedUpdateRun) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.ClusterStagedUpdateRun), m)
+}
+
+// ConvertTo converts this ClusterStagedUpdateStrategy to the hub version (v1beta1).
+func (m *ClusterStagedUpdateStrategy) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.ClusterStagedUpdateStrategy))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ClusterStagedUpdateStrategy.
+func (m *ClusterStagedUpdateStrategy) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.ClusterStagedUpdateStrategy), m)
+}
+
+// ConvertTo converts this ResourceOverride to the hub version (v1beta1).
+func (m *ResourceOverride) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.ResourceOverride))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ResourceOverride.
+func (m *ResourceOverride) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.ResourceOverride), m)
+}
+
+// ConvertTo converts this ResourceOverrideSnapshot to the hub version (v1beta1).
+func (m *ResourceOverrideSnapshot) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.ResourceOverrideSnapshot))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ResourceOverrideSnapshot.
+func (m *ResourceOverrideSnapshot) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.ResourceOverrideSnapshot), m)
+}
+
+// ConvertTo converts this ResourcePlacement to the hub version (v1beta1).
+func (m *ResourcePlacement) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.ResourcePlacement))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this ResourcePlacement.
+func (m *ResourcePlacement) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.ResourcePlacement), m)
+}
+
+// ConvertTo converts this StagedUpdateRun to the hub version (v1beta1).
+func (m *StagedUpdateRun) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.StagedUpdateRun))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this StagedUpdateRun.
+func (m *StagedUpdateRun) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.StagedUpdateRun), m)
+}
+
+// ConvertTo converts this StagedUpdateStrategy to the hub version (v1beta1).
+func (m *StagedUpdateStrategy) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.StagedUpdateStrategy))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this StagedUpdateStrategy.
+func (m *StagedUpdateStrategy) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.StagedUpdateStrategy), m)
+}
+
+// ConvertTo converts this Work to the hub version (v1beta1).
+func (m *Work) ConvertTo(hub conversion.Hub) error {
+	return apis.ConvertToHub(m, hub.(*placementv1beta1.Work))
+}
+
+// ConvertFrom converts the hub version (v1beta1) to this Work.
+func (m *Work) ConvertFrom(hub conversion.Hub) error {
+	return apis.ConvertFromHub(hub.(*placementv1beta1.Work), m)
+}
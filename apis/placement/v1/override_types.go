@@ -26,6 +26,7 @@ import (
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope="Cluster",categories={fleet,fleet-placement}
 // +kubebuilder:validation:XValidation:rule="!has(self.spec.placement) || self.spec.placement.scope != 'Namespaced'",message="clusterResourceOverride placement reference cannot be Namespaced scope"
+// +kubebuilder:subresource:status
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ClusterResourceOverride defines a group of override policies about how to override the selected cluster scope resources
@@ -37,6 +38,10 @@ type ClusterResourceOverride struct {
 	// The desired state of ClusterResourceOverrideSpec.
 	// +required
 	Spec ClusterResourceOverrideSpec `json:"spec"`
+
+	// The observed status of ClusterResourceOverride.
+	// +optional
+	Status OverrideStatus `json:"status,omitempty"`
 }
 
 // ClusterResourceOverrideSpec defines the desired state of the Override.
@@ -149,6 +154,7 @@ const (
 // +genclient:Namespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope="Namespaced",categories={fleet,fleet-placement}
+// +kubebuilder:subresource:status
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ResourceOverride defines a group of override policies about how to override the selected namespaced scope resources
@@ -160,6 +166,10 @@ type ResourceOverride struct {
 	// The desired state of ResourceOverrideSpec.
 	// +required
 	Spec ResourceOverrideSpec `json:"spec"`
+
+	// The observed status of ResourceOverride.
+	// +optional
+	Status OverrideStatus `json:"status,omitempty"`
 }
 
 // ResourceOverrideSpec defines the desired state of the Override.
@@ -289,6 +299,71 @@ const (
 	JSONPatchOverrideOpReplace JSONPatchOverrideOperator = "replace"
 )
 
+// OverrideStatus defines the observed state of an override.
+type OverrideStatus struct {
+	// RenderFailures lists the failures found when rendering the JSON patch overrides of the override rules on
+	// the selected resources, as found in the latest resource snapshots of the placements; the override rules are
+	// rendered regardless of the cluster selectors.
+	// At most 20 failures are reported.
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	RenderFailures []OverrideRenderFailure `json:"renderFailures,omitempty"`
+
+	// Conditions is an array of current observed conditions for the override.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// OverrideRenderFailure describes a failure to render an override rule on a selected resource.
+type OverrideRenderFailure struct {
+	// ResourceSnapshotName is the name of the resource snapshot in which the selected resource is found.
+	// +required
+	ResourceSnapshotName string `json:"resourceSnapshotName"`
+
+	// Group is the group name of the selected resource.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Version is the version of the selected resource.
+	// +required
+	Version string `json:"version"`
+
+	// Kind represents the Kind of the selected resource.
+	// +required
+	Kind string `json:"kind"`
+
+	// Name of the selected resource.
+	// +required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the selected resource. Empty if the resource is cluster scoped.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// RuleIndex is the index of the override rule that fails to render.
+	// +required
+	RuleIndex int32 `json:"ruleIndex"`
+
+	// Message explains why the override rule fails to render, e.g., a JSON patch path that does not exist.
+	// +required
+	Message string `json:"message"`
+}
+
+// OverrideConditionType identifies a specific condition of an override.
+type OverrideConditionType string
+
+const (
+	// OverrideRenderedConditionType indicates whether the override can be rendered on all the resources it selects,
+	// as found in the latest resource snapshots of the placements.
+	// Its condition status can be one of the following:
+	// - "True" means that all the override rules have been rendered on the selected resources successfully.
+	// - "False" means that some override rules fail to render on some selected resources; the rollouts that
+	// consume the override are expected to fail on these resources. See the render failures for details.
+	OverrideRenderedConditionType OverrideConditionType = "Rendered"
+)
+
 // ClusterResourceOverrideList contains a list of ClusterResourceOverride.
 // +kubebuilder:resource:scope="Cluster"
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -443,6 +443,7 @@ func (in *ClusterResourceOverride) DeepCopyInto(out *ClusterResourceOverride) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceOverride.
@@ -1302,6 +1303,21 @@ func (in *OverridePolicy) DeepCopy() *OverridePolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverrideRenderFailure) DeepCopyInto(out *OverrideRenderFailure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverrideRenderFailure.
+func (in *OverrideRenderFailure) DeepCopy() *OverrideRenderFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(OverrideRenderFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OverrideRule) DeepCopyInto(out *OverrideRule) {
 	*out = *in
@@ -1329,6 +1345,33 @@ func (in *OverrideRule) DeepCopy() *OverrideRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverrideStatus) DeepCopyInto(out *OverrideStatus) {
+	*out = *in
+	if in.RenderFailures != nil {
+		in, out := &in.RenderFailures, &out.RenderFailures
+		*out = make([]OverrideRenderFailure, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]metav1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverrideStatus.
+func (in *OverrideStatus) DeepCopy() *OverrideStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OverrideStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PatchDetail) DeepCopyInto(out *PatchDetail) {
 	*out = *in
@@ -1712,6 +1755,7 @@ func (in *ResourceOverride) DeepCopyInto(out *ResourceOverride) {
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceOverride.
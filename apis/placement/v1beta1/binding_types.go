@@ -29,6 +29,11 @@ const (
 	// corresponding CRP name for deleting bindings to trigger a new scheduling cycle.
 	// TODO: migrate the finalizer to the new name "scheduler-binding-cleanup" in the future.
 	SchedulerBindingCleanupFinalizer = FleetPrefix + "scheduler-crb-cleanup"
+
+	// AnalysisFailedResourceSnapshotAnnotation is an annotation on bindings which records the name of the
+	// resource snapshot whose rollout analysis has failed on the target cluster; the rollout of the
+	// resource snapshot is halted while any binding of the placement carries the annotation.
+	AnalysisFailedResourceSnapshotAnnotation = FleetPrefix + "analysis-failed-resource-snapshot"
 )
 
 // make sure the BindingObj and BindingObjList interfaces are implemented by the
@@ -74,6 +79,8 @@ type BindingObjList interface {
 	BindingListItemGetter
 }
 
+// +genclient
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=crb
 // +kubebuilder:subresource:status
@@ -116,6 +123,15 @@ type ResourceBindingSpec struct {
 	// selected resources.
 	ClusterResourceOverrideSnapshots []string `json:"clusterResourceOverrideSnapshots,omitempty"`
 
+	// ClusterPropertySnapshot is a snapshot of the target cluster properties referenced by the property selectors
+	// of the override rules, taken when the overrides are picked for the binding.
+	// Fleet evaluates the property selectors of the override rules against this snapshot, rather than the live
+	// cluster properties, so that the overridden resources are reproducible; a property change is rolled out
+	// to the binding like any other override change.
+	// It is not set if none of the associated override rules has a property selector.
+	// +optional
+	ClusterPropertySnapshot *ClusterPropertySnapshot `json:"clusterPropertySnapshot,omitempty"`
+
 	// SchedulingPolicySnapshotName is the name of the scheduling policy snapshot that this resource binding
 	// points to; more specifically, the scheduler creates this bindings in accordance with this
 	// scheduling policy snapshot.
@@ -131,6 +147,23 @@ type ResourceBindingSpec struct {
 	// and is owned by other appliers.
 	// +optional
 	ApplyStrategy *ApplyStrategy `json:"applyStrategy,omitempty"`
+
+	// ApplyStrategyOverrides are the per cluster group apply strategies of the placement; the work
+	// generator uses the apply strategy of the first override that selects the target cluster, if any,
+	// in place of the ApplyStrategy field above.
+	// +optional
+	ApplyStrategyOverrides []ApplyStrategyOverride `json:"applyStrategyOverrides,omitempty"`
+}
+
+// ClusterPropertySnapshot is a snapshot of the observed property values of a member cluster.
+type ClusterPropertySnapshot struct {
+	// Values are the values of the properties, keyed by the property names.
+	// A property that the property selectors compare with quantities only is bucketed: its value is a
+	// representative one that satisfies the same match expressions as the observed value, so that the
+	// snapshot does not change as long as the observed value stays between the same quantities.
+	// Properties that are not available on the cluster are absent from the map.
+	// +optional
+	Values map[string]string `json:"values,omitempty"`
 }
 
 // BindingState is the state of the binding.
@@ -154,10 +187,17 @@ type ResourceBindingStatus struct {
 	// +kubebuilder:validation:MaxItems=100
 
 	// FailedPlacements is a list of all the resources failed to be placed to the given cluster or the resource is unavailable.
-	// Note that we only include 100 failed resource placements even if there are more than 100.
+	// Note that we only include 100 failed resource placements even if there are more than 100;
+	// the number of the ones left out is reported in FailedPlacementOverflowCount.
 	// +optional
 	FailedPlacements []FailedResourcePlacement `json:"failedPlacements,omitempty"`
 
+	// FailedPlacementOverflowCount is the number of failed resource placements that are not included
+	// in FailedPlacements as the list has been truncated.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	FailedPlacementOverflowCount int32 `json:"failedPlacementOverflowCount,omitempty"`
+
 	// DriftedPlacements is a list of resources that have drifted from their desired states
 	// kept in the hub cluster, as found by Fleet using the drift detection mechanism.
 	//
@@ -182,6 +222,38 @@ type ResourceBindingStatus struct {
 	// +kubebuilder:validation:MaxItems=100
 	DiffedPlacements []DiffedResourcePlacement `json:"diffedPlacements,omitempty"`
 
+	// DiffedPlacementSummary summarizes the resources that have configuration differences from their
+	// corresponding hub cluster manifests. It is set in place of DiffedPlacements when the ReportDiff
+	// apply strategy finds too many diffed resources, so that the status stays small; the complete details
+	// can be requested on demand (see the DiffReportRequestAnnotation on bindings).
+	// +kubebuilder:validation:Optional
+	DiffedPlacementSummary *DiffedPlacementSummary `json:"diffedPlacementSummary,omitempty"`
+
+	// DiffReport refers to the DiffReports that hold the complete details of the drifted and diffed
+	// resources, including the ones left out of DriftedPlacements and DiffedPlacements. It is only set
+	// if there are drifted or diffed resources.
+	// +kubebuilder:validation:Optional
+	DiffReport *DiffReportReference `json:"diffReport,omitempty"`
+
+	// History is a timeline of the latest significant transitions of the binding, i.e., state changes,
+	// resource snapshot switches, and rollout failures and completions, in chronological order.
+	//
+	// To control the object size, only the latest 20 transitions will be kept; older ones are dropped.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=20
+	History []BindingHistoryEntry `json:"history,omitempty"`
+
+	// LastAppliedSnapshots records the resource snapshot and the override snapshots in use the last time
+	// the resources of the binding were applied on the target cluster.
+	// +kubebuilder:validation:Optional
+	LastAppliedSnapshots *BindingSnapshots `json:"lastAppliedSnapshots,omitempty"`
+
+	// EffectiveOverrides lists the override snapshots of the binding in the order in which they are applied on
+	// the selected resources, as dictated by their priority tiers; when multiple overrides patch the same field
+	// of a resource, the last one wins.
+	// +kubebuilder:validation:Optional
+	EffectiveOverrides []EffectiveOverride `json:"effectiveOverrides,omitempty"`
+
 	// +patchMergeKey=type
 	// +patchStrategy=merge
 	// +listType=map
@@ -192,6 +264,91 @@ type ResourceBindingStatus struct {
 	Conditions []metav1.Condition `json:"conditions"`
 }
 
+// BindingSnapshots is a set of snapshots that a binding points to.
+type BindingSnapshots struct {
+	// ResourceSnapshotName is the name of the resource snapshot.
+	// +kubebuilder:validation:Optional
+	ResourceSnapshotName string `json:"resourceSnapshotName,omitempty"`
+
+	// ResourceOverrideSnapshots is a list of ResourceOverride snapshots.
+	// +kubebuilder:validation:Optional
+	ResourceOverrideSnapshots []NamespacedName `json:"resourceOverrideSnapshots,omitempty"`
+
+	// ClusterResourceOverrideSnapshots is a list of ClusterResourceOverride snapshot names.
+	// +kubebuilder:validation:Optional
+	ClusterResourceOverrideSnapshots []string `json:"clusterResourceOverrideSnapshots,omitempty"`
+}
+
+// EffectiveOverride is an override snapshot that is applied on the selected resources.
+type EffectiveOverride struct {
+	// Name is the name of the override snapshot.
+	// +kubebuilder:validation:Required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the ResourceOverride snapshot; it is empty for a ClusterResourceOverride snapshot.
+	// +kubebuilder:validation:Optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// PriorityTier is the priority tier of the override; it is empty if the override has no priority tier.
+	// +kubebuilder:validation:Optional
+	PriorityTier OverridePriorityTier `json:"priorityTier,omitempty"`
+}
+
+// BindingHistoryEntryType identifies the type of transition a binding history entry records.
+// +enum
+type BindingHistoryEntryType string
+
+const (
+	// BindingHistoryEntryTypeStateChanged means the state of the binding has changed.
+	BindingHistoryEntryTypeStateChanged BindingHistoryEntryType = "StateChanged"
+
+	// BindingHistoryEntryTypeResourceSnapshotChanged means the binding has switched to a different resource snapshot.
+	BindingHistoryEntryTypeResourceSnapshotChanged BindingHistoryEntryType = "ResourceSnapshotChanged"
+
+	// BindingHistoryEntryTypeRolloutFailed means the resources of the binding have failed to be placed on the target cluster.
+	BindingHistoryEntryTypeRolloutFailed BindingHistoryEntryType = "RolloutFailed"
+
+	// BindingHistoryEntryTypeRolloutCompleted means the resources of the binding have been placed on the target cluster
+	// and are available (or have had their differences reported, if the ReportDiff apply strategy is in use).
+	BindingHistoryEntryTypeRolloutCompleted BindingHistoryEntryType = "RolloutCompleted"
+)
+
+// BindingHistoryEntry records a significant transition of a binding.
+type BindingHistoryEntry struct {
+	// Type is the type of the transition.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=StateChanged;ResourceSnapshotChanged;RolloutFailed;RolloutCompleted
+	Type BindingHistoryEntryType `json:"type"`
+
+	// Time is when the transition was observed.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	Time metav1.Time `json:"time"`
+
+	// State is the state of the binding when the transition was observed.
+	// +kubebuilder:validation:Optional
+	State BindingState `json:"state,omitempty"`
+
+	// ResourceSnapshotName is the name of the resource snapshot the binding points to when the
+	// transition was observed.
+	// +kubebuilder:validation:Optional
+	ResourceSnapshotName string `json:"resourceSnapshotName,omitempty"`
+
+	// ObservedGeneration is the generation of the binding when the transition was observed.
+	// +kubebuilder:validation:Optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Reason is a brief reason for the transition; for rollout failures, it is the reason of the
+	// failed condition.
+	// +kubebuilder:validation:Optional
+	Reason string `json:"reason,omitempty"`
+
+	// Message is a human-readable summary of the transition.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+}
+
 // ResourceBindingConditionType identifies a specific condition of the ClusterResourceBinding.
 type ResourceBindingConditionType string
 
@@ -245,6 +402,58 @@ const (
 	//   error has occurred.
 	// * Unknown: Fleet has not finished processing the diff reporting yet.
 	ResourceBindingDiffReported ResourceBindingConditionType = "DiffReported"
+
+	// ResourceBindingAnalysisSucceeded indicates the result of the rollout analysis on the target cluster.
+	//
+	// This condition is added only when a rollout analysis is specified in the rollout strategy.
+	//
+	// It can have the following condition statuses:
+	// * True: the metric has met the threshold for the whole analysis duration.
+	// * False: the metric has not met the threshold; the rollout is halted.
+	// * Unknown: the analysis is still in progress.
+	ResourceBindingAnalysisSucceeded ResourceBindingConditionType = "AnalysisSucceeded"
+
+	// ResourceBindingQuotaFit indicates whether the workloads fit in the resource quotas of their
+	// namespaces on the target cluster.
+	//
+	// This condition is added only when the quota preflight check is enabled in the apply strategy.
+	//
+	// It can have the following condition statuses:
+	// * True: the resource requests of all the workloads fit in the resource quotas.
+	// * False: some workloads would exceed the resource quotas and have not been applied.
+	ResourceBindingQuotaFit ResourceBindingConditionType = "QuotaFit"
+
+	// ResourceBindingAdmissionPreflightPassed indicates whether the manifests have passed the admission
+	// webhooks of the target cluster in a dry-run.
+	//
+	// This condition is added only when the admission preflight is enabled in the apply strategy.
+	//
+	// It can have the following condition statuses:
+	// * True: no manifest has been rejected by the admission webhooks.
+	// * False: some manifests have been rejected by the admission webhooks.
+	ResourceBindingAdmissionPreflightPassed ResourceBindingConditionType = "AdmissionPreflightPassed"
+
+	// ResourceBindingCoOwnershipResolved indicates whether the resources that the binding places on
+	// the target cluster and that are also placed there by other placements can be co-owned.
+	//
+	// This condition is added only when some resources are also selected by other placements for
+	// the target cluster.
+	//
+	// It can have the following condition statuses:
+	// * True: all the placements use the same co-ownership policy that allows co-ownership.
+	// * False: some placements deny co-ownership or use different co-ownership policies.
+	ResourceBindingCoOwnershipResolved ResourceBindingConditionType = "CoOwnershipResolved"
+
+	// ResourceBindingPreDeletion indicates whether the binding, which has become unscheduled, is waiting
+	// for the work cleanup grace period to elapse before Fleet deletes it, along with the resources it has
+	// placed on the target cluster.
+	//
+	// This condition is added only when a work cleanup grace period is configured on the hub agent.
+	//
+	// It can have the following condition statuses:
+	// * True: the binding is in the grace period; the resources are kept on the target cluster so that
+	//   logs and states can be collected before teardown.
+	ResourceBindingPreDeletion ResourceBindingConditionType = "PreDeletion"
 )
 
 // ClusterResourceBindingList is a collection of ClusterResourceBinding.
@@ -258,6 +467,7 @@ type ClusterResourceBindingList struct {
 	Items []ClusterResourceBinding `json:"items"`
 }
 
+// +genclient
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,categories={fleet,fleet-placement},shortName=rb
 // +kubebuilder:subresource:status
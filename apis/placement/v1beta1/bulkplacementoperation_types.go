@@ -0,0 +1,233 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=bpo
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.spec.action`,name="Action",type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Completed")].status`,name="Completed",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+
+// BulkPlacementOperation is an operation on all the ClusterResourcePlacements and ResourcePlacements that
+// match a label selector; one may use this API to pause, resume, roll back or retarget many placements at once.
+//
+// The operation is executed by the hub agent with bounded concurrency; the outcome of the operation
+// on each placement is reported in the status.
+//
+// For safety reasons, Fleet will only execute an operation once; the spec in this object is immutable,
+// and once completed, the object will be ignored after. Placements that match the selector after
+// the operation has completed are not operated on. To run the same operation again, re-create
+// (delete and create) the BulkPlacementOperation object.
+type BulkPlacementOperation struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the BulkPlacementOperation.
+	//
+	// Note that all fields in the spec are immutable.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="The spec field is immutable"
+	Spec BulkPlacementOperationSpec `json:"spec"`
+
+	// Status is the observed state of the BulkPlacementOperation.
+	// +kubebuilder:validation:Optional
+	Status BulkPlacementOperationStatus `json:"status,omitempty"`
+}
+
+// BulkPlacementOperationSpec is the desired state of the BulkPlacementOperation.
+// +kubebuilder:validation:XValidation:rule="self.action == 'Retarget' ? has(self.retarget) : !has(self.retarget)",message="retarget must be set if and only if the action is Retarget"
+type BulkPlacementOperationSpec struct {
+	// PlacementSelector selects the ClusterResourcePlacements and ResourcePlacements (in all namespaces)
+	// to operate on by their labels. An empty selector selects all the placements. ResourcePlacements
+	// are only selected if the ResourcePlacement APIs are enabled on the hub agent.
+	// +kubebuilder:validation:Required
+	PlacementSelector metav1.LabelSelector `json:"placementSelector"`
+
+	// Action is the operation to run on each of the selected placements.
+	//
+	// Available actions are:
+	//
+	// * Pause: Fleet stops rolling out resources of the placement to member clusters; bindings that
+	//   are being rolled out are left as they are. Scheduling is not affected.
+	//
+	// * Resume: Fleet resumes rolling out the latest resources of a paused or rolled back placement.
+	//
+	// * Rollback: Fleet rolls all the member clusters of the placement back to the resource snapshot
+	//   just before the one that the placement currently rolls out, following the rollout strategy of
+	//   the placement. The placement keeps running the earlier resource snapshot until it is resumed,
+	//   even if the selected resources change. Running the same operation again does not roll the
+	//   placement back any further.
+	//
+	// * Retarget: Fleet replaces the clusters that the placement targets with the ones specified in
+	//   the Retarget field.
+	//
+	// The Pause, Resume and Rollback actions are recorded via annotations on the placements and only
+	// apply to placements of the RollingUpdate rollout strategy type.
+	//
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Pause;Resume;Rollback;Retarget
+	Action BulkPlacementOperationAction `json:"action"`
+
+	// Retarget is the new target of the placements; it is required if (and only if) the action
+	// is Retarget.
+	// +kubebuilder:validation:Optional
+	Retarget *BulkPlacementRetargetConfig `json:"retarget,omitempty"`
+
+	// MaxConcurrency is the maximum number of placements that Fleet operates on at the same time.
+	// Defaults to 10.
+	// +kubebuilder:default=10
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Optional
+	MaxConcurrency *int32 `json:"maxConcurrency,omitempty"`
+}
+
+// BulkPlacementOperationAction identifies the operation to run on the selected placements.
+// +enum
+type BulkPlacementOperationAction string
+
+const (
+	// BulkPlacementOperationActionPause pauses the rollout of the placements.
+	BulkPlacementOperationActionPause BulkPlacementOperationAction = "Pause"
+
+	// BulkPlacementOperationActionResume resumes the rollout of the placements.
+	BulkPlacementOperationActionResume BulkPlacementOperationAction = "Resume"
+
+	// BulkPlacementOperationActionRollback rolls the placements back to their previous resource snapshots.
+	BulkPlacementOperationActionRollback BulkPlacementOperationAction = "Rollback"
+
+	// BulkPlacementOperationActionRetarget replaces the clusters that the placements target.
+	BulkPlacementOperationActionRetarget BulkPlacementOperationAction = "Retarget"
+)
+
+// BulkPlacementRetargetConfig is the new target of the placements.
+// +kubebuilder:validation:XValidation:rule="has(self.clusterNames) || has(self.clusterSelector)",message="at least one of clusterNames and clusterSelector must be set"
+type BulkPlacementRetargetConfig struct {
+	// ClusterNames replaces the cluster names of the placements of the PickFixed placement type.
+	// +kubebuilder:validation:MaxItems=100
+	// +kubebuilder:validation:Optional
+	ClusterNames []string `json:"clusterNames,omitempty"`
+
+	// ClusterSelector replaces the required cluster affinity of the placements of the PickAll and
+	// PickN placement types.
+	// +kubebuilder:validation:Optional
+	ClusterSelector *ClusterSelector `json:"clusterSelector,omitempty"`
+}
+
+// BulkPlacementOperationStatus is the observed state of the BulkPlacementOperation.
+type BulkPlacementOperationStatus struct {
+	// PlacementResults is the outcome of the operation on each of the selected placements.
+	// +listType=atomic
+	// +kubebuilder:validation:Optional
+	PlacementResults []PlacementOperationResult `json:"placementResults,omitempty"`
+
+	// Conditions is the list of currently observed conditions for the BulkPlacementOperation object.
+	//
+	// Available condition types include:
+	// * Completed: whether the operation has been run on all the selected placements.
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// PlacementOperationResult is the outcome of a bulk operation on a placement.
+type PlacementOperationResult struct {
+	// PlacementName is the name of the placement.
+	// +kubebuilder:validation:Required
+	PlacementName string `json:"placementName"`
+
+	// PlacementNamespace is the namespace of the placement; it is empty for ClusterResourcePlacements.
+	// +kubebuilder:validation:Optional
+	PlacementNamespace string `json:"placementNamespace,omitempty"`
+
+	// Result is the outcome of the operation on the placement.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=Succeeded;Skipped;Failed
+	Result PlacementOperationResultType `json:"result"`
+
+	// Message explains the result, e.g., why the operation is skipped or has failed.
+	// +kubebuilder:validation:Optional
+	Message string `json:"message,omitempty"`
+}
+
+// PlacementOperationResultType identifies the outcome of a bulk operation on a placement.
+// +enum
+type PlacementOperationResultType string
+
+const (
+	// PlacementOperationResultSucceeded means that the operation has been run on the placement.
+	PlacementOperationResultSucceeded PlacementOperationResultType = "Succeeded"
+
+	// PlacementOperationResultSkipped means that the operation does not apply to the placement,
+	// e.g., pausing a placement that is already paused.
+	PlacementOperationResultSkipped PlacementOperationResultType = "Skipped"
+
+	// PlacementOperationResultFailed means that the operation cannot be run on the placement.
+	PlacementOperationResultFailed PlacementOperationResultType = "Failed"
+)
+
+// BulkPlacementOperationConditionType identifies a specific condition of the BulkPlacementOperation.
+type BulkPlacementOperationConditionType string
+
+const (
+	// BulkPlacementOperationConditionTypeCompleted indicates whether the operation has been run on
+	// all the selected placements.
+	//
+	// The following values are possible:
+	// * True: the operation has been run on all the selected placements; the reason tells whether
+	//   it has failed on any of them.
+	//   Note that this is a terminal state; once completed, the operation will not be run again.
+	// * False: the operation has not been run on all the selected placements yet.
+	BulkPlacementOperationConditionTypeCompleted BulkPlacementOperationConditionType = "Completed"
+)
+
+// BulkPlacementOperationList contains a list of BulkPlacementOperation objects.
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:object:root=true
+type BulkPlacementOperationList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of BulkPlacementOperation objects.
+	Items []BulkPlacementOperation `json:"items"`
+}
+
+// SetConditions sets the given conditions on the BulkPlacementOperation.
+func (o *BulkPlacementOperation) SetConditions(conditions ...metav1.Condition) {
+	for _, c := range conditions {
+		meta.SetStatusCondition(&o.Status.Conditions, c)
+	}
+}
+
+// GetCondition returns the condition of the given type on the BulkPlacementOperation.
+func (o *BulkPlacementOperation) GetCondition(conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(o.Status.Conditions, conditionType)
+}
+
+func init() {
+	SchemeBuilder.Register(&BulkPlacementOperation{}, &BulkPlacementOperationList{})
+}
@@ -146,6 +146,26 @@ type PlacementSpec struct {
 	// +patchStrategy=retainKeys
 	Strategy RolloutStrategy `json:"strategy,omitempty"`
 
+	// MinSnapshotIntervalSeconds is the minimum interval, in seconds, between the creations of resource snapshots
+	// for this placement. Changes to the selected resources within the interval are coalesced into one resource
+	// snapshot, and thus one rollout, which is created after the interval elapses; this helps avoid rollout thrash
+	// when the selected resources are updated in bursts, e.g., by CI systems.
+	// The hub agent enforces a minimum interval of its own as well; the longer of the two applies.
+	// If unspecified, only the minimum interval of the hub agent applies.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=3600
+	// +kubebuilder:validation:Optional
+	MinSnapshotIntervalSeconds *int32 `json:"minSnapshotIntervalSeconds,omitempty"`
+
+	// PriorityClassName is the name of the PlacementPriorityClass object that specifies the priority of
+	// this placement. When a member cluster must shed workloads, e.g., when it is being drained, the
+	// resources of lower-priority placements are evicted first.
+	// If unspecified, the priority of the PlacementPriorityClass marked as the global default applies;
+	// if no such class exists, or the specified class does not exist, the priority is zero.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Optional
+	PriorityClassName string `json:"priorityClassName,omitempty"`
+
 	// The number of old SchedulingPolicySnapshot or ResourceSnapshot resources to retain to allow rollback.
 	// This is a pointer to distinguish between explicit zero and not specified.
 	// Defaults to 10.
@@ -155,6 +175,15 @@ type PlacementSpec struct {
 	// +kubebuilder:validation:Optional
 	RevisionHistoryLimit *int32 `json:"revisionHistoryLimit,omitempty"`
 
+	// The number of old SchedulingPolicySnapshot resources to retain, which limits the history of the
+	// scheduling policy separately from that of the selected resources, e.g., for placements whose
+	// policies are tuned often.
+	// If not specified, RevisionHistoryLimit applies to SchedulingPolicySnapshot resources as well.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=1000
+	// +kubebuilder:validation:Optional
+	PolicyRevisionHistoryLimit *int32 `json:"policyRevisionHistoryLimit,omitempty"`
+
 	// StatusReportingScope controls where ClusterResourcePlacement status information is made available.
 	// When set to "ClusterScopeOnly", status is accessible only through the cluster-scoped ClusterResourcePlacement object.
 	// When set to "NamespaceAccessible", a ClusterResourcePlacementStatus object is created in the target namespace,
@@ -165,6 +194,59 @@ type PlacementSpec struct {
 	// +kubebuilder:validation:Enum=ClusterScopeOnly;NamespaceAccessible
 	// +kubebuilder:validation:Optional
 	StatusReportingScope StatusReportingScope `json:"statusReportingScope,omitempty"`
+
+	// ReadinessPolicy controls when the placement is considered ready, i.e., when its rollout is reported
+	// as completed in events and in the placement status metrics.
+	// If unspecified, the placement is considered ready when all the conditions expected by its apply
+	// strategy are true, which is Available for the ClientSideApply and ServerSideApply types, and
+	// DiffReported for the ReportDiff type.
+	// +kubebuilder:validation:Optional
+	ReadinessPolicy *ReadinessPolicy `json:"readinessPolicy,omitempty"`
+
+	// MetadataInjection specifies the labels and annotations that Fleet injects into every resource placed
+	// by the placement, so that tooling in the member clusters (e.g., cost allocation tools and policy
+	// engines) can attribute the resources to the placement.
+	// If unspecified, no labels or annotations are injected.
+	// +kubebuilder:validation:Optional
+	MetadataInjection *MetadataInjectionPolicy `json:"metadataInjection,omitempty"`
+
+	// ResourceNameTransform specifies how Fleet renames the cluster-scoped resources placed by the
+	// placement on each member cluster, so that the same resources (e.g., the ClusterRoles of a
+	// singleton operator) can be placed by multiple placements without name collisions.
+	// It is only supported for ClusterResourcePlacements.
+	// If unspecified, the resources are placed under their original names.
+	// +kubebuilder:validation:Optional
+	ResourceNameTransform *ResourceNameTransform `json:"resourceNameTransform,omitempty"`
+
+	// FailedPlacementsLimit is the maximum number of failed resource placements reported per cluster
+	// in the placement status. Failed placements beyond the limit are counted in the
+	// FailedPlacementOverflowCount field of the per cluster status instead of being listed.
+	// Defaults to 100, which is also the maximum, to keep the size of the status object in check.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Optional
+	FailedPlacementsLimit *int32 `json:"failedPlacementsLimit,omitempty"`
+
+	// DependsOn lists the names of the placements that this placement depends on; for a
+	// ResourcePlacement, the placements are ResourcePlacements in the same namespace.
+	// The rollout of this placement to a member cluster does not start until all the placements it
+	// depends on report that their resources are available on the same cluster.
+	// Placements cannot depend on themselves, either directly or through other placements.
+	// +kubebuilder:validation:MaxItems=10
+	// +listType=set
+	// +kubebuilder:validation:Optional
+	DependsOn []string `json:"dependsOn,omitempty"`
+
+	// SchedulingRateLimit controls how fast the scheduler re-processes the placement after changes in the
+	// fleet (e.g., a cluster joins the fleet, or has its labels or taints updated) and after failed
+	// scheduling attempts. Placements that are not sensitive to such changes (e.g., batch workloads) may
+	// specify a long delay to keep the scheduler from reacting to every change, while placements that are
+	// (e.g., highly available workloads) may leave it unspecified.
+	// If unspecified, the placement is re-processed as soon as possible, with the default backoff of the
+	// scheduler after failed attempts.
+	// Changes to the rate limit take effect the next time the scheduler processes the placement.
+	// +kubebuilder:validation:Optional
+	SchedulingRateLimit *SchedulingRateLimit `json:"schedulingRateLimit,omitempty"`
 }
 
 // Tolerations returns tolerations for PlacementSpec to handle nil policy case.
@@ -236,6 +318,34 @@ type ResourceSelectorTerm struct {
 	// +kubebuilder:default=NamespaceWithResources
 	// +kubebuilder:validation:Optional
 	SelectionScope SelectionScope `json:"selectionScope,omitempty"`
+
+	// IncludeDependents, when set, expands the selection of a cluster-scoped resource to the
+	// resources it declares as its dependents, so that, for example, a custom resource that configures
+	// an operator is placed together with the namespaces and resources the operator needs.
+	// The dependents are declared with the following annotations on the selected resource:
+	// - kubernetes-fleet.io/dependent-namespaces: a comma-separated list of namespace names; each
+	//   namespace is selected along with all the resources within it.
+	// - kubernetes-fleet.io/dependent-resources: a comma-separated list of resources, each in the format
+	//   of {kind}.{version}.{group}/{name}, or {kind}.{version}.{group}/{namespace}/{name} for a
+	//   namespace-scoped resource; the group is omitted for the core API group.
+	// Dependents that do not exist are skipped, and the expansion is not recursive, i.e., the
+	// dependents declared by a dependent are not selected.
+	// This field is only applicable to ClusterResourcePlacement when Kind is a cluster-scoped resource
+	// other than "Namespace", and is ignored otherwise.
+	// +kubebuilder:validation:Optional
+	IncludeDependents bool `json:"includeDependents,omitempty"`
+
+	// FieldManager, when set, restricts the selection to the resources which have fields managed by
+	// the given field manager, as recorded in the managedFields of the resources; for example, specify
+	// the field manager of a CI pipeline to select only the objects it has created or updated, so that
+	// the objects generated by operators in the same namespace are left out.
+	// When Kind is "Namespace" with the NamespaceWithResources selection scope, the restriction applies
+	// to the resources within the selected namespaces, while the namespaces themselves are always selected.
+	// The dependents of the selected resources (see IncludeDependents) are not restricted.
+	// This field is ignored in the resource selectors of overrides.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxLength=128
+	FieldManager string `json:"fieldManager,omitempty"`
 }
 
 // SelectionScope defines the scope of resource selections when selecting namespaces.
@@ -350,6 +460,41 @@ type PlacementPolicy struct {
 	// +kubebuilder:validation:MaxItems=100
 	// +kubebuilder:validation:Optional
 	Tolerations []Toleration `json:"tolerations,omitempty"`
+
+	// ExternalSchedulerName is the name of an external scheduler that is responsible for scheduling
+	// the placement. If specified, the built-in KubeFleet scheduler will skip the placement; the external
+	// scheduler is expected to create the bindings for the placement, each of which must refer to the
+	// latest scheduling policy snapshot of the placement, and to report the scheduling decisions
+	// in the status of the policy snapshot.
+	// This field is immutable.
+	//
+	// This field is alpha-level and is for the external scheduler feature.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern="^[a-z0-9]([-a-z0-9]*[a-z0-9])?$"
+	// +kubebuilder:validation:Optional
+	ExternalSchedulerName string `json:"externalSchedulerName,omitempty"`
+
+	// SchedulingProfileName is the name of the SchedulingProfile that tunes how the scheduler scores
+	// clusters for the placement, e.g., the weights of the score plugins. If not specified, or if the
+	// SchedulingProfile is not found, the scheduler uses its default scoring behavior.
+	//
+	// This field is only valid for placements of the PickN placement type.
+	//
+	// This field is alpha-level and is for the scheduling profile feature.
+	// +kubebuilder:validation:MaxLength=253
+	// +kubebuilder:validation:Optional
+	SchedulingProfileName string `json:"schedulingProfileName,omitempty"`
+
+	// EstimatedResourceRequests is the estimated amount of resources (e.g., CPU and memory) that the selected
+	// resources request on each cluster the placement is scheduled onto.
+	//
+	// If specified, the scheduler reserves this amount of capacity on a cluster as soon as it schedules the
+	// placement onto the cluster, until the resources have been applied; when scheduling other placements,
+	// the reserved capacity is deducted from the available capacity of the cluster, i.e., the values of the
+	// resources.kubernetes-fleet.io/available-* properties. This prevents placements that are scheduled
+	// at the same time from all choosing the same nearly full cluster.
+	// +kubebuilder:validation:Optional
+	EstimatedResourceRequests corev1.ResourceList `json:"estimatedResourceRequests,omitempty"`
 }
 
 // Affinity is a group of cluster affinity scheduling rules. More to be added.
@@ -357,6 +502,25 @@ type Affinity struct {
 	// ClusterAffinity contains cluster affinity scheduling rules for the selected resources.
 	// +kubebuilder:validation:Optional
 	ClusterAffinity *ClusterAffinity `json:"clusterAffinity,omitempty"`
+
+	// PlacementAffinity places the selected resources on exactly the clusters that another placement
+	// currently selects, and keeps following the scheduling decisions of that placement as they change.
+	// It can be combined with the cluster affinity; a cluster must satisfy both to be picked.
+	// +kubebuilder:validation:Optional
+	PlacementAffinity *PlacementAffinity `json:"placementAffinity,omitempty"`
+}
+
+// PlacementAffinity describes the affinity of a placement to the clusters selected by another placement.
+type PlacementAffinity struct {
+	// PlacementName is the name of the placement to follow. For a ClusterResourcePlacement, it refers
+	// to another ClusterResourcePlacement; for a ResourcePlacement, it refers to another ResourcePlacement
+	// in the same namespace.
+	//
+	// If the placement to follow does not exist or has not selected any cluster, no cluster is picked.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	PlacementName string `json:"placementName"`
 }
 
 // ClusterAffinity contains cluster affinity scheduling rules for the selected resources.
@@ -389,10 +553,12 @@ type ClusterSelector struct {
 }
 
 type PreferredClusterSelector struct {
-	// Weight associated with matching the corresponding clusterSelectorTerm, in the range [-100, 100].
+	// Weight associated with matching the corresponding clusterSelectorTerm, in the range [-1000, 1000].
+	// If the absolute value of any weight in the preferred terms exceeds 100, the scheduler normalizes
+	// all the weights proportionally so that the largest absolute weight becomes 100.
 	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:Minimum=-100
-	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Minimum=-1000
+	// +kubebuilder:validation:Maximum=1000
 	Weight int32 `json:"weight"`
 
 	// A cluster selector term, associated with the corresponding weight.
@@ -460,6 +626,14 @@ const (
 	// PropertySelectorLessThanOrEqualTo dictates Fleet to select cluster if its observed value of a
 	// given property is less than or equal to the value specified in the requirement.
 	PropertySelectorLessThanOrEqualTo PropertySelectorOperator = "Le"
+	// PropertySelectorIn dictates Fleet to select cluster if its observed value of a given
+	// property matches any of the glob patterns specified in the requirement; it is only supported
+	// in the property selectors of override rules.
+	PropertySelectorIn PropertySelectorOperator = "In"
+	// PropertySelectorNotIn dictates Fleet to select cluster if its observed value of a given
+	// property matches none of the glob patterns specified in the requirement; it is only supported
+	// in the property selectors of override rules.
+	PropertySelectorNotIn PropertySelectorOperator = "NotIn"
 )
 
 // PropertySelectorRequirement is a specific property requirement when picking clusters for
@@ -478,14 +652,17 @@ type PropertySelectorRequirement struct {
 	// the observed values of individual member clusters in accordance with the given
 	// operator.
 	//
-	// At this moment, each value should be a Kubernetes quantity. For more information, see
-	// https://pkg.go.dev/k8s.io/apimachinery/pkg/api/resource#Quantity.
-	//
 	// If the operator is Gt (greater than), Ge (greater than or equal to), Lt (less than),
 	// or `Le` (less than or equal to), Eq (equal to), or Ne (ne), exactly one value must be
-	// specified in the list.
+	// specified in the list, and the value should be a Kubernetes quantity. For more information, see
+	// https://pkg.go.dev/k8s.io/apimachinery/pkg/api/resource#Quantity.
+	//
+	// If the operator is In or NotIn, one or more values must be specified in the list; each value
+	// is a glob pattern (e.g., `eu-*`), which is matched against the observed value of a non-resource
+	// property as a string. The In and NotIn operators are only supported in the property selectors
+	// of override rules.
 	//
-	// +kubebuilder:validation:MaxItems=1
+	// +kubebuilder:validation:MaxItems=10
 	// +kubebuilder:validation:Required
 	Values []string `json:"values"`
 }
@@ -607,6 +784,130 @@ const (
 	NamespaceAccessible StatusReportingScope = "NamespaceAccessible"
 )
 
+// ReadinessPolicy describes when a placement is considered ready.
+type ReadinessPolicy struct {
+	// ReadyConditionType is the placement condition that gates the readiness of the placement; the
+	// placement is considered ready once this condition and all the conditions that precede it are true.
+	//
+	// Available options are:
+	//
+	// * Applied: the placement is considered ready once all the resources have been applied, even if
+	//   they have not become available yet. This option is not supported for the ReportDiff apply strategy type.
+	//
+	// * Available: the placement is considered ready once all the resources have been applied and have become
+	//   available. This option is not supported for the ReportDiff apply strategy type.
+	//
+	// * DiffReported: the placement is considered ready once configuration differences have been reported
+	//   on all clusters. This option is only supported for the ReportDiff apply strategy type.
+	//
+	// If unspecified, the last condition expected by the apply strategy of the placement is used.
+	// +kubebuilder:validation:Enum=Applied;Available;DiffReported
+	// +kubebuilder:validation:Optional
+	ReadyConditionType ReadyConditionType `json:"readyConditionType,omitempty"`
+}
+
+// SchedulingRateLimit describes how fast the scheduler re-processes a placement.
+//
+// The scheduler waits for BaseDelaySeconds before it re-processes the placement after a change in the fleet or a
+// failed scheduling attempt; the delay doubles on each consecutive re-process, up to MaxDelaySeconds, and is reset
+// once the placement has been scheduled successfully.
+// +kubebuilder:validation:XValidation:rule="!has(self.maxDelaySeconds) || self.maxDelaySeconds >= self.baseDelaySeconds",message="maxDelaySeconds must not be less than baseDelaySeconds"
+type SchedulingRateLimit struct {
+	// BaseDelaySeconds is the delay, in seconds, before the scheduler re-processes the placement for the
+	// first time after it has been scheduled successfully.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	// +kubebuilder:validation:Required
+	BaseDelaySeconds int32 `json:"baseDelaySeconds"`
+
+	// MaxDelaySeconds is the maximum delay, in seconds, before the scheduler re-processes the placement.
+	// Defaults to 1000, which is the maximum backoff of the scheduler after failed attempts.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=86400
+	// +kubebuilder:validation:Optional
+	MaxDelaySeconds *int32 `json:"maxDelaySeconds,omitempty"`
+}
+
+// ResourceNameTransform describes how Fleet renames the cluster-scoped resources placed on a member cluster.
+//
+// The prefix and the suffix are templates, in which the following variables are replaced by the actual
+// values when the resources are placed on a member cluster:
+//
+// * ${PLACEMENT-NAME}: the name of the placement.
+//
+// * ${MEMBER-CLUSTER-NAME}: the name of the member cluster.
+//
+// Namespaces and CustomResourceDefinitions are never renamed, as their names are significant; neither
+// are the resources wrapped in envelopes, which are placed as they are. The original name of a renamed
+// resource is recorded in the `kubernetes-fleet.io/original-name` annotation. Note that references to
+// the renamed resources (e.g., the role references of ClusterRoleBindings) are not updated; use
+// overrides to update them as needed.
+type ResourceNameTransform struct {
+	// Prefix is the template of the prefix prepended to the names of the resources.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Optional
+	Prefix string `json:"prefix,omitempty"`
+
+	// Suffix is the template of the suffix appended to the names of the resources.
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Optional
+	Suffix string `json:"suffix,omitempty"`
+}
+
+// ReadyConditionType describes the condition that gates the readiness of a placement.
+// +enum
+type ReadyConditionType string
+
+const (
+	// ReadyConditionTypeApplied gates the readiness of a placement on the Applied condition.
+	ReadyConditionTypeApplied ReadyConditionType = "Applied"
+
+	// ReadyConditionTypeAvailable gates the readiness of a placement on the Available condition.
+	ReadyConditionTypeAvailable ReadyConditionType = "Available"
+
+	// ReadyConditionTypeDiffReported gates the readiness of a placement on the DiffReported condition.
+	ReadyConditionTypeDiffReported ReadyConditionType = "DiffReported"
+)
+
+// MetadataInjectionPolicy describes the labels and annotations injected into the placed resources.
+//
+// The values of the labels and annotations are templates, in which the following variables are replaced
+// by the actual values when the resources are placed on a member cluster:
+//
+// * ${PLACEMENT-NAME}: the name of the placement.
+//
+// * ${MEMBER-CLUSTER-NAME}: the name of the member cluster.
+//
+// * ${RESOURCE-SNAPSHOT-HASH}: the hash of the resource snapshot being placed; as the hash is longer than
+// what a label value allows, this variable can only be used in annotations.
+//
+// Note that the resources wrapped in envelopes are placed as they are, without any labels or annotations injected.
+type MetadataInjectionPolicy struct {
+	// Standard, if set to true, injects the standard labels and annotations, in addition to the ones
+	// specified below:
+	//
+	// * the `kubernetes-fleet.io/placement-name` label, whose value is the name of the placement;
+	//
+	// * the `kubernetes-fleet.io/member-cluster-name` label, whose value is the name of the member cluster;
+	//
+	// * the `kubernetes-fleet.io/resource-snapshot-hash` annotation, whose value is the hash of the resource
+	// snapshot being placed.
+	// +kubebuilder:validation:Optional
+	Standard bool `json:"standard,omitempty"`
+
+	// Labels are the labels to inject, with templates as values.
+	// Keys with the `kubernetes-fleet.io/` prefix are reserved and cannot be used.
+	// +kubebuilder:validation:MaxProperties=20
+	// +kubebuilder:validation:Optional
+	Labels map[string]string `json:"labels,omitempty"`
+
+	// Annotations are the annotations to inject, with templates as values.
+	// Keys with the `kubernetes-fleet.io/` prefix are reserved and cannot be used.
+	// +kubebuilder:validation:MaxProperties=20
+	// +kubebuilder:validation:Optional
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
 // RolloutStrategy describes how to roll out a new change in selected resources to target clusters.
 type RolloutStrategy struct {
 	// Type of rollout. The only supported types are "RollingUpdate" and "External".
@@ -624,6 +925,19 @@ type RolloutStrategy struct {
 	// +kubebuilder:validation:Optional
 	ApplyStrategy *ApplyStrategy `json:"applyStrategy,omitempty"`
 
+	// ApplyStrategyOverrides replace the apply strategy above for groups of target clusters, e.g.,
+	// to report diffs only on production clusters while applying the resources elsewhere.
+	//
+	// The overrides are evaluated in order against the labels and properties of each target cluster
+	// when Fleet generates the works for the cluster; the first override whose cluster selector
+	// matches the cluster wins. Clusters matched by no override use the apply strategy above.
+	//
+	// Note that a change of cluster labels or properties takes effect the next time Fleet generates
+	// the works for the cluster, e.g., when new resources or override snapshots are rolled out.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:MaxItems=10
+	ApplyStrategyOverrides []ApplyStrategyOverride `json:"applyStrategyOverrides,omitempty"`
+
 	// DeleteStrategy configures the deletion behavior when the ClusterResourcePlacement is deleted.
 	// +kubebuilder:validation:Optional
 	DeleteStrategy *DeleteStrategy `json:"deleteStrategy,omitempty"`
@@ -632,6 +946,32 @@ type RolloutStrategy struct {
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:XValidation:rule="(self == null) || (self.type == 'Mirror' ? size(self.destination) != 0 : true)",message="when reportBackStrategy.type is 'Mirror', a destination must be specified"
 	ReportBackStrategy *ReportBackStrategy `json:"reportBackStrategy,omitempty"`
+
+	// PreDeleteProbe describes a check Fleet performs before removing the placed resources from a cluster
+	// that is no longer selected by the placement, so that traffic can be shifted away from the cluster
+	// before the resources are torn down.
+	// +kubebuilder:validation:Optional
+	PreDeleteProbe *PreDeleteProbe `json:"preDeleteProbe,omitempty"`
+
+	// Analysis describes a metric analysis Fleet runs on each cluster after the placed resources become
+	// available there; Fleet rolls out the resources to more clusters only if the analysis passes.
+	// The analysis is only supported with the RollingUpdate rollout strategy.
+	// +kubebuilder:validation:Optional
+	Analysis *RolloutAnalysis `json:"analysis,omitempty"`
+}
+
+// ApplyStrategyOverride replaces the apply strategy of a placement for the clusters selected by
+// a cluster selector.
+type ApplyStrategyOverride struct {
+	// ClusterSelector selects the clusters the apply strategy applies to.
+	// An empty list of cluster selector terms selects all the clusters.
+	// +kubebuilder:validation:Required
+	ClusterSelector *ClusterSelector `json:"clusterSelector"`
+
+	// ApplyStrategy is the apply strategy Fleet uses on the selected clusters, in place of the
+	// apply strategy of the placement.
+	// +kubebuilder:validation:Required
+	ApplyStrategy *ApplyStrategy `json:"applyStrategy"`
 }
 
 // ApplyStrategy describes when and how to apply the selected resource to the target cluster.
@@ -768,10 +1108,11 @@ type ApplyStrategy struct {
 	// on a Fleet-managed resource. If set to false, Fleet will refuse to apply manifests to
 	// a resource that has been owned by one or more non-Fleet agents.
 	//
-	// Note that Fleet does not support the case where one resource is being placed multiple
-	// times by different CRPs on the same member cluster. An apply error will be returned if
-	// Fleet finds that a resource has been owned by another placement attempt by Fleet, even
-	// with the AllowCoOwnership setting set to true.
+	// Note that this setting does not concern resources that are placed multiple times by
+	// different placements on the same member cluster; see the CoOwnershipPolicy setting instead.
+	// With the default co-ownership policy, an apply error will be returned if Fleet finds that
+	// a resource has been owned by another placement attempt by Fleet, even with the
+	// AllowCoOwnership setting set to true.
 	AllowCoOwnership bool `json:"allowCoOwnership,omitempty"`
 
 	// ServerSideApplyConfig defines the configuration for server side apply. It is honored only when type is ServerSideApply.
@@ -841,8 +1182,290 @@ type ApplyStrategy struct {
 	// +kubebuilder:validation:Enum=Always;IfNoDiff;Never
 	// +kubebuilder:validation:Optional
 	WhenToTakeOver WhenToTakeOverType `json:"whenToTakeOver,omitempty"`
+
+	// QuotaPreflightCheck controls whether Fleet verifies, before applying the manifests to a
+	// member cluster, that the ResourceQuotas in the namespaces of the workloads can accommodate
+	// the resource requests and limits of their pods.
+	//
+	// If set to true, the member agent compares the resources that the Pods, Deployments,
+	// ReplicaSets, StatefulSets, ReplicationControllers and Jobs would additionally consume
+	// against the headroom left in the (unscoped) ResourceQuotas of their namespaces; workloads
+	// that would exceed a quota are not applied, and the cluster is reported with the QuotaFit
+	// condition set to False, instead of having the pods rejected after the apply. Other
+	// workloads, such as DaemonSets, are not checked.
+	//
+	// This setting does not apply to the ReportDiff apply strategy.
+	//
+	// +kubebuilder:validation:Optional
+	QuotaPreflightCheck bool `json:"quotaPreflightCheck,omitempty"`
+
+	// AdmissionPreflight controls whether Fleet dry-runs the manifests against the admission
+	// webhooks of a member cluster before applying them.
+	//
+	// Available options are:
+	//
+	// * Never: Fleet applies the manifests directly; admission webhook rejections surface as apply
+	//   failures. This is the default option.
+	//
+	// * Enforce: the member agent dry-runs every manifest (via server-side apply) before any of
+	//   them is applied; manifests rejected by an admission webhook are not applied, and the
+	//   rejecting webhook is named in the failure message of each such manifest.
+	//
+	// * ReportOnly: the member agent dry-runs every manifest as with the Enforce option, but
+	//   applies the manifests as usual; the rejections are only reported. This helps find out
+	//   whether a new resource snapshot is compatible with the admission webhooks of the member
+	//   clusters without blocking the rollout.
+	//
+	// With either the Enforce or the ReportOnly option, the results are reported with the
+	// AdmissionPreflightPassed condition. Note that only rejections from admission webhooks are
+	// reported; other dry-run errors (e.g., a missing namespace that would have been created
+	// earlier in the same apply op) are ignored.
+	//
+	// This setting does not apply to the ReportDiff apply strategy.
+	//
+	// +kubebuilder:validation:Enum=Never;Enforce;ReportOnly
+	// +kubebuilder:validation:Optional
+	AdmissionPreflight AdmissionPreflightType `json:"admissionPreflight,omitempty"`
+
+	// CoOwnershipPolicy controls how Fleet handles resources that this placement and other
+	// placements select for the same member cluster.
+	//
+	// Available options are:
+	//
+	// * Deny: with this option, a resource can only be placed on a member cluster by one
+	//   placement; the placements that attempt to place it later fail to apply it. This is
+	//   the default option.
+	//
+	// * LastWriterWins: with this option, all the placements that select the resource apply
+	//   it; the values set by the placement that applies last overwrite those set by the
+	//   others (conflicts are forced if server-side apply is used). Drifts are likely to be
+	//   reported if the placements place the resource with different values.
+	//
+	// * SharedFields: with this option, the placements apply the resource via server-side
+	//   apply, each with its own field manager and without forcing conflicts; the placements
+	//   share the resource as long as they do not set the same fields to different values,
+	//   in which case the apply op fails with a conflict. This option requires the
+	//   ServerSideApply apply strategy type. When a placement switches to or from this
+	//   option, Fleet moves the fields it has applied for the placement to the field manager
+	//   in use, unless the resource is also owned by other placements, in which case the
+	//   fields are left behind under the previous field manager until removed manually.
+	//
+	// Co-ownership is only honored if all the placements that select the resource for a cluster
+	// use the same co-ownership policy other than Deny; otherwise the placements that attempt to
+	// place the resource while it is owned by a placement with a different policy fail to apply it,
+	// and Fleet reports the conflict with the CoOwnershipResolved condition set to False in the
+	// statuses of all the placements.
+	//
+	// This setting does not apply to the ReportDiff apply strategy.
+	//
+	// +kubebuilder:validation:Enum=Deny;LastWriterWins;SharedFields
+	// +kubebuilder:validation:Optional
+	CoOwnershipPolicy CoOwnershipPolicyType `json:"coOwnershipPolicy,omitempty"`
+
+	// EnforceNamespaceSameness controls whether Fleet enforces namespace sameness, i.e., whether
+	// a namespace placed to multiple member clusters must keep the same labels and annotations
+	// on all the clusters. Multi-cluster service meshes, for example, often assume that namespaces
+	// of the same name are identical across clusters.
+	//
+	// If set to true, Fleet compares all the labels and annotations of the placed namespaces
+	// with the hub cluster manifests, and reports any difference as a drift, even if the
+	// PartialComparison option is used (which would otherwise ignore labels and annotations
+	// that are absent from the hub cluster manifests). Labels and annotations that are reserved
+	// by Kubernetes or Fleet are not compared. Combined with the IfNotDrifted option, Fleet will
+	// stop applying changes to namespaces whose labels or annotations have drifted.
+	//
+	// This setting only concerns namespaces; other resources are compared in accordance with
+	// the ComparisonOption setting as usual.
+	//
+	// +kubebuilder:validation:Optional
+	EnforceNamespaceSameness bool `json:"enforceNamespaceSameness,omitempty"`
+
+	// MaxFailedManifestsPercent is the percentage of manifests that are allowed to fail to apply,
+	// while the resources are still considered to be applied on a member cluster. This allows
+	// Fleet to make forward progress (e.g., continue the rollout) when only a small number of
+	// non-critical manifests fail to apply.
+	//
+	// If fewer than the specified percentage of manifests fail to apply to a member cluster, Fleet
+	// sets the Applied condition to True (with a reason that signals the failures) and checks the
+	// availability of the applied manifests only; the manifests that fail to apply are still listed
+	// in the failed placements of the cluster. Otherwise, the Applied condition is set to False as usual.
+	//
+	// The percentage is calculated per group of manifests that Fleet applies together (i.e., per Work
+	// object). Defaults to 0, i.e., no failure is tolerated.
+	//
+	// This setting does not apply to the ReportDiff apply strategy.
+	//
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:validation:Optional
+	MaxFailedManifestsPercent int32 `json:"maxFailedManifestsPercent,omitempty"`
+
+	// IgnoreFields is a list of fields that Fleet never manages in the member clusters, so that
+	// other agents on the member cluster side, e.g., HPAs, mutating webhooks, or local controllers,
+	// can own these fields permanently.
+	//
+	// Each field is specified as a JSON pointer (RFC 6901), e.g., `/spec/replicas`; use `*` as a
+	// path segment to match all the items of an array or all the entries of a map, e.g.,
+	// `/spec/template/spec/containers/*/resources`. Fields under the metadata of a resource cannot
+	// be ignored, except for labels and annotations; typeMeta and status fields cannot be ignored
+	// either.
+	//
+	// Fleet keeps the values of the ignored fields as they are when applying the manifests to the
+	// existing resources in the member clusters; the values from the hub cluster manifests are only
+	// used when Fleet creates the resources. Differences in the ignored fields are not reported as
+	// drifts or diffs, and do not block takeovers.
+	//
+	// +kubebuilder:validation:MaxItems=20
+	// +kubebuilder:validation:Optional
+	IgnoreFields []string `json:"ignoreFields,omitempty"`
+
+	// DriftRemediationWindows are recurring time windows that control how Fleet handles drifts
+	// on the member clusters at different times, e.g., to only report drifts during business hours
+	// (or a change freeze) and to revert them automatically off-hours.
+	//
+	// When the member agent applies the manifests, it looks up the first window (in the order of
+	// the list) that is in effect at the time; the action of the window then takes the place of the
+	// WhenToApply setting:
+	//
+	// * AutoRevert: Fleet applies the hub cluster manifests regardless of drifts, i.e., drifts in
+	//   the managed fields are reverted, as with the Always option.
+	//
+	// * ReportOnly: Fleet stops applying the hub cluster manifests to the resources that have
+	//   drifted and reports the drifts instead, as with the IfNotDrifted option.
+	//
+	// Outside all of the windows, the WhenToApply setting is used as usual. The windows are
+	// evaluated by the member agent with its own clock; a new window takes effect at the latest
+	// when it starts, as the member agent re-applies the manifests at the window boundaries.
+	//
+	// This setting does not apply to the ReportDiff apply strategy.
+	//
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:Optional
+	DriftRemediationWindows []DriftRemediationWindow `json:"driftRemediationWindows,omitempty"`
+
+	// WatchForDeletion controls whether the member agent watches the placed resources on the member
+	// clusters for deletions, so that resources deleted out-of-band (i.e., not by Fleet) are
+	// re-created within seconds.
+	//
+	// By default, Fleet re-creates such resources the next time it re-applies the manifests, which,
+	// for placements that have been applied successfully, might take up to 15 minutes. If set to
+	// true, the member agent watches all the resources of the types that it has placed, and
+	// re-applies the manifests right away once a placed resource is deleted. The number of resource
+	// types that the member agent watches is capped by the member agent configuration; resources of
+	// the types beyond the cap are re-created at the next re-apply as usual.
+	//
+	// This setting does not apply to the ReportDiff apply strategy.
+	//
+	// +kubebuilder:validation:Optional
+	WatchForDeletion bool `json:"watchForDeletion,omitempty"`
 }
 
+// DriftRemediationWindow is a recurring time window during which Fleet handles drifts on the
+// member clusters in a specific way.
+type DriftRemediationWindow struct {
+	// Action is how Fleet handles drifts during the window.
+	//
+	// Available options are:
+	//
+	// * AutoRevert: Fleet reverts drifts in the managed fields by applying the hub cluster manifests.
+	//
+	// * ReportOnly: Fleet reports drifts without reverting them.
+	//
+	// +kubebuilder:validation:Enum=AutoRevert;ReportOnly
+	// +kubebuilder:validation:Required
+	Action DriftRemediationActionType `json:"action"`
+
+	// Days are the days of the week on which the window starts. If not specified, the window
+	// starts on every day.
+	// +kubebuilder:validation:MaxItems=7
+	// +listType=set
+	// +kubebuilder:validation:Optional
+	Days []DayOfWeek `json:"days,omitempty"`
+
+	// Start is the time of day at which the window starts, in the 24-hour HH:MM format.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +kubebuilder:validation:Required
+	Start string `json:"start"`
+
+	// End is the time of day at which the window ends (exclusive), in the 24-hour HH:MM format.
+	// If End is earlier than Start, the window ends on the next day, e.g., a window from 22:00
+	// to 06:00 that starts on a Friday ends on Saturday at 06:00; if End is the same as Start, the
+	// window lasts for 24 hours.
+	// +kubebuilder:validation:Pattern=`^([01][0-9]|2[0-3]):[0-5][0-9]$`
+	// +kubebuilder:validation:Required
+	End string `json:"end"`
+
+	// TimeZone is the IANA time zone name (e.g., `America/New_York`) in which Start and End are
+	// expressed. Defaults to UTC.
+	// +kubebuilder:default=UTC
+	// +kubebuilder:validation:Optional
+	TimeZone string `json:"timeZone,omitempty"`
+}
+
+// DriftRemediationActionType describes how Fleet handles drifts during a drift remediation window.
+// +enum
+type DriftRemediationActionType string
+
+const (
+	// DriftRemediationActionTypeAutoRevert instructs Fleet to revert drifts by applying the hub
+	// cluster manifests.
+	DriftRemediationActionTypeAutoRevert DriftRemediationActionType = "AutoRevert"
+
+	// DriftRemediationActionTypeReportOnly instructs Fleet to report drifts without reverting them.
+	DriftRemediationActionTypeReportOnly DriftRemediationActionType = "ReportOnly"
+)
+
+// DayOfWeek is a day of the week.
+// +kubebuilder:validation:Enum=Monday;Tuesday;Wednesday;Thursday;Friday;Saturday;Sunday
+type DayOfWeek string
+
+const (
+	Monday    DayOfWeek = "Monday"
+	Tuesday   DayOfWeek = "Tuesday"
+	Wednesday DayOfWeek = "Wednesday"
+	Thursday  DayOfWeek = "Thursday"
+	Friday    DayOfWeek = "Friday"
+	Saturday  DayOfWeek = "Saturday"
+	Sunday    DayOfWeek = "Sunday"
+)
+
+// CoOwnershipPolicyType describes how Fleet handles resources that are selected by multiple
+// placements for the same member cluster.
+// +enum
+type CoOwnershipPolicyType string
+
+const (
+	// CoOwnershipPolicyTypeDeny instructs Fleet to place a resource on a member cluster via one
+	// placement only.
+	CoOwnershipPolicyTypeDeny CoOwnershipPolicyType = "Deny"
+
+	// CoOwnershipPolicyTypeLastWriterWins instructs Fleet to let all the placements apply the
+	// resource, with the values set by the last apply op taking effect.
+	CoOwnershipPolicyTypeLastWriterWins CoOwnershipPolicyType = "LastWriterWins"
+
+	// CoOwnershipPolicyTypeSharedFields instructs Fleet to let the placements share the resource
+	// via server-side apply, with each placement managing its own set of fields.
+	CoOwnershipPolicyTypeSharedFields CoOwnershipPolicyType = "SharedFields"
+)
+
+// AdmissionPreflightType describes whether and how Fleet dry-runs the manifests against the
+// admission webhooks of a member cluster before applying them.
+// +enum
+type AdmissionPreflightType string
+
+const (
+	// AdmissionPreflightTypeNever instructs Fleet to apply the manifests without a dry-run.
+	AdmissionPreflightTypeNever AdmissionPreflightType = "Never"
+
+	// AdmissionPreflightTypeEnforce instructs Fleet to dry-run the manifests first, and skip the
+	// manifests that admission webhooks reject.
+	AdmissionPreflightTypeEnforce AdmissionPreflightType = "Enforce"
+
+	// AdmissionPreflightTypeReportOnly instructs Fleet to dry-run the manifests first, and report
+	// the rejections without skipping any manifest.
+	AdmissionPreflightTypeReportOnly AdmissionPreflightType = "ReportOnly"
+)
+
 // ComparisonOptionType describes the compare option that Fleet uses to detect drifts and/or
 // calculate differences.
 // +enum
@@ -960,6 +1583,20 @@ const (
 	ExternalRolloutStrategyType RolloutStrategyType = "External"
 )
 
+// ResourceUpdateStrategyType describes how a new version of the selected resources is rolled out to
+// the clusters that already run an older version.
+// +enum
+type ResourceUpdateStrategyType string
+
+const (
+	// ResourceUpdateStrategyTypeInPlace updates the resources on the existing clusters in place.
+	ResourceUpdateStrategyTypeInPlace ResourceUpdateStrategyType = "InPlace"
+
+	// ResourceUpdateStrategyTypeSurgeClusters places the new version of the resources on additional clusters
+	// before the existing clusters are updated in place.
+	ResourceUpdateStrategyTypeSurgeClusters ResourceUpdateStrategyType = "SurgeClusters"
+)
+
 // RollingUpdateConfig contains the config to control the desired behavior of rolling update.
 type RollingUpdateConfig struct {
 	// The maximum number of clusters that can be unavailable during the rolling update
@@ -993,6 +1630,32 @@ type RollingUpdateConfig struct {
 	// +kubebuilder:validation:Optional
 	MaxSurge *intstr.IntOrString `json:"maxSurge,omitempty"`
 
+	// MaxFailedClustersPercentage is the error budget of a rollout: Fleet pauses the rollout of a new version
+	// of the selected resources once more than the given percentage of the clusters that have been updated to
+	// the new version report that the resources have failed to be applied or to become available there.
+	// A paused rollout has the RolloutPausedForSafety condition set on the placement, and stays paused until
+	// the `kubernetes-fleet.io/rollout-paused` annotation is removed from the placement manually; the rollout
+	// of the same version is not paused for failures again after it is resumed.
+	// If not specified, the rollout is never paused for failures.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=99
+	// +kubebuilder:validation:Optional
+	MaxFailedClustersPercentage *int32 `json:"maxFailedClustersPercentage,omitempty"`
+
+	// ResourceUpdateStrategy controls how a new version of the selected resources is rolled out to
+	// the clusters that already run an older version of the resources.
+	// InPlace updates the resources on those clusters directly; each update counts towards `MaxUnavailable`.
+	// SurgeClusters first places the new version of the resources on additional clusters, up to `MaxSurge`
+	// of them, and only updates the existing clusters in place once the additional clusters become available,
+	// so that a rollout with `MaxUnavailable` set to 0 never runs fewer clusters than desired; the additional
+	// clusters are released once the rollout completes. This is useful for stateless workloads where temporarily
+	// running on more clusters is cheaper than any downtime.
+	// SurgeClusters only applies to the `PickN` placement type; other placement types always update in place.
+	// Defaults to InPlace.
+	// +kubebuilder:validation:Enum=InPlace;SurgeClusters
+	// +kubebuilder:validation:Optional
+	ResourceUpdateStrategy ResourceUpdateStrategyType `json:"resourceUpdateStrategy,omitempty"`
+
 	// UnavailablePeriodSeconds is used to configure the waiting time between rollout phases when we
 	// cannot determine if the resources have rolled out successfully or not.
 	// We have a built-in resource state detector to determine the availability status of following well-known Kubernetes
@@ -1035,6 +1698,20 @@ type PlacementStatus struct {
 	// +kubebuilder:validation:Optional
 	PerClusterPlacementStatuses []PerClusterPlacementStatus `json:"placementStatuses,omitempty"`
 
+	// PerClusterRolloutProgress is a compact table that tells, for each selected cluster, the resource snapshot
+	// index and the override snapshots that are desired (i.e., currently being rolled out to the cluster) and the
+	// ones that have last been applied on the cluster, so that the rollout progress across a large fleet can be
+	// observed without listing bindings.
+	// +kubebuilder:validation:Optional
+	PerClusterRolloutProgress []PerClusterRolloutProgress `json:"perClusterRolloutProgress,omitempty"`
+
+	// RolloutImpactEstimate is the estimated blast radius of rolling out the latest resource snapshot (i.e., the one
+	// at the index specified by `ObservedResourceIndex`), which is computed when the resource snapshot is detected,
+	// before the rollout starts, so that automation (or humans) can require approval for large rollouts.
+	// It is not set if the rollout strategy type is `External`.
+	// +kubebuilder:validation:Optional
+	RolloutImpactEstimate *RolloutImpactEstimate `json:"rolloutImpactEstimate,omitempty"`
+
 	// +patchMergeKey=type
 	// +patchStrategy=merge
 	// +listType=map
@@ -1050,6 +1727,87 @@ type PlacementStatus struct {
 	Conditions []metav1.Condition `json:"conditions,omitempty"`
 }
 
+// PerClusterRolloutProgress summarizes the rollout progress on a selected cluster.
+type PerClusterRolloutProgress struct {
+	// ClusterName is the name of the cluster.
+	// +kubebuilder:validation:Required
+	ClusterName string `json:"clusterName"`
+
+	// DesiredResourceIndex is the index of the resource snapshot that is currently being rolled out to the cluster.
+	// It might lag behind the latest resource index (`ObservedResourceIndex` of the placement) when the rollout is
+	// blocked by the rollout strategy.
+	// +kubebuilder:validation:Optional
+	DesiredResourceIndex string `json:"desiredResourceIndex,omitempty"`
+
+	// AppliedResourceIndex is the index of the resource snapshot that has last been applied on the cluster.
+	// It is empty if the resources have never been applied on the cluster.
+	// +kubebuilder:validation:Optional
+	AppliedResourceIndex string `json:"appliedResourceIndex,omitempty"`
+
+	// DesiredOverrideSnapshots is the list of the override snapshots that are currently being rolled out to
+	// the cluster; ClusterResourceOverride snapshots are listed by name, and ResourceOverride snapshots are
+	// listed as namespace/name.
+	// +kubebuilder:validation:Optional
+	DesiredOverrideSnapshots []string `json:"desiredOverrideSnapshots,omitempty"`
+
+	// AppliedOverrideSnapshots is the list of the override snapshots that have last been applied on the
+	// cluster, in the same format as DesiredOverrideSnapshots.
+	// +kubebuilder:validation:Optional
+	AppliedOverrideSnapshots []string `json:"appliedOverrideSnapshots,omitempty"`
+}
+
+// RolloutImpactEstimate estimates how many clusters and resources the rollout of a resource snapshot changes.
+type RolloutImpactEstimate struct {
+	// ResourceIndex is the index of the resource snapshot that the estimate is for.
+	// +kubebuilder:validation:Required
+	ResourceIndex string `json:"resourceIndex"`
+
+	// BaseResourceIndex is the index of the resource snapshot that the changed resources are counted against,
+	// which is the one most of the affected clusters currently have. It is empty if none of the affected clusters
+	// has any resource snapshot rolled out yet, in which case all the resources are counted as changed.
+	// +kubebuilder:validation:Optional
+	BaseResourceIndex string `json:"baseResourceIndex,omitempty"`
+
+	// ClusterCount is the number of selected clusters that the rollout changes, i.e., the selected clusters which
+	// do not have the resource snapshot rolled out yet.
+	// +kubebuilder:validation:Required
+	ClusterCount int32 `json:"clusterCount"`
+
+	// ChangedResourceCount is the number of resources that are added, updated or removed compared with the base
+	// resource snapshot. Overrides are not taken into account.
+	// +kubebuilder:validation:Required
+	ChangedResourceCount int32 `json:"changedResourceCount"`
+
+	// ChangedKinds counts the changed resources by their kinds, sorted by group, version and kind.
+	// +kubebuilder:validation:Optional
+	ChangedKinds []ResourceKindCount `json:"changedKinds,omitempty"`
+
+	// EstimationTime is the time when the estimate is computed.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Type=string
+	// +kubebuilder:validation:Format=date-time
+	EstimationTime metav1.Time `json:"estimationTime"`
+}
+
+// ResourceKindCount is the number of resources of a kind.
+type ResourceKindCount struct {
+	// Group is the group of the resources.
+	// +kubebuilder:validation:Optional
+	Group string `json:"group,omitempty"`
+
+	// Version is the version of the resources.
+	// +kubebuilder:validation:Required
+	Version string `json:"version"`
+
+	// Kind is the kind of the resources.
+	// +kubebuilder:validation:Required
+	Kind string `json:"kind"`
+
+	// Count is the number of the resources.
+	// +kubebuilder:validation:Required
+	Count int32 `json:"count"`
+}
+
 // ResourceIdentifier identifies one Kubernetes resource.
 type ResourceIdentifier struct {
 	// Group is the group name of the selected resource.
@@ -1138,15 +1896,31 @@ type PerClusterPlacementStatus struct {
 	// +kubebuilder:validation:Optional
 	ApplicableClusterResourceOverrides []string `json:"applicableClusterResourceOverrides,omitempty"`
 
+	// EffectiveOverrides lists the applicable override snapshots in the order in which they are applied on the
+	// selected resources, as dictated by their priority tiers; when multiple overrides patch the same field of a
+	// resource, the last one wins.
+	//
+	// This field is alpha-level and is for the override policy feature.
+	// +kubebuilder:validation:Optional
+	EffectiveOverrides []EffectiveOverride `json:"effectiveOverrides,omitempty"`
+
 	// +kubebuilder:validation:MaxItems=100
 
 	// FailedPlacements is a list of all the resources failed to be placed to the given cluster or the resource is unavailable.
-	// Note that we only include 100 failed resource placements even if there are more than 100.
+	// Note that we only include up to FailedPlacementsLimit (as set in the placement spec; 100 by default)
+	// failed resource placements; the number of the ones left out is reported in FailedPlacementOverflowCount.
 	// This field is only meaningful if the `ClusterName` is not empty.
 	// +kubebuilder:validation:Optional
 	// +kubebuilder:validation:MaxItems=100
 	FailedPlacements []FailedResourcePlacement `json:"failedPlacements,omitempty"`
 
+	// FailedPlacementOverflowCount is the number of failed resource placements that are not included
+	// in FailedPlacements as the list has been truncated.
+	// This field is only meaningful if the `ClusterName` is not empty.
+	// +kubebuilder:validation:Optional
+	// +kubebuilder:validation:Minimum=0
+	FailedPlacementOverflowCount int32 `json:"failedPlacementOverflowCount,omitempty"`
+
 	// DriftedPlacements is a list of resources that have drifted from their desired states
 	// kept in the hub cluster, as found by Fleet using the drift detection mechanism.
 	//
@@ -1171,6 +1945,13 @@ type PerClusterPlacementStatus struct {
 	// +kubebuilder:validation:MaxItems=100
 	DiffedPlacements []DiffedResourcePlacement `json:"diffedPlacements,omitempty"`
 
+	// DiffedPlacementSummary summarizes the resources that have configuration differences from their
+	// corresponding hub cluster manifests. It is set in place of DiffedPlacements when the ReportDiff
+	// apply strategy finds too many diffed resources, so that the status stays small; the complete details
+	// can be requested on demand (see the DiffReportRequestAnnotation on bindings).
+	// +kubebuilder:validation:Optional
+	DiffedPlacementSummary *DiffedPlacementSummary `json:"diffedPlacementSummary,omitempty"`
+
 	// Conditions is an array of current observed conditions on the cluster.
 	// Each condition corresponds to the resource snapshot at the index specified by `ObservedResourceIndex`.
 	// For example, the condition of type `RolloutStarted` is observing the rollout status of the resource snapshot with index `ObservedResourceIndex`.
@@ -1391,6 +2172,13 @@ const (
 	// * False: Fleet has failed to create or update the ClusterResourcePlacementStatus object
 	//   in the target namespace.
 	ClusterResourcePlacementStatusSyncedConditionType ClusterResourcePlacementConditionType = "ClusterResourcePlacementStatusSynced"
+
+	// ClusterResourcePlacementRolloutPausedForSafetyConditionType indicates whether Fleet has paused the rollout
+	// of the ClusterResourcePlacement as the error budget of the rollout has been depleted, i.e., too many of
+	// the updated clusters have failed to apply the new version of the selected resources or to make it available.
+	// The condition is only present when the rollout has been paused for safety:
+	// - "True" means the rollout has been paused and waits for a manual resume.
+	ClusterResourcePlacementRolloutPausedForSafetyConditionType ClusterResourcePlacementConditionType = "ClusterResourcePlacementRolloutPausedForSafety"
 )
 
 // ResourcePlacementConditionType defines a specific condition of a resource placement object.
@@ -1459,6 +2247,13 @@ const (
 	//   clusters, or an error has occurred.
 	// * Unknown: Fleet has not finished processing the diff reporting yet.
 	ResourcePlacementDiffReportedConditionType ResourcePlacementConditionType = "ResourcePlacementDiffReported"
+
+	// ResourcePlacementRolloutPausedForSafetyConditionType indicates whether Fleet has paused the rollout
+	// of the ResourcePlacement as the error budget of the rollout has been depleted, i.e., too many of
+	// the updated clusters have failed to apply the new version of the selected resources or to make it available.
+	// The condition is only present when the rollout has been paused for safety:
+	// - "True" means the rollout has been paused and waits for a manual resume.
+	ResourcePlacementRolloutPausedForSafetyConditionType ResourcePlacementConditionType = "ResourcePlacementRolloutPausedForSafety"
 )
 
 // PerClusterPlacementConditionType defines a specific condition of a per cluster placement.
@@ -1524,6 +2319,37 @@ const (
 	//   member cluster, or an error has occurred.
 	// * Unknown: Fleet has not finished processing the diff reporting yet.
 	PerClusterDiffReportedConditionType PerClusterPlacementConditionType = "DiffReported"
+
+	// PerClusterQuotaFitConditionType indicates whether the workloads fit in the resource quotas
+	// of their namespaces on the member cluster.
+	//
+	// This condition is added only when the quota preflight check is enabled in the apply strategy.
+	//
+	// It can have the following condition statuses:
+	// * True: the resource requests of all the workloads fit in the resource quotas.
+	// * False: some workloads would exceed the resource quotas and have not been applied.
+	PerClusterQuotaFitConditionType PerClusterPlacementConditionType = "QuotaFit"
+
+	// PerClusterAdmissionPreflightPassedConditionType indicates whether the manifests have passed the
+	// admission webhooks of the member cluster in a dry-run.
+	//
+	// This condition is added only when the admission preflight is enabled in the apply strategy.
+	//
+	// It can have the following condition statuses:
+	// * True: no manifest has been rejected by the admission webhooks.
+	// * False: some manifests have been rejected by the admission webhooks.
+	PerClusterAdmissionPreflightPassedConditionType PerClusterPlacementConditionType = "AdmissionPreflightPassed"
+
+	// PerClusterCoOwnershipResolvedConditionType indicates whether the resources that the placement
+	// selects for the member cluster and that are also selected by other placements for the same
+	// cluster can be co-owned.
+	//
+	// This condition is added only when some selected resources are also selected by other placements.
+	//
+	// It can have the following condition statuses:
+	// * True: all the placements use the same co-ownership policy that allows co-ownership.
+	// * False: some placements deny co-ownership or use different co-ownership policies.
+	PerClusterCoOwnershipResolvedConditionType PerClusterPlacementConditionType = "CoOwnershipResolved"
 )
 
 // PlacementType identifies the type of placement.
@@ -1633,6 +2459,136 @@ type ReportBackStrategy struct {
 	Destination *ReportBackDestination `json:"destination,omitempty"`
 }
 
+// PreDeleteProbe describes a check on the status of a placed resource, which must pass before Fleet
+// removes the placed resources from a cluster that is no longer selected by the placement.
+//
+// The probe is evaluated on the hub cluster side, against the status back-reported from the member
+// cluster via the Work API; the placement must use the Mirror report back strategy with the WorkAPI
+// destination. The probe is not evaluated when the placement itself is being deleted.
+type PreDeleteProbe struct {
+	// Resource identifies the placed resource whose status is checked, e.g., a ServiceExport object
+	// or an object that reports the draining progress of an external endpoint.
+	// If the resource is not placed on the cluster, the check passes immediately.
+	// +kubebuilder:validation:Required
+	Resource ResourceIdentifier `json:"resource"`
+
+	// JSONPath is a JSONPath expression, in the format used by kubectl (e.g., `{.status.endpointCount}`),
+	// which is evaluated against the back-reported status of the resource.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	JSONPath string `json:"jsonPath"`
+
+	// Value is the expected result of the JSONPath expression; the check passes once the result of the
+	// expression equals the value.
+	// +kubebuilder:validation:Required
+	Value string `json:"value"`
+
+	// TimeoutSeconds is the maximum number of seconds Fleet waits for the check to pass; once the timeout
+	// is reached, Fleet removes the placed resources regardless of the check result.
+	// Default is 300 seconds.
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=3600
+	// +kubebuilder:validation:Optional
+	TimeoutSeconds *int32 `json:"timeoutSeconds,omitempty"`
+}
+
+// RolloutAnalysis describes a metric analysis which gates the rollout of the selected resources.
+//
+// Once the resources placed on a cluster become available, Fleet queries the metric periodically
+// for the analysis duration; the analysis passes if the metric meets the threshold every time. If the
+// metric does not meet the threshold, the analysis fails, and Fleet acts as the failure policy dictates.
+type RolloutAnalysis struct {
+	// PrometheusAddress is the address of the Prometheus HTTP API to query, e.g.,
+	// `http://prometheus.monitoring:9090`.
+	// The address must be one of the addresses that the Fleet hub agent is configured to allow;
+	// the analysis cannot run against any other address.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^https?://.+`
+	PrometheusAddress string `json:"prometheusAddress"`
+
+	// Query is a PromQL query which returns a single value, e.g., the error rate of a service.
+	// The placeholder `{{cluster}}` in the query is replaced with the name of the analyzed cluster.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	Query string `json:"query"`
+
+	// Operator is the operator used to compare the query result with the threshold; the metric meets
+	// the threshold if the comparison `<result> <operator> <threshold>` holds.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Enum=LessThan;LessThanOrEqual;GreaterThan;GreaterThanOrEqual
+	Operator AnalysisOperator `json:"operator"`
+
+	// Threshold is the number the query result is compared with, e.g., `0.01`.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^-?[0-9]+(\.[0-9]+)?$`
+	Threshold string `json:"threshold"`
+
+	// DurationSeconds is the number of seconds the analysis lasts after the resources become available
+	// on a cluster.
+	// Default is 300 seconds.
+	// +kubebuilder:default=300
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=86400
+	// +kubebuilder:validation:Optional
+	DurationSeconds *int32 `json:"durationSeconds,omitempty"`
+
+	// IntervalSeconds is the max number of seconds between two queries of the metric.
+	// Default is 60 seconds.
+	// +kubebuilder:default=60
+	// +kubebuilder:validation:Minimum=5
+	// +kubebuilder:validation:Maximum=3600
+	// +kubebuilder:validation:Optional
+	IntervalSeconds *int32 `json:"intervalSeconds,omitempty"`
+
+	// FailurePolicy is the action Fleet takes when the analysis fails on a cluster.
+	//
+	// Available options are:
+	//
+	// * Halt: Fleet stops rolling out the failed version of the resources to more clusters, and
+	//   leaves the cluster on which the analysis has failed as it is. This is the default option.
+	//
+	// * Rollback: Fleet stops rolling out the failed version of the resources to more clusters, and
+	//   rolls the cluster on which the analysis has failed back to the previous version of the resources.
+	//
+	// In both cases, the rollout resumes once a new version of the resources is selected.
+	// +kubebuilder:default=Halt
+	// +kubebuilder:validation:Enum=Halt;Rollback
+	// +kubebuilder:validation:Optional
+	FailurePolicy AnalysisFailurePolicy `json:"failurePolicy,omitempty"`
+}
+
+// AnalysisOperator is the operator used to compare the result of a rollout analysis query with the threshold.
+// +enum
+type AnalysisOperator string
+
+const (
+	// AnalysisOperatorLessThan requires the query result to be less than the threshold.
+	AnalysisOperatorLessThan AnalysisOperator = "LessThan"
+
+	// AnalysisOperatorLessThanOrEqual requires the query result to be less than or equal to the threshold.
+	AnalysisOperatorLessThanOrEqual AnalysisOperator = "LessThanOrEqual"
+
+	// AnalysisOperatorGreaterThan requires the query result to be greater than the threshold.
+	AnalysisOperatorGreaterThan AnalysisOperator = "GreaterThan"
+
+	// AnalysisOperatorGreaterThanOrEqual requires the query result to be greater than or equal to the threshold.
+	AnalysisOperatorGreaterThanOrEqual AnalysisOperator = "GreaterThanOrEqual"
+)
+
+// AnalysisFailurePolicy is the action Fleet takes when a rollout analysis fails.
+// +enum
+type AnalysisFailurePolicy string
+
+const (
+	// AnalysisFailurePolicyHalt halts the rollout.
+	AnalysisFailurePolicyHalt AnalysisFailurePolicy = "Halt"
+
+	// AnalysisFailurePolicyRollback halts the rollout and rolls the failed cluster back to the previous
+	// version of the resources.
+	AnalysisFailurePolicyRollback AnalysisFailurePolicy = "Rollback"
+)
+
 // ClusterResourcePlacementList contains a list of ClusterResourcePlacement.
 // +kubebuilder:resource:scope="Cluster"
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -1690,7 +2646,6 @@ const (
 )
 
 // +genclient
-// +genclient:Namespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope="Namespaced",shortName=rp,categories={fleet,fleet-placement}
 // +kubebuilder:subresource:status
@@ -1775,7 +2730,7 @@ func (rpl *ResourcePlacementList) GetPlacementObjs() []PlacementObj {
 }
 
 // +genclient
-// +genclient:Namespaced
+// +genclient:noStatus
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope="Namespaced",shortName=crps,categories={fleet,fleet-placement}
 // +kubebuilder:storageversion
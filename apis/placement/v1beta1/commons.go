@@ -63,6 +63,20 @@ const (
 	ClusterResourceEnvelopeKind = "ClusterResourceEnvelope"
 	// ClusterResourcePlacementStatusKind is the kind of the ClusterResourcePlacementStatus.
 	ClusterResourcePlacementStatusKind = "ClusterResourcePlacementStatus"
+	// ClusterExternalRolloutProgressKind is the kind of the ClusterExternalRolloutProgress.
+	ClusterExternalRolloutProgressKind = "ClusterExternalRolloutProgress"
+	// ExternalRolloutProgressKind is the kind of the ExternalRolloutProgress.
+	ExternalRolloutProgressKind = "ExternalRolloutProgress"
+	// BulkPlacementOperationKind is the kind of the BulkPlacementOperation.
+	BulkPlacementOperationKind = "BulkPlacementOperation"
+	// DiffReportKind is the kind of the DiffReport.
+	DiffReportKind = "DiffReport"
+	// ClusterRolloutPolicyKind is the kind of the ClusterRolloutPolicy.
+	ClusterRolloutPolicyKind = "ClusterRolloutPolicy"
+	// ClusterResourceContentKind is the kind of the ClusterResourceContent.
+	ClusterResourceContentKind = "ClusterResourceContent"
+	// SchedulingProfileKind is the kind of the SchedulingProfile.
+	SchedulingProfileKind = "SchedulingProfile"
 )
 
 const (
@@ -95,9 +109,29 @@ const (
 	// IsLatestSnapshotLabel indicates if the snapshot is the latest one.
 	IsLatestSnapshotLabel = FleetPrefix + "is-latest-snapshot"
 
+	// SchedulingCycleSnapshotsLabel is the label added to the config maps in which the scheduler keeps the
+	// snapshots of the latest scheduling cycles of a placement; such config maps are never propagated.
+	SchedulingCycleSnapshotsLabel = FleetPrefix + "scheduling-cycle-snapshots"
+
+	// StatusExportBookmarksLabel is the label added to the config maps in which the placement status exporter
+	// keeps what has been exported for a placement and its bindings.
+	StatusExportBookmarksLabel = FleetPrefix + "status-export-bookmarks"
+
 	// FleetResourceLabelKey indicates that the resource is a fleet resource.
 	FleetResourceLabelKey = FleetPrefix + "is-fleet-resource"
 
+	// PlacementNameMetadataKey is the standard label injected into the placed resources (if enabled in the
+	// metadata injection policy of the placement), whose value is the name of the placement.
+	PlacementNameMetadataKey = FleetPrefix + "placement-name"
+
+	// MemberClusterNameMetadataKey is the standard label injected into the placed resources (if enabled in
+	// the metadata injection policy of the placement), whose value is the name of the member cluster.
+	MemberClusterNameMetadataKey = FleetPrefix + "member-cluster-name"
+
+	// ResourceSnapshotHashMetadataKey is the standard annotation injected into the placed resources (if enabled
+	// in the metadata injection policy of the placement), whose value is the hash of the resource snapshot.
+	ResourceSnapshotHashMetadataKey = FleetPrefix + "resource-snapshot-hash"
+
 	// FirstWorkNameFmt is the format of the name of the work generated with the first resource snapshot.
 	// The name of the first work is {crpName}-work.
 	FirstWorkNameFmt = "%s-work"
@@ -152,10 +186,103 @@ const (
 	// EnvelopeNameLabel contains the name of the envelope object that the work is generated from.
 	EnvelopeNameLabel = FleetPrefix + "envelope-name"
 
+	// EnvelopeSecretRefAllowedEnvelopesAnnotation is the annotation on a Secret that lists, as comma-separated names,
+	// the ResourceEnvelopes in the same namespace that may reference the Secret in their secretRefs. Fleet does
+	// not resolve references to a Secret without the annotation.
+	EnvelopeSecretRefAllowedEnvelopesAnnotation = FleetPrefix + "envelope-secret-ref-allowed-envelopes"
+
 	// PreviousBindingStateAnnotation records the previous state of a binding.
 	// This is used to remember if an "unscheduled" binding was moved from a "bound" state or a "scheduled" state.
 	PreviousBindingStateAnnotation = FleetPrefix + "previous-binding-state"
 
+	// EstimatedResourceRequestsAnnotation records, on a binding, the estimated resource requests of the placement
+	// at the time of scheduling, in the JSON form of a resource list. The scheduler reserves the capacity on the target
+	// cluster until the resources of the binding have been applied.
+	EstimatedResourceRequestsAnnotation = FleetPrefix + "estimated-resource-requests"
+
+	// PreemptedByAnnotation records, on a binding that has been preempted, the key of the higher-priority placement
+	// that preempted it, in the form of "namespace/name" (or "name" for cluster-scoped placements).
+	PreemptedByAnnotation = FleetPrefix + "preempted-by"
+
+	// JobRerunPolicyAnnotation is the annotation on a selected Job that specifies when Fleet should
+	// re-run the Job in member clusters. Jobs are immutable once created and run only once; by default
+	// (JobRerunPolicyNever), Fleet places a Job under its own name and never re-runs it.
+	JobRerunPolicyAnnotation = FleetPrefix + "job-rerun-policy"
+
+	// JobRerunPolicyNever is the JobRerunPolicyAnnotation value that never re-runs a Job; this is the default.
+	JobRerunPolicyNever = "Never"
+
+	// JobRerunPolicyPerResourceSnapshot is the JobRerunPolicyAnnotation value that re-runs a Job
+	// whenever a new resource snapshot is rolled out; Fleet places the Job under a new name suffixed with
+	// the resource snapshot index, and the Job from the previous resource snapshot is removed.
+	JobRerunPolicyPerResourceSnapshot = "PerResourceSnapshot"
+
+	// JobRerunNameFmt is the format of the name of a Job placed with the PerResourceSnapshot re-run policy.
+	// The format is {jobName}-{resourceSnapshotIndex}.
+	JobRerunNameFmt = "%s-%s"
+
+	// JobOriginalNameAnnotation is the annotation on a Job placed with the PerResourceSnapshot re-run
+	// policy that records the name of the Job in the hub cluster.
+	JobOriginalNameAnnotation = FleetPrefix + "job-original-name"
+
+	// OriginalNameAnnotation is the annotation on a resource renamed per the resource name transform of
+	// its placement that records the name of the resource in the hub cluster.
+	OriginalNameAnnotation = FleetPrefix + "original-name"
+
+	// RolloutPausedAnnotation is the annotation on a placement that pauses the rollout of the placement
+	// when set to "true"; the rollout controller stops updating the bindings of a paused placement.
+	RolloutPausedAnnotation = FleetPrefix + "rollout-paused"
+
+	// RolloutPausedForSafetyAnnotation is the annotation on a placement that records the name of the master
+	// resource snapshot whose rollout Fleet has paused, by setting the RolloutPausedAnnotation, as the error
+	// budget of the rollout has been depleted. The rollout of the same resource snapshot is not paused for
+	// failures again once it is resumed.
+	RolloutPausedForSafetyAnnotation = FleetPrefix + "rollout-paused-for-safety"
+
+	// DiffReportRequestAnnotation is the annotation on a binding that asks Fleet to write the complete
+	// details of the drifted and diffed resources of the binding to DiffReport objects, when the binding
+	// status only keeps a summary of the diffed resources. The DiffReports are kept up-to-date for a limited
+	// time, after which they are removed; Fleet removes the annotation once it has served the request.
+	DiffReportRequestAnnotation = FleetPrefix + "diff-report-request"
+
+	// PinnedResourceSnapshotIndexAnnotation is the annotation on a placement that pins the placement to
+	// the resource snapshot of the given index; the rollout controller rolls out the pinned resource
+	// snapshot rather than the latest one. This is used to roll back a placement.
+	PinnedResourceSnapshotIndexAnnotation = FleetPrefix + "pinned-resource-snapshot-index"
+
+	// PinnedByOperationAnnotation is the annotation on a placement that records the UID of the bulk placement
+	// operation that has pinned the placement to a resource snapshot, so that the operation does not roll the
+	// placement back any further if it is run again.
+	PinnedByOperationAnnotation = FleetPrefix + "pinned-by-operation"
+
+	// DependentNamespacesAnnotation is the annotation on a cluster-scoped resource that declares, as a
+	// comma-separated list of names, the namespaces the resource depends on. When a placement selects the
+	// resource with IncludeDependents set, the declared namespaces, along with all the resources within
+	// them, are selected as well.
+	DependentNamespacesAnnotation = FleetPrefix + "dependent-namespaces"
+
+	// DependentResourcesAnnotation is the annotation on a cluster-scoped resource that declares, as a
+	// comma-separated list, the individual resources the resource depends on. Each entry is in the format
+	// of {kind}.{version}.{group}/{name} for a cluster-scoped resource, or
+	// {kind}.{version}.{group}/{namespace}/{name} for a namespace-scoped one; the group is omitted for
+	// the core API group, e.g., ConfigMap.v1/operator-system/operator-config. When a placement selects
+	// the resource with IncludeDependents set, the declared resources are selected as well.
+	DependentResourcesAnnotation = FleetPrefix + "dependent-resources"
+
+	// ResourceContentLastReferencedTimeAnnotation is the annotation on a ClusterResourceContent object that
+	// records (in the RFC 3339 format) when the object was last referred to by a new resource snapshot; the
+	// janitor only deletes unreferenced ClusterResourceContent objects that have not been referred to recently.
+	ResourceContentLastReferencedTimeAnnotation = FleetPrefix + "last-referenced-time"
+
+	// EnvelopeMaxManifestsAnnotation is the annotation on a namespace that overrides, for the ResourceEnvelopes
+	// in the namespace, the hub-wide limit on the number of manifests an envelope may wrap.
+	// Note that the envelope APIs cap the number of wrapped manifests at 50 regardless of the limit.
+	EnvelopeMaxManifestsAnnotation = FleetPrefix + "envelope-max-manifests"
+
+	// EnvelopeMaxSizeBytesAnnotation is the annotation on a namespace that overrides, for the ResourceEnvelopes
+	// in the namespace, the hub-wide limit on the total size (in bytes) of the manifests an envelope may wrap.
+	EnvelopeMaxSizeBytesAnnotation = FleetPrefix + "envelope-max-size-bytes"
+
 	// UpdateRunFinalizer is used by the UpdateRun controller to make sure that the UpdateRun
 	// object is not deleted until all its dependent resources are deleted.
 	UpdateRunFinalizer = FleetPrefix + "stagedupdaterun-finalizer"
@@ -212,6 +339,18 @@ var (
 	// For example, if the string is "${MEMBER-CLUSTER-LABEL-KEY-kube-fleet.io/region}" then the key name is "kube-fleet.io/region".
 	// If there is a label "kube-fleet.io/region": "us-west-1" on the member cluster, this string will be replaced by "us-west-1".
 	OverrideClusterLabelKeyVariablePrefix = "${MEMBER-CLUSTER-LABEL-KEY-"
+
+	// MetadataInjectionPlacementNameVariable is the reserved variable in the injected label and annotation
+	// values that will be replaced by the actual placement name.
+	MetadataInjectionPlacementNameVariable = "${PLACEMENT-NAME}"
+
+	// MetadataInjectionClusterNameVariable is the reserved variable in the injected label and annotation
+	// values that will be replaced by the actual cluster name.
+	MetadataInjectionClusterNameVariable = "${MEMBER-CLUSTER-NAME}"
+
+	// MetadataInjectionResourceSnapshotHashVariable is the reserved variable in the injected annotation
+	// values that will be replaced by the hash of the resource snapshot being placed.
+	MetadataInjectionResourceSnapshotHashVariable = "${RESOURCE-SNAPSHOT-HASH}"
 )
 
 // NamespacedName comprises a resource name, with a mandatory namespace.
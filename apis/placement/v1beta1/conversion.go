@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+// The v1beta1 API version is the hub version for the conversion between the API versions; the
+// conversion functions are implemented in the v1 API version.
+
+// Hub marks AppliedWork as a conversion hub.
+func (*AppliedWork) Hub() {}
+
+// Hub marks ApprovalRequest as a conversion hub.
+func (*ApprovalRequest) Hub() {}
+
+// Hub marks ClusterApprovalRequest as a conversion hub.
+func (*ClusterApprovalRequest) Hub() {}
+
+// Hub marks ClusterResourceBinding as a conversion hub.
+func (*ClusterResourceBinding) Hub() {}
+
+// Hub marks ClusterResourceOverride as a conversion hub.
+func (*ClusterResourceOverride) Hub() {}
+
+// Hub marks ClusterResourceOverrideSnapshot as a conversion hub.
+func (*ClusterResourceOverrideSnapshot) Hub() {}
+
+// Hub marks ClusterResourcePlacement as a conversion hub.
+func (*ClusterResourcePlacement) Hub() {}
+
+// Hub marks ClusterResourcePlacementStatus as a conversion hub.
+func (*ClusterResourcePlacementStatus) Hub() {}
+
+// Hub marks ClusterResourceSnapshot as a conversion hub.
+func (*ClusterResourceSnapshot) Hub() {}
+
+// Hub marks ClusterSchedulingPolicySnapshot as a conversion hub.
+func (*ClusterSchedulingPolicySnapshot) Hub() {}
+
+// Hub marks ClusterStagedUpdateRun as a conversion hub.
+func (*ClusterStagedUpdateRun) Hub() {}
+
+// Hub marks ClusterStagedUpdateStrategy as a conversion hub.
+func (*ClusterStagedUpdateStrategy) Hub() {}
+
+// Hub marks ResourceOverride as a conversion hub.
+func (*ResourceOverride) Hub() {}
+
+// Hub marks ResourceOverrideSnapshot as a conversion hub.
+func (*ResourceOverrideSnapshot) Hub() {}
+
+// Hub marks ResourcePlacement as a conversion hub.
+func (*ResourcePlacement) Hub() {}
+
+// Hub marks StagedUpdateRun as a conversion hub.
+func (*StagedUpdateRun) Hub() {}
+
+// Hub marks StagedUpdateStrategy as a conversion hub.
+func (*StagedUpdateStrategy) Hub() {}
+
+// Hub marks Work as a conversion hub.
+func (*Work) Hub() {}
@@ -0,0 +1,174 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// DiffReportNameFmt is the format of the name of a DiffReport page. It's formatted as
+	// {workNameBase}-diffreport-{pageIndex}, where the work name base is the placement name for
+	// cluster-scoped placements and {namespace}.{placementName} for namespaced placements.
+	DiffReportNameFmt = "%s-diffreport-%d"
+)
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Namespaced,categories={fleet,fleet-placement},shortName=dfr
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.spec.bindingName`,name="Binding",type=string
+// +kubebuilder:printcolumn:JSONPath=`.spec.resourceSnapshotName`,name="Resource-Snapshot",type=string
+// +kubebuilder:printcolumn:JSONPath=`.spec.pageIndex`,name="Page",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.spec.pageCount`,name="Pages",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+
+// DiffReport holds the complete details of the configuration drifts and differences that Fleet finds on
+// the resources a binding places on a member cluster.
+//
+// The binding status only keeps the first 100 drifted and diffed resources, to control the object size;
+// the DiffReports of a binding keep all of them, split into pages so that each page stays well within the
+// object size limit. DiffReports are created by Fleet in the reserved namespace of the member cluster
+// (fleet-member-{clusterName}), and are referenced from the binding status; they always reflect the
+// latest findings on the resource snapshot the binding runs, and are removed along with the binding.
+type DiffReport struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the content of the DiffReport page.
+	// +kubebuilder:validation:Required
+	Spec DiffReportSpec `json:"spec"`
+}
+
+// DiffReportSpec is the content of a DiffReport page.
+type DiffReportSpec struct {
+	// BindingName is the name of the binding whose findings the DiffReport holds.
+	// +kubebuilder:validation:Required
+	BindingName string `json:"bindingName"`
+
+	// BindingNamespace is the namespace of the binding; it is empty for ClusterResourceBindings.
+	// +kubebuilder:validation:Optional
+	BindingNamespace string `json:"bindingNamespace,omitempty"`
+
+	// TargetCluster is the name of the member cluster on which the drifts and differences are found.
+	// +kubebuilder:validation:Required
+	TargetCluster string `json:"targetCluster"`
+
+	// ResourceSnapshotName is the name of the resource snapshot the binding runs.
+	// +kubebuilder:validation:Required
+	ResourceSnapshotName string `json:"resourceSnapshotName"`
+
+	// PageIndex is the zero-based index of the page.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=0
+	PageIndex int32 `json:"pageIndex"`
+
+	// PageCount is the total number of pages of the report.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Minimum=1
+	PageCount int32 `json:"pageCount"`
+
+	// DriftedPlacements is the part of the resources that have drifted from their desired states kept in
+	// the hub cluster that belongs to the page.
+	// +kubebuilder:validation:Optional
+	DriftedPlacements []DriftedResourcePlacement `json:"driftedPlacements,omitempty"`
+
+	// DiffedPlacements is the part of the resources that have configuration differences from their
+	// corresponding hub cluster manifests that belongs to the page.
+	// +kubebuilder:validation:Optional
+	DiffedPlacements []DiffedResourcePlacement `json:"diffedPlacements,omitempty"`
+}
+
+// DiffReportReference refers to the DiffReport pages of a binding.
+type DiffReportReference struct {
+	// Namespace is the namespace of the DiffReport pages.
+	// +kubebuilder:validation:Required
+	Namespace string `json:"namespace"`
+
+	// Names are the names of the DiffReport pages, in the order of their page indices.
+	// +kubebuilder:validation:Required
+	Names []string `json:"names"`
+
+	// ResourceSnapshotName is the name of the resource snapshot on which the report is based.
+	// +kubebuilder:validation:Required
+	ResourceSnapshotName string `json:"resourceSnapshotName"`
+
+	// DriftedPlacementCount is the total number of drifted resources in the report.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	DriftedPlacementCount int32 `json:"driftedPlacementCount,omitempty"`
+
+	// DiffedPlacementCount is the total number of diffed resources in the report.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Optional
+	DiffedPlacementCount int32 `json:"diffedPlacementCount,omitempty"`
+
+	// ExpirationTime is the time after which the DiffReport pages, written on demand, are removed. It is
+	// not set if the pages are kept for as long as there are drifted or diffed resources.
+	// +kubebuilder:validation:Optional
+	ExpirationTime *metav1.Time `json:"expirationTime,omitempty"`
+}
+
+// DiffedPlacementSummary summarizes the resources that have configuration differences from their
+// corresponding hub cluster manifests.
+type DiffedPlacementSummary struct {
+	// Count is the total number of diffed resources.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Required
+	Count int32 `json:"count"`
+
+	// CountsByKind breaks the diffed resources down by their kinds, sorted by group, version, and kind.
+	// +kubebuilder:validation:Optional
+	CountsByKind []DiffedPlacementKindCount `json:"countsByKind,omitempty"`
+
+	// Hash is the hash of the complete details of the diffed resources; it changes whenever any of the
+	// differences changes, which tells whether a DiffReport written earlier is still current.
+	// +kubebuilder:validation:Required
+	Hash string `json:"hash"`
+}
+
+// DiffedPlacementKindCount is the number of diffed resources of a kind.
+type DiffedPlacementKindCount struct {
+	// Group is the API group of the resources; it is empty for the core API group.
+	// +kubebuilder:validation:Optional
+	Group string `json:"group,omitempty"`
+
+	// Version is the API version of the resources.
+	// +kubebuilder:validation:Required
+	Version string `json:"version"`
+
+	// Kind is the kind of the resources.
+	// +kubebuilder:validation:Required
+	Kind string `json:"kind"`
+
+	// Count is the number of diffed resources of the kind.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Required
+	Count int32 `json:"count"`
+}
+
+// DiffReportList contains a list of DiffReports.
+// +kubebuilder:object:root=true
+type DiffReportList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []DiffReport `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&DiffReport{}, &DiffReportList{})
+}
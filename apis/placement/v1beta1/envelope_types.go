@@ -24,6 +24,7 @@ import (
 
 // +genclient
 // +genclient:nonNamespaced
+// +genclient:noStatus
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope="Cluster",categories={fleet,fleet-placement}
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -56,13 +57,16 @@ type ClusterResourceEnvelopeList struct {
 }
 
 // +genclient
-// +genclient:Namespaced
+// +genclient:noStatus
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope="Namespaced",categories={fleet,fleet-placement}
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 // +kubebuilder:storageversion
 
 // ResourceEnvelope wraps namespaced resources for placement.
+// +kubebuilder:validation:XValidation:rule="(has(self.data) ? size(self.data) : 0) + (has(self.secretRefs) ? size(self.secretRefs) : 0) >= 1",message="the envelope must wrap at least one manifest in data or secretRefs"
+// +kubebuilder:validation:XValidation:rule="(has(self.data) ? size(self.data) : 0) + (has(self.secretRefs) ? size(self.secretRefs) : 0) <= 50",message="the envelope can wrap at most 50 manifests in data and secretRefs combined"
+// +kubebuilder:validation:XValidation:rule="!has(self.data) || !has(self.secretRefs) || self.secretRefs.all(k, !(k in self.data))",message="a manifest key cannot appear in both data and secretRefs"
 type ResourceEnvelope struct {
 	metav1.TypeMeta   `json:",inline"`
 	metav1.ObjectMeta `json:"metadata,omitempty"`
@@ -71,10 +75,43 @@ type ResourceEnvelope struct {
 	//
 	// Each manifest is uniquely identified by a string key, typically a filename that represents
 	// the manifest. The value is the manifest object itself.
-	// +kubebuilder:validation:Required
-	// +kubebuilder:validation:MinProperties=1
 	// +kubebuilder:validation:MaxProperties=50
-	Data map[string]runtime.RawExtension `json:"data"`
+	// +optional
+	Data map[string]runtime.RawExtension `json:"data,omitempty"`
+
+	// The manifests wrapped in this envelope that are kept in Secrets rather than inlined in the
+	// envelope, so that sensitive manifests are not embedded in plain envelope objects and access
+	// to them can be restricted separately via RBAC.
+	//
+	// Each manifest is uniquely identified by a string key, which must not appear in Data; the value
+	// refers to a key of a Secret in the same namespace as the envelope, whose value is the manifest
+	// in JSON or YAML. The references are resolved when a resource snapshot is taken of the envelope,
+	// i.e., the resolved manifests are placed in the same way as the manifests in Data.
+	//
+	// Fleet only resolves a reference if the Secret opts in by listing the name of the envelope in its
+	// kubernetes-fleet.io/envelope-secret-ref-allowed-envelopes annotation (comma-separated); this keeps
+	// users who can create envelopes but cannot read Secrets from having Fleet copy arbitrary Secrets
+	// for them. Note that the resolved manifests are kept in plain text in the resource snapshots of
+	// the placement on the hub cluster and in the Works in the member cluster namespaces; grant access
+	// to those objects accordingly.
+	// +kubebuilder:validation:MaxProperties=50
+	// +optional
+	SecretRefs map[string]EnvelopeSecretReference `json:"secretRefs,omitempty"`
+}
+
+// EnvelopeSecretReference refers to a manifest kept in a Secret in the same namespace as the envelope.
+type EnvelopeSecretReference struct {
+	// Name is the name of the Secret.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Name string `json:"name"`
+
+	// Key is the key in the data of the Secret whose value is the manifest.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=253
+	Key string `json:"key"`
 }
 
 // ResourceEnvelopeList contains a list of ResourceEnvelope objects.
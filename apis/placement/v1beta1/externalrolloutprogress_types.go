@@ -0,0 +1,262 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubefleet-dev/kubefleet/apis"
+)
+
+// make sure the ExternalRolloutProgressObj and ExternalRolloutProgressObjList interfaces are implemented by the
+// ClusterExternalRolloutProgress and ExternalRolloutProgress types.
+var _ ExternalRolloutProgressObj = &ClusterExternalRolloutProgress{}
+var _ ExternalRolloutProgressObj = &ExternalRolloutProgress{}
+var _ ExternalRolloutProgressObjList = &ClusterExternalRolloutProgressList{}
+var _ ExternalRolloutProgressObjList = &ExternalRolloutProgressList{}
+
+// ExternalRolloutProgressSpecGetter offers the functionality to get the ExternalRolloutProgressSpec.
+// +kubebuilder:object:generate=false
+type ExternalRolloutProgressSpecGetter interface {
+	GetExternalRolloutProgressSpec() *ExternalRolloutProgressSpec
+}
+
+// ExternalRolloutProgressStatusGetter offers the functionality to get the ExternalRolloutProgressStatus.
+// +kubebuilder:object:generate=false
+type ExternalRolloutProgressStatusGetter interface {
+	GetExternalRolloutProgressStatus() *ExternalRolloutProgressStatus
+}
+
+// ExternalRolloutProgressObj offers the functionality to work with external rollout progress objects, including
+// ClusterExternalRolloutProgresses and ExternalRolloutProgresses.
+// +kubebuilder:object:generate=false
+type ExternalRolloutProgressObj interface {
+	apis.ConditionedObj
+	ExternalRolloutProgressSpecGetter
+	ExternalRolloutProgressStatusGetter
+}
+
+// ExternalRolloutProgressListItemGetter offers the functionality to get a list of ExternalRolloutProgressObj items.
+// +kubebuilder:object:generate=false
+type ExternalRolloutProgressListItemGetter interface {
+	GetExternalRolloutProgressObjs() []ExternalRolloutProgressObj
+}
+
+// ExternalRolloutProgressObjList offers the functionality to work with external rollout progress object list.
+// +kubebuilder:object:generate=false
+type ExternalRolloutProgressObjList interface {
+	client.ObjectList
+	ExternalRolloutProgressListItemGetter
+}
+
+// +genclient
+// +genclient:nonNamespaced
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=cerp
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.metadata.generation`,name="Gen",type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Synced")].status`,name="Synced",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+
+// ClusterExternalRolloutProgress is the progress report of a ClusterResourcePlacement whose rollout is
+// delegated to an external controller, i.e., a ClusterResourcePlacement of the External rollout strategy type.
+//
+// The external controller reports which resource snapshot each member cluster should run in the spec; Fleet
+// then updates the bindings of the ClusterResourcePlacement accordingly. This allows external rollout tools
+// to integrate with Fleet via the API, rather than mutating the bindings directly.
+//
+// To apply a ClusterExternalRolloutProgress to a ClusterResourcePlacement, use the same name for the
+// ClusterExternalRolloutProgress object as the ClusterResourcePlacement object.
+type ClusterExternalRolloutProgress struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the progress reported by the external controller.
+	// +kubebuilder:validation:Required
+	Spec ExternalRolloutProgressSpec `json:"spec"`
+
+	// Status is the observed state of the ClusterExternalRolloutProgress.
+	// +kubebuilder:validation:Optional
+	Status ExternalRolloutProgressStatus `json:"status,omitempty"`
+}
+
+// ExternalRolloutProgressSpec is the progress reported by the external rollout controller.
+type ExternalRolloutProgressSpec struct {
+	// Clusters are the member clusters that the external controller has rolled out resources to, along with
+	// the resource snapshot that each cluster should run.
+	//
+	// Clusters that are not listed here keep running the resource snapshot they already run (if any); to
+	// start rolling out resources to a newly scheduled cluster, add the cluster to the list.
+	// +listType=map
+	// +listMapKey=clusterName
+	// +kubebuilder:validation:MaxItems=1000
+	// +kubebuilder:validation:Optional
+	Clusters []ClusterRolloutTarget `json:"clusters,omitempty"`
+}
+
+// ClusterRolloutTarget is the desired resource snapshot of a member cluster.
+type ClusterRolloutTarget struct {
+	// ClusterName is the name of the member cluster.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:MaxLength=63
+	ClusterName string `json:"clusterName"`
+
+	// ResourceSnapshotIndex is the index of the resource snapshot that the member cluster should run.
+	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=`^[0-9]+$`
+	ResourceSnapshotIndex string `json:"resourceSnapshotIndex"`
+}
+
+// ExternalRolloutProgressStatus is the observed state of the external rollout progress.
+type ExternalRolloutProgressStatus struct {
+	// Conditions is an array of current observed conditions for the external rollout progress.
+	// +listType=map
+	// +listMapKey=type
+	// +kubebuilder:validation:Optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// ExternalRolloutProgressConditionType identifies a specific condition of the external rollout progress.
+type ExternalRolloutProgressConditionType string
+
+const (
+	// ExternalRolloutProgressConditionTypeSynced indicates whether the bindings of the placement have been
+	// updated according to the reported progress.
+	// Its condition status can be one of the following:
+	// - "True" means the bindings of all the reported clusters run the reported resource snapshots.
+	// - "False" means some of the reported clusters cannot run the reported resource snapshots yet, e.g., the
+	//   cluster has not been scheduled or the resource snapshot does not exist; the message lists the reasons.
+	ExternalRolloutProgressConditionTypeSynced ExternalRolloutProgressConditionType = "Synced"
+)
+
+// ClusterExternalRolloutProgressList contains a list of ClusterExternalRolloutProgress objects.
+// +kubebuilder:resource:scope=Cluster
+// +kubebuilder:object:root=true
+type ClusterExternalRolloutProgressList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ClusterExternalRolloutProgress `json:"items"`
+}
+
+// GetExternalRolloutProgressObjs returns the external rollout progress objects in the list.
+func (l *ClusterExternalRolloutProgressList) GetExternalRolloutProgressObjs() []ExternalRolloutProgressObj {
+	objs := make([]ExternalRolloutProgressObj, len(l.Items))
+	for i := range l.Items {
+		objs[i] = &l.Items[i]
+	}
+	return objs
+}
+
+// GetCondition returns the condition of the ClusterExternalRolloutProgress.
+func (p *ClusterExternalRolloutProgress) GetCondition(conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(p.Status.Conditions, conditionType)
+}
+
+// SetConditions sets the conditions of the ClusterExternalRolloutProgress.
+func (p *ClusterExternalRolloutProgress) SetConditions(conditions ...metav1.Condition) {
+	for _, c := range conditions {
+		meta.SetStatusCondition(&p.Status.Conditions, c)
+	}
+}
+
+// GetExternalRolloutProgressSpec returns the external rollout progress spec.
+func (p *ClusterExternalRolloutProgress) GetExternalRolloutProgressSpec() *ExternalRolloutProgressSpec {
+	return &p.Spec
+}
+
+// GetExternalRolloutProgressStatus returns the external rollout progress status.
+func (p *ClusterExternalRolloutProgress) GetExternalRolloutProgressStatus() *ExternalRolloutProgressStatus {
+	return &p.Status
+}
+
+// +genclient
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Namespaced,categories={fleet,fleet-placement},shortName=erp
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.metadata.generation`,name="Gen",type=string
+// +kubebuilder:printcolumn:JSONPath=`.status.conditions[?(@.type=="Synced")].status`,name="Synced",type=string
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+
+// ExternalRolloutProgress is the progress report of a ResourcePlacement whose rollout is delegated to an
+// external controller, i.e., a ResourcePlacement of the External rollout strategy type.
+//
+// To apply an ExternalRolloutProgress to a ResourcePlacement, use the same name and namespace for the
+// ExternalRolloutProgress object as the ResourcePlacement object.
+type ExternalRolloutProgress struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the progress reported by the external controller.
+	// +kubebuilder:validation:Required
+	Spec ExternalRolloutProgressSpec `json:"spec"`
+
+	// Status is the observed state of the ExternalRolloutProgress.
+	// +kubebuilder:validation:Optional
+	Status ExternalRolloutProgressStatus `json:"status,omitempty"`
+}
+
+// ExternalRolloutProgressList contains a list of ExternalRolloutProgress objects.
+// +kubebuilder:resource:scope=Namespaced
+// +kubebuilder:object:root=true
+type ExternalRolloutProgressList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ExternalRolloutProgress `json:"items"`
+}
+
+// GetExternalRolloutProgressObjs returns the external rollout progress objects in the list.
+func (l *ExternalRolloutProgressList) GetExternalRolloutProgressObjs() []ExternalRolloutProgressObj {
+	objs := make([]ExternalRolloutProgressObj, len(l.Items))
+	for i := range l.Items {
+		objs[i] = &l.Items[i]
+	}
+	return objs
+}
+
+// GetCondition returns the condition of the ExternalRolloutProgress.
+func (p *ExternalRolloutProgress) GetCondition(conditionType string) *metav1.Condition {
+	return meta.FindStatusCondition(p.Status.Conditions, conditionType)
+}
+
+// SetConditions sets the conditions of the ExternalRolloutProgress.
+func (p *ExternalRolloutProgress) SetConditions(conditions ...metav1.Condition) {
+	for _, c := range conditions {
+		meta.SetStatusCondition(&p.Status.Conditions, c)
+	}
+}
+
+// GetExternalRolloutProgressSpec returns the external rollout progress spec.
+func (p *ExternalRolloutProgress) GetExternalRolloutProgressSpec() *ExternalRolloutProgressSpec {
+	return &p.Spec
+}
+
+// GetExternalRolloutProgressStatus returns the external rollout progress status.
+func (p *ExternalRolloutProgress) GetExternalRolloutProgressStatus() *ExternalRolloutProgressStatus {
+	return &p.Status
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&ClusterExternalRolloutProgress{}, &ClusterExternalRolloutProgressList{},
+		&ExternalRolloutProgress{}, &ExternalRolloutProgressList{},
+	)
+}
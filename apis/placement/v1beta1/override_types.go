@@ -27,6 +27,7 @@ import (
 // +kubebuilder:storageversion
 // +kubebuilder:resource:scope="Cluster",categories={fleet,fleet-placement}
 // +kubebuilder:validation:XValidation:rule="!has(self.spec.placement) || self.spec.placement.scope != 'Namespaced'",message="clusterResourceOverride placement reference cannot be Namespaced scope"
+// +kubebuilder:subresource:status
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ClusterResourceOverride defines a group of override policies about how to override the selected cluster scope resources
@@ -38,12 +39,16 @@ type ClusterResourceOverride struct {
 	// The desired state of ClusterResourceOverrideSpec.
 	// +required
 	Spec ClusterResourceOverrideSpec `json:"spec"`
+
+	// The observed status of ClusterResourceOverride.
+	// +optional
+	Status OverrideStatus `json:"status,omitempty"`
 }
 
 // ClusterResourceOverrideSpec defines the desired state of the Override.
 // The ClusterResourceOverride create or update will fail when the resource has been selected by the existing ClusterResourceOverride.
-// If the resource is selected by both ClusterResourceOverride and ResourceOverride, ResourceOverride will win when resolving
-// conflicts.
+// If the resource is selected by both ClusterResourceOverride and ResourceOverride of the same priority tier, ResourceOverride
+// will win when resolving conflicts.
 // +kubebuilder:validation:XValidation:rule="(has(oldSelf.placement) && has(self.placement) && oldSelf.placement == self.placement) || (!has(oldSelf.placement) && !has(self.placement))",message="The placement field is immutable"
 type ClusterResourceOverrideSpec struct {
 	// Placement defines whether the override is applied to a specific placement or not.
@@ -67,8 +72,36 @@ type ClusterResourceOverrideSpec struct {
 	// Policy defines how to override the selected resources on the target clusters.
 	// +required
 	Policy *OverridePolicy `json:"policy"`
+
+	// PriorityTier is the priority tier of the override, which is one of Platform, Team and App, from the
+	// highest to the lowest. When multiple overrides select the same resource, they are applied from the
+	// lowest priority tier to the highest, so that the override of the highest tier wins when they patch the
+	// same fields. Overrides without a priority tier rank below the App tier. Within the same tier,
+	// ClusterResourceOverrides are applied before ResourceOverrides, and the overrides of the same kind are
+	// applied in the order of their namespaces and names.
+	// +kubebuilder:validation:Enum=Platform;Team;App
+	// +optional
+	PriorityTier OverridePriorityTier `json:"priorityTier,omitempty"`
 }
 
+// OverridePriorityTier is the priority tier of an override, which decides the order in which the overrides that
+// select the same resource are applied.
+// +enum
+type OverridePriorityTier string
+
+const (
+	// OverridePriorityTierPlatform is the highest priority tier, which is meant for the overrides managed by the
+	// platform team of the fleet.
+	OverridePriorityTierPlatform OverridePriorityTier = "Platform"
+
+	// OverridePriorityTierTeam is the middle priority tier, which is meant for the overrides managed by the teams
+	// that own a group of applications.
+	OverridePriorityTierTeam OverridePriorityTier = "Team"
+
+	// OverridePriorityTierApp is the lowest priority tier, which is meant for the overrides of individual applications.
+	OverridePriorityTierApp OverridePriorityTier = "App"
+)
+
 // ResourceScope defines the scope of placement reference.
 type ResourceScope string
 
@@ -117,7 +150,8 @@ type OverrideRule struct {
 	// The resources will be overridden before applying to the matching clusters.
 	// An empty clusterSelector selects ALL the member clusters.
 	// A nil clusterSelector selects NO member clusters.
-	// For now, only labelSelector is supported.
+	// For now, only labelSelector and propertySelector are supported; the propertySelector is evaluated against
+	// the cluster properties snapshotted on the binding when the overrides are picked for the target cluster.
 	// +optional
 	ClusterSelector *ClusterSelector `json:"clusterSelector,omitempty"`
 
@@ -147,10 +181,10 @@ const (
 )
 
 // +genclient
-// +genclient:Namespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
 // +kubebuilder:resource:scope="Namespaced",categories={fleet,fleet-placement}
+// +kubebuilder:subresource:status
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // ResourceOverride defines a group of override policies about how to override the selected namespaced scope resources
@@ -162,12 +196,16 @@ type ResourceOverride struct {
 	// The desired state of ResourceOverrideSpec.
 	// +required
 	Spec ResourceOverrideSpec `json:"spec"`
+
+	// The observed status of ResourceOverride.
+	// +optional
+	Status OverrideStatus `json:"status,omitempty"`
 }
 
 // ResourceOverrideSpec defines the desired state of the Override.
 // The ResourceOverride create or update will fail when the resource has been selected by the existing ResourceOverride.
-// If the resource is selected by both ClusterResourceOverride and ResourceOverride, ResourceOverride will win when resolving
-// conflicts.
+// If the resource is selected by both ClusterResourceOverride and ResourceOverride of the same priority tier, ResourceOverride
+// will win when resolving conflicts.
 // +kubebuilder:validation:XValidation:rule="(has(oldSelf.placement) && has(self.placement) && oldSelf.placement == self.placement) || (!has(oldSelf.placement) && !has(self.placement))",message="The placement field is immutable"
 type ResourceOverrideSpec struct {
 	// Placement defines whether the override is applied to a specific placement or not.
@@ -188,6 +226,16 @@ type ResourceOverrideSpec struct {
 	// Policy defines how to override the selected resources on the target clusters.
 	// +required
 	Policy *OverridePolicy `json:"policy"`
+
+	// PriorityTier is the priority tier of the override, which is one of Platform, Team and App, from the
+	// highest to the lowest. When multiple overrides select the same resource, they are applied from the
+	// lowest priority tier to the highest, so that the override of the highest tier wins when they patch the
+	// same fields. Overrides without a priority tier rank below the App tier. Within the same tier,
+	// ClusterResourceOverrides are applied before ResourceOverrides, and the overrides of the same kind are
+	// applied in the order of their namespaces and names.
+	// +kubebuilder:validation:Enum=Platform;Team;App
+	// +optional
+	PriorityTier OverridePriorityTier `json:"priorityTier,omitempty"`
 }
 
 // ResourceSelector is used to select namespace scoped resources as the target resources to be placed.
@@ -291,6 +339,71 @@ const (
 	JSONPatchOverrideOpReplace JSONPatchOverrideOperator = "replace"
 )
 
+// OverrideStatus defines the observed state of an override.
+type OverrideStatus struct {
+	// RenderFailures lists the failures found when rendering the JSON patch overrides of the override rules on
+	// the selected resources, as found in the latest resource snapshots of the placements; the override rules are
+	// rendered regardless of the cluster selectors.
+	// At most 20 failures are reported.
+	// +kubebuilder:validation:MaxItems=20
+	// +optional
+	RenderFailures []OverrideRenderFailure `json:"renderFailures,omitempty"`
+
+	// Conditions is an array of current observed conditions for the override.
+	// +listType=map
+	// +listMapKey=type
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// OverrideRenderFailure describes a failure to render an override rule on a selected resource.
+type OverrideRenderFailure struct {
+	// ResourceSnapshotName is the name of the resource snapshot in which the selected resource is found.
+	// +required
+	ResourceSnapshotName string `json:"resourceSnapshotName"`
+
+	// Group is the group name of the selected resource.
+	// +optional
+	Group string `json:"group,omitempty"`
+
+	// Version is the version of the selected resource.
+	// +required
+	Version string `json:"version"`
+
+	// Kind represents the Kind of the selected resource.
+	// +required
+	Kind string `json:"kind"`
+
+	// Name of the selected resource.
+	// +required
+	Name string `json:"name"`
+
+	// Namespace is the namespace of the selected resource. Empty if the resource is cluster scoped.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// RuleIndex is the index of the override rule that fails to render.
+	// +required
+	RuleIndex int32 `json:"ruleIndex"`
+
+	// Message explains why the override rule fails to render, e.g., a JSON patch path that does not exist.
+	// +required
+	Message string `json:"message"`
+}
+
+// OverrideConditionType identifies a specific condition of an override.
+type OverrideConditionType string
+
+const (
+	// OverrideRenderedConditionType indicates whether the override can be rendered on all the resources it selects,
+	// as found in the latest resource snapshots of the placements.
+	// Its condition status can be one of the following:
+	// - "True" means that all the override rules have been rendered on the selected resources successfully.
+	// - "False" means that some override rules fail to render on some selected resources; the rollouts that
+	// consume the override are expected to fail on these resources. See the render failures for details.
+	OverrideRenderedConditionType OverrideConditionType = "Rendered"
+)
+
 // ClusterResourceOverrideList contains a list of ClusterResourceOverride.
 // +kubebuilder:resource:scope="Cluster"
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
@@ -36,6 +36,7 @@ const (
 
 // +genclient
 // +genclient:nonNamespaced
+// +genclient:noStatus
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
 // +kubebuilder:resource:scope="Cluster",categories={fleet,fleet-placement}
@@ -71,7 +72,7 @@ type ClusterResourceOverrideSnapshotSpec struct {
 }
 
 // +genclient
-// +genclient:Namespaced
+// +genclient:noStatus
 // +kubebuilder:object:root=true
 // +kubebuilder:storageversion
 // +kubebuilder:resource:scope="Namespaced",categories={fleet,fleet-placement}
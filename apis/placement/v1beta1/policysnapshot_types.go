@@ -33,6 +33,11 @@ const (
 
 	// NumberOfClustersAnnotation is the annotation that indicates how many clusters should be selected for selectN placement type.
 	NumberOfClustersAnnotation = FleetPrefix + "number-of-clusters"
+
+	// NumberOfSurgeClustersAnnotation is the annotation that indicates how many clusters should be selected
+	// on top of the number of clusters for selectN placement type while the rollout controller surges a
+	// resource change to additional clusters.
+	NumberOfSurgeClustersAnnotation = FleetPrefix + "number-of-surge-clusters"
 )
 
 // make sure the PolicySnapshotObj and PolicySnapshotList interfaces are implemented by the
@@ -255,7 +260,6 @@ func (c *ClusterSchedulingPolicySnapshotList) GetPolicySnapshotObjs() []PolicySn
 }
 
 // +genclient
-// +genclient:Namespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope="Namespaced",shortName=sps,categories={fleet,fleet-placement}
 // +kubebuilder:subresource:status
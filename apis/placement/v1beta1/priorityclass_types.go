@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=ppc
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.spec.value`,name="Value",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.spec.globalDefault`,name="Global-Default",type=boolean
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+
+// PlacementPriorityClass is a fleet-wide priority class for placements. A placement
+// (ClusterResourcePlacement or ResourcePlacement) refers to a PlacementPriorityClass by name
+// in its PriorityClassName field.
+//
+// When a member cluster must shed workloads, e.g., when it is being drained, the resources
+// of lower-priority placements are evicted before those of higher-priority ones. A placement
+// whose class allows preemption may also take the place of lower-priority placements on
+// member clusters that do not have enough capacity left.
+type PlacementPriorityClass struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the PlacementPriorityClass.
+	// +required
+	Spec PlacementPriorityClassSpec `json:"spec"`
+}
+
+// PlacementPriorityClassSpec is the desired state of the PlacementPriorityClass.
+type PlacementPriorityClassSpec struct {
+	// Value is the priority of the placements that refer to this class; the higher the value,
+	// the higher the priority.
+	// +kubebuilder:validation:Minimum=-1000000000
+	// +kubebuilder:validation:Maximum=1000000000
+	// +required
+	Value int32 `json:"value"`
+
+	// GlobalDefault specifies whether this class applies to the placements that do not specify
+	// a priority class. If multiple classes are marked as the global default, the one with the
+	// lowest value applies.
+	// +kubebuilder:validation:Optional
+	GlobalDefault bool `json:"globalDefault,omitempty"`
+
+	// Description is an arbitrary string that describes when this class should be used.
+	// +kubebuilder:validation:Optional
+	Description string `json:"description,omitempty"`
+
+	// PreemptionPolicy specifies whether the placements that refer to this class may preempt
+	// lower-priority placements, i.e., remove their resources from a member cluster that does
+	// not have enough capacity left, when the scheduler cannot find enough clusters for them
+	// otherwise. The disruption budgets of the preempted placements are respected.
+	// Defaults to Never.
+	// +kubebuilder:validation:Enum=PreemptLowerPriority;Never
+	// +kubebuilder:default=Never
+	// +kubebuilder:validation:Optional
+	PreemptionPolicy PlacementPreemptionPolicy `json:"preemptionPolicy,omitempty"`
+}
+
+// PlacementPreemptionPolicy describes whether a placement may preempt lower-priority placements.
+// +enum
+type PlacementPreemptionPolicy string
+
+const (
+	// PreemptLowerPriority means that the placement may preempt lower-priority placements.
+	PreemptLowerPriority PlacementPreemptionPolicy = "PreemptLowerPriority"
+
+	// PreemptNever means that the placement never preempts other placements.
+	PreemptNever PlacementPreemptionPolicy = "Never"
+)
+
+// PlacementPriorityClassList contains a list of PlacementPriorityClass objects.
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type PlacementPriorityClassList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of PlacementPriorityClass objects.
+	Items []PlacementPriorityClass `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&PlacementPriorityClass{},
+		&PlacementPriorityClassList{})
+}
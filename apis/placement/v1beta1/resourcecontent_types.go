@@ -0,0 +1,66 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=crc
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+
+// ClusterResourceContent keeps the content of a single resource selected by one or more placements
+// (ClusterResourcePlacements and ResourcePlacements).
+//
+// When resource content deduplication is enabled on the hub agent, resource snapshots refer to the
+// contents of their selected resources by the names of ClusterResourceContent objects instead of
+// keeping copies of them; the name of a ClusterResourceContent object is derived from the hash of
+// the content it keeps, so that placements that select the same resources (e.g., a namespace
+// baseline shared by many placements) share the same objects.
+//
+// ClusterResourceContent objects are immutable; they are created by the hub agent on demand and
+// garbage collected once no resource snapshot refers to them.
+type ClusterResourceContent struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Content is the content of the resource.
+	// +required
+	Content ResourceContent `json:"content"`
+}
+
+// ClusterResourceContentList contains a list of ClusterResourceContent objects.
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterResourceContentList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of ClusterResourceContent objects.
+	Items []ClusterResourceContent `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&ClusterResourceContent{},
+		&ClusterResourceContentList{})
+}
@@ -145,6 +145,14 @@ type ResourceSnapshotSpec struct {
 	// SelectedResources contains a list of resources selected by ResourceSelectors.
 	// +required
 	SelectedResources []ResourceContent `json:"selectedResources"`
+
+	// SelectedResourceContentNames are the names of the ClusterResourceContent objects that keep the
+	// contents of the resources selected by ResourceSelectors, in the order of selection.
+	//
+	// This field is only set when resource content deduplication is enabled on the hub agent, in
+	// which case the SelectedResources field is left empty.
+	// +optional
+	SelectedResourceContentNames []string `json:"selectedResourceContentNames,omitempty"`
 }
 
 // ResourceContent contains the content of a resource
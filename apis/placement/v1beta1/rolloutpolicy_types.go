@@ -0,0 +1,81 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=crop
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.spec.maxConcurrentRollouts`,name="Max-Concurrent-Rollouts",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+
+// ClusterRolloutPolicy limits how many placements (ClusterResourcePlacements and ResourcePlacements)
+// may roll out changes to the same member cluster at the same time.
+//
+// Each placement honors its own rollout strategy (e.g., maxUnavailable) across the clusters it
+// selects, but it is not aware of the other placements that target the same cluster; when several
+// placements with aggressive rollout strategies roll out together, the workloads on a cluster may
+// all become unavailable at the same time. With a ClusterRolloutPolicy, a placement waits for the
+// rollouts of the other placements on a cluster to complete (i.e., for their resources to become
+// available) before it rolls out its own changes there, if the cluster already has as many rollouts
+// in progress as the policy allows.
+type ClusterRolloutPolicy struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the ClusterRolloutPolicy.
+	// +required
+	Spec ClusterRolloutPolicySpec `json:"spec"`
+}
+
+// ClusterRolloutPolicySpec is the desired state of the ClusterRolloutPolicy.
+type ClusterRolloutPolicySpec struct {
+	// ClusterSelector selects the member clusters that the policy applies to by their labels.
+	// If not specified, the policy applies to all member clusters.
+	// +kubebuilder:validation:Optional
+	ClusterSelector *metav1.LabelSelector `json:"clusterSelector,omitempty"`
+
+	// MaxConcurrentRollouts is the maximum number of placements that may roll out changes to a
+	// selected member cluster at the same time. If multiple policies apply to a member cluster,
+	// the lowest limit applies.
+	// +kubebuilder:validation:Minimum=1
+	// +required
+	MaxConcurrentRollouts int32 `json:"maxConcurrentRollouts"`
+}
+
+// ClusterRolloutPolicyList contains a list of ClusterRolloutPolicy objects.
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type ClusterRolloutPolicyList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of ClusterRolloutPolicy objects.
+	Items []ClusterRolloutPolicy `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&ClusterRolloutPolicy{},
+		&ClusterRolloutPolicyList{})
+}
@@ -0,0 +1,133 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +genclient
+// +genclient:nonNamespaced
+// +genclient:noStatus
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=sprof
+// +kubebuilder:storageversion
+// +kubebuilder:printcolumn:JSONPath=`.spec.minimumScore`,name="Minimum-Score",type=integer
+// +kubebuilder:printcolumn:JSONPath=`.metadata.creationTimestamp`,name="Age",type=date
+
+// SchedulingProfile tunes how the scheduler scores member clusters for the placements
+// (ClusterResourcePlacements and ResourcePlacements) that refer to it by name in the
+// SchedulingProfileName field of their placement policies.
+//
+// By default, the scheduler ranks the clusters by comparing the scores from each score plugin
+// in a fixed order of precedence. With a SchedulingProfile, the scheduler instead ranks the
+// clusters by the weighted sum of the scores from the enabled plugins first, and may leave out
+// the clusters whose weighted sums fall below a minimum. This allows different teams to choose
+// the scoring behavior of their own placements, without changing the settings of the hub agent.
+//
+// Note that a SchedulingProfile only applies to placements of the PickN placement type, as the
+// scheduler does not score clusters for the other placement types.
+type SchedulingProfile struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// Spec is the desired state of the SchedulingProfile.
+	// +required
+	Spec SchedulingProfileSpec `json:"spec"`
+}
+
+// SchedulingProfileSpec is the desired state of the SchedulingProfile.
+type SchedulingProfileSpec struct {
+	// ScorePlugins configures the score plugins of the scheduler. Score plugins that are not
+	// listed are enabled with a weight of 1.
+	// +listType=map
+	// +listMapKey=name
+	// +kubebuilder:validation:MaxItems=10
+	// +kubebuilder:validation:Optional
+	ScorePlugins []ScorePluginConfig `json:"scorePlugins,omitempty"`
+
+	// MinimumScore is the lowest weighted sum of scores a cluster must receive to be picked.
+	// Clusters whose weighted sums fall below the minimum are not picked, even if the placement
+	// has not found enough clusters. If not specified, there is no minimum.
+	// +kubebuilder:validation:Optional
+	MinimumScore *int64 `json:"minimumScore,omitempty"`
+}
+
+// ScorePluginName is the name of a score plugin of the scheduler.
+// +enum
+type ScorePluginName string
+
+const (
+	// ClusterAffinityScorePlugin scores clusters by the preferred cluster affinity terms of the placement.
+	ClusterAffinityScorePlugin ScorePluginName = "ClusterAffinity"
+
+	// TopologySpreadConstraintsScorePlugin scores clusters by how well picking them would satisfy
+	// the topology spread constraints of the placement.
+	TopologySpreadConstraintsScorePlugin ScorePluginName = "TopologySpreadConstraints"
+
+	// SamePlacementAntiAffinityScorePlugin prefers clusters that the placement has been scheduled to
+	// under an older scheduling policy.
+	SamePlacementAntiAffinityScorePlugin ScorePluginName = "SamePlacementAntiAffinity"
+
+	// PlacementSpreadScorePlugin prefers clusters that host fewer placements.
+	//
+	// Note that this plugin only runs if it has been enabled on the hub agent.
+	PlacementSpreadScorePlugin ScorePluginName = "PlacementSpread"
+
+	// ClusterAutoscalingScorePlugin prefers clusters that are scaling up.
+	//
+	// Note that this plugin only runs if it has been enabled on the hub agent.
+	ClusterAutoscalingScorePlugin ScorePluginName = "ClusterAutoscaling"
+)
+
+// ScorePluginConfig configures a score plugin of the scheduler.
+type ScorePluginConfig struct {
+	// Name is the name of the score plugin.
+	// +kubebuilder:validation:Enum=ClusterAffinity;TopologySpreadConstraints;SamePlacementAntiAffinity;PlacementSpread;ClusterAutoscaling
+	// +required
+	Name ScorePluginName `json:"name"`
+
+	// Enabled specifies whether the score plugin runs. If not specified, the plugin runs.
+	// +kubebuilder:default=true
+	// +kubebuilder:validation:Optional
+	Enabled *bool `json:"enabled,omitempty"`
+
+	// Weight is the weight of the scores from the score plugin in the weighted sum of scores
+	// of a cluster. If not specified, the weight is 1.
+	// +kubebuilder:validation:Minimum=0
+	// +kubebuilder:validation:Maximum=100
+	// +kubebuilder:default=1
+	// +kubebuilder:validation:Optional
+	Weight *int32 `json:"weight,omitempty"`
+}
+
+// SchedulingProfileList contains a list of SchedulingProfile objects.
+// +kubebuilder:resource:scope=Cluster
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+type SchedulingProfileList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	// Items is the list of SchedulingProfile objects.
+	Items []SchedulingProfile `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(
+		&SchedulingProfile{},
+		&SchedulingProfileList{})
+}
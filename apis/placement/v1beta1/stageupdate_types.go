@@ -82,7 +82,7 @@ type UpdateRunObjList interface {
 }
 
 // +genclient
-// +genclient:Cluster
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=csur
@@ -232,7 +232,7 @@ type UpdateStrategyObjList interface {
 }
 
 // +genclient
-// +genclient:cluster
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=csus
@@ -519,6 +519,13 @@ type ClusterUpdatingStatus struct {
 	// +kubebuilder:validation:Optional
 	ClusterResourceOverrideSnapshots []string `json:"clusterResourceOverrideSnapshots,omitempty"`
 
+	// ClusterPropertySnapshot is a snapshot of the cluster properties referenced by the property selectors
+	// of the associated override rules.
+	// The snapshot is taken at the beginning of the update run and not updated during the update run.
+	// It is not set if none of the associated override rules has a property selector.
+	// +kubebuilder:validation:Optional
+	ClusterPropertySnapshot *ClusterPropertySnapshot `json:"clusterPropertySnapshot,omitempty"`
+
 	// +patchMergeKey=type
 	// +patchStrategy=merge
 	// +listType=map
@@ -658,7 +665,7 @@ type ApprovalRequestObjList interface {
 }
 
 // +genclient
-// +genclient:Cluster
+// +genclient:nonNamespaced
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,categories={fleet,fleet-placement},shortName=careq
@@ -861,6 +868,7 @@ func (s *StagedUpdateRunList) GetUpdateRunObjs() []UpdateRunObj {
 }
 
 // +genclient
+// +genclient:noStatus
 // +kubebuilder:object:root=true
 // +kubebuilder:resource:scope=Namespaced,categories={fleet,fleet-placement},shortName=sus
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
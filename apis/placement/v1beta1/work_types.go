@@ -58,6 +58,25 @@ const (
 	// WorkConditionTypeStatusTrimmed reports whether the member agent has to trim
 	// the status data in the Work object due to size constraints.
 	WorkConditionTypeStatusTrimmed = "StatusTrimmed"
+
+	// WorkConditionTypePostApplyHooksCompleted reports whether the post-apply hooks configured
+	// on the member agent have completed successfully for the current generation of the Work.
+	WorkConditionTypePostApplyHooksCompleted = "PostApplyHooksCompleted"
+
+	// WorkConditionTypeQuotaFit reports whether the workloads in the Work fit in the resource
+	// quotas of their namespaces on the spoke cluster; it is set only when the quota preflight
+	// check is enabled in the apply strategy.
+	WorkConditionTypeQuotaFit = "QuotaFit"
+
+	// WorkConditionTypeAdmissionPreflightPassed reports whether the manifests in the Work have passed
+	// the admission webhooks on the spoke cluster in a dry-run; it is set only when the admission
+	// preflight is enabled in the apply strategy.
+	WorkConditionTypeAdmissionPreflightPassed = "AdmissionPreflightPassed"
+
+	// WorkConditionTypeSharedDependency reports that the member agent has kept some left-over
+	// resources on the spoke cluster, instead of deleting them, as resources placed by other Works
+	// still depend on them; it is set only when such resources are found.
+	WorkConditionTypeSharedDependency = "SharedDependency"
 )
 
 // This api is copied from https://github.com/kubernetes-sigs/work-api/blob/master/pkg/apis/v1alpha1/work_types.go.
@@ -21,6 +21,7 @@ limitations under the License.
 package v1beta1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -34,6 +35,11 @@ func (in *Affinity) DeepCopyInto(out *Affinity) {
 		*out = new(ClusterAffinity)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PlacementAffinity != nil {
+		in, out := &in.PlacementAffinity, &out.PlacementAffinity
+		*out = new(PlacementAffinity)
+		**out = **in
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Affinity.
@@ -164,6 +170,18 @@ func (in *ApplyStrategy) DeepCopyInto(out *ApplyStrategy) {
 		*out = new(ServerSideApplyConfig)
 		**out = **in
 	}
+	if in.IgnoreFields != nil {
+		in, out := &in.IgnoreFields, &out.IgnoreFields
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.DriftRemediationWindows != nil {
+		in, out := &in.DriftRemediationWindows, &out.DriftRemediationWindows
+		*out = make([]DriftRemediationWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplyStrategy.
@@ -176,6 +194,31 @@ func (in *ApplyStrategy) DeepCopy() *ApplyStrategy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ApplyStrategyOverride) DeepCopyInto(out *ApplyStrategyOverride) {
+	*out = *in
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(ClusterSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ApplyStrategy != nil {
+		in, out := &in.ApplyStrategy, &out.ApplyStrategy
+		*out = new(ApplyStrategy)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ApplyStrategyOverride.
+func (in *ApplyStrategyOverride) DeepCopy() *ApplyStrategyOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ApplyStrategyOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ApprovalRequest) DeepCopyInto(out *ApprovalRequest) {
 	*out = *in
@@ -289,6 +332,184 @@ func (in *BackReportedStatus) DeepCopy() *BackReportedStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BindingHistoryEntry) DeepCopyInto(out *BindingHistoryEntry) {
+	*out = *in
+	in.Time.DeepCopyInto(&out.Time)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BindingHistoryEntry.
+func (in *BindingHistoryEntry) DeepCopy() *BindingHistoryEntry {
+	if in == nil {
+		return nil
+	}
+	out := new(BindingHistoryEntry)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BindingSnapshots) DeepCopyInto(out *BindingSnapshots) {
+	*out = *in
+	if in.ResourceOverrideSnapshots != nil {
+		in, out := &in.ResourceOverrideSnapshots, &out.ResourceOverrideSnapshots
+		*out = make([]NamespacedName, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterResourceOverrideSnapshots != nil {
+		in, out := &in.ClusterResourceOverrideSnapshots, &out.ClusterResourceOverrideSnapshots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BindingSnapshots.
+func (in *BindingSnapshots) DeepCopy() *BindingSnapshots {
+	if in == nil {
+		return nil
+	}
+	out := new(BindingSnapshots)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BulkPlacementOperation) DeepCopyInto(out *BulkPlacementOperation) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BulkPlacementOperation.
+func (in *BulkPlacementOperation) DeepCopy() *BulkPlacementOperation {
+	if in == nil {
+		return nil
+	}
+	out := new(BulkPlacementOperation)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BulkPlacementOperation) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BulkPlacementOperationList) DeepCopyInto(out *BulkPlacementOperationList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]BulkPlacementOperation, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BulkPlacementOperationList.
+func (in *BulkPlacementOperationList) DeepCopy() *BulkPlacementOperationList {
+	if in == nil {
+		return nil
+	}
+	out := new(BulkPlacementOperationList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BulkPlacementOperationList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BulkPlacementOperationSpec) DeepCopyInto(out *BulkPlacementOperationSpec) {
+	*out = *in
+	in.PlacementSelector.DeepCopyInto(&out.PlacementSelector)
+	if in.Retarget != nil {
+		in, out := &in.Retarget, &out.Retarget
+		*out = new(BulkPlacementRetargetConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.MaxConcurrency != nil {
+		in, out := &in.MaxConcurrency, &out.MaxConcurrency
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BulkPlacementOperationSpec.
+func (in *BulkPlacementOperationSpec) DeepCopy() *BulkPlacementOperationSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(BulkPlacementOperationSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BulkPlacementOperationStatus) DeepCopyInto(out *BulkPlacementOperationStatus) {
+	*out = *in
+	if in.PlacementResults != nil {
+		in, out := &in.PlacementResults, &out.PlacementResults
+		*out = make([]PlacementOperationResult, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BulkPlacementOperationStatus.
+func (in *BulkPlacementOperationStatus) DeepCopy() *BulkPlacementOperationStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BulkPlacementOperationStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BulkPlacementRetargetConfig) DeepCopyInto(out *BulkPlacementRetargetConfig) {
+	*out = *in
+	if in.ClusterNames != nil {
+		in, out := &in.ClusterNames, &out.ClusterNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(ClusterSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BulkPlacementRetargetConfig.
+func (in *BulkPlacementRetargetConfig) DeepCopy() *BulkPlacementRetargetConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BulkPlacementRetargetConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAffinity) DeepCopyInto(out *ClusterAffinity) {
 	*out = *in
@@ -396,7 +617,7 @@ func (in *ClusterDecision) DeepCopy() *ClusterDecision {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterResourceBinding) DeepCopyInto(out *ClusterResourceBinding) {
+func (in *ClusterExternalRolloutProgress) DeepCopyInto(out *ClusterExternalRolloutProgress) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
@@ -404,18 +625,18 @@ func (in *ClusterResourceBinding) DeepCopyInto(out *ClusterResourceBinding) {
 	in.Status.DeepCopyInto(&out.Status)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceBinding.
-func (in *ClusterResourceBinding) DeepCopy() *ClusterResourceBinding {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExternalRolloutProgress.
+func (in *ClusterExternalRolloutProgress) DeepCopy() *ClusterExternalRolloutProgress {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterResourceBinding)
+	out := new(ClusterExternalRolloutProgress)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterResourceBinding) DeepCopyObject() runtime.Object {
+func (in *ClusterExternalRolloutProgress) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -423,31 +644,31 @@ func (in *ClusterResourceBinding) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterResourceBindingList) DeepCopyInto(out *ClusterResourceBindingList) {
+func (in *ClusterExternalRolloutProgressList) DeepCopyInto(out *ClusterExternalRolloutProgressList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterResourceBinding, len(*in))
+		*out = make([]ClusterExternalRolloutProgress, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceBindingList.
-func (in *ClusterResourceBindingList) DeepCopy() *ClusterResourceBindingList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterExternalRolloutProgressList.
+func (in *ClusterExternalRolloutProgressList) DeepCopy() *ClusterExternalRolloutProgressList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterResourceBindingList)
+	out := new(ClusterExternalRolloutProgressList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterResourceBindingList) DeepCopyObject() runtime.Object {
+func (in *ClusterExternalRolloutProgressList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -455,31 +676,48 @@ func (in *ClusterResourceBindingList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterResourceEnvelope) DeepCopyInto(out *ClusterResourceEnvelope) {
+func (in *ClusterPropertySnapshot) DeepCopyInto(out *ClusterPropertySnapshot) {
 	*out = *in
-	out.TypeMeta = in.TypeMeta
-	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	if in.Data != nil {
-		in, out := &in.Data, &out.Data
-		*out = make(map[string]runtime.RawExtension, len(*in))
+	if in.Values != nil {
+		in, out := &in.Values, &out.Values
+		*out = make(map[string]string, len(*in))
 		for key, val := range *in {
-			(*out)[key] = *val.DeepCopy()
+			(*out)[key] = val
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceEnvelope.
-func (in *ClusterResourceEnvelope) DeepCopy() *ClusterResourceEnvelope {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterPropertySnapshot.
+func (in *ClusterPropertySnapshot) DeepCopy() *ClusterPropertySnapshot {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterResourceEnvelope)
+	out := new(ClusterPropertySnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceBinding) DeepCopyInto(out *ClusterResourceBinding) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceBinding.
+func (in *ClusterResourceBinding) DeepCopy() *ClusterResourceBinding {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceBinding)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterResourceEnvelope) DeepCopyObject() runtime.Object {
+func (in *ClusterResourceBinding) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -487,31 +725,31 @@ func (in *ClusterResourceEnvelope) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterResourceEnvelopeList) DeepCopyInto(out *ClusterResourceEnvelopeList) {
+func (in *ClusterResourceBindingList) DeepCopyInto(out *ClusterResourceBindingList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterResourceEnvelope, len(*in))
+		*out = make([]ClusterResourceBinding, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceEnvelopeList.
-func (in *ClusterResourceEnvelopeList) DeepCopy() *ClusterResourceEnvelopeList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceBindingList.
+func (in *ClusterResourceBindingList) DeepCopy() *ClusterResourceBindingList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterResourceEnvelopeList)
+	out := new(ClusterResourceBindingList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterResourceEnvelopeList) DeepCopyObject() runtime.Object {
+func (in *ClusterResourceBindingList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -519,25 +757,25 @@ func (in *ClusterResourceEnvelopeList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterResourceOverride) DeepCopyInto(out *ClusterResourceOverride) {
+func (in *ClusterResourceContent) DeepCopyInto(out *ClusterResourceContent) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	in.Content.DeepCopyInto(&out.Content)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceOverride.
-func (in *ClusterResourceOverride) DeepCopy() *ClusterResourceOverride {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceContent.
+func (in *ClusterResourceContent) DeepCopy() *ClusterResourceContent {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterResourceOverride)
+	out := new(ClusterResourceContent)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterResourceOverride) DeepCopyObject() runtime.Object {
+func (in *ClusterResourceContent) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -545,31 +783,31 @@ func (in *ClusterResourceOverride) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterResourceOverrideList) DeepCopyInto(out *ClusterResourceOverrideList) {
+func (in *ClusterResourceContentList) DeepCopyInto(out *ClusterResourceContentList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
 	if in.Items != nil {
 		in, out := &in.Items, &out.Items
-		*out = make([]ClusterResourceOverride, len(*in))
+		*out = make([]ClusterResourceContent, len(*in))
 		for i := range *in {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceOverrideList.
-func (in *ClusterResourceOverrideList) DeepCopy() *ClusterResourceOverrideList {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceContentList.
+func (in *ClusterResourceContentList) DeepCopy() *ClusterResourceContentList {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterResourceOverrideList)
+	out := new(ClusterResourceContentList)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterResourceOverrideList) DeepCopyObject() runtime.Object {
+func (in *ClusterResourceContentList) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -577,25 +815,31 @@ func (in *ClusterResourceOverrideList) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterResourceOverrideSnapshot) DeepCopyInto(out *ClusterResourceOverrideSnapshot) {
+func (in *ClusterResourceEnvelope) DeepCopyInto(out *ClusterResourceEnvelope) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
-	in.Spec.DeepCopyInto(&out.Spec)
+	if in.Data != nil {
+		in, out := &in.Data, &out.Data
+		*out = make(map[string]runtime.RawExtension, len(*in))
+		for key, val := range *in {
+			(*out)[key] = *val.DeepCopy()
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceOverrideSnapshot.
-func (in *ClusterResourceOverrideSnapshot) DeepCopy() *ClusterResourceOverrideSnapshot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceEnvelope.
+func (in *ClusterResourceEnvelope) DeepCopy() *ClusterResourceEnvelope {
 	if in == nil {
 		return nil
 	}
-	out := new(ClusterResourceOverrideSnapshot)
+	out := new(ClusterResourceEnvelope)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
-func (in *ClusterResourceOverrideSnapshot) DeepCopyObject() runtime.Object {
+func (in *ClusterResourceEnvelope) DeepCopyObject() runtime.Object {
 	if c := in.DeepCopy(); c != nil {
 		return c
 	}
@@ -603,7 +847,124 @@ func (in *ClusterResourceOverrideSnapshot) DeepCopyObject() runtime.Object {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *ClusterResourceOverrideSnapshotList) DeepCopyInto(out *ClusterResourceOverrideSnapshotList) {
+func (in *ClusterResourceEnvelopeList) DeepCopyInto(out *ClusterResourceEnvelopeList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterResourceEnvelope, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceEnvelopeList.
+func (in *ClusterResourceEnvelopeList) DeepCopy() *ClusterResourceEnvelopeList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceEnvelopeList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterResourceEnvelopeList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceOverride) DeepCopyInto(out *ClusterResourceOverride) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceOverride.
+func (in *ClusterResourceOverride) DeepCopy() *ClusterResourceOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterResourceOverride) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceOverrideList) DeepCopyInto(out *ClusterResourceOverrideList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterResourceOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceOverrideList.
+func (in *ClusterResourceOverrideList) DeepCopy() *ClusterResourceOverrideList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceOverrideList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterResourceOverrideList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceOverrideSnapshot) DeepCopyInto(out *ClusterResourceOverrideSnapshot) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterResourceOverrideSnapshot.
+func (in *ClusterResourceOverrideSnapshot) DeepCopy() *ClusterResourceOverrideSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterResourceOverrideSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterResourceOverrideSnapshot) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterResourceOverrideSnapshotList) DeepCopyInto(out *ClusterResourceOverrideSnapshotList) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ListMeta.DeepCopyInto(&out.ListMeta)
@@ -981,6 +1342,99 @@ func (in *ClusterResourceSnapshotList) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRolloutTarget) DeepCopyInto(out *ClusterRolloutTarget) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutTarget.
+func (in *ClusterRolloutTarget) DeepCopy() *ClusterRolloutTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRolloutTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRolloutPolicy) DeepCopyInto(out *ClusterRolloutPolicy) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutPolicy.
+func (in *ClusterRolloutPolicy) DeepCopy() *ClusterRolloutPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRolloutPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRolloutPolicy) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRolloutPolicyList) DeepCopyInto(out *ClusterRolloutPolicyList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ClusterRolloutPolicy, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutPolicyList.
+func (in *ClusterRolloutPolicyList) DeepCopy() *ClusterRolloutPolicyList {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRolloutPolicyList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ClusterRolloutPolicyList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ClusterRolloutPolicySpec) DeepCopyInto(out *ClusterRolloutPolicySpec) {
+	*out = *in
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterRolloutPolicySpec.
+func (in *ClusterRolloutPolicySpec) DeepCopy() *ClusterRolloutPolicySpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ClusterRolloutPolicySpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterSchedulingPolicySnapshot) DeepCopyInto(out *ClusterSchedulingPolicySnapshot) {
 	*out = *in
@@ -1247,6 +1701,11 @@ func (in *ClusterUpdatingStatus) DeepCopyInto(out *ClusterUpdatingStatus) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ClusterPropertySnapshot != nil {
+		in, out := &in.ClusterPropertySnapshot, &out.ClusterPropertySnapshot
+		*out = new(ClusterPropertySnapshot)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -1309,69 +1768,235 @@ func (in *DiffDetails) DeepCopy() *DiffDetails {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DiffedResourcePlacement) DeepCopyInto(out *DiffedResourcePlacement) {
+func (in *DiffReport) DeepCopyInto(out *DiffReport) {
 	*out = *in
-	in.ResourceIdentifier.DeepCopyInto(&out.ResourceIdentifier)
-	in.ObservationTime.DeepCopyInto(&out.ObservationTime)
-	if in.TargetClusterObservedGeneration != nil {
-		in, out := &in.TargetClusterObservedGeneration, &out.TargetClusterObservedGeneration
-		*out = new(int64)
-		**out = **in
-	}
-	in.FirstDiffedObservedTime.DeepCopyInto(&out.FirstDiffedObservedTime)
-	if in.ObservedDiffs != nil {
-		in, out := &in.ObservedDiffs, &out.ObservedDiffs
-		*out = make([]PatchDetail, len(*in))
-		copy(*out, *in)
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiffedResourcePlacement.
-func (in *DiffedResourcePlacement) DeepCopy() *DiffedResourcePlacement {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiffReport.
+func (in *DiffReport) DeepCopy() *DiffReport {
 	if in == nil {
 		return nil
 	}
-	out := new(DiffedResourcePlacement)
+	out := new(DiffReport)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DiffReport) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DriftDetails) DeepCopyInto(out *DriftDetails) {
+func (in *DiffReportList) DeepCopyInto(out *DiffReportList) {
 	*out = *in
-	in.ObservationTime.DeepCopyInto(&out.ObservationTime)
-	in.FirstDriftedObservedTime.DeepCopyInto(&out.FirstDriftedObservedTime)
-	if in.ObservedDrifts != nil {
-		in, out := &in.ObservedDrifts, &out.ObservedDrifts
-		*out = make([]PatchDetail, len(*in))
-		copy(*out, *in)
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]DiffReport, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetails.
-func (in *DriftDetails) DeepCopy() *DriftDetails {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiffReportList.
+func (in *DiffReportList) DeepCopy() *DiffReportList {
 	if in == nil {
 		return nil
 	}
-	out := new(DriftDetails)
+	out := new(DiffReportList)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *DiffReportList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DriftedResourcePlacement) DeepCopyInto(out *DriftedResourcePlacement) {
+func (in *DiffReportReference) DeepCopyInto(out *DiffReportReference) {
 	*out = *in
-	in.ResourceIdentifier.DeepCopyInto(&out.ResourceIdentifier)
-	in.ObservationTime.DeepCopyInto(&out.ObservationTime)
-	in.FirstDriftedObservedTime.DeepCopyInto(&out.FirstDriftedObservedTime)
-	if in.ObservedDrifts != nil {
-		in, out := &in.ObservedDrifts, &out.ObservedDrifts
-		*out = make([]PatchDetail, len(*in))
+	if in.Names != nil {
+		in, out := &in.Names, &out.Names
+		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ExpirationTime != nil {
+		in, out := &in.ExpirationTime, &out.ExpirationTime
+		*out = (*in).DeepCopy()
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftedResourcePlacement.
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiffReportReference.
+func (in *DiffReportReference) DeepCopy() *DiffReportReference {
+	if in == nil {
+		return nil
+	}
+	out := new(DiffReportReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiffReportSpec) DeepCopyInto(out *DiffReportSpec) {
+	*out = *in
+	if in.DriftedPlacements != nil {
+		in, out := &in.DriftedPlacements, &out.DriftedPlacements
+		*out = make([]DriftedResourcePlacement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.DiffedPlacements != nil {
+		in, out := &in.DiffedPlacements, &out.DiffedPlacements
+		*out = make([]DiffedResourcePlacement, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiffReportSpec.
+func (in *DiffReportSpec) DeepCopy() *DiffReportSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(DiffReportSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiffedPlacementKindCount) DeepCopyInto(out *DiffedPlacementKindCount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiffedPlacementKindCount.
+func (in *DiffedPlacementKindCount) DeepCopy() *DiffedPlacementKindCount {
+	if in == nil {
+		return nil
+	}
+	out := new(DiffedPlacementKindCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiffedPlacementSummary) DeepCopyInto(out *DiffedPlacementSummary) {
+	*out = *in
+	if in.CountsByKind != nil {
+		in, out := &in.CountsByKind, &out.CountsByKind
+		*out = make([]DiffedPlacementKindCount, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiffedPlacementSummary.
+func (in *DiffedPlacementSummary) DeepCopy() *DiffedPlacementSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(DiffedPlacementSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DiffedResourcePlacement) DeepCopyInto(out *DiffedResourcePlacement) {
+	*out = *in
+	in.ResourceIdentifier.DeepCopyInto(&out.ResourceIdentifier)
+	in.ObservationTime.DeepCopyInto(&out.ObservationTime)
+	if in.TargetClusterObservedGeneration != nil {
+		in, out := &in.TargetClusterObservedGeneration, &out.TargetClusterObservedGeneration
+		*out = new(int64)
+		**out = **in
+	}
+	in.FirstDiffedObservedTime.DeepCopyInto(&out.FirstDiffedObservedTime)
+	if in.ObservedDiffs != nil {
+		in, out := &in.ObservedDiffs, &out.ObservedDiffs
+		*out = make([]PatchDetail, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DiffedResourcePlacement.
+func (in *DiffedResourcePlacement) DeepCopy() *DiffedResourcePlacement {
+	if in == nil {
+		return nil
+	}
+	out := new(DiffedResourcePlacement)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftDetails) DeepCopyInto(out *DriftDetails) {
+	*out = *in
+	in.ObservationTime.DeepCopyInto(&out.ObservationTime)
+	in.FirstDriftedObservedTime.DeepCopyInto(&out.FirstDriftedObservedTime)
+	if in.ObservedDrifts != nil {
+		in, out := &in.ObservedDrifts, &out.ObservedDrifts
+		*out = make([]PatchDetail, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftDetails.
+func (in *DriftDetails) DeepCopy() *DriftDetails {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftDetails)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftRemediationWindow) DeepCopyInto(out *DriftRemediationWindow) {
+	*out = *in
+	if in.Days != nil {
+		in, out := &in.Days, &out.Days
+		*out = make([]DayOfWeek, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftRemediationWindow.
+func (in *DriftRemediationWindow) DeepCopy() *DriftRemediationWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(DriftRemediationWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DriftedResourcePlacement) DeepCopyInto(out *DriftedResourcePlacement) {
+	*out = *in
+	in.ResourceIdentifier.DeepCopyInto(&out.ResourceIdentifier)
+	in.ObservationTime.DeepCopyInto(&out.ObservationTime)
+	in.FirstDriftedObservedTime.DeepCopyInto(&out.FirstDriftedObservedTime)
+	if in.ObservedDrifts != nil {
+		in, out := &in.ObservedDrifts, &out.ObservedDrifts
+		*out = make([]PatchDetail, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DriftedResourcePlacement.
 func (in *DriftedResourcePlacement) DeepCopy() *DriftedResourcePlacement {
 	if in == nil {
 		return nil
@@ -1381,6 +2006,21 @@ func (in *DriftedResourcePlacement) DeepCopy() *DriftedResourcePlacement {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EffectiveOverride) DeepCopyInto(out *EffectiveOverride) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EffectiveOverride.
+func (in *EffectiveOverride) DeepCopy() *EffectiveOverride {
+	if in == nil {
+		return nil
+	}
+	out := new(EffectiveOverride)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *EnvelopeIdentifier) DeepCopyInto(out *EnvelopeIdentifier) {
 	*out = *in
@@ -1396,6 +2036,122 @@ func (in *EnvelopeIdentifier) DeepCopy() *EnvelopeIdentifier {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EnvelopeSecretReference) DeepCopyInto(out *EnvelopeSecretReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EnvelopeSecretReference.
+func (in *EnvelopeSecretReference) DeepCopy() *EnvelopeSecretReference {
+	if in == nil {
+		return nil
+	}
+	out := new(EnvelopeSecretReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalRolloutProgress) DeepCopyInto(out *ExternalRolloutProgress) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalRolloutProgress.
+func (in *ExternalRolloutProgress) DeepCopy() *ExternalRolloutProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalRolloutProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalRolloutProgress) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalRolloutProgressList) DeepCopyInto(out *ExternalRolloutProgressList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ExternalRolloutProgress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalRolloutProgressList.
+func (in *ExternalRolloutProgressList) DeepCopy() *ExternalRolloutProgressList {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalRolloutProgressList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ExternalRolloutProgressList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalRolloutProgressSpec) DeepCopyInto(out *ExternalRolloutProgressSpec) {
+	*out = *in
+	if in.Clusters != nil {
+		in, out := &in.Clusters, &out.Clusters
+		*out = make([]ClusterRolloutTarget, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalRolloutProgressSpec.
+func (in *ExternalRolloutProgressSpec) DeepCopy() *ExternalRolloutProgressSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalRolloutProgressSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExternalRolloutProgressStatus) DeepCopyInto(out *ExternalRolloutProgressStatus) {
+	*out = *in
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExternalRolloutProgressStatus.
+func (in *ExternalRolloutProgressStatus) DeepCopy() *ExternalRolloutProgressStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExternalRolloutProgressStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *FailedResourcePlacement) DeepCopyInto(out *FailedResourcePlacement) {
 	*out = *in
@@ -1483,6 +2239,35 @@ func (in *ManifestCondition) DeepCopy() *ManifestCondition {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *MetadataInjectionPolicy) DeepCopyInto(out *MetadataInjectionPolicy) {
+	*out = *in
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Annotations != nil {
+		in, out := &in.Annotations, &out.Annotations
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new MetadataInjectionPolicy.
+func (in *MetadataInjectionPolicy) DeepCopy() *MetadataInjectionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(MetadataInjectionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NamespacedName) DeepCopyInto(out *NamespacedName) {
 	*out = *in
@@ -1520,6 +2305,21 @@ func (in *OverridePolicy) DeepCopy() *OverridePolicy {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverrideRenderFailure) DeepCopyInto(out *OverrideRenderFailure) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverrideRenderFailure.
+func (in *OverrideRenderFailure) DeepCopy() *OverrideRenderFailure {
+	if in == nil {
+		return nil
+	}
+	out := new(OverrideRenderFailure)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *OverrideRule) DeepCopyInto(out *OverrideRule) {
 	*out = *in
@@ -1547,6 +2347,33 @@ func (in *OverrideRule) DeepCopy() *OverrideRule {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *OverrideStatus) DeepCopyInto(out *OverrideStatus) {
+	*out = *in
+	if in.RenderFailures != nil {
+		in, out := &in.RenderFailures, &out.RenderFailures
+		*out = make([]OverrideRenderFailure, len(*in))
+		copy(*out, *in)
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new OverrideStatus.
+func (in *OverrideStatus) DeepCopy() *OverrideStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(OverrideStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PatchDetail) DeepCopyInto(out *PatchDetail) {
 	*out = *in
@@ -1575,6 +2402,11 @@ func (in *PerClusterPlacementStatus) DeepCopyInto(out *PerClusterPlacementStatus
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.EffectiveOverrides != nil {
+		in, out := &in.EffectiveOverrides, &out.EffectiveOverrides
+		*out = make([]EffectiveOverride, len(*in))
+		copy(*out, *in)
+	}
 	if in.FailedPlacements != nil {
 		in, out := &in.FailedPlacements, &out.FailedPlacements
 		*out = make([]FailedResourcePlacement, len(*in))
@@ -1596,6 +2428,11 @@ func (in *PerClusterPlacementStatus) DeepCopyInto(out *PerClusterPlacementStatus
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DiffedPlacementSummary != nil {
+		in, out := &in.DiffedPlacementSummary, &out.DiffedPlacementSummary
+		*out = new(DiffedPlacementSummary)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -1615,6 +2452,46 @@ func (in *PerClusterPlacementStatus) DeepCopy() *PerClusterPlacementStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PerClusterRolloutProgress) DeepCopyInto(out *PerClusterRolloutProgress) {
+	*out = *in
+	if in.DesiredOverrideSnapshots != nil {
+		in, out := &in.DesiredOverrideSnapshots, &out.DesiredOverrideSnapshots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.AppliedOverrideSnapshots != nil {
+		in, out := &in.AppliedOverrideSnapshots, &out.AppliedOverrideSnapshots
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PerClusterRolloutProgress.
+func (in *PerClusterRolloutProgress) DeepCopy() *PerClusterRolloutProgress {
+	if in == nil {
+		return nil
+	}
+	out := new(PerClusterRolloutProgress)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementAffinity) DeepCopyInto(out *PlacementAffinity) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementAffinity.
+func (in *PlacementAffinity) DeepCopy() *PlacementAffinity {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementAffinity)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlacementDisruptionBudgetSpec) DeepCopyInto(out *PlacementDisruptionBudgetSpec) {
 	*out = *in
@@ -1677,6 +2554,21 @@ func (in *PlacementEvictionStatus) DeepCopy() *PlacementEvictionStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementOperationResult) DeepCopyInto(out *PlacementOperationResult) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementOperationResult.
+func (in *PlacementOperationResult) DeepCopy() *PlacementOperationResult {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementOperationResult)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PlacementPolicy) DeepCopyInto(out *PlacementPolicy) {
 	*out = *in
@@ -1702,19 +2594,99 @@ func (in *PlacementPolicy) DeepCopyInto(out *PlacementPolicy) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
-	if in.Tolerations != nil {
-		in, out := &in.Tolerations, &out.Tolerations
-		*out = make([]Toleration, len(*in))
-		copy(*out, *in)
+	if in.Tolerations != nil {
+		in, out := &in.Tolerations, &out.Tolerations
+		*out = make([]Toleration, len(*in))
+		copy(*out, *in)
+	}
+	if in.EstimatedResourceRequests != nil {
+		in, out := &in.EstimatedResourceRequests, &out.EstimatedResourceRequests
+		*out = make(corev1.ResourceList, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val.DeepCopy()
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPolicy.
+func (in *PlacementPolicy) DeepCopy() *PlacementPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementPriorityClass) DeepCopyInto(out *PlacementPriorityClass) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPriorityClass.
+func (in *PlacementPriorityClass) DeepCopy() *PlacementPriorityClass {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementPriorityClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlacementPriorityClass) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementPriorityClassList) DeepCopyInto(out *PlacementPriorityClassList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]PlacementPriorityClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPriorityClassList.
+func (in *PlacementPriorityClassList) DeepCopy() *PlacementPriorityClassList {
+	if in == nil {
+		return nil
+	}
+	out := new(PlacementPriorityClassList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *PlacementPriorityClassList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
 	}
+	return nil
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPolicy.
-func (in *PlacementPolicy) DeepCopy() *PlacementPolicy {
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PlacementPriorityClassSpec) DeepCopyInto(out *PlacementPriorityClassSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementPriorityClassSpec.
+func (in *PlacementPriorityClassSpec) DeepCopy() *PlacementPriorityClassSpec {
 	if in == nil {
 		return nil
 	}
-	out := new(PlacementPolicy)
+	out := new(PlacementPriorityClassSpec)
 	in.DeepCopyInto(out)
 	return out
 }
@@ -1750,11 +2722,51 @@ func (in *PlacementSpec) DeepCopyInto(out *PlacementSpec) {
 		(*in).DeepCopyInto(*out)
 	}
 	in.Strategy.DeepCopyInto(&out.Strategy)
+	if in.MinSnapshotIntervalSeconds != nil {
+		in, out := &in.MinSnapshotIntervalSeconds, &out.MinSnapshotIntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
 	if in.RevisionHistoryLimit != nil {
 		in, out := &in.RevisionHistoryLimit, &out.RevisionHistoryLimit
 		*out = new(int32)
 		**out = **in
 	}
+	if in.PolicyRevisionHistoryLimit != nil {
+		in, out := &in.PolicyRevisionHistoryLimit, &out.PolicyRevisionHistoryLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.ReadinessPolicy != nil {
+		in, out := &in.ReadinessPolicy, &out.ReadinessPolicy
+		*out = new(ReadinessPolicy)
+		**out = **in
+	}
+	if in.MetadataInjection != nil {
+		in, out := &in.MetadataInjection, &out.MetadataInjection
+		*out = new(MetadataInjectionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ResourceNameTransform != nil {
+		in, out := &in.ResourceNameTransform, &out.ResourceNameTransform
+		*out = new(ResourceNameTransform)
+		**out = **in
+	}
+	if in.FailedPlacementsLimit != nil {
+		in, out := &in.FailedPlacementsLimit, &out.FailedPlacementsLimit
+		*out = new(int32)
+		**out = **in
+	}
+	if in.DependsOn != nil {
+		in, out := &in.DependsOn, &out.DependsOn
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.SchedulingRateLimit != nil {
+		in, out := &in.SchedulingRateLimit, &out.SchedulingRateLimit
+		*out = new(SchedulingRateLimit)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PlacementSpec.
@@ -1784,6 +2796,18 @@ func (in *PlacementStatus) DeepCopyInto(out *PlacementStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.PerClusterRolloutProgress != nil {
+		in, out := &in.PerClusterRolloutProgress, &out.PerClusterRolloutProgress
+		*out = make([]PerClusterRolloutProgress, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.RolloutImpactEstimate != nil {
+		in, out := &in.RolloutImpactEstimate, &out.RolloutImpactEstimate
+		*out = new(RolloutImpactEstimate)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -1803,6 +2827,27 @@ func (in *PlacementStatus) DeepCopy() *PlacementStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PreDeleteProbe) DeepCopyInto(out *PreDeleteProbe) {
+	*out = *in
+	in.Resource.DeepCopyInto(&out.Resource)
+	if in.TimeoutSeconds != nil {
+		in, out := &in.TimeoutSeconds, &out.TimeoutSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PreDeleteProbe.
+func (in *PreDeleteProbe) DeepCopy() *PreDeleteProbe {
+	if in == nil {
+		return nil
+	}
+	out := new(PreDeleteProbe)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *PreferredClusterSelector) DeepCopyInto(out *PreferredClusterSelector) {
 	*out = *in
@@ -1876,6 +2921,21 @@ func (in *PropertySorter) DeepCopy() *PropertySorter {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ReadinessPolicy) DeepCopyInto(out *ReadinessPolicy) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ReadinessPolicy.
+func (in *ReadinessPolicy) DeepCopy() *ReadinessPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(ReadinessPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ReportBackStrategy) DeepCopyInto(out *ReportBackStrategy) {
 	*out = *in
@@ -1968,12 +3028,24 @@ func (in *ResourceBindingSpec) DeepCopyInto(out *ResourceBindingSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.ClusterPropertySnapshot != nil {
+		in, out := &in.ClusterPropertySnapshot, &out.ClusterPropertySnapshot
+		*out = new(ClusterPropertySnapshot)
+		(*in).DeepCopyInto(*out)
+	}
 	in.ClusterDecision.DeepCopyInto(&out.ClusterDecision)
 	if in.ApplyStrategy != nil {
 		in, out := &in.ApplyStrategy, &out.ApplyStrategy
 		*out = new(ApplyStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ApplyStrategyOverrides != nil {
+		in, out := &in.ApplyStrategyOverrides, &out.ApplyStrategyOverrides
+		*out = make([]ApplyStrategyOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceBindingSpec.
@@ -2010,6 +3082,33 @@ func (in *ResourceBindingStatus) DeepCopyInto(out *ResourceBindingStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.DiffedPlacementSummary != nil {
+		in, out := &in.DiffedPlacementSummary, &out.DiffedPlacementSummary
+		*out = new(DiffedPlacementSummary)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.DiffReport != nil {
+		in, out := &in.DiffReport, &out.DiffReport
+		*out = new(DiffReportReference)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.History != nil {
+		in, out := &in.History, &out.History
+		*out = make([]BindingHistoryEntry, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.LastAppliedSnapshots != nil {
+		in, out := &in.LastAppliedSnapshots, &out.LastAppliedSnapshots
+		*out = new(BindingSnapshots)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.EffectiveOverrides != nil {
+		in, out := &in.EffectiveOverrides, &out.EffectiveOverrides
+		*out = make([]EffectiveOverride, len(*in))
+		copy(*out, *in)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -2057,6 +3156,13 @@ func (in *ResourceEnvelope) DeepCopyInto(out *ResourceEnvelope) {
 			(*out)[key] = *val.DeepCopy()
 		}
 	}
+	if in.SecretRefs != nil {
+		in, out := &in.SecretRefs, &out.SecretRefs
+		*out = make(map[string]EnvelopeSecretReference, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceEnvelope.
@@ -2129,12 +3235,43 @@ func (in *ResourceIdentifier) DeepCopy() *ResourceIdentifier {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceKindCount) DeepCopyInto(out *ResourceKindCount) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceKindCount.
+func (in *ResourceKindCount) DeepCopy() *ResourceKindCount {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceKindCount)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceNameTransform) DeepCopyInto(out *ResourceNameTransform) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceNameTransform.
+func (in *ResourceNameTransform) DeepCopy() *ResourceNameTransform {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceNameTransform)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ResourceOverride) DeepCopyInto(out *ResourceOverride) {
 	*out = *in
 	out.TypeMeta = in.TypeMeta
 	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
 	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceOverride.
@@ -2459,6 +3596,11 @@ func (in *ResourceSnapshotSpec) DeepCopyInto(out *ResourceSnapshotSpec) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.SelectedResourceContentNames != nil {
+		in, out := &in.SelectedResourceContentNames, &out.SelectedResourceContentNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceSnapshotSpec.
@@ -2506,6 +3648,11 @@ func (in *RollingUpdateConfig) DeepCopyInto(out *RollingUpdateConfig) {
 		*out = new(intstr.IntOrString)
 		**out = **in
 	}
+	if in.MaxFailedClustersPercentage != nil {
+		in, out := &in.MaxFailedClustersPercentage, &out.MaxFailedClustersPercentage
+		*out = new(int32)
+		**out = **in
+	}
 	if in.UnavailablePeriodSeconds != nil {
 		in, out := &in.UnavailablePeriodSeconds, &out.UnavailablePeriodSeconds
 		*out = new(int)
@@ -2523,6 +3670,52 @@ func (in *RollingUpdateConfig) DeepCopy() *RollingUpdateConfig {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutAnalysis) DeepCopyInto(out *RolloutAnalysis) {
+	*out = *in
+	if in.DurationSeconds != nil {
+		in, out := &in.DurationSeconds, &out.DurationSeconds
+		*out = new(int32)
+		**out = **in
+	}
+	if in.IntervalSeconds != nil {
+		in, out := &in.IntervalSeconds, &out.IntervalSeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutAnalysis.
+func (in *RolloutAnalysis) DeepCopy() *RolloutAnalysis {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutAnalysis)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RolloutImpactEstimate) DeepCopyInto(out *RolloutImpactEstimate) {
+	*out = *in
+	if in.ChangedKinds != nil {
+		in, out := &in.ChangedKinds, &out.ChangedKinds
+		*out = make([]ResourceKindCount, len(*in))
+		copy(*out, *in)
+	}
+	in.EstimationTime.DeepCopyInto(&out.EstimationTime)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutImpactEstimate.
+func (in *RolloutImpactEstimate) DeepCopy() *RolloutImpactEstimate {
+	if in == nil {
+		return nil
+	}
+	out := new(RolloutImpactEstimate)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
 	*out = *in
@@ -2536,6 +3729,13 @@ func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
 		*out = new(ApplyStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ApplyStrategyOverrides != nil {
+		in, out := &in.ApplyStrategyOverrides, &out.ApplyStrategyOverrides
+		*out = make([]ApplyStrategyOverride, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.DeleteStrategy != nil {
 		in, out := &in.DeleteStrategy, &out.DeleteStrategy
 		*out = new(DeleteStrategy)
@@ -2546,6 +3746,16 @@ func (in *RolloutStrategy) DeepCopyInto(out *RolloutStrategy) {
 		*out = new(ReportBackStrategy)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.PreDeleteProbe != nil {
+		in, out := &in.PreDeleteProbe, &out.PreDeleteProbe
+		*out = new(PreDeleteProbe)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Analysis != nil {
+		in, out := &in.Analysis, &out.Analysis
+		*out = new(RolloutAnalysis)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RolloutStrategy.
@@ -2671,6 +3881,136 @@ func (in *SchedulingPolicySnapshotStatus) DeepCopy() *SchedulingPolicySnapshotSt
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingProfile) DeepCopyInto(out *SchedulingProfile) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingProfile.
+func (in *SchedulingProfile) DeepCopy() *SchedulingProfile {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingProfile)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchedulingProfile) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingProfileList) DeepCopyInto(out *SchedulingProfileList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]SchedulingProfile, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingProfileList.
+func (in *SchedulingProfileList) DeepCopy() *SchedulingProfileList {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingProfileList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *SchedulingProfileList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingProfileSpec) DeepCopyInto(out *SchedulingProfileSpec) {
+	*out = *in
+	if in.ScorePlugins != nil {
+		in, out := &in.ScorePlugins, &out.ScorePlugins
+		*out = make([]ScorePluginConfig, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.MinimumScore != nil {
+		in, out := &in.MinimumScore, &out.MinimumScore
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingProfileSpec.
+func (in *SchedulingProfileSpec) DeepCopy() *SchedulingProfileSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingProfileSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SchedulingRateLimit) DeepCopyInto(out *SchedulingRateLimit) {
+	*out = *in
+	if in.MaxDelaySeconds != nil {
+		in, out := &in.MaxDelaySeconds, &out.MaxDelaySeconds
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SchedulingRateLimit.
+func (in *SchedulingRateLimit) DeepCopy() *SchedulingRateLimit {
+	if in == nil {
+		return nil
+	}
+	out := new(SchedulingRateLimit)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScorePluginConfig) DeepCopyInto(out *ScorePluginConfig) {
+	*out = *in
+	if in.Enabled != nil {
+		in, out := &in.Enabled, &out.Enabled
+		*out = new(bool)
+		**out = **in
+	}
+	if in.Weight != nil {
+		in, out := &in.Weight, &out.Weight
+		*out = new(int32)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScorePluginConfig.
+func (in *ScorePluginConfig) DeepCopy() *ScorePluginConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(ScorePluginConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ServerSideApplyConfig) DeepCopyInto(out *ServerSideApplyConfig) {
 	*out = *in
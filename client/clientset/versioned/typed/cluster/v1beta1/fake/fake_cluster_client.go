@@ -0,0 +1,47 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/kubefleet-dev/kubefleet/client/clientset/versioned/typed/cluster/v1beta1"
+	rest "k8s.io/client-go/rest"
+	testing "k8s.io/client-go/testing"
+)
+
+type FakeClusterV1beta1 struct {
+	*testing.Fake
+}
+
+func (c *FakeClusterV1beta1) InternalMemberClusters(namespace string) v1beta1.InternalMemberClusterInterface {
+	return newFakeInternalMemberClusters(c, namespace)
+}
+
+func (c *FakeClusterV1beta1) MemberClusters() v1beta1.MemberClusterInterface {
+	return newFakeMemberClusters(c)
+}
+
+func (c *FakeClusterV1beta1) PropertyCatalogs() v1beta1.PropertyCatalogInterface {
+	return newFakePropertyCatalogs(c)
+}
+
+// RESTClient returns a RESTClient that is used to communicate
+// with API server by this client implementation.
+func (c *FakeClusterV1beta1) RESTClient() rest.Interface {
+	var ret *rest.RESTClient
+	return ret
+}
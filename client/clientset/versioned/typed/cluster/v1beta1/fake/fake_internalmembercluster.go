@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/client/clientset/versioned/typed/cluster/v1beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeInternalMemberClusters implements InternalMemberClusterInterface
+type fakeInternalMemberClusters struct {
+	*gentype.FakeClientWithList[*v1beta1.InternalMemberCluster, *v1beta1.InternalMemberClusterList]
+	Fake *FakeClusterV1beta1
+}
+
+func newFakeInternalMemberClusters(fake *FakeClusterV1beta1, namespace string) clusterv1beta1.InternalMemberClusterInterface {
+	return &fakeInternalMemberClusters{
+		gentype.NewFakeClientWithList[*v1beta1.InternalMemberCluster, *v1beta1.InternalMemberClusterList](
+			fake.Fake,
+			namespace,
+			v1beta1.SchemeGroupVersion.WithResource("internalmemberclusters"),
+			v1beta1.SchemeGroupVersion.WithKind("InternalMemberCluster"),
+			func() *v1beta1.InternalMemberCluster { return &v1beta1.InternalMemberCluster{} },
+			func() *v1beta1.InternalMemberClusterList { return &v1beta1.InternalMemberClusterList{} },
+			func(dst, src *v1beta1.InternalMemberClusterList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.InternalMemberClusterList) []*v1beta1.InternalMemberCluster {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.InternalMemberClusterList, items []*v1beta1.InternalMemberCluster) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/client/clientset/versioned/typed/cluster/v1beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeMemberClusters implements MemberClusterInterface
+type fakeMemberClusters struct {
+	*gentype.FakeClientWithList[*v1beta1.MemberCluster, *v1beta1.MemberClusterList]
+	Fake *FakeClusterV1beta1
+}
+
+func newFakeMemberClusters(fake *FakeClusterV1beta1) clusterv1beta1.MemberClusterInterface {
+	return &fakeMemberClusters{
+		gentype.NewFakeClientWithList[*v1beta1.MemberCluster, *v1beta1.MemberClusterList](
+			fake.Fake,
+			"",
+			v1beta1.SchemeGroupVersion.WithResource("memberclusters"),
+			v1beta1.SchemeGroupVersion.WithKind("MemberCluster"),
+			func() *v1beta1.MemberCluster { return &v1beta1.MemberCluster{} },
+			func() *v1beta1.MemberClusterList { return &v1beta1.MemberClusterList{} },
+			func(dst, src *v1beta1.MemberClusterList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.MemberClusterList) []*v1beta1.MemberCluster {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.MemberClusterList, items []*v1beta1.MemberCluster) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/client/clientset/versioned/typed/cluster/v1beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakePropertyCatalogs implements PropertyCatalogInterface
+type fakePropertyCatalogs struct {
+	*gentype.FakeClientWithList[*v1beta1.PropertyCatalog, *v1beta1.PropertyCatalogList]
+	Fake *FakeClusterV1beta1
+}
+
+func newFakePropertyCatalogs(fake *FakeClusterV1beta1) clusterv1beta1.PropertyCatalogInterface {
+	return &fakePropertyCatalogs{
+		gentype.NewFakeClientWithList[*v1beta1.PropertyCatalog, *v1beta1.PropertyCatalogList](
+			fake.Fake,
+			"",
+			v1beta1.SchemeGroupVersion.WithResource("propertycatalogs"),
+			v1beta1.SchemeGroupVersion.WithKind("PropertyCatalog"),
+			func() *v1beta1.PropertyCatalog { return &v1beta1.PropertyCatalog{} },
+			func() *v1beta1.PropertyCatalogList { return &v1beta1.PropertyCatalogList{} },
+			func(dst, src *v1beta1.PropertyCatalogList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.PropertyCatalogList) []*v1beta1.PropertyCatalog {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.PropertyCatalogList, items []*v1beta1.PropertyCatalog) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/client/clientset/versioned/typed/placement/v1beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeClusterResourceContents implements ClusterResourceContentInterface
+type fakeClusterResourceContents struct {
+	*gentype.FakeClientWithList[*v1beta1.ClusterResourceContent, *v1beta1.ClusterResourceContentList]
+	Fake *FakePlacementV1beta1
+}
+
+func newFakeClusterResourceContents(fake *FakePlacementV1beta1) placementv1beta1.ClusterResourceContentInterface {
+	return &fakeClusterResourceContents{
+		gentype.NewFakeClientWithList[*v1beta1.ClusterResourceContent, *v1beta1.ClusterResourceContentList](
+			fake.Fake,
+			"",
+			v1beta1.SchemeGroupVersion.WithResource("clusterresourcecontents"),
+			v1beta1.SchemeGroupVersion.WithKind("ClusterResourceContent"),
+			func() *v1beta1.ClusterResourceContent { return &v1beta1.ClusterResourceContent{} },
+			func() *v1beta1.ClusterResourceContentList { return &v1beta1.ClusterResourceContentList{} },
+			func(dst, src *v1beta1.ClusterResourceContentList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.ClusterResourceContentList) []*v1beta1.ClusterResourceContent {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.ClusterResourceContentList, items []*v1beta1.ClusterResourceContent) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,49 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/client/clientset/versioned/typed/placement/v1beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeDiffReports implements DiffReportInterface
+type fakeDiffReports struct {
+	*gentype.FakeClientWithList[*v1beta1.DiffReport, *v1beta1.DiffReportList]
+	Fake *FakePlacementV1beta1
+}
+
+func newFakeDiffReports(fake *FakePlacementV1beta1, namespace string) placementv1beta1.DiffReportInterface {
+	return &fakeDiffReports{
+		gentype.NewFakeClientWithList[*v1beta1.DiffReport, *v1beta1.DiffReportList](
+			fake.Fake,
+			namespace,
+			v1beta1.SchemeGroupVersion.WithResource("diffreports"),
+			v1beta1.SchemeGroupVersion.WithKind("DiffReport"),
+			func() *v1beta1.DiffReport { return &v1beta1.DiffReport{} },
+			func() *v1beta1.DiffReportList { return &v1beta1.DiffReportList{} },
+			func(dst, src *v1beta1.DiffReportList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.DiffReportList) []*v1beta1.DiffReport { return gentype.ToPointerSlice(list.Items) },
+			func(list *v1beta1.DiffReportList, items []*v1beta1.DiffReport) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
@@ -0,0 +1,51 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by client-gen. DO NOT EDIT.
+
+package fake
+
+import (
+	v1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/client/clientset/versioned/typed/placement/v1beta1"
+	gentype "k8s.io/client-go/gentype"
+)
+
+// fakeSchedulingProfiles implements SchedulingProfileInterface
+type fakeSchedulingProfiles struct {
+	*gentype.FakeClientWithList[*v1beta1.SchedulingProfile, *v1beta1.SchedulingProfileList]
+	Fake *FakePlacementV1beta1
+}
+
+func newFakeSchedulingProfiles(fake *FakePlacementV1beta1) placementv1beta1.SchedulingProfileInterface {
+	return &fakeSchedulingProfiles{
+		gentype.NewFakeClientWithList[*v1beta1.SchedulingProfile, *v1beta1.SchedulingProfileList](
+			fake.Fake,
+			"",
+			v1beta1.SchemeGroupVersion.WithResource("schedulingprofiles"),
+			v1beta1.SchemeGroupVersion.WithKind("SchedulingProfile"),
+			func() *v1beta1.SchedulingProfile { return &v1beta1.SchedulingProfile{} },
+			func() *v1beta1.SchedulingProfileList { return &v1beta1.SchedulingProfileList{} },
+			func(dst, src *v1beta1.SchedulingProfileList) { dst.ListMeta = src.ListMeta },
+			func(list *v1beta1.SchedulingProfileList) []*v1beta1.SchedulingProfile {
+				return gentype.ToPointerSlice(list.Items)
+			},
+			func(list *v1beta1.SchedulingProfileList, items []*v1beta1.SchedulingProfile) {
+				list.Items = gentype.FromPointerSlice(items)
+			},
+		),
+		fake,
+	}
+}
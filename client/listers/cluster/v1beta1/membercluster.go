@@ -0,0 +1,47 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// MemberClusterLister helps list MemberClusters.
+// All objects returned here must be treated as read-only.
+type MemberClusterLister interface {
+	// List lists all MemberClusters in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*clusterv1beta1.MemberCluster, err error)
+	// Get retrieves the MemberCluster from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*clusterv1beta1.MemberCluster, error)
+	MemberClusterListerExpansion
+}
+
+// memberClusterLister implements the MemberClusterLister interface.
+type memberClusterLister struct {
+	listers.ResourceIndexer[*clusterv1beta1.MemberCluster]
+}
+
+// NewMemberClusterLister returns a new MemberClusterLister.
+func NewMemberClusterLister(indexer cache.Indexer) MemberClusterLister {
+	return &memberClusterLister{listers.New[*clusterv1beta1.MemberCluster](indexer, clusterv1beta1.Resource("membercluster"))}
+}
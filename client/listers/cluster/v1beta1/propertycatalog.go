@@ -0,0 +1,47 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// PropertyCatalogLister helps list PropertyCatalogs.
+// All objects returned here must be treated as read-only.
+type PropertyCatalogLister interface {
+	// List lists all PropertyCatalogs in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*clusterv1beta1.PropertyCatalog, err error)
+	// Get retrieves the PropertyCatalog from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*clusterv1beta1.PropertyCatalog, error)
+	PropertyCatalogListerExpansion
+}
+
+// propertyCatalogLister implements the PropertyCatalogLister interface.
+type propertyCatalogLister struct {
+	listers.ResourceIndexer[*clusterv1beta1.PropertyCatalog]
+}
+
+// NewPropertyCatalogLister returns a new PropertyCatalogLister.
+func NewPropertyCatalogLister(indexer cache.Indexer) PropertyCatalogLister {
+	return &propertyCatalogLister{listers.New[*clusterv1beta1.PropertyCatalog](indexer, clusterv1beta1.Resource("propertycatalog"))}
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+// Code generated by lister-gen. DO NOT EDIT.
+
+package v1beta1
+
+import (
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	labels "k8s.io/apimachinery/pkg/labels"
+	listers "k8s.io/client-go/listers"
+	cache "k8s.io/client-go/tools/cache"
+)
+
+// SchedulingProfileLister helps list SchedulingProfiles.
+// All objects returned here must be treated as read-only.
+type SchedulingProfileLister interface {
+	// List lists all SchedulingProfiles in the indexer.
+	// Objects returned here must be treated as read-only.
+	List(selector labels.Selector) (ret []*placementv1beta1.SchedulingProfile, err error)
+	// Get retrieves the SchedulingProfile from the index for a given name.
+	// Objects returned here must be treated as read-only.
+	Get(name string) (*placementv1beta1.SchedulingProfile, error)
+	SchedulingProfileListerExpansion
+}
+
+// schedulingProfileLister implements the SchedulingProfileLister interface.
+type schedulingProfileLister struct {
+	listers.ResourceIndexer[*placementv1beta1.SchedulingProfile]
+}
+
+// NewSchedulingProfileLister returns a new SchedulingProfileLister.
+func NewSchedulingProfileLister(indexer cache.Indexer) SchedulingProfileLister {
+	return &schedulingProfileLister{listers.New[*placementv1beta1.SchedulingProfile](indexer, placementv1beta1.Resource("schedulingprofile"))}
+}
@@ -33,6 +33,7 @@ import (
 	clusterinventory "sigs.k8s.io/cluster-inventory-api/apis/v1alpha1"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	ctrlcfg "sigs.k8s.io/controller-runtime/pkg/config"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
@@ -41,12 +42,18 @@ import (
 
 	fleetnetworkingv1alpha1 "go.goms.io/fleet-networking/api/v1alpha1"
 
+	clusterv1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1"
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1"
 	placementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1alpha1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/cmd/hubagent/options"
 	"github.com/kubefleet-dev/kubefleet/cmd/hubagent/workload"
+	"github.com/kubefleet-dev/kubefleet/pkg/aggregatedapi"
 	mcv1beta1 "github.com/kubefleet-dev/kubefleet/pkg/controllers/membercluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/externalmetrics"
+	"github.com/kubefleet-dev/kubefleet/pkg/placementview"
+	"github.com/kubefleet-dev/kubefleet/pkg/tunnel"
 	readiness "github.com/kubefleet-dev/kubefleet/pkg/utils/informer/readiness"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/validator"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook"
@@ -67,12 +74,18 @@ var (
 
 const (
 	FleetWebhookPort = 9443
+	// FleetAggregatedAPIPort is the port of the server that serves the APIs registered with the API
+	// aggregation layer, i.e., the external metrics API and the placement view API.
+	FleetAggregatedAPIPort = 8444
 )
 
 func init() {
 	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
 	utilruntime.Must(placementv1beta1.AddToScheme(scheme))
 	utilruntime.Must(clusterv1beta1.AddToScheme(scheme))
+	// The v1 API versions are registered for the conversion webhook.
+	utilruntime.Must(placementv1.AddToScheme(scheme))
+	utilruntime.Must(clusterv1.AddToScheme(scheme))
 	utilruntime.Must(apiextensionsv1.AddToScheme(scheme))
 	utilruntime.Must(fleetnetworkingv1alpha1.AddToScheme(scheme))
 	utilruntime.Must(placementv1alpha1.AddToScheme(scheme))
@@ -121,6 +134,11 @@ func main() {
 			SyncPeriod:       &opts.CtrlMgrOpts.ResyncPeriod.Duration,
 			DefaultTransform: cache.TransformStripManagedFields(),
 		},
+		// Controllers without a dedicated concurrency setting, most notably the watchers, share the same
+		// number of concurrent workers.
+		Controller: ctrlcfg.Controller{
+			MaxConcurrentReconciles: opts.PlacementMgmtOpts.WatcherWorkers,
+		},
 		LeaderElection:       opts.LeaderElectionOpts.LeaderElect,
 		LeaderElectionConfig: leaderElectionCfg,
 		// If leader election is enabled, the hub agent by default uses a setup
@@ -197,6 +215,44 @@ func main() {
 			exitWithErrorFunc()
 		}
 
+		// The aggregated APIs are served on their own port with the serving certificates of the webhook
+		// server; the server accepts only the requests proxied by the API aggregation layer, and the
+		// handlers authorize the users on whose behalf the requests are sent via SubjectAccessReviews.
+		aggregatedAPIServer := aggregatedapi.NewServer(fmt.Sprintf(":%d", FleetAggregatedAPIPort), webhook.FleetWebhookCertDir, mgr.GetAPIReader())
+		aggregatedAPIAuthorizer := aggregatedapi.NewSubjectAccessReviewAuthorizer(mgr.GetClient())
+		var apiServiceNames []string
+
+		if opts.FeatureFlags.EnableExternalMetricsAPI {
+			klog.Info("Setting up the external metrics API")
+			metricsHandler := externalmetrics.NewHandler(mgr.GetClient(), aggregatedAPIAuthorizer, opts.FeatureFlags.EnableResourcePlacementAPIs)
+			aggregatedAPIServer.Register(externalmetrics.APIPath, metricsHandler)
+			aggregatedAPIServer.Register(externalmetrics.APIPath+"/", metricsHandler)
+			apiServiceNames = append(apiServiceNames, externalmetrics.APIServiceName)
+		}
+
+		if opts.FeatureFlags.EnablePlacementViewAPI {
+			// The placement views are read from the informer caches of the hub agent.
+			klog.Info("Setting up the placement view API")
+			viewHandler := placementview.NewHandler(mgr.GetClient(), aggregatedAPIAuthorizer, opts.FeatureFlags.EnableResourcePlacementAPIs)
+			aggregatedAPIServer.Register(placementview.APIPath, viewHandler)
+			aggregatedAPIServer.Register(placementview.APIPath+"/", viewHandler)
+			apiServiceNames = append(apiServiceNames, placementview.APIServiceName)
+		}
+
+		if len(apiServiceNames) > 0 {
+			if err := mgr.Add(aggregatedAPIServer); err != nil {
+				klog.ErrorS(err, "unable to add the aggregated API server")
+				exitWithErrorFunc()
+			}
+			if !opts.WebhookOpts.UseCertManager {
+				// cert-manager injects the CA bundle into the APIService objects otherwise.
+				if err := mgr.Add(aggregatedapi.NewCABundleInjector(mgr.GetClient(), webhookConfig.CABundle(), apiServiceNames...)); err != nil {
+					klog.ErrorS(err, "unable to add the CA bundle injector of the aggregated APIs")
+					exitWithErrorFunc()
+				}
+			}
+		}
+
 		// Add webhook server readiness check to ensure the pod is not marked ready until
 		// the webhook HTTPS listener is actually serving. This uses controller-runtime's
 		// built-in StartedChecker which verifies the server is listening on the port.
@@ -219,6 +275,21 @@ func main() {
 		}
 	}
 
+	if opts.ClusterMgmtOpts.EnableReverseTunnel {
+		// The reverse tunnel server accepts tunnels initiated by member agents, through which the hub agent
+		// can reach the API servers of member clusters.
+		tunnelServer := tunnel.NewServer(
+			opts.ClusterMgmtOpts.ReverseTunnelBindAddress,
+			opts.ClusterMgmtOpts.ReverseTunnelTLSCertFile,
+			opts.ClusterMgmtOpts.ReverseTunnelTLSKeyFile,
+			tunnel.NewTokenReviewAuthenticator(mgr.GetClient()),
+		)
+		if err := mgr.Add(tunnelServer); err != nil {
+			klog.ErrorS(err, "unable to set up the reverse tunnel server")
+			exitWithErrorFunc()
+		}
+	}
+
 	ctx := ctrl.SetupSignalHandler()
 	if err := workload.SetupControllers(ctx, &wg, mgr, defaultCfg, opts); err != nil {
 		klog.ErrorS(err, "unable to set up controllers")
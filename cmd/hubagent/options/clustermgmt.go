@@ -38,6 +38,21 @@ type ClusterManagementOptions struct {
 	// The duration the KubeFleet hub agent will wait before force-deleting a member cluster resource after it has been
 	// marked for deletion.
 	ForceDeleteWaitTime metav1.Duration
+
+	// Enable the reverse tunnel server or not. If set to true, the hub agent accepts tunnels initiated
+	// by member agents, through which it can reach the API servers of member clusters without inbound
+	// connectivity to the member clusters.
+	EnableReverseTunnel bool
+
+	// The TCP address that the reverse tunnel server binds to. This option only applies if the
+	// reverse tunnel server is enabled.
+	ReverseTunnelBindAddress string
+
+	// The paths to the TLS certificate and key files with which the reverse tunnel server serves; they
+	// are required if the reverse tunnel server is enabled, as the member agents authenticate themselves
+	// with bearer tokens.
+	ReverseTunnelTLSCertFile string
+	ReverseTunnelTLSKeyFile  string
 }
 
 // AddFlags adds flags for ClusterManagementOptions to the specified FlagSet.
@@ -60,6 +75,34 @@ func (o *ClusterManagementOptions) AddFlags(flags *flag.FlagSet) {
 		"force-delete-wait-time",
 		"The duration the KubeFleet hub agent will wait before force-deleting a member cluster resource after it has been marked for deletion. Defaults to 15 minutes. Must be a duration in the range [30s, 1h].",
 	)
+
+	flags.BoolVar(
+		&o.EnableReverseTunnel,
+		"enable-reverse-tunnel",
+		false,
+		"Enable the reverse tunnel server or not. If set to true, the hub agent accepts tunnels initiated by member agents, through which it can reach the API servers of member clusters without inbound connectivity to the member clusters.",
+	)
+
+	flags.StringVar(
+		&o.ReverseTunnelBindAddress,
+		"reverse-tunnel-bind-address",
+		":8445",
+		"The TCP address that the reverse tunnel server binds to. This option only applies if the reverse tunnel server is enabled.",
+	)
+
+	flags.StringVar(
+		&o.ReverseTunnelTLSCertFile,
+		"reverse-tunnel-tls-cert-file",
+		"",
+		"The path to the TLS certificate file with which the reverse tunnel server serves. This option is required if the reverse tunnel server is enabled.",
+	)
+
+	flags.StringVar(
+		&o.ReverseTunnelTLSKeyFile,
+		"reverse-tunnel-tls-key-file",
+		"",
+		"The path to the TLS key file with which the reverse tunnel server serves. This option is required if the reverse tunnel server is enabled.",
+	)
 }
 
 // A list of flag variables that allow pluggable validation logic when parsing the input args.
@@ -20,9 +20,16 @@ import (
 	"flag"
 	"fmt"
 	"strconv"
+	"strings"
 	"time"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+const (
+	// fleetAPIGroupSuffix is the suffix of the API groups of the KubeFleet APIs.
+	fleetAPIGroupSuffix = "kubernetes-fleet.io"
 )
 
 // ControllerManagerOptions is a set of options the KubeFleet hub agent exposes for
@@ -52,6 +59,11 @@ type ControllerManagerOptions struct {
 
 	// The duration for the informers in the controller manager to resync.
 	ResyncPeriod metav1.Duration
+
+	// The resources (e.g., high-churn resources such as pods and deployments) whose status is stripped
+	// from the objects kept in the informer caches of the hub agent, to cut its memory usage on large
+	// fleets. The status of the selected resources is never placed on member clusters.
+	CacheStatusStrippedResources []schema.GroupResource
 }
 
 // AddFlags adds flags for ControllerManagerOptions to the specified FlagSet.
@@ -90,6 +102,8 @@ func (o *ControllerManagerOptions) AddFlags(flags *flag.FlagSet) {
 	flags.Var(newHubBurstValueWithValidation(1000, &o.HubBurst), "hub-api-burst", "The burst limit set to the rate limiter of the Kubernetes client in use by the controller manager and all of its managed controller, for client-side throttling purposes. Defaults to 1000. Must be a positive value in the range [10, 20000], and it should be no less than the QPS limit.")
 
 	flags.Var(newResyncPeriodValueWithValidation(6*time.Hour, &o.ResyncPeriod), "resync-period", "The duration for the informers in the controller manager to resync. Defaults to 6 hours. Must be a duration in the range [1h, 12h].")
+
+	flags.Var(newGroupResourceListValueWithValidation(&o.CacheStatusStrippedResources), "cache-status-stripped-resources", "A comma-separated list of resources, in the resource.group format (e.g., 'pods,deployments.apps'), whose status is stripped from the objects kept in the informer caches of the hub agent to cut its memory usage. Defaults to none. The resources of the KubeFleet APIs cannot be specified.")
 }
 
 // A list of flag variables that allow pluggable validation logic when parsing the input args.
@@ -176,3 +190,35 @@ func newResyncPeriodValueWithValidation(defaultVal time.Duration, p *metav1.Dura
 	p.Duration = defaultVal
 	return (*ResyncPeriodValueWithValidation)(p)
 }
+
+type GroupResourceListValueWithValidation []schema.GroupResource
+
+func (v *GroupResourceListValueWithValidation) String() string {
+	grs := make([]string, 0, len(*v))
+	for _, gr := range *v {
+		grs = append(grs, gr.String())
+	}
+	return strings.Join(grs, ",")
+}
+
+func (v *GroupResourceListValueWithValidation) Set(s string) error {
+	var grs []schema.GroupResource
+	for _, item := range strings.Split(s, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) == 0 {
+			continue
+		}
+		gr := schema.ParseGroupResource(item)
+		// The status of the KubeFleet API objects is read from the caches by the hub agent itself.
+		if strings.HasSuffix(gr.Group, fleetAPIGroupSuffix) {
+			return fmt.Errorf("resource %s is of a KubeFleet API, whose status cannot be stripped", item)
+		}
+		grs = append(grs, gr)
+	}
+	*v = grs
+	return nil
+}
+
+func newGroupResourceListValueWithValidation(p *[]schema.GroupResource) *GroupResourceListValueWithValidation {
+	return (*GroupResourceListValueWithValidation)(p)
+}
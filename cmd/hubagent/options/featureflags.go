@@ -51,6 +51,68 @@ type FeatureFlags struct {
 	// ResourcePlacement APIs are a set of KubeFleet APIs for processing namespace scoped resource placements.
 	// This flag does not concern the cluster-scoped placement APIs (`ClusterResourcePlacement` and its related APIs).
 	EnableResourcePlacementAPIs bool
+
+	// Enable the ExternalRolloutProgress API support in the KubeFleet hub agent or not.
+	//
+	// ExternalRolloutProgress APIs are a set of KubeFleet APIs for external rollout controllers to report
+	// which resource snapshot each member cluster should run for placements of the External rollout strategy type.
+	EnableExternalRolloutProgressAPIs bool
+
+	// Enable the BulkPlacementOperation API support in the KubeFleet hub agent or not.
+	//
+	// BulkPlacementOperation APIs are a set of KubeFleet APIs for pausing, resuming, rolling back or
+	// retargeting many ClusterResourcePlacements at once.
+	EnableBulkPlacementOperationAPIs bool
+
+	// Enable the external metrics API (external.metrics.k8s.io) support in the KubeFleet hub agent or not.
+	//
+	// If enabled, the hub agent serves aggregated placement metrics, e.g., the fraction of selected clusters on
+	// which the resources of a placement are available, via the API aggregation layer, so that external automation
+	// (or a HorizontalPodAutoscaler) can react to the rollout health of the fleet. The API is served on its own port
+	// with the serving certificates of the webhook server, so the option requires webhooks to be enabled.
+	EnableExternalMetricsAPI bool
+
+	// Enable the placement view API (views.kubernetes-fleet.io) support in the KubeFleet hub agent or not.
+	//
+	// If enabled, the hub agent serves read-only, denormalized views of placements (placement -> clusters ->
+	// per-resource status) from its informer caches via the API aggregation layer, so that dashboards do not have
+	// to list the bindings of each placement against the API server. The API is served on its own port with the
+	// serving certificates of the webhook server, so the option requires webhooks to be enabled.
+	EnablePlacementViewAPI bool
+
+	// Enable the DiffReport API support in the KubeFleet hub agent or not.
+	//
+	// DiffReport APIs are a set of KubeFleet APIs that hold the complete details of the configuration drifts and
+	// differences found on the resources placed on member clusters, of which the binding status only keeps the first ones.
+	EnableDiffReportAPIs bool
+
+	// Enable the ClusterRolloutPolicy API support in the KubeFleet hub agent or not.
+	//
+	// ClusterRolloutPolicy APIs are a set of KubeFleet APIs that limit how many placements may roll out changes to
+	// the same member cluster at the same time.
+	EnableClusterRolloutPolicyAPIs bool
+
+	// Enable the SchedulingProfile API support in the KubeFleet hub agent or not.
+	//
+	// SchedulingProfile APIs are a set of KubeFleet APIs that allow placements to tune how the scheduler scores
+	// member clusters for them, e.g., the weights of the score plugins, by referring to a SchedulingProfile.
+	EnableSchedulingProfileAPIs bool
+
+	// Enable the PropertyCatalog API support in the KubeFleet hub agent or not.
+	//
+	// PropertyCatalog APIs are a set of KubeFleet APIs that list all the properties currently published by
+	// the member clusters, so that policy authors can discover the valid property names for cluster affinity terms.
+	EnablePropertyCatalogAPIs bool
+
+	// Enable the deduplication of resource snapshot contents in the KubeFleet hub agent or not.
+	//
+	// If enabled, the contents of the resources selected by ClusterResourcePlacements are kept in content-addressed
+	// ClusterResourceContent objects, which are shared across placements, and new resource snapshots refer to them
+	// by name instead of keeping copies of the contents; this reduces the storage footprint of the hub cluster when
+	// many placements select the same resources. The snapshots of ResourcePlacements keep their contents, so that
+	// the contents stay in the namespace of the placement. Unreferenced ClusterResourceContent objects are garbage collected
+	// by the orphaned resource janitor, which must be enabled.
+	EnableResourceContentDeduplication bool
 }
 
 // AddFlags adds flags for FeatureFlags to the specified FlagSet.
@@ -88,6 +150,69 @@ func (o *FeatureFlags) AddFlags(flags *flag.FlagSet) {
 		true,
 		"Enable the ResourcePlacement API support (for namespace-scoped placements) in the KubeFleet hub agent or not.",
 	)
+
+	flags.BoolVar(
+		&o.EnableExternalRolloutProgressAPIs,
+		"enable-external-rollout-progress-apis",
+		true,
+		"Enable the ExternalRolloutProgress API support in the KubeFleet hub agent or not.",
+	)
+
+	flags.BoolVar(
+		&o.EnableBulkPlacementOperationAPIs,
+		"enable-bulk-placement-operation-apis",
+		true,
+		"Enable the BulkPlacementOperation API support in the KubeFleet hub agent or not.",
+	)
+
+	flags.BoolVar(
+		&o.EnableExternalMetricsAPI,
+		"enable-external-metrics-api",
+		false,
+		"Enable the external metrics API (external.metrics.k8s.io) support in the KubeFleet hub agent or not. If enabled, the hub agent serves aggregated placement metrics via the API aggregation layer, with the serving certificates of the webhook server; webhooks must be enabled.",
+	)
+
+	flags.BoolVar(
+		&o.EnablePlacementViewAPI,
+		"enable-placement-view-api",
+		false,
+		"Enable the placement view API (views.kubernetes-fleet.io) support in the KubeFleet hub agent or not. If enabled, the hub agent serves read-only views of placements and their per-cluster, per-resource status via the API aggregation layer, with the serving certificates of the webhook server; webhooks must be enabled.",
+	)
+
+	flags.BoolVar(
+		&o.EnableDiffReportAPIs,
+		"enable-diff-report-apis",
+		true,
+		"Enable the DiffReport API support in the KubeFleet hub agent or not. If enabled, the complete details of the configuration drifts and differences of each binding are kept in DiffReport objects.",
+	)
+
+	flags.BoolVar(
+		&o.EnableClusterRolloutPolicyAPIs,
+		"enable-cluster-rollout-policy-apis",
+		true,
+		"Enable the ClusterRolloutPolicy API support in the KubeFleet hub agent or not. If enabled, the rollout controllers hold back the rollouts of a placement on member clusters that already have as many rollouts of other placements in progress as their ClusterRolloutPolicies allow.",
+	)
+
+	flags.BoolVar(
+		&o.EnableSchedulingProfileAPIs,
+		"enable-scheduling-profile-apis",
+		true,
+		"Enable the SchedulingProfile API support in the KubeFleet hub agent or not. If enabled, the scheduler scores member clusters for a placement per the SchedulingProfile that the placement refers to.",
+	)
+
+	flags.BoolVar(
+		&o.EnablePropertyCatalogAPIs,
+		"enable-property-catalog-apis",
+		true,
+		"Enable the PropertyCatalog API support in the KubeFleet hub agent or not. If enabled, the hub agent keeps a PropertyCatalog object that lists all the properties published by the member clusters.",
+	)
+
+	flags.BoolVar(
+		&o.EnableResourceContentDeduplication,
+		"enable-resource-content-deduplication",
+		false,
+		"Enable the deduplication of resource snapshot contents in the KubeFleet hub agent or not. If enabled, new resource snapshots of ClusterResourcePlacements refer to the contents of the selected resources kept in ClusterResourceContent objects shared across placements; the orphaned resource janitor must be enabled to garbage collect unreferenced contents.",
+	)
 }
 
 // A list of flag variables that allow pluggable validation logic when parsing the input args.
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"flag"
+	"time"
+)
+
+// NotificationOptions is a set of options the KubeFleet hub agent exposes for sending notifications
+// on placement failures and configuration drifts.
+type NotificationOptions struct {
+	// The path to the file that holds the URL of the HTTP webhook to which the KubeFleet hub agent posts
+	// the notifications. If not set, no notification will be sent.
+	//
+	// The URL is read from a file rather than passed as a flag, as webhook URLs (e.g., those of Slack
+	// incoming webhooks) often embed access tokens, and the flags of the hub agent are logged at startup.
+	// The file is read once at startup.
+	//
+	// Notifications are sent when the Applied or Available condition of a placement on a member cluster
+	// transitions to False, and when new configuration drifts are found on a member cluster.
+	WebhookURLFile string
+
+	// The path to the file that holds the Go template with which the KubeFleet hub agent renders the
+	// payloads of the notifications. If not set, the notifications are rendered as JSON objects.
+	PayloadTemplateFile string
+
+	// The interval during which the KubeFleet hub agent will not send the same notification again.
+	DedupInterval time.Duration
+
+	// The QPS limit and burst limit of the notifications; the notifications that exceed the limits are dropped.
+	QPS   float64
+	Burst int
+}
+
+// AddFlags adds flags for NotificationOptions to the specified FlagSet.
+func (o *NotificationOptions) AddFlags(flags *flag.FlagSet) {
+	flags.StringVar(
+		&o.WebhookURLFile,
+		"notification-webhook-url-file",
+		"",
+		"The path to the file that holds the URL of the HTTP webhook to which the KubeFleet hub agent posts the notifications on placement failures and configuration drifts. The file is read once at startup. If not set, no notification will be sent.",
+	)
+
+	flags.StringVar(
+		&o.PayloadTemplateFile,
+		"notification-payload-template-file",
+		"",
+		"The path to the file that holds the Go template with which the KubeFleet hub agent renders the payloads of the notifications. If not set, the notifications are rendered as JSON objects.",
+	)
+
+	flags.DurationVar(
+		&o.DedupInterval,
+		"notification-dedup-interval",
+		time.Hour,
+		"The interval during which the KubeFleet hub agent will not send the same notification again. Defaults to 1 hour.",
+	)
+
+	flags.Float64Var(
+		&o.QPS,
+		"notification-qps",
+		1,
+		"The QPS limit of the notifications; the notifications that exceed the limit are dropped. Defaults to 1.",
+	)
+
+	flags.IntVar(
+		&o.Burst,
+		"notification-burst",
+		10,
+		"The burst limit of the notifications; the notifications that exceed the limit are dropped. Defaults to 10.",
+	)
+}
@@ -39,6 +39,15 @@ type Options struct {
 
 	// Options that fine-tune how KubeFleet hub agent manages resources placements in the fleet.
 	PlacementMgmtOpts PlacementManagementOptions
+
+	// Options that concern the notifications the KubeFleet hub agent sends on placement failures and drifts.
+	NotificationOpts NotificationOptions
+
+	// Options that concern the export of placement statuses and rollout histories to object storage.
+	StatusExportOpts StatusExportOptions
+
+	// Options that concern the runtime configuration the KubeFleet hub agent reloads without restarting.
+	RuntimeConfigOpts RuntimeConfigOptions
 }
 
 func NewOptions() *Options {
@@ -52,4 +61,7 @@ func (o *Options) AddFlags(flags *flag.FlagSet) {
 	o.FeatureFlags.AddFlags(flags)
 	o.ClusterMgmtOpts.AddFlags(flags)
 	o.PlacementMgmtOpts.AddFlags(flags)
+	o.NotificationOpts.AddFlags(flags)
+	o.StatusExportOpts.AddFlags(flags)
+	o.RuntimeConfigOpts.AddFlags(flags)
 }
@@ -25,6 +25,7 @@ import (
 	"github.com/google/go-cmp/cmp"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // TestLeaderElectionOpts tests the parsing and validation logic of the leader election options defined in LeaderElectionOptions.
@@ -195,6 +196,7 @@ func TestControllerManagerOptions(t *testing.T) {
 				"--hub-api-qps=500",
 				"--hub-api-burst=1500",
 				"--resync-period=2h",
+				"--cache-status-stripped-resources=pods, deployments.apps",
 			},
 			wantCtrlMgrOpts: ControllerManagerOptions{
 				HealthProbeBindAddress: ":18081",
@@ -204,6 +206,10 @@ func TestControllerManagerOptions(t *testing.T) {
 				HubQPS:                 500,
 				HubBurst:               1500,
 				ResyncPeriod:           metav1.Duration{Duration: 2 * time.Hour},
+				CacheStatusStrippedResources: []schema.GroupResource{
+					{Resource: "pods"},
+					{Group: "apps", Resource: "deployments"},
+				},
 			},
 		},
 		{
@@ -220,6 +226,13 @@ func TestControllerManagerOptions(t *testing.T) {
 				ResyncPeriod:           metav1.Duration{Duration: 6 * time.Hour},
 			},
 		},
+		{
+			name:             "status of KubeFleet API resources stripped from the caches",
+			flagSetName:      "cacheStatusStrippedFleetResources",
+			args:             []string{"--cache-status-stripped-resources=deployments.apps,clusterresourceplacements.placement.kubernetes-fleet.io"},
+			wantErred:        true,
+			wantErrMsgSubStr: "whose status cannot be stripped",
+		},
 		{
 			name:             "hub client QPS parse error",
 			flagSetName:      "qpsParseError",
@@ -329,11 +342,20 @@ func TestFeatureFlags(t *testing.T) {
 			flagSetName: "allDefault",
 			args:        []string{},
 			wantFeatureFlags: FeatureFlags{
-				EnableV1Beta1APIs:           true,
-				EnableClusterInventoryAPIs:  true,
-				EnableStagedUpdateRunAPIs:   true,
-				EnableEvictionAPIs:          true,
-				EnableResourcePlacementAPIs: true,
+				EnableV1Beta1APIs:                  true,
+				EnableClusterInventoryAPIs:         true,
+				EnableStagedUpdateRunAPIs:          true,
+				EnableEvictionAPIs:                 true,
+				EnableResourcePlacementAPIs:        true,
+				EnableExternalRolloutProgressAPIs:  true,
+				EnableBulkPlacementOperationAPIs:   true,
+				EnableExternalMetricsAPI:           false,
+				EnablePlacementViewAPI:             false,
+				EnableDiffReportAPIs:               true,
+				EnableClusterRolloutPolicyAPIs:     true,
+				EnableSchedulingProfileAPIs:        true,
+				EnablePropertyCatalogAPIs:          true,
+				EnableResourceContentDeduplication: false,
 			},
 		},
 		{
@@ -345,13 +367,31 @@ func TestFeatureFlags(t *testing.T) {
 				"--enable-staged-update-run-apis=false",
 				"--enable-eviction-apis=false",
 				"--enable-resource-placement=false",
+				"--enable-external-rollout-progress-apis=false",
+				"--enable-bulk-placement-operation-apis=false",
+				"--enable-external-metrics-api=true",
+				"--enable-placement-view-api=true",
+				"--enable-diff-report-apis=false",
+				"--enable-cluster-rollout-policy-apis=false",
+				"--enable-scheduling-profile-apis=false",
+				"--enable-property-catalog-apis=false",
+				"--enable-resource-content-deduplication=true",
 			},
 			wantFeatureFlags: FeatureFlags{
-				EnableV1Beta1APIs:           true,
-				EnableClusterInventoryAPIs:  false,
-				EnableStagedUpdateRunAPIs:   false,
-				EnableEvictionAPIs:          false,
-				EnableResourcePlacementAPIs: false,
+				EnableV1Beta1APIs:                  true,
+				EnableClusterInventoryAPIs:         false,
+				EnableStagedUpdateRunAPIs:          false,
+				EnableEvictionAPIs:                 false,
+				EnableResourcePlacementAPIs:        false,
+				EnableExternalRolloutProgressAPIs:  false,
+				EnableBulkPlacementOperationAPIs:   false,
+				EnableExternalMetricsAPI:           true,
+				EnablePlacementViewAPI:             true,
+				EnableDiffReportAPIs:               false,
+				EnableClusterRolloutPolicyAPIs:     false,
+				EnableSchedulingProfileAPIs:        false,
+				EnablePropertyCatalogAPIs:          false,
+				EnableResourceContentDeduplication: true,
 			},
 		},
 		{
@@ -414,9 +454,10 @@ func TestClusterManagementOptions(t *testing.T) {
 			flagSetName: "allDefault",
 			args:        []string{},
 			wantClusterMgmtOpts: ClusterManagementOptions{
-				NetworkingAgentsEnabled: false,
-				UnhealthyThreshold:      metav1.Duration{Duration: 60 * time.Second},
-				ForceDeleteWaitTime:     metav1.Duration{Duration: 15 * time.Minute},
+				NetworkingAgentsEnabled:  false,
+				UnhealthyThreshold:       metav1.Duration{Duration: 60 * time.Second},
+				ForceDeleteWaitTime:      metav1.Duration{Duration: 15 * time.Minute},
+				ReverseTunnelBindAddress: ":8445",
 			},
 		},
 		{
@@ -426,11 +467,19 @@ func TestClusterManagementOptions(t *testing.T) {
 				"--networking-agents-enabled=true",
 				"--cluster-unhealthy-threshold=45s",
 				"--force-delete-wait-time=10m",
+				"--enable-reverse-tunnel=true",
+				"--reverse-tunnel-bind-address=:9444",
+				"--reverse-tunnel-tls-cert-file=/certs/tls.crt",
+				"--reverse-tunnel-tls-key-file=/certs/tls.key",
 			},
 			wantClusterMgmtOpts: ClusterManagementOptions{
-				NetworkingAgentsEnabled: true,
-				UnhealthyThreshold:      metav1.Duration{Duration: 45 * time.Second},
-				ForceDeleteWaitTime:     metav1.Duration{Duration: 10 * time.Minute},
+				NetworkingAgentsEnabled:  true,
+				UnhealthyThreshold:       metav1.Duration{Duration: 45 * time.Second},
+				ForceDeleteWaitTime:      metav1.Duration{Duration: 10 * time.Minute},
+				EnableReverseTunnel:      true,
+				ReverseTunnelBindAddress: ":9444",
+				ReverseTunnelTLSCertFile: "/certs/tls.crt",
+				ReverseTunnelTLSKeyFile:  "/certs/tls.key",
 			},
 		},
 		{
@@ -506,6 +555,158 @@ func TestClusterManagementOptions(t *testing.T) {
 	}
 }
 
+// TestNotificationOptions tests the parsing logic of the notification options defined in NotificationOptions.
+func TestNotificationOptions(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		flagSetName          string
+		args                 []string
+		wantNotificationOpts NotificationOptions
+	}{
+		{
+			name:        "all default",
+			flagSetName: "allDefault",
+			args:        []string{},
+			wantNotificationOpts: NotificationOptions{
+				DedupInterval: time.Hour,
+				QPS:           1,
+				Burst:         10,
+			},
+		},
+		{
+			name:        "all specified",
+			flagSetName: "allSpecified",
+			args: []string{
+				"--notification-webhook-url-file=/etc/kubefleet/notification-webhook/url",
+				"--notification-payload-template-file=/etc/kubefleet/notification/payload.tmpl",
+				"--notification-dedup-interval=30m",
+				"--notification-qps=2",
+				"--notification-burst=20",
+			},
+			wantNotificationOpts: NotificationOptions{
+				WebhookURLFile:      "/etc/kubefleet/notification-webhook/url",
+				PayloadTemplateFile: "/etc/kubefleet/notification/payload.tmpl",
+				DedupInterval:       30 * time.Minute,
+				QPS:                 2,
+				Burst:               20,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flags := flag.NewFlagSet(tc.flagSetName, flag.ContinueOnError)
+			notificationOpts := NotificationOptions{}
+			notificationOpts.AddFlags(flags)
+
+			if err := flags.Parse(tc.args); err != nil {
+				t.Fatalf("flag Parse() = %v, want nil", err)
+			}
+
+			if diff := cmp.Diff(notificationOpts, tc.wantNotificationOpts); diff != "" {
+				t.Errorf("notification options diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestStatusExportOptions tests the parsing logic of the status export options defined in StatusExportOptions.
+func TestStatusExportOptions(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		flagSetName          string
+		args                 []string
+		wantStatusExportOpts StatusExportOptions
+	}{
+		{
+			name:        "all default",
+			flagSetName: "allDefault",
+			args:        []string{},
+			wantStatusExportOpts: StatusExportOptions{
+				Interval: 15 * time.Minute,
+			},
+		},
+		{
+			name:        "all specified",
+			flagSetName: "allSpecified",
+			args: []string{
+				"--status-export-destination=s3://bucket/fleet",
+				"--status-export-interval=1h",
+				"--status-export-s3-region=us-west-2",
+				"--status-export-s3-endpoint=https://minio.example.com",
+			},
+			wantStatusExportOpts: StatusExportOptions{
+				Destination: "s3://bucket/fleet",
+				Interval:    time.Hour,
+				S3Region:    "us-west-2",
+				S3Endpoint:  "https://minio.example.com",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flags := flag.NewFlagSet(tc.flagSetName, flag.ContinueOnError)
+			statusExportOpts := StatusExportOptions{}
+			statusExportOpts.AddFlags(flags)
+
+			if err := flags.Parse(tc.args); err != nil {
+				t.Fatalf("flag Parse() = %v, want nil", err)
+			}
+
+			if diff := cmp.Diff(statusExportOpts, tc.wantStatusExportOpts); diff != "" {
+				t.Errorf("status export options diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRuntimeConfigOptions(t *testing.T) {
+	testCases := []struct {
+		name                  string
+		flagSetName           string
+		args                  []string
+		wantRuntimeConfigOpts RuntimeConfigOptions
+	}{
+		{
+			name:        "all default",
+			flagSetName: "allDefault",
+			args:        []string{},
+			wantRuntimeConfigOpts: RuntimeConfigOptions{
+				ReloadInterval: 30 * time.Second,
+			},
+		},
+		{
+			name:        "all specified",
+			flagSetName: "allSpecified",
+			args: []string{
+				"--runtime-config-file=/etc/kubefleet/runtime/config.yaml",
+				"--runtime-config-reload-interval=1m",
+			},
+			wantRuntimeConfigOpts: RuntimeConfigOptions{
+				File:           "/etc/kubefleet/runtime/config.yaml",
+				ReloadInterval: time.Minute,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			flags := flag.NewFlagSet(tc.flagSetName, flag.ContinueOnError)
+			runtimeConfigOpts := RuntimeConfigOptions{}
+			runtimeConfigOpts.AddFlags(flags)
+
+			if err := flags.Parse(tc.args); err != nil {
+				t.Fatalf("flag Parse() = %v, want nil", err)
+			}
+
+			if diff := cmp.Diff(runtimeConfigOpts, tc.wantRuntimeConfigOpts); diff != "" {
+				t.Errorf("runtime config options diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
 // TestPlacementManagementOptions tests the parsing and validation logic of the placement management options defined in PlacementManagementOptions.
 func TestPlacementManagementOptions(t *testing.T) {
 	testCases := []struct {
@@ -528,6 +729,7 @@ func TestPlacementManagementOptions(t *testing.T) {
 				ConcurrentResourceChangeSyncs: 20,
 				MaxFleetSize:                  100,
 				MaxConcurrentClusterPlacement: 100,
+				WatcherWorkers:                1,
 				PlacementControllerWorkQueueRateLimiterOpts: RateLimitOptions{
 					RateLimiterBaseDelay:  5 * time.Millisecond,
 					RateLimiterMaxDelay:   60 * time.Second,
@@ -536,6 +738,12 @@ func TestPlacementManagementOptions(t *testing.T) {
 				},
 				ResourceSnapshotCreationMinimumInterval: 30 * time.Second,
 				ResourceChangesCollectionDuration:       15 * time.Second,
+				MaxUnselectedClusterDecisionCount:       20,
+				SchedulerPluginWorkers:                  4,
+				SchedulerPluginEvaluationTimeout:        30 * time.Second,
+				OrphanedResourceCleanupInterval:         10 * time.Minute,
+				OrphanedResourceCleanupDryRun:           true,
+				OnDemandDiffReportTTL:                   time.Hour,
 			},
 		},
 		{
@@ -549,8 +757,25 @@ func TestPlacementManagementOptions(t *testing.T) {
 				"--concurrent-resource-change-syncs=30",
 				"--max-fleet-size=150",
 				"--max-concurrent-cluster-placement=120",
+				"--scheduler-workers=10",
+				"--rollout-controller-workers=20",
+				"--work-generator-workers=40",
+				"--placement-controller-workers=15",
+				"--watcher-workers=5",
 				"--resource-snapshot-creation-minimum-interval=45s",
 				"--resource-changes-collection-duration=20s",
+				"--enable-placement-spread-scoring=true",
+				"--enable-scale-down-filtering=true",
+				"--enable-provisioning-headroom-scoring=true",
+				"--max-unselected-cluster-decision-count=100",
+				"--scheduling-cycle-snapshot-count=10",
+				"--scheduler-plugin-workers=32",
+				"--scheduler-plugin-evaluation-timeout=1m",
+				"--orphaned-resource-cleanup-interval=1h",
+				"--orphaned-resource-cleanup-dry-run=false",
+				"--work-cleanup-grace-period=10m",
+				"--diffed-placements-summary-threshold=50",
+				"--on-demand-diff-report-ttl=30m",
 			},
 			wantPlacementMgmtOpts: PlacementManagementOptions{
 				WorkPendingGracePeriod:        metav1.Duration{Duration: 15 * time.Second},
@@ -560,6 +785,11 @@ func TestPlacementManagementOptions(t *testing.T) {
 				ConcurrentResourceChangeSyncs: 30,
 				MaxFleetSize:                  150,
 				MaxConcurrentClusterPlacement: 120,
+				SchedulerWorkers:              10,
+				RolloutControllerWorkers:      20,
+				WorkGeneratorWorkers:          40,
+				PlacementControllerWorkers:    15,
+				WatcherWorkers:                5,
 				PlacementControllerWorkQueueRateLimiterOpts: RateLimitOptions{
 					RateLimiterBaseDelay:  5 * time.Millisecond,
 					RateLimiterMaxDelay:   60 * time.Second,
@@ -568,6 +798,17 @@ func TestPlacementManagementOptions(t *testing.T) {
 				},
 				ResourceSnapshotCreationMinimumInterval: 45 * time.Second,
 				ResourceChangesCollectionDuration:       20 * time.Second,
+				EnablePlacementSpreadScoring:            true,
+				EnableScaleDownFiltering:                true,
+				EnableProvisioningHeadroomScoring:       true,
+				MaxUnselectedClusterDecisionCount:       100,
+				SchedulingCycleSnapshotCount:            10,
+				SchedulerPluginWorkers:                  32,
+				SchedulerPluginEvaluationTimeout:        time.Minute,
+				OrphanedResourceCleanupInterval:         time.Hour,
+				WorkCleanupGracePeriod:                  10 * time.Minute,
+				DiffedPlacementsSummaryThreshold:        50,
+				OnDemandDiffReportTTL:                   30 * time.Minute,
 			},
 		},
 		{
@@ -647,6 +888,55 @@ func TestPlacementManagementOptions(t *testing.T) {
 			wantErred:        true,
 			wantErrMsgSubStr: "number of max concurrent cluster placements must be in the range [10, 200]",
 		},
+		{
+			name:             "scheduler workers parse error",
+			flagSetName:      "schedulerWorkersParseError",
+			args:             []string{"--scheduler-workers=abc"},
+			wantErred:        true,
+			wantErrMsgSubStr: "failed to parse int value",
+		},
+		{
+			name:             "rollout controller workers out of range (too small)",
+			flagSetName:      "rolloutControllerWorkersOutOfRangeTooSmall",
+			args:             []string{"--rollout-controller-workers=-1"},
+			wantErred:        true,
+			wantErrMsgSubStr: "number of controller workers must be in the range [0, 1000]",
+		},
+		{
+			name:             "work generator workers out of range (too large)",
+			flagSetName:      "workGeneratorWorkersOutOfRangeTooLarge",
+			args:             []string{"--work-generator-workers=1001"},
+			wantErred:        true,
+			wantErrMsgSubStr: "number of controller workers must be in the range [0, 1000]",
+		},
+		{
+			name:             "placement controller workers out of range (too large)",
+			flagSetName:      "placementControllerWorkersOutOfRangeTooLarge",
+			args:             []string{"--placement-controller-workers=1001"},
+			wantErred:        true,
+			wantErrMsgSubStr: "number of controller workers must be in the range [0, 1000]",
+		},
+		{
+			name:             "watcher workers parse error",
+			flagSetName:      "watcherWorkersParseError",
+			args:             []string{"--watcher-workers=abc"},
+			wantErred:        true,
+			wantErrMsgSubStr: "failed to parse int value",
+		},
+		{
+			name:             "watcher workers out of range (too small)",
+			flagSetName:      "watcherWorkersOutOfRangeTooSmall",
+			args:             []string{"--watcher-workers=0"},
+			wantErred:        true,
+			wantErrMsgSubStr: "number of watcher workers must be in the range [1, 100]",
+		},
+		{
+			name:             "watcher workers out of range (too large)",
+			flagSetName:      "watcherWorkersOutOfRangeTooLarge",
+			args:             []string{"--watcher-workers=101"},
+			wantErred:        true,
+			wantErrMsgSubStr: "number of watcher workers must be in the range [1, 100]",
+		},
 		{
 			name:             "resource snapshot creation minimum interval parse error",
 			flagSetName:      "resourceSnapshotCreationMinimumIntervalParseError",
@@ -675,6 +965,125 @@ func TestPlacementManagementOptions(t *testing.T) {
 			wantErred:        true,
 			wantErrMsgSubStr: "duration must be in the range [0s, 1m]",
 		},
+		{
+			name:             "max unselected cluster decision count parse error",
+			flagSetName:      "maxUnselectedClusterDecisionCountParseError",
+			args:             []string{"--max-unselected-cluster-decision-count=abc"},
+			wantErred:        true,
+			wantErrMsgSubStr: "failed to parse int value",
+		},
+		{
+			name:             "max unselected cluster decision count out of range (too small)",
+			flagSetName:      "maxUnselectedClusterDecisionCountOutOfRangeTooSmall",
+			args:             []string{"--max-unselected-cluster-decision-count=-1"},
+			wantErred:        true,
+			wantErrMsgSubStr: "number of max unselected cluster decisions must be in the range [0, 1000]",
+		},
+		{
+			name:             "max unselected cluster decision count out of range (too large)",
+			flagSetName:      "maxUnselectedClusterDecisionCountOutOfRangeTooLarge",
+			args:             []string{"--max-unselected-cluster-decision-count=1001"},
+			wantErred:        true,
+			wantErrMsgSubStr: "number of max unselected cluster decisions must be in the range [0, 1000]",
+		},
+		{
+			name:             "scheduling cycle snapshot count parse error",
+			flagSetName:      "schedulingCycleSnapshotCountParseError",
+			args:             []string{"--scheduling-cycle-snapshot-count=abc"},
+			wantErred:        true,
+			wantErrMsgSubStr: "failed to parse int value",
+		},
+		{
+			name:             "scheduling cycle snapshot count out of range (too small)",
+			flagSetName:      "schedulingCycleSnapshotCountOutOfRangeTooSmall",
+			args:             []string{"--scheduling-cycle-snapshot-count=-1"},
+			wantErred:        true,
+			wantErrMsgSubStr: "number of scheduling cycle snapshots must be in the range [0, 50]",
+		},
+		{
+			name:             "scheduling cycle snapshot count out of range (too large)",
+			flagSetName:      "schedulingCycleSnapshotCountOutOfRangeTooLarge",
+			args:             []string{"--scheduling-cycle-snapshot-count=51"},
+			wantErred:        true,
+			wantErrMsgSubStr: "number of scheduling cycle snapshots must be in the range [0, 50]",
+		},
+		{
+			name:             "scheduler plugin workers parse error",
+			flagSetName:      "schedulerPluginWorkersParseError",
+			args:             []string{"--scheduler-plugin-workers=abc"},
+			wantErred:        true,
+			wantErrMsgSubStr: "failed to parse int value",
+		},
+		{
+			name:             "scheduler plugin workers out of range (too small)",
+			flagSetName:      "schedulerPluginWorkersOutOfRangeTooSmall",
+			args:             []string{"--scheduler-plugin-workers=0"},
+			wantErred:        true,
+			wantErrMsgSubStr: "number of scheduler plugin workers must be in the range [1, 128]",
+		},
+		{
+			name:             "scheduler plugin workers out of range (too large)",
+			flagSetName:      "schedulerPluginWorkersOutOfRangeTooLarge",
+			args:             []string{"--scheduler-plugin-workers=129"},
+			wantErred:        true,
+			wantErrMsgSubStr: "number of scheduler plugin workers must be in the range [1, 128]",
+		},
+		{
+			name:             "scheduler plugin evaluation timeout parse error",
+			flagSetName:      "schedulerPluginEvaluationTimeoutParseError",
+			args:             []string{"--scheduler-plugin-evaluation-timeout=abc"},
+			wantErred:        true,
+			wantErrMsgSubStr: "failed to parse duration",
+		},
+		{
+			name:             "scheduler plugin evaluation timeout out of range (too large)",
+			flagSetName:      "schedulerPluginEvaluationTimeoutOutOfRangeTooLarge",
+			args:             []string{"--scheduler-plugin-evaluation-timeout=11m"},
+			wantErred:        true,
+			wantErrMsgSubStr: "duration must be in the range [0s, 10m]",
+		},
+		{
+			name:             "orphaned resource cleanup interval parse error",
+			flagSetName:      "orphanedResourceCleanupIntervalParseError",
+			args:             []string{"--orphaned-resource-cleanup-interval=abc"},
+			wantErred:        true,
+			wantErrMsgSubStr: "failed to parse duration",
+		},
+		{
+			name:             "orphaned resource cleanup interval out of range (too large)",
+			flagSetName:      "orphanedResourceCleanupIntervalOutOfRangeTooLarge",
+			args:             []string{"--orphaned-resource-cleanup-interval=25h"},
+			wantErred:        true,
+			wantErrMsgSubStr: "duration must be in the range [0s, 24h]",
+		},
+		{
+			name:             "work cleanup grace period parse error",
+			flagSetName:      "workCleanupGracePeriodParseError",
+			args:             []string{"--work-cleanup-grace-period=abc"},
+			wantErred:        true,
+			wantErrMsgSubStr: "failed to parse duration",
+		},
+		{
+			name:             "work cleanup grace period out of range (negative)",
+			flagSetName:      "workCleanupGracePeriodOutOfRangeNegative",
+			args:             []string{"--work-cleanup-grace-period=-1s"},
+			wantErred:        true,
+			wantErrMsgSubStr: "duration must be in the range [0s, 24h]",
+		},
+		{
+			name:             "diffed placements summary threshold out of range",
+			flagSetName:      "diffedPlacementsSummaryThresholdOutOfRange",
+			args:             []string{"--diffed-placements-summary-threshold=101"},
+			wantErred:        true,
+			wantErrMsgSubStr: "diffed placements summary threshold must be in the range [0, 100]",
+		},
+		{
+			name:             "on-demand diff report TTL out of range",
+			flagSetName:      "onDemandDiffReportTTLOutOfRange",
+			args:             []string{"--on-demand-diff-report-ttl=30s"},
+			wantErred:        true,
+			wantErrMsgSubStr: "duration must be in the range [1m, 24h]",
+		},
 	}
 
 	for _, tc := range testCases {
@@ -706,6 +1115,74 @@ func TestPlacementManagementOptions(t *testing.T) {
 	}
 }
 
+// TestPlacementManagementOptionsEffectiveWorkers tests the methods that compute the effective number of
+// concurrent workers for placement related controllers.
+func TestPlacementManagementOptionsEffectiveWorkers(t *testing.T) {
+	testCases := []struct {
+		name                           string
+		opts                           PlacementManagementOptions
+		wantSchedulerWorkers           int
+		wantRolloutControllerWorkers   int
+		wantWorkGeneratorWorkers       int
+		wantPlacementControllerWorkers int
+	}{
+		{
+			name: "derived from the defaults",
+			opts: PlacementManagementOptions{
+				MaxFleetSize:                  100,
+				MaxConcurrentClusterPlacement: 100,
+			},
+			wantSchedulerWorkers:           20,
+			wantRolloutControllerWorkers:   40,
+			wantWorkGeneratorWorkers:       100,
+			wantPlacementControllerWorkers: 10,
+		},
+		{
+			name: "derived from custom fleet size and placement concurrency",
+			opts: PlacementManagementOptions{
+				MaxFleetSize:                  200,
+				MaxConcurrentClusterPlacement: 15,
+			},
+			wantSchedulerWorkers:           8,
+			wantRolloutControllerWorkers:   14,
+			wantWorkGeneratorWorkers:       40,
+			wantPlacementControllerWorkers: 2,
+		},
+		{
+			name: "explicitly specified",
+			opts: PlacementManagementOptions{
+				MaxFleetSize:                  100,
+				MaxConcurrentClusterPlacement: 100,
+				SchedulerWorkers:              5,
+				RolloutControllerWorkers:      6,
+				WorkGeneratorWorkers:          7,
+				PlacementControllerWorkers:    8,
+			},
+			wantSchedulerWorkers:           5,
+			wantRolloutControllerWorkers:   6,
+			wantWorkGeneratorWorkers:       7,
+			wantPlacementControllerWorkers: 8,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.opts.EffectiveSchedulerWorkers(); got != tc.wantSchedulerWorkers {
+				t.Errorf("EffectiveSchedulerWorkers() = %d, want %d", got, tc.wantSchedulerWorkers)
+			}
+			if got := tc.opts.EffectiveRolloutControllerWorkers(); got != tc.wantRolloutControllerWorkers {
+				t.Errorf("EffectiveRolloutControllerWorkers() = %d, want %d", got, tc.wantRolloutControllerWorkers)
+			}
+			if got := tc.opts.EffectiveWorkGeneratorWorkers(); got != tc.wantWorkGeneratorWorkers {
+				t.Errorf("EffectiveWorkGeneratorWorkers() = %d, want %d", got, tc.wantWorkGeneratorWorkers)
+			}
+			if got := tc.opts.EffectivePlacementControllerWorkers(); got != tc.wantPlacementControllerWorkers {
+				t.Errorf("EffectivePlacementControllerWorkers() = %d, want %d", got, tc.wantPlacementControllerWorkers)
+			}
+		})
+	}
+}
+
 // TestWebhookOptions tests the parsing and validation logic of the webhook options defined in WebhookOptions.
 func TestWebhookOptions(t *testing.T) {
 	testCases := []struct {
@@ -730,6 +1207,8 @@ func TestWebhookOptions(t *testing.T) {
 				EnableWorkload:                         false,
 				EnablePDBs:                             true,
 				UseCertManager:                         false,
+				EnvelopeMaxManifests:                   50,
+				EnvelopeMaxSizeBytes:                   1048576,
 			},
 		},
 		{
@@ -744,6 +1223,9 @@ func TestWebhookOptions(t *testing.T) {
 				"--deny-modify-member-cluster-labels=true",
 				"--enable-workload=true",
 				"--use-cert-manager=true",
+				"--deny-member-cluster-delete-with-placements=true",
+				"--envelope-max-manifests=20",
+				"--envelope-max-size-bytes=524288",
 			},
 			wantWebhookOpts: WebhookOptions{
 				EnableWebhooks:                         false,
@@ -755,6 +1237,9 @@ func TestWebhookOptions(t *testing.T) {
 				EnableWorkload:                         true,
 				EnablePDBs:                             true,
 				UseCertManager:                         true,
+				DenyMemberClusterDeleteWithPlacements:  true,
+				EnvelopeMaxManifests:                   20,
+				EnvelopeMaxSizeBytes:                   524288,
 			},
 		},
 		{
@@ -771,6 +1256,8 @@ func TestWebhookOptions(t *testing.T) {
 				EnableWorkload:                         false,
 				EnablePDBs:                             true,
 				UseCertManager:                         false,
+				EnvelopeMaxManifests:                   50,
+				EnvelopeMaxSizeBytes:                   1048576,
 			},
 		},
 		{
@@ -787,6 +1274,8 @@ func TestWebhookOptions(t *testing.T) {
 				EnableWorkload:                         false,
 				EnablePDBs:                             true,
 				UseCertManager:                         false,
+				EnvelopeMaxManifests:                   50,
+				EnvelopeMaxSizeBytes:                   1048576,
 			},
 		},
 		{
@@ -19,6 +19,7 @@ package options
 import (
 	"flag"
 	"fmt"
+	"math"
 	"strconv"
 	"time"
 
@@ -26,6 +27,7 @@ import (
 	"k8s.io/klog/v2"
 
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
 )
 
 // PlacementManagementOptions is a set of options the KubeFleet hub agent exposes for
@@ -99,6 +101,26 @@ type PlacementManagementOptions struct {
 	// the value higher increases the concurrency of such controllers.
 	MaxConcurrentClusterPlacement int
 
+	// The number of concurrent workers of the KubeFleet scheduler. If set to zero, the value is derived from
+	// the MaxFleetSize and MaxConcurrentClusterPlacement options.
+	SchedulerWorkers int
+
+	// The number of concurrent workers of the rollout controllers. If set to zero, the value is derived from
+	// the MaxFleetSize and MaxConcurrentClusterPlacement options.
+	RolloutControllerWorkers int
+
+	// The number of concurrent workers of the work generator controllers. If set to zero, the value is derived from
+	// the MaxFleetSize and MaxConcurrentClusterPlacement options.
+	WorkGeneratorWorkers int
+
+	// The number of concurrent workers of the placement controllers. If set to zero, the value is derived from
+	// the MaxConcurrentClusterPlacement option.
+	PlacementControllerWorkers int
+
+	// The number of concurrent workers of each watcher controller (e.g., the placement, binding, and scheduling
+	// policy snapshot watchers), and of any other hub controller that does not have a dedicated concurrency setting.
+	WatcherWorkers int
+
 	// The rate limiting options for work queues in use by several placement related controllers.
 	PlacementControllerWorkQueueRateLimiterOpts RateLimitOptions
 
@@ -115,6 +137,75 @@ type PlacementManagementOptions struct {
 	// if new changes are found, KubeFleet will build a new resource snapshot if there has not been any
 	// new snapshot built within the ResourceSnapshotCreationMinimumInterval.
 	ResourceChangesCollectionDuration time.Duration
+
+	// Enable the placement spread scoring in the KubeFleet scheduler or not.
+	//
+	// If enabled, when all the other scores are the same, the scheduler will prefer clusters that host fewer
+	// bindings across all placements in the fleet, so as to avoid piling every placement onto the same few clusters.
+	EnablePlacementSpreadScoring bool
+
+	// Enable the scale down filtering in the KubeFleet scheduler or not.
+	//
+	// If enabled, the scheduler will not place new bindings on clusters that report (via the
+	// autoscaler.kubernetes-fleet.io/scale-down-in-progress property) that they are scaling down;
+	// clusters that already host a binding of a placement are not affected.
+	EnableScaleDownFiltering bool
+
+	// Enable the provisioning headroom scoring in the KubeFleet scheduler or not.
+	//
+	// If enabled, when the affinity and topology spread scores are the same, the scheduler will prefer clusters
+	// that report (via the autoscaler.kubernetes-fleet.io/provisioning-headroom property) pending capacity expansion.
+	EnableProvisioningHeadroomScoring bool
+
+	// The maximum number of clusters that are not selected by a placement which the KubeFleet scheduler will
+	// explain (e.g., report the filter that rejects a cluster) in the scheduling decisions of the policy snapshot status.
+	//
+	// Setting the value higher helps debug cluster selectors for placements in large fleets, at the cost of
+	// bigger policy snapshot objects.
+	MaxUnselectedClusterDecisionCount int
+
+	// The number of the latest scheduling cycles per placement whose snapshots the KubeFleet scheduler will keep
+	// for debugging. A snapshot includes the cycle state, the filter outcomes, and the scores of a scheduling cycle;
+	// snapshots are kept in a config map per placement and can be retrieved with the kubectl-fleet plugin.
+	// Set the value to zero to disable the snapshots.
+	SchedulingCycleSnapshotCount int
+
+	// The number of concurrent workers the KubeFleet scheduler uses to run the filter and score plugins
+	// across clusters in a scheduling cycle.
+	SchedulerPluginWorkers int
+
+	// The deadline for running the filter and score plugins across clusters in a scheduling cycle; if the
+	// deadline is exceeded, the scheduling cycle will be retried. Set the value to zero to disable the deadline.
+	SchedulerPluginEvaluationTimeout time.Duration
+
+	// The interval between sweeps for orphaned placement-owned objects, i.e., bindings, snapshots, and works whose
+	// parent placements no longer exist. Set the value to zero to disable the sweeps.
+	OrphanedResourceCleanupInterval time.Duration
+
+	// Only report orphaned placement-owned objects via logs and metrics, without deleting them.
+	OrphanedResourceCleanupDryRun bool
+
+	// The period the rollout controllers wait after a binding becomes unscheduled before deleting it (and, by
+	// extension, the resources it has placed on the member cluster). During the period the binding reports a
+	// PreDeletion condition so that one can collect logs and states from the member cluster before teardown.
+	// Set the value to zero to delete unscheduled bindings right away.
+	WorkCleanupGracePeriod time.Duration
+
+	// The number of diffed resources found on a member cluster with the ReportDiff apply strategy beyond which
+	// the binding status only keeps a summary of the diffed resources, i.e., their counts by kind and a hash of
+	// their details, so that the status stays small. The complete details of such bindings are written to
+	// DiffReport objects on demand (when the bindings are annotated with kubernetes-fleet.io/diff-report-request),
+	// rather than at all times. Set the value to zero to disable the summaries.
+	DiffedPlacementsSummaryThreshold int
+
+	// How long the DiffReport objects written on demand are kept up-to-date before they are removed.
+	OnDemandDiffReportTTL time.Duration
+
+	// A list of comma-separated addresses of the Prometheus servers that the rollout analysis of placements
+	// may query, such as `http://prometheus.monitoring:9090`. The rollout controllers do not query any
+	// other address, as the addresses are set by the users who create placements; if the list is empty,
+	// the rollout analysis cannot run.
+	RolloutAnalysisPrometheusAddresses string
 }
 
 // AddFlags adds flags for PlacementManagementOptions to the specified FlagSet.
@@ -162,6 +253,36 @@ func (o *PlacementManagementOptions) AddFlags(flags *flag.FlagSet) {
 		"The expected maximum number of placements that are allowed to run concurrently. This is used specifically for setting the number of concurrent workers for several key placement related controllers. Default is 100. Must be a positive integer value in the range [10, 200].",
 	)
 
+	flags.Var(
+		newControllerWorkersValueWithValidation(0, &o.SchedulerWorkers),
+		"scheduler-workers",
+		"The number of concurrent workers of the KubeFleet scheduler. Default is 0, which derives the value from the max-fleet-size and max-concurrent-cluster-placement options. Must be an integer value in the range [0, 1000].",
+	)
+
+	flags.Var(
+		newControllerWorkersValueWithValidation(0, &o.RolloutControllerWorkers),
+		"rollout-controller-workers",
+		"The number of concurrent workers of the rollout controllers. Default is 0, which derives the value from the max-fleet-size and max-concurrent-cluster-placement options. Must be an integer value in the range [0, 1000].",
+	)
+
+	flags.Var(
+		newControllerWorkersValueWithValidation(0, &o.WorkGeneratorWorkers),
+		"work-generator-workers",
+		"The number of concurrent workers of the work generator controllers. Default is 0, which derives the value from the max-fleet-size and max-concurrent-cluster-placement options. Must be an integer value in the range [0, 1000].",
+	)
+
+	flags.Var(
+		newControllerWorkersValueWithValidation(0, &o.PlacementControllerWorkers),
+		"placement-controller-workers",
+		"The number of concurrent workers of the placement controllers. Default is 0, which derives the value from the max-concurrent-cluster-placement option. Must be an integer value in the range [0, 1000].",
+	)
+
+	flags.Var(
+		newWatcherWorkersValueWithValidation(1, &o.WatcherWorkers),
+		"watcher-workers",
+		"The number of concurrent workers of each watcher controller (e.g., the placement, binding, and scheduling policy snapshot watchers), and of any other hub controller that does not have a dedicated concurrency setting. Default is 1. Must be a positive integer value in the range [1, 100].",
+	)
+
 	o.PlacementControllerWorkQueueRateLimiterOpts.AddFlags(flags)
 
 	flags.Var(
@@ -175,6 +296,121 @@ func (o *PlacementManagementOptions) AddFlags(flags *flag.FlagSet) {
 		"resource-changes-collection-duration",
 		"The interval between resource change collection attempts. Default is 15 seconds. Must be a duration in the range [0s, 1m].",
 	)
+
+	flags.BoolVar(
+		&o.EnablePlacementSpreadScoring,
+		"enable-placement-spread-scoring",
+		false,
+		"Enable the placement spread scoring in the KubeFleet scheduler or not. If enabled, the scheduler will prefer clusters that host fewer bindings across all placements in the fleet when all the other scores are the same.",
+	)
+
+	flags.BoolVar(
+		&o.EnableScaleDownFiltering,
+		"enable-scale-down-filtering",
+		false,
+		"Enable the scale down filtering in the KubeFleet scheduler or not. If enabled, the scheduler will not place new bindings on clusters that report that they are scaling down.",
+	)
+
+	flags.BoolVar(
+		&o.EnableProvisioningHeadroomScoring,
+		"enable-provisioning-headroom-scoring",
+		false,
+		"Enable the provisioning headroom scoring in the KubeFleet scheduler or not. If enabled, the scheduler will prefer clusters that report pending capacity expansion.",
+	)
+
+	flags.Var(
+		newMaxUnselectedClusterDecisionCountValueWithValidation(20, &o.MaxUnselectedClusterDecisionCount),
+		"max-unselected-cluster-decision-count",
+		"The maximum number of clusters that are not selected by a placement which the KubeFleet scheduler will explain in the scheduling decisions of the policy snapshot status. Default is 20. Must be an integer value in the range [0, 1000].",
+	)
+
+	flags.Var(
+		newSchedulingCycleSnapshotCountValueWithValidation(0, &o.SchedulingCycleSnapshotCount),
+		"scheduling-cycle-snapshot-count",
+		"The number of the latest scheduling cycles per placement whose snapshots (the cycle state, the filter outcomes, and the scores) the KubeFleet scheduler will keep in a config map for debugging. Default is 0, which disables the snapshots. Must be an integer value in the range [0, 50].",
+	)
+
+	flags.Var(
+		newSchedulerPluginWorkersValueWithValidation(parallelizer.DefaultNumOfWorkers, &o.SchedulerPluginWorkers),
+		"scheduler-plugin-workers",
+		"The number of concurrent workers the KubeFleet scheduler uses to run the filter and score plugins across clusters in a scheduling cycle. Default is 4. Must be a positive integer value in the range [1, 128].",
+	)
+
+	flags.Var(
+		newSchedulerPluginEvaluationTimeoutValueWithValidation(30*time.Second, &o.SchedulerPluginEvaluationTimeout),
+		"scheduler-plugin-evaluation-timeout",
+		"The deadline for running the filter and score plugins across clusters in a scheduling cycle; the scheduling cycle will be retried if the deadline is exceeded. Default is 30 seconds. Must be a duration in the range [0s, 10m]; set to 0s to disable the deadline.",
+	)
+
+	flags.Var(
+		newOrphanedResourceCleanupIntervalValueWithValidation(10*time.Minute, &o.OrphanedResourceCleanupInterval),
+		"orphaned-resource-cleanup-interval",
+		"The interval between sweeps for bindings, snapshots, and works whose parent placements no longer exist. Default is 10 minutes. Must be a duration in the range [0s, 24h]; set to 0s to disable the sweeps.",
+	)
+
+	flags.BoolVar(
+		&o.OrphanedResourceCleanupDryRun,
+		"orphaned-resource-cleanup-dry-run",
+		true,
+		"Only report bindings, snapshots, and works whose parent placements no longer exist via logs and metrics, without deleting them.",
+	)
+
+	flags.Var(
+		newWorkCleanupGracePeriodValueWithValidation(0, &o.WorkCleanupGracePeriod),
+		"work-cleanup-grace-period",
+		"The period the rollout controllers wait after a binding becomes unscheduled before removing the resources it has placed on the member cluster; a PreDeletion condition is reported on the binding during the period. Default is 0, which removes the resources right away. Must be a duration in the range [0s, 24h].",
+	)
+
+	flags.Var(
+		newDiffedPlacementsSummaryThresholdValueWithValidation(0, &o.DiffedPlacementsSummaryThreshold),
+		"diffed-placements-summary-threshold",
+		"The number of diffed resources found on a member cluster with the ReportDiff apply strategy beyond which the binding status only keeps their counts by kind and a hash of their details; the complete details are then written to DiffReport objects on demand only. Default is 0, which disables the summaries. Must be an integer value in the range [0, 100].",
+	)
+
+	flags.Var(
+		newOnDemandDiffReportTTLValueWithValidation(time.Hour, &o.OnDemandDiffReportTTL),
+		"on-demand-diff-report-ttl",
+		"How long the DiffReport objects written on demand are kept up-to-date before they are removed. Default is 1 hour. Must be a duration in the range [1m, 24h].",
+	)
+
+	flags.StringVar(
+		&o.RolloutAnalysisPrometheusAddresses,
+		"rollout-analysis-prometheus-addresses",
+		"",
+		"A list of comma-separated addresses of the Prometheus servers that the rollout analysis of placements may query, such as `http://prometheus.monitoring:9090`. No other address is queried; if the list is empty, the rollout analysis cannot run.",
+	)
+}
+
+// EffectiveSchedulerWorkers returns the number of concurrent workers of the KubeFleet scheduler.
+func (o *PlacementManagementOptions) EffectiveSchedulerWorkers() int {
+	if o.SchedulerWorkers > 0 {
+		return o.SchedulerWorkers
+	}
+	return int(math.Ceil(float64(o.MaxFleetSize)/50) * math.Ceil(float64(o.MaxConcurrentClusterPlacement)/10))
+}
+
+// EffectiveRolloutControllerWorkers returns the number of concurrent workers of the rollout controllers.
+func (o *PlacementManagementOptions) EffectiveRolloutControllerWorkers() int {
+	if o.RolloutControllerWorkers > 0 {
+		return o.RolloutControllerWorkers
+	}
+	return int(math.Ceil(float64(o.MaxFleetSize)/30) * math.Ceil(float64(o.MaxConcurrentClusterPlacement)/10))
+}
+
+// EffectiveWorkGeneratorWorkers returns the number of concurrent workers of the work generator controllers.
+func (o *PlacementManagementOptions) EffectiveWorkGeneratorWorkers() int {
+	if o.WorkGeneratorWorkers > 0 {
+		return o.WorkGeneratorWorkers
+	}
+	return int(math.Ceil(float64(o.MaxFleetSize)/10) * math.Ceil(float64(o.MaxConcurrentClusterPlacement)/10))
+}
+
+// EffectivePlacementControllerWorkers returns the number of concurrent workers of the placement controllers.
+func (o *PlacementManagementOptions) EffectivePlacementControllerWorkers() int {
+	if o.PlacementControllerWorkers > 0 {
+		return o.PlacementControllerWorkers
+	}
+	return int(math.Ceil(float64(o.MaxConcurrentClusterPlacement) / 10))
 }
 
 // A list of flag variables that allow pluggable validation logic when parsing the input args.
@@ -305,6 +541,52 @@ func newMaxConcurrentClusterPlacementValueWithValidation(defaultVal int, p *int)
 	return (*MaxConcurrentClusterPlacementValueWithValidation)(p)
 }
 
+type ControllerWorkersValueWithValidation int
+
+func (v *ControllerWorkersValueWithValidation) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *ControllerWorkersValueWithValidation) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse int value: %w", err)
+	}
+	if n < 0 || n > 1000 {
+		return fmt.Errorf("number of controller workers must be in the range [0, 1000]")
+	}
+	*v = ControllerWorkersValueWithValidation(n)
+	return nil
+}
+
+func newControllerWorkersValueWithValidation(defaultVal int, p *int) *ControllerWorkersValueWithValidation {
+	*p = defaultVal
+	return (*ControllerWorkersValueWithValidation)(p)
+}
+
+type WatcherWorkersValueWithValidation int
+
+func (v *WatcherWorkersValueWithValidation) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *WatcherWorkersValueWithValidation) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse int value: %w", err)
+	}
+	if n < 1 || n > 100 {
+		return fmt.Errorf("number of watcher workers must be in the range [1, 100]")
+	}
+	*v = WatcherWorkersValueWithValidation(n)
+	return nil
+}
+
+func newWatcherWorkersValueWithValidation(defaultVal int, p *int) *WatcherWorkersValueWithValidation {
+	*p = defaultVal
+	return (*WatcherWorkersValueWithValidation)(p)
+}
+
 type ResourceSnapshotCreationMinimumIntervalValueWithValidation time.Duration
 
 func (v *ResourceSnapshotCreationMinimumIntervalValueWithValidation) String() string {
@@ -350,3 +632,187 @@ func newResourceChangesCollectionDurationValueWithValidation(defaultVal time.Dur
 	*p = defaultVal
 	return (*ResourceChangesCollectionDurationValueWithValidation)(p)
 }
+
+type MaxUnselectedClusterDecisionCountValueWithValidation int
+
+func (v *MaxUnselectedClusterDecisionCountValueWithValidation) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *MaxUnselectedClusterDecisionCountValueWithValidation) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse int value: %w", err)
+	}
+	if n < 0 || n > 1000 {
+		return fmt.Errorf("number of max unselected cluster decisions must be in the range [0, 1000]")
+	}
+	*v = MaxUnselectedClusterDecisionCountValueWithValidation(n)
+	return nil
+}
+
+func newMaxUnselectedClusterDecisionCountValueWithValidation(defaultVal int, p *int) *MaxUnselectedClusterDecisionCountValueWithValidation {
+	*p = defaultVal
+	return (*MaxUnselectedClusterDecisionCountValueWithValidation)(p)
+}
+
+type SchedulingCycleSnapshotCountValueWithValidation int
+
+func (v *SchedulingCycleSnapshotCountValueWithValidation) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *SchedulingCycleSnapshotCountValueWithValidation) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse int value: %w", err)
+	}
+	if n < 0 || n > 50 {
+		return fmt.Errorf("number of scheduling cycle snapshots must be in the range [0, 50]")
+	}
+	*v = SchedulingCycleSnapshotCountValueWithValidation(n)
+	return nil
+}
+
+func newSchedulingCycleSnapshotCountValueWithValidation(defaultVal int, p *int) *SchedulingCycleSnapshotCountValueWithValidation {
+	*p = defaultVal
+	return (*SchedulingCycleSnapshotCountValueWithValidation)(p)
+}
+
+type SchedulerPluginWorkersValueWithValidation int
+
+func (v *SchedulerPluginWorkersValueWithValidation) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *SchedulerPluginWorkersValueWithValidation) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse int value: %w", err)
+	}
+	if n < 1 || n > 128 {
+		return fmt.Errorf("number of scheduler plugin workers must be in the range [1, 128]")
+	}
+	*v = SchedulerPluginWorkersValueWithValidation(n)
+	return nil
+}
+
+func newSchedulerPluginWorkersValueWithValidation(defaultVal int, p *int) *SchedulerPluginWorkersValueWithValidation {
+	*p = defaultVal
+	return (*SchedulerPluginWorkersValueWithValidation)(p)
+}
+
+type SchedulerPluginEvaluationTimeoutValueWithValidation time.Duration
+
+func (v *SchedulerPluginEvaluationTimeoutValueWithValidation) String() string {
+	return time.Duration(*v).String()
+}
+
+func (v *SchedulerPluginEvaluationTimeoutValueWithValidation) Set(s string) error {
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse duration: %w", err)
+	}
+	if duration < 0 || duration > 10*time.Minute {
+		return fmt.Errorf("duration must be in the range [0s, 10m]")
+	}
+	*v = SchedulerPluginEvaluationTimeoutValueWithValidation(duration)
+	return nil
+}
+
+func newSchedulerPluginEvaluationTimeoutValueWithValidation(defaultVal time.Duration, p *time.Duration) *SchedulerPluginEvaluationTimeoutValueWithValidation {
+	*p = defaultVal
+	return (*SchedulerPluginEvaluationTimeoutValueWithValidation)(p)
+}
+
+type OrphanedResourceCleanupIntervalValueWithValidation time.Duration
+
+func (v *OrphanedResourceCleanupIntervalValueWithValidation) String() string {
+	return time.Duration(*v).String()
+}
+
+func (v *OrphanedResourceCleanupIntervalValueWithValidation) Set(s string) error {
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse duration: %w", err)
+	}
+	if duration < 0 || duration > 24*time.Hour {
+		return fmt.Errorf("duration must be in the range [0s, 24h]")
+	}
+	*v = OrphanedResourceCleanupIntervalValueWithValidation(duration)
+	return nil
+}
+
+func newOrphanedResourceCleanupIntervalValueWithValidation(defaultVal time.Duration, p *time.Duration) *OrphanedResourceCleanupIntervalValueWithValidation {
+	*p = defaultVal
+	return (*OrphanedResourceCleanupIntervalValueWithValidation)(p)
+}
+
+type WorkCleanupGracePeriodValueWithValidation time.Duration
+
+func (v *WorkCleanupGracePeriodValueWithValidation) String() string {
+	return time.Duration(*v).String()
+}
+
+func (v *WorkCleanupGracePeriodValueWithValidation) Set(s string) error {
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse duration: %w", err)
+	}
+	if duration < 0 || duration > 24*time.Hour {
+		return fmt.Errorf("duration must be in the range [0s, 24h]")
+	}
+	*v = WorkCleanupGracePeriodValueWithValidation(duration)
+	return nil
+}
+
+func newWorkCleanupGracePeriodValueWithValidation(defaultVal time.Duration, p *time.Duration) *WorkCleanupGracePeriodValueWithValidation {
+	*p = defaultVal
+	return (*WorkCleanupGracePeriodValueWithValidation)(p)
+}
+
+type DiffedPlacementsSummaryThresholdValueWithValidation int
+
+func (v *DiffedPlacementsSummaryThresholdValueWithValidation) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *DiffedPlacementsSummaryThresholdValueWithValidation) Set(s string) error {
+	n, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse int value: %w", err)
+	}
+	if n < 0 || n > 100 {
+		return fmt.Errorf("diffed placements summary threshold must be in the range [0, 100]")
+	}
+	*v = DiffedPlacementsSummaryThresholdValueWithValidation(n)
+	return nil
+}
+
+func newDiffedPlacementsSummaryThresholdValueWithValidation(defaultVal int, p *int) *DiffedPlacementsSummaryThresholdValueWithValidation {
+	*p = defaultVal
+	return (*DiffedPlacementsSummaryThresholdValueWithValidation)(p)
+}
+
+type OnDemandDiffReportTTLValueWithValidation time.Duration
+
+func (v *OnDemandDiffReportTTLValueWithValidation) String() string {
+	return time.Duration(*v).String()
+}
+
+func (v *OnDemandDiffReportTTLValueWithValidation) Set(s string) error {
+	duration, err := time.ParseDuration(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse duration: %w", err)
+	}
+	if duration < time.Minute || duration > 24*time.Hour {
+		return fmt.Errorf("duration must be in the range [1m, 24h]")
+	}
+	*v = OnDemandDiffReportTTLValueWithValidation(duration)
+	return nil
+}
+
+func newOnDemandDiffReportTTLValueWithValidation(defaultVal time.Duration, p *time.Duration) *OnDemandDiffReportTTLValueWithValidation {
+	*p = defaultVal
+	return (*OnDemandDiffReportTTLValueWithValidation)(p)
+}
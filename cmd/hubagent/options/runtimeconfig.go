@@ -0,0 +1,52 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"flag"
+	"time"
+)
+
+// RuntimeConfigOptions is a set of options the KubeFleet hub agent exposes for loading a runtime
+// configuration, i.e., a set of tunables (e.g., the concurrency and the rate limits of the placement
+// controllers) that can be changed without restarting the hub agent.
+type RuntimeConfigOptions struct {
+	// The path to the runtime configuration file, typically projected from a ConfigMap. The file is
+	// watched at runtime; its settings take precedence over the corresponding command-line flags. If
+	// not set, the tunables are fixed at their command-line values.
+	File string
+
+	// The interval at which the KubeFleet hub agent checks the runtime configuration file for changes.
+	ReloadInterval time.Duration
+}
+
+// AddFlags adds flags for RuntimeConfigOptions to the specified FlagSet.
+func (o *RuntimeConfigOptions) AddFlags(flags *flag.FlagSet) {
+	flags.StringVar(
+		&o.File,
+		"runtime-config-file",
+		"",
+		"The path to the runtime configuration file, typically projected from a ConfigMap, which the KubeFleet hub agent watches and applies without restarting. If not set, the tunables are fixed at their command-line values.",
+	)
+
+	flags.DurationVar(
+		&o.ReloadInterval,
+		"runtime-config-reload-interval",
+		30*time.Second,
+		"The interval at which the KubeFleet hub agent checks the runtime configuration file for changes. Defaults to 30 seconds.",
+	)
+}
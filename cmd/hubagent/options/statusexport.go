@@ -0,0 +1,78 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"flag"
+	"time"
+)
+
+// StatusExportOptions is a set of options the KubeFleet hub agent exposes for archiving the statuses
+// of placements and the rollout histories of their bindings to an object storage service.
+type StatusExportOptions struct {
+	// The object storage location to which the KubeFleet hub agent exports the placement statuses and
+	// rollout histories, in the JSON Lines format. It is either an S3 URL of the format
+	// s3://BUCKET[/PREFIX], or an Azure Blob Storage URL of the format
+	// https://ACCOUNT.blob.core.windows.net/CONTAINER[/PREFIX]. If not set, nothing will be exported.
+	//
+	// The credentials are read from the environment: AWS_ACCESS_KEY_ID, AWS_SECRET_ACCESS_KEY, and
+	// (optionally) AWS_SESSION_TOKEN for S3; AZURE_STORAGE_SAS_TOKEN, or the default Azure credential
+	// chain if the SAS token is not set, for Azure Blob Storage.
+	Destination string
+
+	// The interval at which the KubeFleet hub agent exports the placement statuses and rollout histories
+	// that have changed since the last export.
+	Interval time.Duration
+
+	// The region of the S3 bucket; required for S3 destinations.
+	S3Region string
+
+	// The endpoint of the S3 service; if not set, the regional AWS endpoint is used. Set this to use
+	// an S3-compatible service.
+	S3Endpoint string
+}
+
+// AddFlags adds flags for StatusExportOptions to the specified FlagSet.
+func (o *StatusExportOptions) AddFlags(flags *flag.FlagSet) {
+	flags.StringVar(
+		&o.Destination,
+		"status-export-destination",
+		"",
+		"The object storage location (s3://BUCKET[/PREFIX] or https://ACCOUNT.blob.core.windows.net/CONTAINER[/PREFIX]) to which the KubeFleet hub agent exports the placement statuses and rollout histories. If not set, nothing will be exported.",
+	)
+
+	flags.DurationVar(
+		&o.Interval,
+		"status-export-interval",
+		15*time.Minute,
+		"The interval at which the KubeFleet hub agent exports the placement statuses and rollout histories that have changed since the last export. Defaults to 15 minutes.",
+	)
+
+	flags.StringVar(
+		&o.S3Region,
+		"status-export-s3-region",
+		"",
+		"The region of the S3 bucket to which the placement statuses and rollout histories are exported; required for S3 destinations.",
+	)
+
+	flags.StringVar(
+		&o.S3Endpoint,
+		"status-export-s3-endpoint",
+		"",
+		"The endpoint of the S3 service to which the placement statuses and rollout histories are exported. If not set, the regional AWS endpoint is used.",
+	)
+}
@@ -17,7 +17,11 @@ limitations under the License.
 package options
 
 import (
+	"strings"
+
 	"k8s.io/apimachinery/pkg/util/validation/field"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/statusexporter"
 )
 
 // Validate checks Options and return a slice of found errs.
@@ -53,6 +57,31 @@ func (o *Options) Validate() field.ErrorList {
 		errs = append(errs, field.Invalid(newPath.Child("UseCertManager"), o.WebhookOpts.UseCertManager, "If cert manager is used for securing webhook connections, the EnableWorkload option must be set to true, so that cert manager pods can run in the hub cluster."))
 	}
 
+	if o.WebhookOpts.EnableWebhooks && o.WebhookOpts.EnvelopeMaxManifests <= 0 {
+		errs = append(errs, field.Invalid(newPath.Child("EnvelopeMaxManifests"), o.WebhookOpts.EnvelopeMaxManifests, "The limit on the number of manifests an envelope may wrap must be greater than 0"))
+	}
+
+	if o.WebhookOpts.EnableWebhooks && o.WebhookOpts.EnvelopeMaxSizeBytes <= 0 {
+		errs = append(errs, field.Invalid(newPath.Child("EnvelopeMaxSizeBytes"), o.WebhookOpts.EnvelopeMaxSizeBytes, "The limit on the total size of the manifests an envelope may wrap must be greater than 0"))
+	}
+
+	if o.FeatureFlags.EnableExternalMetricsAPI && !o.WebhookOpts.EnableWebhooks {
+		errs = append(errs, field.Invalid(newPath.Child("EnableExternalMetricsAPI"), o.FeatureFlags.EnableExternalMetricsAPI, "The external metrics API is served with the serving certificates of the webhook server, which requires webhooks to be enabled"))
+	}
+
+	if o.FeatureFlags.EnablePlacementViewAPI && !o.WebhookOpts.EnableWebhooks {
+		errs = append(errs, field.Invalid(newPath.Child("EnablePlacementViewAPI"), o.FeatureFlags.EnablePlacementViewAPI, "The placement view API is served with the serving certificates of the webhook server, which requires webhooks to be enabled"))
+	}
+
+	if o.FeatureFlags.EnableResourceContentDeduplication && o.PlacementMgmtOpts.OrphanedResourceCleanupInterval <= 0 {
+		errs = append(errs, field.Invalid(newPath.Child("EnableResourceContentDeduplication"), o.FeatureFlags.EnableResourceContentDeduplication, "Unreferenced resource contents are garbage collected by the orphaned resource janitor, which requires a positive orphaned resource cleanup interval"))
+	}
+
+	// Cross-field validation for cluster management options.
+	if o.ClusterMgmtOpts.EnableReverseTunnel && (o.ClusterMgmtOpts.ReverseTunnelTLSCertFile == "" || o.ClusterMgmtOpts.ReverseTunnelTLSKeyFile == "") {
+		errs = append(errs, field.Invalid(newPath.Child("ReverseTunnelTLSCertFile"), o.ClusterMgmtOpts.ReverseTunnelTLSCertFile, "The TLS certificate and key files of the reverse tunnel server must be specified if the reverse tunnel server is enabled"))
+	}
+
 	if o.PlacementMgmtOpts.AllowedPropagatingAPIs != "" && o.PlacementMgmtOpts.SkippedPropagatingAPIs != "" {
 		errs = append(errs, field.Invalid(newPath.Child("AllowedPropagatingAPIs"), o.PlacementMgmtOpts.AllowedPropagatingAPIs, "AllowedPropagatingAPIs and SkippedPropagatingAPIs options are mutually exclusive"))
 	}
@@ -66,5 +95,36 @@ func (o *Options) Validate() field.ErrorList {
 		errs = append(errs, field.Invalid(newPath.Child("PlacementControllerWorkQueueRateLimiterOpts").Child("RateLimiterQPS"), o.PlacementMgmtOpts.PlacementControllerWorkQueueRateLimiterOpts.RateLimiterQPS, "the QPS for the placement controller set rate limiter must be less than its bucket size"))
 	}
 
+	// Cross-field validation for notification options.
+	// The webhook URL itself is validated when it is read from the file, so that it never appears in
+	// the validation errors.
+	if o.NotificationOpts.WebhookURLFile != "" {
+		if o.NotificationOpts.QPS <= 0 {
+			errs = append(errs, field.Invalid(newPath.Child("NotificationQPS"), o.NotificationOpts.QPS, "The QPS limit of the notifications must be greater than 0"))
+		}
+		if float64(o.NotificationOpts.Burst) < o.NotificationOpts.QPS {
+			errs = append(errs, field.Invalid(newPath.Child("NotificationBurst"), o.NotificationOpts.Burst, "The burst limit of the notifications must be greater than or equal to its QPS limit"))
+		}
+	} else if o.NotificationOpts.PayloadTemplateFile != "" {
+		errs = append(errs, field.Invalid(newPath.Child("NotificationPayloadTemplateFile"), o.NotificationOpts.PayloadTemplateFile, "A notification payload template is specified without a notification webhook URL file"))
+	}
+
+	// Cross-field validation for status export options.
+	if o.StatusExportOpts.Destination != "" {
+		if err := statusexporter.ValidateDestination(o.StatusExportOpts.Destination); err != nil {
+			errs = append(errs, field.Invalid(newPath.Child("StatusExportDestination"), o.StatusExportOpts.Destination, err.Error()))
+		} else if strings.HasPrefix(o.StatusExportOpts.Destination, "s3://") && o.StatusExportOpts.S3Region == "" {
+			errs = append(errs, field.Invalid(newPath.Child("StatusExportS3Region"), o.StatusExportOpts.S3Region, "The S3 region is required when the placement statuses are exported to S3"))
+		}
+		if o.StatusExportOpts.Interval <= 0 {
+			errs = append(errs, field.Invalid(newPath.Child("StatusExportInterval"), o.StatusExportOpts.Interval, "The status export interval must be greater than 0"))
+		}
+	}
+
+	// Cross-field validation for runtime config options.
+	if o.RuntimeConfigOpts.File != "" && o.RuntimeConfigOpts.ReloadInterval <= 0 {
+		errs = append(errs, field.Invalid(newPath.Child("RuntimeConfigReloadInterval"), o.RuntimeConfigOpts.ReloadInterval, "The runtime config reload interval must be greater than 0"))
+	}
+
 	return errs
 }
@@ -47,6 +47,8 @@ func newTestOptions(modifyOptions ModifyOptions) Options {
 		WebhookOpts: WebhookOptions{
 			ClientConnectionType: "url",
 			ServiceName:          testWebhookServiceName,
+			EnvelopeMaxManifests: 50,
+			EnvelopeMaxSizeBytes: 1048576,
 		},
 		PlacementMgmtOpts: PlacementManagementOptions{
 			SkippedPropagatingAPIs: "fleet.azure.com;multicluster.x-k8s.io",
@@ -114,6 +116,77 @@ func TestValidateControllerManagerConfiguration(t *testing.T) {
 			}),
 			want: field.ErrorList{},
 		},
+		"non-positive envelope limits with EnableWebhook": {
+			opt: newTestOptions(func(option *Options) {
+				option.WebhookOpts.EnableWebhooks = true
+				option.WebhookOpts.EnvelopeMaxManifests = 0
+				option.WebhookOpts.EnvelopeMaxSizeBytes = -1
+			}),
+			want: field.ErrorList{
+				field.Invalid(newPath.Child("EnvelopeMaxManifests"), 0, "The limit on the number of manifests an envelope may wrap must be greater than 0"),
+				field.Invalid(newPath.Child("EnvelopeMaxSizeBytes"), -1, "The limit on the total size of the manifests an envelope may wrap must be greater than 0"),
+			},
+		},
+		"external metrics API without webhooks": {
+			opt: newTestOptions(func(option *Options) {
+				option.FeatureFlags.EnableExternalMetricsAPI = true
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("EnableExternalMetricsAPI"), true, "The external metrics API is served with the serving certificates of the webhook server, which requires webhooks to be enabled")},
+		},
+		"external metrics API with webhooks": {
+			opt: newTestOptions(func(option *Options) {
+				option.FeatureFlags.EnableExternalMetricsAPI = true
+				option.WebhookOpts.EnableWebhooks = true
+			}),
+			want: field.ErrorList{},
+		},
+		"placement view API without webhooks": {
+			opt: newTestOptions(func(option *Options) {
+				option.FeatureFlags.EnablePlacementViewAPI = true
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("EnablePlacementViewAPI"), true, "The placement view API is served with the serving certificates of the webhook server, which requires webhooks to be enabled")},
+		},
+		"placement view API with webhooks": {
+			opt: newTestOptions(func(option *Options) {
+				option.FeatureFlags.EnablePlacementViewAPI = true
+				option.WebhookOpts.EnableWebhooks = true
+			}),
+			want: field.ErrorList{},
+		},
+		"resource content deduplication without the orphaned resource janitor": {
+			opt: newTestOptions(func(option *Options) {
+				option.FeatureFlags.EnableResourceContentDeduplication = true
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("EnableResourceContentDeduplication"), true, "Unreferenced resource contents are garbage collected by the orphaned resource janitor, which requires a positive orphaned resource cleanup interval")},
+		},
+		"resource content deduplication with the orphaned resource janitor": {
+			opt: newTestOptions(func(option *Options) {
+				option.FeatureFlags.EnableResourceContentDeduplication = true
+				option.PlacementMgmtOpts.OrphanedResourceCleanupInterval = 10 * time.Minute
+			}),
+			want: field.ErrorList{},
+		},
+		"reverse tunnel TLS certificate file without key file": {
+			opt: newTestOptions(func(option *Options) {
+				option.ClusterMgmtOpts.EnableReverseTunnel = true
+				option.ClusterMgmtOpts.ReverseTunnelTLSCertFile = "/certs/tls.crt"
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("ReverseTunnelTLSCertFile"), "/certs/tls.crt", "The TLS certificate and key files of the reverse tunnel server must be specified if the reverse tunnel server is enabled")},
+		},
+		"reverse tunnel without TLS": {
+			opt: newTestOptions(func(option *Options) {
+				option.ClusterMgmtOpts.EnableReverseTunnel = true
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("ReverseTunnelTLSCertFile"), "", "The TLS certificate and key files of the reverse tunnel server must be specified if the reverse tunnel server is enabled")},
+		},
+		"reverse tunnel with TLS": {
+			opt: newTestOptions(func(option *Options) {
+				option.ClusterMgmtOpts.EnableReverseTunnel = true
+				option.ClusterMgmtOpts.ReverseTunnelTLSCertFile = "/certs/tls.crt"
+				option.ClusterMgmtOpts.ReverseTunnelTLSKeyFile = "/certs/tls.key"
+			}),
+			want: field.ErrorList{},
+		},
 		"mutually exclusive allowed/skipped propagating APIs": {
 			opt: newTestOptions(func(option *Options) {
 				option.PlacementMgmtOpts.AllowedPropagatingAPIs = "apps/v1/Deployment"
@@ -134,6 +207,63 @@ func TestValidateControllerManagerConfiguration(t *testing.T) {
 			}),
 			want: field.ErrorList{field.Invalid(newPath.Child("PlacementControllerWorkQueueRateLimiterOpts").Child("RateLimiterQPS"), 100, "the QPS for the placement controller set rate limiter must be less than its bucket size")},
 		},
+		"valid notification options": {
+			opt: newTestOptions(func(option *Options) {
+				option.NotificationOpts.WebhookURLFile = "/etc/kubefleet/notification-webhook/url"
+				option.NotificationOpts.PayloadTemplateFile = "/etc/kubefleet/notification/payload.tmpl"
+				option.NotificationOpts.QPS = 1
+				option.NotificationOpts.Burst = 10
+			}),
+			want: field.ErrorList{},
+		},
+		"notification burst less than QPS": {
+			opt: newTestOptions(func(option *Options) {
+				option.NotificationOpts.WebhookURLFile = "/etc/kubefleet/notification-webhook/url"
+				option.NotificationOpts.QPS = 5
+				option.NotificationOpts.Burst = 1
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("NotificationBurst"), 1, "The burst limit of the notifications must be greater than or equal to its QPS limit")},
+		},
+		"notification payload template without webhook URL": {
+			opt: newTestOptions(func(option *Options) {
+				option.NotificationOpts.PayloadTemplateFile = "/etc/kubefleet/notification/payload.tmpl"
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("NotificationPayloadTemplateFile"), "/etc/kubefleet/notification/payload.tmpl", "A notification payload template is specified without a notification webhook URL file")},
+		},
+		"valid status export options": {
+			opt: newTestOptions(func(option *Options) {
+				option.StatusExportOpts.Destination = "s3://bucket/fleet"
+				option.StatusExportOpts.S3Region = "us-east-1"
+				option.StatusExportOpts.Interval = 15 * time.Minute
+			}),
+			want: field.ErrorList{},
+		},
+		"invalid status export destination": {
+			opt: newTestOptions(func(option *Options) {
+				option.StatusExportOpts.Destination = "gs://bucket"
+				option.StatusExportOpts.Interval = 15 * time.Minute
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("StatusExportDestination"), "gs://bucket", `unsupported destination "gs://bucket": must be s3://BUCKET[/PREFIX] or https://ACCOUNT.blob.core.windows.net/CONTAINER[/PREFIX]`)},
+		},
+		"status export to S3 without region": {
+			opt: newTestOptions(func(option *Options) {
+				option.StatusExportOpts.Destination = "s3://bucket"
+				option.StatusExportOpts.Interval = 15 * time.Minute
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("StatusExportS3Region"), "", "The S3 region is required when the placement statuses are exported to S3")},
+		},
+		"invalid status export interval": {
+			opt: newTestOptions(func(option *Options) {
+				option.StatusExportOpts.Destination = "https://account.blob.core.windows.net/container"
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("StatusExportInterval"), time.Duration(0), "The status export interval must be greater than 0")},
+		},
+		"invalid runtime config reload interval": {
+			opt: newTestOptions(func(option *Options) {
+				option.RuntimeConfigOpts.File = "/etc/kubefleet/runtime/config.yaml"
+			}),
+			want: field.ErrorList{field.Invalid(newPath.Child("RuntimeConfigReloadInterval"), time.Duration(0), "The runtime config reload interval must be greater than 0")},
+		},
 	}
 
 	for name, tc := range testCases {
@@ -43,13 +43,20 @@ type WebhookOptions struct {
 
 	// A list of comma-separated usernames who are whitelisted in the guard rail webhook and
 	// thus allowed to modify KubeFleet resources. This option only applies if the guard rail
-	// webhook is enabled.
+	// webhook is enabled, except that the whitelisted users are always allowed to create bindings
+	// and change their states (e.g., as an external scheduler), which the binding webhook otherwise
+	// reserves for the KubeFleet system components.
 	GuardRailWhitelistedUsers string
 
 	// Set the guard rail webhook to block users (with certain exceptions) from modifying the labels
 	// on the MemberCluster resources. This option only applies if the guard rail webhook is enabled.
 	GuardRailDenyModifyMemberClusterLabels bool
 
+	// Block the deletion of MemberCluster resources that still host the resources of any placement, unless
+	// the deletion validation is skipped via the delete options of the MemberCluster. The webhook always
+	// reports such placements as warnings. This option only applies if webhooks are enabled.
+	DenyMemberClusterDeleteWithPlacements bool
+
 	// Enable workload resources (pods and replicaSets) to be created in the hub cluster or not.
 	// If set to false, the KubeFleet pod and replicaset validating webhooks, which blocks the creation
 	// of pods and replicaSets outside KubeFleet reserved namespaces for most users, will be enabled.
@@ -65,6 +72,23 @@ type WebhookOptions struct {
 	// If set to false, the system will use self-signed certificates.
 	// This option only applies if webhooks are enabled.
 	UseCertManager bool
+
+	// Enable the KubeFleet conversion webhook or not. The conversion webhook converts KubeFleet API
+	// objects between the API versions served by the hub cluster, so that agents using different API
+	// versions can work with the same objects. This option only applies if webhooks are enabled.
+	EnableConversionWebhook bool
+
+	// The hub-wide limit on the number of manifests a ClusterResourceEnvelope or ResourceEnvelope may wrap,
+	// which the envelope validating webhook enforces at admission. The limit can be overridden for the
+	// ResourceEnvelopes in a namespace via an annotation on the namespace. This option only applies if
+	// webhooks are enabled.
+	EnvelopeMaxManifests int
+
+	// The hub-wide limit on the total size (in bytes) of the manifests a ClusterResourceEnvelope or
+	// ResourceEnvelope may wrap, which the envelope validating webhook enforces at admission. The limit can
+	// be overridden for the ResourceEnvelopes in a namespace via an annotation on the namespace. This option
+	// only applies if webhooks are enabled.
+	EnvelopeMaxSizeBytes int
 }
 
 // AddFlags adds flags for WebhookOptions to the specified FlagSet.
@@ -100,7 +124,7 @@ func (o *WebhookOptions) AddFlags(flags *flag.FlagSet) {
 		&o.GuardRailWhitelistedUsers,
 		"whitelisted-users",
 		"",
-		"A list of comma-separated usernames who are whitelisted in the guard rail webhook and thus allowed to modify KubeFleet resources. This option only applies if the guard rail webhook is enabled.",
+		"A list of comma-separated usernames who are whitelisted in the guard rail webhook and thus allowed to modify KubeFleet resources. This option only applies if the guard rail webhook is enabled, except that the whitelisted users are always allowed to create bindings and change their states (e.g., as an external scheduler).",
 	)
 
 	flags.BoolVar(
@@ -110,6 +134,13 @@ func (o *WebhookOptions) AddFlags(flags *flag.FlagSet) {
 		"Set the guard rail webhook to block users (with certain exceptions) from modifying the labels on the MemberCluster resources. This option only applies if the guard rail webhook is enabled.",
 	)
 
+	flags.BoolVar(
+		&o.DenyMemberClusterDeleteWithPlacements,
+		"deny-member-cluster-delete-with-placements",
+		false,
+		"Block the deletion of MemberCluster resources that still host the resources of any placement, unless the deletion validation is skipped via the delete options of the MemberCluster. The webhook always reports such placements as warnings. This option only applies if webhooks are enabled.",
+	)
+
 	flags.BoolVar(
 		&o.EnableWorkload,
 		"enable-workload",
@@ -131,6 +162,27 @@ func (o *WebhookOptions) AddFlags(flags *flag.FlagSet) {
 		false,
 		"Use the cert-manager project for managing KubeFleet webhook server certificates or not. If set to false, the system will use self-signed certificates. If set to true, the EnableWorkload option must be set to true as well. This option only applies if webhooks are enabled.",
 	)
+
+	flags.BoolVar(
+		&o.EnableConversionWebhook,
+		"enable-conversion-webhook",
+		false,
+		"Enable the KubeFleet conversion webhook or not. The conversion webhook converts KubeFleet API objects between the API versions served by the hub cluster, so that agents using different API versions can work with the same objects. This option only applies if webhooks are enabled.",
+	)
+
+	flags.IntVar(
+		&o.EnvelopeMaxManifests,
+		"envelope-max-manifests",
+		50,
+		"The hub-wide limit on the number of manifests a ClusterResourceEnvelope or ResourceEnvelope may wrap, which is enforced at admission. The limit can be overridden for the ResourceEnvelopes in a namespace via the kubernetes-fleet.io/envelope-max-manifests annotation on the namespace. Defaults to 50. Must be a positive value. This option only applies if webhooks are enabled.",
+	)
+
+	flags.IntVar(
+		&o.EnvelopeMaxSizeBytes,
+		"envelope-max-size-bytes",
+		1048576,
+		"The hub-wide limit on the total size (in bytes) of the manifests a ClusterResourceEnvelope or ResourceEnvelope may wrap, which is enforced at admission. The limit can be overridden for the ResourceEnvelopes in a namespace via the kubernetes-fleet.io/envelope-max-size-bytes annotation on the namespace. Defaults to 1048576 (1 MiB). Must be a positive value. This option only applies if webhooks are enabled.",
+	)
 }
 
 type WebhookClientConnTypeValueWithValidation string
@@ -18,7 +18,7 @@ package workload
 
 import (
 	"context"
-	"math"
+	"os"
 	"strings"
 	"sync"
 
@@ -34,15 +34,22 @@ import (
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/cmd/hubagent/options"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/bindingwatcher"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/bulkplacementoperation"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clusterinventory/clusterprofile"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clusterresourceplacementeviction"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/clusterresourceplacementstatuswatcher"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/externalrollout"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/janitor"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/overrider"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placement"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placementlifecycle"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placementnotifier"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/placementwatcher"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/propertycatalog"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/resourcechange"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/rollout"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/schedulingpolicysnapshot"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/statusexporter"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/updaterun"
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/workgenerator"
 	"github.com/kubefleet-dev/kubefleet/pkg/resourcewatcher"
@@ -54,10 +61,14 @@ import (
 	schedulerbindingwatcher "github.com/kubefleet-dev/kubefleet/pkg/scheduler/watchers/binding"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/watchers/membercluster"
 	schedulerplacementwatcher "github.com/kubefleet-dev/kubefleet/pkg/scheduler/watchers/placement"
+	schedulerplacementaffinitywatcher "github.com/kubefleet-dev/kubefleet/pkg/scheduler/watchers/placementaffinity"
 	schedulerspswatcher "github.com/kubefleet-dev/kubefleet/pkg/scheduler/watchers/schedulingpolicysnapshot"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/events"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/informer"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/prometheus"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/runtimeconfig"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/validator"
 )
 
@@ -68,8 +79,12 @@ const (
 	placementControllerName  = "placement-controller"
 
 	resourceChangeControllerName = "resource-change-controller"
+	evictionControllerName       = "cluster-resource-placement-eviction-controller"
 
 	schedulerQueueName = "scheduler-queue"
+
+	// cacheReportPath is the path on the metrics server at which the usage of the informer caches is served.
+	cacheReportPath = "/debug/cache"
 )
 
 var (
@@ -116,6 +131,38 @@ var (
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterResourcePlacementEvictionKind),
 		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterResourcePlacementDisruptionBudgetKind),
 	}
+
+	clusterExternalRolloutProgressGVKs = []schema.GroupVersionKind{
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterExternalRolloutProgressKind),
+	}
+
+	externalRolloutProgressGVKs = []schema.GroupVersionKind{
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ExternalRolloutProgressKind),
+	}
+
+	bulkPlacementOperationGVKs = []schema.GroupVersionKind{
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.BulkPlacementOperationKind),
+	}
+
+	diffReportGVKs = []schema.GroupVersionKind{
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.DiffReportKind),
+	}
+
+	clusterRolloutPolicyGVKs = []schema.GroupVersionKind{
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterRolloutPolicyKind),
+	}
+
+	resourceContentGVKs = []schema.GroupVersionKind{
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.ClusterResourceContentKind),
+	}
+
+	schedulingProfileGVKs = []schema.GroupVersionKind{
+		placementv1beta1.GroupVersion.WithKind(placementv1beta1.SchedulingProfileKind),
+	}
+
+	propertyCatalogGVKs = []schema.GroupVersionKind{
+		clusterv1beta1.GroupVersion.WithKind(clusterv1beta1.PropertyCatalogKind),
+	}
 )
 
 // SetupControllers set up the customized controllers we developed
@@ -152,7 +199,14 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 	}
 
 	// the manager for all the dynamically created informers
-	dynamicInformerManager := informer.NewInformerManager(dynamicClient, opts.CtrlMgrOpts.ResyncPeriod.Duration, ctx.Done())
+	dynamicInformerManager := informer.NewInformerManager(dynamicClient, opts.CtrlMgrOpts.ResyncPeriod.Duration, ctx.Done(), opts.CtrlMgrOpts.CacheStatusStrippedResources...)
+	if opts.CtrlMgrOpts.EnablePprof {
+		// Serve the usage of the informer caches next to the metrics, to help profile the memory usage along with pprof.
+		if err := mgr.AddMetricsServerExtraHandler(cacheReportPath, informer.NewCacheReportHandler(dynamicInformerManager)); err != nil {
+			klog.ErrorS(err, "Failed to add the cache report handler to the metrics server")
+			return err
+		}
+	}
 	validator.ResourceInformer = dynamicInformerManager // webhook needs this to check resource scope
 	validator.RestMapper = mgr.GetRESTMapper()          // webhook needs this to validate GVK of resource selector
 
@@ -166,16 +220,30 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 	}
 	resourceSnapshotResolver := controller.NewResourceSnapshotResolver(mgr.GetClient(), mgr.GetScheme())
 	resourceSnapshotResolver.Config = controller.NewResourceSnapshotConfig(opts.PlacementMgmtOpts.ResourceSnapshotCreationMinimumInterval, opts.PlacementMgmtOpts.ResourceChangesCollectionDuration)
+	if opts.FeatureFlags.EnableResourceContentDeduplication {
+		for _, gvk := range resourceContentGVKs {
+			if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+				klog.ErrorS(err, "Unable to find the required CRD", "GVK", gvk)
+				return err
+			}
+		}
+		klog.Info("Enabling the deduplication of resource snapshot contents")
+		resourceSnapshotResolver.EnableContentDeduplication = true
+	}
 	pc := &placement.Reconciler{
 		Client:                   mgr.GetClient(),
-		Recorder:                 mgr.GetEventRecorderFor(placementControllerName),
+		Recorder:                 events.NewRateLimitedRecorder(mgr.GetEventRecorderFor(placementControllerName), events.DefaultDedupWindow),
 		Scheme:                   mgr.GetScheme(),
 		UncachedReader:           mgr.GetAPIReader(),
 		ResourceSelectorResolver: resourceSelectorResolver,
 		ResourceSnapshotResolver: resourceSnapshotResolver,
 	}
 
-	rateLimiter := options.DefaultControllerRateLimiter(opts.PlacementMgmtOpts.PlacementControllerWorkQueueRateLimiterOpts)
+	// The rate limiter and the worker limits of the placement controllers can be tuned at runtime via the
+	// runtime config file, if one is specified.
+	rateLimiter := controller.NewReloadableRateLimiter(options.DefaultControllerRateLimiter(opts.PlacementMgmtOpts.PlacementControllerWorkQueueRateLimiterOpts))
+	crpWorkerLimiter := controller.NewWorkerLimiter(opts.PlacementMgmtOpts.EffectivePlacementControllerWorkers())
+	rpWorkerLimiter := controller.NewWorkerLimiter(opts.PlacementMgmtOpts.EffectivePlacementControllerWorkers())
 	var clusterResourcePlacementControllerV1Beta1 controller.Controller
 	var resourcePlacementController controller.Controller
 
@@ -187,7 +255,7 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 			}
 		}
 		klog.Info("Setting up clusterResourcePlacement v1beta1 controller")
-		clusterResourcePlacementControllerV1Beta1 = controller.NewController(crpControllerV1Beta1Name, controller.NamespaceKeyFunc, pc.Reconcile, rateLimiter)
+		clusterResourcePlacementControllerV1Beta1 = controller.NewController(crpControllerV1Beta1Name, controller.NamespaceKeyFunc, pc.Reconcile, rateLimiter, controller.WithWorkerLimiter(crpWorkerLimiter))
 		klog.Info("Setting up clusterResourcePlacement watcher")
 		if err := (&placementwatcher.Reconciler{
 			PlacementController: clusterResourcePlacementControllerV1Beta1,
@@ -231,7 +299,7 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 				}
 			}
 			klog.Info("Setting up resourcePlacement controller")
-			resourcePlacementController = controller.NewController(rpControllerName, controller.NamespaceKeyFunc, pc.Reconcile, rateLimiter)
+			resourcePlacementController = controller.NewController(rpControllerName, controller.NamespaceKeyFunc, pc.Reconcile, rateLimiter, controller.WithWorkerLimiter(rpWorkerLimiter))
 			klog.Info("Setting up resourcePlacement watcher")
 			if err := (&placementwatcher.Reconciler{
 				PlacementController: resourcePlacementController,
@@ -260,12 +328,24 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 		}
 
 		// Set up a new controller to do rollout resources according to CRP/RP rollout strategy
+		if opts.FeatureFlags.EnableClusterRolloutPolicyAPIs {
+			for _, gvk := range clusterRolloutPolicyGVKs {
+				if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+					klog.ErrorS(err, "Unable to find the required CRD", "GVK", gvk)
+					return err
+				}
+			}
+		}
 		klog.Info("Setting up rollout controller")
+		rolloutAnalysisQuerier := prometheus.NewQuerier(nil, strings.Split(opts.PlacementMgmtOpts.RolloutAnalysisPrometheusAddresses, ","))
 		if err := (&rollout.Reconciler{
-			Client:                  mgr.GetClient(),
-			UncachedReader:          mgr.GetAPIReader(),
-			MaxConcurrentReconciles: int(math.Ceil(float64(opts.PlacementMgmtOpts.MaxFleetSize)/30) * math.Ceil(float64(opts.PlacementMgmtOpts.MaxConcurrentClusterPlacement)/10)),
-			InformerManager:         dynamicInformerManager,
+			Client:                       mgr.GetClient(),
+			UncachedReader:               mgr.GetAPIReader(),
+			MaxConcurrentReconciles:      opts.PlacementMgmtOpts.EffectiveRolloutControllerWorkers(),
+			InformerManager:              dynamicInformerManager,
+			WorkCleanupGracePeriod:       opts.PlacementMgmtOpts.WorkCleanupGracePeriod,
+			EnableClusterRolloutPolicies: opts.FeatureFlags.EnableClusterRolloutPolicyAPIs,
+			MetricQuerier:                rolloutAnalysisQuerier,
 		}).SetupWithManagerForClusterResourcePlacement(mgr); err != nil {
 			klog.ErrorS(err, "Unable to set up rollout controller for clusterResourcePlacement")
 			return err
@@ -273,10 +353,13 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 
 		if opts.FeatureFlags.EnableResourcePlacementAPIs {
 			if err := (&rollout.Reconciler{
-				Client:                  mgr.GetClient(),
-				UncachedReader:          mgr.GetAPIReader(),
-				MaxConcurrentReconciles: int(math.Ceil(float64(opts.PlacementMgmtOpts.MaxFleetSize)/30) * math.Ceil(float64(opts.PlacementMgmtOpts.MaxConcurrentClusterPlacement)/10)),
-				InformerManager:         dynamicInformerManager,
+				Client:                       mgr.GetClient(),
+				UncachedReader:               mgr.GetAPIReader(),
+				MaxConcurrentReconciles:      opts.PlacementMgmtOpts.EffectiveRolloutControllerWorkers(),
+				InformerManager:              dynamicInformerManager,
+				WorkCleanupGracePeriod:       opts.PlacementMgmtOpts.WorkCleanupGracePeriod,
+				EnableClusterRolloutPolicies: opts.FeatureFlags.EnableClusterRolloutPolicyAPIs,
+				MetricQuerier:                rolloutAnalysisQuerier,
 			}).SetupWithManagerForResourcePlacement(mgr); err != nil {
 				klog.ErrorS(err, "Unable to set up rollout controller for resourcePlacement")
 				return err
@@ -294,12 +377,69 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 			if err := (&clusterresourceplacementeviction.Reconciler{
 				Client:         mgr.GetClient(),
 				UncachedReader: mgr.GetAPIReader(),
+				Recorder:       mgr.GetEventRecorderFor(evictionControllerName),
 			}).SetupWithManager(mgr); err != nil {
 				klog.ErrorS(err, "Unable to set up cluster resource placement eviction controller")
 				return err
 			}
 		}
 
+		// Set up a controller to update the bindings of placements of the External rollout strategy type
+		// according to the progress reported by the external rollout controllers.
+		if opts.FeatureFlags.EnableExternalRolloutProgressAPIs {
+			for _, gvk := range clusterExternalRolloutProgressGVKs {
+				if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+					klog.ErrorS(err, "Unable to find the required CRD", "GVK", gvk)
+					return err
+				}
+			}
+			klog.Info("Setting up clusterExternalRolloutProgress controller")
+			if err := (&externalrollout.Reconciler{
+				Client:          mgr.GetClient(),
+				UncachedReader:  mgr.GetAPIReader(),
+				InformerManager: dynamicInformerManager,
+			}).SetupWithManagerForClusterResourcePlacement(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up clusterExternalRolloutProgress controller")
+				return err
+			}
+
+			if opts.FeatureFlags.EnableResourcePlacementAPIs {
+				for _, gvk := range externalRolloutProgressGVKs {
+					if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+						klog.ErrorS(err, "Unable to find the required CRD", "GVK", gvk)
+						return err
+					}
+				}
+				klog.Info("Setting up externalRolloutProgress controller")
+				if err := (&externalrollout.Reconciler{
+					Client:          mgr.GetClient(),
+					UncachedReader:  mgr.GetAPIReader(),
+					InformerManager: dynamicInformerManager,
+				}).SetupWithManagerForResourcePlacement(mgr); err != nil {
+					klog.ErrorS(err, "Unable to set up externalRolloutProgress controller")
+					return err
+				}
+			}
+		}
+
+		// Set up a controller to run operations on many placements at once.
+		if opts.FeatureFlags.EnableBulkPlacementOperationAPIs {
+			for _, gvk := range bulkPlacementOperationGVKs {
+				if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+					klog.ErrorS(err, "Unable to find the required CRD", "GVK", gvk)
+					return err
+				}
+			}
+			klog.Info("Setting up bulkPlacementOperation controller")
+			if err := (&bulkplacementoperation.Reconciler{
+				Client:                  mgr.GetClient(),
+				EnableResourcePlacement: opts.FeatureFlags.EnableResourcePlacementAPIs,
+			}).SetupWithManager(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up bulkPlacementOperation controller")
+				return err
+			}
+		}
+
 		// Set up a controller to do staged update run, rolling out resources to clusters in a stage by stage manner.
 		if opts.FeatureFlags.EnableStagedUpdateRunAPIs {
 			for _, gvk := range clusterStagedUpdateRunGVKs {
@@ -340,11 +480,23 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 		}
 
 		// Set up the work generator
+		if opts.FeatureFlags.EnableDiffReportAPIs {
+			for _, gvk := range diffReportGVKs {
+				if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+					klog.ErrorS(err, "Unable to find the required CRD", "GVK", gvk)
+					return err
+				}
+			}
+		}
 		klog.Info("Setting up work generator")
 		if err := (&workgenerator.Reconciler{
-			Client:                  mgr.GetClient(),
-			MaxConcurrentReconciles: int(math.Ceil(float64(opts.PlacementMgmtOpts.MaxFleetSize)/10) * math.Ceil(float64(opts.PlacementMgmtOpts.MaxConcurrentClusterPlacement)/10)),
-			InformerManager:         dynamicInformerManager,
+			Client:                           mgr.GetClient(),
+			MaxConcurrentReconciles:          opts.PlacementMgmtOpts.EffectiveWorkGeneratorWorkers(),
+			InformerManager:                  dynamicInformerManager,
+			EnableResourcePlacement:          opts.FeatureFlags.EnableResourcePlacementAPIs,
+			EnableDiffReports:                opts.FeatureFlags.EnableDiffReportAPIs,
+			DiffedPlacementsSummaryThreshold: opts.PlacementMgmtOpts.DiffedPlacementsSummaryThreshold,
+			OnDemandDiffReportTTL:            opts.PlacementMgmtOpts.OnDemandDiffReportTTL,
 		}).SetupWithManagerForClusterResourceBinding(mgr); err != nil {
 			klog.ErrorS(err, "Unable to set up work generator for clusterResourceBinding")
 			return err
@@ -352,9 +504,13 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 
 		if opts.FeatureFlags.EnableResourcePlacementAPIs {
 			if err := (&workgenerator.Reconciler{
-				Client:                  mgr.GetClient(),
-				MaxConcurrentReconciles: int(math.Ceil(float64(opts.PlacementMgmtOpts.MaxFleetSize)/10) * math.Ceil(float64(opts.PlacementMgmtOpts.MaxConcurrentClusterPlacement)/10)),
-				InformerManager:         dynamicInformerManager,
+				Client:                           mgr.GetClient(),
+				MaxConcurrentReconciles:          opts.PlacementMgmtOpts.EffectiveWorkGeneratorWorkers(),
+				InformerManager:                  dynamicInformerManager,
+				EnableResourcePlacement:          true,
+				EnableDiffReports:                opts.FeatureFlags.EnableDiffReportAPIs,
+				DiffedPlacementsSummaryThreshold: opts.PlacementMgmtOpts.DiffedPlacementsSummaryThreshold,
+				OnDemandDiffReportTTL:            opts.PlacementMgmtOpts.OnDemandDiffReportTTL,
 			}).SetupWithManagerForResourceBinding(mgr); err != nil {
 				klog.ErrorS(err, "Unable to set up work generator for resourceBinding")
 				return err
@@ -363,14 +519,32 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 
 		// Set up the scheduler
 		klog.Info("Setting up scheduler")
-		defaultProfile := profile.NewDefaultProfile()
-		defaultFramework := framework.NewFramework(defaultProfile, mgr)
+		if opts.FeatureFlags.EnableSchedulingProfileAPIs {
+			for _, gvk := range schedulingProfileGVKs {
+				if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+					klog.ErrorS(err, "Unable to find the required CRD", "GVK", gvk)
+					return err
+				}
+			}
+		}
+		defaultProfile := profile.NewProfile(profile.Options{
+			EnablePlacementSpreadPlugin:       opts.PlacementMgmtOpts.EnablePlacementSpreadScoring,
+			EnableScaleDownFiltering:          opts.PlacementMgmtOpts.EnableScaleDownFiltering,
+			EnableProvisioningHeadroomScoring: opts.PlacementMgmtOpts.EnableProvisioningHeadroomScoring,
+		})
+		defaultFramework := framework.NewFramework(defaultProfile, mgr,
+			framework.WithResourcePlacementEnabled(opts.FeatureFlags.EnableResourcePlacementAPIs),
+			framework.WithMaxClusterDecisionCount(opts.PlacementMgmtOpts.MaxUnselectedClusterDecisionCount),
+			framework.WithSchedulingCycleSnapshotCount(opts.PlacementMgmtOpts.SchedulingCycleSnapshotCount),
+			framework.WithNumOfWorkers(opts.PlacementMgmtOpts.SchedulerPluginWorkers),
+			framework.WithPluginEvaluationTimeout(opts.PlacementMgmtOpts.SchedulerPluginEvaluationTimeout),
+			framework.WithSchedulingProfilesEnabled(opts.FeatureFlags.EnableSchedulingProfileAPIs))
 		defaultSchedulingQueue := queue.NewSimplePlacementSchedulingQueue(
 			schedulerQueueName, nil,
 		)
 		// we use one scheduler for every 10 concurrent placement
 		defaultScheduler := scheduler.NewScheduler("DefaultScheduler", defaultFramework, defaultSchedulingQueue, mgr,
-			int(math.Ceil(float64(opts.PlacementMgmtOpts.MaxFleetSize)/50)*math.Ceil(float64(opts.PlacementMgmtOpts.MaxConcurrentClusterPlacement)/10)))
+			opts.PlacementMgmtOpts.EffectiveSchedulerWorkers())
 		klog.Info("Starting the scheduler")
 		// Scheduler must run in a separate goroutine as Run() is a blocking call.
 		wg.Add(1)
@@ -402,6 +576,15 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 			return err
 		}
 
+		klog.Info("Setting up the clusterResourcePlacement affinity watcher for scheduler")
+		if err := (&schedulerplacementaffinitywatcher.Reconciler{
+			Client:             mgr.GetClient(),
+			SchedulerWorkQueue: defaultSchedulingQueue,
+		}).SetupWithManagerForClusterResourcePlacement(mgr); err != nil {
+			klog.ErrorS(err, "Unable to set up clusterResourcePlacement affinity watcher for scheduler")
+			return err
+		}
+
 		klog.Info("Setting up the clusterResourceBinding watcher for scheduler")
 		if err := (&schedulerbindingwatcher.Reconciler{
 			Client:             mgr.GetClient(),
@@ -430,6 +613,15 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 				return err
 			}
 
+			klog.Info("Setting up the resourcePlacement affinity watcher for scheduler")
+			if err := (&schedulerplacementaffinitywatcher.Reconciler{
+				Client:             mgr.GetClient(),
+				SchedulerWorkQueue: defaultSchedulingQueue,
+			}).SetupWithManagerForResourcePlacement(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up resourcePlacement affinity watcher for scheduler")
+				return err
+			}
+
 			klog.Info("Setting up the resourceBinding watcher for scheduler")
 			if err := (&schedulerbindingwatcher.Reconciler{
 				Client:             mgr.GetClient(),
@@ -467,11 +659,110 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 			Reconciler: overrider.Reconciler{
 				Client: mgr.GetClient(),
 			},
+			EnableResourcePlacement: opts.FeatureFlags.EnableResourcePlacementAPIs,
 		}).SetupWithManager(mgr); err != nil {
 			klog.ErrorS(err, "Unable to set up resourceOverride controller")
 			return err
 		}
 
+		if opts.PlacementMgmtOpts.OrphanedResourceCleanupInterval > 0 {
+			klog.Info("Setting up the orphaned resource janitor")
+			if err := mgr.Add(&janitor.Janitor{
+				Client:                             mgr.GetClient(),
+				Interval:                           opts.PlacementMgmtOpts.OrphanedResourceCleanupInterval,
+				DryRun:                             opts.PlacementMgmtOpts.OrphanedResourceCleanupDryRun,
+				EnableResourcePlacement:            opts.FeatureFlags.EnableResourcePlacementAPIs,
+				EnableResourceContentDeduplication: opts.FeatureFlags.EnableResourceContentDeduplication,
+			}); err != nil {
+				klog.ErrorS(err, "Unable to set up the orphaned resource janitor")
+				return err
+			}
+		}
+
+		// The placement lifecycle events are only watched for if any subscriber (e.g., a networking controller
+		// built into the hub agent) has registered to them.
+		if subscribers := placementlifecycle.RegisteredSubscribers(); len(subscribers) > 0 {
+			klog.InfoS("Setting up the clusterResourceBinding lifecycle watcher", "subscribers", len(subscribers))
+			if err := (&placementlifecycle.Reconciler{
+				Client:      mgr.GetClient(),
+				Subscribers: subscribers,
+			}).SetupWithManagerForClusterResourceBinding(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up the clusterResourceBinding lifecycle watcher")
+				return err
+			}
+			if opts.FeatureFlags.EnableResourcePlacementAPIs {
+				klog.InfoS("Setting up the resourceBinding lifecycle watcher", "subscribers", len(subscribers))
+				if err := (&placementlifecycle.Reconciler{
+					Client:      mgr.GetClient(),
+					Subscribers: subscribers,
+				}).SetupWithManagerForResourceBinding(mgr); err != nil {
+					klog.ErrorS(err, "Unable to set up the resourceBinding lifecycle watcher")
+					return err
+				}
+			}
+		}
+
+		if opts.NotificationOpts.WebhookURLFile != "" {
+			klog.Info("Setting up the placement notifier")
+			b, err := os.ReadFile(opts.NotificationOpts.WebhookURLFile)
+			if err != nil {
+				klog.ErrorS(err, "Unable to read the notification webhook URL", "file", opts.NotificationOpts.WebhookURLFile)
+				return err
+			}
+			webhookURL := strings.TrimSpace(string(b))
+			var payloadTemplate string
+			if opts.NotificationOpts.PayloadTemplateFile != "" {
+				b, err := os.ReadFile(opts.NotificationOpts.PayloadTemplateFile)
+				if err != nil {
+					klog.ErrorS(err, "Unable to read the notification payload template", "file", opts.NotificationOpts.PayloadTemplateFile)
+					return err
+				}
+				payloadTemplate = string(b)
+			}
+			sink, err := placementnotifier.NewWebhookSink(webhookURL, payloadTemplate, nil)
+			if err != nil {
+				klog.ErrorS(err, "Unable to set up the notification webhook sink")
+				return err
+			}
+			notifier := placementnotifier.NewNotifier(sink, opts.NotificationOpts.QPS, opts.NotificationOpts.Burst, opts.NotificationOpts.DedupInterval)
+			if err := (&placementnotifier.Reconciler{
+				Client:   mgr.GetClient(),
+				Notifier: notifier,
+			}).SetupWithManagerForClusterResourcePlacement(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up the clusterResourcePlacement notifier")
+				return err
+			}
+			if opts.FeatureFlags.EnableResourcePlacementAPIs {
+				if err := (&placementnotifier.Reconciler{
+					Client:   mgr.GetClient(),
+					Notifier: notifier,
+				}).SetupWithManagerForResourcePlacement(mgr); err != nil {
+					klog.ErrorS(err, "Unable to set up the resourcePlacement notifier")
+					return err
+				}
+			}
+		}
+
+		if opts.StatusExportOpts.Destination != "" {
+			klog.Info("Setting up the placement status exporter")
+			store, err := statusexporter.NewObjectStore(ctx, opts.StatusExportOpts.Destination, opts.StatusExportOpts.S3Region, opts.StatusExportOpts.S3Endpoint)
+			if err != nil {
+				klog.ErrorS(err, "Unable to set up the object store for the placement status exporter", "destination", opts.StatusExportOpts.Destination)
+				return err
+			}
+			if err := mgr.Add(&statusexporter.Exporter{
+				Client:                  mgr.GetClient(),
+				APIReader:               mgr.GetAPIReader(),
+				Store:                   store,
+				Interval:                opts.StatusExportOpts.Interval,
+				BookmarkNamespace:       utils.FleetSystemNamespace,
+				EnableResourcePlacement: opts.FeatureFlags.EnableResourcePlacementAPIs,
+			}); err != nil {
+				klog.ErrorS(err, "Unable to set up the placement status exporter")
+				return err
+			}
+		}
+
 		// Verify cluster inventory CRD installation status.
 		if opts.FeatureFlags.EnableClusterInventoryAPIs {
 			for _, gvk := range clusterInventoryGVKs {
@@ -490,6 +781,23 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 				return err
 			}
 		}
+
+		// Set up a controller to catalog the properties published by the member clusters.
+		if opts.FeatureFlags.EnablePropertyCatalogAPIs {
+			for _, gvk := range propertyCatalogGVKs {
+				if err = utils.CheckCRDInstalled(discoverClient, gvk); err != nil {
+					klog.ErrorS(err, "Unable to find the required CRD", "GVK", gvk)
+					return err
+				}
+			}
+			klog.Info("Setting up propertyCatalog controller")
+			if err := (&propertycatalog.Reconciler{
+				Client: mgr.GetClient(),
+			}).SetupWithManager(mgr); err != nil {
+				klog.ErrorS(err, "Unable to set up propertyCatalog controller")
+				return err
+			}
+		}
 	}
 
 	// Set up a new controller to reconcile any resources in the cluster
@@ -530,7 +838,7 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 		InformerManager:                           dynamicInformerManager,
 		ResourceConfig:                            resourceConfig,
 		SkippedNamespaces:                         skippedNamespaces,
-		ConcurrentPlacementWorker:                 int(math.Ceil(float64(opts.PlacementMgmtOpts.MaxConcurrentClusterPlacement) / 10)),
+		ConcurrentPlacementWorker:                 opts.PlacementMgmtOpts.EffectivePlacementControllerWorkers(),
 		ConcurrentResourceChangeWorker:            opts.PlacementMgmtOpts.ConcurrentResourceChangeSyncs,
 		EnableWorkload:                            opts.WebhookOpts.EnableWorkload,
 	}
@@ -539,5 +847,66 @@ func SetupControllers(ctx context.Context, wg *sync.WaitGroup, mgr ctrl.Manager,
 		klog.ErrorS(err, "Failed to setup resource detector")
 		return err
 	}
+
+	if opts.RuntimeConfigOpts.File != "" {
+		klog.Info("Setting up the runtime config watcher")
+		applier := newPlacementRuntimeConfigApplier(opts.PlacementMgmtOpts, rateLimiter, resourceSnapshotResolver.Config, crpWorkerLimiter, rpWorkerLimiter)
+		if err := mgr.Add(runtimeconfig.NewWatcher(opts.RuntimeConfigOpts.File, opts.RuntimeConfigOpts.ReloadInterval, applier)); err != nil {
+			klog.ErrorS(err, "Failed to setup the runtime config watcher")
+			return err
+		}
+	}
 	return nil
 }
+
+// newPlacementRuntimeConfigApplier returns an applier that applies the runtime config to the placement
+// controllers; the tunables that the runtime config does not set fall back to their command-line values.
+func newPlacementRuntimeConfigApplier(
+	placementOpts options.PlacementManagementOptions,
+	rateLimiter *controller.ReloadableRateLimiter,
+	snapshotConfig *controller.ResourceSnapshotConfig,
+	workerLimiters ...*controller.WorkerLimiter,
+) runtimeconfig.Applier {
+	appliedRateLimitOpts := placementOpts.PlacementControllerWorkQueueRateLimiterOpts
+	return func(cfg *runtimeconfig.Config) {
+		// Workers cannot be added at runtime; the limit can only be lowered from the number of workers
+		// the controllers start with.
+		workers := placementOpts.EffectivePlacementControllerWorkers()
+		if cfg.PlacementControllerWorkers != nil {
+			workers = min(workers, *cfg.PlacementControllerWorkers)
+		}
+		for _, limiter := range workerLimiters {
+			limiter.SetLimit(workers)
+		}
+
+		rateLimitOpts := placementOpts.PlacementControllerWorkQueueRateLimiterOpts
+		if rl := cfg.PlacementControllerRateLimiter; rl != nil {
+			rateLimitOpts = options.RateLimitOptions{
+				RateLimiterBaseDelay:  rl.BaseDelay.Duration,
+				RateLimiterMaxDelay:   rl.MaxDelay.Duration,
+				RateLimiterQPS:        rl.QPS,
+				RateLimiterBucketSize: rl.BucketSize,
+			}
+		}
+		// Swapping the rate limiter resets the backoffs of all the queued items; do it only if the
+		// settings have changed.
+		if rateLimitOpts != appliedRateLimitOpts {
+			rateLimiter.Set(options.DefaultControllerRateLimiter(rateLimitOpts))
+			appliedRateLimitOpts = rateLimitOpts
+		}
+
+		creationInterval := placementOpts.ResourceSnapshotCreationMinimumInterval
+		if cfg.ResourceSnapshotCreationMinimumInterval != nil {
+			creationInterval = cfg.ResourceSnapshotCreationMinimumInterval.Duration
+		}
+		collectionDuration := placementOpts.ResourceChangesCollectionDuration
+		if cfg.ResourceChangesCollectionDuration != nil {
+			collectionDuration = cfg.ResourceChangesCollectionDuration.Duration
+		}
+		snapshotConfig.Update(creationInterval, collectionDuration)
+
+		klog.V(2).InfoS("Applied the runtime config to the placement controllers",
+			"workers", workers, "rateLimitOptions", rateLimitOpts,
+			"resourceSnapshotCreationMinimumInterval", creationInterval, "resourceChangesCollectionDuration", collectionDuration)
+	}
+}
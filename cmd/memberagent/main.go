@@ -32,16 +32,19 @@ import (
 	"time"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/dynamic"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
@@ -55,15 +58,23 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/controllers/workapplier"
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/azure"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/connectivity"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/cost"
+	"github.com/kubefleet-dev/kubefleet/pkg/tunnel"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/events"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/faultinjection"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/httpclient"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/hubconnectivity"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
+	"github.com/kubefleet-dev/kubefleet/pkg/version"
 	//+kubebuilder:scaffold:imports
 )
 
 const (
 	// The list of available property provider names.
 	azurePropertyProvider = "azure"
+	costPropertyProvider  = "cost"
 )
 
 var (
@@ -94,19 +105,51 @@ func main() {
 	// Set up controller-runtime logger
 	ctrl.SetLogger(zap.New(zap.UseDevMode(true)))
 
+	versionInfo := version.Get()
+	klog.InfoS("Starting the member agent", "version", versionInfo.Version, "gitCommit", versionInfo.GitCommit)
+
 	hubURL := os.Getenv("HUB_SERVER_URL")
 
 	if hubURL == "" {
 		klog.ErrorS(errors.New("hub server api cannot be empty"), "Failed to read URL for the hub cluster")
 		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
 	}
-	hubConfig, err := buildHubConfig(hubURL, opts.HubConnectivityOpts.UseCertificateAuth, opts.HubConnectivityOpts.UseInsecureTLSClient)
+	if err := hubconnectivity.ValidateHubURL(hubURL); err != nil {
+		klog.ErrorS(err, "Failed to validate URL for the hub cluster")
+		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+	}
+	hubConfig, err := buildHubConfig(hubURL, opts.HubConnectivityOpts)
 	if err != nil {
 		klog.ErrorS(err, "Failed to build Kubernetes client configuration for the hub cluster")
 		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
 	}
 	hubConfig.QPS = float32(opts.CtrlManagerOptions.HubManagerOpts.QPS)
 	hubConfig.Burst = opts.CtrlManagerOptions.HubManagerOpts.Burst
+	// Inject faults into the communication with the hub cluster, if asked to; this is for testing
+	// purposes only.
+	faults, err := faultinjection.FaultsFromEnv()
+	if err != nil {
+		klog.ErrorS(err, "Failed to read the faults to inject")
+		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+	}
+	if !faults.IsEmpty() {
+		klog.InfoS("Injecting faults into the communication with the hub cluster; this should never happen in production",
+			"latency", faults.Latency, "partitions", faults.Partitions, "clockSkew", faults.ClockSkew)
+		hubConfig.Wrap(faultinjection.NewInjector(faults).WrapTransport)
+	}
+	// Keep track of the failures when connecting to the hub cluster, so that they can be reported
+	// in the status of the InternalMemberCluster object once the connection recovers.
+	hubConnectivityTracker := hubconnectivity.NewTracker()
+	hubConfig.Wrap(hubConnectivityTracker.WrapTransport)
+
+	ctx := ctrl.SetupSignalHandler()
+	if timeout := opts.HubConnectivityOpts.PreflightCheckTimeoutSeconds; timeout > 0 {
+		klog.InfoS("Verifying connectivity to the hub cluster", "hubURL", hubURL, "timeout", timeout)
+		if err := hubconnectivity.Preflight(ctx, hubConfig, time.Second*time.Duration(timeout)); err != nil {
+			klog.ErrorS(err, "Failed the preflight check of connectivity to the hub cluster")
+			klog.FlushAndExit(klog.ExitFlushTimeout, 1)
+		}
+	}
 
 	mcName := os.Getenv("MEMBER_CLUSTER_NAME")
 	if mcName == "" {
@@ -171,17 +214,17 @@ func main() {
 		hubOpts.PprofBindAddress = fmt.Sprintf(":%d", opts.CtrlManagerOptions.HubManagerOpts.PprofPort)
 	}
 
-	if err := Start(ctrl.SetupSignalHandler(), hubConfig, memberConfig, hubOpts, memberOpts, *opts); err != nil {
+	if err := Start(ctx, hubConfig, memberConfig, hubOpts, memberOpts, *opts, hubConnectivityTracker, mcName); err != nil {
 		klog.ErrorS(err, "Failed to start the controllers for the member agent")
 		klog.FlushAndExit(klog.ExitFlushTimeout, 1)
 	}
 }
 
-func buildHubConfig(hubURL string, useCertificateAuth bool, tlsClientInsecure bool) (*rest.Config, error) {
+func buildHubConfig(hubURL string, hubConnectivityOpts options.HubConnectivityOptions) (*rest.Config, error) {
 	var hubConfig = &rest.Config{
 		Host: hubURL,
 	}
-	if useCertificateAuth {
+	if hubConnectivityOpts.UseCertificateAuth {
 		keyFilePath := os.Getenv("IDENTITY_KEY")
 		certFilePath := os.Getenv("IDENTITY_CERT")
 		if keyFilePath == "" {
@@ -219,8 +262,12 @@ func buildHubConfig(hubURL string, useCertificateAuth bool, tlsClientInsecure bo
 		hubConfig.BearerTokenFile = tokenFilePath
 	}
 
+	tlsClientInsecure := hubConnectivityOpts.UseInsecureTLSClient
 	hubConfig.TLSClientConfig.Insecure = tlsClientInsecure
-	if !tlsClientInsecure {
+	if !tlsClientInsecure && hubConnectivityOpts.CABundlePath != "" {
+		// The CA bundle set via the command-line flag overrides the environment variables.
+		hubConfig.TLSClientConfig.CAFile = hubConnectivityOpts.CABundlePath
+	} else if !tlsClientInsecure {
 		caBundle, ok := os.LookupEnv("CA_BUNDLE")
 		if ok && caBundle == "" {
 			err := errors.New("environment variable CA_BUNDLE should not be empty")
@@ -251,6 +298,28 @@ func buildHubConfig(hubURL string, useCertificateAuth bool, tlsClientInsecure bo
 		}
 	}
 
+	// Set the server name to use for SNI and certificate verification, if specified.
+	serverName := hubConnectivityOpts.TLSServerName
+	if serverName == "" {
+		serverName = os.Getenv("HUB_TLS_SERVER_NAME")
+	}
+	hubConfig.TLSClientConfig.ServerName = serverName
+
+	// Connect to the hub cluster via a proxy, if specified; otherwise the standard proxy environment
+	// variables (HTTPS_PROXY and NO_PROXY) apply.
+	proxyURL := hubConnectivityOpts.ProxyURL
+	if proxyURL == "" {
+		proxyURL = os.Getenv("HUB_PROXY_URL")
+	}
+	if proxyURL != "" {
+		u, err := hubconnectivity.ParseProxyURL(proxyURL)
+		if err != nil {
+			klog.ErrorS(err, "Failed to parse the proxy URL for the hub cluster")
+			return nil, err
+		}
+		hubConfig.Proxy = http.ProxyURL(u)
+	}
+
 	// Sometime the hub cluster need additional http header for authentication or authorization.
 	// the "HUB_KUBE_HEADER" to allow sending custom header to hub's API Server for authentication and authorization.
 	if header, ok := os.LookupEnv("HUB_KUBE_HEADER"); ok {
@@ -268,7 +337,7 @@ func buildHubConfig(hubURL string, useCertificateAuth bool, tlsClientInsecure bo
 }
 
 // Start the member controllers with the supplied config
-func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memberOpts ctrl.Options, globalOpts options.Options) error {
+func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memberOpts ctrl.Options, globalOpts options.Options, hubConnectivityTracker *hubconnectivity.Tracker, mcName string) error {
 	hubMgr, err := ctrl.NewManager(hubCfg, hubOpts)
 	if err != nil {
 		return fmt.Errorf("unable to start hub manager: %w", err)
@@ -372,30 +441,212 @@ func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memb
 		globalOpts.ApplierOpts.RequeueRateLimiterSkipToFastBackoffForAvailableOrDiffReportedWorkObjs,
 	)
 
-	workApplier := workapplier.NewReconciler(
-		"work-applier",
-		hubMgr.GetClient(),
-		targetNS,
-		spokeDynamicClient,
-		memberMgr.GetClient(),
-		restMapper,
-		hubMgr.GetEventRecorderFor("work_applier"),
-		// The number of concurrent reconcilations. This is set to 5 to boost performance in
-		// resource processing.
-		5,
-		// Use the default worker count (4) for parallelized manifest processing.
-		parallelizer.NewParallelizer(parallelizer.DefaultNumOfWorkers),
-		time.Minute*time.Duration(globalOpts.ApplierOpts.ResourceForceDeletionWaitTimeMinutes),
-		requeueRateLimiter,
-		globalOpts.ApplierOpts.EnablePriorityQueue,
-		&globalOpts.ApplierOpts.PriorityLinearEquationCoEffA,
-		&globalOpts.ApplierOpts.PriorityLinearEquationCoEffB,
-	)
+	// Set up the post-apply hooks (if any) for the work applier.
+	var postApplyHooks []workapplier.PostApplyHook
+	if globalOpts.ApplierOpts.PostApplyHookSocketPath != "" {
+		klog.V(2).InfoS("Setting up the post-apply hook", "socketPath", globalOpts.ApplierOpts.PostApplyHookSocketPath)
+		postApplyHooks = append(postApplyHooks, workapplier.NewUnixSocketPostApplyHook(
+			"unix-socket",
+			globalOpts.ApplierOpts.PostApplyHookSocketPath,
+			time.Second*time.Duration(globalOpts.ApplierOpts.PostApplyHookTimeoutSeconds),
+		))
+	}
+
+	// Set up the manifest transformers (if any) for the work applier; the SOPS manifest decrypter
+	// (if any) runs first, so that the other transformers see the decrypted resources.
+	var manifestTransformers []workapplier.ManifestTransformer
+	if globalOpts.ApplierOpts.SOPSAgeKeyFilePath != "" || globalOpts.ApplierOpts.SOPSDataKeyDecrypterSocketPath != "" {
+		var ageKeys []byte
+		if globalOpts.ApplierOpts.SOPSAgeKeyFilePath != "" {
+			klog.V(2).InfoS("Loading the age keys for SOPS decryption", "path", globalOpts.ApplierOpts.SOPSAgeKeyFilePath)
+			ageKeys, err = os.ReadFile(globalOpts.ApplierOpts.SOPSAgeKeyFilePath)
+			if err != nil {
+				klog.ErrorS(err, "Failed to read the age key file for SOPS decryption")
+				return err
+			}
+		}
+		var dataKeyDecrypter workapplier.SOPSDataKeyDecrypter
+		if globalOpts.ApplierOpts.SOPSDataKeyDecrypterSocketPath != "" {
+			klog.V(2).InfoS("Setting up the SOPS data key decrypter", "socketPath", globalOpts.ApplierOpts.SOPSDataKeyDecrypterSocketPath)
+			dataKeyDecrypter = workapplier.NewUnixSocketSOPSDataKeyDecrypter(
+				globalOpts.ApplierOpts.SOPSDataKeyDecrypterSocketPath,
+				time.Second*time.Duration(globalOpts.ApplierOpts.SOPSDataKeyDecrypterTimeoutSeconds),
+			)
+		}
+		sopsDecrypter, err := workapplier.NewSOPSManifestDecrypter(ageKeys, dataKeyDecrypter)
+		if err != nil {
+			klog.ErrorS(err, "Failed to set up the SOPS manifest decrypter")
+			return err
+		}
+		manifestTransformers = append(manifestTransformers, sopsDecrypter)
+	}
+	if globalOpts.ApplierOpts.ManifestTransformerSocketPath != "" {
+		klog.V(2).InfoS("Setting up the manifest transformer", "socketPath", globalOpts.ApplierOpts.ManifestTransformerSocketPath)
+		manifestTransformers = append(manifestTransformers, workapplier.NewUnixSocketManifestTransformer(
+			"unix-socket",
+			globalOpts.ApplierOpts.ManifestTransformerSocketPath,
+			time.Second*time.Duration(globalOpts.ApplierOpts.ManifestTransformerTimeoutSeconds),
+		))
+	}
+
+	// Set up the audit logger (if any) for the work applier; all the work appliers share the same
+	// audit trail, with the records labeled with the tenant names.
+	var auditLogger workapplier.AuditLogger
+	if globalOpts.ApplierOpts.AuditLogPath != "" {
+		klog.V(2).InfoS("Setting up the work applier audit log", "path", globalOpts.ApplierOpts.AuditLogPath)
+		auditLogger, err = workapplier.NewFileAuditLogger(
+			globalOpts.ApplierOpts.AuditLogPath,
+			int64(globalOpts.ApplierOpts.AuditLogMaxSizeMB)*1024*1024,
+			globalOpts.ApplierOpts.AuditLogMaxBackups,
+		)
+		if err != nil {
+			klog.ErrorS(err, "Failed to set up the work applier audit log")
+			return err
+		}
+	}
+
+	// Set up the shard membership (if applicable), so that the Work objects of the member cluster
+	// are processed by all the member agent replicas rather than by the leader alone.
+	var shardMembership *workapplier.ShardMembership
+	if globalOpts.ApplierOpts.EnableSharding {
+		// The host name of a member agent replica is the name of its pod, which is unique.
+		identity, err := os.Hostname()
+		if err != nil {
+			klog.ErrorS(err, "Failed to retrieve the identity of the member agent replica for sharding")
+			return err
+		}
+		// Have the lease of the replica garbage collected along with its pod, which can own the lease
+		// only if they are in the same namespace.
+		leaseNamespace := globalOpts.CtrlManagerOptions.LeaderElectionOpts.ResourceNamespace
+		var podUID types.UID
+		if os.Getenv("POD_NAMESPACE") == leaseNamespace {
+			podUID = types.UID(os.Getenv("POD_UID"))
+		}
+		klog.V(2).InfoS("Setting up the work applier shard membership", "identity", identity, "podUID", podUID)
+		shardMembership = workapplier.NewShardMembership(
+			memberMgr.GetClient(),
+			memberMgr.GetAPIReader(),
+			leaseNamespace,
+			identity,
+			podUID,
+			time.Second*time.Duration(globalOpts.ApplierOpts.ShardLeaseDurationSeconds),
+		)
+		if err := memberMgr.Add(shardMembership); err != nil {
+			klog.ErrorS(err, "Failed to set up the work applier shard membership with the member controller manager")
+			return err
+		}
+	}
+
+	// The work applier looks up the AppliedWork objects that depend on a resource via the applied
+	// resource index of the member cluster cache.
+	if err := workapplier.SetupAppliedResourceIndex(ctx, memberMgr.GetFieldIndexer()); err != nil {
+		klog.ErrorS(err, "Failed to set up the applied resource index with the member controller manager")
+		return err
+	}
+
+	newWorkApplier := func(controllerName string, hubClient client.Client, workNamespace, tenant string, spokeDynamicClient dynamic.Interface, recorder record.EventRecorder, shardMembership *workapplier.ShardMembership) *workapplier.Reconciler {
+		return workapplier.NewReconciler(
+			controllerName,
+			hubClient,
+			workNamespace,
+			tenant,
+			spokeDynamicClient,
+			memberMgr.GetClient(),
+			restMapper,
+			events.NewRateLimitedRecorder(recorder, events.DefaultDedupWindow),
+			// The number of concurrent reconcilations. This is set to 5 to boost performance in
+			// resource processing.
+			5,
+			// Use the default worker count (4) for parallelized manifest processing.
+			parallelizer.NewParallelizer(parallelizer.DefaultNumOfWorkers),
+			time.Minute*time.Duration(globalOpts.ApplierOpts.ResourceForceDeletionWaitTimeMinutes),
+			requeueRateLimiter,
+			globalOpts.ApplierOpts.EnablePriorityQueue,
+			&globalOpts.ApplierOpts.PriorityLinearEquationCoEffA,
+			&globalOpts.ApplierOpts.PriorityLinearEquationCoEffB,
+			postApplyHooks,
+			auditLogger,
+			shardMembership,
+			manifestTransformers,
+			globalOpts.ApplierOpts.DeletionWatchMaxResourceTypes,
+		)
+	}
 
+	workApplier := newWorkApplier("work-applier", hubMgr.GetClient(), targetNS, workapplier.DefaultTenant, spokeDynamicClient, hubMgr.GetEventRecorderFor("work_applier"), shardMembership)
 	if err = workApplier.SetupWithManager(hubMgr); err != nil {
 		klog.ErrorS(err, "Failed to create v1beta1 controller", "controller", "work")
 		return err
 	}
+	if shardMembership != nil {
+		// The InternalMemberCluster controller, which joins and leaves the work applier, runs on the
+		// leader only; let the work appliers on the other replicas follow the join state as well.
+		joinStateFollower := workapplier.NewJoinStateFollower(hubMgr.GetClient(), types.NamespacedName{Namespace: targetNS, Name: mcName}, workApplier, time.Second*5)
+		if err := hubMgr.Add(joinStateFollower); err != nil {
+			klog.ErrorS(err, "Failed to set up the work applier join state follower with the hub controller manager")
+			return err
+		}
+	}
+	workAppliers := workapplier.TenantReconcilers{workApplier}
+
+	// Set up the work appliers for the tenants (if any).
+	//
+	// Each tenant has its own controller manager, so that the work applier of the tenant watches only
+	// the hub cluster namespace of the tenant, and talks to the hub cluster with its own client-side
+	// rate limits. The tenant controller managers are run by the hub controller manager, which starts
+	// them only after the current instance wins the leader election.
+	//
+	// The work applier of a tenant applies resources to the member cluster as the service account of
+	// the tenant, so that the tenants are isolated from each other by the RBAC permissions granted to
+	// their service accounts in the member cluster.
+	//
+	// The Work objects of the tenants are not sharded, as the tenant work appliers run on the leader only.
+	for _, tenant := range globalOpts.TenantOpts.Tenants {
+		if tenant.HubNamespace == targetNS {
+			return fmt.Errorf("the hub cluster namespace %s of tenant %s is reserved for the member cluster itself", tenant.HubNamespace, tenant.Name)
+		}
+		klog.V(2).InfoS("Setting up the work applier for the tenant", "tenant", tenant.Name, "hubNamespace", tenant.HubNamespace)
+		tenantHubCfg := rest.CopyConfig(hubCfg)
+		tenantHubCfg.QPS = float32(globalOpts.TenantOpts.QPS)
+		tenantHubCfg.Burst = globalOpts.TenantOpts.Burst
+		tenantMgr, err := ctrl.NewManager(tenantHubCfg, ctrl.Options{
+			Scheme: hubOpts.Scheme,
+			// The metrics of the tenant controller manager are served by the hub controller manager.
+			Metrics: metricsserver.Options{
+				BindAddress: "0",
+			},
+			Cache: cache.Options{
+				DefaultNamespaces: map[string]cache.Config{
+					tenant.HubNamespace: {},
+				},
+				DefaultTransform: hubOpts.Cache.DefaultTransform,
+			},
+		})
+		if err != nil {
+			klog.ErrorS(err, "Failed to create the controller manager for the tenant", "tenant", tenant.Name)
+			return fmt.Errorf("failed to create the controller manager for tenant %s: %w", tenant.Name, err)
+		}
+
+		tenantMemberCfg := rest.CopyConfig(memberConfig)
+		tenantMemberCfg.Impersonate = rest.ImpersonationConfig{
+			UserName: globalOpts.TenantOpts.MemberClusterUserName(tenant),
+		}
+		tenantSpokeDynamicClient, err := dynamic.NewForConfig(tenantMemberCfg)
+		if err != nil {
+			klog.ErrorS(err, "Failed to create the spoke dynamic client for the tenant", "tenant", tenant.Name)
+			return fmt.Errorf("failed to create the spoke dynamic client for tenant %s: %w", tenant.Name, err)
+		}
+
+		tenantWorkApplier := newWorkApplier(fmt.Sprintf("work-applier-%s", tenant.Name), tenantMgr.GetClient(), tenant.HubNamespace, tenant.Name, tenantSpokeDynamicClient, tenantMgr.GetEventRecorderFor("work_applier"), nil)
+		if err := tenantWorkApplier.SetupWithManager(tenantMgr); err != nil {
+			klog.ErrorS(err, "Failed to create v1beta1 controller for the tenant", "controller", "work", "tenant", tenant.Name)
+			return fmt.Errorf("failed to set up the work applier for tenant %s: %w", tenant.Name, err)
+		}
+		if err := hubMgr.Add(tenantMgr); err != nil {
+			klog.ErrorS(err, "Failed to set up the tenant controller manager with the hub controller manager", "tenant", tenant.Name)
+			return fmt.Errorf("failed to set up the controller manager for tenant %s: %w", tenant.Name, err)
+		}
+		workAppliers = append(workAppliers, tenantWorkApplier)
+	}
 
 	klog.Info("Setting up the internalMemberCluster v1beta1 controller")
 	// Set up a provider provider (if applicable).
@@ -412,6 +663,17 @@ func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memb
 			globalOpts.PropertyProviderOpts.EnableAzProviderCostProperties,
 			globalOpts.PropertyProviderOpts.EnableAzProviderAvailableResourceProperties,
 			globalOpts.PropertyProviderOpts.EnableAzProviderNamespaceCollection)
+	case globalOpts.PropertyProviderOpts.Name == costPropertyProvider && globalOpts.PropertyProviderOpts.CostProviderPricingFilePath != "":
+		klog.V(2).InfoS("setting up the cost property provider with a static pricing table", "pricingFile", globalOpts.PropertyProviderOpts.CostProviderPricingFilePath)
+		pricingProvider, err := cost.NewStaticPricingProviderFromFile(globalOpts.PropertyProviderOpts.CostProviderPricingFilePath)
+		if err != nil {
+			klog.ErrorS(err, "Failed to load the pricing table for the cost property provider")
+			return fmt.Errorf("failed to load the pricing table for the cost property provider: %w", err)
+		}
+		pp = cost.New(pricingProvider)
+	case globalOpts.PropertyProviderOpts.Name == costPropertyProvider:
+		klog.V(2).InfoS("setting up the cost property provider with the Azure Retail Prices API", "region", globalOpts.PropertyProviderOpts.Region)
+		pp = cost.NewWithAzureRetailPricing(globalOpts.PropertyProviderOpts.Region)
 	default:
 		// Fall back to not using any property provider if the provided type is none or
 		// not recognizable.
@@ -419,13 +681,45 @@ func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memb
 		pp = nil
 	}
 
+	// Set up the connectivity prober (if applicable); its results are reported as cluster properties
+	// along with the ones from the property provider.
+	var connectivityProber *connectivity.Prober
+	if globalOpts.PropertyProviderOpts.ConnectivityProbeTargetsFilePath != "" {
+		targets, err := connectivity.LoadTargetsFromFile(globalOpts.PropertyProviderOpts.ConnectivityProbeTargetsFilePath)
+		if err != nil {
+			klog.ErrorS(err, "Failed to load the connectivity probe targets")
+			return fmt.Errorf("failed to load the connectivity probe targets: %w", err)
+		}
+		klog.V(2).InfoS("Setting up the connectivity prober", "targets", len(targets))
+		connectivityProber = connectivity.NewProber(targets, globalOpts.PropertyProviderOpts.ConnectivityProbeInterval, globalOpts.PropertyProviderOpts.ConnectivityProbeTimeout)
+		// The prober runs only on the leader, which is consistent with the property provider.
+		if err := hubMgr.Add(connectivityProber); err != nil {
+			klog.ErrorS(err, "Failed to set up the connectivity prober with the hub controller manager")
+			return fmt.Errorf("failed to set up the connectivity prober: %w", err)
+		}
+	}
+
+	var agentUpgradeCfg *imcv1beta1.AgentUpgradeConfig
+	if globalOpts.AgentUpgradeOpts.Enabled {
+		klog.V(2).InfoS("Agent upgrade is enabled", "deployment", klog.KRef(globalOpts.AgentUpgradeOpts.DeploymentNamespace, globalOpts.AgentUpgradeOpts.DeploymentName))
+		agentUpgradeCfg = &imcv1beta1.AgentUpgradeConfig{
+			Reader:              memberMgr.GetAPIReader(),
+			DeploymentNamespace: globalOpts.AgentUpgradeOpts.DeploymentNamespace,
+			DeploymentName:      globalOpts.AgentUpgradeOpts.DeploymentName,
+			ContainerName:       globalOpts.AgentUpgradeOpts.ContainerName,
+		}
+	}
+
 	// Set up the IMC controller.
 	imcReconciler, err := imcv1beta1.NewReconciler(
 		ctx,
 		hubMgr.GetClient(),
 		memberMgr.GetConfig(), memberMgr.GetClient(),
-		workApplier,
-		pp)
+		workAppliers,
+		pp,
+		hubConnectivityTracker,
+		connectivityProber,
+		agentUpgradeCfg)
 	if err != nil {
 		klog.ErrorS(err, "Failed to create InternalMemberCluster v1beta1 reconciler")
 		return fmt.Errorf("failed to create InternalMemberCluster v1beta1 reconciler: %w", err)
@@ -435,6 +729,19 @@ func Start(ctx context.Context, hubCfg, memberConfig *rest.Config, hubOpts, memb
 		return fmt.Errorf("failed to set up InternalMemberCluster v1beta1 controller with the controller manager: %w", err)
 	}
 
+	if globalOpts.HubConnectivityOpts.ReverseTunnelURL != "" {
+		// The tunnel agent runs only on the leader, which is consistent with the other controllers.
+		tunnelAgent, err := tunnel.NewAgent(mcName, globalOpts.HubConnectivityOpts.ReverseTunnelURL, hubCfg, memberConfig)
+		if err != nil {
+			klog.ErrorS(err, "Failed to create the reverse tunnel agent")
+			return fmt.Errorf("failed to create the reverse tunnel agent: %w", err)
+		}
+		if err := hubMgr.Add(tunnelAgent); err != nil {
+			klog.ErrorS(err, "Failed to set up the reverse tunnel agent with the controller manager")
+			return fmt.Errorf("failed to set up the reverse tunnel agent with the controller manager: %w", err)
+		}
+	}
+
 	klog.InfoS("starting hub manager")
 	go func() {
 		defer klog.InfoS("shutting down hub manager")
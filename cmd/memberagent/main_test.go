@@ -5,27 +5,29 @@ import (
 
 	"github.com/stretchr/testify/assert"
 	"k8s.io/client-go/rest"
+
+	"github.com/kubefleet-dev/kubefleet/cmd/memberagent/options"
 )
 
 func Test_buildHubConfig(t *testing.T) {
 	t.Run("use CA auth, no key file - error", func(t *testing.T) {
 		t.Setenv("IDENTITY_KEY", "")
 		t.Setenv("IDENTITY_CERT", "/path/to/cert")
-		config, err := buildHubConfig("https://hub.domain.com", true, false)
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{UseCertificateAuth: true})
 		assert.Nil(t, config)
 		assert.NotNil(t, err)
 	})
 	t.Run("use CA auth, no cert file - error", func(t *testing.T) {
 		t.Setenv("IDENTITY_KEY", "/path/to/key")
 		t.Setenv("IDENTITY_CERT", "")
-		config, err := buildHubConfig("https://hub.domain.com", true, false)
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{UseCertificateAuth: true})
 		assert.Nil(t, config)
 		assert.NotNil(t, err)
 	})
 	t.Run("use CA auth  - success", func(t *testing.T) {
 		t.Setenv("IDENTITY_KEY", "/path/to/key")
 		t.Setenv("IDENTITY_CERT", "/path/to/cert")
-		config, err := buildHubConfig("https://hub.domain.com", true, false)
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{UseCertificateAuth: true})
 		assert.NotNil(t, config)
 		assert.Nil(t, err)
 		assert.Equal(t, rest.Config{
@@ -40,7 +42,7 @@ func Test_buildHubConfig(t *testing.T) {
 		t.Setenv("IDENTITY_KEY", "/path/to/key")
 		t.Setenv("IDENTITY_CERT", "/path/to/cert")
 		t.Setenv("CA_BUNDLE", "")
-		config, err := buildHubConfig("https://hub.domain.com", true, false)
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{UseCertificateAuth: true})
 		assert.Nil(t, config)
 		assert.NotNil(t, err)
 	})
@@ -48,7 +50,7 @@ func Test_buildHubConfig(t *testing.T) {
 		t.Setenv("IDENTITY_KEY", "/path/to/key")
 		t.Setenv("IDENTITY_CERT", "/path/to/cert")
 		t.Setenv("CA_BUNDLE", "/path/to/ca/bundle")
-		config, err := buildHubConfig("https://hub.domain.com", true, false)
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{UseCertificateAuth: true})
 		assert.NotNil(t, config)
 		assert.Nil(t, err)
 		assert.Equal(t, rest.Config{
@@ -63,7 +65,7 @@ func Test_buildHubConfig(t *testing.T) {
 	t.Run("use CA data - success", func(t *testing.T) {
 		t.Setenv("CONFIG_PATH", "./testdata/token")
 		t.Setenv("HUB_CERTIFICATE_AUTHORITY", "dGhpcyBpcyBhIGZha2UgY2E=")
-		config, err := buildHubConfig("https://hub.domain.com", false, false)
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{})
 		assert.NotNil(t, config)
 		assert.Nil(t, err)
 		assert.Equal(t, rest.Config{
@@ -77,7 +79,7 @@ func Test_buildHubConfig(t *testing.T) {
 	t.Run("empty CA data - error", func(t *testing.T) {
 		t.Setenv("CONFIG_PATH", "./testdata/token")
 		t.Setenv("HUB_CERTIFICATE_AUTHORITY", "")
-		config, err := buildHubConfig("https://hub.domain.com", false, false)
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{})
 		assert.Nil(t, config)
 		assert.NotNil(t, err)
 	})
@@ -85,25 +87,25 @@ func Test_buildHubConfig(t *testing.T) {
 		t.Setenv("CONFIG_PATH", "./testdata/token")
 		t.Setenv("HUB_CERTIFICATE_AUTHORITY", "dGhpcyBpcyBhIGZha2UgY2E=")
 		t.Setenv("CA_BUNDLE", "/path/to/ca/bundle")
-		config, err := buildHubConfig("https://hub.domain.com", false, false)
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{})
 		assert.Nil(t, config)
 		assert.NotNil(t, err)
 	})
 	t.Run("use token auth, no token path - error", func(t *testing.T) {
 		t.Setenv("CONFIG_PATH", "")
-		config, err := buildHubConfig("https://hub.domain.com", false, false)
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{})
 		assert.Nil(t, config)
 		assert.NotNil(t, err)
 	})
 	t.Run("use token auth, not exists token path - error", func(t *testing.T) {
 		t.Setenv("CONFIG_PATH", "/hot/exists/token/path")
-		config, err := buildHubConfig("https://hub.domain.com", false, false)
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{})
 		assert.Nil(t, config)
 		assert.NotNil(t, err)
 	})
 	t.Run("use token auth - success", func(t *testing.T) {
 		t.Setenv("CONFIG_PATH", "./testdata/token")
-		config, err := buildHubConfig("https://hub.domain.com", false, false)
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{})
 		assert.NotNil(t, config)
 		assert.Nil(t, err)
 		assert.Equal(t, rest.Config{
@@ -113,7 +115,7 @@ func Test_buildHubConfig(t *testing.T) {
 	})
 	t.Run("No CA bundle, no Hub CA, not insecure - success", func(t *testing.T) {
 		t.Setenv("CONFIG_PATH", "./testdata/token")
-		config, err := buildHubConfig("https://hub.domain.com", false, false)
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{})
 		assert.NotNil(t, config)
 		assert.Nil(t, err)
 		assert.Equal(t, rest.Config{
@@ -123,7 +125,7 @@ func Test_buildHubConfig(t *testing.T) {
 	})
 	t.Run("use insecure client - success", func(t *testing.T) {
 		t.Setenv("CONFIG_PATH", "./testdata/token")
-		config, err := buildHubConfig("https://hub.domain.com", false, true)
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{UseInsecureTLSClient: true})
 		assert.NotNil(t, config)
 		assert.Nil(t, err)
 		assert.Equal(t, rest.Config{
@@ -137,9 +139,57 @@ func Test_buildHubConfig(t *testing.T) {
 	t.Run("use insecure client and custom header - success", func(t *testing.T) {
 		t.Setenv("CONFIG_PATH", "./testdata/token")
 		t.Setenv("HUB_KUBE_HEADER", "Member-Resource-ID: some-id")
-		config, err := buildHubConfig("https://hub.domain.com", false, true)
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{UseInsecureTLSClient: true})
 		assert.NotNil(t, config)
 		assert.Nil(t, err)
 		assert.NotNil(t, config.WrapTransport)
 	})
+	t.Run("CA bundle flag overrides environment variables - success", func(t *testing.T) {
+		t.Setenv("CONFIG_PATH", "./testdata/token")
+		t.Setenv("HUB_CERTIFICATE_AUTHORITY", "dGhpcyBpcyBhIGZha2UgY2E=")
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{CABundlePath: "/path/to/ca/bundle"})
+		assert.NotNil(t, config)
+		assert.Nil(t, err)
+		assert.Equal(t, rest.Config{
+			Host:            "https://hub.domain.com",
+			BearerTokenFile: "./testdata/token",
+			TLSClientConfig: rest.TLSClientConfig{
+				CAFile: "/path/to/ca/bundle",
+			},
+		}, *config)
+	})
+	t.Run("TLS server name from environment variable - success", func(t *testing.T) {
+		t.Setenv("CONFIG_PATH", "./testdata/token")
+		t.Setenv("HUB_TLS_SERVER_NAME", "hub.internal")
+		config, err := buildHubConfig("https://10.0.0.1", options.HubConnectivityOptions{})
+		assert.NotNil(t, config)
+		assert.Nil(t, err)
+		assert.Equal(t, "hub.internal", config.TLSClientConfig.ServerName)
+	})
+	t.Run("TLS server name flag overrides environment variable - success", func(t *testing.T) {
+		t.Setenv("CONFIG_PATH", "./testdata/token")
+		t.Setenv("HUB_TLS_SERVER_NAME", "hub.internal")
+		config, err := buildHubConfig("https://10.0.0.1", options.HubConnectivityOptions{TLSServerName: "hub.domain.com"})
+		assert.NotNil(t, config)
+		assert.Nil(t, err)
+		assert.Equal(t, "hub.domain.com", config.TLSClientConfig.ServerName)
+	})
+	t.Run("use proxy from environment variable - success", func(t *testing.T) {
+		t.Setenv("CONFIG_PATH", "./testdata/token")
+		t.Setenv("HUB_PROXY_URL", "socks5://[fd00::1]:1080")
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{})
+		assert.NotNil(t, config)
+		assert.Nil(t, err)
+		assert.NotNil(t, config.Proxy)
+		proxyURL, err := config.Proxy(nil)
+		assert.Nil(t, err)
+		assert.Equal(t, "socks5://[fd00::1]:1080", proxyURL.String())
+	})
+	t.Run("invalid proxy from environment variable - error", func(t *testing.T) {
+		t.Setenv("CONFIG_PATH", "./testdata/token")
+		t.Setenv("HUB_PROXY_URL", "ftp://proxy.domain.com")
+		config, err := buildHubConfig("https://hub.domain.com", options.HubConnectivityOptions{})
+		assert.Nil(t, config)
+		assert.NotNil(t, err)
+	})
 }
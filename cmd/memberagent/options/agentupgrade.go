@@ -0,0 +1,68 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"flag"
+)
+
+// AgentUpgradeOptions is a set of options that allow the KubeFleet member agent to upgrade
+// itself to the version desired by the hub cluster.
+//
+// With agent upgrade enabled, the KubeFleet member agent watches the desired agent version set
+// on its InternalMemberCluster object, and rolls out the version to its own Deployment on the
+// member cluster, one pod at a time; if the new version fails to become available, the agent
+// rolls its Deployment back to the previous version.
+type AgentUpgradeOptions struct {
+	// Enable agent upgrade driven by the hub cluster or not.
+	Enabled bool
+
+	// The namespace of the Deployment that runs the KubeFleet member agent.
+	DeploymentNamespace string
+
+	// The name of the Deployment that runs the KubeFleet member agent.
+	DeploymentName string
+
+	// The name of the container that runs the KubeFleet member agent in the Deployment.
+	ContainerName string
+}
+
+func (o *AgentUpgradeOptions) AddFlags(flags *flag.FlagSet) {
+	flags.BoolVar(
+		&o.Enabled,
+		"enable-agent-upgrade",
+		false,
+		"Enable the KubeFleet member agent to upgrade itself to the version desired by the hub cluster or not. If enabled, the agent updates the image tag of its own Deployment to the desired version, and rolls back if the new version fails to become available.")
+
+	flags.StringVar(
+		&o.DeploymentNamespace,
+		"agent-deployment-namespace",
+		"fleet-system",
+		"The namespace of the Deployment that runs the KubeFleet member agent. This option applies only when agent upgrade is enabled.")
+
+	flags.StringVar(
+		&o.DeploymentName,
+		"agent-deployment-name",
+		"member-agent",
+		"The name of the Deployment that runs the KubeFleet member agent. This option applies only when agent upgrade is enabled.")
+
+	flags.StringVar(
+		&o.ContainerName,
+		"agent-container-name",
+		"member-agent",
+		"The name of the container that runs the KubeFleet member agent in its Deployment. This option applies only when agent upgrade is enabled.")
+}
@@ -104,6 +104,109 @@ type ApplierOptions struct {
 
 	// The coefficient B in the linear equation for calculating the priority score of a placement.
 	PriorityLinearEquationCoEffB int
+
+	// The KubeFleet member agent can notify a local process after all the resources in a Work object have
+	// been applied and have become available on the member cluster, e.g., to warm up caches or run
+	// smoke tests. A placement is delivered to a member cluster in one or more Work objects, and the hook
+	// runs once for each of them. The agent sends a HTTP POST request, which describes the Work object
+	// that has been applied, to a Unix domain socket where the process listens; the outcome is reported
+	// back to the hub cluster via the PostApplyHooksCompleted condition of the Work object, which is not
+	// propagated to the placement status.
+	//
+	// The hook runs as part of the processing of the Work object, so the process should respond quickly
+	// (e.g., by starting any long-running task in the background); the timeout is capped at 30 seconds.
+	//
+	// See the options below for further details:
+
+	// The path to the Unix domain socket where the post-apply hook listens. If not set, no post-apply hook
+	// will run.
+	PostApplyHookSocketPath string
+
+	// The timeout in seconds for the post-apply hook to respond.
+	PostApplyHookTimeoutSeconds int
+
+	// The KubeFleet member agent can keep an audit trail of all the changes (creations, updates, and
+	// deletions) it makes to the member cluster when applying placements, so that one can find out
+	// afterwards what Fleet has changed on the member cluster and when. The records, one JSON
+	// object per line, are written to a local file, which is rotated when it grows too large.
+	//
+	// See the options below for further details:
+
+	// The path to the audit log file. If not set, no audit trail will be kept.
+	AuditLogPath string
+
+	// The maximum size in megabytes of the audit log file before it is rotated.
+	AuditLogMaxSizeMB int
+
+	// The maximum number of rotated audit log files to keep.
+	AuditLogMaxBackups int
+
+	// The KubeFleet member agent can have a local process (e.g., a local webhook) transform each
+	// resource in a placement before it is applied to the member cluster, e.g., to rewrite container
+	// image registries to a local mirror. The agent sends a HTTP POST request, which carries the
+	// resource, to a Unix domain socket where the process listens, and applies the resource that the
+	// process responds with; failed transformations are reported back to the hub cluster via the
+	// placement status.
+	//
+	// See the options below for further details:
+
+	// The path to the Unix domain socket where the manifest transformer listens. If not set, no
+	// transformation will happen.
+	ManifestTransformerSocketPath string
+
+	// The timeout in seconds for the manifest transformer to respond.
+	ManifestTransformerTimeoutSeconds int
+
+	// The KubeFleet member agent can decrypt the resources that have been encrypted with SOPS before they
+	// are applied, so that sensitive data can flow through the hub cluster encrypted at rest (e.g., wrapped
+	// in an envelope object) and be decrypted on the member cluster only. The data keys of the resources
+	// are decrypted with the age keys provisioned on the member cluster, or with a local data key decrypter
+	// (e.g., a process with access to the cloud KMS) otherwise. If neither is set, no decryption will happen.
+	//
+	// See the options below for further details:
+
+	// The path to the age key file, which holds the age identities (one AGE-SECRET-KEY-1... identity per line)
+	// for decrypting the SOPS-encrypted resources. The file is read once at startup; the member agent must
+	// be restarted to pick up rotated keys.
+	SOPSAgeKeyFilePath string
+
+	// The path to the Unix domain socket where the data key decrypter listens. Note that the data key
+	// decrypter speaks a protocol of KubeFleet's own, not that of the SOPS key service.
+	SOPSDataKeyDecrypterSocketPath string
+
+	// The timeout in seconds for the data key decrypter to respond.
+	SOPSDataKeyDecrypterTimeoutSeconds int
+
+	// By default, only the leader of the KubeFleet member agent replicas processes placements. On
+	// member clusters that receive a large number of placements, one can set up the agent to share
+	// the workload among all the replicas instead: each replica announces itself with a lease on the
+	// member cluster, and the placements are sharded among the live replicas via consistent hashing
+	// on their names, so that each placement is processed by one replica at a time.
+	//
+	// Note that sharding applies only to the placements for the member cluster itself; the placements
+	// of the tenants (if any) are still processed by the leader alone.
+	//
+	// See the options below for further details:
+
+	// Enable sharding placements among the KubeFleet member agent replicas or not.
+	EnableSharding bool
+
+	// The duration in seconds of the lease that a replica holds to announce itself; a replica that fails
+	// to renew its lease within the duration is considered gone, and its placements are taken over by
+	// the other replicas once they have all adopted the new membership. The lease is owned by the pod
+	// of the replica (if it runs in the leader election namespace), and a lease that has expired for
+	// three lease durations is deleted by the other replicas.
+	ShardLeaseDurationSeconds int
+
+	// The maximum number of resource types that the KubeFleet member agent watches on the member
+	// cluster, so that placed resources deleted out-of-band are re-created within seconds, for the
+	// placements that have opted in (see the WatchForDeletion apply strategy setting). Resources of
+	// the types beyond the limit are re-created the next time the placement is re-processed as usual.
+	//
+	// Each watched resource type costs a watch connection to the member cluster API server and an
+	// in-memory cache of the resources of the type (trimmed to their identities and owners). Set
+	// to 0 to disable the watches altogether.
+	DeletionWatchMaxResourceTypes int
 }
 
 func (o *ApplierOptions) AddFlags(flags *flag.FlagSet) {
@@ -168,6 +271,77 @@ func (o *ApplierOptions) AddFlags(flags *flag.FlagSet) {
 		newPriCoEffBValue(100, &o.PriorityLinearEquationCoEffB),
 		"work-applier-priority-linear-equation-coeff-b",
 		"The coefficient B in the linear equation for calculating the priority score of a placement. The value must be a positive integer no greater than 1000. Default is 100.")
+
+	flags.StringVar(
+		&o.PostApplyHookSocketPath,
+		"work-applier-post-apply-hook-socket-path",
+		"",
+		"The path to the Unix domain socket where a post-apply hook listens. If set, the KubeFleet member agent will send a HTTP POST request to the socket after all the resources in a Work object have been applied and have become available, and report the outcome in the PostApplyHooksCompleted condition of the Work object. Default is empty, which means no post-apply hook will run.")
+
+	flags.Var(
+		newPostApplyHookTimeoutSecondsValue(10, &o.PostApplyHookTimeoutSeconds),
+		"work-applier-post-apply-hook-timeout-seconds",
+		"The timeout in seconds for the post-apply hook to respond. Default is 10 seconds. The value must be in the range [1, 30].")
+
+	flags.StringVar(
+		&o.AuditLogPath,
+		"work-applier-audit-log-path",
+		"",
+		"The path to the file where the KubeFleet member agent keeps an audit trail of all the changes it makes to the member cluster when applying placements. Default is empty, which means no audit trail will be kept.")
+
+	flags.Var(
+		newAuditLogMaxSizeMBValue(100, &o.AuditLogMaxSizeMB),
+		"work-applier-audit-log-max-size-mb",
+		"The maximum size in megabytes of the audit log file before it is rotated. Default is 100. The value must be in the range [1, 1024].")
+
+	flags.Var(
+		newAuditLogMaxBackupsValue(5, &o.AuditLogMaxBackups),
+		"work-applier-audit-log-max-backups",
+		"The maximum number of rotated audit log files to keep. Default is 5. The value must be in the range [0, 100].")
+
+	flags.StringVar(
+		&o.ManifestTransformerSocketPath,
+		"work-applier-manifest-transformer-socket-path",
+		"",
+		"The path to the Unix domain socket where a manifest transformer listens. If set, the KubeFleet member agent will send each resource in a placement to the socket via a HTTP POST request, and apply the transformed resource that the transformer responds with. Default is empty, which means no transformation will happen.")
+
+	flags.Var(
+		newManifestTransformerTimeoutSecondsValue(10, &o.ManifestTransformerTimeoutSeconds),
+		"work-applier-manifest-transformer-timeout-seconds",
+		"The timeout in seconds for the manifest transformer to respond. Default is 10 seconds. The value must be in the range [1, 60].")
+
+	flags.StringVar(
+		&o.SOPSAgeKeyFilePath,
+		"work-applier-sops-age-key-file",
+		"",
+		"The path to the age key file, which holds the age identities for decrypting the resources encrypted with SOPS. If set, the KubeFleet member agent will decrypt each resource in a placement that has SOPS metadata before it is applied. The file is read once at startup, so the member agent must be restarted to pick up rotated keys. Default is empty, which means no age keys are provisioned.")
+
+	flags.StringVar(
+		&o.SOPSDataKeyDecrypterSocketPath,
+		"work-applier-sops-data-key-decrypter-socket-path",
+		"",
+		"The path to the Unix domain socket where a data key decrypter listens. If set, the KubeFleet member agent will send the master keys (e.g., cloud KMS keys) of each resource encrypted with SOPS, for which no age keys are provisioned, to the socket via a HTTP POST request with a JSON payload, and decrypt the resource with the data key that the decrypter responds with. This is a protocol of KubeFleet's own; the SOPS key service (sops keyservice) cannot serve it. Default is empty, which means no data key decrypter is used.")
+
+	flags.Var(
+		newSOPSDataKeyDecrypterTimeoutSecondsValue(10, &o.SOPSDataKeyDecrypterTimeoutSeconds),
+		"work-applier-sops-data-key-decrypter-timeout-seconds",
+		"The timeout in seconds for the data key decrypter to respond. Default is 10 seconds. The value must be in the range [1, 60].")
+
+	flags.BoolVar(
+		&o.EnableSharding,
+		"work-applier-enable-sharding",
+		false,
+		"Enable sharding placements among all the KubeFleet member agent replicas or not. Default is false, which means placements will be processed by the leader alone.")
+
+	flags.Var(
+		newShardLeaseDurationSecondsValue(30, &o.ShardLeaseDurationSeconds),
+		"work-applier-shard-lease-duration-seconds",
+		"The duration in seconds of the lease that a KubeFleet member agent replica holds to take part in placement sharding. Default is 30 seconds. The value must be in the range [10, 300].")
+
+	flags.Var(
+		newDeletionWatchMaxResourceTypesValue(50, &o.DeletionWatchMaxResourceTypes),
+		"work-applier-deletion-watch-max-resource-types",
+		"The maximum number of resource types that the KubeFleet member agent watches for out-of-band deletions of placed resources, for the placements that have opted in. Default is 50. The value must be in the range [0, 500]; 0 disables the watches.")
 }
 
 type ResForceDeletionWaitTimeMinutes int
@@ -413,3 +587,171 @@ func newPriCoEffBValue(defaultValue int, p *int) *PriCoEffB {
 	*p = defaultValue
 	return (*PriCoEffB)(p)
 }
+
+type PostApplyHookTimeoutSeconds int
+
+func (v *PostApplyHookTimeoutSeconds) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *PostApplyHookTimeoutSeconds) Set(s string) error {
+	t, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse integer value: %w", err)
+	}
+
+	if t < 1 || t > 30 {
+		return fmt.Errorf("post-apply hook timeout seconds is set to an invalid value (%d), must be a value in the range [1, 30]", t)
+	}
+	*v = PostApplyHookTimeoutSeconds(t)
+	return nil
+}
+
+func newPostApplyHookTimeoutSecondsValue(defaultValue int, p *int) *PostApplyHookTimeoutSeconds {
+	*p = defaultValue
+	return (*PostApplyHookTimeoutSeconds)(p)
+}
+
+type AuditLogMaxSizeMB int
+
+func (v *AuditLogMaxSizeMB) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *AuditLogMaxSizeMB) Set(s string) error {
+	t, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse integer value: %w", err)
+	}
+
+	if t < 1 || t > 1024 {
+		return fmt.Errorf("audit log max size in megabytes is set to an invalid value (%d), must be a value in the range [1, 1024]", t)
+	}
+	*v = AuditLogMaxSizeMB(t)
+	return nil
+}
+
+func newAuditLogMaxSizeMBValue(defaultValue int, p *int) *AuditLogMaxSizeMB {
+	*p = defaultValue
+	return (*AuditLogMaxSizeMB)(p)
+}
+
+type AuditLogMaxBackups int
+
+func (v *AuditLogMaxBackups) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *AuditLogMaxBackups) Set(s string) error {
+	t, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse integer value: %w", err)
+	}
+
+	if t < 0 || t > 100 {
+		return fmt.Errorf("audit log max backups is set to an invalid value (%d), must be a value in the range [0, 100]", t)
+	}
+	*v = AuditLogMaxBackups(t)
+	return nil
+}
+
+func newAuditLogMaxBackupsValue(defaultValue int, p *int) *AuditLogMaxBackups {
+	*p = defaultValue
+	return (*AuditLogMaxBackups)(p)
+}
+
+type ManifestTransformerTimeoutSeconds int
+
+func (v *ManifestTransformerTimeoutSeconds) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *ManifestTransformerTimeoutSeconds) Set(s string) error {
+	t, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse integer value: %w", err)
+	}
+
+	if t < 1 || t > 60 {
+		return fmt.Errorf("manifest transformer timeout seconds is set to an invalid value (%d), must be a value in the range [1, 60]", t)
+	}
+	*v = ManifestTransformerTimeoutSeconds(t)
+	return nil
+}
+
+func newManifestTransformerTimeoutSecondsValue(defaultValue int, p *int) *ManifestTransformerTimeoutSeconds {
+	*p = defaultValue
+	return (*ManifestTransformerTimeoutSeconds)(p)
+}
+
+type SOPSDataKeyDecrypterTimeoutSeconds int
+
+func (v *SOPSDataKeyDecrypterTimeoutSeconds) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *SOPSDataKeyDecrypterTimeoutSeconds) Set(s string) error {
+	t, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse integer value: %w", err)
+	}
+
+	if t < 1 || t > 60 {
+		return fmt.Errorf("SOPS data key decrypter timeout seconds is set to an invalid value (%d), must be a value in the range [1, 60]", t)
+	}
+	*v = SOPSDataKeyDecrypterTimeoutSeconds(t)
+	return nil
+}
+
+func newSOPSDataKeyDecrypterTimeoutSecondsValue(defaultValue int, p *int) *SOPSDataKeyDecrypterTimeoutSeconds {
+	*p = defaultValue
+	return (*SOPSDataKeyDecrypterTimeoutSeconds)(p)
+}
+
+type ShardLeaseDurationSeconds int
+
+func (v *ShardLeaseDurationSeconds) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *ShardLeaseDurationSeconds) Set(s string) error {
+	t, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse integer value: %w", err)
+	}
+
+	if t < 10 || t > 300 {
+		return fmt.Errorf("shard lease duration seconds is set to an invalid value (%d), must be a value in the range [10, 300]", t)
+	}
+	*v = ShardLeaseDurationSeconds(t)
+	return nil
+}
+
+func newShardLeaseDurationSecondsValue(defaultValue int, p *int) *ShardLeaseDurationSeconds {
+	*p = defaultValue
+	return (*ShardLeaseDurationSeconds)(p)
+}
+
+type DeletionWatchMaxResourceTypes int
+
+func (v *DeletionWatchMaxResourceTypes) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *DeletionWatchMaxResourceTypes) Set(s string) error {
+	t, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse integer value: %w", err)
+	}
+
+	if t < 0 || t > 500 {
+		return fmt.Errorf("deletion watch max resource types is set to an invalid value (%d), must be a value in the range [0, 500]", t)
+	}
+	*v = DeletionWatchMaxResourceTypes(t)
+	return nil
+}
+
+func newDeletionWatchMaxResourceTypesValue(defaultValue int, p *int) *DeletionWatchMaxResourceTypes {
+	*p = defaultValue
+	return (*DeletionWatchMaxResourceTypes)(p)
+}
@@ -18,6 +18,10 @@ package options
 
 import (
 	"flag"
+	"fmt"
+	"strconv"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/hubconnectivity"
 )
 
 // HubConnectivityOptions is a set of options that control how the KubeFleet
@@ -41,6 +45,44 @@ type HubConnectivityOptions struct {
 	// variable; you can also give the member agent a file path to the CA data instead
 	// via the `HUB_CERTIFICATE_AUTHORITY` environment variable.
 	UseInsecureTLSClient bool
+
+	// The URL of the proxy through which the member agent connects to the hub cluster, e.g.,
+	// `http://proxy.example.com:3128` or `socks5://[fd00::1]:1080`. HTTP, HTTPS, and SOCKS5 proxies
+	// are supported.
+	//
+	// If this is not set, the member agent will read the URL from the `HUB_PROXY_URL` environment
+	// variable; if neither is set, the member agent will respect the standard `HTTPS_PROXY` and
+	// `NO_PROXY` environment variables. Proxy URLs with credentials should be set via the environment
+	// variable (e.g., sourced from a Secret), as command-line arguments are visible in the pod spec;
+	// the password is redacted when the flag value is logged.
+	ProxyURL string
+
+	// The file path to the CA bundle to use for verifying the hub cluster's identity. If this is set,
+	// it overrides the `CA_BUNDLE` and `HUB_CERTIFICATE_AUTHORITY` environment variables. The option
+	// does not apply if an insecure client is in use.
+	CABundlePath string
+
+	// The server name to use for SNI and for verifying the hub cluster's certificate, which is useful
+	// if the member agent connects to the hub cluster by IP address or via a TLS-passthrough gateway.
+	//
+	// If this is not set, the member agent will read the server name from the `HUB_TLS_SERVER_NAME`
+	// environment variable; if neither is set, the host name in the hub server URL is used.
+	TLSServerName string
+
+	// The timeout in seconds for the preflight check, in which the member agent verifies that the
+	// hub cluster is reachable before starting its controllers; if the hub cluster is still unreachable
+	// when the timeout expires, the member agent exits with a detailed description of the failure.
+	// Set this to 0 to skip the preflight check.
+	PreflightCheckTimeoutSeconds int
+
+	// The URL of the reverse tunnel server in the hub agent. If this is set, the member agent keeps a
+	// tunnel connected to the server, through which the hub agent can send read-only requests to the
+	// API server of the member cluster without inbound connectivity to the member cluster.
+	//
+	// The URL must use the https scheme. The member agent connects to the tunnel server with the same
+	// TLS, proxy, and credential settings as it connects to the hub cluster; the tunnel server supports
+	// only token-based authentication.
+	ReverseTunnelURL string
 }
 
 // AddFlags adds flags for HubConnectivityOptions to the specified FlagSet.
@@ -56,4 +98,77 @@ func (o *HubConnectivityOptions) AddFlags(flags *flag.FlagSet) {
 		"tls-insecure",
 		false,
 		"Use an insecure client or not when connecting to the hub cluster.")
+
+	flags.Var(
+		newHubProxyURLValue(&o.ProxyURL),
+		"hub-proxy-url",
+		"The URL of the HTTP, HTTPS, or SOCKS5 proxy through which the member agent connects to the hub cluster. If not set, the value of the HUB_PROXY_URL environment variable is used; set proxy URLs with credentials via the environment variable (e.g., from a Secret) rather than this flag, as command-line arguments are visible in the pod spec.")
+
+	flags.StringVar(
+		&o.CABundlePath,
+		"hub-ca-bundle-path",
+		"",
+		"The file path to the CA bundle to use for verifying the hub cluster's identity. If set, it overrides the CA_BUNDLE and HUB_CERTIFICATE_AUTHORITY environment variables.")
+
+	flags.StringVar(
+		&o.TLSServerName,
+		"hub-tls-server-name",
+		"",
+		"The server name to use for SNI and for verifying the hub cluster's certificate. If not set, the value of the HUB_TLS_SERVER_NAME environment variable is used; if neither is set, the host name in the hub server URL is used.")
+
+	flags.Var(
+		newPreflightCheckTimeoutSecondsValue(60, &o.PreflightCheckTimeoutSeconds),
+		"hub-preflight-check-timeout-seconds",
+		"The timeout in seconds for the preflight check, in which the member agent verifies that the hub cluster is reachable before starting its controllers. Default is 60 seconds. Set to 0 to skip the check. The value must be in the range [0, 600].")
+
+	flags.StringVar(
+		&o.ReverseTunnelURL,
+		"hub-reverse-tunnel-url",
+		"",
+		"The HTTPS URL of the reverse tunnel server in the hub agent. If set, the member agent keeps a tunnel connected to the server, through which the hub agent can send read-only requests to the API server of the member cluster.")
+}
+
+type HubProxyURL string
+
+// String returns the proxy URL with the password (if any) redacted, as flag values are logged.
+func (v *HubProxyURL) String() string {
+	return hubconnectivity.RedactProxyURL(string(*v))
+}
+
+func (v *HubProxyURL) Set(s string) error {
+	if s != "" {
+		if _, err := hubconnectivity.ParseProxyURL(s); err != nil {
+			return err
+		}
+	}
+	*v = HubProxyURL(s)
+	return nil
+}
+
+func newHubProxyURLValue(p *string) *HubProxyURL {
+	return (*HubProxyURL)(p)
+}
+
+type PreflightCheckTimeoutSeconds int
+
+func (v *PreflightCheckTimeoutSeconds) String() string {
+	return fmt.Sprintf("%d", *v)
+}
+
+func (v *PreflightCheckTimeoutSeconds) Set(s string) error {
+	t, err := strconv.Atoi(s)
+	if err != nil {
+		return fmt.Errorf("failed to parse integer value: %w", err)
+	}
+
+	if t < 0 || t > 600 {
+		return fmt.Errorf("preflight check timeout in seconds is set to an invalid value (%d), must be a value in the range [0, 600]", t)
+	}
+	*v = PreflightCheckTimeoutSeconds(t)
+	return nil
+}
+
+func newPreflightCheckTimeoutSecondsValue(defaultValue int, p *int) *PreflightCheckTimeoutSeconds {
+	*p = defaultValue
+	return (*PreflightCheckTimeoutSeconds)(p)
 }
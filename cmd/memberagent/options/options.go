@@ -36,6 +36,14 @@ type Options struct {
 	// KubeFleet cluster property provider related options.
 	PropertyProviderOpts PropertyProviderOptions
 
+	// Options that allow the KubeFleet member agent to serve multiple tenants on the same
+	// member cluster.
+	TenantOpts TenantOptions
+
+	// Options that allow the KubeFleet member agent to upgrade itself to the version desired by
+	// the hub cluster.
+	AgentUpgradeOpts AgentUpgradeOptions
+
 	// The fields below are added only for backwards compatibility reasons.
 	// Their values are never read.
 	UseV1Beta1APIs bool
@@ -50,6 +58,8 @@ func (o *Options) AddFlags(flags *flag.FlagSet) {
 	o.CtrlManagerOptions.AddFlags(flags)
 	o.ApplierOpts.AddFlags(flags)
 	o.PropertyProviderOpts.AddFlags(flags)
+	o.TenantOpts.AddFlags(flags)
+	o.AgentUpgradeOpts.AddFlags(flags)
 
 	// The flags set up below are added only for backwards compatibility reasons.
 	// They are no-op flags and their values are never read.
@@ -18,6 +18,7 @@ package options
 
 import (
 	"flag"
+	"time"
 )
 
 type PropertyProviderOptions struct {
@@ -41,6 +42,24 @@ type PropertyProviderOptions struct {
 
 	// Enable support for namespace collection in the Azure property provider or not. This option applies only when the Azure property provider is in use.
 	EnableAzProviderNamespaceCollection bool
+
+	// The path to a file that maps node SKUs (instance types) to their on-demand hourly prices,
+	// which the cost property provider uses for calculating cluster costs. If unspecified, the
+	// cost property provider retrieves prices from the Azure Retail Prices API of the specified
+	// region instead. This option applies only when the cost property provider is in use.
+	CostProviderPricingFilePath string
+
+	// The path to a file that lists the endpoints (e.g., the hub cluster, container registries, and
+	// internal services) that the KubeFleet member agent probes for reachability and latency; the
+	// results are reported as cluster properties, whichever property provider is in use. If
+	// unspecified, no endpoint is probed.
+	ConnectivityProbeTargetsFilePath string
+
+	// The interval between two rounds of connectivity probes.
+	ConnectivityProbeInterval time.Duration
+
+	// The timeout of a single connectivity probe.
+	ConnectivityProbeTimeout time.Duration
 }
 
 func (o *PropertyProviderOptions) AddFlags(flags *flag.FlagSet) {
@@ -79,4 +98,28 @@ func (o *PropertyProviderOptions) AddFlags(flags *flag.FlagSet) {
 		"enable-namespace-collection-in-property-provider",
 		false,
 		"Enable support for namespace collection in the Azure property provider or not. This option applies only when the Azure property provider is in use.")
+
+	flags.StringVar(
+		&o.CostProviderPricingFilePath,
+		"cost-provider-pricing-file",
+		"",
+		"The path to a file that maps node SKUs (instance types) to their on-demand hourly prices for the cost property provider; if unspecified, prices are retrieved from the Azure Retail Prices API of the specified region. This option applies only when the cost property provider is in use.")
+
+	flags.StringVar(
+		&o.ConnectivityProbeTargetsFilePath,
+		"connectivity-probe-targets-file",
+		"",
+		"The path to a file that lists the endpoints (e.g., the hub cluster, container registries, and internal services) that the KubeFleet member agent probes for reachability and latency; the results are reported as cluster properties. If unspecified, no endpoint is probed.")
+
+	flags.DurationVar(
+		&o.ConnectivityProbeInterval,
+		"connectivity-probe-interval",
+		30*time.Second,
+		"The interval between two rounds of connectivity probes. This option applies only when a connectivity probe targets file is specified.")
+
+	flags.DurationVar(
+		&o.ConnectivityProbeTimeout,
+		"connectivity-probe-timeout",
+		5*time.Second,
+		"The timeout of a single connectivity probe; it must not exceed the probe interval. This option applies only when a connectivity probe targets file is specified.")
 }
@@ -0,0 +1,154 @@
+/*
+Copyright 2026 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package options
+
+import (
+	"flag"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/workapplier"
+)
+
+// TenantOptions is a set of options that allow the KubeFleet member agent to serve multiple
+// logical tenants that share one physical member cluster.
+//
+// By default, the KubeFleet member agent processes only the Work objects from the hub cluster
+// namespace reserved for the member cluster itself. With tenants specified, the agent runs,
+// within the same process, a scoped work applier for each tenant, which processes the Work objects
+// from the hub cluster namespace of the tenant with its own hub cluster client (and thus its own
+// client-side rate limits), and applies them to the member cluster as the service account of the
+// tenant (see TenantServiceAccountNameFormat), so that each tenant can only change what its
+// service account is allowed to.
+//
+// Note that all tenants share the same member cluster identity, i.e., the agent joins and leaves
+// the fleet, and reports cluster properties, on behalf of the member cluster only.
+type TenantOptions struct {
+	// The tenants that the KubeFleet member agent serves, in addition to the member cluster itself.
+	Tenants []TenantScope
+
+	// The member cluster namespace of the service accounts that the work appliers of the tenants
+	// impersonate when they apply resources to the member cluster.
+	ServiceAccountNamespace string
+
+	// The QPS limit set to the rate limiter of the Kubernetes client in use by each tenant for
+	// connecting to the hub cluster, for client-side throttling purposes.
+	QPS float64
+
+	// The burst limit set to the rate limiter of the Kubernetes client in use by each tenant for
+	// connecting to the hub cluster, for client-side throttling purposes.
+	Burst int
+}
+
+const (
+	// TenantServiceAccountNameFormat is the format of the name of the member cluster service account
+	// that the work applier of a tenant impersonates; it is formatted with the tenant name.
+	TenantServiceAccountNameFormat = "fleet-tenant-%s"
+)
+
+// TenantScope describes a tenant that the KubeFleet member agent serves.
+type TenantScope struct {
+	// The name of the tenant; it is used to label the metrics emitted for the tenant.
+	Name string
+
+	// The hub cluster namespace from which the work applier of the tenant processes Work objects.
+	HubNamespace string
+}
+
+func (o *TenantOptions) AddFlags(flags *flag.FlagSet) {
+	flags.Var(
+		newTenantScopesValueWithValidation(&o.Tenants),
+		"tenants",
+		"A comma-separated list of tenants that the KubeFleet member agent serves in addition to the member cluster itself, each in the format of <tenant name>=<hub cluster namespace>; for each tenant, the agent processes the Work objects from the given hub cluster namespace. Default is empty, which means that the agent serves the member cluster only.")
+
+	flags.StringVar(
+		&o.ServiceAccountNamespace,
+		"tenant-service-account-namespace",
+		"fleet-system",
+		"The member cluster namespace of the service accounts that the work appliers of the tenants impersonate when they apply resources to the member cluster; the service account of a tenant is named fleet-tenant-<tenant name>. This option applies only when tenants are specified.")
+
+	flags.Var(
+		newHubQPSValueWithValidation(10.0, &o.QPS),
+		"tenant-hub-api-qps",
+		"The QPS limit set to the rate limiter of the Kubernetes client in use by each tenant for connecting to the hub cluster, for client-side throttling purposes. Default is 10.")
+
+	flags.Var(
+		newHubBurstValueWithValidation(100, &o.Burst),
+		"tenant-hub-api-burst",
+		"The burst limit set to the rate limiter of the Kubernetes client in use by each tenant for connecting to the hub cluster, for client-side throttling purposes. Default is 100.")
+}
+
+// MemberClusterUserName returns the user name of the member cluster service account that the work
+// applier of a tenant impersonates.
+func (o *TenantOptions) MemberClusterUserName(tenant TenantScope) string {
+	return fmt.Sprintf("system:serviceaccount:%s:%s", o.ServiceAccountNamespace, fmt.Sprintf(TenantServiceAccountNameFormat, tenant.Name))
+}
+
+// TenantScopesValueWithValidation is a custom flag value type for the Tenants option.
+type TenantScopesValueWithValidation []TenantScope
+
+func (v *TenantScopesValueWithValidation) String() string {
+	scopes := make([]string, 0, len(*v))
+	for _, scope := range *v {
+		scopes = append(scopes, fmt.Sprintf("%s=%s", scope.Name, scope.HubNamespace))
+	}
+	return strings.Join(scopes, ",")
+}
+
+func (v *TenantScopesValueWithValidation) Set(s string) error {
+	if len(s) == 0 {
+		*v = nil
+		return nil
+	}
+
+	entries := strings.Split(s, ",")
+	scopes := make([]TenantScope, 0, len(entries))
+	seenNames := make(map[string]bool, len(entries))
+	seenNamespaces := make(map[string]bool, len(entries))
+	for _, entry := range entries {
+		name, namespace, found := strings.Cut(strings.TrimSpace(entry), "=")
+		if !found {
+			return fmt.Errorf("tenant %q is not in the format of <tenant name>=<hub cluster namespace>", entry)
+		}
+		if errs := validation.IsDNS1123Label(name); len(errs) != 0 {
+			return fmt.Errorf("tenant name %q is invalid: %s", name, strings.Join(errs, "; "))
+		}
+		if errs := validation.IsDNS1123Label(namespace); len(errs) != 0 {
+			return fmt.Errorf("hub cluster namespace %q of tenant %s is invalid: %s", namespace, name, strings.Join(errs, "; "))
+		}
+		if name == workapplier.DefaultTenant {
+			return fmt.Errorf("tenant name %q is reserved for the member cluster itself", name)
+		}
+		if seenNames[name] {
+			return fmt.Errorf("tenant %s is specified more than once", name)
+		}
+		if seenNamespaces[namespace] {
+			return fmt.Errorf("hub cluster namespace %s is assigned to more than one tenant", namespace)
+		}
+		seenNames[name] = true
+		seenNamespaces[namespace] = true
+		scopes = append(scopes, TenantScope{Name: name, HubNamespace: namespace})
+	}
+	*v = scopes
+	return nil
+}
+
+func newTenantScopesValueWithValidation(p *[]TenantScope) *TenantScopesValueWithValidation {
+	return (*TenantScopesValueWithValidation)(p)
+}
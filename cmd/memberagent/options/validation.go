@@ -17,6 +17,7 @@ limitations under the License.
 package options
 
 import (
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 )
 
@@ -50,5 +51,40 @@ func (o *Options) Validate() field.ErrorList {
 		errs = append(errs, field.Invalid(newPath.Child("ApplierOpts").Child("RequeueRateLimiterExponentialBaseForFastBackoff"), o.ApplierOpts.RequeueRateLimiterExponentialBaseForFastBackoff, "The exponential base for the fast backoff stage must be greater than or equal to the exponential base for the slow backoff stage"))
 	}
 
+	// Cross-field validation for property provider options.
+	if o.PropertyProviderOpts.Name == "cost" && o.PropertyProviderOpts.CostProviderPricingFilePath == "" && o.PropertyProviderOpts.Region == "" {
+		errs = append(errs, field.Required(newPath.Child("PropertyProviderOpts").Child("Region"), "The region must be specified for the cost property provider when no pricing file is specified"))
+	}
+
+	if o.PropertyProviderOpts.ConnectivityProbeTargetsFilePath != "" {
+		if o.PropertyProviderOpts.ConnectivityProbeInterval <= 0 {
+			errs = append(errs, field.Invalid(newPath.Child("PropertyProviderOpts").Child("ConnectivityProbeInterval"), o.PropertyProviderOpts.ConnectivityProbeInterval, "The connectivity probe interval must be positive"))
+		}
+		if o.PropertyProviderOpts.ConnectivityProbeTimeout <= 0 || o.PropertyProviderOpts.ConnectivityProbeTimeout > o.PropertyProviderOpts.ConnectivityProbeInterval {
+			errs = append(errs, field.Invalid(newPath.Child("PropertyProviderOpts").Child("ConnectivityProbeTimeout"), o.PropertyProviderOpts.ConnectivityProbeTimeout, "The connectivity probe timeout must be positive and must not exceed the connectivity probe interval"))
+		}
+	}
+
+	// Cross-field validation for tenant options.
+	if len(o.TenantOpts.Tenants) > 0 && float64(o.TenantOpts.Burst) < o.TenantOpts.QPS {
+		errs = append(errs, field.Invalid(newPath.Child("TenantOpts").Child("Burst"), o.TenantOpts.Burst, "The burst limit for tenant hub cluster client-side throttling must be greater than or equal to its QPS limit"))
+	}
+	if len(o.TenantOpts.Tenants) > 0 && len(validation.IsDNS1123Label(o.TenantOpts.ServiceAccountNamespace)) > 0 {
+		errs = append(errs, field.Invalid(newPath.Child("TenantOpts").Child("ServiceAccountNamespace"), o.TenantOpts.ServiceAccountNamespace, "The namespace of the tenant service accounts must be a valid namespace name"))
+	}
+
+	// Cross-field validation for agent upgrade options.
+	if o.AgentUpgradeOpts.Enabled {
+		if o.AgentUpgradeOpts.DeploymentNamespace == "" {
+			errs = append(errs, field.Required(newPath.Child("AgentUpgradeOpts").Child("DeploymentNamespace"), "The namespace of the member agent Deployment must be specified when agent upgrade is enabled"))
+		}
+		if o.AgentUpgradeOpts.DeploymentName == "" {
+			errs = append(errs, field.Required(newPath.Child("AgentUpgradeOpts").Child("DeploymentName"), "The name of the member agent Deployment must be specified when agent upgrade is enabled"))
+		}
+		if o.AgentUpgradeOpts.ContainerName == "" {
+			errs = append(errs, field.Required(newPath.Child("AgentUpgradeOpts").Child("ContainerName"), "The name of the member agent container must be specified when agent upgrade is enabled"))
+		}
+	}
+
 	return errs
 }
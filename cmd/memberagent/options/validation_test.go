@@ -18,6 +18,7 @@ package options
 
 import (
 	"testing"
+	"time"
 
 	"github.com/google/go-cmp/cmp"
 	"k8s.io/apimachinery/pkg/util/validation/field"
@@ -46,6 +47,9 @@ func newTestOptions(modifyOptions ModifyOptions) Options {
 			RequeueRateLimiterExponentialBaseForSlowBackoff:  1.2,
 			RequeueRateLimiterExponentialBaseForFastBackoff:  1.5,
 		},
+		TenantOpts: TenantOptions{
+			ServiceAccountNamespace: "fleet-system",
+		},
 	}
 
 	if modifyOptions != nil {
@@ -109,6 +113,95 @@ func TestValidate(t *testing.T) {
 				field.Invalid(newPath.Child("ApplierOpts").Child("RequeueRateLimiterExponentialBaseForFastBackoff"), 1.5, "The exponential base for the fast backoff stage must be greater than or equal to the exponential base for the slow backoff stage"),
 			},
 		},
+		"cost property provider with a pricing file": {
+			opt: newTestOptions(func(option *Options) {
+				option.PropertyProviderOpts.Name = "cost"
+				option.PropertyProviderOpts.CostProviderPricingFilePath = "/etc/kubefleet/pricing/prices.yaml"
+			}),
+			want: field.ErrorList{},
+		},
+		"cost property provider with a region": {
+			opt: newTestOptions(func(option *Options) {
+				option.PropertyProviderOpts.Name = "cost"
+				option.PropertyProviderOpts.Region = "eastus"
+			}),
+			want: field.ErrorList{},
+		},
+		"cost property provider with neither a pricing file nor a region": {
+			opt: newTestOptions(func(option *Options) {
+				option.PropertyProviderOpts.Name = "cost"
+			}),
+			want: field.ErrorList{
+				field.Required(newPath.Child("PropertyProviderOpts").Child("Region"), "The region must be specified for the cost property provider when no pricing file is specified"),
+			},
+		},
+		"tenant burst less than tenant QPS": {
+			opt: newTestOptions(func(option *Options) {
+				option.TenantOpts.Tenants = []TenantScope{{Name: "tenant-a", HubNamespace: "fleet-member-tenant-a"}}
+				option.TenantOpts.QPS = 200
+				option.TenantOpts.Burst = 100
+			}),
+			want: field.ErrorList{
+				field.Invalid(newPath.Child("TenantOpts").Child("Burst"), 100, "The burst limit for tenant hub cluster client-side throttling must be greater than or equal to its QPS limit"),
+			},
+		},
+		"invalid tenant service account namespace": {
+			opt: newTestOptions(func(option *Options) {
+				option.TenantOpts.Tenants = []TenantScope{{Name: "tenant-a", HubNamespace: "fleet-member-tenant-a"}}
+				option.TenantOpts.ServiceAccountNamespace = ""
+				option.TenantOpts.QPS = 10
+				option.TenantOpts.Burst = 100
+			}),
+			want: field.ErrorList{
+				field.Invalid(newPath.Child("TenantOpts").Child("ServiceAccountNamespace"), "", "The namespace of the tenant service accounts must be a valid namespace name"),
+			},
+		},
+		"tenant burst less than tenant QPS, no tenants": {
+			opt: newTestOptions(func(option *Options) {
+				option.TenantOpts.QPS = 200
+				option.TenantOpts.Burst = 100
+			}),
+			want: field.ErrorList{},
+		},
+		"agent upgrade enabled without a deployment name": {
+			opt: newTestOptions(func(option *Options) {
+				option.AgentUpgradeOpts = AgentUpgradeOptions{
+					Enabled:             true,
+					DeploymentNamespace: "fleet-system",
+					ContainerName:       "member-agent",
+				}
+			}),
+			want: field.ErrorList{
+				field.Required(newPath.Child("AgentUpgradeOpts").Child("DeploymentName"), "The name of the member agent Deployment must be specified when agent upgrade is enabled"),
+			},
+		},
+		"agent upgrade disabled without a deployment name": {
+			opt: newTestOptions(func(option *Options) {
+				option.AgentUpgradeOpts = AgentUpgradeOptions{
+					DeploymentNamespace: "fleet-system",
+					ContainerName:       "member-agent",
+				}
+			}),
+			want: field.ErrorList{},
+		},
+		"connectivity probes with a valid interval and timeout": {
+			opt: newTestOptions(func(option *Options) {
+				option.PropertyProviderOpts.ConnectivityProbeTargetsFilePath = "/etc/kubefleet/connectivity/targets.yaml"
+				option.PropertyProviderOpts.ConnectivityProbeInterval = 30 * time.Second
+				option.PropertyProviderOpts.ConnectivityProbeTimeout = 5 * time.Second
+			}),
+			want: field.ErrorList{},
+		},
+		"connectivity probes with a timeout longer than the interval": {
+			opt: newTestOptions(func(option *Options) {
+				option.PropertyProviderOpts.ConnectivityProbeTargetsFilePath = "/etc/kubefleet/connectivity/targets.yaml"
+				option.PropertyProviderOpts.ConnectivityProbeInterval = 5 * time.Second
+				option.PropertyProviderOpts.ConnectivityProbeTimeout = 10 * time.Second
+			}),
+			want: field.ErrorList{
+				field.Invalid(newPath.Child("PropertyProviderOpts").Child("ConnectivityProbeTimeout"), 10*time.Second, "The connectivity probe timeout must be positive and must not exceed the connectivity probe interval"),
+			},
+		},
 		"multiple simultaneous violations": {
 			opt: newTestOptions(func(option *Options) {
 				option.CtrlManagerOptions.HubManagerOpts.QPS = 200
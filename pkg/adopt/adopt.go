@@ -0,0 +1,581 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package adopt plans the adoption of the resources that already exist in a namespace on member clusters
+// (e.g., deployed by legacy tooling) as a ClusterResourcePlacement. The plan consists of the hub manifests,
+// i.e., the namespace and its resources to create in the hub cluster, the ClusterResourcePlacement that
+// places them to the member clusters, and the ResourceOverrides that keep the per-cluster differences, so
+// that Fleet takes over the existing objects without changing them; along with the changes that the
+// adoption makes on each member cluster.
+package adopt
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	discoveryv1 "k8s.io/api/discovery/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// DefaultClusterNameLabel is the label on the MemberCluster objects whose value is the name of the
+	// member cluster; the generated overrides select the member clusters with it.
+	DefaultClusterNameLabel = "kubernetes-fleet.io/cluster-name"
+
+	// maxOverrideItems is the maximum number of rules in an override policy, and the maximum number of
+	// JSON patches in an override rule.
+	maxOverrideItems = 20
+)
+
+var (
+	// secretDataFields are the fields of a Secret that hold its data.
+	secretDataFields = []string{"data", "stringData"}
+)
+
+// ChangeType describes what the adoption does to a resource on a member cluster.
+type ChangeType string
+
+const (
+	// ChangeTypeTakeOver means that the resource exists on the member cluster and matches the hub manifest
+	// (after the overrides are applied); Fleet takes it over without changing it.
+	ChangeTypeTakeOver ChangeType = "TakeOver"
+	// ChangeTypeCreate means that the resource does not exist on the member cluster; Fleet creates it.
+	ChangeTypeCreate ChangeType = "Create"
+	// ChangeTypeSkipTakeOver means that the resource exists on the member cluster, but its Secret data differ
+	// from the hub manifest; Secret data are never kept as overrides, so Fleet does not take the resource over
+	// and reports the differences instead, leaving it unchanged.
+	ChangeTypeSkipTakeOver ChangeType = "SkipTakeOver"
+)
+
+// Options are the options of an adoption.
+type Options struct {
+	// PlacementName is the name of the ClusterResourcePlacement to generate.
+	PlacementName string
+	// Namespace is the namespace whose resources are adopted.
+	Namespace string
+	// ClusterNameLabel is the label on the MemberCluster objects whose value is the name of the member cluster.
+	ClusterNameLabel string
+}
+
+// Validate validates the options.
+func (o *Options) Validate() error {
+	var errs []error
+	if msgs := validation.IsDNS1123Subdomain(o.PlacementName); len(msgs) > 0 {
+		errs = append(errs, fmt.Errorf("invalid placement name %q: %s", o.PlacementName, strings.Join(msgs, "; ")))
+	}
+	if msgs := validation.IsDNS1123Label(o.Namespace); len(msgs) > 0 {
+		errs = append(errs, fmt.Errorf("invalid namespace %q: %s", o.Namespace, strings.Join(msgs, "; ")))
+	}
+	if msgs := validation.IsQualifiedName(o.ClusterNameLabel); len(msgs) > 0 {
+		errs = append(errs, fmt.Errorf("invalid cluster name label %q: %s", o.ClusterNameLabel, strings.Join(msgs, "; ")))
+	}
+	return errors.Join(errs...)
+}
+
+// ResourceChange is the change that the adoption makes to a resource on a member cluster.
+type ResourceChange struct {
+	// Cluster is the name of the member cluster.
+	Cluster string
+	// Resource identifies the resource.
+	Resource placementv1beta1.ResourceIdentifier
+	// Type is the type of the change.
+	Type ChangeType
+	// Overrides are the JSON patches that are applied to the hub manifest for the member cluster, so that
+	// it matches the existing resource.
+	Overrides []placementv1beta1.JSONPatchOverride
+}
+
+// Plan is the plan of an adoption.
+type Plan struct {
+	// HubObjects are the namespace and its resources to create in the hub cluster.
+	HubObjects []*unstructured.Unstructured
+	// Placement is the ClusterResourcePlacement that places the namespace to the member clusters.
+	Placement *placementv1beta1.ClusterResourcePlacement
+	// Overrides are the ResourceOverrides that keep the per-cluster differences.
+	Overrides []*placementv1beta1.ResourceOverride
+	// Changes are the changes that the adoption makes on the member clusters.
+	Changes []ResourceChange
+}
+
+// BuildPlan builds the plan to adopt the resources in the namespace as observed on the member clusters,
+// keyed by the member cluster names; the observed objects are expected to include the namespace itself.
+//
+// The hub manifest of a resource is taken from the first member cluster (by name) where it exists, and the
+// differences on the other member clusters are kept as overrides. The namespace is an exception: overrides
+// cannot select it without selecting all its resources, so its hub manifest only keeps the labels and
+// annotations that are the same across all the member clusters. Secret data are another: they are never
+// copied into the overrides, so a Secret whose data differ from the hub manifest is not taken over. The
+// ClusterResourcePlacement only takes over existing objects if they match the hub manifests, so that
+// nothing is redeployed.
+func BuildPlan(o *Options, observed map[string][]*unstructured.Unstructured) (*Plan, error) {
+	clusterNames := make([]string, 0, len(observed))
+	for clusterName := range observed {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+	if len(clusterNames) == 0 {
+		return nil, fmt.Errorf("no member clusters are specified")
+	}
+
+	// Sanitize the observed objects and index them by resource.
+	perClusterObjs := make(map[placementv1beta1.ResourceIdentifier]map[string]*unstructured.Unstructured)
+	for _, clusterName := range clusterNames {
+		for _, obj := range observed[clusterName] {
+			id := resourceIdentifier(obj)
+			if id.Namespace != o.Namespace && !isNamespace(id, o.Namespace) {
+				return nil, fmt.Errorf("resource %s on cluster %s is not in namespace %s", formatResourceIdentifier(id), clusterName, o.Namespace)
+			}
+			if perClusterObjs[id] == nil {
+				perClusterObjs[id] = make(map[string]*unstructured.Unstructured)
+			}
+			perClusterObjs[id][clusterName] = sanitize(obj)
+		}
+	}
+	ids := make([]placementv1beta1.ResourceIdentifier, 0, len(perClusterObjs))
+	for id := range perClusterObjs {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		// The namespace goes first.
+		if isNamespace(ids[i], o.Namespace) != isNamespace(ids[j], o.Namespace) {
+			return isNamespace(ids[i], o.Namespace)
+		}
+		return formatResourceIdentifier(ids[i]) < formatResourceIdentifier(ids[j])
+	})
+	if len(ids) == 0 || !isNamespace(ids[0], o.Namespace) {
+		// The namespace is not found on any member cluster; it is created on all of them.
+		ns := &unstructured.Unstructured{}
+		ns.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Namespace"))
+		ns.SetName(o.Namespace)
+		id := resourceIdentifier(ns)
+		perClusterObjs[id] = map[string]*unstructured.Unstructured{}
+		ids = append([]placementv1beta1.ResourceIdentifier{id}, ids...)
+	}
+
+	plan := &Plan{
+		Placement: buildPlacement(o, clusterNames),
+	}
+	for _, id := range ids {
+		objs := perClusterObjs[id]
+		var hubObj *unstructured.Unstructured
+		if isNamespace(id, o.Namespace) {
+			hubObj = buildHubNamespace(o.Namespace, clusterNames, objs)
+		} else {
+			for _, clusterName := range clusterNames {
+				if obj, found := objs[clusterName]; found {
+					hubObj = obj
+					break
+				}
+			}
+		}
+		plan.HubObjects = append(plan.HubObjects, hubObj)
+
+		var rules []placementv1beta1.OverrideRule
+		for _, clusterName := range clusterNames {
+			obj, found := objs[clusterName]
+			if !found {
+				plan.Changes = append(plan.Changes, ResourceChange{Cluster: clusterName, Resource: id, Type: ChangeTypeCreate})
+				continue
+			}
+			change := ResourceChange{Cluster: clusterName, Resource: id, Type: ChangeTypeTakeOver}
+			if !isNamespace(id, o.Namespace) {
+				hubFields, memberFields := hubObj.Object, obj.Object
+				if isSecret(id) {
+					if !secretDataEqual(hubObj, obj) {
+						change.Type = ChangeTypeSkipTakeOver
+					}
+					hubFields, memberFields = withoutSecretData(hubObj), withoutSecretData(obj)
+				}
+				patches, err := buildOverridePatches(hubFields, memberFields)
+				if err != nil {
+					return nil, fmt.Errorf("failed to keep the differences of resource %s on cluster %s: %w", formatResourceIdentifier(id), clusterName, err)
+				}
+				if len(patches) > 0 {
+					change.Overrides = patches
+					rules = append(rules, placementv1beta1.OverrideRule{
+						ClusterSelector: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{o.ClusterNameLabel: clusterName},
+									},
+								},
+							},
+						},
+						OverrideType:       placementv1beta1.JSONPatchOverrideType,
+						JSONPatchOverrides: patches,
+					})
+				}
+			}
+			plan.Changes = append(plan.Changes, change)
+		}
+		if len(rules) > maxOverrideItems {
+			return nil, fmt.Errorf("resource %s differs on %d member clusters, which exceeds the maximum of %d override rules", formatResourceIdentifier(id), len(rules), maxOverrideItems)
+		}
+		if len(rules) > 0 {
+			plan.Overrides = append(plan.Overrides, buildOverride(o, id, rules))
+		}
+	}
+	return plan, nil
+}
+
+// SelectAdoptableObjects selects the objects in a namespace that are adopted, skipping the ones that are
+// created by the Kubernetes controllers (e.g., the ReplicaSets of the Deployments and the endpoints of
+// the services) and the API server (e.g., the default service account), which mirrors the objects that
+// Fleet does not propagate.
+func SelectAdoptableObjects(objs []*unstructured.Unstructured) []*unstructured.Unstructured {
+	serviceNames := make(map[string]bool)
+	for _, obj := range objs {
+		if obj.GroupVersionKind() == corev1.SchemeGroupVersion.WithKind("Service") {
+			serviceNames[obj.GetName()] = true
+		}
+	}
+
+	var res []*unstructured.Unstructured
+	for _, obj := range objs {
+		if len(obj.GetOwnerReferences()) > 0 {
+			// The object is managed by another object.
+			continue
+		}
+		switch obj.GroupVersionKind() {
+		case corev1.SchemeGroupVersion.WithKind("ConfigMap"):
+			if obj.GetName() == "kube-root-ca.crt" {
+				continue
+			}
+		case corev1.SchemeGroupVersion.WithKind("ServiceAccount"):
+			if obj.GetName() == "default" {
+				continue
+			}
+		case corev1.SchemeGroupVersion.WithKind("Secret"):
+			if secretType, _, _ := unstructured.NestedString(obj.Object, "type"); secretType == string(corev1.SecretTypeServiceAccountToken) {
+				continue
+			}
+		case corev1.SchemeGroupVersion.WithKind("Endpoints"):
+			if serviceNames[obj.GetName()] {
+				continue
+			}
+		case discoveryv1.SchemeGroupVersion.WithKind("EndpointSlice"):
+			if _, found := obj.GetLabels()[discoveryv1.LabelManagedBy]; found {
+				continue
+			}
+		case corev1.SchemeGroupVersion.WithKind("Event"):
+			continue
+		}
+		res = append(res, obj)
+	}
+	return res
+}
+
+func buildPlacement(o *Options, clusterNames []string) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: placementv1beta1.GroupVersion.String(),
+			Kind:       placementv1beta1.ClusterResourcePlacementKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: o.PlacementName,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{
+				{
+					Group:   corev1.GroupName,
+					Version: corev1.SchemeGroupVersion.Version,
+					Kind:    "Namespace",
+					Name:    o.Namespace,
+				},
+			},
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickFixedPlacementType,
+				ClusterNames:  clusterNames,
+			},
+			Strategy: placementv1beta1.RolloutStrategy{
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					// Only take over the existing objects that match the hub manifests, so that the adoption never
+					// changes them.
+					WhenToTakeOver:   placementv1beta1.WhenToTakeOverTypeIfNoDiff,
+					ComparisonOption: placementv1beta1.ComparisonOptionTypePartialComparison,
+				},
+			},
+		},
+	}
+}
+
+func buildOverride(o *Options, id placementv1beta1.ResourceIdentifier, rules []placementv1beta1.OverrideRule) *placementv1beta1.ResourceOverride {
+	return &placementv1beta1.ResourceOverride{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: placementv1beta1.GroupVersion.String(),
+			Kind:       placementv1beta1.ResourceOverrideKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("%s-%s-%s", o.PlacementName, strings.ToLower(id.Kind), id.Name),
+			Namespace: o.Namespace,
+		},
+		Spec: placementv1beta1.ResourceOverrideSpec{
+			Placement: &placementv1beta1.PlacementRef{
+				Name:  o.PlacementName,
+				Scope: placementv1beta1.ClusterScoped,
+			},
+			ResourceSelectors: []placementv1beta1.ResourceSelector{
+				{
+					Group:   id.Group,
+					Version: id.Version,
+					Kind:    id.Kind,
+					Name:    id.Name,
+				},
+			},
+			Policy: &placementv1beta1.OverridePolicy{
+				OverrideRules: rules,
+			},
+		},
+	}
+}
+
+// buildHubNamespace builds the hub manifest of the namespace, which keeps the labels and annotations that
+// are the same on all the member clusters where the namespace exists.
+func buildHubNamespace(name string, clusterNames []string, objs map[string]*unstructured.Unstructured) *unstructured.Unstructured {
+	var labels, annotations map[string]string
+	first := true
+	for _, clusterName := range clusterNames {
+		obj, found := objs[clusterName]
+		if !found {
+			continue
+		}
+		if first {
+			labels, annotations = obj.GetLabels(), obj.GetAnnotations()
+			first = false
+			continue
+		}
+		labels = intersect(labels, obj.GetLabels())
+		annotations = intersect(annotations, obj.GetAnnotations())
+	}
+
+	ns := &unstructured.Unstructured{}
+	ns.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("Namespace"))
+	ns.SetName(name)
+	if len(labels) > 0 {
+		ns.SetLabels(labels)
+	}
+	if len(annotations) > 0 {
+		ns.SetAnnotations(annotations)
+	}
+	return ns
+}
+
+func intersect(a, b map[string]string) map[string]string {
+	res := make(map[string]string)
+	for k, v := range a {
+		if bv, found := b[k]; found && bv == v {
+			res[k] = v
+		}
+	}
+	return res
+}
+
+// buildOverridePatches builds the JSON patches that turn the hub manifest into the existing object. The
+// patches are built on the leaf fields; if there are too many of them, they are built on coarser fields.
+func buildOverridePatches(hubObj, memberObj map[string]interface{}) ([]placementv1beta1.JSONPatchOverride, error) {
+	for _, maxDepth := range []int{-1, 3, 2} {
+		patches, err := diff(hubObj, memberObj, "", maxDepth)
+		if err != nil {
+			return nil, err
+		}
+		for _, patch := range patches {
+			// Overrides can only patch the labels and annotations among the metadata fields.
+			parts := strings.Split(patch.Path, "/")
+			if parts[1] == "metadata" && parts[2] != "labels" && parts[2] != "annotations" {
+				return nil, fmt.Errorf("field %s differs from the hub manifest, which cannot be overridden", patch.Path)
+			}
+		}
+		if len(patches) <= maxOverrideItems {
+			return patches, nil
+		}
+	}
+	return nil, fmt.Errorf("too many differences from the hub manifest to keep as overrides")
+}
+
+// diff builds the JSON patches that turn one object into another; it does not descend into the fields
+// beyond the given depth (if positive) and replaces them as a whole instead. Lists are always replaced as
+// a whole.
+func diff(from, to map[string]interface{}, path string, maxDepth int) ([]placementv1beta1.JSONPatchOverride, error) {
+	keys := make([]string, 0, len(from)+len(to))
+	for k := range from {
+		keys = append(keys, k)
+	}
+	for k := range to {
+		if _, found := from[k]; !found {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	var patches []placementv1beta1.JSONPatchOverride
+	for _, k := range keys {
+		fieldPath := path + "/" + escapeJSONPointer(k)
+		fromVal, inFrom := from[k]
+		toVal, inTo := to[k]
+		switch {
+		case !inTo:
+			patches = append(patches, placementv1beta1.JSONPatchOverride{
+				Operator: placementv1beta1.JSONPatchOverrideOpRemove,
+				Path:     fieldPath,
+			})
+		case !inFrom:
+			patch, err := valuePatch(placementv1beta1.JSONPatchOverrideOpAdd, fieldPath, toVal)
+			if err != nil {
+				return nil, err
+			}
+			patches = append(patches, patch)
+		case reflect.DeepEqual(fromVal, toVal):
+			continue
+		default:
+			fromMap, fromIsMap := fromVal.(map[string]interface{})
+			toMap, toIsMap := toVal.(map[string]interface{})
+			if fromIsMap && toIsMap && maxDepth != 1 {
+				subPatches, err := diff(fromMap, toMap, fieldPath, maxDepth-1)
+				if err != nil {
+					return nil, err
+				}
+				patches = append(patches, subPatches...)
+				continue
+			}
+			patch, err := valuePatch(placementv1beta1.JSONPatchOverrideOpReplace, fieldPath, toVal)
+			if err != nil {
+				return nil, err
+			}
+			patches = append(patches, patch)
+		}
+	}
+	return patches, nil
+}
+
+func valuePatch(op placementv1beta1.JSONPatchOverrideOperator, path string, val interface{}) (placementv1beta1.JSONPatchOverride, error) {
+	raw, err := json.Marshal(val)
+	if err != nil {
+		return placementv1beta1.JSONPatchOverride{}, fmt.Errorf("failed to marshal the value of %s: %w", path, err)
+	}
+	return placementv1beta1.JSONPatchOverride{
+		Operator: op,
+		Path:     path,
+		Value:    apiextensionsv1.JSON{Raw: raw},
+	}, nil
+}
+
+// escapeJSONPointer escapes a reference token of a JSON pointer per RFC 6901.
+func escapeJSONPointer(token string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(token, "~", "~0"), "/", "~1")
+}
+
+// sanitize strips the fields that are set by the member cluster API servers and controllers, which mirrors
+// how Fleet prepares the hub manifests for dispatch.
+func sanitize(obj *unstructured.Unstructured) *unstructured.Unstructured {
+	obj = obj.DeepCopy()
+	obj.SetResourceVersion("")
+	obj.SetGeneration(0)
+	obj.SetUID("")
+	obj.SetSelfLink("")
+	obj.SetDeletionTimestamp(nil)
+	obj.SetManagedFields(nil)
+	obj.SetOwnerReferences(nil)
+	unstructured.RemoveNestedField(obj.Object, "metadata", "creationTimestamp")
+	unstructured.RemoveNestedField(obj.Object, "status")
+
+	annotations := obj.GetAnnotations()
+	delete(annotations, corev1.LastAppliedConfigAnnotation)
+	delete(annotations, "deployment.kubernetes.io/revision")
+	if len(annotations) == 0 {
+		obj.SetAnnotations(nil)
+	} else {
+		obj.SetAnnotations(annotations)
+	}
+
+	if obj.GroupVersionKind() == corev1.SchemeGroupVersion.WithKind("Service") {
+		// The cluster IPs and node ports are allocated by the member clusters.
+		if clusterIP, found, _ := unstructured.NestedString(obj.Object, "spec", "clusterIP"); found && clusterIP != corev1.ClusterIPNone {
+			unstructured.RemoveNestedField(obj.Object, "spec", "clusterIP")
+			unstructured.RemoveNestedField(obj.Object, "spec", "clusterIPs")
+		}
+		unstructured.RemoveNestedField(obj.Object, "spec", "healthCheckNodePort")
+		if ports, found, _ := unstructured.NestedSlice(obj.Object, "spec", "ports"); found {
+			for i := range ports {
+				if port, ok := ports[i].(map[string]interface{}); ok {
+					delete(port, "nodePort")
+				}
+			}
+			_ = unstructured.SetNestedSlice(obj.Object, ports, "spec", "ports")
+		}
+	}
+	return obj
+}
+
+func resourceIdentifier(obj *unstructured.Unstructured) placementv1beta1.ResourceIdentifier {
+	gvk := obj.GroupVersionKind()
+	return placementv1beta1.ResourceIdentifier{
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}
+}
+
+func isSecret(id placementv1beta1.ResourceIdentifier) bool {
+	return schema.GroupKind{Group: id.Group, Kind: id.Kind} == schema.GroupKind{Kind: "Secret"}
+}
+
+// secretDataEqual returns if two Secrets have the same data.
+func secretDataEqual(a, b *unstructured.Unstructured) bool {
+	for _, field := range secretDataFields {
+		aVal, _, _ := unstructured.NestedFieldNoCopy(a.Object, field)
+		bVal, _, _ := unstructured.NestedFieldNoCopy(b.Object, field)
+		if !reflect.DeepEqual(aVal, bVal) {
+			return false
+		}
+	}
+	return true
+}
+
+// withoutSecretData returns the fields of a Secret without its data, so that the data never end up in the
+// overrides.
+func withoutSecretData(obj *unstructured.Unstructured) map[string]interface{} {
+	fields := make(map[string]interface{}, len(obj.Object))
+	for k, v := range obj.Object {
+		fields[k] = v
+	}
+	for _, field := range secretDataFields {
+		delete(fields, field)
+	}
+	return fields
+}
+
+func isNamespace(id placementv1beta1.ResourceIdentifier, namespace string) bool {
+	return schema.GroupKind{Group: id.Group, Kind: id.Kind} == schema.GroupKind{Kind: "Namespace"} && id.Name == namespace
+}
+
+// formatResourceIdentifier formats a resource identifier in the form of group/version/kind/namespace/name.
+func formatResourceIdentifier(id placementv1beta1.ResourceIdentifier) string {
+	return strings.Join([]string{id.Group, id.Version, id.Kind, id.Namespace, id.Name}, "/")
+}
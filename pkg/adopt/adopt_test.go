@@ -0,0 +1,473 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adopt
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	placementName = "crp-adopt"
+	namespaceName = "app"
+	cluster1      = "cluster-1"
+	cluster2      = "cluster-2"
+)
+
+var (
+	opts = &Options{
+		PlacementName:    placementName,
+		Namespace:        namespaceName,
+		ClusterNameLabel: DefaultClusterNameLabel,
+	}
+
+	namespaceID = placementv1beta1.ResourceIdentifier{Version: "v1", Kind: "Namespace", Name: namespaceName}
+	configMapID = placementv1beta1.ResourceIdentifier{Version: "v1", Kind: "ConfigMap", Namespace: namespaceName, Name: "config"}
+	deployID    = placementv1beta1.ResourceIdentifier{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: namespaceName, Name: "web"}
+	secretID    = placementv1beta1.ResourceIdentifier{Version: "v1", Kind: "Secret", Namespace: namespaceName, Name: "creds"}
+)
+
+func namespace(labels map[string]interface{}) *unstructured.Unstructured {
+	metadata := map[string]interface{}{
+		"name":              namespaceName,
+		"uid":               "ns-uid",
+		"resourceVersion":   "1",
+		"creationTimestamp": "2025-01-01T00:00:00Z",
+	}
+	if labels != nil {
+		metadata["labels"] = labels
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Namespace",
+		"metadata":   metadata,
+		"status":     map[string]interface{}{"phase": "Active"},
+	}}
+}
+
+func configMap(data map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":            "config",
+			"namespace":       namespaceName,
+			"uid":             "cm-uid",
+			"resourceVersion": "2",
+		},
+		"data": data,
+	}}
+}
+
+func secret(labels, data map[string]interface{}) *unstructured.Unstructured {
+	metadata := map[string]interface{}{
+		"name":      "creds",
+		"namespace": namespaceName,
+	}
+	if labels != nil {
+		metadata["labels"] = labels
+	}
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "Secret",
+		"metadata":   metadata,
+		"type":       "Opaque",
+		"data":       data,
+	}}
+}
+
+func deployment(replicas int64, image string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "apps/v1",
+		"kind":       "Deployment",
+		"metadata": map[string]interface{}{
+			"name":      "web",
+			"namespace": namespaceName,
+			"annotations": map[string]interface{}{
+				"deployment.kubernetes.io/revision": "3",
+			},
+			"generation": int64(3),
+		},
+		"spec": map[string]interface{}{
+			"replicas": replicas,
+			"template": map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "web", "image": image},
+					},
+				},
+			},
+		},
+		"status": map[string]interface{}{"readyReplicas": replicas},
+	}}
+}
+
+func clusterSelector(clusterName string) *placementv1beta1.ClusterSelector {
+	return &placementv1beta1.ClusterSelector{
+		ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+			{
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{DefaultClusterNameLabel: clusterName},
+				},
+			},
+		},
+	}
+}
+
+// TestBuildPlan tests the BuildPlan function.
+func TestBuildPlan(t *testing.T) {
+	deployPatches := []placementv1beta1.JSONPatchOverride{
+		{
+			Operator: placementv1beta1.JSONPatchOverrideOpReplace,
+			Path:     "/spec/replicas",
+			Value:    apiextensionsv1.JSON{Raw: []byte(`5`)},
+		},
+		{
+			Operator: placementv1beta1.JSONPatchOverrideOpReplace,
+			Path:     "/spec/template/spec/containers",
+			Value:    apiextensionsv1.JSON{Raw: []byte(`[{"image":"web:v2","name":"web"}]`)},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		observed         map[string][]*unstructured.Unstructured
+		wantHubObjects   []*unstructured.Unstructured
+		wantOverrides    []*placementv1beta1.ResourceOverride
+		wantChanges      []ResourceChange
+		wantClusterNames []string
+		wantErr          bool
+	}{
+		{
+			name: "identical resources",
+			observed: map[string][]*unstructured.Unstructured{
+				cluster1: {namespace(nil), configMap(map[string]interface{}{"key": "value"})},
+				cluster2: {namespace(nil), configMap(map[string]interface{}{"key": "value"})},
+			},
+			wantHubObjects: []*unstructured.Unstructured{
+				{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Namespace", "metadata": map[string]interface{}{"name": namespaceName}}},
+				{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]interface{}{"name": "config", "namespace": namespaceName}, "data": map[string]interface{}{"key": "value"}}},
+			},
+			wantChanges: []ResourceChange{
+				{Cluster: cluster1, Resource: namespaceID, Type: ChangeTypeTakeOver},
+				{Cluster: cluster2, Resource: namespaceID, Type: ChangeTypeTakeOver},
+				{Cluster: cluster1, Resource: configMapID, Type: ChangeTypeTakeOver},
+				{Cluster: cluster2, Resource: configMapID, Type: ChangeTypeTakeOver},
+			},
+			wantClusterNames: []string{cluster1, cluster2},
+		},
+		{
+			name: "per-cluster differences and missing resources",
+			observed: map[string][]*unstructured.Unstructured{
+				cluster1: {
+					namespace(map[string]interface{}{"team": "a", "env": "prod"}),
+					deployment(3, "web:v1"),
+					configMap(map[string]interface{}{"key": "value"}),
+				},
+				cluster2: {
+					namespace(map[string]interface{}{"team": "a", "env": "staging"}),
+					deployment(5, "web:v2"),
+				},
+			},
+			wantHubObjects: []*unstructured.Unstructured{
+				{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Namespace", "metadata": map[string]interface{}{"name": namespaceName, "labels": map[string]interface{}{"team": "a"}}}},
+				{Object: map[string]interface{}{"apiVersion": "v1", "kind": "ConfigMap", "metadata": map[string]interface{}{"name": "config", "namespace": namespaceName}, "data": map[string]interface{}{"key": "value"}}},
+				{Object: map[string]interface{}{
+					"apiVersion": "apps/v1",
+					"kind":       "Deployment",
+					"metadata":   map[string]interface{}{"name": "web", "namespace": namespaceName},
+					"spec": map[string]interface{}{
+						"replicas": int64(3),
+						"template": map[string]interface{}{
+							"spec": map[string]interface{}{
+								"containers": []interface{}{map[string]interface{}{"name": "web", "image": "web:v1"}},
+							},
+						},
+					},
+				}},
+			},
+			wantOverrides: []*placementv1beta1.ResourceOverride{
+				{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: placementv1beta1.GroupVersion.String(),
+						Kind:       placementv1beta1.ResourceOverrideKind,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("%s-deployment-web", placementName),
+						Namespace: namespaceName,
+					},
+					Spec: placementv1beta1.ResourceOverrideSpec{
+						Placement: &placementv1beta1.PlacementRef{
+							Name:  placementName,
+							Scope: placementv1beta1.ClusterScoped,
+						},
+						ResourceSelectors: []placementv1beta1.ResourceSelector{
+							{Group: "apps", Version: "v1", Kind: "Deployment", Name: "web"},
+						},
+						Policy: &placementv1beta1.OverridePolicy{
+							OverrideRules: []placementv1beta1.OverrideRule{
+								{
+									ClusterSelector:    clusterSelector(cluster2),
+									OverrideType:       placementv1beta1.JSONPatchOverrideType,
+									JSONPatchOverrides: deployPatches,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantChanges: []ResourceChange{
+				{Cluster: cluster1, Resource: namespaceID, Type: ChangeTypeTakeOver},
+				{Cluster: cluster2, Resource: namespaceID, Type: ChangeTypeTakeOver},
+				{Cluster: cluster1, Resource: configMapID, Type: ChangeTypeTakeOver},
+				{Cluster: cluster2, Resource: configMapID, Type: ChangeTypeCreate},
+				{Cluster: cluster1, Resource: deployID, Type: ChangeTypeTakeOver},
+				{Cluster: cluster2, Resource: deployID, Type: ChangeTypeTakeOver, Overrides: deployPatches},
+			},
+			wantClusterNames: []string{cluster1, cluster2},
+		},
+		{
+			name: "secret data differences are not kept as overrides",
+			observed: map[string][]*unstructured.Unstructured{
+				cluster1: {namespace(nil), secret(nil, map[string]interface{}{"password": "b25l"})},
+				cluster2: {namespace(nil), secret(map[string]interface{}{"tier": "web"}, map[string]interface{}{"password": "dHdv"})},
+			},
+			wantHubObjects: []*unstructured.Unstructured{
+				{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Namespace", "metadata": map[string]interface{}{"name": namespaceName}}},
+				secret(nil, map[string]interface{}{"password": "b25l"}),
+			},
+			wantOverrides: []*placementv1beta1.ResourceOverride{
+				{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: placementv1beta1.GroupVersion.String(),
+						Kind:       placementv1beta1.ResourceOverrideKind,
+					},
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      fmt.Sprintf("%s-secret-creds", placementName),
+						Namespace: namespaceName,
+					},
+					Spec: placementv1beta1.ResourceOverrideSpec{
+						Placement: &placementv1beta1.PlacementRef{
+							Name:  placementName,
+							Scope: placementv1beta1.ClusterScoped,
+						},
+						ResourceSelectors: []placementv1beta1.ResourceSelector{
+							{Version: "v1", Kind: "Secret", Name: "creds"},
+						},
+						Policy: &placementv1beta1.OverridePolicy{
+							OverrideRules: []placementv1beta1.OverrideRule{
+								{
+									ClusterSelector: clusterSelector(cluster2),
+									OverrideType:    placementv1beta1.JSONPatchOverrideType,
+									JSONPatchOverrides: []placementv1beta1.JSONPatchOverride{
+										{
+											Operator: placementv1beta1.JSONPatchOverrideOpAdd,
+											Path:     "/metadata/labels",
+											Value:    apiextensionsv1.JSON{Raw: []byte(`{"tier":"web"}`)},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantChanges: []ResourceChange{
+				{Cluster: cluster1, Resource: namespaceID, Type: ChangeTypeTakeOver},
+				{Cluster: cluster2, Resource: namespaceID, Type: ChangeTypeTakeOver},
+				{Cluster: cluster1, Resource: secretID, Type: ChangeTypeTakeOver},
+				{Cluster: cluster2, Resource: secretID, Type: ChangeTypeSkipTakeOver, Overrides: []placementv1beta1.JSONPatchOverride{
+					{
+						Operator: placementv1beta1.JSONPatchOverrideOpAdd,
+						Path:     "/metadata/labels",
+						Value:    apiextensionsv1.JSON{Raw: []byte(`{"tier":"web"}`)},
+					},
+				}},
+			},
+			wantClusterNames: []string{cluster1, cluster2},
+		},
+		{
+			name: "namespace not found",
+			observed: map[string][]*unstructured.Unstructured{
+				cluster1: {},
+			},
+			wantHubObjects: []*unstructured.Unstructured{
+				{Object: map[string]interface{}{"apiVersion": "v1", "kind": "Namespace", "metadata": map[string]interface{}{"name": namespaceName}}},
+			},
+			wantChanges: []ResourceChange{
+				{Cluster: cluster1, Resource: namespaceID, Type: ChangeTypeCreate},
+			},
+			wantClusterNames: []string{cluster1},
+		},
+		{
+			name: "resource in another namespace",
+			observed: map[string][]*unstructured.Unstructured{
+				cluster1: {
+					{Object: map[string]interface{}{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata":   map[string]interface{}{"name": "config", "namespace": "other"},
+					}},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "metadata differences that cannot be overridden",
+			observed: map[string][]*unstructured.Unstructured{
+				cluster1: {namespace(nil), configMap(nil)},
+				cluster2: {namespace(nil), func() *unstructured.Unstructured {
+					cm := configMap(nil)
+					cm.SetFinalizers([]string{"example.com/protect"})
+					return cm
+				}()},
+			},
+			wantErr: true,
+		},
+		{
+			name:     "no member clusters",
+			observed: map[string][]*unstructured.Unstructured{},
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plan, err := BuildPlan(opts, tc.observed)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("BuildPlan() = %v, want error %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(plan.HubObjects, tc.wantHubObjects); diff != "" {
+				t.Errorf("BuildPlan() hub objects mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(plan.Overrides, tc.wantOverrides); diff != "" {
+				t.Errorf("BuildPlan() overrides mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(plan.Changes, tc.wantChanges); diff != "" {
+				t.Errorf("BuildPlan() changes mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(plan.Placement.Spec.Policy.ClusterNames, tc.wantClusterNames); diff != "" {
+				t.Errorf("BuildPlan() placement cluster names mismatch (-got, +want):\n%s", diff)
+			}
+			applyStrategy := plan.Placement.Spec.Strategy.ApplyStrategy
+			if applyStrategy == nil || applyStrategy.WhenToTakeOver != placementv1beta1.WhenToTakeOverTypeIfNoDiff {
+				t.Errorf("BuildPlan() placement apply strategy = %+v, want to take over only if there is no diff", applyStrategy)
+			}
+		})
+	}
+}
+
+// TestBuildOverridePatches tests the buildOverridePatches function.
+func TestBuildOverridePatches(t *testing.T) {
+	testCases := []struct {
+		name        string
+		hubObj      map[string]interface{}
+		memberObj   map[string]interface{}
+		wantPatches []placementv1beta1.JSONPatchOverride
+		wantErr     bool
+	}{
+		{
+			name:      "add, remove and replace",
+			hubObj:    map[string]interface{}{"metadata": map[string]interface{}{"name": "a"}, "data": map[string]interface{}{"x": "1", "y": "2"}},
+			memberObj: map[string]interface{}{"metadata": map[string]interface{}{"name": "a", "labels": map[string]interface{}{"app.kubernetes.io/name": "a"}}, "data": map[string]interface{}{"x": "3", "z~": "4"}},
+			wantPatches: []placementv1beta1.JSONPatchOverride{
+				{Operator: placementv1beta1.JSONPatchOverrideOpReplace, Path: "/data/x", Value: apiextensionsv1.JSON{Raw: []byte(`"3"`)}},
+				{Operator: placementv1beta1.JSONPatchOverrideOpRemove, Path: "/data/y"},
+				{Operator: placementv1beta1.JSONPatchOverrideOpAdd, Path: "/data/z~0", Value: apiextensionsv1.JSON{Raw: []byte(`"4"`)}},
+				{Operator: placementv1beta1.JSONPatchOverrideOpAdd, Path: "/metadata/labels", Value: apiextensionsv1.JSON{Raw: []byte(`{"app.kubernetes.io/name":"a"}`)}},
+			},
+		},
+		{
+			name: "too many leaf differences",
+			hubObj: map[string]interface{}{"spec": map[string]interface{}{"values": func() map[string]interface{} {
+				m := map[string]interface{}{}
+				for i := 0; i < 25; i++ {
+					m[fmt.Sprintf("k%02d", i)] = "a"
+				}
+				return m
+			}()}},
+			memberObj: map[string]interface{}{"spec": map[string]interface{}{"values": map[string]interface{}{}}},
+			wantPatches: []placementv1beta1.JSONPatchOverride{
+				{Operator: placementv1beta1.JSONPatchOverrideOpReplace, Path: "/spec/values", Value: apiextensionsv1.JSON{Raw: []byte(`{}`)}},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := buildOverridePatches(tc.hubObj, tc.memberObj)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("buildOverridePatches() = %v, want error %t", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(got, tc.wantPatches); diff != "" {
+				t.Errorf("buildOverridePatches() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestSelectAdoptableObjects tests the SelectAdoptableObjects function.
+func TestSelectAdoptableObjects(t *testing.T) {
+	newObj := func(apiVersion, kind, name string, mutate func(obj *unstructured.Unstructured)) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       kind,
+			"metadata":   map[string]interface{}{"name": name, "namespace": namespaceName},
+		}}
+		if mutate != nil {
+			mutate(obj)
+		}
+		return obj
+	}
+	ownedReplicaSet := newObj("apps/v1", "ReplicaSet", "web-abc", func(obj *unstructured.Unstructured) {
+		obj.SetOwnerReferences([]metav1.OwnerReference{{APIVersion: "apps/v1", Kind: "Deployment", Name: "web", UID: "uid"}})
+	})
+	rootCA := newObj("v1", "ConfigMap", "kube-root-ca.crt", nil)
+	defaultSA := newObj("v1", "ServiceAccount", "default", nil)
+	saToken := newObj("v1", "Secret", "sa-token", func(obj *unstructured.Unstructured) {
+		obj.Object["type"] = "kubernetes.io/service-account-token"
+	})
+	service := newObj("v1", "Service", "web", nil)
+	serviceEndpoints := newObj("v1", "Endpoints", "web", nil)
+	managedEndpointSlice := newObj("discovery.k8s.io/v1", "EndpointSlice", "web-xyz", func(obj *unstructured.Unstructured) {
+		obj.SetLabels(map[string]string{"endpointslice.kubernetes.io/managed-by": "endpointslice-controller.k8s.io"})
+	})
+	event := newObj("v1", "Event", "web.123", nil)
+	deploy := newObj("apps/v1", "Deployment", "web", nil)
+	secret := newObj("v1", "Secret", "credentials", func(obj *unstructured.Unstructured) {
+		obj.Object["type"] = "Opaque"
+	})
+	userEndpoints := newObj("v1", "Endpoints", "external", nil)
+
+	objs := []*unstructured.Unstructured{
+		ownedReplicaSet, rootCA, defaultSA, saToken, service, serviceEndpoints, managedEndpointSlice, event, deploy, secret, userEndpoints,
+	}
+	want := []*unstructured.Unstructured{service, deploy, secret, userEndpoints}
+	if diff := cmp.Diff(SelectAdoptableObjects(objs), want); diff != "" {
+		t.Errorf("SelectAdoptableObjects() mismatch (-got, +want):\n%s", diff)
+	}
+}
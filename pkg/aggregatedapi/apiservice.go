@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregatedapi
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+var (
+	apiServiceGVK = schema.GroupVersionKind{Group: "apiregistration.k8s.io", Version: "v1", Kind: "APIService"}
+)
+
+// CABundleInjector sets the CA bundle of the self-signed serving certificate in the APIService
+// objects that register the aggregated APIs, so that the API aggregation layer can verify the
+// server; it is used when the serving certificate is not managed by cert-manager.
+type CABundleInjector struct {
+	client          client.Client
+	caBundle        []byte
+	apiServiceNames []string
+}
+
+// NewCABundleInjector returns a CABundleInjector that sets the given CA bundle in the given APIService
+// objects.
+func NewCABundleInjector(client client.Client, caBundle []byte, apiServiceNames ...string) *CABundleInjector {
+	return &CABundleInjector{
+		client:          client,
+		caBundle:        caBundle,
+		apiServiceNames: apiServiceNames,
+	}
+}
+
+// Start sets the CA bundle in the APIService objects; it implements the controller-runtime Runnable
+// interface, and runs on the leader hub agent instance only.
+//
+// APIService objects that do not exist are skipped, as registering the aggregated APIs is opt-in.
+func (i *CABundleInjector) Start(ctx context.Context) error {
+	patch, err := json.Marshal(map[string]interface{}{
+		"spec": map[string]interface{}{
+			// The CA bundle is base64-encoded by the JSON marshaller, as the API expects.
+			"caBundle":              i.caBundle,
+			"insecureSkipTLSVerify": false,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to build the CA bundle patch: %w", err)
+	}
+	for _, name := range i.apiServiceNames {
+		apiService := &unstructured.Unstructured{}
+		apiService.SetGroupVersionKind(apiServiceGVK)
+		apiService.SetName(name)
+		if err := i.client.Patch(ctx, apiService, client.RawPatch(types.MergePatchType, patch)); err != nil {
+			if apierrors.IsNotFound(err) {
+				klog.V(2).InfoS("Skipped setting the CA bundle as the APIService is not registered", "apiService", name)
+				continue
+			}
+			return fmt.Errorf("failed to set the CA bundle of APIService %s: %w", name, err)
+		}
+		klog.V(2).InfoS("Set the CA bundle of the APIService", "apiService", name)
+	}
+	return nil
+}
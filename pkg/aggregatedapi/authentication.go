@@ -0,0 +1,178 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregatedapi
+
+import (
+	"context"
+	"crypto/x509"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"slices"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// authenticationConfigMapNamespace and authenticationConfigMapName locate the config map in which
+	// the API server publishes how the API aggregation layer authenticates the requests it proxies.
+	authenticationConfigMapNamespace = "kube-system"
+	authenticationConfigMapName      = "extension-apiserver-authentication"
+
+	// The keys in the authentication config map.
+	requestHeaderClientCAKey            = "requestheader-client-ca-file"
+	requestHeaderAllowedNamesKey        = "requestheader-allowed-names"
+	requestHeaderUsernameHeadersKey     = "requestheader-username-headers"
+	requestHeaderGroupHeadersKey        = "requestheader-group-headers"
+	requestHeaderExtraHeaderPrefixesKey = "requestheader-extra-headers-prefix"
+)
+
+// Authenticator authenticates the requests proxied by the API aggregation layer.
+type Authenticator interface {
+	// Authenticate returns the user on whose behalf a request is sent, or an error if the request
+	// is not sent by the API aggregation layer.
+	Authenticate(req *http.Request) (*authenticationv1.UserInfo, error)
+}
+
+// requestHeaderAuthenticator authenticates requests in the same way as the API server delegates
+// authentication to the API aggregation layer: the request must present a client certificate that
+// is signed by the request header client CA (and has one of the allowed common names, if any), and
+// the user is read from the request headers set by the aggregation layer.
+type requestHeaderAuthenticator struct {
+	clientCAs           *x509.CertPool
+	allowedNames        []string
+	usernameHeaders     []string
+	groupHeaders        []string
+	extraHeaderPrefixes []string
+}
+
+// NewRequestHeaderAuthenticator returns an Authenticator that is configured from the
+// extension-apiserver-authentication config map in the kube-system namespace.
+//
+// The config map is read once; the hub agent must be restarted if the request header client CA
+// of the API server is rotated.
+func NewRequestHeaderAuthenticator(ctx context.Context, reader client.Reader) (Authenticator, error) {
+	cm := &corev1.ConfigMap{}
+	if err := reader.Get(ctx, client.ObjectKey{Namespace: authenticationConfigMapNamespace, Name: authenticationConfigMapName}, cm); err != nil {
+		return nil, fmt.Errorf("failed to get the authentication config map %s/%s: %w", authenticationConfigMapNamespace, authenticationConfigMapName, err)
+	}
+	return newRequestHeaderAuthenticator(cm.Data)
+}
+
+// newRequestHeaderAuthenticator returns a requestHeaderAuthenticator that is configured from the
+// data of the authentication config map.
+func newRequestHeaderAuthenticator(data map[string]string) (*requestHeaderAuthenticator, error) {
+	caPEM := data[requestHeaderClientCAKey]
+	if caPEM == "" {
+		return nil, fmt.Errorf("the authentication config map has no %s; the API aggregation layer is not configured on the API server", requestHeaderClientCAKey)
+	}
+	clientCAs := x509.NewCertPool()
+	if !clientCAs.AppendCertsFromPEM([]byte(caPEM)) {
+		return nil, fmt.Errorf("failed to parse the certificates in %s", requestHeaderClientCAKey)
+	}
+
+	a := &requestHeaderAuthenticator{clientCAs: clientCAs}
+	for key, target := range map[string]*[]string{
+		requestHeaderAllowedNamesKey:        &a.allowedNames,
+		requestHeaderUsernameHeadersKey:     &a.usernameHeaders,
+		requestHeaderGroupHeadersKey:        &a.groupHeaders,
+		requestHeaderExtraHeaderPrefixesKey: &a.extraHeaderPrefixes,
+	} {
+		// The values are published as JSON string arrays.
+		if raw := data[key]; raw != "" {
+			if err := json.Unmarshal([]byte(raw), target); err != nil {
+				return nil, fmt.Errorf("failed to parse %s in the authentication config map: %w", key, err)
+			}
+		}
+	}
+	if len(a.usernameHeaders) == 0 {
+		return nil, fmt.Errorf("the authentication config map has no %s", requestHeaderUsernameHeadersKey)
+	}
+	return a, nil
+}
+
+// Authenticate implements the Authenticator interface.
+func (a *requestHeaderAuthenticator) Authenticate(req *http.Request) (*authenticationv1.UserInfo, error) {
+	if req.TLS == nil || len(req.TLS.PeerCertificates) == 0 {
+		return nil, errors.New("no client certificate is presented; the API can only be accessed via the API aggregation layer")
+	}
+	clientCert := req.TLS.PeerCertificates[0]
+	intermediates := x509.NewCertPool()
+	for _, cert := range req.TLS.PeerCertificates[1:] {
+		intermediates.AddCert(cert)
+	}
+	if _, err := clientCert.Verify(x509.VerifyOptions{
+		Roots:         a.clientCAs,
+		Intermediates: intermediates,
+		KeyUsages:     []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}); err != nil {
+		return nil, fmt.Errorf("the client certificate is not signed by the request header client CA: %w", err)
+	}
+	if len(a.allowedNames) > 0 && !slices.Contains(a.allowedNames, clientCert.Subject.CommonName) {
+		return nil, fmt.Errorf("the common name %q of the client certificate is not allowed to send requests on behalf of users", clientCert.Subject.CommonName)
+	}
+
+	user := &authenticationv1.UserInfo{}
+	for _, header := range a.usernameHeaders {
+		if user.Username = req.Header.Get(header); user.Username != "" {
+			break
+		}
+	}
+	if user.Username == "" {
+		return nil, errors.New("no user is specified in the request headers")
+	}
+	for _, header := range a.groupHeaders {
+		user.Groups = append(user.Groups, req.Header.Values(header)...)
+	}
+	for header, values := range req.Header {
+		for _, prefix := range a.extraHeaderPrefixes {
+			suffix, found := strings.CutPrefix(strings.ToLower(header), strings.ToLower(prefix))
+			if !found || suffix == "" {
+				continue
+			}
+			// The keys of the extra user information are escaped when set in the headers.
+			key, err := url.PathUnescape(suffix)
+			if err != nil {
+				key = suffix
+			}
+			if user.Extra == nil {
+				user.Extra = make(map[string]authenticationv1.ExtraValue)
+			}
+			user.Extra[key] = append(user.Extra[key], values...)
+		}
+	}
+	return user, nil
+}
+
+// userContextKey is the context key of the authenticated user.
+type userContextKey struct{}
+
+// WithUser returns a copy of the context which carries the authenticated user.
+func WithUser(ctx context.Context, user *authenticationv1.UserInfo) context.Context {
+	return context.WithValue(ctx, userContextKey{}, user)
+}
+
+// UserFrom returns the authenticated user carried by the context, if any.
+func UserFrom(ctx context.Context) (*authenticationv1.UserInfo, bool) {
+	user, ok := ctx.Value(userContextKey{}).(*authenticationv1.UserInfo)
+	return user, ok && user != nil
+}
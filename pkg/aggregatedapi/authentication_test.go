@@ -0,0 +1,251 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregatedapi
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	authenticationv1 "k8s.io/api/authentication/v1"
+)
+
+const (
+	frontProxyClientName = "front-proxy-client"
+)
+
+// newCAForTest returns a self-signed CA certificate and its key.
+func newCAForTest(t *testing.T) (*x509.Certificate, *ecdsa.PrivateKey) {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() = %v, want no error", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber:          big.NewInt(1),
+		Subject:               pkix.Name{CommonName: "front-proxy-ca"},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageCertSign,
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, tmpl, &key.PublicKey, key)
+	if err != nil {
+		t.Fatalf("CreateCertificate() = %v, want no error", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate() = %v, want no error", err)
+	}
+	return cert, key
+}
+
+// newClientCertForTest returns a client certificate with the given common name, signed by the given CA.
+func newClientCertForTest(t *testing.T, commonName string, ca *x509.Certificate, caKey *ecdsa.PrivateKey) *x509.Certificate {
+	t.Helper()
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatalf("GenerateKey() = %v, want no error", err)
+	}
+	tmpl := &x509.Certificate{
+		SerialNumber: big.NewInt(2),
+		Subject:      pkix.Name{CommonName: commonName},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		KeyUsage:     x509.KeyUsageDigitalSignature,
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageClientAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, tmpl, ca, &key.PublicKey, caKey)
+	if err != nil {
+		t.Fatalf("CreateCertificate() = %v, want no error", err)
+	}
+	cert, err := x509.ParseCertificate(der)
+	if err != nil {
+		t.Fatalf("ParseCertificate() = %v, want no error", err)
+	}
+	return cert
+}
+
+func TestNewRequestHeaderAuthenticator(t *testing.T) {
+	ca, _ := newCAForTest(t)
+	caPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: ca.Raw}))
+
+	testCases := []struct {
+		name             string
+		data             map[string]string
+		wantErr          bool
+		wantAllowedNames []string
+		wantUserHeaders  []string
+	}{
+		{
+			name: "valid",
+			data: map[string]string{
+				requestHeaderClientCAKey:        caPEM,
+				requestHeaderAllowedNamesKey:    `["front-proxy-client"]`,
+				requestHeaderUsernameHeadersKey: `["X-Remote-User"]`,
+			},
+			wantAllowedNames: []string{frontProxyClientName},
+			wantUserHeaders:  []string{"X-Remote-User"},
+		},
+		{
+			name: "no client CA",
+			data: map[string]string{
+				requestHeaderUsernameHeadersKey: `["X-Remote-User"]`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid client CA",
+			data: map[string]string{
+				requestHeaderClientCAKey:        "invalid",
+				requestHeaderUsernameHeadersKey: `["X-Remote-User"]`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid allowed names",
+			data: map[string]string{
+				requestHeaderClientCAKey:        caPEM,
+				requestHeaderAllowedNamesKey:    "front-proxy-client",
+				requestHeaderUsernameHeadersKey: `["X-Remote-User"]`,
+			},
+			wantErr: true,
+		},
+		{
+			name: "no username headers",
+			data: map[string]string{
+				requestHeaderClientCAKey: caPEM,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			a, err := newRequestHeaderAuthenticator(tc.data)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("newRequestHeaderAuthenticator() error = %v, wantErr %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(a.allowedNames, tc.wantAllowedNames); diff != "" {
+				t.Errorf("newRequestHeaderAuthenticator() allowed names mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(a.usernameHeaders, tc.wantUserHeaders); diff != "" {
+				t.Errorf("newRequestHeaderAuthenticator() username headers mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRequestHeaderAuthenticatorAuthenticate(t *testing.T) {
+	ca, caKey := newCAForTest(t)
+	otherCA, otherCAKey := newCAForTest(t)
+	clientCAs := x509.NewCertPool()
+	clientCAs.AddCert(ca)
+	a := &requestHeaderAuthenticator{
+		clientCAs:           clientCAs,
+		allowedNames:        []string{frontProxyClientName},
+		usernameHeaders:     []string{"X-Remote-User"},
+		groupHeaders:        []string{"X-Remote-Group"},
+		extraHeaderPrefixes: []string{"X-Remote-Extra-"},
+	}
+
+	testCases := []struct {
+		name       string
+		clientCert *x509.Certificate
+		headers    map[string][]string
+		wantUser   *authenticationv1.UserInfo
+		wantErr    bool
+	}{
+		{
+			name:       "authenticated",
+			clientCert: newClientCertForTest(t, frontProxyClientName, ca, caKey),
+			headers: map[string][]string{
+				"X-Remote-User":                   {"alice"},
+				"X-Remote-Group":                  {"system:authenticated", "dev"},
+				"X-Remote-Extra-Scopes":           {"view"},
+				"X-Remote-Extra-Example.com%2fid": {"1"},
+			},
+			wantUser: &authenticationv1.UserInfo{
+				Username: "alice",
+				Groups:   []string{"system:authenticated", "dev"},
+				Extra: map[string]authenticationv1.ExtraValue{
+					"scopes":         {"view"},
+					"example.com/id": {"1"},
+				},
+			},
+		},
+		{
+			name:    "no client certificate",
+			headers: map[string][]string{"X-Remote-User": {"alice"}},
+			wantErr: true,
+		},
+		{
+			name:       "client certificate signed by another CA",
+			clientCert: newClientCertForTest(t, frontProxyClientName, otherCA, otherCAKey),
+			headers:    map[string][]string{"X-Remote-User": {"alice"}},
+			wantErr:    true,
+		},
+		{
+			name:       "common name not allowed",
+			clientCert: newClientCertForTest(t, "someone", ca, caKey),
+			headers:    map[string][]string{"X-Remote-User": {"alice"}},
+			wantErr:    true,
+		},
+		{
+			name:       "no user",
+			clientCert: newClientCertForTest(t, frontProxyClientName, ca, caKey),
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "/apis", nil)
+			for header, values := range tc.headers {
+				for _, v := range values {
+					req.Header.Add(header, v)
+				}
+			}
+			req.TLS = &tls.ConnectionState{}
+			if tc.clientCert != nil {
+				req.TLS.PeerCertificates = []*x509.Certificate{tc.clientCert}
+			}
+
+			gotUser, err := a.Authenticate(req)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Authenticate() error = %v, wantErr %t", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(gotUser, tc.wantUser); diff != "" {
+				t.Errorf("Authenticate() user mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregatedapi
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// Authorizer decides if a user may access a resource.
+type Authorizer interface {
+	// Authorize returns nil if the user may access the resource; otherwise it returns a Forbidden API
+	// error, or an InternalError API error if the decision cannot be made.
+	Authorize(ctx context.Context, user *authenticationv1.UserInfo, attrs *authorizationv1.ResourceAttributes) error
+}
+
+// subjectAccessReviewAuthorizer delegates authorization decisions to the API server via the
+// SubjectAccessReview API.
+type subjectAccessReviewAuthorizer struct {
+	client client.Client
+}
+
+// NewSubjectAccessReviewAuthorizer returns an Authorizer that delegates authorization decisions to the
+// API server, so that access to the aggregated APIs is granted with RBAC as to any other resource.
+func NewSubjectAccessReviewAuthorizer(client client.Client) Authorizer {
+	return &subjectAccessReviewAuthorizer{client: client}
+}
+
+// Authorize implements the Authorizer interface.
+func (a *subjectAccessReviewAuthorizer) Authorize(ctx context.Context, user *authenticationv1.UserInfo, attrs *authorizationv1.ResourceAttributes) error {
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			ResourceAttributes: attrs,
+			User:               user.Username,
+			Groups:             user.Groups,
+			UID:                user.UID,
+		},
+	}
+	if len(user.Extra) > 0 {
+		review.Spec.Extra = make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+		for key, values := range user.Extra {
+			review.Spec.Extra[key] = authorizationv1.ExtraValue(values)
+		}
+	}
+	if err := a.client.Create(ctx, review); err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("failed to review the access of user %s: %w", user.Username, err))
+	}
+	if !review.Status.Allowed || review.Status.Denied {
+		return forbiddenError(user, attrs, review.Status.Reason)
+	}
+	return nil
+}
+
+// forbiddenError returns a Forbidden API error for a user who may not access a resource.
+func forbiddenError(user *authenticationv1.UserInfo, attrs *authorizationv1.ResourceAttributes, reason string) error {
+	scope := "at the cluster scope"
+	if attrs.Namespace != "" {
+		scope = fmt.Sprintf("in the namespace %q", attrs.Namespace)
+	}
+	msg := fmt.Sprintf("user %q cannot %s resource %q in API group %q %s", user.Username, attrs.Verb, attrs.Resource, attrs.Group, scope)
+	if reason != "" {
+		msg = fmt.Sprintf("%s: %s", msg, reason)
+	}
+	return apierrors.NewForbidden(schema.GroupResource{Group: attrs.Group, Resource: attrs.Resource}, attrs.Name, errors.New(msg))
+}
@@ -0,0 +1,115 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package aggregatedapi
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func TestSubjectAccessReviewAuthorizerAuthorize(t *testing.T) {
+	user := &authenticationv1.UserInfo{
+		Username: "alice",
+		Groups:   []string{"dev"},
+		Extra:    map[string]authenticationv1.ExtraValue{"scopes": {"view"}},
+	}
+	attrs := &authorizationv1.ResourceAttributes{
+		Namespace: "work",
+		Verb:      "list",
+		Group:     "external.metrics.k8s.io",
+		Resource:  "kubefleet-placement-selected-clusters",
+	}
+
+	testCases := []struct {
+		name          string
+		reviewStatus  authorizationv1.SubjectAccessReviewStatus
+		createErr     error
+		wantForbidden bool
+		wantInternal  bool
+	}{
+		{
+			name:         "allowed",
+			reviewStatus: authorizationv1.SubjectAccessReviewStatus{Allowed: true},
+		},
+		{
+			name:          "not allowed",
+			reviewStatus:  authorizationv1.SubjectAccessReviewStatus{Reason: "no RBAC policy matched"},
+			wantForbidden: true,
+		},
+		{
+			name:          "denied",
+			reviewStatus:  authorizationv1.SubjectAccessReviewStatus{Allowed: true, Denied: true},
+			wantForbidden: true,
+		},
+		{
+			name:         "review failed",
+			createErr:    errors.New("connection refused"),
+			wantInternal: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotSpec authorizationv1.SubjectAccessReviewSpec
+			scheme := runtime.NewScheme()
+			if err := authorizationv1.AddToScheme(scheme); err != nil {
+				t.Fatalf("AddToScheme() = %v, want no error", err)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+				Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+					if tc.createErr != nil {
+						return tc.createErr
+					}
+					review := obj.(*authorizationv1.SubjectAccessReview)
+					gotSpec = review.Spec
+					review.Status = tc.reviewStatus
+					return nil
+				},
+			}).Build()
+
+			err := NewSubjectAccessReviewAuthorizer(fakeClient).Authorize(context.Background(), user, attrs)
+			if got := apierrors.IsForbidden(err); got != tc.wantForbidden {
+				t.Errorf("Authorize() = %v, want forbidden %t", err, tc.wantForbidden)
+			}
+			if got := apierrors.IsInternalError(err); got != tc.wantInternal {
+				t.Errorf("Authorize() = %v, want internal error %t", err, tc.wantInternal)
+			}
+			if tc.createErr != nil {
+				return
+			}
+			wantSpec := authorizationv1.SubjectAccessReviewSpec{
+				ResourceAttributes: attrs,
+				User:               user.Username,
+				Groups:             user.Groups,
+				Extra:              map[string]authorizationv1.ExtraValue{"scopes": {"view"}},
+			}
+			if diff := cmp.Diff(gotSpec, wantSpec); diff != "" {
+				t.Errorf("Authorize() review spec mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package aggregatedapi features the server of the APIs that the hub agent serves via the Kubernetes API
+// aggregation layer, e.g., the external metrics API and the placement view API. The server runs on its
+// own port, accepts only the requests proxied by the aggregation layer, and leaves it to the handlers of
+// each API to authorize the users on whose behalf the requests are sent.
+package aggregatedapi
+
+import (
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// The names of the serving certificate and key files in the certificate directory, which are the
+	// same as the ones of the webhook server.
+	certFileName = "tls.crt"
+	keyFileName  = "tls.key"
+)
+
+// Server serves the aggregated APIs over TLS.
+type Server struct {
+	bindAddress string
+	certDir     string
+	// reader reads the authentication config map of the API aggregation layer; it is expected to
+	// read from the API server directly.
+	reader        client.Reader
+	authenticator Authenticator
+	mux           *http.ServeMux
+}
+
+// NewServer returns a server that listens on the given address, and serves TLS with the certificate
+// and key files in the given directory; the files are reloaded when they change.
+func NewServer(bindAddress, certDir string, reader client.Reader) *Server {
+	return &Server{
+		bindAddress: bindAddress,
+		certDir:     certDir,
+		reader:      reader,
+		mux:         http.NewServeMux(),
+	}
+}
+
+// Register registers the handler for the given path. The handler is only invoked for authenticated
+// requests, and can read the authenticated user from the request context with UserFrom.
+func (s *Server) Register(path string, handler http.Handler) {
+	s.mux.Handle(path, handler)
+}
+
+// ServeHTTP authenticates a request, and passes it to the handler registered for its path.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	user, err := s.authenticator.Authenticate(r)
+	if err != nil {
+		klog.V(2).InfoS("Rejected an unauthenticated request to the aggregated APIs", "path", r.URL.Path, "remoteAddr", r.RemoteAddr, "err", err)
+		WriteStatus(w, apierrors.NewUnauthorized(err.Error()))
+		return
+	}
+	s.mux.ServeHTTP(w, r.WithContext(WithUser(r.Context(), user)))
+}
+
+// Start runs the server until the context is cancelled; it implements the controller-runtime
+// Runnable interface.
+func (s *Server) Start(ctx context.Context) error {
+	authenticator, err := NewRequestHeaderAuthenticator(ctx, s.reader)
+	if err != nil {
+		return fmt.Errorf("failed to set up the authentication of the aggregated API server: %w", err)
+	}
+	s.authenticator = authenticator
+
+	watcher, err := certwatcher.New(filepath.Join(s.certDir, certFileName), filepath.Join(s.certDir, keyFileName))
+	if err != nil {
+		return fmt.Errorf("failed to load the serving certificate of the aggregated API server: %w", err)
+	}
+	go func() {
+		if err := watcher.Start(ctx); err != nil {
+			klog.ErrorS(err, "Failed to watch the serving certificate of the aggregated API server")
+		}
+	}()
+
+	listener, err := tls.Listen("tcp", s.bindAddress, &tls.Config{
+		GetCertificate: watcher.GetCertificate,
+		// The client certificate is verified against the request header client CA by the authenticator,
+		// so that a request without one still receives an API error instead of a TLS handshake failure.
+		ClientAuth: tls.RequestClientCert,
+		MinVersion: tls.VersionTLS12,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.bindAddress, err)
+	}
+	srv := &http.Server{
+		Handler:           s,
+		ReadHeaderTimeout: 10 * time.Second,
+		BaseContext:       func(net.Listener) context.Context { return ctx },
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		klog.InfoS("Starting the aggregated API server", "address", s.bindAddress)
+		errCh <- srv.Serve(listener)
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("the aggregated API server has stopped: %w", err)
+	case <-ctx.Done():
+	}
+
+	klog.InfoS("Shutting down the aggregated API server")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err := srv.Shutdown(shutdownCtx); err != nil && !errors.Is(err, http.ErrServerClosed) {
+		return err
+	}
+	return nil
+}
+
+// NeedLeaderElection implements the controller-runtime LeaderElectionRunnable interface; every hub
+// agent instance serves the aggregated APIs from its own caches.
+func (s *Server) NeedLeaderElection() bool {
+	return false
+}
+
+// WriteStatus writes an API error as a Status object; errors that are not API errors are reported
+// as internal errors. It is shared by the handlers of the aggregated APIs.
+func WriteStatus(w http.ResponseWriter, err error) {
+	status := apierrors.NewInternalError(err).ErrStatus
+	if apiStatus, ok := err.(apierrors.APIStatus); ok {
+		status = apiStatus.Status()
+	} else {
+		klog.ErrorS(err, "Failed to serve the aggregated API request")
+	}
+	status.TypeMeta = metav1.TypeMeta{Kind: "Status", APIVersion: "v1"}
+	WriteJSON(w, int(status.Code), &status)
+}
+
+// WriteJSON writes an object as the JSON response body. It is shared by the handlers of the
+// aggregated APIs.
+func WriteJSON(w http.ResponseWriter, code int, obj interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(obj); err != nil {
+		klog.ErrorS(err, "Failed to write the aggregated API response")
+	}
+}
@@ -37,7 +37,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
-	"github.com/kubefleet-dev/kubefleet/test/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/testutils"
 )
 
 var (
@@ -47,7 +47,7 @@ var (
 	testEnv                 *envtest.Environment
 	ctx                     context.Context
 	cancel                  context.CancelFunc
-	fakePlacementController *controller.FakeController
+	fakePlacementController *testutils.FakePlacementController
 )
 
 func TestAPIs(t *testing.T) {
@@ -104,7 +104,7 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).Should(Succeed())
 
-	fakePlacementController = &controller.FakeController{}
+	fakePlacementController = testutils.NewFakePlacementController()
 
 	err = (&Reconciler{
 		Client:              mgr.GetClient(),
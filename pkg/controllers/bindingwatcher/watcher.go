@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"time"
 
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -149,6 +150,8 @@ func buildCustomPredicate(isClusterScoped bool) predicate.Predicate {
 				klog.ErrorS(err, "Failed to process update event", "isClusterScoped", isClusterScoped)
 				return false
 			}
+			// Spec changes are ignored on purpose: the work generator watches the binding generation
+			// and picks them up directly.
 			return isBindingStatusUpdated(oldBinding, newBinding)
 		},
 	}
@@ -171,6 +174,10 @@ func isBindingStatusUpdated(oldBinding, newBinding fleetv1beta1.BindingObj) bool
 		klog.V(2).InfoS("Failed placements reported on the binding status has changed, need to refresh the placement status", "binding", klog.KObj(oldBinding))
 		return true
 	}
+	if oldStatus.FailedPlacementOverflowCount != newStatus.FailedPlacementOverflowCount {
+		klog.V(2).InfoS("Failed placement overflow count reported on the binding status has changed, need to refresh the placement status", "binding", klog.KObj(oldBinding))
+		return true
+	}
 	if !utils.IsDriftedResourcePlacementsEqual(oldStatus.DriftedPlacements, newStatus.DriftedPlacements) {
 		klog.V(2).InfoS("Drifted placements reported on the binding status has changed, need to refresh the placement status", "binding", klog.KObj(oldBinding))
 		return true
@@ -179,6 +186,10 @@ func isBindingStatusUpdated(oldBinding, newBinding fleetv1beta1.BindingObj) bool
 		klog.V(2).InfoS("Diffed placements reported on the binding status has changed, need to refresh the placement status", "binding", klog.KObj(oldBinding))
 		return true
 	}
+	if !equality.Semantic.DeepEqual(oldStatus.DiffedPlacementSummary, newStatus.DiffedPlacementSummary) {
+		klog.V(2).InfoS("Diffed placement summary reported on the binding status has changed, need to refresh the placement status", "binding", klog.KObj(oldBinding))
+		return true
+	}
 
 	klog.V(5).InfoS("The binding status has not changed, no need to refresh the placement status", "binding", klog.KObj(oldBinding))
 	return false
@@ -26,6 +26,7 @@ import (
 	"k8s.io/utils/ptr"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 )
 
@@ -256,7 +257,7 @@ var _ = Describe("Test ClusterResourceBinding Watcher - update status", Serial,
 					Condition: metav1.Condition{
 						Type:               fleetv1beta1.WorkConditionTypeAvailable,
 						Status:             metav1.ConditionFalse,
-						Reason:             "fakeFailedAvailableReason",
+						Reason:             condition.WorkNotAvailableYetReason,
 						Message:            "fakeFailedAvailableMessage",
 						LastTransitionTime: metav1.Now(),
 					},
@@ -272,7 +273,7 @@ var _ = Describe("Test ClusterResourceBinding Watcher - update status", Serial,
 					Condition: metav1.Condition{
 						Type:               fleetv1beta1.WorkConditionTypeAvailable,
 						Status:             metav1.ConditionFalse,
-						Reason:             "fakeFailedAvailableReason",
+						Reason:             condition.WorkNotAvailableYetReason,
 						Message:            "fakeFailedAvailableMessage",
 						LastTransitionTime: metav1.Now(),
 					},
@@ -300,7 +301,7 @@ var _ = Describe("Test ClusterResourceBinding Watcher - update status", Serial,
 					Condition: metav1.Condition{
 						Type:               fleetv1beta1.WorkConditionTypeAvailable,
 						Status:             metav1.ConditionFalse,
-						Reason:             "fakeFailedAvailableReason",
+						Reason:             condition.WorkNotAvailableYetReason,
 						Message:            "fakeFailedAvailableMessage",
 						LastTransitionTime: metav1.Now(),
 					},
@@ -0,0 +1,385 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package bulkplacementoperation features a controller that runs bulk operations (pause, resume, rollback
+// and retarget) on the ClusterResourcePlacements and ResourcePlacements that match a label selector.
+package bulkplacementoperation
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+	runtime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
+)
+
+const (
+	// defaultMaxConcurrency is the maximum number of placements operated on at the same time if the
+	// operation does not specify one.
+	defaultMaxConcurrency = 10
+)
+
+// Reconciler reconciles a BulkPlacementOperation object.
+type Reconciler struct {
+	client.Client
+
+	// EnableResourcePlacement, if set, makes the operations also run on the ResourcePlacements that
+	// match their selectors.
+	EnableResourcePlacement bool
+}
+
+// Reconcile runs a bulk placement operation on all the selected placements once.
+func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtime.Result, error) {
+	startTime := time.Now()
+	operationName := req.NamespacedName.Name
+	klog.V(2).InfoS("BulkPlacementOperation reconciliation starts", "bulkPlacementOperation", operationName)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("BulkPlacementOperation reconciliation ends", "bulkPlacementOperation", operationName, "latency", latency)
+	}()
+
+	var operation placementv1beta1.BulkPlacementOperation
+	if err := r.Client.Get(ctx, req.NamespacedName, &operation); err != nil {
+		klog.ErrorS(err, "Failed to get bulk placement operation", "bulkPlacementOperation", operationName)
+		return runtime.Result{}, client.IgnoreNotFound(err)
+	}
+	if operation.DeletionTimestamp != nil {
+		klog.V(2).InfoS("Bulk placement operation is being deleted", "bulkPlacementOperation", operationName)
+		return runtime.Result{}, nil
+	}
+	// An operation is only run once.
+	if condition.IsConditionStatusTrue(operation.GetCondition(string(placementv1beta1.BulkPlacementOperationConditionTypeCompleted)), operation.Generation) {
+		klog.V(2).InfoS("Bulk placement operation has completed", "bulkPlacementOperation", operationName)
+		return runtime.Result{}, nil
+	}
+
+	selector, err := metav1.LabelSelectorAsSelector(&operation.Spec.PlacementSelector)
+	if err != nil {
+		klog.ErrorS(err, "Bulk placement operation has an invalid placement selector", "bulkPlacementOperation", operationName)
+		markOperationCompleted(&operation, condition.BulkPlacementOperationFailedReason, fmt.Sprintf("The placement selector is invalid: %v", err))
+		return runtime.Result{}, r.updateOperationStatus(ctx, &operation)
+	}
+	var crpList placementv1beta1.ClusterResourcePlacementList
+	if err := r.Client.List(ctx, &crpList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+		klog.ErrorS(err, "Failed to list the cluster resource placements selected by the bulk placement operation", "bulkPlacementOperation", operationName)
+		return runtime.Result{}, controller.NewAPIServerError(true, err)
+	}
+	var rpList placementv1beta1.ResourcePlacementList
+	if r.EnableResourcePlacement {
+		if err := r.Client.List(ctx, &rpList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			klog.ErrorS(err, "Failed to list the resource placements selected by the bulk placement operation", "bulkPlacementOperation", operationName)
+			return runtime.Result{}, controller.NewAPIServerError(true, err)
+		}
+	}
+	placementKeys := make([]types.NamespacedName, 0, len(crpList.Items)+len(rpList.Items))
+	for i := range crpList.Items {
+		placementKeys = append(placementKeys, types.NamespacedName{Name: crpList.Items[i].Name})
+	}
+	for i := range rpList.Items {
+		placementKeys = append(placementKeys, types.NamespacedName{Namespace: rpList.Items[i].Namespace, Name: rpList.Items[i].Name})
+	}
+
+	maxConcurrency := defaultMaxConcurrency
+	if operation.Spec.MaxConcurrency != nil {
+		maxConcurrency = int(*operation.Spec.MaxConcurrency)
+	}
+	results := make([]placementv1beta1.PlacementOperationResult, len(placementKeys))
+	doWork := func(piece int) {
+		results[piece] = r.operateOnPlacement(ctx, &operation, placementKeys[piece])
+	}
+	parallelizer.NewParallelizer(maxConcurrency).ParallelizeUntil(ctx, len(placementKeys), doWork, "operateOnPlacements")
+	if err := ctx.Err(); err != nil {
+		klog.ErrorS(err, "Bulk placement operation is interrupted", "bulkPlacementOperation", operationName)
+		return runtime.Result{}, err
+	}
+
+	sort.Slice(results, func(i, j int) bool {
+		if results[i].PlacementNamespace != results[j].PlacementNamespace {
+			return results[i].PlacementNamespace < results[j].PlacementNamespace
+		}
+		return results[i].PlacementName < results[j].PlacementName
+	})
+	operation.Status.PlacementResults = results
+	reason, message := summarizeResults(results)
+	markOperationCompleted(&operation, reason, message)
+	klog.V(2).InfoS("Bulk placement operation has been run on all the selected placements", "bulkPlacementOperation", operationName, "action", operation.Spec.Action, "result", message)
+	return runtime.Result{}, r.updateOperationStatus(ctx, &operation)
+}
+
+// operateOnPlacement runs the operation on a placement and reports the outcome.
+func (r *Reconciler) operateOnPlacement(ctx context.Context, operation *placementv1beta1.BulkPlacementOperation, placementKey types.NamespacedName) placementv1beta1.PlacementOperationResult {
+	spec := &operation.Spec
+	var result placementv1beta1.PlacementOperationResult
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		placement, err := controller.FetchPlacementFromNamespacedName(ctx, r.Client, placementKey)
+		if err != nil {
+			return err
+		}
+		if result, err = r.prepareOperation(ctx, operation, placement); err != nil {
+			return err
+		}
+		if result.Result != placementv1beta1.PlacementOperationResultSucceeded {
+			return nil
+		}
+		return r.Client.Update(ctx, placement)
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to run the bulk operation on the placement", "placement", placementKey, "action", spec.Action)
+		return placementv1beta1.PlacementOperationResult{
+			PlacementName:      placementKey.Name,
+			PlacementNamespace: placementKey.Namespace,
+			Result:             placementv1beta1.PlacementOperationResultFailed,
+			Message:            fmt.Sprintf("Failed to run the %s operation on the placement: %v", spec.Action, err),
+		}
+	}
+	klog.V(2).InfoS("Ran the bulk operation on the placement", "placement", placementKey, "action", spec.Action, "result", result.Result)
+	return result
+}
+
+// prepareOperation modifies the placement in place according to the operation; the placement only
+// needs to be updated if the returned result is Succeeded.
+func (r *Reconciler) prepareOperation(ctx context.Context, operation *placementv1beta1.BulkPlacementOperation, placement placementv1beta1.PlacementObj) (placementv1beta1.PlacementOperationResult, error) {
+	spec := &operation.Spec
+	result := func(resultType placementv1beta1.PlacementOperationResultType, format string, args ...interface{}) placementv1beta1.PlacementOperationResult {
+		return placementv1beta1.PlacementOperationResult{
+			PlacementName:      placement.GetName(),
+			PlacementNamespace: placement.GetNamespace(),
+			Result:             resultType,
+			Message:            fmt.Sprintf(format, args...),
+		}
+	}
+	if placement.GetDeletionTimestamp() != nil {
+		return result(placementv1beta1.PlacementOperationResultSkipped, "The placement is being deleted"), nil
+	}
+	placementSpec := placement.GetPlacementSpec()
+	if spec.Action != placementv1beta1.BulkPlacementOperationActionRetarget && placementSpec.Strategy.Type == placementv1beta1.ExternalRolloutStrategyType {
+		return result(placementv1beta1.PlacementOperationResultSkipped, "The rollout of the placement is managed by an external controller"), nil
+	}
+
+	annotations := placement.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	switch spec.Action {
+	case placementv1beta1.BulkPlacementOperationActionPause:
+		if annotations[placementv1beta1.RolloutPausedAnnotation] == "true" {
+			return result(placementv1beta1.PlacementOperationResultSkipped, "The rollout of the placement has already been paused"), nil
+		}
+		annotations[placementv1beta1.RolloutPausedAnnotation] = "true"
+		placement.SetAnnotations(annotations)
+		return result(placementv1beta1.PlacementOperationResultSucceeded, "The rollout of the placement has been paused"), nil
+
+	case placementv1beta1.BulkPlacementOperationActionResume:
+		_, paused := annotations[placementv1beta1.RolloutPausedAnnotation]
+		_, pinned := annotations[placementv1beta1.PinnedResourceSnapshotIndexAnnotation]
+		if !paused && !pinned {
+			return result(placementv1beta1.PlacementOperationResultSkipped, "The rollout of the placement is neither paused nor rolled back"), nil
+		}
+		delete(annotations, placementv1beta1.RolloutPausedAnnotation)
+		delete(annotations, placementv1beta1.PinnedResourceSnapshotIndexAnnotation)
+		delete(annotations, placementv1beta1.PinnedByOperationAnnotation)
+		placement.SetAnnotations(annotations)
+		return result(placementv1beta1.PlacementOperationResultSucceeded, "The rollout of the placement has been resumed"), nil
+
+	case placementv1beta1.BulkPlacementOperationActionRollback:
+		// The operation may be run again on the same placement, e.g., if it fails to record its completion;
+		// the placement must not be rolled back any further then.
+		if annotations[placementv1beta1.PinnedByOperationAnnotation] == string(operation.UID) {
+			return result(placementv1beta1.PlacementOperationResultSkipped, "The placement has already been rolled back to the resource snapshot of index %s by the operation",
+				annotations[placementv1beta1.PinnedResourceSnapshotIndexAnnotation]), nil
+		}
+		rollbackIndex, err := r.findRollbackResourceSnapshotIndex(ctx, placement)
+		if err != nil {
+			return placementv1beta1.PlacementOperationResult{}, err
+		}
+		if rollbackIndex < 0 {
+			return result(placementv1beta1.PlacementOperationResultFailed, "The placement does not have an earlier resource snapshot to roll back to"), nil
+		}
+		annotations[placementv1beta1.PinnedResourceSnapshotIndexAnnotation] = strconv.Itoa(rollbackIndex)
+		annotations[placementv1beta1.PinnedByOperationAnnotation] = string(operation.UID)
+		placement.SetAnnotations(annotations)
+		return result(placementv1beta1.PlacementOperationResultSucceeded, "The placement has been rolled back to the resource snapshot of index %d", rollbackIndex), nil
+
+	case placementv1beta1.BulkPlacementOperationActionRetarget:
+		return retargetPlacement(spec.Retarget, placement), nil
+
+	default:
+		return result(placementv1beta1.PlacementOperationResultFailed, "Unknown action %s", spec.Action), nil
+	}
+}
+
+// findRollbackResourceSnapshotIndex returns the index of the latest resource snapshot before the one that the
+// placement currently rolls out, or -1 if there is no such resource snapshot.
+func (r *Reconciler) findRollbackResourceSnapshotIndex(ctx context.Context, placement placementv1beta1.PlacementObj) (int, error) {
+	placementKey := types.NamespacedName{Namespace: placement.GetNamespace(), Name: placement.GetName()}
+	snapshotList, err := controller.ListAllResourceSnapshots(ctx, r.Client, placementKey)
+	if err != nil {
+		return -1, err
+	}
+
+	var indices []int
+	for _, snapshot := range snapshotList.GetResourceSnapshotObjs() {
+		// only master has this annotation.
+		if len(snapshot.GetAnnotations()[placementv1beta1.ResourceGroupHashAnnotation]) == 0 {
+			continue
+		}
+		index, err := strconv.Atoi(snapshot.GetLabels()[placementv1beta1.ResourceIndexLabel])
+		if err != nil {
+			klog.ErrorS(err, "Resource snapshot has an invalid index label", "resourceSnapshot", klog.KObj(snapshot))
+			return -1, controller.NewUnexpectedBehaviorError(err)
+		}
+		indices = append(indices, index)
+	}
+	if len(indices) == 0 {
+		return -1, nil
+	}
+	sort.Ints(indices)
+
+	// A placement that has been rolled back rolls out the pinned resource snapshot rather than the latest one.
+	currentIndex := indices[len(indices)-1]
+	if pinnedIndex, pinned := placement.GetAnnotations()[placementv1beta1.PinnedResourceSnapshotIndexAnnotation]; pinned {
+		if currentIndex, err = strconv.Atoi(pinnedIndex); err != nil {
+			return -1, fmt.Errorf("the placement is pinned to an invalid resource snapshot index %q: %w", pinnedIndex, err)
+		}
+	}
+	rollbackIndex := -1
+	for _, index := range indices {
+		if index < currentIndex {
+			rollbackIndex = index
+		}
+	}
+	return rollbackIndex, nil
+}
+
+// retargetPlacement replaces the clusters that the placement targets, depending on its placement type.
+func retargetPlacement(retarget *placementv1beta1.BulkPlacementRetargetConfig, placement placementv1beta1.PlacementObj) placementv1beta1.PlacementOperationResult {
+	result := placementv1beta1.PlacementOperationResult{
+		PlacementName:      placement.GetName(),
+		PlacementNamespace: placement.GetNamespace(),
+		Result:             placementv1beta1.PlacementOperationResultFailed,
+	}
+	if retarget == nil {
+		result.Message = "The retarget configuration is missing"
+		return result
+	}
+	placementSpec := placement.GetPlacementSpec()
+	if placementSpec.Policy == nil {
+		// A placement without a policy places resources to all the member clusters.
+		placementSpec.Policy = &placementv1beta1.PlacementPolicy{PlacementType: placementv1beta1.PickAllPlacementType}
+	}
+	policy := placementSpec.Policy
+
+	if policy.PlacementType == placementv1beta1.PickFixedPlacementType {
+		if len(retarget.ClusterNames) == 0 {
+			result.Message = "The cluster names must be specified to retarget placements of the PickFixed placement type"
+			return result
+		}
+		if equality.Semantic.DeepEqual(policy.ClusterNames, retarget.ClusterNames) {
+			result.Result = placementv1beta1.PlacementOperationResultSkipped
+			result.Message = "The placement already targets the clusters"
+			return result
+		}
+		policy.ClusterNames = append([]string{}, retarget.ClusterNames...)
+	} else {
+		if retarget.ClusterSelector == nil {
+			result.Message = fmt.Sprintf("The cluster selector must be specified to retarget placements of the %s placement type", policy.PlacementType)
+			return result
+		}
+		if policy.Affinity == nil {
+			policy.Affinity = &placementv1beta1.Affinity{}
+		}
+		if policy.Affinity.ClusterAffinity == nil {
+			policy.Affinity.ClusterAffinity = &placementv1beta1.ClusterAffinity{}
+		}
+		if equality.Semantic.DeepEqual(policy.Affinity.ClusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution, retarget.ClusterSelector) {
+			result.Result = placementv1beta1.PlacementOperationResultSkipped
+			result.Message = "The placement already targets the clusters"
+			return result
+		}
+		policy.Affinity.ClusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution = retarget.ClusterSelector.DeepCopy()
+	}
+	result.Result = placementv1beta1.PlacementOperationResultSucceeded
+	result.Message = "The placement has been retargeted"
+	return result
+}
+
+// summarizeResults returns the reason and message of the Completed condition based on the outcome of the
+// operation on each placement.
+func summarizeResults(results []placementv1beta1.PlacementOperationResult) (string, string) {
+	counts := make(map[placementv1beta1.PlacementOperationResultType]int)
+	for i := range results {
+		counts[results[i].Result]++
+	}
+	reason := condition.BulkPlacementOperationSucceededReason
+	if counts[placementv1beta1.PlacementOperationResultFailed] > 0 {
+		reason = condition.BulkPlacementOperationFailedReason
+	}
+	return reason, fmt.Sprintf("The operation has been run on %d placement(s): %d succeeded, %d skipped, %d failed",
+		len(results), counts[placementv1beta1.PlacementOperationResultSucceeded],
+		counts[placementv1beta1.PlacementOperationResultSkipped], counts[placementv1beta1.PlacementOperationResultFailed])
+}
+
+// markOperationCompleted sets the Completed condition of the operation.
+func markOperationCompleted(operation *placementv1beta1.BulkPlacementOperation, reason, message string) {
+	operation.SetConditions(metav1.Condition{
+		Type:               string(placementv1beta1.BulkPlacementOperationConditionTypeCompleted),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: operation.Generation,
+		Reason:             reason,
+		Message:            message,
+	})
+}
+
+// updateOperationStatus updates the status of the operation; the operation is not run again once its
+// completion is recorded, so conflicts are retried on the latest version of the operation.
+func (r *Reconciler) updateOperationStatus(ctx context.Context, operation *placementv1beta1.BulkPlacementOperation) error {
+	status := operation.Status.DeepCopy()
+	err := retry.RetryOnConflict(retry.DefaultBackoff, func() error {
+		var latest placementv1beta1.BulkPlacementOperation
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: operation.Name}, &latest); err != nil {
+			return err
+		}
+		status.DeepCopyInto(&latest.Status)
+		return r.Client.Status().Update(ctx, &latest)
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to update bulk placement operation status", "bulkPlacementOperation", klog.KObj(operation))
+		return controller.NewAPIServerError(false, err)
+	}
+	return nil
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr runtime.Manager) error {
+	return runtime.NewControllerManagedBy(mgr).Named("bulkplacementoperation-controller").
+		For(&placementv1beta1.BulkPlacementOperation{}).
+		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		Complete(r)
+}
@@ -0,0 +1,357 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package bulkplacementoperation
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	testOperationName = "test-operation"
+	testOperationUID  = "test-operation-uid"
+	teamLabel         = "team"
+)
+
+func serviceScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add placement v1beta1 scheme: %v", err)
+	}
+	return scheme
+}
+
+func placementWith(name string, annotations map[string]string, strategyType placementv1beta1.RolloutStrategyType, policy *placementv1beta1.PlacementPolicy) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:        name,
+			Labels:      map[string]string{teamLabel: "a"},
+			Annotations: annotations,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy:   policy,
+			Strategy: placementv1beta1.RolloutStrategy{Type: strategyType},
+		},
+	}
+}
+
+func masterResourceSnapshot(placementName string, index int) *placementv1beta1.ClusterResourceSnapshot {
+	return &placementv1beta1.ClusterResourceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf(placementv1beta1.ResourceSnapshotNameFmt, placementName, index),
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: placementName,
+				placementv1beta1.ResourceIndexLabel:     fmt.Sprint(index),
+			},
+			Annotations: map[string]string{placementv1beta1.ResourceGroupHashAnnotation: "hash"},
+		},
+	}
+}
+
+func resourcePlacementWith(namespace, name string, annotations map[string]string) *placementv1beta1.ResourcePlacement {
+	return &placementv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   namespace,
+			Name:        name,
+			Labels:      map[string]string{teamLabel: "a"},
+			Annotations: annotations,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Strategy: placementv1beta1.RolloutStrategy{Type: placementv1beta1.RollingUpdateRolloutStrategyType},
+		},
+	}
+}
+
+func namespacedMasterResourceSnapshot(namespace, placementName string, index int) *placementv1beta1.ResourceSnapshot {
+	return &placementv1beta1.ResourceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: namespace,
+			Name:      fmt.Sprintf(placementv1beta1.ResourceSnapshotNameFmt, placementName, index),
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: placementName,
+				placementv1beta1.ResourceIndexLabel:     fmt.Sprint(index),
+			},
+			Annotations: map[string]string{placementv1beta1.ResourceGroupHashAnnotation: "hash"},
+		},
+	}
+}
+
+func operationWith(action placementv1beta1.BulkPlacementOperationAction, retarget *placementv1beta1.BulkPlacementRetargetConfig) *placementv1beta1.BulkPlacementOperation {
+	return &placementv1beta1.BulkPlacementOperation{
+		ObjectMeta: metav1.ObjectMeta{Name: testOperationName, Generation: 1, UID: testOperationUID},
+		Spec: placementv1beta1.BulkPlacementOperationSpec{
+			PlacementSelector: metav1.LabelSelector{MatchLabels: map[string]string{teamLabel: "a"}},
+			Action:            action,
+			Retarget:          retarget,
+		},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	clusterSelector := &placementv1beta1.ClusterSelector{
+		ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+			{LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"region": "west"}}},
+		},
+	}
+	tests := map[string]struct {
+		objects         []client.Object
+		wantReason      string
+		wantResults     []placementv1beta1.PlacementOperationResult
+		wantAnnotations map[string]map[string]string
+		wantPolicies    map[string]*placementv1beta1.PlacementPolicy
+	}{
+		"pause placements": {
+			objects: []client.Object{
+				placementWith("crp-1", nil, placementv1beta1.RollingUpdateRolloutStrategyType, nil),
+				placementWith("crp-2", map[string]string{placementv1beta1.RolloutPausedAnnotation: "true"}, placementv1beta1.RollingUpdateRolloutStrategyType, nil),
+				placementWith("crp-3", nil, placementv1beta1.ExternalRolloutStrategyType, nil),
+				operationWith(placementv1beta1.BulkPlacementOperationActionPause, nil),
+			},
+			wantReason: condition.BulkPlacementOperationSucceededReason,
+			wantResults: []placementv1beta1.PlacementOperationResult{
+				{PlacementName: "crp-1", Result: placementv1beta1.PlacementOperationResultSucceeded},
+				{PlacementName: "crp-2", Result: placementv1beta1.PlacementOperationResultSkipped},
+				{PlacementName: "crp-3", Result: placementv1beta1.PlacementOperationResultSkipped},
+			},
+			wantAnnotations: map[string]map[string]string{
+				"crp-1": {placementv1beta1.RolloutPausedAnnotation: "true"},
+				"crp-2": {placementv1beta1.RolloutPausedAnnotation: "true"},
+				"crp-3": nil,
+			},
+		},
+		"resume placements": {
+			objects: []client.Object{
+				placementWith("crp-1", map[string]string{
+					placementv1beta1.RolloutPausedAnnotation:               "true",
+					placementv1beta1.PinnedResourceSnapshotIndexAnnotation: "0",
+					placementv1beta1.PinnedByOperationAnnotation:           "another-operation-uid",
+					"foo": "bar",
+				}, placementv1beta1.RollingUpdateRolloutStrategyType, nil),
+				placementWith("crp-2", nil, placementv1beta1.RollingUpdateRolloutStrategyType, nil),
+				operationWith(placementv1beta1.BulkPlacementOperationActionResume, nil),
+			},
+			wantReason: condition.BulkPlacementOperationSucceededReason,
+			wantResults: []placementv1beta1.PlacementOperationResult{
+				{PlacementName: "crp-1", Result: placementv1beta1.PlacementOperationResultSucceeded},
+				{PlacementName: "crp-2", Result: placementv1beta1.PlacementOperationResultSkipped},
+			},
+			wantAnnotations: map[string]map[string]string{
+				"crp-1": {"foo": "bar"},
+				"crp-2": nil,
+			},
+		},
+		"roll back placements": {
+			objects: []client.Object{
+				placementWith("crp-1", nil, placementv1beta1.RollingUpdateRolloutStrategyType, nil),
+				masterResourceSnapshot("crp-1", 0),
+				masterResourceSnapshot("crp-1", 1),
+				masterResourceSnapshot("crp-1", 2),
+				placementWith("crp-2", map[string]string{placementv1beta1.PinnedResourceSnapshotIndexAnnotation: "1"}, placementv1beta1.RollingUpdateRolloutStrategyType, nil),
+				masterResourceSnapshot("crp-2", 0),
+				masterResourceSnapshot("crp-2", 1),
+				masterResourceSnapshot("crp-2", 2),
+				placementWith("crp-3", nil, placementv1beta1.RollingUpdateRolloutStrategyType, nil),
+				masterResourceSnapshot("crp-3", 0),
+				// crp-4 has already been rolled back by the same operation.
+				placementWith("crp-4", map[string]string{
+					placementv1beta1.PinnedResourceSnapshotIndexAnnotation: "1",
+					placementv1beta1.PinnedByOperationAnnotation:           testOperationUID,
+				}, placementv1beta1.RollingUpdateRolloutStrategyType, nil),
+				masterResourceSnapshot("crp-4", 0),
+				masterResourceSnapshot("crp-4", 1),
+				masterResourceSnapshot("crp-4", 2),
+				resourcePlacementWith("app", "rp-1", nil),
+				namespacedMasterResourceSnapshot("app", "rp-1", 0),
+				namespacedMasterResourceSnapshot("app", "rp-1", 1),
+				operationWith(placementv1beta1.BulkPlacementOperationActionRollback, nil),
+			},
+			wantReason: condition.BulkPlacementOperationFailedReason,
+			wantResults: []placementv1beta1.PlacementOperationResult{
+				{PlacementName: "crp-1", Result: placementv1beta1.PlacementOperationResultSucceeded},
+				{PlacementName: "crp-2", Result: placementv1beta1.PlacementOperationResultSucceeded},
+				{PlacementName: "crp-3", Result: placementv1beta1.PlacementOperationResultFailed},
+				{PlacementName: "crp-4", Result: placementv1beta1.PlacementOperationResultSkipped},
+				{PlacementName: "rp-1", PlacementNamespace: "app", Result: placementv1beta1.PlacementOperationResultSucceeded},
+			},
+			wantAnnotations: map[string]map[string]string{
+				"crp-1": {
+					placementv1beta1.PinnedResourceSnapshotIndexAnnotation: "1",
+					placementv1beta1.PinnedByOperationAnnotation:           testOperationUID,
+				},
+				"crp-2": {
+					placementv1beta1.PinnedResourceSnapshotIndexAnnotation: "0",
+					placementv1beta1.PinnedByOperationAnnotation:           testOperationUID,
+				},
+				"crp-3": nil,
+				"crp-4": {
+					placementv1beta1.PinnedResourceSnapshotIndexAnnotation: "1",
+					placementv1beta1.PinnedByOperationAnnotation:           testOperationUID,
+				},
+				"app/rp-1": {
+					placementv1beta1.PinnedResourceSnapshotIndexAnnotation: "0",
+					placementv1beta1.PinnedByOperationAnnotation:           testOperationUID,
+				},
+			},
+		},
+		"retarget placements": {
+			objects: []client.Object{
+				placementWith("crp-1", nil, placementv1beta1.RollingUpdateRolloutStrategyType, &placementv1beta1.PlacementPolicy{
+					PlacementType: placementv1beta1.PickFixedPlacementType,
+					ClusterNames:  []string{"member-1"},
+				}),
+				placementWith("crp-2", nil, placementv1beta1.ExternalRolloutStrategyType, nil),
+				operationWith(placementv1beta1.BulkPlacementOperationActionRetarget, &placementv1beta1.BulkPlacementRetargetConfig{
+					ClusterNames:    []string{"member-2", "member-3"},
+					ClusterSelector: clusterSelector,
+				}),
+			},
+			wantReason: condition.BulkPlacementOperationSucceededReason,
+			wantResults: []placementv1beta1.PlacementOperationResult{
+				{PlacementName: "crp-1", Result: placementv1beta1.PlacementOperationResultSucceeded},
+				{PlacementName: "crp-2", Result: placementv1beta1.PlacementOperationResultSucceeded},
+			},
+			wantPolicies: map[string]*placementv1beta1.PlacementPolicy{
+				"crp-1": {
+					PlacementType: placementv1beta1.PickFixedPlacementType,
+					ClusterNames:  []string{"member-2", "member-3"},
+				},
+				"crp-2": {
+					PlacementType: placementv1beta1.PickAllPlacementType,
+					Affinity: &placementv1beta1.Affinity{
+						ClusterAffinity: &placementv1beta1.ClusterAffinity{
+							RequiredDuringSchedulingIgnoredDuringExecution: clusterSelector,
+						},
+					},
+				},
+			},
+		},
+		"retarget placements without a matching target": {
+			objects: []client.Object{
+				placementWith("crp-1", nil, placementv1beta1.RollingUpdateRolloutStrategyType, &placementv1beta1.PlacementPolicy{
+					PlacementType: placementv1beta1.PickFixedPlacementType,
+					ClusterNames:  []string{"member-1"},
+				}),
+				operationWith(placementv1beta1.BulkPlacementOperationActionRetarget, &placementv1beta1.BulkPlacementRetargetConfig{
+					ClusterSelector: clusterSelector,
+				}),
+			},
+			wantReason: condition.BulkPlacementOperationFailedReason,
+			wantResults: []placementv1beta1.PlacementOperationResult{
+				{PlacementName: "crp-1", Result: placementv1beta1.PlacementOperationResultFailed},
+			},
+			wantPolicies: map[string]*placementv1beta1.PlacementPolicy{
+				"crp-1": {
+					PlacementType: placementv1beta1.PickFixedPlacementType,
+					ClusterNames:  []string{"member-1"},
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(tc.objects...).
+				WithStatusSubresource(&placementv1beta1.BulkPlacementOperation{}).
+				Build()
+			r := Reconciler{Client: fakeClient, EnableResourcePlacement: true}
+			ctx := context.Background()
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: testOperationName}}); err != nil {
+				t.Fatalf("Reconcile() = %v, want nil", err)
+			}
+
+			var gotOperation placementv1beta1.BulkPlacementOperation
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: testOperationName}, &gotOperation); err != nil {
+				t.Fatalf("Get() operation = %v, want nil", err)
+			}
+			wantCond := &metav1.Condition{
+				Type:               string(placementv1beta1.BulkPlacementOperationConditionTypeCompleted),
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 1,
+				Reason:             tc.wantReason,
+			}
+			gotCond := gotOperation.GetCondition(string(placementv1beta1.BulkPlacementOperationConditionTypeCompleted))
+			if diff := cmp.Diff(wantCond, gotCond, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "Message")); diff != "" {
+				t.Errorf("Completed condition mismatch (-want, +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantResults, gotOperation.Status.PlacementResults, cmpopts.IgnoreFields(placementv1beta1.PlacementOperationResult{}, "Message")); diff != "" {
+				t.Errorf("placement results mismatch (-want, +got):\n%s", diff)
+			}
+
+			for placementName, wantAnnotations := range tc.wantAnnotations {
+				placementKey := types.NamespacedName{Name: placementName}
+				if namespace, name, found := strings.Cut(placementName, "/"); found {
+					placementKey = types.NamespacedName{Namespace: namespace, Name: name}
+				}
+				gotPlacement, err := controller.FetchPlacementFromNamespacedName(ctx, fakeClient, placementKey)
+				if err != nil {
+					t.Fatalf("Get() placement = %v, want nil", err)
+				}
+				if diff := cmp.Diff(wantAnnotations, gotPlacement.GetAnnotations(), cmpopts.EquateEmpty()); diff != "" {
+					t.Errorf("placement %s annotations mismatch (-want, +got):\n%s", placementName, diff)
+				}
+			}
+			for placementName, wantPolicy := range tc.wantPolicies {
+				var gotPlacement placementv1beta1.ClusterResourcePlacement
+				if err := fakeClient.Get(ctx, types.NamespacedName{Name: placementName}, &gotPlacement); err != nil {
+					t.Fatalf("Get() placement = %v, want nil", err)
+				}
+				if diff := cmp.Diff(wantPolicy, gotPlacement.Spec.Policy); diff != "" {
+					t.Errorf("placement %s policy mismatch (-want, +got):\n%s", placementName, diff)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcile_CompletedOperation(t *testing.T) {
+	operation := operationWith(placementv1beta1.BulkPlacementOperationActionPause, nil)
+	markOperationCompleted(operation, condition.BulkPlacementOperationSucceededReason, "")
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(serviceScheme(t)).
+		WithObjects(operation, placementWith("crp-1", nil, placementv1beta1.RollingUpdateRolloutStrategyType, nil)).
+		WithStatusSubresource(&placementv1beta1.BulkPlacementOperation{}).
+		Build()
+	r := Reconciler{Client: fakeClient, EnableResourcePlacement: true}
+	ctx := context.Background()
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: testOperationName}}); err != nil {
+		t.Fatalf("Reconcile() = %v, want nil", err)
+	}
+
+	var gotPlacement placementv1beta1.ClusterResourcePlacement
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: "crp-1"}, &gotPlacement); err != nil {
+		t.Fatalf("Get() placement = %v, want nil", err)
+	}
+	if len(gotPlacement.Annotations) != 0 {
+		t.Errorf("placement annotations = %v, want none as the operation has completed", gotPlacement.Annotations)
+	}
+}
@@ -22,10 +22,12 @@ import (
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	runtime "sigs.k8s.io/controller-runtime"
@@ -41,6 +43,25 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
 	evictionutils "github.com/kubefleet-dev/kubefleet/pkg/utils/eviction"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/priority"
+)
+
+const (
+	// lowerPriorityEvictionRequeueDelay is the delay before an eviction, which waits for the pending evictions
+	// of lower-priority placements targeting the same cluster, is reconciled again.
+	lowerPriorityEvictionRequeueDelay = 5 * time.Second
+)
+
+// The results of completed evictions, as reported in the eviction metrics.
+const (
+	evictionResultGranted = "granted"
+	evictionResultDenied  = "denied"
+)
+
+// The reasons of the events emitted on the placement targeted by an eviction.
+const (
+	evictionGrantedEventReason = "EvictionGranted"
+	evictionDeniedEventReason  = "EvictionDenied"
 )
 
 // Reconciler reconciles a ClusterResourcePlacementEviction object.
@@ -48,6 +69,8 @@ type Reconciler struct {
 	client.Client
 	// UncachedReader is only used to read disruption budget objects directly from the API server to ensure we can enforce the disruption budget for eviction.
 	UncachedReader client.Reader
+	// Recorder is used to emit events on the placements targeted by evictions.
+	Recorder record.EventRecorder
 }
 
 // Reconcile triggers a single eviction reconcile round.
@@ -86,11 +109,24 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 			return runtime.Result{}, err
 		}
 		emitEvictionCompleteMetric(&eviction)
+		r.recordEvictionOutcome(&eviction, validationResult.crp, validationResult.outcomeReason)
 		return runtime.Result{}, nil
 	}
 
 	markEvictionValid(&eviction)
 
+	// The resources of lower-priority placements are evicted from the target cluster first.
+	hasPending, err := r.hasPendingLowerPriorityEvictions(ctx, &eviction, validationResult.crp)
+	if err != nil {
+		internalError = true
+		return runtime.Result{}, err
+	}
+	if hasPending {
+		klog.V(2).InfoS("Defer the eviction as there are pending evictions of lower-priority placements targeting the same cluster",
+			"clusterResourcePlacementEviction", evictionName, "targetCluster", eviction.Spec.ClusterName)
+		return runtime.Result{RequeueAfter: lowerPriorityEvictionRequeueDelay}, nil
+	}
+
 	if err = r.executeEviction(ctx, validationResult, &eviction); err != nil {
 		internalError = true
 		return runtime.Result{}, err
@@ -101,6 +137,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 		return runtime.Result{}, err
 	}
 	emitEvictionCompleteMetric(&eviction)
+	r.recordEvictionOutcome(&eviction, validationResult.crp, validationResult.outcomeReason)
 	return runtime.Result{}, nil
 }
 
@@ -111,6 +148,7 @@ func (r *Reconciler) validateEviction(ctx context.Context, eviction *placementv1
 	if err := r.Client.Get(ctx, types.NamespacedName{Name: eviction.Spec.PlacementName}, &crp); err != nil {
 		if k8serrors.IsNotFound(err) {
 			klog.V(2).InfoS(condition.EvictionInvalidMissingCRPMessage, "clusterResourcePlacementEviction", eviction.Name, "clusterResourcePlacement", eviction.Spec.PlacementName)
+			validationResult.outcomeReason = condition.EvictionReasonMissingPlacement
 			markEvictionInvalid(eviction, condition.EvictionInvalidMissingCRPMessage)
 			return validationResult, nil
 		}
@@ -122,11 +160,13 @@ func (r *Reconciler) validateEviction(ctx context.Context, eviction *placementv1
 
 	if crp.DeletionTimestamp != nil {
 		klog.V(2).InfoS(condition.EvictionInvalidDeletingCRPMessage, "clusterResourcePlacementEviction", eviction.Name, "clusterResourcePlacement", eviction.Spec.PlacementName)
+		validationResult.outcomeReason = condition.EvictionReasonDeletingPlacement
 		markEvictionInvalid(eviction, condition.EvictionInvalidDeletingCRPMessage)
 		return validationResult, nil
 	}
 	if crp.Spec.Policy.PlacementType == placementv1beta1.PickFixedPlacementType {
 		klog.V(2).InfoS(condition.EvictionInvalidPickFixedCRPMessage, "clusterResourcePlacementEviction", eviction.Name, "clusterResourcePlacement", eviction.Spec.PlacementName)
+		validationResult.outcomeReason = condition.EvictionReasonPickFixedPlacement
 		markEvictionInvalid(eviction, condition.EvictionInvalidPickFixedCRPMessage)
 		return validationResult, nil
 	}
@@ -145,6 +185,7 @@ func (r *Reconciler) validateEviction(ctx context.Context, eviction *placementv1
 				evictionTargetBinding = &crbList.Items[i]
 			} else {
 				klog.V(2).InfoS(condition.EvictionInvalidMultipleCRBMessage, "clusterResourcePlacementEviction", eviction.Name, "clusterResourcePlacement", eviction.Spec.PlacementName)
+				validationResult.outcomeReason = condition.EvictionReasonMultipleBindings
 				markEvictionInvalid(eviction, condition.EvictionInvalidMultipleCRBMessage)
 				return validationResult, nil
 			}
@@ -152,6 +193,7 @@ func (r *Reconciler) validateEviction(ctx context.Context, eviction *placementv1
 	}
 	if evictionTargetBinding == nil {
 		klog.V(2).InfoS("Failed to find cluster resource binding for cluster targeted by eviction", "clusterResourcePlacementEviction", eviction.Name, "targetCluster", eviction.Spec.ClusterName)
+		validationResult.outcomeReason = condition.EvictionReasonMissingBinding
 		markEvictionInvalid(eviction, condition.EvictionInvalidMissingCRBMessage)
 		return validationResult, nil
 	}
@@ -161,6 +203,53 @@ func (r *Reconciler) validateEviction(ctx context.Context, eviction *placementv1
 	return validationResult, nil
 }
 
+// hasPendingLowerPriorityEvictions checks if there are evictions, which target the same cluster as the given eviction
+// and are not in a terminal state yet, of placements with a lower priority than the placement of the given eviction.
+func (r *Reconciler) hasPendingLowerPriorityEvictions(ctx context.Context, eviction *placementv1beta1.ClusterResourcePlacementEviction, crp *placementv1beta1.ClusterResourcePlacement) (bool, error) {
+	var evictionList placementv1beta1.ClusterResourcePlacementEvictionList
+	if err := r.Client.List(ctx, &evictionList); err != nil {
+		return false, controller.NewAPIServerError(true, err)
+	}
+	var pending []*placementv1beta1.ClusterResourcePlacementEviction
+	for i := range evictionList.Items {
+		other := &evictionList.Items[i]
+		if other.Name == eviction.Name || other.Spec.ClusterName != eviction.Spec.ClusterName || other.Spec.PlacementName == eviction.Spec.PlacementName {
+			continue
+		}
+		if other.DeletionTimestamp != nil || evictionutils.IsEvictionInTerminalState(other) {
+			continue
+		}
+		pending = append(pending, other)
+	}
+	if len(pending) == 0 {
+		return false, nil
+	}
+
+	resolver, err := priority.NewResolver(ctx, r.Client)
+	if err != nil {
+		return false, controller.NewAPIServerError(true, err)
+	}
+	crpPriority := resolver.PriorityOf(crp)
+	for _, other := range pending {
+		var otherCRP placementv1beta1.ClusterResourcePlacement
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: other.Spec.PlacementName}, &otherCRP); err != nil {
+			if k8serrors.IsNotFound(err) {
+				// The other eviction will be marked as invalid.
+				continue
+			}
+			return false, controller.NewAPIServerError(true, err)
+		}
+		if otherCRP.DeletionTimestamp != nil {
+			// The other eviction will be marked as invalid.
+			continue
+		}
+		if resolver.PriorityOf(&otherCRP) < crpPriority {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 // updateEvictionStatus updates eviction status.
 func (r *Reconciler) updateEvictionStatus(ctx context.Context, eviction *placementv1beta1.ClusterResourcePlacementEviction) error {
 	evictionRef := klog.KObj(eviction)
@@ -197,6 +286,7 @@ func (r *Reconciler) executeEviction(ctx context.Context, validationResult *evic
 	if evictionTargetBinding.GetDeletionTimestamp() != nil {
 		klog.V(2).InfoS("ClusterResourceBinding targeted by eviction is being deleted",
 			"clusterResourcePlacementEviction", eviction.Name, "clusterResourceBinding", evictionTargetBinding.Name, "targetCluster", eviction.Spec.ClusterName)
+		validationResult.outcomeReason = condition.EvictionReasonPlacementRemoved
 		markEvictionExecuted(eviction, condition.EvictionAllowedPlacementRemovedMessage)
 		return nil
 	}
@@ -204,6 +294,7 @@ func (r *Reconciler) executeEviction(ctx context.Context, validationResult *evic
 	if !evictionutils.IsPlacementPresent(evictionTargetBinding) {
 		klog.V(2).InfoS("No resources have been placed for ClusterResourceBinding in target cluster",
 			"clusterResourcePlacementEviction", eviction.Name, "clusterResourceBinding", evictionTargetBinding.Name, "targetCluster", eviction.Spec.ClusterName)
+		validationResult.outcomeReason = condition.EvictionReasonResourcesNotPlaced
 		markEvictionNotExecuted(eviction, condition.EvictionBlockedMissingPlacementMessage)
 		return nil
 	}
@@ -215,6 +306,7 @@ func (r *Reconciler) executeEviction(ctx context.Context, validationResult *evic
 		if err := r.deleteClusterResourceBinding(ctx, evictionTargetBinding); err != nil {
 			return err
 		}
+		validationResult.outcomeReason = condition.EvictionReasonPlacementFailed
 		markEvictionExecuted(eviction, condition.EvictionAllowedPlacementFailedMessage)
 		return nil
 	}
@@ -225,6 +317,7 @@ func (r *Reconciler) executeEviction(ctx context.Context, validationResult *evic
 			if err = r.deleteClusterResourceBinding(ctx, evictionTargetBinding); err != nil {
 				return err
 			}
+			validationResult.outcomeReason = condition.EvictionReasonNoPDB
 			markEvictionExecuted(eviction, condition.EvictionAllowedNoPDBMessage)
 			return nil
 		}
@@ -234,6 +327,7 @@ func (r *Reconciler) executeEviction(ctx context.Context, validationResult *evic
 	// handle special case for PickAll CRP.
 	if crp.Spec.Policy.PlacementType == placementv1beta1.PickAllPlacementType {
 		if db.Spec.MaxUnavailable != nil || (db.Spec.MinAvailable != nil && db.Spec.MinAvailable.Type == intstr.String) {
+			validationResult.outcomeReason = condition.EvictionReasonMisconfiguredPDB
 			markEvictionNotExecuted(eviction, condition.EvictionBlockedMisconfiguredPDBSpecifiedMessage)
 			return nil
 		}
@@ -245,8 +339,10 @@ func (r *Reconciler) executeEviction(ctx context.Context, validationResult *evic
 		if err := r.deleteClusterResourceBinding(ctx, evictionTargetBinding); err != nil {
 			return err
 		}
+		validationResult.outcomeReason = condition.EvictionReasonPDB
 		markEvictionExecuted(eviction, fmt.Sprintf(condition.EvictionAllowedPDBSpecifiedMessageFmt, availableBindings, totalBindings))
 	} else {
+		validationResult.outcomeReason = condition.EvictionReasonPDB
 		markEvictionNotExecuted(eviction, fmt.Sprintf(condition.EvictionBlockedPDBSpecifiedMessageFmt, availableBindings, totalBindings))
 	}
 	return nil
@@ -254,41 +350,11 @@ func (r *Reconciler) executeEviction(ctx context.Context, validationResult *evic
 
 // isEvictionAllowed calculates if eviction allowed based on available bindings and spec specified in placement disruption budget.
 func isEvictionAllowed(bindings []placementv1beta1.ClusterResourceBinding, crp placementv1beta1.ClusterResourcePlacement, db placementv1beta1.ClusterResourcePlacementDisruptionBudget) (bool, int) {
-	availableBindings := 0
+	bindingObjs := make([]placementv1beta1.BindingObj, 0, len(bindings))
 	for i := range bindings {
-		availableCondition := bindings[i].GetCondition(string(placementv1beta1.ResourceBindingAvailable))
-		if condition.IsConditionStatusTrue(availableCondition, bindings[i].GetGeneration()) {
-			availableBindings++
-		}
-	}
-
-	var desiredBindings int
-	placementType := crp.Spec.Policy.PlacementType
-	// we don't know the desired bindings for PickAll and we won't evict a binding for PickFixed CRP.
-	if placementType == placementv1beta1.PickNPlacementType {
-		desiredBindings = int(*crp.Spec.Policy.NumberOfClusters)
-	}
-
-	var disruptionsAllowed int
-	switch {
-	// For PickAll CRPs, MaxUnavailable won't be specified in DB.
-	case db.Spec.MaxUnavailable != nil:
-		maxUnavailable, _ := intstr.GetScaledValueFromIntOrPercent(db.Spec.MaxUnavailable, desiredBindings, true)
-		unavailableBindings := len(bindings) - availableBindings
-		disruptionsAllowed = maxUnavailable - unavailableBindings
-	case db.Spec.MinAvailable != nil:
-		var minAvailable int
-		if placementType == placementv1beta1.PickAllPlacementType {
-			// MinAvailable will be an Integer value for PickAll CRP.
-			minAvailable = db.Spec.MinAvailable.IntValue()
-		} else {
-			minAvailable, _ = intstr.GetScaledValueFromIntOrPercent(db.Spec.MinAvailable, desiredBindings, true)
-		}
-		disruptionsAllowed = availableBindings - minAvailable
-	}
-	if disruptionsAllowed < 0 {
-		disruptionsAllowed = 0
+		bindingObjs = append(bindingObjs, &bindings[i])
 	}
+	disruptionsAllowed, availableBindings := evictionutils.DisruptionsAllowed(bindingObjs, &crp, &db)
 	return disruptionsAllowed > 0, availableBindings
 }
 
@@ -355,6 +421,45 @@ func emitEvictionCompleteMetric(eviction *placementv1beta1.ClusterResourcePlacem
 	}
 }
 
+// recordEvictionOutcome emits the metrics on a completed eviction, plus an event on the placement it targets
+// if the placement can be found.
+func (r *Reconciler) recordEvictionOutcome(eviction *placementv1beta1.ClusterResourcePlacementEviction, crp *placementv1beta1.ClusterResourcePlacement, reason string) {
+	result, message := evictionOutcome(eviction)
+	if reason == "" {
+		reason = condition.EvictionReasonUnknown
+	}
+	hubmetrics.FleetEvictionRequestTotal.WithLabelValues(result, reason).Inc()
+	hubmetrics.FleetEvictionDurationSeconds.WithLabelValues(result).Observe(time.Since(eviction.CreationTimestamp.Time).Seconds())
+	klog.V(2).InfoS("Eviction completed", "clusterResourcePlacementEviction", klog.KObj(eviction), "result", result, "reason", reason)
+
+	if crp == nil {
+		return
+	}
+	eventType, eventReason := corev1.EventTypeNormal, evictionGrantedEventReason
+	if result == evictionResultDenied {
+		eventType, eventReason = corev1.EventTypeWarning, evictionDeniedEventReason
+	}
+	r.Recorder.Eventf(crp, eventType, eventReason, "Eviction %s targeting cluster %s: %s", eviction.Name, eviction.Spec.ClusterName, message)
+}
+
+// evictionOutcome returns the result of a completed eviction and the message of the condition that concludes
+// the eviction.
+func evictionOutcome(eviction *placementv1beta1.ClusterResourcePlacementEviction) (result, message string) {
+	validCond := eviction.GetCondition(string(placementv1beta1.PlacementEvictionConditionTypeValid))
+	if condition.IsConditionStatusFalse(validCond, eviction.GetGeneration()) {
+		return evictionResultDenied, validCond.Message
+	}
+	executedCond := eviction.GetCondition(string(placementv1beta1.PlacementEvictionConditionTypeExecuted))
+	if executedCond == nil {
+		return evictionResultDenied, ""
+	}
+	result = evictionResultDenied
+	if condition.IsConditionStatusTrue(executedCond, eviction.GetGeneration()) {
+		result = evictionResultGranted
+	}
+	return result, executedCond.Message
+}
+
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr runtime.Manager) error {
 	return runtime.NewControllerManagedBy(mgr).Named("clusterresourceplacementeviction-controller").
@@ -377,4 +482,6 @@ type evictionValidationResult struct {
 	crb      *placementv1beta1.ClusterResourceBinding
 	bindings []placementv1beta1.ClusterResourceBinding
 	isValid  bool
+	// outcomeReason explains why the eviction has been granted or denied, once it is concluded.
+	outcomeReason string
 }
@@ -26,11 +26,13 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	prometheusclientmodel "github.com/prometheus/client_model/go"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/utils/ptr"
 	controllerruntime "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -114,7 +116,8 @@ func TestValidateEviction(t *testing.T) {
 			name:     "invalid eviction - CRP not found",
 			eviction: buildTestEviction(testEvictionName, testCRPName, testClusterName),
 			wantValidationResult: &evictionValidationResult{
-				isValid: false,
+				isValid:       false,
+				outcomeReason: condition.EvictionReasonMissingPlacement,
 			},
 			wantEvictionInvalidCondition: &metav1.Condition{
 				Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
@@ -141,7 +144,8 @@ func TestValidateEviction(t *testing.T) {
 				},
 			},
 			wantValidationResult: &evictionValidationResult{
-				isValid: false,
+				isValid:       false,
+				outcomeReason: condition.EvictionReasonDeletingPlacement,
 			},
 			wantEvictionInvalidCondition: &metav1.Condition{
 				Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
@@ -166,7 +170,8 @@ func TestValidateEviction(t *testing.T) {
 				},
 			},
 			wantValidationResult: &evictionValidationResult{
-				isValid: false,
+				isValid:       false,
+				outcomeReason: condition.EvictionReasonPickFixedPlacement,
 			},
 			wantEvictionInvalidCondition: &metav1.Condition{
 				Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
@@ -185,9 +190,10 @@ func TestValidateEviction(t *testing.T) {
 				testBinding1, testBinding2,
 			},
 			wantValidationResult: &evictionValidationResult{
-				isValid:  false,
-				crp:      testCRP,
-				bindings: []placementv1beta1.ClusterResourceBinding{testBinding1, testBinding2},
+				isValid:       false,
+				crp:           testCRP,
+				bindings:      []placementv1beta1.ClusterResourceBinding{testBinding1, testBinding2},
+				outcomeReason: condition.EvictionReasonMultipleBindings,
 			},
 			wantEvictionInvalidCondition: &metav1.Condition{
 				Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
@@ -203,9 +209,10 @@ func TestValidateEviction(t *testing.T) {
 			eviction: buildTestEviction(testEvictionName, testCRPName, testClusterName),
 			crp:      testCRP,
 			wantValidationResult: &evictionValidationResult{
-				isValid:  false,
-				crp:      testCRP,
-				bindings: []placementv1beta1.ClusterResourceBinding{},
+				isValid:       false,
+				crp:           testCRP,
+				bindings:      []placementv1beta1.ClusterResourceBinding{},
+				outcomeReason: condition.EvictionReasonMissingBinding,
 			},
 			wantEvictionInvalidCondition: &metav1.Condition{
 				Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
@@ -279,6 +286,132 @@ func TestValidateEviction(t *testing.T) {
 	}
 }
 
+func TestHasPendingLowerPriorityEvictions(t *testing.T) {
+	priorityClasses := []placementv1beta1.PlacementPriorityClass{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "high"},
+			Spec:       placementv1beta1.PlacementPriorityClassSpec{Value: 1000},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "low"},
+			Spec:       placementv1beta1.PlacementPriorityClassSpec{Value: -1000},
+		},
+	}
+	newCRP := func(name, priorityClassName string) *placementv1beta1.ClusterResourcePlacement {
+		return &placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: placementv1beta1.PlacementSpec{
+				PriorityClassName: priorityClassName,
+			},
+		}
+	}
+	newEviction := func(name, crpName, clusterName string) *placementv1beta1.ClusterResourcePlacementEviction {
+		return &placementv1beta1.ClusterResourcePlacementEviction{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: placementv1beta1.PlacementEvictionSpec{
+				PlacementName: crpName,
+				ClusterName:   clusterName,
+			},
+		}
+	}
+	executedEviction := newEviction("executed-eviction", "low-crp", testClusterName)
+	executedEviction.Status.Conditions = []metav1.Condition{
+		{
+			Type:   string(placementv1beta1.PlacementEvictionConditionTypeExecuted),
+			Status: metav1.ConditionTrue,
+		},
+	}
+
+	tests := []struct {
+		name    string
+		crp     *placementv1beta1.ClusterResourcePlacement
+		objects []client.Object
+		want    bool
+	}{
+		{
+			name: "no other evictions",
+			crp:  newCRP(testCRPName, "high"),
+			want: false,
+		},
+		{
+			name: "pending eviction of lower-priority placement targeting the same cluster",
+			crp:  newCRP(testCRPName, "high"),
+			objects: []client.Object{
+				newCRP("low-crp", "low"),
+				newEviction("other-eviction", "low-crp", testClusterName),
+			},
+			want: true,
+		},
+		{
+			name: "pending eviction of placement with the default priority",
+			crp:  newCRP(testCRPName, "high"),
+			objects: []client.Object{
+				newCRP("default-crp", ""),
+				newEviction("other-eviction", "default-crp", testClusterName),
+			},
+			want: true,
+		},
+		{
+			name: "pending eviction of higher-priority placement targeting the same cluster",
+			crp:  newCRP(testCRPName, "low"),
+			objects: []client.Object{
+				newCRP("high-crp", "high"),
+				newEviction("other-eviction", "high-crp", testClusterName),
+			},
+			want: false,
+		},
+		{
+			name: "pending eviction of lower-priority placement targeting another cluster",
+			crp:  newCRP(testCRPName, "high"),
+			objects: []client.Object{
+				newCRP("low-crp", "low"),
+				newEviction("other-eviction", "low-crp", "other-cluster"),
+			},
+			want: false,
+		},
+		{
+			name: "executed eviction of lower-priority placement",
+			crp:  newCRP(testCRPName, "high"),
+			objects: []client.Object{
+				newCRP("low-crp", "low"),
+				executedEviction,
+			},
+			want: false,
+		},
+		{
+			name: "pending eviction of missing placement",
+			crp:  newCRP(testCRPName, "high"),
+			objects: []client.Object{
+				newEviction("other-eviction", "low-crp", testClusterName),
+			},
+			want: false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			eviction := newEviction(testEvictionName, tc.crp.Name, testClusterName)
+			objects := append([]client.Object{tc.crp, eviction}, tc.objects...)
+			for i := range priorityClasses {
+				objects = append(objects, &priorityClasses[i])
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(objects...).
+				Build()
+			r := Reconciler{
+				Client: fakeClient,
+			}
+			got, err := r.hasPendingLowerPriorityEvictions(context.Background(), eviction, tc.crp)
+			if err != nil {
+				t.Fatalf("hasPendingLowerPriorityEvictions() got error %v, want no error", err)
+			}
+			if got != tc.want {
+				t.Errorf("hasPendingLowerPriorityEvictions() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestDeleteClusterResourceBinding(t *testing.T) {
 	tests := []struct {
 		name          string
@@ -389,6 +522,7 @@ func TestExecuteEviction(t *testing.T) {
 		eviction                      *placementv1beta1.ClusterResourcePlacementEviction
 		pdb                           *placementv1beta1.ClusterResourcePlacementDisruptionBudget
 		wantEvictionExecutedCondition *metav1.Condition
+		wantOutcomeReason             string
 		wantErr                       error
 	}{
 		{
@@ -411,7 +545,8 @@ func TestExecuteEviction(t *testing.T) {
 				Reason:             condition.ClusterResourcePlacementEvictionNotExecutedReason,
 				Message:            condition.EvictionBlockedMissingPlacementMessage,
 			},
-			wantErr: nil,
+			wantOutcomeReason: condition.EvictionReasonResourcesNotPlaced,
+			wantErr:           nil,
 		},
 		{
 			name: "unscheduled binding with previous state annotation doesn't exist - eviction not executed",
@@ -433,7 +568,8 @@ func TestExecuteEviction(t *testing.T) {
 				Reason:             condition.ClusterResourcePlacementEvictionNotExecutedReason,
 				Message:            condition.EvictionBlockedMissingPlacementMessage,
 			},
-			wantErr: nil,
+			wantOutcomeReason: condition.EvictionReasonResourcesNotPlaced,
+			wantErr:           nil,
 		},
 		{
 			name: "unscheduled binding with previous state as scheduled - eviction not executed",
@@ -456,7 +592,8 @@ func TestExecuteEviction(t *testing.T) {
 				Reason:             condition.ClusterResourcePlacementEvictionNotExecutedReason,
 				Message:            condition.EvictionBlockedMissingPlacementMessage,
 			},
-			wantErr: nil,
+			wantOutcomeReason: condition.EvictionReasonResourcesNotPlaced,
+			wantErr:           nil,
 		},
 		{
 			name: "deleting binding - eviction executed",
@@ -481,7 +618,8 @@ func TestExecuteEviction(t *testing.T) {
 				Reason:             condition.ClusterResourcePlacementEvictionExecutedReason,
 				Message:            condition.EvictionAllowedPlacementRemovedMessage,
 			},
-			wantErr: nil,
+			wantOutcomeReason: condition.EvictionReasonPlacementRemoved,
+			wantErr:           nil,
 		},
 		{
 			name: "failed to apply binding - eviction executed",
@@ -514,7 +652,8 @@ func TestExecuteEviction(t *testing.T) {
 				Reason:             condition.ClusterResourcePlacementEvictionExecutedReason,
 				Message:            condition.EvictionAllowedPlacementFailedMessage,
 			},
-			wantErr: nil,
+			wantOutcomeReason: condition.EvictionReasonPlacementFailed,
+			wantErr:           nil,
 		},
 		{
 			name: "failed to be available binding - eviction executed",
@@ -553,7 +692,8 @@ func TestExecuteEviction(t *testing.T) {
 				Reason:             condition.ClusterResourcePlacementEvictionExecutedReason,
 				Message:            condition.EvictionAllowedPlacementFailedMessage,
 			},
-			wantErr: nil,
+			wantOutcomeReason: condition.EvictionReasonPlacementFailed,
+			wantErr:           nil,
 		},
 		{
 			name: "pdb not found - eviction executed",
@@ -573,7 +713,8 @@ func TestExecuteEviction(t *testing.T) {
 				Reason:             condition.ClusterResourcePlacementEvictionExecutedReason,
 				Message:            condition.EvictionAllowedNoPDBMessage,
 			},
-			wantErr: nil,
+			wantOutcomeReason: condition.EvictionReasonNoPDB,
+			wantErr:           nil,
 		},
 		{
 			name: "PickAll CRP, Misconfigured PDB MaxUnavailable specified - eviction not executed",
@@ -600,7 +741,8 @@ func TestExecuteEviction(t *testing.T) {
 				Reason:             condition.ClusterResourcePlacementEvictionNotExecutedReason,
 				Message:            condition.EvictionBlockedMisconfiguredPDBSpecifiedMessage,
 			},
-			wantErr: nil,
+			wantOutcomeReason: condition.EvictionReasonMisconfiguredPDB,
+			wantErr:           nil,
 		},
 		{
 			name: "PickAll CRP, Misconfigured PDB MinAvailable specified as percentage - eviction not executed",
@@ -627,7 +769,8 @@ func TestExecuteEviction(t *testing.T) {
 				Reason:             condition.ClusterResourcePlacementEvictionNotExecutedReason,
 				Message:            condition.EvictionBlockedMisconfiguredPDBSpecifiedMessage,
 			},
-			wantErr: nil,
+			wantOutcomeReason: condition.EvictionReasonMisconfiguredPDB,
+			wantErr:           nil,
 		},
 	}
 	for _, tc := range tests {
@@ -646,6 +789,9 @@ func TestExecuteEviction(t *testing.T) {
 				UncachedReader: fakeClient,
 			}
 			gotErr := r.executeEviction(ctx, tc.validationResult, tc.eviction)
+			if gotReason := tc.validationResult.outcomeReason; gotReason != tc.wantOutcomeReason {
+				t.Errorf("executeEviction() outcome reason = %q, want %q", gotReason, tc.wantOutcomeReason)
+			}
 			gotExecutedCondition := tc.eviction.GetCondition(string(placementv1beta1.PlacementEvictionConditionTypeExecuted))
 			if diff := cmp.Diff(tc.wantEvictionExecutedCondition, gotExecutedCondition, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
 				t.Errorf("executeEviction() eviction executed condition mismatch (-want, +got):\n%s", diff)
@@ -1487,6 +1633,233 @@ func TestIsEvictionAllowed(t *testing.T) {
 	}
 }
 
+func TestEvictionOutcome(t *testing.T) {
+	tests := []struct {
+		name        string
+		conditions  []metav1.Condition
+		wantResult  string
+		wantMessage string
+	}{
+		{
+			name: "invalid eviction - missing binding",
+			conditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: 1,
+					Message:            condition.EvictionInvalidMissingCRBMessage,
+				},
+			},
+			wantResult:  evictionResultDenied,
+			wantMessage: condition.EvictionInvalidMissingCRBMessage,
+		},
+		{
+			name: "eviction blocked by PDB",
+			conditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+					Message:            condition.EvictionValidMessage,
+				},
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeExecuted),
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: 1,
+					Message:            fmt.Sprintf(condition.EvictionBlockedPDBSpecifiedMessageFmt, 1, 2),
+				},
+			},
+			wantResult:  evictionResultDenied,
+			wantMessage: fmt.Sprintf(condition.EvictionBlockedPDBSpecifiedMessageFmt, 1, 2),
+		},
+		{
+			name: "eviction allowed by PDB",
+			conditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+					Message:            condition.EvictionValidMessage,
+				},
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeExecuted),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+					Message:            fmt.Sprintf(condition.EvictionAllowedPDBSpecifiedMessageFmt, 2, 2),
+				},
+			},
+			wantResult:  evictionResultGranted,
+			wantMessage: fmt.Sprintf(condition.EvictionAllowedPDBSpecifiedMessageFmt, 2, 2),
+		},
+		{
+			name: "eviction allowed without PDB",
+			conditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+					Message:            condition.EvictionValidMessage,
+				},
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeExecuted),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+					Message:            condition.EvictionAllowedNoPDBMessage,
+				},
+			},
+			wantResult:  evictionResultGranted,
+			wantMessage: condition.EvictionAllowedNoPDBMessage,
+		},
+		{
+			name: "eviction blocked as resources are not placed yet",
+			conditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+					Message:            condition.EvictionValidMessage,
+				},
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeExecuted),
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: 1,
+					Message:            condition.EvictionBlockedMissingPlacementMessage,
+				},
+			},
+			wantResult:  evictionResultDenied,
+			wantMessage: condition.EvictionBlockedMissingPlacementMessage,
+		},
+		{
+			name:       "no conditions",
+			wantResult: evictionResultDenied,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			eviction := buildTestEviction(testEvictionName, testCRPName, testClusterName)
+			eviction.Status.Conditions = tc.conditions
+			gotResult, gotMessage := evictionOutcome(eviction)
+			if gotResult != tc.wantResult || gotMessage != tc.wantMessage {
+				t.Errorf("evictionOutcome() = (%q, %q), want (%q, %q)", gotResult, gotMessage, tc.wantResult, tc.wantMessage)
+			}
+		})
+	}
+}
+
+func TestRecordEvictionOutcome(t *testing.T) {
+	crp := buildTestPickAllCRP(testCRPName)
+	tests := []struct {
+		name       string
+		crp        *placementv1beta1.ClusterResourcePlacement
+		conditions []metav1.Condition
+		reason     string
+		wantResult string
+		wantReason string
+		wantEvents []string
+	}{
+		{
+			name: "granted eviction",
+			crp:  &crp,
+			conditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+				},
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeExecuted),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+					Message:            condition.EvictionAllowedNoPDBMessage,
+				},
+			},
+			wantResult: evictionResultGranted,
+			reason:     condition.EvictionReasonNoPDB,
+			wantReason: condition.EvictionReasonNoPDB,
+			wantEvents: []string{
+				fmt.Sprintf("Normal %s Eviction %s targeting cluster %s: %s", evictionGrantedEventReason, testEvictionName, testClusterName, condition.EvictionAllowedNoPDBMessage),
+			},
+		},
+		{
+			name: "denied eviction",
+			crp:  &crp,
+			conditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+				},
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeExecuted),
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: 1,
+					Message:            fmt.Sprintf(condition.EvictionBlockedPDBSpecifiedMessageFmt, 1, 2),
+				},
+			},
+			wantResult: evictionResultDenied,
+			reason:     condition.EvictionReasonPDB,
+			wantReason: condition.EvictionReasonPDB,
+			wantEvents: []string{
+				fmt.Sprintf("Warning %s Eviction %s targeting cluster %s: %s", evictionDeniedEventReason, testEvictionName, testClusterName, fmt.Sprintf(condition.EvictionBlockedPDBSpecifiedMessageFmt, 1, 2)),
+			},
+		},
+		{
+			name: "denied eviction of a missing placement",
+			conditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: 1,
+					Message:            condition.EvictionInvalidMissingCRPMessage,
+				},
+			},
+			wantResult: evictionResultDenied,
+			reason:     condition.EvictionReasonMissingPlacement,
+			wantReason: condition.EvictionReasonMissingPlacement,
+		},
+		{
+			name: "denied eviction without a known reason",
+			conditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.PlacementEvictionConditionTypeValid),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+				},
+			},
+			wantResult: evictionResultDenied,
+			wantReason: condition.EvictionReasonUnknown,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			hubmetrics.FleetEvictionRequestTotal.Reset()
+			hubmetrics.FleetEvictionDurationSeconds.Reset()
+			recorder := record.NewFakeRecorder(10)
+			r := Reconciler{Recorder: recorder}
+			eviction := buildTestEviction(testEvictionName, testCRPName, testClusterName)
+			eviction.CreationTimestamp = metav1.NewTime(time.Now().Add(-time.Minute))
+			eviction.Status.Conditions = tc.conditions
+
+			r.recordEvictionOutcome(eviction, tc.crp, tc.reason)
+
+			if got := testutil.ToFloat64(hubmetrics.FleetEvictionRequestTotal.WithLabelValues(tc.wantResult, tc.wantReason)); got != 1 {
+				t.Errorf("eviction request count for (%s, %s) = %v, want 1", tc.wantResult, tc.wantReason, got)
+			}
+			if got := testutil.CollectAndCount(hubmetrics.FleetEvictionDurationSeconds); got != 1 {
+				t.Errorf("eviction duration metric count = %d, want 1", got)
+			}
+			close(recorder.Events)
+			var gotEvents []string
+			for e := range recorder.Events {
+				gotEvents = append(gotEvents, e)
+			}
+			if diff := cmp.Diff(tc.wantEvents, gotEvents); diff != "" {
+				t.Errorf("recordEvictionOutcome() events mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestReconcileForIncompleteEvictionMetric(t *testing.T) {
 	request := controllerruntime.Request{NamespacedName: types.NamespacedName{Name: "test-eviction"}}
 	isValid := "unknown"
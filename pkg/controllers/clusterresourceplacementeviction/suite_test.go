@@ -94,6 +94,7 @@ var _ = BeforeSuite(func() {
 	err = (&Reconciler{
 		Client:         k8sClient,
 		UncachedReader: mgr.GetAPIReader(),
+		Recorder:       mgr.GetEventRecorderFor("test-eviction-controller"),
 	}).SetupWithManager(mgr)
 	Expect(err).Should(Succeed())
 
@@ -35,7 +35,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
-	"github.com/kubefleet-dev/kubefleet/test/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/testutils"
 )
 
 var (
@@ -45,7 +45,7 @@ var (
 	testEnv                 *envtest.Environment
 	ctx                     context.Context
 	cancel                  context.CancelFunc
-	fakePlacementController *controller.FakeController
+	fakePlacementController *testutils.FakePlacementController
 )
 
 const (
@@ -106,7 +106,7 @@ var _ = BeforeSuite(func() {
 	})
 	Expect(err).Should(Succeed())
 
-	fakePlacementController = &controller.FakeController{}
+	fakePlacementController = testutils.NewFakePlacementController()
 
 	err = (&Reconciler{
 		Client:              mgr.GetClient(),
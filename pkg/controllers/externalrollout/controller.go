@@ -0,0 +1,361 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package externalrollout features a controller that updates the bindings of placements whose rollout is
+// delegated to an external controller, according to the progress reported by the external controller.
+package externalrollout
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	runtime "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/informer"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/overrider"
+)
+
+// Reconciler updates the bindings of a placement of the External rollout strategy type according to the
+// progress reported in its ClusterExternalRolloutProgress or ExternalRolloutProgress object.
+type Reconciler struct {
+	client.Client
+	// UncachedReader reads the bindings from the API server directly to avoid acting on stale bindings.
+	UncachedReader client.Reader
+	// InformerManager contains the cache for all the resources to check the resource scope.
+	InformerManager informer.Manager
+}
+
+// Reconcile syncs the bindings of a placement with the progress reported by the external controller.
+func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtime.Result, error) {
+	startTime := time.Now()
+	progressKey := req.NamespacedName
+	klog.V(2).InfoS("ExternalRolloutProgress reconciliation starts", "externalRolloutProgress", progressKey)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("ExternalRolloutProgress reconciliation ends", "externalRolloutProgress", progressKey, "latency", latency)
+	}()
+
+	progress, err := fetchExternalRolloutProgress(ctx, r.Client, progressKey)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(4).InfoS("Ignoring NotFound externalRolloutProgress", "externalRolloutProgress", progressKey)
+			return runtime.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get externalRolloutProgress", "externalRolloutProgress", progressKey)
+		return runtime.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if progress.GetDeletionTimestamp() != nil {
+		klog.V(2).InfoS("Ignoring externalRolloutProgress that is being deleted", "externalRolloutProgress", progressKey)
+		return runtime.Result{}, nil
+	}
+
+	// The external rollout progress shares the name (and namespace) with its placement.
+	placementObj, err := controller.FetchPlacementFromKey(ctx, r.Client, controller.GetObjectKeyFromRequest(req))
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to get placement", "externalRolloutProgress", progressKey)
+			return runtime.Result{}, controller.NewAPIServerError(true, err)
+		}
+		markProgressInvalidPlacement(progress, "Failed to find the placement of the external rollout progress")
+		return runtime.Result{}, r.updateStatus(ctx, progress)
+	}
+	if placementObj.GetDeletionTimestamp() != nil {
+		markProgressInvalidPlacement(progress, "Found the placement of the external rollout progress being deleted")
+		return runtime.Result{}, r.updateStatus(ctx, progress)
+	}
+	// fill out all the default values for placement just in case the mutation webhook is not enabled.
+	defaulter.SetPlacementDefaults(placementObj)
+	if placementObj.GetPlacementSpec().Strategy.Type != placementv1beta1.ExternalRolloutStrategyType {
+		markProgressInvalidPlacement(progress, fmt.Sprintf("The placement of the external rollout progress is of the %s rollout strategy type, not %s",
+			placementObj.GetPlacementSpec().Strategy.Type, placementv1beta1.ExternalRolloutStrategyType))
+		return runtime.Result{}, r.updateStatus(ctx, progress)
+	}
+
+	// we read from the API server directly to avoid the repeated reconcile loop due to cache inconsistency
+	bindings, err := controller.ListBindingsFromKey(ctx, r.UncachedReader, progressKey, false)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list all the bindings associated with the placement", "placement", klog.KObj(placementObj))
+		return runtime.Result{}, err
+	}
+
+	notSyncedReasons, err := r.syncBindings(ctx, placementObj, progress, bindings)
+	if err != nil {
+		return runtime.Result{}, err
+	}
+	markProgressSynced(progress, notSyncedReasons)
+	return runtime.Result{}, r.updateStatus(ctx, progress)
+}
+
+// syncBindings updates the bindings of the reported clusters to run the reported resource snapshots.
+// It returns the reasons why some of the reported clusters cannot run the reported resource snapshots yet.
+func (r *Reconciler) syncBindings(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	progress placementv1beta1.ExternalRolloutProgressObj,
+	bindings []placementv1beta1.BindingObj,
+) ([]string, error) {
+	placementKey := string(controller.GetObjectKeyFromObj(placementObj))
+	applyStrategy := placementObj.GetPlacementSpec().Strategy.ApplyStrategy
+	bindingMap := make(map[string]placementv1beta1.BindingObj, len(bindings))
+	for _, binding := range bindings {
+		bindingSpec := binding.GetBindingSpec()
+		// Only the scheduled or bound bindings can be rolled out.
+		if binding.GetDeletionTimestamp() != nil || bindingSpec.State == placementv1beta1.BindingStateUnscheduled {
+			continue
+		}
+		bindingMap[bindingSpec.TargetCluster] = binding
+	}
+
+	// The resource snapshots and their matched overrides are shared by the clusters running the same index.
+	masterSnapshots := make(map[string]placementv1beta1.ResourceSnapshotObj)
+	matchedCROs := make(map[string][]*placementv1beta1.ClusterResourceOverrideSnapshot)
+	matchedROs := make(map[string][]*placementv1beta1.ResourceOverrideSnapshot)
+
+	var notSyncedReasons []string
+	var errs []error
+	for _, target := range progress.GetExternalRolloutProgressSpec().Clusters {
+		binding, found := bindingMap[target.ClusterName]
+		if !found {
+			notSyncedReasons = append(notSyncedReasons, fmt.Sprintf("cluster %s is not scheduled", target.ClusterName))
+			continue
+		}
+		masterSnapshot, found := masterSnapshots[target.ResourceSnapshotIndex]
+		if !found {
+			var err error
+			masterSnapshot, err = controller.FetchMasterResourceSnapshotWithAnIndex(ctx, r.Client, target.ResourceSnapshotIndex, types.NamespacedName{Name: placementObj.GetName(), Namespace: placementObj.GetNamespace()})
+			if err != nil {
+				return nil, err
+			}
+			if masterSnapshot != nil {
+				if matchedCROs[target.ResourceSnapshotIndex], matchedROs[target.ResourceSnapshotIndex], err =
+					overrider.FetchAllMatchingOverridesForResourceSnapshot(ctx, r.Client, r.InformerManager, placementKey, masterSnapshot); err != nil {
+					klog.ErrorS(err, "Failed to find all matching overrides for the resource snapshot", "resourceSnapshot", klog.KObj(masterSnapshot))
+					return nil, err
+				}
+			}
+			masterSnapshots[target.ResourceSnapshotIndex] = masterSnapshot
+		}
+		if masterSnapshot == nil {
+			notSyncedReasons = append(notSyncedReasons, fmt.Sprintf("resource snapshot index %s of cluster %s does not exist", target.ResourceSnapshotIndex, target.ClusterName))
+			continue
+		}
+
+		cro, ro, propertySnapshot, err := overrider.PickFromResourceMatchedOverridesForTargetCluster(ctx, r.Client, target.ClusterName,
+			matchedCROs[target.ResourceSnapshotIndex], matchedROs[target.ResourceSnapshotIndex])
+		if err != nil {
+			klog.ErrorS(err, "Failed to pick the override snapshots for cluster", "cluster", target.ClusterName, "resourceSnapshot", klog.KObj(masterSnapshot))
+			errs = append(errs, err)
+			continue
+		}
+		if err := r.updateBinding(ctx, binding, masterSnapshot, cro, ro, propertySnapshot, applyStrategy, progress); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return notSyncedReasons, utilerrors.NewAggregate(errs)
+}
+
+// updateBinding updates the binding to run the given resource snapshot with the given overrides, if it has not
+// done so yet, and marks the rollout of the binding as started.
+func (r *Reconciler) updateBinding(
+	ctx context.Context,
+	binding placementv1beta1.BindingObj,
+	masterSnapshot placementv1beta1.ResourceSnapshotObj,
+	cro []string,
+	ro []placementv1beta1.NamespacedName,
+	propertySnapshot *placementv1beta1.ClusterPropertySnapshot,
+	applyStrategy *placementv1beta1.ApplyStrategy,
+	progress placementv1beta1.ExternalRolloutProgressObj,
+) error {
+	bindingRef := klog.KObj(binding)
+	bindingSpec := binding.GetBindingSpec()
+	if !isBindingUpToDate(bindingSpec, masterSnapshot.GetName(), cro, ro, propertySnapshot, applyStrategy) {
+		bindingSpec.State = placementv1beta1.BindingStateBound
+		bindingSpec.ResourceSnapshotName = masterSnapshot.GetName()
+		bindingSpec.ClusterResourceOverrideSnapshots = cro
+		bindingSpec.ResourceOverrideSnapshots = ro
+		bindingSpec.ClusterPropertySnapshot = propertySnapshot
+		bindingSpec.ApplyStrategy = applyStrategy
+		if err := r.Client.Update(ctx, binding); err != nil {
+			klog.ErrorS(err, "Failed to update binding to run the reported resource snapshot", "binding", bindingRef, "resourceSnapshot", masterSnapshot.GetName())
+			return controller.NewUpdateIgnoreConflictError(err)
+		}
+		klog.V(2).InfoS("Updated binding to run the reported resource snapshot", "binding", bindingRef, "resourceSnapshot", masterSnapshot.GetName())
+	} else if condition.IsConditionStatusTrue(binding.GetCondition(string(placementv1beta1.ResourceBindingRolloutStarted)), binding.GetGeneration()) {
+		return nil
+	}
+
+	// The work generator only processes the bindings whose rollout has started at the latest generation.
+	// first reset the condition to reflect the latest lastTransitionTime
+	binding.RemoveCondition(string(placementv1beta1.ResourceBindingRolloutStarted))
+	cond := metav1.Condition{
+		Type:               string(placementv1beta1.ResourceBindingRolloutStarted),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: binding.GetGeneration(),
+		Reason:             condition.RolloutStartedReason,
+		Message: fmt.Sprintf("Detected the new changes on the resources and started the rollout process, resourceSnapshot: %s, externalRolloutProgress: %s",
+			masterSnapshot.GetName(), progress.GetName()),
+	}
+	binding.SetConditions(cond)
+	if err := r.Client.Status().Update(ctx, binding); err != nil {
+		klog.ErrorS(err, "Failed to update binding status", "binding", bindingRef, "condition", cond)
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated binding as rolloutStarted", "binding", bindingRef, "condition", cond)
+	return nil
+}
+
+// isBindingUpToDate returns true if the binding is bound and runs the given resource snapshot with the given overrides.
+func isBindingUpToDate(
+	bindingSpec *placementv1beta1.ResourceBindingSpec,
+	resourceSnapshotName string,
+	cro []string,
+	ro []placementv1beta1.NamespacedName,
+	propertySnapshot *placementv1beta1.ClusterPropertySnapshot,
+	applyStrategy *placementv1beta1.ApplyStrategy,
+) bool {
+	return bindingSpec.State == placementv1beta1.BindingStateBound &&
+		bindingSpec.ResourceSnapshotName == resourceSnapshotName &&
+		equality.Semantic.DeepEqual(bindingSpec.ClusterResourceOverrideSnapshots, cro) &&
+		equality.Semantic.DeepEqual(bindingSpec.ResourceOverrideSnapshots, ro) &&
+		equality.Semantic.DeepEqual(bindingSpec.ClusterPropertySnapshot, propertySnapshot) &&
+		equality.Semantic.DeepEqual(bindingSpec.ApplyStrategy, applyStrategy)
+}
+
+// fetchExternalRolloutProgress returns the ClusterExternalRolloutProgress or ExternalRolloutProgress of the key,
+// depending on whether the key has a namespace.
+func fetchExternalRolloutProgress(ctx context.Context, c client.Reader, key types.NamespacedName) (placementv1beta1.ExternalRolloutProgressObj, error) {
+	var progress placementv1beta1.ExternalRolloutProgressObj
+	if key.Namespace == "" {
+		progress = &placementv1beta1.ClusterExternalRolloutProgress{}
+	} else {
+		progress = &placementv1beta1.ExternalRolloutProgress{}
+	}
+	if err := c.Get(ctx, key, progress); err != nil {
+		return nil, err
+	}
+	return progress, nil
+}
+
+func (r *Reconciler) updateStatus(ctx context.Context, progress placementv1beta1.ExternalRolloutProgressObj) error {
+	if err := r.Client.Status().Update(ctx, progress); err != nil {
+		klog.ErrorS(err, "Failed to update externalRolloutProgress status", "externalRolloutProgress", klog.KObj(progress))
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the status of externalRolloutProgress", "externalRolloutProgress", klog.KObj(progress))
+	return nil
+}
+
+// markProgressInvalidPlacement sets the synced condition as false as the placement cannot be rolled out externally.
+func markProgressInvalidPlacement(progress placementv1beta1.ExternalRolloutProgressObj, message string) {
+	progress.SetConditions(metav1.Condition{
+		Type:               string(placementv1beta1.ExternalRolloutProgressConditionTypeSynced),
+		Status:             metav1.ConditionFalse,
+		ObservedGeneration: progress.GetGeneration(),
+		Reason:             condition.ExternalRolloutProgressInvalidPlacementReason,
+		Message:            message,
+	})
+}
+
+// markProgressSynced sets the synced condition based on the reasons why some of the reported clusters cannot run
+// the reported resource snapshots yet.
+func markProgressSynced(progress placementv1beta1.ExternalRolloutProgressObj, notSyncedReasons []string) {
+	cond := metav1.Condition{
+		Type:               string(placementv1beta1.ExternalRolloutProgressConditionTypeSynced),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: progress.GetGeneration(),
+		Reason:             condition.ExternalRolloutProgressSyncedReason,
+		Message:            "All the reported clusters run the reported resource snapshots",
+	}
+	if len(notSyncedReasons) > 0 {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = condition.ExternalRolloutProgressNotSyncedReason
+		cond.Message = fmt.Sprintf("Some of the reported clusters cannot run the reported resource snapshots yet: %s", strings.Join(notSyncedReasons, "; "))
+	}
+	progress.SetConditions(cond)
+}
+
+// SetupWithManagerForClusterResourcePlacement sets up the controller for ClusterExternalRolloutProgress objects.
+func (r *Reconciler) SetupWithManagerForClusterResourcePlacement(mgr runtime.Manager) error {
+	return runtime.NewControllerManagedBy(mgr).Named("cluster-external-rollout-progress-controller").
+		For(&placementv1beta1.ClusterExternalRolloutProgress{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		// Re-sync when the bindings are (re-)scheduled, e.g., a reported cluster is scheduled after the report.
+		Watches(&placementv1beta1.ClusterResourceBinding{}, bindingHandlerFuncs()).
+		Complete(r)
+}
+
+// SetupWithManagerForResourcePlacement sets up the controller for ExternalRolloutProgress objects.
+func (r *Reconciler) SetupWithManagerForResourcePlacement(mgr runtime.Manager) error {
+	return runtime.NewControllerManagedBy(mgr).Named("external-rollout-progress-controller").
+		For(&placementv1beta1.ExternalRolloutProgress{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		// Re-sync when the bindings are (re-)scheduled, e.g., a reported cluster is scheduled after the report.
+		Watches(&placementv1beta1.ResourceBinding{}, bindingHandlerFuncs()).
+		Complete(r)
+}
+
+// bindingHandlerFuncs enqueues the external rollout progress of the placement whose binding is created or whose
+// binding changes its state.
+func bindingHandlerFuncs() handler.Funcs {
+	return handler.Funcs{
+		CreateFunc: func(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			klog.V(2).InfoS("Handling a binding create event", "binding", klog.KObj(e.Object))
+			enqueueExternalRolloutProgress(e.Object, q)
+		},
+		UpdateFunc: func(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			oldBinding, oldOK := e.ObjectOld.(placementv1beta1.BindingObj)
+			newBinding, newOK := e.ObjectNew.(placementv1beta1.BindingObj)
+			if !oldOK || !newOK {
+				klog.ErrorS(controller.NewUnexpectedBehaviorError(fmt.Errorf("non binding type resource: %+v", e.ObjectNew)),
+					"External rollout progress controller received invalid binding event", "object", klog.KObj(e.ObjectNew))
+				return
+			}
+			if oldBinding.GetBindingSpec().State == newBinding.GetBindingSpec().State {
+				return
+			}
+			klog.V(2).InfoS("Handling a binding state change event", "binding", klog.KObj(newBinding),
+				"oldState", oldBinding.GetBindingSpec().State, "newState", newBinding.GetBindingSpec().State)
+			enqueueExternalRolloutProgress(newBinding, q)
+		},
+	}
+}
+
+func enqueueExternalRolloutProgress(binding client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	placementName, exist := binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+	if !exist {
+		klog.ErrorS(controller.NewUnexpectedBehaviorError(fmt.Errorf("binding %s has no placement tracking label", binding.GetName())),
+			"Invalid binding", "binding", klog.KObj(binding))
+		return
+	}
+	q.Add(reconcile.Request{NamespacedName: types.NamespacedName{Namespace: binding.GetNamespace(), Name: placementName}})
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalrollout
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+const (
+	testPlacementName = "test-placement"
+	testClusterName   = "member-1"
+)
+
+func serviceScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add placement v1beta1 scheme: %v", err)
+	}
+	return scheme
+}
+
+func placementWithStrategy(strategyType placementv1beta1.RolloutStrategyType) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{Name: testPlacementName},
+		Spec: placementv1beta1.PlacementSpec{
+			Strategy: placementv1beta1.RolloutStrategy{Type: strategyType},
+		},
+	}
+}
+
+func scheduledBinding(clusterName string) *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "binding-" + clusterName,
+			Generation: 1,
+			Labels:     map[string]string{placementv1beta1.PlacementTrackingLabel: testPlacementName},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateScheduled,
+			TargetCluster: clusterName,
+		},
+	}
+}
+
+func masterResourceSnapshot(index string) *placementv1beta1.ClusterResourceSnapshot {
+	return &placementv1beta1.ClusterResourceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testPlacementName + "-" + index + "-snapshot",
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: testPlacementName,
+				placementv1beta1.ResourceIndexLabel:     index,
+			},
+			Annotations: map[string]string{placementv1beta1.ResourceGroupHashAnnotation: "hash"},
+		},
+	}
+}
+
+func progressWithTargets(targets ...placementv1beta1.ClusterRolloutTarget) *placementv1beta1.ClusterExternalRolloutProgress {
+	return &placementv1beta1.ClusterExternalRolloutProgress{
+		ObjectMeta: metav1.ObjectMeta{Name: testPlacementName, Generation: 1},
+		Spec:       placementv1beta1.ExternalRolloutProgressSpec{Clusters: targets},
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	tests := map[string]struct {
+		objects     []client.Object
+		wantCond    metav1.Condition
+		wantBinding *placementv1beta1.ResourceBindingSpec
+	}{
+		"placement does not exist": {
+			objects: []client.Object{
+				progressWithTargets(placementv1beta1.ClusterRolloutTarget{ClusterName: testClusterName, ResourceSnapshotIndex: "0"}),
+			},
+			wantCond: metav1.Condition{
+				Type:               string(placementv1beta1.ExternalRolloutProgressConditionTypeSynced),
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 1,
+				Reason:             condition.ExternalRolloutProgressInvalidPlacementReason,
+			},
+		},
+		"placement is not of the External rollout strategy type": {
+			objects: []client.Object{
+				placementWithStrategy(placementv1beta1.RollingUpdateRolloutStrategyType),
+				scheduledBinding(testClusterName),
+				masterResourceSnapshot("0"),
+				progressWithTargets(placementv1beta1.ClusterRolloutTarget{ClusterName: testClusterName, ResourceSnapshotIndex: "0"}),
+			},
+			wantCond: metav1.Condition{
+				Type:               string(placementv1beta1.ExternalRolloutProgressConditionTypeSynced),
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 1,
+				Reason:             condition.ExternalRolloutProgressInvalidPlacementReason,
+			},
+			wantBinding: &placementv1beta1.ResourceBindingSpec{
+				State:         placementv1beta1.BindingStateScheduled,
+				TargetCluster: testClusterName,
+			},
+		},
+		"reported cluster is not scheduled": {
+			objects: []client.Object{
+				placementWithStrategy(placementv1beta1.ExternalRolloutStrategyType),
+				masterResourceSnapshot("0"),
+				progressWithTargets(placementv1beta1.ClusterRolloutTarget{ClusterName: testClusterName, ResourceSnapshotIndex: "0"}),
+			},
+			wantCond: metav1.Condition{
+				Type:               string(placementv1beta1.ExternalRolloutProgressConditionTypeSynced),
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 1,
+				Reason:             condition.ExternalRolloutProgressNotSyncedReason,
+			},
+		},
+		"reported resource snapshot does not exist": {
+			objects: []client.Object{
+				placementWithStrategy(placementv1beta1.ExternalRolloutStrategyType),
+				scheduledBinding(testClusterName),
+				masterResourceSnapshot("0"),
+				progressWithTargets(placementv1beta1.ClusterRolloutTarget{ClusterName: testClusterName, ResourceSnapshotIndex: "1"}),
+			},
+			wantCond: metav1.Condition{
+				Type:               string(placementv1beta1.ExternalRolloutProgressConditionTypeSynced),
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: 1,
+				Reason:             condition.ExternalRolloutProgressNotSyncedReason,
+			},
+			wantBinding: &placementv1beta1.ResourceBindingSpec{
+				State:         placementv1beta1.BindingStateScheduled,
+				TargetCluster: testClusterName,
+			},
+		},
+		"scheduled binding is bound to the reported resource snapshot": {
+			objects: []client.Object{
+				placementWithStrategy(placementv1beta1.ExternalRolloutStrategyType),
+				scheduledBinding(testClusterName),
+				masterResourceSnapshot("0"),
+				masterResourceSnapshot("1"),
+				progressWithTargets(placementv1beta1.ClusterRolloutTarget{ClusterName: testClusterName, ResourceSnapshotIndex: "1"}),
+			},
+			wantCond: metav1.Condition{
+				Type:               string(placementv1beta1.ExternalRolloutProgressConditionTypeSynced),
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 1,
+				Reason:             condition.ExternalRolloutProgressSyncedReason,
+			},
+			wantBinding: &placementv1beta1.ResourceBindingSpec{
+				State:                placementv1beta1.BindingStateBound,
+				TargetCluster:        testClusterName,
+				ResourceSnapshotName: testPlacementName + "-1-snapshot",
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					ComparisonOption: placementv1beta1.ComparisonOptionTypePartialComparison,
+					WhenToApply:      placementv1beta1.WhenToApplyTypeAlways,
+					Type:             placementv1beta1.ApplyStrategyTypeClientSideApply,
+					WhenToTakeOver:   placementv1beta1.WhenToTakeOverTypeAlways,
+				},
+			},
+		},
+	}
+	for name, tc := range tests {
+		t.Run(name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(tc.objects...).
+				WithStatusSubresource(&placementv1beta1.ClusterExternalRolloutProgress{}, &placementv1beta1.ClusterResourceBinding{}).
+				Build()
+			r := Reconciler{Client: fakeClient, UncachedReader: fakeClient}
+			ctx := context.Background()
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: types.NamespacedName{Name: testPlacementName}}); err != nil {
+				t.Fatalf("Reconcile() = %v, want nil", err)
+			}
+
+			var gotProgress placementv1beta1.ClusterExternalRolloutProgress
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: testPlacementName}, &gotProgress); err != nil {
+				t.Fatalf("Get() progress = %v, want nil", err)
+			}
+			gotCond := gotProgress.GetCondition(string(placementv1beta1.ExternalRolloutProgressConditionTypeSynced))
+			if diff := cmp.Diff(&tc.wantCond, gotCond, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime", "Message")); diff != "" {
+				t.Errorf("Synced condition mismatch (-want, +got):\n%s", diff)
+			}
+
+			if tc.wantBinding == nil {
+				return
+			}
+			var gotBinding placementv1beta1.ClusterResourceBinding
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: "binding-" + testClusterName}, &gotBinding); err != nil {
+				t.Fatalf("Get() binding = %v, want nil", err)
+			}
+			if diff := cmp.Diff(*tc.wantBinding, gotBinding.Spec); diff != "" {
+				t.Errorf("binding spec mismatch (-want, +got):\n%s", diff)
+			}
+			wantRolloutStarted := tc.wantBinding.State == placementv1beta1.BindingStateBound
+			gotRolloutStarted := condition.IsConditionStatusTrue(gotBinding.GetCondition(string(placementv1beta1.ResourceBindingRolloutStarted)), gotBinding.Generation)
+			if gotRolloutStarted != wantRolloutStarted {
+				t.Errorf("binding RolloutStarted = %t, want %t", gotRolloutStarted, wantRolloutStarted)
+			}
+		})
+	}
+}
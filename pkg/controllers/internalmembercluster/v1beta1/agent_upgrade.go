@@ -0,0 +1,254 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/version"
+)
+
+const (
+	// agentPreviousImageAnnotation is the annotation on the member agent Deployment that keeps the
+	// image the agent runs before an upgrade, so that the agent can roll back to it.
+	agentPreviousImageAnnotation = placementv1beta1.FleetPrefix + "agent-previous-image"
+	// agentFailedVersionAnnotation is the annotation on the member agent Deployment that keeps the
+	// version the agent has failed to upgrade to; the agent does not retry the version.
+	agentFailedVersionAnnotation = placementv1beta1.FleetPrefix + "agent-failed-version"
+
+	// The condition information for reporting if the member agent runs the desired version.
+	AgentUpgradeDisabledReason       = "UpgradeDisabled"
+	AgentUpgradeDisabledMessage      = "A desired agent version is set, but agent upgrade is not enabled on the member agent"
+	AgentUpgradeFailedReason         = "UpgradeFailed"
+	AgentUpgradeFailedMessageFmt     = "Failed to upgrade the member agent to version %s: %v"
+	AgentUpgradeInProgressReason     = "Upgrading"
+	AgentUpgradeInProgressMessageFmt = "The member agent is being upgraded to version %s"
+	AgentUpgradeRolledBackReason     = "RolledBack"
+	AgentUpgradeRolledBackMessageFmt = "Version %s of the member agent fails to become available; the member agent has been rolled back to image %s and will not retry the version"
+	AgentUpgradeSucceededReason      = "Upgraded"
+	AgentUpgradeSucceededMessageFmt  = "The member agent runs the desired version %s"
+
+	// deploymentProgressDeadlineExceededReason is the reason Kubernetes sets on the Progressing
+	// condition of a Deployment when its rollout fails to make progress in time.
+	deploymentProgressDeadlineExceededReason = "ProgressDeadlineExceeded"
+)
+
+// AgentUpgradeConfig is a group of settings that allow the member agent to upgrade itself to the
+// version desired by the hub cluster.
+type AgentUpgradeConfig struct {
+	// Reader is an uncached reader to the member cluster, for reading the Deployment of the member agent.
+	Reader client.Reader
+	// DeploymentNamespace is the namespace of the Deployment that runs the member agent.
+	DeploymentNamespace string
+	// DeploymentName is the name of the Deployment that runs the member agent.
+	DeploymentName string
+	// ContainerName is the name of the container that runs the member agent in the Deployment.
+	ContainerName string
+}
+
+// reportAgentVersion reports the build version of the running member agent.
+func reportAgentVersion(imc *clusterv1beta1.InternalMemberCluster) {
+	info := version.Get()
+	agentStatus := imc.GetAgentStatus(clusterv1beta1.MemberAgent)
+	agentStatus.Version = info.Version
+	agentStatus.GitCommit = info.GitCommit
+}
+
+// syncAgentUpgrade rolls out the agent version desired by the hub cluster to the Deployment of
+// the member agent, and reports the progress in the Upgraded condition.
+//
+// The new version is rolled out one pod at a time, with no pod of the current version removed
+// before a pod of the new version becomes available; i.e., the first new pod serves as a canary.
+// If the rollout fails to make progress before the deadline of the Deployment, the agent rolls
+// its Deployment back to the previous image and does not retry the failed version.
+func (r *Reconciler) syncAgentUpgrade(ctx context.Context, imc *clusterv1beta1.InternalMemberCluster) error {
+	agentStatus := imc.GetAgentStatus(clusterv1beta1.MemberAgent)
+	desiredVersion := imc.Spec.DesiredAgentVersion
+	if desiredVersion == "" {
+		meta.RemoveStatusCondition(&agentStatus.Conditions, string(clusterv1beta1.AgentUpgraded))
+		return nil
+	}
+	if r.agentUpgradeCfg == nil {
+		reportAgentUpgradedCondition(imc, metav1.ConditionFalse, AgentUpgradeDisabledReason, AgentUpgradeDisabledMessage)
+		return nil
+	}
+
+	deploy := &appsv1.Deployment{}
+	deployKey := types.NamespacedName{Namespace: r.agentUpgradeCfg.DeploymentNamespace, Name: r.agentUpgradeCfg.DeploymentName}
+	if err := r.agentUpgradeCfg.Reader.Get(ctx, deployKey, deploy); err != nil {
+		klog.ErrorS(err, "Failed to get the member agent deployment", "deployment", deployKey)
+		reportAgentUpgradedCondition(imc, metav1.ConditionFalse, AgentUpgradeFailedReason, fmt.Sprintf(AgentUpgradeFailedMessageFmt, desiredVersion, err))
+		return err
+	}
+
+	status, reason, message, updated, err := planAgentUpgrade(deploy, r.agentUpgradeCfg.ContainerName, desiredVersion)
+	if err != nil {
+		// The error is not retriable; report it without requeueing.
+		klog.ErrorS(err, "Failed to plan the member agent upgrade", "deployment", deployKey, "desiredVersion", desiredVersion)
+		reportAgentUpgradedCondition(imc, metav1.ConditionFalse, AgentUpgradeFailedReason, fmt.Sprintf(AgentUpgradeFailedMessageFmt, desiredVersion, err))
+		return nil
+	}
+	if updated {
+		klog.V(2).InfoS("Updating the member agent deployment", "deployment", deployKey, "desiredVersion", desiredVersion, "reason", reason)
+		if err := r.memberClient.Update(ctx, deploy); err != nil {
+			klog.ErrorS(err, "Failed to update the member agent deployment", "deployment", deployKey)
+			reportAgentUpgradedCondition(imc, metav1.ConditionFalse, AgentUpgradeFailedReason, fmt.Sprintf(AgentUpgradeFailedMessageFmt, desiredVersion, err))
+			return err
+		}
+	}
+	reportAgentUpgradedCondition(imc, status, reason, message)
+	return nil
+}
+
+// planAgentUpgrade decides the next step of upgrading the member agent Deployment to the desired
+// version; it mutates the Deployment in place and returns true if the Deployment needs an update.
+func planAgentUpgrade(deploy *appsv1.Deployment, containerName, desiredVersion string) (status metav1.ConditionStatus, reason, message string, updated bool, err error) {
+	idx := -1
+	for i := range deploy.Spec.Template.Spec.Containers {
+		if deploy.Spec.Template.Spec.Containers[i].Name == containerName {
+			idx = i
+			break
+		}
+	}
+	if idx == -1 {
+		return "", "", "", false, fmt.Errorf("container %s is not found in deployment %s", containerName, klog.KObj(deploy))
+	}
+	container := &deploy.Spec.Template.Spec.Containers[idx]
+	annotations := deploy.GetAnnotations()
+	if annotations == nil {
+		annotations = map[string]string{}
+	}
+
+	if imageTag(container.Image) != desiredVersion {
+		if annotations[agentFailedVersionAnnotation] == desiredVersion {
+			return metav1.ConditionFalse, AgentUpgradeRolledBackReason,
+				fmt.Sprintf(AgentUpgradeRolledBackMessageFmt, desiredVersion, container.Image), false, nil
+		}
+		// Roll out the desired version one pod at a time, keeping all the pods of the
+		// current version until the new pods become available.
+		deploy.Spec.Strategy = appsv1.DeploymentStrategy{
+			Type: appsv1.RollingUpdateDeploymentStrategyType,
+			RollingUpdate: &appsv1.RollingUpdateDeployment{
+				MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+				MaxSurge:       ptr.To(intstr.FromInt32(1)),
+			},
+		}
+		annotations[agentPreviousImageAnnotation] = container.Image
+		delete(annotations, agentFailedVersionAnnotation)
+		deploy.SetAnnotations(annotations)
+		container.Image = replaceImageTag(container.Image, desiredVersion)
+		return metav1.ConditionUnknown, AgentUpgradeInProgressReason, fmt.Sprintf(AgentUpgradeInProgressMessageFmt, desiredVersion), true, nil
+	}
+
+	previousImage, hasPreviousImage := annotations[agentPreviousImageAnnotation]
+	switch {
+	case isDeploymentRolloutStuck(deploy) && hasPreviousImage:
+		container.Image = previousImage
+		annotations[agentFailedVersionAnnotation] = desiredVersion
+		delete(annotations, agentPreviousImageAnnotation)
+		deploy.SetAnnotations(annotations)
+		return metav1.ConditionFalse, AgentUpgradeRolledBackReason,
+			fmt.Sprintf(AgentUpgradeRolledBackMessageFmt, desiredVersion, previousImage), true, nil
+	case !isDeploymentRolloutComplete(deploy):
+		return metav1.ConditionUnknown, AgentUpgradeInProgressReason, fmt.Sprintf(AgentUpgradeInProgressMessageFmt, desiredVersion), false, nil
+	case hasPreviousImage:
+		// The upgrade has completed; the previous image is no longer needed.
+		delete(annotations, agentPreviousImageAnnotation)
+		deploy.SetAnnotations(annotations)
+		return metav1.ConditionTrue, AgentUpgradeSucceededReason, fmt.Sprintf(AgentUpgradeSucceededMessageFmt, desiredVersion), true, nil
+	default:
+		return metav1.ConditionTrue, AgentUpgradeSucceededReason, fmt.Sprintf(AgentUpgradeSucceededMessageFmt, desiredVersion), false, nil
+	}
+}
+
+// isDeploymentRolloutComplete returns true if all the replicas of the Deployment run its latest
+// pod template and are available.
+func isDeploymentRolloutComplete(deploy *appsv1.Deployment) bool {
+	if deploy.Status.ObservedGeneration < deploy.Generation {
+		return false
+	}
+	replicas := int32(1)
+	if deploy.Spec.Replicas != nil {
+		replicas = *deploy.Spec.Replicas
+	}
+	return deploy.Status.UpdatedReplicas == replicas &&
+		deploy.Status.Replicas == replicas &&
+		deploy.Status.AvailableReplicas == replicas
+}
+
+// isDeploymentRolloutStuck returns true if the rollout of the Deployment has failed to make
+// progress before its deadline.
+func isDeploymentRolloutStuck(deploy *appsv1.Deployment) bool {
+	if deploy.Status.ObservedGeneration < deploy.Generation {
+		return false
+	}
+	for _, cond := range deploy.Status.Conditions {
+		if cond.Type == appsv1.DeploymentProgressing {
+			return cond.Reason == deploymentProgressDeadlineExceededReason
+		}
+	}
+	return false
+}
+
+// imageTag returns the tag of the given image reference, or an empty string if the image
+// reference has no tag.
+func imageTag(image string) string {
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		name = name[:i]
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		return name[i+1:]
+	}
+	return ""
+}
+
+// replaceImageTag returns the given image reference with its tag (and digest, if any) replaced
+// by the given tag.
+func replaceImageTag(image, tag string) string {
+	name := image
+	if i := strings.Index(name, "@"); i >= 0 {
+		name = name[:i]
+	}
+	if i := strings.LastIndex(name, ":"); i > strings.LastIndex(name, "/") {
+		name = name[:i]
+	}
+	return name + ":" + tag
+}
+
+func reportAgentUpgradedCondition(imc *clusterv1beta1.InternalMemberCluster, status metav1.ConditionStatus, reason, message string) {
+	imc.SetConditionsWithType(clusterv1beta1.MemberAgent, metav1.Condition{
+		Type:               string(clusterv1beta1.AgentUpgraded),
+		Status:             status,
+		Reason:             reason,
+		Message:            message,
+		ObservedGeneration: imc.GetGeneration(),
+	})
+}
@@ -0,0 +1,367 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+const (
+	agentDeploymentNamespace = "fleet-system"
+	agentDeploymentName      = "member-agent"
+	agentContainerName       = "member-agent"
+	agentImageRepository     = "myregistry.azurecr.io:5000/fleet/member-agent"
+)
+
+func agentDeployment(tag string, annotations map[string]string, status appsv1.DeploymentStatus) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   agentDeploymentNamespace,
+			Name:        agentDeploymentName,
+			Generation:  2,
+			Annotations: annotations,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(1)),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{Name: "refresh-token", Image: "myregistry.azurecr.io/fleet/refresh-token:v0.14.0"},
+						{Name: agentContainerName, Image: fmt.Sprintf("%s:%s", agentImageRepository, tag)},
+					},
+				},
+			},
+		},
+		Status: status,
+	}
+}
+
+var (
+	rolloutCompleteStatus = appsv1.DeploymentStatus{
+		ObservedGeneration: 2,
+		Replicas:           1,
+		UpdatedReplicas:    1,
+		AvailableReplicas:  1,
+	}
+	rolloutInProgressStatus = appsv1.DeploymentStatus{
+		ObservedGeneration: 2,
+		Replicas:           2,
+		UpdatedReplicas:    1,
+		AvailableReplicas:  1,
+	}
+	rolloutStuckStatus = appsv1.DeploymentStatus{
+		ObservedGeneration: 2,
+		Replicas:           2,
+		UpdatedReplicas:    1,
+		AvailableReplicas:  1,
+		Conditions: []appsv1.DeploymentCondition{
+			{
+				Type:   appsv1.DeploymentProgressing,
+				Status: corev1.ConditionFalse,
+				Reason: deploymentProgressDeadlineExceededReason,
+			},
+		},
+	}
+	canaryStrategy = appsv1.DeploymentStrategy{
+		Type: appsv1.RollingUpdateDeploymentStrategyType,
+		RollingUpdate: &appsv1.RollingUpdateDeployment{
+			MaxUnavailable: ptr.To(intstr.FromInt32(0)),
+			MaxSurge:       ptr.To(intstr.FromInt32(1)),
+		},
+	}
+)
+
+func TestImageTag(t *testing.T) {
+	testCases := []struct {
+		name        string
+		image       string
+		wantTag     string
+		wantReplace string
+	}{
+		{
+			name:        "tagged image",
+			image:       "ghcr.io/kubefleet-dev/kubefleet/member-agent:v0.14.0",
+			wantTag:     "v0.14.0",
+			wantReplace: "ghcr.io/kubefleet-dev/kubefleet/member-agent:v0.15.0",
+		},
+		{
+			name:        "tagged image from a registry with a port",
+			image:       "localhost:5000/member-agent:v0.14.0",
+			wantTag:     "v0.14.0",
+			wantReplace: "localhost:5000/member-agent:v0.15.0",
+		},
+		{
+			name:        "untagged image from a registry with a port",
+			image:       "localhost:5000/member-agent",
+			wantTag:     "",
+			wantReplace: "localhost:5000/member-agent:v0.15.0",
+		},
+		{
+			name:        "tagged image with a digest",
+			image:       "member-agent:v0.14.0@sha256:0123456789abcdef",
+			wantTag:     "v0.14.0",
+			wantReplace: "member-agent:v0.15.0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := imageTag(tc.image); got != tc.wantTag {
+				t.Errorf("imageTag(%s) = %s, want %s", tc.image, got, tc.wantTag)
+			}
+			if got := replaceImageTag(tc.image, "v0.15.0"); got != tc.wantReplace {
+				t.Errorf("replaceImageTag(%s) = %s, want %s", tc.image, got, tc.wantReplace)
+			}
+		})
+	}
+}
+
+func TestPlanAgentUpgrade(t *testing.T) {
+	previousImage := agentImageRepository + ":v0.14.0"
+
+	testCases := []struct {
+		name          string
+		deploy        *appsv1.Deployment
+		containerName string
+		wantDeploy    *appsv1.Deployment
+		wantStatus    metav1.ConditionStatus
+		wantReason    string
+		wantUpdated   bool
+		wantErred     bool
+	}{
+		{
+			name:          "container not found",
+			deploy:        agentDeployment("v0.14.0", nil, rolloutCompleteStatus),
+			containerName: "unknown",
+			wantErred:     true,
+		},
+		{
+			name:          "start the upgrade",
+			deploy:        agentDeployment("v0.14.0", map[string]string{agentFailedVersionAnnotation: "v0.14.1"}, rolloutCompleteStatus),
+			containerName: agentContainerName,
+			wantDeploy: func() *appsv1.Deployment {
+				d := agentDeployment("v0.15.0", map[string]string{agentPreviousImageAnnotation: previousImage}, rolloutCompleteStatus)
+				d.Spec.Strategy = canaryStrategy
+				return d
+			}(),
+			wantStatus:  metav1.ConditionUnknown,
+			wantReason:  AgentUpgradeInProgressReason,
+			wantUpdated: true,
+		},
+		{
+			name:          "do not retry a failed version",
+			deploy:        agentDeployment("v0.14.0", map[string]string{agentFailedVersionAnnotation: "v0.15.0"}, rolloutStuckStatus),
+			containerName: agentContainerName,
+			wantDeploy:    agentDeployment("v0.14.0", map[string]string{agentFailedVersionAnnotation: "v0.15.0"}, rolloutStuckStatus),
+			wantStatus:    metav1.ConditionFalse,
+			wantReason:    AgentUpgradeRolledBackReason,
+		},
+		{
+			name:          "upgrade in progress",
+			deploy:        agentDeployment("v0.15.0", map[string]string{agentPreviousImageAnnotation: previousImage}, rolloutInProgressStatus),
+			containerName: agentContainerName,
+			wantDeploy:    agentDeployment("v0.15.0", map[string]string{agentPreviousImageAnnotation: previousImage}, rolloutInProgressStatus),
+			wantStatus:    metav1.ConditionUnknown,
+			wantReason:    AgentUpgradeInProgressReason,
+		},
+		{
+			name:          "roll back a stuck upgrade",
+			deploy:        agentDeployment("v0.15.0", map[string]string{agentPreviousImageAnnotation: previousImage}, rolloutStuckStatus),
+			containerName: agentContainerName,
+			wantDeploy:    agentDeployment("v0.14.0", map[string]string{agentFailedVersionAnnotation: "v0.15.0"}, rolloutStuckStatus),
+			wantStatus:    metav1.ConditionFalse,
+			wantReason:    AgentUpgradeRolledBackReason,
+			wantUpdated:   true,
+		},
+		{
+			name:          "upgrade completed",
+			deploy:        agentDeployment("v0.15.0", map[string]string{agentPreviousImageAnnotation: previousImage}, rolloutCompleteStatus),
+			containerName: agentContainerName,
+			wantDeploy:    agentDeployment("v0.15.0", map[string]string{}, rolloutCompleteStatus),
+			wantStatus:    metav1.ConditionTrue,
+			wantReason:    AgentUpgradeSucceededReason,
+			wantUpdated:   true,
+		},
+		{
+			name:          "already up to date",
+			deploy:        agentDeployment("v0.15.0", nil, rolloutCompleteStatus),
+			containerName: agentContainerName,
+			wantDeploy:    agentDeployment("v0.15.0", nil, rolloutCompleteStatus),
+			wantStatus:    metav1.ConditionTrue,
+			wantReason:    AgentUpgradeSucceededReason,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotStatus, gotReason, _, gotUpdated, err := planAgentUpgrade(tc.deploy, tc.containerName, "v0.15.0")
+			if tc.wantErred {
+				if err == nil {
+					t.Fatalf("planAgentUpgrade() = nil, want erred")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("planAgentUpgrade() = %v, want no error", err)
+			}
+			if gotStatus != tc.wantStatus || gotReason != tc.wantReason || gotUpdated != tc.wantUpdated {
+				t.Errorf("planAgentUpgrade() = (%s, %s, %t), want (%s, %s, %t)", gotStatus, gotReason, gotUpdated, tc.wantStatus, tc.wantReason, tc.wantUpdated)
+			}
+			if diff := cmp.Diff(tc.wantDeploy, tc.deploy, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("planAgentUpgrade() deployment mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncAgentUpgrade(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := appsv1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme (appsv1): %v", err)
+	}
+
+	testCases := []struct {
+		name             string
+		desiredVersion   string
+		disabled         bool
+		deploy           *appsv1.Deployment
+		wantCondition    *metav1.Condition
+		wantImage        string
+		wantErred        bool
+		wantNoConditions bool
+	}{
+		{
+			name:             "no desired version",
+			deploy:           agentDeployment("v0.14.0", nil, rolloutCompleteStatus),
+			wantNoConditions: true,
+			wantImage:        agentImageRepository + ":v0.14.0",
+		},
+		{
+			name:           "agent upgrade disabled",
+			desiredVersion: "v0.15.0",
+			disabled:       true,
+			deploy:         agentDeployment("v0.14.0", nil, rolloutCompleteStatus),
+			wantCondition: &metav1.Condition{
+				Type:    string(clusterv1beta1.AgentUpgraded),
+				Status:  metav1.ConditionFalse,
+				Reason:  AgentUpgradeDisabledReason,
+				Message: AgentUpgradeDisabledMessage,
+			},
+			wantImage: agentImageRepository + ":v0.14.0",
+		},
+		{
+			name:           "deployment not found",
+			desiredVersion: "v0.15.0",
+			wantCondition: &metav1.Condition{
+				Type:   string(clusterv1beta1.AgentUpgraded),
+				Status: metav1.ConditionFalse,
+				Reason: AgentUpgradeFailedReason,
+			},
+			wantErred: true,
+		},
+		{
+			name:           "start the upgrade",
+			desiredVersion: "v0.15.0",
+			deploy:         agentDeployment("v0.14.0", nil, rolloutCompleteStatus),
+			wantCondition: &metav1.Condition{
+				Type:    string(clusterv1beta1.AgentUpgraded),
+				Status:  metav1.ConditionUnknown,
+				Reason:  AgentUpgradeInProgressReason,
+				Message: fmt.Sprintf(AgentUpgradeInProgressMessageFmt, "v0.15.0"),
+			},
+			wantImage: agentImageRepository + ":v0.15.0",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClientBuilder := fake.NewClientBuilder().WithScheme(scheme)
+			if tc.deploy != nil {
+				fakeClientBuilder.WithObjects(tc.deploy)
+			}
+			fakeClient := fakeClientBuilder.Build()
+			r := &Reconciler{memberClient: fakeClient}
+			if !tc.disabled {
+				r.agentUpgradeCfg = &AgentUpgradeConfig{
+					Reader:              fakeClient,
+					DeploymentNamespace: agentDeploymentNamespace,
+					DeploymentName:      agentDeploymentName,
+					ContainerName:       agentContainerName,
+				}
+			}
+			imc := &clusterv1beta1.InternalMemberCluster{
+				ObjectMeta: metav1.ObjectMeta{Name: imcName},
+				Spec:       clusterv1beta1.InternalMemberClusterSpec{DesiredAgentVersion: tc.desiredVersion},
+			}
+
+			err := r.syncAgentUpgrade(context.Background(), imc)
+			if gotErred := err != nil; gotErred != tc.wantErred {
+				t.Fatalf("syncAgentUpgrade() = %v, want erred %t", err, tc.wantErred)
+			}
+
+			gotCondition := imc.GetConditionWithType(clusterv1beta1.MemberAgent, string(clusterv1beta1.AgentUpgraded))
+			if tc.wantNoConditions {
+				if gotCondition != nil {
+					t.Errorf("syncAgentUpgrade() set condition %v, want none", gotCondition)
+				}
+			} else {
+				ignoreOpts := []cmp.Option{cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")}
+				if tc.wantErred {
+					ignoreOpts = append(ignoreOpts, cmpopts.IgnoreFields(metav1.Condition{}, "Message"))
+				}
+				if diff := cmp.Diff(tc.wantCondition, gotCondition, ignoreOpts...); diff != "" {
+					t.Errorf("syncAgentUpgrade() condition mismatch (-want, +got):\n%s", diff)
+				}
+			}
+
+			if tc.deploy == nil {
+				return
+			}
+			gotDeploy := &appsv1.Deployment{}
+			if err := fakeClient.Get(context.Background(), types.NamespacedName{Namespace: agentDeploymentNamespace, Name: agentDeploymentName}, gotDeploy); err != nil {
+				t.Fatalf("failed to get the deployment: %v", err)
+			}
+			if gotImage := gotDeploy.Spec.Template.Spec.Containers[1].Image; gotImage != tc.wantImage {
+				t.Errorf("syncAgentUpgrade() deployment image = %s, want %s", gotImage, tc.wantImage)
+			}
+		})
+	}
+}
+
+func TestReportAgentVersion(t *testing.T) {
+	imc := &clusterv1beta1.InternalMemberCluster{}
+	reportAgentVersion(imc)
+	agentStatus := imc.GetAgentStatus(clusterv1beta1.MemberAgent)
+	if agentStatus.Version == "" {
+		t.Errorf("reportAgentVersion() reported an empty version, want a version")
+	}
+}
@@ -28,12 +28,11 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	utilrand "k8s.io/apimachinery/pkg/util/rand"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -42,8 +41,12 @@ import (
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	sharedmetrics "github.com/kubefleet-dev/kubefleet/pkg/metrics/shared"
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/connectivity"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/backoff"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/events"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/hubconnectivity"
 )
 
 // propertyProviderConfig is a group of settings for configuring the the property provider.
@@ -109,6 +112,19 @@ type Reconciler struct {
 	propertyProviderCfg *propertyProviderConfig
 
 	recorder record.EventRecorder
+
+	// The tracker of the failures the member agent encounters when connecting to the hub cluster;
+	// the failures are reported in the status of the InternalMemberCluster object. It is nil if
+	// the failures are not tracked.
+	hubConnectivityTracker *hubconnectivity.Tracker
+
+	// The prober that checks whether the member cluster can reach the endpoints it depends on; the
+	// results are reported as cluster properties. It is nil if no endpoint is probed.
+	connectivityProber *connectivity.Prober
+
+	// The configuration that allows the member agent to upgrade itself to the version desired by
+	// the hub cluster. It is nil if agent upgrade is not enabled.
+	agentUpgradeCfg *AgentUpgradeConfig
 }
 
 const (
@@ -128,6 +144,11 @@ const (
 	ClusterPropertyCollectionSucceededReason           = "PropertiesCollected"
 	ClusterPropertyCollectionSucceededMessage          = "The property provider has returned the latest cluster properties"
 
+	// The condition information for reporting if the member agent has encountered failures when connecting to the hub cluster.
+	HubConnectivityNoFailureReason     = "NoFailureSinceLastHeartbeat"
+	HubConnectivityNoFailureMessage    = "The member agent has not encountered any failure when connecting to the hub cluster since its last heartbeat"
+	HubConnectivityRecoveredMessageFmt = "The member agent has recovered from a failure when connecting to the hub cluster at %s: %s"
+
 	// EventReasonInternalMemberClusterHealthy is the event type and reason string when the agent is healthy.
 	EventReasonInternalMemberClusterHealthy = "InternalMemberClusterHealthy"
 	// EventReasonInternalMemberClusterUnhealthy is the event type and reason string when the agent is unhealthy.
@@ -178,6 +199,9 @@ func NewReconciler(globalCtx context.Context,
 	memberClient client.Client,
 	workController controller.MemberController,
 	propertyProvider propertyprovider.PropertyProvider,
+	hubConnectivityTracker *hubconnectivity.Tracker,
+	connectivityProber *connectivity.Prober,
+	agentUpgradeCfg *AgentUpgradeConfig,
 ) (*Reconciler, error) {
 	rawMemberClientSet, err := kubernetes.NewForConfig(memberCfg)
 	if err != nil {
@@ -194,6 +218,9 @@ func NewReconciler(globalCtx context.Context,
 			memberConfig:     memberCfg,
 			propertyProvider: propertyProvider,
 		},
+		hubConnectivityTracker: hubConnectivityTracker,
+		connectivityProber:     connectivityProber,
+		agentUpgradeCfg:        agentUpgradeCfg,
 	}, nil
 }
 
@@ -210,17 +237,26 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		klog.ErrorS(err, "Failed to get internal member cluster", "internalMemberCluster", req.NamespacedName)
 		return ctrl.Result{}, client.IgnoreNotFound(err)
 	}
+	// Keep the copy as read, so that only the status changes made in this reconciliation are sent.
+	original := imc.DeepCopy()
 
 	switch imc.Spec.State {
 	case clusterv1beta1.ClusterStateJoin:
-		if err := r.startAgents(ctx, &imc); err != nil {
+		if err := r.startAgents(ctx, &imc, original); err != nil {
 			return ctrl.Result{}, err
 		}
+		r.syncWorkApplyConcurrency(&imc)
+		// Report the hub connectivity before refreshing the heartbeat, as the report concerns
+		// the failures since the last heartbeat.
+		r.reportHubConnectivity(&imc)
 		updateMemberAgentHeartBeat(&imc)
+		reportAgentVersion(&imc)
+		agentUpgradeErr := r.syncAgentUpgrade(ctx, &imc)
 		updateHealthErr := r.updateHealth(ctx, &imc)
 		clusterPropertyCollectionErr := r.connectToPropertyProvider(ctx, &imc)
+		r.reportConnectivityProperties(&imc)
 		r.markInternalMemberClusterJoined(&imc)
-		if err := r.updateInternalMemberClusterWithRetry(ctx, &imc); err != nil {
+		if err := r.patchInternalMemberClusterStatusWithRetry(ctx, &imc, original); err != nil {
 			if apierrors.IsConflict(err) {
 				klog.V(2).InfoS("Failed to update status due to conflicts", "imc", klog.KObj(&imc))
 			} else {
@@ -236,17 +272,19 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 			klog.ErrorS(clusterPropertyCollectionErr, "Failed to collect cluster properties", "imc", klog.KObj(&imc))
 			return ctrl.Result{}, clusterPropertyCollectionErr
 		}
+		if agentUpgradeErr != nil {
+			klog.ErrorS(agentUpgradeErr, "Failed to sync the agent upgrade", "imc", klog.KObj(&imc))
+			return ctrl.Result{}, agentUpgradeErr
+		}
 		// add jitter to the heart beat to mitigate the herding of multiple agents
-		hbinterval := 1000 * imc.Spec.HeartbeatPeriodSeconds
-		jitterRange := int64(hbinterval*jitterPercent) / 100
-		return ctrl.Result{RequeueAfter: time.Millisecond *
-			(time.Duration(hbinterval) + time.Duration(utilrand.Int63nRange(0, jitterRange)-jitterRange/2))}, nil
+		hbinterval := time.Second * time.Duration(imc.Spec.HeartbeatPeriodSeconds)
+		return ctrl.Result{RequeueAfter: backoff.SymmetricJitter(jitterPercent)(hbinterval)}, nil
 	case clusterv1beta1.ClusterStateLeave:
-		if err := r.stopAgents(ctx, &imc); err != nil {
+		if err := r.stopAgents(ctx, &imc, original); err != nil {
 			return ctrl.Result{}, err
 		}
 		r.markInternalMemberClusterLeft(&imc)
-		if err := r.updateInternalMemberClusterWithRetry(ctx, &imc); err != nil {
+		if err := r.patchInternalMemberClusterStatusWithRetry(ctx, &imc, original); err != nil {
 			if apierrors.IsConflict(err) {
 				klog.V(2).InfoS("Failed to update status due to conflicts", "imc", klog.KObj(&imc))
 			} else {
@@ -263,24 +301,35 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 }
 
 // startAgents start all the member agents running on the member cluster
-func (r *Reconciler) startAgents(ctx context.Context, imc *clusterv1beta1.InternalMemberCluster) error {
+func (r *Reconciler) startAgents(ctx context.Context, imc, original *clusterv1beta1.InternalMemberCluster) error {
 	// TODO: handle all the controllers uniformly if we have more
 	if err := r.workController.Join(ctx); err != nil {
 		r.markInternalMemberClusterJoinFailed(imc, err)
 		// ignore the update error since we will return an error anyway
-		_ = r.updateInternalMemberClusterWithRetry(ctx, imc)
+		_ = r.patchInternalMemberClusterStatusWithRetry(ctx, imc, original)
 		return err
 	}
 	return nil
 }
 
+// syncWorkApplyConcurrency applies the work apply concurrency set in the spec to the work controller,
+// and acknowledges the concurrency in effect in the status.
+func (r *Reconciler) syncWorkApplyConcurrency(imc *clusterv1beta1.InternalMemberCluster) {
+	tuner, ok := r.workController.(controller.ApplyConcurrencyTuner)
+	if !ok {
+		imc.Status.WorkApplyConcurrency = nil
+		return
+	}
+	imc.Status.WorkApplyConcurrency = ptr.To(tuner.SetApplyConcurrency(imc.Spec.WorkApplyConcurrency))
+}
+
 // stopAgents stops all the member agents running on the member cluster
-func (r *Reconciler) stopAgents(ctx context.Context, imc *clusterv1beta1.InternalMemberCluster) error {
+func (r *Reconciler) stopAgents(ctx context.Context, imc, original *clusterv1beta1.InternalMemberCluster) error {
 	// TODO: handle all the controllers uniformly if we have more
 	if err := r.workController.Leave(ctx); err != nil {
 		r.markInternalMemberClusterLeaveFailed(imc, err)
 		// ignore the update error since we will return an error anyway
-		_ = r.updateInternalMemberClusterWithRetry(ctx, imc)
+		_ = r.patchInternalMemberClusterStatusWithRetry(ctx, imc, original)
 		return err
 	}
 	return nil
@@ -551,19 +600,68 @@ func (r *Reconciler) updateResourceStats(ctx context.Context, imc *clusterv1beta
 	return nil
 }
 
-// updateInternalMemberClusterWithRetry updates InternalMemberCluster status.
-func (r *Reconciler) updateInternalMemberClusterWithRetry(ctx context.Context, imc *clusterv1beta1.InternalMemberCluster) error {
-	klog.V(2).InfoS("Updating InternalMemberCluster status with retries", "internalMemberCluster", klog.KObj(imc))
-	backOffPeriod := retry.DefaultBackoff
-	backOffPeriod.Cap = time.Second * time.Duration(imc.Spec.HeartbeatPeriodSeconds)
+// patchInternalMemberClusterStatusWithRetry patches the InternalMemberCluster status with the changes
+// made since the original copy was read.
+//
+// Patching the status (instead of updating the whole object) avoids conflicts with the spec changes made
+// on the hub cluster and keeps the heartbeats small. The status is not written by the member agent alone,
+// though (e.g., the fleet-networking agents report their agent statuses too), so the patch carries the
+// resource version of the original copy and fails with a conflict if any of them has written the status
+// since; the reconciliation is then requeued and works on a fresh copy.
+func (r *Reconciler) patchInternalMemberClusterStatusWithRetry(ctx context.Context, imc, original *clusterv1beta1.InternalMemberCluster) error {
+	klog.V(2).InfoS("Patching InternalMemberCluster status with retries", "internalMemberCluster", klog.KObj(imc))
+	backOffPeriod := backoff.DefaultAPIRetry.WithMax(time.Second * time.Duration(imc.Spec.HeartbeatPeriodSeconds))
+
+	return backoff.Retry(ctx, backOffPeriod, backoff.IsTransientAPIError, func() error {
+		_, err := controller.PatchStatus(ctx, r.hubClient, imc, original)
+		return err
+	})
+}
 
-	return retry.OnError(backOffPeriod,
-		func(err error) bool {
-			return apierrors.IsServiceUnavailable(err) || apierrors.IsServerTimeout(err) || apierrors.IsTooManyRequests(err)
-		},
-		func() error {
-			return r.hubClient.Status().Update(ctx, imc)
-		})
+// reportHubConnectivity reports whether the member agent has encountered failures when connecting to
+// the hub cluster since its last heartbeat.
+func (r *Reconciler) reportHubConnectivity(imc *clusterv1beta1.InternalMemberCluster) {
+	if r.hubConnectivityTracker == nil {
+		return
+	}
+
+	newCondition := metav1.Condition{
+		Type:               string(clusterv1beta1.AgentHubConnectivity),
+		Status:             metav1.ConditionTrue,
+		Reason:             HubConnectivityNoFailureReason,
+		Message:            HubConnectivityNoFailureMessage,
+		ObservedGeneration: imc.GetGeneration(),
+	}
+	var lastHeartbeat time.Time
+	if agentStatus := imc.GetAgentStatus(clusterv1beta1.MemberAgent); agentStatus != nil {
+		lastHeartbeat = agentStatus.LastReceivedHeartbeat.Time
+	}
+	if failure := r.hubConnectivityTracker.LastFailure(); failure != nil && failure.Time.After(lastHeartbeat) {
+		klog.V(2).InfoS("The member agent has encountered a failure when connecting to the hub cluster", "internalMemberCluster", klog.KObj(imc), "reason", failure.Reason, "time", failure.Time)
+		newCondition.Status = metav1.ConditionFalse
+		newCondition.Reason = failure.Reason
+		newCondition.Message = fmt.Sprintf(HubConnectivityRecoveredMessageFmt, failure.Time.UTC().Format(time.RFC3339), failure.Message)
+	}
+	imc.SetConditionsWithType(clusterv1beta1.MemberAgent, newCondition)
+}
+
+// reportConnectivityProperties adds the latest connectivity probe results to the cluster properties.
+func (r *Reconciler) reportConnectivityProperties(imc *clusterv1beta1.InternalMemberCluster) {
+	if r.connectivityProber == nil {
+		return
+	}
+
+	properties := r.connectivityProber.Properties()
+	if len(properties) == 0 {
+		return
+	}
+	if imc.Status.Properties == nil {
+		imc.Status.Properties = make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue, len(properties))
+	}
+	for name, val := range properties {
+		imc.Status.Properties[name] = val
+	}
+	klog.V(2).InfoS("Reported connectivity properties", "internalMemberCluster", klog.KObj(imc), "properties", len(properties))
 }
 
 // updateMemberAgentHeartBeat is used to update member agent heart beat for Internal member cluster.
@@ -695,7 +793,7 @@ func (r *Reconciler) markInternalMemberClusterLeaveFailed(imc clusterv1beta1.Con
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager, name string) error {
-	r.recorder = mgr.GetEventRecorderFor("v1beta1InternalMemberClusterController")
+	r.recorder = events.NewRateLimitedRecorder(mgr.GetEventRecorderFor("v1beta1InternalMemberClusterController"), events.DefaultDedupWindow)
 	return ctrl.NewControllerManagedBy(mgr).Named(name).
 		For(&clusterv1beta1.InternalMemberCluster{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
 		Complete(r)
@@ -0,0 +1,295 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1beta1
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/controllers/workapplier"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/faultinjection"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/hubconnectivity"
+)
+
+const (
+	latencyMemberName           = "cluster-latency"
+	latencyMemberReservedNSName = "fleet-member-cluster-latency"
+
+	partitionMemberName           = "cluster-partition"
+	partitionMemberReservedNSName = "fleet-member-cluster-partition"
+
+	clockSkewMemberName           = "cluster-clock-skew"
+	clockSkewMemberReservedNSName = "fleet-member-cluster-clock-skew"
+)
+
+// startMemberAgentWithFaults sets up an InternalMemberCluster object, and starts the
+// InternalMemberCluster controller for it with the given faults injected into its hub client.
+//
+// The controller runs against member cluster 2 until the returned function is called.
+func startMemberAgentWithFaults(memberName, reservedNSName string, heartbeatPeriodSeconds int32, faults faultinjection.Faults) (*faultinjection.Injector, context.CancelFunc) {
+	Expect(hubClient.Create(ctx, &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: reservedNSName}})).To(Succeed())
+	imc := &clusterv1beta1.InternalMemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      memberName,
+			Namespace: reservedNSName,
+		},
+		Spec: clusterv1beta1.InternalMemberClusterSpec{
+			State:                  clusterv1beta1.ClusterStateJoin,
+			HeartbeatPeriodSeconds: heartbeatPeriodSeconds,
+		},
+	}
+	Expect(hubClient.Create(ctx, imc)).To(Succeed())
+
+	// Wrap the fault injector before the hub connectivity tracker, as the member agent does, so
+	// that the injected partitions are tracked as connectivity failures.
+	injector := faultinjection.NewInjector(faults)
+	faultyHubCfg := rest.CopyConfig(hubCfg)
+	faultyHubCfg.Wrap(injector.WrapTransport)
+	tracker := hubconnectivity.NewTracker()
+	faultyHubCfg.Wrap(tracker.WrapTransport)
+
+	faultyHubClient, err := client.New(faultyHubCfg, client.Options{Scheme: scheme.Scheme})
+	Expect(err).NotTo(HaveOccurred())
+	mgr, err := ctrl.NewManager(faultyHubCfg, ctrl.Options{
+		Scheme: scheme.Scheme,
+		Metrics: server.Options{
+			BindAddress: "0",
+		},
+		Cache: cache.Options{
+			DefaultNamespaces: map[string]cache.Config{
+				reservedNSName: {},
+			},
+		},
+	})
+	Expect(err).NotTo(HaveOccurred())
+
+	// This controller is created for testing purposes only; no reconciliation loop is actually
+	// run.
+	workApplier := workapplier.NewReconciler(memberName+"-work-applier", faultyHubClient, reservedNSName, workapplier.DefaultTenant, nil, nil, nil, nil, 0, nil, time.Minute, nil, false, nil, nil, nil, nil, nil, nil, 0)
+	reconciler, err := NewReconciler(ctx, faultyHubClient, member2Cfg, member2Client, workApplier, nil, tracker, nil, nil)
+	Expect(err).NotTo(HaveOccurred())
+	Expect(reconciler.SetupWithManager(mgr, memberName+"-controller")).To(Succeed())
+
+	agentCtx, agentCancel := context.WithCancel(ctx)
+	go func() {
+		defer GinkgoRecover()
+		Expect(mgr.Start(agentCtx)).To(Succeed())
+	}()
+	return injector, agentCancel
+}
+
+// memberAgentStatus returns the status the member agent reports on an InternalMemberCluster object.
+func memberAgentStatus(memberName, reservedNSName string) (*clusterv1beta1.AgentStatus, error) {
+	imc := &clusterv1beta1.InternalMemberCluster{}
+	if err := hubClient.Get(ctx, types.NamespacedName{Name: memberName, Namespace: reservedNSName}, imc); err != nil {
+		return nil, fmt.Errorf("failed to get InternalMemberCluster: %w", err)
+	}
+	agentStatus := imc.GetAgentStatus(clusterv1beta1.MemberAgent)
+	if agentStatus == nil {
+		return nil, fmt.Errorf("member agent status not found")
+	}
+	return agentStatus, nil
+}
+
+// memberAgentConditionActual checks that the member agent reports a condition with the given
+// status and reason on an InternalMemberCluster object; the reason is not checked if empty.
+func memberAgentConditionActual(memberName, reservedNSName string, condType clusterv1beta1.AgentConditionType, status metav1.ConditionStatus, reason string) func() error {
+	return func() error {
+		agentStatus, err := memberAgentStatus(memberName, reservedNSName)
+		if err != nil {
+			return err
+		}
+		cond := meta.FindStatusCondition(agentStatus.Conditions, string(condType))
+		if cond == nil {
+			return fmt.Errorf("condition %s not found", condType)
+		}
+		if cond.Status != status || (reason != "" && cond.Reason != reason) {
+			return fmt.Errorf("condition %s has status %s and reason %s, want status %s and reason %s", condType, cond.Status, cond.Reason, status, reason)
+		}
+		return nil
+	}
+}
+
+var _ = Describe("Test InternalMemberCluster Controller with faults injected into the hub client", func() {
+	Context("Test latency", Ordered, func() {
+		var stopAgent context.CancelFunc
+
+		BeforeAll(func() {
+			_, stopAgent = startMemberAgentWithFaults(latencyMemberName, latencyMemberReservedNSName, 2, faultinjection.Faults{
+				Latency: 300 * time.Millisecond,
+			})
+		})
+
+		It("should join the cluster", func() {
+			Eventually(memberAgentConditionActual(latencyMemberName, latencyMemberReservedNSName, clusterv1beta1.AgentJoined, metav1.ConditionTrue, ""),
+				eventuallyTimeout, eventuallyInterval).Should(Succeed(), "Failed to join the cluster")
+			Eventually(memberAgentConditionActual(latencyMemberName, latencyMemberReservedNSName, clusterv1beta1.AgentHubConnectivity, metav1.ConditionTrue, HubConnectivityNoFailureReason),
+				eventuallyTimeout, eventuallyInterval).Should(Succeed(), "Failed to report no hub connectivity failure")
+		})
+
+		It("should keep sending heartbeats", func() {
+			agentStatus, err := memberAgentStatus(latencyMemberName, latencyMemberReservedNSName)
+			Expect(err).NotTo(HaveOccurred())
+			lastHeartbeat := agentStatus.LastReceivedHeartbeat
+
+			Eventually(func() error {
+				agentStatus, err := memberAgentStatus(latencyMemberName, latencyMemberReservedNSName)
+				if err != nil {
+					return err
+				}
+				if !agentStatus.LastReceivedHeartbeat.After(lastHeartbeat.Time) {
+					return fmt.Errorf("heartbeat has not been refreshed since %v", lastHeartbeat)
+				}
+				return nil
+			}, eventuallyTimeout, eventuallyInterval).Should(Succeed(), "Failed to send heartbeats")
+		})
+
+		AfterAll(func() {
+			stopAgent()
+		})
+	})
+
+	Context("Test network partition", Ordered, func() {
+		var (
+			injector       *faultinjection.Injector
+			stopAgent      context.CancelFunc
+			partitionStart time.Time
+			partitionEnd   time.Time
+		)
+
+		BeforeAll(func() {
+			// Use a longer heartbeat period, so that the condition reporting the recovery from the
+			// partition, which lasts until the next heartbeat, can be observed.
+			injector, stopAgent = startMemberAgentWithFaults(partitionMemberName, partitionMemberReservedNSName, 5, faultinjection.Faults{})
+		})
+
+		It("should join the cluster", func() {
+			Eventually(memberAgentConditionActual(partitionMemberName, partitionMemberReservedNSName, clusterv1beta1.AgentJoined, metav1.ConditionTrue, ""),
+				eventuallyTimeout, eventuallyInterval).Should(Succeed(), "Failed to join the cluster")
+			Eventually(memberAgentConditionActual(partitionMemberName, partitionMemberReservedNSName, clusterv1beta1.AgentHubConnectivity, metav1.ConditionTrue, HubConnectivityNoFailureReason),
+				eventuallyTimeout, eventuallyInterval).Should(Succeed(), "Failed to report no hub connectivity failure")
+		})
+
+		It("should stop sending heartbeats when partitioned", func() {
+			partitionStart = time.Now()
+			injector.SetFaults(faultinjection.Faults{
+				Partitions: []faultinjection.Period{{Start: 0, End: time.Hour}},
+			})
+
+			// Allow a request already in flight to complete.
+			Consistently(func() error {
+				agentStatus, err := memberAgentStatus(partitionMemberName, partitionMemberReservedNSName)
+				if err != nil {
+					return err
+				}
+				if agentStatus.LastReceivedHeartbeat.After(partitionStart.Add(time.Second)) {
+					return fmt.Errorf("heartbeat %v is received after the partition starts at %v", agentStatus.LastReceivedHeartbeat, partitionStart)
+				}
+				return nil
+			}, 12*time.Second, eventuallyInterval).Should(Succeed(), "Failed to stop sending heartbeats when partitioned")
+		})
+
+		It("should report the partition once it is over", func() {
+			partitionEnd = time.Now()
+			injector.SetFaults(faultinjection.Faults{})
+
+			Eventually(memberAgentConditionActual(partitionMemberName, partitionMemberReservedNSName, clusterv1beta1.AgentHubConnectivity, metav1.ConditionFalse, hubconnectivity.ConnectionRefusedReason),
+				eventuallyTimeout, eventuallyInterval).Should(Succeed(), "Failed to report the hub connectivity failure")
+
+			agentStatus, err := memberAgentStatus(partitionMemberName, partitionMemberReservedNSName)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(agentStatus.LastReceivedHeartbeat.After(partitionEnd.Add(-time.Second))).To(BeTrue(), "Heartbeat is not refreshed after the partition")
+		})
+
+		It("should report no failure with the next heartbeat", func() {
+			Eventually(memberAgentConditionActual(partitionMemberName, partitionMemberReservedNSName, clusterv1beta1.AgentHubConnectivity, metav1.ConditionTrue, HubConnectivityNoFailureReason),
+				eventuallyTimeout, eventuallyInterval).Should(Succeed(), "Failed to report no hub connectivity failure")
+			Expect(memberAgentConditionActual(partitionMemberName, partitionMemberReservedNSName, clusterv1beta1.AgentJoined, metav1.ConditionTrue, "")()).To(Succeed())
+		})
+
+		AfterAll(func() {
+			stopAgent()
+		})
+	})
+
+	Context("Test clock skew", Ordered, func() {
+		var stopAgent context.CancelFunc
+		clockSkew := -10 * time.Minute
+
+		BeforeAll(func() {
+			_, stopAgent = startMemberAgentWithFaults(clockSkewMemberName, clockSkewMemberReservedNSName, 2, faultinjection.Faults{
+				ClockSkew: clockSkew,
+			})
+		})
+
+		It("should join the cluster", func() {
+			Eventually(memberAgentConditionActual(clockSkewMemberName, clockSkewMemberReservedNSName, clusterv1beta1.AgentJoined, metav1.ConditionTrue, ""),
+				eventuallyTimeout, eventuallyInterval).Should(Succeed(), "Failed to join the cluster")
+		})
+
+		It("should report skewed heartbeats", func() {
+			Eventually(func() error {
+				agentStatus, err := memberAgentStatus(clockSkewMemberName, clockSkewMemberReservedNSName)
+				if err != nil {
+					return err
+				}
+				// Allow for the heartbeat period and the precision of the timestamps.
+				if offset := time.Until(agentStatus.LastReceivedHeartbeat.Time); offset < clockSkew-5*time.Second || offset > clockSkew+time.Second {
+					return fmt.Errorf("heartbeat %v is off by %v, want %v", agentStatus.LastReceivedHeartbeat, offset, clockSkew)
+				}
+				return nil
+			}, eventuallyTimeout, eventuallyInterval).Should(Succeed(), "Failed to report skewed heartbeats")
+		})
+
+		It("should make the cluster ineligible for placements on the hub cluster", func() {
+			imc := &clusterv1beta1.InternalMemberCluster{}
+			Expect(hubClient.Get(ctx, types.NamespacedName{Name: clockSkewMemberName, Namespace: clockSkewMemberReservedNSName}, imc)).To(Succeed())
+			mc := &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clockSkewMemberName,
+				},
+				Status: clusterv1beta1.MemberClusterStatus{
+					AgentStatus: imc.Status.AgentStatus,
+				},
+			}
+			// The heartbeats look older than the heartbeat check timeout on the hub cluster.
+			eligible, reason := clustereligibilitychecker.New().IsEligible(mc)
+			Expect(eligible).To(BeFalse(), "Cluster with a lagging clock is eligible")
+			Expect(reason).To(ContainSubstring("no recent heartbeat signals"))
+		})
+
+		AfterAll(func() {
+			stopAgent()
+		})
+	})
+})
@@ -190,6 +190,7 @@ var _ = Describe("Test InternalMemberCluster Controller", func() {
 				if diff := cmp.Diff(
 					imc.Status, wantIMCStatus,
 					ignoreAllTimeFields,
+					ignoreAgentVersionField,
 					sortByConditionType,
 				); diff != "" {
 					return fmt.Errorf("InternalMemberCluster status diff (-got, +want):\n%s", diff)
@@ -344,6 +345,7 @@ var _ = Describe("Test InternalMemberCluster Controller", func() {
 				if diff := cmp.Diff(
 					imc.Status, wantIMCStatus,
 					ignoreAllTimeFields,
+					ignoreAgentVersionField,
 					sortByConditionType,
 				); diff != "" {
 					return fmt.Errorf("InternalMemberCluster status diff (-got, +want):\n%s", diff)
@@ -474,6 +476,7 @@ var _ = Describe("Test InternalMemberCluster Controller", func() {
 				if diff := cmp.Diff(
 					imc.Status, wantIMCStatus,
 					ignoreAllTimeFields,
+					ignoreAgentVersionField,
 					sortByConditionType,
 				); diff != "" {
 					return fmt.Errorf("InternalMemberCluster status diff (-got, +want):\n%s", diff)
@@ -580,6 +583,7 @@ var _ = Describe("Test InternalMemberCluster Controller", func() {
 				if diff := cmp.Diff(
 					imc.Status, wantIMCStatus,
 					ignoreAllTimeFields,
+					ignoreAgentVersionField,
 					sortByConditionType,
 				); diff != "" {
 					return fmt.Errorf("InternalMemberCluster status diff (-got, +want):\n%s", diff)
@@ -722,6 +726,7 @@ var _ = Describe("Test InternalMemberCluster Controller", func() {
 				if diff := cmp.Diff(
 					imc.Status, wantIMCStatus,
 					ignoreAllTimeFields,
+					ignoreAgentVersionField,
 					sortByConditionType,
 				); diff != "" {
 					return fmt.Errorf("InternalMemberCluster status diff (-got, +want):\n%s", diff)
@@ -824,6 +829,7 @@ var _ = Describe("Test InternalMemberCluster Controller", func() {
 				if diff := cmp.Diff(
 					imc.Status, wantIMCStatus,
 					ignoreAllTimeFields,
+					ignoreAgentVersionField,
 					sortByConditionType,
 				); diff != "" {
 					return fmt.Errorf("InternalMemberCluster status diff (-got, +want):\n%s", diff)
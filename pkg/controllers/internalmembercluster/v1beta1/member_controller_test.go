@@ -20,6 +20,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net"
+	"strings"
 	"testing"
 	"time"
 
@@ -42,6 +44,7 @@ import (
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/hubconnectivity"
 )
 
 const (
@@ -71,6 +74,7 @@ const (
 var (
 	ignoreLTTConditionField = cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")
 	ignoreAllTimeFields     = cmpopts.IgnoreTypes(time.Time{}, metav1.Time{})
+	ignoreAgentVersionField = cmpopts.IgnoreFields(clusterv1beta1.AgentStatus{}, "Version", "GitCommit")
 
 	sortByConditionType = cmpopts.SortSlices(func(a, b metav1.Condition) bool {
 		return a.Type < b.Type
@@ -158,7 +162,7 @@ func TestMarkInternalMemberClusterHeartbeatUnhealthy(t *testing.T) {
 	assert.Equal(t, "", cmp.Diff(expectedCondition, *(actualCondition), cmpopts.IgnoreTypes(time.Time{})), utils.TestCaseMsg, "TestMarkInternalMemberClusterHeartbeatUnhealthy")
 }
 
-func TestUpdateInternalMemberClusterWithRetry(t *testing.T) {
+func TestPatchInternalMemberClusterStatusWithRetry(t *testing.T) {
 	lessRetriesForRetriable := 0
 	lessRetriesForNonRetriable := 0
 	moreRetriesForRetriable := 0
@@ -173,7 +177,7 @@ func TestUpdateInternalMemberClusterWithRetry(t *testing.T) {
 		"succeed without retries if no errors": {
 			retries: 0,
 			r: &Reconciler{hubClient: &test.MockClient{
-				MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				MockStatusPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
 					return nil
 				}}},
 			internalMemberCluster: &clusterv1beta1.InternalMemberCluster{},
@@ -181,7 +185,7 @@ func TestUpdateInternalMemberClusterWithRetry(t *testing.T) {
 		},
 		"succeed with retries for retriable errors: TooManyRequests": {
 			r: &Reconciler{hubClient: &test.MockClient{
-				MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				MockStatusPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
 					lessRetriesForRetriable++
 					if lessRetriesForRetriable >= 3 {
 						return nil
@@ -195,7 +199,7 @@ func TestUpdateInternalMemberClusterWithRetry(t *testing.T) {
 		},
 		"fail without retries for non-retriable errors: Invalid": {
 			r: &Reconciler{hubClient: &test.MockClient{
-				MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				MockStatusPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
 					lessRetriesForNonRetriable++
 					if lessRetriesForNonRetriable >= 3 {
 						return nil
@@ -207,7 +211,7 @@ func TestUpdateInternalMemberClusterWithRetry(t *testing.T) {
 		},
 		"fail if too many retries for retirable errors: TooManyRequests": {
 			r: &Reconciler{hubClient: &test.MockClient{
-				MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				MockStatusPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
 					moreRetriesForRetriable++
 					if moreRetriesForRetriable >= 100 {
 						return nil
@@ -221,7 +225,11 @@ func TestUpdateInternalMemberClusterWithRetry(t *testing.T) {
 
 	for testName, testCase := range testCases {
 		t.Run(testName, func(t *testing.T) {
-			err := testCase.r.updateInternalMemberClusterWithRetry(context.Background(), testCase.internalMemberCluster)
+			// The status patch carries the resource version of the original copy.
+			testCase.internalMemberCluster.ResourceVersion = "1"
+			original := testCase.internalMemberCluster.DeepCopy()
+			updateMemberAgentHeartBeat(testCase.internalMemberCluster)
+			err := testCase.r.patchInternalMemberClusterStatusWithRetry(context.Background(), testCase.internalMemberCluster, original)
 			assert.Equal(t, testCase.wantErr, err, utils.TestCaseMsg, testName)
 		})
 	}
@@ -1468,3 +1476,75 @@ func TestConnectToPropertyProvider(t *testing.T) {
 		})
 	}
 }
+
+func TestReportHubConnectivity(t *testing.T) {
+	dnsErr := &net.DNSError{Err: "no such host", Name: "hub.example.com", IsNotFound: true}
+	testCases := []struct {
+		name          string
+		failure       error
+		lastHeartbeat time.Time
+		wantCondition *metav1.Condition
+	}{
+		{
+			name:          "no failure",
+			lastHeartbeat: time.Now(),
+			wantCondition: &metav1.Condition{
+				Type:    string(clusterv1beta1.AgentHubConnectivity),
+				Status:  metav1.ConditionTrue,
+				Reason:  HubConnectivityNoFailureReason,
+				Message: HubConnectivityNoFailureMessage,
+			},
+		},
+		{
+			name:          "failure since last heartbeat",
+			failure:       dnsErr,
+			lastHeartbeat: time.Now().Add(-time.Minute),
+			wantCondition: &metav1.Condition{
+				Type:   string(clusterv1beta1.AgentHubConnectivity),
+				Status: metav1.ConditionFalse,
+				Reason: hubconnectivity.DNSResolutionFailedReason,
+			},
+		},
+		{
+			name:          "failure before last heartbeat",
+			failure:       dnsErr,
+			lastHeartbeat: time.Now().Add(time.Minute),
+			wantCondition: &metav1.Condition{
+				Type:    string(clusterv1beta1.AgentHubConnectivity),
+				Status:  metav1.ConditionTrue,
+				Reason:  HubConnectivityNoFailureReason,
+				Message: HubConnectivityNoFailureMessage,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			tracker := hubconnectivity.NewTracker()
+			if tc.failure != nil {
+				tracker.Record(tc.failure)
+			}
+			r := Reconciler{hubConnectivityTracker: tracker}
+			imc := &clusterv1beta1.InternalMemberCluster{
+				Status: clusterv1beta1.InternalMemberClusterStatus{
+					AgentStatus: []clusterv1beta1.AgentStatus{
+						{
+							Type:                  clusterv1beta1.MemberAgent,
+							LastReceivedHeartbeat: metav1.NewTime(tc.lastHeartbeat),
+						},
+					},
+				},
+			}
+
+			r.reportHubConnectivity(imc)
+
+			got := imc.GetConditionWithType(clusterv1beta1.MemberAgent, string(clusterv1beta1.AgentHubConnectivity))
+			if diff := cmp.Diff(got, tc.wantCondition, ignoreLTTConditionField, cmpopts.IgnoreFields(metav1.Condition{}, "Message")); diff != "" {
+				t.Errorf("reportHubConnectivity() condition mismatch (-got, +want):\n%s", diff)
+			}
+			if tc.wantCondition.Status == metav1.ConditionFalse && !strings.Contains(got.Message, dnsErr.Error()) {
+				t.Errorf("reportHubConnectivity() condition message = %q, want it to contain %q", got.Message, dnsErr.Error())
+			}
+		})
+	}
+}
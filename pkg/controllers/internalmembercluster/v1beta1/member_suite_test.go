@@ -379,10 +379,10 @@ var _ = BeforeSuite(func() {
 
 	// This controller is created for testing purposes only; no reconciliation loop is actually
 	// run.
-	workApplier1 = workapplier.NewReconciler("work-applier-1", hubClient, member1ReservedNSName, nil, nil, nil, nil, 0, nil, time.Minute, nil, false, nil, nil)
+	workApplier1 = workapplier.NewReconciler("work-applier-1", hubClient, member1ReservedNSName, workapplier.DefaultTenant, nil, nil, nil, nil, 0, nil, time.Minute, nil, false, nil, nil, nil, nil, nil, nil, 0)
 
 	propertyProvider1 = &manuallyUpdatedProvider{}
-	member1Reconciler, err := NewReconciler(ctx, hubClient, member1Cfg, member1Client, workApplier1, propertyProvider1)
+	member1Reconciler, err := NewReconciler(ctx, hubClient, member1Cfg, member1Client, workApplier1, propertyProvider1, nil, nil, nil)
 	Expect(err).NotTo(HaveOccurred())
 	Expect(member1Reconciler.SetupWithManager(member1Mgr, member1Name+"-controller")).To(Succeed())
 
@@ -402,9 +402,9 @@ var _ = BeforeSuite(func() {
 
 	// This controller is created for testing purposes only; no reconciliation loop is actually
 	// run.
-	workApplier2 = workapplier.NewReconciler("work-applier-2", hubClient, member2ReservedNSName, nil, nil, nil, nil, 0, nil, time.Minute, nil, false, nil, nil)
+	workApplier2 = workapplier.NewReconciler("work-applier-2", hubClient, member2ReservedNSName, workapplier.DefaultTenant, nil, nil, nil, nil, 0, nil, time.Minute, nil, false, nil, nil, nil, nil, nil, nil, 0)
 
-	member2Reconciler, err := NewReconciler(ctx, hubClient, member2Cfg, member2Client, workApplier2, nil)
+	member2Reconciler, err := NewReconciler(ctx, hubClient, member2Cfg, member2Client, workApplier2, nil, nil, nil, nil)
 	Expect(err).NotTo(HaveOccurred())
 	Expect(member2Reconciler.SetupWithManager(member2Mgr, member2Name+"-controller")).To(Succeed())
 
@@ -0,0 +1,353 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package janitor features a runnable that periodically detects (and optionally deletes) placement-owned
+// objects, i.e., bindings, snapshots, and works, whose parent placements no longer exist.
+//
+// Such objects should have been cleaned up by the placement controllers when the placements are
+// deleted; they could only be left behind by bugs or manual interventions (e.g., force removal
+// of finalizers).
+//
+// If resource content deduplication is enabled, the janitor also garbage collects the
+// ClusterResourceContent objects that no resource snapshot refers to any more.
+package janitor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	hubmetrics "github.com/kubefleet-dev/kubefleet/pkg/metrics/hub"
+)
+
+const (
+	// orphanGracePeriod is the minimum age of an object before the janitor considers it an orphan; this helps
+	// avoid racing with placements (and their owned objects) that are being created or deleted.
+	orphanGracePeriod = 5 * time.Minute
+)
+
+// make sure that our Janitor implements controller runtime interfaces
+var (
+	_ manager.Runnable               = &Janitor{}
+	_ manager.LeaderElectionRunnable = &Janitor{}
+)
+
+// Janitor periodically detects placement-owned objects whose parent placements no longer exist, reports
+// them via metrics, and deletes them unless it runs in the dry-run mode.
+type Janitor struct {
+	// Client is the client the janitor uses to read and delete objects.
+	Client client.Client
+
+	// Interval is the period between two sweeps.
+	Interval time.Duration
+
+	// DryRun, if set, makes the janitor only report orphaned objects without deleting them.
+	DryRun bool
+
+	// EnableResourcePlacement, if set, makes the janitor also look for orphaned objects of
+	// namespace-scoped ResourcePlacements.
+	EnableResourcePlacement bool
+
+	// EnableResourceContentDeduplication, if set, makes the janitor also delete the ClusterResourceContent
+	// objects that no resource snapshot refers to; in the dry-run mode, they are only reported.
+	EnableResourceContentDeduplication bool
+}
+
+// orphanKind describes a kind of placement-owned objects that the janitor examines.
+type orphanKind struct {
+	// kind is the Kind of the objects, which is also used as a metric label.
+	kind string
+	// newList returns an empty list of the objects.
+	newList func() client.ObjectList
+	// isNamespaced is true if the objects are owned by namespace-scoped ResourcePlacements.
+	isNamespaced bool
+}
+
+// Start runs the janitor until the context is cancelled. This is called by the controller manager.
+func (j *Janitor) Start(ctx context.Context) error {
+	klog.InfoS("Starting the orphaned resource janitor", "interval", j.Interval, "dryRun", j.DryRun)
+	defer klog.Info("The orphaned resource janitor is stopped")
+
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := j.sweep(ctx); err != nil {
+			klog.ErrorS(err, "Failed to clean up orphaned resources")
+		}
+	}, j.Interval)
+	return nil
+}
+
+// NeedLeaderElection implements the LeaderElectionRunnable interface, so that only the leader
+// hub agent cleans up orphaned objects.
+func (j *Janitor) NeedLeaderElection() bool {
+	return true
+}
+
+// kinds returns the kinds of placement-owned objects the janitor examines.
+func (j *Janitor) kinds() []orphanKind {
+	kinds := []orphanKind{
+		{kind: placementv1beta1.ClusterResourceBindingKind, newList: func() client.ObjectList { return &placementv1beta1.ClusterResourceBindingList{} }},
+		{kind: placementv1beta1.ClusterResourceSnapshotKind, newList: func() client.ObjectList { return &placementv1beta1.ClusterResourceSnapshotList{} }},
+		{kind: placementv1beta1.ClusterSchedulingPolicySnapshotKind, newList: func() client.ObjectList { return &placementv1beta1.ClusterSchedulingPolicySnapshotList{} }},
+	}
+	if j.EnableResourcePlacement {
+		kinds = append(kinds,
+			orphanKind{kind: placementv1beta1.ResourceBindingKind, newList: func() client.ObjectList { return &placementv1beta1.ResourceBindingList{} }, isNamespaced: true},
+			orphanKind{kind: placementv1beta1.ResourceSnapshotKind, newList: func() client.ObjectList { return &placementv1beta1.ResourceSnapshotList{} }, isNamespaced: true},
+			orphanKind{kind: placementv1beta1.SchedulingPolicySnapshotKind, newList: func() client.ObjectList { return &placementv1beta1.SchedulingPolicySnapshotList{} }, isNamespaced: true},
+		)
+	}
+	return kinds
+}
+
+// sweep runs one round of orphaned object detection and cleanup.
+func (j *Janitor) sweep(ctx context.Context) error {
+	startTime := time.Now()
+	klog.V(2).InfoS("Sweep for orphaned resources starts", "dryRun", j.DryRun)
+	defer func() {
+		klog.V(2).InfoS("Sweep for orphaned resources ends", "latency", time.Since(startTime).Milliseconds())
+	}()
+
+	// List the owned objects before the placements; any object created after the placement
+	// list is retrieved could belong to a new placement that is not in the list.
+	kinds := j.kinds()
+	ownedObjs := make([][]client.Object, len(kinds))
+	for i, k := range kinds {
+		objs, err := j.listObjects(ctx, k.newList())
+		if err != nil {
+			return err
+		}
+		ownedObjs[i] = objs
+	}
+	works, err := j.listObjects(ctx, &placementv1beta1.WorkList{})
+	if err != nil {
+		return err
+	}
+
+	placements, err := j.listPlacements(ctx)
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	for i, k := range kinds {
+		var orphans []client.Object
+		for _, obj := range ownedObjs[i] {
+			placementName, ok := obj.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+			if !ok {
+				continue
+			}
+			placementNamespace := ""
+			if k.isNamespaced {
+				placementNamespace = obj.GetNamespace()
+			}
+			if isOrphan(obj, types.NamespacedName{Namespace: placementNamespace, Name: placementName}, placements, startTime) {
+				orphans = append(orphans, obj)
+			}
+		}
+		errs = append(errs, j.handleOrphans(ctx, k.kind, orphans))
+	}
+
+	// Works live in the reserved namespaces of member clusters; for works created for ResourcePlacements,
+	// the namespace of the parent placement is tracked by a separate label.
+	var orphanedWorks []client.Object
+	for _, work := range works {
+		placementName, ok := work.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+		if !ok {
+			continue
+		}
+		placementNamespace := work.GetLabels()[placementv1beta1.ParentNamespaceLabel]
+		if placementNamespace != "" && !j.EnableResourcePlacement {
+			continue
+		}
+		if isOrphan(work, types.NamespacedName{Namespace: placementNamespace, Name: placementName}, placements, startTime) {
+			orphanedWorks = append(orphanedWorks, work)
+		}
+	}
+	errs = append(errs, j.handleOrphans(ctx, placementv1beta1.WorkKind, orphanedWorks))
+
+	if j.EnableResourceContentDeduplication {
+		errs = append(errs, j.sweepResourceContents(ctx))
+	}
+	return errors.Join(errs...)
+}
+
+// sweepResourceContents deletes the ClusterResourceContent objects that no resource snapshot refers to
+// and that have not been referred to by a new resource snapshot within the grace period.
+func (j *Janitor) sweepResourceContents(ctx context.Context) error {
+	startTime := time.Now()
+
+	// List the resource snapshots before the contents; any content that is referred to by a resource
+	// snapshot created after the snapshot list is retrieved has been referred to recently.
+	referenced := make(map[string]bool)
+	snapshotLists := []placementv1beta1.ResourceSnapshotObjList{
+		&placementv1beta1.ClusterResourceSnapshotList{},
+		&placementv1beta1.ResourceSnapshotList{},
+	}
+	for _, snapshotList := range snapshotLists {
+		if err := j.Client.List(ctx, snapshotList); err != nil {
+			klog.ErrorS(err, "Failed to list resource snapshots", "listType", fmt.Sprintf("%T", snapshotList))
+			return err
+		}
+		for _, snapshot := range snapshotList.GetResourceSnapshotObjs() {
+			for _, name := range snapshot.GetResourceSnapshotSpec().SelectedResourceContentNames {
+				referenced[name] = true
+			}
+		}
+	}
+	contents, err := j.listObjects(ctx, &placementv1beta1.ClusterResourceContentList{})
+	if err != nil {
+		return err
+	}
+
+	var errs []error
+	unreferencedCount := 0
+	for _, content := range contents {
+		if referenced[content.GetName()] || !isUnreferencedLongEnough(content, startTime) {
+			continue
+		}
+		unreferencedCount++
+		if j.DryRun {
+			klog.InfoS("Found an unreferenced resource content; skip deleting it in the dry-run mode", "resourceContent", klog.KObj(content))
+			continue
+		}
+		// The resource version precondition makes sure that the content is not deleted if its last referenced
+		// time has been refreshed for a new resource snapshot since it was listed.
+		preconditions := client.Preconditions{UID: ptr.To(content.GetUID()), ResourceVersion: ptr.To(content.GetResourceVersion())}
+		if err := j.Client.Delete(ctx, content, preconditions); err != nil {
+			switch {
+			case k8serrors.IsNotFound(err):
+			case k8serrors.IsConflict(err):
+				klog.V(2).InfoS("Skipped deleting a resource content that has been referenced again", "resourceContent", klog.KObj(content))
+				continue
+			default:
+				klog.ErrorS(err, "Failed to delete an unreferenced resource content", "resourceContent", klog.KObj(content))
+				errs = append(errs, err)
+				continue
+			}
+		}
+		klog.V(2).InfoS("Deleted an unreferenced resource content", "resourceContent", klog.KObj(content))
+		hubmetrics.FleetOrphanedResourceDeletedTotal.WithLabelValues(placementv1beta1.ClusterResourceContentKind).Inc()
+	}
+	hubmetrics.FleetOrphanedResourceCount.WithLabelValues(placementv1beta1.ClusterResourceContentKind).Set(float64(unreferencedCount))
+	return errors.Join(errs...)
+}
+
+// listObjects lists all the objects of a kind.
+func (j *Janitor) listObjects(ctx context.Context, list client.ObjectList) ([]client.Object, error) {
+	if err := j.Client.List(ctx, list); err != nil {
+		klog.ErrorS(err, "Failed to list objects", "listType", fmt.Sprintf("%T", list))
+		return nil, err
+	}
+	items, err := meta.ExtractList(list)
+	if err != nil {
+		return nil, err
+	}
+	objs := make([]client.Object, 0, len(items))
+	for _, item := range items {
+		obj, ok := item.(client.Object)
+		if !ok {
+			continue
+		}
+		objs = append(objs, obj)
+	}
+	return objs, nil
+}
+
+// listPlacements returns the keys of all the placements in the system.
+func (j *Janitor) listPlacements(ctx context.Context) (map[types.NamespacedName]bool, error) {
+	placements := make(map[types.NamespacedName]bool)
+	crpList := &placementv1beta1.ClusterResourcePlacementList{}
+	if err := j.Client.List(ctx, crpList); err != nil {
+		klog.ErrorS(err, "Failed to list clusterResourcePlacements")
+		return nil, err
+	}
+	for i := range crpList.Items {
+		placements[types.NamespacedName{Name: crpList.Items[i].Name}] = true
+	}
+	if !j.EnableResourcePlacement {
+		return placements, nil
+	}
+
+	rpList := &placementv1beta1.ResourcePlacementList{}
+	if err := j.Client.List(ctx, rpList); err != nil {
+		klog.ErrorS(err, "Failed to list resourcePlacements")
+		return nil, err
+	}
+	for i := range rpList.Items {
+		placements[types.NamespacedName{Namespace: rpList.Items[i].Namespace, Name: rpList.Items[i].Name}] = true
+	}
+	return placements, nil
+}
+
+// isOrphan returns true if an object refers to a placement that does not exist and has lived long enough.
+func isOrphan(obj client.Object, placementKey types.NamespacedName, placements map[types.NamespacedName]bool, now time.Time) bool {
+	if placements[placementKey] {
+		return false
+	}
+	if obj.GetDeletionTimestamp() != nil {
+		// The object is already being deleted.
+		return false
+	}
+	return now.Sub(obj.GetCreationTimestamp().Time) >= orphanGracePeriod
+}
+
+// isUnreferencedLongEnough returns true if a ClusterResourceContent object has not been referred to by a new
+// resource snapshot within the grace period.
+func isUnreferencedLongEnough(content client.Object, now time.Time) bool {
+	if content.GetDeletionTimestamp() != nil {
+		// The object is already being deleted.
+		return false
+	}
+	lastReferencedTime := content.GetCreationTimestamp().Time
+	if t, err := time.Parse(time.RFC3339, content.GetAnnotations()[placementv1beta1.ResourceContentLastReferencedTimeAnnotation]); err == nil && t.After(lastReferencedTime) {
+		lastReferencedTime = t
+	}
+	return now.Sub(lastReferencedTime) >= orphanGracePeriod
+}
+
+// handleOrphans reports the orphaned objects of a kind and deletes them if not in the dry-run mode.
+func (j *Janitor) handleOrphans(ctx context.Context, kind string, orphans []client.Object) error {
+	hubmetrics.FleetOrphanedResourceCount.WithLabelValues(kind).Set(float64(len(orphans)))
+
+	var errs []error
+	for _, obj := range orphans {
+		if j.DryRun {
+			klog.InfoS("Found an orphaned object; skip deleting it in the dry-run mode", "kind", kind, "object", klog.KObj(obj),
+				"placement", obj.GetLabels()[placementv1beta1.PlacementTrackingLabel])
+			continue
+		}
+		if err := j.Client.Delete(ctx, obj, client.Preconditions{UID: ptr.To(obj.GetUID())}); err != nil && !k8serrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete an orphaned object", "kind", kind, "object", klog.KObj(obj))
+			errs = append(errs, err)
+			continue
+		}
+		klog.InfoS("Deleted an orphaned object", "kind", kind, "object", klog.KObj(obj),
+			"placement", obj.GetLabels()[placementv1beta1.PlacementTrackingLabel])
+		hubmetrics.FleetOrphanedResourceDeletedTotal.WithLabelValues(kind).Inc()
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package janitor
+
+import (
+	"context"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	crpName        = "crp"
+	deletedCRPName = "deleted-crp"
+	rpName         = "rp"
+	deletedRPName  = "deleted-rp"
+	testNamespace  = "test-ns"
+	memberNS       = "fleet-member-cluster-1"
+)
+
+var (
+	oldTimestamp = metav1.NewTime(time.Now().Add(-time.Hour))
+)
+
+func ownedObjectMeta(name, namespace, placementName string, created metav1.Time) metav1.ObjectMeta {
+	return metav1.ObjectMeta{
+		Name:              name,
+		Namespace:         namespace,
+		CreationTimestamp: created,
+		Labels: map[string]string{
+			placementv1beta1.PlacementTrackingLabel: placementName,
+		},
+	}
+}
+
+func workWith(name, placementName, placementNamespace string) *placementv1beta1.Work {
+	work := &placementv1beta1.Work{
+		ObjectMeta: ownedObjectMeta(name, memberNS, placementName, oldTimestamp),
+	}
+	if placementNamespace != "" {
+		work.Labels[placementv1beta1.ParentNamespaceLabel] = placementNamespace
+	}
+	return work
+}
+
+func remainingNames(t *testing.T, c client.Client, list client.ObjectList) []string {
+	if err := c.List(context.Background(), list); err != nil {
+		t.Fatalf("failed to list objects: %v", err)
+	}
+	var names []string
+	switch l := list.(type) {
+	case *placementv1beta1.ClusterResourceBindingList:
+		for _, item := range l.Items {
+			names = append(names, item.Name)
+		}
+	case *placementv1beta1.ClusterResourceSnapshotList:
+		for _, item := range l.Items {
+			names = append(names, item.Name)
+		}
+	case *placementv1beta1.ResourceBindingList:
+		for _, item := range l.Items {
+			names = append(names, item.Name)
+		}
+	case *placementv1beta1.WorkList:
+		for _, item := range l.Items {
+			names = append(names, item.Name)
+		}
+	}
+	sort.Strings(names)
+	return names
+}
+
+// TestSweep tests the sweep method.
+func TestSweep(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+
+	newObjects := func() []client.Object {
+		return []client.Object{
+			&placementv1beta1.ClusterResourcePlacement{ObjectMeta: metav1.ObjectMeta{Name: crpName}},
+			&placementv1beta1.ResourcePlacement{ObjectMeta: metav1.ObjectMeta{Name: rpName, Namespace: testNamespace}},
+			&placementv1beta1.ClusterResourceBinding{ObjectMeta: ownedObjectMeta("crb-owned", "", crpName, oldTimestamp)},
+			&placementv1beta1.ClusterResourceBinding{ObjectMeta: ownedObjectMeta("crb-orphaned", "", deletedCRPName, oldTimestamp)},
+			// An orphaned binding that is too young to be cleaned up.
+			&placementv1beta1.ClusterResourceBinding{ObjectMeta: ownedObjectMeta("crb-orphaned-new", "", deletedCRPName, metav1.Now())},
+			&placementv1beta1.ClusterResourceSnapshot{ObjectMeta: ownedObjectMeta("crs-owned", "", crpName, oldTimestamp)},
+			&placementv1beta1.ClusterResourceSnapshot{ObjectMeta: ownedObjectMeta("crs-orphaned", "", deletedCRPName, oldTimestamp)},
+			&placementv1beta1.ResourceBinding{ObjectMeta: ownedObjectMeta("rb-owned", testNamespace, rpName, oldTimestamp)},
+			&placementv1beta1.ResourceBinding{ObjectMeta: ownedObjectMeta("rb-orphaned", testNamespace, deletedRPName, oldTimestamp)},
+			workWith("work-crp-owned", crpName, ""),
+			workWith("work-crp-orphaned", deletedCRPName, ""),
+			workWith("work-rp-owned", rpName, testNamespace),
+			workWith("work-rp-orphaned", deletedRPName, testNamespace),
+		}
+	}
+
+	testCases := []struct {
+		name                    string
+		dryRun                  bool
+		enableResourcePlacement bool
+		wantCRBs                []string
+		wantCRSs                []string
+		wantRBs                 []string
+		wantWorks               []string
+	}{
+		{
+			name:                    "dry run",
+			dryRun:                  true,
+			enableResourcePlacement: true,
+			wantCRBs:                []string{"crb-orphaned", "crb-orphaned-new", "crb-owned"},
+			wantCRSs:                []string{"crs-orphaned", "crs-owned"},
+			wantRBs:                 []string{"rb-orphaned", "rb-owned"},
+			wantWorks:               []string{"work-crp-orphaned", "work-crp-owned", "work-rp-orphaned", "work-rp-owned"},
+		},
+		{
+			name:                    "delete orphaned objects",
+			enableResourcePlacement: true,
+			wantCRBs:                []string{"crb-orphaned-new", "crb-owned"},
+			wantCRSs:                []string{"crs-owned"},
+			wantRBs:                 []string{"rb-owned"},
+			wantWorks:               []string{"work-crp-owned", "work-rp-owned"},
+		},
+		{
+			name:      "delete orphaned objects, resource placement disabled",
+			wantCRBs:  []string{"crb-orphaned-new", "crb-owned"},
+			wantCRSs:  []string{"crs-owned"},
+			wantRBs:   []string{"rb-orphaned", "rb-owned"},
+			wantWorks: []string{"work-crp-owned", "work-rp-orphaned", "work-rp-owned"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(newObjects()...).Build()
+			j := &Janitor{
+				Client:                  fakeClient,
+				DryRun:                  tc.dryRun,
+				EnableResourcePlacement: tc.enableResourcePlacement,
+			}
+			if err := j.sweep(context.Background()); err != nil {
+				t.Fatalf("sweep() = %v, want no error", err)
+			}
+
+			if diff := cmp.Diff(remainingNames(t, fakeClient, &placementv1beta1.ClusterResourceBindingList{}), tc.wantCRBs); diff != "" {
+				t.Errorf("clusterResourceBindings mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(remainingNames(t, fakeClient, &placementv1beta1.ClusterResourceSnapshotList{}), tc.wantCRSs); diff != "" {
+				t.Errorf("clusterResourceSnapshots mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(remainingNames(t, fakeClient, &placementv1beta1.ResourceBindingList{}), tc.wantRBs); diff != "" {
+				t.Errorf("resourceBindings mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(remainingNames(t, fakeClient, &placementv1beta1.WorkList{}), tc.wantWorks); diff != "" {
+				t.Errorf("works mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestSweepResourceContents tests the sweepResourceContents method.
+func TestSweepResourceContents(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	contentWith := func(name string, created metav1.Time, lastReferenced time.Time) *placementv1beta1.ClusterResourceContent {
+		content := &placementv1beta1.ClusterResourceContent{
+			ObjectMeta: metav1.ObjectMeta{Name: name, CreationTimestamp: created},
+		}
+		if !lastReferenced.IsZero() {
+			content.Annotations = map[string]string{
+				placementv1beta1.ResourceContentLastReferencedTimeAnnotation: lastReferenced.Format(time.RFC3339),
+			}
+		}
+		return content
+	}
+	objects := []client.Object{
+		&placementv1beta1.ClusterResourceSnapshot{
+			ObjectMeta: ownedObjectMeta("crs", "", crpName, oldTimestamp),
+			Spec:       placementv1beta1.ResourceSnapshotSpec{SelectedResourceContentNames: []string{"rc-referenced-by-crs"}},
+		},
+		&placementv1beta1.ResourceSnapshot{
+			ObjectMeta: ownedObjectMeta("rs", testNamespace, rpName, oldTimestamp),
+			Spec:       placementv1beta1.ResourceSnapshotSpec{SelectedResourceContentNames: []string{"rc-referenced-by-rs"}},
+		},
+		contentWith("rc-referenced-by-crs", oldTimestamp, time.Time{}),
+		contentWith("rc-referenced-by-rs", oldTimestamp, time.Time{}),
+		contentWith("rc-unreferenced", oldTimestamp, time.Time{}),
+		contentWith("rc-unreferenced-stale", oldTimestamp, time.Now().Add(-time.Hour)),
+		// Unreferenced contents that are created or referred to recently.
+		contentWith("rc-unreferenced-new", metav1.Now(), time.Time{}),
+		contentWith("rc-unreferenced-recently-referenced", oldTimestamp, time.Now()),
+	}
+
+	testCases := []struct {
+		name string
+		// refreshed is the name of a content whose last referenced time is refreshed after it is listed.
+		refreshed string
+		dryRun    bool
+		want      []string
+	}{
+		{
+			name: "unreferenced contents deleted",
+			want: []string{"rc-referenced-by-crs", "rc-referenced-by-rs", "rc-unreferenced-new", "rc-unreferenced-recently-referenced"},
+		},
+		{
+			name:      "content referenced again after being listed",
+			refreshed: "rc-unreferenced-stale",
+			want:      []string{"rc-referenced-by-crs", "rc-referenced-by-rs", "rc-unreferenced-new", "rc-unreferenced-recently-referenced", "rc-unreferenced-stale"},
+		},
+		{
+			name:   "dry run",
+			dryRun: true,
+			want:   []string{"rc-referenced-by-crs", "rc-referenced-by-rs", "rc-unreferenced", "rc-unreferenced-new", "rc-unreferenced-recently-referenced", "rc-unreferenced-stale"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).WithInterceptorFuncs(interceptor.Funcs{
+				List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+					if err := c.List(ctx, list, opts...); err != nil {
+						return err
+					}
+					if _, ok := list.(*placementv1beta1.ClusterResourceContentList); !ok || tc.refreshed == "" {
+						return nil
+					}
+					// Refresh the last referenced time of the content, as a new resource snapshot is being created.
+					content := &placementv1beta1.ClusterResourceContent{}
+					if err := c.Get(ctx, types.NamespacedName{Name: tc.refreshed}, content); err != nil {
+						return err
+					}
+					content.Annotations = map[string]string{
+						placementv1beta1.ResourceContentLastReferencedTimeAnnotation: time.Now().Format(time.RFC3339),
+					}
+					return c.Update(ctx, content)
+				},
+			}).Build()
+			j := &Janitor{
+				Client:                             fakeClient,
+				DryRun:                             tc.dryRun,
+				EnableResourceContentDeduplication: true,
+			}
+			if err := j.sweepResourceContents(context.Background()); err != nil {
+				t.Fatalf("sweepResourceContents() = %v, want no error", err)
+			}
+
+			contentList := &placementv1beta1.ClusterResourceContentList{}
+			if err := fakeClient.List(context.Background(), contentList); err != nil {
+				t.Fatalf("failed to list resource contents: %v", err)
+			}
+			var got []string
+			for _, item := range contentList.Items {
+				got = append(got, item.Name)
+			}
+			sort.Strings(got)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("clusterResourceContents mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -30,7 +30,6 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
-	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	runtime "sigs.k8s.io/controller-runtime"
@@ -44,9 +43,12 @@ import (
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	sharedmetrics "github.com/kubefleet-dev/kubefleet/pkg/metrics/shared"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/backoff"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/events"
 )
 
 const (
@@ -63,6 +65,10 @@ const (
 	reasonMemberClusterJoined         = "MemberClusterJoined"
 	reasonMemberClusterLeft           = "MemberClusterLeft"
 	reasonMemberClusterUnknown        = "MemberClusterJoinStateUnknown"
+
+	// eventReasonMemberClusterLeaving is the reason of the event emitted on each placement which will lose the
+	// leaving member cluster.
+	eventReasonMemberClusterLeaving = "MemberClusterLeaving"
 )
 
 // Reconciler reconciles a MemberCluster object
@@ -93,6 +99,8 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 		return runtime.Result{}, client.IgnoreNotFound(err)
 	}
 	mcObjRef := klog.KObj(&mc)
+	// Keep the copy as read, so that only the status changes made in this reconciliation are sent.
+	original := mc.DeepCopy()
 
 	// Handle deleting/leaving member cluster, garbage collect all the resources in the cluster namespace
 	if !mc.DeletionTimestamp.IsZero() {
@@ -123,7 +131,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 
 	// Copy status from InternalMemberCluster to MemberCluster.
 	r.syncInternalMemberClusterStatus(currentIMC, &mc)
-	if err := r.updateMemberClusterStatus(ctx, &mc); err != nil {
+	if err := r.updateMemberClusterStatus(ctx, &mc, original); err != nil {
 		if apierrors.IsConflict(err) {
 			klog.V(2).InfoS("Failed to update status due to conflicts", "memberCluster", mcObjRef)
 		} else {
@@ -139,6 +147,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 // then garbage collects all the resources in the cluster namespace.
 func (r *Reconciler) handleDelete(ctx context.Context, mc *clusterv1beta1.MemberCluster) (runtime.Result, error) {
 	mcObjRef := klog.KObj(mc)
+	original := mc.DeepCopy()
 	if !controllerutil.ContainsFinalizer(mc, placementv1beta1.MemberClusterFinalizer) {
 		klog.V(2).InfoS("No need to do anything for the deleting member cluster without a finalizer", "memberCluster", mcObjRef)
 		return runtime.Result{}, nil
@@ -185,7 +194,7 @@ func (r *Reconciler) handleDelete(ctx context.Context, mc *clusterv1beta1.Member
 		if gcErr := r.garbageCollect(ctx, mc); gcErr != nil {
 			return runtime.Result{}, gcErr
 		}
-		return runtime.Result{Requeue: true}, controller.NewUpdateIgnoreConflictError(r.updateMemberClusterStatus(ctx, mc))
+		return runtime.Result{Requeue: true}, controller.NewUpdateIgnoreConflictError(r.updateMemberClusterStatus(ctx, mc, original))
 	}
 	// check to see if we can force delete member cluster.
 	if currentImc.Spec.State == clusterv1beta1.ClusterStateLeave && time.Since(mc.DeletionTimestamp.Time) >= r.ForceDeleteWaitTime {
@@ -193,6 +202,10 @@ func (r *Reconciler) handleDelete(ctx context.Context, mc *clusterv1beta1.Member
 		return runtime.Result{Requeue: true}, r.garbageCollect(ctx, mc)
 	}
 	klog.V(2).InfoS("Need to wait for the agent to leave", "memberCluster", mcObjRef, "joinedCondition", mcJoinedCondition)
+	if currentImc.Spec.State != clusterv1beta1.ClusterStateLeave {
+		// only notify the placements once, when the member cluster starts to leave.
+		r.recordPlacementImpactEvents(ctx, mc)
+	}
 	// mark the imc as left to make sure the agent is leaving the fleet
 	if err := r.leave(ctx, mc, currentImc); err != nil {
 		klog.ErrorS(err, "Failed to mark the imc as leave", "memberCluster", mcObjRef)
@@ -201,7 +214,7 @@ func (r *Reconciler) handleDelete(ctx context.Context, mc *clusterv1beta1.Member
 	// update the mc status to track the leaving status while we wait for all the agents to leave.
 	// once the imc is updated, the mc controller will reconcile again ,or we reconcile to force delete
 	// the member cluster after force delete wait time.
-	return runtime.Result{RequeueAfter: r.ForceDeleteWaitTime}, controller.NewUpdateIgnoreConflictError(r.updateMemberClusterStatus(ctx, mc))
+	return runtime.Result{RequeueAfter: r.ForceDeleteWaitTime}, controller.NewUpdateIgnoreConflictError(r.updateMemberClusterStatus(ctx, mc, original))
 }
 
 func (r *Reconciler) getInternalMemberCluster(ctx context.Context, name string) (*clusterv1beta1.InternalMemberCluster, error) {
@@ -345,6 +358,28 @@ func (r *Reconciler) leave(ctx context.Context, mc *clusterv1beta1.MemberCluster
 	return nil
 }
 
+// recordPlacementImpactEvents emits an event on each placement that has resources placed on the leaving member cluster.
+// This is best effort, the failures are logged and do not block the member cluster from leaving.
+func (r *Reconciler) recordPlacementImpactEvents(ctx context.Context, mc *clusterv1beta1.MemberCluster) {
+	mcObjRef := klog.KObj(mc)
+	placementKeys, err := controller.ListPlacementsOnCluster(ctx, r.Client, mc.Name, true)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list the placements on the leaving member cluster", "memberCluster", mcObjRef)
+		return
+	}
+	for _, key := range placementKeys {
+		placementKey := controller.GetObjectKeyFromNamespaceName(key.Namespace, key.Name)
+		placement, err := controller.FetchPlacementFromKey(ctx, r.Client, queue.PlacementKey(placementKey))
+		if err != nil {
+			klog.ErrorS(err, "Failed to get the placement on the leaving member cluster", "memberCluster", mcObjRef, "placement", placementKey)
+			continue
+		}
+		r.recorder.Eventf(placement, corev1.EventTypeWarning, eventReasonMemberClusterLeaving,
+			"Member cluster %s is leaving the fleet, the resources placed on it will be removed", mc.Name)
+	}
+	klog.V(2).InfoS("Notified the placements on the leaving member cluster", "memberCluster", mcObjRef, "numberOfPlacements", len(placementKeys))
+}
+
 // syncNamespace creates or updates the namespace for member cluster.
 func (r *Reconciler) syncNamespace(ctx context.Context, mc *clusterv1beta1.MemberCluster) (string, error) {
 	klog.V(4).InfoS("Sync the namespace for the member cluster", "memberCluster", klog.KObj(mc))
@@ -500,6 +535,8 @@ func (r *Reconciler) syncInternalMemberCluster(ctx context.Context, mc *clusterv
 		},
 		Spec: clusterv1beta1.InternalMemberClusterSpec{
 			HeartbeatPeriodSeconds: mc.Spec.HeartbeatPeriodSeconds,
+			WorkApplyConcurrency:   mc.Spec.WorkApplyConcurrency,
+			DesiredAgentVersion:    mc.Spec.DesiredAgentVersion,
 		},
 	}
 	if mc.GetDeletionTimestamp().IsZero() {
@@ -571,10 +608,13 @@ func (r *Reconciler) syncInternalMemberClusterStatus(imc *clusterv1beta1.Interna
 	}
 	// Copy the cluster properties.
 	mc.Status.Properties = imc.Status.Properties
+	// Copy the acknowledged work apply concurrency.
+	mc.Status.WorkApplyConcurrency = imc.Status.WorkApplyConcurrency
 }
 
-// updateMemberClusterStatus is used to update member cluster status.
-func (r *Reconciler) updateMemberClusterStatus(ctx context.Context, mc *clusterv1beta1.MemberCluster) error {
+// updateMemberClusterStatus patches the member cluster status with the changes made since the original
+// copy was read; the patch fails with a conflict if the status has been written since.
+func (r *Reconciler) updateMemberClusterStatus(ctx context.Context, mc, original *clusterv1beta1.MemberCluster) error {
 	joined := condition.IsConditionStatusTrue(meta.FindStatusCondition(mc.Status.Conditions, string(clusterv1beta1.ConditionTypeMemberClusterJoined)), mc.Generation)
 	healthy := condition.IsConditionStatusTrue(mc.GetAgentCondition(clusterv1beta1.MemberAgent, clusterv1beta1.AgentHealthy), mc.Generation)
 	lastReceivedHeartbeat := "nil"
@@ -584,16 +624,18 @@ func (r *Reconciler) updateMemberClusterStatus(ctx context.Context, mc *clusterv
 	}
 	klog.V(2).InfoS("Update the memberCluster status", "memberCluster", klog.KObj(mc), "joined", joined, "healthy", healthy, "lastReceivedHeartbeat", lastReceivedHeartbeat)
 
-	backOffPeriod := retry.DefaultRetry
-	backOffPeriod.Cap = time.Second * time.Duration(mc.Spec.HeartbeatPeriodSeconds/2)
+	backOffPeriod := backoff.Backoff{
+		Initial: 10 * time.Millisecond,
+		Factor:  1.0,
+		Max:     time.Second * time.Duration(mc.Spec.HeartbeatPeriodSeconds/2),
+		Steps:   5,
+		Jitter:  backoff.ProportionalJitter(0.1),
+	}
 
-	return retry.OnError(backOffPeriod,
-		func(err error) bool {
-			return apierrors.IsServiceUnavailable(err) || apierrors.IsServerTimeout(err) || apierrors.IsTooManyRequests(err)
-		},
-		func() error {
-			return r.Client.Status().Update(ctx, mc)
-		})
+	return backoff.Retry(ctx, backOffPeriod, backoff.IsTransientAPIError, func() error {
+		_, err := controller.PatchStatus(ctx, r.Client, mc, original)
+		return err
+	})
 }
 
 // aggregateJoinedCondition is used to calculate and mark the joined or left status for member cluster based on join conditions from all agents.
@@ -736,7 +778,7 @@ func markMemberClusterUnknown(recorder record.EventRecorder, mc apis.Conditioned
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *Reconciler) SetupWithManager(mgr runtime.Manager, name string) error {
-	r.recorder = mgr.GetEventRecorderFor("mcv1beta1")
+	r.recorder = events.NewRateLimitedRecorder(mgr.GetEventRecorderFor("mcv1beta1"), events.DefaultDedupWindow)
 	r.agents = make(map[clusterv1beta1.AgentType]bool)
 	r.agents[clusterv1beta1.MemberAgent] = true
 
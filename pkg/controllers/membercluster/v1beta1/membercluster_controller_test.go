@@ -33,10 +33,13 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
@@ -761,7 +764,7 @@ func TestSyncInternalMemberCluster(t *testing.T) {
 	expectedMemberCluster2 := clusterv1beta1.MemberCluster{
 		TypeMeta:   metav1.TypeMeta{Kind: "MemberCluster", APIVersion: clusterv1beta1.GroupVersion.String()},
 		ObjectMeta: metav1.ObjectMeta{Name: "mc4", UID: "mc4-UID"},
-		Spec:       clusterv1beta1.MemberClusterSpec{HeartbeatPeriodSeconds: 30},
+		Spec:       clusterv1beta1.MemberClusterSpec{HeartbeatPeriodSeconds: 30, WorkApplyConcurrency: ptr.To(int32(2)), DesiredAgentVersion: "v0.15.0"},
 	}
 
 	expectedEvent1 := utils.GetEventString(&expectedLeavingMemberCluster, corev1.EventTypeNormal, eventReasonIMCSpecUpdated, "internal member cluster spec updated")
@@ -832,7 +835,7 @@ func TestSyncInternalMemberCluster(t *testing.T) {
 			memberCluster:                   &expectedMemberCluster2,
 			namespaceName:                   "fleet-mc4",
 			internalMemberCluster:           nil,
-			wantedInternalMemberClusterSpec: &clusterv1beta1.InternalMemberClusterSpec{State: clusterv1beta1.ClusterStateJoin, HeartbeatPeriodSeconds: 30},
+			wantedInternalMemberClusterSpec: &clusterv1beta1.InternalMemberClusterSpec{State: clusterv1beta1.ClusterStateJoin, HeartbeatPeriodSeconds: 30, WorkApplyConcurrency: ptr.To(int32(2)), DesiredAgentVersion: "v0.15.0"},
 			wantedEvent:                     expectedEvent2,
 			wantedError:                     "",
 		},
@@ -1739,7 +1742,7 @@ func TestUpdateMemberClusterStatus(t *testing.T) {
 	}{
 		"update member cluster status": {
 			r: &Reconciler{Client: &test.MockClient{
-				MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				MockStatusPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
 					count++
 					return nil
 				}},
@@ -1753,7 +1756,7 @@ func TestUpdateMemberClusterStatus(t *testing.T) {
 		},
 		"update member cluster status within cap": {
 			r: &Reconciler{Client: &test.MockClient{
-				MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				MockStatusPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
 					count++
 					if count == 3 {
 						return nil
@@ -1770,7 +1773,7 @@ func TestUpdateMemberClusterStatus(t *testing.T) {
 		},
 		"error updating exceeding cap for exponential backoff": {
 			r: &Reconciler{Client: &test.MockClient{
-				MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				MockStatusPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
 					count++
 					return apierrors.NewServerTimeout(schema.GroupResource{}, "", 1)
 				}},
@@ -1784,7 +1787,7 @@ func TestUpdateMemberClusterStatus(t *testing.T) {
 		},
 		"error updating within cap with error different from conflict/serverTimeout": {
 			r: &Reconciler{Client: &test.MockClient{
-				MockStatusUpdate: func(ctx context.Context, obj client.Object, opts ...client.SubResourceUpdateOption) error {
+				MockStatusPatch: func(ctx context.Context, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
 					count++
 					return errors.New("random update error")
 				}},
@@ -1801,7 +1804,11 @@ func TestUpdateMemberClusterStatus(t *testing.T) {
 	for testName, tt := range tests {
 		t.Run(testName, func(t *testing.T) {
 			count = -1
-			err := tt.r.updateMemberClusterStatus(context.Background(), tt.memberCluster)
+			// The status patch carries the resource version of the original copy.
+			tt.memberCluster.ResourceVersion = "1"
+			original := tt.memberCluster.DeepCopy()
+			tt.memberCluster.Status.ResourceUsage.ObservationTime = metav1.Now()
+			err := tt.r.updateMemberClusterStatus(context.Background(), tt.memberCluster, original)
 			if tt.wantedError == "" {
 				assert.Equal(t, err, nil, utils.TestCaseMsg, testName)
 			} else {
@@ -1951,3 +1958,63 @@ func TestHandleDelete(t *testing.T) {
 		})
 	}
 }
+
+func TestRecordPlacementImpactEvents(t *testing.T) {
+	mc := &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: "mc1"}}
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement scheme: %v", err)
+	}
+	objs := []client.Object{
+		&placementv1beta1.ClusterResourcePlacement{ObjectMeta: metav1.ObjectMeta{Name: "crp-1"}},
+		&placementv1beta1.ResourcePlacement{ObjectMeta: metav1.ObjectMeta{Name: "rp-1", Namespace: "app"}},
+		&placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "crp-1-binding",
+				Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: "crp-1"},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{State: placementv1beta1.BindingStateBound, TargetCluster: mc.Name},
+		},
+		// the placement of this binding is already gone.
+		&placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "crp-gone-binding",
+				Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: "crp-gone"},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{State: placementv1beta1.BindingStateBound, TargetCluster: mc.Name},
+		},
+		&placementv1beta1.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rp-1-binding",
+				Namespace: "app",
+				Labels:    map[string]string{placementv1beta1.PlacementTrackingLabel: "rp-1"},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{State: placementv1beta1.BindingStateScheduled, TargetCluster: mc.Name},
+		},
+		&placementv1beta1.ResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "rp-2-binding",
+				Namespace: "app",
+				Labels:    map[string]string{placementv1beta1.PlacementTrackingLabel: "rp-2"},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{State: placementv1beta1.BindingStateBound, TargetCluster: "mc2"},
+		},
+	}
+	recorder := record.NewFakeRecorder(10)
+	r := &Reconciler{
+		Client:   fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build(),
+		recorder: recorder,
+	}
+
+	r.recordPlacementImpactEvents(context.Background(), mc)
+	close(recorder.Events)
+	var got []string
+	for event := range recorder.Events {
+		got = append(got, event)
+	}
+	wantEvent := "Warning MemberClusterLeaving Member cluster mc1 is leaving the fleet, the resources placed on it will be removed"
+	want := []string{wantEvent, wantEvent}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("recordPlacementImpactEvents() events mismatch (-want, +got):\n%s", diff)
+	}
+}
@@ -29,6 +29,7 @@ import (
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
@@ -77,7 +78,13 @@ func (r *ClusterResourceReconciler) Reconcile(ctx context.Context, req ctrl.Requ
 	}
 
 	// create or update the overrideSnapshot
-	return ctrl.Result{}, r.ensureClusterResourceOverrideSnapshot(ctx, &clusterOverride, 10)
+	if err := r.ensureClusterResourceOverrideSnapshot(ctx, &clusterOverride, 10); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// dry-run the override rules against the latest resource snapshots so that render errors are surfaced
+	// before any rollout consumes the override.
+	return ctrl.Result{}, r.renderClusterResourceOverride(ctx, &clusterOverride)
 }
 
 func (r *ClusterResourceReconciler) ensureClusterResourceOverrideSnapshot(ctx context.Context, cro *placementv1beta1.ClusterResourceOverride, revisionHistoryLimit int) error {
@@ -161,5 +168,8 @@ func (r *ClusterResourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		Named("clusterresourceoverride-controller").
 		For(&placementv1beta1.ClusterResourceOverride{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Watches(&placementv1beta1.ClusterResourceSnapshot{},
+			handler.EnqueueRequestsFromMapFunc(r.clusterResourceOverridesForResourceSnapshot),
+			builder.WithPredicates(resourceSnapshotCreatedPredicate)).
 		Complete(r)
 }
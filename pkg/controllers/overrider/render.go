@@ -0,0 +1,302 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overrider
+
+import (
+	"context"
+	"strconv"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/overrider"
+)
+
+const (
+	// maxRenderFailures is the maximum number of render failures reported in the status of an override.
+	maxRenderFailures = 20
+)
+
+var (
+	// resourceSnapshotCreatedPredicate triggers the render of the overrides when a new resource snapshot is
+	// created; the content of the resource snapshots never changes once created.
+	resourceSnapshotCreatedPredicate = predicate.Funcs{
+		CreateFunc:  func(event.CreateEvent) bool { return true },
+		UpdateFunc:  func(event.UpdateEvent) bool { return false },
+		DeleteFunc:  func(event.DeleteEvent) bool { return false },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+)
+
+// renderClusterResourceOverride dry-runs the override rules of a clusterResourceOverride on the selected
+// resources in the latest resource snapshots, and reports the render failures in its status.
+func (r *Reconciler) renderClusterResourceOverride(ctx context.Context, cro *placementv1beta1.ClusterResourceOverride) error {
+	snapshots, err := r.listLatestResourceSnapshots(ctx, "", cro.Spec.Placement, false)
+	if err != nil {
+		return err
+	}
+	if err := resolveResourceSnapshotContents(ctx, r.Client, snapshots); err != nil {
+		return err
+	}
+	failures := renderOverrideRules(snapshots, cro.Spec.Policy, func(resource *unstructured.Unstructured) bool {
+		return isSelectedByClusterResourceOverride(cro, resource)
+	})
+	return r.updateRenderStatus(ctx, cro, &cro.Status, failures)
+}
+
+// renderResourceOverride dry-runs the override rules of a resourceOverride on the selected resources in the
+// latest resource snapshots, and reports the render failures in its status.
+func (r *Reconciler) renderResourceOverride(ctx context.Context, ro *placementv1beta1.ResourceOverride, enableResourcePlacement bool) error {
+	snapshots, err := r.listLatestResourceSnapshots(ctx, ro.Namespace, ro.Spec.Placement, enableResourcePlacement)
+	if err != nil {
+		return err
+	}
+	if err := resolveResourceSnapshotContents(ctx, r.Client, snapshots); err != nil {
+		return err
+	}
+	failures := renderOverrideRules(snapshots, ro.Spec.Policy, func(resource *unstructured.Unstructured) bool {
+		return isSelectedByResourceOverride(ro, resource)
+	})
+	return r.updateRenderStatus(ctx, ro, &ro.Status, failures)
+}
+
+// listLatestResourceSnapshots lists the latest resource snapshots of the placements that an override may apply to.
+// If the override does not reference a placement, the latest resource snapshots of all the clusterResourcePlacements,
+// and of the resourcePlacements in the namespace of the override (if any), are listed.
+func (r *Reconciler) listLatestResourceSnapshots(ctx context.Context, namespace string, placement *placementv1beta1.PlacementRef, enableResourcePlacement bool) ([]placementv1beta1.ResourceSnapshotObj, error) {
+	if placement != nil {
+		placementKey := types.NamespacedName{Name: placement.Name}
+		if placement.Scope == placementv1beta1.NamespaceScoped {
+			if !enableResourcePlacement {
+				return nil, nil
+			}
+			placementKey.Namespace = namespace
+		}
+		snapshotList, err := controller.ListLatestResourceSnapshots(ctx, r.Client, placementKey)
+		if err != nil {
+			return nil, err
+		}
+		return snapshotList.GetResourceSnapshotObjs(), nil
+	}
+
+	latestSnapshotLabelMatcher := client.MatchingLabels{
+		placementv1beta1.IsLatestSnapshotLabel: strconv.FormatBool(true),
+	}
+	clusterSnapshotList := &placementv1beta1.ClusterResourceSnapshotList{}
+	if err := r.Client.List(ctx, clusterSnapshotList, latestSnapshotLabelMatcher); err != nil {
+		klog.ErrorS(err, "Failed to list the latest clusterResourceSnapshots")
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	snapshots := clusterSnapshotList.GetResourceSnapshotObjs()
+	if namespace == "" || !enableResourcePlacement {
+		return snapshots, nil
+	}
+	snapshotList := &placementv1beta1.ResourceSnapshotList{}
+	if err := r.Client.List(ctx, snapshotList, latestSnapshotLabelMatcher, client.InNamespace(namespace)); err != nil {
+		klog.ErrorS(err, "Failed to list the latest resourceSnapshots", "namespace", namespace)
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	return append(snapshots, snapshotList.GetResourceSnapshotObjs()...), nil
+}
+
+// resolveResourceSnapshotContents fills in the selected resources of the resource snapshots that refer to
+// their contents by ClusterResourceContent objects.
+func resolveResourceSnapshotContents(ctx context.Context, k8Client client.Reader, snapshots []placementv1beta1.ResourceSnapshotObj) error {
+	for _, snapshot := range snapshots {
+		if err := controller.ResolveResourceSnapshotContents(ctx, k8Client, snapshot); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// renderOverrideRules dry-runs the JSON patch overrides of each override rule, regardless of its cluster selector,
+// on each selected resource in the resource snapshots, and returns the render failures.
+func renderOverrideRules(snapshots []placementv1beta1.ResourceSnapshotObj, policy *placementv1beta1.OverridePolicy, isSelected func(*unstructured.Unstructured) bool) []placementv1beta1.OverrideRenderFailure {
+	if policy == nil {
+		return nil
+	}
+	var failures []placementv1beta1.OverrideRenderFailure
+	for _, snapshot := range snapshots {
+		for _, resource := range snapshot.GetResourceSnapshotSpec().SelectedResources {
+			var uResource unstructured.Unstructured
+			if err := uResource.UnmarshalJSON(resource.Raw); err != nil {
+				klog.ErrorS(err, "Resource snapshot has invalid content", "resourceSnapshot", klog.KObj(snapshot))
+				continue
+			}
+			if !isSelected(&uResource) {
+				continue
+			}
+			gvk := uResource.GroupVersionKind()
+			for i, rule := range policy.OverrideRules {
+				if rule.OverrideType == placementv1beta1.DeleteOverrideType {
+					continue
+				}
+				if err := overrider.DryRunJSONPatchOverrides(resource.Raw, rule.JSONPatchOverrides); err != nil {
+					if len(failures) == maxRenderFailures {
+						return failures
+					}
+					failures = append(failures, placementv1beta1.OverrideRenderFailure{
+						ResourceSnapshotName: snapshot.GetName(),
+						Group:                gvk.Group,
+						Version:              gvk.Version,
+						Kind:                 gvk.Kind,
+						Name:                 uResource.GetName(),
+						Namespace:            uResource.GetNamespace(),
+						RuleIndex:            int32(i),
+						Message:              err.Error(),
+					})
+				}
+			}
+		}
+	}
+	return failures
+}
+
+// isSelectedByClusterResourceOverride checks if a resource is selected by a clusterResourceOverride; a namespace
+// scoped resource is selected if its namespace is selected.
+func isSelectedByClusterResourceOverride(cro *placementv1beta1.ClusterResourceOverride, resource *unstructured.Unstructured) bool {
+	gvk := resource.GroupVersionKind()
+	key := placementv1beta1.ResourceIdentifier{
+		Group:   gvk.Group,
+		Version: gvk.Version,
+		Kind:    gvk.Kind,
+		Name:    resource.GetName(),
+	}
+	if resource.GetNamespace() != "" {
+		key = placementv1beta1.ResourceIdentifier{
+			Group:   utils.NamespaceMetaGVK.Group,
+			Version: utils.NamespaceMetaGVK.Version,
+			Kind:    utils.NamespaceMetaGVK.Kind,
+			Name:    resource.GetNamespace(),
+		}
+	}
+	for _, selector := range cro.Spec.ClusterResourceSelectors {
+		// Note, we only support name selector here.
+		if key == (placementv1beta1.ResourceIdentifier{Group: selector.Group, Version: selector.Version, Kind: selector.Kind, Name: selector.Name}) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSelectedByResourceOverride checks if a resource is selected by a resourceOverride.
+func isSelectedByResourceOverride(ro *placementv1beta1.ResourceOverride, resource *unstructured.Unstructured) bool {
+	if resource.GetNamespace() != ro.Namespace {
+		return false
+	}
+	gvk := resource.GroupVersionKind()
+	for _, selector := range ro.Spec.ResourceSelectors {
+		if selector.Group == gvk.Group && selector.Version == gvk.Version && selector.Kind == gvk.Kind && selector.Name == resource.GetName() {
+			return true
+		}
+	}
+	return false
+}
+
+// updateRenderStatus reports the render failures in the status of an override.
+func (r *Reconciler) updateRenderStatus(ctx context.Context, overrideObj client.Object, status *placementv1beta1.OverrideStatus, failures []placementv1beta1.OverrideRenderFailure) error {
+	oldStatus := status.DeepCopy()
+	renderedCond := metav1.Condition{
+		Type:               string(placementv1beta1.OverrideRenderedConditionType),
+		Status:             metav1.ConditionTrue,
+		Reason:             condition.OverrideRenderSucceededReason,
+		Message:            "All the override rules have been rendered on the selected resources in the latest resource snapshots",
+		ObservedGeneration: overrideObj.GetGeneration(),
+	}
+	if len(failures) > 0 {
+		renderedCond.Status = metav1.ConditionFalse
+		renderedCond.Reason = condition.OverrideRenderFailedReason
+		renderedCond.Message = "Some override rules fail to render on the selected resources in the latest resource snapshots; the rollouts of these resources are expected to fail"
+	}
+	status.RenderFailures = failures
+	meta.SetStatusCondition(&status.Conditions, renderedCond)
+	if equality.Semantic.DeepEqual(oldStatus, status) {
+		return nil
+	}
+	if err := r.Client.Status().Update(ctx, overrideObj); err != nil {
+		klog.ErrorS(err, "Failed to update the override status", "override", klog.KObj(overrideObj))
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the override status", "override", klog.KObj(overrideObj), "renderFailures", len(failures))
+	return nil
+}
+
+// clusterResourceOverridesForResourceSnapshot returns the requests of the clusterResourceOverrides that may apply to
+// the resources in a resource snapshot.
+func (r *Reconciler) clusterResourceOverridesForResourceSnapshot(ctx context.Context, snapshot client.Object) []reconcile.Request {
+	croList := &placementv1beta1.ClusterResourceOverrideList{}
+	if err := r.Client.List(ctx, croList); err != nil {
+		klog.ErrorS(err, "Failed to list clusterResourceOverrides", "resourceSnapshot", klog.KObj(snapshot))
+		return nil
+	}
+	placementName := snapshot.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+	requests := make([]reconcile.Request, 0, len(croList.Items))
+	for i := range croList.Items {
+		cro := &croList.Items[i]
+		if cro.Spec.Placement == nil || cro.Spec.Placement.Name == placementName {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Name: cro.Name}})
+		}
+	}
+	return requests
+}
+
+// resourceOverridesForResourceSnapshot returns the requests of the resourceOverrides that may apply to the resources
+// in a resource snapshot.
+func (r *Reconciler) resourceOverridesForResourceSnapshot(ctx context.Context, snapshot client.Object) []reconcile.Request {
+	roList := &placementv1beta1.ResourceOverrideList{}
+	var listOptions []client.ListOption
+	if snapshot.GetNamespace() != "" {
+		listOptions = append(listOptions, client.InNamespace(snapshot.GetNamespace()))
+	}
+	if err := r.Client.List(ctx, roList, listOptions...); err != nil {
+		klog.ErrorS(err, "Failed to list resourceOverrides", "resourceSnapshot", klog.KObj(snapshot))
+		return nil
+	}
+	placementName := snapshot.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+	wantScope := placementv1beta1.ClusterScoped
+	if snapshot.GetNamespace() != "" {
+		wantScope = placementv1beta1.NamespaceScoped
+	}
+	requests := make([]reconcile.Request, 0, len(roList.Items))
+	for i := range roList.Items {
+		ro := &roList.Items[i]
+		if ro.Spec.Placement != nil {
+			scope := ro.Spec.Placement.Scope
+			if scope == "" {
+				scope = placementv1beta1.ClusterScoped
+			}
+			if ro.Spec.Placement.Name != placementName || scope != wantScope {
+				continue
+			}
+		}
+		requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: ro.Namespace, Name: ro.Name}})
+	}
+	return requests
+}
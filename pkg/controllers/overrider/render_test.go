@@ -0,0 +1,228 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package overrider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+const (
+	renderTestCRPName = "test-crp"
+)
+
+func renderTestResourceSnapshot() *placementv1beta1.ClusterResourceSnapshot {
+	return &placementv1beta1.ClusterResourceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-crp-0-snapshot",
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: renderTestCRPName,
+				placementv1beta1.IsLatestSnapshotLabel:  "true",
+			},
+		},
+		Spec: placementv1beta1.ResourceSnapshotSpec{
+			SelectedResources: []placementv1beta1.ResourceContent{
+				{RawExtension: runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"app"}}`)}},
+				{RawExtension: runtime.RawExtension{Raw: []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"config","namespace":"app"},"data":{"key":"value"}}`)}},
+			},
+		},
+	}
+}
+
+func TestRenderClusterResourceOverride(t *testing.T) {
+	validRule := placementv1beta1.OverrideRule{
+		JSONPatchOverrides: []placementv1beta1.JSONPatchOverride{
+			{
+				Operator: placementv1beta1.JSONPatchOverrideOpAdd,
+				Path:     "/metadata/labels",
+				Value:    apiextensionsv1.JSON{Raw: []byte(`{"cluster":"${MEMBER-CLUSTER-NAME}"}`)},
+			},
+		},
+	}
+	invalidRule := placementv1beta1.OverrideRule{
+		JSONPatchOverrides: []placementv1beta1.JSONPatchOverride{
+			{
+				Operator: placementv1beta1.JSONPatchOverrideOpReplace,
+				Path:     "/data/key",
+				Value:    apiextensionsv1.JSON{Raw: []byte(`"new-value"`)},
+			},
+		},
+	}
+	deleteRule := placementv1beta1.OverrideRule{
+		OverrideType: placementv1beta1.DeleteOverrideType,
+	}
+
+	testCases := []struct {
+		name          string
+		placement     *placementv1beta1.PlacementRef
+		rules         []placementv1beta1.OverrideRule
+		wantFailures  []placementv1beta1.OverrideRenderFailure
+		wantCondition metav1.ConditionStatus
+	}{
+		{
+			name:          "all rules rendered",
+			placement:     &placementv1beta1.PlacementRef{Name: renderTestCRPName},
+			rules:         []placementv1beta1.OverrideRule{validRule, deleteRule},
+			wantCondition: metav1.ConditionTrue,
+		},
+		{
+			name:  "rule fails to render on the namespace",
+			rules: []placementv1beta1.OverrideRule{validRule, invalidRule},
+			wantFailures: []placementv1beta1.OverrideRenderFailure{
+				{
+					ResourceSnapshotName: "test-crp-0-snapshot",
+					Version:              "v1",
+					Kind:                 "Namespace",
+					Name:                 "app",
+					RuleIndex:            1,
+					Message:              "failed to apply the JSON patch overrides: replace operation does not apply: doc is missing path: /data/key: missing value",
+				},
+			},
+			wantCondition: metav1.ConditionFalse,
+		},
+		{
+			name:          "placement has no resource snapshots",
+			placement:     &placementv1beta1.PlacementRef{Name: "other-crp"},
+			rules:         []placementv1beta1.OverrideRule{invalidRule},
+			wantCondition: metav1.ConditionTrue,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cro := &placementv1beta1.ClusterResourceOverride{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "test-cro",
+					Generation: 2,
+				},
+				Spec: placementv1beta1.ClusterResourceOverrideSpec{
+					Placement: tc.placement,
+					ClusterResourceSelectors: []placementv1beta1.ResourceSelectorTerm{
+						{Group: "", Version: "v1", Kind: "Namespace", Name: "app"},
+					},
+					Policy: &placementv1beta1.OverridePolicy{OverrideRules: tc.rules},
+				},
+			}
+			scheme := runtime.NewScheme()
+			if err := placementv1beta1.AddToScheme(scheme); err != nil {
+				t.Fatalf("AddToScheme() = %v, want no error", err)
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(cro, renderTestResourceSnapshot()).
+				WithStatusSubresource(cro).
+				Build()
+			r := Reconciler{Client: fakeClient}
+			if err := r.renderClusterResourceOverride(context.Background(), cro); err != nil {
+				t.Fatalf("renderClusterResourceOverride() = %v, want no error", err)
+			}
+
+			got := &placementv1beta1.ClusterResourceOverride{}
+			if err := fakeClient.Get(context.Background(), types.NamespacedName{Name: cro.Name}, got); err != nil {
+				t.Fatalf("Get() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(got.Status.RenderFailures, tc.wantFailures, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("renderClusterResourceOverride() render failures mismatch (-got, +want):\n%s", diff)
+			}
+			cond := meta.FindStatusCondition(got.Status.Conditions, string(placementv1beta1.OverrideRenderedConditionType))
+			if cond == nil || cond.Status != tc.wantCondition || cond.ObservedGeneration != cro.Generation {
+				t.Errorf("renderClusterResourceOverride() Rendered condition = %+v, want status %s with observed generation %d", cond, tc.wantCondition, cro.Generation)
+			}
+			wantReason := condition.OverrideRenderSucceededReason
+			if tc.wantCondition == metav1.ConditionFalse {
+				wantReason = condition.OverrideRenderFailedReason
+			}
+			if cond != nil && cond.Reason != wantReason {
+				t.Errorf("renderClusterResourceOverride() Rendered condition reason = %s, want %s", cond.Reason, wantReason)
+			}
+		})
+	}
+}
+
+func TestResourceOverridesForResourceSnapshot(t *testing.T) {
+	ros := []*placementv1beta1.ResourceOverride{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "no-placement", Namespace: "app"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "crp", Namespace: "app"},
+			Spec: placementv1beta1.ResourceOverrideSpec{
+				Placement: &placementv1beta1.PlacementRef{Name: renderTestCRPName},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "rp", Namespace: "app"},
+			Spec: placementv1beta1.ResourceOverrideSpec{
+				Placement: &placementv1beta1.PlacementRef{Name: renderTestCRPName, Scope: placementv1beta1.NamespaceScoped},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-crp", Namespace: "other"},
+			Spec: placementv1beta1.ResourceOverrideSpec{
+				Placement: &placementv1beta1.PlacementRef{Name: "other-crp"},
+			},
+		},
+	}
+	rpSnapshot := &placementv1beta1.ResourceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "test-crp-0-snapshot",
+			Namespace: "app",
+			Labels:    map[string]string{placementv1beta1.PlacementTrackingLabel: renderTestCRPName},
+		},
+	}
+
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme() = %v, want no error", err)
+	}
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	for _, ro := range ros {
+		builder = builder.WithObjects(ro)
+	}
+	r := Reconciler{Client: builder.Build()}
+
+	got := r.resourceOverridesForResourceSnapshot(context.Background(), renderTestResourceSnapshot())
+	want := []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "app", Name: "crp"}},
+		{NamespacedName: types.NamespacedName{Namespace: "app", Name: "no-placement"}},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("resourceOverridesForResourceSnapshot(clusterResourceSnapshot) mismatch (-got, +want):\n%s", diff)
+	}
+
+	got = r.resourceOverridesForResourceSnapshot(context.Background(), rpSnapshot)
+	want = []reconcile.Request{
+		{NamespacedName: types.NamespacedName{Namespace: "app", Name: "no-placement"}},
+		{NamespacedName: types.NamespacedName{Namespace: "app", Name: "rp"}},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("resourceOverridesForResourceSnapshot(resourceSnapshot) mismatch (-got, +want):\n%s", diff)
+	}
+}
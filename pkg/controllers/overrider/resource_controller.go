@@ -29,6 +29,7 @@ import (
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
@@ -40,6 +41,10 @@ import (
 // ResourceReconciler reconciles a ResourceOverride object.
 type ResourceReconciler struct {
 	Reconciler
+
+	// EnableResourcePlacement indicates whether the resourcePlacement APIs are enabled, in which case the
+	// resourceOverrides are also rendered against the resource snapshots of the resourcePlacements.
+	EnableResourcePlacement bool
 }
 
 // Reconcile triggers a single  reconcile round when scheduling policy has changed.
@@ -78,7 +83,13 @@ func (r *ResourceReconciler) Reconcile(ctx context.Context, req ctrl.Request) (c
 	}
 
 	// create or update the overrideSnapshot
-	return ctrl.Result{}, r.ensureResourceOverrideSnapshot(ctx, &resourceOverride, 10)
+	if err := r.ensureResourceOverrideSnapshot(ctx, &resourceOverride, 10); err != nil {
+		return ctrl.Result{}, err
+	}
+
+	// dry-run the override rules against the latest resource snapshots so that render errors are surfaced
+	// before any rollout consumes the override.
+	return ctrl.Result{}, r.renderResourceOverride(ctx, &resourceOverride, r.EnableResourcePlacement)
 }
 
 func (r *ResourceReconciler) ensureResourceOverrideSnapshot(ctx context.Context, ro *placementv1beta1.ResourceOverride, revisionHistoryLimit int) error {
@@ -159,8 +170,16 @@ func (r *ResourceReconciler) ensureResourceOverrideSnapshot(ctx context.Context,
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ResourceReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	b := ctrl.NewControllerManagedBy(mgr).
 		Named("resourceoverride-controller").
 		For(&placementv1beta1.ResourceOverride{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
-		Complete(r)
+		Watches(&placementv1beta1.ClusterResourceSnapshot{},
+			handler.EnqueueRequestsFromMapFunc(r.resourceOverridesForResourceSnapshot),
+			builder.WithPredicates(resourceSnapshotCreatedPredicate))
+	if r.EnableResourcePlacement {
+		b = b.Watches(&placementv1beta1.ResourceSnapshot{},
+			handler.EnqueueRequestsFromMapFunc(r.resourceOverridesForResourceSnapshot),
+			builder.WithPredicates(resourceSnapshotCreatedPredicate))
+	}
+	return b.Complete(r)
 }
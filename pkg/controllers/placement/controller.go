@@ -131,6 +131,8 @@ func (r *Reconciler) handleDelete(ctx context.Context, placementObj fleetv1beta1
 	}
 	// change the metrics to add nameplace of namespace
 	hubmetrics.FleetPlacementStatusLastTimeStampSeconds.DeletePartialMatch(prometheus.Labels{"namespace": placementObj.GetNamespace(), "name": placementObj.GetName()})
+	hubmetrics.FleetPolicySnapshotCreatedTotal.DeletePartialMatch(prometheus.Labels{"namespace": placementObj.GetNamespace(), "name": placementObj.GetName()})
+	hubmetrics.FleetPolicySnapshotDeletedTotal.DeletePartialMatch(prometheus.Labels{"namespace": placementObj.GetNamespace(), "name": placementObj.GetName()})
 	controllerutil.RemoveFinalizer(placementObj, fleetv1beta1.PlacementCleanupFinalizer)
 	if err := r.Client.Update(ctx, placementObj); err != nil {
 		klog.ErrorS(err, "Failed to remove placement finalizer", "placement", placementKObj)
@@ -146,18 +148,19 @@ func (r *Reconciler) handleDelete(ctx context.Context, placementObj fleetv1beta1
 // clusterSchedulingPolicySnapshot status and work status.
 // If the error type is ErrUnexpectedBehavior, the controller will skip the reconciling.
 func (r *Reconciler) handleUpdate(ctx context.Context, placementObj fleetv1beta1.PlacementObj) (ctrl.Result, error) {
-	revisionLimit := int32(defaulter.DefaultRevisionHistoryLimitValue)
 	placementKObj := klog.KObj(placementObj)
 	oldPlacement := placementObj.DeepCopyObject().(fleetv1beta1.PlacementObj)
-	placementSpec := placementObj.GetPlacementSpec()
 
-	if placementSpec.RevisionHistoryLimit != nil {
-		if revisionLimit <= 0 {
-			err := fmt.Errorf("invalid placement %s/%s: invalid revisionHistoryLimit %d", placementObj.GetNamespace(), placementObj.GetName(), revisionLimit)
-			klog.ErrorS(controller.NewUnexpectedBehaviorError(err), "Invalid revisionHistoryLimit value and using default value instead", "placement", placementKObj)
-		} else {
-			revisionLimit = *placementSpec.RevisionHistoryLimit
-		}
+	// Read the revision history limit from a defaulted copy, just in case the mutation webhook is not enabled;
+	// the placement itself is left as it is, so that the snapshots are always built from the stored spec.
+	defaultedPlacement := placementObj.DeepCopyObject().(fleetv1beta1.PlacementObj)
+	defaulter.SetPlacementDefaults(defaultedPlacement)
+	revisionLimit := *defaultedPlacement.GetPlacementSpec().RevisionHistoryLimit
+	// The scheduling policy snapshots may have a limit of their own, so that the policy history can
+	// be kept short for placements whose policies are tuned often.
+	policyRevisionLimit := revisionLimit
+	if limit := defaultedPlacement.GetPlacementSpec().PolicyRevisionHistoryLimit; limit != nil {
+		policyRevisionLimit = *limit
 	}
 
 	// Validate namespace selector consistency for NamespaceAccessible CRPs.
@@ -191,7 +194,7 @@ func (r *Reconciler) handleUpdate(ctx context.Context, placementObj fleetv1beta1
 		}
 		placementObj.SetConditions(scheduleCondition)
 
-		if updateErr := r.Client.Status().Update(ctx, placementObj); updateErr != nil {
+		if _, updateErr := controller.PatchStatus(ctx, r.Client, placementObj, oldPlacement); updateErr != nil {
 			klog.ErrorS(updateErr, "Failed to update the status", "placement", placementKObj)
 			return ctrl.Result{}, controller.NewUpdateIgnoreConflictError(updateErr)
 		}
@@ -207,7 +210,7 @@ func (r *Reconciler) handleUpdate(ctx context.Context, placementObj fleetv1beta1
 		return ctrl.Result{RequeueAfter: controllerResyncPeriod}, nil
 	}
 
-	latestSchedulingPolicySnapshot, err := r.getOrCreateSchedulingPolicySnapshot(ctx, placementObj, int(revisionLimit))
+	latestSchedulingPolicySnapshot, err := r.getOrCreateSchedulingPolicySnapshot(ctx, placementObj, int(policyRevisionLimit))
 	if err != nil {
 		klog.ErrorS(err, "Failed to select resources for placement", "placement", placementKObj)
 		return ctrl.Result{}, err
@@ -225,7 +228,7 @@ func (r *Reconciler) handleUpdate(ctx context.Context, placementObj fleetv1beta1
 		return ctrl.Result{}, err
 	}
 
-	if err := r.Client.Status().Update(ctx, placementObj); err != nil {
+	if _, err := controller.PatchStatus(ctx, r.Client, placementObj, oldPlacement); err != nil {
 		klog.ErrorS(err, "Failed to update the status", "placement", placementKObj)
 		return ctrl.Result{}, err
 	}
@@ -237,8 +240,10 @@ func (r *Reconciler) handleUpdate(ctx context.Context, placementObj fleetv1beta1
 		}
 	}
 
-	// We skip checking the last resource condition (available) because it will be covered by checking isRolloutCompleted func.
-	for i := condition.RolloutStartedCondition; i < condition.TotalCondition-1; i++ {
+	// Emit an event for each expected condition that has become true, including the ones past the ready
+	// condition selected by the readiness policy of the placement (if any), e.g., the Available condition
+	// when the placement is considered ready once the resources are applied.
+	for _, i := range determineExpectedPlacementAndResourcePlacementStatusCondType(placementObj) {
 		var oldCond, newCond *metav1.Condition
 		conditionType := getPlacementConditionType(placementObj, i)
 		oldCond = oldPlacement.GetCondition(conditionType)
@@ -262,6 +267,13 @@ func (r *Reconciler) handleUpdate(ctx context.Context, placementObj fleetv1beta1
 			// We requeue the request to handle the resource snapshot.
 			return createResourceSnapshotRes, nil
 		}
+		if !areAllExpectedConditionsTrue(placementObj) {
+			// The readiness policy considers the placement ready before all the expected conditions are true
+			// (e.g., the resources are applied but not yet available); keep the long resync loop just in case
+			// as the rollout is still in progress.
+			klog.V(2).InfoS("Placement is ready but not all the expected conditions are true yet and requeue the request", "placement", placementKObj, "generation", placementObj.GetGeneration())
+			return ctrl.Result{RequeueAfter: controllerResyncPeriod}, nil
+		}
 		// We don't need to requeue any request now by watching the binding changes
 		return ctrl.Result{}, nil
 	}
@@ -298,7 +310,9 @@ func (r *Reconciler) handleUpdate(ctx context.Context, placementObj fleetv1beta1
 
 // handleResourceSnapshotByStrategy handles resource snapshot resolution based on rollout strategy.
 // For External rollout strategy, it only fetches the existing snapshot (can be nil).
-// For other strategies, it creates or gets a resource snapshot and may update selectedResourceIDs if requeue is needed.
+// For other strategies, it creates or gets a resource snapshot and may update selectedResourceIDs if requeue is needed;
+// if the placement has been rolled back, it returns the pinned resource snapshot, which is the one being rolled out,
+// along with the resources it selects.
 func (r *Reconciler) handleResourceSnapshotByStrategy(
 	ctx context.Context,
 	placementObj fleetv1beta1.PlacementObj,
@@ -342,7 +356,30 @@ func (r *Reconciler) handleResourceSnapshotByStrategy(
 		}
 		klog.V(2).InfoS("Fetched the selected resources from the latestResourceSnapshot", "placement", placementKObj, "resourceSnapshot", latestResourceSnapshotKObj, "generation", placementObj.GetGeneration())
 	}
-	return createResourceSnapshotRes, latestResourceSnapshot, selectedResourceIDs, nil
+
+	// A placement that has been rolled back rolls out the pinned resource snapshot rather than the latest one.
+	pinnedIndex, pinned := placementObj.GetAnnotations()[fleetv1beta1.PinnedResourceSnapshotIndexAnnotation]
+	if !pinned || pinnedIndex == latestResourceSnapshot.GetLabels()[fleetv1beta1.ResourceIndexLabel] {
+		return createResourceSnapshotRes, latestResourceSnapshot, selectedResourceIDs, nil
+	}
+	pinnedResourceSnapshot, err := controller.FetchMasterResourceSnapshotWithAnIndex(ctx, r.Client, pinnedIndex, types.NamespacedName{Name: placementObj.GetName(), Namespace: placementObj.GetNamespace()})
+	if err != nil {
+		klog.ErrorS(err, "Failed to fetch the pinned resourceSnapshot", "placement", placementKObj, "resourceSnapshotIndex", pinnedIndex)
+		return ctrl.Result{}, nil, selectedResourceIDs, err
+	}
+	if pinnedResourceSnapshot == nil {
+		// The rollout controller does not roll out a placement pinned to a resource snapshot that does not exist.
+		klog.V(2).InfoS("The pinned resourceSnapshot does not exist", "placement", placementKObj, "resourceSnapshotIndex", pinnedIndex)
+		return createResourceSnapshotRes, latestResourceSnapshot, selectedResourceIDs, nil
+	}
+	placementKey := controller.GetObjectKeyFromNamespaceName(placementObj.GetNamespace(), placementObj.GetName())
+	selectedResourceIDs, err = controller.CollectResourceIdentifiersUsingMasterResourceSnapshot(ctx, r.Client, placementKey, pinnedResourceSnapshot, pinnedIndex)
+	if err != nil {
+		klog.ErrorS(err, "Failed to collect resource identifiers from the pinned resourceSnapshot", "placement", placementKObj, "resourceSnapshot", klog.KObj(pinnedResourceSnapshot))
+		return ctrl.Result{}, nil, selectedResourceIDs, err
+	}
+	klog.V(2).InfoS("Fetched the selected resources from the pinned resourceSnapshot", "placement", placementKObj, "resourceSnapshot", klog.KObj(pinnedResourceSnapshot), "generation", placementObj.GetGeneration())
+	return createResourceSnapshotRes, pinnedResourceSnapshot, selectedResourceIDs, nil
 }
 
 func (r *Reconciler) getOrCreateSchedulingPolicySnapshot(ctx context.Context, placementObj fleetv1beta1.PlacementObj, revisionHistoryLimit int) (fleetv1beta1.PolicySnapshotObj, error) {
@@ -410,6 +447,7 @@ func (r *Reconciler) getOrCreateSchedulingPolicySnapshot(ctx context.Context, pl
 		return nil, controller.NewAPIServerError(false, err)
 	}
 	klog.V(2).InfoS("Created new policySnapshot", "placement", placementKObj, "policySnapshot", policySnapshotKObj)
+	hubmetrics.FleetPolicySnapshotCreatedTotal.WithLabelValues(placementObj.GetNamespace(), placementObj.GetName()).Inc()
 	return newPolicySnapshot, nil
 }
 
@@ -419,7 +457,10 @@ func (r *Reconciler) deleteRedundantSchedulingPolicySnapshots(ctx context.Contex
 		return err
 	}
 
-	items := sortedList.GetPolicySnapshotObjs()
+	items, err := r.compactSchedulingPolicySnapshots(ctx, placementObj, sortedList.GetPolicySnapshotObjs())
+	if err != nil {
+		return err
+	}
 	if len(items) < revisionHistoryLimit {
 		return nil
 	}
@@ -438,10 +479,36 @@ func (r *Reconciler) deleteRedundantSchedulingPolicySnapshots(ctx context.Contex
 			klog.ErrorS(err, "Failed to delete policySnapshot", "placement", klog.KObj(placementObj), "policySnapshot", klog.KObj(items[i]))
 			return controller.NewAPIServerError(false, err)
 		}
+		hubmetrics.FleetPolicySnapshotDeletedTotal.WithLabelValues(placementObj.GetNamespace(), placementObj.GetName(), hubmetrics.PolicySnapshotDeletedReasonRevisionLimit).Inc()
 	}
 	return nil
 }
 
+// compactSchedulingPolicySnapshots deletes the policySnapshots (except the most recent one) that have never been
+// scheduled, as they have been superseded by newer policies before the scheduler ever picks them up; such snapshots
+// pile up when a policy is edited frequently, and keeping them only pushes meaningful revisions out of the history.
+// It returns the sorted policySnapshots that are kept.
+func (r *Reconciler) compactSchedulingPolicySnapshots(ctx context.Context, placementObj fleetv1beta1.PlacementObj, sortedItems []fleetv1beta1.PolicySnapshotObj) ([]fleetv1beta1.PolicySnapshotObj, error) {
+	if len(sortedItems) <= 1 {
+		return sortedItems, nil
+	}
+	kept := make([]fleetv1beta1.PolicySnapshotObj, 0, len(sortedItems))
+	// The most recent snapshot is always kept, as the scheduler might be working on it right now.
+	for _, item := range sortedItems[:len(sortedItems)-1] {
+		if item.GetCondition(string(fleetv1beta1.PolicySnapshotScheduled)) != nil {
+			kept = append(kept, item)
+			continue
+		}
+		if err := r.Client.Delete(ctx, item); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete the unscheduled policySnapshot", "placement", klog.KObj(placementObj), "policySnapshot", klog.KObj(item))
+			return nil, controller.NewAPIServerError(false, err)
+		}
+		klog.V(2).InfoS("Deleted the policySnapshot superseded before being scheduled", "placement", klog.KObj(placementObj), "policySnapshot", klog.KObj(item))
+		hubmetrics.FleetPolicySnapshotDeletedTotal.WithLabelValues(placementObj.GetNamespace(), placementObj.GetName(), hubmetrics.PolicySnapshotDeletedReasonCompacted).Inc()
+	}
+	return append(kept, sortedItems[len(sortedItems)-1]), nil
+}
+
 // ensureLatestPolicySnapshot ensures the latest policySnapshot has the isLatest label and the numberOfClusters are updated for interface types.
 func (r *Reconciler) ensureLatestPolicySnapshot(ctx context.Context, placementObj fleetv1beta1.PlacementObj, latest fleetv1beta1.PolicySnapshotObj) error {
 	needUpdate := false
@@ -600,7 +667,8 @@ func (r *Reconciler) setPlacementStatus(
 
 	scheduledCondition := buildScheduledCondition(placementObj, latestSchedulingPolicySnapshot)
 	placementObj.SetConditions(scheduledCondition)
-	// set ObservedResourceIndex from the latest resource snapshot's resource index label, before we set Synchronized, Applied conditions.
+	// set ObservedResourceIndex from the latest (or the pinned, if the placement has been rolled back) resource snapshot's resource
+	// index label, before we set Synchronized, Applied conditions.
 	// For External rollout strategy, latestResourceSnapshot can be nil if no snapshot has been created yet by the external controller.
 	if latestResourceSnapshot != nil {
 		placementStatus.ObservedResourceIndex = latestResourceSnapshot.GetLabels()[fleetv1beta1.ResourceIndexLabel]
@@ -623,6 +691,7 @@ func (r *Reconciler) setPlacementStatus(
 		// Today, we only track the resources progress if the same cluster is selected again.
 		klog.V(2).InfoS("Resetting the resource placement status since scheduled condition is unknown", "placement", klog.KObj(placementObj))
 		placementStatus.PerClusterPlacementStatuses = []fleetv1beta1.PerClusterPlacementStatus{}
+		placementStatus.PerClusterRolloutProgress = nil
 		return false, nil
 	}
 
@@ -639,7 +708,7 @@ func (r *Reconciler) setPlacementStatus(
 	// For clusters that have been selected, set the resource placement status based on the
 	// respective resource binding status for each of them.
 	expectedCondTypes := determineExpectedPlacementAndResourcePlacementStatusCondType(placementObj)
-	perClusterStatus, perClusterCondTypeCounter, err := r.buildSelectedPerClusterPlacementStatuses(
+	perClusterStatus, rolloutProgress, perClusterCondTypeCounter, err := r.buildSelectedPerClusterPlacementStatuses(
 		ctx, selected, expectedCondTypes, placementObj, latestSchedulingPolicySnapshot, latestResourceSnapshot)
 	if err != nil {
 		return false, err
@@ -648,6 +717,10 @@ func (r *Reconciler) setPlacementStatus(
 	// For clusters that failed to get scheduled, set a resource placement status with the failed to schedule condition for each of them.
 	perClusterStatus = append(perClusterStatus, buildFailedToSchedulePerClusterPlacementStatuses(unselected, failedToScheduleClusterCount, placementObj)...)
 	placementStatus.PerClusterPlacementStatuses = perClusterStatus
+	placementStatus.PerClusterRolloutProgress = rolloutProgress
+	if err := r.setRolloutImpactEstimate(ctx, placementObj, selected, latestSchedulingPolicySnapshot, latestResourceSnapshot); err != nil {
+		return false, err
+	}
 	klog.V(2).InfoS("Updated placement status for each individual cluster", "selectedNoCluster", len(selected), "unselectedNoCluster", len(unselected), "failedToScheduleClusterCount", failedToScheduleClusterCount, "placement", klog.KObj(placementObj))
 
 	// Prepare the conditions for the placement object itself.
@@ -849,14 +922,25 @@ func buildPerClusterPlacementStatusMap(placementObj fleetv1beta1.PlacementObj) m
 
 // isRolloutCompleted checks if the placement rollout is completed for both CRP and RP which means:
 // 1. Placement Scheduled condition is true.
-// 2. All expected placement conditions are true depends on what type of policy placementObj has.
+// 2. All expected placement conditions are true depends on what type of policy placementObj has,
+// up to the ready condition selected by the readiness policy of the placement (if any).
 func isRolloutCompleted(placementObj fleetv1beta1.PlacementObj) bool {
+	return areConditionsTrue(placementObj, determineReadinessCondTypes(placementObj))
+}
+
+// areAllExpectedConditionsTrue checks if the placement Scheduled condition and all the expected placement
+// conditions are true, regardless of the readiness policy of the placement.
+func areAllExpectedConditionsTrue(placementObj fleetv1beta1.PlacementObj) bool {
+	return areConditionsTrue(placementObj, determineExpectedPlacementAndResourcePlacementStatusCondType(placementObj))
+}
+
+// areConditionsTrue checks if the placement Scheduled condition and the given placement conditions are true.
+func areConditionsTrue(placementObj fleetv1beta1.PlacementObj, expectedCondTypes []condition.ResourceCondition) bool {
 	scheduledConditionType := getPlacementScheduledConditionType(placementObj)
 	if !condition.IsConditionStatusTrue(placementObj.GetCondition(scheduledConditionType), placementObj.GetGeneration()) {
 		return false
 	}
 
-	expectedCondTypes := determineExpectedPlacementAndResourcePlacementStatusCondType(placementObj)
 	for _, i := range expectedCondTypes {
 		conditionType := getPlacementConditionType(placementObj, i)
 		if !condition.IsConditionStatusTrue(placementObj.GetCondition(conditionType), placementObj.GetGeneration()) {
@@ -870,19 +954,21 @@ func emitPlacementStatusMetric(placementObj fleetv1beta1.PlacementObj) {
 	// Check Placement Scheduled condition.
 	status := "nil"
 	reason := "nil"
+	reasonCategory := "nil"
 	scheduledConditionType := getPlacementScheduledConditionType(placementObj)
 	cond := placementObj.GetCondition(scheduledConditionType)
 	if !condition.IsConditionStatusTrue(cond, placementObj.GetGeneration()) {
 		if cond != nil && cond.ObservedGeneration == placementObj.GetGeneration() {
 			status = string(cond.Status)
 			reason = cond.Reason
+			reasonCategory = string(condition.CategoryOf(cond.Reason))
 		}
-		hubmetrics.FleetPlacementStatusLastTimeStampSeconds.WithLabelValues(placementObj.GetNamespace(), placementObj.GetName(), strconv.FormatInt(placementObj.GetGeneration(), 10), scheduledConditionType, status, reason).SetToCurrentTime()
+		hubmetrics.FleetPlacementStatusLastTimeStampSeconds.WithLabelValues(placementObj.GetNamespace(), placementObj.GetName(), strconv.FormatInt(placementObj.GetGeneration(), 10), scheduledConditionType, status, reason, reasonCategory).SetToCurrentTime()
 		return
 	}
 
-	// Check placement expected conditions.
-	expectedCondTypes := determineExpectedPlacementAndResourcePlacementStatusCondType(placementObj)
+	// Check placement expected conditions, up to the ready condition selected by the readiness policy (if any).
+	expectedCondTypes := determineReadinessCondTypes(placementObj)
 	for _, condType := range expectedCondTypes {
 		conditionType := getPlacementConditionType(placementObj, condType)
 		cond = placementObj.GetCondition(conditionType)
@@ -890,13 +976,14 @@ func emitPlacementStatusMetric(placementObj fleetv1beta1.PlacementObj) {
 			if cond != nil && cond.ObservedGeneration == placementObj.GetGeneration() {
 				status = string(cond.Status)
 				reason = cond.Reason
+				reasonCategory = string(condition.CategoryOf(cond.Reason))
 			}
-			hubmetrics.FleetPlacementStatusLastTimeStampSeconds.WithLabelValues(placementObj.GetNamespace(), placementObj.GetName(), strconv.FormatInt(placementObj.GetGeneration(), 10), conditionType, status, reason).SetToCurrentTime()
+			hubmetrics.FleetPlacementStatusLastTimeStampSeconds.WithLabelValues(placementObj.GetNamespace(), placementObj.GetName(), strconv.FormatInt(placementObj.GetGeneration(), 10), conditionType, status, reason, reasonCategory).SetToCurrentTime()
 			return
 		}
 	}
 
 	// Emit the "Completed" condition metric to indicate that the placement has completed.
 	// This condition is used solely for metric reporting purposes.
-	hubmetrics.FleetPlacementStatusLastTimeStampSeconds.WithLabelValues(placementObj.GetNamespace(), placementObj.GetName(), strconv.FormatInt(placementObj.GetGeneration(), 10), "Completed", string(metav1.ConditionTrue), "Completed").SetToCurrentTime()
+	hubmetrics.FleetPlacementStatusLastTimeStampSeconds.WithLabelValues(placementObj.GetNamespace(), placementObj.GetName(), strconv.FormatInt(placementObj.GetGeneration(), 10), "Completed", string(metav1.ConditionTrue), "Completed", string(condition.ReasonCategorySucceeded)).SetToCurrentTime()
 }
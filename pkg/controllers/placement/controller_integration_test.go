@@ -483,6 +483,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementScheduledConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.SchedulingUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -496,6 +497,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementRolloutStartedConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To("nil")},
 						{Name: ptr.To("reason"), Value: ptr.To("nil")},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To("nil")},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -541,6 +543,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementScheduledConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.SchedulingUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -554,6 +557,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementScheduledConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionFalse))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.ResourceScheduleFailedReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryFailed))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -651,6 +655,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementScheduledConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.SchedulingUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -664,6 +669,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementRolloutStartedConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.RolloutStartedUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -768,6 +774,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 					{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementWorkSynchronizedConditionType))},
 					{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 					{Name: ptr.To("reason"), Value: ptr.To(condition.WorkSynchronizedUnknownReason)},
+					{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 				},
 				Gauge: &prometheusclientmodel.Gauge{
 					Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -862,6 +869,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementScheduledConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.SchedulingUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -875,6 +883,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementRolloutStartedConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.RolloutStartedUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -979,6 +988,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 					{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementWorkSynchronizedConditionType))},
 					{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 					{Name: ptr.To("reason"), Value: ptr.To(condition.WorkSynchronizedUnknownReason)},
+					{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 				},
 				Gauge: &prometheusclientmodel.Gauge{
 					Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1042,6 +1052,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 					{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementScheduledConditionType))},
 					{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 					{Name: ptr.To("reason"), Value: ptr.To(condition.SchedulingUnknownReason)},
+					{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 				},
 				Gauge: &prometheusclientmodel.Gauge{
 					Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1164,6 +1175,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 					{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementWorkSynchronizedConditionType))},
 					{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 					{Name: ptr.To("reason"), Value: ptr.To(condition.WorkSynchronizedUnknownReason)},
+					{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 				},
 				Gauge: &prometheusclientmodel.Gauge{
 					Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1286,6 +1298,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementScheduledConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.SchedulingUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1299,6 +1312,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementRolloutStartedConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.RolloutStartedUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1312,6 +1326,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementWorkSynchronizedConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.WorkSynchronizedUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1356,6 +1371,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 					{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementAppliedConditionType))},
 					{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 					{Name: ptr.To("reason"), Value: ptr.To(condition.ApplyPendingReason)},
+					{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 				},
 				Gauge: &prometheusclientmodel.Gauge{
 					Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1399,6 +1415,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 					{Name: ptr.To("conditionType"), Value: ptr.To("Completed")},
 					{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionTrue))},
 					{Name: ptr.To("reason"), Value: ptr.To("Completed")},
+					{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategorySucceeded))},
 				},
 				Gauge: &prometheusclientmodel.Gauge{
 					Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1585,6 +1602,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementScheduledConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.SchedulingUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1598,6 +1616,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementRolloutStartedConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.RolloutStartedUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1611,6 +1630,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementWorkSynchronizedConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.WorkSynchronizedUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1655,6 +1675,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 					{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementDiffReportedConditionType))},
 					{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 					{Name: ptr.To("reason"), Value: ptr.To(condition.DiffReportedStatusUnknownReason)},
+					{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 				},
 				Gauge: &prometheusclientmodel.Gauge{
 					Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1772,6 +1793,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementScheduledConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.SchedulingUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1785,6 +1807,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementRolloutStartedConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.RolloutStartedUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1798,6 +1821,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementWorkSynchronizedConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.WorkSynchronizedUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1916,6 +1940,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To("Completed")},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionTrue))},
 						{Name: ptr.To("reason"), Value: ptr.To("Completed")},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategorySucceeded))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -1987,6 +2012,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementScheduledConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionFalse))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.InvalidResourceSelectorsReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryUserError))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -2076,6 +2102,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 						{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementScheduledConditionType))},
 						{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 						{Name: ptr.To("reason"), Value: ptr.To(condition.SchedulingUnknownReason)},
+						{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 					},
 					Gauge: &prometheusclientmodel.Gauge{
 						Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
@@ -2152,6 +2179,7 @@ var _ = Describe("Test ClusterResourcePlacement Controller", func() {
 					{Name: ptr.To("conditionType"), Value: ptr.To(string(placementv1beta1.ClusterResourcePlacementRolloutStartedConditionType))},
 					{Name: ptr.To("status"), Value: ptr.To(string(corev1.ConditionUnknown))},
 					{Name: ptr.To("reason"), Value: ptr.To(condition.RolloutControlledByExternalControllerReason)},
+					{Name: ptr.To("reasonCategory"), Value: ptr.To(string(condition.ReasonCategoryInProgress))},
 				},
 				Gauge: &prometheusclientmodel.Gauge{
 					Value: ptr.To(float64(time.Now().UnixNano()) / 1e9),
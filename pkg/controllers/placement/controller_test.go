@@ -1056,6 +1056,114 @@ func TestGetOrCreateClusterSchedulingPolicySnapshot_failure(t *testing.T) {
 	}
 }
 
+func TestDeleteRedundantSchedulingPolicySnapshots(t *testing.T) {
+	policySnapshot := func(index int, scheduled bool) fleetv1beta1.ClusterSchedulingPolicySnapshot {
+		snapshot := fleetv1beta1.ClusterSchedulingPolicySnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf(fleetv1beta1.PolicySnapshotNameFmt, testCRPName, index),
+				Labels: map[string]string{
+					fleetv1beta1.PolicyIndexLabel:       strconv.Itoa(index),
+					fleetv1beta1.IsLatestSnapshotLabel:  "false",
+					fleetv1beta1.PlacementTrackingLabel: testCRPName,
+				},
+			},
+		}
+		if scheduled {
+			snapshot.Status.Conditions = []metav1.Condition{
+				{
+					Type:   string(fleetv1beta1.PolicySnapshotScheduled),
+					Status: metav1.ConditionTrue,
+					Reason: "Scheduled",
+				},
+			}
+		}
+		return snapshot
+	}
+	tests := []struct {
+		name                 string
+		revisionHistoryLimit int
+		policySnapshots      []fleetv1beta1.ClusterSchedulingPolicySnapshot
+		wantSnapshotNames    []string
+	}{
+		{
+			name:                 "number of snapshots is below the limit",
+			revisionHistoryLimit: 3,
+			policySnapshots: []fleetv1beta1.ClusterSchedulingPolicySnapshot{
+				policySnapshot(0, true),
+				policySnapshot(1, true),
+			},
+			wantSnapshotNames: []string{"my-crp-0", "my-crp-1"},
+		},
+		{
+			name:                 "number of snapshots has reached the limit",
+			revisionHistoryLimit: 2,
+			policySnapshots: []fleetv1beta1.ClusterSchedulingPolicySnapshot{
+				policySnapshot(0, true),
+				policySnapshot(1, true),
+				policySnapshot(2, true),
+			},
+			wantSnapshotNames: []string{"my-crp-2"},
+		},
+		{
+			name:                 "unscheduled snapshots are compacted",
+			revisionHistoryLimit: 4,
+			policySnapshots: []fleetv1beta1.ClusterSchedulingPolicySnapshot{
+				policySnapshot(0, true),
+				policySnapshot(1, false),
+				policySnapshot(2, false),
+				policySnapshot(3, true),
+				policySnapshot(4, false),
+			},
+			// The most recent snapshot is kept even if it has not been scheduled yet.
+			wantSnapshotNames: []string{"my-crp-0", "my-crp-3", "my-crp-4"},
+		},
+		{
+			name:                 "unscheduled snapshots are compacted before the limit applies",
+			revisionHistoryLimit: 2,
+			policySnapshots: []fleetv1beta1.ClusterSchedulingPolicySnapshot{
+				policySnapshot(0, true),
+				policySnapshot(1, false),
+				policySnapshot(2, true),
+			},
+			wantSnapshotNames: []string{"my-crp-2"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			crp := clusterResourcePlacementForTest()
+			objects := []client.Object{crp}
+			for i := range tc.policySnapshots {
+				objects = append(objects, &tc.policySnapshots[i])
+			}
+			scheme := serviceScheme(t)
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(objects...).
+				Build()
+			r := Reconciler{
+				Client:   fakeClient,
+				Scheme:   scheme,
+				Recorder: record.NewFakeRecorder(10),
+			}
+			if err := r.deleteRedundantSchedulingPolicySnapshots(ctx, crp, tc.revisionHistoryLimit); err != nil {
+				t.Fatalf("deleteRedundantSchedulingPolicySnapshots() got error %v, want no error", err)
+			}
+			clusterPolicySnapshotList := &fleetv1beta1.ClusterSchedulingPolicySnapshotList{}
+			if err := fakeClient.List(ctx, clusterPolicySnapshotList); err != nil {
+				t.Fatalf("clusterPolicySnapshot List() got error %v, want no error", err)
+			}
+			gotSnapshotNames := make([]string, 0, len(clusterPolicySnapshotList.Items))
+			for _, snapshot := range clusterPolicySnapshotList.Items {
+				gotSnapshotNames = append(gotSnapshotNames, snapshot.Name)
+			}
+			if diff := cmp.Diff(tc.wantSnapshotNames, gotSnapshotNames, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("clusterPolicySnapshot names mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestHandleDelete(t *testing.T) {
 	tests := []struct {
 		name                  string
@@ -1326,9 +1434,10 @@ func TestHandleDelete(t *testing.T) {
 func TestIsRolloutComplete(t *testing.T) {
 	crpGeneration := int64(25)
 	tests := []struct {
-		name       string
-		conditions []metav1.Condition
-		want       bool
+		name            string
+		readinessPolicy *fleetv1beta1.ReadinessPolicy
+		conditions      []metav1.Condition
+		want            bool
 	}{
 		{
 			name: "rollout is completed",
@@ -1497,6 +1606,45 @@ func TestIsRolloutComplete(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "available condition is false, ready on applied",
+			readinessPolicy: &fleetv1beta1.ReadinessPolicy{
+				ReadyConditionType: fleetv1beta1.ReadyConditionTypeApplied,
+			},
+			conditions: []metav1.Condition{
+				{
+					Status:             metav1.ConditionTrue,
+					Type:               string(fleetv1beta1.ClusterResourcePlacementAppliedConditionType),
+					ObservedGeneration: crpGeneration,
+				},
+				{
+					Status:             metav1.ConditionFalse,
+					Type:               string(fleetv1beta1.ClusterResourcePlacementAvailableConditionType),
+					ObservedGeneration: crpGeneration,
+				},
+				{
+					Status:             metav1.ConditionTrue,
+					Type:               string(fleetv1beta1.ClusterResourcePlacementOverriddenConditionType),
+					ObservedGeneration: crpGeneration,
+				},
+				{
+					Status:             metav1.ConditionTrue,
+					Type:               string(fleetv1beta1.ClusterResourcePlacementRolloutStartedConditionType),
+					ObservedGeneration: crpGeneration,
+				},
+				{
+					Status:             metav1.ConditionTrue,
+					Type:               string(fleetv1beta1.ClusterResourcePlacementScheduledConditionType),
+					ObservedGeneration: crpGeneration,
+				},
+				{
+					Status:             metav1.ConditionTrue,
+					Type:               string(fleetv1beta1.ClusterResourcePlacementWorkSynchronizedConditionType),
+					ObservedGeneration: crpGeneration,
+				},
+			},
+			want: true,
+		},
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
@@ -1505,6 +1653,9 @@ func TestIsRolloutComplete(t *testing.T) {
 					Name:       testCRPName,
 					Generation: crpGeneration,
 				},
+				Spec: fleetv1beta1.PlacementSpec{
+					ReadinessPolicy: tc.readinessPolicy,
+				},
 				Status: fleetv1beta1.PlacementStatus{
 					Conditions: tc.conditions,
 				},
@@ -1517,6 +1668,98 @@ func TestIsRolloutComplete(t *testing.T) {
 	}
 }
 
+func TestAreAllExpectedConditionsTrue(t *testing.T) {
+	crpGeneration := int64(25)
+	trueConditions := func(availableStatus metav1.ConditionStatus) []metav1.Condition {
+		return []metav1.Condition{
+			{
+				Status:             metav1.ConditionTrue,
+				Type:               string(fleetv1beta1.ClusterResourcePlacementScheduledConditionType),
+				ObservedGeneration: crpGeneration,
+			},
+			{
+				Status:             metav1.ConditionTrue,
+				Type:               string(fleetv1beta1.ClusterResourcePlacementRolloutStartedConditionType),
+				ObservedGeneration: crpGeneration,
+			},
+			{
+				Status:             metav1.ConditionTrue,
+				Type:               string(fleetv1beta1.ClusterResourcePlacementOverriddenConditionType),
+				ObservedGeneration: crpGeneration,
+			},
+			{
+				Status:             metav1.ConditionTrue,
+				Type:               string(fleetv1beta1.ClusterResourcePlacementWorkSynchronizedConditionType),
+				ObservedGeneration: crpGeneration,
+			},
+			{
+				Status:             metav1.ConditionTrue,
+				Type:               string(fleetv1beta1.ClusterResourcePlacementAppliedConditionType),
+				ObservedGeneration: crpGeneration,
+			},
+			{
+				Status:             availableStatus,
+				Type:               string(fleetv1beta1.ClusterResourcePlacementAvailableConditionType),
+				ObservedGeneration: crpGeneration,
+			},
+		}
+	}
+	tests := []struct {
+		name            string
+		readinessPolicy *fleetv1beta1.ReadinessPolicy
+		conditions      []metav1.Condition
+		wantReady       bool
+		want            bool
+	}{
+		{
+			name:       "all the expected conditions are true",
+			conditions: trueConditions(metav1.ConditionTrue),
+			wantReady:  true,
+			want:       true,
+		},
+		{
+			name: "available condition is false, ready on applied",
+			readinessPolicy: &fleetv1beta1.ReadinessPolicy{
+				ReadyConditionType: fleetv1beta1.ReadyConditionTypeApplied,
+			},
+			conditions: trueConditions(metav1.ConditionFalse),
+			wantReady:  true,
+			want:       false,
+		},
+		{
+			name: "all the expected conditions are true, ready on applied",
+			readinessPolicy: &fleetv1beta1.ReadinessPolicy{
+				ReadyConditionType: fleetv1beta1.ReadyConditionTypeApplied,
+			},
+			conditions: trueConditions(metav1.ConditionTrue),
+			wantReady:  true,
+			want:       true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       testCRPName,
+					Generation: crpGeneration,
+				},
+				Spec: fleetv1beta1.PlacementSpec{
+					ReadinessPolicy: tc.readinessPolicy,
+				},
+				Status: fleetv1beta1.PlacementStatus{
+					Conditions: tc.conditions,
+				},
+			}
+			if got := isRolloutCompleted(crp); got != tc.wantReady {
+				t.Errorf("isRolloutCompleted() got %v, want %v", got, tc.wantReady)
+			}
+			if got := areAllExpectedConditionsTrue(crp); got != tc.want {
+				t.Errorf("areAllExpectedConditionsTrue() got %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
 func TestDetermineRolloutStateForPlacementWithExternalRolloutStrategy(t *testing.T) {
 	namespaceResourceContent := *resource.NamespaceResourceContentForTest(t)
 	deploymentResourceContent := *resource.DeploymentResourceContentForTest(t)
@@ -2539,6 +2782,74 @@ func TestHandleResourceSnapshotByStrategy(t *testing.T) {
 			wantRequeueAfter: true,
 			wantErr:          false,
 		},
+		{
+			name: "RollingUpdate strategy returns the pinned snapshot of a rolled back placement",
+			crp: &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        testCRPName,
+					Generation:  1,
+					Annotations: map[string]string{fleetv1beta1.PinnedResourceSnapshotIndexAnnotation: "0"},
+				},
+				Spec: fleetv1beta1.PlacementSpec{
+					ResourceSelectors: []fleetv1beta1.ResourceSelectorTerm{
+						{
+							Group:   corev1.GroupName,
+							Version: "v1",
+							Kind:    "Namespace",
+						},
+					},
+					Strategy: fleetv1beta1.RolloutStrategy{
+						Type: fleetv1beta1.RollingUpdateRolloutStrategyType,
+					},
+				},
+			},
+			existingSnapshots: []client.Object{
+				&fleetv1beta1.ClusterResourceSnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, 0),
+						Labels: map[string]string{
+							fleetv1beta1.PlacementTrackingLabel: testCRPName,
+							fleetv1beta1.IsLatestSnapshotLabel:  strconv.FormatBool(true),
+							fleetv1beta1.ResourceIndexLabel:     "0",
+						},
+						Annotations: map[string]string{
+							fleetv1beta1.ResourceGroupHashAnnotation:         "old-hash-different-from-new",
+							fleetv1beta1.NumberOfResourceSnapshotsAnnotation: "1",
+						},
+					},
+					Spec: fleetv1beta1.ResourceSnapshotSpec{
+						SelectedResources: []fleetv1beta1.ResourceContent{
+							{
+								RawExtension: runtime.RawExtension{
+									Raw: []byte(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"pinned-ns"}}`),
+								},
+							},
+						},
+					},
+				},
+			},
+			selectedResources: []fleetv1beta1.ResourceContent{
+				{
+					RawExtension: runtime.RawExtension{
+						Raw: []byte(`{"apiVersion":"v1","kind":"Namespace","metadata":{"name":"new-ns"}}`),
+					},
+				},
+			},
+			selectedResourceIDs: []fleetv1beta1.ResourceIdentifier{{Kind: "Namespace", Name: "new-ns"}},
+			// A new snapshot is created for the changed resources, but the placement keeps rolling out the pinned one.
+			wantSnapshot:     true,
+			wantSnapshotName: fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, 0),
+			wantSelectedResourceIDs: []fleetv1beta1.ResourceIdentifier{
+				{
+					Group:   "",
+					Version: "v1",
+					Kind:    "Namespace",
+					Name:    "pinned-ns",
+				},
+			},
+			wantRequeueAfter: false,
+			wantErr:          false,
+		},
 	}
 
 	for _, tc := range tests {
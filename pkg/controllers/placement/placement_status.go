@@ -26,9 +26,11 @@ import (
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/klog/v2"
 
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/overrider"
 )
 
 // calculateFailedToScheduleClusterCount calculates the count of failed to schedule clusters based on the scheduling policy.
@@ -105,17 +107,86 @@ func buildFailedToSchedulePerClusterPlacementStatuses(
 // determineExpectedPlacementAndResourcePlacementStatusCondType determines the expected condition types for the CRP and resource placement statuses
 // given the currently in-use apply strategy.
 func determineExpectedPlacementAndResourcePlacementStatusCondType(placementObj fleetv1beta1.PlacementObj) []condition.ResourceCondition {
-	placementSpec := placementObj.GetPlacementSpec()
+	return condTypesForApplyStrategy(placementObj.GetPlacementSpec().Strategy.ApplyStrategy)
+}
+
+// condTypesForApplyStrategy returns the condition types expected for an apply strategy.
+func condTypesForApplyStrategy(applyStrategy *fleetv1beta1.ApplyStrategy) []condition.ResourceCondition {
 	switch {
-	case placementSpec.Strategy.ApplyStrategy == nil:
+	case applyStrategy == nil:
 		return condition.CondTypesForApplyStrategies
-	case placementSpec.Strategy.ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff:
+	case applyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff:
 		return condition.CondTypesForReportDiffApplyStrategy
 	default:
 		return condition.CondTypesForApplyStrategies
 	}
 }
 
+// determinePerClusterExpectedCondTypes determines the expected condition types for the per cluster
+// placement status of a cluster, which differ from the ones of the placement if an apply strategy
+// override of a different kind (i.e., reporting diffs vs. applying resources) selects the cluster.
+//
+// The placement conditions are still set based on the apply strategy of the placement; a cluster
+// only counts towards the placement conditions that it shares with the placement.
+func (r *Reconciler) determinePerClusterExpectedCondTypes(
+	ctx context.Context,
+	placementObj fleetv1beta1.PlacementObj,
+	clusterName string,
+	expectedCondTypes []condition.ResourceCondition,
+) ([]condition.ResourceCondition, error) {
+	strategy := placementObj.GetPlacementSpec().Strategy
+	if len(strategy.ApplyStrategyOverrides) == 0 {
+		return expectedCondTypes, nil
+	}
+
+	cluster := clusterv1beta1.MemberCluster{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: clusterName}, &cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The cluster has left the fleet; no works will be generated for the cluster anyway.
+			return expectedCondTypes, nil
+		}
+		klog.ErrorS(err, "Failed to get the member cluster", "memberCluster", clusterName, "placement", klog.KObj(placementObj))
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	applyStrategy, err := overrider.PickApplyStrategyForCluster(&cluster, strategy.ApplyStrategy, strategy.ApplyStrategyOverrides)
+	if err != nil {
+		// The cluster selectors have been validated by the webhook; normally this should never occur.
+		klog.ErrorS(controller.NewUnexpectedBehaviorError(err), "Failed to pick the apply strategy for the cluster", "memberCluster", clusterName, "placement", klog.KObj(placementObj))
+		return expectedCondTypes, nil
+	}
+	return condTypesForApplyStrategy(applyStrategy), nil
+}
+
+// determineReadinessCondTypes returns the placement condition types that need to be true for the placement
+// to be considered ready, as controlled by the readiness policy of the placement.
+func determineReadinessCondTypes(placementObj fleetv1beta1.PlacementObj) []condition.ResourceCondition {
+	expectedCondTypes := determineExpectedPlacementAndResourcePlacementStatusCondType(placementObj)
+	readinessPolicy := placementObj.GetPlacementSpec().ReadinessPolicy
+	if readinessPolicy == nil {
+		return expectedCondTypes
+	}
+
+	var readyCondType condition.ResourceCondition
+	switch readinessPolicy.ReadyConditionType {
+	case fleetv1beta1.ReadyConditionTypeApplied:
+		readyCondType = condition.AppliedCondition
+	case fleetv1beta1.ReadyConditionTypeAvailable:
+		readyCondType = condition.AvailableCondition
+	case fleetv1beta1.ReadyConditionTypeDiffReported:
+		readyCondType = condition.DiffReportedCondition
+	default:
+		return expectedCondTypes
+	}
+	for i, condType := range expectedCondTypes {
+		if condType == readyCondType {
+			return expectedCondTypes[:i+1]
+		}
+	}
+	// The ready condition is not expected by the apply strategy in use, which should have been
+	// rejected by the webhook; fall back to all the expected conditions.
+	return expectedCondTypes
+}
+
 // appendSelecteddPerClusterPlacementStatuses appends the per cluster placement statuses for the
 // scheduled clusters to the list of all per cluster placement statuses.
 // it returns the updated list of per cluster placement statuses.
@@ -128,6 +199,7 @@ func (r *Reconciler) buildSelectedPerClusterPlacementStatuses(
 	latestResourceSnapshot fleetv1beta1.ResourceSnapshotObj,
 ) (
 	[]fleetv1beta1.PerClusterPlacementStatus,
+	[]fleetv1beta1.PerClusterRolloutProgress,
 	[condition.TotalCondition][condition.TotalConditionStatus]int,
 	error,
 ) {
@@ -137,12 +209,16 @@ func (r *Reconciler) buildSelectedPerClusterPlacementStatuses(
 	oldPerClusterStatusMap := buildPerClusterPlacementStatusMap(placementObj)
 	clusterToBindingMap, err := r.buildClusterToBindingMap(ctx, placementObj, latestSchedulingPolicySnapshot)
 	if err != nil {
-		return nil, perClusterCondTypeCounter, err
+		return nil, nil, perClusterCondTypeCounter, err
 	}
 
 	resourceSnapshotIndexMap, err := r.findResourceSnapshotIndexForBindings(ctx, placementObj, clusterToBindingMap)
 	if err != nil {
-		return nil, perClusterCondTypeCounter, err
+		return nil, nil, perClusterCondTypeCounter, err
+	}
+	rolloutProgress, err := r.buildPerClusterRolloutProgress(ctx, placementObj, selected, clusterToBindingMap, resourceSnapshotIndexMap)
+	if err != nil {
+		return nil, nil, perClusterCondTypeCounter, err
 	}
 	allPerClusterStatuses := make([]fleetv1beta1.PerClusterPlacementStatus, 0, len(latestSchedulingPolicySnapshot.GetPolicySnapshotStatus().ClusterDecisions))
 
@@ -172,7 +248,11 @@ func (r *Reconciler) buildSelectedPerClusterPlacementStatuses(
 		// Prepare the new conditions.
 		binding := clusterToBindingMap[clusterDecision.ClusterName]
 		resourceSnapshotIndexOnBinding := resourceSnapshotIndexMap[clusterDecision.ClusterName]
-		setStatusByCondType := r.setPerClusterPlacementStatus(placementObj, latestResourceSnapshot, resourceSnapshotIndexOnBinding, binding, &perCluserStatus, expectedCondTypes)
+		clusterExpectedCondTypes, err := r.determinePerClusterExpectedCondTypes(ctx, placementObj, clusterDecision.ClusterName, expectedCondTypes)
+		if err != nil {
+			return nil, nil, perClusterCondTypeCounter, err
+		}
+		setStatusByCondType := r.setPerClusterPlacementStatus(placementObj, latestResourceSnapshot, resourceSnapshotIndexOnBinding, binding, &perCluserStatus, clusterExpectedCondTypes)
 
 		// Update the counter.
 		for condType, condStatus := range setStatusByCondType {
@@ -193,13 +273,16 @@ func (r *Reconciler) buildSelectedPerClusterPlacementStatuses(
 				meta.RemoveStatusCondition(&perCluserStatus.Conditions, string(i.PerClusterPlacementConditionType()))
 			}
 		}
+		setPerClusterQuotaFitCondition(placementObj, binding, &perCluserStatus)
+		setPerClusterAdmissionPreflightPassedCondition(placementObj, binding, &perCluserStatus)
+		setPerClusterCoOwnershipResolvedCondition(placementObj, binding, &perCluserStatus)
 		// The allRPS slice has been pre-allocated, so the append call will never produce a new
 		// slice; here, however, Fleet will still return the old slice just in case.
 		allPerClusterStatuses = append(allPerClusterStatuses, perCluserStatus)
 		klog.V(2).InfoS("Populated the resource placement status for the scheduled cluster", "placement", klog.KObj(placementObj), "cluster", clusterDecision.ClusterName, "resourcePlacementStatus", perCluserStatus)
 	}
 
-	return allPerClusterStatuses, perClusterCondTypeCounter, nil
+	return allPerClusterStatuses, rolloutProgress, perClusterCondTypeCounter, nil
 }
 
 // setPlacementConditions sets the CRP/RP conditions based on the resource placement statuses.
@@ -333,6 +416,89 @@ func (r *Reconciler) findResourceSnapshotIndexForBindings(
 	return res, nil
 }
 
+// buildPerClusterRolloutProgress builds the compact rollout progress table for the selected clusters,
+// i.e., the resource snapshot index and the override snapshots that are desired on each cluster (as
+// found in the binding spec) and the ones that have last been applied (as recorded in the binding status).
+func (r *Reconciler) buildPerClusterRolloutProgress(
+	ctx context.Context,
+	placementObj fleetv1beta1.PlacementObj,
+	selected []*fleetv1beta1.ClusterDecision,
+	clusterToBindingMap map[string]fleetv1beta1.BindingObj,
+	resourceSnapshotIndexMap map[string]string,
+) ([]fleetv1beta1.PerClusterRolloutProgress, error) {
+	// Bindings usually share the same few resource snapshots; track the indices found so far by
+	// snapshot name to avoid repeated lookups.
+	indexBySnapshotName := make(map[string]string, len(clusterToBindingMap))
+	for clusterName, binding := range clusterToBindingMap {
+		indexBySnapshotName[binding.GetBindingSpec().ResourceSnapshotName] = resourceSnapshotIndexMap[clusterName]
+	}
+
+	progress := make([]fleetv1beta1.PerClusterRolloutProgress, 0, len(selected))
+	for _, clusterDecision := range selected {
+		entry := fleetv1beta1.PerClusterRolloutProgress{ClusterName: clusterDecision.ClusterName}
+		binding := clusterToBindingMap[clusterDecision.ClusterName]
+		if binding == nil {
+			// The binding has not been created yet.
+			progress = append(progress, entry)
+			continue
+		}
+
+		bindingSpec := binding.GetBindingSpec()
+		entry.DesiredResourceIndex = resourceSnapshotIndexMap[clusterDecision.ClusterName]
+		entry.DesiredOverrideSnapshots = formatOverrideSnapshots(bindingSpec.ClusterResourceOverrideSnapshots, bindingSpec.ResourceOverrideSnapshots)
+		if applied := binding.GetBindingStatus().LastAppliedSnapshots; applied != nil {
+			appliedIndex, found := indexBySnapshotName[applied.ResourceSnapshotName]
+			if !found {
+				var err error
+				if appliedIndex, err = r.findResourceSnapshotIndex(ctx, placementObj, applied.ResourceSnapshotName); err != nil {
+					return nil, err
+				}
+				indexBySnapshotName[applied.ResourceSnapshotName] = appliedIndex
+			}
+			entry.AppliedResourceIndex = appliedIndex
+			entry.AppliedOverrideSnapshots = formatOverrideSnapshots(applied.ClusterResourceOverrideSnapshots, applied.ResourceOverrideSnapshots)
+		}
+		progress = append(progress, entry)
+	}
+	return progress, nil
+}
+
+// findResourceSnapshotIndex finds the index of a resource snapshot by its name; it returns an empty
+// index if the snapshot cannot be found (e.g., it has been deleted due to the revision history limit).
+func (r *Reconciler) findResourceSnapshotIndex(ctx context.Context, placementObj fleetv1beta1.PlacementObj, resourceSnapshotName string) (string, error) {
+	if resourceSnapshotName == "" {
+		return "", nil
+	}
+	var resourceSnapshotObj fleetv1beta1.ResourceSnapshotObj
+	if isClusterScopedPlacement(placementObj) {
+		resourceSnapshotObj = &fleetv1beta1.ClusterResourceSnapshot{}
+	} else {
+		resourceSnapshotObj = &fleetv1beta1.ResourceSnapshot{}
+	}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: resourceSnapshotName, Namespace: placementObj.GetNamespace()}, resourceSnapshotObj); err != nil {
+		if apierrors.IsNotFound(err) {
+			return "", nil
+		}
+		klog.ErrorS(err, "Failed to get the resource snapshot", "resourceSnapshotName", resourceSnapshotName, "placement", klog.KObj(placementObj))
+		return "", controller.NewAPIServerError(true, err)
+	}
+	return resourceSnapshotObj.GetLabels()[fleetv1beta1.ResourceIndexLabel], nil
+}
+
+// formatOverrideSnapshots lists the override snapshots in a compact form, i.e., ClusterResourceOverride
+// snapshots by name and ResourceOverride snapshots as namespace/name.
+func formatOverrideSnapshots(clusterResourceOverrideSnapshots []string, resourceOverrideSnapshots []fleetv1beta1.NamespacedName) []string {
+	if len(clusterResourceOverrideSnapshots)+len(resourceOverrideSnapshots) == 0 {
+		return nil
+	}
+	res := make([]string, 0, len(clusterResourceOverrideSnapshots)+len(resourceOverrideSnapshots))
+	res = append(res, clusterResourceOverrideSnapshots...)
+	for _, ro := range resourceOverrideSnapshots {
+		res = append(res, fmt.Sprintf("%s/%s", ro.Namespace, ro.Name))
+	}
+	return res
+}
+
 // setPerClusterPlacementStatus sets the resource related fields for each cluster.
 // It returns a map which tracks the set status for each relevant condition type.
 func (r *Reconciler) setPerClusterPlacementStatus(
@@ -390,6 +556,23 @@ func (r *Reconciler) setPerClusterPlacementStatus(
 	}
 }
 
+// setFailedPlacementsBasedOnBinding copies the failed placements reported on the binding to the placement status,
+// truncating the list at the limit set in the placement spec (if any) and counting the ones left out as overflow.
+func setFailedPlacementsBasedOnBinding(
+	placementObj fleetv1beta1.PlacementObj,
+	binding fleetv1beta1.BindingObj,
+	status *fleetv1beta1.PerClusterPlacementStatus,
+) {
+	failedPlacements := binding.GetBindingStatus().FailedPlacements
+	overflowCount := binding.GetBindingStatus().FailedPlacementOverflowCount
+	if limit := placementObj.GetPlacementSpec().FailedPlacementsLimit; limit != nil && *limit >= 0 && len(failedPlacements) > int(*limit) {
+		overflowCount += int32(len(failedPlacements)) - *limit //nolint:gosec // the list is capped at 100 items on the binding
+		failedPlacements = failedPlacements[:*limit]
+	}
+	status.FailedPlacements = failedPlacements
+	status.FailedPlacementOverflowCount = overflowCount
+}
+
 // setResourcePlacementStatusBasedOnBinding sets the placement status based on its corresponding binding status.
 // It updates the status object in place and tracks the set status for each relevant condition type in setStatusByCondType map provided.
 func setResourcePlacementStatusBasedOnBinding(
@@ -414,11 +597,17 @@ func setResourcePlacementStatusBasedOnBinding(
 			if bindingCond.Status == metav1.ConditionTrue {
 				status.ApplicableResourceOverrides = binding.GetBindingSpec().ResourceOverrideSnapshots
 				status.ApplicableClusterResourceOverrides = binding.GetBindingSpec().ClusterResourceOverrideSnapshots
+				status.EffectiveOverrides = binding.GetBindingStatus().EffectiveOverrides
 			}
 		case condition.AppliedCondition, condition.AvailableCondition:
 			if bindingCond.Status == metav1.ConditionFalse {
-				status.FailedPlacements = binding.GetBindingStatus().FailedPlacements
+				setFailedPlacementsBasedOnBinding(placementObj, binding, status)
 				status.DiffedPlacements = binding.GetBindingStatus().DiffedPlacements
+				status.DiffedPlacementSummary = binding.GetBindingStatus().DiffedPlacementSummary
+			} else if len(binding.GetBindingStatus().FailedPlacements) > 0 {
+				// Some manifests have failed to apply, but the failures are tolerated by the
+				// apply strategy.
+				setFailedPlacementsBasedOnBinding(placementObj, binding, status)
 			}
 			// Note that configuration drifts can occur whether the manifests are applied
 			// successfully or not.
@@ -426,6 +615,7 @@ func setResourcePlacementStatusBasedOnBinding(
 		case condition.DiffReportedCondition:
 			if bindingCond.Status == metav1.ConditionTrue {
 				status.DiffedPlacements = binding.GetBindingStatus().DiffedPlacements
+				status.DiffedPlacementSummary = binding.GetBindingStatus().DiffedPlacementSummary
 			}
 		}
 
@@ -445,6 +635,65 @@ func setResourcePlacementStatusBasedOnBinding(
 	}
 }
 
+// setPerClusterQuotaFitCondition sets the QuotaFit condition in the per cluster placement status
+// based on the corresponding binding, or removes it if the binding has not reported one for its
+// current generation.
+//
+// Unlike the other per cluster conditions, the QuotaFit condition is not part of the sequence of
+// conditions that track the rollout progress; it explains why the apply op has failed.
+func setPerClusterQuotaFitCondition(placementObj fleetv1beta1.PlacementObj, binding fleetv1beta1.BindingObj, status *fleetv1beta1.PerClusterPlacementStatus) {
+	setPerClusterConditionFromBinding(placementObj, binding, status, fleetv1beta1.ResourceBindingQuotaFit, fleetv1beta1.PerClusterQuotaFitConditionType)
+}
+
+// setPerClusterAdmissionPreflightPassedCondition sets the AdmissionPreflightPassed condition in the per
+// cluster placement status based on the corresponding binding, or removes it if the binding has not
+// reported one for its current generation.
+//
+// Like the QuotaFit condition, the AdmissionPreflightPassed condition is not part of the sequence of
+// conditions that track the rollout progress; it reports the manifests that the admission webhooks
+// of the member cluster reject.
+func setPerClusterAdmissionPreflightPassedCondition(placementObj fleetv1beta1.PlacementObj, binding fleetv1beta1.BindingObj, status *fleetv1beta1.PerClusterPlacementStatus) {
+	setPerClusterConditionFromBinding(placementObj, binding, status, fleetv1beta1.ResourceBindingAdmissionPreflightPassed, fleetv1beta1.PerClusterAdmissionPreflightPassedConditionType)
+}
+
+// setPerClusterCoOwnershipResolvedCondition sets the CoOwnershipResolved condition in the per cluster
+// placement status based on the corresponding binding, or removes it if the binding has not reported
+// one for its current generation.
+//
+// Like the QuotaFit condition, the CoOwnershipResolved condition is not part of the sequence of
+// conditions that track the rollout progress; it reports the resources that are also selected by
+// other placements for the same cluster.
+func setPerClusterCoOwnershipResolvedCondition(placementObj fleetv1beta1.PlacementObj, binding fleetv1beta1.BindingObj, status *fleetv1beta1.PerClusterPlacementStatus) {
+	setPerClusterConditionFromBinding(placementObj, binding, status, fleetv1beta1.ResourceBindingCoOwnershipResolved, fleetv1beta1.PerClusterCoOwnershipResolvedConditionType)
+}
+
+// setPerClusterConditionFromBinding copies a binding condition of the given type to the per cluster
+// placement status as the condition of the given per cluster type, or removes the latter if the binding
+// has not reported the condition for its current generation.
+func setPerClusterConditionFromBinding(
+	placementObj fleetv1beta1.PlacementObj,
+	binding fleetv1beta1.BindingObj,
+	status *fleetv1beta1.PerClusterPlacementStatus,
+	bindingCondType fleetv1beta1.ResourceBindingConditionType,
+	perClusterCondType fleetv1beta1.PerClusterPlacementConditionType,
+) {
+	var bindingCond *metav1.Condition
+	if binding != nil {
+		bindingCond = binding.GetCondition(string(bindingCondType))
+	}
+	if bindingCond == nil || bindingCond.ObservedGeneration != binding.GetGeneration() {
+		meta.RemoveStatusCondition(&status.Conditions, string(perClusterCondType))
+		return
+	}
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               string(perClusterCondType),
+		Status:             bindingCond.Status,
+		ObservedGeneration: placementObj.GetGeneration(),
+		Reason:             bindingCond.Reason,
+		Message:            bindingCond.Message,
+	})
+}
+
 // isClusterScopedPlacement returns true if the placement is cluster-scoped (namespace is empty), false otherwise.
 func isClusterScopedPlacement(placementObj fleetv1beta1.PlacementObj) bool {
 	return placementObj.GetNamespace() == ""
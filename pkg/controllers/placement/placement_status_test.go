@@ -33,6 +33,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/test/utils/resource"
@@ -1254,6 +1255,11 @@ func TestSetPlacementStatusForClusterResourcePlacement(t *testing.T) {
 						TargetCluster:                    "member-1",
 					},
 					Status: fleetv1beta1.ResourceBindingStatus{
+						EffectiveOverrides: []fleetv1beta1.EffectiveOverride{
+							{Name: "o-1"},
+							{Name: "override-1", Namespace: "override-ns", PriorityTier: fleetv1beta1.OverridePriorityTierApp},
+							{Name: "o-2", PriorityTier: fleetv1beta1.OverridePriorityTierPlatform},
+						},
 						Conditions: []metav1.Condition{
 							{
 								Status:             metav1.ConditionTrue,
@@ -1342,6 +1348,11 @@ func TestSetPlacementStatusForClusterResourcePlacement(t *testing.T) {
 						ClusterName:                        "member-1",
 						ObservedResourceIndex:              "0",
 						ApplicableClusterResourceOverrides: []string{"o-1", "o-2"},
+						EffectiveOverrides: []fleetv1beta1.EffectiveOverride{
+							{Name: "o-1"},
+							{Name: "override-1", Namespace: "override-ns", PriorityTier: fleetv1beta1.OverridePriorityTierApp},
+							{Name: "o-2", PriorityTier: fleetv1beta1.OverridePriorityTierPlatform},
+						},
 						ApplicableResourceOverrides: []fleetv1beta1.NamespacedName{
 							{
 								Name:      "override-1",
@@ -6013,7 +6024,9 @@ func TestSetPlacementStatusForClusterResourcePlacement(t *testing.T) {
 				t.Errorf("setPlacementStatus() = %v, want %v", got, tc.want)
 			}
 
-			if diff := cmp.Diff(tc.wantStatus, &crp.Status, statusCmpOptions...); diff != "" {
+			// The per-cluster rollout progress is verified in TestBuildPerClusterRolloutProgress, and the rollout
+			// impact estimate is verified in TestSetRolloutImpactEstimate.
+			if diff := cmp.Diff(tc.wantStatus, &crp.Status, append(statusCmpOptions, cmpopts.IgnoreFields(fleetv1beta1.PlacementStatus{}, "PerClusterRolloutProgress", "RolloutImpactEstimate"))...); diff != "" {
 				t.Errorf("setPlacementStatus() status mismatch (-want, +got):\n%s", diff)
 			}
 		})
@@ -6700,7 +6713,9 @@ func TestSetResourcePlacementStatus(t *testing.T) {
 				t.Errorf("setPlacementStatus() = %v, want %v", got, tc.want)
 			}
 
-			if diff := cmp.Diff(tc.wantStatus, &rp.Status, statusCmpOptions...); diff != "" {
+			// The per-cluster rollout progress is verified in TestBuildPerClusterRolloutProgress, and the rollout
+			// impact estimate is verified in TestSetRolloutImpactEstimate.
+			if diff := cmp.Diff(tc.wantStatus, &rp.Status, append(statusCmpOptions, cmpopts.IgnoreFields(fleetv1beta1.PlacementStatus{}, "PerClusterRolloutProgress", "RolloutImpactEstimate"))...); diff != "" {
 				t.Errorf("setPlacementStatus() status mismatch (-want, +got):\n%s", diff)
 			}
 		})
@@ -9776,6 +9791,339 @@ func TestFindResourceSnapshotIndexForBindings(t *testing.T) {
 	}
 }
 
+func TestBuildPerClusterRolloutProgress(t *testing.T) {
+	crp := fleetv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: testCRPName,
+		},
+	}
+	snapshotName := func(index int) string {
+		return fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, index)
+	}
+	resourceSnapshots := []client.Object{
+		&fleetv1beta1.ClusterResourceSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: snapshotName(1),
+				Labels: map[string]string{
+					fleetv1beta1.ResourceIndexLabel:     "1",
+					fleetv1beta1.PlacementTrackingLabel: testCRPName,
+				},
+			},
+		},
+		&fleetv1beta1.ClusterResourceSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: snapshotName(2),
+				Labels: map[string]string{
+					fleetv1beta1.ResourceIndexLabel:     "2",
+					fleetv1beta1.PlacementTrackingLabel: testCRPName,
+				},
+			},
+		},
+	}
+	selected := []*fleetv1beta1.ClusterDecision{
+		{ClusterName: "member-1", Selected: true},
+		{ClusterName: "member-2", Selected: true},
+		{ClusterName: "member-3", Selected: true},
+		{ClusterName: "member-4", Selected: true},
+	}
+	clusterToBindingMap := map[string]fleetv1beta1.BindingObj{
+		// The rollout has completed.
+		"member-1": &fleetv1beta1.ClusterResourceBinding{
+			Spec: fleetv1beta1.ResourceBindingSpec{
+				ResourceSnapshotName:             snapshotName(2),
+				ClusterResourceOverrideSnapshots: []string{"cro-1"},
+				ResourceOverrideSnapshots:        []fleetv1beta1.NamespacedName{{Namespace: "app", Name: "ro-1"}},
+			},
+			Status: fleetv1beta1.ResourceBindingStatus{
+				LastAppliedSnapshots: &fleetv1beta1.BindingSnapshots{
+					ResourceSnapshotName:             snapshotName(2),
+					ClusterResourceOverrideSnapshots: []string{"cro-1"},
+					ResourceOverrideSnapshots:        []fleetv1beta1.NamespacedName{{Namespace: "app", Name: "ro-1"}},
+				},
+			},
+		},
+		// The rollout is in progress.
+		"member-2": &fleetv1beta1.ClusterResourceBinding{
+			Spec: fleetv1beta1.ResourceBindingSpec{
+				ResourceSnapshotName: snapshotName(2),
+			},
+			Status: fleetv1beta1.ResourceBindingStatus{
+				LastAppliedSnapshots: &fleetv1beta1.BindingSnapshots{
+					ResourceSnapshotName:             snapshotName(1),
+					ClusterResourceOverrideSnapshots: []string{"cro-0"},
+				},
+			},
+		},
+		// The resources have never been applied.
+		"member-3": &fleetv1beta1.ClusterResourceBinding{
+			Spec: fleetv1beta1.ResourceBindingSpec{
+				ResourceSnapshotName: snapshotName(2),
+			},
+		},
+		// The binding of member-4 has not been created yet.
+	}
+	resourceSnapshotIndexMap := map[string]string{
+		"member-1": "2",
+		"member-2": "2",
+		"member-3": "2",
+	}
+
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(serviceScheme(t)).
+		WithObjects(resourceSnapshots...).
+		Build()
+	r := Reconciler{
+		Client: fakeClient,
+	}
+	got, err := r.buildPerClusterRolloutProgress(context.Background(), &crp, selected, clusterToBindingMap, resourceSnapshotIndexMap)
+	if err != nil {
+		t.Fatalf("buildPerClusterRolloutProgress() got err %v, want nil", err)
+	}
+	want := []fleetv1beta1.PerClusterRolloutProgress{
+		{
+			ClusterName:              "member-1",
+			DesiredResourceIndex:     "2",
+			AppliedResourceIndex:     "2",
+			DesiredOverrideSnapshots: []string{"cro-1", "app/ro-1"},
+			AppliedOverrideSnapshots: []string{"cro-1", "app/ro-1"},
+		},
+		{
+			ClusterName:              "member-2",
+			DesiredResourceIndex:     "2",
+			AppliedResourceIndex:     "1",
+			AppliedOverrideSnapshots: []string{"cro-0"},
+		},
+		{
+			ClusterName:          "member-3",
+			DesiredResourceIndex: "2",
+		},
+		{
+			ClusterName: "member-4",
+		},
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("buildPerClusterRolloutProgress() mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestSetPerClusterQuotaFitCondition(t *testing.T) {
+	crp := &fleetv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testCRPName,
+			Generation: 2,
+		},
+	}
+	staleCond := metav1.Condition{
+		Type:               string(fleetv1beta1.PerClusterQuotaFitConditionType),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: 1,
+	}
+	tests := []struct {
+		name      string
+		binding   fleetv1beta1.BindingObj
+		wantConds []metav1.Condition
+	}{
+		{
+			name: "binding reports quota exceeded",
+			binding: &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "binding", Generation: 3},
+				Status: fleetv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(fleetv1beta1.ResourceBindingQuotaFit),
+							Status:             metav1.ConditionFalse,
+							Reason:             condition.WorkQuotaExceededReason,
+							ObservedGeneration: 3,
+						},
+					},
+				},
+			},
+			wantConds: []metav1.Condition{
+				{
+					Type:               string(fleetv1beta1.PerClusterQuotaFitConditionType),
+					Status:             metav1.ConditionFalse,
+					Reason:             condition.WorkQuotaExceededReason,
+					ObservedGeneration: 2,
+				},
+			},
+		},
+		{
+			name: "binding condition is stale",
+			binding: &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "binding", Generation: 3},
+				Status: fleetv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(fleetv1beta1.ResourceBindingQuotaFit),
+							Status:             metav1.ConditionFalse,
+							ObservedGeneration: 2,
+						},
+					},
+				},
+			},
+		},
+		{
+			name: "no binding",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			status := &fleetv1beta1.PerClusterPlacementStatus{Conditions: []metav1.Condition{staleCond}}
+			setPerClusterQuotaFitCondition(crp, tc.binding, status)
+			if diff := cmp.Diff(tc.wantConds, status.Conditions, append(statusCmpOptions, cmpopts.EquateEmpty(), cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"))...); diff != "" {
+				t.Errorf("setPerClusterQuotaFitCondition() conditions mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetFailedPlacementsBasedOnBinding(t *testing.T) {
+	failedPlacements := []fleetv1beta1.FailedResourcePlacement{
+		{
+			ResourceIdentifier: fleetv1beta1.ResourceIdentifier{Version: "v1", Kind: "ConfigMap", Name: "cm-1", Namespace: "app"},
+			Condition:          metav1.Condition{Type: string(fleetv1beta1.ResourceBindingApplied), Status: metav1.ConditionFalse},
+		},
+		{
+			ResourceIdentifier: fleetv1beta1.ResourceIdentifier{Version: "v1", Kind: "ConfigMap", Name: "cm-2", Namespace: "app"},
+			Condition:          metav1.Condition{Type: string(fleetv1beta1.ResourceBindingApplied), Status: metav1.ConditionFalse},
+		},
+		{
+			ResourceIdentifier: fleetv1beta1.ResourceIdentifier{Version: "v1", Kind: "ConfigMap", Name: "cm-3", Namespace: "app"},
+			Condition:          metav1.Condition{Type: string(fleetv1beta1.ResourceBindingApplied), Status: metav1.ConditionFalse},
+		},
+	}
+	tests := []struct {
+		name              string
+		limit             *int32
+		bindingOverflow   int32
+		wantFailed        []fleetv1beta1.FailedResourcePlacement
+		wantOverflowCount int32
+	}{
+		{
+			name:       "no limit set",
+			wantFailed: failedPlacements,
+		},
+		{
+			name:              "no limit set, binding reports overflow",
+			bindingOverflow:   5,
+			wantFailed:        failedPlacements,
+			wantOverflowCount: 5,
+		},
+		{
+			name:       "limit above the number of failed placements",
+			limit:      ptr.To(int32(10)),
+			wantFailed: failedPlacements,
+		},
+		{
+			name:       "limit equal to the number of failed placements",
+			limit:      ptr.To(int32(3)),
+			wantFailed: failedPlacements,
+		},
+		{
+			name:              "limit below the number of failed placements",
+			limit:             ptr.To(int32(1)),
+			wantFailed:        failedPlacements[:1],
+			wantOverflowCount: 2,
+		},
+		{
+			name:              "limit below the number of failed placements, binding reports overflow",
+			limit:             ptr.To(int32(2)),
+			bindingOverflow:   5,
+			wantFailed:        failedPlacements[:2],
+			wantOverflowCount: 6,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: testCRPName},
+				Spec: fleetv1beta1.PlacementSpec{
+					FailedPlacementsLimit: tc.limit,
+				},
+			}
+			binding := &fleetv1beta1.ClusterResourceBinding{
+				Status: fleetv1beta1.ResourceBindingStatus{
+					FailedPlacements:             failedPlacements,
+					FailedPlacementOverflowCount: tc.bindingOverflow,
+				},
+			}
+			status := &fleetv1beta1.PerClusterPlacementStatus{}
+			setFailedPlacementsBasedOnBinding(crp, binding, status)
+			if diff := cmp.Diff(tc.wantFailed, status.FailedPlacements); diff != "" {
+				t.Errorf("setFailedPlacementsBasedOnBinding() failed placements mismatch (-want, +got):\n%s", diff)
+			}
+			if status.FailedPlacementOverflowCount != tc.wantOverflowCount {
+				t.Errorf("setFailedPlacementsBasedOnBinding() overflow count = %d, want %d", status.FailedPlacementOverflowCount, tc.wantOverflowCount)
+			}
+		})
+	}
+}
+
+func TestSetPerClusterCoOwnershipResolvedCondition(t *testing.T) {
+	rp := &fleetv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       testCRPName,
+			Namespace:  "test-ns",
+			Generation: 2,
+		},
+	}
+	tests := []struct {
+		name      string
+		binding   fleetv1beta1.BindingObj
+		wantConds []metav1.Condition
+	}{
+		{
+			name: "binding reports co-ownership conflict",
+			binding: &fleetv1beta1.ResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "test-ns", Generation: 3},
+				Status: fleetv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(fleetv1beta1.ResourceBindingCoOwnershipResolved),
+							Status:             metav1.ConditionFalse,
+							Reason:             condition.CoOwnershipConflictReason,
+							Message:            "conflict",
+							ObservedGeneration: 3,
+						},
+					},
+				},
+			},
+			wantConds: []metav1.Condition{
+				{
+					Type:               string(fleetv1beta1.PerClusterCoOwnershipResolvedConditionType),
+					Status:             metav1.ConditionFalse,
+					Reason:             condition.CoOwnershipConflictReason,
+					Message:            "conflict",
+					ObservedGeneration: 2,
+				},
+			},
+		},
+		{
+			name: "binding reports no co-owned resources",
+			binding: &fleetv1beta1.ResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "binding", Namespace: "test-ns", Generation: 3},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			status := &fleetv1beta1.PerClusterPlacementStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(fleetv1beta1.PerClusterCoOwnershipResolvedConditionType),
+						Status:             metav1.ConditionTrue,
+						ObservedGeneration: 1,
+					},
+				},
+			}
+			setPerClusterCoOwnershipResolvedCondition(rp, tc.binding, status)
+			if diff := cmp.Diff(tc.wantConds, status.Conditions, append(statusCmpOptions, cmpopts.EquateEmpty(), cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"))...); diff != "" {
+				t.Errorf("setPerClusterCoOwnershipResolvedCondition() conditions mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestIsClusterScopedPlacement(t *testing.T) {
 	tests := []struct {
 		name      string
@@ -9874,6 +10222,202 @@ func TestGetPlacementConditionType(t *testing.T) {
 	}
 }
 
+func TestDetermineReadinessCondTypes(t *testing.T) {
+	reportDiffStrategy := fleetv1beta1.RolloutStrategy{
+		ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+			Type: fleetv1beta1.ApplyStrategyTypeReportDiff,
+		},
+	}
+	tests := []struct {
+		name            string
+		strategy        fleetv1beta1.RolloutStrategy
+		readinessPolicy *fleetv1beta1.ReadinessPolicy
+		want            []condition.ResourceCondition
+	}{
+		{
+			name: "no readiness policy",
+			want: condition.CondTypesForApplyStrategies,
+		},
+		{
+			name:            "no readiness policy, report diff",
+			strategy:        reportDiffStrategy,
+			readinessPolicy: &fleetv1beta1.ReadinessPolicy{},
+			want:            condition.CondTypesForReportDiffApplyStrategy,
+		},
+		{
+			name: "ready on applied",
+			readinessPolicy: &fleetv1beta1.ReadinessPolicy{
+				ReadyConditionType: fleetv1beta1.ReadyConditionTypeApplied,
+			},
+			want: []condition.ResourceCondition{
+				condition.RolloutStartedCondition,
+				condition.OverriddenCondition,
+				condition.WorkSynchronizedCondition,
+				condition.AppliedCondition,
+			},
+		},
+		{
+			name: "ready on available",
+			readinessPolicy: &fleetv1beta1.ReadinessPolicy{
+				ReadyConditionType: fleetv1beta1.ReadyConditionTypeAvailable,
+			},
+			want: condition.CondTypesForApplyStrategies,
+		},
+		{
+			name:     "ready on diff reported, report diff",
+			strategy: reportDiffStrategy,
+			readinessPolicy: &fleetv1beta1.ReadinessPolicy{
+				ReadyConditionType: fleetv1beta1.ReadyConditionTypeDiffReported,
+			},
+			want: condition.CondTypesForReportDiffApplyStrategy,
+		},
+		{
+			name:     "ready on applied, report diff (fall back)",
+			strategy: reportDiffStrategy,
+			readinessPolicy: &fleetv1beta1.ReadinessPolicy{
+				ReadyConditionType: fleetv1beta1.ReadyConditionTypeApplied,
+			},
+			want: condition.CondTypesForReportDiffApplyStrategy,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: fleetv1beta1.PlacementSpec{
+					Strategy:        tc.strategy,
+					ReadinessPolicy: tc.readinessPolicy,
+				},
+			}
+			got := determineReadinessCondTypes(crp)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("determineReadinessCondTypes() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeterminePerClusterExpectedCondTypes(t *testing.T) {
+	prodCluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "prod-cluster",
+			Labels: map[string]string{
+				"ring": "prod",
+			},
+		},
+	}
+	testCluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "test-cluster",
+			Labels: map[string]string{
+				"ring": "test",
+			},
+		},
+	}
+	reportDiffOnProdOverrides := []fleetv1beta1.ApplyStrategyOverride{
+		{
+			ClusterSelector: &fleetv1beta1.ClusterSelector{
+				ClusterSelectorTerms: []fleetv1beta1.ClusterSelectorTerm{
+					{
+						LabelSelector: &metav1.LabelSelector{
+							MatchLabels: map[string]string{
+								"ring": "prod",
+							},
+						},
+					},
+				},
+			},
+			ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+				Type: fleetv1beta1.ApplyStrategyTypeReportDiff,
+			},
+		},
+	}
+	tests := []struct {
+		name        string
+		strategy    fleetv1beta1.RolloutStrategy
+		clusterName string
+		want        []condition.ResourceCondition
+	}{
+		{
+			name: "no apply strategy overrides",
+			strategy: fleetv1beta1.RolloutStrategy{
+				ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+					Type: fleetv1beta1.ApplyStrategyTypeReportDiff,
+				},
+			},
+			clusterName: prodCluster.Name,
+			want:        condition.CondTypesForReportDiffApplyStrategy,
+		},
+		{
+			name: "cluster selected by a report diff override",
+			strategy: fleetv1beta1.RolloutStrategy{
+				ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+					Type: fleetv1beta1.ApplyStrategyTypeClientSideApply,
+				},
+				ApplyStrategyOverrides: reportDiffOnProdOverrides,
+			},
+			clusterName: prodCluster.Name,
+			want:        condition.CondTypesForReportDiffApplyStrategy,
+		},
+		{
+			name: "cluster not selected by any override",
+			strategy: fleetv1beta1.RolloutStrategy{
+				ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+					Type: fleetv1beta1.ApplyStrategyTypeClientSideApply,
+				},
+				ApplyStrategyOverrides: reportDiffOnProdOverrides,
+			},
+			clusterName: testCluster.Name,
+			want:        condition.CondTypesForApplyStrategies,
+		},
+		{
+			name: "cluster has left the fleet",
+			strategy: fleetv1beta1.RolloutStrategy{
+				ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+					Type: fleetv1beta1.ApplyStrategyTypeClientSideApply,
+				},
+				ApplyStrategyOverrides: reportDiffOnProdOverrides,
+			},
+			clusterName: "unknown-cluster",
+			want:        condition.CondTypesForApplyStrategies,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := serviceScheme(t)
+			if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+				t.Fatalf("failed to add cluster scheme: %v", err)
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(prodCluster, testCluster).
+				Build()
+			r := Reconciler{
+				Client: fakeClient,
+			}
+			crp := &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: fleetv1beta1.PlacementSpec{
+					Strategy: tc.strategy,
+				},
+			}
+			got, err := r.determinePerClusterExpectedCondTypes(context.Background(), crp, tc.clusterName, determineExpectedPlacementAndResourcePlacementStatusCondType(crp))
+			if err != nil {
+				t.Fatalf("determinePerClusterExpectedCondTypes() got error %v, want nil", err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("determinePerClusterExpectedCondTypes() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestGeneratePlacementConditionByStatus(t *testing.T) {
 	tests := []struct {
 		name         string
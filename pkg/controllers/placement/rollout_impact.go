@@ -0,0 +1,220 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"context"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// setRolloutImpactEstimate estimates the blast radius of rolling out the latest resource snapshot, i.e., the
+// number of selected clusters that the rollout changes and the resources that change, when the resource snapshot
+// is first observed; the estimate is kept as it is afterwards, so that it reflects the state before the rollout.
+func (r *Reconciler) setRolloutImpactEstimate(
+	ctx context.Context,
+	placementObj fleetv1beta1.PlacementObj,
+	selected []*fleetv1beta1.ClusterDecision,
+	latestSchedulingPolicySnapshot fleetv1beta1.PolicySnapshotObj,
+	latestResourceSnapshot fleetv1beta1.ResourceSnapshotObj,
+) error {
+	placementStatus := placementObj.GetPlacementStatus()
+	if latestResourceSnapshot == nil || placementObj.GetPlacementSpec().Strategy.Type == fleetv1beta1.ExternalRolloutStrategyType {
+		// The resource snapshots are rolled out by an external controller; the rollout is not estimated.
+		placementStatus.RolloutImpactEstimate = nil
+		return nil
+	}
+	resourceIndex := latestResourceSnapshot.GetLabels()[fleetv1beta1.ResourceIndexLabel]
+	if estimate := placementStatus.RolloutImpactEstimate; estimate != nil && estimate.ResourceIndex == resourceIndex {
+		return nil
+	}
+
+	clusterToBindingMap, err := r.buildClusterToBindingMap(ctx, placementObj, latestSchedulingPolicySnapshot)
+	if err != nil {
+		return err
+	}
+	clusterCount, baseResourceSnapshotName := findClustersToRollout(selected, clusterToBindingMap, latestResourceSnapshot.GetName())
+	estimate := &fleetv1beta1.RolloutImpactEstimate{
+		ResourceIndex:  resourceIndex,
+		ClusterCount:   int32(clusterCount), //nolint:gosec // the number of selected clusters is bounded
+		EstimationTime: metav1.Now(),
+	}
+	if clusterCount > 0 {
+		latestResources, err := r.collectResourceSnapshotContents(ctx, placementObj, latestResourceSnapshot)
+		if err != nil {
+			return err
+		}
+		var baseResources map[fleetv1beta1.ResourceIdentifier]*unstructured.Unstructured
+		if baseResourceSnapshotName != "" {
+			baseResourceSnapshot, err := r.getResourceSnapshot(ctx, placementObj, baseResourceSnapshotName)
+			switch {
+			case apierrors.IsNotFound(err):
+				// The resource snapshot has been deleted due to the revision history limit; count all the
+				// resources as changed.
+				klog.V(2).InfoS("The base resource snapshot of the rollout is not found", "resourceSnapshot", baseResourceSnapshotName, "placement", klog.KObj(placementObj))
+			case err != nil:
+				return controller.NewAPIServerError(true, err)
+			default:
+				estimate.BaseResourceIndex = baseResourceSnapshot.GetLabels()[fleetv1beta1.ResourceIndexLabel]
+				if baseResources, err = r.collectResourceSnapshotContents(ctx, placementObj, baseResourceSnapshot); err != nil {
+					return err
+				}
+			}
+		}
+		estimate.ChangedResourceCount, estimate.ChangedKinds = countChangedResources(baseResources, latestResources)
+	}
+	placementStatus.RolloutImpactEstimate = estimate
+	klog.V(2).InfoS("Estimated the rollout impact of the latest resource snapshot", "placement", klog.KObj(placementObj),
+		"resourceIndex", resourceIndex, "baseResourceIndex", estimate.BaseResourceIndex, "clusterCount", estimate.ClusterCount, "changedResourceCount", estimate.ChangedResourceCount)
+	return nil
+}
+
+// findClustersToRollout returns the number of selected clusters which do not have the latest resource snapshot
+// yet, and the resource snapshot that most of them currently have (if any); ties are broken by the snapshot names,
+// so that the result is stable.
+func findClustersToRollout(
+	selected []*fleetv1beta1.ClusterDecision,
+	clusterToBindingMap map[string]fleetv1beta1.BindingObj,
+	latestResourceSnapshotName string,
+) (int, string) {
+	clusterCount := 0
+	clusterCountByResourceSnapshot := make(map[string]int)
+	for _, decision := range selected {
+		resourceSnapshotName := ""
+		if binding, ok := clusterToBindingMap[decision.ClusterName]; ok {
+			resourceSnapshotName = binding.GetBindingSpec().ResourceSnapshotName
+		}
+		if resourceSnapshotName == latestResourceSnapshotName {
+			continue
+		}
+		clusterCount++
+		if resourceSnapshotName != "" {
+			clusterCountByResourceSnapshot[resourceSnapshotName]++
+		}
+	}
+
+	baseResourceSnapshotName := ""
+	for name, count := range clusterCountByResourceSnapshot {
+		baseCount := clusterCountByResourceSnapshot[baseResourceSnapshotName]
+		if count > baseCount || (count == baseCount && name < baseResourceSnapshotName) {
+			baseResourceSnapshotName = name
+		}
+	}
+	return clusterCount, baseResourceSnapshotName
+}
+
+// getResourceSnapshot gets the resource snapshot of the placement with the given name.
+func (r *Reconciler) getResourceSnapshot(ctx context.Context, placementObj fleetv1beta1.PlacementObj, name string) (fleetv1beta1.ResourceSnapshotObj, error) {
+	var resourceSnapshot fleetv1beta1.ResourceSnapshotObj
+	if isClusterScopedPlacement(placementObj) {
+		resourceSnapshot = &fleetv1beta1.ClusterResourceSnapshot{}
+	} else {
+		resourceSnapshot = &fleetv1beta1.ResourceSnapshot{}
+	}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: name, Namespace: placementObj.GetNamespace()}, resourceSnapshot); err != nil {
+		return nil, err
+	}
+	return resourceSnapshot, nil
+}
+
+// collectResourceSnapshotContents collects the resources in the group of resource snapshots of the given master
+// resource snapshot, keyed by their identifiers.
+func (r *Reconciler) collectResourceSnapshotContents(
+	ctx context.Context,
+	placementObj fleetv1beta1.PlacementObj,
+	masterResourceSnapshot fleetv1beta1.ResourceSnapshotObj,
+) (map[fleetv1beta1.ResourceIdentifier]*unstructured.Unstructured, error) {
+	placementKey := controller.GetObjectKeyFromNamespaceName(placementObj.GetNamespace(), placementObj.GetName())
+	resourceSnapshots, err := controller.FetchAllResourceSnapshotsAlongWithMaster(ctx, r.Client, placementKey, masterResourceSnapshot)
+	if err != nil {
+		klog.ErrorS(err, "Failed to fetch the resource snapshots", "resourceSnapshot", klog.KObj(masterResourceSnapshot), "placement", klog.KObj(placementObj))
+		return nil, err
+	}
+
+	resources := make(map[fleetv1beta1.ResourceIdentifier]*unstructured.Unstructured)
+	for _, resourceSnapshot := range resourceSnapshots {
+		for _, content := range resourceSnapshot.GetResourceSnapshotSpec().SelectedResources {
+			obj := &unstructured.Unstructured{}
+			if err := obj.UnmarshalJSON(content.Raw); err != nil {
+				klog.ErrorS(err, "Failed to unmarshal a resource in the resource snapshot", "resourceSnapshot", klog.KObj(resourceSnapshot), "placement", klog.KObj(placementObj))
+				return nil, controller.NewUnexpectedBehaviorError(err)
+			}
+			gvk := obj.GroupVersionKind()
+			resources[fleetv1beta1.ResourceIdentifier{
+				Group:     gvk.Group,
+				Version:   gvk.Version,
+				Kind:      gvk.Kind,
+				Name:      obj.GetName(),
+				Namespace: obj.GetNamespace(),
+			}] = obj
+		}
+	}
+	return resources, nil
+}
+
+// countChangedResources counts the resources that are added, updated or removed in the latest resources compared
+// with the base resources, in total and by their kinds.
+func countChangedResources(
+	baseResources, latestResources map[fleetv1beta1.ResourceIdentifier]*unstructured.Unstructured,
+) (int32, []fleetv1beta1.ResourceKindCount) {
+	counts := make(map[metav1.GroupVersionKind]int32)
+	total := int32(0)
+	countChange := func(id fleetv1beta1.ResourceIdentifier) {
+		counts[metav1.GroupVersionKind{Group: id.Group, Version: id.Version, Kind: id.Kind}]++
+		total++
+	}
+	for id, latest := range latestResources {
+		if base, ok := baseResources[id]; !ok || !equality.Semantic.DeepEqual(base.Object, latest.Object) {
+			countChange(id)
+		}
+	}
+	for id := range baseResources {
+		if _, ok := latestResources[id]; !ok {
+			countChange(id)
+		}
+	}
+
+	var kindCounts []fleetv1beta1.ResourceKindCount
+	for gvk, count := range counts {
+		kindCounts = append(kindCounts, fleetv1beta1.ResourceKindCount{
+			Group:   gvk.Group,
+			Version: gvk.Version,
+			Kind:    gvk.Kind,
+			Count:   count,
+		})
+	}
+	sort.Slice(kindCounts, func(i, j int) bool {
+		a, b := kindCounts[i], kindCounts[j]
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+		return a.Kind < b.Kind
+	})
+	return total, kindCounts
+}
@@ -0,0 +1,276 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func resourceContentFor(t *testing.T, apiVersion, kind, namespace, name string, data map[string]interface{}) fleetv1beta1.ResourceContent {
+	obj := map[string]interface{}{
+		"apiVersion": apiVersion,
+		"kind":       kind,
+		"metadata":   map[string]interface{}{"name": name, "namespace": namespace},
+	}
+	for k, v := range data {
+		obj[k] = v
+	}
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("failed to marshal resource: %v", err)
+	}
+	return fleetv1beta1.ResourceContent{RawExtension: runtime.RawExtension{Raw: raw}}
+}
+
+func TestSetRolloutImpactEstimate(t *testing.T) {
+	policySnapshotName := fmt.Sprintf(fleetv1beta1.PolicySnapshotNameFmt, testCRPName, 0)
+	snapshotName := func(index int) string {
+		return fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, index)
+	}
+	resourceSnapshotWith := func(index int, resources ...fleetv1beta1.ResourceContent) *fleetv1beta1.ClusterResourceSnapshot {
+		return &fleetv1beta1.ClusterResourceSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: snapshotName(index),
+				Labels: map[string]string{
+					fleetv1beta1.ResourceIndexLabel:     fmt.Sprint(index),
+					fleetv1beta1.IsLatestSnapshotLabel:  "false",
+					fleetv1beta1.PlacementTrackingLabel: testCRPName,
+				},
+				Annotations: map[string]string{
+					fleetv1beta1.NumberOfResourceSnapshotsAnnotation: "1",
+				},
+			},
+			Spec: fleetv1beta1.ResourceSnapshotSpec{SelectedResources: resources},
+		}
+	}
+	bindingOn := func(cluster string, index int) *fleetv1beta1.ClusterResourceBinding {
+		return &fleetv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "binding-" + cluster,
+				Labels: map[string]string{fleetv1beta1.PlacementTrackingLabel: testCRPName},
+			},
+			Spec: fleetv1beta1.ResourceBindingSpec{
+				State:                        fleetv1beta1.BindingStateBound,
+				TargetCluster:                cluster,
+				ResourceSnapshotName:         snapshotName(index),
+				SchedulingPolicySnapshotName: policySnapshotName,
+			},
+		}
+	}
+	policySnapshot := &fleetv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: policySnapshotName},
+	}
+
+	deployment := resourceContentFor(t, "apps/v1", "Deployment", "app", "web", map[string]interface{}{"spec": map[string]interface{}{"replicas": 2}})
+	service := resourceContentFor(t, "v1", "Service", "app", "web", nil)
+	oldConfigMap := resourceContentFor(t, "v1", "ConfigMap", "app", "config", map[string]interface{}{"data": map[string]interface{}{"key": "old"}})
+	newConfigMap := resourceContentFor(t, "v1", "ConfigMap", "app", "config", map[string]interface{}{"data": map[string]interface{}{"key": "new"}})
+	secret := resourceContentFor(t, "v1", "Secret", "app", "token", nil)
+	// The latest snapshot updates the config map, adds the secret, and removes the service.
+	baseSnapshot := resourceSnapshotWith(1, deployment, service, oldConfigMap)
+	latestSnapshot := resourceSnapshotWith(2, deployment, newConfigMap, secret)
+
+	selected := []*fleetv1beta1.ClusterDecision{
+		{ClusterName: "member-1", Selected: true},
+		{ClusterName: "member-2", Selected: true},
+		{ClusterName: "member-3", Selected: true},
+		{ClusterName: "member-4", Selected: true},
+	}
+	bindings := []client.Object{
+		bindingOn("member-1", 2),
+		bindingOn("member-2", 1),
+		bindingOn("member-3", 1),
+		// The binding of member-4 has not been created yet.
+	}
+
+	tests := map[string]struct {
+		strategy       fleetv1beta1.RolloutStrategyType
+		existing       *fleetv1beta1.RolloutImpactEstimate
+		objects        []client.Object
+		latestSnapshot fleetv1beta1.ResourceSnapshotObj
+		want           *fleetv1beta1.RolloutImpactEstimate
+	}{
+		"new resource snapshot": {
+			objects:        append([]client.Object{baseSnapshot, latestSnapshot}, bindings...),
+			latestSnapshot: latestSnapshot,
+			want: &fleetv1beta1.RolloutImpactEstimate{
+				ResourceIndex:        "2",
+				BaseResourceIndex:    "1",
+				ClusterCount:         3,
+				ChangedResourceCount: 3,
+				ChangedKinds: []fleetv1beta1.ResourceKindCount{
+					{Version: "v1", Kind: "ConfigMap", Count: 1},
+					{Version: "v1", Kind: "Secret", Count: 1},
+					{Version: "v1", Kind: "Service", Count: 1},
+				},
+			},
+		},
+		"base resource snapshot has been deleted": {
+			objects:        append([]client.Object{latestSnapshot}, bindings...),
+			latestSnapshot: latestSnapshot,
+			want: &fleetv1beta1.RolloutImpactEstimate{
+				ResourceIndex:        "2",
+				ClusterCount:         3,
+				ChangedResourceCount: 3,
+				ChangedKinds: []fleetv1beta1.ResourceKindCount{
+					{Version: "v1", Kind: "ConfigMap", Count: 1},
+					{Version: "v1", Kind: "Secret", Count: 1},
+					{Group: "apps", Version: "v1", Kind: "Deployment", Count: 1},
+				},
+			},
+		},
+		"resource snapshot has been rolled out everywhere": {
+			objects:        []client.Object{latestSnapshot, bindingOn("member-1", 2), bindingOn("member-2", 2), bindingOn("member-3", 2), bindingOn("member-4", 2)},
+			latestSnapshot: latestSnapshot,
+			want:           &fleetv1beta1.RolloutImpactEstimate{ResourceIndex: "2"},
+		},
+		"resource snapshot has been estimated": {
+			existing:       &fleetv1beta1.RolloutImpactEstimate{ResourceIndex: "2", ClusterCount: 10},
+			objects:        append([]client.Object{baseSnapshot, latestSnapshot}, bindings...),
+			latestSnapshot: latestSnapshot,
+			want:           &fleetv1beta1.RolloutImpactEstimate{ResourceIndex: "2", ClusterCount: 10},
+		},
+		"external rollout strategy": {
+			strategy:       fleetv1beta1.ExternalRolloutStrategyType,
+			existing:       &fleetv1beta1.RolloutImpactEstimate{ResourceIndex: "1"},
+			objects:        bindings,
+			latestSnapshot: nil,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			crp := &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: testCRPName},
+				Spec: fleetv1beta1.PlacementSpec{
+					Strategy: fleetv1beta1.RolloutStrategy{Type: tt.strategy},
+				},
+				Status: fleetv1beta1.PlacementStatus{RolloutImpactEstimate: tt.existing},
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(serviceScheme(t)).WithObjects(tt.objects...).Build()
+			r := Reconciler{Client: fakeClient}
+			if err := r.setRolloutImpactEstimate(context.Background(), crp, selected, policySnapshot, tt.latestSnapshot); err != nil {
+				t.Fatalf("setRolloutImpactEstimate() = %v, want nil", err)
+			}
+			if diff := cmp.Diff(tt.want, crp.Status.RolloutImpactEstimate, cmpopts.IgnoreFields(fleetv1beta1.RolloutImpactEstimate{}, "EstimationTime")); diff != "" {
+				t.Errorf("setRolloutImpactEstimate() mismatch (-want, +got):\n%s", diff)
+			}
+			if tt.want != nil && tt.existing == nil && time.Since(crp.Status.RolloutImpactEstimate.EstimationTime.Time) > time.Minute {
+				t.Errorf("setRolloutImpactEstimate() estimation time = %v, want the current time", crp.Status.RolloutImpactEstimate.EstimationTime)
+			}
+		})
+	}
+}
+
+func TestFindClustersToRollout(t *testing.T) {
+	bindingOn := func(resourceSnapshotName string) fleetv1beta1.BindingObj {
+		return &fleetv1beta1.ClusterResourceBinding{Spec: fleetv1beta1.ResourceBindingSpec{ResourceSnapshotName: resourceSnapshotName}}
+	}
+	selected := []*fleetv1beta1.ClusterDecision{
+		{ClusterName: "member-1"},
+		{ClusterName: "member-2"},
+		{ClusterName: "member-3"},
+		{ClusterName: "member-4"},
+	}
+
+	tests := map[string]struct {
+		bindings         map[string]fleetv1beta1.BindingObj
+		wantClusterCount int
+		wantBase         string
+	}{
+		"no bindings": {
+			wantClusterCount: 4,
+		},
+		"most clusters are on one snapshot": {
+			bindings: map[string]fleetv1beta1.BindingObj{
+				"member-1": bindingOn("snapshot-3"),
+				"member-2": bindingOn("snapshot-2"),
+				"member-3": bindingOn("snapshot-1"),
+				"member-4": bindingOn("snapshot-1"),
+			},
+			wantClusterCount: 3,
+			wantBase:         "snapshot-1",
+		},
+		"ties are broken by names": {
+			bindings: map[string]fleetv1beta1.BindingObj{
+				"member-1": bindingOn("snapshot-2"),
+				"member-2": bindingOn("snapshot-1"),
+			},
+			wantClusterCount: 4,
+			wantBase:         "snapshot-1",
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			gotClusterCount, gotBase := findClustersToRollout(selected, tt.bindings, "snapshot-3")
+			if gotClusterCount != tt.wantClusterCount || gotBase != tt.wantBase {
+				t.Errorf("findClustersToRollout() = (%d, %q), want (%d, %q)", gotClusterCount, gotBase, tt.wantClusterCount, tt.wantBase)
+			}
+		})
+	}
+}
+
+func TestCountChangedResources(t *testing.T) {
+	configMap := func(name, value string) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata":   map[string]interface{}{"name": name},
+			"data":       map[string]interface{}{"key": value},
+		}}
+	}
+	idOf := func(name string) fleetv1beta1.ResourceIdentifier {
+		return fleetv1beta1.ResourceIdentifier{Version: "v1", Kind: "ConfigMap", Name: name}
+	}
+	base := map[fleetv1beta1.ResourceIdentifier]*unstructured.Unstructured{
+		idOf("same"):    configMap("same", "a"),
+		idOf("updated"): configMap("updated", "a"),
+		idOf("removed"): configMap("removed", "a"),
+	}
+	latest := map[fleetv1beta1.ResourceIdentifier]*unstructured.Unstructured{
+		idOf("same"):    configMap("same", "a"),
+		idOf("updated"): configMap("updated", "b"),
+		idOf("added"):   configMap("added", "a"),
+	}
+
+	gotCount, gotKinds := countChangedResources(base, latest)
+	if gotCount != 3 {
+		t.Errorf("countChangedResources() count = %d, want %d", gotCount, 3)
+	}
+	wantKinds := []fleetv1beta1.ResourceKindCount{{Version: "v1", Kind: "ConfigMap", Count: 3}}
+	if diff := cmp.Diff(wantKinds, gotKinds); diff != "" {
+		t.Errorf("countChangedResources() kinds mismatch (-want, +got):\n%s", diff)
+	}
+
+	gotCount, gotKinds = countChangedResources(latest, latest)
+	if gotCount != 0 || gotKinds != nil {
+		t.Errorf("countChangedResources() = (%d, %v), want (0, nil)", gotCount, gotKinds)
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package placementlifecycle features a controller that watches the bindings and delivers the placement
+// lifecycle events (a member cluster gaining or losing the workload of a placement) to the subscribers
+// registered through a stable Go interface, e.g., the networking controllers which coordinate the
+// ServiceExport and ServiceImport objects with the placements.
+package placementlifecycle
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// Reconciler watches the bindings and delivers the placement lifecycle events to the subscribers.
+type Reconciler struct {
+	// Client is the client the controller uses to access the hub cluster.
+	client.Client
+	// Subscribers are the subscribers to deliver the events to.
+	Subscribers []Subscriber
+
+	mu sync.Mutex
+	// placed are the WorkloadPlaced events last delivered for the bindings, keyed by the binding keys.
+	//
+	// Note that the events are kept in memory only; after the hub agent restarts (or the leader changes),
+	// the WorkloadPlaced events are delivered again for all the workloads which are still placed.
+	placed map[types.NamespacedName]Event
+}
+
+// Reconcile compares the current state of a binding with the last delivered event of it, and delivers
+// a placement lifecycle event to the subscribers if the member cluster has gained or lost the workload.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	bindingRef := klog.KRef(req.Namespace, req.Name)
+	startTime := time.Now()
+	klog.V(2).InfoS("Placement lifecycle reconciliation starts", "binding", bindingRef)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Placement lifecycle reconciliation ends", "binding", bindingRef, "latency", latency)
+	}()
+
+	binding, err := controller.FetchBindingFromKey(ctx, r.Client, req.NamespacedName)
+	if err != nil {
+		if !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to get the binding", "binding", bindingRef)
+			return ctrl.Result{}, controller.NewAPIServerError(true, err)
+		}
+		klog.V(4).InfoS("Binding is not found", "binding", bindingRef)
+	}
+
+	r.mu.Lock()
+	last, found := r.placed[req.NamespacedName]
+	r.mu.Unlock()
+
+	// A workload stays placed once its resources are applied, until the binding is unscheduled or deleted;
+	// the workload is not removed from the target cluster while newer resources are being applied.
+	var event *Event
+	switch {
+	case !isBound(binding):
+		if !found {
+			return ctrl.Result{}, nil
+		}
+		removed := last
+		removed.Type = EventTypeWorkloadRemoved
+		event = &removed
+	case isApplied(binding):
+		event = buildPlacedEvent(binding)
+		if found && last == *event {
+			return ctrl.Result{}, nil
+		}
+	default:
+		return ctrl.Result{}, nil
+	}
+
+	var errs []error
+	for _, s := range r.Subscribers {
+		if err := s.OnPlacementEvent(ctx, *event); err != nil {
+			klog.ErrorS(err, "Subscriber failed to handle the placement lifecycle event",
+				"subscriber", s.Name(), "binding", bindingRef, "cluster", event.ClusterName, "type", event.Type)
+			errs = append(errs, fmt.Errorf("subscriber %s failed to handle the %s event: %w", s.Name(), event.Type, err))
+		}
+	}
+	if len(errs) > 0 {
+		// Keep the last delivered event so that the event will be re-delivered.
+		return ctrl.Result{}, errors.Join(errs...)
+	}
+	klog.V(2).InfoS("Delivered the placement lifecycle event", "binding", bindingRef, "cluster", event.ClusterName, "type", event.Type)
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if event.Type == EventTypeWorkloadRemoved {
+		delete(r.placed, req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+	if r.placed == nil {
+		r.placed = make(map[types.NamespacedName]Event)
+	}
+	r.placed[req.NamespacedName] = *event
+	return ctrl.Result{}, nil
+}
+
+// isBound returns if a binding (if any) binds its placement to the target cluster; the workload is being
+// removed from the target cluster if not.
+func isBound(binding placementv1beta1.BindingObj) bool {
+	return binding != nil && binding.GetDeletionTimestamp() == nil && binding.GetBindingSpec().State == placementv1beta1.BindingStateBound
+}
+
+// isApplied returns if the resources of the latest generation of a binding have been applied on the target cluster.
+func isApplied(binding placementv1beta1.BindingObj) bool {
+	return condition.IsConditionStatusTrue(binding.GetCondition(string(placementv1beta1.ResourceBindingApplied)), binding.GetGeneration())
+}
+
+// buildPlacedEvent builds the WorkloadPlaced event of a binding.
+func buildPlacedEvent(binding placementv1beta1.BindingObj) *Event {
+	spec := binding.GetBindingSpec()
+	return &Event{
+		Type:                 EventTypeWorkloadPlaced,
+		PlacementNamespace:   binding.GetNamespace(),
+		PlacementName:        binding.GetLabels()[placementv1beta1.PlacementTrackingLabel],
+		ClusterName:          spec.TargetCluster,
+		BindingName:          binding.GetName(),
+		ResourceSnapshotName: spec.ResourceSnapshotName,
+	}
+}
+
+// SetupWithManagerForClusterResourceBinding sets up the controller with the manager for ClusterResourceBinding.
+func (r *Reconciler) SetupWithManagerForClusterResourceBinding(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).Named("cluster-resource-binding-lifecycle-watcher").
+		For(&placementv1beta1.ClusterResourceBinding{}).
+		Complete(r)
+}
+
+// SetupWithManagerForResourceBinding sets up the controller with the manager for ResourceBinding.
+func (r *Reconciler) SetupWithManagerForResourceBinding(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).Named("resource-binding-lifecycle-watcher").
+		For(&placementv1beta1.ResourceBinding{}).
+		Complete(r)
+}
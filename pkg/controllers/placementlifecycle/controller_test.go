@@ -0,0 +1,219 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementlifecycle
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	placementName = "crp-1"
+	rpNamespace   = "app"
+	bindingName   = "crp-1-cluster-1"
+	clusterName   = "cluster-1"
+	snapshotName  = "crp-1-0-snapshot"
+	generation    = 2
+)
+
+// fakeSubscriber records the events it has handled.
+type fakeSubscriber struct {
+	events []Event
+	err    error
+}
+
+func (s *fakeSubscriber) Name() string {
+	return "fake"
+}
+
+func (s *fakeSubscriber) OnPlacementEvent(_ context.Context, event Event) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, event)
+	return nil
+}
+
+func newBinding(namespace string, state placementv1beta1.BindingState, snapshot string, appliedStatus metav1.ConditionStatus, appliedGeneration int64) placementv1beta1.BindingObj {
+	objectMeta := metav1.ObjectMeta{
+		Namespace:  namespace,
+		Name:       bindingName,
+		Generation: generation,
+		Labels: map[string]string{
+			placementv1beta1.PlacementTrackingLabel: placementName,
+		},
+	}
+	spec := placementv1beta1.ResourceBindingSpec{
+		State:                state,
+		ResourceSnapshotName: snapshot,
+		TargetCluster:        clusterName,
+	}
+	status := placementv1beta1.ResourceBindingStatus{
+		Conditions: []metav1.Condition{
+			{
+				Type:               string(placementv1beta1.ResourceBindingApplied),
+				Status:             appliedStatus,
+				Reason:             "Test",
+				ObservedGeneration: appliedGeneration,
+			},
+		},
+	}
+	if namespace == "" {
+		return &placementv1beta1.ClusterResourceBinding{ObjectMeta: objectMeta, Spec: spec, Status: status}
+	}
+	return &placementv1beta1.ResourceBinding{ObjectMeta: objectMeta, Spec: spec, Status: status}
+}
+
+func placedEvent(namespace, snapshot string) Event {
+	return Event{
+		Type:                 EventTypeWorkloadPlaced,
+		PlacementNamespace:   namespace,
+		PlacementName:        placementName,
+		ClusterName:          clusterName,
+		BindingName:          bindingName,
+		ResourceSnapshotName: snapshot,
+	}
+}
+
+func removedEvent(namespace, snapshot string) Event {
+	event := placedEvent(namespace, snapshot)
+	event.Type = EventTypeWorkloadRemoved
+	return event
+}
+
+func TestReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	newSnapshot := "crp-1-1-snapshot"
+
+	tests := []struct {
+		name          string
+		namespace     string
+		binding       placementv1beta1.BindingObj
+		placed        *Event
+		subscriberErr error
+		wantErr       bool
+		wantEvents    []Event
+		wantPlaced    *Event
+	}{
+		{
+			name:       "cluster gained the workload",
+			binding:    newBinding("", placementv1beta1.BindingStateBound, snapshotName, metav1.ConditionTrue, generation),
+			wantEvents: []Event{placedEvent("", snapshotName)},
+			wantPlaced: ptr.To(placedEvent("", snapshotName)),
+		},
+		{
+			name:       "cluster gained the workload of a resource placement",
+			namespace:  rpNamespace,
+			binding:    newBinding(rpNamespace, placementv1beta1.BindingStateBound, snapshotName, metav1.ConditionTrue, generation),
+			wantEvents: []Event{placedEvent(rpNamespace, snapshotName)},
+			wantPlaced: ptr.To(placedEvent(rpNamespace, snapshotName)),
+		},
+		{
+			name:       "workload placed already",
+			binding:    newBinding("", placementv1beta1.BindingStateBound, snapshotName, metav1.ConditionTrue, generation),
+			placed:     ptr.To(placedEvent("", snapshotName)),
+			wantPlaced: ptr.To(placedEvent("", snapshotName)),
+		},
+		{
+			name:       "newer resources applied",
+			binding:    newBinding("", placementv1beta1.BindingStateBound, newSnapshot, metav1.ConditionTrue, generation),
+			placed:     ptr.To(placedEvent("", snapshotName)),
+			wantEvents: []Event{placedEvent("", newSnapshot)},
+			wantPlaced: ptr.To(placedEvent("", newSnapshot)),
+		},
+		{
+			name:       "newer resources being applied",
+			binding:    newBinding("", placementv1beta1.BindingStateBound, newSnapshot, metav1.ConditionTrue, generation-1),
+			placed:     ptr.To(placedEvent("", snapshotName)),
+			wantPlaced: ptr.To(placedEvent("", snapshotName)),
+		},
+		{
+			name:    "resources not applied yet",
+			binding: newBinding("", placementv1beta1.BindingStateBound, snapshotName, metav1.ConditionFalse, generation),
+		},
+		{
+			name:       "binding unscheduled",
+			binding:    newBinding("", placementv1beta1.BindingStateUnscheduled, snapshotName, metav1.ConditionTrue, generation),
+			placed:     ptr.To(placedEvent("", snapshotName)),
+			wantEvents: []Event{removedEvent("", snapshotName)},
+		},
+		{
+			name:       "binding deleted",
+			placed:     ptr.To(placedEvent("", snapshotName)),
+			wantEvents: []Event{removedEvent("", snapshotName)},
+		},
+		{
+			name: "binding deleted before the workload was placed",
+		},
+		{
+			name:          "subscriber failed to handle the event",
+			binding:       newBinding("", placementv1beta1.BindingStateUnscheduled, snapshotName, metav1.ConditionTrue, generation),
+			placed:        ptr.To(placedEvent("", snapshotName)),
+			subscriberErr: errors.New("failed"),
+			wantErr:       true,
+			wantPlaced:    ptr.To(placedEvent("", snapshotName)),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			objs := []client.Object{}
+			if tc.binding != nil {
+				objs = append(objs, tc.binding)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+			subscriber := &fakeSubscriber{err: tc.subscriberErr}
+			r := &Reconciler{
+				Client:      fakeClient,
+				Subscribers: []Subscriber{subscriber},
+			}
+			key := types.NamespacedName{Namespace: tc.namespace, Name: bindingName}
+			if tc.placed != nil {
+				r.placed = map[types.NamespacedName]Event{key: *tc.placed}
+			}
+
+			_, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: key})
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("Reconcile() got error %v, want error %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(tc.wantEvents, subscriber.events); diff != "" {
+				t.Errorf("Reconcile() delivered events mismatch (-want, +got):\n%s", diff)
+			}
+			var gotPlaced *Event
+			if placed, found := r.placed[key]; found {
+				gotPlaced = &placed
+			}
+			if diff := cmp.Diff(tc.wantPlaced, gotPlaced); diff != "" {
+				t.Errorf("Reconcile() last placed event mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
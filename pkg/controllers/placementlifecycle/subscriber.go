@@ -0,0 +1,92 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementlifecycle
+
+import (
+	"context"
+	"sync"
+)
+
+// EventType is the type of a placement lifecycle event.
+type EventType string
+
+const (
+	// EventTypeWorkloadPlaced means that the resources selected by a placement have been applied on a
+	// member cluster, i.e., the cluster has gained the workload; it is sent again whenever a newer set
+	// of the resources has been applied on the cluster.
+	EventTypeWorkloadPlaced EventType = "WorkloadPlaced"
+	// EventTypeWorkloadRemoved means that the resources selected by a placement are being removed from
+	// a member cluster, i.e., the cluster has lost the workload.
+	EventTypeWorkloadRemoved EventType = "WorkloadRemoved"
+)
+
+// Event is a placement lifecycle event, which describes a member cluster gaining or losing the
+// workload of a placement.
+type Event struct {
+	// Type is the type of the event.
+	Type EventType
+	// PlacementNamespace is the namespace of the placement; it is empty for a ClusterResourcePlacement.
+	PlacementNamespace string
+	// PlacementName is the name of the placement.
+	PlacementName string
+	// ClusterName is the name of the member cluster.
+	ClusterName string
+	// BindingName is the name of the binding which binds the placement to the member cluster; the
+	// binding is in the same namespace as the placement.
+	BindingName string
+	// ResourceSnapshotName is the name of the master resource snapshot of the resources which have
+	// been placed on the member cluster.
+	ResourceSnapshotName string
+}
+
+// Subscriber subscribes to the placement lifecycle events, so that the controllers which build on top
+// of the placements (e.g., the networking controllers which export and import the Services of the
+// placed workloads across member clusters) can act in coordination with the placement lifecycle.
+//
+// The events are delivered at least once: an event is re-delivered to all the subscribers if any of
+// them fails to handle it, and the events of the workloads which are still placed are re-delivered after
+// the hub agent restarts (or the leader changes). On the other hand, the WorkloadRemoved events of the
+// bindings which are deleted while the hub agent is not running are not delivered; the subscribers
+// should resync with the bindings on the hub cluster when they start. Subscribers must therefore handle
+// the events idempotently.
+type Subscriber interface {
+	// Name returns the name of the subscriber.
+	Name() string
+	// OnPlacementEvent handles a placement lifecycle event; the event is re-delivered if an error is returned.
+	OnPlacementEvent(ctx context.Context, event Event) error
+}
+
+var (
+	subscribersMu sync.Mutex
+	subscribers   []Subscriber
+)
+
+// RegisterSubscriber registers a subscriber to the placement lifecycle events of the hub agent.
+// It must be called before the hub agent sets up its controllers (e.g., from an init function of
+// the package which implements the subscriber).
+func RegisterSubscriber(s Subscriber) {
+	subscribersMu.Lock()
+	defer subscribersMu.Unlock()
+	subscribers = append(subscribers, s)
+}
+
+// RegisteredSubscribers returns the subscribers registered to the placement lifecycle events.
+func RegisteredSubscribers() []Subscriber {
+	subscribersMu.Lock()
+	defer subscribersMu.Unlock()
+	return append([]Subscriber(nil), subscribers...)
+}
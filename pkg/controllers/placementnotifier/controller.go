@@ -0,0 +1,258 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package placementnotifier features a controller that sends notifications to an external sink
+// (e.g., a generic HTTP webhook) when placements fail to apply or become unavailable on member
+// clusters, or when configuration drifts are found, so that teams can be alerted without
+// building their own watchers.
+package placementnotifier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// notifiedConditionTypes are the per-cluster condition types whose transitions to False are notified.
+var notifiedConditionTypes = []string{
+	string(placementv1beta1.PerClusterAppliedConditionType),
+	string(placementv1beta1.PerClusterAvailableConditionType),
+}
+
+// clusterState is the observed status of a placement on a member cluster.
+type clusterState struct {
+	// conditionStatuses are the statuses of the notified condition types; a condition that is
+	// absent or stale is considered to be Unknown.
+	conditionStatuses map[string]metav1.ConditionStatus
+	// driftedResources are the resources that have drifted.
+	driftedResources sets.Set[string]
+}
+
+// Reconciler watches the status of placements and sends notifications on placement failures
+// and configuration drifts.
+type Reconciler struct {
+	client.Client
+
+	// Notifier sends the notifications.
+	Notifier *Notifier
+
+	mu sync.Mutex
+	// observedStates are the last observed per-cluster statuses of placements, keyed by the placement keys.
+	//
+	// Note that the states are kept in memory only; after the hub agent restarts (or the leader changes),
+	// the first observation of each placement is used as the baseline and no notification is sent for it.
+	observedStates map[queue.PlacementKey]map[string]*clusterState
+}
+
+// Reconcile compares the current status of a placement with the last observed one and sends
+// notifications for the changes of interest.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	placementKey := controller.GetObjectKeyFromRequest(req)
+	startTime := time.Now()
+	klog.V(2).InfoS("Placement notifier reconciliation starts", "placement", placementKey)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Placement notifier reconciliation ends", "placement", placementKey, "latency", latency)
+	}()
+
+	placementObj, err := controller.FetchPlacementFromKey(ctx, r.Client, placementKey)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(4).InfoS("Ignoring NotFound placement", "placement", placementKey)
+			r.forget(placementKey)
+			return ctrl.Result{}, nil
+		}
+		klog.ErrorS(err, "Failed to get placement", "placement", placementKey)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	if placementObj.GetDeletionTimestamp() != nil {
+		klog.V(2).InfoS("Ignoring placement that is being deleted", "placement", placementKey)
+		r.forget(placementKey)
+		return ctrl.Result{}, nil
+	}
+
+	currentStates := buildClusterStates(placementObj)
+
+	r.mu.Lock()
+	if r.observedStates == nil {
+		r.observedStates = make(map[queue.PlacementKey]map[string]*clusterState)
+	}
+	lastStates, found := r.observedStates[placementKey]
+	r.mu.Unlock()
+	if !found {
+		// Use the first observation as the baseline.
+		r.remember(placementKey, currentStates)
+		return ctrl.Result{}, nil
+	}
+
+	events := buildEvents(placementObj, lastStates, currentStates, time.Now())
+	var errs []error
+	for i := range events {
+		if err := r.Notifier.Notify(ctx, &events[i]); err != nil {
+			klog.ErrorS(err, "Failed to send placement notification", "placement", placementKey, "cluster", events[i].ClusterName, "type", events[i].Type)
+			errs = append(errs, err)
+		}
+	}
+	if len(errs) > 0 {
+		// Keep the last observed states so that the failed notifications will be retried; the
+		// notifications that have been sent will be suppressed as duplicates.
+		return ctrl.Result{}, errors.Join(errs...)
+	}
+	r.remember(placementKey, currentStates)
+	return ctrl.Result{}, nil
+}
+
+func (r *Reconciler) remember(placementKey queue.PlacementKey, states map[string]*clusterState) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.observedStates[placementKey] = states
+}
+
+func (r *Reconciler) forget(placementKey queue.PlacementKey) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	delete(r.observedStates, placementKey)
+}
+
+// buildClusterStates builds the per-cluster states from the status of a placement.
+func buildClusterStates(placementObj placementv1beta1.PlacementObj) map[string]*clusterState {
+	perClusterStatuses := placementObj.GetPlacementStatus().PerClusterPlacementStatuses
+	states := make(map[string]*clusterState, len(perClusterStatuses))
+	for i := range perClusterStatuses {
+		s := &perClusterStatuses[i]
+		if s.ClusterName == "" {
+			continue
+		}
+		state := &clusterState{
+			conditionStatuses: make(map[string]metav1.ConditionStatus, len(notifiedConditionTypes)),
+			driftedResources:  sets.New[string](),
+		}
+		for _, condType := range notifiedConditionTypes {
+			status := metav1.ConditionUnknown
+			if cond := meta.FindStatusCondition(s.Conditions, condType); cond != nil && cond.ObservedGeneration == placementObj.GetGeneration() {
+				status = cond.Status
+			}
+			state.conditionStatuses[condType] = status
+		}
+		for j := range s.DriftedPlacements {
+			state.driftedResources.Insert(formatResourceIdentifier(&s.DriftedPlacements[j].ResourceIdentifier))
+		}
+		states[s.ClusterName] = state
+	}
+	return states
+}
+
+// buildEvents builds the notifications for the changes between the last observed and the current
+// per-cluster states of a placement.
+func buildEvents(placementObj placementv1beta1.PlacementObj, lastStates, currentStates map[string]*clusterState, now time.Time) []Event {
+	clusterNames := make([]string, 0, len(currentStates))
+	for clusterName := range currentStates {
+		clusterNames = append(clusterNames, clusterName)
+	}
+	sort.Strings(clusterNames)
+
+	perClusterStatuses := placementObj.GetPlacementStatus().PerClusterPlacementStatuses
+	var events []Event
+	for _, clusterName := range clusterNames {
+		current := currentStates[clusterName]
+		last := lastStates[clusterName]
+
+		for _, condType := range notifiedConditionTypes {
+			if current.conditionStatuses[condType] != metav1.ConditionFalse {
+				continue
+			}
+			if last != nil && last.conditionStatuses[condType] == metav1.ConditionFalse {
+				// The condition has been False already.
+				continue
+			}
+			e := newEvent(placementObj, EventTypeConditionFalse, clusterName, now)
+			e.ConditionType = condType
+			for i := range perClusterStatuses {
+				if perClusterStatuses[i].ClusterName != clusterName {
+					continue
+				}
+				if cond := meta.FindStatusCondition(perClusterStatuses[i].Conditions, condType); cond != nil {
+					e.Reason = cond.Reason
+					e.Message = cond.Message
+				}
+				break
+			}
+			events = append(events, e)
+		}
+
+		newlyDrifted := current.driftedResources
+		if last != nil {
+			newlyDrifted = current.driftedResources.Difference(last.driftedResources)
+		}
+		if newlyDrifted.Len() > 0 {
+			e := newEvent(placementObj, EventTypeDriftDetected, clusterName, now)
+			e.DriftedResources = sets.List(newlyDrifted)
+			e.Message = fmt.Sprintf("Found configuration drifts on %d resource(s)", newlyDrifted.Len())
+			events = append(events, e)
+		}
+	}
+	return events
+}
+
+func newEvent(placementObj placementv1beta1.PlacementObj, eventType EventType, clusterName string, now time.Time) Event {
+	placementKind := placementv1beta1.ClusterResourcePlacementKind
+	if placementObj.GetNamespace() != "" {
+		placementKind = placementv1beta1.ResourcePlacementKind
+	}
+	return Event{
+		Type:               eventType,
+		PlacementKind:      placementKind,
+		PlacementNamespace: placementObj.GetNamespace(),
+		PlacementName:      placementObj.GetName(),
+		ClusterName:        clusterName,
+		Time:               now,
+	}
+}
+
+// formatResourceIdentifier formats a resource identifier in the form of group/version/kind/namespace/name.
+func formatResourceIdentifier(id *placementv1beta1.ResourceIdentifier) string {
+	return strings.Join([]string{id.Group, id.Version, id.Kind, id.Namespace, id.Name}, "/")
+}
+
+// SetupWithManagerForClusterResourcePlacement sets up the controller with the manager for ClusterResourcePlacements.
+func (r *Reconciler) SetupWithManagerForClusterResourcePlacement(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).Named("cluster-resource-placement-notifier").
+		For(&placementv1beta1.ClusterResourcePlacement{}).
+		Complete(r)
+}
+
+// SetupWithManagerForResourcePlacement sets up the controller with the manager for ResourcePlacements.
+func (r *Reconciler) SetupWithManagerForResourcePlacement(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).Named("resource-placement-notifier").
+		For(&placementv1beta1.ResourcePlacement{}).
+		Complete(r)
+}
@@ -0,0 +1,293 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementnotifier
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	crpName     = "crp-1"
+	rpNamespace = "app"
+	rpName      = "rp-1"
+	cluster1    = "cluster-1"
+	cluster2    = "cluster-2"
+	generation  = 2
+)
+
+var (
+	deployIdentifier = placementv1beta1.DriftedResourcePlacement{
+		ResourceIdentifier: placementv1beta1.ResourceIdentifier{
+			Group:     "apps",
+			Version:   "v1",
+			Kind:      "Deployment",
+			Namespace: "app",
+			Name:      "web",
+		},
+	}
+	configMapIdentifier = placementv1beta1.DriftedResourcePlacement{
+		ResourceIdentifier: placementv1beta1.ResourceIdentifier{
+			Version:   "v1",
+			Kind:      "ConfigMap",
+			Namespace: "app",
+			Name:      "config",
+		},
+	}
+)
+
+func perClusterCondition(condType placementv1beta1.PerClusterPlacementConditionType, status metav1.ConditionStatus, observedGeneration int64) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(condType),
+		Status:             status,
+		Reason:             string(condType) + string(status),
+		Message:            string(condType) + " is " + string(status),
+		ObservedGeneration: observedGeneration,
+	}
+}
+
+func newState(applied, available metav1.ConditionStatus, drifted ...string) *clusterState {
+	return &clusterState{
+		conditionStatuses: map[string]metav1.ConditionStatus{
+			string(placementv1beta1.PerClusterAppliedConditionType):   applied,
+			string(placementv1beta1.PerClusterAvailableConditionType): available,
+		},
+		driftedResources: sets.New(drifted...),
+	}
+}
+
+func crpWithStatuses(statuses ...placementv1beta1.PerClusterPlacementStatus) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       crpName,
+			Generation: generation,
+		},
+		Status: placementv1beta1.PlacementStatus{
+			PerClusterPlacementStatuses: statuses,
+		},
+	}
+}
+
+// TestBuildClusterStates tests the buildClusterStates function.
+func TestBuildClusterStates(t *testing.T) {
+	testCases := []struct {
+		name       string
+		placement  placementv1beta1.PlacementObj
+		wantStates map[string]*clusterState
+	}{
+		{
+			name:       "no per-cluster statuses",
+			placement:  crpWithStatuses(),
+			wantStates: map[string]*clusterState{},
+		},
+		{
+			name: "up-to-date, stale and absent conditions",
+			placement: crpWithStatuses(
+				placementv1beta1.PerClusterPlacementStatus{
+					ClusterName: cluster1,
+					Conditions: []metav1.Condition{
+						perClusterCondition(placementv1beta1.PerClusterAppliedConditionType, metav1.ConditionFalse, generation),
+						perClusterCondition(placementv1beta1.PerClusterAvailableConditionType, metav1.ConditionTrue, generation-1),
+					},
+				},
+				placementv1beta1.PerClusterPlacementStatus{
+					ClusterName: cluster2,
+				},
+				// Statuses of unselected clusters do not have cluster names.
+				placementv1beta1.PerClusterPlacementStatus{},
+			),
+			wantStates: map[string]*clusterState{
+				cluster1: newState(metav1.ConditionFalse, metav1.ConditionUnknown),
+				cluster2: newState(metav1.ConditionUnknown, metav1.ConditionUnknown),
+			},
+		},
+		{
+			name: "drifted resources",
+			placement: crpWithStatuses(placementv1beta1.PerClusterPlacementStatus{
+				ClusterName: cluster1,
+				Conditions: []metav1.Condition{
+					perClusterCondition(placementv1beta1.PerClusterAppliedConditionType, metav1.ConditionTrue, generation),
+					perClusterCondition(placementv1beta1.PerClusterAvailableConditionType, metav1.ConditionTrue, generation),
+				},
+				DriftedPlacements: []placementv1beta1.DriftedResourcePlacement{deployIdentifier, configMapIdentifier},
+			}),
+			wantStates: map[string]*clusterState{
+				cluster1: newState(metav1.ConditionTrue, metav1.ConditionTrue, "apps/v1/Deployment/app/web", "/v1/ConfigMap/app/config"),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := buildClusterStates(tc.placement)
+			if diff := cmp.Diff(got, tc.wantStates, cmp.AllowUnexported(clusterState{})); diff != "" {
+				t.Errorf("buildClusterStates() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestBuildEvents tests the buildEvents function.
+func TestBuildEvents(t *testing.T) {
+	appliedFalse := perClusterCondition(placementv1beta1.PerClusterAppliedConditionType, metav1.ConditionFalse, generation)
+	availableFalse := perClusterCondition(placementv1beta1.PerClusterAvailableConditionType, metav1.ConditionFalse, generation)
+
+	rp := &placementv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:  rpNamespace,
+			Name:       rpName,
+			Generation: generation,
+		},
+		Status: placementv1beta1.PlacementStatus{
+			PerClusterPlacementStatuses: []placementv1beta1.PerClusterPlacementStatus{
+				{
+					ClusterName: cluster1,
+					Conditions:  []metav1.Condition{availableFalse},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name          string
+		placement     placementv1beta1.PlacementObj
+		lastStates    map[string]*clusterState
+		currentStates map[string]*clusterState
+		wantEvents    []Event
+	}{
+		{
+			name:      "no changes",
+			placement: crpWithStatuses(),
+			lastStates: map[string]*clusterState{
+				cluster1: newState(metav1.ConditionFalse, metav1.ConditionUnknown, "apps/v1/Deployment/app/web"),
+			},
+			currentStates: map[string]*clusterState{
+				cluster1: newState(metav1.ConditionFalse, metav1.ConditionUnknown, "apps/v1/Deployment/app/web"),
+			},
+		},
+		{
+			name: "conditions transition to False",
+			placement: crpWithStatuses(
+				placementv1beta1.PerClusterPlacementStatus{
+					ClusterName: cluster1,
+					Conditions:  []metav1.Condition{appliedFalse},
+				},
+				placementv1beta1.PerClusterPlacementStatus{
+					ClusterName: cluster2,
+					Conditions:  []metav1.Condition{availableFalse},
+				},
+			),
+			lastStates: map[string]*clusterState{
+				cluster1: newState(metav1.ConditionTrue, metav1.ConditionTrue),
+			},
+			currentStates: map[string]*clusterState{
+				cluster1: newState(metav1.ConditionFalse, metav1.ConditionUnknown),
+				cluster2: newState(metav1.ConditionTrue, metav1.ConditionFalse),
+			},
+			wantEvents: []Event{
+				{
+					Type:          EventTypeConditionFalse,
+					PlacementKind: placementv1beta1.ClusterResourcePlacementKind,
+					PlacementName: crpName,
+					ClusterName:   cluster1,
+					ConditionType: appliedFalse.Type,
+					Reason:        appliedFalse.Reason,
+					Message:       appliedFalse.Message,
+					Time:          eventTime,
+				},
+				{
+					Type:          EventTypeConditionFalse,
+					PlacementKind: placementv1beta1.ClusterResourcePlacementKind,
+					PlacementName: crpName,
+					ClusterName:   cluster2,
+					ConditionType: availableFalse.Type,
+					Reason:        availableFalse.Reason,
+					Message:       availableFalse.Message,
+					Time:          eventTime,
+				},
+			},
+		},
+		{
+			name:      "new drifts",
+			placement: crpWithStatuses(),
+			lastStates: map[string]*clusterState{
+				cluster1: newState(metav1.ConditionTrue, metav1.ConditionTrue, "apps/v1/Deployment/app/web"),
+			},
+			currentStates: map[string]*clusterState{
+				cluster1: newState(metav1.ConditionTrue, metav1.ConditionTrue, "apps/v1/Deployment/app/web", "/v1/ConfigMap/app/config"),
+				cluster2: newState(metav1.ConditionTrue, metav1.ConditionTrue, "apps/v1/Deployment/app/web"),
+			},
+			wantEvents: []Event{
+				{
+					Type:             EventTypeDriftDetected,
+					PlacementKind:    placementv1beta1.ClusterResourcePlacementKind,
+					PlacementName:    crpName,
+					ClusterName:      cluster1,
+					Message:          "Found configuration drifts on 1 resource(s)",
+					DriftedResources: []string{"/v1/ConfigMap/app/config"},
+					Time:             eventTime,
+				},
+				{
+					Type:             EventTypeDriftDetected,
+					PlacementKind:    placementv1beta1.ClusterResourcePlacementKind,
+					PlacementName:    crpName,
+					ClusterName:      cluster2,
+					Message:          "Found configuration drifts on 1 resource(s)",
+					DriftedResources: []string{"apps/v1/Deployment/app/web"},
+					Time:             eventTime,
+				},
+			},
+		},
+		{
+			name:      "resource placement",
+			placement: rp,
+			lastStates: map[string]*clusterState{
+				cluster1: newState(metav1.ConditionTrue, metav1.ConditionTrue),
+			},
+			currentStates: map[string]*clusterState{
+				cluster1: newState(metav1.ConditionTrue, metav1.ConditionFalse),
+			},
+			wantEvents: []Event{
+				{
+					Type:               EventTypeConditionFalse,
+					PlacementKind:      placementv1beta1.ResourcePlacementKind,
+					PlacementNamespace: rpNamespace,
+					PlacementName:      rpName,
+					ClusterName:        cluster1,
+					ConditionType:      availableFalse.Type,
+					Reason:             availableFalse.Reason,
+					Message:            availableFalse.Message,
+					Time:               eventTime,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := buildEvents(tc.placement, tc.lastStates, tc.currentStates, eventTime)
+			if diff := cmp.Diff(got, tc.wantEvents); diff != "" {
+				t.Errorf("buildEvents() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementnotifier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/url"
+	"sync"
+	"text/template"
+	"time"
+
+	"golang.org/x/time/rate"
+	"k8s.io/klog/v2"
+)
+
+// EventType identifies the kind of a placement notification.
+type EventType string
+
+const (
+	// EventTypeConditionFalse is the event type for the notifications that are fired when the
+	// Applied or Available condition of a placement on a member cluster transitions to False.
+	EventTypeConditionFalse EventType = "ConditionFalse"
+
+	// EventTypeDriftDetected is the event type for the notifications that are fired when new
+	// configuration drifts are found on the resources of a placement on a member cluster.
+	EventTypeDriftDetected EventType = "DriftDetected"
+)
+
+// DefaultPayloadTemplate is the template of the webhook payloads if no template is specified;
+// it renders an event as a JSON object.
+const DefaultPayloadTemplate = `{"type":{{ json .Type }},"placementKind":{{ json .PlacementKind }},"placementNamespace":{{ json .PlacementNamespace }},"placementName":{{ json .PlacementName }},"cluster":{{ json .ClusterName }},"conditionType":{{ json .ConditionType }},"reason":{{ json .Reason }},"message":{{ json .Message }},"driftedResources":{{ json .DriftedResources }},"time":{{ json .Time }}}`
+
+// Event is a placement notification; its fields are available to the payload templates.
+type Event struct {
+	// Type is the type of the event.
+	Type EventType
+	// PlacementKind is the kind of the placement, i.e., ClusterResourcePlacement or ResourcePlacement.
+	PlacementKind string
+	// PlacementNamespace is the namespace of the placement; it is empty for cluster-scoped placements.
+	PlacementNamespace string
+	// PlacementName is the name of the placement.
+	PlacementName string
+	// ClusterName is the name of the member cluster.
+	ClusterName string
+	// ConditionType is the type of the condition that has transitioned to False; it is only set
+	// for events of the ConditionFalse type.
+	ConditionType string
+	// Reason is the reason of the condition; it is only set for events of the ConditionFalse type.
+	Reason string
+	// Message is the message of the condition, or a summary of the drifts.
+	Message string
+	// DriftedResources are the resources that have drifted; it is only set for events of the
+	// DriftDetected type.
+	DriftedResources []string
+	// Time is when the event is observed.
+	Time time.Time
+}
+
+// dedupKey returns the key with which duplicate events are identified.
+func (e *Event) dedupKey() string {
+	return fmt.Sprintf("%s/%s/%s/%s/%s/%s/%s/%v",
+		e.Type, e.PlacementKind, e.PlacementNamespace, e.PlacementName, e.ClusterName, e.ConditionType, e.Reason, e.DriftedResources)
+}
+
+// Sink delivers placement notifications.
+type Sink interface {
+	// Send delivers an event.
+	Send(ctx context.Context, e *Event) error
+}
+
+// WebhookSink delivers placement notifications by posting the rendered payloads to an HTTP endpoint,
+// e.g., a Slack incoming webhook or a PagerDuty events endpoint.
+type WebhookSink struct {
+	url         string
+	payloadTmpl *template.Template
+	client      *http.Client
+}
+
+var _ Sink = &WebhookSink{}
+
+// NewWebhookSink returns a WebhookSink that posts to the given URL with payloads rendered from the
+// given Go template. The template can use a json function to render a value as a JSON literal.
+//
+// The URL may embed an access token; it is never included in the returned errors.
+func NewWebhookSink(webhookURL, payloadTemplate string, client *http.Client) (*WebhookSink, error) {
+	if u, err := url.Parse(webhookURL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, errors.New("the webhook URL must be a valid HTTP or HTTPS URL")
+	}
+	if payloadTemplate == "" {
+		payloadTemplate = DefaultPayloadTemplate
+	}
+	tmpl, err := template.New("payload").Funcs(template.FuncMap{
+		"json": func(v any) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Parse(payloadTemplate)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the payload template: %w", err)
+	}
+	if client == nil {
+		client = &http.Client{Timeout: 10 * time.Second}
+	}
+	return &WebhookSink{
+		url:         webhookURL,
+		payloadTmpl: tmpl,
+		client:      client,
+	}, nil
+}
+
+// Send renders the payload of an event and posts it to the webhook.
+func (s *WebhookSink) Send(ctx context.Context, e *Event) error {
+	var payload bytes.Buffer
+	if err := s.payloadTmpl.Execute(&payload, e); err != nil {
+		return fmt.Errorf("failed to render the payload: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, s.url, &payload)
+	if err != nil {
+		return fmt.Errorf("failed to build the request: %w", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	resp, err := s.client.Do(req)
+	if err != nil {
+		// The error from the HTTP client quotes the URL, which may embed an access token.
+		var urlErr *url.Error
+		if errors.As(err, &urlErr) {
+			err = urlErr.Err
+		}
+		return fmt.Errorf("failed to post the payload: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("the webhook responded with status %s", resp.Status)
+	}
+	return nil
+}
+
+// Notifier sends placement notifications to a sink, with duplicate events suppressed and the
+// sending rate limited.
+type Notifier struct {
+	sink          Sink
+	limiter       *rate.Limiter
+	dedupInterval time.Duration
+
+	mu sync.Mutex
+	// lastSent tracks when an event was last sent, keyed by its dedup key.
+	lastSent map[string]time.Time
+	now      func() time.Time
+}
+
+// NewNotifier returns a Notifier that sends events to the given sink at most at the given rate
+// (with the given burst); an event is not sent again within the dedup interval after it is sent.
+func NewNotifier(sink Sink, qps float64, burst int, dedupInterval time.Duration) *Notifier {
+	return &Notifier{
+		sink:          sink,
+		limiter:       rate.NewLimiter(rate.Limit(qps), burst),
+		dedupInterval: dedupInterval,
+		lastSent:      make(map[string]time.Time),
+		now:           time.Now,
+	}
+}
+
+// Notify sends an event; duplicate events and events that exceed the rate limit are dropped.
+func (n *Notifier) Notify(ctx context.Context, e *Event) error {
+	key := e.dedupKey()
+	now := n.now()
+
+	n.mu.Lock()
+	// Forget the events that are out of the dedup interval, so that the map does not grow unbounded.
+	for k, t := range n.lastSent {
+		if now.Sub(t) >= n.dedupInterval {
+			delete(n.lastSent, k)
+		}
+	}
+	_, isDuplicate := n.lastSent[key]
+	n.mu.Unlock()
+	if isDuplicate {
+		klog.V(2).InfoS("Dropped a duplicate placement notification", "type", e.Type, "placement", e.PlacementName, "cluster", e.ClusterName)
+		return nil
+	}
+
+	if !n.limiter.Allow() {
+		klog.V(2).InfoS("Dropped a placement notification as the rate limit is exceeded", "type", e.Type, "placement", e.PlacementName, "cluster", e.ClusterName)
+		return nil
+	}
+
+	if err := n.sink.Send(ctx, e); err != nil {
+		return err
+	}
+
+	n.mu.Lock()
+	n.lastSent[key] = now
+	n.mu.Unlock()
+	return nil
+}
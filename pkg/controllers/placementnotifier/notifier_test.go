@@ -0,0 +1,256 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementnotifier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+var (
+	eventTime = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	conditionFalseEvent = Event{
+		Type:          EventTypeConditionFalse,
+		PlacementKind: "ClusterResourcePlacement",
+		PlacementName: "crp-1",
+		ClusterName:   "cluster-1",
+		ConditionType: "Applied",
+		Reason:        "NotAllWorkHaveBeenApplied",
+		Message:       "failed to apply manifests",
+		Time:          eventTime,
+	}
+)
+
+// fakeSink records the events it receives and fails with the given error, if any.
+type fakeSink struct {
+	events []Event
+	err    error
+}
+
+func (s *fakeSink) Send(_ context.Context, e *Event) error {
+	if s.err != nil {
+		return s.err
+	}
+	s.events = append(s.events, *e)
+	return nil
+}
+
+// TestWebhookSinkSend tests the Send method of WebhookSink.
+func TestWebhookSinkSend(t *testing.T) {
+	testCases := []struct {
+		name            string
+		payloadTemplate string
+		statusCode      int
+		wantPayload     string
+		wantErr         bool
+	}{
+		{
+			name:        "default template",
+			statusCode:  http.StatusOK,
+			wantPayload: `{"type":"ConditionFalse","placementKind":"ClusterResourcePlacement","placementNamespace":"","placementName":"crp-1","cluster":"cluster-1","conditionType":"Applied","reason":"NotAllWorkHaveBeenApplied","message":"failed to apply manifests","driftedResources":null,"time":"2025-01-01T00:00:00Z"}`,
+		},
+		{
+			name:            "custom template",
+			payloadTemplate: `{"text":{{ json (printf "%s on %s: %s" .PlacementName .ClusterName .Message) }}}`,
+			statusCode:      http.StatusAccepted,
+			wantPayload:     `{"text":"crp-1 on cluster-1: failed to apply manifests"}`,
+		},
+		{
+			name:            "template execution error",
+			payloadTemplate: `{{ .Unknown }}`,
+			statusCode:      http.StatusOK,
+			wantErr:         true,
+		},
+		{
+			name:       "non-2xx response",
+			statusCode: http.StatusInternalServerError,
+			wantErr:    true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotPayload string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if got := r.Header.Get("Content-Type"); got != "application/json" {
+					t.Errorf("request Content-Type = %q, want application/json", got)
+				}
+				b, err := io.ReadAll(r.Body)
+				if err != nil {
+					t.Errorf("failed to read the request body: %v", err)
+				}
+				gotPayload = string(b)
+				w.WriteHeader(tc.statusCode)
+			}))
+			defer server.Close()
+
+			sink, err := NewWebhookSink(server.URL, tc.payloadTemplate, server.Client())
+			if err != nil {
+				t.Fatalf("NewWebhookSink() = %v, want no error", err)
+			}
+			e := conditionFalseEvent
+			err = sink.Send(context.Background(), &e)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("Send() = %v, want error %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if !json.Valid([]byte(gotPayload)) {
+				t.Errorf("Send() posted invalid JSON payload %q", gotPayload)
+			}
+			if diff := cmp.Diff(gotPayload, tc.wantPayload); diff != "" {
+				t.Errorf("Send() payload mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestNewWebhookSink_InvalidTemplate tests that NewWebhookSink rejects invalid payload templates.
+func TestNewWebhookSink_InvalidTemplate(t *testing.T) {
+	if _, err := NewWebhookSink("http://example.com", "{{ .Type", nil); err == nil {
+		t.Errorf("NewWebhookSink() = nil, want error")
+	}
+}
+
+// TestNewWebhookSink_InvalidURL tests that NewWebhookSink rejects invalid URLs without quoting them.
+func TestNewWebhookSink_InvalidURL(t *testing.T) {
+	_, err := NewWebhookSink("hooks.example.com/services/secret-token", "", nil)
+	if err == nil {
+		t.Fatalf("NewWebhookSink() = nil, want error")
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("NewWebhookSink() = %v, want the error not to quote the URL", err)
+	}
+}
+
+// TestWebhookSinkSend_RedactsURL tests that the errors of posting payloads do not quote the webhook URL.
+func TestWebhookSinkSend_RedactsURL(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {}))
+	webhookURL := server.URL + "/services/secret-token"
+	// Close the server so that posting the payload fails.
+	server.Close()
+
+	sink, err := NewWebhookSink(webhookURL, "", nil)
+	if err != nil {
+		t.Fatalf("NewWebhookSink() = %v, want no error", err)
+	}
+	e := conditionFalseEvent
+	err = sink.Send(context.Background(), &e)
+	if err == nil {
+		t.Fatalf("Send() = nil, want error")
+	}
+	if strings.Contains(err.Error(), "secret-token") {
+		t.Errorf("Send() = %v, want the error not to quote the URL", err)
+	}
+}
+
+// TestNotify tests the Notify method of Notifier.
+func TestNotify(t *testing.T) {
+	driftEvent := Event{
+		Type:             EventTypeDriftDetected,
+		PlacementKind:    "ClusterResourcePlacement",
+		PlacementName:    "crp-1",
+		ClusterName:      "cluster-1",
+		DriftedResources: []string{"apps/v1/Deployment/app/web"},
+		Time:             eventTime,
+	}
+
+	testCases := []struct {
+		name   string
+		events []Event
+		// sendAfter are the offsets from the start at which the events are sent.
+		sendAfter  []time.Duration
+		burst      int
+		wantEvents []Event
+	}{
+		{
+			name:       "distinct events",
+			events:     []Event{conditionFalseEvent, driftEvent},
+			sendAfter:  []time.Duration{0, 0},
+			burst:      10,
+			wantEvents: []Event{conditionFalseEvent, driftEvent},
+		},
+		{
+			name:       "duplicate events within the dedup interval",
+			events:     []Event{conditionFalseEvent, conditionFalseEvent},
+			sendAfter:  []time.Duration{0, 30 * time.Minute},
+			burst:      10,
+			wantEvents: []Event{conditionFalseEvent},
+		},
+		{
+			name:       "duplicate events out of the dedup interval",
+			events:     []Event{conditionFalseEvent, conditionFalseEvent},
+			sendAfter:  []time.Duration{0, 2 * time.Hour},
+			burst:      10,
+			wantEvents: []Event{conditionFalseEvent, conditionFalseEvent},
+		},
+		{
+			name:       "rate limited events",
+			events:     []Event{conditionFalseEvent, driftEvent},
+			sendAfter:  []time.Duration{0, 0},
+			burst:      1,
+			wantEvents: []Event{conditionFalseEvent},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sink := &fakeSink{}
+			// Use a negligible QPS so that the tokens are not refilled during the test.
+			n := NewNotifier(sink, 1e-9, tc.burst, time.Hour)
+			for i := range tc.events {
+				now := eventTime.Add(tc.sendAfter[i])
+				n.now = func() time.Time { return now }
+				if err := n.Notify(context.Background(), &tc.events[i]); err != nil {
+					t.Fatalf("Notify() = %v, want no error", err)
+				}
+			}
+			if diff := cmp.Diff(sink.events, tc.wantEvents); diff != "" {
+				t.Errorf("sent events mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestNotify_SinkError tests that an event that fails to be sent is not suppressed as a duplicate.
+func TestNotify_SinkError(t *testing.T) {
+	sink := &fakeSink{err: errors.New("unavailable")}
+	n := NewNotifier(sink, 100, 10, time.Hour)
+	e := conditionFalseEvent
+	if err := n.Notify(context.Background(), &e); err == nil {
+		t.Fatalf("Notify() = nil, want error")
+	}
+
+	sink.err = nil
+	if err := n.Notify(context.Background(), &e); err != nil {
+		t.Fatalf("Notify() = %v, want no error", err)
+	}
+	if diff := cmp.Diff(sink.events, []Event{conditionFalseEvent}); diff != "" {
+		t.Errorf("sent events mismatch (-got, +want):\n%s", diff)
+	}
+}
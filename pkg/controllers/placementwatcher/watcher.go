@@ -23,6 +23,7 @@ import (
 
 	"k8s.io/klog/v2"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
@@ -53,7 +54,7 @@ func (r *Reconciler) Reconcile(_ context.Context, req ctrl.Request) (ctrl.Result
 func (r *Reconciler) SetupWithManagerForClusterResourcePlacement(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).Named("clusterresourceplacement-watcher").
 		For(&fleetv1beta1.ClusterResourcePlacement{}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, pinnedResourceSnapshotChangedPredicate())).
 		Complete(r)
 }
 
@@ -61,6 +62,20 @@ func (r *Reconciler) SetupWithManagerForClusterResourcePlacement(mgr ctrl.Manage
 func (r *Reconciler) SetupWithManagerForResourcePlacement(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).Named("resourceplacement-watcher").
 		For(&fleetv1beta1.ResourcePlacement{}).
-		WithEventFilter(predicate.GenerationChangedPredicate{}).
+		WithEventFilter(predicate.Or(predicate.GenerationChangedPredicate{}, pinnedResourceSnapshotChangedPredicate())).
 		Complete(r)
 }
+
+// pinnedResourceSnapshotChangedPredicate triggers a placement reconcile round when the placement is pinned to
+// (or unpinned from) a resource snapshot, which does not change the generation of the placement but changes
+// the resource snapshot that the placement status reports.
+func pinnedResourceSnapshotChangedPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				return false
+			}
+			return e.ObjectOld.GetAnnotations()[fleetv1beta1.PinnedResourceSnapshotIndexAnnotation] != e.ObjectNew.GetAnnotations()[fleetv1beta1.PinnedResourceSnapshotIndexAnnotation]
+		},
+	}
+}
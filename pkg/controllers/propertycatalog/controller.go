@@ -0,0 +1,205 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package propertycatalog features a controller that maintains the PropertyCatalog object, which
+// lists all the properties currently published by the member clusters.
+package propertycatalog
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// maxSampleValues is the maximum number of sample values kept for each property.
+	maxSampleValues = 5
+)
+
+// catalogRequest is the request that all the events are mapped to, as there is only one
+// PropertyCatalog object.
+var catalogRequest = reconcile.Request{NamespacedName: types.NamespacedName{Name: clusterv1beta1.PropertyCatalogName}}
+
+// Reconciler reconciles the PropertyCatalog object from the MemberCluster objects.
+type Reconciler struct {
+	client.Client
+}
+
+// Reconcile rebuilds the PropertyCatalog object from the properties that all the member clusters
+// currently publish.
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	if req.Name != clusterv1beta1.PropertyCatalogName {
+		// Only the PropertyCatalog object of the well-known name is maintained.
+		klog.V(2).InfoS("Skipping the property catalog that is not maintained by the hub agent", "propertyCatalog", req.Name)
+		return ctrl.Result{}, nil
+	}
+	startTime := time.Now()
+	klog.V(2).InfoS("PropertyCatalog reconciliation starts", "propertyCatalog", req.Name)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("PropertyCatalog reconciliation ends", "propertyCatalog", req.Name, "latency", latency)
+	}()
+
+	mcList := &clusterv1beta1.MemberClusterList{}
+	if err := r.Client.List(ctx, mcList); err != nil {
+		klog.ErrorS(err, "Failed to list member clusters")
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	status := buildPropertyCatalogStatus(mcList.Items)
+
+	catalog := &clusterv1beta1.PropertyCatalog{}
+	err := r.Client.Get(ctx, req.NamespacedName, catalog)
+	switch {
+	case apierrors.IsNotFound(err):
+		catalog = &clusterv1beta1.PropertyCatalog{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterv1beta1.PropertyCatalogName,
+			},
+		}
+		if err := r.Client.Create(ctx, catalog); err != nil {
+			klog.ErrorS(err, "Failed to create the property catalog", "propertyCatalog", klog.KObj(catalog))
+			return ctrl.Result{}, controller.NewCreateIgnoreAlreadyExistError(err)
+		}
+		klog.V(2).InfoS("Created the property catalog", "propertyCatalog", klog.KObj(catalog))
+	case err != nil:
+		klog.ErrorS(err, "Failed to get the property catalog", "propertyCatalog", req.Name)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+
+	if equality.Semantic.DeepEqual(catalog.Status, status) {
+		return ctrl.Result{}, nil
+	}
+	catalog.Status = status
+	if err := r.Client.Status().Update(ctx, catalog); err != nil {
+		klog.ErrorS(err, "Failed to update the property catalog status", "propertyCatalog", klog.KObj(catalog))
+		return ctrl.Result{}, controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the property catalog status", "propertyCatalog", klog.KObj(catalog), "memberClusterCount", status.MemberClusterCount, "propertyCount", len(status.Properties))
+	return ctrl.Result{}, nil
+}
+
+// catalogEntryBuilder collects the observations of a property across the member clusters.
+type catalogEntryBuilder struct {
+	propertyType clusterv1beta1.PropertyType
+	clusterCount int32
+	values       map[string]bool
+}
+
+// buildPropertyCatalogStatus builds the status of the PropertyCatalog object from the properties,
+// resource or non-resource, that the member clusters publish.
+//
+// Member clusters that are being deleted are not cataloged.
+func buildPropertyCatalogStatus(clusters []clusterv1beta1.MemberCluster) clusterv1beta1.PropertyCatalogStatus {
+	builders := make(map[clusterv1beta1.PropertyName]*catalogEntryBuilder)
+	observe := func(name string, propertyType clusterv1beta1.PropertyType, value string) {
+		b, found := builders[clusterv1beta1.PropertyName(name)]
+		if !found {
+			b = &catalogEntryBuilder{
+				propertyType: propertyType,
+				values:       make(map[string]bool),
+			}
+			builders[clusterv1beta1.PropertyName(name)] = b
+		}
+		b.clusterCount++
+		b.values[value] = true
+	}
+
+	var memberClusterCount int32
+	for idx := range clusters {
+		mc := &clusters[idx]
+		if mc.DeletionTimestamp != nil {
+			continue
+		}
+		memberClusterCount++
+
+		for name, v := range mc.Status.Properties {
+			observe(string(name), clusterv1beta1.PropertyTypeNonResource, v.Value)
+		}
+
+		resourceUsage := mc.Status.ResourceUsage
+		for capacityName, resources := range map[string]corev1.ResourceList{
+			propertyprovider.TotalCapacityName:       resourceUsage.Capacity,
+			propertyprovider.AllocatableCapacityName: resourceUsage.Allocatable,
+			propertyprovider.AvailableCapacityName:   resourceUsage.Available,
+		} {
+			for resourceName, q := range resources {
+				// A resource property name is of the format `[PREFIX]/[CAPACITY_TYPE]-[RESOURCE_NAME]`;
+				// resources whose names contain dashes (e.g., ephemeral-storage) cannot be referred to.
+				if strings.Contains(string(resourceName), "-") {
+					continue
+				}
+				name := fmt.Sprintf("%s%s-%s", propertyprovider.ResourcePropertyNamePrefix, capacityName, resourceName)
+				observe(name, clusterv1beta1.PropertyTypeResource, q.String())
+			}
+		}
+	}
+
+	status := clusterv1beta1.PropertyCatalogStatus{
+		MemberClusterCount: memberClusterCount,
+	}
+	if len(builders) == 0 {
+		return status
+	}
+	status.Properties = make([]clusterv1beta1.PropertyCatalogEntry, 0, len(builders))
+	for name, b := range builders {
+		values := make([]string, 0, len(b.values))
+		for v := range b.values {
+			values = append(values, v)
+		}
+		sort.Strings(values)
+		if len(values) > maxSampleValues {
+			values = values[:maxSampleValues]
+		}
+		status.Properties = append(status.Properties, clusterv1beta1.PropertyCatalogEntry{
+			Name:         name,
+			Type:         b.propertyType,
+			ClusterCount: b.clusterCount,
+			SampleValues: values,
+		})
+	}
+	sort.Slice(status.Properties, func(i, j int) bool {
+		return status.Properties[i].Name < status.Properties[j].Name
+	})
+	return status
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).Named("propertycatalog-controller").
+		For(&clusterv1beta1.PropertyCatalog{}).
+		Watches(&clusterv1beta1.MemberCluster{}, handler.EnqueueRequestsFromMapFunc(
+			func(_ context.Context, _ client.Object) []reconcile.Request {
+				return []reconcile.Request{catalogRequest}
+			})).
+		Complete(r)
+}
@@ -0,0 +1,221 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propertycatalog
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+)
+
+func memberClusterWithProperties(name string, properties map[string]string, resourceUsage clusterv1beta1.ResourceUsage) clusterv1beta1.MemberCluster {
+	mc := clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			ResourceUsage: resourceUsage,
+		},
+	}
+	if len(properties) > 0 {
+		mc.Status.Properties = make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue, len(properties))
+		for k, v := range properties {
+			mc.Status.Properties[clusterv1beta1.PropertyName(k)] = clusterv1beta1.PropertyValue{
+				Value:           v,
+				ObservationTime: metav1.Now(),
+			}
+		}
+	}
+	return mc
+}
+
+func TestBuildPropertyCatalogStatus(t *testing.T) {
+	deletingCluster := memberClusterWithProperties("member-3", map[string]string{"example.com/deleting": "1"}, clusterv1beta1.ResourceUsage{})
+	deletingCluster.DeletionTimestamp = &metav1.Time{Time: metav1.Now().Time}
+
+	manyClusters := make([]clusterv1beta1.MemberCluster, 0, 7)
+	for i := 0; i < 7; i++ {
+		manyClusters = append(manyClusters, memberClusterWithProperties(fmt.Sprintf("member-%d", i), map[string]string{
+			propertyprovider.NodeCountProperty: fmt.Sprintf("%d", i),
+		}, clusterv1beta1.ResourceUsage{}))
+	}
+
+	tests := []struct {
+		name     string
+		clusters []clusterv1beta1.MemberCluster
+		want     clusterv1beta1.PropertyCatalogStatus
+	}{
+		{
+			name: "no member clusters",
+			want: clusterv1beta1.PropertyCatalogStatus{},
+		},
+		{
+			name: "resource and non-resource properties",
+			clusters: []clusterv1beta1.MemberCluster{
+				memberClusterWithProperties("member-1", map[string]string{
+					propertyprovider.NodeCountProperty: "3",
+					"example.com/gpu-generation":       "4",
+				}, clusterv1beta1.ResourceUsage{
+					Available: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("2"),
+						// Resources whose names contain dashes cannot be referred to.
+						corev1.ResourceEphemeralStorage: resource.MustParse("10Gi"),
+					},
+				}),
+				memberClusterWithProperties("member-2", map[string]string{
+					propertyprovider.NodeCountProperty: "3",
+				}, clusterv1beta1.ResourceUsage{
+					Available: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("500m"),
+					},
+					Capacity: corev1.ResourceList{
+						corev1.ResourceMemory: resource.MustParse("4Gi"),
+					},
+				}),
+				deletingCluster,
+			},
+			want: clusterv1beta1.PropertyCatalogStatus{
+				MemberClusterCount: 2,
+				Properties: []clusterv1beta1.PropertyCatalogEntry{
+					{
+						Name:         "example.com/gpu-generation",
+						Type:         clusterv1beta1.PropertyTypeNonResource,
+						ClusterCount: 1,
+						SampleValues: []string{"4"},
+					},
+					{
+						Name:         propertyprovider.NodeCountProperty,
+						Type:         clusterv1beta1.PropertyTypeNonResource,
+						ClusterCount: 2,
+						SampleValues: []string{"3"},
+					},
+					{
+						Name:         propertyprovider.AvailableCPUCapacityProperty,
+						Type:         clusterv1beta1.PropertyTypeResource,
+						ClusterCount: 2,
+						SampleValues: []string{"2", "500m"},
+					},
+					{
+						Name:         propertyprovider.TotalMemoryCapacityProperty,
+						Type:         clusterv1beta1.PropertyTypeResource,
+						ClusterCount: 1,
+						SampleValues: []string{"4Gi"},
+					},
+				},
+			},
+		},
+		{
+			name:     "sample values are capped",
+			clusters: manyClusters,
+			want: clusterv1beta1.PropertyCatalogStatus{
+				MemberClusterCount: 7,
+				Properties: []clusterv1beta1.PropertyCatalogEntry{
+					{
+						Name:         propertyprovider.NodeCountProperty,
+						Type:         clusterv1beta1.PropertyTypeNonResource,
+						ClusterCount: 7,
+						SampleValues: []string{"0", "1", "2", "3", "4"},
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := buildPropertyCatalogStatus(tc.clusters)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("buildPropertyCatalogStatus() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	mc := memberClusterWithProperties("member-1", map[string]string{propertyprovider.NodeCountProperty: "3"}, clusterv1beta1.ResourceUsage{})
+	wantStatus := clusterv1beta1.PropertyCatalogStatus{
+		MemberClusterCount: 1,
+		Properties: []clusterv1beta1.PropertyCatalogEntry{
+			{
+				Name:         propertyprovider.NodeCountProperty,
+				Type:         clusterv1beta1.PropertyTypeNonResource,
+				ClusterCount: 1,
+				SampleValues: []string{"3"},
+			},
+		},
+	}
+
+	tests := []struct {
+		name    string
+		objects []client.Object
+	}{
+		{
+			name:    "property catalog does not exist",
+			objects: []client.Object{&mc},
+		},
+		{
+			name: "property catalog is out of date",
+			objects: []client.Object{
+				&mc,
+				&clusterv1beta1.PropertyCatalog{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: clusterv1beta1.PropertyCatalogName,
+					},
+					Status: clusterv1beta1.PropertyCatalogStatus{
+						MemberClusterCount: 2,
+					},
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.objects...).
+				WithStatusSubresource(&clusterv1beta1.PropertyCatalog{}).
+				Build()
+			r := &Reconciler{Client: fakeClient}
+			if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: catalogRequest.NamespacedName}); err != nil {
+				t.Fatalf("Reconcile() got error %v, want no error", err)
+			}
+			catalog := &clusterv1beta1.PropertyCatalog{}
+			if err := fakeClient.Get(ctx, catalogRequest.NamespacedName, catalog); err != nil {
+				t.Fatalf("failed to get the property catalog: %v", err)
+			}
+			if diff := cmp.Diff(wantStatus, catalog.Status); diff != "" {
+				t.Errorf("property catalog status mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
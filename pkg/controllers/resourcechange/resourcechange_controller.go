@@ -79,14 +79,70 @@ func (r *Reconciler) Reconcile(_ context.Context, key controller.QueueKey) (ctrl
 
 	// The clusterObj is set to be the object that the placement direct selects.
 	clusterObj, isClusterScoped, err := r.getUnstructuredObject(clusterWideKey)
+	var result ctrl.Result
 	switch {
 	case apierrors.IsNotFound(err):
-		return r.handleDeletedResource(clusterWideKey, isClusterScoped)
+		result, err = r.handleDeletedResource(clusterWideKey, isClusterScoped)
 	case err != nil:
 		klog.ErrorS(err, "Failed to get unstructured object", "obj", clusterWideKey)
 		return ctrl.Result{}, err
+	default:
+		result, err = r.handleUpdatedResource(clusterWideKey, clusterObj, isClusterScoped)
 	}
-	return r.handleUpdatedResource(clusterWideKey, clusterObj, isClusterScoped)
+	if err != nil || clusterWideKey.GroupVersionKind() != utils.SecretGVK {
+		return result, err
+	}
+	// The manifests of a resource envelope might be kept in secrets; a change in such a secret is a
+	// change in the envelope as well.
+	return result, r.handleEnvelopesReferencingSecret(clusterWideKey)
+}
+
+// handleEnvelopesReferencingSecret handles the resource envelopes that reference the given secret in their
+// secretRefs as updated resources, so that the placements selecting them take new resource snapshots.
+func (r *Reconciler) handleEnvelopesReferencingSecret(key keys.ClusterWideKey) error {
+	if !r.InformerManager.IsInformerSynced(utils.ResourceEnvelopeGVR) {
+		return fmt.Errorf("informer cache for %+v is not synced yet", utils.ResourceEnvelopeGVR)
+	}
+	envelopeObjs, err := r.InformerManager.Lister(utils.ResourceEnvelopeGVR).ByNamespace(key.Namespace).List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Failed to list the resource envelopes in namespace", "obj", key)
+		return fmt.Errorf("failed to list the resource envelopes in namespace %s: %w", key.Namespace, err)
+	}
+	for _, obj := range envelopeObjs {
+		uObj := obj.(*unstructured.Unstructured)
+		var envelope placementv1beta1.ResourceEnvelope
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(uObj.Object, &envelope); err != nil {
+			klog.ErrorS(err, "Failed to convert the resource envelope", "envelope", klog.KObj(uObj))
+			continue
+		}
+		if !envelopeReferencesSecret(&envelope, key.Name) {
+			continue
+		}
+		envelopeKey := keys.ClusterWideKey{
+			ResourceIdentifier: placementv1beta1.ResourceIdentifier{
+				Group:     uObj.GroupVersionKind().Group,
+				Version:   uObj.GroupVersionKind().Version,
+				Kind:      uObj.GroupVersionKind().Kind,
+				Namespace: uObj.GetNamespace(),
+				Name:      uObj.GetName(),
+			},
+		}
+		klog.V(2).InfoS("Change in secret triggered the resource envelope that references it", "obj", key, "envelope", envelopeKey)
+		if _, err := r.handleUpdatedResource(envelopeKey, uObj, false); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// envelopeReferencesSecret returns true if the given resource envelope keeps any of its manifests in the secret.
+func envelopeReferencesSecret(envelope *placementv1beta1.ResourceEnvelope, secretName string) bool {
+	for _, ref := range envelope.SecretRefs {
+		if ref.Name == secretName {
+			return true
+		}
+	}
+	return false
 }
 
 // handleDeletedResource handles the deleted resource and triggers the affected placements.
@@ -309,6 +365,27 @@ func isSelectNamespaceOnly(selector placementv1beta1.ResourceSelectorTerm) bool
 	return selector.Group == "" && selector.Version == "v1" && selector.Kind == "Namespace" && selector.SelectionScope == placementv1beta1.NamespaceOnly
 }
 
+// isNamespaceSelectedWithDependents returns true if the placement includes the dependents of the resources it
+// selects and has selected the given namespace.
+func isNamespaceSelectedWithDependents(namespace string, placement placementv1beta1.PlacementObj) bool {
+	includeDependents := false
+	for _, selector := range placement.GetPlacementSpec().ResourceSelectors {
+		if selector.IncludeDependents {
+			includeDependents = true
+			break
+		}
+	}
+	if !includeDependents {
+		return false
+	}
+	for _, selectedRes := range placement.GetPlacementStatus().SelectedResources {
+		if selectedRes.Group == "" && selectedRes.Version == "v1" && selectedRes.Kind == "Namespace" && selectedRes.Name == namespace {
+			return true
+		}
+	}
+	return false
+}
+
 // collectAllAffectedPlacementsV1Beta1 goes through all v1beta1 placements and collect the ones whose resource selector matches the object given its gvk.
 // If the key is namespace scoped, res will be the namespace object for the clusterResourcePlacement.
 func collectAllAffectedPlacementsV1Beta1(key keys.ClusterWideKey, res *unstructured.Unstructured, placementList []placementv1beta1.PlacementObj) map[string]bool {
@@ -329,6 +406,12 @@ func collectAllAffectedPlacementsV1Beta1(key keys.ClusterWideKey, res *unstructu
 		if match {
 			continue
 		}
+		// A new namespace-scoped resource might be created in a dependent namespace of a resource that the
+		// clusterResourcePlacement selects with its dependents included.
+		if key.Namespace != "" && placement.GetNamespace() == "" && isNamespaceSelectedWithDependents(key.Namespace, placement) {
+			placements[placement.GetName()] = true
+			continue
+		}
 		// check if object match any placement's resource selectors
 		// For the resource placement, we do not compare the namespace in the selector.
 		// We assume the namespace is the same as the resource placement's namespace and webhook/CEL
@@ -31,6 +31,7 @@ import (
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/utils/ptr"
 	ctrl "sigs.k8s.io/controller-runtime"
 
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
@@ -799,6 +800,62 @@ func TestCollectAllAffectedPlacementsV1Beta1_ClusterResourcePlacement(t *testing
 			},
 			wantCRP: make(map[string]bool),
 		},
+		"match a placement that selected the namespace as a dependent": {
+			key: keys.ClusterWideKey{
+				ResourceIdentifier: namespaceScopedResourceIdentifier,
+			},
+			res: matchRes,
+			crpList: []*placementv1beta1.ClusterResourcePlacement{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "resource-selected",
+					},
+					Spec: placementv1beta1.PlacementSpec{
+						ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{
+							{
+								Group:             "rbac.authorization.k8s.io",
+								Version:           "v1",
+								Kind:              "ClusterRole",
+								Name:              "operator-cluster-role",
+								IncludeDependents: true,
+							},
+						},
+					},
+					Status: placementv1beta1.PlacementStatus{
+						SelectedResources: []placementv1beta1.ResourceIdentifier{namespaceResourceIdentifier},
+					},
+				},
+			},
+			wantCRP: map[string]bool{"resource-selected": true},
+		},
+		"Skip a placement that selected the namespace without dependents included": {
+			key: keys.ClusterWideKey{
+				ResourceIdentifier: namespaceScopedResourceIdentifier,
+			},
+			res: matchRes,
+			crpList: []*placementv1beta1.ClusterResourcePlacement{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "resource-selected",
+					},
+					Spec: placementv1beta1.PlacementSpec{
+						ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{
+							{
+								Group:          corev1.GroupName,
+								Version:        "v1",
+								Kind:           matchRes.Kind,
+								Name:           matchRes.Name,
+								SelectionScope: placementv1beta1.NamespaceOnly,
+							},
+						},
+					},
+					Status: placementv1beta1.PlacementStatus{
+						SelectedResources: []placementv1beta1.ResourceIdentifier{namespaceResourceIdentifier},
+					},
+				},
+			},
+			wantCRP: make(map[string]bool),
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
@@ -2588,3 +2645,111 @@ func TestIsSelectNamespaceOnly(t *testing.T) {
 		})
 	}
 }
+
+func TestHandleEnvelopesReferencingSecret(t *testing.T) {
+	testNamespace := createNamespaceUnstructured(createTestNamespace())
+	newEnvelope := func(name, secretName string) runtime.Object {
+		envelope := &placementv1beta1.ResourceEnvelope{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: placementv1beta1.GroupVersion.String(),
+				Kind:       placementv1beta1.ResourceEnvelopeKind,
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+			},
+			SecretRefs: map[string]placementv1beta1.EnvelopeSecretReference{
+				"manifest.yaml": {Name: secretName, Key: "manifest.yaml"},
+			},
+		}
+		uMap, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(envelope)
+		return &unstructured.Unstructured{Object: uMap}
+	}
+	newRP := func(name, envelopeName string) runtime.Object {
+		rp := &placementv1beta1.ResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "test-namespace",
+			},
+			Spec: placementv1beta1.PlacementSpec{
+				ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{
+					{
+						Group:   placementv1beta1.GroupVersion.Group,
+						Version: placementv1beta1.GroupVersion.Version,
+						Kind:    placementv1beta1.ResourceEnvelopeKind,
+						Name:    envelopeName,
+					},
+				},
+			},
+		}
+		uMap, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(rp)
+		return &unstructured.Unstructured{Object: uMap}
+	}
+	secretKey := keys.ClusterWideKey{
+		ResourceIdentifier: placementv1beta1.ResourceIdentifier{
+			Version:   "v1",
+			Kind:      "Secret",
+			Name:      "test-secret",
+			Namespace: "test-namespace",
+		},
+	}
+
+	tests := map[string]struct {
+		envelopes      []runtime.Object
+		notSynced      bool
+		wantRPEnqueued []string
+		wantError      bool
+	}{
+		"envelope referencing the secret triggers its placement": {
+			envelopes:      []runtime.Object{newEnvelope("env-1", "test-secret"), newEnvelope("env-2", "other-secret")},
+			wantRPEnqueued: []string{"test-namespace/rp-1"},
+		},
+		"no envelope references the secret": {
+			envelopes:      []runtime.Object{newEnvelope("env-2", "other-secret")},
+			wantRPEnqueued: []string{},
+		},
+		"envelope informer is not synced": {
+			notSynced:      true,
+			wantRPEnqueued: []string{},
+			wantError:      true,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fakeInformerManager := &testinformer.FakeManager{
+				Listers: map[schema.GroupVersionResource]*testinformer.FakeLister{
+					utils.ResourceEnvelopeGVR: {Objects: tt.envelopes},
+					{Group: "placement.kubernetes-fleet.io", Version: "v1beta1", Resource: "clusterresourceplacements"}: {
+						Objects: []runtime.Object{},
+					},
+					{Group: "placement.kubernetes-fleet.io", Version: "v1beta1", Resource: "resourceplacements"}: {
+						Objects: []runtime.Object{newRP("rp-1", "env-1"), newRP("rp-2", "env-2")},
+					},
+					{Group: "", Version: "v1", Resource: "namespaces"}: {
+						Objects: []runtime.Object{testNamespace},
+					},
+				},
+			}
+			if tt.notSynced {
+				fakeInformerManager.InformerSynced = ptr.To(false)
+			}
+			reconciler := &Reconciler{
+				InformerManager:             fakeInformerManager,
+				PlacementControllerV1Beta1:  &fakeController{QueueObj: []string{}},
+				ResourcePlacementController: &fakeController{QueueObj: []string{}},
+			}
+
+			err := reconciler.handleEnvelopesReferencingSecret(secretKey)
+			if gotErr := err != nil; gotErr != tt.wantError {
+				t.Fatalf("handleEnvelopesReferencingSecret() error = %v, wantError = %v", err, tt.wantError)
+			}
+
+			gotRP := reconciler.ResourcePlacementController.(*fakeController).QueueObj
+			if !cmp.Equal(gotRP, tt.wantRPEnqueued, sortSlicesOption) {
+				t.Errorf("handleEnvelopesReferencingSecret enqueues keys to ResourcePlacementController, got %v, want %v",
+					gotRP, tt.wantRPEnqueued)
+			}
+		})
+	}
+}
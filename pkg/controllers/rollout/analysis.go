@@ -0,0 +1,351 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/annotations"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/labels"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/overrider"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/prometheus"
+)
+
+const (
+	// defaultAnalysisDurationSeconds is the duration of a rollout analysis if none is specified.
+	defaultAnalysisDurationSeconds = 300
+	// defaultAnalysisIntervalSeconds is the interval between two metric queries if none is specified.
+	defaultAnalysisIntervalSeconds = 60
+
+	// analysisClusterPlaceholder is the placeholder in an analysis query which is replaced with the
+	// name of the analyzed cluster.
+	analysisClusterPlaceholder = "{{cluster}}"
+
+	// eventReasonRolloutAnalysisFailed is the reason of the event emitted on the placement when the
+	// rollout analysis fails on a cluster.
+	eventReasonRolloutAnalysisFailed = "RolloutAnalysisFailed"
+)
+
+// findAnalysisFailedBinding returns a binding on which the rollout analysis of the given resource
+// snapshot has failed, or nil if there is none.
+func findAnalysisFailedBinding(bindings []placementv1beta1.BindingObj, resourceSnapshotName string) placementv1beta1.BindingObj {
+	for _, binding := range bindings {
+		if binding.GetAnnotations()[placementv1beta1.AnalysisFailedResourceSnapshotAnnotation] == resourceSnapshotName {
+			return binding
+		}
+	}
+	return nil
+}
+
+// holdBindingsOnFailedAnalysis returns the bindings to update, minus those that would be updated to the
+// resources of the given resource snapshot, on which the rollout analysis has failed; this includes the
+// bindings on newly selected clusters and the bindings that have been rolled back.
+func holdBindingsOnFailedAnalysis(toBeUpdatedBindings []toBeUpdatedBinding, masterResourceSnapshot placementv1beta1.ResourceSnapshotObj) []toBeUpdatedBinding {
+	remaining := make([]toBeUpdatedBinding, 0, len(toBeUpdatedBindings))
+	for _, binding := range toBeUpdatedBindings {
+		if binding.desiredBinding != nil &&
+			binding.desiredBinding.GetBindingSpec().ResourceSnapshotName == masterResourceSnapshot.GetName() &&
+			(binding.currentBinding.GetBindingSpec().ResourceSnapshotName != masterResourceSnapshot.GetName() ||
+				binding.currentBinding.GetBindingSpec().State == placementv1beta1.BindingStateScheduled) {
+			klog.V(2).InfoS("Held back a binding as the rollout analysis of the resources has failed",
+				"binding", klog.KObj(binding.currentBinding), "masterResourceSnapshot", klog.KObj(masterResourceSnapshot))
+			continue
+		}
+		remaining = append(remaining, binding)
+	}
+	return remaining
+}
+
+// isBindingAnalysisPending returns true if the binding runs the latest resources but the rollout
+// analysis has not succeeded on it yet; such a binding is not considered ready by the rollout.
+func isBindingAnalysisPending(analysis *placementv1beta1.RolloutAnalysis, binding placementv1beta1.BindingObj, masterResourceSnapshot placementv1beta1.ResourceSnapshotObj) bool {
+	if analysis == nil || binding.GetBindingSpec().ResourceSnapshotName != masterResourceSnapshot.GetName() {
+		return false
+	}
+	return !condition.IsConditionStatusTrue(binding.GetCondition(string(placementv1beta1.ResourceBindingAnalysisSucceeded)), binding.GetGeneration())
+}
+
+// analyzeBindings runs the rollout analysis on all the bound bindings which have the latest resources
+// ready on their target clusters.
+//
+// It returns the time to wait before the analysis in progress should be run again (0 if there is none),
+// and a binding on which the analysis has failed (nil if there is none).
+func (r *Reconciler) analyzeBindings(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	allBindings []placementv1beta1.BindingObj,
+	masterResourceSnapshot placementv1beta1.ResourceSnapshotObj,
+) (time.Duration, placementv1beta1.BindingObj, error) {
+	placementSpec := placementObj.GetPlacementSpec()
+	analysis := placementSpec.Strategy.Analysis
+	readyTimeCutOff := time.Now().Add(-time.Duration(*placementSpec.Strategy.RollingUpdate.UnavailablePeriodSeconds) * time.Second)
+
+	var minWaitTime time.Duration
+	for _, binding := range allBindings {
+		bindingSpec := binding.GetBindingSpec()
+		if bindingSpec.State != placementv1beta1.BindingStateBound || !binding.GetDeletionTimestamp().IsZero() ||
+			bindingSpec.ResourceSnapshotName != masterResourceSnapshot.GetName() {
+			continue
+		}
+		if _, ready := isBindingReady(binding, readyTimeCutOff); !ready {
+			// The analysis starts once the resources become ready on the cluster.
+			continue
+		}
+		cond := binding.GetCondition(string(placementv1beta1.ResourceBindingAnalysisSucceeded))
+		if condition.IsConditionStatusTrue(cond, binding.GetGeneration()) || condition.IsConditionStatusFalse(cond, binding.GetGeneration()) {
+			// The analysis has completed.
+			continue
+		}
+		waitTime, failed, err := r.analyzeBinding(ctx, analysis, binding)
+		if err != nil {
+			return 0, nil, err
+		}
+		if failed {
+			return 0, binding, nil
+		}
+		if waitTime > 0 && (minWaitTime == 0 || waitTime < minWaitTime) {
+			minWaitTime = waitTime
+		}
+	}
+	return minWaitTime, nil, nil
+}
+
+// analyzeBinding queries the analysis metric for the target cluster of a binding, and updates the
+// AnalysisSucceeded condition of the binding accordingly.
+//
+// It returns the time to wait before the metric should be queried again (0 if the analysis has
+// completed), and whether the analysis has failed.
+func (r *Reconciler) analyzeBinding(ctx context.Context, analysis *placementv1beta1.RolloutAnalysis, binding placementv1beta1.BindingObj) (time.Duration, bool, error) {
+	bindingKObj := klog.KObj(binding)
+	durationSeconds := int32(defaultAnalysisDurationSeconds)
+	if analysis.DurationSeconds != nil {
+		durationSeconds = *analysis.DurationSeconds
+	}
+	intervalSeconds := int32(defaultAnalysisIntervalSeconds)
+	if analysis.IntervalSeconds != nil {
+		intervalSeconds = *analysis.IntervalSeconds
+	}
+	interval := time.Duration(intervalSeconds) * time.Second
+
+	// The analysis starts when the in-progress condition is set for the current generation of the binding.
+	startTime := time.Now()
+	cond := binding.GetCondition(string(placementv1beta1.ResourceBindingAnalysisSucceeded))
+	started := cond != nil && cond.ObservedGeneration == binding.GetGeneration()
+	if started {
+		startTime = cond.LastTransitionTime.Time
+	}
+
+	cluster := binding.GetBindingSpec().TargetCluster
+	query := strings.ReplaceAll(analysis.Query, analysisClusterPlaceholder, cluster)
+	value, err := r.MetricQuerier.Query(ctx, analysis.PrometheusAddress, query)
+	if errors.Is(err, prometheus.ErrAddressNotAllowed) {
+		// The analysis cannot run until the placement (or the hub agent configuration) changes; do not retry.
+		klog.V(2).InfoS("The Prometheus address of the rollout analysis is not allowed", "binding", bindingKObj, "address", analysis.PrometheusAddress)
+		message := fmt.Sprintf("The rollout analysis cannot run: %v", err)
+		if started && cond.Message == message {
+			return 0, false, nil
+		}
+		return 0, false, r.setAnalysisCondition(ctx, binding, metav1.ConditionUnknown, condition.RolloutAnalysisInProgressReason, message)
+	}
+	if err != nil {
+		// Treat query errors as inconclusive and retry later, as they are often transient.
+		klog.ErrorS(err, "Failed to query the rollout analysis metric", "binding", bindingKObj, "cluster", cluster)
+		if !started {
+			return interval, false, r.setAnalysisCondition(ctx, binding, metav1.ConditionUnknown, condition.RolloutAnalysisInProgressReason,
+				fmt.Sprintf("Started the rollout analysis; failed to query the metric: %v", err))
+		}
+		return interval, false, nil
+	}
+	met, err := meetsThreshold(value, analysis.Operator, analysis.Threshold)
+	if err != nil {
+		// This should never happen as the threshold is validated.
+		klog.ErrorS(controller.NewUnexpectedBehaviorError(err), "Found an invalid rollout analysis", "binding", bindingKObj)
+		return 0, false, nil
+	}
+	klog.V(2).InfoS("Queried the rollout analysis metric", "binding", bindingKObj, "cluster", cluster, "value", value, "meetsThreshold", met)
+
+	switch {
+	case !met:
+		return 0, true, r.setAnalysisCondition(ctx, binding, metav1.ConditionFalse, condition.RolloutAnalysisFailedReason,
+			fmt.Sprintf("The metric value %v does not meet the threshold (%s %s)", value, analysis.Operator, analysis.Threshold))
+	case time.Since(startTime) >= time.Duration(durationSeconds)*time.Second:
+		return 0, false, r.setAnalysisCondition(ctx, binding, metav1.ConditionTrue, condition.RolloutAnalysisSucceededReason,
+			fmt.Sprintf("The metric has met the threshold (%s %s) for %d seconds", analysis.Operator, analysis.Threshold, durationSeconds))
+	}
+
+	waitTime := time.Until(startTime.Add(time.Duration(durationSeconds) * time.Second))
+	if waitTime > interval {
+		waitTime = interval
+	}
+	if !started {
+		return waitTime, false, r.setAnalysisCondition(ctx, binding, metav1.ConditionUnknown, condition.RolloutAnalysisInProgressReason,
+			fmt.Sprintf("Started the rollout analysis, which lasts %d seconds", durationSeconds))
+	}
+	return waitTime, false, nil
+}
+
+// setAnalysisCondition sets the AnalysisSucceeded condition of a binding.
+func (r *Reconciler) setAnalysisCondition(ctx context.Context, binding placementv1beta1.BindingObj, status metav1.ConditionStatus, reason, message string) error {
+	cond := binding.GetCondition(string(placementv1beta1.ResourceBindingAnalysisSucceeded))
+	if cond != nil && cond.ObservedGeneration != binding.GetGeneration() {
+		// Remove the condition of an older generation, so that the transition time reflects when the
+		// analysis of the current generation starts.
+		binding.RemoveCondition(string(placementv1beta1.ResourceBindingAnalysisSucceeded))
+	}
+	binding.SetConditions(metav1.Condition{
+		Type:               string(placementv1beta1.ResourceBindingAnalysisSucceeded),
+		Status:             status,
+		ObservedGeneration: binding.GetGeneration(),
+		Reason:             reason,
+		Message:            message,
+	})
+	if err := r.Client.Status().Update(ctx, binding); err != nil {
+		klog.ErrorS(err, "Failed to update the rollout analysis condition of a binding", "binding", klog.KObj(binding))
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	return nil
+}
+
+// handleAnalysisFailure halts the rollout of the latest resources after the rollout analysis has failed
+// on a binding, and rolls the binding back to the previous resources if the failure policy asks so.
+//
+// A rollback restores the whole binding spec for the previous resources, i.e., the resource snapshot
+// along with the override snapshots (and the cluster property snapshot) that apply to its resources.
+func (r *Reconciler) handleAnalysisFailure(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	failedBinding placementv1beta1.BindingObj,
+	masterResourceSnapshot placementv1beta1.ResourceSnapshotObj,
+) error {
+	placementKObj := klog.KObj(placementObj)
+	bindingKObj := klog.KObj(failedBinding)
+	analysis := placementObj.GetPlacementSpec().Strategy.Analysis
+	cluster := failedBinding.GetBindingSpec().TargetCluster
+
+	bindingAnnotations := failedBinding.GetAnnotations()
+	if bindingAnnotations == nil {
+		bindingAnnotations = map[string]string{}
+	}
+	bindingAnnotations[placementv1beta1.AnalysisFailedResourceSnapshotAnnotation] = masterResourceSnapshot.GetName()
+	failedBinding.SetAnnotations(bindingAnnotations)
+
+	message := fmt.Sprintf("The rollout analysis of resource snapshot %s has failed on cluster %s; halted the rollout", masterResourceSnapshot.GetName(), cluster)
+	if analysis.FailurePolicy == placementv1beta1.AnalysisFailurePolicyRollback {
+		previous, err := r.fetchPreviousMasterResourceSnapshot(ctx, placementObj, masterResourceSnapshot)
+		if err != nil {
+			return err
+		}
+		if previous != nil {
+			placementKey := string(controller.GetObjectKeyFromObj(placementObj))
+			matchedCRO, matchedRO, err := overrider.FetchAllMatchingOverridesForResourceSnapshot(ctx, r.Client, r.InformerManager, placementKey, previous)
+			if err != nil {
+				klog.ErrorS(err, "Failed to find all matching overrides for the previous resources", "placement", placementKObj, "resourceSnapshot", klog.KObj(previous))
+				return err
+			}
+			cro, ro, propertySnapshot, err := overrider.PickFromResourceMatchedOverridesForTargetCluster(ctx, r.Client, cluster, matchedCRO, matchedRO)
+			if err != nil {
+				return err
+			}
+			bindingSpec := failedBinding.GetBindingSpec()
+			bindingSpec.ResourceSnapshotName = previous.GetName()
+			bindingSpec.ClusterResourceOverrideSnapshots = cro
+			bindingSpec.ResourceOverrideSnapshots = ro
+			bindingSpec.ClusterPropertySnapshot = propertySnapshot
+			message = fmt.Sprintf("The rollout analysis of resource snapshot %s has failed on cluster %s; halted the rollout and rolled the cluster back to resource snapshot %s",
+				masterResourceSnapshot.GetName(), cluster, previous.GetName())
+		} else {
+			klog.V(2).InfoS("No previous resource snapshot to roll back to", "placement", placementKObj, "binding", bindingKObj)
+		}
+	}
+
+	if err := r.Client.Update(ctx, failedBinding); err != nil {
+		klog.ErrorS(err, "Failed to halt the rollout on a binding", "placement", placementKObj, "binding", bindingKObj)
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS(message, "placement", placementKObj, "binding", bindingKObj)
+	r.recorder.Event(placementObj, corev1.EventTypeWarning, eventReasonRolloutAnalysisFailed, message)
+	return nil
+}
+
+// fetchPreviousMasterResourceSnapshot returns the master resource snapshot with the largest index
+// below the index of the given master resource snapshot, or nil if there is none.
+func (r *Reconciler) fetchPreviousMasterResourceSnapshot(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	masterResourceSnapshot placementv1beta1.ResourceSnapshotObj,
+) (placementv1beta1.ResourceSnapshotObj, error) {
+	latestIndex, err := labels.ExtractResourceIndexFromResourceSnapshot(masterResourceSnapshot)
+	if err != nil {
+		return nil, controller.NewUnexpectedBehaviorError(err)
+	}
+	placementKey := types.NamespacedName{Namespace: placementObj.GetNamespace(), Name: placementObj.GetName()}
+	snapshotList, err := controller.ListAllResourceSnapshots(ctx, r.Client, placementKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var previous placementv1beta1.ResourceSnapshotObj
+	previousIndex := -1
+	for _, snapshot := range snapshotList.GetResourceSnapshotObjs() {
+		if isSubindexed, _, err := annotations.ExtractSubindexFromResourceSnapshot(snapshot); err != nil || isSubindexed {
+			// Skip the non-master resource snapshots.
+			continue
+		}
+		index, err := labels.ExtractResourceIndexFromResourceSnapshot(snapshot)
+		if err != nil {
+			klog.ErrorS(err, "Found a resource snapshot with an invalid index", "resourceSnapshot", klog.KObj(snapshot))
+			continue
+		}
+		if index < latestIndex && index > previousIndex {
+			previous, previousIndex = snapshot, index
+		}
+	}
+	return previous, nil
+}
+
+// meetsThreshold returns true if the comparison `<value> <operator> <threshold>` holds.
+func meetsThreshold(value float64, operator placementv1beta1.AnalysisOperator, threshold string) (bool, error) {
+	t, err := strconv.ParseFloat(threshold, 64)
+	if err != nil {
+		return false, fmt.Errorf("invalid threshold %q: %w", threshold, err)
+	}
+	switch operator {
+	case placementv1beta1.AnalysisOperatorLessThan:
+		return value < t, nil
+	case placementv1beta1.AnalysisOperatorLessThanOrEqual:
+		return value <= t, nil
+	case placementv1beta1.AnalysisOperatorGreaterThan:
+		return value > t, nil
+	case placementv1beta1.AnalysisOperatorGreaterThanOrEqual:
+		return value >= t, nil
+	default:
+		return false, fmt.Errorf("unknown operator %q", operator)
+	}
+}
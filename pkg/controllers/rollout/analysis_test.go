@@ -0,0 +1,425 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/prometheus"
+)
+
+// fakeQuerier returns the same result for all queries, and records the queries it receives.
+type fakeQuerier struct {
+	value   float64
+	err     error
+	queries []string
+}
+
+func (f *fakeQuerier) Query(_ context.Context, _, query string) (float64, error) {
+	f.queries = append(f.queries, query)
+	return f.value, f.err
+}
+
+func rolloutAnalysisForTest(policy placementv1beta1.AnalysisFailurePolicy) *placementv1beta1.RolloutAnalysis {
+	return &placementv1beta1.RolloutAnalysis{
+		PrometheusAddress: "http://prometheus.monitoring:9090",
+		Query:             `error_rate{cluster="{{cluster}}"}`,
+		Operator:          placementv1beta1.AnalysisOperatorLessThan,
+		Threshold:         "0.01",
+		DurationSeconds:   ptr.To(int32(300)),
+		IntervalSeconds:   ptr.To(int32(60)),
+		FailurePolicy:     policy,
+	}
+}
+
+func masterClusterResourceSnapshotForTest(index int) *placementv1beta1.ClusterResourceSnapshot {
+	return &placementv1beta1.ClusterResourceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf(placementv1beta1.ResourceSnapshotNameFmt, testCRPName, index),
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: testCRPName,
+				placementv1beta1.ResourceIndexLabel:     strconv.Itoa(index),
+			},
+		},
+	}
+}
+
+func TestMeetsThreshold(t *testing.T) {
+	tests := []struct {
+		name     string
+		value    float64
+		operator placementv1beta1.AnalysisOperator
+		want     bool
+		wantErr  bool
+	}{
+		{
+			name:     "less than",
+			value:    0.5,
+			operator: placementv1beta1.AnalysisOperatorLessThan,
+			want:     true,
+		},
+		{
+			name:     "not less than",
+			value:    1,
+			operator: placementv1beta1.AnalysisOperatorLessThan,
+			want:     false,
+		},
+		{
+			name:     "less than or equal",
+			value:    1,
+			operator: placementv1beta1.AnalysisOperatorLessThanOrEqual,
+			want:     true,
+		},
+		{
+			name:     "greater than",
+			value:    1.5,
+			operator: placementv1beta1.AnalysisOperatorGreaterThan,
+			want:     true,
+		},
+		{
+			name:     "not greater than or equal",
+			value:    0.5,
+			operator: placementv1beta1.AnalysisOperatorGreaterThanOrEqual,
+			want:     false,
+		},
+		{
+			name:     "unknown operator",
+			value:    1,
+			operator: "Equal",
+			wantErr:  true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := meetsThreshold(tc.value, tc.operator, "1")
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("meetsThreshold() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("meetsThreshold() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestIsBindingAnalysisPending(t *testing.T) {
+	masterSnapshot := masterClusterResourceSnapshotForTest(1)
+	succeededBinding := generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, masterSnapshot.Name, cluster1)
+	succeededBinding.Status.Conditions = append(succeededBinding.Status.Conditions, metav1.Condition{
+		Type:   string(placementv1beta1.ResourceBindingAnalysisSucceeded),
+		Status: metav1.ConditionTrue,
+	})
+	tests := []struct {
+		name     string
+		analysis *placementv1beta1.RolloutAnalysis
+		binding  *placementv1beta1.ClusterResourceBinding
+		want     bool
+	}{
+		{
+			name:    "no analysis",
+			binding: generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, masterSnapshot.Name, cluster1),
+			want:    false,
+		},
+		{
+			name:     "binding with older resources",
+			analysis: rolloutAnalysisForTest(placementv1beta1.AnalysisFailurePolicyHalt),
+			binding:  generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, "snapshot-0", cluster1),
+			want:     false,
+		},
+		{
+			name:     "analysis not completed",
+			analysis: rolloutAnalysisForTest(placementv1beta1.AnalysisFailurePolicyHalt),
+			binding:  generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, masterSnapshot.Name, cluster1),
+			want:     true,
+		},
+		{
+			name:     "analysis succeeded",
+			analysis: rolloutAnalysisForTest(placementv1beta1.AnalysisFailurePolicyHalt),
+			binding:  succeededBinding,
+			want:     false,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isBindingAnalysisPending(tc.analysis, tc.binding, masterSnapshot); got != tc.want {
+				t.Errorf("isBindingAnalysisPending() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestAnalyzeBindings(t *testing.T) {
+	masterSnapshot := masterClusterResourceSnapshotForTest(1)
+	analysisInProgressFor := func(elapsed time.Duration) *placementv1beta1.ClusterResourceBinding {
+		binding := generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, masterSnapshot.Name, cluster1)
+		binding.Status.Conditions = append(binding.Status.Conditions, metav1.Condition{
+			Type:               string(placementv1beta1.ResourceBindingAnalysisSucceeded),
+			Status:             metav1.ConditionUnknown,
+			Reason:             condition.RolloutAnalysisInProgressReason,
+			LastTransitionTime: metav1.NewTime(time.Now().Add(-elapsed)),
+		})
+		return binding
+	}
+
+	tests := []struct {
+		name           string
+		binding        *placementv1beta1.ClusterResourceBinding
+		value          float64
+		queryErr       error
+		wantWaitTime   bool
+		wantFailed     bool
+		wantQueried    bool
+		wantCondStatus *metav1.ConditionStatus
+	}{
+		{
+			name:    "resources not ready yet",
+			binding: generateCanBeReadyClusterResourceBinding(placementv1beta1.BindingStateBound, masterSnapshot.Name, cluster1),
+		},
+		{
+			name:    "binding with older resources",
+			binding: generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, "snapshot-0", cluster1),
+		},
+		{
+			name:           "analysis started",
+			binding:        generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, masterSnapshot.Name, cluster1),
+			value:          0,
+			wantWaitTime:   true,
+			wantQueried:    true,
+			wantCondStatus: ptr.To(metav1.ConditionUnknown),
+		},
+		{
+			name:           "analysis in progress",
+			binding:        analysisInProgressFor(time.Minute),
+			value:          0,
+			wantWaitTime:   true,
+			wantQueried:    true,
+			wantCondStatus: ptr.To(metav1.ConditionUnknown),
+		},
+		{
+			name:           "analysis succeeded",
+			binding:        analysisInProgressFor(10 * time.Minute),
+			value:          0,
+			wantQueried:    true,
+			wantCondStatus: ptr.To(metav1.ConditionTrue),
+		},
+		{
+			name:           "analysis failed",
+			binding:        analysisInProgressFor(time.Minute),
+			value:          0.5,
+			wantFailed:     true,
+			wantQueried:    true,
+			wantCondStatus: ptr.To(metav1.ConditionFalse),
+		},
+		{
+			name:           "query error",
+			binding:        analysisInProgressFor(10 * time.Minute),
+			queryErr:       errors.New("connection refused"),
+			wantWaitTime:   true,
+			wantQueried:    true,
+			wantCondStatus: ptr.To(metav1.ConditionUnknown),
+		},
+		{
+			name:           "prometheus address not allowed",
+			binding:        analysisInProgressFor(10 * time.Minute),
+			queryErr:       fmt.Errorf("%w: %q", prometheus.ErrAddressNotAllowed, "http://prometheus.monitoring:9090"),
+			wantQueried:    true,
+			wantCondStatus: ptr.To(metav1.ConditionUnknown),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := clusterResourcePlacementForTest(testCRPName, createPlacementPolicyForTest(placementv1beta1.PickAllPlacementType, 0),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, generateDefaultRollingUpdateConfig(), nil))
+			crp.Spec.Strategy.Analysis = rolloutAnalysisForTest(placementv1beta1.AnalysisFailurePolicyHalt)
+			objects := []client.Object{tc.binding}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(objects...).
+				WithStatusSubresource(objects...).
+				Build()
+			querier := &fakeQuerier{value: tc.value, err: tc.queryErr}
+			r := Reconciler{
+				Client:        fakeClient,
+				MetricQuerier: querier,
+			}
+
+			waitTime, failedBinding, err := r.analyzeBindings(context.Background(), crp, []placementv1beta1.BindingObj{tc.binding}, masterSnapshot)
+			if err != nil {
+				t.Fatalf("analyzeBindings() error = %v, want no error", err)
+			}
+			if gotWaitTime := waitTime > 0; gotWaitTime != tc.wantWaitTime {
+				t.Errorf("analyzeBindings() waitTime = %v, want wait time %v", waitTime, tc.wantWaitTime)
+			}
+			if gotFailed := failedBinding != nil; gotFailed != tc.wantFailed {
+				t.Errorf("analyzeBindings() failedBinding = %v, want failed %v", failedBinding, tc.wantFailed)
+			}
+			wantQueries := []string(nil)
+			if tc.wantQueried {
+				wantQueries = []string{fmt.Sprintf(`error_rate{cluster="%s"}`, cluster1)}
+			}
+			if diff := cmp.Diff(wantQueries, querier.queries); diff != "" {
+				t.Errorf("analyzeBindings() queries mismatch (-want +got):\n%s", diff)
+			}
+
+			var gotBinding placementv1beta1.ClusterResourceBinding
+			if err := fakeClient.Get(context.Background(), types.NamespacedName{Name: tc.binding.Name}, &gotBinding); err != nil {
+				t.Fatalf("failed to get the binding: %v", err)
+			}
+			var gotCondStatus *metav1.ConditionStatus
+			if cond := gotBinding.GetCondition(string(placementv1beta1.ResourceBindingAnalysisSucceeded)); cond != nil {
+				gotCondStatus = &cond.Status
+			}
+			if diff := cmp.Diff(tc.wantCondStatus, gotCondStatus); diff != "" {
+				t.Errorf("analyzeBindings() AnalysisSucceeded condition status mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHandleAnalysisFailure(t *testing.T) {
+	previousSnapshot := masterClusterResourceSnapshotForTest(1)
+	// A sub-indexed snapshot of the previous resources.
+	previousSubindexedSnapshot := masterClusterResourceSnapshotForTest(1)
+	previousSubindexedSnapshot.Name = fmt.Sprintf(placementv1beta1.ResourceSnapshotNameWithSubindexFmt, testCRPName, 1, 0)
+	previousSubindexedSnapshot.Annotations = map[string]string{
+		placementv1beta1.SubindexOfResourceSnapshotAnnotation: "0",
+	}
+	masterSnapshot := masterClusterResourceSnapshotForTest(2)
+
+	latestOverrides := []string{"cro-1-1"}
+
+	tests := []struct {
+		name                 string
+		policy               placementv1beta1.AnalysisFailurePolicy
+		snapshots            []client.Object
+		wantResourceSnapshot string
+		wantOverrides        []string
+	}{
+		{
+			name:                 "halt",
+			policy:               placementv1beta1.AnalysisFailurePolicyHalt,
+			snapshots:            []client.Object{previousSnapshot, previousSubindexedSnapshot, masterSnapshot},
+			wantResourceSnapshot: masterSnapshot.Name,
+			wantOverrides:        latestOverrides,
+		},
+		{
+			name:                 "rollback",
+			policy:               placementv1beta1.AnalysisFailurePolicyRollback,
+			snapshots:            []client.Object{masterClusterResourceSnapshotForTest(0), previousSnapshot, previousSubindexedSnapshot, masterSnapshot},
+			wantResourceSnapshot: previousSnapshot.Name,
+		},
+		{
+			name:                 "rollback without previous resources",
+			policy:               placementv1beta1.AnalysisFailurePolicyRollback,
+			snapshots:            []client.Object{masterSnapshot},
+			wantResourceSnapshot: masterSnapshot.Name,
+			wantOverrides:        latestOverrides,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := clusterResourcePlacementForTest(testCRPName, createPlacementPolicyForTest(placementv1beta1.PickAllPlacementType, 0),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, generateDefaultRollingUpdateConfig(), nil))
+			crp.Spec.Strategy.Analysis = rolloutAnalysisForTest(tc.policy)
+			binding := generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, masterSnapshot.Name, cluster1)
+			// The override snapshots that apply to the latest resources.
+			binding.Spec.ClusterResourceOverrideSnapshots = latestOverrides
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(append(tc.snapshots, binding)...).
+				Build()
+			fakeRecorder := record.NewFakeRecorder(1)
+			r := Reconciler{
+				Client:   fakeClient,
+				recorder: fakeRecorder,
+			}
+
+			if err := r.handleAnalysisFailure(context.Background(), crp, binding, masterSnapshot); err != nil {
+				t.Fatalf("handleAnalysisFailure() error = %v, want no error", err)
+			}
+
+			var gotBinding placementv1beta1.ClusterResourceBinding
+			if err := fakeClient.Get(context.Background(), types.NamespacedName{Name: binding.Name}, &gotBinding); err != nil {
+				t.Fatalf("failed to get the binding: %v", err)
+			}
+			if got := gotBinding.Annotations[placementv1beta1.AnalysisFailedResourceSnapshotAnnotation]; got != masterSnapshot.Name {
+				t.Errorf("handleAnalysisFailure() binding annotation = %q, want %q", got, masterSnapshot.Name)
+			}
+			if got := gotBinding.Spec.ResourceSnapshotName; got != tc.wantResourceSnapshot {
+				t.Errorf("handleAnalysisFailure() binding resource snapshot = %q, want %q", got, tc.wantResourceSnapshot)
+			}
+			if diff := cmp.Diff(tc.wantOverrides, gotBinding.Spec.ClusterResourceOverrideSnapshots, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("handleAnalysisFailure() binding override snapshots mismatch (-want +got):\n%s", diff)
+			}
+			if got := findAnalysisFailedBinding([]placementv1beta1.BindingObj{&gotBinding}, masterSnapshot.Name); got == nil {
+				t.Errorf("findAnalysisFailedBinding() = nil, want the failed binding")
+			}
+			if len(fakeRecorder.Events) != 1 {
+				t.Errorf("handleAnalysisFailure() emitted %d events, want 1", len(fakeRecorder.Events))
+			}
+		})
+	}
+}
+
+func TestHoldBindingsOnFailedAnalysis(t *testing.T) {
+	masterSnapshot := masterClusterResourceSnapshotForTest(2)
+	previousSnapshotName := masterClusterResourceSnapshotForTest(1).Name
+	updateOf := func(state placementv1beta1.BindingState, current, desired, cluster string) toBeUpdatedBinding {
+		binding := generateClusterResourceBinding(state, current, cluster)
+		info := toBeUpdatedBinding{currentBinding: binding}
+		if desired != "" {
+			info = createUpdateInfo(binding, masterClusterResourceSnapshotForTest(2), []string{"cro-1-1"}, nil, nil)
+			info.desiredBinding.GetBindingSpec().ResourceSnapshotName = desired
+		}
+		return info
+	}
+
+	toBeUpdated := []toBeUpdatedBinding{
+		// A binding that is rolled back to the previous resources.
+		updateOf(placementv1beta1.BindingStateBound, previousSnapshotName, masterSnapshot.Name, cluster1),
+		// A binding on a newly selected cluster.
+		updateOf(placementv1beta1.BindingStateScheduled, "", masterSnapshot.Name, cluster2),
+		// A binding that runs the latest resources but has its overrides changed.
+		updateOf(placementv1beta1.BindingStateBound, masterSnapshot.Name, masterSnapshot.Name, cluster3),
+		// A binding on a cluster that is no longer selected.
+		updateOf(placementv1beta1.BindingStateUnscheduled, masterSnapshot.Name, "", cluster4),
+	}
+	got := holdBindingsOnFailedAnalysis(toBeUpdated, masterSnapshot)
+	var gotClusters []string
+	for _, binding := range got {
+		gotClusters = append(gotClusters, binding.currentBinding.GetBindingSpec().TargetCluster)
+	}
+	if diff := cmp.Diff([]string{cluster3, cluster4}, gotClusters); diff != "" {
+		t.Errorf("holdBindingsOnFailedAnalysis() mismatch (-want +got):\n%s", diff)
+	}
+}
@@ -44,8 +44,10 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/events"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/informer"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/overrider"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/prometheus"
 )
 
 // Reconciler recomputes the cluster resource binding.
@@ -58,6 +60,14 @@ type Reconciler struct {
 	// the informer contains the cache for all the resources we need.
 	// to check the resource scope
 	InformerManager informer.Manager
+	// MetricQuerier queries the metrics of the rollout analysis.
+	MetricQuerier prometheus.Querier
+	// WorkCleanupGracePeriod is the period to wait after a binding becomes unscheduled before deleting it;
+	// the binding reports a PreDeletion condition in the meantime. Zero means no wait.
+	WorkCleanupGracePeriod time.Duration
+	// EnableClusterRolloutPolicies specifies whether to limit the concurrent rollouts of placements on
+	// member clusters according to the ClusterRolloutPolicy objects.
+	EnableClusterRolloutPolicies bool
 }
 
 // Reconcile triggers a single binding reconcile round.
@@ -144,10 +154,22 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 		return runtime.Result{RequeueAfter: 5 * time.Second}, nil
 	}
 
+	// the rollout of a paused placement is resumed once the annotation is removed.
+	if isRolloutPaused(placementObj) {
+		klog.V(2).InfoS("The rollout of the placement is paused, stop rolling", "placement", placementObjRef)
+		return runtime.Result{}, nil
+	}
+
 	// find the master resourceSnapshot.
 	// Use the cached client so that rollout controller and work-generator have the same view of the
 	// resourceSnapshots in order to reduce the possibility of missing resourceSnapshots in work-generator.
-	masterResourceSnapshot, err := controller.FetchLatestMasterResourceSnapshot(ctx, r.Client, placementKey)
+	var masterResourceSnapshot placementv1beta1.ResourceSnapshotObj
+	if pinnedIndex, pinned := placementObj.GetAnnotations()[placementv1beta1.PinnedResourceSnapshotIndexAnnotation]; pinned {
+		// the placement is pinned to (i.e., rolled back to) an earlier resource snapshot.
+		masterResourceSnapshot, err = controller.FetchMasterResourceSnapshotWithAnIndex(ctx, r.Client, pinnedIndex, placementKey)
+	} else {
+		masterResourceSnapshot, err = controller.FetchLatestMasterResourceSnapshot(ctx, r.Client, placementKey)
+	}
 	if err != nil {
 		klog.ErrorS(err, "Failed to find the masterResourceSnapshot for the placement",
 			"placement", placementObjRef)
@@ -155,7 +177,7 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 	}
 	if masterResourceSnapshot == nil {
 		klog.V(2).InfoS("No masterResourceSnapshot found for the placement, stop rolling", "placement", placementObjRef)
-		// New masterResourceSnapshot creation should trigger the rollout controller.
+		// New masterResourceSnapshot creation (or a change to the pinned resource snapshot index) should trigger the rollout controller.
 		return runtime.Result{}, nil
 	}
 	klog.V(2).InfoS("Found the masterResourceSnapshot for the placement", "placement", placementObjRef, "masterResourceSnapshot", klog.KObj(masterResourceSnapshot))
@@ -170,6 +192,43 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 		return runtime.Result{}, err
 	}
 
+	// pause the rollout for safety if too many of the clusters updated to the latest resources have failed.
+	paused, err := r.checkErrorBudget(ctx, placementObj, allBindings, masterResourceSnapshot)
+	if err != nil {
+		klog.ErrorS(err, "Failed to check the error budget of the rollout", "placement", placementObjRef)
+		return runtime.Result{}, err
+	}
+	if paused {
+		// The rollout is resumed once the rollout-paused annotation is removed.
+		return runtime.Result{}, nil
+	}
+
+	// run the rollout analysis (if any) on the clusters that have the latest resources ready; the
+	// rollout of the latest resources is halted once the analysis fails on any of the clusters.
+	var analysisWaitTime time.Duration
+	analysisFailed := false
+	if placementSpec.Strategy.Analysis != nil {
+		if failedBinding := findAnalysisFailedBinding(allBindings, masterResourceSnapshot.GetName()); failedBinding != nil {
+			// Only the rollout of the latest resources is halted; the other changes, e.g., the removal of
+			// the resources from unselected clusters, still roll out. A new masterResourceSnapshot creation
+			// resumes the rollout.
+			klog.V(2).InfoS("The rollout analysis has failed on a binding, the rollout of the latest resources is halted", "placement", placementObjRef,
+				"binding", klog.KObj(failedBinding), "masterResourceSnapshot", klog.KObj(masterResourceSnapshot))
+			analysisFailed = true
+		}
+	}
+	if placementSpec.Strategy.Analysis != nil && !analysisFailed {
+		var failedBinding placementv1beta1.BindingObj
+		analysisWaitTime, failedBinding, err = r.analyzeBindings(ctx, placementObj, allBindings, masterResourceSnapshot)
+		if err != nil {
+			klog.ErrorS(err, "Failed to run the rollout analysis", "placement", placementObjRef)
+			return runtime.Result{}, err
+		}
+		if failedBinding != nil {
+			return runtime.Result{}, r.handleAnalysisFailure(ctx, placementObj, failedBinding, masterResourceSnapshot)
+		}
+	}
+
 	// pick the bindings to be updated according to the rollout plan
 	// staleBoundBindings is a list of "Bound" bindings and are not selected in this round because of the rollout strategy.
 	toBeUpdatedBindings, staleBoundBindings, upToDateBoundBindings, needRoll, waitTime, err := r.pickBindingsToRoll(ctx, allBindings, masterResourceSnapshot, placementObj, matchedCRO, matchedRO)
@@ -177,13 +236,26 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 		klog.ErrorS(err, "Failed to pick the bindings to roll", "placement", placementObjRef)
 		return runtime.Result{}, err
 	}
+	if analysisWaitTime > 0 && (waitTime <= 0 || analysisWaitTime < waitTime) {
+		waitTime = analysisWaitTime
+	}
+	if analysisFailed {
+		toBeUpdatedBindings = holdBindingsOnFailedAnalysis(toBeUpdatedBindings, masterResourceSnapshot)
+	}
+
+	// surge the new version of the resources to additional clusters if the placement asks for it.
+	if err := r.syncSurgeClusters(ctx, placementObj, allBindings, masterResourceSnapshot); err != nil {
+		klog.ErrorS(err, "Failed to sync the surge clusters", "placement", placementObjRef)
+		return runtime.Result{}, err
+	}
 
 	if !needRoll {
 		klog.V(2).InfoS("No bindings are out of date, stop rolling", "placement", placementObjRef)
 		// There is a corner case that rollout controller succeeds to update the binding spec to the latest one,
 		// but fails to update the binding conditions when it reconciled it last time.
 		// Here it will correct the binding status just in case this happens last time.
-		return runtime.Result{}, r.checkAndUpdateStaleBindingsStatus(ctx, allBindings)
+		// Requeue the request if the rollout analysis is still in progress on some clusters.
+		return runtime.Result{RequeueAfter: analysisWaitTime}, r.checkAndUpdateStaleBindingsStatus(ctx, allBindings)
 	}
 	klog.V(2).InfoS("Picked the bindings to be updated",
 		"placement", placementObjRef,
@@ -191,6 +263,37 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 		"numberOfStaleBindings", len(staleBoundBindings),
 		"numberOfUpToDateBindings", len(upToDateBoundBindings))
 
+	// Hold back the bindings on the clusters that already have as many rollouts of other placements in
+	// progress as their cluster rollout policies allow; they are retried after a while, as the rollouts of
+	// other placements do not trigger the reconciliation of this placement.
+	toBeUpdatedBindings, heldBindings, err := r.holdBindingsOnBusyClusters(ctx, placementObj, toBeUpdatedBindings)
+	if err != nil {
+		return runtime.Result{}, err
+	}
+	if len(heldBindings) > 0 {
+		if err := r.updateHeldBindingsStatus(ctx, heldBindings); err != nil {
+			return runtime.Result{}, err
+		}
+		if waitTime <= 0 || clusterRolloutPolicyRequeueDelay < waitTime {
+			waitTime = clusterRolloutPolicyRequeueDelay
+		}
+	}
+
+	// Hold back the bindings on the clusters where the placements that this placement depends on have
+	// not made their resources available yet; similarly, they are retried after a while.
+	toBeUpdatedBindings, heldBindings, err = r.holdBindingsOnUnavailableDependencies(ctx, placementObj, toBeUpdatedBindings)
+	if err != nil {
+		return runtime.Result{}, err
+	}
+	if len(heldBindings) > 0 {
+		if err := r.updateBindingsWaitingForDependenciesStatus(ctx, placementObj, heldBindings); err != nil {
+			return runtime.Result{}, err
+		}
+		if waitTime <= 0 || dependencyRequeueDelay < waitTime {
+			waitTime = dependencyRequeueDelay
+		}
+	}
+
 	// StaleBindings is the list that contains bindings that need to be updated (binding to a
 	// cluster, upgrading to a newer resource/override snapshot) but are blocked by
 	// the rollout strategy.
@@ -219,6 +322,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req runtime.Request) (runtim
 	}
 	klog.V(2).InfoS("Successfully updated status of the up-to-date bindings", "placement", placementObjRef, "numberOfUpToDateBindings", len(upToDateBoundBindings))
 
+	// Hold back the unscheduled bindings that are still in the work cleanup grace period so that
+	// one can collect logs and states from the member clusters before the resources are removed.
+	toBeUpdatedBindings, cleanupWaitTime, err := r.holdBindingsInCleanupGracePeriod(ctx, toBeUpdatedBindings)
+	if err != nil {
+		return runtime.Result{}, err
+	}
+	if cleanupWaitTime > 0 && (waitTime <= 0 || cleanupWaitTime < waitTime) {
+		waitTime = cleanupWaitTime
+	}
+
 	// Update all the bindings in parallel according to the rollout plan.
 	// We need to requeue the request regardless if the binding updates succeed or not
 	// to avoid the case that the rollout process stalling because the time based binding readiness does not trigger any event.
@@ -312,7 +425,8 @@ type toBeUpdatedBinding struct {
 }
 
 func createUpdateInfo(binding placementv1beta1.BindingObj,
-	masterResourceSnapshot placementv1beta1.ResourceSnapshotObj, cro []string, ro []placementv1beta1.NamespacedName) toBeUpdatedBinding {
+	masterResourceSnapshot placementv1beta1.ResourceSnapshotObj, cro []string, ro []placementv1beta1.NamespacedName,
+	propertySnapshot *placementv1beta1.ClusterPropertySnapshot) toBeUpdatedBinding {
 	desiredBinding := binding.DeepCopyObject().(placementv1beta1.BindingObj)
 
 	// Apply strategy is updated separately for all bindings.
@@ -324,6 +438,7 @@ func createUpdateInfo(binding placementv1beta1.BindingObj,
 	// TODO: check the size of the cro and ro to not exceed the limit
 	desiredSpec.ClusterResourceOverrideSnapshots = cro
 	desiredSpec.ResourceOverrideSnapshots = ro
+	desiredSpec.ClusterPropertySnapshot = propertySnapshot
 
 	return toBeUpdatedBinding{
 		currentBinding: binding,
@@ -424,15 +539,20 @@ func (r *Reconciler) pickBindingsToRoll(
 			schedulerTargetedBinds = append(schedulerTargetedBinds, binding)
 			// this binding has not been bound yet, so it is an update candidate
 			// PickFromResourceMatchedOverridesForTargetCluster always returns the ordered list of the overrides.
-			cro, ro, err := overrider.PickFromResourceMatchedOverridesForTargetCluster(ctx, r.Client, bindingSpec.TargetCluster, matchedCROs, matchedROs)
+			cro, ro, propertySnapshot, err := overrider.PickFromResourceMatchedOverridesForTargetCluster(ctx, r.Client, bindingSpec.TargetCluster, matchedCROs, matchedROs)
 			if err != nil {
 				return nil, nil, nil, false, minWaitTime, err
 			}
-			boundingCandidates = append(boundingCandidates, createUpdateInfo(binding, masterResourceSnapshot, cro, ro))
+			boundingCandidates = append(boundingCandidates, createUpdateInfo(binding, masterResourceSnapshot, cro, ro, propertySnapshot))
 		case placementv1beta1.BindingStateBound:
 			bindingFailed := false
 			schedulerTargetedBinds = append(schedulerTargetedBinds, binding)
 			waitTime, bindingReady := isBindingReady(binding, readyTimeCutOff)
+			if bindingReady && isBindingAnalysisPending(placementSpec.Strategy.Analysis, binding, masterResourceSnapshot) {
+				// the rollout analysis will requeue the request when it needs to run again.
+				klog.V(2).InfoS("Found a bound binding with the rollout analysis pending", "placement", placementKObj, "binding", bindingKObj)
+				waitTime, bindingReady = -1, false
+			}
 			if bindingReady {
 				klog.V(2).InfoS("Found a ready bound binding", "placement", placementKObj, "binding", bindingKObj)
 				readyBindings = append(readyBindings, binding)
@@ -453,13 +573,15 @@ func (r *Reconciler) pickBindingsToRoll(
 			// check to see if binding is not being deleted.
 			if binding.GetDeletionTimestamp().IsZero() {
 				// PickFromResourceMatchedOverridesForTargetCluster always returns the ordered list of the overrides.
-				cro, ro, err := overrider.PickFromResourceMatchedOverridesForTargetCluster(ctx, r.Client, bindingSpec.TargetCluster, matchedCROs, matchedROs)
+				cro, ro, propertySnapshot, err := overrider.PickFromResourceMatchedOverridesForTargetCluster(ctx, r.Client, bindingSpec.TargetCluster, matchedCROs, matchedROs)
 				if err != nil {
 					return nil, nil, nil, false, 0, err
 				}
-				// The binding needs update if it's not pointing to the latest resource binding or the overrides.
-				if bindingSpec.ResourceSnapshotName != masterResourceSnapshot.GetName() || !equality.Semantic.DeepEqual(bindingSpec.ClusterResourceOverrideSnapshots, cro) || !equality.Semantic.DeepEqual(bindingSpec.ResourceOverrideSnapshots, ro) {
-					updateInfo := createUpdateInfo(binding, masterResourceSnapshot, cro, ro)
+				// The binding needs update if it's not pointing to the latest resource binding, the overrides, or the
+				// cluster property values the overrides depend on.
+				if bindingSpec.ResourceSnapshotName != masterResourceSnapshot.GetName() || !equality.Semantic.DeepEqual(bindingSpec.ClusterResourceOverrideSnapshots, cro) ||
+					!equality.Semantic.DeepEqual(bindingSpec.ResourceOverrideSnapshots, ro) || !equality.Semantic.DeepEqual(bindingSpec.ClusterPropertySnapshot, propertySnapshot) {
+					updateInfo := createUpdateInfo(binding, masterResourceSnapshot, cro, ro, propertySnapshot)
 					if bindingFailed {
 						// the binding has been applied but failed to apply, we can safely update it to latest resources without affecting max unavailable count
 						applyFailedUpdateCandidates = append(applyFailedUpdateCandidates, updateInfo)
@@ -687,11 +809,73 @@ func (r *Reconciler) updateBindings(ctx context.Context, bindings []toBeUpdatedB
 	return errs.Wait()
 }
 
+// holdBindingsInCleanupGracePeriod filters out the unscheduled bindings that are still in the work cleanup
+// grace period. It marks such bindings with the PreDeletion condition, and returns the time to wait before
+// the earliest one of them can be deleted.
+func (r *Reconciler) holdBindingsInCleanupGracePeriod(ctx context.Context, bindings []toBeUpdatedBinding) ([]toBeUpdatedBinding, time.Duration, error) {
+	if r.WorkCleanupGracePeriod <= 0 {
+		return bindings, 0, nil
+	}
+	readyBindings := make([]toBeUpdatedBinding, 0, len(bindings))
+	var waitTime time.Duration
+	now := time.Now()
+	for i := range bindings {
+		binding := bindings[i].currentBinding
+		if binding.GetBindingSpec().State != placementv1beta1.BindingStateUnscheduled {
+			readyBindings = append(readyBindings, bindings[i])
+			continue
+		}
+		preDeletionCond := binding.GetCondition(string(placementv1beta1.ResourceBindingPreDeletion))
+		if !condition.IsConditionStatusTrue(preDeletionCond, binding.GetGeneration()) {
+			// The grace period starts when the binding is first found unscheduled in its current generation.
+			if err := r.markBindingPreDeletion(ctx, binding); err != nil {
+				return nil, 0, err
+			}
+			preDeletionCond = binding.GetCondition(string(placementv1beta1.ResourceBindingPreDeletion))
+		}
+		remaining := preDeletionCond.LastTransitionTime.Add(r.WorkCleanupGracePeriod).Sub(now)
+		if remaining <= 0 {
+			readyBindings = append(readyBindings, bindings[i])
+			continue
+		}
+		klog.V(2).InfoS("Holding an unscheduled binding until the work cleanup grace period elapses", "binding", klog.KObj(binding), "remaining", remaining)
+		if waitTime <= 0 || remaining < waitTime {
+			waitTime = remaining
+		}
+	}
+	return readyBindings, waitTime, nil
+}
+
+// markBindingPreDeletion sets the PreDeletion condition on an unscheduled binding, which starts its work
+// cleanup grace period.
+func (r *Reconciler) markBindingPreDeletion(ctx context.Context, binding placementv1beta1.BindingObj) error {
+	cond := metav1.Condition{
+		Type:               string(placementv1beta1.ResourceBindingPreDeletion),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: binding.GetGeneration(),
+		Reason:             condition.WorkCleanupGracePeriodPendingReason,
+		Message: fmt.Sprintf("The binding is no longer scheduled; the resources will be removed from the cluster after the work cleanup grace period of %s",
+			r.WorkCleanupGracePeriod),
+	}
+	// Remove any stale condition first so that the last transition time always marks the start of the grace period.
+	binding.RemoveCondition(string(placementv1beta1.ResourceBindingPreDeletion))
+	binding.SetConditions(cond)
+	if err := r.Client.Status().Update(ctx, binding); err != nil {
+		klog.ErrorS(err, "Failed to mark an unscheduled binding for pre-deletion", "binding", klog.KObj(binding))
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Marked an unscheduled binding for pre-deletion", "binding", klog.KObj(binding), "gracePeriod", r.WorkCleanupGracePeriod)
+	return nil
+}
+
 // SetupWithManagerForClusterResourcePlacement sets up the rollout controller with the Manager for cluster scoped resources.
 // The rollout controller watches resource snapshots and resource bindings.
 // It reconciles on the CRP when a new cluster resource binding is created or an existing cluster resource binding is created/updated.
 func (r *Reconciler) SetupWithManagerForClusterResourcePlacement(mgr runtime.Manager) error {
-	r.recorder = mgr.GetEventRecorderFor("cluster-resource-placement-rollout-controller")
+	r.recorder = events.NewRateLimitedRecorder(mgr.GetEventRecorderFor("cluster-resource-placement-rollout-controller"), events.DefaultDedupWindow)
+	if r.MetricQuerier == nil {
+		r.MetricQuerier = prometheus.NewQuerier(nil, nil)
+	}
 	return runtime.NewControllerManagedBy(mgr).Named("cluster-resource-placement-rollout-controller").
 		WithOptions(ctrl.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}). // set the max number of concurrent reconciles
 		Watches(&placementv1beta1.ClusterResourceSnapshot{}, resourceSnapshotObjHandlerFuncs()).
@@ -736,7 +920,10 @@ func (r *Reconciler) SetupWithManagerForClusterResourcePlacement(mgr runtime.Man
 // The rollout controller watches resource snapshots and resource bindings.
 // It reconciles on the RP when a new resource binding is created or an existing resource binding is created/updated.
 func (r *Reconciler) SetupWithManagerForResourcePlacement(mgr runtime.Manager) error {
-	r.recorder = mgr.GetEventRecorderFor("resource-placement-rollout-controller")
+	r.recorder = events.NewRateLimitedRecorder(mgr.GetEventRecorderFor("resource-placement-rollout-controller"), events.DefaultDedupWindow)
+	if r.MetricQuerier == nil {
+		r.MetricQuerier = prometheus.NewQuerier(nil, nil)
+	}
 	return runtime.NewControllerManagedBy(mgr).Named("resource-placement-rollout-controller").
 		WithOptions(ctrl.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}). // set the max number of concurrent reconciles
 		Watches(&placementv1beta1.ResourceSnapshot{}, resourceSnapshotObjHandlerFuncs()).
@@ -1098,7 +1285,7 @@ func (r *Reconciler) updateBindingStatus(ctx context.Context, binding placementv
 }
 
 // processApplyStrategyUpdates processes apply strategy updates on the placement end; specifically
-// it will push the update to all applicable bindings.
+// it will push the update, along with the apply strategy overrides, to all applicable bindings.
 func (r *Reconciler) processApplyStrategyUpdates(
 	ctx context.Context,
 	placementObj placementv1beta1.PlacementObj,
@@ -1116,6 +1303,7 @@ func (r *Reconciler) processApplyStrategyUpdates(
 		applyStrategy = &placementv1beta1.ApplyStrategy{}
 		defaulter.SetDefaultsApplyStrategy(applyStrategy)
 	}
+	applyStrategyOverrides := placementObj.GetPlacementSpec().Strategy.ApplyStrategyOverrides
 
 	errs, childCtx := errgroup.WithContext(ctx)
 	for idx := range allBindings {
@@ -1126,8 +1314,9 @@ func (r *Reconciler) processApplyStrategyUpdates(
 			continue
 		}
 
-		// Verify if the binding has the latest apply strategy set.
-		if equality.Semantic.DeepEqual(binding.GetBindingSpec().ApplyStrategy, applyStrategy) {
+		// Verify if the binding has the latest apply strategy (and apply strategy overrides) set.
+		if equality.Semantic.DeepEqual(binding.GetBindingSpec().ApplyStrategy, applyStrategy) &&
+			equality.Semantic.DeepEqual(binding.GetBindingSpec().ApplyStrategyOverrides, applyStrategyOverrides) {
 			// The binding already has the latest apply strategy set; no need to push the update.
 			klog.V(2).InfoS("The binding already has the latest apply strategy; skip the apply strategy update", "binding", klog.KObj(binding), "bindingGeneration", binding.GetGeneration())
 			continue
@@ -1140,6 +1329,7 @@ func (r *Reconciler) processApplyStrategyUpdates(
 		updatedBinding := binding.DeepCopyObject().(placementv1beta1.BindingObj)
 		updatedSpec := updatedBinding.GetBindingSpec()
 		updatedSpec.ApplyStrategy = applyStrategy
+		updatedSpec.ApplyStrategyOverrides = applyStrategyOverrides
 		applyStrategyUpdated = true
 
 		errs.Go(func() error {
@@ -1193,6 +1383,15 @@ func handlePlacement(newPlacementObj, oldPlacementObj client.Object, q workqueue
 		return
 	}
 
+	// Check if the rollout has been paused, resumed, or pinned to a different resource snapshot.
+	if !isRolloutControlAnnotationsEqual(newPlacement, oldPlacement) {
+		klog.V(2).InfoS("Detected an update to the rollout control annotations on the placement", "placement", klog.KObj(newPlacement))
+		q.Add(reconcile.Request{
+			NamespacedName: types.NamespacedName{Name: newPlacement.GetName(), Namespace: newPlacement.GetNamespace()},
+		})
+		return
+	}
+
 	// Check if the apply strategy has been updated.
 	newApplyStrategy := newPlacementSpec.Strategy.ApplyStrategy
 	oldApplyStrategy := oldPlacementSpec.Strategy.ApplyStrategy
@@ -1206,3 +1405,22 @@ func handlePlacement(newPlacementObj, oldPlacementObj client.Object, q workqueue
 
 	klog.V(2).InfoS("No update to apply strategy detected; ignore the placement Update event", "placement", klog.KObj(newPlacement))
 }
+
+// isRolloutPaused returns if the rollout of the placement has been paused.
+func isRolloutPaused(placementObj placementv1beta1.PlacementObj) bool {
+	return placementObj.GetAnnotations()[placementv1beta1.RolloutPausedAnnotation] == "true"
+}
+
+// isRolloutControlAnnotationsEqual returns if the two placements have the same rollout control annotations,
+// i.e., whether they are paused or pinned to a resource snapshot in the same way.
+func isRolloutControlAnnotationsEqual(newPlacement, oldPlacement placementv1beta1.PlacementObj) bool {
+	newAnnotations, oldAnnotations := newPlacement.GetAnnotations(), oldPlacement.GetAnnotations()
+	for _, key := range []string{placementv1beta1.RolloutPausedAnnotation, placementv1beta1.PinnedResourceSnapshotIndexAnnotation} {
+		newValue, newFound := newAnnotations[key]
+		oldValue, oldFound := oldAnnotations[key]
+		if newValue != oldValue || newFound != oldFound {
+			return false
+		}
+	}
+	return true
+}
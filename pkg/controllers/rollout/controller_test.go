@@ -1618,6 +1618,78 @@ func TestPickBindingsToRoll(t *testing.T) {
 			wantNeedRoll: true,
 			wantWaitTime: defaultUnavailablePeriod * time.Second,
 		},
+		"test failed to apply bound binding, latest resources and overrides with stale cluster property snapshot - rollout allowed": {
+			allBindingsFunc: func() []*placementv1beta1.ClusterResourceBinding {
+				binding := generateFailedToApplyClusterResourceBinding(placementv1beta1.BindingStateBound, "snapshot-1", cluster1)
+				binding.Spec.ClusterResourceOverrideSnapshots = []string{"cro-1"}
+				binding.Spec.ClusterPropertySnapshot = &placementv1beta1.ClusterPropertySnapshot{
+					Values: map[string]string{"region": "eu-west"},
+				}
+				return []*placementv1beta1.ClusterResourceBinding{binding}
+			},
+			latestResourceSnapshotName: "snapshot-1",
+			crp: clusterResourcePlacementForTest("test",
+				createPlacementPolicyForTest(placementv1beta1.PickNPlacementType, 5),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, generateDefaultRollingUpdateConfig(), nil)),
+			matchedCROs: []*placementv1beta1.ClusterResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cro-1",
+					},
+					Spec: placementv1beta1.ClusterResourceOverrideSnapshotSpec{
+						OverrideSpec: placementv1beta1.ClusterResourceOverrideSpec{
+							Policy: &placementv1beta1.OverridePolicy{
+								OverrideRules: []placementv1beta1.OverrideRule{
+									{
+										ClusterSelector: &placementv1beta1.ClusterSelector{
+											ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+												{
+													PropertySelector: &placementv1beta1.PropertySelector{
+														MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+															{
+																Name:     "region",
+																Operator: placementv1beta1.PropertySelectorIn,
+																Values:   []string{"eu-*"},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			clusters: []clusterv1beta1.MemberCluster{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cluster-1",
+					},
+					Status: clusterv1beta1.MemberClusterStatus{
+						Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+							"region": {Value: "eu-central"},
+						},
+					},
+				},
+			},
+			wantTobeUpdatedBindings: []int{0},
+			wantDesiredBindingsSpec: []placementv1beta1.ResourceBindingSpec{
+				{
+					State:                            placementv1beta1.BindingStateBound,
+					TargetCluster:                    cluster1,
+					ResourceSnapshotName:             "snapshot-1",
+					ClusterResourceOverrideSnapshots: []string{"cro-1"},
+					ClusterPropertySnapshot: &placementv1beta1.ClusterPropertySnapshot{
+						Values: map[string]string{"region": "eu-central"},
+					},
+				},
+			},
+			wantNeedRoll: true,
+			wantWaitTime: defaultUnavailablePeriod * time.Second,
+		},
 		"test one failed to apply bound binding and four failed non ready bound bindings, outdated resources with maxUnavailable specified - rollout allowed": {
 			allBindingsFunc: func() []*placementv1beta1.ClusterResourceBinding {
 				return []*placementv1beta1.ClusterResourceBinding{
@@ -3135,6 +3207,177 @@ func TestRefreshUpToDateBindingStatus(t *testing.T) {
 	}
 }
 
+func TestHoldBindingsInCleanupGracePeriod(t *testing.T) {
+	gracePeriod := 10 * time.Minute
+	longAgo := metav1.NewTime(time.Now().Add(-time.Hour))
+	boundBinding := placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "bound-binding",
+			Generation: 1,
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateBound,
+			TargetCluster: cluster1,
+		},
+	}
+	newUnscheduledBinding := placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "new-unscheduled-binding",
+			Generation: 2,
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateUnscheduled,
+			TargetCluster: cluster2,
+		},
+	}
+	expiredUnscheduledBinding := placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "expired-unscheduled-binding",
+			Generation: 2,
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateUnscheduled,
+			TargetCluster: cluster3,
+		},
+		Status: placementv1beta1.ResourceBindingStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.ResourceBindingPreDeletion),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 2,
+					LastTransitionTime: longAgo,
+					Reason:             condition.WorkCleanupGracePeriodPendingReason,
+				},
+			},
+		},
+	}
+	staleUnscheduledBinding := placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "stale-unscheduled-binding",
+			Generation: 4,
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateUnscheduled,
+			TargetCluster: cluster4,
+		},
+		Status: placementv1beta1.ResourceBindingStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.ResourceBindingPreDeletion),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 2,
+					LastTransitionTime: longAgo,
+					Reason:             condition.WorkCleanupGracePeriodPendingReason,
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                 string
+		gracePeriod          time.Duration
+		bindings             []placementv1beta1.ClusterResourceBinding
+		wantReadyBindings    []string
+		wantWait             bool
+		wantPreDeletionSince map[string]metav1.Time
+	}{
+		{
+			name:              "no grace period",
+			gracePeriod:       0,
+			bindings:          []placementv1beta1.ClusterResourceBinding{boundBinding, newUnscheduledBinding},
+			wantReadyBindings: []string{"bound-binding", "new-unscheduled-binding"},
+		},
+		{
+			name:              "newly unscheduled binding is held",
+			gracePeriod:       gracePeriod,
+			bindings:          []placementv1beta1.ClusterResourceBinding{boundBinding, newUnscheduledBinding},
+			wantReadyBindings: []string{"bound-binding"},
+			wantWait:          true,
+			wantPreDeletionSince: map[string]metav1.Time{
+				"new-unscheduled-binding": metav1.Now(),
+			},
+		},
+		{
+			name:              "unscheduled binding past the grace period is released",
+			gracePeriod:       gracePeriod,
+			bindings:          []placementv1beta1.ClusterResourceBinding{expiredUnscheduledBinding},
+			wantReadyBindings: []string{"expired-unscheduled-binding"},
+			wantPreDeletionSince: map[string]metav1.Time{
+				"expired-unscheduled-binding": longAgo,
+			},
+		},
+		{
+			name:              "unscheduled binding with a stale pre-deletion condition restarts the grace period",
+			gracePeriod:       gracePeriod,
+			bindings:          []placementv1beta1.ClusterResourceBinding{staleUnscheduledBinding},
+			wantReadyBindings: []string{},
+			wantWait:          true,
+			wantPreDeletionSince: map[string]metav1.Time{
+				"stale-unscheduled-binding": metav1.Now(),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var objects []client.Object
+			for i := range tc.bindings {
+				objects = append(objects, tc.bindings[i].DeepCopy())
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(objects...).
+				WithStatusSubresource(objects...).
+				Build()
+			r := Reconciler{
+				Client:                 fakeClient,
+				WorkCleanupGracePeriod: tc.gracePeriod,
+			}
+			ctx := context.Background()
+			bindings := make([]toBeUpdatedBinding, 0, len(tc.bindings))
+			for i := range tc.bindings {
+				binding := &placementv1beta1.ClusterResourceBinding{}
+				if err := fakeClient.Get(ctx, client.ObjectKey{Name: tc.bindings[i].Name}, binding); err != nil {
+					t.Fatalf("failed to get binding: %v", err)
+				}
+				bindings = append(bindings, toBeUpdatedBinding{currentBinding: binding})
+			}
+
+			readyBindings, waitTime, err := r.holdBindingsInCleanupGracePeriod(ctx, bindings)
+			if err != nil {
+				t.Fatalf("holdBindingsInCleanupGracePeriod() = %v, want no error", err)
+			}
+			gotReadyBindings := make([]string, 0, len(readyBindings))
+			for _, binding := range readyBindings {
+				gotReadyBindings = append(gotReadyBindings, binding.currentBinding.GetName())
+			}
+			if diff := cmp.Diff(tc.wantReadyBindings, gotReadyBindings); diff != "" {
+				t.Errorf("holdBindingsInCleanupGracePeriod() ready bindings mismatch (-want, +got):\n%s", diff)
+			}
+			if gotWait := waitTime > 0 && waitTime <= tc.gracePeriod; gotWait != tc.wantWait {
+				t.Errorf("holdBindingsInCleanupGracePeriod() wait time = %v, want wait %t", waitTime, tc.wantWait)
+			}
+			for name, wantSince := range tc.wantPreDeletionSince {
+				binding := &placementv1beta1.ClusterResourceBinding{}
+				if err := fakeClient.Get(ctx, client.ObjectKey{Name: name}, binding); err != nil {
+					t.Fatalf("failed to get binding: %v", err)
+				}
+				wantCond := metav1.Condition{
+					Type:               string(placementv1beta1.ResourceBindingPreDeletion),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: binding.Generation,
+					LastTransitionTime: wantSince,
+					Reason:             condition.WorkCleanupGracePeriodPendingReason,
+				}
+				gotCond := binding.GetCondition(string(placementv1beta1.ResourceBindingPreDeletion))
+				if diff := cmp.Diff(&wantCond, gotCond, cmpOptions...); diff != "" {
+					t.Errorf("binding %s PreDeletion condition mismatch (-want, +got):\n%s", name, diff)
+				}
+			}
+		})
+	}
+}
+
 func TestCheckAndUpdateStaleBindingsStatus(t *testing.T) {
 	generation := int64(15)
 	latestBindings := []*placementv1beta1.ClusterResourceBinding{
@@ -4071,6 +4314,96 @@ func TestProcessApplyStrategyUpdates(t *testing.T) {
 			},
 			wantApplyStrategyUpdated: true,
 		},
+		{
+			name: "push apply strategy overrides to bindings",
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: crpName,
+				},
+				Spec: placementv1beta1.PlacementSpec{
+					Strategy: placementv1beta1.RolloutStrategy{
+						ApplyStrategy: &placementv1beta1.ApplyStrategy{
+							Type:             placementv1beta1.ApplyStrategyTypeClientSideApply,
+							ComparisonOption: placementv1beta1.ComparisonOptionTypePartialComparison,
+							WhenToApply:      placementv1beta1.WhenToApplyTypeAlways,
+							WhenToTakeOver:   placementv1beta1.WhenToTakeOverTypeAlways,
+						},
+						ApplyStrategyOverrides: []placementv1beta1.ApplyStrategyOverride{
+							{
+								ClusterSelector: &placementv1beta1.ClusterSelector{
+									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+										{
+											LabelSelector: &metav1.LabelSelector{
+												MatchLabels: map[string]string{"ring": "prod"},
+											},
+										},
+									},
+								},
+								ApplyStrategy: &placementv1beta1.ApplyStrategy{
+									Type:             placementv1beta1.ApplyStrategyTypeReportDiff,
+									ComparisonOption: placementv1beta1.ComparisonOptionTypePartialComparison,
+									WhenToApply:      placementv1beta1.WhenToApplyTypeAlways,
+									WhenToTakeOver:   placementv1beta1.WhenToTakeOverTypeAlways,
+								},
+							},
+						},
+					},
+				},
+			},
+			allBindings: []*placementv1beta1.ClusterResourceBinding{
+				// A binding that has the latest apply strategy but no apply strategy overrides.
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "binding-1",
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						ResourceSnapshotName: "snapshot-1",
+						ApplyStrategy: &placementv1beta1.ApplyStrategy{
+							Type:             placementv1beta1.ApplyStrategyTypeClientSideApply,
+							ComparisonOption: placementv1beta1.ComparisonOptionTypePartialComparison,
+							WhenToApply:      placementv1beta1.WhenToApplyTypeAlways,
+							WhenToTakeOver:   placementv1beta1.WhenToTakeOverTypeAlways,
+						},
+					},
+				},
+			},
+			wantAllBindings: []*placementv1beta1.ClusterResourceBinding{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "binding-1",
+					},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						ResourceSnapshotName: "snapshot-1",
+						ApplyStrategy: &placementv1beta1.ApplyStrategy{
+							Type:             placementv1beta1.ApplyStrategyTypeClientSideApply,
+							ComparisonOption: placementv1beta1.ComparisonOptionTypePartialComparison,
+							WhenToApply:      placementv1beta1.WhenToApplyTypeAlways,
+							WhenToTakeOver:   placementv1beta1.WhenToTakeOverTypeAlways,
+						},
+						ApplyStrategyOverrides: []placementv1beta1.ApplyStrategyOverride{
+							{
+								ClusterSelector: &placementv1beta1.ClusterSelector{
+									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+										{
+											LabelSelector: &metav1.LabelSelector{
+												MatchLabels: map[string]string{"ring": "prod"},
+											},
+										},
+									},
+								},
+								ApplyStrategy: &placementv1beta1.ApplyStrategy{
+									Type:             placementv1beta1.ApplyStrategyTypeReportDiff,
+									ComparisonOption: placementv1beta1.ComparisonOptionTypePartialComparison,
+									WhenToApply:      placementv1beta1.WhenToApplyTypeAlways,
+									WhenToTakeOver:   placementv1beta1.WhenToTakeOverTypeAlways,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantApplyStrategyUpdated: true,
+		},
 		{
 			name: "no apply strategy update needed",
 			crp: &placementv1beta1.ClusterResourcePlacement{
@@ -4205,3 +4538,52 @@ func TestProcessApplyStrategyUpdates(t *testing.T) {
 		})
 	}
 }
+
+func TestIsRolloutControlAnnotationsEqual(t *testing.T) {
+	crpWithAnnotations := func(annotations map[string]string) *placementv1beta1.ClusterResourcePlacement {
+		return &placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: "test-crp", Annotations: annotations},
+		}
+	}
+	tests := map[string]struct {
+		newPlacement placementv1beta1.PlacementObj
+		oldPlacement placementv1beta1.PlacementObj
+		want         bool
+	}{
+		"no rollout control annotations": {
+			newPlacement: crpWithAnnotations(map[string]string{"foo": "bar"}),
+			oldPlacement: crpWithAnnotations(nil),
+			want:         true,
+		},
+		"rollout is paused": {
+			newPlacement: crpWithAnnotations(map[string]string{placementv1beta1.RolloutPausedAnnotation: "true"}),
+			oldPlacement: crpWithAnnotations(nil),
+			want:         false,
+		},
+		"rollout is resumed": {
+			newPlacement: crpWithAnnotations(nil),
+			oldPlacement: crpWithAnnotations(map[string]string{
+				placementv1beta1.RolloutPausedAnnotation:               "true",
+				placementv1beta1.PinnedResourceSnapshotIndexAnnotation: "1",
+			}),
+			want: false,
+		},
+		"placement is pinned to a different resource snapshot": {
+			newPlacement: crpWithAnnotations(map[string]string{placementv1beta1.PinnedResourceSnapshotIndexAnnotation: "1"}),
+			oldPlacement: crpWithAnnotations(map[string]string{placementv1beta1.PinnedResourceSnapshotIndexAnnotation: "2"}),
+			want:         false,
+		},
+		"placement is pinned to the same resource snapshot": {
+			newPlacement: crpWithAnnotations(map[string]string{placementv1beta1.PinnedResourceSnapshotIndexAnnotation: "1"}),
+			oldPlacement: crpWithAnnotations(map[string]string{placementv1beta1.PinnedResourceSnapshotIndexAnnotation: "1", "foo": "bar"}),
+			want:         true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := isRolloutControlAnnotationsEqual(tt.newPlacement, tt.oldPlacement); got != tt.want {
+				t.Errorf("isRolloutControlAnnotationsEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,150 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// dependencyRequeueDelay is the delay before the rollout controller checks again whether the
+	// placements that a placement depends on have become available on the clusters it waits for.
+	dependencyRequeueDelay = 15 * time.Second
+)
+
+// holdBindingsOnUnavailableDependencies filters out the bindings that would start a rollout on a member
+// cluster where any of the placements that the placement depends on (see the DependsOn field) has not
+// made its resources available yet. It returns the bindings that can be rolled out and the ones that
+// are held back.
+func (r *Reconciler) holdBindingsOnUnavailableDependencies(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	bindings []toBeUpdatedBinding,
+) ([]toBeUpdatedBinding, []toBeUpdatedBinding, error) {
+	dependsOn := placementObj.GetPlacementSpec().DependsOn
+	if len(dependsOn) == 0 || len(bindings) == 0 {
+		return bindings, nil, nil
+	}
+
+	// availableClusters is the set of clusters on which all the dependencies are available.
+	var availableClusters sets.Set[string]
+	for _, name := range dependsOn {
+		clusters, err := r.listClustersWithAvailableResources(ctx, types.NamespacedName{Namespace: placementObj.GetNamespace(), Name: name})
+		if err != nil {
+			return nil, nil, err
+		}
+		if availableClusters == nil {
+			availableClusters = clusters
+			continue
+		}
+		availableClusters = availableClusters.Intersection(clusters)
+	}
+
+	readyBindings := make([]toBeUpdatedBinding, 0, len(bindings))
+	var heldBindings []toBeUpdatedBinding
+	for i := range bindings {
+		bindingSpec := bindings[i].currentBinding.GetBindingSpec()
+		if bindingSpec.State != placementv1beta1.BindingStateScheduled && bindingSpec.State != placementv1beta1.BindingStateBound {
+			// Only the bindings that are bound to (new versions of) the resources start rollouts.
+			readyBindings = append(readyBindings, bindings[i])
+			continue
+		}
+		if availableClusters.Has(bindingSpec.TargetCluster) {
+			readyBindings = append(readyBindings, bindings[i])
+			continue
+		}
+		klog.V(2).InfoS("Holding back a binding as the placements it depends on are not available on the cluster yet",
+			"placement", klog.KObj(placementObj), "binding", klog.KObj(bindings[i].currentBinding), "cluster", bindingSpec.TargetCluster,
+			"dependsOn", dependsOn)
+		heldBindings = append(heldBindings, bindings[i])
+	}
+	return readyBindings, heldBindings, nil
+}
+
+// listClustersWithAvailableResources returns the member clusters on which the given placement has
+// made the latest version of its resources available; no cluster is returned if the placement
+// does not exist.
+func (r *Reconciler) listClustersWithAvailableResources(ctx context.Context, placementKey types.NamespacedName) (sets.Set[string], error) {
+	if _, err := controller.FetchPlacementFromNamespacedName(ctx, r.Client, placementKey); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("The placement that another placement depends on is not found", "dependency", placementKey)
+			return sets.New[string](), nil
+		}
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	bindings, err := controller.ListBindingsFromKey(ctx, r.Client, placementKey, true)
+	if err != nil {
+		return nil, err
+	}
+	clusters := sets.New[string]()
+	for _, binding := range bindings {
+		if isResourcesAvailable(binding) {
+			clusters.Insert(binding.GetBindingSpec().TargetCluster)
+		}
+	}
+	return clusters, nil
+}
+
+// isResourcesAvailable returns whether a binding has made its resources available on its target cluster.
+func isResourcesAvailable(binding placementv1beta1.BindingObj) bool {
+	if !binding.GetDeletionTimestamp().IsZero() || binding.GetBindingSpec().State != placementv1beta1.BindingStateBound {
+		return false
+	}
+	return condition.IsConditionStatusTrue(binding.GetCondition(string(placementv1beta1.ResourceBindingAvailable)), binding.GetGeneration())
+}
+
+// updateBindingsWaitingForDependenciesStatus reports on the bindings held back by the placements that
+// their placement depends on that their rollouts have not started yet.
+func (r *Reconciler) updateBindingsWaitingForDependenciesStatus(ctx context.Context, placementObj placementv1beta1.PlacementObj, heldBindings []toBeUpdatedBinding) error {
+	dependsOn := strings.Join(placementObj.GetPlacementSpec().DependsOn, ", ")
+	errs, cctx := errgroup.WithContext(ctx)
+	for i := range heldBindings {
+		binding := heldBindings[i].currentBinding
+		errs.Go(func() error {
+			cond := metav1.Condition{
+				Type:               string(placementv1beta1.ResourceBindingRolloutStarted),
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: binding.GetGeneration(),
+				Reason:             condition.RolloutWaitingForDependenciesReason,
+				Message: fmt.Sprintf("The resources cannot be updated to the latest because the placements that the placement depends on (%s) are not available on cluster %s yet",
+					dependsOn, binding.GetBindingSpec().TargetCluster),
+			}
+			binding.SetConditions(cond)
+			if err := r.Client.Status().Update(cctx, binding); err != nil {
+				klog.ErrorS(err, "Failed to update binding status", "binding", klog.KObj(binding), "condition", cond)
+				return controller.NewUpdateIgnoreConflictError(err)
+			}
+			klog.V(2).InfoS("Updated the status of a binding held back by the placements it depends on", "binding", klog.KObj(binding))
+			return nil
+		})
+	}
+	return errs.Wait()
+}
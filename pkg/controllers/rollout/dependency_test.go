@@ -0,0 +1,201 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+func TestHoldBindingsOnUnavailableDependencies(t *testing.T) {
+	dependencies := []client.Object{
+		&placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "crp-a",
+			},
+		},
+		&placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "crp-b",
+			},
+		},
+	}
+	availableBinding := func(name, placementName, cluster string) *placementv1beta1.ClusterResourceBinding {
+		binding := rolloutInProgressBinding(name, placementName, cluster)
+		binding.Status.Conditions[1].Status = metav1.ConditionTrue
+		return binding
+	}
+	staleAvailableBinding := availableBinding("dep-stale", "crp-a", cluster2)
+	staleAvailableBinding.Generation = 2
+	candidate := func(name, cluster string, state placementv1beta1.BindingState) *placementv1beta1.ClusterResourceBinding {
+		return &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Generation: 1,
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: crpName,
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				State:         state,
+				TargetCluster: cluster,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		dependsOn        []string
+		existingBindings []client.Object
+		candidates       []*placementv1beta1.ClusterResourceBinding
+		wantReady        []string
+		wantHeld         []string
+	}{
+		{
+			name: "no dependencies",
+			candidates: []*placementv1beta1.ClusterResourceBinding{
+				candidate("binding-1", cluster1, placementv1beta1.BindingStateBound),
+			},
+			wantReady: []string{"binding-1"},
+		},
+		{
+			name:      "binding on a cluster where the dependency is not available is held",
+			dependsOn: []string{"crp-a"},
+			existingBindings: []client.Object{
+				availableBinding("dep-1", "crp-a", cluster1),
+				rolloutInProgressBinding("dep-2", "crp-a", cluster2),
+			},
+			candidates: []*placementv1beta1.ClusterResourceBinding{
+				candidate("binding-1", cluster1, placementv1beta1.BindingStateBound),
+				candidate("binding-2", cluster2, placementv1beta1.BindingStateScheduled),
+				candidate("binding-3", cluster3, placementv1beta1.BindingStateBound),
+			},
+			wantReady: []string{"binding-1"},
+			wantHeld:  []string{"binding-2", "binding-3"},
+		},
+		{
+			name:      "dependency available for an older generation of its binding",
+			dependsOn: []string{"crp-a"},
+			existingBindings: []client.Object{
+				staleAvailableBinding,
+			},
+			candidates: []*placementv1beta1.ClusterResourceBinding{
+				candidate("binding-1", cluster2, placementv1beta1.BindingStateBound),
+			},
+			wantHeld: []string{"binding-1"},
+		},
+		{
+			name:      "all the dependencies must be available",
+			dependsOn: []string{"crp-a", "crp-b"},
+			existingBindings: []client.Object{
+				availableBinding("dep-1", "crp-a", cluster1),
+				availableBinding("dep-2", "crp-a", cluster2),
+				availableBinding("dep-3", "crp-b", cluster2),
+			},
+			candidates: []*placementv1beta1.ClusterResourceBinding{
+				candidate("binding-1", cluster1, placementv1beta1.BindingStateBound),
+				candidate("binding-2", cluster2, placementv1beta1.BindingStateBound),
+			},
+			wantReady: []string{"binding-2"},
+			wantHeld:  []string{"binding-1"},
+		},
+		{
+			name:      "dependency not found",
+			dependsOn: []string{"crp-missing"},
+			candidates: []*placementv1beta1.ClusterResourceBinding{
+				candidate("binding-1", cluster1, placementv1beta1.BindingStateBound),
+			},
+			wantHeld: []string{"binding-1"},
+		},
+		{
+			name:      "unscheduled binding is never held",
+			dependsOn: []string{"crp-a"},
+			candidates: []*placementv1beta1.ClusterResourceBinding{
+				candidate("binding-1", cluster1, placementv1beta1.BindingStateUnscheduled),
+			},
+			wantReady: []string{"binding-1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: crpName,
+				},
+				Spec: placementv1beta1.PlacementSpec{
+					DependsOn: tc.dependsOn,
+				},
+			}
+			objects := append(append([]client.Object{}, dependencies...), tc.existingBindings...)
+			for _, binding := range tc.candidates {
+				objects = append(objects, binding)
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(objects...).
+				WithStatusSubresource(objects...).
+				Build()
+			r := Reconciler{
+				Client: fakeClient,
+			}
+			bindings := make([]toBeUpdatedBinding, 0, len(tc.candidates))
+			for _, binding := range tc.candidates {
+				bindings = append(bindings, toBeUpdatedBinding{currentBinding: binding})
+			}
+
+			ctx := context.Background()
+			ready, held, err := r.holdBindingsOnUnavailableDependencies(ctx, crp, bindings)
+			if err != nil {
+				t.Fatalf("holdBindingsOnUnavailableDependencies() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(tc.wantReady, bindingNames(ready), cmpOptions...); diff != "" {
+				t.Errorf("holdBindingsOnUnavailableDependencies() ready bindings mismatch (-want, +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantHeld, bindingNames(held), cmpOptions...); diff != "" {
+				t.Errorf("holdBindingsOnUnavailableDependencies() held bindings mismatch (-want, +got):\n%s", diff)
+			}
+
+			if err := r.updateBindingsWaitingForDependenciesStatus(ctx, crp, held); err != nil {
+				t.Fatalf("updateBindingsWaitingForDependenciesStatus() = %v, want no error", err)
+			}
+			for _, name := range tc.wantHeld {
+				binding := &placementv1beta1.ClusterResourceBinding{}
+				if err := fakeClient.Get(ctx, client.ObjectKey{Name: name}, binding); err != nil {
+					t.Fatalf("failed to get binding: %v", err)
+				}
+				wantCond := &metav1.Condition{
+					Type:               string(placementv1beta1.ResourceBindingRolloutStarted),
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: binding.Generation,
+					Reason:             condition.RolloutWaitingForDependenciesReason,
+				}
+				if diff := cmp.Diff(wantCond, binding.GetCondition(string(placementv1beta1.ResourceBindingRolloutStarted)), cmpOptions...); diff != "" {
+					t.Errorf("binding %s RolloutStarted condition mismatch (-want, +got):\n%s", name, diff)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,154 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// eventReasonRolloutPausedForSafety is the reason of the event emitted on the placement when the
+	// rollout is paused because its error budget is depleted.
+	eventReasonRolloutPausedForSafety = "RolloutPausedForSafety"
+)
+
+// getRolloutPausedForSafetyConditionType returns the type of the RolloutPausedForSafety condition
+// based on the placement type.
+func getRolloutPausedForSafetyConditionType(placementObj placementv1beta1.PlacementObj) string {
+	if placementObj.GetNamespace() == "" {
+		return string(placementv1beta1.ClusterResourcePlacementRolloutPausedForSafetyConditionType)
+	}
+	return string(placementv1beta1.ResourcePlacementRolloutPausedForSafetyConditionType)
+}
+
+// findFailedUpdatedClusters returns the number of the clusters that have been updated to the given
+// master resource snapshot, and the names of those which report that the resources have failed to be
+// applied or to become available, sorted by name.
+func findFailedUpdatedClusters(allBindings []placementv1beta1.BindingObj, masterResourceSnapshot placementv1beta1.ResourceSnapshotObj) (int, []string) {
+	updated := 0
+	var failedClusters []string
+	for _, binding := range allBindings {
+		bindingSpec := binding.GetBindingSpec()
+		if bindingSpec.State != placementv1beta1.BindingStateBound || !binding.GetDeletionTimestamp().IsZero() ||
+			bindingSpec.ResourceSnapshotName != masterResourceSnapshot.GetName() {
+			continue
+		}
+		updated++
+		for _, condType := range []placementv1beta1.ResourceBindingConditionType{placementv1beta1.ResourceBindingApplied, placementv1beta1.ResourceBindingAvailable} {
+			if condition.IsConditionStatusFalse(binding.GetCondition(string(condType)), binding.GetGeneration()) {
+				failedClusters = append(failedClusters, bindingSpec.TargetCluster)
+				break
+			}
+		}
+	}
+	sort.Strings(failedClusters)
+	return updated, failedClusters
+}
+
+// isErrorBudgetDepleted returns true if the failed clusters make up more than the given percentage of
+// the updated clusters.
+func isErrorBudgetDepleted(updated, failed int, maxFailedClustersPercentage int32) bool {
+	return failed > 0 && failed*100 > int(maxFailedClustersPercentage)*updated
+}
+
+// checkErrorBudget pauses the rollout of the latest resources for safety once too many of the
+// clusters that have been updated to them report failures.
+//
+// The rollout stays paused until the user removes the rollout-paused annotation; after that, the
+// rollout of the same resource snapshot is never paused for safety again.
+//
+// It returns true if the rollout has been paused.
+func (r *Reconciler) checkErrorBudget(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	allBindings []placementv1beta1.BindingObj,
+	masterResourceSnapshot placementv1beta1.ResourceSnapshotObj,
+) (bool, error) {
+	placementKObj := klog.KObj(placementObj)
+	condType := getRolloutPausedForSafetyConditionType(placementObj)
+	maxFailedClustersPercentage := placementObj.GetPlacementSpec().Strategy.RollingUpdate.MaxFailedClustersPercentage
+	if maxFailedClustersPercentage == nil ||
+		placementObj.GetAnnotations()[placementv1beta1.RolloutPausedForSafetyAnnotation] == masterResourceSnapshot.GetName() {
+		// Either there is no error budget or the user has resumed the rollout after it was paused for safety.
+		return false, r.removeRolloutPausedForSafetyCondition(ctx, placementObj)
+	}
+
+	updated, failedClusters := findFailedUpdatedClusters(allBindings, masterResourceSnapshot)
+	if !isErrorBudgetDepleted(updated, len(failedClusters), *maxFailedClustersPercentage) {
+		return false, r.removeRolloutPausedForSafetyCondition(ctx, placementObj)
+	}
+
+	message := fmt.Sprintf("%d of the %d clusters updated to resource snapshot %s have failed to apply the resources or make them available, which exceeds %d%%; paused the rollout, remove the %s annotation to resume it (failed clusters: %s)",
+		len(failedClusters), updated, masterResourceSnapshot.GetName(), *maxFailedClustersPercentage, placementv1beta1.RolloutPausedAnnotation, strings.Join(failedClusters, ", "))
+	// Set the condition first so that it is never missing on a placement paused for safety.
+	placementObj.SetConditions(metav1.Condition{
+		Type:               condType,
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: placementObj.GetGeneration(),
+		Reason:             condition.RolloutErrorBudgetDepletedReason,
+		Message:            message,
+	})
+	if err := r.Client.Status().Update(ctx, placementObj); err != nil {
+		klog.ErrorS(err, "Failed to update the placement status", "placement", placementKObj, "conditionType", condType)
+		return false, controller.NewUpdateIgnoreConflictError(err)
+	}
+
+	// Patch the annotations only, as the placement spec has been defaulted in memory.
+	original := placementObj.DeepCopyObject().(placementv1beta1.PlacementObj)
+	placementAnnotations := placementObj.GetAnnotations()
+	if placementAnnotations == nil {
+		placementAnnotations = map[string]string{}
+	}
+	placementAnnotations[placementv1beta1.RolloutPausedAnnotation] = "true"
+	placementAnnotations[placementv1beta1.RolloutPausedForSafetyAnnotation] = masterResourceSnapshot.GetName()
+	placementObj.SetAnnotations(placementAnnotations)
+	if err := r.Client.Patch(ctx, placementObj, client.MergeFrom(original)); err != nil {
+		klog.ErrorS(err, "Failed to pause the rollout of the placement", "placement", placementKObj)
+		return false, controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS(message, "placement", placementKObj, "masterResourceSnapshot", klog.KObj(masterResourceSnapshot))
+	r.recorder.Event(placementObj, corev1.EventTypeWarning, eventReasonRolloutPausedForSafety, message)
+	return true, nil
+}
+
+// removeRolloutPausedForSafetyCondition removes the RolloutPausedForSafety condition (if any) from
+// the placement once its rollout has resumed.
+func (r *Reconciler) removeRolloutPausedForSafetyCondition(ctx context.Context, placementObj placementv1beta1.PlacementObj) error {
+	condType := getRolloutPausedForSafetyConditionType(placementObj)
+	if !meta.RemoveStatusCondition(&placementObj.GetPlacementStatus().Conditions, condType) {
+		return nil
+	}
+	if err := r.Client.Status().Update(ctx, placementObj); err != nil {
+		klog.ErrorS(err, "Failed to update the placement status", "placement", klog.KObj(placementObj), "conditionType", condType)
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Removed the rollout paused for safety condition", "placement", klog.KObj(placementObj))
+	return nil
+}
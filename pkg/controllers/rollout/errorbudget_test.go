@@ -0,0 +1,251 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+func generateFailedClusterResourceBinding(resourceSnapshotName, targetCluster string, condType placementv1beta1.ResourceBindingConditionType) *placementv1beta1.ClusterResourceBinding {
+	binding := generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, resourceSnapshotName, targetCluster)
+	for i := range binding.Status.Conditions {
+		if binding.Status.Conditions[i].Type == string(condType) {
+			binding.Status.Conditions[i].Status = metav1.ConditionFalse
+			binding.Status.Conditions[i].ObservedGeneration = binding.Generation
+		}
+	}
+	return binding
+}
+
+func TestFindFailedUpdatedClusters(t *testing.T) {
+	masterSnapshot := masterClusterResourceSnapshotForTest(1)
+	staleFailedBinding := generateFailedClusterResourceBinding(masterSnapshot.Name, cluster4, placementv1beta1.ResourceBindingApplied)
+	staleFailedBinding.Generation = 2
+
+	tests := []struct {
+		name               string
+		bindings           []placementv1beta1.BindingObj
+		wantUpdated        int
+		wantFailedClusters []string
+	}{
+		{
+			name: "no updated clusters",
+			bindings: []placementv1beta1.BindingObj{
+				generateFailedClusterResourceBinding("snapshot-0", cluster1, placementv1beta1.ResourceBindingApplied),
+				generateClusterResourceBinding(placementv1beta1.BindingStateScheduled, masterSnapshot.Name, cluster2),
+			},
+		},
+		{
+			name: "updated clusters with failures",
+			bindings: []placementv1beta1.BindingObj{
+				generateFailedClusterResourceBinding(masterSnapshot.Name, cluster3, placementv1beta1.ResourceBindingAvailable),
+				generateFailedClusterResourceBinding(masterSnapshot.Name, cluster1, placementv1beta1.ResourceBindingApplied),
+				generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, masterSnapshot.Name, cluster2),
+				// The failure is reported for an older generation of the binding.
+				staleFailedBinding,
+				setDeletionTimeStampForBinding(generateFailedClusterResourceBinding(masterSnapshot.Name, "cluster-5", placementv1beta1.ResourceBindingApplied)),
+			},
+			wantUpdated:        4,
+			wantFailedClusters: []string{cluster1, cluster3},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotUpdated, gotFailedClusters := findFailedUpdatedClusters(tc.bindings, masterSnapshot)
+			if gotUpdated != tc.wantUpdated {
+				t.Errorf("findFailedUpdatedClusters() updated = %d, want %d", gotUpdated, tc.wantUpdated)
+			}
+			if diff := cmp.Diff(tc.wantFailedClusters, gotFailedClusters); diff != "" {
+				t.Errorf("findFailedUpdatedClusters() failed clusters mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestIsErrorBudgetDepleted(t *testing.T) {
+	tests := []struct {
+		name                        string
+		updated                     int
+		failed                      int
+		maxFailedClustersPercentage int32
+		want                        bool
+	}{
+		{
+			name:                        "no failures with zero budget",
+			updated:                     3,
+			maxFailedClustersPercentage: 0,
+			want:                        false,
+		},
+		{
+			name:                        "any failure with zero budget",
+			updated:                     10,
+			failed:                      1,
+			maxFailedClustersPercentage: 0,
+			want:                        true,
+		},
+		{
+			name:                        "failures at the budget",
+			updated:                     4,
+			failed:                      1,
+			maxFailedClustersPercentage: 25,
+			want:                        false,
+		},
+		{
+			name:                        "failures over the budget",
+			updated:                     4,
+			failed:                      2,
+			maxFailedClustersPercentage: 25,
+			want:                        true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isErrorBudgetDepleted(tc.updated, tc.failed, tc.maxFailedClustersPercentage); got != tc.want {
+				t.Errorf("isErrorBudgetDepleted(%d, %d, %d) = %v, want %v", tc.updated, tc.failed, tc.maxFailedClustersPercentage, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCheckErrorBudget(t *testing.T) {
+	masterSnapshot := masterClusterResourceSnapshotForTest(1)
+	bindings := []placementv1beta1.BindingObj{
+		generateFailedClusterResourceBinding(masterSnapshot.Name, cluster1, placementv1beta1.ResourceBindingApplied),
+		generateFailedClusterResourceBinding(masterSnapshot.Name, cluster2, placementv1beta1.ResourceBindingAvailable),
+		generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, masterSnapshot.Name, cluster3),
+		generateReadyClusterResourceBinding(placementv1beta1.BindingStateBound, masterSnapshot.Name, cluster4),
+	}
+	pausedCondition := metav1.Condition{
+		Type:   string(placementv1beta1.ClusterResourcePlacementRolloutPausedForSafetyConditionType),
+		Status: metav1.ConditionTrue,
+		Reason: condition.RolloutErrorBudgetDepletedReason,
+	}
+
+	tests := []struct {
+		name                        string
+		maxFailedClustersPercentage *int32
+		annotations                 map[string]string
+		conditions                  []metav1.Condition
+		wantPaused                  bool
+		wantAnnotations             map[string]string
+		wantCondStatus              *metav1.ConditionStatus
+	}{
+		{
+			name: "no error budget",
+		},
+		{
+			name:                        "error budget not depleted",
+			maxFailedClustersPercentage: ptr.To(int32(50)),
+		},
+		{
+			name:                        "error budget depleted",
+			maxFailedClustersPercentage: ptr.To(int32(25)),
+			wantPaused:                  true,
+			wantAnnotations: map[string]string{
+				placementv1beta1.RolloutPausedAnnotation:          "true",
+				placementv1beta1.RolloutPausedForSafetyAnnotation: masterSnapshot.Name,
+			},
+			wantCondStatus: ptr.To(metav1.ConditionTrue),
+		},
+		{
+			name:                        "rollout resumed after it was paused for the same resources",
+			maxFailedClustersPercentage: ptr.To(int32(25)),
+			annotations: map[string]string{
+				placementv1beta1.RolloutPausedForSafetyAnnotation: masterSnapshot.Name,
+			},
+			conditions: []metav1.Condition{pausedCondition},
+			wantAnnotations: map[string]string{
+				placementv1beta1.RolloutPausedForSafetyAnnotation: masterSnapshot.Name,
+			},
+		},
+		{
+			name:                        "rollout resumed after it was paused for older resources",
+			maxFailedClustersPercentage: ptr.To(int32(25)),
+			annotations: map[string]string{
+				placementv1beta1.RolloutPausedForSafetyAnnotation: "snapshot-0",
+			},
+			conditions: []metav1.Condition{pausedCondition},
+			wantPaused: true,
+			wantAnnotations: map[string]string{
+				placementv1beta1.RolloutPausedAnnotation:          "true",
+				placementv1beta1.RolloutPausedForSafetyAnnotation: masterSnapshot.Name,
+			},
+			wantCondStatus: ptr.To(metav1.ConditionTrue),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			rollingUpdate := generateDefaultRollingUpdateConfig()
+			rollingUpdate.MaxFailedClustersPercentage = tc.maxFailedClustersPercentage
+			crp := clusterResourcePlacementForTest(crpName, createPlacementPolicyForTest(placementv1beta1.PickAllPlacementType, 0),
+				createPlacementRolloutStrategyForTest(placementv1beta1.RollingUpdateRolloutStrategyType, rollingUpdate, nil))
+			crp.Annotations = tc.annotations
+			crp.Status.Conditions = tc.conditions
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(crp).
+				WithStatusSubresource(crp).
+				Build()
+			fakeRecorder := record.NewFakeRecorder(1)
+			r := Reconciler{
+				Client:   fakeClient,
+				recorder: fakeRecorder,
+			}
+
+			gotPaused, err := r.checkErrorBudget(context.Background(), crp, bindings, masterSnapshot)
+			if err != nil {
+				t.Fatalf("checkErrorBudget() error = %v, want no error", err)
+			}
+			if gotPaused != tc.wantPaused {
+				t.Errorf("checkErrorBudget() = %v, want %v", gotPaused, tc.wantPaused)
+			}
+
+			var gotCRP placementv1beta1.ClusterResourcePlacement
+			if err := fakeClient.Get(context.Background(), types.NamespacedName{Name: crp.Name}, &gotCRP); err != nil {
+				t.Fatalf("failed to get the placement: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantAnnotations, gotCRP.Annotations); diff != "" {
+				t.Errorf("checkErrorBudget() placement annotations mismatch (-want +got):\n%s", diff)
+			}
+			var gotCondStatus *metav1.ConditionStatus
+			if cond := gotCRP.GetCondition(string(placementv1beta1.ClusterResourcePlacementRolloutPausedForSafetyConditionType)); cond != nil {
+				gotCondStatus = &cond.Status
+			}
+			if diff := cmp.Diff(tc.wantCondStatus, gotCondStatus); diff != "" {
+				t.Errorf("checkErrorBudget() RolloutPausedForSafety condition status mismatch (-want +got):\n%s", diff)
+			}
+			wantEvents := 0
+			if tc.wantPaused {
+				wantEvents = 1
+			}
+			if len(fakeRecorder.Events) != wantEvents {
+				t.Errorf("checkErrorBudget() emitted %d events, want %d", len(fakeRecorder.Events), wantEvents)
+			}
+		})
+	}
+}
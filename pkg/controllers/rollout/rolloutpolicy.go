@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"golang.org/x/sync/errgroup"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// clusterRolloutPolicyRequeueDelay is the delay before the rollout controller checks again
+	// whether the bindings held back by the cluster rollout policies can be rolled out.
+	clusterRolloutPolicyRequeueDelay = 15 * time.Second
+)
+
+// holdBindingsOnBusyClusters filters out the bindings that would start a rollout on a member cluster
+// which already has as many rollouts of other placements in progress as its cluster rollout
+// policies allow. It returns the bindings that can be rolled out and the ones that are held back.
+func (r *Reconciler) holdBindingsOnBusyClusters(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	bindings []toBeUpdatedBinding,
+) ([]toBeUpdatedBinding, []toBeUpdatedBinding, error) {
+	if !r.EnableClusterRolloutPolicies || len(bindings) == 0 {
+		return bindings, nil, nil
+	}
+	policyList := &placementv1beta1.ClusterRolloutPolicyList{}
+	if err := r.Client.List(ctx, policyList); err != nil {
+		klog.ErrorS(err, "Failed to list the cluster rollout policies", "placement", klog.KObj(placementObj))
+		return nil, nil, controller.NewAPIServerError(true, err)
+	}
+	if len(policyList.Items) == 0 {
+		return bindings, nil, nil
+	}
+
+	inProgress, err := r.countRolloutsInProgressPerCluster(ctx, types.NamespacedName{Namespace: placementObj.GetNamespace(), Name: placementObj.GetName()})
+	if err != nil {
+		return nil, nil, err
+	}
+	readyBindings := make([]toBeUpdatedBinding, 0, len(bindings))
+	var heldBindings []toBeUpdatedBinding
+	for i := range bindings {
+		bindingSpec := bindings[i].currentBinding.GetBindingSpec()
+		if bindingSpec.State != placementv1beta1.BindingStateScheduled && bindingSpec.State != placementv1beta1.BindingStateBound {
+			// Only the bindings that are bound to (new versions of) the resources start rollouts.
+			readyBindings = append(readyBindings, bindings[i])
+			continue
+		}
+		limit, found, err := r.maxConcurrentRolloutsOnCluster(ctx, bindingSpec.TargetCluster, policyList.Items)
+		if err != nil {
+			return nil, nil, err
+		}
+		if !found || inProgress[bindingSpec.TargetCluster] < limit {
+			readyBindings = append(readyBindings, bindings[i])
+			continue
+		}
+		klog.V(2).InfoS("Holding back a binding as the cluster has reached its limit of concurrent rollouts",
+			"placement", klog.KObj(placementObj), "binding", klog.KObj(bindings[i].currentBinding), "cluster", bindingSpec.TargetCluster,
+			"rolloutsInProgress", inProgress[bindingSpec.TargetCluster], "maxConcurrentRollouts", limit)
+		heldBindings = append(heldBindings, bindings[i])
+	}
+	return readyBindings, heldBindings, nil
+}
+
+// countRolloutsInProgressPerCluster returns the number of placements, other than the given one, that
+// are rolling out changes to each member cluster.
+func (r *Reconciler) countRolloutsInProgressPerCluster(ctx context.Context, placementKey types.NamespacedName) (map[string]int, error) {
+	crbList := &placementv1beta1.ClusterResourceBindingList{}
+	if err := r.Client.List(ctx, crbList); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	rbList := &placementv1beta1.ResourceBindingList{}
+	if err := r.Client.List(ctx, rbList); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+
+	inProgress := make(map[string]int)
+	for _, binding := range append(crbList.GetBindingObjs(), rbList.GetBindingObjs()...) {
+		if binding.GetNamespace() == placementKey.Namespace && binding.GetLabels()[placementv1beta1.PlacementTrackingLabel] == placementKey.Name {
+			continue
+		}
+		if isRolloutInProgress(binding) {
+			inProgress[binding.GetBindingSpec().TargetCluster]++
+		}
+	}
+	return inProgress, nil
+}
+
+// isRolloutInProgress returns whether a binding is rolling out changes to its target cluster, i.e.,
+// the rollout has started but the resources have not become available yet.
+func isRolloutInProgress(binding placementv1beta1.BindingObj) bool {
+	if !binding.GetDeletionTimestamp().IsZero() || binding.GetBindingSpec().State != placementv1beta1.BindingStateBound {
+		return false
+	}
+	generation := binding.GetGeneration()
+	if !condition.IsConditionStatusTrue(binding.GetCondition(string(placementv1beta1.ResourceBindingRolloutStarted)), generation) {
+		return false
+	}
+	return !condition.IsConditionStatusTrue(binding.GetCondition(string(placementv1beta1.ResourceBindingAvailable)), generation)
+}
+
+// maxConcurrentRolloutsOnCluster returns the lowest limit of concurrent rollouts among the cluster
+// rollout policies that apply to the given member cluster; it returns false if no policy applies.
+func (r *Reconciler) maxConcurrentRolloutsOnCluster(ctx context.Context, clusterName string, policies []placementv1beta1.ClusterRolloutPolicy) (int, bool, error) {
+	cluster := &clusterv1beta1.MemberCluster{}
+	if err := r.Client.Get(ctx, types.NamespacedName{Name: clusterName}, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			// The cluster has left the fleet; no policy applies.
+			return 0, false, nil
+		}
+		return 0, false, controller.NewAPIServerError(true, err)
+	}
+
+	limit, found := 0, false
+	for i := range policies {
+		policy := &policies[i]
+		if policy.Spec.ClusterSelector != nil {
+			selector, err := metav1.LabelSelectorAsSelector(policy.Spec.ClusterSelector)
+			if err != nil {
+				// Normally this should never occur.
+				klog.ErrorS(controller.NewUnexpectedBehaviorError(err), "Failed to parse the cluster selector of a cluster rollout policy", "clusterRolloutPolicy", klog.KObj(policy))
+				continue
+			}
+			if !selector.Matches(labels.Set(cluster.GetLabels())) {
+				continue
+			}
+		}
+		if policyLimit := int(policy.Spec.MaxConcurrentRollouts); !found || policyLimit < limit {
+			limit, found = policyLimit, true
+		}
+	}
+	return limit, found, nil
+}
+
+// updateHeldBindingsStatus reports on the bindings held back by the cluster rollout policies that
+// their rollouts have not started yet.
+func (r *Reconciler) updateHeldBindingsStatus(ctx context.Context, heldBindings []toBeUpdatedBinding) error {
+	errs, cctx := errgroup.WithContext(ctx)
+	for i := range heldBindings {
+		binding := heldBindings[i].currentBinding
+		errs.Go(func() error {
+			cond := metav1.Condition{
+				Type:               string(placementv1beta1.ResourceBindingRolloutStarted),
+				Status:             metav1.ConditionFalse,
+				ObservedGeneration: binding.GetGeneration(),
+				Reason:             condition.RolloutWaitingForClusterRolloutPolicyReason,
+				Message: fmt.Sprintf("The resources cannot be updated to the latest because cluster %s has reached its limit of concurrent rollouts set by the cluster rollout policies",
+					binding.GetBindingSpec().TargetCluster),
+			}
+			binding.SetConditions(cond)
+			if err := r.Client.Status().Update(cctx, binding); err != nil {
+				klog.ErrorS(err, "Failed to update binding status", "binding", klog.KObj(binding), "condition", cond)
+				return controller.NewUpdateIgnoreConflictError(err)
+			}
+			klog.V(2).InfoS("Updated the status of a binding held back by the cluster rollout policies", "binding", klog.KObj(binding))
+			return nil
+		})
+	}
+	return errs.Wait()
+}
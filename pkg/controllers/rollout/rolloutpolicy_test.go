@@ -0,0 +1,324 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+func rolloutInProgressBinding(name, placementName, cluster string) *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Generation: 1,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: placementName,
+			},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         placementv1beta1.BindingStateBound,
+			TargetCluster: cluster,
+		},
+		Status: placementv1beta1.ResourceBindingStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:               string(placementv1beta1.ResourceBindingRolloutStarted),
+					Status:             metav1.ConditionTrue,
+					ObservedGeneration: 1,
+				},
+				{
+					Type:               string(placementv1beta1.ResourceBindingAvailable),
+					Status:             metav1.ConditionUnknown,
+					ObservedGeneration: 1,
+				},
+			},
+		},
+	}
+}
+
+func TestIsRolloutInProgress(t *testing.T) {
+	deletionTime := metav1.Now()
+	testCases := []struct {
+		name    string
+		mutate  func(binding *placementv1beta1.ClusterResourceBinding)
+		wantRes bool
+	}{
+		{
+			name:    "rollout started and resources not available yet",
+			mutate:  func(_ *placementv1beta1.ClusterResourceBinding) {},
+			wantRes: true,
+		},
+		{
+			name: "resources available",
+			mutate: func(binding *placementv1beta1.ClusterResourceBinding) {
+				binding.Status.Conditions[1].Status = metav1.ConditionTrue
+			},
+			wantRes: false,
+		},
+		{
+			name: "available condition of an older generation",
+			mutate: func(binding *placementv1beta1.ClusterResourceBinding) {
+				binding.Generation = 2
+				binding.Status.Conditions[0].ObservedGeneration = 2
+				binding.Status.Conditions[1].Status = metav1.ConditionTrue
+			},
+			wantRes: true,
+		},
+		{
+			name: "rollout not started",
+			mutate: func(binding *placementv1beta1.ClusterResourceBinding) {
+				binding.Status.Conditions[0].Status = metav1.ConditionFalse
+			},
+			wantRes: false,
+		},
+		{
+			name: "scheduled binding",
+			mutate: func(binding *placementv1beta1.ClusterResourceBinding) {
+				binding.Spec.State = placementv1beta1.BindingStateScheduled
+			},
+			wantRes: false,
+		},
+		{
+			name: "deleting binding",
+			mutate: func(binding *placementv1beta1.ClusterResourceBinding) {
+				binding.DeletionTimestamp = &deletionTime
+			},
+			wantRes: false,
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			binding := rolloutInProgressBinding("binding", "other-crp", cluster1)
+			tc.mutate(binding)
+			if got := isRolloutInProgress(binding); got != tc.wantRes {
+				t.Errorf("isRolloutInProgress() = %t, want %t", got, tc.wantRes)
+			}
+		})
+	}
+}
+
+func TestHoldBindingsOnBusyClusters(t *testing.T) {
+	crp := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crpName,
+		},
+	}
+	clusters := []client.Object{
+		&clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   cluster1,
+				Labels: map[string]string{"env": "prod"},
+			},
+		},
+		&clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   cluster2,
+				Labels: map[string]string{"env": "dev"},
+			},
+		},
+	}
+	prodPolicy := &placementv1beta1.ClusterRolloutPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "prod",
+		},
+		Spec: placementv1beta1.ClusterRolloutPolicySpec{
+			ClusterSelector: &metav1.LabelSelector{
+				MatchLabels: map[string]string{"env": "prod"},
+			},
+			MaxConcurrentRollouts: 1,
+		},
+	}
+	fleetPolicy := &placementv1beta1.ClusterRolloutPolicy{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "fleet",
+		},
+		Spec: placementv1beta1.ClusterRolloutPolicySpec{
+			MaxConcurrentRollouts: 2,
+		},
+	}
+	candidate := func(name, cluster string, state placementv1beta1.BindingState) *placementv1beta1.ClusterResourceBinding {
+		return &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Generation: 1,
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: crpName,
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				State:         state,
+				TargetCluster: cluster,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name             string
+		disabled         bool
+		policies         []client.Object
+		existingBindings []client.Object
+		candidates       []*placementv1beta1.ClusterResourceBinding
+		wantReady        []string
+		wantHeld         []string
+	}{
+		{
+			name:     "feature disabled",
+			disabled: true,
+			policies: []client.Object{prodPolicy},
+			existingBindings: []client.Object{
+				rolloutInProgressBinding("other-1", "other-crp", cluster1),
+			},
+			candidates: []*placementv1beta1.ClusterResourceBinding{candidate("binding-1", cluster1, placementv1beta1.BindingStateBound)},
+			wantReady:  []string{"binding-1"},
+		},
+		{
+			name: "no policies",
+			existingBindings: []client.Object{
+				rolloutInProgressBinding("other-1", "other-crp", cluster1),
+			},
+			candidates: []*placementv1beta1.ClusterResourceBinding{candidate("binding-1", cluster1, placementv1beta1.BindingStateBound)},
+			wantReady:  []string{"binding-1"},
+		},
+		{
+			name:     "binding on a busy cluster is held",
+			policies: []client.Object{prodPolicy},
+			existingBindings: []client.Object{
+				rolloutInProgressBinding("other-1", "other-crp", cluster1),
+				rolloutInProgressBinding("other-2", "other-crp", cluster2),
+			},
+			candidates: []*placementv1beta1.ClusterResourceBinding{
+				candidate("binding-1", cluster1, placementv1beta1.BindingStateBound),
+				candidate("binding-2", cluster2, placementv1beta1.BindingStateScheduled),
+			},
+			wantReady: []string{"binding-2"},
+			wantHeld:  []string{"binding-1"},
+		},
+		{
+			name:     "lowest limit among the matching policies applies",
+			policies: []client.Object{prodPolicy, fleetPolicy},
+			existingBindings: []client.Object{
+				rolloutInProgressBinding("other-1", "other-crp", cluster1),
+				rolloutInProgressBinding("other-2", "other-crp", cluster2),
+				rolloutInProgressBinding("other-3", "another-crp", cluster2),
+			},
+			candidates: []*placementv1beta1.ClusterResourceBinding{
+				candidate("binding-1", cluster1, placementv1beta1.BindingStateBound),
+				candidate("binding-2", cluster2, placementv1beta1.BindingStateBound),
+			},
+			wantHeld: []string{"binding-1", "binding-2"},
+		},
+		{
+			name:     "rollouts of the same placement do not count",
+			policies: []client.Object{prodPolicy},
+			existingBindings: []client.Object{
+				rolloutInProgressBinding("same-crp", crpName, cluster1),
+			},
+			candidates: []*placementv1beta1.ClusterResourceBinding{candidate("binding-1", cluster1, placementv1beta1.BindingStateBound)},
+			wantReady:  []string{"binding-1"},
+		},
+		{
+			name:     "unscheduled binding is never held",
+			policies: []client.Object{prodPolicy},
+			existingBindings: []client.Object{
+				rolloutInProgressBinding("other-1", "other-crp", cluster1),
+			},
+			candidates: []*placementv1beta1.ClusterResourceBinding{candidate("binding-1", cluster1, placementv1beta1.BindingStateUnscheduled)},
+			wantReady:  []string{"binding-1"},
+		},
+		{
+			name:     "binding on a cluster that has left the fleet is not held",
+			policies: []client.Object{fleetPolicy},
+			existingBindings: []client.Object{
+				rolloutInProgressBinding("other-1", "other-crp", cluster3),
+				rolloutInProgressBinding("other-2", "another-crp", cluster3),
+			},
+			candidates: []*placementv1beta1.ClusterResourceBinding{candidate("binding-1", cluster3, placementv1beta1.BindingStateBound)},
+			wantReady:  []string{"binding-1"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := append(append(append([]client.Object{}, clusters...), tc.policies...), tc.existingBindings...)
+			for _, binding := range tc.candidates {
+				objects = append(objects, binding)
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(objects...).
+				WithStatusSubresource(objects...).
+				Build()
+			r := Reconciler{
+				Client:                       fakeClient,
+				EnableClusterRolloutPolicies: !tc.disabled,
+			}
+			bindings := make([]toBeUpdatedBinding, 0, len(tc.candidates))
+			for _, binding := range tc.candidates {
+				bindings = append(bindings, toBeUpdatedBinding{currentBinding: binding})
+			}
+
+			ctx := context.Background()
+			ready, held, err := r.holdBindingsOnBusyClusters(ctx, crp, bindings)
+			if err != nil {
+				t.Fatalf("holdBindingsOnBusyClusters() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(tc.wantReady, bindingNames(ready), cmpOptions...); diff != "" {
+				t.Errorf("holdBindingsOnBusyClusters() ready bindings mismatch (-want, +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantHeld, bindingNames(held), cmpOptions...); diff != "" {
+				t.Errorf("holdBindingsOnBusyClusters() held bindings mismatch (-want, +got):\n%s", diff)
+			}
+
+			if err := r.updateHeldBindingsStatus(ctx, held); err != nil {
+				t.Fatalf("updateHeldBindingsStatus() = %v, want no error", err)
+			}
+			for _, name := range tc.wantHeld {
+				binding := &placementv1beta1.ClusterResourceBinding{}
+				if err := fakeClient.Get(ctx, client.ObjectKey{Name: name}, binding); err != nil {
+					t.Fatalf("failed to get binding: %v", err)
+				}
+				wantCond := &metav1.Condition{
+					Type:               string(placementv1beta1.ResourceBindingRolloutStarted),
+					Status:             metav1.ConditionFalse,
+					ObservedGeneration: binding.Generation,
+					Reason:             condition.RolloutWaitingForClusterRolloutPolicyReason,
+				}
+				if diff := cmp.Diff(wantCond, binding.GetCondition(string(placementv1beta1.ResourceBindingRolloutStarted)), cmpOptions...); diff != "" {
+					t.Errorf("binding %s RolloutStarted condition mismatch (-want, +got):\n%s", name, diff)
+				}
+			}
+		})
+	}
+}
+
+func bindingNames(bindings []toBeUpdatedBinding) []string {
+	names := make([]string, 0, len(bindings))
+	for _, binding := range bindings {
+		names = append(names, binding.currentBinding.GetName())
+	}
+	return names
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/annotations"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// syncSurgeClusters asks the scheduler, via the latest policy snapshot of the placement, for the
+// additional clusters that the new version of the resources is surged to before the existing
+// clusters are updated in place.
+func (r *Reconciler) syncSurgeClusters(
+	ctx context.Context,
+	placementObj placementv1beta1.PlacementObj,
+	allBindings []placementv1beta1.BindingObj,
+	masterResourceSnapshot placementv1beta1.ResourceSnapshotObj,
+) error {
+	placementKObj := klog.KObj(placementObj)
+	policySnapshots, err := controller.FetchLatestPolicySnapshot(ctx, r.Client, types.NamespacedName{Namespace: placementObj.GetNamespace(), Name: placementObj.GetName()})
+	if err != nil {
+		return controller.NewAPIServerError(true, err)
+	}
+	policySnapshotObjs := policySnapshots.GetPolicySnapshotObjs()
+	if len(policySnapshotObjs) != 1 {
+		// The placement controller has not created the policy snapshot yet.
+		klog.V(2).InfoS("No unique latest policy snapshot found for the placement, skip syncing surge clusters", "placement", placementKObj, "numberOfPolicySnapshots", len(policySnapshotObjs))
+		return nil
+	}
+	policySnapshot := policySnapshotObjs[0]
+	// An invalid annotation is overwritten with the desired value.
+	current, parseErr := annotations.ExtractNumOfSurgeClustersFromPolicySnapshot(policySnapshot)
+	if parseErr != nil {
+		klog.ErrorS(parseErr, "Failed to parse the number of surge clusters", "policySnapshot", klog.KObj(policySnapshot))
+	}
+	desired := calculateSurgeClusters(placementObj, allBindings, masterResourceSnapshot, current)
+	if parseErr == nil && desired == current {
+		return nil
+	}
+
+	policyAnnotations := policySnapshot.GetAnnotations()
+	if desired == 0 {
+		delete(policyAnnotations, placementv1beta1.NumberOfSurgeClustersAnnotation)
+	} else {
+		if policyAnnotations == nil {
+			policyAnnotations = make(map[string]string, 1)
+		}
+		policyAnnotations[placementv1beta1.NumberOfSurgeClustersAnnotation] = strconv.Itoa(desired)
+	}
+	policySnapshot.SetAnnotations(policyAnnotations)
+	if err := r.Client.Update(ctx, policySnapshot); err != nil {
+		klog.ErrorS(err, "Failed to update the number of surge clusters", "policySnapshot", klog.KObj(policySnapshot))
+		return controller.NewUpdateIgnoreConflictError(err)
+	}
+	klog.V(2).InfoS("Updated the number of surge clusters", "placement", placementKObj, "policySnapshot", klog.KObj(policySnapshot), "numberOfSurgeClusters", desired)
+	return nil
+}
+
+// calculateSurgeClusters returns the number of additional clusters the placement needs while it rolls
+// out the given resource snapshot.
+//
+// A placement surges to at most MaxSurge clusters as long as some bound bindings still run an older
+// version of the resources; once all of them are updated, the additional clusters are kept until every
+// bound binding becomes ready so that the placement never runs fewer clusters than desired.
+func calculateSurgeClusters(
+	placementObj placementv1beta1.PlacementObj,
+	allBindings []placementv1beta1.BindingObj,
+	masterResourceSnapshot placementv1beta1.ResourceSnapshotObj,
+	current int,
+) int {
+	placementSpec := placementObj.GetPlacementSpec()
+	if placementSpec.Strategy.RollingUpdate.ResourceUpdateStrategy != placementv1beta1.ResourceUpdateStrategyTypeSurgeClusters ||
+		placementSpec.Policy == nil || placementSpec.Policy.PlacementType != placementv1beta1.PickNPlacementType ||
+		placementSpec.Policy.NumberOfClusters == nil {
+		return 0
+	}
+
+	readyTimeCutOff := time.Now().Add(-time.Duration(*placementSpec.Strategy.RollingUpdate.UnavailablePeriodSeconds) * time.Second)
+	outdated := 0
+	allReady := true
+	for _, binding := range allBindings {
+		bindingSpec := binding.GetBindingSpec()
+		if bindingSpec.State != placementv1beta1.BindingStateBound || !binding.GetDeletionTimestamp().IsZero() {
+			continue
+		}
+		if bindingSpec.ResourceSnapshotName != masterResourceSnapshot.GetName() {
+			outdated++
+		}
+		if _, ready := isBindingReady(binding, readyTimeCutOff); !ready {
+			allReady = false
+		}
+	}
+	if outdated == 0 {
+		if allReady {
+			return 0
+		}
+		return current
+	}
+
+	maxSurgeNumber, _ := intstr.GetScaledValueFromIntOrPercent(placementSpec.Strategy.RollingUpdate.MaxSurge, int(*placementSpec.Policy.NumberOfClusters), true)
+	return min(maxSurgeNumber, outdated)
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rollout
+
+import (
+	"context"
+	"strconv"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	surgeTestCRPName = "test-crp"
+)
+
+func surgeCRPForTest(strategy placementv1beta1.ResourceUpdateStrategyType) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: surgeTestCRPName,
+		},
+		Spec: placementv1beta1.PlacementSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: ptr.To(int32(4)),
+			},
+			Strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				RollingUpdate: &placementv1beta1.RollingUpdateConfig{
+					MaxUnavailable:           ptr.To(intstr.FromInt(0)),
+					MaxSurge:                 ptr.To(intstr.FromInt(2)),
+					UnavailablePeriodSeconds: ptr.To(60),
+					ResourceUpdateStrategy:   strategy,
+				},
+			},
+		},
+	}
+}
+
+func surgeBindingForTest(cluster, resourceSnapshotName string, ready bool) *placementv1beta1.ClusterResourceBinding {
+	binding := &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "binding-" + cluster,
+			Generation: 1,
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:                placementv1beta1.BindingStateBound,
+			TargetCluster:        cluster,
+			ResourceSnapshotName: resourceSnapshotName,
+		},
+	}
+	if ready {
+		binding.Status.Conditions = []metav1.Condition{
+			{
+				Type:               string(placementv1beta1.ResourceBindingAvailable),
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: 1,
+				Reason:             "any",
+			},
+		}
+	}
+	return binding
+}
+
+func TestCalculateSurgeClusters(t *testing.T) {
+	masterResourceSnapshot := &placementv1beta1.ClusterResourceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "snapshot-1",
+		},
+	}
+
+	tests := map[string]struct {
+		placement placementv1beta1.PlacementObj
+		bindings  []placementv1beta1.BindingObj
+		current   int
+		want      int
+	}{
+		"in-place resource update strategy never surges": {
+			placement: surgeCRPForTest(placementv1beta1.ResourceUpdateStrategyTypeInPlace),
+			bindings: []placementv1beta1.BindingObj{
+				surgeBindingForTest("cluster-1", "snapshot-0", true),
+			},
+			want: 0,
+		},
+		"PickAll placement never surges": {
+			placement: func() placementv1beta1.PlacementObj {
+				crp := surgeCRPForTest(placementv1beta1.ResourceUpdateStrategyTypeSurgeClusters)
+				crp.Spec.Policy = &placementv1beta1.PlacementPolicy{PlacementType: placementv1beta1.PickAllPlacementType}
+				return crp
+			}(),
+			bindings: []placementv1beta1.BindingObj{
+				surgeBindingForTest("cluster-1", "snapshot-0", true),
+			},
+			want: 0,
+		},
+		"surges to the outdated clusters": {
+			placement: surgeCRPForTest(placementv1beta1.ResourceUpdateStrategyTypeSurgeClusters),
+			bindings: []placementv1beta1.BindingObj{
+				surgeBindingForTest("cluster-1", "snapshot-0", true),
+				surgeBindingForTest("cluster-2", "snapshot-1", true),
+			},
+			want: 1,
+		},
+		"surges to at most max surge clusters": {
+			placement: surgeCRPForTest(placementv1beta1.ResourceUpdateStrategyTypeSurgeClusters),
+			bindings: []placementv1beta1.BindingObj{
+				surgeBindingForTest("cluster-1", "snapshot-0", true),
+				surgeBindingForTest("cluster-2", "snapshot-0", true),
+				surgeBindingForTest("cluster-3", "snapshot-0", true),
+				surgeBindingForTest("cluster-4", "snapshot-0", true),
+			},
+			want: 2,
+		},
+		"keeps the surge clusters until all bindings are ready": {
+			placement: surgeCRPForTest(placementv1beta1.ResourceUpdateStrategyTypeSurgeClusters),
+			bindings: []placementv1beta1.BindingObj{
+				surgeBindingForTest("cluster-1", "snapshot-1", true),
+				surgeBindingForTest("cluster-2", "snapshot-1", false),
+			},
+			current: 2,
+			want:    2,
+		},
+		"releases the surge clusters once the rollout completes": {
+			placement: surgeCRPForTest(placementv1beta1.ResourceUpdateStrategyTypeSurgeClusters),
+			bindings: []placementv1beta1.BindingObj{
+				surgeBindingForTest("cluster-1", "snapshot-1", true),
+				surgeBindingForTest("cluster-2", "snapshot-1", true),
+			},
+			current: 2,
+			want:    0,
+		},
+		"ignores unscheduled bindings": {
+			placement: surgeCRPForTest(placementv1beta1.ResourceUpdateStrategyTypeSurgeClusters),
+			bindings: []placementv1beta1.BindingObj{
+				func() placementv1beta1.BindingObj {
+					binding := surgeBindingForTest("cluster-1", "snapshot-0", false)
+					binding.Spec.State = placementv1beta1.BindingStateUnscheduled
+					return binding
+				}(),
+				surgeBindingForTest("cluster-2", "snapshot-1", true),
+			},
+			current: 1,
+			want:    0,
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := calculateSurgeClusters(tt.placement, tt.bindings, masterResourceSnapshot, tt.current)
+			if got != tt.want {
+				t.Errorf("calculateSurgeClusters() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestSyncSurgeClusters(t *testing.T) {
+	masterResourceSnapshot := &placementv1beta1.ClusterResourceSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "snapshot-1",
+		},
+	}
+	policySnapshotForTest := func(surgeAnnotation string) *placementv1beta1.ClusterSchedulingPolicySnapshot {
+		policySnapshot := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: surgeTestCRPName + "-0",
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: surgeTestCRPName,
+					placementv1beta1.IsLatestSnapshotLabel:  strconv.FormatBool(true),
+				},
+				Annotations: map[string]string{
+					placementv1beta1.NumberOfClustersAnnotation: "4",
+				},
+			},
+		}
+		if surgeAnnotation != "" {
+			policySnapshot.Annotations[placementv1beta1.NumberOfSurgeClustersAnnotation] = surgeAnnotation
+		}
+		return policySnapshot
+	}
+
+	tests := map[string]struct {
+		policySnapshot  *placementv1beta1.ClusterSchedulingPolicySnapshot
+		bindings        []placementv1beta1.BindingObj
+		wantAnnotations map[string]string
+	}{
+		"sets the number of surge clusters": {
+			policySnapshot: policySnapshotForTest(""),
+			bindings: []placementv1beta1.BindingObj{
+				surgeBindingForTest("cluster-1", "snapshot-0", true),
+			},
+			wantAnnotations: map[string]string{
+				placementv1beta1.NumberOfClustersAnnotation:      "4",
+				placementv1beta1.NumberOfSurgeClustersAnnotation: "1",
+			},
+		},
+		"removes the number of surge clusters once the rollout completes": {
+			policySnapshot: policySnapshotForTest("2"),
+			bindings: []placementv1beta1.BindingObj{
+				surgeBindingForTest("cluster-1", "snapshot-1", true),
+			},
+			wantAnnotations: map[string]string{
+				placementv1beta1.NumberOfClustersAnnotation: "4",
+			},
+		},
+		"overwrites an invalid number of surge clusters": {
+			policySnapshot: policySnapshotForTest("abc"),
+			bindings: []placementv1beta1.BindingObj{
+				surgeBindingForTest("cluster-1", "snapshot-1", true),
+			},
+			wantAnnotations: map[string]string{
+				placementv1beta1.NumberOfClustersAnnotation: "4",
+			},
+		},
+	}
+
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(tt.policySnapshot).
+				Build()
+			r := Reconciler{Client: fakeClient}
+			crp := surgeCRPForTest(placementv1beta1.ResourceUpdateStrategyTypeSurgeClusters)
+			if err := r.syncSurgeClusters(context.Background(), crp, tt.bindings, masterResourceSnapshot); err != nil {
+				t.Fatalf("syncSurgeClusters() got error %v, want no error", err)
+			}
+
+			var got placementv1beta1.ClusterSchedulingPolicySnapshot
+			if err := fakeClient.Get(context.Background(), types.NamespacedName{Name: tt.policySnapshot.Name}, &got); err != nil {
+				t.Fatalf("failed to get the policy snapshot: %v", err)
+			}
+			if diff := cmp.Diff(tt.wantAnnotations, got.GetAnnotations()); diff != "" {
+				t.Errorf("syncSurgeClusters() policy snapshot annotations mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,478 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package statusexporter features a runnable that periodically archives the statuses of placements and
+// the rollout histories of their bindings to an object storage service (S3 or Azure Blob Storage) in the
+// JSON Lines format, for long-term retention, compliance, and analytics purposes.
+package statusexporter
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"maps"
+	"strconv"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	hubmetrics "github.com/kubefleet-dev/kubefleet/pkg/metrics/hub"
+)
+
+const (
+	// bookmarkConfigMapNamePrefix is the prefix of the names of the config maps in which the exporter
+	// keeps its bookmarks, i.e., what has been exported for each placement and its bindings, so that
+	// nothing is exported twice (barring failures to update the bookmarks after a successful upload).
+	// Each placement has its own config map, so that the bookmarks never outgrow the size limit of
+	// config maps; the name ends with the hash of the kind, namespace, and name of the placement.
+	bookmarkConfigMapNamePrefix = "status-export-bookmarks-"
+
+	// legacyBookmarkConfigMapName is the name of the config map in which earlier versions of the
+	// exporter kept the bookmarks of all placements. Its bookmarks are used for the placements that
+	// have no config map of their own yet, and it is deleted once all the placements have one.
+	legacyBookmarkConfigMapName = "fleet-status-export-bookmarks"
+
+	// objectKeyTimeFormat is the format of the time in the keys of the exported objects.
+	objectKeyTimeFormat = "20060102T150405.000000000Z"
+)
+
+// RecordType is the type of an exported record.
+type RecordType string
+
+const (
+	// RecordTypePlacementStatus is the type of records that hold the status of a placement.
+	RecordTypePlacementStatus RecordType = "PlacementStatus"
+	// RecordTypeRolloutHistory is the type of records that hold an entry of the rollout history of a binding.
+	RecordTypeRolloutHistory RecordType = "RolloutHistory"
+)
+
+// Record is a line in the exported JSON Lines objects.
+type Record struct {
+	// Type is the type of the record.
+	Type RecordType `json:"type"`
+	// ExportTime is when the record was exported.
+	ExportTime metav1.Time `json:"exportTime"`
+
+	// The placement that the record concerns.
+	PlacementKind      string    `json:"placementKind"`
+	PlacementNamespace string    `json:"placementNamespace,omitempty"`
+	PlacementName      string    `json:"placementName"`
+	PlacementUID       types.UID `json:"placementUID,omitempty"`
+
+	// The resource version and generation of the placement; set for placement status records only.
+	// Together with the UID, the resource version identifies a placement status record uniquely.
+	ResourceVersion string `json:"resourceVersion,omitempty"`
+	Generation      int64  `json:"generation,omitempty"`
+	// Status is the status of the placement; set for placement status records only.
+	Status *placementv1beta1.PlacementStatus `json:"status,omitempty"`
+
+	// The binding and its target cluster; set for rollout history records only.
+	BindingName string `json:"bindingName,omitempty"`
+	ClusterName string `json:"clusterName,omitempty"`
+	// HistoryEntry is an entry of the rollout history of the binding; set for rollout history records only.
+	HistoryEntry *placementv1beta1.BindingHistoryEntry `json:"historyEntry,omitempty"`
+}
+
+// make sure that our Exporter implements controller runtime interfaces
+var (
+	_ manager.Runnable               = &Exporter{}
+	_ manager.LeaderElectionRunnable = &Exporter{}
+)
+
+// Exporter periodically exports the placement statuses that have changed, and the rollout history
+// entries that have been added, since the last export.
+//
+// Each export uploads one object of the key PREFIX/YYYY/MM/DD/TIMESTAMP.jsonl, which holds one record
+// per line; no object is uploaded if nothing has changed.
+type Exporter struct {
+	// Client is the client the exporter uses to read placements and bindings, and to write the bookmarks.
+	Client client.Client
+
+	// APIReader is the reader the exporter uses to read the bookmarks, bypassing the cache.
+	APIReader client.Reader
+
+	// Store is the object storage service to which the records are exported.
+	Store ObjectStore
+
+	// Interval is the period between two exports.
+	Interval time.Duration
+
+	// BookmarkNamespace is the namespace of the config maps that keep the bookmarks.
+	BookmarkNamespace string
+
+	// EnableResourcePlacement, if set, makes the exporter also export the statuses of namespace-scoped
+	// ResourcePlacements and the rollout histories of their bindings.
+	EnableResourcePlacement bool
+
+	// lastExportTime is when the records were last exported successfully.
+	lastExportTime time.Time
+	nowFunc        func() time.Time
+}
+
+// Start runs the exporter until the context is cancelled. This is called by the controller manager.
+func (e *Exporter) Start(ctx context.Context) error {
+	klog.InfoS("Starting the placement status exporter", "interval", e.Interval)
+	defer klog.Info("The placement status exporter is stopped")
+
+	e.lastExportTime = e.now()
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := e.export(ctx); err != nil {
+			klog.ErrorS(err, "Failed to export the placement statuses")
+			hubmetrics.FleetStatusExportFailureTotal.Inc()
+		} else {
+			e.lastExportTime = e.now()
+		}
+		hubmetrics.FleetStatusExportLagSeconds.Set(e.now().Sub(e.lastExportTime).Seconds())
+	}, e.Interval)
+	return nil
+}
+
+// NeedLeaderElection implements the LeaderElectionRunnable interface, so that only the leader
+// hub agent exports the placement statuses.
+func (e *Exporter) NeedLeaderElection() bool {
+	return true
+}
+
+func (e *Exporter) now() time.Time {
+	if e.nowFunc != nil {
+		return e.nowFunc()
+	}
+	return time.Now()
+}
+
+// export runs one round of export.
+func (e *Exporter) export(ctx context.Context) error {
+	startTime := e.now()
+	klog.V(2).InfoS("Placement status export starts")
+	defer func() {
+		klog.V(2).InfoS("Placement status export ends", "latency", time.Since(startTime).Milliseconds())
+	}()
+
+	bookmarkCMs, legacyBookmarks, err := e.getBookmarks(ctx)
+	if err != nil {
+		return err
+	}
+	exportTime := metav1.NewTime(startTime)
+
+	placements, err := e.listPlacements(ctx)
+	if err != nil {
+		return err
+	}
+	// newBookmarks are the bookmarks to keep after the export, keyed by the names of the config maps.
+	newBookmarks := make(map[string]map[string]string, len(placements))
+	// oldBookmarks are the bookmarks of the last export, keyed by the names of the config maps.
+	oldBookmarks := make(map[string]map[string]string, len(placements))
+	records := make([]Record, 0)
+	for _, placement := range placements {
+		kind := placementKindOf(placement)
+		cmName := bookmarkConfigMapName(kind, placement.GetNamespace(), placement.GetName())
+		oldBookmarks[cmName] = legacyBookmarks
+		if cm, ok := bookmarkCMs[cmName]; ok {
+			oldBookmarks[cmName] = cm.Data
+		}
+		key := bookmarkKey(kind, placement.GetNamespace(), placement.GetName())
+		newBookmarks[cmName] = map[string]string{key: placement.GetResourceVersion()}
+		if oldBookmarks[cmName][key] == placement.GetResourceVersion() {
+			continue
+		}
+		records = append(records, Record{
+			Type:               RecordTypePlacementStatus,
+			ExportTime:         exportTime,
+			PlacementKind:      kind,
+			PlacementNamespace: placement.GetNamespace(),
+			PlacementName:      placement.GetName(),
+			PlacementUID:       placement.GetUID(),
+			ResourceVersion:    placement.GetResourceVersion(),
+			Generation:         placement.GetGeneration(),
+			Status:             placement.GetPlacementStatus(),
+		})
+	}
+
+	bindings, err := e.listBindings(ctx)
+	if err != nil {
+		return err
+	}
+	for _, binding := range bindings {
+		kind := placementv1beta1.ClusterResourceBindingKind
+		placementKind := placementv1beta1.ClusterResourcePlacementKind
+		if binding.GetNamespace() != "" {
+			kind = placementv1beta1.ResourceBindingKind
+			placementKind = placementv1beta1.ResourcePlacementKind
+		}
+		placementName := binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+		cmName := bookmarkConfigMapName(placementKind, binding.GetNamespace(), placementName)
+		if _, ok := newBookmarks[cmName]; !ok {
+			// The placement is gone; the binding is about to be deleted along with it.
+			continue
+		}
+		key := bookmarkKey(kind, binding.GetNamespace(), binding.GetName())
+		history := binding.GetBindingStatus().History
+		newEntries, bookmark := historyEntriesAfter(history, oldBookmarks[cmName][key])
+		if bookmark != "" {
+			newBookmarks[cmName][key] = bookmark
+		}
+		for idx := range newEntries {
+			records = append(records, Record{
+				Type:               RecordTypeRolloutHistory,
+				ExportTime:         exportTime,
+				PlacementKind:      placementKind,
+				PlacementNamespace: binding.GetNamespace(),
+				PlacementName:      placementName,
+				BindingName:        binding.GetName(),
+				ClusterName:        binding.GetBindingSpec().TargetCluster,
+				HistoryEntry:       &newEntries[idx],
+			})
+		}
+	}
+
+	if len(records) > 0 {
+		content, err := encodeRecords(records)
+		if err != nil {
+			return err
+		}
+		objKey := fmt.Sprintf("%s/%s.jsonl", startTime.UTC().Format("2006/01/02"), startTime.UTC().Format(objectKeyTimeFormat))
+		if err := e.Store.PutObject(ctx, objKey, content); err != nil {
+			klog.ErrorS(err, "Failed to upload the placement status records", "object", objKey, "records", len(records))
+			return err
+		}
+		for _, r := range records {
+			hubmetrics.FleetStatusExportRecordTotal.WithLabelValues(string(r.Type)).Inc()
+		}
+		klog.V(2).InfoS("Exported the placement status records", "object", objKey, "records", len(records))
+	}
+
+	// Update the bookmarks; the bookmarks of the placements and bindings that no longer exist are dropped.
+	return e.updateBookmarks(ctx, bookmarkCMs, legacyBookmarks != nil, newBookmarks)
+}
+
+// getBookmarks returns the config maps that keep the bookmarks, keyed by their names, along with the
+// bookmarks kept in the legacy config map, which are nil if the legacy config map does not exist.
+func (e *Exporter) getBookmarks(ctx context.Context) (map[string]*corev1.ConfigMap, map[string]string, error) {
+	cmList := &corev1.ConfigMapList{}
+	if err := e.APIReader.List(ctx, cmList, client.InNamespace(e.BookmarkNamespace), client.HasLabels{placementv1beta1.StatusExportBookmarksLabel}); err != nil {
+		klog.ErrorS(err, "Failed to list the bookmarks of the placement status exporter")
+		return nil, nil, err
+	}
+	cms := make(map[string]*corev1.ConfigMap, len(cmList.Items))
+	for idx := range cmList.Items {
+		cms[cmList.Items[idx].Name] = &cmList.Items[idx]
+	}
+
+	legacyCM := &corev1.ConfigMap{}
+	err := e.APIReader.Get(ctx, types.NamespacedName{Namespace: e.BookmarkNamespace, Name: legacyBookmarkConfigMapName}, legacyCM)
+	switch {
+	case k8serrors.IsNotFound(err):
+		return cms, nil, nil
+	case err != nil:
+		klog.ErrorS(err, "Failed to get the legacy bookmarks of the placement status exporter")
+		return nil, nil, err
+	}
+	if legacyCM.Data == nil {
+		return cms, map[string]string{}, nil
+	}
+	return cms, legacyCM.Data, nil
+}
+
+// updateBookmarks creates or updates the config maps that keep the bookmarks of the existing placements,
+// and deletes the ones of the placements that no longer exist, along with the legacy config map.
+func (e *Exporter) updateBookmarks(ctx context.Context, cms map[string]*corev1.ConfigMap, hasLegacy bool, bookmarks map[string]map[string]string) error {
+	for cmName, data := range bookmarks {
+		cm, ok := cms[cmName]
+		var err error
+		switch {
+		case !ok:
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: e.BookmarkNamespace,
+					Name:      cmName,
+					Labels: map[string]string{
+						placementv1beta1.StatusExportBookmarksLabel: "true",
+					},
+				},
+				Data: data,
+			}
+			err = e.Client.Create(ctx, cm)
+		case maps.Equal(cm.Data, data):
+			// Skip the update as the bookmarks have not changed.
+			continue
+		default:
+			cm.Data = data
+			err = e.Client.Update(ctx, cm)
+		}
+		if err != nil {
+			klog.ErrorS(err, "Failed to update the bookmarks of the placement status exporter", "configMap", klog.KObj(cm))
+			return err
+		}
+	}
+
+	for cmName, cm := range cms {
+		if _, ok := bookmarks[cmName]; ok {
+			continue
+		}
+		if err := e.Client.Delete(ctx, cm); err != nil && !k8serrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete the bookmarks of the placement status exporter", "configMap", klog.KObj(cm))
+			return err
+		}
+	}
+
+	if hasLegacy {
+		legacyCM := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: e.BookmarkNamespace,
+				Name:      legacyBookmarkConfigMapName,
+			},
+		}
+		if err := e.Client.Delete(ctx, legacyCM); err != nil && !k8serrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete the legacy bookmarks of the placement status exporter", "configMap", klog.KObj(legacyCM))
+			return err
+		}
+	}
+	return nil
+}
+
+// listPlacements returns all the placements whose statuses are exported.
+func (e *Exporter) listPlacements(ctx context.Context) ([]placementv1beta1.PlacementObj, error) {
+	crpList := &placementv1beta1.ClusterResourcePlacementList{}
+	if err := e.Client.List(ctx, crpList); err != nil {
+		klog.ErrorS(err, "Failed to list clusterResourcePlacements")
+		return nil, err
+	}
+	placements := crpList.GetPlacementObjs()
+	if !e.EnableResourcePlacement {
+		return placements, nil
+	}
+	rpList := &placementv1beta1.ResourcePlacementList{}
+	if err := e.Client.List(ctx, rpList); err != nil {
+		klog.ErrorS(err, "Failed to list resourcePlacements")
+		return nil, err
+	}
+	return append(placements, rpList.GetPlacementObjs()...), nil
+}
+
+// listBindings returns all the bindings whose rollout histories are exported.
+func (e *Exporter) listBindings(ctx context.Context) ([]placementv1beta1.BindingObj, error) {
+	crbList := &placementv1beta1.ClusterResourceBindingList{}
+	if err := e.Client.List(ctx, crbList); err != nil {
+		klog.ErrorS(err, "Failed to list clusterResourceBindings")
+		return nil, err
+	}
+	bindings := crbList.GetBindingObjs()
+	if !e.EnableResourcePlacement {
+		return bindings, nil
+	}
+	rbList := &placementv1beta1.ResourceBindingList{}
+	if err := e.Client.List(ctx, rbList); err != nil {
+		klog.ErrorS(err, "Failed to list resourceBindings")
+		return nil, err
+	}
+	return append(bindings, rbList.GetBindingObjs()...), nil
+}
+
+// placementKindOf returns the kind of a placement.
+func placementKindOf(placement placementv1beta1.PlacementObj) string {
+	if placement.GetNamespace() != "" {
+		return placementv1beta1.ResourcePlacementKind
+	}
+	return placementv1beta1.ClusterResourcePlacementKind
+}
+
+// bookmarkConfigMapName returns the name of the config map that keeps the bookmarks of a placement
+// and its bindings.
+func bookmarkConfigMapName(placementKind, namespace, name string) string {
+	return fmt.Sprintf("%s%x", bookmarkConfigMapNamePrefix, sha256.Sum256([]byte(bookmarkKey(placementKind, namespace, name))))
+}
+
+// bookmarkKey returns the key of the bookmark of an object in a bookmark config map.
+func bookmarkKey(kind, namespace, name string) string {
+	if namespace == "" {
+		return fmt.Sprintf("%s.%s", kind, name)
+	}
+	return fmt.Sprintf("%s.%s.%s", kind, namespace, name)
+}
+
+// historyEntriesAfter returns the history entries that have not been exported per the bookmark, and
+// the new bookmark.
+//
+// A history bookmark is of the format TIME/COUNT, where TIME is the time of the latest exported entry
+// and COUNT is the number of exported entries that share that time, as history entries are recorded
+// with a precision of seconds only. An empty bookmark is returned if the history is empty.
+func historyEntriesAfter(history []placementv1beta1.BindingHistoryEntry, bookmark string) ([]placementv1beta1.BindingHistoryEntry, string) {
+	if len(history) == 0 {
+		return nil, ""
+	}
+
+	var bookmarkTime time.Time
+	bookmarkCount := 0
+	if timeStr, countStr, found := strings.Cut(bookmark, "/"); found {
+		t, timeErr := time.Parse(time.RFC3339, timeStr)
+		c, countErr := strconv.Atoi(countStr)
+		if timeErr == nil && countErr == nil {
+			bookmarkTime, bookmarkCount = t, c
+		}
+	}
+
+	newEntries := make([]placementv1beta1.BindingHistoryEntry, 0, len(history))
+	seenAtBookmarkTime := 0
+	for _, entry := range history {
+		switch {
+		case entry.Time.Time.Before(bookmarkTime):
+			continue
+		case entry.Time.Time.Equal(bookmarkTime):
+			seenAtBookmarkTime++
+			if seenAtBookmarkTime <= bookmarkCount {
+				continue
+			}
+		}
+		newEntries = append(newEntries, entry)
+	}
+
+	// The entries are kept in chronological order; the last one is the latest.
+	latestTime := history[len(history)-1].Time.Time
+	latestCount := 0
+	for _, entry := range history {
+		if entry.Time.Time.Equal(latestTime) {
+			latestCount++
+		}
+	}
+	if latestTime.Equal(bookmarkTime) && bookmarkCount > latestCount {
+		// The older entries of the same time have been dropped from the bounded history.
+		latestCount = bookmarkCount
+	}
+	return newEntries, fmt.Sprintf("%s/%d", latestTime.UTC().Format(time.RFC3339), latestCount)
+}
+
+// encodeRecords encodes the records in the JSON Lines format.
+func encodeRecords(records []Record) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	encoder := json.NewEncoder(buf)
+	for idx := range records {
+		if err := encoder.Encode(&records[idx]); err != nil {
+			return nil, fmt.Errorf("failed to encode the placement status record: %w", err)
+		}
+	}
+	return buf.Bytes(), nil
+}
@@ -0,0 +1,358 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statusexporter
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	crpName     = "crp-1"
+	bindingName = "crp-1-member-1"
+	clusterName = "member-1"
+	bookmarkNS  = "fleet-system"
+)
+
+// fakeObjectStore keeps the uploaded objects in memory.
+type fakeObjectStore struct {
+	objects map[string][]byte
+}
+
+func (s *fakeObjectStore) PutObject(_ context.Context, key string, content []byte) error {
+	s.objects[key] = content
+	return nil
+}
+
+func historyEntry(t time.Time, entryType placementv1beta1.BindingHistoryEntryType) placementv1beta1.BindingHistoryEntry {
+	return placementv1beta1.BindingHistoryEntry{
+		Type: entryType,
+		Time: metav1.NewTime(t),
+	}
+}
+
+// TestHistoryEntriesAfter tests the historyEntriesAfter function.
+func TestHistoryEntriesAfter(t *testing.T) {
+	t1 := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	t2 := t1.Add(time.Second)
+	entry1 := historyEntry(t1, placementv1beta1.BindingHistoryEntryTypeStateChanged)
+	entry2 := historyEntry(t2, placementv1beta1.BindingHistoryEntryTypeResourceSnapshotChanged)
+	entry3 := historyEntry(t2, placementv1beta1.BindingHistoryEntryTypeRolloutCompleted)
+
+	testCases := []struct {
+		name         string
+		history      []placementv1beta1.BindingHistoryEntry
+		bookmark     string
+		wantEntries  []placementv1beta1.BindingHistoryEntry
+		wantBookmark string
+	}{
+		{
+			name: "empty history",
+		},
+		{
+			name:         "no bookmark",
+			history:      []placementv1beta1.BindingHistoryEntry{entry1, entry2},
+			wantEntries:  []placementv1beta1.BindingHistoryEntry{entry1, entry2},
+			wantBookmark: "2025-01-02T03:04:06Z/1",
+		},
+		{
+			name:         "invalid bookmark",
+			history:      []placementv1beta1.BindingHistoryEntry{entry1},
+			bookmark:     "invalid",
+			wantEntries:  []placementv1beta1.BindingHistoryEntry{entry1},
+			wantBookmark: "2025-01-02T03:04:05Z/1",
+		},
+		{
+			name:         "entries of the same time as the bookmark",
+			history:      []placementv1beta1.BindingHistoryEntry{entry1, entry2, entry3},
+			bookmark:     "2025-01-02T03:04:06Z/1",
+			wantEntries:  []placementv1beta1.BindingHistoryEntry{entry3},
+			wantBookmark: "2025-01-02T03:04:06Z/2",
+		},
+		{
+			name:         "all exported",
+			history:      []placementv1beta1.BindingHistoryEntry{entry1, entry2, entry3},
+			bookmark:     "2025-01-02T03:04:06Z/2",
+			wantEntries:  []placementv1beta1.BindingHistoryEntry{},
+			wantBookmark: "2025-01-02T03:04:06Z/2",
+		},
+		{
+			name:         "exported entries of the same time dropped from the history",
+			history:      []placementv1beta1.BindingHistoryEntry{entry3},
+			bookmark:     "2025-01-02T03:04:06Z/2",
+			wantEntries:  []placementv1beta1.BindingHistoryEntry{},
+			wantBookmark: "2025-01-02T03:04:06Z/2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotEntries, gotBookmark := historyEntriesAfter(tc.history, tc.bookmark)
+			if diff := cmp.Diff(gotEntries, tc.wantEntries); diff != "" {
+				t.Errorf("historyEntriesAfter() entries mismatch (-got, +want):\n%s", diff)
+			}
+			if gotBookmark != tc.wantBookmark {
+				t.Errorf("historyEntriesAfter() bookmark = %s, want %s", gotBookmark, tc.wantBookmark)
+			}
+		})
+	}
+}
+
+// TestExport tests that the exporter exports only the placement statuses and the rollout history
+// entries that have not been exported yet.
+func TestExport(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	entry1 := historyEntry(now.Add(-time.Minute), placementv1beta1.BindingHistoryEntryTypeStateChanged)
+	entry2 := historyEntry(now, placementv1beta1.BindingHistoryEntryTypeRolloutCompleted)
+
+	crp := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       crpName,
+			Generation: 1,
+		},
+		Status: placementv1beta1.PlacementStatus{
+			ObservedResourceIndex: "0",
+		},
+	}
+	binding := &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: bindingName,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: crpName,
+			},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			TargetCluster: clusterName,
+		},
+		Status: placementv1beta1.ResourceBindingStatus{
+			History: []placementv1beta1.BindingHistoryEntry{entry1},
+		},
+	}
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme() = %v, want no error", err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme() = %v, want no error", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(crp, binding).Build()
+	store := &fakeObjectStore{objects: map[string][]byte{}}
+	e := &Exporter{
+		Client:            fakeClient,
+		APIReader:         fakeClient,
+		Store:             store,
+		BookmarkNamespace: bookmarkNS,
+		nowFunc:           func() time.Time { return now },
+	}
+
+	// The first export exports the placement status and the rollout history entry.
+	if err := e.export(ctx); err != nil {
+		t.Fatalf("export() = %v, want no error", err)
+	}
+	wantKey := "2025/01/02/20250102T030405.000000000Z.jsonl"
+	gotRecords := decodeRecords(t, store.objects[wantKey])
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: crpName}, crp); err != nil {
+		t.Fatalf("failed to get the placement: %v", err)
+	}
+	wantRecords := []Record{
+		{
+			Type:            RecordTypePlacementStatus,
+			ExportTime:      metav1.NewTime(now),
+			PlacementKind:   placementv1beta1.ClusterResourcePlacementKind,
+			PlacementName:   crpName,
+			ResourceVersion: crp.ResourceVersion,
+			Generation:      1,
+			Status:          &crp.Status,
+		},
+		{
+			Type:          RecordTypeRolloutHistory,
+			ExportTime:    metav1.NewTime(now),
+			PlacementKind: placementv1beta1.ClusterResourcePlacementKind,
+			PlacementName: crpName,
+			BindingName:   bindingName,
+			ClusterName:   clusterName,
+			HistoryEntry:  &entry1,
+		},
+	}
+	if diff := cmp.Diff(gotRecords, wantRecords); diff != "" {
+		t.Errorf("exported records mismatch (-got, +want):\n%s", diff)
+	}
+
+	// The second export exports nothing, as nothing has changed.
+	delete(store.objects, wantKey)
+	now = now.Add(time.Minute)
+	if err := e.export(ctx); err != nil {
+		t.Fatalf("export() = %v, want no error", err)
+	}
+	if len(store.objects) != 0 {
+		t.Errorf("exported objects = %d, want 0", len(store.objects))
+	}
+
+	// The third export exports the new rollout history entry only.
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: bindingName}, binding); err != nil {
+		t.Fatalf("failed to get the binding: %v", err)
+	}
+	binding.Status.History = append(binding.Status.History, entry2)
+	if err := fakeClient.Update(ctx, binding); err != nil {
+		t.Fatalf("failed to update the binding: %v", err)
+	}
+	now = now.Add(time.Minute)
+	if err := e.export(ctx); err != nil {
+		t.Fatalf("export() = %v, want no error", err)
+	}
+	gotRecords = decodeRecords(t, store.objects["2025/01/02/20250102T030605.000000000Z.jsonl"])
+	wantRecords = []Record{
+		{
+			Type:          RecordTypeRolloutHistory,
+			ExportTime:    metav1.NewTime(now),
+			PlacementKind: placementv1beta1.ClusterResourcePlacementKind,
+			PlacementName: crpName,
+			BindingName:   bindingName,
+			ClusterName:   clusterName,
+			HistoryEntry:  &entry2,
+		},
+	}
+	if diff := cmp.Diff(gotRecords, wantRecords); diff != "" {
+		t.Errorf("exported records mismatch (-got, +want):\n%s", diff)
+	}
+}
+
+// TestExport_Bookmarks tests that the exporter keeps the bookmarks of each placement in a config map of
+// its own, picks up the bookmarks of the legacy config map, and drops the bookmarks of deleted placements.
+func TestExport_Bookmarks(t *testing.T) {
+	ctx := context.Background()
+	now := time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC)
+	entry := historyEntry(now, placementv1beta1.BindingHistoryEntryTypeRolloutCompleted)
+
+	crp := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            crpName,
+			ResourceVersion: "10",
+		},
+	}
+	binding := &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: bindingName,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: crpName,
+			},
+		},
+		Status: placementv1beta1.ResourceBindingStatus{
+			History: []placementv1beta1.BindingHistoryEntry{entry},
+		},
+	}
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme() = %v, want no error", err)
+	}
+	if err := corev1.AddToScheme(scheme); err != nil {
+		t.Fatalf("AddToScheme() = %v, want no error", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(crp, binding).Build()
+	if err := fakeClient.Get(ctx, types.NamespacedName{Name: crpName}, crp); err != nil {
+		t.Fatalf("failed to get the placement: %v", err)
+	}
+	crpKey := bookmarkKey(placementv1beta1.ClusterResourcePlacementKind, "", crpName)
+	bindingKey := bookmarkKey(placementv1beta1.ClusterResourceBindingKind, "", bindingName)
+	wantBookmarks := map[string]string{
+		crpKey:     crp.ResourceVersion,
+		bindingKey: "2025-01-02T03:04:05Z/1",
+	}
+	legacyCM := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: bookmarkNS,
+			Name:      legacyBookmarkConfigMapName,
+		},
+		Data: wantBookmarks,
+	}
+	staleCM := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: bookmarkNS,
+			Name:      bookmarkConfigMapName(placementv1beta1.ClusterResourcePlacementKind, "", "deleted-crp"),
+			Labels: map[string]string{
+				placementv1beta1.StatusExportBookmarksLabel: "true",
+			},
+		},
+	}
+	for _, cm := range []*corev1.ConfigMap{legacyCM, staleCM} {
+		if err := fakeClient.Create(ctx, cm); err != nil {
+			t.Fatalf("failed to create config map %s: %v", cm.Name, err)
+		}
+	}
+	store := &fakeObjectStore{objects: map[string][]byte{}}
+	e := &Exporter{
+		Client:            fakeClient,
+		APIReader:         fakeClient,
+		Store:             store,
+		BookmarkNamespace: bookmarkNS,
+		nowFunc:           func() time.Time { return now },
+	}
+
+	// Nothing is exported, as the legacy bookmarks show that everything has been exported.
+	if err := e.export(ctx); err != nil {
+		t.Fatalf("export() = %v, want no error", err)
+	}
+	if len(store.objects) != 0 {
+		t.Errorf("exported objects = %d, want 0", len(store.objects))
+	}
+
+	cm := &corev1.ConfigMap{}
+	cmKey := types.NamespacedName{Namespace: bookmarkNS, Name: bookmarkConfigMapName(placementv1beta1.ClusterResourcePlacementKind, "", crpName)}
+	if err := fakeClient.Get(ctx, cmKey, cm); err != nil {
+		t.Fatalf("failed to get the bookmarks of the placement: %v", err)
+	}
+	if _, ok := cm.Labels[placementv1beta1.StatusExportBookmarksLabel]; !ok {
+		t.Errorf("bookmark config map labels = %v, want label %s", cm.Labels, placementv1beta1.StatusExportBookmarksLabel)
+	}
+	if diff := cmp.Diff(cm.Data, wantBookmarks); diff != "" {
+		t.Errorf("bookmarks mismatch (-got, +want):\n%s", diff)
+	}
+	for _, deleted := range []*corev1.ConfigMap{legacyCM, staleCM} {
+		if err := fakeClient.Get(ctx, client.ObjectKeyFromObject(deleted), &corev1.ConfigMap{}); !k8serrors.IsNotFound(err) {
+			t.Errorf("get config map %s = %v, want not found", deleted.Name, err)
+		}
+	}
+}
+
+func decodeRecords(t *testing.T, content []byte) []Record {
+	records := make([]Record, 0)
+	scanner := bufio.NewScanner(bytes.NewReader(content))
+	for scanner.Scan() {
+		r := Record{}
+		if err := json.Unmarshal(scanner.Bytes(), &r); err != nil {
+			t.Fatalf("failed to decode the record %s: %v", scanner.Text(), err)
+		}
+		records = append(records, r)
+	}
+	return records
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statusexporter
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path"
+	"strings"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/to"
+	"github.com/Azure/azure-sdk-for-go/sdk/azidentity"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/blockblob"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	awsconfig "github.com/aws/aws-sdk-go-v2/config"
+	"github.com/aws/aws-sdk-go-v2/service/s3"
+)
+
+const (
+	// AzureStorageSASTokenEnvVar holds a SAS token for Azure Blob Storage destinations; if not set,
+	// the default Azure credential chain (e.g., workload identity or managed identity) is used.
+	AzureStorageSASTokenEnvVar = "AZURE_STORAGE_SAS_TOKEN"
+
+	azureBlobHostSuffix = ".blob.core.windows.net"
+
+	jsonLinesContentType = "application/x-ndjson"
+)
+
+// ObjectStore is an object storage service to which the exporter uploads the archives.
+type ObjectStore interface {
+	// PutObject uploads an object with the given key (relative to the destination prefix), replacing
+	// the existing object with the same key, if any.
+	PutObject(ctx context.Context, key string, content []byte) error
+}
+
+// NewObjectStore returns an object store for the given destination, which is either an S3 URL of the
+// format s3://BUCKET[/PREFIX], or an Azure Blob Storage URL of the format
+// https://ACCOUNT.blob.core.windows.net/CONTAINER[/PREFIX].
+//
+// For S3 destinations, the region is required; the endpoint is optional and defaults to the regional
+// AWS endpoint, which allows the use of S3-compatible services. The credentials are resolved with the
+// default AWS credential chain, i.e., from the environment variables, the shared config files, a web
+// identity token (e.g., IRSA or EKS Pod Identity), or the instance profile.
+//
+// For Azure Blob Storage destinations, a SAS token is used if set in the AZURE_STORAGE_SAS_TOKEN
+// environment variable; otherwise, the credentials are resolved with the default Azure credential chain.
+func NewObjectStore(ctx context.Context, destination, s3Region, s3Endpoint string) (ObjectStore, error) {
+	d, err := parseDestination(destination)
+	if err != nil {
+		return nil, err
+	}
+	if d.isS3 {
+		return newS3Store(ctx, d.location, d.prefix, s3Region, s3Endpoint)
+	}
+	return newAzureBlobStore(d.location, d.prefix)
+}
+
+// ValidateDestination checks if a destination is in one of the supported formats.
+func ValidateDestination(destination string) error {
+	_, err := parseDestination(destination)
+	return err
+}
+
+// parsedDestination is a parsed destination.
+type parsedDestination struct {
+	// isS3 is set for S3 destinations.
+	isS3 bool
+	// location is the bucket name for S3 destinations, and the container URL for Azure Blob Storage
+	// destinations.
+	location string
+	// prefix is the prefix of the keys of the uploaded objects.
+	prefix string
+}
+
+func parseDestination(destination string) (*parsedDestination, error) {
+	u, err := url.Parse(destination)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the destination %q: %w", destination, err)
+	}
+	switch {
+	case u.Scheme == "s3" && u.Host != "":
+		return &parsedDestination{isS3: true, location: u.Host, prefix: strings.Trim(u.Path, "/")}, nil
+	case u.Scheme == "https" && strings.HasSuffix(u.Host, azureBlobHostSuffix):
+		container, prefix, _ := strings.Cut(strings.Trim(u.Path, "/"), "/")
+		if container == "" {
+			return nil, fmt.Errorf("no container is specified in the destination %q", destination)
+		}
+		return &parsedDestination{location: fmt.Sprintf("https://%s/%s", u.Host, container), prefix: prefix}, nil
+	default:
+		return nil, fmt.Errorf("unsupported destination %q: must be s3://BUCKET[/PREFIX] or https://ACCOUNT%s/CONTAINER[/PREFIX]", destination, azureBlobHostSuffix)
+	}
+}
+
+// redactURLError strips the query string from the URL quoted in a transport error, as the query string
+// may hold credentials, e.g., the signature of a SAS token.
+func redactURLError(err error) error {
+	var urlErr *url.Error
+	if !errors.As(err, &urlErr) {
+		return err
+	}
+	u, parseErr := url.Parse(urlErr.URL)
+	if parseErr != nil {
+		return fmt.Errorf("%s request failed: %w", urlErr.Op, urlErr.Err)
+	}
+	u.RawQuery = ""
+	u.User = nil
+	return &url.Error{Op: urlErr.Op, URL: u.String(), Err: urlErr.Err}
+}
+
+// s3Store uploads objects to an S3 (or S3-compatible) bucket.
+type s3Store struct {
+	client *s3.Client
+	bucket string
+	prefix string
+}
+
+func newS3Store(ctx context.Context, bucket, prefix, region, endpoint string) (*s3Store, error) {
+	if region == "" {
+		return nil, fmt.Errorf("the region of S3 bucket %s is not specified", bucket)
+	}
+	cfg, err := awsconfig.LoadDefaultConfig(ctx, awsconfig.WithRegion(region))
+	if err != nil {
+		return nil, fmt.Errorf("failed to load the AWS config: %w", err)
+	}
+	return newS3StoreWithConfig(cfg, bucket, prefix, endpoint), nil
+}
+
+func newS3StoreWithConfig(cfg aws.Config, bucket, prefix, endpoint string) *s3Store {
+	client := s3.NewFromConfig(cfg, func(o *s3.Options) {
+		if endpoint != "" {
+			// S3-compatible services are usually addressed in the path style.
+			o.BaseEndpoint = aws.String(endpoint)
+			o.UsePathStyle = true
+		}
+	})
+	return &s3Store{client: client, bucket: bucket, prefix: prefix}
+}
+
+// PutObject uploads an object to the S3 bucket.
+func (s *s3Store) PutObject(ctx context.Context, key string, content []byte) error {
+	_, err := s.client.PutObject(ctx, &s3.PutObjectInput{
+		Bucket:      aws.String(s.bucket),
+		Key:         aws.String(path.Join(s.prefix, key)),
+		Body:        bytes.NewReader(content),
+		ContentType: aws.String(jsonLinesContentType),
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload object %s to S3 bucket %s: %w", key, s.bucket, redactURLError(err))
+	}
+	return nil
+}
+
+// azureBlobStore uploads objects as block blobs to an Azure Blob Storage container.
+type azureBlobStore struct {
+	containerURL string
+	prefix       string
+	client       *container.Client
+}
+
+func newAzureBlobStore(containerURL, prefix string) (*azureBlobStore, error) {
+	var client *container.Client
+	var err error
+	if sasToken := strings.TrimPrefix(os.Getenv(AzureStorageSASTokenEnvVar), "?"); sasToken != "" {
+		client, err = container.NewClientWithNoCredential(containerURL+"?"+sasToken, nil)
+	} else {
+		credential, credErr := azidentity.NewDefaultAzureCredential(nil)
+		if credErr != nil {
+			return nil, fmt.Errorf("failed to create the default Azure credential: %w", credErr)
+		}
+		client, err = container.NewClient(containerURL, credential, nil)
+	}
+	if err != nil {
+		// The error may quote the container URL along with the SAS token.
+		return nil, fmt.Errorf("failed to create the client of container %s: %w", containerURL, redactURLError(err))
+	}
+	return &azureBlobStore{containerURL: containerURL, prefix: prefix, client: client}, nil
+}
+
+// PutObject uploads an object as a block blob to the container.
+func (s *azureBlobStore) PutObject(ctx context.Context, key string, content []byte) error {
+	blobClient := s.client.NewBlockBlobClient(path.Join(s.prefix, key))
+	_, err := blobClient.UploadBuffer(ctx, content, &blockblob.UploadBufferOptions{
+		HTTPHeaders: &blob.HTTPHeaders{BlobContentType: to.Ptr(jsonLinesContentType)},
+	})
+	if err != nil {
+		return fmt.Errorf("failed to upload blob %s to container %s: %w", key, s.containerURL, redactURLError(err))
+	}
+	return nil
+}
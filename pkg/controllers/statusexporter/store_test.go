@@ -0,0 +1,215 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package statusexporter
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/Azure/azure-sdk-for-go/sdk/azcore/policy"
+	"github.com/Azure/azure-sdk-for-go/sdk/storage/azblob/container"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/google/go-cmp/cmp"
+)
+
+// TestParseDestination tests the parseDestination function.
+func TestParseDestination(t *testing.T) {
+	testCases := []struct {
+		name        string
+		destination string
+		want        *parsedDestination
+		wantErr     bool
+	}{
+		{
+			name:        "S3 bucket",
+			destination: "s3://bucket",
+			want:        &parsedDestination{isS3: true, location: "bucket"},
+		},
+		{
+			name:        "S3 bucket with prefix",
+			destination: "s3://bucket/fleet/status/",
+			want:        &parsedDestination{isS3: true, location: "bucket", prefix: "fleet/status"},
+		},
+		{
+			name:        "Azure Blob Storage container with prefix",
+			destination: "https://account.blob.core.windows.net/container/fleet",
+			want:        &parsedDestination{location: "https://account.blob.core.windows.net/container", prefix: "fleet"},
+		},
+		{
+			name:        "Azure Blob Storage without container",
+			destination: "https://account.blob.core.windows.net/",
+			wantErr:     true,
+		},
+		{
+			name:        "S3 without bucket",
+			destination: "s3:///prefix",
+			wantErr:     true,
+		},
+		{
+			name:        "unsupported scheme",
+			destination: "gs://bucket",
+			wantErr:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := parseDestination(tc.destination)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("parseDestination() = %v, want error %t", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(got, tc.want, cmp.AllowUnexported(parsedDestination{})); diff != "" {
+				t.Errorf("parseDestination() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestPutObject tests the PutObject method of the object stores.
+func TestPutObject(t *testing.T) {
+	content := []byte("{\"type\":\"PlacementStatus\"}\n")
+	testCases := []struct {
+		name        string
+		newStore    func(t *testing.T, serverURL string) ObjectStore
+		wantPath    string
+		wantQuery   string
+		wantHeaders map[string]string
+		wantAuth    string
+	}{
+		{
+			name: "S3",
+			newStore: func(_ *testing.T, serverURL string) ObjectStore {
+				cfg := aws.Config{
+					Region: "us-east-1",
+					Credentials: aws.CredentialsProviderFunc(func(context.Context) (aws.Credentials, error) {
+						return aws.Credentials{AccessKeyID: "AKID", SecretAccessKey: "secret", SessionToken: "token"}, nil
+					}),
+				}
+				return newS3StoreWithConfig(cfg, "bucket", "fleet", serverURL)
+			},
+			wantPath:  "/bucket/fleet/2025/01/02/records.jsonl",
+			wantQuery: "x-id=PutObject",
+			wantHeaders: map[string]string{
+				"Content-Type":         jsonLinesContentType,
+				"X-Amz-Security-Token": "token",
+			},
+			wantAuth: "AWS4-HMAC-SHA256 Credential=AKID/",
+		},
+		{
+			name: "Azure Blob Storage with SAS token",
+			newStore: func(t *testing.T, serverURL string) ObjectStore {
+				client, err := container.NewClientWithNoCredential(serverURL+"/container?sv=2021-08-06&sig=abc", nil)
+				if err != nil {
+					t.Fatalf("NewClientWithNoCredential() = %v, want no error", err)
+				}
+				return &azureBlobStore{containerURL: serverURL + "/container", client: client}
+			},
+			wantPath:  "/container/2025/01/02/records.jsonl",
+			wantQuery: "sig=abc&sv=2021-08-06",
+			wantHeaders: map[string]string{
+				"X-Ms-Blob-Content-Type": jsonLinesContentType,
+				"X-Ms-Blob-Type":         "BlockBlob",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotReq *http.Request
+			var gotBody []byte
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				gotReq = r
+				gotBody, _ = io.ReadAll(r.Body)
+				w.WriteHeader(http.StatusCreated)
+			}))
+			defer server.Close()
+
+			store := tc.newStore(t, server.URL)
+			if err := store.PutObject(context.Background(), "2025/01/02/records.jsonl", content); err != nil {
+				t.Fatalf("PutObject() = %v, want no error", err)
+			}
+			if gotReq.Method != http.MethodPut {
+				t.Errorf("request method = %s, want %s", gotReq.Method, http.MethodPut)
+			}
+			if gotReq.URL.Path != tc.wantPath {
+				t.Errorf("request path = %s, want %s", gotReq.URL.Path, tc.wantPath)
+			}
+			if got := gotReq.URL.Query().Encode(); got != tc.wantQuery {
+				t.Errorf("request query = %s, want %s", got, tc.wantQuery)
+			}
+			for name, want := range tc.wantHeaders {
+				if got := gotReq.Header.Get(name); got != want {
+					t.Errorf("request header %s = %s, want %s", name, got, want)
+				}
+			}
+			if got := gotReq.Header.Get("Authorization"); !strings.HasPrefix(got, tc.wantAuth) {
+				t.Errorf("request header Authorization = %s, want prefix %s", got, tc.wantAuth)
+			}
+			if diff := cmp.Diff(string(gotBody), string(content)); diff != "" {
+				t.Errorf("request body mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestPutObject_Rejected tests that PutObject returns an error if the upload is rejected.
+func TestPutObject_Rejected(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.Header().Set("x-ms-error-code", "AuthenticationFailed")
+		w.WriteHeader(http.StatusForbidden)
+	}))
+	defer server.Close()
+
+	client, err := container.NewClientWithNoCredential(server.URL+"/container?sig=abc", nil)
+	if err != nil {
+		t.Fatalf("NewClientWithNoCredential() = %v, want no error", err)
+	}
+	store := &azureBlobStore{containerURL: server.URL + "/container", client: client}
+	err = store.PutObject(context.Background(), "records.jsonl", []byte("{}\n"))
+	if err == nil || !strings.Contains(err.Error(), "AuthenticationFailed") {
+		t.Errorf("PutObject() = %v, want an error with the error code", err)
+	}
+	if err != nil && strings.Contains(err.Error(), "sig=abc") {
+		t.Errorf("PutObject() = %v, want the error not to quote the SAS token", err)
+	}
+}
+
+// TestPutObject_Unreachable tests that PutObject does not quote the SAS token in transport errors.
+func TestPutObject_Unreachable(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(_ http.ResponseWriter, _ *http.Request) {}))
+	// Close the server so that the upload fails.
+	server.Close()
+
+	client, err := container.NewClientWithNoCredential(server.URL+"/container?sv=2021-08-06&sig=abc", &container.ClientOptions{
+		ClientOptions: policy.ClientOptions{Retry: policy.RetryOptions{MaxRetries: -1}},
+	})
+	if err != nil {
+		t.Fatalf("NewClientWithNoCredential() = %v, want no error", err)
+	}
+	store := &azureBlobStore{containerURL: server.URL + "/container", client: client}
+	err = store.PutObject(context.Background(), "records.jsonl", []byte("{}\n"))
+	if err == nil {
+		t.Fatalf("PutObject() = nil, want error")
+	}
+	if strings.Contains(err.Error(), "sig=abc") {
+		t.Errorf("PutObject() = %v, want the error not to quote the SAS token", err)
+	}
+}
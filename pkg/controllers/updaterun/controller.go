@@ -42,6 +42,7 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/events"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/informer"
 )
 
@@ -348,7 +349,7 @@ func (r *Reconciler) recordUpdateRunStatus(ctx context.Context, updateRun placem
 
 // SetupWithManagerForClusterStagedUpdateRun sets up the controller with the Manager for ClusterStagedUpdateRun resources.
 func (r *Reconciler) SetupWithManagerForClusterStagedUpdateRun(mgr runtime.Manager) error {
-	r.recorder = mgr.GetEventRecorderFor("clusterstagedupdaterun-controller")
+	r.recorder = events.NewRateLimitedRecorder(mgr.GetEventRecorderFor("clusterstagedupdaterun-controller"), events.DefaultDedupWindow)
 	return runtime.NewControllerManagedBy(mgr).
 		Named("clusterstagedupdaterun-controller").
 		For(&placementv1beta1.ClusterStagedUpdateRun{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
@@ -368,7 +369,7 @@ func (r *Reconciler) SetupWithManagerForClusterStagedUpdateRun(mgr runtime.Manag
 
 // SetupWithManagerForStagedUpdateRun sets up the controller with the Manager for StagedUpdateRun resources.
 func (r *Reconciler) SetupWithManagerForStagedUpdateRun(mgr runtime.Manager) error {
-	r.recorder = mgr.GetEventRecorderFor("stagedupdaterun-controller")
+	r.recorder = events.NewRateLimitedRecorder(mgr.GetEventRecorderFor("stagedupdaterun-controller"), events.DefaultDedupWindow)
 	return runtime.NewControllerManagedBy(mgr).
 		Named("stagedupdaterun-controller").
 		For(&placementv1beta1.StagedUpdateRun{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
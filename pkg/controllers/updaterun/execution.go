@@ -197,6 +197,7 @@ func (r *Reconciler) executeUpdatingStage(
 				bindingSpec.ResourceSnapshotName = resourceSnapshotName
 				bindingSpec.ResourceOverrideSnapshots = clusterStatus.ResourceOverrideSnapshots
 				bindingSpec.ClusterResourceOverrideSnapshots = clusterStatus.ClusterResourceOverrideSnapshots
+				bindingSpec.ClusterPropertySnapshot = clusterStatus.ClusterPropertySnapshot
 				bindingSpec.ApplyStrategy = updateRunStatus.ApplyStrategy
 				if err := r.Client.Update(ctx, binding); err != nil {
 					klog.ErrorS(err, "Failed to update binding to be bound with the matching spec of the updateRun", "binding", klog.KObj(binding), "updateRun", updateRunRef)
@@ -596,6 +597,10 @@ func isBindingSyncedWithClusterStatus(resourceSnapshotName string, updateRun pla
 		klog.ErrorS(fmt.Errorf("binding has different clusterResourceOverrideSnapshots, want: %v, got: %v", cluster.ClusterResourceOverrideSnapshots, bindingSpec.ClusterResourceOverrideSnapshots), "binding is not up-to-date", "binding", klog.KObj(binding), "updateRun", klog.KObj(updateRun))
 		return false
 	}
+	if !reflect.DeepEqual(cluster.ClusterPropertySnapshot, bindingSpec.ClusterPropertySnapshot) {
+		klog.ErrorS(fmt.Errorf("binding has different clusterPropertySnapshot, want: %v, got: %v", cluster.ClusterPropertySnapshot, bindingSpec.ClusterPropertySnapshot), "binding is not up-to-date", "binding", klog.KObj(binding), "updateRun", klog.KObj(updateRun))
+		return false
+	}
 	if !reflect.DeepEqual(bindingSpec.ApplyStrategy, updateRun.GetUpdateRunStatus().ApplyStrategy) {
 		klog.ErrorS(fmt.Errorf("binding has different applyStrategy, want: %v, got: %v", updateRun.GetUpdateRunStatus().ApplyStrategy, bindingSpec.ApplyStrategy), "binding is not up-to-date", "binding", klog.KObj(binding), "updateRun", klog.KObj(updateRun))
 		return false
@@ -112,6 +112,26 @@ func TestIsBindingSyncedWithClusterStatus(t *testing.T) {
 			},
 			wantEqual: false,
 		},
+		{
+			name:                 "isBindingSyncedWithClusterStatus should return false if binding and cluster status have different clusterPropertySnapshot",
+			resourceSnapshotName: "test-1-snapshot",
+			binding: &placementv1beta1.ClusterResourceBinding{
+				Spec: placementv1beta1.ResourceBindingSpec{
+					ResourceSnapshotName:             "test-1-snapshot",
+					ClusterResourceOverrideSnapshots: []string{"cr1"},
+					ClusterPropertySnapshot: &placementv1beta1.ClusterPropertySnapshot{
+						Values: map[string]string{"region": "eu-west"},
+					},
+				},
+			},
+			cluster: &placementv1beta1.ClusterUpdatingStatus{
+				ClusterResourceOverrideSnapshots: []string{"cr1"},
+				ClusterPropertySnapshot: &placementv1beta1.ClusterPropertySnapshot{
+					Values: map[string]string{"region": "us-east"},
+				},
+			},
+			wantEqual: false,
+		},
 		{
 			name:                 "isBindingSyncedWithClusterStatus should return false if binding and updateRun have different applyStrategy",
 			resourceSnapshotName: "test-1-snapshot",
@@ -97,8 +97,7 @@ func (r *Reconciler) validatePlacement(ctx context.Context, updateRun placementv
 		return nil, types.NamespacedName{}, controller.NewAPIServerError(true, err)
 	}
 
-	// fill out all the default values for placement, mutation webhook is not setup for resource placement.
-	// TODO: setup mutation webhook for resource placement.
+	// fill out all the default values for placement just in case the mutation webhook is not enabled.
 	defaulter.SetPlacementDefaults(placement)
 
 	// Check if the Placement has an external rollout strategy.
@@ -547,7 +546,7 @@ func (r *Reconciler) recordOverrideSnapshots(ctx context.Context, placement plac
 	for _, stageStatus := range updateRunStatus.StagesStatus {
 		for i := range stageStatus.Clusters {
 			clusterStatus := &stageStatus.Clusters[i]
-			clusterStatus.ClusterResourceOverrideSnapshots, clusterStatus.ResourceOverrideSnapshots, err =
+			clusterStatus.ClusterResourceOverrideSnapshots, clusterStatus.ResourceOverrideSnapshots, clusterStatus.ClusterPropertySnapshot, err =
 				overrider.PickFromResourceMatchedOverridesForTargetCluster(ctx, r.Client, clusterStatus.ClusterName, matchedCRO, matchedRO)
 			if err != nil {
 				klog.ErrorS(err, "Failed to pick the override snapshots for cluster", "cluster", clusterStatus.ClusterName, "resourceSnapshot", resourceSnapshotRef, "updateRun", updateRunRef)
@@ -607,12 +606,8 @@ func (r *Reconciler) getResourceSnapshotObjs(ctx context.Context, placement plac
 		return nil, err
 	}
 
-	// Determine the revision history limit.
-	revisionLimit := int32(defaulter.DefaultRevisionHistoryLimitValue)
-	placementSpec := placement.GetPlacementSpec()
-	if placementSpec.RevisionHistoryLimit != nil && *placementSpec.RevisionHistoryLimit > 0 {
-		revisionLimit = *placementSpec.RevisionHistoryLimit
-	}
+	// The revision history limit is always set as the placement has been defaulted.
+	revisionLimit := *placement.GetPlacementSpec().RevisionHistoryLimit
 
 	// Create or get the resource snapshot. Unlike the placement controller, we do not wait for snapshot creation intervals.
 	_, latestResourceSnapshot, err := r.ResourceSnapshotResolver.GetOrCreateResourceSnapshot(ctx, placement, envelopeObjCount,
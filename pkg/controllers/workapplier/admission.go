@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	WorkAdmissionPreflightPassedReason  = "AdmissionPreflightPassed"
+	WorkAdmissionPreflightPassedMsg     = "No manifest has been rejected by the admission webhooks in a dry-run"
+	WorkAdmissionPreflightFailedReason  = "RejectedByAdmissionWebhooks"
+	WorkAdmissionPreflightFailedMsgTmpl = "%d manifest(s) have been rejected by the admission webhooks in a dry-run: %s"
+	admissionWebhookRejectedErrTmpl     = "admission webhook %q rejected the manifest in a dry-run: %w"
+)
+
+var (
+	// admissionWebhookDeniedRegexp matches the error message that the Kubernetes API server returns
+	// when an admission webhook denies a request, and captures the name of the webhook.
+	admissionWebhookDeniedRegexp = regexp.MustCompile(`admission webhook "([^"]+)" denied the request`)
+)
+
+// admissionPreflightModeOf returns the admission preflight mode in effect for a Work object; the
+// preflight is disabled for the ReportDiff apply strategy, as no apply op is performed.
+func admissionPreflightModeOf(work *fleetv1beta1.Work) fleetv1beta1.AdmissionPreflightType {
+	if work.Spec.ApplyStrategy == nil ||
+		work.Spec.ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff ||
+		work.Spec.ApplyStrategy.AdmissionPreflight == "" {
+		return fleetv1beta1.AdmissionPreflightTypeNever
+	}
+	return work.Spec.ApplyStrategy.AdmissionPreflight
+}
+
+// runAdmissionPreflightIfApplicable dry-runs, before any manifest is applied, all the manifests
+// against the admission webhooks in the member cluster, so that rejections can be caught (and
+// attributed to the rejecting webhooks) early.
+//
+// Only rejections from admission webhooks are recorded; other dry-run errors are ignored, as they
+// are not necessarily errors in the actual apply op (e.g., the namespace of a manifest object might
+// not exist yet, but will be created in an earlier processing wave). If the preflight is enforced,
+// the rejected manifests are marked as such and will not be applied.
+func (r *Reconciler) runAdmissionPreflightIfApplicable(ctx context.Context, bundles []*manifestProcessingBundle, work *fleetv1beta1.Work) {
+	mode := admissionPreflightModeOf(work)
+	if mode == fleetv1beta1.AdmissionPreflightTypeNever {
+		return
+	}
+
+	doWork := func(piece int) {
+		bundle := bundles[piece]
+		if bundle.applyOrReportDiffErr != nil || bundle.manifestObj == nil || bundle.gvr == nil {
+			// Skip the manifests that have failed pre-processing or earlier preflight checks.
+			return
+		}
+
+		// Always use forced server-side apply for the dry-run, so that the result is not
+		// affected by field ownership conflicts.
+		_, err := r.serverSideApply(ctx, bundle.gvr, bundle.manifestObj, &unstructured.Unstructured{}, workFieldManagerName, true, false, true)
+		if err == nil {
+			return
+		}
+		webhookName, isRejected := admissionWebhookNameFrom(err)
+		if !isRejected {
+			klog.V(2).InfoS("Failed to dry-run the manifest for the admission preflight; ignore the error",
+				"manifestObj", klog.KObj(bundle.manifestObj), "work", klog.KObj(work), "err", err)
+			return
+		}
+		klog.V(2).InfoS("The manifest is rejected by an admission webhook in the admission preflight",
+			"manifestObj", klog.KObj(bundle.manifestObj), "webhook", webhookName, "work", klog.KObj(work), "mode", mode)
+		bundle.admissionPreflightErr = fmt.Errorf(admissionWebhookRejectedErrTmpl, webhookName, err)
+		if mode == fleetv1beta1.AdmissionPreflightTypeEnforce {
+			bundle.applyOrReportDiffErr = bundle.admissionPreflightErr
+			bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeRejectedByAdmissionWebhook
+		}
+	}
+	r.parallelizer.ParallelizeUntil(ctx, len(bundles), doWork, "runningAdmissionPreflight")
+}
+
+// admissionWebhookNameFrom returns the name of the admission webhook that has denied a request,
+// if the error is such a denial.
+func admissionWebhookNameFrom(err error) (string, bool) {
+	matches := admissionWebhookDeniedRegexp.FindStringSubmatch(err.Error())
+	if len(matches) != 2 {
+		return "", false
+	}
+	return matches[1], true
+}
+
+// setWorkAdmissionPreflightPassedCondition sets the AdmissionPreflightPassed condition on a Work
+// object based on the results of the admission preflight, or removes it if the preflight is not enabled.
+func setWorkAdmissionPreflightPassedCondition(work *fleetv1beta1.Work, bundles []*manifestProcessingBundle) {
+	if admissionPreflightModeOf(work) == fleetv1beta1.AdmissionPreflightTypeNever {
+		meta.RemoveStatusCondition(&work.Status.Conditions, fleetv1beta1.WorkConditionTypeAdmissionPreflightPassed)
+		return
+	}
+
+	rejectedManifests := make([]string, 0)
+	for _, bundle := range bundles {
+		if bundle.admissionPreflightErr != nil {
+			rejectedManifests = append(rejectedManifests, fmt.Sprintf("%s %s (%s)", bundle.manifestObj.GetKind(), klog.KObj(bundle.manifestObj), bundle.admissionPreflightErr))
+		}
+	}
+	cond := metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypeAdmissionPreflightPassed,
+		Status:             metav1.ConditionTrue,
+		Reason:             WorkAdmissionPreflightPassedReason,
+		Message:            WorkAdmissionPreflightPassedMsg,
+		ObservedGeneration: work.Generation,
+	}
+	if len(rejectedManifests) > 0 {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = WorkAdmissionPreflightFailedReason
+		cond.Message = fmt.Sprintf(WorkAdmissionPreflightFailedMsgTmpl, len(rejectedManifests), strings.Join(rejectedManifests, "; "))
+	}
+	meta.SetStatusCondition(&work.Status.Conditions, cond)
+}
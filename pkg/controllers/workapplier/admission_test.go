@@ -0,0 +1,182 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	testingclient "k8s.io/client-go/testing"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
+)
+
+func TestRunAdmissionPreflightIfApplicable(t *testing.T) {
+	configMap := func(name string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "app"},
+		}
+	}
+	workWith := func(mode fleetv1beta1.AdmissionPreflightType) *fleetv1beta1.Work {
+		return &fleetv1beta1.Work{
+			ObjectMeta: metav1.ObjectMeta{Name: "work", Generation: 1},
+			Spec: fleetv1beta1.WorkSpec{
+				ApplyStrategy: &fleetv1beta1.ApplyStrategy{AdmissionPreflight: mode},
+			},
+		}
+	}
+
+	tests := []struct {
+		name         string
+		work         *fleetv1beta1.Work
+		wantResTypes []ManifestProcessingApplyOrReportDiffResultType
+		wantRejected []bool
+		wantCond     *metav1.Condition
+	}{
+		{
+			name: "enforced",
+			work: workWith(fleetv1beta1.AdmissionPreflightTypeEnforce),
+			wantResTypes: []ManifestProcessingApplyOrReportDiffResultType{
+				"",
+				ApplyOrReportDiffResTypeRejectedByAdmissionWebhook,
+				"",
+			},
+			wantRejected: []bool{false, true, false},
+			wantCond: &metav1.Condition{
+				Type:               fleetv1beta1.WorkConditionTypeAdmissionPreflightPassed,
+				Status:             metav1.ConditionFalse,
+				Reason:             WorkAdmissionPreflightFailedReason,
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name:         "report only",
+			work:         workWith(fleetv1beta1.AdmissionPreflightTypeReportOnly),
+			wantResTypes: []ManifestProcessingApplyOrReportDiffResultType{"", "", ""},
+			wantRejected: []bool{false, true, false},
+			wantCond: &metav1.Condition{
+				Type:               fleetv1beta1.WorkConditionTypeAdmissionPreflightPassed,
+				Status:             metav1.ConditionFalse,
+				Reason:             WorkAdmissionPreflightFailedReason,
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name: "disabled with the ReportDiff apply strategy",
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{Name: "work", Generation: 1},
+				Spec: fleetv1beta1.WorkSpec{
+					ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+						Type:               fleetv1beta1.ApplyStrategyTypeReportDiff,
+						AdmissionPreflight: fleetv1beta1.AdmissionPreflightTypeEnforce,
+					},
+				},
+			},
+			wantResTypes: []ManifestProcessingApplyOrReportDiffResultType{"", "", ""},
+			wantRejected: []bool{false, false, false},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dynamicClient := fake.NewSimpleDynamicClient(scheme.Scheme)
+			dynamicClient.PrependReactor("patch", "configmaps", func(action testingclient.Action) (bool, runtime.Object, error) {
+				switch action.(testingclient.PatchAction).GetName() {
+				case "rejected":
+					return true, nil, apierrors.NewForbidden(utils.ConfigMapGVR.GroupResource(), "rejected",
+						fmt.Errorf(`admission webhook "policy.example.com" denied the request: missing owner label`))
+				case "missing-namespace":
+					return true, nil, apierrors.NewNotFound(utils.NamespaceGVR.GroupResource(), "app")
+				}
+				return true, nil, nil
+			})
+			r := &Reconciler{
+				spokeDynamicClient: dynamicClient,
+				parallelizer:       parallelizer.NewParallelizer(2),
+			}
+			bundles := make([]*manifestProcessingBundle, 0, 3)
+			for _, name := range []string{"accepted", "rejected", "missing-namespace"} {
+				gvr := utils.ConfigMapGVR
+				bundles = append(bundles, &manifestProcessingBundle{
+					manifestObj: toUnstructured(t, configMap(name)),
+					gvr:         &gvr,
+				})
+			}
+			work := tc.work.DeepCopy()
+
+			r.runAdmissionPreflightIfApplicable(context.Background(), bundles, work)
+			gotResTypes := make([]ManifestProcessingApplyOrReportDiffResultType, 0, len(bundles))
+			gotRejected := make([]bool, 0, len(bundles))
+			for _, bundle := range bundles {
+				gotResTypes = append(gotResTypes, bundle.applyOrReportDiffResTyp)
+				gotRejected = append(gotRejected, bundle.admissionPreflightErr != nil)
+			}
+			if diff := cmp.Diff(tc.wantResTypes, gotResTypes); diff != "" {
+				t.Errorf("runAdmissionPreflightIfApplicable() result types mismatch (-want, +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantRejected, gotRejected); diff != "" {
+				t.Errorf("runAdmissionPreflightIfApplicable() rejections mismatch (-want, +got):\n%s", diff)
+			}
+
+			setWorkAdmissionPreflightPassedCondition(work, bundles)
+			gotCond := meta.FindStatusCondition(work.Status.Conditions, fleetv1beta1.WorkConditionTypeAdmissionPreflightPassed)
+			if diff := cmp.Diff(tc.wantCond, gotCond, cmpopts.IgnoreFields(metav1.Condition{}, "Message", "LastTransitionTime")); diff != "" {
+				t.Errorf("setWorkAdmissionPreflightPassedCondition() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestAdmissionWebhookNameFrom(t *testing.T) {
+	tests := []struct {
+		name     string
+		err      error
+		wantName string
+		wantOK   bool
+	}{
+		{
+			name:     "denied by a webhook",
+			err:      fmt.Errorf(`failed to apply: admission webhook "policy.example.com" denied the request: missing owner label`),
+			wantName: "policy.example.com",
+			wantOK:   true,
+		},
+		{
+			name: "other error",
+			err:  fmt.Errorf(`namespaces "app" not found`),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotName, gotOK := admissionWebhookNameFrom(tc.err)
+			if gotName != tc.wantName || gotOK != tc.wantOK {
+				t.Errorf("admissionWebhookNameFrom() = (%s, %t), want (%s, %t)", gotName, gotOK, tc.wantName, tc.wantOK)
+			}
+		})
+	}
+}
@@ -18,9 +18,12 @@ package workapplier
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/json"
 	"fmt"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
 	"k8s.io/apimachinery/pkg/api/validation"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -60,8 +63,49 @@ func (r *Reconciler) applyInDryRunMode(
 	// in the manifest object, and Fleet will clear out system managed and read-only fields
 	// before the comparison.
 	//
-	// Note that full comparison can be carried out directly without involving the apply op.
-	return r.serverSideApply(ctx, gvr, manifestObj, inMemberClusterObj, true, false, true)
+	// Note that full comparison uses a dry-run update op instead; see the updateInDryRunMode method.
+	return r.serverSideApply(ctx, gvr, manifestObj, inMemberClusterObj, workFieldManagerName, true, false, true)
+}
+
+// updateInDryRunMode dry-runs an update op that replaces the object in the member cluster with
+// the manifest object.
+//
+// The returned object is the manifest object as the member cluster API server would persist it,
+// i.e., with all the server-side defaults (e.g., the protocol of a Service port) applied and
+// empty values normalized, which allows Fleet to compare the desired state with the object in
+// the member cluster without reporting the noise introduced by the API server.
+func (r *Reconciler) updateInDryRunMode(
+	ctx context.Context,
+	gvr *schema.GroupVersionResource,
+	manifestObj, inMemberClusterObj *unstructured.Unstructured,
+) (*unstructured.Unstructured, error) {
+	desiredObj := prepareManifestObjForDryRunUpdate(manifestObj, inMemberClusterObj)
+	updatedObj, err := r.spokeDynamicClient.
+		Resource(*gvr).Namespace(desiredObj.GetNamespace()).
+		Update(ctx, desiredObj, metav1.UpdateOptions{
+			FieldManager: workFieldManagerName,
+			DryRun:       []string{metav1.DryRunAll},
+		})
+	if err != nil {
+		// Similar to the server-side apply op, the error is not wrapped by NewAPIServerError so that
+		// the caller can still identify the error type.
+		_ = controller.NewAPIServerError(false, err)
+		return nil, fmt.Errorf("failed to update the object in dry-run mode: an error is returned by the API server: %w", err)
+	}
+	return updatedObj, nil
+}
+
+// prepareManifestObjForDryRunUpdate prepares a sanitized copy of the manifest object that can
+// replace the object in the member cluster in a dry-run update op.
+//
+// Fields that Fleet ignores in the comparison process are kept as they are in the member cluster,
+// so that the dry-run update op does not fail (or trigger additional admission checks) due to them.
+func prepareManifestObjForDryRunUpdate(manifestObj, inMemberClusterObj *unstructured.Unstructured) *unstructured.Unstructured {
+	desiredObj := sanitizeManifestObject(manifestObj)
+	desiredObj.SetResourceVersion(inMemberClusterObj.GetResourceVersion())
+	desiredObj.SetOwnerReferences(inMemberClusterObj.GetOwnerReferences())
+	desiredObj.SetFinalizers(inMemberClusterObj.GetFinalizers())
+	return desiredObj
 }
 
 func (r *Reconciler) apply(
@@ -97,19 +141,47 @@ func (r *Reconciler) apply(
 		return nil, fmt.Errorf("failed to set manifest hash annotation: %w", err)
 	}
 
+	// Keep the ignored fields of the object in the member cluster as they are.
+	//
+	// Note that this runs after the manifest hash computation, so that changes made to the
+	// ignored fields on the member cluster side would not change the manifest hash (and in turn
+	// skip the pre-apply drift detection); the values from the manifest object are still used
+	// if the object has not been created yet.
+	if inMemberClusterObj != nil && len(applyStrategy.IgnoreFields) > 0 {
+		keepIgnoredFieldsFrom(manifestObjCopy, inMemberClusterObj, applyStrategy.IgnoreFields)
+	}
+
 	// Validate owner references.
 	//
 	// As previously mentioned, with the new capabilities, at this point of the workflow,
 	// Fleet has been added as an owner for the object. Still, to guard against cases where
 	// the allow co-ownership switch is turned on then off or the addition of new owner references
 	// in the manifest, Fleet will still perform a validation round.
-	if err := validateOwnerReferences(manifestObj, inMemberClusterObj, applyStrategy, expectedAppliedWorkOwnerRef); err != nil {
+	//
+	// The co-ownership policies of the other placements that own the object are checked as well, as
+	// they might have changed since the object was taken over.
+	isCoOwnershipWithOtherPlacementsAllowed := false
+	if inMemberClusterObj != nil {
+		var err error
+		isCoOwnershipWithOtherPlacementsAllowed, err = r.isCoOwnershipWithOtherPlacementsAllowed(ctx, inMemberClusterObj.GetOwnerReferences(), applyStrategy, expectedAppliedWorkOwnerRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to check the co-ownership policies of the co-owners: %w", err)
+		}
+	}
+	if err := validateOwnerReferences(manifestObj, inMemberClusterObj, applyStrategy, expectedAppliedWorkOwnerRef, isCoOwnershipWithOtherPlacementsAllowed); err != nil {
 		return nil, fmt.Errorf("failed to validate owner references: %w", err)
 	}
 
 	// Add the owner reference information.
 	setOwnerRef(manifestObjCopy, expectedAppliedWorkOwnerRef)
 
+	// Determine the field manager to use; with the SharedFields co-ownership policy, each placement
+	// manages its own set of fields via server-side apply.
+	fieldManager := fieldManagerFor(applyStrategy, expectedAppliedWorkOwnerRef)
+	// With the LastWriterWins co-ownership policy, conflicts are always forced so that the values
+	// from the latest apply op take effect.
+	isLastWriterWins := applyStrategy.CoOwnershipPolicy == fleetv1beta1.CoOwnershipPolicyTypeLastWriterWins
+
 	// If three-way merge patch is used, set the Fleet-specific last applied annotation.
 	// Note that this op might not complete due to the last applied annotation being too large;
 	// this is not recognized as an error and Fleet will switch to server-side apply instead.
@@ -129,7 +201,7 @@ func (r *Reconciler) apply(
 
 	// Create the object if it does not exist in the member cluster.
 	if inMemberClusterObj == nil {
-		return r.createManifestObject(ctx, gvr, manifestObjCopy)
+		return r.createManifestObject(ctx, gvr, manifestObjCopy, fieldManager)
 	}
 
 	// Note: originally Fleet will add its owner reference and
@@ -137,8 +209,26 @@ func (r *Reconciler) apply(
 	// of drift detection and takeover capabilities, such steps have been completed before
 	// the apply op actually runs.
 
-	// Run the apply op. Note that Fleet will always attempt to apply the manifest, even if
-	// the manifest object hash does not change.
+	// Skip the apply op if it would not change the object in the member cluster; this saves
+	// the member cluster API server from no-op writes (and the audit log from the noise).
+	isServerSideApply := applyStrategy.Type != fleetv1beta1.ApplyStrategyTypeClientSideApply || !isLastAppliedAnnotationSet
+
+	// If the placement has switched to or from the SharedFields co-ownership policy, move the fields
+	// that Fleet has applied under the previous field manager to the one in use.
+	if isServerSideApply {
+		migratedObj, err := r.migrateFleetFieldManager(ctx, gvr, inMemberClusterObj, fieldManager, expectedAppliedWorkOwnerRef)
+		if err != nil {
+			return nil, fmt.Errorf("failed to migrate the fields managed by Fleet: %w", err)
+		}
+		inMemberClusterObj = migratedObj
+	}
+
+	if isApplyOpNoOp(manifestObjCopy, inMemberClusterObj, fieldManager, isServerSideApply) {
+		klog.V(2).InfoS("Skipped the apply op as the object in the member cluster is already up-to-date",
+			"GVR", *gvr, "manifestObj", klog.KObj(manifestObjCopy))
+		trackManifestApplyOpMetrics(manifestApplyOpResultSkipped, r.tenant)
+		return inMemberClusterObj, nil
+	}
 
 	// Optimistic lock is enabled when the apply strategy dictates that an apply op should
 	// not be carries through if a drift has been found (i.e., the WhenToApply field is set
@@ -152,6 +242,8 @@ func (r *Reconciler) apply(
 	// is added.
 	isOptimisticLockEnabled := shouldEnableOptimisticLock(applyStrategy)
 
+	var appliedObj *unstructured.Unstructured
+	var err error
 	switch {
 	case applyStrategy.Type == fleetv1beta1.ApplyStrategyTypeClientSideApply && isLastAppliedAnnotationSet:
 		// The apply strategy dictates that three-way merge patch
@@ -159,31 +251,31 @@ func (r *Reconciler) apply(
 		// has been set.
 		klog.V(2).InfoS("Using three-way merge patch to apply the manifest object",
 			"GVR", *gvr, "manifestObj", klog.KObj(manifestObjCopy))
-		return r.threeWayMergePatch(ctx, gvr, manifestObjCopy, inMemberClusterObj, isOptimisticLockEnabled, false)
+		appliedObj, err = r.threeWayMergePatch(ctx, gvr, manifestObjCopy, inMemberClusterObj, isOptimisticLockEnabled, false)
 	case applyStrategy.Type == fleetv1beta1.ApplyStrategyTypeClientSideApply:
 		// The apply strategy dictates that three-way merge patch
 		// (client-side apply) should be used, but the last applied annotation
 		// cannot be set. Fleet will fall back to server-side apply.
 		klog.V(2).InfoS("Falling back to server-side apply as the last applied annotation cannot be set",
 			"GVR", *gvr, "manifestObj", klog.KObj(manifestObjCopy))
-		return r.serverSideApply(
+		appliedObj, err = r.serverSideApply(
 			ctx,
 			gvr, manifestObjCopy, inMemberClusterObj,
 			// When falling back to SSA, always disable force apply ops (this is also the default
 			// behavior).
 			//
 			// Note that the work applier might still enable force apply ops if it finds that
-			// self-conflicts might be occur.
-			false, isOptimisticLockEnabled, false,
+			// self-conflicts might be occur, or if the LastWriterWins co-ownership policy is used.
+			fieldManager, isLastWriterWins, isOptimisticLockEnabled, false,
 		)
 	case applyStrategy.Type == fleetv1beta1.ApplyStrategyTypeServerSideApply:
 		// The apply strategy dictates that server-side apply should be used.
 		klog.V(2).InfoS("Using server-side apply to apply the manifest object",
 			"GVR", *gvr, "manifestObj", klog.KObj(manifestObjCopy))
-		return r.serverSideApply(
+		appliedObj, err = r.serverSideApply(
 			ctx,
 			gvr, manifestObjCopy, inMemberClusterObj,
-			applyStrategy.ServerSideApplyConfig.ForceConflicts, isOptimisticLockEnabled, false,
+			fieldManager, applyStrategy.ServerSideApplyConfig.ForceConflicts || isLastWriterWins, isOptimisticLockEnabled, false,
 		)
 	default:
 		// An unexpected apply strategy has been set. Normally this will never run as the built-in
@@ -192,6 +284,106 @@ func (r *Reconciler) apply(
 		_ = controller.NewUnexpectedBehaviorError(wrappedErr)
 		return nil, wrappedErr
 	}
+
+	// Track the apply op only after it returns, so that failed apply ops are not counted as
+	// performed.
+	if err != nil {
+		trackManifestApplyOpMetrics(manifestApplyOpResultFailed, r.tenant)
+		return nil, err
+	}
+	trackManifestApplyOpMetrics(manifestApplyOpResultPerformed, r.tenant)
+	return appliedObj, nil
+}
+
+// isApplyOpNoOp checks if applying the (prepared) manifest object would leave the object in the
+// member cluster unchanged, i.e., the object has been applied from the same manifest before (as
+// the manifest hash annotation suggests), and every field set in the manifest object has the same
+// value on the object in the member cluster.
+//
+// The comparison is done after the API server has defaulted the object, so that fields absent from
+// the manifest object (e.g., defaulted or system-managed fields) are not compared. Note that
+// the check errs on the side of applying: values that are equivalent but spelled differently
+// (e.g., quantities 1000m and 1) are considered as changes.
+func isApplyOpNoOp(manifestObj, inMemberClusterObj *unstructured.Unstructured, fieldManager string, isServerSideApply bool) bool {
+	manifestObjHash := manifestObj.GetAnnotations()[fleetv1beta1.ManifestHashAnnotation]
+	if len(manifestObjHash) == 0 || inMemberClusterObj.GetAnnotations()[fleetv1beta1.ManifestHashAnnotation] != manifestObjHash {
+		// The object has not been applied from the same manifest before.
+		return false
+	}
+
+	if isServerSideApply {
+		// With server-side apply, an apply op also updates the ownership of the fields; skip it
+		// only if Fleet has applied the object with the same field manager before.
+		isAppliedByFieldManager := false
+		for _, mf := range inMemberClusterObj.GetManagedFields() {
+			if mf.Manager == fieldManager && mf.Operation == metav1.ManagedFieldsOperationApply {
+				isAppliedByFieldManager = true
+				break
+			}
+		}
+		if !isAppliedByFieldManager {
+			return false
+		}
+	}
+
+	// Note that with three-way merge patch, the last applied annotation is part of the manifest
+	// object, so no field will be removed from the object in the member cluster either if
+	// the check passes.
+	return isSemanticallyContainedIn(manifestObj.Object, inMemberClusterObj.Object)
+}
+
+// isSemanticallyContainedIn checks if all the fields in the desired value are present with the same
+// values in the live value. Lists must be of the same length, and their items are compared
+// one by one.
+func isSemanticallyContainedIn(desired, live interface{}) bool {
+	switch typedDesired := desired.(type) {
+	case map[string]interface{}:
+		typedLive, ok := live.(map[string]interface{})
+		if !ok {
+			return false
+		}
+		for k, desiredV := range typedDesired {
+			liveV, found := typedLive[k]
+			if !found {
+				// The API server drops null and empty values.
+				if isEmptyValue(desiredV) {
+					continue
+				}
+				return false
+			}
+			if !isSemanticallyContainedIn(desiredV, liveV) {
+				return false
+			}
+		}
+		return true
+	case []interface{}:
+		typedLive, ok := live.([]interface{})
+		if !ok || len(typedLive) != len(typedDesired) {
+			return false
+		}
+		for idx := range typedDesired {
+			if !isSemanticallyContainedIn(typedDesired[idx], typedLive[idx]) {
+				return false
+			}
+		}
+		return true
+	default:
+		return equality.Semantic.DeepEqual(desired, live)
+	}
+}
+
+// isEmptyValue checks if a value in an unstructured object is null or empty.
+func isEmptyValue(v interface{}) bool {
+	switch typedV := v.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(typedV) == 0
+	case []interface{}:
+		return len(typedV) == 0
+	default:
+		return false
+	}
 }
 
 // createManifestObject creates the manifest object in the member cluster.
@@ -199,9 +391,10 @@ func (r *Reconciler) createManifestObject(
 	ctx context.Context,
 	gvr *schema.GroupVersionResource,
 	manifestObject *unstructured.Unstructured,
+	fieldManager string,
 ) (*unstructured.Unstructured, error) {
 	createOpts := metav1.CreateOptions{
-		FieldManager: workFieldManagerName,
+		FieldManager: fieldManager,
 	}
 	createdObj, err := r.spokeDynamicClient.Resource(*gvr).Namespace(manifestObject.GetNamespace()).Create(ctx, manifestObject, createOpts)
 	if err != nil {
@@ -276,6 +469,7 @@ func (r *Reconciler) serverSideApply(
 	ctx context.Context,
 	gvr *schema.GroupVersionResource,
 	manifestObj, inMemberClusterObj *unstructured.Unstructured,
+	fieldManager string,
 	force, optimisticLock, dryRun bool,
 ) (*unstructured.Unstructured, error) {
 	// Enable optimistic lock by forcing the resource version field to be added to the
@@ -303,7 +497,7 @@ func (r *Reconciler) serverSideApply(
 	// first apply attempt being successful, yet any subsequent update would fail due to
 	// conflicts. There are also a few other similar cases that are solved by this check;
 	// see the inner comments for specifics.
-	if shouldUseForcedServerSideApply(inMemberClusterObj) {
+	if shouldUseForcedServerSideApply(inMemberClusterObj, fieldManager) {
 		force = true
 	}
 
@@ -311,7 +505,7 @@ func (r *Reconciler) serverSideApply(
 	//
 	// See the Kubernetes documentation on structured merged diff for the exact behaviors.
 	applyOpts := metav1.ApplyOptions{
-		FieldManager: workFieldManagerName,
+		FieldManager: fieldManager,
 		Force:        force,
 	}
 	if dryRun {
@@ -479,10 +673,14 @@ func setOwnerRef(obj *unstructured.Unstructured, expectedAppliedWorkOwnerRef *me
 
 // validateOwnerReferences validates the owner references of an applied manifest, checking
 // if an apply op can be performed on the object.
+//
+// isCoOwnershipWithOtherPlacementsAllowed tells if the co-ownership policies of the placements
+// that own the object allow it to be placed by multiple placements.
 func validateOwnerReferences(
 	manifestObj, inMemberClusterObj *unstructured.Unstructured,
 	applyStrategy *fleetv1beta1.ApplyStrategy,
 	expectedAppliedWorkOwnerRef *metav1.OwnerReference,
+	isCoOwnershipWithOtherPlacementsAllowed bool,
 ) error {
 	manifestObjOwnerRefs := manifestObj.GetOwnerReferences()
 
@@ -517,7 +715,14 @@ func validateOwnerReferences(
 	inMemberClusterObjOwnerRefs := inMemberClusterObj.GetOwnerReferences()
 
 	// If the live object is co-owned but co-ownership is no longer allowed, the validation fails.
-	if len(inMemberClusterObjOwnerRefs) > 1 && !applyStrategy.AllowCoOwnership {
+	//
+	// Owner references that point to the AppliedWork objects of other placements are not
+	// considered here if the co-ownership policies allow placing the object via multiple placements.
+	coOwnerRefCount := len(inMemberClusterObjOwnerRefs) - 1
+	if isCoOwnershipWithOtherPlacementsAllowed {
+		coOwnerRefCount = len(ownerRefsExcludingAppliedWorks(inMemberClusterObjOwnerRefs))
+	}
+	if coOwnerRefCount > 0 && !applyStrategy.AllowCoOwnership {
 		wrappedErr := fmt.Errorf("object is co-owned by multiple objects but co-ownership has been disallowed")
 		_ = controller.NewUserError(wrappedErr)
 		return wrappedErr
@@ -538,11 +743,12 @@ func validateOwnerReferences(
 		return wrappedErr
 	}
 
-	// If the object is already owned by another AppliedWork object, the validation fails.
+	// If the object is already owned by another AppliedWork object and the co-ownership policies
+	// do not allow so, the validation fails.
 	//
 	// Normally this branch will never get executed as Fleet would refuse to take over an object
 	// that has been owned by another AppliedWork object.
-	if isPlacedByFleetInDuplicate(inMemberClusterObjOwnerRefs, expectedAppliedWorkOwnerRef) {
+	if !isCoOwnershipWithOtherPlacementsAllowed && isPlacedByFleetInDuplicate(inMemberClusterObjOwnerRefs, expectedAppliedWorkOwnerRef) {
 		wrappedErr := fmt.Errorf("object is already owned by another AppliedWork object")
 		_ = controller.NewUnexpectedBehaviorError(wrappedErr)
 		return wrappedErr
@@ -614,13 +820,117 @@ func shouldEnableOptimisticLock(applyStrategy *fleetv1beta1.ApplyStrategy) bool
 	return applyStrategy.WhenToApply == fleetv1beta1.WhenToApplyTypeIfNotDrifted
 }
 
+// fieldManagerFor returns the field manager name Fleet uses to apply the manifest objects of a Work.
+//
+// With the SharedFields co-ownership policy, each AppliedWork object (and thus each placement) uses
+// its own field manager, so that the fields managed by different placements can be told apart and
+// conflicts between the placements are surfaced by the API server.
+func fieldManagerFor(applyStrategy *fleetv1beta1.ApplyStrategy, expectedAppliedWorkOwnerRef *metav1.OwnerReference) string {
+	if applyStrategy.CoOwnershipPolicy != fleetv1beta1.CoOwnershipPolicyTypeSharedFields {
+		return workFieldManagerName
+	}
+	return sharedFieldsFieldManagerFor(expectedAppliedWorkOwnerRef)
+}
+
+// sharedFieldsFieldManagerFor returns the field manager name Fleet uses to apply the manifest objects
+// of a Work with the SharedFields co-ownership policy.
+//
+// The name includes a short hash of the AppliedWork object name rather than the name itself, as the
+// API server limits the length of field manager names (128 characters) and truncated names might
+// collide.
+func sharedFieldsFieldManagerFor(expectedAppliedWorkOwnerRef *metav1.OwnerReference) string {
+	sum := sha256.Sum256([]byte(expectedAppliedWorkOwnerRef.Name))
+	return fmt.Sprintf("%s/%x", workFieldManagerName, sum[:8])
+}
+
+// migrateFleetFieldManager moves the fields that Fleet has applied to an object under the field manager
+// that a placement used before it switched to (or from) the SharedFields co-ownership policy to the field
+// manager in use, so that server-side apply can still remove the fields that the placement no longer sets.
+//
+// The fields are moved only if the field manager in use has not applied any field to the object yet and,
+// when they are moved from the field manager shared by all the placements, the placement is the only one
+// that owns the object, as the fields might have been applied by other placements otherwise. In other
+// cases the fields are left behind under the previous field manager, which keeps them on the object
+// until they are removed manually.
+func (r *Reconciler) migrateFleetFieldManager(
+	ctx context.Context,
+	gvr *schema.GroupVersionResource,
+	inMemberClusterObj *unstructured.Unstructured,
+	fieldManager string,
+	expectedAppliedWorkOwnerRef *metav1.OwnerReference,
+) (*unstructured.Unstructured, error) {
+	prevFieldManager := workFieldManagerName
+	if fieldManager == workFieldManagerName {
+		prevFieldManager = sharedFieldsFieldManagerFor(expectedAppliedWorkOwnerRef)
+	} else if isOwnedByOtherAppliedWorks(inMemberClusterObj.GetOwnerReferences(), expectedAppliedWorkOwnerRef) {
+		return inMemberClusterObj, nil
+	}
+
+	managedFields := inMemberClusterObj.GetManagedFields()
+	prevIdx := -1
+	for idx := range managedFields {
+		mf := &managedFields[idx]
+		if mf.Operation != metav1.ManagedFieldsOperationApply || len(mf.Subresource) > 0 {
+			continue
+		}
+		switch mf.Manager {
+		case fieldManager:
+			// The field manager in use has applied fields to the object; no migration is needed.
+			return inMemberClusterObj, nil
+		case prevFieldManager:
+			prevIdx = idx
+		}
+	}
+	if prevIdx == -1 {
+		return inMemberClusterObj, nil
+	}
+
+	migratedManagedFields := make([]metav1.ManagedFieldsEntry, len(managedFields))
+	copy(migratedManagedFields, managedFields)
+	migratedManagedFields[prevIdx].Manager = fieldManager
+	// Guard the op with the resource version so that the managed fields are not overwritten with a
+	// stale copy.
+	patch := []map[string]interface{}{
+		{"op": "test", "path": "/metadata/resourceVersion", "value": inMemberClusterObj.GetResourceVersion()},
+		{"op": "replace", "path": "/metadata/managedFields", "value": migratedManagedFields},
+	}
+	data, err := json.Marshal(patch)
+	if err != nil {
+		wrappedErr := fmt.Errorf("failed to marshal the managed fields patch: %w", err)
+		_ = controller.NewUnexpectedBehaviorError(wrappedErr)
+		return nil, wrappedErr
+	}
+	migratedObj, err := r.spokeDynamicClient.
+		Resource(*gvr).Namespace(inMemberClusterObj.GetNamespace()).
+		Patch(ctx, inMemberClusterObj.GetName(), types.JSONPatchType, data, metav1.PatchOptions{})
+	if err != nil {
+		wrappedErr := controller.NewAPIServerError(false, err)
+		return nil, fmt.Errorf("failed to patch the managed fields: %w", wrappedErr)
+	}
+	klog.V(2).InfoS("Moved the fields applied by Fleet to the field manager in use",
+		"GVR", *gvr, "inMemberClusterObj", klog.KObj(inMemberClusterObj), "prevFieldManager", prevFieldManager, "fieldManager", fieldManager)
+	return migratedObj, nil
+}
+
+// isOwnedByOtherAppliedWorks checks if an object is owned by AppliedWork objects other than the
+// expected one.
+func isOwnedByOtherAppliedWorks(ownerRefs []metav1.OwnerReference, expectedAppliedWorkOwnerRef *metav1.OwnerReference) bool {
+	for idx := range ownerRefs {
+		ownerRef := ownerRefs[idx]
+		if ownerRef.APIVersion == fleetv1beta1.GroupVersion.String() && ownerRef.Kind == fleetv1beta1.AppliedWorkKind && ownerRef.UID != expectedAppliedWorkOwnerRef.UID {
+			return true
+		}
+	}
+	return false
+}
+
 // shouldUseForcedServerSideApply checks if forced server-side apply should be used even if
 // the force option is not turned on.
-func shouldUseForcedServerSideApply(inMemberClusterObj *unstructured.Unstructured) bool {
+func shouldUseForcedServerSideApply(inMemberClusterObj *unstructured.Unstructured, fieldManager string) bool {
 	managedFields := inMemberClusterObj.GetManagedFields()
 	for idx := range managedFields {
 		mf := &managedFields[idx]
-		// workFieldManagerName is the field manager name used by Fleet; its presence
+		// fieldManager is the field manager name used by Fleet for the apply op; its presence
 		// suggests that some (not necessarily all) fields are managed by Fleet.
 		//
 		// `before-first-apply` is a field manager name used by Kubernetes to "properly"
@@ -630,7 +940,7 @@ func shouldUseForcedServerSideApply(inMemberClusterObj *unstructured.Unstructure
 		//
 		// Note (chenyu1): unfortunately this name is not exposed as a public variable. See
 		// the Kubernetes source code for more information.
-		if mf.Manager != workFieldManagerName && mf.Manager != "before-first-apply" {
+		if mf.Manager != fieldManager && mf.Manager != "before-first-apply" {
 			// There exists a field manager this is neither Fleet nor the `before-first-apply`
 			// field manager, which suggests that the object (or at least some of its fields)
 			// is managed by another entity. Fleet will not enable forced server-side apply in
@@ -17,21 +17,30 @@ limitations under the License.
 package workapplier
 
 import (
+	"context"
 	"crypto/rand"
+	"fmt"
 	"strings"
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	testingclient "k8s.io/client-go/testing"
 	"k8s.io/kubectl/pkg/util/deployment"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	membermetrics "github.com/kubefleet-dev/kubefleet/pkg/metrics/member"
 )
 
 // Note (chenyu1): The fake client Fleet uses for unit tests has trouble processing certain requests
@@ -150,6 +159,81 @@ func TestSanitizeManifestObject(t *testing.T) {
 	}
 }
 
+// TestPrepareManifestObjForDryRunUpdate tests the prepareManifestObjForDryRunUpdate function.
+func TestPrepareManifestObjForDryRunUpdate(t *testing.T) {
+	ownerRefs := []metav1.OwnerReference{
+		{
+			APIVersion: "placement.kubernetes-fleet.io/v1beta1",
+			Kind:       "AppliedWork",
+			Name:       "work-1",
+			UID:        "uid-1",
+		},
+	}
+	finalizers := []string{"example.com/finalizer"}
+
+	manifestObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":      "cm",
+				"namespace": nsName,
+				"annotations": map[string]interface{}{
+					fleetv1beta1.ManifestHashAnnotation: dummyLabelValue1,
+				},
+				"resourceVersion": "1",
+			},
+			"data": map[string]interface{}{
+				dummyLabelKey: dummyLabelValue1,
+			},
+		},
+	}
+	inMemberClusterObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":      "cm",
+				"namespace": nsName,
+			},
+			"data": map[string]interface{}{
+				dummyLabelKey: dummyLabelValue2,
+			},
+		},
+	}
+	inMemberClusterObj.SetResourceVersion("2")
+	inMemberClusterObj.SetOwnerReferences(ownerRefs)
+	inMemberClusterObj.SetFinalizers(finalizers)
+
+	wantObj := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":      "cm",
+				"namespace": nsName,
+			},
+			"data": map[string]interface{}{
+				dummyLabelKey: dummyLabelValue1,
+			},
+		},
+	}
+	wantObj.SetResourceVersion("2")
+	wantObj.SetOwnerReferences(ownerRefs)
+	wantObj.SetFinalizers(finalizers)
+
+	gotObj := prepareManifestObjForDryRunUpdate(manifestObj, inMemberClusterObj)
+	// Ignore the fields that the sanitization process clears out.
+	gotObj.SetCreationTimestamp(metav1.Time{})
+	unstructured.RemoveNestedField(gotObj.Object, "metadata", "creationTimestamp")
+	if diff := cmp.Diff(gotObj, wantObj); diff != "" {
+		t.Errorf("prepareManifestObjForDryRunUpdate() mismatches (-got +want):\n%s", diff)
+	}
+	if manifestObj.GetResourceVersion() != "1" {
+		t.Errorf("prepareManifestObjForDryRunUpdate() modified the manifest object, resource version = %s, want 1", manifestObj.GetResourceVersion())
+	}
+}
+
 // TestValidateOwnerReferences tests the validateOwnerReferences function.
 func TestValidateOwnerReferences(t *testing.T) {
 	deployManifestObj1 := deploy.DeepCopy()
@@ -201,13 +285,23 @@ func TestValidateOwnerReferences(t *testing.T) {
 		*appliedWorkOwnerRef,
 	}
 
+	deployManifestObj9 := deploy.DeepCopy()
+	deployInMemberClusterObj9 := deployInMemberClusterObj6.DeepCopy()
+
+	deployManifestObj10 := deploy.DeepCopy()
+	deployInMemberClusterObj10 := deployInMemberClusterObj6.DeepCopy()
+	deployInMemberClusterObj10.OwnerReferences = append(deployInMemberClusterObj10.OwnerReferences, dummyOwnerRef)
+
 	testCases := []struct {
 		name               string
 		manifestObj        *unstructured.Unstructured
 		inMemberClusterObj *unstructured.Unstructured
 		applyStrategy      *fleetv1beta1.ApplyStrategy
-		wantErred          bool
-		wantErrMsgSubStr   string
+		// isCoOwnershipWithOtherPlacementsAllowed tells if the co-ownership policies of all the
+		// placements allow co-ownership.
+		isCoOwnershipWithOtherPlacementsAllowed bool
+		wantErred                               bool
+		wantErrMsgSubStr                        string
 	}{
 		{
 			name:               "multiple owners set on manifest, co-ownership is not allowed",
@@ -285,11 +379,46 @@ func TestValidateOwnerReferences(t *testing.T) {
 			},
 			wantErred: false,
 		},
+		{
+			name:               "placed by Fleet in duplication, co-ownership policy allows co-ownership",
+			manifestObj:        toUnstructured(t, deployManifestObj9),
+			inMemberClusterObj: toUnstructured(t, deployInMemberClusterObj9),
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				AllowCoOwnership:  false,
+				CoOwnershipPolicy: fleetv1beta1.CoOwnershipPolicyTypeSharedFields,
+			},
+			isCoOwnershipWithOtherPlacementsAllowed: true,
+			wantErred:                               false,
+		},
+		{
+			name:               "placed by Fleet in duplication, co-ownership policies of the placements differ",
+			manifestObj:        toUnstructured(t, deployManifestObj9),
+			inMemberClusterObj: toUnstructured(t, deployInMemberClusterObj9),
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				AllowCoOwnership:  false,
+				CoOwnershipPolicy: fleetv1beta1.CoOwnershipPolicyTypeSharedFields,
+			},
+			isCoOwnershipWithOtherPlacementsAllowed: false,
+			wantErred:                               true,
+			wantErrMsgSubStr:                        "object is co-owned by multiple objects but co-ownership has been disallowed",
+		},
+		{
+			name:               "placed by Fleet in duplication and owned by others, co-ownership policy allows co-ownership",
+			manifestObj:        toUnstructured(t, deployManifestObj10),
+			inMemberClusterObj: toUnstructured(t, deployInMemberClusterObj10),
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				AllowCoOwnership:  false,
+				CoOwnershipPolicy: fleetv1beta1.CoOwnershipPolicyTypeLastWriterWins,
+			},
+			isCoOwnershipWithOtherPlacementsAllowed: true,
+			wantErred:                               true,
+			wantErrMsgSubStr:                        "object is co-owned by multiple objects but co-ownership has been disallowed",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			err := validateOwnerReferences(tc.manifestObj, tc.inMemberClusterObj, tc.applyStrategy, appliedWorkOwnerRef)
+			err := validateOwnerReferences(tc.manifestObj, tc.inMemberClusterObj, tc.applyStrategy, appliedWorkOwnerRef, tc.isCoOwnershipWithOtherPlacementsAllowed)
 			switch {
 			case tc.wantErred && err == nil:
 				t.Fatalf("validateOwnerReferences() = nil, want error")
@@ -468,6 +597,7 @@ func TestShouldUseForcedServerSideApply(t *testing.T) {
 	testCases := []struct {
 		name                               string
 		inMemberClusterObj                 client.Object
+		fieldManager                       string
 		wantShouldUseForcedServerSideApply bool
 	}{
 		{
@@ -541,14 +671,457 @@ func TestShouldUseForcedServerSideApply(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "object under Fleet's per-placement field manager's management",
+			inMemberClusterObj: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: configMapName,
+					ManagedFields: []metav1.ManagedFieldsEntry{
+						{
+							Manager:   workFieldManagerName + "/work-1",
+							Operation: metav1.ManagedFieldsOperationUpdate,
+						},
+					},
+				},
+			},
+			fieldManager:                       workFieldManagerName + "/work-1",
+			wantShouldUseForcedServerSideApply: true,
+		},
+		{
+			name: "object shared by multiple placements",
+			inMemberClusterObj: &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: configMapName,
+					ManagedFields: []metav1.ManagedFieldsEntry{
+						{
+							Manager:   workFieldManagerName + "/work-1",
+							Operation: metav1.ManagedFieldsOperationApply,
+						},
+						{
+							Manager:   workFieldManagerName + "/work-2",
+							Operation: metav1.ManagedFieldsOperationApply,
+						},
+					},
+				},
+			},
+			fieldManager: workFieldManagerName + "/work-1",
+		},
 	}
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			got := shouldUseForcedServerSideApply(toUnstructured(t, tc.inMemberClusterObj))
+			fieldManager := tc.fieldManager
+			if fieldManager == "" {
+				fieldManager = workFieldManagerName
+			}
+			got := shouldUseForcedServerSideApply(toUnstructured(t, tc.inMemberClusterObj), fieldManager)
 			if got != tc.wantShouldUseForcedServerSideApply {
 				t.Errorf("shouldUseForcedServerSideApply() = %t, want %t", got, tc.wantShouldUseForcedServerSideApply)
 			}
 		})
 	}
 }
+
+// TestFieldManagerFor tests the fieldManagerFor function.
+func TestFieldManagerFor(t *testing.T) {
+	testCases := []struct {
+		name          string
+		applyStrategy *fleetv1beta1.ApplyStrategy
+		ownerRef      *metav1.OwnerReference
+		want          string
+	}{
+		{
+			name:          "default co-ownership policy",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{Type: fleetv1beta1.ApplyStrategyTypeServerSideApply},
+			ownerRef:      appliedWorkOwnerRef,
+			want:          workFieldManagerName,
+		},
+		{
+			name: "LastWriterWins co-ownership policy",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				Type:              fleetv1beta1.ApplyStrategyTypeServerSideApply,
+				CoOwnershipPolicy: fleetv1beta1.CoOwnershipPolicyTypeLastWriterWins,
+			},
+			ownerRef: appliedWorkOwnerRef,
+			want:     workFieldManagerName,
+		},
+		{
+			name: "SharedFields co-ownership policy",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				Type:              fleetv1beta1.ApplyStrategyTypeServerSideApply,
+				CoOwnershipPolicy: fleetv1beta1.CoOwnershipPolicyTypeSharedFields,
+			},
+			ownerRef: appliedWorkOwnerRef,
+			want:     sharedFieldsFieldManagerFor(appliedWorkOwnerRef),
+		},
+		{
+			name: "SharedFields co-ownership policy, long work name",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				Type:              fleetv1beta1.ApplyStrategyTypeServerSideApply,
+				CoOwnershipPolicy: fleetv1beta1.CoOwnershipPolicyTypeSharedFields,
+			},
+			ownerRef: &metav1.OwnerReference{Name: strings.Repeat("a", 200)},
+			want:     sharedFieldsFieldManagerFor(&metav1.OwnerReference{Name: strings.Repeat("a", 200)}),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := fieldManagerFor(tc.applyStrategy, tc.ownerRef); got != tc.want {
+				t.Errorf("fieldManagerFor() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestSharedFieldsFieldManagerFor tests the sharedFieldsFieldManagerFor function.
+func TestSharedFieldsFieldManagerFor(t *testing.T) {
+	longName1 := strings.Repeat("a", 200) + "-1"
+	longName2 := strings.Repeat("a", 200) + "-2"
+	fieldManager1 := sharedFieldsFieldManagerFor(&metav1.OwnerReference{Name: longName1})
+	fieldManager2 := sharedFieldsFieldManagerFor(&metav1.OwnerReference{Name: longName2})
+	if fieldManager1 == fieldManager2 {
+		t.Errorf("sharedFieldsFieldManagerFor() = %q for both %q and %q, want different field managers", fieldManager1, longName1, longName2)
+	}
+	// The API server rejects field manager names longer than 128 characters.
+	if len(fieldManager1) > 128 {
+		t.Errorf("sharedFieldsFieldManagerFor() = %q, want a name of at most 128 characters", fieldManager1)
+	}
+	if got := sharedFieldsFieldManagerFor(&metav1.OwnerReference{Name: longName1}); got != fieldManager1 {
+		t.Errorf("sharedFieldsFieldManagerFor() = %q, want %q (stable)", got, fieldManager1)
+	}
+}
+
+// TestMigrateFleetFieldManager tests the migrateFleetFieldManager method.
+func TestMigrateFleetFieldManager(t *testing.T) {
+	ctx := context.Background()
+	sharedFieldsFieldManager := sharedFieldsFieldManagerFor(appliedWorkOwnerRef)
+	otherAppliedWorkOwnerRef := &metav1.OwnerReference{
+		APIVersion: fleetv1beta1.GroupVersion.String(),
+		Kind:       fleetv1beta1.AppliedWorkKind,
+		Name:       "other-work",
+		UID:        "other-uid",
+	}
+	managedFieldsEntry := func(manager string) metav1.ManagedFieldsEntry {
+		return metav1.ManagedFieldsEntry{
+			Manager:    manager,
+			Operation:  metav1.ManagedFieldsOperationApply,
+			APIVersion: "v1",
+			FieldsType: "FieldsV1",
+			FieldsV1:   &metav1.FieldsV1{Raw: []byte(`{"f:metadata":{"f:labels":{"f:foo":{}}}}`)},
+		}
+	}
+	nsWith := func(ownerRefs []metav1.OwnerReference, managers ...string) *unstructured.Unstructured {
+		nsObj := ns.DeepCopy()
+		nsObj.ResourceVersion = "1"
+		nsObj.OwnerReferences = ownerRefs
+		for _, manager := range managers {
+			nsObj.ManagedFields = append(nsObj.ManagedFields, managedFieldsEntry(manager))
+		}
+		return toUnstructured(t, nsObj)
+	}
+
+	testCases := []struct {
+		name               string
+		inMemberClusterObj *unstructured.Unstructured
+		fieldManager       string
+		wantManagers       []string
+	}{
+		{
+			name:               "switched to SharedFields",
+			inMemberClusterObj: nsWith([]metav1.OwnerReference{*appliedWorkOwnerRef}, workFieldManagerName),
+			fieldManager:       sharedFieldsFieldManager,
+			wantManagers:       []string{sharedFieldsFieldManager},
+		},
+		{
+			name:               "switched from SharedFields",
+			inMemberClusterObj: nsWith([]metav1.OwnerReference{*appliedWorkOwnerRef}, sharedFieldsFieldManager),
+			fieldManager:       workFieldManagerName,
+			wantManagers:       []string{workFieldManagerName},
+		},
+		{
+			name:               "switched to SharedFields, co-owned by another placement",
+			inMemberClusterObj: nsWith([]metav1.OwnerReference{*appliedWorkOwnerRef, *otherAppliedWorkOwnerRef}, workFieldManagerName),
+			fieldManager:       sharedFieldsFieldManager,
+			wantManagers:       []string{workFieldManagerName},
+		},
+		{
+			name:               "switched from SharedFields, co-owned by another placement",
+			inMemberClusterObj: nsWith([]metav1.OwnerReference{*appliedWorkOwnerRef, *otherAppliedWorkOwnerRef}, sharedFieldsFieldManager),
+			fieldManager:       workFieldManagerName,
+			wantManagers:       []string{workFieldManagerName},
+		},
+		{
+			name:               "field manager in use has applied fields",
+			inMemberClusterObj: nsWith([]metav1.OwnerReference{*appliedWorkOwnerRef}, workFieldManagerName, sharedFieldsFieldManager),
+			fieldManager:       sharedFieldsFieldManager,
+			wantManagers:       []string{workFieldManagerName, sharedFieldsFieldManager},
+		},
+		{
+			name:               "no fields applied by the previous field manager",
+			inMemberClusterObj: nsWith([]metav1.OwnerReference{*appliedWorkOwnerRef}, "other-manager"),
+			fieldManager:       sharedFieldsFieldManager,
+			wantManagers:       []string{"other-manager"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Reconciler{
+				spokeDynamicClient: fake.NewSimpleDynamicClient(scheme.Scheme, tc.inMemberClusterObj),
+			}
+			gotObj, err := r.migrateFleetFieldManager(ctx, &nsGVR, tc.inMemberClusterObj, tc.fieldManager, appliedWorkOwnerRef)
+			if err != nil {
+				t.Fatalf("migrateFleetFieldManager() = %v, want no error", err)
+			}
+			gotManagers := []string{}
+			for _, mf := range gotObj.GetManagedFields() {
+				gotManagers = append(gotManagers, mf.Manager)
+			}
+			if diff := cmp.Diff(gotManagers, tc.wantManagers); diff != "" {
+				t.Errorf("managers of the managed fields mismatched (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestApplyTracksManifestApplyOpMetrics tests that the apply method tracks the result of an apply op
+// on an existing object after the apply op returns.
+func TestApplyTracksManifestApplyOpMetrics(t *testing.T) {
+	ctx := context.Background()
+
+	testCases := []struct {
+		name          string
+		tenant        string
+		applyErr      error
+		wantPerformed float64
+		wantFailed    float64
+	}{
+		{
+			name:          "apply op performed",
+			tenant:        "apply-op-performed",
+			wantPerformed: 1,
+		},
+		{
+			name:       "apply op failed",
+			tenant:     "apply-op-failed",
+			applyErr:   apierrors.NewInternalError(fmt.Errorf("etcdserver: request timed out")),
+			wantFailed: 1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			nsObj := ns.DeepCopy()
+			nsObj.ResourceVersion = "1"
+			nsObj.OwnerReferences = []metav1.OwnerReference{*appliedWorkOwnerRef}
+			inMemberClusterObj := toUnstructured(t, nsObj)
+
+			dynamicClient := fake.NewSimpleDynamicClient(scheme.Scheme, inMemberClusterObj)
+			dynamicClient.PrependReactor("patch", "namespaces", func(_ testingclient.Action) (bool, runtime.Object, error) {
+				if tc.applyErr != nil {
+					return true, nil, tc.applyErr
+				}
+				return true, inMemberClusterObj.DeepCopy(), nil
+			})
+			r := &Reconciler{
+				spokeDynamicClient: dynamicClient,
+				tenant:             tc.tenant,
+			}
+			// Drop the series of the tenant afterwards, so that they do not leak into the tests
+			// that collect the apply op counter.
+			t.Cleanup(func() {
+				membermetrics.FleetManifestApplyOpsTotal.DeletePartialMatch(map[string]string{"tenant": tc.tenant})
+			})
+			applyStrategy := &fleetv1beta1.ApplyStrategy{
+				Type:                  fleetv1beta1.ApplyStrategyTypeServerSideApply,
+				ServerSideApplyConfig: &fleetv1beta1.ServerSideApplyConfig{},
+			}
+
+			_, err := r.apply(ctx, &nsGVR, toUnstructured(t, ns.DeepCopy()), inMemberClusterObj, applyStrategy, appliedWorkOwnerRef)
+			if gotErr := err != nil; gotErr != (tc.applyErr != nil) {
+				t.Fatalf("apply() = %v, want error: %t", err, tc.applyErr != nil)
+			}
+
+			if got := testutil.ToFloat64(membermetrics.FleetManifestApplyOpsTotal.WithLabelValues(manifestApplyOpResultPerformed, tc.tenant)); got != tc.wantPerformed {
+				t.Errorf("performed apply ops = %v, want %v", got, tc.wantPerformed)
+			}
+			if got := testutil.ToFloat64(membermetrics.FleetManifestApplyOpsTotal.WithLabelValues(manifestApplyOpResultFailed, tc.tenant)); got != tc.wantFailed {
+				t.Errorf("failed apply ops = %v, want %v", got, tc.wantFailed)
+			}
+		})
+	}
+}
+
+// TestIsApplyOpNoOp tests the isApplyOpNoOp function.
+func TestIsApplyOpNoOp(t *testing.T) {
+	manifestObj := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"name":      deployName,
+					"namespace": nsName,
+					"labels":    map[string]interface{}{},
+					"annotations": map[string]interface{}{
+						fleetv1beta1.ManifestHashAnnotation: "hash",
+					},
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(1),
+					"template": map[string]interface{}{
+						"spec": map[string]interface{}{
+							"containers": []interface{}{
+								map[string]interface{}{
+									"name":  "nginx",
+									"image": "nginx",
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+	// The object in the member cluster has been defaulted by the API server.
+	inMemberClusterObj := func() *unstructured.Unstructured {
+		obj := manifestObj()
+		obj.SetLabels(nil)
+		obj.SetResourceVersion("1")
+		obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+			{
+				Manager:   workFieldManagerName,
+				Operation: metav1.ManagedFieldsOperationApply,
+			},
+		})
+		_ = unstructured.SetNestedField(obj.Object, int64(10), "spec", "revisionHistoryLimit")
+		_ = unstructured.SetNestedSlice(obj.Object, []interface{}{
+			map[string]interface{}{
+				"name":                     "nginx",
+				"image":                    "nginx",
+				"imagePullPolicy":          "Always",
+				"terminationMessagePolicy": "File",
+			},
+		}, "spec", "template", "spec", "containers")
+		return obj
+	}
+
+	testCases := []struct {
+		name               string
+		manifestObj        *unstructured.Unstructured
+		inMemberClusterObj *unstructured.Unstructured
+		fieldManager       string
+		isServerSideApply  bool
+		want               bool
+	}{
+		{
+			name:               "no changes (server-side apply)",
+			manifestObj:        manifestObj(),
+			inMemberClusterObj: inMemberClusterObj(),
+			fieldManager:       workFieldManagerName,
+			isServerSideApply:  true,
+			want:               true,
+		},
+		{
+			name:        "no changes (three-way merge patch)",
+			manifestObj: manifestObj(),
+			inMemberClusterObj: func() *unstructured.Unstructured {
+				obj := inMemberClusterObj()
+				obj.SetManagedFields(nil)
+				return obj
+			}(),
+			fieldManager: workFieldManagerName,
+			want:         true,
+		},
+		{
+			name:        "fields added in the member cluster",
+			manifestObj: manifestObj(),
+			inMemberClusterObj: func() *unstructured.Unstructured {
+				obj := inMemberClusterObj()
+				obj.SetLabels(map[string]string{"foo": "bar"})
+				return obj
+			}(),
+			fieldManager:      workFieldManagerName,
+			isServerSideApply: true,
+			want:              true,
+		},
+		{
+			name: "manifest changed",
+			manifestObj: func() *unstructured.Unstructured {
+				obj := manifestObj()
+				obj.SetAnnotations(map[string]string{fleetv1beta1.ManifestHashAnnotation: "new-hash"})
+				return obj
+			}(),
+			inMemberClusterObj: inMemberClusterObj(),
+			fieldManager:       workFieldManagerName,
+			isServerSideApply:  true,
+		},
+		{
+			name:        "field changed in the member cluster",
+			manifestObj: manifestObj(),
+			inMemberClusterObj: func() *unstructured.Unstructured {
+				obj := inMemberClusterObj()
+				_ = unstructured.SetNestedField(obj.Object, int64(2), "spec", "replicas")
+				return obj
+			}(),
+			fieldManager:      workFieldManagerName,
+			isServerSideApply: true,
+		},
+		{
+			name:        "list item added in the member cluster",
+			manifestObj: manifestObj(),
+			inMemberClusterObj: func() *unstructured.Unstructured {
+				obj := inMemberClusterObj()
+				containers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+				containers = append(containers, map[string]interface{}{"name": "sidecar", "image": "busybox"})
+				_ = unstructured.SetNestedSlice(obj.Object, containers, "spec", "template", "spec", "containers")
+				return obj
+			}(),
+			fieldManager:      workFieldManagerName,
+			isServerSideApply: true,
+		},
+		{
+			name:        "field removed in the member cluster",
+			manifestObj: manifestObj(),
+			inMemberClusterObj: func() *unstructured.Unstructured {
+				obj := inMemberClusterObj()
+				unstructured.RemoveNestedField(obj.Object, "spec", "replicas")
+				return obj
+			}(),
+			fieldManager:      workFieldManagerName,
+			isServerSideApply: true,
+		},
+		{
+			name:               "not applied by the field manager before (server-side apply)",
+			manifestObj:        manifestObj(),
+			inMemberClusterObj: inMemberClusterObj(),
+			fieldManager:       "other-field-manager",
+			isServerSideApply:  true,
+		},
+		{
+			name:        "updated by the field manager only (server-side apply)",
+			manifestObj: manifestObj(),
+			inMemberClusterObj: func() *unstructured.Unstructured {
+				obj := inMemberClusterObj()
+				obj.SetManagedFields([]metav1.ManagedFieldsEntry{
+					{
+						Manager:   workFieldManagerName,
+						Operation: metav1.ManagedFieldsOperationUpdate,
+					},
+				})
+				return obj
+			}(),
+			fieldManager:      workFieldManagerName,
+			isServerSideApply: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isApplyOpNoOp(tc.manifestObj, tc.inMemberClusterObj, tc.fieldManager, tc.isServerSideApply); got != tc.want {
+				t.Errorf("isApplyOpNoOp() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/resource"
+)
+
+// AuditOperation is the type of a change that the work applier makes to an object in the member cluster.
+type AuditOperation string
+
+const (
+	AuditOperationCreate AuditOperation = "Create"
+	AuditOperationUpdate AuditOperation = "Update"
+	AuditOperationDelete AuditOperation = "Delete"
+)
+
+const (
+	// The reasons why the work applier makes a change, as recorded in the audit trail.
+	AuditReasonApply           = "Apply"
+	AuditReasonTakeOver        = "TakeOver"
+	AuditReasonRemoveLeftOver  = "RemoveLeftOver"
+	AuditReasonDropOwnership   = "DropOwnership"
+	AuditReasonUnblockDeletion = "UnblockDeletion"
+)
+
+// AuditRecord describes a change that the work applier has made to an object in the member cluster.
+//
+// The hashes are computed over the object with the fields that change on every write (e.g., the
+// resource version and the managed fields) removed; comparing the old and the new hashes tells if
+// a write has actually changed the object.
+type AuditRecord struct {
+	Time               time.Time      `json:"time"`
+	Tenant             string         `json:"tenant"`
+	WorkNamespace      string         `json:"workNamespace"`
+	WorkName           string         `json:"workName"`
+	Operation          AuditOperation `json:"operation"`
+	Reason             string         `json:"reason"`
+	Group              string         `json:"group"`
+	Version            string         `json:"version"`
+	Resource           string         `json:"resource"`
+	Kind               string         `json:"kind"`
+	Namespace          string         `json:"namespace,omitempty"`
+	Name               string         `json:"name"`
+	OldResourceVersion string         `json:"oldResourceVersion,omitempty"`
+	NewResourceVersion string         `json:"newResourceVersion,omitempty"`
+	OldHash            string         `json:"oldHash,omitempty"`
+	NewHash            string         `json:"newHash,omitempty"`
+}
+
+// AuditLogger records the changes that the work applier makes to the member cluster, so that
+// one can find out afterwards what Fleet has changed on the member cluster and when.
+//
+// Implementations must be safe for concurrent use, and should not block the work applier for long;
+// failures to record a change are not reported back to the work applier.
+type AuditLogger interface {
+	Log(record *AuditRecord)
+}
+
+// fileAuditLogger is an audit logger that writes the records, one JSON object per line, to a
+// local file, which is rotated when it grows beyond the size limit.
+type fileAuditLogger struct {
+	mu           sync.Mutex
+	path         string
+	maxSizeBytes int64
+	maxBackups   int
+	file         *os.File
+	sizeBytes    int64
+}
+
+var _ AuditLogger = &fileAuditLogger{}
+
+// NewFileAuditLogger returns an audit logger that writes the records to the file at the given path.
+//
+// When the file grows beyond maxSizeBytes, it is renamed to {path}.1 (the existing backups are
+// shifted to {path}.2, {path}.3, and so on) and a new file is started; at most maxBackups backups
+// are kept.
+func NewFileAuditLogger(path string, maxSizeBytes int64, maxBackups int) (AuditLogger, error) {
+	if err := os.MkdirAll(filepath.Dir(path), 0o750); err != nil {
+		return nil, fmt.Errorf("failed to create the directory for the audit log: %w", err)
+	}
+	l := &fileAuditLogger{
+		path:         path,
+		maxSizeBytes: maxSizeBytes,
+		maxBackups:   maxBackups,
+	}
+	if err := l.open(); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Log writes a record to the audit log file.
+func (l *fileAuditLogger) Log(record *AuditRecord) {
+	line, err := json.Marshal(record)
+	if err != nil {
+		klog.ErrorS(err, "Failed to marshal the audit record", "record", *record)
+		return
+	}
+	line = append(line, '\n')
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.sizeBytes > 0 && l.sizeBytes+int64(len(line)) > l.maxSizeBytes {
+		if err := l.rotate(); err != nil {
+			klog.ErrorS(err, "Failed to rotate the audit log file", "path", l.path)
+		}
+	}
+	if l.file == nil {
+		if err := l.open(); err != nil {
+			klog.ErrorS(err, "Failed to open the audit log file", "path", l.path)
+			return
+		}
+	}
+	n, err := l.file.Write(line)
+	l.sizeBytes += int64(n)
+	if err != nil {
+		klog.ErrorS(err, "Failed to write the audit record", "path", l.path, "record", *record)
+	}
+}
+
+// open opens the audit log file for appending.
+func (l *fileAuditLogger) open() error {
+	f, err := os.OpenFile(l.path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open the audit log file: %w", err)
+	}
+	info, err := f.Stat()
+	if err != nil {
+		_ = f.Close()
+		return fmt.Errorf("failed to stat the audit log file: %w", err)
+	}
+	l.file = f
+	l.sizeBytes = info.Size()
+	return nil
+}
+
+// rotate closes the current audit log file and shifts it, along with the existing backups, by one.
+func (l *fileAuditLogger) rotate() error {
+	if err := l.file.Close(); err != nil {
+		klog.ErrorS(err, "Failed to close the audit log file", "path", l.path)
+	}
+	l.file = nil
+	l.sizeBytes = 0
+
+	if l.maxBackups == 0 {
+		return os.Remove(l.path)
+	}
+	for i := l.maxBackups - 1; i >= 1; i-- {
+		if err := os.Rename(backupPathFor(l.path, i), backupPathFor(l.path, i+1)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return os.Rename(l.path, backupPathFor(l.path, 1))
+}
+
+func backupPathFor(path string, idx int) string {
+	return fmt.Sprintf("%s.%d", path, idx)
+}
+
+// auditChange records a change that the work applier has made to an object in the member cluster,
+// if audit logging is enabled.
+//
+// The old object is nil for a create op and the new object is nil for a delete op. An update op
+// that does not change the resource version of the object (i.e., a no-op) is not recorded.
+func (r *Reconciler) auditChange(
+	workName string,
+	op AuditOperation, reason string,
+	gvr schema.GroupVersionResource,
+	oldObj, newObj *unstructured.Unstructured,
+) {
+	if r.auditLogger == nil {
+		return
+	}
+	if op == AuditOperationUpdate && oldObj != nil && newObj != nil && oldObj.GetResourceVersion() == newObj.GetResourceVersion() {
+		return
+	}
+
+	record := &AuditRecord{
+		Time:          time.Now().UTC(),
+		Tenant:        r.tenant,
+		WorkNamespace: r.workNameSpace,
+		WorkName:      workName,
+		Operation:     op,
+		Reason:        reason,
+		Group:         gvr.Group,
+		Version:       gvr.Version,
+		Resource:      gvr.Resource,
+	}
+	for _, obj := range []*unstructured.Unstructured{oldObj, newObj} {
+		if obj != nil {
+			record.Kind = obj.GetKind()
+			record.Namespace = obj.GetNamespace()
+			record.Name = obj.GetName()
+		}
+	}
+	if oldObj != nil {
+		record.OldResourceVersion = oldObj.GetResourceVersion()
+		record.OldHash = auditHashOf(oldObj)
+	}
+	if newObj != nil {
+		record.NewResourceVersion = newObj.GetResourceVersion()
+		record.NewHash = auditHashOf(newObj)
+	}
+	r.auditLogger.Log(record)
+}
+
+// auditHashOf returns the hash of an object with the fields that change on every write removed.
+func auditHashOf(obj *unstructured.Unstructured) string {
+	objCopy := obj.DeepCopy()
+	objCopy.SetResourceVersion("")
+	objCopy.SetManagedFields(nil)
+	hash, err := resource.HashOf(objCopy.Object)
+	if err != nil {
+		klog.ErrorS(err, "Failed to hash the object for the audit record", "object", klog.KObj(obj))
+		return ""
+	}
+	return hash
+}
@@ -0,0 +1,238 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"bufio"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// fakeAuditLogger is an audit logger for testing purposes.
+type fakeAuditLogger struct {
+	records []*AuditRecord
+}
+
+func (l *fakeAuditLogger) Log(record *AuditRecord) {
+	l.records = append(l.records, record)
+}
+
+func auditTestConfigMap(resourceVersion, data string) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":            "app-config",
+				"namespace":       "app",
+				"resourceVersion": resourceVersion,
+			},
+			"data": map[string]interface{}{
+				"key": data,
+			},
+		},
+	}
+}
+
+// TestAuditChange tests the auditChange method.
+func TestAuditChange(t *testing.T) {
+	gvr := schema.GroupVersionResource{Version: "v1", Resource: "configmaps"}
+	oldObj := auditTestConfigMap("1", "old")
+	newObj := auditTestConfigMap("2", "new")
+	// An object that differs from the old object only in the resource version.
+	touchedObj := auditTestConfigMap("2", "old")
+
+	testCases := []struct {
+		name        string
+		op          AuditOperation
+		oldObj      *unstructured.Unstructured
+		newObj      *unstructured.Unstructured
+		wantRecords []*AuditRecord
+	}{
+		{
+			name:   "create",
+			op:     AuditOperationCreate,
+			newObj: newObj,
+			wantRecords: []*AuditRecord{
+				{
+					Tenant:             DefaultTenant,
+					WorkNamespace:      "fleet-member-cluster-1",
+					WorkName:           "work-1",
+					Operation:          AuditOperationCreate,
+					Reason:             AuditReasonApply,
+					Version:            "v1",
+					Resource:           "configmaps",
+					Kind:               "ConfigMap",
+					Namespace:          "app",
+					Name:               "app-config",
+					NewResourceVersion: "2",
+					NewHash:            auditHashOf(newObj),
+				},
+			},
+		},
+		{
+			name:   "update",
+			op:     AuditOperationUpdate,
+			oldObj: oldObj,
+			newObj: newObj,
+			wantRecords: []*AuditRecord{
+				{
+					Tenant:             DefaultTenant,
+					WorkNamespace:      "fleet-member-cluster-1",
+					WorkName:           "work-1",
+					Operation:          AuditOperationUpdate,
+					Reason:             AuditReasonApply,
+					Version:            "v1",
+					Resource:           "configmaps",
+					Kind:               "ConfigMap",
+					Namespace:          "app",
+					Name:               "app-config",
+					OldResourceVersion: "1",
+					NewResourceVersion: "2",
+					OldHash:            auditHashOf(oldObj),
+					NewHash:            auditHashOf(newObj),
+				},
+			},
+		},
+		{
+			name:   "no-op update",
+			op:     AuditOperationUpdate,
+			oldObj: newObj,
+			newObj: newObj,
+		},
+		{
+			name:   "delete",
+			op:     AuditOperationDelete,
+			oldObj: oldObj,
+			wantRecords: []*AuditRecord{
+				{
+					Tenant:             DefaultTenant,
+					WorkNamespace:      "fleet-member-cluster-1",
+					WorkName:           "work-1",
+					Operation:          AuditOperationDelete,
+					Reason:             AuditReasonApply,
+					Version:            "v1",
+					Resource:           "configmaps",
+					Kind:               "ConfigMap",
+					Namespace:          "app",
+					Name:               "app-config",
+					OldResourceVersion: "1",
+					OldHash:            auditHashOf(oldObj),
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			logger := &fakeAuditLogger{}
+			r := &Reconciler{
+				workNameSpace: "fleet-member-cluster-1",
+				tenant:        DefaultTenant,
+				auditLogger:   logger,
+			}
+			r.auditChange("work-1", tc.op, AuditReasonApply, gvr, tc.oldObj, tc.newObj)
+			if diff := cmp.Diff(logger.records, tc.wantRecords, cmpopts.IgnoreFields(AuditRecord{}, "Time")); diff != "" {
+				t.Errorf("audit records mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+
+	// The hash ignores the fields that change on every write.
+	if auditHashOf(oldObj) != auditHashOf(touchedObj) {
+		t.Errorf("auditHashOf() differs for objects that differ only in the resource version")
+	}
+}
+
+// TestFileAuditLogger tests the file-based audit logger, including the rotation of the audit log file.
+func TestFileAuditLogger(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "audit", "audit.log")
+	record := &AuditRecord{
+		WorkNamespace: "fleet-member-cluster-1",
+		WorkName:      "work-1",
+		Operation:     AuditOperationCreate,
+		Reason:        AuditReasonApply,
+		Version:       "v1",
+		Resource:      "configmaps",
+		Kind:          "ConfigMap",
+		Namespace:     "app",
+		Name:          "app-config",
+	}
+	line, err := json.Marshal(record)
+	if err != nil {
+		t.Fatalf("json.Marshal() = %v, want no error", err)
+	}
+	// Allow two records per file.
+	maxSizeBytes := int64(len(line)+1) * 2
+
+	logger, err := NewFileAuditLogger(path, maxSizeBytes, 2)
+	if err != nil {
+		t.Fatalf("NewFileAuditLogger() = %v, want no error", err)
+	}
+	for i := 0; i < 7; i++ {
+		logger.Log(record)
+	}
+
+	// 7 records are written in total: 1 in the current file, 2 in each of the 2 backups, and the
+	// oldest 2 are dropped.
+	wantRecordCounts := map[string]int{
+		path:                   1,
+		backupPathFor(path, 1): 2,
+		backupPathFor(path, 2): 2,
+		backupPathFor(path, 3): 0,
+	}
+	for p, wantCount := range wantRecordCounts {
+		gotRecords := readAuditRecords(t, p)
+		if len(gotRecords) != wantCount {
+			t.Errorf("number of records in %s = %d, want %d", p, len(gotRecords), wantCount)
+		}
+		for _, got := range gotRecords {
+			if diff := cmp.Diff(got, record); diff != "" {
+				t.Errorf("audit record in %s mismatch (-got, +want):\n%s", p, diff)
+			}
+		}
+	}
+}
+
+func readAuditRecords(t *testing.T, path string) []*AuditRecord {
+	f, err := os.Open(path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		t.Fatalf("os.Open(%s) = %v, want no error", path, err)
+	}
+	defer f.Close()
+
+	var records []*AuditRecord
+	scanner := bufio.NewScanner(f)
+	for scanner.Scan() {
+		record := &AuditRecord{}
+		if err := json.Unmarshal(scanner.Bytes(), record); err != nil {
+			t.Fatalf("json.Unmarshal() = %v, want no error", err)
+		}
+		records = append(records, record)
+	}
+	return records
+}
@@ -21,6 +21,7 @@ import (
 	"fmt"
 
 	appv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	apiextensionshelpers "k8s.io/apiextensions-apiserver/pkg/apihelpers"
@@ -115,6 +116,13 @@ func trackInMemberClusterObjAvailabilityByGVR(
 		return trackCRDAvailability(inMemberClusterObj)
 	case utils.PodDisruptionBudgetGVR:
 		return trackPDBAvailability(inMemberClusterObj)
+	case utils.JobGVR:
+		return trackJobAvailability(inMemberClusterObj)
+	case utils.CronJobGVR:
+		// A cron job only schedules jobs per its schedule; Fleet considers it to be available
+		// as soon as it has been applied.
+		klog.V(2).InfoS("CronJob is available", "cronJob", klog.KObj(inMemberClusterObj))
+		return AvailabilityResultTypeAvailable, nil
 	default:
 		if isDataResource(*gvr) {
 			klog.V(2).InfoS("The object from the member cluster is a data object, consider it to be immediately available",
@@ -280,6 +288,42 @@ func trackPDBAvailability(curObj *unstructured.Unstructured) (ManifestProcessing
 	return AvailabilityResultTypeNotYetAvailable, nil
 }
 
+// trackJobAvailability tracks the availability of a job in the member cluster.
+//
+// A job is considered to be available once it has completed; a failed job is reported as an
+// availability check failure, as it will never become available.
+func trackJobAvailability(inMemberClusterObj *unstructured.Unstructured) (ManifestProcessingAvailabilityResultType, error) {
+	if inMemberClusterObj == nil {
+		// The job has completed and has been cleaned up per its TTL; see the
+		// skipFinishedJobCleanedUpIfApplicable method for more information.
+		return AvailabilityResultTypeAvailable, nil
+	}
+
+	var job batchv1.Job
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(inMemberClusterObj.Object, &job); err != nil {
+		// Normally this branch should never run.
+		wrappedErr := fmt.Errorf("failed to convert the unstructured object to a job: %w", err)
+		_ = controller.NewUnexpectedBehaviorError(wrappedErr)
+		return AvailabilityResultTypeFailed, wrappedErr
+	}
+
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			klog.V(2).InfoS("Job has completed and is available", "job", klog.KObj(inMemberClusterObj))
+			return AvailabilityResultTypeAvailable, nil
+		case batchv1.JobFailed:
+			klog.V(2).InfoS("Job has failed", "job", klog.KObj(inMemberClusterObj), "reason", cond.Reason)
+			return AvailabilityResultTypeFailed, fmt.Errorf("job has failed (reason = %s): %s", cond.Reason, cond.Message)
+		}
+	}
+	klog.V(2).InfoS("Job has not completed yet, will check later to see if it becomes available", "job", klog.KObj(inMemberClusterObj))
+	return AvailabilityResultTypeNotYetAvailable, nil
+}
+
 // isDataResource checks if the resource is a data resource; such resources are
 // available immediately after creation.
 func isDataResource(gvr schema.GroupVersionResource) bool {
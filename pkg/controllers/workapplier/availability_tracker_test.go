@@ -43,6 +43,22 @@ import (
 )
 
 var (
+	untrackableGVR = schema.GroupVersionResource{
+		Group:    "example.com",
+		Version:  "v1",
+		Resource: "widgets",
+	}
+	untrackableObj = &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "example.com/v1",
+			"kind":       "Widget",
+			"metadata": map[string]interface{}{
+				"name":      "widget",
+				"namespace": nsName,
+			},
+		},
+	}
+
 	statefulSetTemplate = &appsv1.StatefulSet{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "apps/v1",
@@ -802,6 +818,78 @@ func TestTrackPDBAvailability(t *testing.T) {
 	}
 }
 
+// TestTrackJobAvailability tests the trackJobAvailability function.
+func TestTrackJobAvailability(t *testing.T) {
+	jobWithConditions := func(conds ...batchv1.JobCondition) *unstructured.Unstructured {
+		return toUnstructured(t, &batchv1.Job{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "batch/v1",
+				Kind:       "Job",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      "job",
+				Namespace: nsName,
+			},
+			Status: batchv1.JobStatus{
+				Conditions: conds,
+			},
+		})
+	}
+
+	testCases := []struct {
+		name                       string
+		job                        *unstructured.Unstructured
+		wantAvailabilityResultType ManifestProcessingAvailabilityResultType
+		wantErred                  bool
+	}{
+		{
+			name:                       "running job",
+			job:                        jobWithConditions(),
+			wantAvailabilityResultType: AvailabilityResultTypeNotYetAvailable,
+		},
+		{
+			name: "completed job",
+			job: jobWithConditions(
+				batchv1.JobCondition{Type: batchv1.JobSuccessCriteriaMet, Status: corev1.ConditionTrue},
+				batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionTrue},
+			),
+			wantAvailabilityResultType: AvailabilityResultTypeAvailable,
+		},
+		{
+			name: "failed job",
+			job: jobWithConditions(
+				batchv1.JobCondition{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "BackoffLimitExceeded"},
+			),
+			wantAvailabilityResultType: AvailabilityResultTypeFailed,
+			wantErred:                  true,
+		},
+		{
+			name: "suspended job",
+			job: jobWithConditions(
+				batchv1.JobCondition{Type: batchv1.JobSuspended, Status: corev1.ConditionTrue},
+				batchv1.JobCondition{Type: batchv1.JobComplete, Status: corev1.ConditionFalse},
+			),
+			wantAvailabilityResultType: AvailabilityResultTypeNotYetAvailable,
+		},
+		{
+			name:                       "completed job cleaned up",
+			wantAvailabilityResultType: AvailabilityResultTypeAvailable,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotResTyp, err := trackJobAvailability(tc.job)
+			if gotErred := err != nil; gotErred != tc.wantErred {
+				t.Fatalf("trackJobAvailability() = %v, want erred %t", err, tc.wantErred)
+			}
+			if gotResTyp != tc.wantAvailabilityResultType {
+				t.Errorf("manifestProcessingAvailabilityResultType = %v, want %v", gotResTyp, tc.wantAvailabilityResultType)
+			}
+		})
+	}
+}
+
 // TestTrackInMemberClusterObjAvailabilityByGVR tests the trackInMemberClusterObjAvailabilityByGVR function.
 func TestTrackInMemberClusterObjAvailabilityByGVR(t *testing.T) {
 	availableDeploy := deploy.DeepCopy()
@@ -868,11 +956,19 @@ func TestTrackInMemberClusterObjAvailabilityByGVR(t *testing.T) {
 		},
 	}
 
-	untrackableJob := &batchv1.Job{
+	completedJob := &batchv1.Job{
 		TypeMeta: metav1.TypeMeta{
 			APIVersion: "batch/v1",
 			Kind:       "Job",
 		},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{
+				{
+					Type:   batchv1.JobComplete,
+					Status: corev1.ConditionTrue,
+				},
+			},
+		},
 	}
 
 	testCases := []struct {
@@ -924,9 +1020,21 @@ func TestTrackInMemberClusterObjAvailabilityByGVR(t *testing.T) {
 			wantAvailabilityResultType: AvailabilityResultTypeAvailable,
 		},
 		{
-			name:                       "untrackable object (job)",
+			name:                       "available job",
 			gvr:                        utils.JobGVR,
-			inMemberClusterObj:         toUnstructured(t, untrackableJob),
+			inMemberClusterObj:         toUnstructured(t, completedJob),
+			wantAvailabilityResultType: AvailabilityResultTypeAvailable,
+		},
+		{
+			name:                       "available cron job",
+			gvr:                        utils.CronJobGVR,
+			inMemberClusterObj:         toUnstructured(t, &batchv1.CronJob{}),
+			wantAvailabilityResultType: AvailabilityResultTypeAvailable,
+		},
+		{
+			name:                       "untrackable object (custom resource)",
+			gvr:                        untrackableGVR,
+			inMemberClusterObj:         untrackableObj.DeepCopy(),
 			wantAvailabilityResultType: AvailabilityResultTypeNotTrackable,
 		},
 		{
@@ -1030,8 +1138,6 @@ func TestTrackInMemberClusterObjAvailability(t *testing.T) {
 		UpdatedNumberScheduled: 2,
 	}
 
-	untrackableJob := &batchv1.Job{}
-
 	testCases := []struct {
 		name        string
 		bundles     []*manifestProcessingBundle
@@ -1074,8 +1180,8 @@ func TestTrackInMemberClusterObjAvailability(t *testing.T) {
 					id: &fleetv1beta1.WorkResourceIdentifier{
 						Ordinal: 3,
 					},
-					gvr:                     &utils.JobGVR,
-					inMemberClusterObj:      toUnstructured(t, untrackableJob),
+					gvr:                     &untrackableGVR,
+					inMemberClusterObj:      untrackableObj.DeepCopy(),
 					applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
 				},
 			},
@@ -1111,8 +1217,8 @@ func TestTrackInMemberClusterObjAvailability(t *testing.T) {
 					id: &fleetv1beta1.WorkResourceIdentifier{
 						Ordinal: 3,
 					},
-					gvr:                     &utils.JobGVR,
-					inMemberClusterObj:      toUnstructured(t, untrackableJob),
+					gvr:                     &untrackableGVR,
+					inMemberClusterObj:      untrackableObj.DeepCopy(),
 					applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
 					availabilityResTyp:      AvailabilityResultTypeNotTrackable,
 				},
@@ -26,6 +26,7 @@ import (
 	"k8s.io/klog/v2"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/backoff"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/resource"
@@ -232,26 +233,21 @@ func (r *RequeueMultiStageWithExponentialBackoffRateLimiter) When(work *fleetv1b
 			condition.IsConditionStatusTrue(diffReportedCond, work.Generation)):
 		// The Work object is already in an available or diff reported state,
 		// and the rate limiter is configured to skip to the fast backoff stage under such conditions.
-		requeueDelay = time.Duration(float64(lastRequeueDelayWithBackoff) * r.exponentialBaseForFastBackoff)
+		requeueDelay = backoff.Grow(lastRequeueDelayWithBackoff, r.exponentialBaseForFastBackoff, r.maxFastBackoffDelay)
 	case lastRequeueDelayWithBackoff >= r.maxSlowBackoffDelay:
 		// The requeue delay has reached the cap for the slow backoff stage;
 		// start to fast back off.
-		requeueDelay = time.Duration(float64(lastRequeueDelayWithBackoff) * r.exponentialBaseForFastBackoff)
+		requeueDelay = backoff.Grow(lastRequeueDelayWithBackoff, r.exponentialBaseForFastBackoff, r.maxFastBackoffDelay)
 	default:
 		// Continue to slow back off.
-		requeueDelay = time.Duration(float64(lastRequeueDelayWithBackoff) * r.exponentialBaseForSlowBackoff)
+		requeueDelay = backoff.Grow(lastRequeueDelayWithBackoff, r.exponentialBaseForSlowBackoff, r.maxFastBackoffDelay)
 		if requeueDelay > r.maxSlowBackoffDelay {
 			// Backing off slowly this time will break the cap for the slow backoff stage;
 			// switch to fast back off.
-			requeueDelay = time.Duration(float64(lastRequeueDelayWithBackoff) * r.exponentialBaseForFastBackoff)
+			requeueDelay = backoff.Grow(lastRequeueDelayWithBackoff, r.exponentialBaseForFastBackoff, r.maxFastBackoffDelay)
 		}
 	}
 
-	// Cap on the requeue delay.
-	if requeueDelay > r.maxFastBackoffDelay {
-		requeueDelay = r.maxFastBackoffDelay
-	}
-
 	// Update the requeue counter and last requeue delay tracker.
 	if numRequeues+1 < maxNumOfRequeuesToTrack {
 		r.requeueCounter[namespacedName] = numRequeues + 1
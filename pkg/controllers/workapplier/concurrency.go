@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"sync"
+
+	"k8s.io/klog/v2"
+)
+
+// applyConcurrencyLimiter limits the number of Work objects that the work applier processes at the
+// same time. Unlike the number of concurrent reconciles, which is fixed when the controller is set up,
+// the limit can be changed at runtime (e.g., per the InternalMemberCluster spec).
+type applyConcurrencyLimiter struct {
+	mu       sync.Mutex
+	limit    int
+	inFlight int
+	// changed is closed (and replaced) whenever a slot is released or the limit is changed, so that
+	// waiters can re-check if they can proceed.
+	changed chan struct{}
+}
+
+func newApplyConcurrencyLimiter(limit int) *applyConcurrencyLimiter {
+	return &applyConcurrencyLimiter{
+		limit:   limit,
+		changed: make(chan struct{}),
+	}
+}
+
+// acquire blocks until a slot is available or the context is cancelled. A nil limiter does not
+// limit anything.
+func (l *applyConcurrencyLimiter) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	for {
+		l.mu.Lock()
+		if l.inFlight < l.limit {
+			l.inFlight++
+			l.mu.Unlock()
+			return nil
+		}
+		changed := l.changed
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// release frees a slot acquired earlier.
+func (l *applyConcurrencyLimiter) release() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inFlight--
+	l.notifyLocked()
+}
+
+// setLimit changes the limit; slots that are in use are not affected.
+func (l *applyConcurrencyLimiter) setLimit(limit int) {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limit == limit {
+		return
+	}
+	l.limit = limit
+	l.notifyLocked()
+}
+
+func (l *applyConcurrencyLimiter) notifyLocked() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
+
+// SetApplyConcurrency sets the maximum number of Work objects that the work applier processes at the
+// same time, and returns the concurrency that is in effect.
+//
+// The concurrency is capped by the number of concurrent reconciles the work applier is set up with;
+// a nil value restores the latter.
+func (r *Reconciler) SetApplyConcurrency(concurrency *int32) int32 {
+	effective := r.concurrentReconciles
+	if concurrency != nil && int(*concurrency) < effective {
+		effective = max(int(*concurrency), 1)
+	}
+	r.applyLimiter.setLimit(effective)
+	klog.V(2).InfoS("Set the work apply concurrency", "requested", concurrency, "effective", effective)
+	return int32(effective)
+}
@@ -0,0 +1,121 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8s.io/utils/ptr"
+)
+
+// TestSetApplyConcurrency tests the SetApplyConcurrency method.
+func TestSetApplyConcurrency(t *testing.T) {
+	testCases := []struct {
+		name        string
+		concurrency *int32
+		want        int32
+	}{
+		{
+			name: "not set",
+			want: 5,
+		},
+		{
+			name:        "throttled",
+			concurrency: ptr.To(int32(2)),
+			want:        2,
+		},
+		{
+			name:        "capped by concurrent reconciles",
+			concurrency: ptr.To(int32(10)),
+			want:        5,
+		},
+		{
+			name:        "invalid value",
+			concurrency: ptr.To(int32(0)),
+			want:        1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Reconciler{
+				concurrentReconciles: 5,
+				applyLimiter:         newApplyConcurrencyLimiter(5),
+			}
+			if got := r.SetApplyConcurrency(tc.concurrency); got != tc.want {
+				t.Errorf("SetApplyConcurrency() = %d, want %d", got, tc.want)
+			}
+			if got := r.applyLimiter.limit; got != int(tc.want) {
+				t.Errorf("applyLimiter.limit = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestApplyConcurrencyLimiter tests the applyConcurrencyLimiter.
+func TestApplyConcurrencyLimiter(t *testing.T) {
+	l := newApplyConcurrencyLimiter(1)
+	if err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("acquire() = %v, want no error", err)
+	}
+
+	// The limit has been reached; acquire should block until the context expires.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := l.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("acquire() = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// Raising the limit should unblock the waiters.
+	acquired := make(chan error, 1)
+	go func() {
+		acquired <- l.acquire(context.Background())
+	}()
+	l.setLimit(2)
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatalf("acquire() = %v, want no error", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("acquire() did not return after the limit is raised")
+	}
+
+	// Lowering the limit should block new acquirers until enough slots are released.
+	l.setLimit(1)
+	go func() {
+		acquired <- l.acquire(context.Background())
+	}()
+	l.release()
+	select {
+	case <-acquired:
+		t.Fatalf("acquire() returned before enough slots are released")
+	case <-time.After(50 * time.Millisecond):
+	}
+	l.release()
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatalf("acquire() = %v, want no error", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("acquire() did not return after the slots are released")
+	}
+}
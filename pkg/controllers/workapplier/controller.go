@@ -41,8 +41,10 @@ import (
 	ctrloption "sigs.k8s.io/controller-runtime/pkg/controller"
 	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
 	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
 	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+	"sigs.k8s.io/controller-runtime/pkg/source"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
@@ -106,11 +108,28 @@ type Reconciler struct {
 	restMapper           meta.RESTMapper
 	recorder             record.EventRecorder
 	concurrentReconciles int
-	deletionWaitTime     time.Duration
-	joined               *atomic.Bool
-	parallelizer         parallelizerutil.Parallelizer
-	requeueRateLimiter   *RequeueMultiStageWithExponentialBackoffRateLimiter
-	usePriorityQueue     bool
+	// applyLimiter limits the number of Work objects processed at the same time; the limit can be
+	// tuned at runtime, but never exceeds the number of concurrent reconciles.
+	applyLimiter       *applyConcurrencyLimiter
+	deletionWaitTime   time.Duration
+	joined             *atomic.Bool
+	parallelizer       parallelizerutil.Parallelizer
+	requeueRateLimiter *RequeueMultiStageWithExponentialBackoffRateLimiter
+	usePriorityQueue   bool
+	postApplyHooks     []PostApplyHook
+	// The audit logger (if any) that records the changes the work applier makes to the member cluster.
+	auditLogger AuditLogger
+	// The transformers (if any) that the work applier runs on the manifest objects before they are applied.
+	manifestTransformers []ManifestTransformer
+	// The shard membership (if any) that decides which Work objects the work applier processes
+	// when Work objects are sharded among the member agent replicas.
+	shardMembership *ShardMembership
+	// The deletion watcher (if any) that watches the resources placed on the member cluster, so that
+	// the Work objects which have opted in are re-processed right away when their resources are deleted.
+	deletionWatcher *deletionWatcher
+	// The tenant that the work applier serves; it is used to label the metrics emitted by the
+	// work applier, so that the processing of different tenants can be told apart.
+	tenant string
 	// The custom priority queue in use if the option watchWorkWithPriorityQueue is enabled.
 	//
 	// Note that this variable is set only after the controller starts.
@@ -124,7 +143,7 @@ type Reconciler struct {
 // NewReconciler returns a new Work object reconciler for the work applier.
 func NewReconciler(
 	controllerName string,
-	hubClient client.Client, workNameSpace string,
+	hubClient client.Client, workNameSpace, tenant string,
 	spokeDynamicClient dynamic.Interface, spokeClient client.Client, restMapper meta.RESTMapper,
 	recorder record.EventRecorder,
 	concurrentReconciles int,
@@ -134,6 +153,11 @@ func NewReconciler(
 	usePriorityQueue bool,
 	priorityLinearEquationCoeffA *int,
 	priorityLinearEquationCoeffB *int,
+	postApplyHooks []PostApplyHook,
+	auditLogger AuditLogger,
+	shardMembership *ShardMembership,
+	manifestTransformers []ManifestTransformer,
+	maxDeletionWatchResourceTypes int,
 ) *Reconciler {
 	if requeueRateLimiter == nil {
 		klog.V(2).InfoS("requeue rate limiter is not set; using the default rate limiter")
@@ -149,6 +173,10 @@ func NewReconciler(
 		priorityLinearEquationCoeffA = ptr.To(-3)
 		priorityLinearEquationCoeffB = ptr.To(int(highestPriorityLevel))
 	}
+	var watcher *deletionWatcher
+	if maxDeletionWatchResourceTypes > 0 {
+		watcher = newDeletionWatcher(spokeDynamicClient, workNameSpace, maxDeletionWatchResourceTypes)
+	}
 
 	return &Reconciler{
 		controllerName:       controllerName,
@@ -158,12 +186,19 @@ func NewReconciler(
 		restMapper:           restMapper,
 		recorder:             recorder,
 		concurrentReconciles: concurrentReconciles,
+		applyLimiter:         newApplyConcurrencyLimiter(concurrentReconciles),
 		parallelizer:         parallelizer,
 		workNameSpace:        workNameSpace,
+		tenant:               tenant,
 		joined:               atomic.NewBool(false),
 		deletionWaitTime:     deletionWaitTime,
 		requeueRateLimiter:   requeueRateLimiter,
 		usePriorityQueue:     usePriorityQueue,
+		postApplyHooks:       postApplyHooks,
+		auditLogger:          auditLogger,
+		shardMembership:      shardMembership,
+		deletionWatcher:      watcher,
+		manifestTransformers: manifestTransformers,
 		priLinearEqCoeffA:    *priorityLinearEquationCoeffA,
 		priLinearEqCoeffB:    *priorityLinearEquationCoeffB,
 	}
@@ -190,6 +225,12 @@ const (
 	ApplyOrReportDiffResTypeFailedToRunDriftDetection      ManifestProcessingApplyOrReportDiffResultType = "FailedToRunDriftDetection"
 	ApplyOrReportDiffResTypeFoundDrifts                    ManifestProcessingApplyOrReportDiffResultType = "FoundDrifts"
 	ApplyOrReportDiffResTypeFoundDriftsInDegradedMode      ManifestProcessingApplyOrReportDiffResultType = "FoundDriftsInDegradedMode"
+	ApplyOrReportDiffResTypeExceedsResourceQuota           ManifestProcessingApplyOrReportDiffResultType = "ExceedsResourceQuota"
+	ApplyOrReportDiffResTypeRejectedByAdmissionWebhook     ManifestProcessingApplyOrReportDiffResultType = "RejectedByAdmissionWebhook"
+	ApplyOrReportDiffResTypeCRDNotEstablished              ManifestProcessingApplyOrReportDiffResultType = "CRDNotEstablished"
+	ApplyOrReportDiffResTypeCRDVersionSkew                 ManifestProcessingApplyOrReportDiffResultType = "CRDVersionSkew"
+	ApplyOrReportDiffResTypeFailedToTransform              ManifestProcessingApplyOrReportDiffResultType = "FailedToTransform"
+	ApplyOrReportDiffResTypeInvalidTransformation          ManifestProcessingApplyOrReportDiffResultType = "InvalidTransformation"
 	// Note that the reason string below uses the same value as kept in the old work applier.
 	ApplyOrReportDiffResTypeFailedToApply ManifestProcessingApplyOrReportDiffResultType = "ManifestApplyFailed"
 
@@ -236,6 +277,12 @@ var (
 		ApplyOrReportDiffResTypeFailedToRunDriftDetection,
 		ApplyOrReportDiffResTypeFoundDrifts,
 		ApplyOrReportDiffResTypeFoundDriftsInDegradedMode,
+		ApplyOrReportDiffResTypeExceedsResourceQuota,
+		ApplyOrReportDiffResTypeRejectedByAdmissionWebhook,
+		ApplyOrReportDiffResTypeCRDNotEstablished,
+		ApplyOrReportDiffResTypeCRDVersionSkew,
+		ApplyOrReportDiffResTypeFailedToTransform,
+		ApplyOrReportDiffResTypeInvalidTransformation,
 		ApplyOrReportDiffResTypeFailedToApply,
 		ApplyOrReportDiffResTypeAppliedWithFailedDriftDetection,
 		ApplyOrReportDiffResTypeApplied,
@@ -298,9 +345,18 @@ type manifestProcessingBundle struct {
 	applyOrReportDiffErr error
 	// The error that stops the availability check op.
 	availabilityErr error
+	// The rejection from an admission webhook in the member cluster when the manifest object is
+	// dry-run in the admission preflight (if applicable).
+	admissionPreflightErr error
+	// The bundle of the CRD placed in the same Work object that defines the manifest object (if
+	// applicable); the manifest object is processed after the CRD has been established.
+	definingCRDBundle *manifestProcessingBundle
 	// Configuration drifts/diffs detected during the apply op or the diff reporting op.
 	drifts []fleetv1beta1.PatchDetail
 	diffs  []fleetv1beta1.PatchDetail
+	// The JSON pointers of the fields in which the manifest transformers have revealed sensitive
+	// data (e.g., the decrypted fields); the drifts/diffs found in these fields are redacted.
+	sensitivePaths []string
 }
 
 // Reconcile implement the control loop logic for Work object.
@@ -309,6 +365,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		klog.V(2).InfoS("Work applier has not started yet", "work", req.NamespacedName)
 		return ctrl.Result{RequeueAfter: time.Second * 5}, nil
 	}
+	// Skip the Work object if it is assigned to another member agent replica; the Work object is
+	// enqueued again if the replica takes it over as the membership changes.
+	if r.shardMembership != nil && !r.shardMembership.Owns(req.Name) {
+		klog.V(4).InfoS("Work object is assigned to another member agent replica", "work", req.NamespacedName)
+		return ctrl.Result{}, nil
+	}
+	// Wait for a slot if the apply concurrency has been throttled.
+	if err := r.applyLimiter.acquire(ctx); err != nil {
+		klog.V(2).InfoS("Work applier reconciliation is cancelled while waiting for the apply concurrency", "work", req.NamespacedName)
+		return ctrl.Result{}, err
+	}
+	defer r.applyLimiter.release()
 	startTime := time.Now()
 	klog.V(2).InfoS("Work applier reconciliation starts", "work", req.NamespacedName)
 	defer func() {
@@ -322,6 +390,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	switch {
 	case apierrors.IsNotFound(err):
 		klog.V(2).InfoS("Work object has been deleted", "work", req.NamespacedName)
+		if r.deletionWatcher != nil {
+			r.deletionWatcher.forget(req.Name)
+		}
 		return ctrl.Result{}, nil
 	case err != nil:
 		klog.ErrorS(err, "Failed to retrieve the work", "work", req.NamespacedName)
@@ -353,6 +424,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 	// later steps.
 	defaulter.SetDefaultsWork(work)
 
+	// Decide how drifts are handled in this run, if drift remediation windows are specified.
+	untilNextWindowBoundary := applyDriftRemediationWindowIfApplicable(work, time.Now())
+
 	age := time.Since(work.CreationTimestamp.Time)
 	klog.V(4).InfoS("reconciling Work", "work", req.NamespacedName, "age", age)
 
@@ -379,6 +453,33 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// Transform the manifests with the manifest transformers, if applicable.
+	//
+	// This runs after the write-ahead step, so that the objects applied previously are still tracked
+	// (and not removed as left-overs) even if their manifests fail to transform; manifests that
+	// fail to transform are skipped in the later steps.
+	r.transformManifestsIfApplicable(ctx, bundles, work)
+
+	// Verify that the workloads fit in the resource quotas of their namespaces, if applicable.
+	//
+	// Workloads that would exceed a quota are skipped in the later steps.
+	if err := r.checkResourceQuotasIfApplicable(ctx, bundles, work); err != nil {
+		klog.ErrorS(err, "Failed to check the resource quotas", "work", workRef)
+		return ctrl.Result{}, err
+	}
+
+	// Dry-run the manifests against the admission webhooks in the member cluster, if applicable.
+	//
+	// Manifests rejected by an admission webhook are skipped in the later steps if the preflight
+	// is enforced.
+	r.runAdmissionPreflightIfApplicable(ctx, bundles, work)
+
+	// Verify that the custom resources are compatible with their CRDs in the member cluster.
+	//
+	// Custom resources that use versions or fields unknown to the CRDs (e.g., the member cluster has
+	// an older version of a CRD) are skipped in the later steps.
+	r.checkCustomResourcesAgainstMemberClusterCRDs(ctx, bundles, work)
+
 	// Process the manifests.
 	//
 	// In this step, Fleet will:
@@ -392,6 +493,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
+	// Watch the placed resources for out-of-band deletions, if applicable.
+	r.watchForDeletionIfApplicable(work, bundles)
+
 	// Track the availability information.
 	if err := r.trackInMemberClusterObjAvailability(ctx, bundles, workRef); err != nil {
 		klog.ErrorS(err, "Failed to check for object availability", "work", workRef)
@@ -410,13 +514,18 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		return ctrl.Result{}, err
 	}
 
-	trackWorkAndManifestProcessingRequestMetrics(work)
+	trackWorkAndManifestProcessingRequestMetrics(work, r.tenant)
 
 	// Requeue the Work object with a delay based on the requeue rate limiter.
 	//
 	// Note (chenyu1): at this moment the work applier does not register changes on back-reported
 	// status as a trigger for resetting the rate limiter.
 	requeueDelay := r.requeueRateLimiter.When(work, bundles)
+	if untilNextWindowBoundary > 0 && untilNextWindowBoundary < requeueDelay {
+		// Re-process the Work object when the next drift remediation window starts or ends, so
+		// that the new setting takes effect in time.
+		requeueDelay = untilNextWindowBoundary
+	}
 	klog.V(2).InfoS("Requeue the Work object for re-processing", "work", workRef, "delaySeconds", requeueDelay.Seconds())
 	if r.usePriorityQueue {
 		// A priority queue is in use; requeue the Work object with custom logic.
@@ -437,40 +546,47 @@ func (r *Reconciler) garbageCollectAppliedWork(ctx context.Context, work *fleetv
 	if !controllerutil.ContainsFinalizer(work, fleetv1beta1.WorkFinalizer) {
 		return ctrl.Result{}, nil
 	}
+	appliedWorkName := r.appliedWorkNameOf(work)
 	appliedWork := &fleetv1beta1.AppliedWork{
-		ObjectMeta: metav1.ObjectMeta{Name: work.Name},
+		ObjectMeta: metav1.ObjectMeta{Name: appliedWorkName},
 	}
 	// Get the AppliedWork object
-	if err := r.spokeClient.Get(ctx, types.NamespacedName{Name: work.Name}, appliedWork); err != nil {
+	if err := r.spokeClient.Get(ctx, types.NamespacedName{Name: appliedWorkName}, appliedWork); err != nil {
 		if apierrors.IsNotFound(err) {
-			klog.V(2).InfoS("The appliedWork is already deleted, removing the finalizer from the work", "appliedWork", work.Name)
+			klog.V(2).InfoS("The appliedWork is already deleted, removing the finalizer from the work", "appliedWork", appliedWorkName)
 			return r.forgetWorkAndRemoveFinalizer(ctx, work)
 		}
-		klog.ErrorS(err, "Failed to get AppliedWork", "appliedWork", work.Name)
+		klog.ErrorS(err, "Failed to get AppliedWork", "appliedWork", appliedWorkName)
 		return ctrl.Result{}, controller.NewAPIServerError(false, err)
 	}
+	if !isAppliedWorkOwnedBy(appliedWork, work) {
+		// The AppliedWork belongs to a Work object of the same name from another namespace; leave it alone.
+		klog.V(2).InfoS("The appliedWork is in use by another work, removing the finalizer from the work",
+			"appliedWork", appliedWorkName, "appliedWorkNamespace", appliedWork.Spec.WorkNamespace, "work", klog.KObj(work))
+		return r.forgetWorkAndRemoveFinalizer(ctx, work)
+	}
 
 	// Handle stuck deletion after 5 minutes where the other owner references might not exist or are invalid.
 	if !appliedWork.DeletionTimestamp.IsZero() && time.Since(appliedWork.DeletionTimestamp.Time) >= r.deletionWaitTime {
-		klog.V(2).InfoS("AppliedWork deletion appears stuck; attempting to patch owner references", "appliedWork", work.Name)
+		klog.V(2).InfoS("AppliedWork deletion appears stuck; attempting to patch owner references", "appliedWork", appliedWorkName)
 		if err := r.updateOwnerReference(ctx, work, appliedWork); err != nil {
-			klog.ErrorS(err, "Failed to update owner references for AppliedWork", "appliedWork", work.Name)
+			klog.ErrorS(err, "Failed to update owner references for AppliedWork", "appliedWork", appliedWorkName)
 			return ctrl.Result{}, controller.NewAPIServerError(false, err)
 		}
-		return ctrl.Result{}, fmt.Errorf("AppliedWork %s is being deleted, waiting for the deletion to complete", work.Name)
+		return ctrl.Result{}, fmt.Errorf("AppliedWork %s is being deleted, waiting for the deletion to complete", appliedWorkName)
 	}
 
 	if err := r.spokeClient.Delete(ctx, appliedWork, &client.DeleteOptions{PropagationPolicy: &deletePolicy}); err != nil {
 		if apierrors.IsNotFound(err) {
-			klog.V(2).InfoS("AppliedWork already deleted", "appliedWork", work.Name)
+			klog.V(2).InfoS("AppliedWork already deleted", "appliedWork", appliedWorkName)
 			return r.forgetWorkAndRemoveFinalizer(ctx, work)
 		}
-		klog.V(2).ErrorS(err, "Failed to delete the appliedWork", "appliedWork", work.Name)
+		klog.V(2).ErrorS(err, "Failed to delete the appliedWork", "appliedWork", appliedWorkName)
 		return ctrl.Result{}, controller.NewAPIServerError(false, err)
 	}
 
-	klog.V(2).InfoS("AppliedWork deletion in progress", "appliedWork", work.Name)
-	return ctrl.Result{}, fmt.Errorf("AppliedWork %s is being deleted, waiting for the deletion to complete", work.Name)
+	klog.V(2).InfoS("AppliedWork deletion in progress", "appliedWork", appliedWorkName)
+	return ctrl.Result{}, fmt.Errorf("AppliedWork %s is being deleted, waiting for the deletion to complete", appliedWorkName)
 }
 
 // updateOwnerReference updates the AppliedWork owner reference in the manifest objects.
@@ -523,11 +639,14 @@ func (r *Reconciler) updateOwnerReference(ctx context.Context, work *fleetv1beta
 				}
 			}
 			if updated {
+				oldObj := obj.DeepCopy()
 				obj.SetOwnerReferences(ownerRefs)
-				if _, err = r.spokeDynamicClient.Resource(gvr).Namespace(obj.GetNamespace()).Update(ctx, obj, metav1.UpdateOptions{}); err != nil {
+				updatedObj, err := r.spokeDynamicClient.Resource(gvr).Namespace(obj.GetNamespace()).Update(ctx, obj, metav1.UpdateOptions{})
+				if err != nil {
 					klog.ErrorS(err, "Failed to update manifest owner references", "gvr", gvr, "name", res.Name, "namespace", res.Namespace)
 					return err
 				}
+				r.auditChange(work.Name, AuditOperationUpdate, AuditReasonUnblockDeletion, gvr, oldObj, updatedObj)
 				klog.V(4).InfoS("Patched manifest owner references", "gvr", gvr, "name", res.Name, "namespace", res.Namespace)
 			}
 		}
@@ -539,6 +658,9 @@ func (r *Reconciler) updateOwnerReference(ctx context.Context, work *fleetv1beta
 // the finalizer from the Work object.
 func (r *Reconciler) forgetWorkAndRemoveFinalizer(ctx context.Context, work *fleetv1beta1.Work) (ctrl.Result, error) {
 	r.requeueRateLimiter.Forget(work)
+	if r.deletionWatcher != nil {
+		r.deletionWatcher.forget(work.Name)
+	}
 
 	controllerutil.RemoveFinalizer(work, fleetv1beta1.WorkFinalizer)
 	if err := r.hubClient.Update(ctx, work, &client.UpdateOptions{}); err != nil {
@@ -572,9 +694,16 @@ func (r *Reconciler) ensureAppliedWork(ctx context.Context, work *fleetv1beta1.W
 	// member cluster leaves the fleet. If the member cluster chooses to re-join the fleet, the controller
 	// will see a Work object with no finalizer but with an AppliedWork object. Because of this, here we always
 	// check for the existence of the AppliedWork object, with or without the finalizer.
+	appliedWorkName := r.appliedWorkNameOf(work)
 	appliedWork := &fleetv1beta1.AppliedWork{}
-	err := r.spokeClient.Get(ctx, types.NamespacedName{Name: work.Name}, appliedWork)
+	err := r.spokeClient.Get(ctx, types.NamespacedName{Name: appliedWorkName}, appliedWork)
 	switch {
+	case err == nil && !isAppliedWorkOwnedBy(appliedWork, work):
+		// The AppliedWork belongs to a Work object from another namespace; AppliedWork objects are
+		// cluster-scoped, and the tenant prefix in the names only makes such collisions unlikely.
+		wrappedErr := fmt.Errorf("the AppliedWork %s is already in use by the Work object from namespace %s", appliedWork.Name, appliedWork.Spec.WorkNamespace)
+		klog.ErrorS(wrappedErr, "Failed to claim the AppliedWork for the Work object", "work", workRef)
+		return nil, controller.NewUserError(wrappedErr)
 	case err == nil:
 		// The AppliedWork already exists; no further action is needed.
 		klog.V(2).InfoS("Found an AppliedWork for the Work object", "work", workRef, "appliedWork", klog.KObj(appliedWork))
@@ -587,7 +716,7 @@ func (r *Reconciler) ensureAppliedWork(ctx context.Context, work *fleetv1beta1.W
 	// The AppliedWork object does not exist; create one.
 	appliedWork = &fleetv1beta1.AppliedWork{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: work.Name,
+			Name: appliedWorkName,
 		},
 		Spec: fleetv1beta1.AppliedWorkSpec{
 			WorkName:      work.Name,
@@ -605,6 +734,14 @@ func (r *Reconciler) ensureAppliedWork(ctx context.Context, work *fleetv1beta1.W
 	return appliedWork, nil
 }
 
+// isAppliedWorkOwnedBy checks if an AppliedWork object belongs to a Work object.
+//
+// AppliedWork objects created by earlier versions of the work applier might not have the
+// work namespace set; these are considered to belong to the Work object of the same name.
+func isAppliedWorkOwnedBy(appliedWork *fleetv1beta1.AppliedWork, work *fleetv1beta1.Work) bool {
+	return appliedWork.Spec.WorkNamespace == "" || appliedWork.Spec.WorkNamespace == work.Namespace
+}
+
 // prepareManifestProcessingBundles prepares the manifest processing bundles.
 func prepareManifestProcessingBundles(work *fleetv1beta1.Work) []*manifestProcessingBundle {
 	// Pre-allocate the bundles.
@@ -661,6 +798,31 @@ func (r *Reconciler) Leave(ctx context.Context) error {
 
 // SetupWithManager wires up the controller.
 func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
+	// When Work objects are sharded, the work applier runs on all the member agent replicas rather
+	// than on the leader only.
+	var needLeaderElection *bool
+	if r.shardMembership != nil {
+		needLeaderElection = ptr.To(false)
+	}
+
+	// Re-process the Work objects whose resources have been deleted out-of-band, if applicable.
+	var deletionEventSource source.Source
+	if r.deletionWatcher != nil {
+		if err := mgr.Add(r.deletionWatcher); err != nil {
+			return fmt.Errorf("failed to set up the deletion watcher: %w", err)
+		}
+		deletionEventSource = source.Channel(r.deletionWatcher.events, deletionEventHandler)
+	}
+
+	// Re-process the Work objects that the replica takes over when the shard membership changes,
+	// if applicable.
+	var shardEventSource source.Source
+	if r.shardMembership != nil {
+		shardEventSource = source.Channel(r.shardMembership.events, handler.EnqueueRequestsFromMapFunc(func(ctx context.Context, _ client.Object) []reconcile.Request {
+			return r.shardMembership.ownedWorkRequests(ctx, r.hubClient, r.workNameSpace)
+		}))
+	}
+
 	if r.usePriorityQueue {
 		eventHandler := &priorityBasedWorkObjEventHandler{
 			qm:                r,
@@ -679,20 +841,34 @@ func (r *Reconciler) SetupWithManager(mgr ctrl.Manager) error {
 			return r.pq
 		}
 
-		return ctrl.NewControllerManagedBy(mgr).Named(r.controllerName).
+		b := ctrl.NewControllerManagedBy(mgr).Named(r.controllerName).
 			WithOptions(ctrloption.Options{
 				MaxConcurrentReconciles: r.concurrentReconciles,
 				NewQueue:                newPQ,
+				NeedLeaderElection:      needLeaderElection,
 			}).
 			// Use custom event handler to allow access to the priority queue interface.
-			Watches(&fleetv1beta1.Work{}, eventHandler).
-			Complete(r)
+			Watches(&fleetv1beta1.Work{}, eventHandler)
+		if deletionEventSource != nil {
+			b = b.WatchesRawSource(deletionEventSource)
+		}
+		if shardEventSource != nil {
+			b = b.WatchesRawSource(shardEventSource)
+		}
+		return b.Complete(r)
 	}
 
-	return ctrl.NewControllerManagedBy(mgr).Named(r.controllerName).
+	b := ctrl.NewControllerManagedBy(mgr).Named(r.controllerName).
 		WithOptions(ctrloption.Options{
 			MaxConcurrentReconciles: r.concurrentReconciles,
+			NeedLeaderElection:      needLeaderElection,
 		}).
-		For(&fleetv1beta1.Work{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
-		Complete(r)
+		For(&fleetv1beta1.Work{}, builder.WithPredicates(predicate.GenerationChangedPredicate{}))
+	if deletionEventSource != nil {
+		b = b.WatchesRawSource(deletionEventSource)
+	}
+	if shardEventSource != nil {
+		b = b.WatchesRawSource(shardEventSource)
+	}
+	return b.Complete(r)
 }
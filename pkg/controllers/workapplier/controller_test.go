@@ -18,6 +18,7 @@ package workapplier
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"log"
 	"os"
@@ -39,6 +40,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 )
 
 const (
@@ -550,3 +552,36 @@ func TestEnsureAppliedWork(t *testing.T) {
 		})
 	}
 }
+
+// TestEnsureAppliedWorkInUseByAnotherWork tests the ensureAppliedWork method when the AppliedWork
+// belongs to a Work object of the same name from another namespace.
+func TestEnsureAppliedWorkInUseByAnotherWork(t *testing.T) {
+	ctx := context.Background()
+
+	work := &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workName,
+			Namespace: memberReservedNSName1,
+			Finalizers: []string{
+				fleetv1beta1.WorkFinalizer,
+			},
+		},
+	}
+	appliedWork := &fleetv1beta1.AppliedWork{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: workName,
+		},
+		Spec: fleetv1beta1.AppliedWorkSpec{
+			WorkName:      workName,
+			WorkNamespace: memberReservedNSName2,
+		},
+	}
+	r := &Reconciler{
+		hubClient:   fake.NewClientBuilder().WithScheme(fakeClientScheme(t)).WithObjects(work).Build(),
+		spokeClient: fake.NewClientBuilder().WithScheme(fakeClientScheme(t)).WithObjects(appliedWork).Build(),
+	}
+
+	if _, err := r.ensureAppliedWork(ctx, work); !errors.Is(err, controller.ErrUserError) {
+		t.Errorf("ensureAppliedWork() = %v, want user error", err)
+	}
+}
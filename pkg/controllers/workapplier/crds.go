@@ -0,0 +1,361 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	apiextensionshelpers "k8s.io/apiextensions-apiserver/pkg/apihelpers"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// crdEstablishedPollInterval is the interval at which the work applier checks whether a CRD it has
+	// just applied has been established.
+	crdEstablishedPollInterval = 500 * time.Millisecond
+	// crdEstablishedWaitTimeout is how long the work applier waits for a CRD it has just applied to be
+	// established before it gives up on the custom resources of the CRD in the current run.
+	crdEstablishedWaitTimeout = 10 * time.Second
+
+	// maxReportedMissingFields is the maximum number of fields undefined in a CRD reported for a custom resource.
+	maxReportedMissingFields = 5
+)
+
+var (
+	crdGVK = apiextensionsv1.SchemeGroupVersion.WithKind("CustomResourceDefinition")
+)
+
+// deferCustomResourcesOfPlacedCRDs marks the custom resources whose CRDs are placed in the same Work
+// object, so that they are processed only after all the processing waves complete, and their CRDs
+// have been established in the member cluster.
+//
+// This also recovers the custom resources that fail decoding only because their CRDs have not been
+// created in the member cluster yet. No deferral is needed with the ReportDiff apply strategy, as
+// CRDs are not applied.
+func deferCustomResourcesOfPlacedCRDs(bundles []*manifestProcessingBundle, work *fleetv1beta1.Work) {
+	if work.Spec.ApplyStrategy != nil && work.Spec.ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff {
+		return
+	}
+
+	// Find the CRDs placed in the Work object.
+	crdBundleByGroupKind := make(map[schema.GroupKind]*manifestProcessingBundle)
+	for idx := range bundles {
+		bundle := bundles[idx]
+		if bundle.applyOrReportDiffErr != nil || bundle.manifestObj == nil || bundle.manifestObj.GroupVersionKind() != crdGVK {
+			continue
+		}
+		group, _, _ := unstructured.NestedString(bundle.manifestObj.Object, "spec", "group")
+		kind, _, _ := unstructured.NestedString(bundle.manifestObj.Object, "spec", "names", "kind")
+		crdBundleByGroupKind[schema.GroupKind{Group: group, Kind: kind}] = bundle
+	}
+	if len(crdBundleByGroupKind) == 0 {
+		return
+	}
+
+	for idx := range bundles {
+		bundle := bundles[idx]
+		switch {
+		case bundle.applyOrReportDiffErr == nil && bundle.manifestObj != nil:
+			crdBundle, found := crdBundleByGroupKind[bundle.manifestObj.GroupVersionKind().GroupKind()]
+			if !found {
+				continue
+			}
+			bundle.definingCRDBundle = crdBundle
+		case bundle.applyOrReportDiffResTyp == ApplyOrReportDiffResTypeDecodingErred && meta.IsNoMatchError(bundle.applyOrReportDiffErr):
+			// The manifest data can be unmarshalled, but the API is not available in the member cluster (yet).
+			manifestObj := &unstructured.Unstructured{}
+			if err := manifestObj.UnmarshalJSON(bundle.manifest.Raw); err != nil || len(manifestObj.GetName()) == 0 {
+				continue
+			}
+			crdBundle, found := crdBundleByGroupKind[manifestObj.GroupVersionKind().GroupKind()]
+			if !found {
+				continue
+			}
+			wriStr, err := formatWRIString(bundle.id)
+			if err != nil {
+				continue
+			}
+			bundle.manifestObj = manifestObj
+			bundle.workResourceIdentifierStr = wriStr
+			bundle.applyOrReportDiffErr = nil
+			bundle.applyOrReportDiffResTyp = ""
+			bundle.definingCRDBundle = crdBundle
+		default:
+			continue
+		}
+		klog.V(2).InfoS("Deferred the processing of a custom resource until its CRD is established",
+			"manifestObj", klog.KObj(bundle.manifestObj), "crd", klog.KObj(bundle.definingCRDBundle.manifestObj), "work", klog.KObj(work))
+	}
+}
+
+// processCustomResourcesOfPlacedCRDs processes the custom resources whose CRDs are placed in the same
+// Work object, after their CRDs have been applied and established in the member cluster.
+func (r *Reconciler) processCustomResourcesOfPlacedCRDs(
+	ctx context.Context,
+	bundles []*manifestProcessingBundle,
+	work *fleetv1beta1.Work,
+	expectedAppliedWorkOwnerRef *metav1.OwnerReference,
+) error {
+	deferredBundles := make([]*manifestProcessingBundle, 0)
+	for idx := range bundles {
+		if bundles[idx].definingCRDBundle != nil && bundles[idx].applyOrReportDiffErr == nil {
+			deferredBundles = append(deferredBundles, bundles[idx])
+		}
+	}
+	if len(deferredBundles) == 0 {
+		return nil
+	}
+
+	// Wait for each CRD to become established; this runs sequentially as in most cases the CRDs
+	// become established at about the same time.
+	establishedCRDs := make(map[*manifestProcessingBundle]*apiextensionsv1.CustomResourceDefinition)
+	crdErrs := make(map[*manifestProcessingBundle]error)
+	for _, bundle := range deferredBundles {
+		crdBundle := bundle.definingCRDBundle
+		if _, found := establishedCRDs[crdBundle]; found {
+			continue
+		}
+		if _, found := crdErrs[crdBundle]; found {
+			continue
+		}
+		crd, err := r.waitForPlacedCRDToBeEstablished(ctx, crdBundle)
+		if err != nil {
+			klog.V(2).InfoS("A placed CRD is not established; its custom resources will not be processed",
+				"crd", klog.KObj(crdBundle.manifestObj), "work", klog.KObj(work), "err", err)
+			crdErrs[crdBundle] = err
+			continue
+		}
+		establishedCRDs[crdBundle] = crd
+	}
+
+	doWork := func(piece int) {
+		bundle := deferredBundles[piece]
+		if err, found := crdErrs[bundle.definingCRDBundle]; found {
+			bundle.applyOrReportDiffErr = err
+			bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeCRDNotEstablished
+			return
+		}
+		if bundle.gvr == nil {
+			// The API was not available when the manifest was decoded; try again now that the CRD
+			// has been established.
+			gvr, _, err := r.decodeManifest(bundle.manifest)
+			if err != nil {
+				bundle.applyOrReportDiffErr = fmt.Errorf("the API of the custom resource is not available even though its CRD %s has been established: %w",
+					bundle.definingCRDBundle.manifestObj.GetName(), err)
+				bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeCRDNotEstablished
+				return
+			}
+			bundle.gvr = gvr
+			bundle.id.Resource = gvr.Resource
+		}
+		if err := checkCustomResourceAgainstCRD(bundle.manifestObj, establishedCRDs[bundle.definingCRDBundle]); err != nil {
+			bundle.applyOrReportDiffErr = err
+			bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeCRDVersionSkew
+			return
+		}
+
+		r.processOneManifest(ctx, bundle, work, expectedAppliedWorkOwnerRef)
+		klog.V(2).InfoS("Processed a manifest", "manifestObj", klog.KObj(bundle.manifestObj), "work", klog.KObj(work))
+	}
+	r.parallelizer.ParallelizeUntil(ctx, len(deferredBundles), doWork, "processingCustomResourcesOfPlacedCRDs")
+
+	if err := ctx.Err(); err != nil {
+		klog.V(2).InfoS("manifest processing has been interrupted as the main context has been cancelled")
+		return fmt.Errorf("manifest processing has been interrupted: %w", err)
+	}
+	return nil
+}
+
+// waitForPlacedCRDToBeEstablished waits for a CRD placed in the Work object to become established in
+// the member cluster, and returns the CRD.
+func (r *Reconciler) waitForPlacedCRDToBeEstablished(ctx context.Context, crdBundle *manifestProcessingBundle) (*apiextensionsv1.CustomResourceDefinition, error) {
+	crdName := crdBundle.manifestObj.GetName()
+	if !isManifestObjectApplied(crdBundle.applyOrReportDiffResTyp) {
+		return nil, fmt.Errorf("the CRD %s of the custom resource, which is placed together with the custom resource, has not been applied: %w",
+			crdName, crdBundle.applyOrReportDiffErr)
+	}
+
+	var crd *apiextensionsv1.CustomResourceDefinition
+	var lastErr error
+	err := wait.PollUntilContextTimeout(ctx, crdEstablishedPollInterval, crdEstablishedWaitTimeout, true, func(ctx context.Context) (bool, error) {
+		crd, lastErr = r.getMemberClusterCRD(ctx, crdName)
+		if lastErr != nil || crd == nil {
+			return false, nil
+		}
+		return apiextensionshelpers.IsCRDConditionTrue(crd, apiextensionsv1.Established), nil
+	})
+	if err != nil {
+		if lastErr != nil {
+			return nil, fmt.Errorf("the CRD %s of the custom resource has not been established: %w", crdName, lastErr)
+		}
+		return nil, fmt.Errorf("the CRD %s of the custom resource has not been established within %s; Fleet will retry later", crdName, crdEstablishedWaitTimeout)
+	}
+	return crd, nil
+}
+
+// checkCustomResourcesAgainstMemberClusterCRDs verifies that the custom resources to apply are
+// compatible with the definitions of their CRDs in the member cluster, so that a member cluster
+// with an older version of a CRD yields a targeted error, rather than opaque validation errors or
+// fields dropped silently.
+//
+// Custom resources whose CRDs are placed in the same Work object are verified after their CRDs
+// have been applied.
+func (r *Reconciler) checkCustomResourcesAgainstMemberClusterCRDs(ctx context.Context, bundles []*manifestProcessingBundle, work *fleetv1beta1.Work) {
+	crdByName := make(map[string]*apiextensionsv1.CustomResourceDefinition)
+	for idx := range bundles {
+		bundle := bundles[idx]
+		if bundle.applyOrReportDiffErr != nil || bundle.gvr == nil || bundle.definingCRDBundle != nil || knownAPIGroups.Has(bundle.gvr.Group) {
+			continue
+		}
+
+		crdName := bundle.gvr.Resource + "." + bundle.gvr.Group
+		crd, checked := crdByName[crdName]
+		if !checked {
+			var err error
+			if crd, err = r.getMemberClusterCRD(ctx, crdName); err != nil {
+				// Do not block the apply op; any incompatibility will be reported by the API server.
+				klog.ErrorS(err, "Failed to get the CRD of a custom resource; skip the check", "crd", crdName, "work", klog.KObj(work))
+			}
+			crdByName[crdName] = crd
+		}
+		if crd == nil {
+			// The API is not served via a CRD, e.g., it is an aggregated API.
+			continue
+		}
+
+		if err := checkCustomResourceAgainstCRD(bundle.manifestObj, crd); err != nil {
+			klog.V(2).InfoS("A custom resource is not compatible with its CRD in the member cluster",
+				"manifestObj", klog.KObj(bundle.manifestObj), "crd", crdName, "work", klog.KObj(work), "err", err)
+			bundle.applyOrReportDiffErr = err
+			bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeCRDVersionSkew
+		}
+	}
+}
+
+// getMemberClusterCRD returns the CRD of the given name in the member cluster; it returns nil if
+// the CRD does not exist.
+func (r *Reconciler) getMemberClusterCRD(ctx context.Context, name string) (*apiextensionsv1.CustomResourceDefinition, error) {
+	unstructuredCRD, err := r.spokeDynamicClient.Resource(utils.CustomResourceDefinitionGVR).Get(ctx, name, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, controller.NewAPIServerError(false, err)
+	}
+	crd := &apiextensionsv1.CustomResourceDefinition{}
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(unstructuredCRD.Object, crd); err != nil {
+		return nil, controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to convert the unstructured object to a custom resource definition: %w", err))
+	}
+	return crd, nil
+}
+
+// checkCustomResourceAgainstCRD verifies that the version of a custom resource is served by its CRD,
+// and that all the fields of the custom resource are defined in the schema of the version.
+func checkCustomResourceAgainstCRD(manifestObj *unstructured.Unstructured, crd *apiextensionsv1.CustomResourceDefinition) error {
+	version := manifestObj.GroupVersionKind().Version
+	var crdVersion *apiextensionsv1.CustomResourceDefinitionVersion
+	servedVersions := make([]string, 0, len(crd.Spec.Versions))
+	for idx := range crd.Spec.Versions {
+		if !crd.Spec.Versions[idx].Served {
+			continue
+		}
+		servedVersions = append(servedVersions, crd.Spec.Versions[idx].Name)
+		if crd.Spec.Versions[idx].Name == version {
+			crdVersion = &crd.Spec.Versions[idx]
+		}
+	}
+	if crdVersion == nil {
+		return fmt.Errorf("version %s is not served by the CRD %s in the member cluster (served versions: %v); the member cluster might have an older version of the CRD",
+			version, crd.Name, servedVersions)
+	}
+	if crdVersion.Schema == nil || crdVersion.Schema.OpenAPIV3Schema == nil {
+		return nil
+	}
+
+	// The object metadata and type information are not defined in the schema.
+	content := make(map[string]interface{}, len(manifestObj.Object))
+	for field, value := range manifestObj.Object {
+		if field != "apiVersion" && field != "kind" && field != "metadata" {
+			content[field] = value
+		}
+	}
+	missingFields := findFieldsUndefinedInSchema(content, "", crdVersion.Schema.OpenAPIV3Schema)
+	// Report each missing field once, as the same field might be missing in many items of a list.
+	missingFields = sets.List(sets.New(missingFields...))
+	if len(missingFields) == 0 {
+		return nil
+	}
+	if len(missingFields) > maxReportedMissingFields {
+		missingFields = append(missingFields[:maxReportedMissingFields], "...")
+	}
+	return fmt.Errorf("the CRD %s in the member cluster does not define the fields [%s] in version %s; the member cluster might have an older version of the CRD",
+		crd.Name, strings.Join(missingFields, ", "), version)
+}
+
+// findFieldsUndefinedInSchema returns the paths of the fields in a value that are not defined in the
+// schema of the value.
+func findFieldsUndefinedInSchema(value interface{}, path string, schemaProps *apiextensionsv1.JSONSchemaProps) []string {
+	if schemaProps.XPreserveUnknownFields != nil && *schemaProps.XPreserveUnknownFields {
+		return nil
+	}
+
+	var missingFields []string
+	switch typed := value.(type) {
+	case map[string]interface{}:
+		for field, fieldValue := range typed {
+			fieldPath := field
+			if path != "" {
+				fieldPath = path + "." + field
+			}
+			fieldSchemaProps, found := schemaProps.Properties[field]
+			switch {
+			case found:
+				missingFields = append(missingFields, findFieldsUndefinedInSchema(fieldValue, fieldPath, &fieldSchemaProps)...)
+			case schemaProps.AdditionalProperties != nil && schemaProps.AdditionalProperties.Schema != nil:
+				missingFields = append(missingFields, findFieldsUndefinedInSchema(fieldValue, fieldPath, schemaProps.AdditionalProperties.Schema)...)
+			case schemaProps.AdditionalProperties != nil && schemaProps.AdditionalProperties.Allows:
+				continue
+			default:
+				missingFields = append(missingFields, fieldPath)
+			}
+		}
+	case []interface{}:
+		if schemaProps.Items == nil || schemaProps.Items.Schema == nil {
+			return nil
+		}
+		for _, item := range typed {
+			missingFields = append(missingFields, findFieldsUndefinedInSchema(item, path+"[]", schemaProps.Items.Schema)...)
+		}
+	}
+	return missingFields
+}
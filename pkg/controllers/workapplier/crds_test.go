@@ -0,0 +1,373 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+var (
+	widgetGVR = schema.GroupVersionResource{Group: "example.com", Version: "v1", Resource: "widgets"}
+)
+
+// widgetCRD returns a CRD of widgets, with the given fields defined in the spec of version v1.
+func widgetCRD(specFields ...string) *apiextensionsv1.CustomResourceDefinition {
+	specProps := map[string]apiextensionsv1.JSONSchemaProps{}
+	for _, field := range specFields {
+		specProps[field] = apiextensionsv1.JSONSchemaProps{Type: "string"}
+	}
+	return &apiextensionsv1.CustomResourceDefinition{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: apiextensionsv1.SchemeGroupVersion.String(),
+			Kind:       "CustomResourceDefinition",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "widgets.example.com",
+		},
+		Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+			Group: "example.com",
+			Names: apiextensionsv1.CustomResourceDefinitionNames{
+				Plural: "widgets",
+				Kind:   "Widget",
+			},
+			Scope: apiextensionsv1.NamespaceScoped,
+			Versions: []apiextensionsv1.CustomResourceDefinitionVersion{
+				{
+					Name:    "v1",
+					Served:  true,
+					Storage: true,
+					Schema: &apiextensionsv1.CustomResourceValidation{
+						OpenAPIV3Schema: &apiextensionsv1.JSONSchemaProps{
+							Type: "object",
+							Properties: map[string]apiextensionsv1.JSONSchemaProps{
+								"spec": {
+									Type:       "object",
+									Properties: specProps,
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func widget(apiVersion string, spec map[string]interface{}) *unstructured.Unstructured {
+	return &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       "Widget",
+			"metadata": map[string]interface{}{
+				"name":      "widget",
+				"namespace": "default",
+			},
+			"spec": spec,
+		},
+	}
+}
+
+func TestDeferCustomResourcesOfPlacedCRDs(t *testing.T) {
+	crdBundle := func(t *testing.T) *manifestProcessingBundle {
+		return &manifestProcessingBundle{
+			manifestObj: toUnstructured(t, widgetCRD()),
+			gvr:         &utils.CustomResourceDefinitionGVR,
+		}
+	}
+	decodedCRBundle := func() *manifestProcessingBundle {
+		return &manifestProcessingBundle{
+			manifestObj: widget("example.com/v1", nil),
+			gvr:         &widgetGVR,
+		}
+	}
+	undecodedCRBundle := func(t *testing.T) *manifestProcessingBundle {
+		cr := widget("example.com/v1", nil)
+		raw, err := cr.MarshalJSON()
+		if err != nil {
+			t.Fatalf("failed to marshal the custom resource: %v", err)
+		}
+		return &manifestProcessingBundle{
+			manifest: &fleetv1beta1.Manifest{RawExtension: runtime.RawExtension{Raw: raw}},
+			id:       buildWorkResourceIdentifier(2, nil, cr),
+			applyOrReportDiffErr: fmt.Errorf("failed to decode manifest: %w",
+				&meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "example.com", Kind: "Widget"}, SearchedVersions: []string{"v1"}}),
+			applyOrReportDiffResTyp: ApplyOrReportDiffResTypeDecodingErred,
+		}
+	}
+	otherBundle := func() *manifestProcessingBundle {
+		return &manifestProcessingBundle{
+			manifestObj: toUnstructured(t, deploy.DeepCopy()),
+			gvr:         &utils.DeploymentGVR,
+		}
+	}
+
+	testCases := []struct {
+		name         string
+		work         *fleetv1beta1.Work
+		withCRD      bool
+		wantDeferred []bool
+		wantResTypes []ManifestProcessingApplyOrReportDiffResultType
+	}{
+		{
+			name:         "custom resources of a placed CRD",
+			work:         &fleetv1beta1.Work{},
+			withCRD:      true,
+			wantDeferred: []bool{false, true, true, false},
+			wantResTypes: []ManifestProcessingApplyOrReportDiffResultType{"", "", "", ""},
+		},
+		{
+			name:         "no placed CRD",
+			work:         &fleetv1beta1.Work{},
+			wantDeferred: []bool{false, false, false, false},
+			wantResTypes: []ManifestProcessingApplyOrReportDiffResultType{"", "", ApplyOrReportDiffResTypeDecodingErred, ""},
+		},
+		{
+			name: "ReportDiff apply strategy",
+			work: &fleetv1beta1.Work{
+				Spec: fleetv1beta1.WorkSpec{
+					ApplyStrategy: &fleetv1beta1.ApplyStrategy{Type: fleetv1beta1.ApplyStrategyTypeReportDiff},
+				},
+			},
+			withCRD:      true,
+			wantDeferred: []bool{false, false, false, false},
+			wantResTypes: []ManifestProcessingApplyOrReportDiffResultType{"", "", ApplyOrReportDiffResTypeDecodingErred, ""},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			first := crdBundle(t)
+			if !tc.withCRD {
+				// Use a regular object instead.
+				first = otherBundle()
+			}
+			bundles := []*manifestProcessingBundle{first, decodedCRBundle(), undecodedCRBundle(t), otherBundle()}
+
+			deferCustomResourcesOfPlacedCRDs(bundles, tc.work)
+			gotDeferred := make([]bool, 0, len(bundles))
+			gotResTypes := make([]ManifestProcessingApplyOrReportDiffResultType, 0, len(bundles))
+			for _, bundle := range bundles {
+				gotDeferred = append(gotDeferred, bundle.definingCRDBundle != nil)
+				gotResTypes = append(gotResTypes, bundle.applyOrReportDiffResTyp)
+			}
+			if diff := cmp.Diff(gotDeferred, tc.wantDeferred); diff != "" {
+				t.Errorf("deferred bundles mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(gotResTypes, tc.wantResTypes); diff != "" {
+				t.Errorf("result types mismatch (-got, +want):\n%s", diff)
+			}
+			if tc.withCRD && tc.wantDeferred[2] {
+				wantWRIStr := "GV=example.com/v1, Kind=Widget, Namespace=default, Name=widget"
+				if bundles[2].workResourceIdentifierStr != wantWRIStr || bundles[2].manifestObj == nil {
+					t.Errorf("recovered bundle = (%q, %v), want (%q, decoded object)", bundles[2].workResourceIdentifierStr, bundles[2].manifestObj, wantWRIStr)
+				}
+			}
+		})
+	}
+}
+
+func TestCheckCustomResourceAgainstCRD(t *testing.T) {
+	crdWithListAndMap := widgetCRD("size")
+	specProps := crdWithListAndMap.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["spec"]
+	specProps.Properties["parts"] = apiextensionsv1.JSONSchemaProps{
+		Type: "array",
+		Items: &apiextensionsv1.JSONSchemaPropsOrArray{
+			Schema: &apiextensionsv1.JSONSchemaProps{
+				Type: "object",
+				Properties: map[string]apiextensionsv1.JSONSchemaProps{
+					"name": {Type: "string"},
+				},
+			},
+		},
+	}
+	specProps.Properties["labels"] = apiextensionsv1.JSONSchemaProps{
+		Type: "object",
+		AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{
+			Schema: &apiextensionsv1.JSONSchemaProps{Type: "string"},
+		},
+	}
+	specProps.Properties["extra"] = apiextensionsv1.JSONSchemaProps{
+		Type:                   "object",
+		XPreserveUnknownFields: ptr.To(true),
+	}
+	crdWithListAndMap.Spec.Versions[0].Schema.OpenAPIV3Schema.Properties["spec"] = specProps
+
+	testCases := []struct {
+		name    string
+		cr      *unstructured.Unstructured
+		crd     *apiextensionsv1.CustomResourceDefinition
+		wantErr bool
+	}{
+		{
+			name: "compatible",
+			cr:   widget("example.com/v1", map[string]interface{}{"size": "large"}),
+			crd:  widgetCRD("size"),
+		},
+		{
+			name:    "version not served",
+			cr:      widget("example.com/v2", map[string]interface{}{"size": "large"}),
+			crd:     widgetCRD("size"),
+			wantErr: true,
+		},
+		{
+			name:    "field not defined",
+			cr:      widget("example.com/v1", map[string]interface{}{"size": "large", "color": "red"}),
+			crd:     widgetCRD("size"),
+			wantErr: true,
+		},
+		{
+			name: "fields in lists, maps and objects that preserve unknown fields",
+			cr: widget("example.com/v1", map[string]interface{}{
+				"parts":  []interface{}{map[string]interface{}{"name": "wheel"}},
+				"labels": map[string]interface{}{"app": "demo"},
+				"extra":  map[string]interface{}{"anything": "goes"},
+			}),
+			crd: crdWithListAndMap,
+		},
+		{
+			name: "field in a list item not defined",
+			cr: widget("example.com/v1", map[string]interface{}{
+				"parts": []interface{}{map[string]interface{}{"name": "wheel", "weight": "1kg"}},
+			}),
+			crd:     crdWithListAndMap,
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := checkCustomResourceAgainstCRD(tc.cr, tc.crd)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("checkCustomResourceAgainstCRD() = %v, want error %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestFindFieldsUndefinedInSchema(t *testing.T) {
+	crd := widgetCRD("size")
+	content := map[string]interface{}{
+		"spec": map[string]interface{}{
+			"size":  "large",
+			"color": "red",
+		},
+		"status": map[string]interface{}{
+			"ready": true,
+		},
+	}
+	got := findFieldsUndefinedInSchema(content, "", crd.Spec.Versions[0].Schema.OpenAPIV3Schema)
+	want := []string{"spec.color", "status"}
+	if diff := cmp.Diff(got, want, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+		t.Errorf("findFieldsUndefinedInSchema() mismatch (-got, +want):\n%s", diff)
+	}
+}
+
+func TestCheckCustomResourcesAgainstMemberClusterCRDs(t *testing.T) {
+	oldCRD := toUnstructured(t, widgetCRD("size"))
+	bundles := []*manifestProcessingBundle{
+		{
+			manifestObj: widget("example.com/v1", map[string]interface{}{"size": "large"}),
+			gvr:         &widgetGVR,
+		},
+		{
+			manifestObj: widget("example.com/v1", map[string]interface{}{"size": "large", "color": "red"}),
+			gvr:         &widgetGVR,
+		},
+		{
+			manifestObj: toUnstructured(t, deploy.DeepCopy()),
+			gvr:         &utils.DeploymentGVR,
+		},
+	}
+	r := &Reconciler{
+		spokeDynamicClient: fake.NewSimpleDynamicClient(scheme.Scheme, oldCRD),
+	}
+
+	r.checkCustomResourcesAgainstMemberClusterCRDs(context.Background(), bundles, &fleetv1beta1.Work{})
+	gotResTypes := make([]ManifestProcessingApplyOrReportDiffResultType, 0, len(bundles))
+	for _, bundle := range bundles {
+		gotResTypes = append(gotResTypes, bundle.applyOrReportDiffResTyp)
+	}
+	wantResTypes := []ManifestProcessingApplyOrReportDiffResultType{"", ApplyOrReportDiffResTypeCRDVersionSkew, ""}
+	if diff := cmp.Diff(gotResTypes, wantResTypes); diff != "" {
+		t.Errorf("result types mismatch (-got, +want):\n%s", diff)
+	}
+}
+
+func TestWaitForPlacedCRDToBeEstablished(t *testing.T) {
+	establishedCRD := widgetCRD("size")
+	establishedCRD.Status.Conditions = []apiextensionsv1.CustomResourceDefinitionCondition{
+		{
+			Type:   apiextensionsv1.Established,
+			Status: apiextensionsv1.ConditionTrue,
+		},
+	}
+
+	testCases := []struct {
+		name      string
+		crdBundle *manifestProcessingBundle
+		wantErr   bool
+	}{
+		{
+			name: "established",
+			crdBundle: &manifestProcessingBundle{
+				manifestObj:             toUnstructured(t, widgetCRD()),
+				applyOrReportDiffResTyp: ApplyOrReportDiffResTypeApplied,
+			},
+		},
+		{
+			name: "not applied",
+			crdBundle: &manifestProcessingBundle{
+				manifestObj:             toUnstructured(t, widgetCRD()),
+				applyOrReportDiffResTyp: ApplyOrReportDiffResTypeFailedToApply,
+				applyOrReportDiffErr:    fmt.Errorf("failed to apply the manifest"),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Reconciler{
+				spokeDynamicClient: fake.NewSimpleDynamicClient(scheme.Scheme, toUnstructured(t, establishedCRD)),
+			}
+			crd, err := r.waitForPlacedCRDToBeEstablished(context.Background(), tc.crdBundle)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("waitForPlacedCRDToBeEstablished() = %v, want error %t", err, tc.wantErr)
+			}
+			if !tc.wantErr && crd.Name != establishedCRD.Name {
+				t.Errorf("waitForPlacedCRDToBeEstablished() = %s, want %s", crd.Name, establishedCRD.Name)
+			}
+		})
+	}
+}
@@ -0,0 +1,288 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"sync"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic"
+	"k8s.io/client-go/dynamic/dynamicinformer"
+	"k8s.io/client-go/tools/cache"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/priorityqueue"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// deletionEventBufferSize is the number of deletion events that the deletion watcher buffers
+	// before the work applier picks them up; events beyond the buffer are dropped, and the
+	// deleted resources are re-created at the next re-apply instead.
+	deletionEventBufferSize = 1024
+)
+
+// resourceTypeWatch is the watch of a resource type on the member cluster.
+type resourceTypeWatch struct {
+	// cancel stops the informer of the resource type.
+	cancel context.CancelFunc
+	// works are the names of the Work objects that have resources of the type placed.
+	works sets.Set[string]
+}
+
+// deletionWatcher watches the resources placed by the work applier on the member cluster, so that
+// the Work objects which have opted in (see the WatchForDeletion apply strategy setting) are
+// re-processed right away when any of their resources is deleted out-of-band.
+//
+// Only the resource types of the resources that the opted-in Work objects have placed are watched,
+// and at most a given number of resource types are watched at the same time.
+type deletionWatcher struct {
+	dynamicClient    dynamic.Interface
+	workNamespace    string
+	maxResourceTypes int
+	events           chan event.TypedGenericEvent[client.Object]
+
+	mu sync.Mutex
+	// ctx is the context in which the informers run; it is set when the watcher starts.
+	ctx     context.Context
+	watches map[schema.GroupVersionResource]*resourceTypeWatch
+	// workResourceTypes are the resource types watched for each Work object.
+	workResourceTypes map[string]sets.Set[schema.GroupVersionResource]
+}
+
+// newDeletionWatcher returns a new deletion watcher, which watches at most the given number of
+// resource types on the member cluster for the Work objects in the given namespace.
+func newDeletionWatcher(dynamicClient dynamic.Interface, workNamespace string, maxResourceTypes int) *deletionWatcher {
+	return &deletionWatcher{
+		dynamicClient:     dynamicClient,
+		workNamespace:     workNamespace,
+		maxResourceTypes:  maxResourceTypes,
+		events:            make(chan event.TypedGenericEvent[client.Object], deletionEventBufferSize),
+		watches:           make(map[schema.GroupVersionResource]*resourceTypeWatch),
+		workResourceTypes: make(map[string]sets.Set[schema.GroupVersionResource]),
+	}
+}
+
+// Start runs the deletion watcher until the context is cancelled.
+func (w *deletionWatcher) Start(ctx context.Context) error {
+	klog.InfoS("Starting the work applier deletion watcher", "workNamespace", w.workNamespace, "maxResourceTypes", w.maxResourceTypes)
+	w.mu.Lock()
+	w.ctx = ctx
+	w.mu.Unlock()
+
+	<-ctx.Done()
+
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for gvr, watch := range w.watches {
+		watch.cancel()
+		delete(w.watches, gvr)
+	}
+	w.workResourceTypes = make(map[string]sets.Set[schema.GroupVersionResource])
+	klog.InfoS("Stopped the work applier deletion watcher", "workNamespace", w.workNamespace)
+	return nil
+}
+
+// NeedLeaderElection implements the LeaderElectionRunnable interface.
+//
+// The deletion watcher runs on all the member agent replicas, as it only watches the resource types
+// that the work applier on the same replica asks it to.
+func (w *deletionWatcher) NeedLeaderElection() bool {
+	return false
+}
+
+// watch watches the given resource types for a Work object, and stops watching the resource types
+// that the Work object no longer has resources of; resource types beyond the limit are not watched.
+func (w *deletionWatcher) watch(workName string, gvrs sets.Set[schema.GroupVersionResource]) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	if w.ctx == nil {
+		// The watcher has not started yet; the Work object will ask again when it is re-processed.
+		return
+	}
+
+	for gvr := range w.workResourceTypes[workName].Difference(gvrs) {
+		w.unwatchLocked(workName, gvr)
+	}
+	watched := sets.New[schema.GroupVersionResource]()
+	for gvr := range gvrs {
+		if watch, found := w.watches[gvr]; found {
+			watch.works.Insert(workName)
+			watched.Insert(gvr)
+			continue
+		}
+		if len(w.watches) >= w.maxResourceTypes {
+			klog.V(2).InfoS("Cannot watch the resource type for deletions as the limit has been reached",
+				"work", klog.KRef(w.workNamespace, workName), "gvr", gvr, "maxResourceTypes", w.maxResourceTypes)
+			continue
+		}
+		w.startWatchLocked(gvr)
+		w.watches[gvr].works.Insert(workName)
+		watched.Insert(gvr)
+	}
+	if watched.Len() == 0 {
+		delete(w.workResourceTypes, workName)
+		return
+	}
+	w.workResourceTypes[workName] = watched
+}
+
+// forget stops watching all the resource types for a Work object.
+func (w *deletionWatcher) forget(workName string) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	for gvr := range w.workResourceTypes[workName] {
+		w.unwatchLocked(workName, gvr)
+	}
+	delete(w.workResourceTypes, workName)
+}
+
+// unwatchLocked stops watching a resource type for a Work object; the informer of the resource type
+// is stopped once no Work object needs it. The caller must hold the lock.
+func (w *deletionWatcher) unwatchLocked(workName string, gvr schema.GroupVersionResource) {
+	watch, found := w.watches[gvr]
+	if !found {
+		return
+	}
+	watch.works.Delete(workName)
+	if watch.works.Len() == 0 {
+		watch.cancel()
+		delete(w.watches, gvr)
+		klog.V(2).InfoS("Stopped watching the resource type for deletions", "gvr", gvr)
+	}
+}
+
+// startWatchLocked starts the informer of a resource type. The caller must hold the lock.
+func (w *deletionWatcher) startWatchLocked(gvr schema.GroupVersionResource) {
+	informer := dynamicinformer.NewFilteredDynamicInformer(w.dynamicClient, gvr, metav1.NamespaceAll, 0, cache.Indexers{}, nil).Informer()
+	// Keep only the object metadata that the watcher needs in the cache.
+	if err := informer.SetTransform(trimToOwnerReferences); err != nil {
+		klog.ErrorS(err, "Failed to set the transform of the informer", "gvr", gvr)
+	}
+	if _, err := informer.AddEventHandler(cache.ResourceEventHandlerFuncs{
+		DeleteFunc: func(obj interface{}) {
+			w.onDelete(gvr, obj)
+		},
+	}); err != nil {
+		klog.ErrorS(err, "Failed to add the event handler to the informer", "gvr", gvr)
+	}
+	ctx, cancel := context.WithCancel(w.ctx)
+	go informer.Run(ctx.Done())
+	w.watches[gvr] = &resourceTypeWatch{
+		cancel: cancel,
+		works:  sets.New[string](),
+	}
+	klog.V(2).InfoS("Started watching the resource type for deletions", "gvr", gvr)
+}
+
+// onDelete asks the work applier to re-process the Work objects which own a deleted resource.
+func (w *deletionWatcher) onDelete(gvr schema.GroupVersionResource, obj interface{}) {
+	if tombstone, ok := obj.(cache.DeletedFinalStateUnknown); ok {
+		obj = tombstone.Obj
+	}
+	deletedObj, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return
+	}
+	for _, ownerRef := range deletedObj.GetOwnerReferences() {
+		// The resources placed by a Work object are owned by the AppliedWork object of the same name.
+		if ownerRef.APIVersion != fleetv1beta1.GroupVersion.String() || ownerRef.Kind != fleetv1beta1.AppliedWorkKind {
+			continue
+		}
+		w.mu.Lock()
+		watched := w.workResourceTypes[ownerRef.Name].Has(gvr)
+		w.mu.Unlock()
+		if !watched {
+			continue
+		}
+		work := &fleetv1beta1.Work{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: w.workNamespace,
+				Name:      ownerRef.Name,
+			},
+		}
+		select {
+		case w.events <- event.TypedGenericEvent[client.Object]{Object: work}:
+			klog.V(2).InfoS("Found a placed resource deleted out-of-band; re-process the Work object",
+				"work", klog.KObj(work), "gvr", gvr, "resource", klog.KObj(deletedObj))
+		default:
+			klog.V(2).InfoS("Too many pending deletion events; the deleted resource will be re-created at the next re-apply",
+				"work", klog.KObj(work), "gvr", gvr, "resource", klog.KObj(deletedObj))
+		}
+	}
+}
+
+// trimToOwnerReferences trims an object in the informer cache down to its identity and owner references.
+func trimToOwnerReferences(obj interface{}) (interface{}, error) {
+	u, ok := obj.(*unstructured.Unstructured)
+	if !ok {
+		return obj, nil
+	}
+	trimmed := &unstructured.Unstructured{}
+	trimmed.SetGroupVersionKind(u.GroupVersionKind())
+	trimmed.SetNamespace(u.GetNamespace())
+	trimmed.SetName(u.GetName())
+	trimmed.SetUID(u.GetUID())
+	trimmed.SetResourceVersion(u.GetResourceVersion())
+	trimmed.SetOwnerReferences(u.GetOwnerReferences())
+	return trimmed, nil
+}
+
+// deletionEventHandler enqueues the Work objects that the deletion watcher asks to re-process;
+// if a priority queue is in use, the Work objects are processed with the highest priority.
+var deletionEventHandler = handler.TypedFuncs[client.Object, reconcile.Request]{
+	GenericFunc: func(_ context.Context, e event.TypedGenericEvent[client.Object], q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+		req := reconcile.Request{NamespacedName: client.ObjectKeyFromObject(e.Object)}
+		if pq, ok := q.(priorityqueue.PriorityQueue[reconcile.Request]); ok {
+			pq.AddWithOpts(priorityqueue.AddOpts{Priority: ptr.To(highestPriorityLevel)}, req)
+			return
+		}
+		q.Add(req)
+	},
+}
+
+// watchForDeletionIfApplicable asks the deletion watcher (if any) to watch the resource types of the
+// resources that a Work object has placed, if the Work object has opted in.
+func (r *Reconciler) watchForDeletionIfApplicable(work *fleetv1beta1.Work, bundles []*manifestProcessingBundle) {
+	if r.deletionWatcher == nil {
+		return
+	}
+	applyStrategy := work.Spec.ApplyStrategy
+	if applyStrategy == nil || !applyStrategy.WatchForDeletion || applyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff {
+		r.deletionWatcher.forget(work.Name)
+		return
+	}
+	gvrs := sets.New[schema.GroupVersionResource]()
+	for _, bundle := range bundles {
+		if bundle.gvr == nil || bundle.inMemberClusterObj == nil {
+			// Skip the manifests that have not been placed.
+			continue
+		}
+		gvrs.Insert(*bundle.gvr)
+	}
+	r.deletionWatcher.watch(work.Name, gvrs)
+}
@@ -0,0 +1,237 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/tools/cache"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+// newDeletionWatcherForTest returns a started deletion watcher backed by a fake dynamic client.
+func newDeletionWatcherForTest(t *testing.T, maxResourceTypes int) *deletionWatcher {
+	dynamicClient := fake.NewSimpleDynamicClientWithCustomListKinds(runtime.NewScheme(), map[schema.GroupVersionResource]string{
+		utils.ConfigMapGVR:  "ConfigMapList",
+		utils.SecretGVR:     "SecretList",
+		utils.DeploymentGVR: "DeploymentList",
+	})
+	w := newDeletionWatcher(dynamicClient, memberReservedNSName1, maxResourceTypes)
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	w.ctx = ctx
+	return w
+}
+
+// watchedWorks summarizes the Work objects that each watched resource type is watched for.
+func watchedWorks(w *deletionWatcher) map[schema.GroupVersionResource][]string {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+	res := make(map[schema.GroupVersionResource][]string, len(w.watches))
+	for gvr, watch := range w.watches {
+		res[gvr] = sets.List(watch.works)
+	}
+	return res
+}
+
+func TestDeletionWatcherWatch(t *testing.T) {
+	w := newDeletionWatcherForTest(t, 2)
+
+	w.watch("work-1", sets.New(utils.ConfigMapGVR, utils.DeploymentGVR))
+	// Secrets cannot be watched as the limit has been reached.
+	w.watch("work-2", sets.New(utils.ConfigMapGVR, utils.SecretGVR))
+	want := map[schema.GroupVersionResource][]string{
+		utils.ConfigMapGVR:  {"work-1", "work-2"},
+		utils.DeploymentGVR: {"work-1"},
+	}
+	if diff := cmp.Diff(want, watchedWorks(w)); diff != "" {
+		t.Errorf("watched works after watch() mismatch (-want, +got):\n%s", diff)
+	}
+
+	// Deployments are no longer watched once no Work object has any placed, which makes room for secrets.
+	w.watch("work-1", sets.New(utils.ConfigMapGVR))
+	w.watch("work-2", sets.New(utils.ConfigMapGVR, utils.SecretGVR))
+	want = map[schema.GroupVersionResource][]string{
+		utils.ConfigMapGVR: {"work-1", "work-2"},
+		utils.SecretGVR:    {"work-2"},
+	}
+	if diff := cmp.Diff(want, watchedWorks(w)); diff != "" {
+		t.Errorf("watched works after re-watch() mismatch (-want, +got):\n%s", diff)
+	}
+
+	w.forget("work-1")
+	w.forget("work-2")
+	if diff := cmp.Diff(map[schema.GroupVersionResource][]string{}, watchedWorks(w)); diff != "" {
+		t.Errorf("watched works after forget() mismatch (-want, +got):\n%s", diff)
+	}
+	if len(w.workResourceTypes) != 0 {
+		t.Errorf("workResourceTypes = %v, want empty", w.workResourceTypes)
+	}
+}
+
+func TestDeletionWatcherOnDelete(t *testing.T) {
+	ownedConfigMap := func(ownerKind, ownerName string) *unstructured.Unstructured {
+		cm := &unstructured.Unstructured{}
+		cm.SetAPIVersion("v1")
+		cm.SetKind("ConfigMap")
+		cm.SetNamespace(nsName)
+		cm.SetName(configMapName)
+		cm.SetOwnerReferences([]metav1.OwnerReference{
+			{
+				APIVersion: fleetv1beta1.GroupVersion.String(),
+				Kind:       ownerKind,
+				Name:       ownerName,
+			},
+		})
+		return cm
+	}
+
+	tests := []struct {
+		name      string
+		gvr       schema.GroupVersionResource
+		obj       interface{}
+		wantWorks []string
+	}{
+		{
+			name:      "placed resource deleted",
+			gvr:       utils.ConfigMapGVR,
+			obj:       ownedConfigMap(fleetv1beta1.AppliedWorkKind, workName),
+			wantWorks: []string{workName},
+		},
+		{
+			name: "placed resource deleted (tombstone)",
+			gvr:  utils.ConfigMapGVR,
+			obj: cache.DeletedFinalStateUnknown{
+				Key: nsName + "/" + configMapName,
+				Obj: ownedConfigMap(fleetv1beta1.AppliedWorkKind, workName),
+			},
+			wantWorks: []string{workName},
+		},
+		{
+			name: "resource not owned by an AppliedWork object",
+			gvr:  utils.ConfigMapGVR,
+			obj:  ownedConfigMap("DummyOwner", workName),
+		},
+		{
+			name: "resource of a Work object that is not watched",
+			gvr:  utils.ConfigMapGVR,
+			obj:  ownedConfigMap(fleetv1beta1.AppliedWorkKind, "work-2"),
+		},
+		{
+			name: "resource type not watched for the Work object",
+			gvr:  utils.SecretGVR,
+			obj:  ownedConfigMap(fleetv1beta1.AppliedWorkKind, workName),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w := newDeletionWatcherForTest(t, 1)
+			w.watch(workName, sets.New(utils.ConfigMapGVR))
+
+			w.onDelete(tc.gvr, tc.obj)
+			close(w.events)
+			var gotWorks []string
+			for e := range w.events {
+				if e.Object.GetNamespace() != memberReservedNSName1 {
+					t.Errorf("event object namespace = %s, want %s", e.Object.GetNamespace(), memberReservedNSName1)
+				}
+				gotWorks = append(gotWorks, e.Object.GetName())
+			}
+			if diff := cmp.Diff(tc.wantWorks, gotWorks); diff != "" {
+				t.Errorf("onDelete() enqueued works mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestWatchForDeletionIfApplicable(t *testing.T) {
+	bundles := []*manifestProcessingBundle{
+		{
+			gvr:                &utils.ConfigMapGVR,
+			inMemberClusterObj: &unstructured.Unstructured{},
+		},
+		{
+			// The manifest has not been placed.
+			gvr: &utils.SecretGVR,
+		},
+		{
+			// The manifest cannot be decoded.
+		},
+	}
+
+	tests := []struct {
+		name          string
+		applyStrategy *fleetv1beta1.ApplyStrategy
+		want          map[schema.GroupVersionResource][]string
+	}{
+		{
+			name:          "not opted in",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{Type: fleetv1beta1.ApplyStrategyTypeClientSideApply},
+			want:          map[schema.GroupVersionResource][]string{},
+		},
+		{
+			name: "opted in",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				Type:             fleetv1beta1.ApplyStrategyTypeClientSideApply,
+				WatchForDeletion: true,
+			},
+			want: map[schema.GroupVersionResource][]string{
+				utils.ConfigMapGVR: {workName},
+			},
+		},
+		{
+			name: "opted in with the ReportDiff apply strategy",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				Type:             fleetv1beta1.ApplyStrategyTypeReportDiff,
+				WatchForDeletion: true,
+			},
+			want: map[schema.GroupVersionResource][]string{},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			w := newDeletionWatcherForTest(t, 10)
+			// The Work object has been watched before.
+			w.watch(workName, sets.New(utils.DeploymentGVR))
+			r := &Reconciler{deletionWatcher: w}
+			work := &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: memberReservedNSName1,
+					Name:      workName,
+				},
+				Spec: fleetv1beta1.WorkSpec{
+					ApplyStrategy: tc.applyStrategy,
+				},
+			}
+
+			r.watchForDeletionIfApplicable(work, bundles)
+			if diff := cmp.Diff(tc.want, watchedWorks(w)); diff != "" {
+				t.Errorf("watched works mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -31,6 +31,7 @@ import (
 	"k8s.io/klog/v2"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 )
 
@@ -38,6 +39,9 @@ const (
 	k8sReservedLabelAnnotationFullDomain = "kubernetes.io/"
 	k8sReservedLabelAnnotationAbbrDomain = "k8s.io/"
 	fleetReservedLabelAnnotationDomain   = "kubernetes-fleet.io/"
+
+	// redactedPatchDetailValue replaces the sensitive values in the drift/diff outputs.
+	redactedPatchDetailValue = "(redacted for security reasons)"
 )
 
 // takeOverPreExistingObject takes over a pre-existing object in the member cluster.
@@ -65,7 +69,19 @@ func (r *Reconciler) takeOverPreExistingObject(
 
 	// Check this object is already owned by another object (or controller); if so, Fleet will only
 	// add itself as an additional owner if co-ownership is allowed.
-	if len(existingOwnerRefs) >= 1 && !applyStrategy.AllowCoOwnership {
+	//
+	// Owner references that point to the AppliedWork objects of other placements are not
+	// considered here if the co-ownership policies of all the placements allow placing the object
+	// via multiple placements.
+	isCoOwnershipWithOtherPlacementsAllowed, err := r.isCoOwnershipWithOtherPlacementsAllowed(ctx, existingOwnerRefs, applyStrategy, expectedAppliedWorkOwnerRef)
+	if err != nil {
+		return nil, nil, false, fmt.Errorf("failed to check the co-ownership policies of the co-owners: %w", err)
+	}
+	nonFleetOwnerRefs := existingOwnerRefs
+	if isCoOwnershipWithOtherPlacementsAllowed {
+		nonFleetOwnerRefs = ownerRefsExcludingAppliedWorks(existingOwnerRefs)
+	}
+	if len(nonFleetOwnerRefs) >= 1 && !applyStrategy.AllowCoOwnership {
 		// The object is already owned by another object, and co-ownership is forbidden.
 		// No takeover will be performed.
 		//
@@ -82,16 +98,16 @@ func (r *Reconciler) takeOverPreExistingObject(
 	// but no error would be raised on the user-end. With the drift detection feature, however,
 	// this scenario would lead to constant flipping of the drift reporting, which could lead to
 	// user confusion. To address this corner case, Fleet would now deny placing the same object
-	// twice.
-	if isPlacedByFleetInDuplicate(existingOwnerRefs, expectedAppliedWorkOwnerRef) {
-		return nil, nil, false, fmt.Errorf("the object is already owned by another Fleet AppliedWork object")
+	// twice, unless the co-ownership policies of all the placements explicitly allow so.
+	if !isCoOwnershipWithOtherPlacementsAllowed && isPlacedByFleetInDuplicate(existingOwnerRefs, expectedAppliedWorkOwnerRef) {
+		return nil, nil, false, fmt.Errorf("the object is already owned by another Fleet AppliedWork object and the co-ownership policies of the placements do not allow co-ownership")
 	}
 
 	// Check if the takeover action requires additional steps (configuration difference inspection).
 	//
 	// Note that the default takeover action is AlwaysApply.
 	if applyStrategy.WhenToTakeOver == fleetv1beta1.WhenToTakeOverTypeIfNoDiff {
-		configDiffs, diffCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx, gvr, manifestObj, inMemberClusterObjCopy, applyStrategy.ComparisonOption)
+		configDiffs, diffCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx, gvr, manifestObj, inMemberClusterObjCopy, applyStrategy.ComparisonOption, applyStrategy.IgnoreFields)
 		switch {
 		case err != nil:
 			return nil, nil, false, fmt.Errorf("failed to calculate configuration diffs between the manifest object and the object from the member cluster: %w", err)
@@ -115,24 +131,29 @@ func (r *Reconciler) takeOverPreExistingObject(
 }
 
 // diffBetweenManifestAndInMemberClusterObjects calculates the differences between the manifest object
-// and its corresponding object in the member cluster.
+// and its corresponding object in the member cluster; differences in the ignored fields are discarded.
 func (r *Reconciler) diffBetweenManifestAndInMemberClusterObjects(
 	ctx context.Context,
 	gvr *schema.GroupVersionResource,
 	manifestObj, inMemberClusterObj *unstructured.Unstructured,
 	cmpOption fleetv1beta1.ComparisonOptionType,
+	ignoreFields []string,
 ) ([]fleetv1beta1.PatchDetail, bool, error) {
+	var diffs []fleetv1beta1.PatchDetail
+	var diffsCalculatedInDegradedMode bool
+	var err error
 	switch cmpOption {
 	case fleetv1beta1.ComparisonOptionTypePartialComparison:
-		return r.partialDiffBetweenManifestAndInMemberClusterObjects(ctx, gvr, manifestObj, inMemberClusterObj)
+		diffs, diffsCalculatedInDegradedMode, err = r.partialDiffBetweenManifestAndInMemberClusterObjects(ctx, gvr, manifestObj, inMemberClusterObj)
 	case fleetv1beta1.ComparisonOptionTypeFullComparison:
-		// For the full comparison, Fleet compares directly the JSON representations of the
-		// manifest object and the object in the member cluster.
-		patchDetails, err := preparePatchDetails(manifestObj, inMemberClusterObj)
-		return patchDetails, false, err
+		diffs, diffsCalculatedInDegradedMode, err = r.fullDiffBetweenManifestAndInMemberClusterObjects(ctx, gvr, manifestObj, inMemberClusterObj)
 	default:
 		return nil, false, fmt.Errorf("an invalid comparison option is specified")
 	}
+	if err != nil {
+		return nil, false, err
+	}
+	return discardIgnoredFieldsFromPatchDetails(diffs, ignoreFields), diffsCalculatedInDegradedMode, nil
 }
 
 // partialDiffBetweenManifestAndInMemberClusterObjects calculates the differences between the
@@ -187,6 +208,114 @@ func (r *Reconciler) partialDiffBetweenManifestAndInMemberClusterObjects(
 	}
 }
 
+// fullDiffBetweenManifestAndInMemberClusterObjects calculates the differences between the
+// manifest object and its corresponding object in the member cluster across all fields.
+//
+// To avoid reporting differences that are introduced by the member cluster API server rather than
+// the user (e.g., defaulted fields such as the protocol of a Service port, or empty maps that
+// are dropped on persistence), Fleet first retrieves the defaulted desired state by replacing the
+// object in the member cluster with the manifest object in the dry-run mode, and compares it
+// with the object in the member cluster.
+func (r *Reconciler) fullDiffBetweenManifestAndInMemberClusterObjects(
+	ctx context.Context,
+	gvr *schema.GroupVersionResource,
+	manifestObj, inMemberClusterObj *unstructured.Unstructured,
+) ([]fleetv1beta1.PatchDetail, bool, error) {
+	defaultedManifestObj, err := r.updateInDryRunMode(ctx, gvr, manifestObj, inMemberClusterObj)
+	switch {
+	case err == nil:
+		patchDetails, err := preparePatchDetails(defaultedManifestObj, inMemberClusterObj)
+		return patchDetails, false, err
+	case errors.IsInvalid(err):
+		// The dry-run update op has failed as the manifest object provided is not valid, e.g.,
+		// it attempts to change immutable fields of the object in the member cluster.
+		//
+		// In this case, fall back to comparing the manifest object as it is. Report that the diff
+		// is being calculated in a degraded manner.
+		//
+		// This is not considered as a diff calculation error.
+		klog.V(2).InfoS("Calculate diffs in degraded mode as the manifest object cannot be updated in dry-run mode",
+			"gvr", gvr, "manifestObj", klog.KObj(manifestObj), "serverErr", err)
+		patchDetails, err := preparePatchDetails(manifestObj, inMemberClusterObj)
+		return patchDetails, true, err
+	default:
+		// An unexpected error has occurred.
+		return nil, false, fmt.Errorf("failed to update the manifest in dry-run mode: %w", err)
+	}
+}
+
+// driftsBetweenManifestAndInMemberClusterObjects calculates the drifts between the manifest object
+// and its corresponding object in the member cluster, in accordance with both the comparison option
+// and the namespace sameness setting of the apply strategy.
+func (r *Reconciler) driftsBetweenManifestAndInMemberClusterObjects(
+	ctx context.Context,
+	gvr *schema.GroupVersionResource,
+	manifestObj, inMemberClusterObj *unstructured.Unstructured,
+	applyStrategy *fleetv1beta1.ApplyStrategy,
+) ([]fleetv1beta1.PatchDetail, bool, error) {
+	drifts, driftsCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx,
+		gvr,
+		manifestObj, inMemberClusterObj,
+		applyStrategy.ComparisonOption, applyStrategy.IgnoreFields)
+	if err != nil || !shouldCheckNamespaceSameness(gvr, applyStrategy) {
+		return drifts, driftsCalculatedInDegradedMode, err
+	}
+
+	samenessDrifts, err := namespaceSamenessDriftsBetween(manifestObj, inMemberClusterObj)
+	if err != nil {
+		return nil, false, fmt.Errorf("failed to calculate namespace sameness drifts: %w", err)
+	}
+	samenessDrifts = discardIgnoredFieldsFromPatchDetails(samenessDrifts, applyStrategy.IgnoreFields)
+	return mergePatchDetails(drifts, samenessDrifts), driftsCalculatedInDegradedMode, nil
+}
+
+// shouldCheckNamespaceSameness checks if Fleet should compare all the labels and annotations of
+// an object, on top of the drifts found with the comparison option in use.
+func shouldCheckNamespaceSameness(gvr *schema.GroupVersionResource, applyStrategy *fleetv1beta1.ApplyStrategy) bool {
+	// With the full comparison option, all labels and annotations are compared anyway.
+	return applyStrategy.EnforceNamespaceSameness &&
+		applyStrategy.ComparisonOption != fleetv1beta1.ComparisonOptionTypeFullComparison &&
+		*gvr == utils.NamespaceGVR
+}
+
+// namespaceSamenessDriftsBetween calculates the differences in labels and annotations between a
+// namespace manifest object and the namespace in the member cluster, regardless of whether the
+// labels and annotations are specified in the manifest object.
+func namespaceSamenessDriftsBetween(manifestObj, inMemberClusterObj *unstructured.Unstructured) ([]fleetv1beta1.PatchDetail, error) {
+	manifestMetaObj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	manifestMetaObj.SetLabels(manifestObj.GetLabels())
+	manifestMetaObj.SetAnnotations(manifestObj.GetAnnotations())
+
+	inMemberClusterMetaObj := &unstructured.Unstructured{Object: map[string]interface{}{}}
+	inMemberClusterMetaObj.SetLabels(inMemberClusterObj.GetLabels())
+	inMemberClusterMetaObj.SetAnnotations(inMemberClusterObj.GetAnnotations())
+
+	// Labels and annotations reserved by Kubernetes and Fleet are discarded in the comparison.
+	return preparePatchDetails(manifestMetaObj, inMemberClusterMetaObj)
+}
+
+// mergePatchDetails merges two lists of patch details; patch details of the same path are
+// reported only once.
+func mergePatchDetails(details, additionalDetails []fleetv1beta1.PatchDetail) []fleetv1beta1.PatchDetail {
+	if len(additionalDetails) == 0 {
+		return details
+	}
+
+	merged := make([]fleetv1beta1.PatchDetail, 0, len(details)+len(additionalDetails))
+	seenPaths := make(map[string]bool, len(details))
+	for idx := range details {
+		merged = append(merged, details[idx])
+		seenPaths[details[idx].Path] = true
+	}
+	for idx := range additionalDetails {
+		if seenPaths[additionalDetails[idx].Path] {
+			continue
+		}
+		merged = append(merged, additionalDetails[idx])
+	}
+	return merged
+}
+
 // organizeJSONPatchIntoFleetPatchDetails organizes the JSON patch operations into Fleet patch details.
 func organizeJSONPatchIntoFleetPatchDetails(patch jsondiff.Patch, manifestObjMap map[string]interface{}) ([]fleetv1beta1.PatchDetail, error) {
 	// Pre-allocate the slice for the patch details. The organization procedure typically will yield
@@ -378,6 +507,47 @@ func (r *Reconciler) removeLeftBehindAppliedWorkOwnerRefs(ctx context.Context, o
 	return updatedOwnerRefs, nil
 }
 
+// isCoOwnershipWithOtherPlacementsAllowed checks if an object can be placed by other placements
+// as well, i.e., to be owned by multiple AppliedWork objects.
+//
+// This is the case only if the apply strategy and the apply strategies of the Work objects of all
+// the other AppliedWork objects that own the object use the same co-ownership policy other than
+// Deny; an AppliedWork object without a Work object in the hub cluster namespace of the member
+// cluster is considered to use the Deny policy, as its policy is unknown.
+func (r *Reconciler) isCoOwnershipWithOtherPlacementsAllowed(
+	ctx context.Context,
+	ownerRefs []metav1.OwnerReference,
+	applyStrategy *fleetv1beta1.ApplyStrategy,
+	expectedAppliedWorkOwnerRef *metav1.OwnerReference,
+) (bool, error) {
+	policy := coOwnershipPolicyOf(applyStrategy)
+	if policy == fleetv1beta1.CoOwnershipPolicyTypeDeny {
+		return false, nil
+	}
+	for idx := range ownerRefs {
+		ownerRef := ownerRefs[idx]
+		if ownerRef.APIVersion != fleetv1beta1.GroupVersion.String() || ownerRef.Kind != fleetv1beta1.AppliedWorkKind || ownerRef.UID == expectedAppliedWorkOwnerRef.UID {
+			continue
+		}
+		// The AppliedWork object has the same name as its Work object.
+		work := &fleetv1beta1.Work{}
+		if err := r.hubClient.Get(ctx, types.NamespacedName{Namespace: r.workNameSpace, Name: ownerRef.Name}, work); err != nil {
+			if errors.IsNotFound(err) {
+				klog.V(2).InfoS("Found an AppliedWork owner without a Work object; co-ownership is not allowed", "ownerRef", ownerRef)
+				return false, nil
+			}
+			wrappedErr := controller.NewAPIServerError(true, err)
+			return false, fmt.Errorf("failed to get the Work object of a co-owner: %w", wrappedErr)
+		}
+		if coOwnerPolicy := coOwnershipPolicyOf(work.Spec.ApplyStrategy); coOwnerPolicy != policy {
+			klog.V(2).InfoS("Found a co-owner with a different co-ownership policy; co-ownership is not allowed",
+				"coOwnerWork", klog.KObj(work), "coOwnerPolicy", coOwnerPolicy, "policy", policy)
+			return false, nil
+		}
+	}
+	return true, nil
+}
+
 // obscureSensitiveFieldsInPatchDetails obscures sensitive fields from the patch details so that
 // such information will not be included in the drift/diff outputs.
 //
@@ -407,12 +577,57 @@ func obscureSensitiveFieldsInPatchDetails(
 			// Obscure all patch details that concerns the Secret object's data.
 
 			if len(pd.ValueInHub) > 0 {
-				pd.ValueInHub = "(redacted for security reasons)"
+				pd.ValueInHub = redactedPatchDetailValue
 			}
 			if len(pd.ValueInMember) > 0 {
-				pd.ValueInMember = "(redacted for security reasons)"
+				pd.ValueInMember = redactedPatchDetailValue
 			}
 		}
 	}
 	return details
 }
+
+// obscureSensitivePathsInPatchDetails obscures the values in the patch details that concern the given
+// sensitive fields (e.g., the fields that have been decrypted on the member cluster), the fields nested
+// in them, or the fields that contain them.
+func obscureSensitivePathsInPatchDetails(details []fleetv1beta1.PatchDetail, sensitivePaths []string) []fleetv1beta1.PatchDetail {
+	if len(sensitivePaths) == 0 {
+		return details
+	}
+	for idx := range details {
+		pd := &details[idx]
+		if !isIgnoredFieldPath(pd.Path, sensitivePaths) && !containsSensitivePath(pd.Path, sensitivePaths) {
+			continue
+		}
+		if len(pd.ValueInHub) > 0 {
+			pd.ValueInHub = redactedPatchDetailValue
+		}
+		if len(pd.ValueInMember) > 0 {
+			pd.ValueInMember = redactedPatchDetailValue
+		}
+	}
+	return details
+}
+
+// containsSensitivePath checks if any of the sensitive fields is nested in the field that a JSON
+// pointer path points to.
+func containsSensitivePath(path string, sensitivePaths []string) bool {
+	pathSegs := splitJSONPointer(path)
+	for _, sensitivePath := range sensitivePaths {
+		sensitivePathSegs := splitJSONPointer(sensitivePath)
+		if len(pathSegs) > len(sensitivePathSegs) {
+			continue
+		}
+		isMatched := true
+		for idx := range pathSegs {
+			if sensitivePathSegs[idx] != ignoredFieldPathWildcardSegment && sensitivePathSegs[idx] != pathSegs[idx] {
+				isMatched = false
+				break
+			}
+		}
+		if isMatched {
+			return true
+		}
+	}
+	return false
+}
@@ -531,6 +531,101 @@ func TestRemoveLeftBehindAppliedWorkOwnerRefs(t *testing.T) {
 	}
 }
 
+// TestIsCoOwnershipWithOtherPlacementsAllowed tests the isCoOwnershipWithOtherPlacementsAllowed method.
+func TestIsCoOwnershipWithOtherPlacementsAllowed(t *testing.T) {
+	ctx := context.Background()
+
+	coOwnerAppliedWorkOwnerRef := metav1.OwnerReference{
+		APIVersion: fleetv1beta1.GroupVersion.String(),
+		Kind:       fleetv1beta1.AppliedWorkKind,
+		Name:       "co-owner-work",
+		UID:        "uid-2",
+	}
+	coOwnerWork := func(policy fleetv1beta1.CoOwnershipPolicyType) *fleetv1beta1.Work {
+		return &fleetv1beta1.Work{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      coOwnerAppliedWorkOwnerRef.Name,
+				Namespace: memberReservedNSName1,
+			},
+			Spec: fleetv1beta1.WorkSpec{
+				ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+					CoOwnershipPolicy: policy,
+				},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name          string
+		ownerRefs     []metav1.OwnerReference
+		applyStrategy *fleetv1beta1.ApplyStrategy
+		workObj       *fleetv1beta1.Work
+		want          bool
+	}{
+		{
+			name:          "deny policy",
+			ownerRefs:     []metav1.OwnerReference{*appliedWorkOwnerRef, coOwnerAppliedWorkOwnerRef},
+			applyStrategy: &fleetv1beta1.ApplyStrategy{},
+			workObj:       coOwnerWork(fleetv1beta1.CoOwnershipPolicyTypeDeny),
+			want:          false,
+		},
+		{
+			name:          "no co-owners",
+			ownerRefs:     []metav1.OwnerReference{dummyOwnerRef, *appliedWorkOwnerRef},
+			applyStrategy: &fleetv1beta1.ApplyStrategy{CoOwnershipPolicy: fleetv1beta1.CoOwnershipPolicyTypeLastWriterWins},
+			want:          true,
+		},
+		{
+			name:          "co-owner with the same policy",
+			ownerRefs:     []metav1.OwnerReference{*appliedWorkOwnerRef, coOwnerAppliedWorkOwnerRef},
+			applyStrategy: &fleetv1beta1.ApplyStrategy{CoOwnershipPolicy: fleetv1beta1.CoOwnershipPolicyTypeSharedFields},
+			workObj:       coOwnerWork(fleetv1beta1.CoOwnershipPolicyTypeSharedFields),
+			want:          true,
+		},
+		{
+			name:          "co-owner with the deny policy",
+			ownerRefs:     []metav1.OwnerReference{*appliedWorkOwnerRef, coOwnerAppliedWorkOwnerRef},
+			applyStrategy: &fleetv1beta1.ApplyStrategy{CoOwnershipPolicy: fleetv1beta1.CoOwnershipPolicyTypeLastWriterWins},
+			workObj:       coOwnerWork(""),
+			want:          false,
+		},
+		{
+			name:          "co-owner with a different policy",
+			ownerRefs:     []metav1.OwnerReference{*appliedWorkOwnerRef, coOwnerAppliedWorkOwnerRef},
+			applyStrategy: &fleetv1beta1.ApplyStrategy{CoOwnershipPolicy: fleetv1beta1.CoOwnershipPolicyTypeLastWriterWins},
+			workObj:       coOwnerWork(fleetv1beta1.CoOwnershipPolicyTypeSharedFields),
+			want:          false,
+		},
+		{
+			name:          "co-owner without a work object",
+			ownerRefs:     []metav1.OwnerReference{*appliedWorkOwnerRef, coOwnerAppliedWorkOwnerRef},
+			applyStrategy: &fleetv1beta1.ApplyStrategy{CoOwnershipPolicy: fleetv1beta1.CoOwnershipPolicyTypeLastWriterWins},
+			want:          false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeHubClientBuilder := ctrlfake.NewClientBuilder().WithScheme(scheme.Scheme)
+			if tc.workObj != nil {
+				fakeHubClientBuilder = fakeHubClientBuilder.WithObjects(tc.workObj)
+			}
+			r := &Reconciler{
+				hubClient:     fakeHubClientBuilder.Build(),
+				workNameSpace: memberReservedNSName1,
+			}
+
+			got, err := r.isCoOwnershipWithOtherPlacementsAllowed(ctx, tc.ownerRefs, tc.applyStrategy, appliedWorkOwnerRef)
+			if err != nil {
+				t.Fatalf("isCoOwnershipWithOtherPlacementsAllowed() = %v, want no error", err)
+			}
+			if got != tc.want {
+				t.Errorf("isCoOwnershipWithOtherPlacementsAllowed() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
 // TestOrganizeJSONPatchIntoFleetPatchDetails tests the organizeJSONPatchIntoFleetPatchDetails function.
 func TestOrganizeJSONPatchIntoFleetPatchDetails(t *testing.T) {
 	testCases := []struct {
@@ -877,3 +972,182 @@ func TestObscureSensitiveFieldsInPatchDetails(t *testing.T) {
 		})
 	}
 }
+
+// TestObscureSensitivePathsInPatchDetails tests the obscureSensitivePathsInPatchDetails function.
+func TestObscureSensitivePathsInPatchDetails(t *testing.T) {
+	sensitivePaths := []string{"/data/password", "/spec/tokens/*/value"}
+
+	testCases := []struct {
+		name             string
+		patchDetails     []fleetv1beta1.PatchDetail
+		sensitivePaths   []string
+		wantPatchDetails []fleetv1beta1.PatchDetail
+	}{
+		{
+			name: "no sensitive paths",
+			patchDetails: []fleetv1beta1.PatchDetail{
+				{Path: "/data/password", ValueInHub: "s3cr3t", ValueInMember: "0ld"},
+			},
+			wantPatchDetails: []fleetv1beta1.PatchDetail{
+				{Path: "/data/password", ValueInHub: "s3cr3t", ValueInMember: "0ld"},
+			},
+		},
+		{
+			name: "sensitive fields",
+			patchDetails: []fleetv1beta1.PatchDetail{
+				{Path: "/data/password", ValueInHub: "s3cr3t", ValueInMember: "0ld"},
+				{Path: "/spec/tokens/1/value", ValueInMember: "token-2"},
+				{Path: "/data/user", ValueInHub: "admin", ValueInMember: "root"},
+			},
+			sensitivePaths: sensitivePaths,
+			wantPatchDetails: []fleetv1beta1.PatchDetail{
+				{Path: "/data/password", ValueInHub: redactedPatchDetailValue, ValueInMember: redactedPatchDetailValue},
+				{Path: "/spec/tokens/1/value", ValueInMember: redactedPatchDetailValue},
+				{Path: "/data/user", ValueInHub: "admin", ValueInMember: "root"},
+			},
+		},
+		{
+			name: "fields that contain sensitive fields",
+			patchDetails: []fleetv1beta1.PatchDetail{
+				{Path: "/data", ValueInHub: "map[password:s3cr3t]"},
+				{Path: "/spec/tokens/0", ValueInMember: "map[value:token-1]"},
+			},
+			sensitivePaths: sensitivePaths,
+			wantPatchDetails: []fleetv1beta1.PatchDetail{
+				{Path: "/data", ValueInHub: redactedPatchDetailValue},
+				{Path: "/spec/tokens/0", ValueInMember: redactedPatchDetailValue},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := obscureSensitivePathsInPatchDetails(tc.patchDetails, tc.sensitivePaths)
+			if diff := cmp.Diff(got, tc.wantPatchDetails); diff != "" {
+				t.Errorf("obscureSensitivePathsInPatchDetails() mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestNamespaceSamenessDriftsBetween tests the namespaceSamenessDriftsBetween function.
+func TestNamespaceSamenessDriftsBetween(t *testing.T) {
+	nsManifest := ns.DeepCopy()
+	nsManifest.Labels = map[string]string{
+		"istio-injection": "enabled",
+	}
+	nsManifest.Annotations = map[string]string{
+		"owner": "team-a",
+	}
+
+	nsInMemberSame := nsManifest.DeepCopy()
+	nsInMemberSame.Labels[corev1.LabelMetadataName] = nsName
+	nsInMemberSame.Annotations[fleetv1beta1.ManifestHashAnnotation] = "hash"
+
+	nsInMemberWithExtraFields := nsManifest.DeepCopy()
+	nsInMemberWithExtraFields.Labels["topology.istio.io/network"] = "network-1"
+	nsInMemberWithExtraFields.Annotations["owner"] = "team-b"
+
+	nsInMemberWithMissingFields := ns.DeepCopy()
+
+	testCases := []struct {
+		name               string
+		manifestObj        *unstructured.Unstructured
+		inMemberClusterObj *unstructured.Unstructured
+		wantPatchDetails   []fleetv1beta1.PatchDetail
+	}{
+		{
+			name:               "same labels and annotations (reserved ones ignored)",
+			manifestObj:        toUnstructured(t, nsManifest),
+			inMemberClusterObj: toUnstructured(t, nsInMemberSame),
+			wantPatchDetails:   []fleetv1beta1.PatchDetail{},
+		},
+		{
+			name:               "extra label and changed annotation in member cluster",
+			manifestObj:        toUnstructured(t, nsManifest),
+			inMemberClusterObj: toUnstructured(t, nsInMemberWithExtraFields),
+			wantPatchDetails: []fleetv1beta1.PatchDetail{
+				{
+					Path:          "/metadata/labels/topology.istio.io~1network",
+					ValueInMember: "network-1",
+				},
+				{
+					Path:          "/metadata/annotations/owner",
+					ValueInMember: "team-b",
+					ValueInHub:    "team-a",
+				},
+			},
+		},
+		{
+			name:               "missing labels and annotations in member cluster",
+			manifestObj:        toUnstructured(t, nsManifest),
+			inMemberClusterObj: toUnstructured(t, nsInMemberWithMissingFields),
+			wantPatchDetails: []fleetv1beta1.PatchDetail{
+				{
+					Path:       "/metadata/labels/istio-injection",
+					ValueInHub: "enabled",
+				},
+				{
+					Path:       "/metadata/annotations/owner",
+					ValueInHub: "team-a",
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			patchDetails, err := namespaceSamenessDriftsBetween(tc.manifestObj, tc.inMemberClusterObj)
+			if err != nil {
+				t.Fatalf("namespaceSamenessDriftsBetween() = %v, want no error", err)
+			}
+
+			if diff := cmp.Diff(patchDetails, tc.wantPatchDetails, cmpopts.SortSlices(lessFuncPatchDetail), cmpopts.EquateEmpty()); diff != "" {
+				t.Fatalf("patchDetails mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestMergePatchDetails tests the mergePatchDetails function.
+func TestMergePatchDetails(t *testing.T) {
+	testCases := []struct {
+		name              string
+		details           []fleetv1beta1.PatchDetail
+		additionalDetails []fleetv1beta1.PatchDetail
+		wantPatchDetails  []fleetv1beta1.PatchDetail
+	}{
+		{
+			name: "no additional details",
+			details: []fleetv1beta1.PatchDetail{
+				{Path: "/spec/replicas", ValueInMember: "1", ValueInHub: "2"},
+			},
+			wantPatchDetails: []fleetv1beta1.PatchDetail{
+				{Path: "/spec/replicas", ValueInMember: "1", ValueInHub: "2"},
+			},
+		},
+		{
+			name: "details of the same path are reported once",
+			details: []fleetv1beta1.PatchDetail{
+				{Path: "/metadata/labels/app", ValueInMember: "nginx", ValueInHub: "envoy"},
+			},
+			additionalDetails: []fleetv1beta1.PatchDetail{
+				{Path: "/metadata/labels/app", ValueInMember: "nginx", ValueInHub: "envoy"},
+				{Path: "/metadata/labels/team", ValueInMember: "red"},
+			},
+			wantPatchDetails: []fleetv1beta1.PatchDetail{
+				{Path: "/metadata/labels/app", ValueInMember: "nginx", ValueInHub: "envoy"},
+				{Path: "/metadata/labels/team", ValueInMember: "red"},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := mergePatchDetails(tc.details, tc.additionalDetails)
+			if diff := cmp.Diff(got, tc.wantPatchDetails); diff != "" {
+				t.Errorf("mergePatchDetails() mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,158 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"fmt"
+	"time"
+
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// timeOfDayLayout is the layout of the start and end times of drift remediation windows.
+	timeOfDayLayout = "15:04"
+)
+
+// weekdayToDayOfWeek maps the days of the week in the time package to those in the Fleet API.
+var weekdayToDayOfWeek = map[time.Weekday]fleetv1beta1.DayOfWeek{
+	time.Monday:    fleetv1beta1.Monday,
+	time.Tuesday:   fleetv1beta1.Tuesday,
+	time.Wednesday: fleetv1beta1.Wednesday,
+	time.Thursday:  fleetv1beta1.Thursday,
+	time.Friday:    fleetv1beta1.Friday,
+	time.Saturday:  fleetv1beta1.Saturday,
+	time.Sunday:    fleetv1beta1.Sunday,
+}
+
+// applyDriftRemediationWindowIfApplicable resolves the drift remediation window that is in effect
+// for a Work object at the given time, and sets the WhenToApply setting of its apply strategy
+// (in memory only) in accordance with the action of the window.
+//
+// It returns the duration until the next window boundary, at which the Work object should be
+// re-processed so that a new window takes effect in time; zero is returned if no window applies.
+func applyDriftRemediationWindowIfApplicable(work *fleetv1beta1.Work, now time.Time) time.Duration {
+	applyStrategy := work.Spec.ApplyStrategy
+	if applyStrategy == nil || len(applyStrategy.DriftRemediationWindows) == 0 || applyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff {
+		return 0
+	}
+
+	window, untilNextBoundary := driftRemediationWindowInEffect(applyStrategy.DriftRemediationWindows, now)
+	if window == nil {
+		klog.V(2).InfoS("No drift remediation window is in effect; use the WhenToApply setting as is",
+			"work", klog.KObj(work), "whenToApply", applyStrategy.WhenToApply, "untilNextBoundary", untilNextBoundary)
+		return untilNextBoundary
+	}
+
+	switch window.Action {
+	case fleetv1beta1.DriftRemediationActionTypeAutoRevert:
+		applyStrategy.WhenToApply = fleetv1beta1.WhenToApplyTypeAlways
+	case fleetv1beta1.DriftRemediationActionTypeReportOnly:
+		applyStrategy.WhenToApply = fleetv1beta1.WhenToApplyTypeIfNotDrifted
+	}
+	klog.V(2).InfoS("A drift remediation window is in effect", "work", klog.KObj(work),
+		"action", window.Action, "start", window.Start, "end", window.End, "timeZone", window.TimeZone,
+		"whenToApply", applyStrategy.WhenToApply, "untilNextBoundary", untilNextBoundary)
+	return untilNextBoundary
+}
+
+// driftRemediationWindowInEffect returns the first of the given drift remediation windows that is
+// in effect at the given time (nil if there is none), along with the duration until the next
+// boundary (i.e., the start or the end) of any of the windows.
+//
+// Windows with invalid settings are ignored.
+func driftRemediationWindowInEffect(windows []fleetv1beta1.DriftRemediationWindow, now time.Time) (*fleetv1beta1.DriftRemediationWindow, time.Duration) {
+	var inEffect *fleetv1beta1.DriftRemediationWindow
+	var nextBoundary time.Time
+	for idx := range windows {
+		window := &windows[idx]
+		occurrences, err := driftRemediationWindowOccurrencesAround(window, now)
+		if err != nil {
+			klog.ErrorS(err, "Skipped a drift remediation window with invalid settings", "index", idx)
+			continue
+		}
+		for _, occurrence := range occurrences {
+			start, end := occurrence[0], occurrence[1]
+			if inEffect == nil && !now.Before(start) && now.Before(end) {
+				inEffect = window
+			}
+			for _, boundary := range []time.Time{start, end} {
+				if boundary.After(now) && (nextBoundary.IsZero() || boundary.Before(nextBoundary)) {
+					nextBoundary = boundary
+				}
+			}
+		}
+	}
+
+	if nextBoundary.IsZero() {
+		return inEffect, 0
+	}
+	return inEffect, nextBoundary.Sub(now)
+}
+
+// driftRemediationWindowOccurrencesAround returns the start and end times of the occurrences of a
+// drift remediation window that start from the day before the given time to a week after it, which
+// cover both the occurrence that might be in effect and the next boundary of the window.
+func driftRemediationWindowOccurrencesAround(window *fleetv1beta1.DriftRemediationWindow, now time.Time) ([][2]time.Time, error) {
+	timeZone := window.TimeZone
+	if timeZone == "" {
+		timeZone = "UTC"
+	}
+	loc, err := time.LoadLocation(timeZone)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load time zone %s: %w", timeZone, err)
+	}
+	start, err := time.Parse(timeOfDayLayout, window.Start)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse start time %s: %w", window.Start, err)
+	}
+	end, err := time.Parse(timeOfDayLayout, window.End)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse end time %s: %w", window.End, err)
+	}
+
+	localNow := now.In(loc)
+	occurrences := make([][2]time.Time, 0, 9)
+	for offset := -1; offset <= 7; offset++ {
+		occurrenceStart := time.Date(localNow.Year(), localNow.Month(), localNow.Day()+offset, start.Hour(), start.Minute(), 0, 0, loc)
+		if !startsOn(window, occurrenceStart.Weekday()) {
+			continue
+		}
+		occurrenceEnd := time.Date(localNow.Year(), localNow.Month(), localNow.Day()+offset, end.Hour(), end.Minute(), 0, 0, loc)
+		if !occurrenceEnd.After(occurrenceStart) {
+			// The window ends on the next day.
+			occurrenceEnd = time.Date(localNow.Year(), localNow.Month(), localNow.Day()+offset+1, end.Hour(), end.Minute(), 0, 0, loc)
+		}
+		occurrences = append(occurrences, [2]time.Time{occurrenceStart, occurrenceEnd})
+	}
+	return occurrences, nil
+}
+
+// startsOn returns if a drift remediation window starts on the given day of the week.
+func startsOn(window *fleetv1beta1.DriftRemediationWindow, weekday time.Weekday) bool {
+	if len(window.Days) == 0 {
+		return true
+	}
+	for _, day := range window.Days {
+		if day == weekdayToDayOfWeek[weekday] {
+			return true
+		}
+	}
+	return false
+}
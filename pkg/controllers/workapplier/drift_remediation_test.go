@@ -0,0 +1,233 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+var (
+	// businessHoursWindow only reports drifts from 09:00 to 17:00 (UTC) on weekdays.
+	businessHoursWindow = fleetv1beta1.DriftRemediationWindow{
+		Action: fleetv1beta1.DriftRemediationActionTypeReportOnly,
+		Days:   []fleetv1beta1.DayOfWeek{fleetv1beta1.Monday, fleetv1beta1.Tuesday, fleetv1beta1.Wednesday, fleetv1beta1.Thursday, fleetv1beta1.Friday},
+		Start:  "09:00",
+		End:    "17:00",
+	}
+	// nightlyWindow reverts drifts from 22:00 to 06:00 (New York time) every day.
+	nightlyWindow = fleetv1beta1.DriftRemediationWindow{
+		Action:   fleetv1beta1.DriftRemediationActionTypeAutoRevert,
+		Start:    "22:00",
+		End:      "06:00",
+		TimeZone: "America/New_York",
+	}
+)
+
+// TestDriftRemediationWindowInEffect tests the driftRemediationWindowInEffect function.
+func TestDriftRemediationWindowInEffect(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Fatalf("failed to load time zone: %v", err)
+	}
+
+	testCases := []struct {
+		name                  string
+		windows               []fleetv1beta1.DriftRemediationWindow
+		now                   time.Time
+		wantWindow            *fleetv1beta1.DriftRemediationWindow
+		wantUntilNextBoundary time.Duration
+	}{
+		{
+			name:    "in a window on a weekday",
+			windows: []fleetv1beta1.DriftRemediationWindow{businessHoursWindow},
+			// 2025-06-02 is a Monday.
+			now:                   time.Date(2025, 6, 2, 10, 30, 0, 0, time.UTC),
+			wantWindow:            &businessHoursWindow,
+			wantUntilNextBoundary: 6*time.Hour + 30*time.Minute,
+		},
+		{
+			name:                  "at the end of a window",
+			windows:               []fleetv1beta1.DriftRemediationWindow{businessHoursWindow},
+			now:                   time.Date(2025, 6, 2, 17, 0, 0, 0, time.UTC),
+			wantUntilNextBoundary: 16 * time.Hour,
+		},
+		{
+			name:    "outside a window on a weekend",
+			windows: []fleetv1beta1.DriftRemediationWindow{businessHoursWindow},
+			// 2025-06-07 is a Saturday.
+			now:                   time.Date(2025, 6, 7, 10, 0, 0, 0, time.UTC),
+			wantUntilNextBoundary: 47 * time.Hour,
+		},
+		{
+			name:    "in a window that started on the previous day in another time zone",
+			windows: []fleetv1beta1.DriftRemediationWindow{nightlyWindow},
+			// 02:00 in New York.
+			now:                   time.Date(2025, 6, 3, 2, 0, 0, 0, newYork),
+			wantWindow:            &nightlyWindow,
+			wantUntilNextBoundary: 4 * time.Hour,
+		},
+		{
+			name:    "the first window in effect takes precedence",
+			windows: []fleetv1beta1.DriftRemediationWindow{nightlyWindow, businessHoursWindow},
+			// 22:30 in New York on Monday, i.e., 02:30 on Tuesday in UTC.
+			now:        time.Date(2025, 6, 2, 22, 30, 0, 0, newYork),
+			wantWindow: &nightlyWindow,
+			// The business hours window starts at 09:00 in UTC, before the nightly window ends.
+			wantUntilNextBoundary: 6*time.Hour + 30*time.Minute,
+		},
+		{
+			name: "window lasting for 24 hours",
+			windows: []fleetv1beta1.DriftRemediationWindow{
+				{
+					Action: fleetv1beta1.DriftRemediationActionTypeReportOnly,
+					Days:   []fleetv1beta1.DayOfWeek{fleetv1beta1.Friday},
+					Start:  "12:00",
+					End:    "12:00",
+				},
+			},
+			// 2025-06-07 is a Saturday.
+			now: time.Date(2025, 6, 7, 8, 0, 0, 0, time.UTC),
+			wantWindow: &fleetv1beta1.DriftRemediationWindow{
+				Action: fleetv1beta1.DriftRemediationActionTypeReportOnly,
+				Days:   []fleetv1beta1.DayOfWeek{fleetv1beta1.Friday},
+				Start:  "12:00",
+				End:    "12:00",
+			},
+			wantUntilNextBoundary: 4 * time.Hour,
+		},
+		{
+			name: "window with an invalid time zone is ignored",
+			windows: []fleetv1beta1.DriftRemediationWindow{
+				{
+					Action:   fleetv1beta1.DriftRemediationActionTypeAutoRevert,
+					Start:    "00:00",
+					End:      "00:00",
+					TimeZone: "Mars/Olympus_Mons",
+				},
+			},
+			now: time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotWindow, gotUntilNextBoundary := driftRemediationWindowInEffect(tc.windows, tc.now)
+			if diff := cmp.Diff(gotWindow, tc.wantWindow); diff != "" {
+				t.Errorf("driftRemediationWindowInEffect() window mismatches (-got, +want):\n%s", diff)
+			}
+			if gotUntilNextBoundary != tc.wantUntilNextBoundary {
+				t.Errorf("driftRemediationWindowInEffect() until next boundary = %v, want %v", gotUntilNextBoundary, tc.wantUntilNextBoundary)
+			}
+		})
+	}
+}
+
+// TestApplyDriftRemediationWindowIfApplicable tests the applyDriftRemediationWindowIfApplicable function.
+func TestApplyDriftRemediationWindowIfApplicable(t *testing.T) {
+	// 2025-06-02 is a Monday.
+	inBusinessHours := time.Date(2025, 6, 2, 10, 0, 0, 0, time.UTC)
+	offHours := time.Date(2025, 6, 2, 18, 0, 0, 0, time.UTC)
+
+	testCases := []struct {
+		name                  string
+		applyStrategy         *fleetv1beta1.ApplyStrategy
+		now                   time.Time
+		wantWhenToApply       fleetv1beta1.WhenToApplyType
+		wantUntilNextBoundary time.Duration
+	}{
+		{
+			name: "no windows",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				Type:        fleetv1beta1.ApplyStrategyTypeClientSideApply,
+				WhenToApply: fleetv1beta1.WhenToApplyTypeAlways,
+			},
+			now:             inBusinessHours,
+			wantWhenToApply: fleetv1beta1.WhenToApplyTypeAlways,
+		},
+		{
+			name: "report only in business hours",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				Type:                    fleetv1beta1.ApplyStrategyTypeClientSideApply,
+				WhenToApply:             fleetv1beta1.WhenToApplyTypeAlways,
+				DriftRemediationWindows: []fleetv1beta1.DriftRemediationWindow{businessHoursWindow},
+			},
+			now:                   inBusinessHours,
+			wantWhenToApply:       fleetv1beta1.WhenToApplyTypeIfNotDrifted,
+			wantUntilNextBoundary: 7 * time.Hour,
+		},
+		{
+			name: "use the WhenToApply setting outside the windows",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				Type:                    fleetv1beta1.ApplyStrategyTypeServerSideApply,
+				WhenToApply:             fleetv1beta1.WhenToApplyTypeAlways,
+				DriftRemediationWindows: []fleetv1beta1.DriftRemediationWindow{businessHoursWindow},
+			},
+			now:                   offHours,
+			wantWhenToApply:       fleetv1beta1.WhenToApplyTypeAlways,
+			wantUntilNextBoundary: 15 * time.Hour,
+		},
+		{
+			name: "auto revert in a window",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				Type:        fleetv1beta1.ApplyStrategyTypeClientSideApply,
+				WhenToApply: fleetv1beta1.WhenToApplyTypeIfNotDrifted,
+				DriftRemediationWindows: []fleetv1beta1.DriftRemediationWindow{
+					{
+						Action: fleetv1beta1.DriftRemediationActionTypeAutoRevert,
+						Start:  "17:00",
+						End:    "09:00",
+					},
+				},
+			},
+			now:                   offHours,
+			wantWhenToApply:       fleetv1beta1.WhenToApplyTypeAlways,
+			wantUntilNextBoundary: 15 * time.Hour,
+		},
+		{
+			name: "windows do not apply to the ReportDiff apply strategy",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{
+				Type:                    fleetv1beta1.ApplyStrategyTypeReportDiff,
+				WhenToApply:             fleetv1beta1.WhenToApplyTypeAlways,
+				DriftRemediationWindows: []fleetv1beta1.DriftRemediationWindow{businessHoursWindow},
+			},
+			now:             inBusinessHours,
+			wantWhenToApply: fleetv1beta1.WhenToApplyTypeAlways,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			work := &fleetv1beta1.Work{
+				Spec: fleetv1beta1.WorkSpec{
+					ApplyStrategy: tc.applyStrategy,
+				},
+			}
+			gotUntilNextBoundary := applyDriftRemediationWindowIfApplicable(work, tc.now)
+			if gotUntilNextBoundary != tc.wantUntilNextBoundary {
+				t.Errorf("applyDriftRemediationWindowIfApplicable() = %v, want %v", gotUntilNextBoundary, tc.wantUntilNextBoundary)
+			}
+			if got := work.Spec.ApplyStrategy.WhenToApply; got != tc.wantWhenToApply {
+				t.Errorf("applyDriftRemediationWindowIfApplicable() WhenToApply = %s, want %s", got, tc.wantWhenToApply)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+const (
+	WorkPostApplyHooksSucceededReason = "PostApplyHooksSucceeded"
+	WorkPostApplyHooksSucceededMsg    = "All post-apply hooks have completed successfully"
+	WorkPostApplyHookFailedReason     = "PostApplyHookFailed"
+	WorkPostApplyHookFailedMsgTmpl    = "Post-apply hook %s has failed: %v"
+)
+
+// PostApplyHook is a hook that the work applier runs after all the manifests in a Work object
+// have been applied and have become available on the member cluster, e.g., to warm up caches or
+// to run smoke tests.
+//
+// The work applier runs the hooks at most once per generation of a Work object, as long as they
+// complete successfully; failed hooks are retried when the Work object is re-processed. As a result,
+// implementations should be idempotent.
+type PostApplyHook interface {
+	// Name returns the name of the hook, which is used in the Work object status.
+	Name() string
+	// Run runs the hook for a Work object.
+	Run(ctx context.Context, work *fleetv1beta1.Work) error
+}
+
+// postApplyHookRequest is the payload the work applier sends to a socket-based post-apply hook.
+type postApplyHookRequest struct {
+	WorkNamespace  string `json:"workNamespace"`
+	WorkName       string `json:"workName"`
+	WorkGeneration int64  `json:"workGeneration"`
+}
+
+// unixSocketPostApplyHook is a post-apply hook that notifies a local process, which listens on
+// a Unix domain socket, via a HTTP POST request.
+type unixSocketPostApplyHook struct {
+	name   string
+	client *unixSocketJSONClient
+}
+
+var _ PostApplyHook = &unixSocketPostApplyHook{}
+
+// NewUnixSocketPostApplyHook returns a post-apply hook that sends a HTTP POST request, which
+// describes the Work object that has been applied, to a local process listening on the
+// given Unix domain socket. The hook is considered to be successful if the process responds
+// with a 2XX status code within the given timeout.
+func NewUnixSocketPostApplyHook(name, socketPath string, timeout time.Duration) PostApplyHook {
+	return &unixSocketPostApplyHook{
+		name:   name,
+		client: newUnixSocketJSONClient("hook", socketPath, timeout),
+	}
+}
+
+// Name returns the name of the hook.
+func (h *unixSocketPostApplyHook) Name() string {
+	return h.name
+}
+
+// Run sends the request to the local process.
+func (h *unixSocketPostApplyHook) Run(ctx context.Context, work *fleetv1beta1.Work) error {
+	return h.client.post(ctx, &postApplyHookRequest{
+		WorkNamespace:  work.Namespace,
+		WorkName:       work.Name,
+		WorkGeneration: work.Generation,
+	}, nil, 0)
+}
+
+// runPostApplyHooksIfNeeded runs the post-apply hooks for a Work object if all of its manifests
+// have been applied and are available, and sets the PostApplyHooksCompleted condition on the
+// Work object accordingly.
+func (r *Reconciler) runPostApplyHooksIfNeeded(ctx context.Context, work *fleetv1beta1.Work) {
+	if len(r.postApplyHooks) == 0 {
+		return
+	}
+
+	hooksCond := meta.FindStatusCondition(work.Status.Conditions, fleetv1beta1.WorkConditionTypePostApplyHooksCompleted)
+	availableCond := meta.FindStatusCondition(work.Status.Conditions, fleetv1beta1.WorkConditionTypeAvailable)
+	switch {
+	case condition.IsConditionStatusTrue(hooksCond, work.Generation):
+		// The hooks have completed for the current generation; no need to run them again.
+		return
+	case !condition.IsConditionStatusTrue(availableCond, work.Generation):
+		// The Work object is not yet available; the hooks will run when it becomes available.
+		//
+		// Drop the results from previous generations (if any) so that they will not be
+		// confused with those of the current generation.
+		if hooksCond != nil && hooksCond.ObservedGeneration != work.Generation {
+			meta.RemoveStatusCondition(&work.Status.Conditions, fleetv1beta1.WorkConditionTypePostApplyHooksCompleted)
+		}
+		return
+	}
+
+	newCond := metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypePostApplyHooksCompleted,
+		Status:             metav1.ConditionTrue,
+		Reason:             WorkPostApplyHooksSucceededReason,
+		Message:            WorkPostApplyHooksSucceededMsg,
+		ObservedGeneration: work.Generation,
+	}
+	for _, hook := range r.postApplyHooks {
+		if err := hook.Run(ctx, work); err != nil {
+			klog.ErrorS(err, "Failed to run post-apply hook", "work", klog.KObj(work), "hook", hook.Name())
+			newCond.Status = metav1.ConditionFalse
+			newCond.Reason = WorkPostApplyHookFailedReason
+			newCond.Message = fmt.Sprintf(WorkPostApplyHookFailedMsgTmpl, hook.Name(), err)
+			break
+		}
+		klog.V(2).InfoS("Post-apply hook has completed", "work", klog.KObj(work), "hook", hook.Name())
+	}
+	meta.SetStatusCondition(&work.Status.Conditions, newCond)
+}
@@ -0,0 +1,271 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+// fakePostApplyHook is a post-apply hook for testing purposes.
+type fakePostApplyHook struct {
+	name     string
+	err      error
+	runCount int
+}
+
+func (h *fakePostApplyHook) Name() string {
+	return h.name
+}
+
+func (h *fakePostApplyHook) Run(_ context.Context, _ *fleetv1beta1.Work) error {
+	h.runCount++
+	return h.err
+}
+
+// TestRunPostApplyHooksIfNeeded tests the runPostApplyHooksIfNeeded method.
+func TestRunPostApplyHooksIfNeeded(t *testing.T) {
+	ctx := context.Background()
+
+	availableCond := metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypeAvailable,
+		Status:             metav1.ConditionTrue,
+		Reason:             condition.WorkAllManifestsAvailableReason,
+		ObservedGeneration: 2,
+	}
+	notAvailableCond := metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypeAvailable,
+		Status:             metav1.ConditionFalse,
+		Reason:             condition.WorkNotAllManifestsAvailableReason,
+		ObservedGeneration: 2,
+	}
+	hooksSucceededCond := metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypePostApplyHooksCompleted,
+		Status:             metav1.ConditionTrue,
+		Reason:             WorkPostApplyHooksSucceededReason,
+		Message:            WorkPostApplyHooksSucceededMsg,
+		ObservedGeneration: 2,
+	}
+	hooksSucceededCondOfLastGen := metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypePostApplyHooksCompleted,
+		Status:             metav1.ConditionTrue,
+		Reason:             WorkPostApplyHooksSucceededReason,
+		Message:            WorkPostApplyHooksSucceededMsg,
+		ObservedGeneration: 1,
+	}
+	hookErr := fmt.Errorf("connection refused")
+
+	testCases := []struct {
+		name          string
+		hooks         []*fakePostApplyHook
+		conds         []metav1.Condition
+		wantConds     []metav1.Condition
+		wantRunCounts []int
+	}{
+		{
+			name:      "no hooks",
+			conds:     []metav1.Condition{availableCond},
+			wantConds: []metav1.Condition{availableCond},
+		},
+		{
+			name: "work not available",
+			hooks: []*fakePostApplyHook{
+				{name: "hook-1"},
+			},
+			conds:         []metav1.Condition{notAvailableCond},
+			wantConds:     []metav1.Condition{notAvailableCond},
+			wantRunCounts: []int{0},
+		},
+		{
+			name: "work not available, drop results from last generation",
+			hooks: []*fakePostApplyHook{
+				{name: "hook-1"},
+			},
+			conds:         []metav1.Condition{notAvailableCond, hooksSucceededCondOfLastGen},
+			wantConds:     []metav1.Condition{notAvailableCond},
+			wantRunCounts: []int{0},
+		},
+		{
+			name: "work available, hooks have completed",
+			hooks: []*fakePostApplyHook{
+				{name: "hook-1"},
+			},
+			conds:         []metav1.Condition{availableCond, hooksSucceededCond},
+			wantConds:     []metav1.Condition{availableCond, hooksSucceededCond},
+			wantRunCounts: []int{0},
+		},
+		{
+			name: "work available, all hooks succeed",
+			hooks: []*fakePostApplyHook{
+				{name: "hook-1"},
+				{name: "hook-2"},
+			},
+			conds:         []metav1.Condition{availableCond, hooksSucceededCondOfLastGen},
+			wantConds:     []metav1.Condition{availableCond, hooksSucceededCond},
+			wantRunCounts: []int{1, 1},
+		},
+		{
+			name: "work available, a hook fails",
+			hooks: []*fakePostApplyHook{
+				{name: "hook-1", err: hookErr},
+				{name: "hook-2"},
+			},
+			conds: []metav1.Condition{availableCond},
+			wantConds: []metav1.Condition{
+				availableCond,
+				{
+					Type:               fleetv1beta1.WorkConditionTypePostApplyHooksCompleted,
+					Status:             metav1.ConditionFalse,
+					Reason:             WorkPostApplyHookFailedReason,
+					Message:            fmt.Sprintf(WorkPostApplyHookFailedMsgTmpl, "hook-1", hookErr),
+					ObservedGeneration: 2,
+				},
+			},
+			wantRunCounts: []int{1, 0},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			hooks := make([]PostApplyHook, 0, len(tc.hooks))
+			for _, h := range tc.hooks {
+				hooks = append(hooks, h)
+			}
+			r := &Reconciler{
+				postApplyHooks: hooks,
+			}
+			work := &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       workName,
+					Namespace:  memberReservedNSName1,
+					Generation: 2,
+				},
+				Status: fleetv1beta1.WorkStatus{
+					Conditions: tc.conds,
+				},
+			}
+
+			r.runPostApplyHooksIfNeeded(ctx, work)
+			if diff := cmp.Diff(work.Status.Conditions, tc.wantConds, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("work conditions mismatches (-got, +want):\n%s", diff)
+			}
+			for idx, h := range tc.hooks {
+				if h.runCount != tc.wantRunCounts[idx] {
+					t.Errorf("hook %s run count = %d, want %d", h.name, h.runCount, tc.wantRunCounts[idx])
+				}
+			}
+		})
+	}
+}
+
+// TestUnixSocketPostApplyHook tests the unixSocketPostApplyHook.
+func TestUnixSocketPostApplyHook(t *testing.T) {
+	work := &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       workName,
+			Namespace:  memberReservedNSName1,
+			Generation: 2,
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		statusCode       int
+		wantErred        bool
+		wantErrMsgSubStr string
+	}{
+		{
+			name:       "hook succeeds",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:             "hook fails",
+			statusCode:       http.StatusInternalServerError,
+			wantErred:        true,
+			wantErrMsgSubStr: "hook responded with status code 500: smoke test failed",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			socketPath := filepath.Join(t.TempDir(), "hook.sock")
+			listener, err := net.Listen("unix", socketPath)
+			if err != nil {
+				t.Fatalf("failed to listen on the socket: %v", err)
+			}
+
+			var gotReq postApplyHookRequest
+			server := &http.Server{
+				Handler: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+					if err := json.NewDecoder(req.Body).Decode(&gotReq); err != nil {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					w.WriteHeader(tc.statusCode)
+					if tc.statusCode != http.StatusOK {
+						_, _ = w.Write([]byte("smoke test failed"))
+					}
+				}),
+				ReadHeaderTimeout: time.Second,
+			}
+			go func() {
+				_ = server.Serve(listener)
+			}()
+			t.Cleanup(func() {
+				_ = server.Close()
+			})
+
+			hook := NewUnixSocketPostApplyHook("unix-socket", socketPath, 5*time.Second)
+			err = hook.Run(context.Background(), work)
+			if tc.wantErred {
+				if err == nil {
+					t.Fatalf("Run() = nil, want erred")
+				}
+				if !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Fatalf("Run() error = %v, want error msg with sub-string %s", err, tc.wantErrMsgSubStr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Run() = %v, want nil", err)
+			}
+
+			wantReq := postApplyHookRequest{
+				WorkNamespace:  memberReservedNSName1,
+				WorkName:       workName,
+				WorkGeneration: 2,
+			}
+			if diff := cmp.Diff(gotReq, wantReq); diff != "" {
+				t.Errorf("hook request mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,180 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"strconv"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// ignoredFieldPathWildcardSegment is the path segment that matches all the items of an array
+	// or all the entries of a map in an ignored field path.
+	ignoredFieldPathWildcardSegment = "*"
+)
+
+// splitJSONPointer splits a JSON pointer into its (escaped) path segments.
+func splitJSONPointer(path string) []string {
+	if !strings.HasPrefix(path, "/") {
+		return nil
+	}
+	return strings.Split(path[1:], "/")
+}
+
+// unescapeJSONPointerSegment unescapes a JSON pointer path segment, as specified in RFC 6901.
+func unescapeJSONPointerSegment(seg string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(seg, "~1", "/"), "~0", "~")
+}
+
+// escapeJSONPointerSegment escapes a JSON pointer path segment, as specified in RFC 6901.
+func escapeJSONPointerSegment(seg string) string {
+	return strings.ReplaceAll(strings.ReplaceAll(seg, "~", "~0"), "/", "~1")
+}
+
+// isIgnoredFieldPath checks if a JSON pointer path points to an ignored field, or to a field
+// nested in an ignored field.
+func isIgnoredFieldPath(path string, ignoreFields []string) bool {
+	pathSegs := splitJSONPointer(path)
+	for _, ignoreField := range ignoreFields {
+		ignoreFieldSegs := splitJSONPointer(ignoreField)
+		if len(ignoreFieldSegs) == 0 || len(ignoreFieldSegs) > len(pathSegs) {
+			continue
+		}
+
+		isMatched := true
+		for idx := range ignoreFieldSegs {
+			if ignoreFieldSegs[idx] != ignoredFieldPathWildcardSegment && ignoreFieldSegs[idx] != pathSegs[idx] {
+				isMatched = false
+				break
+			}
+		}
+		if isMatched {
+			return true
+		}
+	}
+	return false
+}
+
+// discardIgnoredFieldsFromPatchDetails removes the patch details that concern ignored fields.
+func discardIgnoredFieldsFromPatchDetails(details []fleetv1beta1.PatchDetail, ignoreFields []string) []fleetv1beta1.PatchDetail {
+	if len(ignoreFields) == 0 || len(details) == 0 {
+		return details
+	}
+
+	kept := make([]fleetv1beta1.PatchDetail, 0, len(details))
+	for idx := range details {
+		if isIgnoredFieldPath(details[idx].Path, ignoreFields) {
+			continue
+		}
+		kept = append(kept, details[idx])
+	}
+	return kept
+}
+
+// keepIgnoredFieldsFrom sets the ignored fields in the manifest object to their values in the
+// object from the member cluster, so that an apply op would leave these fields untouched; ignored
+// fields that are absent from the object in the member cluster are removed from the manifest object.
+func keepIgnoredFieldsFrom(manifestObj, inMemberClusterObj *unstructured.Unstructured, ignoreFields []string) {
+	for _, ignoreField := range ignoreFields {
+		segs := splitJSONPointer(ignoreField)
+		if len(segs) == 0 {
+			continue
+		}
+		keepFieldFrom(manifestObj.Object, inMemberClusterObj.Object, segs)
+	}
+}
+
+// keepFieldFrom sets the field at the given path in the destination to its value in the source,
+// or removes the field from the destination if it is absent from the source.
+func keepFieldFrom(dst, src interface{}, segs []string) {
+	seg, restSegs := segs[0], segs[1:]
+
+	switch typedDst := dst.(type) {
+	case map[string]interface{}:
+		typedSrc, _ := src.(map[string]interface{})
+		keys := []string{unescapeJSONPointerSegment(seg)}
+		if seg == ignoredFieldPathWildcardSegment {
+			keys = keys[:0]
+			for k := range typedDst {
+				keys = append(keys, k)
+			}
+			for k := range typedSrc {
+				if _, found := typedDst[k]; !found {
+					keys = append(keys, k)
+				}
+			}
+		}
+
+		for _, k := range keys {
+			srcVal, isInSrc := typedSrc[k]
+			if len(restSegs) == 0 {
+				if isInSrc {
+					typedDst[k] = runtime.DeepCopyJSONValue(srcVal)
+				} else {
+					delete(typedDst, k)
+				}
+				continue
+			}
+
+			dstVal, isInDst := typedDst[k]
+			if !isInDst {
+				// Fill in the missing map so that the nested fields from the source can be kept.
+				if _, ok := srcVal.(map[string]interface{}); !ok {
+					continue
+				}
+				newDstVal := map[string]interface{}{}
+				keepFieldFrom(newDstVal, srcVal, restSegs)
+				if len(newDstVal) > 0 {
+					typedDst[k] = newDstVal
+				}
+				continue
+			}
+			keepFieldFrom(dstVal, srcVal, restSegs)
+		}
+	case []interface{}:
+		// Items are matched by their indices; items cannot be added or removed, as this would
+		// shift the rest of the items in the array.
+		typedSrc, _ := src.([]interface{})
+		indices := make([]int, 0, len(typedDst))
+		if seg == ignoredFieldPathWildcardSegment {
+			for idx := range typedDst {
+				indices = append(indices, idx)
+			}
+		} else if idx, err := strconv.Atoi(seg); err == nil && idx >= 0 && idx < len(typedDst) {
+			indices = append(indices, idx)
+		}
+
+		for _, idx := range indices {
+			var srcVal interface{}
+			if idx < len(typedSrc) {
+				srcVal = typedSrc[idx]
+			}
+			if len(restSegs) == 0 {
+				if srcVal != nil {
+					typedDst[idx] = runtime.DeepCopyJSONValue(srcVal)
+				}
+				continue
+			}
+			keepFieldFrom(typedDst[idx], srcVal, restSegs)
+		}
+	}
+}
@@ -0,0 +1,216 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func TestIsIgnoredFieldPath(t *testing.T) {
+	ignoreFields := []string{
+		"/spec/replicas",
+		"/spec/template/spec/containers/*/resources",
+		"/metadata/annotations/example.com~1owner",
+	}
+
+	testCases := []struct {
+		name        string
+		path        string
+		wantIgnored bool
+	}{
+		{
+			name:        "ignored field",
+			path:        "/spec/replicas",
+			wantIgnored: true,
+		},
+		{
+			name:        "field nested in an ignored field (wildcard)",
+			path:        "/spec/template/spec/containers/1/resources/limits/cpu",
+			wantIgnored: true,
+		},
+		{
+			name:        "ignored annotation with an escaped name",
+			path:        "/metadata/annotations/example.com~1owner",
+			wantIgnored: true,
+		},
+		{
+			name: "sibling of an ignored field",
+			path: "/spec/template/spec/containers/1/image",
+		},
+		{
+			name: "parent of an ignored field",
+			path: "/spec",
+		},
+		{
+			name: "field with a common prefix",
+			path: "/spec/replicasLimit",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := isIgnoredFieldPath(tc.path, ignoreFields); got != tc.wantIgnored {
+				t.Errorf("isIgnoredFieldPath(%s) = %t, want %t", tc.path, got, tc.wantIgnored)
+			}
+		})
+	}
+}
+
+func TestDiscardIgnoredFieldsFromPatchDetails(t *testing.T) {
+	testCases := []struct {
+		name             string
+		details          []fleetv1beta1.PatchDetail
+		ignoreFields     []string
+		wantPatchDetails []fleetv1beta1.PatchDetail
+	}{
+		{
+			name: "no ignored fields",
+			details: []fleetv1beta1.PatchDetail{
+				{Path: "/spec/replicas", ValueInMember: "5", ValueInHub: "2"},
+			},
+			wantPatchDetails: []fleetv1beta1.PatchDetail{
+				{Path: "/spec/replicas", ValueInMember: "5", ValueInHub: "2"},
+			},
+		},
+		{
+			name: "details of ignored fields are discarded",
+			details: []fleetv1beta1.PatchDetail{
+				{Path: "/spec/replicas", ValueInMember: "5", ValueInHub: "2"},
+				{Path: "/spec/template/spec/containers/0/image", ValueInMember: "nginx:1.25", ValueInHub: "nginx:1.26"},
+				{Path: "/spec/template/spec/containers/0/resources/requests/cpu", ValueInMember: "200m", ValueInHub: "100m"},
+			},
+			ignoreFields: []string{"/spec/replicas", "/spec/template/spec/containers/*/resources"},
+			wantPatchDetails: []fleetv1beta1.PatchDetail{
+				{Path: "/spec/template/spec/containers/0/image", ValueInMember: "nginx:1.25", ValueInHub: "nginx:1.26"},
+			},
+		},
+		{
+			name: "all details discarded",
+			details: []fleetv1beta1.PatchDetail{
+				{Path: "/spec/replicas", ValueInMember: "5", ValueInHub: "2"},
+			},
+			ignoreFields:     []string{"/spec/replicas"},
+			wantPatchDetails: []fleetv1beta1.PatchDetail{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := discardIgnoredFieldsFromPatchDetails(tc.details, tc.ignoreFields)
+			if diff := cmp.Diff(got, tc.wantPatchDetails); diff != "" {
+				t.Errorf("discardIgnoredFieldsFromPatchDetails() mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestKeepIgnoredFieldsFrom(t *testing.T) {
+	testCases := []struct {
+		name               string
+		manifestObj        *unstructured.Unstructured
+		inMemberClusterObj *unstructured.Unstructured
+		ignoreFields       []string
+		wantManifestObj    *unstructured.Unstructured
+	}{
+		{
+			name: "keep the value from the member cluster",
+			manifestObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": int64(2), "paused": false},
+			}},
+			inMemberClusterObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": int64(5), "paused": true},
+			}},
+			ignoreFields: []string{"/spec/replicas"},
+			wantManifestObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": int64(5), "paused": false},
+			}},
+		},
+		{
+			name: "remove the field absent from the member cluster",
+			manifestObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{"replicas": int64(2)},
+			}},
+			inMemberClusterObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{},
+			}},
+			ignoreFields: []string{"/spec/replicas"},
+			wantManifestObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{},
+			}},
+		},
+		{
+			name: "fill in the missing map from the member cluster",
+			manifestObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{"name": "app"},
+			}},
+			inMemberClusterObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":        "app",
+					"annotations": map[string]interface{}{"example.com/owner": "team-a", "other": "value"},
+				},
+			}},
+			ignoreFields: []string{"/metadata/annotations/example.com~1owner"},
+			wantManifestObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"name":        "app",
+					"annotations": map[string]interface{}{"example.com/owner": "team-a"},
+				},
+			}},
+		},
+		{
+			name: "wildcard over array items",
+			manifestObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app", "resources": map[string]interface{}{"cpu": "100m"}},
+						map[string]interface{}{"name": "sidecar", "resources": map[string]interface{}{"cpu": "50m"}},
+					},
+				},
+			}},
+			inMemberClusterObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app", "resources": map[string]interface{}{"cpu": "300m"}},
+					},
+				},
+			}},
+			ignoreFields: []string{"/spec/containers/*/resources"},
+			wantManifestObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"spec": map[string]interface{}{
+					"containers": []interface{}{
+						map[string]interface{}{"name": "app", "resources": map[string]interface{}{"cpu": "300m"}},
+						map[string]interface{}{"name": "sidecar"},
+					},
+				},
+			}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			keepIgnoredFieldsFrom(tc.manifestObj, tc.inMemberClusterObj, tc.ignoreFields)
+			if diff := cmp.Diff(tc.manifestObj, tc.wantManifestObj); diff != "" {
+				t.Errorf("keepIgnoredFieldsFrom() mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -47,9 +47,22 @@ const (
 	manifestDriftOrDiffDetectionStatusNotFound = "NotFound"
 )
 
+// The label values for the manifest apply op counter metric.
+const (
+	manifestApplyOpResultPerformed = "Performed"
+	manifestApplyOpResultFailed    = "Failed"
+	manifestApplyOpResultSkipped   = "Skipped"
+)
+
+// trackManifestApplyOpMetrics tracks an apply op on an object that already exists in the member
+// cluster, which has been performed, has failed, or has been skipped as a no-op.
+func trackManifestApplyOpMetrics(result, tenant string) {
+	membermetrics.FleetManifestApplyOpsTotal.WithLabelValues(result, tenant).Inc()
+}
+
 // trackWorkAndManifestProcessingRequestMetrics tracks the work and manifest processing request metrics.
 // It is called right after the status of the work is refreshed.
-func trackWorkAndManifestProcessingRequestMetrics(work *fleetv1beta1.Work) {
+func trackWorkAndManifestProcessingRequestMetrics(work *fleetv1beta1.Work, tenant string) {
 	// Increment the work processing request counter.
 
 	var workApplyStatus string
@@ -128,6 +141,12 @@ func trackWorkAndManifestProcessingRequestMetrics(work *fleetv1beta1.Work) {
 		workAvailabilityStatus,
 		workDiffReportedStatus,
 	).Inc()
+	membermetrics.FleetTenantWorkProcessingRequestsTotal.WithLabelValues(
+		tenant,
+		workApplyStatus,
+		workAvailabilityStatus,
+		workDiffReportedStatus,
+	).Inc()
 
 	// Increment the manifest processing request counter.
 	for idx := range work.Status.ManifestConditions {
@@ -519,7 +519,7 @@ func TestTrackWorkAndManifestProcessingRequestMetrics(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			trackWorkAndManifestProcessingRequestMetrics(tc.work)
+			trackWorkAndManifestProcessingRequestMetrics(tc.work, DefaultTenant)
 
 			// Collect the metrics.
 			if c := testutil.CollectAndCount(membermetrics.FleetWorkProcessingRequestsTotal); c != tc.wantWorkMetricCount {
@@ -546,3 +546,63 @@ func TestTrackWorkAndManifestProcessingRequestMetrics(t *testing.T) {
 		})
 	}
 }
+
+func TestTrackTenantWorkProcessingRequestMetrics(t *testing.T) {
+	work := &placementv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: workName,
+		},
+		Status: placementv1beta1.WorkStatus{
+			Conditions: []metav1.Condition{
+				{
+					Type:   placementv1beta1.WorkConditionTypeApplied,
+					Reason: condition.WorkAllManifestsAppliedReason,
+					Status: metav1.ConditionTrue,
+				},
+				{
+					Type:   placementv1beta1.WorkConditionTypeAvailable,
+					Reason: condition.WorkAllManifestsAvailableReason,
+					Status: metav1.ConditionTrue,
+				},
+			},
+		},
+	}
+	tenantCounter := membermetrics.FleetTenantWorkProcessingRequestsTotal.WithLabelValues(
+		"tenant-a", condition.WorkAllManifestsAppliedReason, condition.WorkAllManifestsAvailableReason, workOrManifestStatusSkipped)
+	wantTotal := testutil.ToFloat64(membermetrics.FleetWorkProcessingRequestsTotal.WithLabelValues(
+		condition.WorkAllManifestsAppliedReason, condition.WorkAllManifestsAvailableReason, workOrManifestStatusSkipped)) + 2
+
+	trackWorkAndManifestProcessingRequestMetrics(work, "tenant-a")
+	trackWorkAndManifestProcessingRequestMetrics(work, "tenant-a")
+
+	if got := testutil.ToFloat64(tenantCounter); got != 2 {
+		t.Errorf("tenant work processing requests = %v, want 2", got)
+	}
+	// The requests of the tenant are also counted in the total of all the tenants.
+	if got := testutil.ToFloat64(membermetrics.FleetWorkProcessingRequestsTotal.WithLabelValues(
+		condition.WorkAllManifestsAppliedReason, condition.WorkAllManifestsAvailableReason, workOrManifestStatusSkipped)); got != wantTotal {
+		t.Errorf("work processing requests = %v, want %v", got, wantTotal)
+	}
+}
+
+func TestTrackManifestApplyOpMetrics(t *testing.T) {
+	metricMetadata := `
+		# HELP fleet_manifest_apply_ops_total Total number of apply ops on manifest objects that already exist in the member cluster, performed, failed, or skipped as no-ops
+		# TYPE fleet_manifest_apply_ops_total counter
+	`
+
+	trackManifestApplyOpMetrics(manifestApplyOpResultPerformed, DefaultTenant)
+	trackManifestApplyOpMetrics(manifestApplyOpResultSkipped, DefaultTenant)
+	trackManifestApplyOpMetrics(manifestApplyOpResultSkipped, DefaultTenant)
+
+	wantCounter := `
+		fleet_manifest_apply_ops_total{result="Performed",tenant="default"} 1
+		fleet_manifest_apply_ops_total{result="Skipped",tenant="default"} 2
+	`
+	if err := testutil.CollectAndCompare(
+		membermetrics.FleetManifestApplyOpsTotal,
+		strings.NewReader(metricMetadata+wantCounter),
+	); err != nil {
+		t.Fatalf("unexpected apply op counter value:\n%v", err)
+	}
+}
@@ -101,6 +101,10 @@ func (r *Reconciler) preProcessManifests(
 	}
 	r.parallelizer.ParallelizeUntil(childCtx, len(bundles), doWork, "decodingManifests")
 
+	// Defer the processing of the custom resources whose CRDs are placed in the same Work object
+	// until the CRDs have been established.
+	deferCustomResourcesOfPlacedCRDs(bundles, work)
+
 	// Check for duplicated manifests.
 	//
 	// This is to address a corner case where users might have specified the same manifest
@@ -192,13 +196,19 @@ func (r *Reconciler) writeAheadManifestProcessingAttempts(
 	// Identify any manifests from previous runs that might have been applied and are now left
 	// over in the member cluster.
 	leftOverManifests := findLeftOverManifests(manifestCondsForWA, existingManifestCondQIdx, work.Status.ManifestConditions)
-	if err := r.removeLeftOverManifests(ctx, leftOverManifests, expectedAppliedWorkOwnerRef); err != nil {
+
+	// Before any left-over manifest is removed, check the inventories of other AppliedWork objects
+	// so that Fleet will not delete resources that other Works still depend on (e.g., a namespace
+	// that is shared by resources placed by another Work).
+	sharedDeps := r.newSharedDependencyIndex(expectedAppliedWorkOwnerRef.Name)
+	keptSharedDeps, err := r.removeLeftOverManifests(ctx, leftOverManifests, expectedAppliedWorkOwnerRef, sharedDeps)
+	if err != nil {
 		klog.Errorf("Failed to remove left-over manifests (work=%+v, leftOverManifestCount=%d, removalFailureCount=%d)",
 			workRef, len(leftOverManifests), len(err.Errors()))
 		return fmt.Errorf("failed to remove left-over manifests: %w", err)
 	}
 	klog.V(2).InfoS("Left-over manifests are found and removed",
-		"leftOverManifestCount", len(leftOverManifests), "work", workRef)
+		"leftOverManifestCount", len(leftOverManifests), "keptSharedDependencyCount", len(keptSharedDeps), "work", workRef)
 
 	// Update the status.
 	//
@@ -209,6 +219,7 @@ func (r *Reconciler) writeAheadManifestProcessingAttempts(
 		// not allow nil conditions.
 		work.Status.Conditions = []metav1.Condition{}
 	}
+	setWorkSharedDependencyCondition(work, keptSharedDeps)
 	work.Status.ManifestConditions = manifestCondsForWA
 	if err := r.hubClient.Status().Update(ctx, work); err != nil {
 		klog.ErrorS(err, "Failed to write ahead manifest processing attempts", "work", workRef)
@@ -432,11 +443,15 @@ func findLeftOverManifests(
 }
 
 // removeLeftOverManifests removes applied left-over manifests from the member cluster.
+//
+// Left-over manifests that other Works still depend on, as told by the shared dependency index, are
+// kept on the member cluster (with Fleet dropping the ownership); such manifests are returned.
 func (r *Reconciler) removeLeftOverManifests(
 	ctx context.Context,
 	leftOverManifests []fleetv1beta1.AppliedResourceMeta,
 	expectedAppliedWorkOwnerRef *metav1.OwnerReference,
-) utilerrors.Aggregate {
+	sharedDeps *sharedDependencyIndex,
+) ([]fleetv1beta1.AppliedResourceMeta, utilerrors.Aggregate) {
 	// Remove all the manifests in parallel.
 	//
 	// This is concurrency safe as each worker processes its own applied manifest and writes
@@ -449,27 +464,37 @@ func (r *Reconciler) removeLeftOverManifests(
 
 	// Pre-allocate the slice.
 	errs := make([]error, len(leftOverManifests))
+	kept := make([]bool, len(leftOverManifests))
 	doWork := func(pieces int) {
 		appliedManifestMeta := leftOverManifests[pieces]
 
 		// Remove the left-over manifest.
-		err := r.removeOneLeftOverManifest(ctx, appliedManifestMeta, expectedAppliedWorkOwnerRef)
+		isKept, err := r.removeOneLeftOverManifest(ctx, appliedManifestMeta, expectedAppliedWorkOwnerRef, sharedDeps)
 		if err != nil {
 			errs[pieces] = fmt.Errorf("failed to remove the left-over manifest (regular object): %w", err)
 		}
+		kept[pieces] = isKept
 	}
 	r.parallelizer.ParallelizeUntil(childCtx, len(leftOverManifests), doWork, "removeLeftOverManifests")
 
-	return utilerrors.NewAggregate(errs)
+	keptSharedDeps := make([]fleetv1beta1.AppliedResourceMeta, 0)
+	for idx := range leftOverManifests {
+		if kept[idx] {
+			keptSharedDeps = append(keptSharedDeps, leftOverManifests[idx])
+		}
+	}
+	return keptSharedDeps, utilerrors.NewAggregate(errs)
 }
 
 // removeOneLeftOverManifestWithGenerateName removes an applied manifest object that is left over
-// in the member cluster.
+// in the member cluster. It returns true if the object has been kept, as other Works still
+// depend on it.
 func (r *Reconciler) removeOneLeftOverManifest(
 	ctx context.Context,
 	leftOverManifest fleetv1beta1.AppliedResourceMeta,
 	expectedAppliedWorkOwnerRef *metav1.OwnerReference,
-) error {
+	sharedDeps *sharedDependencyIndex,
+) (bool, error) {
 	// Build the GVR.
 	gvr := schema.GroupVersionResource{
 		Group:    leftOverManifest.Group,
@@ -486,14 +511,14 @@ func (r *Reconciler) removeOneLeftOverManifest(
 	switch {
 	case err != nil && apierrors.IsNotFound(err):
 		// The object has been deleted from the member cluster; no further action is needed.
-		return nil
+		return false, nil
 	case err != nil:
 		// Failed to retrieve the object from the member cluster.
 		wrappedErr := controller.NewAPIServerError(false, err) // false as dynamic client is non-caching.
-		return fmt.Errorf("failed to retrieve the object from the member cluster (gvr=%+v, manifestObj=%+v): %w", gvr, klog.KRef(manifestNamespace, manifestName), wrappedErr)
+		return false, fmt.Errorf("failed to retrieve the object from the member cluster (gvr=%+v, manifestObj=%+v): %w", gvr, klog.KRef(manifestNamespace, manifestName), wrappedErr)
 	case inMemberClusterObj.GetDeletionTimestamp() != nil:
 		// The object has been marked for deletion; no further action is needed.
-		return nil
+		return false, nil
 	}
 
 	// There are occasions, though rare, where the object has the same GVR + namespace + name
@@ -506,9 +531,13 @@ func (r *Reconciler) removeOneLeftOverManifest(
 			"gvr", gvr, "manifestObj",
 			klog.KRef(manifestNamespace, manifestName), "inMemberClusterObj", klog.KObj(inMemberClusterObj),
 			"expectedAppliedWorkOwnerRef", *expectedAppliedWorkOwnerRef)
-		return nil
+		return false, nil
 	}
 
+	dependents, err := sharedDeps.dependents(ctx, &leftOverManifest.WorkResourceIdentifier)
+	if err != nil {
+		return false, fmt.Errorf("failed to find the dependents of the object (gvr=%+v, manifestObj=%+v): %w", gvr, klog.KRef(manifestNamespace, manifestName), err)
+	}
 	switch {
 	case len(inMemberClusterObj.GetOwnerReferences()) > 1:
 		// Fleet is not the sole owner of the object; in this case, Fleet will only drop the
@@ -517,13 +546,20 @@ func (r *Reconciler) removeOneLeftOverManifest(
 			"gvr", gvr, "manifestObj",
 			klog.KRef(manifestNamespace, manifestName), "inMemberClusterObj", klog.KObj(inMemberClusterObj),
 			"expectedAppliedWorkOwnerRef", *expectedAppliedWorkOwnerRef)
-		removeOwnerRef(inMemberClusterObj, expectedAppliedWorkOwnerRef)
-		if _, err := r.spokeDynamicClient.Resource(gvr).Namespace(manifestNamespace).Update(ctx, inMemberClusterObj, metav1.UpdateOptions{}); err != nil && !apierrors.IsNotFound(err) {
-			// Failed to drop the ownership.
-			wrappedErr := controller.NewAPIServerError(false, err)
-			return fmt.Errorf("failed to drop the ownership of the object (gvr=%+v, manifestObj=%+v, inMemberClusterObj=%+v, expectedAppliedWorkOwnerRef=%+v): %w",
-				gvr, klog.KRef(manifestNamespace, manifestName), klog.KObj(inMemberClusterObj), *expectedAppliedWorkOwnerRef, wrappedErr)
+		return false, r.dropOwnershipOfLeftOverObject(ctx, gvr, inMemberClusterObj, expectedAppliedWorkOwnerRef)
+	case len(dependents) > 0:
+		// Fleet is the sole owner of the object, but resources placed by other Works still depend
+		// on it (e.g., the object is a namespace that hosts resources from other Works); in this
+		// case, Fleet will keep the object and only drop the ownership, so that the dependents
+		// are not deleted along with it.
+		klog.V(2).InfoS("The object to remove is still depended upon by resources placed by other Works; Fleet will keep the object and drop the ownership",
+			"gvr", gvr, "manifestObj",
+			klog.KRef(manifestNamespace, manifestName), "inMemberClusterObj", klog.KObj(inMemberClusterObj),
+			"dependentAppliedWorks", dependents, "expectedAppliedWorkOwnerRef", *expectedAppliedWorkOwnerRef)
+		if err := r.dropOwnershipOfLeftOverObject(ctx, gvr, inMemberClusterObj, expectedAppliedWorkOwnerRef); err != nil {
+			return false, err
 		}
+		return true, nil
 	default:
 		// Fleet is the sole owner of the object; in this case, Fleet will delete the object.
 		klog.V(2).InfoS("The object to remove is solely owned by Fleet; Fleet will delete the object",
@@ -543,13 +579,40 @@ func (r *Reconciler) removeOneLeftOverManifest(
 				UID: &inMemberClusterObjUID,
 			},
 		}
-		if err := r.spokeDynamicClient.Resource(gvr).Namespace(manifestNamespace).Delete(ctx, manifestName, deleteOpts); err != nil && !apierrors.IsNotFound(err) {
+		err := r.spokeDynamicClient.Resource(gvr).Namespace(manifestNamespace).Delete(ctx, manifestName, deleteOpts)
+		switch {
+		case err != nil && !apierrors.IsNotFound(err):
 			// Failed to delete the object from the member cluster.
 			wrappedErr := controller.NewAPIServerError(false, err)
-			return fmt.Errorf("failed to delete the object (gvr=%+v, manifestObj=%+v, inMemberClusterObj=%+v, expectedAppliedWorkOwnerRef=%+v): %w",
+			return false, fmt.Errorf("failed to delete the object (gvr=%+v, manifestObj=%+v, inMemberClusterObj=%+v, expectedAppliedWorkOwnerRef=%+v): %w",
 				gvr, klog.KRef(manifestNamespace, manifestName), klog.KObj(inMemberClusterObj), *expectedAppliedWorkOwnerRef, wrappedErr)
+		case err == nil:
+			r.auditChange(expectedAppliedWorkOwnerRef.Name, AuditOperationDelete, AuditReasonRemoveLeftOver, gvr, inMemberClusterObj, nil)
 		}
 	}
+	return false, nil
+}
+
+// dropOwnershipOfLeftOverObject removes the Fleet ownership from a left-over object in the
+// member cluster.
+func (r *Reconciler) dropOwnershipOfLeftOverObject(
+	ctx context.Context,
+	gvr schema.GroupVersionResource,
+	inMemberClusterObj *unstructured.Unstructured,
+	expectedAppliedWorkOwnerRef *metav1.OwnerReference,
+) error {
+	oldInMemberClusterObj := inMemberClusterObj.DeepCopy()
+	removeOwnerRef(inMemberClusterObj, expectedAppliedWorkOwnerRef)
+	updatedObj, err := r.spokeDynamicClient.Resource(gvr).Namespace(inMemberClusterObj.GetNamespace()).Update(ctx, inMemberClusterObj, metav1.UpdateOptions{})
+	switch {
+	case err != nil && !apierrors.IsNotFound(err):
+		// Failed to drop the ownership.
+		wrappedErr := controller.NewAPIServerError(false, err)
+		return fmt.Errorf("failed to drop the ownership of the object (gvr=%+v, inMemberClusterObj=%+v, expectedAppliedWorkOwnerRef=%+v): %w",
+			gvr, klog.KObj(inMemberClusterObj), *expectedAppliedWorkOwnerRef, wrappedErr)
+	case err == nil:
+		r.auditChange(expectedAppliedWorkOwnerRef.Name, AuditOperationUpdate, AuditReasonDropOwnership, gvr, oldInMemberClusterObj, updatedObj)
+	}
 	return nil
 }
 
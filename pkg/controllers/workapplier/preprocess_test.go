@@ -198,13 +198,16 @@ func TestRemoveLeftOverManifests(t *testing.T) {
 	nsName1 := fmt.Sprintf(nsNameTemplate, "1")
 	nsName2 := fmt.Sprintf(nsNameTemplate, "2")
 	nsName3 := fmt.Sprintf(nsNameTemplate, "3")
+	nsName4 := fmt.Sprintf(nsNameTemplate, "4")
 
 	testCases := []struct {
 		name                           string
 		leftOverManifests              []fleetv1beta1.AppliedResourceMeta
+		sharedDeps                     *sharedDependencyIndex
 		inMemberClusterObjs            []runtime.Object
 		wantInMemberClusterObjs        []corev1.Namespace
 		wantRemovedInMemberClusterObjs []corev1.Namespace
+		wantKeptSharedDeps             []fleetv1beta1.AppliedResourceMeta
 	}{
 		{
 			name: "mixed",
@@ -225,7 +228,25 @@ func TestRemoveLeftOverManifests(t *testing.T) {
 				{
 					WorkResourceIdentifier: *nsWRI(3, nsName3),
 				},
+				// The object is still depended upon by resources placed by other Works.
+				{
+					WorkResourceIdentifier: *nsWRI(4, nsName4),
+				},
 			},
+			sharedDeps: newSharedDependencyIndexFor(t, []fleetv1beta1.AppliedWork{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "other-work",
+					},
+					Status: fleetv1beta1.AppliedWorkStatus{
+						AppliedResources: []fleetv1beta1.AppliedResourceMeta{
+							{
+								WorkResourceIdentifier: *deployWRI(0, nsName4, deployName),
+							},
+						},
+					},
+				},
+			}, workName),
 			inMemberClusterObjs: []runtime.Object{
 				&corev1.Namespace{
 					ObjectMeta: metav1.ObjectMeta{
@@ -249,6 +270,14 @@ func TestRemoveLeftOverManifests(t *testing.T) {
 						},
 					},
 				},
+				&corev1.Namespace{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: nsName4,
+						OwnerReferences: []metav1.OwnerReference{
+							*appliedWorkOwnerRef,
+						},
+					},
+				},
 			},
 			wantInMemberClusterObjs: []corev1.Namespace{
 				{
@@ -267,6 +296,12 @@ func TestRemoveLeftOverManifests(t *testing.T) {
 						},
 					},
 				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:            nsName4,
+						OwnerReferences: []metav1.OwnerReference{},
+					},
+				},
 			},
 			wantRemovedInMemberClusterObjs: []corev1.Namespace{
 				{
@@ -275,6 +310,11 @@ func TestRemoveLeftOverManifests(t *testing.T) {
 					},
 				},
 			},
+			wantKeptSharedDeps: []fleetv1beta1.AppliedResourceMeta{
+				{
+					WorkResourceIdentifier: *nsWRI(4, nsName4),
+				},
+			},
 		},
 	}
 
@@ -285,9 +325,13 @@ func TestRemoveLeftOverManifests(t *testing.T) {
 				spokeDynamicClient: fakeClient,
 				parallelizer:       parallelizer.NewParallelizer(2),
 			}
-			if err := r.removeLeftOverManifests(ctx, tc.leftOverManifests, appliedWorkOwnerRef); err != nil {
+			keptSharedDeps, err := r.removeLeftOverManifests(ctx, tc.leftOverManifests, appliedWorkOwnerRef, tc.sharedDeps)
+			if err != nil {
 				t.Errorf("removeLeftOverManifests() = %v, want no error", err)
 			}
+			if diff := cmp.Diff(keptSharedDeps, tc.wantKeptSharedDeps, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("removeLeftOverManifests() kept shared dependencies mismatches (-got +want):\n%s", diff)
+			}
 
 			for idx := range tc.wantInMemberClusterObjs {
 				wantNS := tc.wantInMemberClusterObjs[idx]
@@ -353,7 +397,9 @@ func TestRemoveOneLeftOverManifest(t *testing.T) {
 		name string
 		// To simplify things, for this test Fleet uses a fixed concrete type.
 		inMemberClusterObj     *corev1.Namespace
+		sharedDeps             *sharedDependencyIndex
 		wantInMemberClusterObj *corev1.Namespace
+		wantKept               bool
 	}{
 		{
 			name: "not found",
@@ -412,6 +458,38 @@ func TestRemoveOneLeftOverManifest(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "shared dependency",
+			inMemberClusterObj: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: nsName,
+					OwnerReferences: []metav1.OwnerReference{
+						*appliedWorkOwnerRef,
+					},
+				},
+			},
+			sharedDeps: newSharedDependencyIndexFor(t, []fleetv1beta1.AppliedWork{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "other-work",
+					},
+					Status: fleetv1beta1.AppliedWorkStatus{
+						AppliedResources: []fleetv1beta1.AppliedResourceMeta{
+							{
+								WorkResourceIdentifier: *nsWRI(0, nsName),
+							},
+						},
+					},
+				},
+			}, workName),
+			wantInMemberClusterObj: &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:            nsName,
+					OwnerReferences: []metav1.OwnerReference{},
+				},
+			},
+			wantKept: true,
+		},
 		{
 			name: "deletion",
 			inMemberClusterObj: &corev1.Namespace{
@@ -437,9 +515,13 @@ func TestRemoveOneLeftOverManifest(t *testing.T) {
 			r := &Reconciler{
 				spokeDynamicClient: fakeClient,
 			}
-			if err := r.removeOneLeftOverManifest(ctx, leftOverManifest, appliedWorkOwnerRef); err != nil {
+			kept, err := r.removeOneLeftOverManifest(ctx, leftOverManifest, appliedWorkOwnerRef, tc.sharedDeps)
+			if err != nil {
 				t.Errorf("removeOneLeftOverManifest() = %v, want no error", err)
 			}
+			if kept != tc.wantKept {
+				t.Errorf("removeOneLeftOverManifest() kept = %t, want %t", kept, tc.wantKept)
+			}
 
 			if tc.inMemberClusterObj != nil {
 				var gotUnstructured *unstructured.Unstructured
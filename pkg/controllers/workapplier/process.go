@@ -21,11 +21,14 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/resource"
 )
@@ -104,7 +107,10 @@ func (r *Reconciler) processManifests(
 			return fmt.Errorf("manifest processing has been interrupted: %w", err)
 		}
 	}
-	return nil
+
+	// Process the custom resources whose CRDs are placed in the same Work object last, after the
+	// CRDs have been established.
+	return r.processCustomResourcesOfPlacedCRDs(ctx, bundles, work, expectedAppliedWorkOwnerRef)
 }
 
 // processOneManifest processes a manifest (in the JSON format) embedded in the Work object.
@@ -125,6 +131,12 @@ func (r *Reconciler) processOneManifest(
 		return
 	}
 
+	// Skip the manifest if it is a job that has completed and then been cleaned up per its TTL in
+	// the member cluster; re-creating the job would run it again.
+	if shouldSkipProcessing := skipFinishedJobCleanedUpIfApplicable(bundle, work); shouldSkipProcessing {
+		return
+	}
+
 	// Take over the object in the member cluster that corresponds to the manifest object
 	// if applicable.
 	//
@@ -164,6 +176,13 @@ func (r *Reconciler) processOneManifest(
 		return
 	}
 
+	// Keep a copy of the object in the member cluster (if any) for the audit trail, as the apply op
+	// might modify it.
+	var preApplyInMemberClusterObj *unstructured.Unstructured
+	if r.auditLogger != nil && bundle.inMemberClusterObj != nil {
+		preApplyInMemberClusterObj = bundle.inMemberClusterObj.DeepCopy()
+	}
+
 	// Perform the apply op.
 	appliedObj, err := r.apply(ctx, bundle.gvr, bundle.manifestObj, bundle.inMemberClusterObj, work.Spec.ApplyStrategy, expectedAppliedWorkOwnerRef)
 	if err != nil {
@@ -177,6 +196,12 @@ func (r *Reconciler) processOneManifest(
 	if appliedObj != nil {
 		// Update the bundle with the newly applied object, if an apply op has been run.
 		bundle.inMemberClusterObj = appliedObj
+
+		auditOp := AuditOperationUpdate
+		if preApplyInMemberClusterObj == nil {
+			auditOp = AuditOperationCreate
+		}
+		r.auditChange(work.Name, auditOp, AuditReasonApply, *bundle.gvr, preApplyInMemberClusterObj, appliedObj)
 	}
 	klog.V(2).InfoS("Apply process completed",
 		"manifestObj", manifestObjRef, "GVR", *bundle.gvr, "work", workRef)
@@ -240,6 +265,42 @@ func (r *Reconciler) findInMemberClusterObjectFor(
 	}
 }
 
+// skipFinishedJobCleanedUpIfApplicable checks if a job manifest should be skipped as the job has
+// completed and been cleaned up by the TTL controller in the member cluster.
+//
+// Fleet will skip the job if:
+// a) the job cannot be found in the member cluster, and the job manifest has a TTL set; and
+// b) the job has been reported as available (i.e., completed) in the previous runs.
+//
+// Skipped jobs are considered to be applied and available. Note that this does not apply to the
+// ReportDiff mode, as no apply op will be run anyway.
+func skipFinishedJobCleanedUpIfApplicable(bundle *manifestProcessingBundle, work *fleetv1beta1.Work) (shouldSkipProcessing bool) {
+	if bundle.inMemberClusterObj != nil || *bundle.gvr != utils.JobGVR {
+		return false
+	}
+	if work.Spec.ApplyStrategy != nil && work.Spec.ApplyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff {
+		return false
+	}
+	if _, found, _ := unstructured.NestedInt64(bundle.manifestObj.Object, "spec", "ttlSecondsAfterFinished"); !found {
+		return false
+	}
+
+	existingManifestCondQIdx := prepareExistingManifestCondQIdx(work.Status.ManifestConditions)
+	existingManifestCondIdx, found := existingManifestCondQIdx[bundle.workResourceIdentifierStr]
+	if !found {
+		return false
+	}
+	availableCond := meta.FindStatusCondition(work.Status.ManifestConditions[existingManifestCondIdx].Conditions, fleetv1beta1.WorkConditionTypeAvailable)
+	if availableCond == nil || availableCond.Status != metav1.ConditionTrue || availableCond.Reason != string(AvailabilityResultTypeAvailable) {
+		return false
+	}
+
+	klog.V(2).InfoS("The job has completed and been cleaned up per its TTL in the member cluster; skip the apply op",
+		"manifestObj", klog.KObj(bundle.manifestObj), "work", klog.KObj(work))
+	bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeApplied
+	return true
+}
+
 // takeOverInMemberClusterObjectIfApplicable attempts to take over an object in the member cluster
 // as needed.
 func (r *Reconciler) takeOverInMemberClusterObjectIfApplicable(
@@ -294,6 +355,7 @@ func (r *Reconciler) takeOverInMemberClusterObjectIfApplicable(
 	}
 
 	// Takeover process is completed; update the bundle with the newly refreshed object from the member cluster.
+	r.auditChange(work.Name, AuditOperationUpdate, AuditReasonTakeOver, *bundle.gvr, bundle.inMemberClusterObj, takenOverInMemberClusterObj)
 	bundle.inMemberClusterObj = takenOverInMemberClusterObj
 	klog.V(2).InfoS("The corresponding object has been taken over",
 		"manifestObj", klog.KObj(bundle.manifestObj), "GVR", *bundle.gvr, "work", klog.KObj(work))
@@ -374,7 +436,7 @@ func (r *Reconciler) reportDiffOnlyIfApplicable(
 	configDiffs, diffCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx,
 		bundle.gvr,
 		bundle.manifestObj, bundle.inMemberClusterObj,
-		work.Spec.ApplyStrategy.ComparisonOption)
+		work.Spec.ApplyStrategy.ComparisonOption, work.Spec.ApplyStrategy.IgnoreFields)
 	switch {
 	case err != nil:
 		// Failed to calculate the configuration diffs.
@@ -463,10 +525,10 @@ func (r *Reconciler) performPreApplyDriftDetectionIfApplicable(
 		return false
 	default:
 		// Run the drift detection process.
-		drifts, driftsCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx,
+		drifts, driftsCalculatedInDegradedMode, err := r.driftsBetweenManifestAndInMemberClusterObjects(ctx,
 			bundle.gvr,
 			bundle.manifestObj, bundle.inMemberClusterObj,
-			work.Spec.ApplyStrategy.ComparisonOption)
+			work.Spec.ApplyStrategy)
 		switch {
 		case err != nil:
 			// An unexpected error has occurred.
@@ -539,17 +601,17 @@ func (r *Reconciler) performPostApplyDriftDetectionIfApplicable(
 	work *fleetv1beta1.Work,
 	expectedAppliedWorkOwnerRef *metav1.OwnerReference,
 ) (shouldSkipProcessing bool) {
-	if !shouldPerformPostApplyDriftDetection(work.Spec.ApplyStrategy) {
+	if !shouldPerformPostApplyDriftDetection(bundle.gvr, work.Spec.ApplyStrategy) {
 		// Post-apply drift detection is not needed; proceed with the processing.
 		klog.V(2).InfoS("Post-apply drift detection is not needed; skip the step",
 			"manifestObj", klog.KObj(bundle.manifestObj), "GVR", *bundle.gvr, "work", klog.KObj(work))
 		return false
 	}
 
-	drifts, driftsCalculatedInDegradedMode, err := r.diffBetweenManifestAndInMemberClusterObjects(ctx,
+	drifts, driftsCalculatedInDegradedMode, err := r.driftsBetweenManifestAndInMemberClusterObjects(ctx,
 		bundle.gvr,
 		bundle.manifestObj, bundle.inMemberClusterObj,
-		work.Spec.ApplyStrategy.ComparisonOption)
+		work.Spec.ApplyStrategy)
 	switch {
 	case err != nil:
 		// An unexpected error has occurred.
@@ -565,8 +627,8 @@ func (r *Reconciler) performPostApplyDriftDetectionIfApplicable(
 	case len(drifts) > 0 && driftsCalculatedInDegradedMode:
 		// Configuration drifts are found, but they were calculated in degraded mode.
 		//
-		// Normally this should never happen, as post apply drift detection will only run if the full
-		// comparison mode is enabled, but degraded mode only applies to partial comparison mode.
+		// Normally this should never happen, as the manifest object has just been applied
+		// successfully, which implies that it is considered to be valid by the member cluster API server.
 
 		// Surface the drifts as normal, but raise an unexpected behavior flag.
 		bundle.drifts = drifts
@@ -590,8 +652,10 @@ func (r *Reconciler) performPostApplyDriftDetectionIfApplicable(
 }
 
 // shouldPerformPostApplyDriftDetection checks if post-apply drift detection should be performed.
-func shouldPerformPostApplyDriftDetection(applyStrategy *fleetv1beta1.ApplyStrategy) bool {
+func shouldPerformPostApplyDriftDetection(gvr *schema.GroupVersionResource, applyStrategy *fleetv1beta1.ApplyStrategy) bool {
 	// Post-apply drift detection is performed if (and only if):
-	// * The apply strategy dictates that drift detection should run in full comparison mode.
-	return applyStrategy.ComparisonOption == fleetv1beta1.ComparisonOptionTypeFullComparison
+	// * The apply strategy dictates that drift detection should run in full comparison mode; or
+	// * The apply strategy enforces namespace sameness and the object is a namespace.
+	return applyStrategy.ComparisonOption == fleetv1beta1.ComparisonOptionTypeFullComparison ||
+		shouldCheckNamespaceSameness(gvr, applyStrategy)
 }
@@ -0,0 +1,331 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	appsv1 "k8s.io/api/apps/v1"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	WorkQuotaFitReason           = "ResourceQuotasFit"
+	WorkQuotaFitMsg              = "The workloads fit in the resource quotas of their namespaces"
+	WorkQuotaExceededReason      = "ResourceQuotaExceeded"
+	WorkQuotaExceededMsgTmpl     = "%d workload(s) would exceed the resource quotas of their namespaces and have not been applied: %s"
+	resourceQuotaExceededErrTmpl = "the workload would exceed resource quota %s in namespace %s (%s)"
+)
+
+var (
+	podGK                   = schema.GroupKind{Group: corev1.GroupName, Kind: "Pod"}
+	replicationControllerGK = schema.GroupKind{Group: corev1.GroupName, Kind: "ReplicationController"}
+	deploymentGK            = schema.GroupKind{Group: appsv1.GroupName, Kind: "Deployment"}
+	replicaSetGK            = schema.GroupKind{Group: appsv1.GroupName, Kind: "ReplicaSet"}
+	statefulSetGK           = schema.GroupKind{Group: appsv1.GroupName, Kind: "StatefulSet"}
+	jobGK                   = schema.GroupKind{Group: batchv1.GroupName, Kind: "Job"}
+)
+
+// isQuotaPreflightCheckEnabled returns if the quota preflight check is enabled for a Work object.
+func isQuotaPreflightCheckEnabled(work *fleetv1beta1.Work) bool {
+	return work.Spec.ApplyStrategy != nil &&
+		work.Spec.ApplyStrategy.QuotaPreflightCheck &&
+		work.Spec.ApplyStrategy.Type != fleetv1beta1.ApplyStrategyTypeReportDiff
+}
+
+// checkResourceQuotasIfApplicable verifies, before any manifest is applied, that the resource quotas
+// in the namespaces of the workloads can accommodate the additional resources the workloads would
+// consume; workloads that would exceed a quota are marked as such and will not be applied.
+//
+// The check is performed per namespace, as all the workloads in a namespace count towards the same
+// quotas; if the workloads in a namespace would exceed a quota together, all of them are marked, as
+// applying any one of them might leave the rest unable to run.
+func (r *Reconciler) checkResourceQuotasIfApplicable(ctx context.Context, bundles []*manifestProcessingBundle, work *fleetv1beta1.Work) error {
+	if !isQuotaPreflightCheckEnabled(work) {
+		return nil
+	}
+
+	// Tally the additional resources that the workloads would consume in each namespace.
+	usageDeltaByNS := make(map[string]corev1.ResourceList)
+	workloadBundlesByNS := make(map[string][]*manifestProcessingBundle)
+	for _, bundle := range bundles {
+		if bundle.applyOrReportDiffErr != nil || bundle.manifestObj == nil || bundle.gvr == nil {
+			// Skip the manifests that have failed pre-processing.
+			continue
+		}
+		desiredUsage, err := workloadQuotaUsage(bundle.manifestObj)
+		if err != nil {
+			// The manifest cannot be interpreted as a workload; leave it to the apply op to report the error.
+			klog.V(2).InfoS("Failed to calculate the resource quota usage of a manifest; skip the quota check for it",
+				"manifestObj", klog.KObj(bundle.manifestObj), "work", klog.KObj(work), "err", err)
+			continue
+		}
+		if desiredUsage == nil {
+			// The manifest is not a workload that the quota check covers.
+			continue
+		}
+
+		// The resources consumed by the current version of the workload (if any) have been
+		// accounted for in the quota usage already; credit them back.
+		inMemberClusterObj, err := r.spokeDynamicClient.
+			Resource(*bundle.gvr).
+			Namespace(bundle.manifestObj.GetNamespace()).
+			Get(ctx, bundle.manifestObj.GetName(), metav1.GetOptions{})
+		switch {
+		case apierrors.IsNotFound(err):
+		case err != nil:
+			klog.ErrorS(err, "Failed to get the workload in the member cluster for the quota check",
+				"manifestObj", klog.KObj(bundle.manifestObj), "work", klog.KObj(work))
+			return controller.NewAPIServerError(false, err)
+		default:
+			if currentUsage, err := workloadQuotaUsage(inMemberClusterObj); err == nil {
+				subtractResourceList(desiredUsage, currentUsage)
+			}
+		}
+
+		ns := bundle.manifestObj.GetNamespace()
+		if _, ok := usageDeltaByNS[ns]; !ok {
+			usageDeltaByNS[ns] = corev1.ResourceList{}
+		}
+		addResourceList(usageDeltaByNS[ns], desiredUsage)
+		workloadBundlesByNS[ns] = append(workloadBundlesByNS[ns], bundle)
+	}
+
+	// Compare the additional resources with the headroom left in the quotas of each namespace.
+	for ns, usageDelta := range usageDeltaByNS {
+		quotaList, err := r.spokeDynamicClient.Resource(utils.ResourceQuotaGVR).Namespace(ns).List(ctx, metav1.ListOptions{})
+		if err != nil {
+			klog.ErrorS(err, "Failed to list the resource quotas in the member cluster", "namespace", ns, "work", klog.KObj(work))
+			return controller.NewAPIServerError(false, err)
+		}
+		for idx := range quotaList.Items {
+			quota := &corev1.ResourceQuota{}
+			if err := runtime.DefaultUnstructuredConverter.FromUnstructured(quotaList.Items[idx].Object, quota); err != nil {
+				return controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to convert the resource quota %s/%s: %w", ns, quotaList.Items[idx].GetName(), err))
+			}
+			exceeded := exceededQuotaResources(quota, usageDelta)
+			if len(exceeded) == 0 {
+				continue
+			}
+			quotaErr := fmt.Errorf(resourceQuotaExceededErrTmpl, quota.Name, ns, strings.Join(exceeded, ", "))
+			klog.V(2).InfoS("Workloads would exceed a resource quota in the member cluster",
+				"resourceQuota", klog.KObj(quota), "exceededResources", exceeded, "work", klog.KObj(work))
+			for _, bundle := range workloadBundlesByNS[ns] {
+				bundle.applyOrReportDiffErr = quotaErr
+				bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeExceedsResourceQuota
+			}
+			break
+		}
+	}
+	return nil
+}
+
+// setWorkQuotaFitCondition sets the QuotaFit condition on a Work object based on the results
+// of the quota preflight check, or removes it if the check is not enabled.
+func setWorkQuotaFitCondition(work *fleetv1beta1.Work, bundles []*manifestProcessingBundle) {
+	if !isQuotaPreflightCheckEnabled(work) {
+		meta.RemoveStatusCondition(&work.Status.Conditions, fleetv1beta1.WorkConditionTypeQuotaFit)
+		return
+	}
+
+	exceededWorkloads := make([]string, 0)
+	for _, bundle := range bundles {
+		if bundle.applyOrReportDiffResTyp == ApplyOrReportDiffResTypeExceedsResourceQuota {
+			exceededWorkloads = append(exceededWorkloads, fmt.Sprintf("%s %s", bundle.manifestObj.GetKind(), klog.KObj(bundle.manifestObj)))
+		}
+	}
+	cond := metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypeQuotaFit,
+		Status:             metav1.ConditionTrue,
+		Reason:             WorkQuotaFitReason,
+		Message:            WorkQuotaFitMsg,
+		ObservedGeneration: work.Generation,
+	}
+	if len(exceededWorkloads) > 0 {
+		cond.Status = metav1.ConditionFalse
+		cond.Reason = WorkQuotaExceededReason
+		cond.Message = fmt.Sprintf(WorkQuotaExceededMsgTmpl, len(exceededWorkloads), strings.Join(exceededWorkloads, ", "))
+	}
+	meta.SetStatusCondition(&work.Status.Conditions, cond)
+}
+
+// exceededQuotaResources returns the descriptions of the resources in a quota that the given
+// additional usage would exceed, sorted by resource name.
+//
+// Quotas with scopes or scope selectors are skipped, as whether they apply depends on the
+// details of the pods (e.g., their priority classes).
+func exceededQuotaResources(quota *corev1.ResourceQuota, usageDelta corev1.ResourceList) []string {
+	if len(quota.Spec.Scopes) > 0 || quota.Spec.ScopeSelector != nil {
+		return nil
+	}
+	exceeded := make([]string, 0)
+	for name, hard := range quota.Status.Hard {
+		delta, ok := usageDelta[name]
+		if !ok || delta.Sign() <= 0 {
+			continue
+		}
+		used := quota.Status.Used[name]
+		want := used.DeepCopy()
+		want.Add(delta)
+		if want.Cmp(hard) > 0 {
+			exceeded = append(exceeded, fmt.Sprintf("%s: requested %s, used %s, limited %s", name, delta.String(), used.String(), hard.String()))
+		}
+	}
+	sort.Strings(exceeded)
+	return exceeded
+}
+
+// workloadQuotaUsage returns the resources that a workload consumes in the resource quotas of
+// its namespace, keyed by the quota resource names (e.g., requests.cpu, limits.memory, pods), or nil
+// if the object is not a workload that the quota check covers.
+func workloadQuotaUsage(obj *unstructured.Unstructured) (corev1.ResourceList, error) {
+	var podSpec *corev1.PodSpec
+	var replicas int64
+	switch obj.GroupVersionKind().GroupKind() {
+	case podGK:
+		pod := &corev1.Pod{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, pod); err != nil {
+			return nil, err
+		}
+		podSpec, replicas = &pod.Spec, 1
+	case replicationControllerGK:
+		rc := &corev1.ReplicationController{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, rc); err != nil {
+			return nil, err
+		}
+		if rc.Spec.Template == nil {
+			return nil, nil
+		}
+		podSpec, replicas = &rc.Spec.Template.Spec, int64(ptr.Deref(rc.Spec.Replicas, 1))
+	case deploymentGK:
+		deploy := &appsv1.Deployment{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, deploy); err != nil {
+			return nil, err
+		}
+		podSpec, replicas = &deploy.Spec.Template.Spec, int64(ptr.Deref(deploy.Spec.Replicas, 1))
+	case replicaSetGK:
+		rs := &appsv1.ReplicaSet{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, rs); err != nil {
+			return nil, err
+		}
+		podSpec, replicas = &rs.Spec.Template.Spec, int64(ptr.Deref(rs.Spec.Replicas, 1))
+	case statefulSetGK:
+		sts := &appsv1.StatefulSet{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, sts); err != nil {
+			return nil, err
+		}
+		podSpec, replicas = &sts.Spec.Template.Spec, int64(ptr.Deref(sts.Spec.Replicas, 1))
+	case jobGK:
+		job := &batchv1.Job{}
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(obj.Object, job); err != nil {
+			return nil, err
+		}
+		podSpec, replicas = &job.Spec.Template.Spec, int64(ptr.Deref(job.Spec.Parallelism, 1))
+	default:
+		return nil, nil
+	}
+
+	usage := corev1.ResourceList{
+		corev1.ResourcePods: *resource.NewQuantity(replicas, resource.DecimalSI),
+	}
+	requests, limits := podResources(podSpec)
+	for name, quantity := range requests {
+		total := multiplyQuantity(quantity, replicas)
+		usage[corev1.ResourceName(corev1.DefaultResourceRequestsPrefix+string(name))] = total
+		// The standard resources can also be limited in quotas by their plain names.
+		if name == corev1.ResourceCPU || name == corev1.ResourceMemory || name == corev1.ResourceEphemeralStorage {
+			usage[name] = total.DeepCopy()
+		}
+	}
+	for name, quantity := range limits {
+		usage[corev1.ResourceName("limits."+string(name))] = multiplyQuantity(quantity, replicas)
+	}
+	return usage, nil
+}
+
+// podResources returns the effective resource requests and limits of a pod, i.e., the larger of
+// the sum over all containers and the max over all init containers, plus the pod overhead.
+func podResources(podSpec *corev1.PodSpec) (requests, limits corev1.ResourceList) {
+	requests, limits = corev1.ResourceList{}, corev1.ResourceList{}
+	for idx := range podSpec.Containers {
+		addResourceList(requests, podSpec.Containers[idx].Resources.Requests)
+		addResourceList(limits, podSpec.Containers[idx].Resources.Limits)
+	}
+	for idx := range podSpec.InitContainers {
+		maxResourceList(requests, podSpec.InitContainers[idx].Resources.Requests)
+		maxResourceList(limits, podSpec.InitContainers[idx].Resources.Limits)
+	}
+	if podSpec.Overhead != nil {
+		addResourceList(requests, podSpec.Overhead)
+		for name := range limits {
+			if overhead, ok := podSpec.Overhead[name]; ok {
+				quantity := limits[name]
+				quantity.Add(overhead)
+				limits[name] = quantity
+			}
+		}
+	}
+	return requests, limits
+}
+
+func addResourceList(list, toAdd corev1.ResourceList) {
+	for name, quantity := range toAdd {
+		if value, ok := list[name]; ok {
+			value.Add(quantity)
+			list[name] = value
+		} else {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+func subtractResourceList(list, toSubtract corev1.ResourceList) {
+	for name, quantity := range toSubtract {
+		value := list[name]
+		value.Sub(quantity)
+		list[name] = value
+	}
+}
+
+func maxResourceList(list, other corev1.ResourceList) {
+	for name, quantity := range other {
+		if value, ok := list[name]; !ok || quantity.Cmp(value) > 0 {
+			list[name] = quantity.DeepCopy()
+		}
+	}
+}
+
+func multiplyQuantity(quantity resource.Quantity, factor int64) resource.Quantity {
+	return *resource.NewMilliQuantity(quantity.MilliValue()*factor, quantity.Format)
+}
@@ -0,0 +1,351 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+const (
+	quotaTestNS = "quota-test"
+)
+
+func quotaTestDeployment(name string, replicas int32, cpu, memory string) *appsv1.Deployment {
+	return &appsv1.Deployment{
+		TypeMeta: metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: quotaTestNS,
+		},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(replicas),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{
+						{
+							Name: "app",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{
+									corev1.ResourceCPU:    resource.MustParse(cpu),
+									corev1.ResourceMemory: resource.MustParse(memory),
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func quotaTestResourceQuota(hard, used corev1.ResourceList) *corev1.ResourceQuota {
+	return &corev1.ResourceQuota{
+		TypeMeta: metav1.TypeMeta{APIVersion: "v1", Kind: "ResourceQuota"},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "compute",
+			Namespace: quotaTestNS,
+		},
+		Spec: corev1.ResourceQuotaSpec{
+			Hard: hard,
+		},
+		Status: corev1.ResourceQuotaStatus{
+			Hard: hard,
+			Used: used,
+		},
+	}
+}
+
+// TestWorkloadQuotaUsage tests the workloadQuotaUsage function.
+func TestWorkloadQuotaUsage(t *testing.T) {
+	tests := []struct {
+		name      string
+		obj       runtime.Object
+		wantUsage corev1.ResourceList
+	}{
+		{
+			name: "deployment",
+			obj:  quotaTestDeployment("app", 3, "500m", "256Mi"),
+			wantUsage: corev1.ResourceList{
+				corev1.ResourcePods:           resource.MustParse("3"),
+				corev1.ResourceCPU:            resource.MustParse("1500m"),
+				corev1.ResourceRequestsCPU:    resource.MustParse("1500m"),
+				corev1.ResourceMemory:         resource.MustParse("768Mi"),
+				corev1.ResourceRequestsMemory: resource.MustParse("768Mi"),
+			},
+		},
+		{
+			name: "pod with init containers and limits",
+			obj: &corev1.Pod{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Pod"},
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: quotaTestNS},
+				Spec: corev1.PodSpec{
+					InitContainers: []corev1.Container{
+						{
+							Name: "init",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("2")},
+							},
+						},
+					},
+					Containers: []corev1.Container{
+						{
+							Name: "app",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+								Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("1")},
+							},
+						},
+						{
+							Name: "sidecar",
+							Resources: corev1.ResourceRequirements{
+								Requests: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+								Limits:   corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+							},
+						},
+					},
+				},
+			},
+			wantUsage: corev1.ResourceList{
+				corev1.ResourcePods:        resource.MustParse("1"),
+				corev1.ResourceCPU:         resource.MustParse("2"),
+				corev1.ResourceRequestsCPU: resource.MustParse("2"),
+				corev1.ResourceLimitsCPU:   resource.MustParse("1500m"),
+			},
+		},
+		{
+			name: "not a workload",
+			obj: &corev1.ConfigMap{
+				TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+				ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: quotaTestNS},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotUsage, err := workloadQuotaUsage(toUnstructured(t, tc.obj))
+			if err != nil {
+				t.Fatalf("workloadQuotaUsage() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(tc.wantUsage, gotUsage, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("workloadQuotaUsage() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestExceededQuotaResources tests the exceededQuotaResources function.
+func TestExceededQuotaResources(t *testing.T) {
+	usageDelta := corev1.ResourceList{
+		corev1.ResourcePods:        resource.MustParse("2"),
+		corev1.ResourceRequestsCPU: resource.MustParse("2"),
+	}
+	tests := []struct {
+		name         string
+		quota        *corev1.ResourceQuota
+		wantExceeded []string
+	}{
+		{
+			name: "fits",
+			quota: quotaTestResourceQuota(
+				corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("4"), corev1.ResourcePods: resource.MustParse("10")},
+				corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("2"), corev1.ResourcePods: resource.MustParse("2")},
+			),
+		},
+		{
+			name: "exceeds",
+			quota: quotaTestResourceQuota(
+				corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("4"), corev1.ResourcePods: resource.MustParse("3")},
+				corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("3"), corev1.ResourcePods: resource.MustParse("2")},
+			),
+			wantExceeded: []string{
+				"pods: requested 2, used 2, limited 3",
+				"requests.cpu: requested 2, used 3, limited 4",
+			},
+		},
+		{
+			name: "scoped quota",
+			quota: func() *corev1.ResourceQuota {
+				quota := quotaTestResourceQuota(
+					corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("1")},
+					corev1.ResourceList{},
+				)
+				quota.Spec.Scopes = []corev1.ResourceQuotaScope{corev1.ResourceQuotaScopeBestEffort}
+				return quota
+			}(),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotExceeded := exceededQuotaResources(tc.quota, usageDelta)
+			if diff := cmp.Diff(tc.wantExceeded, gotExceeded, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("exceededQuotaResources() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestCheckResourceQuotasIfApplicable tests the checkResourceQuotasIfApplicable method.
+func TestCheckResourceQuotasIfApplicable(t *testing.T) {
+	quota := quotaTestResourceQuota(
+		corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("4")},
+		corev1.ResourceList{corev1.ResourceRequestsCPU: resource.MustParse("2")},
+	)
+	enabledWork := &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{Name: "work", Generation: 1},
+		Spec: fleetv1beta1.WorkSpec{
+			ApplyStrategy: &fleetv1beta1.ApplyStrategy{QuotaPreflightCheck: true},
+		},
+	}
+
+	tests := []struct {
+		name                string
+		work                *fleetv1beta1.Work
+		manifests           []runtime.Object
+		inMemberClusterObjs []runtime.Object
+		wantResTypes        []ManifestProcessingApplyOrReportDiffResultType
+		wantCond            *metav1.Condition
+	}{
+		{
+			name:      "fits",
+			work:      enabledWork,
+			manifests: []runtime.Object{quotaTestDeployment("app", 2, "1", "1Gi")},
+			wantResTypes: []ManifestProcessingApplyOrReportDiffResultType{
+				"",
+			},
+			wantCond: &metav1.Condition{
+				Type:               fleetv1beta1.WorkConditionTypeQuotaFit,
+				Status:             metav1.ConditionTrue,
+				Reason:             WorkQuotaFitReason,
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name: "exceeds together",
+			work: enabledWork,
+			manifests: []runtime.Object{
+				quotaTestDeployment("app", 2, "1", "1Gi"),
+				quotaTestDeployment("worker", 1, "1", "1Gi"),
+				&corev1.ConfigMap{
+					TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+					ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: quotaTestNS},
+				},
+			},
+			wantResTypes: []ManifestProcessingApplyOrReportDiffResultType{
+				ApplyOrReportDiffResTypeExceedsResourceQuota,
+				ApplyOrReportDiffResTypeExceedsResourceQuota,
+				"",
+			},
+			wantCond: &metav1.Condition{
+				Type:               fleetv1beta1.WorkConditionTypeQuotaFit,
+				Status:             metav1.ConditionFalse,
+				Reason:             WorkQuotaExceededReason,
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name:                "existing workload is credited",
+			work:                enabledWork,
+			manifests:           []runtime.Object{quotaTestDeployment("app", 3, "1", "1Gi")},
+			inMemberClusterObjs: []runtime.Object{quotaTestDeployment("app", 2, "1", "1Gi")},
+			wantResTypes: []ManifestProcessingApplyOrReportDiffResultType{
+				"",
+			},
+			wantCond: &metav1.Condition{
+				Type:               fleetv1beta1.WorkConditionTypeQuotaFit,
+				Status:             metav1.ConditionTrue,
+				Reason:             WorkQuotaFitReason,
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name: "disabled",
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{Name: "work", Generation: 1},
+				Status: fleetv1beta1.WorkStatus{
+					Conditions: []metav1.Condition{
+						{Type: fleetv1beta1.WorkConditionTypeQuotaFit, Status: metav1.ConditionFalse},
+					},
+				},
+			},
+			manifests: []runtime.Object{quotaTestDeployment("app", 5, "1", "1Gi")},
+			wantResTypes: []ManifestProcessingApplyOrReportDiffResultType{
+				"",
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			inMemberClusterObjs := append([]runtime.Object{toUnstructured(t, quota)}, tc.inMemberClusterObjs...)
+			for idx := range inMemberClusterObjs[1:] {
+				inMemberClusterObjs[idx+1] = toUnstructured(t, inMemberClusterObjs[idx+1])
+			}
+			r := &Reconciler{
+				spokeDynamicClient: fake.NewSimpleDynamicClient(scheme.Scheme, inMemberClusterObjs...),
+			}
+			bundles := make([]*manifestProcessingBundle, 0, len(tc.manifests))
+			for _, manifest := range tc.manifests {
+				manifestObj := toUnstructured(t, manifest)
+				gvr := utils.DeploymentGVR
+				if manifestObj.GetKind() == "ConfigMap" {
+					gvr = utils.ConfigMapGVR
+				}
+				bundles = append(bundles, &manifestProcessingBundle{
+					manifestObj: manifestObj,
+					gvr:         &gvr,
+				})
+			}
+			work := tc.work.DeepCopy()
+
+			if err := r.checkResourceQuotasIfApplicable(context.Background(), bundles, work); err != nil {
+				t.Fatalf("checkResourceQuotasIfApplicable() = %v, want no error", err)
+			}
+			gotResTypes := make([]ManifestProcessingApplyOrReportDiffResultType, 0, len(bundles))
+			for _, bundle := range bundles {
+				gotResTypes = append(gotResTypes, bundle.applyOrReportDiffResTyp)
+			}
+			if diff := cmp.Diff(tc.wantResTypes, gotResTypes); diff != "" {
+				t.Errorf("checkResourceQuotasIfApplicable() result types mismatch (-want, +got):\n%s", diff)
+			}
+
+			setWorkQuotaFitCondition(work, bundles)
+			var gotCond *metav1.Condition
+			for idx := range work.Status.Conditions {
+				if work.Status.Conditions[idx].Type == fleetv1beta1.WorkConditionTypeQuotaFit {
+					gotCond = &work.Status.Conditions[idx]
+				}
+			}
+			if diff := cmp.Diff(tc.wantCond, gotCond, cmpopts.IgnoreFields(metav1.Condition{}, "Message", "LastTransitionTime")); diff != "" {
+				t.Errorf("setWorkQuotaFitCondition() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
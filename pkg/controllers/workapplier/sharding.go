@@ -0,0 +1,493 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// ShardMemberLabel is the label added to the leases through which the member agent replicas
+	// announce themselves for sharding Work objects.
+	ShardMemberLabel = fleetv1beta1.FleetPrefix + "work-applier-shard-member"
+
+	// ShardMembersHashAnnotation is the annotation on the lease of a member agent replica that keeps
+	// the hash of the membership that the replica has adopted, i.e., the one by which it decides which
+	// Work objects to process.
+	ShardMembersHashAnnotation = fleetv1beta1.FleetPrefix + "work-applier-shard-members-hash"
+
+	// shardLeaseNameFmt is the format of the names of the leases that the member agent replicas hold.
+	shardLeaseNameFmt = "work-applier-shard-%s"
+
+	// expiredShardLeaseRetentionPeriods is the number of lease durations for which an expired lease is
+	// kept before any replica deletes it, in case the lease is not garbage collected along with the pod
+	// of its replica.
+	expiredShardLeaseRetentionPeriods = 3
+
+	// virtualNodesPerShardMember is the number of points that each member agent replica has on the
+	// hash ring; the more points each replica has, the more evenly Work objects are spread.
+	virtualNodesPerShardMember = 100
+)
+
+// hashRingPoint is a point on the hash ring, which belongs to a member agent replica.
+type hashRingPoint struct {
+	hash   uint64
+	member string
+}
+
+// hashRing assigns keys to members via consistent hashing, so that when a member joins or leaves,
+// only the keys that it owns (or is about to own) move.
+type hashRing struct {
+	points  []hashRingPoint
+	members []string
+}
+
+// hashOf returns the position of a key on the hash ring.
+//
+// A cryptographic hash is used for its even spread, as the keys (e.g., the names of the Work
+// objects) are often similar to each other.
+func hashOf(key string) uint64 {
+	sum := sha256.Sum256([]byte(key))
+	return binary.BigEndian.Uint64(sum[:8])
+}
+
+// newHashRing builds a hash ring for a list of members, which must be sorted and de-duplicated.
+func newHashRing(members []string) *hashRing {
+	points := make([]hashRingPoint, 0, len(members)*virtualNodesPerShardMember)
+	for _, member := range members {
+		for i := 0; i < virtualNodesPerShardMember; i++ {
+			points = append(points, hashRingPoint{
+				hash:   hashOf(fmt.Sprintf("%s#%d", member, i)),
+				member: member,
+			})
+		}
+	}
+	sort.Slice(points, func(i, j int) bool {
+		if points[i].hash != points[j].hash {
+			return points[i].hash < points[j].hash
+		}
+		return points[i].member < points[j].member
+	})
+	return &hashRing{
+		points:  points,
+		members: members,
+	}
+}
+
+// owner returns the member that owns a key, i.e., the member of the first point on the ring
+// at or after the hash of the key; it returns an empty string if the ring has no members.
+func (hr *hashRing) owner(key string) string {
+	if len(hr.points) == 0 {
+		return ""
+	}
+	h := hashOf(key)
+	idx := sort.Search(len(hr.points), func(i int) bool {
+		return hr.points[i].hash >= h
+	})
+	if idx == len(hr.points) {
+		idx = 0
+	}
+	return hr.points[idx].member
+}
+
+// shardLeaseExpiry returns when a lease expires; it returns false if the lease has no holder or has
+// never been renewed.
+func shardLeaseExpiry(lease *coordinationv1.Lease) (time.Time, bool) {
+	spec := &lease.Spec
+	if ptr.Deref(spec.HolderIdentity, "") == "" || spec.RenewTime == nil || spec.LeaseDurationSeconds == nil {
+		return time.Time{}, false
+	}
+	return spec.RenewTime.Add(time.Duration(*spec.LeaseDurationSeconds) * time.Second), true
+}
+
+// liveShardLeases returns the leases that have not expired yet.
+func liveShardLeases(leases []coordinationv1.Lease, now time.Time) []*coordinationv1.Lease {
+	live := make([]*coordinationv1.Lease, 0, len(leases))
+	for idx := range leases {
+		if expiry, ok := shardLeaseExpiry(&leases[idx]); ok && expiry.After(now) {
+			live = append(live, &leases[idx])
+		}
+	}
+	return live
+}
+
+// liveShardMembers returns the sorted identities of the member agent replicas whose leases have
+// not expired yet.
+func liveShardMembers(leases []coordinationv1.Lease, now time.Time) []string {
+	found := make(map[string]bool, len(leases))
+	members := make([]string, 0, len(leases))
+	for _, lease := range liveShardLeases(leases, now) {
+		holder := *lease.Spec.HolderIdentity
+		if found[holder] {
+			continue
+		}
+		found[holder] = true
+		members = append(members, holder)
+	}
+	sort.Strings(members)
+	return members
+}
+
+// shardMembersHash returns the hash of a sorted list of members, which the replicas announce in
+// their leases.
+func shardMembersHash(members []string) string {
+	return strconv.FormatUint(hashOf(strings.Join(members, ",")), 16)
+}
+
+// ShardMembership shards Work objects among the member agent replicas.
+//
+// Each replica holds a lease on the member cluster, which it renews periodically to announce that
+// it is alive; the Work objects are then assigned to the live replicas via consistent hashing on
+// their names. The lease of a replica is owned by its pod, so that it is garbage collected along
+// with the pod; the leases that have expired for a while are deleted by the other replicas as well.
+//
+// Each replica also announces in its lease the membership that it has adopted. When the membership
+// changes, a replica stops processing the Work objects that it no longer owns right away, but takes
+// over the Work objects that move to it only after all the live replicas have adopted the new
+// membership (i.e., the previous owner has stopped processing them, or its lease has expired). This
+// way, a Work object is processed by only one replica at a time, and the order in which the changes
+// to a Work object are applied is kept.
+type ShardMembership struct {
+	client        client.Client
+	reader        client.Reader
+	namespace     string
+	identity      string
+	podUID        types.UID
+	leaseDuration time.Duration
+	now           func() time.Time
+
+	// events notifies the work applier of membership changes, so that it picks up the Work objects
+	// that the replica takes over.
+	events chan event.TypedGenericEvent[client.Object]
+
+	mu sync.RWMutex
+	// ring is the hash ring of the membership that the replica has adopted.
+	ring *hashRing
+	// settledRing is the hash ring of the latest membership that all the live replicas have adopted.
+	settledRing *hashRing
+}
+
+// NewShardMembership returns a ShardMembership for a member agent replica, which keeps its lease
+// in the given namespace of the member cluster.
+//
+// The identity is the name of the pod of the replica; if the UID of the pod is set, the pod, which
+// must run in the same namespace, owns the lease. The client is used for writing the lease of the
+// replica; the reader, which should not be backed by a cache, is used for listing the leases of all
+// the replicas.
+func NewShardMembership(memberClient client.Client, memberReader client.Reader, namespace, identity string, podUID types.UID, leaseDuration time.Duration) *ShardMembership {
+	return &ShardMembership{
+		client:        memberClient,
+		reader:        memberReader,
+		namespace:     namespace,
+		identity:      identity,
+		podUID:        podUID,
+		leaseDuration: leaseDuration,
+		now:           time.Now,
+		events:        make(chan event.TypedGenericEvent[client.Object], 1),
+	}
+}
+
+// Start renews the lease of the replica and refreshes the membership periodically until the
+// context is cancelled.
+func (m *ShardMembership) Start(ctx context.Context) error {
+	klog.InfoS("Starting the work applier shard membership", "identity", m.identity, "namespace", m.namespace)
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := m.refresh(ctx); err != nil {
+			klog.ErrorS(err, "Failed to refresh the work applier shard membership", "identity", m.identity)
+		}
+	}, m.leaseDuration/3)
+
+	// Stop processing Work objects before releasing the lease.
+	m.mu.Lock()
+	m.ring, m.settledRing = nil, nil
+	m.mu.Unlock()
+
+	// Release the lease so that the other replicas can take over the Work objects right away,
+	// rather than after the lease expires.
+	releaseCtx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	lease := &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      m.leaseName(),
+			Namespace: m.namespace,
+		},
+	}
+	if err := m.client.Delete(releaseCtx, lease); err != nil && !apierrors.IsNotFound(err) {
+		klog.ErrorS(err, "Failed to release the work applier shard lease", "lease", klog.KObj(lease))
+	}
+	return nil
+}
+
+// NeedLeaderElection implements the LeaderElectionRunnable interface; the shard membership runs
+// on all the replicas.
+func (m *ShardMembership) NeedLeaderElection() bool {
+	return false
+}
+
+// Owns returns whether a Work object is assigned to the replica.
+//
+// No Work object is assigned to the replica before all the live replicas have adopted a membership
+// that includes it. While a membership change is in progress, the replica keeps only the Work objects
+// that it owns both before and after the change.
+func (m *ShardMembership) Owns(workName string) bool {
+	m.mu.RLock()
+	defer m.mu.RUnlock()
+	if m.ring == nil || m.ring.owner(workName) != m.identity {
+		return false
+	}
+	return m.settledRing != nil && m.settledRing.owner(workName) == m.identity
+}
+
+func (m *ShardMembership) leaseName() string {
+	return fmt.Sprintf(shardLeaseNameFmt, m.identity)
+}
+
+// refresh rebuilds the hash ring from the leases of all the live replicas, renews the lease of the
+// replica to announce the membership it adopts, and checks whether all the live replicas have
+// adopted the same membership.
+func (m *ShardMembership) refresh(ctx context.Context) error {
+	leaseList := &coordinationv1.LeaseList{}
+	if err := m.reader.List(ctx, leaseList, client.InNamespace(m.namespace), client.HasLabels{ShardMemberLabel}); err != nil {
+		return fmt.Errorf("failed to list the work applier shard leases: %w", err)
+	}
+	now := m.now()
+	members := liveShardMembers(leaseList.Items, now)
+	if idx, found := slices.BinarySearch(members, m.identity); !found {
+		// The replica is alive once its lease is renewed below.
+		members = slices.Insert(members, idx, m.identity)
+	}
+	membersHash := shardMembersHash(members)
+	if err := m.renewLease(ctx, membersHash); err != nil {
+		return err
+	}
+	settled := true
+	for _, lease := range liveShardLeases(leaseList.Items, now) {
+		if *lease.Spec.HolderIdentity != m.identity && lease.Annotations[ShardMembersHashAnnotation] != membersHash {
+			settled = false
+			break
+		}
+	}
+	m.deleteExpiredLeases(ctx, leaseList.Items, now)
+
+	m.mu.Lock()
+	changed := false
+	if m.ring == nil || !slices.Equal(m.ring.members, members) {
+		klog.V(2).InfoS("Work applier shard membership has changed", "identity", m.identity, "members", members)
+		m.ring = newHashRing(members)
+		changed = true
+	}
+	if settled && m.settledRing != m.ring {
+		klog.V(2).InfoS("All the work applier replicas have adopted the shard membership", "identity", m.identity, "members", members)
+		m.settledRing = m.ring
+		changed = true
+	}
+	m.mu.Unlock()
+
+	if changed {
+		// Notify the work applier, unless a notification is pending already.
+		select {
+		case m.events <- event.TypedGenericEvent[client.Object]{Object: &coordinationv1.Lease{ObjectMeta: metav1.ObjectMeta{Namespace: m.namespace, Name: m.leaseName()}}}:
+		default:
+		}
+	}
+	return nil
+}
+
+// deleteExpiredLeases deletes the leases of the replicas that have been gone for a while, in case
+// they have not been garbage collected along with the pods of the replicas.
+func (m *ShardMembership) deleteExpiredLeases(ctx context.Context, leases []coordinationv1.Lease, now time.Time) {
+	for idx := range leases {
+		lease := &leases[idx]
+		expiry, ok := shardLeaseExpiry(lease)
+		if !ok || lease.Name == m.leaseName() {
+			continue
+		}
+		retention := time.Duration(*lease.Spec.LeaseDurationSeconds) * time.Second * expiredShardLeaseRetentionPeriods
+		if !now.After(expiry.Add(retention)) {
+			continue
+		}
+		// Delete the lease only if it has not been renewed since it was listed.
+		err := m.client.Delete(ctx, lease, client.Preconditions{ResourceVersion: ptr.To(lease.ResourceVersion)})
+		switch {
+		case err == nil:
+			klog.V(2).InfoS("Deleted an expired work applier shard lease", "lease", klog.KObj(lease))
+		case !apierrors.IsNotFound(err) && !apierrors.IsConflict(err):
+			klog.ErrorS(err, "Failed to delete an expired work applier shard lease", "lease", klog.KObj(lease))
+		}
+	}
+}
+
+// ownerReferences returns the owner references of the lease of the replica, i.e., the pod of the
+// replica, if known.
+func (m *ShardMembership) ownerReferences() []metav1.OwnerReference {
+	if m.podUID == "" {
+		return nil
+	}
+	return []metav1.OwnerReference{
+		{
+			APIVersion: corev1.SchemeGroupVersion.String(),
+			Kind:       "Pod",
+			Name:       m.identity,
+			UID:        m.podUID,
+		},
+	}
+}
+
+// renewLease creates or renews the lease of the replica, which announces the hash of the membership
+// that the replica adopts.
+func (m *ShardMembership) renewLease(ctx context.Context, membersHash string) error {
+	now := metav1.NewMicroTime(m.now())
+	lease := &coordinationv1.Lease{}
+	err := m.reader.Get(ctx, types.NamespacedName{Namespace: m.namespace, Name: m.leaseName()}, lease)
+	switch {
+	case apierrors.IsNotFound(err):
+		lease = &coordinationv1.Lease{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      m.leaseName(),
+				Namespace: m.namespace,
+				Labels: map[string]string{
+					ShardMemberLabel: "true",
+				},
+				Annotations: map[string]string{
+					ShardMembersHashAnnotation: membersHash,
+				},
+				OwnerReferences: m.ownerReferences(),
+			},
+			Spec: coordinationv1.LeaseSpec{
+				HolderIdentity:       ptr.To(m.identity),
+				LeaseDurationSeconds: ptr.To(int32(m.leaseDuration.Seconds())),
+				AcquireTime:          &now,
+				RenewTime:            &now,
+			},
+		}
+		if err := m.client.Create(ctx, lease); err != nil {
+			return fmt.Errorf("failed to create the work applier shard lease: %w", err)
+		}
+		return nil
+	case err != nil:
+		return fmt.Errorf("failed to get the work applier shard lease: %w", err)
+	}
+
+	if lease.Annotations == nil {
+		lease.Annotations = make(map[string]string)
+	}
+	lease.Annotations[ShardMembersHashAnnotation] = membersHash
+	if ownerRefs := m.ownerReferences(); ownerRefs != nil {
+		lease.OwnerReferences = ownerRefs
+	}
+	lease.Spec.HolderIdentity = ptr.To(m.identity)
+	lease.Spec.LeaseDurationSeconds = ptr.To(int32(m.leaseDuration.Seconds()))
+	lease.Spec.RenewTime = &now
+	if err := m.client.Update(ctx, lease); err != nil {
+		return fmt.Errorf("failed to renew the work applier shard lease: %w", err)
+	}
+	return nil
+}
+
+// ownedWorkRequests returns the requests for the Work objects in a namespace that the replica owns;
+// the work applier re-processes them when the membership changes.
+func (m *ShardMembership) ownedWorkRequests(ctx context.Context, hubClient client.Reader, workNamespace string) []reconcile.Request {
+	workList := &fleetv1beta1.WorkList{}
+	if err := hubClient.List(ctx, workList, client.InNamespace(workNamespace)); err != nil {
+		klog.ErrorS(err, "Failed to list the Work objects to re-process after the shard membership changes", "workNamespace", workNamespace)
+		return nil
+	}
+	requests := make([]reconcile.Request, 0, len(workList.Items))
+	for idx := range workList.Items {
+		if m.Owns(workList.Items[idx].Name) {
+			requests = append(requests, reconcile.Request{NamespacedName: client.ObjectKeyFromObject(&workList.Items[idx])})
+		}
+	}
+	return requests
+}
+
+// JoinStateFollower keeps a work applier joined or left as the InternalMemberCluster object on
+// the hub cluster prescribes.
+//
+// The work applier is normally joined and left by the InternalMemberCluster controller, which
+// runs on the leader only; when Work objects are sharded, the follower lets the work appliers on
+// the other replicas start and stop processing Work objects as well. It does not clean up the
+// Work objects on leave, which is left to the leader.
+type JoinStateFollower struct {
+	hubClient client.Client
+	imcKey    types.NamespacedName
+	applier   *Reconciler
+	interval  time.Duration
+}
+
+// NewJoinStateFollower returns a JoinStateFollower for a work applier.
+func NewJoinStateFollower(hubClient client.Client, imcKey types.NamespacedName, applier *Reconciler, interval time.Duration) *JoinStateFollower {
+	return &JoinStateFollower{
+		hubClient: hubClient,
+		imcKey:    imcKey,
+		applier:   applier,
+		interval:  interval,
+	}
+}
+
+// Start syncs the join state periodically until the context is cancelled.
+func (f *JoinStateFollower) Start(ctx context.Context) error {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := f.sync(ctx); err != nil {
+			klog.ErrorS(err, "Failed to sync the join state of the work applier", "internalMemberCluster", f.imcKey)
+		}
+	}, f.interval)
+	return nil
+}
+
+// NeedLeaderElection implements the LeaderElectionRunnable interface; the follower runs on all
+// the replicas.
+func (f *JoinStateFollower) NeedLeaderElection() bool {
+	return false
+}
+
+func (f *JoinStateFollower) sync(ctx context.Context) error {
+	imc := &clusterv1beta1.InternalMemberCluster{}
+	if err := f.hubClient.Get(ctx, f.imcKey, imc); err != nil {
+		return fmt.Errorf("failed to get the internal member cluster: %w", err)
+	}
+	joined := imc.Spec.State == clusterv1beta1.ClusterStateJoin
+	if f.applier.joined.Load() != joined {
+		klog.V(2).InfoS("Work applier join state changes", "internalMemberCluster", f.imcKey, "joined", joined)
+	}
+	f.applier.joined.Store(joined)
+	return nil
+}
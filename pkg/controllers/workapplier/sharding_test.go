@@ -0,0 +1,412 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"go.uber.org/atomic"
+	coordinationv1 "k8s.io/api/coordination/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	shardLeaseNS = "fleet-system"
+)
+
+func shardLease(name, holder string, renewTime time.Time, durationSeconds int32) *coordinationv1.Lease {
+	return &coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: shardLeaseNS,
+			Labels: map[string]string{
+				ShardMemberLabel: "true",
+			},
+		},
+		Spec: coordinationv1.LeaseSpec{
+			HolderIdentity:       ptr.To(holder),
+			LeaseDurationSeconds: ptr.To(durationSeconds),
+			RenewTime:            ptr.To(metav1.NewMicroTime(renewTime)),
+		},
+	}
+}
+
+// TestHashRingOwner tests the owner method of the hash ring.
+func TestHashRingOwner(t *testing.T) {
+	members := []string{"replica-0", "replica-1", "replica-2"}
+	keyCount := 3000
+	keys := make([]string, 0, keyCount)
+	for i := 0; i < keyCount; i++ {
+		keys = append(keys, fmt.Sprintf("work-%d", i))
+	}
+
+	if got := newHashRing(nil).owner(keys[0]); got != "" {
+		t.Errorf("owner() on an empty ring = %s, want empty", got)
+	}
+
+	ring := newHashRing(members)
+	owners := make(map[string]string, keyCount)
+	counts := make(map[string]int, len(members))
+	for _, key := range keys {
+		owner := ring.owner(key)
+		owners[key] = owner
+		counts[owner]++
+	}
+	for _, member := range members {
+		// Each member should get a fair share (1/3) of the keys, give or take.
+		if counts[member] < keyCount/5 {
+			t.Errorf("member %s owns %d keys, want at least %d", member, counts[member], keyCount/5)
+		}
+	}
+	if len(counts) != len(members) {
+		t.Errorf("keys are owned by %d members, want %d", len(counts), len(members))
+	}
+
+	// Removing a member should move only the keys that it owns.
+	shrunkRing := newHashRing(members[:2])
+	for _, key := range keys {
+		got := shrunkRing.owner(key)
+		if owners[key] != members[2] && got != owners[key] {
+			t.Errorf("owner(%s) = %s after removing %s, want %s", key, got, members[2], owners[key])
+		}
+		if got == members[2] {
+			t.Errorf("owner(%s) = %s, which has been removed", key, got)
+		}
+	}
+}
+
+// TestLiveShardMembers tests the liveShardMembers function.
+func TestLiveShardMembers(t *testing.T) {
+	now := time.Now()
+
+	testCases := []struct {
+		name        string
+		leases      []coordinationv1.Lease
+		wantMembers []string
+	}{
+		{
+			name:        "no leases",
+			wantMembers: []string{},
+		},
+		{
+			name: "live, expired, and incomplete leases",
+			leases: []coordinationv1.Lease{
+				*shardLease("lease-2", "replica-2", now.Add(-time.Second*10), 30),
+				*shardLease("lease-0", "replica-0", now, 30),
+				// Expired.
+				*shardLease("lease-1", "replica-1", now.Add(-time.Minute), 30),
+				// No holder.
+				*shardLease("lease-3", "", now, 30),
+				// Duplicate holder.
+				*shardLease("lease-4", "replica-0", now, 30),
+				{
+					// No renew time.
+					Spec: coordinationv1.LeaseSpec{
+						HolderIdentity:       ptr.To("replica-5"),
+						LeaseDurationSeconds: ptr.To(int32(30)),
+					},
+				},
+			},
+			wantMembers: []string{"replica-0", "replica-2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := liveShardMembers(tc.leases, now)
+			if diff := cmp.Diff(got, tc.wantMembers); diff != "" {
+				t.Errorf("liveShardMembers() mismatches (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestShardMembershipRefresh tests the refresh method of the shard membership.
+func TestShardMembershipRefresh(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	identity := "replica-0"
+	podUID := types.UID("pod-uid")
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(
+		shardLease("work-applier-shard-replica-1", "replica-1", now, 30),
+		// Expired, but kept for a while.
+		shardLease("work-applier-shard-replica-2", "replica-2", now.Add(-time.Minute), 30),
+		// Expired long ago.
+		shardLease("work-applier-shard-replica-3", "replica-3", now.Add(-time.Hour), 30),
+	).Build()
+	m := NewShardMembership(fakeClient, fakeClient, shardLeaseNS, identity, podUID, time.Second*30)
+	m.now = func() time.Time { return now }
+
+	if m.Owns(workName) {
+		t.Errorf("Owns() = true before the membership is refreshed, want false")
+	}
+
+	if err := m.refresh(ctx); err != nil {
+		t.Fatalf("refresh() = %v, want no error", err)
+	}
+	wantMembers := []string{"replica-0", "replica-1"}
+	lease := &coordinationv1.Lease{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Namespace: shardLeaseNS, Name: "work-applier-shard-replica-0"}, lease); err != nil {
+		t.Fatalf("failed to get the lease of the replica: %v", err)
+	}
+	if got := ptr.Deref(lease.Spec.HolderIdentity, ""); got != identity {
+		t.Errorf("lease holder = %s, want %s", got, identity)
+	}
+	if got := lease.Labels[ShardMemberLabel]; got != "true" {
+		t.Errorf("lease label %s = %s, want true", ShardMemberLabel, got)
+	}
+	if got, want := lease.Annotations[ShardMembersHashAnnotation], shardMembersHash(wantMembers); got != want {
+		t.Errorf("lease annotation %s = %s, want %s", ShardMembersHashAnnotation, got, want)
+	}
+	wantOwnerRefs := []metav1.OwnerReference{{APIVersion: "v1", Kind: "Pod", Name: identity, UID: podUID}}
+	if diff := cmp.Diff(lease.OwnerReferences, wantOwnerRefs); diff != "" {
+		t.Errorf("lease owner references mismatches (-got +want):\n%s", diff)
+	}
+	if diff := cmp.Diff(m.ring.members, wantMembers); diff != "" {
+		t.Errorf("shard members mismatches (-got +want):\n%s", diff)
+	}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Namespace: shardLeaseNS, Name: "work-applier-shard-replica-2"}, &coordinationv1.Lease{}); err != nil {
+		t.Errorf("failed to get the recently expired lease: %v, want it kept", err)
+	}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Namespace: shardLeaseNS, Name: "work-applier-shard-replica-3"}, &coordinationv1.Lease{}); !apierrors.IsNotFound(err) {
+		t.Errorf("get the lease expired long ago = %v, want not found", err)
+	}
+	// The other replica has not adopted the membership yet.
+	for i := 0; i < 10; i++ {
+		if key := fmt.Sprintf("work-%d", i); m.Owns(key) {
+			t.Errorf("Owns(%s) = true before all the replicas adopt the membership, want false", key)
+		}
+	}
+	select {
+	case <-m.events:
+	default:
+		t.Errorf("no membership change event, want one")
+	}
+
+	// The other replica adopts the membership.
+	otherLease := &coordinationv1.Lease{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Namespace: shardLeaseNS, Name: "work-applier-shard-replica-1"}, otherLease); err != nil {
+		t.Fatalf("failed to get the lease of the other replica: %v", err)
+	}
+	otherLease.Annotations = map[string]string{ShardMembersHashAnnotation: shardMembersHash(wantMembers)}
+	if err := fakeClient.Update(ctx, otherLease); err != nil {
+		t.Fatalf("failed to update the lease of the other replica: %v", err)
+	}
+
+	// Refresh again to renew the lease.
+	later := now.Add(time.Second * 10)
+	m.now = func() time.Time { return later }
+	if err := m.refresh(ctx); err != nil {
+		t.Fatalf("refresh() = %v, want no error", err)
+	}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Namespace: shardLeaseNS, Name: "work-applier-shard-replica-0"}, lease); err != nil {
+		t.Fatalf("failed to get the lease of the replica: %v", err)
+	}
+	// The renew time is kept at the precision of microseconds.
+	if got := lease.Spec.RenewTime.Time; !got.Equal(later.Truncate(time.Microsecond)) {
+		t.Errorf("lease renew time = %v, want %v", got, later)
+	}
+	for i := 0; i < 10; i++ {
+		key := fmt.Sprintf("work-%d", i)
+		if got, want := m.Owns(key), m.ring.owner(key) == identity; got != want {
+			t.Errorf("Owns(%s) = %t, want %t", key, got, want)
+		}
+	}
+	select {
+	case <-m.events:
+	default:
+		t.Errorf("no membership change event, want one")
+	}
+}
+
+// TestShardMembershipOwns tests that a replica takes over a Work object only after all the live
+// replicas have adopted the membership.
+func TestShardMembershipOwns(t *testing.T) {
+	identity := "replica-0"
+	settledRing := newHashRing([]string{"replica-0", "replica-1"})
+	// replica-2 joins.
+	ring := newHashRing([]string{"replica-0", "replica-1", "replica-2"})
+
+	testCases := []struct {
+		name        string
+		ring        *hashRing
+		settledRing *hashRing
+		wantOwns    func(key string) bool
+	}{
+		{
+			name:     "no membership settled",
+			ring:     ring,
+			wantOwns: func(string) bool { return false },
+		},
+		{
+			name:        "membership change in progress",
+			ring:        ring,
+			settledRing: settledRing,
+			wantOwns: func(key string) bool {
+				return ring.owner(key) == identity && settledRing.owner(key) == identity
+			},
+		},
+		{
+			name:        "membership settled",
+			ring:        ring,
+			settledRing: ring,
+			wantOwns:    func(key string) bool { return ring.owner(key) == identity },
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			m := &ShardMembership{
+				identity:    identity,
+				ring:        tc.ring,
+				settledRing: tc.settledRing,
+			}
+			for i := 0; i < 100; i++ {
+				key := fmt.Sprintf("work-%d", i)
+				if got, want := m.Owns(key), tc.wantOwns(key); got != want {
+					t.Errorf("Owns(%s) = %t, want %t", key, got, want)
+				}
+			}
+		})
+	}
+}
+
+// TestShardMembershipOwnedWorkRequests tests the ownedWorkRequests method of the shard membership.
+func TestShardMembershipOwnedWorkRequests(t *testing.T) {
+	identity := "replica-0"
+	ring := newHashRing([]string{"replica-0", "replica-1"})
+	m := &ShardMembership{
+		identity:    identity,
+		ring:        ring,
+		settledRing: ring,
+	}
+	hubScheme := runtime.NewScheme()
+	if err := fleetv1beta1.AddToScheme(hubScheme); err != nil {
+		t.Fatalf("Failed to add placement v1beta1 scheme: %v", err)
+	}
+	builder := fake.NewClientBuilder().WithScheme(hubScheme)
+	var wantRequests []reconcile.Request
+	for i := 0; i < 20; i++ {
+		name := fmt.Sprintf("work-%d", i)
+		builder = builder.WithObjects(&fleetv1beta1.Work{ObjectMeta: metav1.ObjectMeta{Namespace: memberReservedNSName1, Name: name}})
+		if ring.owner(name) == identity {
+			wantRequests = append(wantRequests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: memberReservedNSName1, Name: name}})
+		}
+	}
+
+	got := m.ownedWorkRequests(context.Background(), builder.Build(), memberReservedNSName1)
+	less := func(a, b reconcile.Request) bool { return a.Name < b.Name }
+	if diff := cmp.Diff(got, wantRequests, cmpopts.SortSlices(less)); diff != "" {
+		t.Errorf("ownedWorkRequests() mismatches (-got +want):\n%s", diff)
+	}
+}
+
+// TestReconcileWorkAssignedToAnotherReplica tests that the work applier skips the Work objects
+// that are assigned to other member agent replicas.
+func TestReconcileWorkAssignedToAnotherReplica(t *testing.T) {
+	ring := newHashRing([]string{"replica-1"})
+	m := &ShardMembership{
+		identity:      "replica-0",
+		leaseDuration: time.Second * 30,
+		ring:          ring,
+		settledRing:   ring,
+	}
+	r := &Reconciler{
+		joined:          atomic.NewBool(true),
+		shardMembership: m,
+	}
+
+	// The hub client is not set; the work applier would panic if it tries to retrieve the Work object.
+	res, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Namespace: memberReservedNSName1, Name: workName}})
+	if err != nil {
+		t.Fatalf("Reconcile() = %v, want no error", err)
+	}
+	// The Work object is not requeued; it is enqueued again when the membership changes.
+	if diff := cmp.Diff(res, ctrl.Result{}); diff != "" {
+		t.Errorf("Reconcile() result mismatches (-got +want):\n%s", diff)
+	}
+}
+
+// TestJoinStateFollowerSync tests the sync method of the join state follower.
+func TestJoinStateFollowerSync(t *testing.T) {
+	imcKey := types.NamespacedName{Namespace: memberReservedNSName1, Name: "member-1"}
+	clusterScheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(clusterScheme); err != nil {
+		t.Fatalf("Failed to add cluster v1beta1 scheme: %v", err)
+	}
+
+	testCases := []struct {
+		name       string
+		state      clusterv1beta1.ClusterState
+		joined     bool
+		wantJoined bool
+	}{
+		{
+			name:       "join",
+			state:      clusterv1beta1.ClusterStateJoin,
+			wantJoined: true,
+		},
+		{
+			name:       "leave",
+			state:      clusterv1beta1.ClusterStateLeave,
+			joined:     true,
+			wantJoined: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			imc := &clusterv1beta1.InternalMemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      imcKey.Name,
+					Namespace: imcKey.Namespace,
+				},
+				Spec: clusterv1beta1.InternalMemberClusterSpec{
+					State: tc.state,
+				},
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(clusterScheme).WithObjects(imc).Build()
+			r := &Reconciler{
+				joined: atomic.NewBool(tc.joined),
+			}
+			f := NewJoinStateFollower(fakeClient, imcKey, r, time.Second)
+			if err := f.sync(context.Background()); err != nil {
+				t.Fatalf("sync() = %v, want no error", err)
+			}
+			if got := r.joined.Load(); got != tc.wantJoined {
+				t.Errorf("joined = %t, want %t", got, tc.wantJoined)
+			}
+		})
+	}
+}
@@ -0,0 +1,163 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// WorkSharedDependencyKeptReason is the reason of the SharedDependency condition, which is set
+	// when some left-over resources are kept as resources placed by other Works depend on them.
+	WorkSharedDependencyKeptReason = "SharedDependenciesKept"
+	// WorkSharedDependencyKeptMsgTmpl is the message template of the SharedDependency condition; it
+	// takes the number of kept resources and a (capped) list of them.
+	WorkSharedDependencyKeptMsgTmpl = "%d left-over resource(s) are still depended upon by resources placed by other Works and have not been deleted: %s"
+
+	// maxKeptSharedDependenciesInMsg is the max number of kept resources to include in the message
+	// of the SharedDependency condition.
+	maxKeptSharedDependenciesInMsg = 5
+
+	// appliedResourceIndexField is the name of the field index that maps a resource on the member
+	// cluster to the AppliedWork objects that depend on it; see appliedResourceIndexValues.
+	appliedResourceIndexField = "status.appliedResources.dependency"
+)
+
+// sharedDependencyKey returns the string representation of a resource in the applied resource
+// index.
+//
+// The version is omitted, as the same resource might be applied by different Works in different
+// versions.
+func sharedDependencyKey(id *fleetv1beta1.WorkResourceIdentifier) string {
+	return fmt.Sprintf("%s/%s/%s/%s", id.Group, id.Kind, id.Namespace, id.Name)
+}
+
+// appliedResourceIndexValues returns the values of an AppliedWork object in the applied resource
+// index, i.e., the keys of the resources that the AppliedWork object depends on: the resources
+// that it has applied, and the namespaces of these resources.
+func appliedResourceIndexValues(obj client.Object) []string {
+	appliedWork, ok := obj.(*fleetv1beta1.AppliedWork)
+	if !ok {
+		return nil
+	}
+
+	keys := sets.New[string]()
+	for idx := range appliedWork.Status.AppliedResources {
+		id := &appliedWork.Status.AppliedResources[idx].WorkResourceIdentifier
+		keys.Insert(sharedDependencyKey(id))
+		if len(id.Namespace) > 0 {
+			keys.Insert(sharedDependencyKey(&fleetv1beta1.WorkResourceIdentifier{Kind: "Namespace", Name: id.Namespace}))
+		}
+	}
+	return sets.List(keys)
+}
+
+// SetupAppliedResourceIndex registers the applied resource index over the AppliedWork objects with
+// the given field indexer, which should be the one of the cache that backs the member cluster
+// client of the work applier. It must be called before the cache starts.
+func SetupAppliedResourceIndex(ctx context.Context, indexer client.FieldIndexer) error {
+	return indexer.IndexField(ctx, &fleetv1beta1.AppliedWork{}, appliedResourceIndexField, appliedResourceIndexValues)
+}
+
+// sharedDependencyIndex helps find out whether a resource on the member cluster is still
+// depended upon by resources that other Work objects have placed, as tracked in the
+// inventories (the applied resource lists) of their AppliedWork objects.
+//
+// The lookups are served by the applied resource index of the cache that backs the member
+// cluster client; only the AppliedWork objects that depend on a resource are read.
+type sharedDependencyIndex struct {
+	// reader reads the AppliedWork objects from the (indexed) cache.
+	reader client.Reader
+	// ownAppliedWorkName is the name of the AppliedWork object of the Work object being processed,
+	// which is not considered as a dependent.
+	ownAppliedWorkName string
+}
+
+// dependents returns the names of the other AppliedWork objects that depend on a resource, i.e.,
+// the ones that have applied the same resource, or, if the resource is a namespace, the ones that
+// have applied resources in the namespace. AppliedWork objects that are being deleted are not
+// considered as dependents. The names are sorted.
+//
+// A nil index has no dependents for any resource.
+func (idx *sharedDependencyIndex) dependents(ctx context.Context, id *fleetv1beta1.WorkResourceIdentifier) ([]string, error) {
+	if idx == nil {
+		return nil, nil
+	}
+
+	appliedWorkList := &fleetv1beta1.AppliedWorkList{}
+	if err := idx.reader.List(ctx, appliedWorkList, client.MatchingFields{appliedResourceIndexField: sharedDependencyKey(id)}); err != nil {
+		return nil, controller.NewAPIServerError(true, fmt.Errorf("failed to list the AppliedWork objects that depend on the resource: %w", err))
+	}
+
+	var names []string
+	for i := range appliedWorkList.Items {
+		appliedWork := &appliedWorkList.Items[i]
+		if appliedWork.Name == idx.ownAppliedWorkName || appliedWork.DeletionTimestamp != nil {
+			continue
+		}
+		names = append(names, appliedWork.Name)
+	}
+	sort.Strings(names)
+	return names, nil
+}
+
+// newSharedDependencyIndex returns a shared dependency index for the given AppliedWork object.
+func (r *Reconciler) newSharedDependencyIndex(ownAppliedWorkName string) *sharedDependencyIndex {
+	return &sharedDependencyIndex{
+		reader:             r.spokeClient,
+		ownAppliedWorkName: ownAppliedWorkName,
+	}
+}
+
+// setWorkSharedDependencyCondition sets the SharedDependency condition on a Work object if some
+// left-over resources have been kept as other Works still depend on them, or removes it otherwise.
+func setWorkSharedDependencyCondition(work *fleetv1beta1.Work, keptSharedDependencies []fleetv1beta1.AppliedResourceMeta) {
+	if len(keptSharedDependencies) == 0 {
+		meta.RemoveStatusCondition(&work.Status.Conditions, fleetv1beta1.WorkConditionTypeSharedDependency)
+		return
+	}
+
+	kept := make([]string, 0, len(keptSharedDependencies))
+	for idx := range keptSharedDependencies {
+		id := &keptSharedDependencies[idx].WorkResourceIdentifier
+		kept = append(kept, fmt.Sprintf("%s %s", id.Kind, klog.KRef(id.Namespace, id.Name)))
+	}
+	sort.Strings(kept)
+	keptDescription := strings.Join(kept, ", ")
+	if len(kept) > maxKeptSharedDependenciesInMsg {
+		keptDescription = fmt.Sprintf("%s and %d more", strings.Join(kept[:maxKeptSharedDependenciesInMsg], ", "), len(kept)-maxKeptSharedDependenciesInMsg)
+	}
+	meta.SetStatusCondition(&work.Status.Conditions, metav1.Condition{
+		Type:               fleetv1beta1.WorkConditionTypeSharedDependency,
+		Status:             metav1.ConditionTrue,
+		Reason:             WorkSharedDependencyKeptReason,
+		Message:            fmt.Sprintf(WorkSharedDependencyKeptMsgTmpl, len(kept), keptDescription),
+		ObservedGeneration: work.Generation,
+	})
+}
@@ -0,0 +1,263 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// newSharedDependencyIndexFor returns a shared dependency index backed by a fake client, which
+// indexes the given AppliedWork objects by the resources they depend on.
+func newSharedDependencyIndexFor(t *testing.T, appliedWorks []fleetv1beta1.AppliedWork, ownAppliedWorkName string) *sharedDependencyIndex {
+	objs := make([]client.Object, 0, len(appliedWorks))
+	for idx := range appliedWorks {
+		objs = append(objs, &appliedWorks[idx])
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(fakeClientScheme(t)).
+		WithObjects(objs...).
+		WithIndex(&fleetv1beta1.AppliedWork{}, appliedResourceIndexField, appliedResourceIndexValues).
+		Build()
+	return &sharedDependencyIndex{
+		reader:             fakeClient,
+		ownAppliedWorkName: ownAppliedWorkName,
+	}
+}
+
+// TestAppliedResourceIndexValues tests the appliedResourceIndexValues function.
+func TestAppliedResourceIndexValues(t *testing.T) {
+	appliedWork := &fleetv1beta1.AppliedWork{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: workName,
+		},
+		Status: fleetv1beta1.AppliedWorkStatus{
+			AppliedResources: []fleetv1beta1.AppliedResourceMeta{
+				{WorkResourceIdentifier: *nsWRI(0, nsName)},
+				{WorkResourceIdentifier: *deployWRI(1, nsName, deployName)},
+				{WorkResourceIdentifier: *deployWRI(2, nsName, "deploy-2")},
+			},
+		},
+	}
+	want := []string{
+		"/Namespace//" + nsName,
+		fmt.Sprintf("apps/Deployment/%s/%s", nsName, deployName),
+		fmt.Sprintf("apps/Deployment/%s/deploy-2", nsName),
+	}
+	if diff := cmp.Diff(appliedResourceIndexValues(appliedWork), want); diff != "" {
+		t.Errorf("appliedResourceIndexValues() mismatches (-got +want):\n%s", diff)
+	}
+}
+
+// TestSharedDependencyIndexDependents tests the dependents method of the shared dependency index.
+func TestSharedDependencyIndexDependents(t *testing.T) {
+	now := metav1.Now()
+	appliedWorks := []fleetv1beta1.AppliedWork{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: workName,
+			},
+			Status: fleetv1beta1.AppliedWorkStatus{
+				AppliedResources: []fleetv1beta1.AppliedResourceMeta{
+					{WorkResourceIdentifier: *nsWRI(0, "own-ns")},
+					{WorkResourceIdentifier: *deployWRI(1, "own-ns", deployName)},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "work-b",
+			},
+			Status: fleetv1beta1.AppliedWorkStatus{
+				AppliedResources: []fleetv1beta1.AppliedResourceMeta{
+					{WorkResourceIdentifier: *deployWRI(0, nsName, deployName)},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "work-a",
+			},
+			Status: fleetv1beta1.AppliedWorkStatus{
+				AppliedResources: []fleetv1beta1.AppliedResourceMeta{
+					{WorkResourceIdentifier: *nsWRI(0, nsName)},
+					{WorkResourceIdentifier: *deployWRI(1, nsName, "deploy-2")},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "work-deleting",
+				DeletionTimestamp: &now,
+				Finalizers:        []string{"foo"},
+			},
+			Status: fleetv1beta1.AppliedWorkStatus{
+				AppliedResources: []fleetv1beta1.AppliedResourceMeta{
+					{WorkResourceIdentifier: *nsWRI(0, "deleting-ns")},
+					{WorkResourceIdentifier: *deployWRI(1, "deleting-ns", deployName)},
+				},
+			},
+		},
+	}
+	idx := newSharedDependencyIndexFor(t, appliedWorks, workName)
+
+	testCases := []struct {
+		name           string
+		idx            *sharedDependencyIndex
+		id             *fleetv1beta1.WorkResourceIdentifier
+		wantDependents []string
+	}{
+		{
+			name: "nil index",
+			id:   nsWRI(0, nsName),
+		},
+		{
+			name:           "namespace with resources from other works",
+			idx:            idx,
+			id:             nsWRI(0, nsName),
+			wantDependents: []string{"work-a", "work-b"},
+		},
+		{
+			name:           "resource applied by another work",
+			idx:            idx,
+			id:             deployWRI(0, nsName, deployName),
+			wantDependents: []string{"work-b"},
+		},
+		{
+			name: "resource applied by own work only",
+			idx:  idx,
+			id:   nsWRI(0, "own-ns"),
+		},
+		{
+			name: "resources applied by a work being deleted",
+			idx:  idx,
+			id:   nsWRI(0, "deleting-ns"),
+		},
+		{
+			name: "resource in a shared namespace, but not shared itself",
+			idx:  idx,
+			id:   deployWRI(0, nsName, "deploy-3"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := tc.idx.dependents(context.Background(), tc.id)
+			if err != nil {
+				t.Fatalf("dependents() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(got, tc.wantDependents); diff != "" {
+				t.Errorf("dependents() mismatches (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestSetWorkSharedDependencyCondition tests the setWorkSharedDependencyCondition function.
+func TestSetWorkSharedDependencyCondition(t *testing.T) {
+	testCases := []struct {
+		name           string
+		work           *fleetv1beta1.Work
+		kept           []fleetv1beta1.AppliedResourceMeta
+		wantConditions []metav1.Condition
+	}{
+		{
+			name: "no kept shared dependencies, condition removed",
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 2,
+				},
+				Status: fleetv1beta1.WorkStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               fleetv1beta1.WorkConditionTypeSharedDependency,
+							Status:             metav1.ConditionTrue,
+							Reason:             WorkSharedDependencyKeptReason,
+							ObservedGeneration: 1,
+						},
+					},
+				},
+			},
+			wantConditions: []metav1.Condition{},
+		},
+		{
+			name: "kept shared dependencies",
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 2,
+				},
+			},
+			kept: []fleetv1beta1.AppliedResourceMeta{
+				{WorkResourceIdentifier: *nsWRI(1, nsName)},
+				{WorkResourceIdentifier: *deployWRI(0, nsName, deployName)},
+			},
+			wantConditions: []metav1.Condition{
+				{
+					Type:               fleetv1beta1.WorkConditionTypeSharedDependency,
+					Status:             metav1.ConditionTrue,
+					Reason:             WorkSharedDependencyKeptReason,
+					Message:            "2 left-over resource(s) are still depended upon by resources placed by other Works and have not been deleted: Deployment ns-1/deploy-1, Namespace ns-1",
+					ObservedGeneration: 2,
+				},
+			},
+		},
+		{
+			name: "kept shared dependencies, list capped",
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: 2,
+				},
+			},
+			kept: []fleetv1beta1.AppliedResourceMeta{
+				{WorkResourceIdentifier: *nsWRI(0, nsName)},
+				{WorkResourceIdentifier: *deployWRI(1, nsName, "deploy-1")},
+				{WorkResourceIdentifier: *deployWRI(2, nsName, "deploy-2")},
+				{WorkResourceIdentifier: *deployWRI(3, nsName, "deploy-3")},
+				{WorkResourceIdentifier: *deployWRI(4, nsName, "deploy-4")},
+				{WorkResourceIdentifier: *deployWRI(5, nsName, "deploy-5")},
+				{WorkResourceIdentifier: *deployWRI(6, nsName, "deploy-6")},
+			},
+			wantConditions: []metav1.Condition{
+				{
+					Type:               fleetv1beta1.WorkConditionTypeSharedDependency,
+					Status:             metav1.ConditionTrue,
+					Reason:             WorkSharedDependencyKeptReason,
+					Message:            "7 left-over resource(s) are still depended upon by resources placed by other Works and have not been deleted: Deployment ns-1/deploy-1, Deployment ns-1/deploy-2, Deployment ns-1/deploy-3, Deployment ns-1/deploy-4, Deployment ns-1/deploy-5 and 2 more",
+					ObservedGeneration: 2,
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			setWorkSharedDependencyCondition(tc.work, tc.kept)
+			if diff := cmp.Diff(tc.work.Status.Conditions, tc.wantConditions, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("conditions mismatch (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,585 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"bytes"
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/sha512"
+	"crypto/subtle"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"maps"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/utils/lru"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// sopsManifestDecrypterName is the name of the SOPS manifest decrypter, which is used in the
+	// Work object status.
+	sopsManifestDecrypterName = "sops"
+
+	// sopsMetadataField is the top-level field where SOPS keeps the metadata of an encrypted document.
+	sopsMetadataField = "sops"
+	// sopsKeyGroupsField is the field in the SOPS metadata that keeps the master keys of documents
+	// encrypted with more than one key group (Shamir's secret sharing).
+	sopsKeyGroupsField = "key_groups"
+	// sopsEncryptedDataKeyField is the field in a master key entry of the SOPS metadata that keeps
+	// the data key encrypted with the master key.
+	sopsEncryptedDataKeyField = "enc"
+	// sopsAgeKeyType is the type of the age master keys in the SOPS metadata.
+	sopsAgeKeyType = "age"
+
+	// The fields in the SOPS metadata that keep the rules by which SOPS has decided which values of
+	// a document to encrypt.
+	sopsUnencryptedSuffixField = "unencrypted_suffix"
+	sopsEncryptedSuffixField   = "encrypted_suffix"
+	sopsUnencryptedRegexField  = "unencrypted_regex"
+	sopsEncryptedRegexField    = "encrypted_regex"
+	// The fields in the SOPS metadata that keep the message authentication code (MAC) of a document,
+	// the time when the document was last modified, with which the MAC is encrypted, and whether the MAC
+	// covers the encrypted values only.
+	sopsMACField              = "mac"
+	sopsLastModifiedField     = "lastmodified"
+	sopsMACOnlyEncryptedField = "mac_only_encrypted"
+	// sopsDefaultUnencryptedSuffix is the suffix of the keys whose values SOPS leaves unencrypted
+	// when no rules are set.
+	sopsDefaultUnencryptedSuffix = "_unencrypted"
+
+	// sopsEncryptedValuePrefix is the prefix of the values that SOPS has encrypted.
+	sopsEncryptedValuePrefix = "ENC[AES256_GCM,"
+	sopsDataKeySize          = 32
+	sopsGCMTagSize           = 16
+
+	// sopsDataKeyCacheSize is the maximum number of decrypted data keys the work applier keeps,
+	// so that the master keys (and the data key decrypter) are not consulted every time a Work
+	// object is processed.
+	sopsDataKeyCacheSize = 1024
+	// sopsAgeDataKeyLimitBytes is the maximum number of bytes the work applier will read from an
+	// age-encrypted data key.
+	sopsAgeDataKeyLimitBytes = 1024
+
+	// unixSocketSOPSDataKeyDecrypterResponseBodyLimitBytes is the maximum number of bytes the work
+	// applier will read from the response body of a socket-based data key decrypter.
+	unixSocketSOPSDataKeyDecrypterResponseBodyLimitBytes = 64 * 1024
+)
+
+var (
+	// sopsMasterKeyTypes are the types of master keys in the SOPS metadata, in the order that the
+	// work applier tries them to decrypt the data key of a document.
+	sopsMasterKeyTypes = []string{sopsAgeKeyType, "kms", "gcp_kms", "azure_kv", "hc_vault", "pgp"}
+
+	// sopsEncryptedValueRegexp matches the values that SOPS has encrypted with AES-256-GCM, e.g.,
+	// ENC[AES256_GCM,data:...,iv:...,tag:...,type:str].
+	sopsEncryptedValueRegexp = regexp.MustCompile(`^ENC\[AES256_GCM,data:([^,]*),iv:([^,]*),tag:([^,]*),type:([a-z]+)\]$`)
+)
+
+// SOPSDataKeyDecrypter decrypts the data keys of SOPS-encrypted manifests with the master keys that
+// the work applier does not hold itself, e.g., cloud KMS keys.
+type SOPSDataKeyDecrypter interface {
+	// DecryptDataKey returns the data key that has been encrypted with a master key. The key type
+	// is the type of the master key as it appears in the SOPS metadata (e.g., kms, gcp_kms, or
+	// azure_kv), and the master key is its entry in the SOPS metadata, which keeps the encrypted
+	// data key in the enc field.
+	DecryptDataKey(ctx context.Context, keyType string, masterKey map[string]interface{}) ([]byte, error)
+}
+
+// sopsManifestDecrypter is a manifest transformer that decrypts the manifests encrypted with SOPS
+// (https://github.com/getsops/sops), so that sensitive data can flow through the hub cluster
+// encrypted at rest (e.g., wrapped in an envelope object) and be decrypted on the member cluster only.
+//
+// The decrypter handles the manifests that have the SOPS metadata in their top-level sops field;
+// the other manifests are left as they are. The data key of a manifest is decrypted with the local
+// age identities if possible, or with the data key decrypter otherwise. Note that:
+//
+//   - The API version, kind, and metadata of a manifest must not be encrypted (e.g., use the
+//     encrypted_regex setting of SOPS to encrypt the data fields only), as the work applier tracks
+//     the manifest by them before decryption.
+//   - Each decrypted value is authenticated along with its path in the manifest, and the message
+//     authentication code (MAC) of the whole document is verified the way SOPS verifies it (covering
+//     the encrypted values only if mac_only_encrypted is set); manifests without a valid MAC are
+//     refused. SOPS computes the MAC over the values in the order they appear in the document, while
+//     the manifests reach the member cluster with their fields sorted by key (the API server does not
+//     keep the order), so the keys of the document must be sorted at all levels before it is encrypted
+//     (e.g., with yq 'sort_keys(..)'). The decrypter also refuses manifests whose values are not
+//     encrypted as the encryption rules in the SOPS metadata (e.g., encrypted_regex) prescribe.
+//   - Drifts and differences found in the decrypted fields are redacted in the Work object status.
+type sopsManifestDecrypter struct {
+	ageIdentities    []age.Identity
+	dataKeyDecrypter SOPSDataKeyDecrypter
+	dataKeyCache     *lru.Cache
+}
+
+var _ ManifestTransformer = &sopsManifestDecrypter{}
+var _ sensitiveFieldsMarker = &sopsManifestDecrypter{}
+
+// NewSOPSManifestDecrypter returns a manifest transformer that decrypts the manifests encrypted
+// with SOPS. The age keys are the content of an age key file, which holds the age identities
+// that the decrypter uses locally; the data key decrypter (if any) decrypts the data keys
+// encrypted with the other master keys, or with age keys not in the key file. At least one of
+// them must be set.
+func NewSOPSManifestDecrypter(ageKeys []byte, dataKeyDecrypter SOPSDataKeyDecrypter) (ManifestTransformer, error) {
+	var ageIdentities []age.Identity
+	if len(ageKeys) > 0 {
+		identities, err := age.ParseIdentities(bytes.NewReader(ageKeys))
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse the age keys: %w", err)
+		}
+		ageIdentities = identities
+	}
+	if len(ageIdentities) == 0 && dataKeyDecrypter == nil {
+		return nil, errors.New("neither age keys nor a data key decrypter is set for SOPS decryption")
+	}
+	return &sopsManifestDecrypter{
+		ageIdentities:    ageIdentities,
+		dataKeyDecrypter: dataKeyDecrypter,
+		dataKeyCache:     lru.New(sopsDataKeyCacheSize),
+	}, nil
+}
+
+// Name returns the name of the transformer.
+func (d *sopsManifestDecrypter) Name() string {
+	return sopsManifestDecrypterName
+}
+
+// Transform decrypts the manifest object if it has been encrypted with SOPS.
+func (d *sopsManifestDecrypter) Transform(ctx context.Context, _ *fleetv1beta1.Work, manifestObj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	metadata, ok := manifestObj.Object[sopsMetadataField].(map[string]interface{})
+	if !ok {
+		// The manifest object has not been encrypted with SOPS.
+		return manifestObj, nil
+	}
+
+	rules, err := parseSOPSEncryptionRules(metadata)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := d.decryptDataKey(ctx, metadata)
+	if err != nil {
+		return nil, err
+	}
+
+	macOnlyEncrypted, _ := metadata[sopsMACOnlyEncryptedField].(bool)
+	treeDecrypter := &sopsTreeDecrypter{
+		rules:            rules,
+		dataKey:          dataKey,
+		mac:              sha512.New(),
+		macOnlyEncrypted: macOnlyEncrypted,
+	}
+	decrypted := manifestObj.DeepCopy()
+	delete(decrypted.Object, sopsMetadataField)
+	for _, field := range slices.Sorted(maps.Keys(decrypted.Object)) {
+		decryptedValue, err := treeDecrypter.decrypt(decrypted.Object[field], []string{field})
+		if err != nil {
+			return nil, err
+		}
+		decrypted.Object[field] = decryptedValue
+	}
+	if err := verifySOPSMAC(metadata, dataKey, treeDecrypter.mac.Sum(nil)); err != nil {
+		return nil, err
+	}
+	return decrypted, nil
+}
+
+// sensitivePaths returns the JSON pointers of all the values that SOPS has encrypted in the
+// manifest object (if any), which the decrypter reveals.
+func (d *sopsManifestDecrypter) sensitivePaths(manifestObj *unstructured.Unstructured) []string {
+	if _, ok := manifestObj.Object[sopsMetadataField].(map[string]interface{}); !ok {
+		return nil
+	}
+	var paths []string
+	var walk func(value interface{}, pointer string)
+	walk = func(value interface{}, pointer string) {
+		switch typedValue := value.(type) {
+		case map[string]interface{}:
+			for field, child := range typedValue {
+				walk(child, pointer+"/"+escapeJSONPointerSegment(field))
+			}
+		case []interface{}:
+			// The list items are matched by a wildcard, so that the values are redacted even if
+			// the items are shifted in the object on the member cluster.
+			for _, child := range typedValue {
+				walk(child, pointer+"/"+ignoredFieldPathWildcardSegment)
+			}
+		case string:
+			if strings.HasPrefix(typedValue, sopsEncryptedValuePrefix) {
+				paths = append(paths, pointer)
+			}
+		}
+	}
+	for field, value := range manifestObj.Object {
+		if field == sopsMetadataField {
+			continue
+		}
+		walk(value, "/"+escapeJSONPointerSegment(field))
+	}
+	return paths
+}
+
+// decryptDataKey decrypts the data key of a SOPS-encrypted document with the first master key
+// in its SOPS metadata that the decrypter has access to.
+func (d *sopsManifestDecrypter) decryptDataKey(ctx context.Context, metadata map[string]interface{}) ([]byte, error) {
+	if keyGroups, ok := metadata[sopsKeyGroupsField].([]interface{}); ok && len(keyGroups) > 0 {
+		return nil, errors.New("documents encrypted with key groups are not supported")
+	}
+
+	var errs []error
+	for _, keyType := range sopsMasterKeyTypes {
+		masterKeys, _ := metadata[keyType].([]interface{})
+		for _, entry := range masterKeys {
+			masterKey, ok := entry.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			dataKey, err := d.decryptDataKeyWithMasterKey(ctx, keyType, masterKey)
+			if err != nil {
+				errs = append(errs, fmt.Errorf("%s master key: %w", keyType, err))
+				continue
+			}
+			return dataKey, nil
+		}
+	}
+	if len(errs) == 0 {
+		return nil, errors.New("the SOPS metadata has no master keys")
+	}
+	return nil, fmt.Errorf("failed to decrypt the data key with any of the master keys: %w", errors.Join(errs...))
+}
+
+// decryptDataKeyWithMasterKey decrypts the data key of a SOPS-encrypted document with a master key.
+func (d *sopsManifestDecrypter) decryptDataKeyWithMasterKey(ctx context.Context, keyType string, masterKey map[string]interface{}) ([]byte, error) {
+	encryptedDataKey, _ := masterKey[sopsEncryptedDataKeyField].(string)
+	if len(encryptedDataKey) == 0 {
+		return nil, errors.New("the master key has no encrypted data key")
+	}
+	cacheKey := keyType + "/" + encryptedDataKey
+	if dataKey, ok := d.dataKeyCache.Get(cacheKey); ok {
+		return dataKey.([]byte), nil
+	}
+
+	var dataKey []byte
+	var err error
+	switch {
+	case keyType == sopsAgeKeyType && len(d.ageIdentities) > 0:
+		dataKey, err = decryptAgeDataKey(encryptedDataKey, d.ageIdentities)
+		var noMatchErr *age.NoIdentityMatchError
+		if errors.As(err, &noMatchErr) && d.dataKeyDecrypter != nil {
+			dataKey, err = d.dataKeyDecrypter.DecryptDataKey(ctx, keyType, masterKey)
+		}
+	case d.dataKeyDecrypter != nil:
+		dataKey, err = d.dataKeyDecrypter.DecryptDataKey(ctx, keyType, masterKey)
+	default:
+		return nil, errors.New("no key has been provisioned for the master key")
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(dataKey) != sopsDataKeySize {
+		return nil, fmt.Errorf("the data key has an unexpected length %d", len(dataKey))
+	}
+	d.dataKeyCache.Add(cacheKey, dataKey)
+	return dataKey, nil
+}
+
+// decryptAgeDataKey decrypts a data key that has been encrypted with age (in the ASCII-armored
+// format) with the first of the age identities that matches.
+func decryptAgeDataKey(armored string, identities []age.Identity) ([]byte, error) {
+	plaintext, err := age.Decrypt(armor.NewReader(strings.NewReader(armored)), identities...)
+	if err != nil {
+		return nil, err
+	}
+	dataKey, err := io.ReadAll(io.LimitReader(plaintext, sopsAgeDataKeyLimitBytes))
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt the data key with age: %w", err)
+	}
+	return dataKey, nil
+}
+
+// sopsEncryptionRules are the rules in the SOPS metadata by which SOPS has decided which values of
+// a document to encrypt.
+type sopsEncryptionRules struct {
+	unencryptedSuffix string
+	encryptedSuffix   string
+	unencryptedRegex  *regexp.Regexp
+	encryptedRegex    *regexp.Regexp
+}
+
+// parseSOPSEncryptionRules parses the encryption rules in the SOPS metadata.
+func parseSOPSEncryptionRules(metadata map[string]interface{}) (*sopsEncryptionRules, error) {
+	rules := &sopsEncryptionRules{}
+	rules.unencryptedSuffix, _ = metadata[sopsUnencryptedSuffixField].(string)
+	rules.encryptedSuffix, _ = metadata[sopsEncryptedSuffixField].(string)
+	for field, regex := range map[string]**regexp.Regexp{
+		sopsUnencryptedRegexField: &rules.unencryptedRegex,
+		sopsEncryptedRegexField:   &rules.encryptedRegex,
+	} {
+		expr, _ := metadata[field].(string)
+		if len(expr) == 0 {
+			continue
+		}
+		compiled, err := regexp.Compile(expr)
+		if err != nil {
+			return nil, fmt.Errorf("the %s in the SOPS metadata is invalid: %w", field, err)
+		}
+		*regex = compiled
+	}
+	if rules.unencryptedSuffix == "" && rules.encryptedSuffix == "" && rules.unencryptedRegex == nil && rules.encryptedRegex == nil {
+		rules.unencryptedSuffix = sopsDefaultUnencryptedSuffix
+	}
+	return rules, nil
+}
+
+// shouldBeEncrypted returns if SOPS encrypts the value at the given path, the same way SOPS
+// itself decides when it encrypts a document.
+func (r *sopsEncryptionRules) shouldBeEncrypted(path []string) bool {
+	encrypted := true
+	if r.unencryptedSuffix != "" && slices.ContainsFunc(path, func(key string) bool { return strings.HasSuffix(key, r.unencryptedSuffix) }) {
+		encrypted = false
+	}
+	if r.encryptedSuffix != "" {
+		encrypted = slices.ContainsFunc(path, func(key string) bool { return strings.HasSuffix(key, r.encryptedSuffix) })
+	}
+	if r.unencryptedRegex != nil && slices.ContainsFunc(path, r.unencryptedRegex.MatchString) {
+		encrypted = false
+	}
+	if r.encryptedRegex != nil {
+		encrypted = slices.ContainsFunc(path, r.encryptedRegex.MatchString)
+	}
+	return encrypted
+}
+
+// sopsTreeDecrypter decrypts the SOPS-encrypted values in a manifest object and computes the MAC of
+// the plaintext values along the way.
+type sopsTreeDecrypter struct {
+	rules            *sopsEncryptionRules
+	dataKey          []byte
+	mac              hash.Hash
+	macOnlyEncrypted bool
+}
+
+// decrypt decrypts all the SOPS-encrypted values in a (part of a) manifest object in place; it fails
+// if a value is not encrypted as the encryption rules prescribe. The fields of objects are visited in
+// the order of their keys, which is the order the values are added to the MAC in.
+//
+// SOPS authenticates each value with its path, i.e., the keys from the root of the document, which
+// excludes the indices of list items.
+func (d *sopsTreeDecrypter) decrypt(value interface{}, path []string) (interface{}, error) {
+	switch typedValue := value.(type) {
+	case map[string]interface{}:
+		for _, field := range slices.Sorted(maps.Keys(typedValue)) {
+			decryptedChild, err := d.decrypt(typedValue[field], append(path[:len(path):len(path)], field))
+			if err != nil {
+				return nil, err
+			}
+			typedValue[field] = decryptedChild
+		}
+		return typedValue, nil
+	case []interface{}:
+		for idx, child := range typedValue {
+			decryptedChild, err := d.decrypt(child, path)
+			if err != nil {
+				return nil, err
+			}
+			typedValue[idx] = decryptedChild
+		}
+		return typedValue, nil
+	case nil:
+		// SOPS neither encrypts nor authenticates null values.
+		return value, nil
+	}
+
+	encryptedValue, isEncrypted := value.(string)
+	isEncrypted = isEncrypted && strings.HasPrefix(encryptedValue, sopsEncryptedValuePrefix)
+	shouldBeEncrypted := d.rules.shouldBeEncrypted(path)
+	decrypted := value
+	switch {
+	case value == "":
+		// SOPS leaves empty strings as they are, even if they should be encrypted.
+	case shouldBeEncrypted && !isEncrypted:
+		return nil, fmt.Errorf("the value at %s is not encrypted, but the encryption rules in the SOPS metadata require it to be", strings.Join(path, "."))
+	case !shouldBeEncrypted && isEncrypted:
+		return nil, fmt.Errorf("the value at %s is encrypted, but the encryption rules in the SOPS metadata exclude it", strings.Join(path, "."))
+	case isEncrypted:
+		var err error
+		if decrypted, err = decryptSOPSValue(encryptedValue, path, d.dataKey); err != nil {
+			return nil, fmt.Errorf("failed to decrypt the value at %s: %w", strings.Join(path, "."), err)
+		}
+	}
+
+	if shouldBeEncrypted || !d.macOnlyEncrypted {
+		macBytes, err := sopsMACBytes(decrypted)
+		if err != nil {
+			return nil, fmt.Errorf("failed to authenticate the value at %s: %w", strings.Join(path, "."), err)
+		}
+		d.mac.Write(macBytes)
+	}
+	return decrypted, nil
+}
+
+// sopsMACBytes returns the bytes of a plaintext value that SOPS adds to the MAC of a document.
+func sopsMACBytes(value interface{}) ([]byte, error) {
+	switch typedValue := value.(type) {
+	case string:
+		return []byte(typedValue), nil
+	case int64:
+		return []byte(strconv.FormatInt(typedValue, 10)), nil
+	case float64:
+		return []byte(strconv.FormatFloat(typedValue, 'f', -1, 64)), nil
+	case bool:
+		// SOPS keeps the format of its Python predecessor.
+		if typedValue {
+			return []byte("True"), nil
+		}
+		return []byte("False"), nil
+	default:
+		return nil, fmt.Errorf("the value has an unsupported type %T", value)
+	}
+}
+
+// verifySOPSMAC verifies the MAC in the SOPS metadata against the one computed over the plaintext
+// values of a document; the MAC is encrypted with the time when the document was last modified as
+// the additional data.
+func verifySOPSMAC(metadata map[string]interface{}, dataKey []byte, computed []byte) error {
+	encryptedMAC, _ := metadata[sopsMACField].(string)
+	if len(encryptedMAC) == 0 {
+		return errors.New("the SOPS metadata has no MAC")
+	}
+	lastModifiedStr, _ := metadata[sopsLastModifiedField].(string)
+	lastModified, err := time.Parse(time.RFC3339, lastModifiedStr)
+	if err != nil {
+		return fmt.Errorf("the %s in the SOPS metadata is invalid: %w", sopsLastModifiedField, err)
+	}
+	mac, valueType, err := openSOPSValue(encryptedMAC, lastModified.Format(time.RFC3339), dataKey)
+	if err != nil {
+		return fmt.Errorf("failed to decrypt the MAC: %w", err)
+	}
+	if valueType != "str" || subtle.ConstantTimeCompare(mac, []byte(fmt.Sprintf("%X", computed))) != 1 {
+		return errors.New("the MAC in the SOPS metadata does not match the values of the manifest; the manifest has been tampered with, or its keys were not sorted when it was encrypted")
+	}
+	return nil
+}
+
+// decryptSOPSValue decrypts a SOPS-encrypted value.
+func decryptSOPSValue(value string, path []string, dataKey []byte) (interface{}, error) {
+	plaintext, valueType, err := openSOPSValue(value, strings.Join(path, ":")+":", dataKey)
+	if err != nil {
+		return nil, err
+	}
+
+	switch valueType {
+	case "str", "bytes":
+		return string(plaintext), nil
+	case "int":
+		return strconv.ParseInt(string(plaintext), 10, 64)
+	case "float":
+		return strconv.ParseFloat(string(plaintext), 64)
+	case "bool":
+		return strconv.ParseBool(string(plaintext))
+	default:
+		return nil, fmt.Errorf("the encrypted value has an unsupported type %q", valueType)
+	}
+}
+
+// openSOPSValue decrypts and authenticates a SOPS-encrypted value with the given additional data; it
+// returns the plaintext and the type of the value.
+func openSOPSValue(value, additionalData string, dataKey []byte) ([]byte, string, error) {
+	matches := sopsEncryptedValueRegexp.FindStringSubmatch(value)
+	if matches == nil {
+		return nil, "", errors.New("the encrypted value is malformed")
+	}
+	data, dataErr := base64.StdEncoding.DecodeString(matches[1])
+	iv, ivErr := base64.StdEncoding.DecodeString(matches[2])
+	tag, tagErr := base64.StdEncoding.DecodeString(matches[3])
+	if dataErr != nil || ivErr != nil || tagErr != nil || len(iv) == 0 || len(tag) != sopsGCMTagSize {
+		return nil, "", errors.New("the encrypted value is malformed")
+	}
+
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		return nil, "", err
+	}
+	gcm, err := cipher.NewGCMWithNonceSize(block, len(iv))
+	if err != nil {
+		return nil, "", err
+	}
+	plaintext, err := gcm.Open(nil, iv, append(data, tag...), []byte(additionalData))
+	if err != nil {
+		return nil, "", fmt.Errorf("failed to authenticate the encrypted value: %w", err)
+	}
+	return plaintext, matches[4], nil
+}
+
+// sopsDataKeyDecrypterRequest is the payload the work applier sends to a socket-based data key
+// decrypter.
+type sopsDataKeyDecrypterRequest struct {
+	KeyType   string                 `json:"keyType"`
+	MasterKey map[string]interface{} `json:"masterKey"`
+}
+
+// sopsDataKeyDecrypterResponse is the payload a socket-based data key decrypter responds with; the
+// data key is base64-encoded.
+type sopsDataKeyDecrypterResponse struct {
+	DataKey []byte `json:"dataKey"`
+}
+
+// unixSocketSOPSDataKeyDecrypter is a data key decrypter that delegates the decryption of data keys
+// to a local process (e.g., one with access to the cloud KMS), which listens on a Unix domain socket,
+// via a HTTP POST request.
+//
+// Note that this is a protocol of KubeFleet's own (a JSON payload over HTTP), not the gRPC protocol
+// of the SOPS key service; the stock `sops keyservice` command cannot serve as the local process.
+type unixSocketSOPSDataKeyDecrypter struct {
+	client *unixSocketJSONClient
+}
+
+var _ SOPSDataKeyDecrypter = &unixSocketSOPSDataKeyDecrypter{}
+
+// NewUnixSocketSOPSDataKeyDecrypter returns a data key decrypter that sends a HTTP POST request,
+// which carries a master key entry of the SOPS metadata, to a local process listening on the given
+// Unix domain socket. The process is expected to respond with a 2XX status code and the decrypted
+// data key within the given timeout.
+func NewUnixSocketSOPSDataKeyDecrypter(socketPath string, timeout time.Duration) SOPSDataKeyDecrypter {
+	return &unixSocketSOPSDataKeyDecrypter{
+		client: newUnixSocketJSONClient("data key decrypter", socketPath, timeout),
+	}
+}
+
+// DecryptDataKey sends the master key entry to the local process for decryption.
+func (s *unixSocketSOPSDataKeyDecrypter) DecryptDataKey(ctx context.Context, keyType string, masterKey map[string]interface{}) ([]byte, error) {
+	decrypted := &sopsDataKeyDecrypterResponse{}
+	if err := s.client.post(ctx, &sopsDataKeyDecrypterRequest{
+		KeyType:   keyType,
+		MasterKey: masterKey,
+	}, decrypted, unixSocketSOPSDataKeyDecrypterResponseBodyLimitBytes); err != nil {
+		return nil, err
+	}
+	return decrypted.DataKey, nil
+}
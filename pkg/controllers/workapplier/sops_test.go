@@ -0,0 +1,783 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"crypto/sha512"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"path/filepath"
+	"slices"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"filippo.io/age"
+	"filippo.io/age/armor"
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+// fakeSOPSDataKeyDecrypter is a SOPS data key decrypter for testing purposes.
+type fakeSOPSDataKeyDecrypter struct {
+	dataKeys     map[string][]byte
+	gotKeyTypes  []string
+	decryptCalls int
+}
+
+func (s *fakeSOPSDataKeyDecrypter) DecryptDataKey(_ context.Context, keyType string, masterKey map[string]interface{}) ([]byte, error) {
+	s.decryptCalls++
+	s.gotKeyTypes = append(s.gotKeyTypes, keyType)
+	dataKey, ok := s.dataKeys[masterKey["enc"].(string)]
+	if !ok {
+		return nil, errors.New("access denied")
+	}
+	return dataKey, nil
+}
+
+// randomBytesForTest returns n random bytes.
+func randomBytesForTest(t *testing.T, n int) []byte {
+	t.Helper()
+	b := make([]byte, n)
+	if _, err := rand.Read(b); err != nil {
+		t.Fatalf("failed to generate random bytes: %v", err)
+	}
+	return b
+}
+
+// newAgeIdentityForTest returns a new age identity, in the format of an age key file, and its recipient.
+func newAgeIdentityForTest(t *testing.T) (string, age.Recipient) {
+	t.Helper()
+	identity, err := age.GenerateX25519Identity()
+	if err != nil {
+		t.Fatalf("failed to generate age identity: %v", err)
+	}
+	return "# created: 2025-01-01T00:00:00Z\n# public key: " + identity.Recipient().String() + "\n" + identity.String() + "\n", identity.Recipient()
+}
+
+// encryptAgeForTest encrypts the plaintext for the recipient as an ASCII-armored age file, the way
+// SOPS encrypts data keys with age.
+func encryptAgeForTest(t *testing.T, recipient age.Recipient, plaintext []byte) string {
+	t.Helper()
+	var buf strings.Builder
+	armorWriter := armor.NewWriter(&buf)
+	w, err := age.Encrypt(armorWriter, recipient)
+	if err != nil {
+		t.Fatalf("failed to encrypt with age: %v", err)
+	}
+	if _, err := w.Write(plaintext); err != nil {
+		t.Fatalf("failed to encrypt with age: %v", err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatalf("failed to encrypt with age: %v", err)
+	}
+	if err := armorWriter.Close(); err != nil {
+		t.Fatalf("failed to armor the age file: %v", err)
+	}
+	return buf.String()
+}
+
+// encryptSOPSValueForTest encrypts a value at the given path the way SOPS does.
+func encryptSOPSValueForTest(t *testing.T, dataKey []byte, path, value, valueType string) string {
+	t.Helper()
+	block, err := aes.NewCipher(dataKey)
+	if err != nil {
+		t.Fatalf("failed to create cipher: %v", err)
+	}
+	gcm, err := cipher.NewGCMWithNonceSize(block, 32)
+	if err != nil {
+		t.Fatalf("failed to create GCM: %v", err)
+	}
+	iv := randomBytesForTest(t, 32)
+	sealed := gcm.Seal(nil, iv, []byte(value), []byte(path))
+	data, tag := sealed[:len(sealed)-16], sealed[len(sealed)-16:]
+	return fmt.Sprintf("ENC[AES256_GCM,data:%s,iv:%s,tag:%s,type:%s]",
+		base64.StdEncoding.EncodeToString(data), base64.StdEncoding.EncodeToString(iv), base64.StdEncoding.EncodeToString(tag), valueType)
+}
+
+// sopsMACForTest computes the MAC of a plaintext document the way SOPS does, visiting the fields in
+// the order of their keys, and encrypts it with the given time of last modification; if macOnly is
+// set, only the values at the paths it accepts are added to the MAC.
+func sopsMACForTest(t *testing.T, dataKey []byte, doc map[string]interface{}, lastModified string, macOnly func(path []string) bool) string {
+	t.Helper()
+	mac := sha512.New()
+	var walk func(value interface{}, path []string)
+	walk = func(value interface{}, path []string) {
+		switch typedValue := value.(type) {
+		case map[string]interface{}:
+			keys := make([]string, 0, len(typedValue))
+			for key := range typedValue {
+				keys = append(keys, key)
+			}
+			sort.Strings(keys)
+			for _, key := range keys {
+				walk(typedValue[key], append(slices.Clone(path), key))
+			}
+			return
+		case []interface{}:
+			for _, item := range typedValue {
+				walk(item, path)
+			}
+			return
+		}
+		if macOnly != nil && !macOnly(path) {
+			return
+		}
+		switch typedValue := value.(type) {
+		case string:
+			mac.Write([]byte(typedValue))
+		case int64:
+			mac.Write([]byte(strconv.FormatInt(typedValue, 10)))
+		case bool:
+			if typedValue {
+				mac.Write([]byte("True"))
+			} else {
+				mac.Write([]byte("False"))
+			}
+		default:
+			t.Fatalf("unexpected value %v of type %T", value, value)
+		}
+	}
+	walk(doc, nil)
+	return encryptSOPSValueForTest(t, dataKey, lastModified, fmt.Sprintf("%X", mac.Sum(nil)), "str")
+}
+
+// TestSOPSManifestDecrypter tests the SOPS manifest decrypter.
+func TestSOPSManifestDecrypter(t *testing.T) {
+	identity, recipient := newAgeIdentityForTest(t)
+	_, otherRecipient := newAgeIdentityForTest(t)
+	dataKey := randomBytesForTest(t, 32)
+	ageEncryptedDataKey := encryptAgeForTest(t, recipient, dataKey)
+	otherAgeEncryptedDataKey := encryptAgeForTest(t, otherRecipient, dataKey)
+
+	// encryptedConfigMap returns a ConfigMap encrypted with the given master keys in its SOPS metadata.
+	encryptedConfigMap := func(metadata map[string]interface{}) *unstructured.Unstructured {
+		return &unstructured.Unstructured{Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":      "app-config",
+				"namespace": "app",
+			},
+			"data": map[string]interface{}{
+				"password": encryptSOPSValueForTest(t, dataKey, "data:password:", "s3cr3t", "str"),
+			},
+			"spec": map[string]interface{}{
+				"replicas": encryptSOPSValueForTest(t, dataKey, "spec:replicas:", "3", "int"),
+				"enabled":  encryptSOPSValueForTest(t, dataKey, "spec:enabled:", "true", "bool"),
+				"tokens": []interface{}{
+					encryptSOPSValueForTest(t, dataKey, "spec:tokens:", "token-1", "str"),
+					map[string]interface{}{
+						"value": encryptSOPSValueForTest(t, dataKey, "spec:tokens:value:", "token-2", "str"),
+					},
+				},
+			},
+			"sops": metadata,
+		}}
+	}
+	wantDecrypted := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata": map[string]interface{}{
+			"name":      "app-config",
+			"namespace": "app",
+		},
+		"data": map[string]interface{}{
+			"password": "s3cr3t",
+		},
+		"spec": map[string]interface{}{
+			"replicas": int64(3),
+			"enabled":  true,
+			"tokens": []interface{}{
+				"token-1",
+				map[string]interface{}{"value": "token-2"},
+			},
+		},
+	}}
+	lastModified := "2025-01-02T03:04:05Z"
+	mac := sopsMACForTest(t, dataKey, wantDecrypted.Object, lastModified, nil)
+	ageMetadata := func(encryptedDataKeys ...string) map[string]interface{} {
+		var ageKeys []interface{}
+		for _, enc := range encryptedDataKeys {
+			ageKeys = append(ageKeys, map[string]interface{}{"recipient": "age1...", "enc": enc})
+		}
+		return map[string]interface{}{
+			"age":             ageKeys,
+			"encrypted_regex": "^(data|spec)$",
+			"lastmodified":    lastModified,
+			"mac":             mac,
+			"version":         "3.9.0",
+		}
+	}
+	kmsMetadata := map[string]interface{}{
+		"kms":             []interface{}{map[string]interface{}{"arn": "arn:aws:kms:...", "enc": "kms-encrypted"}},
+		"encrypted_regex": "^(data|spec)$",
+		"lastmodified":    lastModified,
+		"mac":             mac,
+		"version":         "3.9.0",
+	}
+	plainConfigMap := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "app-config", "namespace": "app"},
+		"data":       map[string]interface{}{"key": "value"},
+	}}
+	tamperedConfigMap := encryptedConfigMap(ageMetadata(ageEncryptedDataKey))
+	// Move an encrypted value to another path.
+	tamperedConfigMap.Object["data"].(map[string]interface{})["other"] = tamperedConfigMap.Object["data"].(map[string]interface{})["password"]
+	mixedConfigMap := encryptedConfigMap(ageMetadata(ageEncryptedDataKey))
+	// Add a plaintext value to the encrypted fields.
+	mixedConfigMap.Object["data"].(map[string]interface{})["injected"] = "plaintext"
+	misplacedConfigMap := encryptedConfigMap(ageMetadata(ageEncryptedDataKey))
+	// Add an encrypted value to the fields that are left unencrypted.
+	misplacedConfigMap.Object["metadata"].(map[string]interface{})["labels"] = map[string]interface{}{
+		"app": encryptSOPSValueForTest(t, dataKey, "metadata:labels:app:", "web", "str"),
+	}
+	noRulesConfigMap := encryptedConfigMap(ageMetadata(ageEncryptedDataKey))
+	// Without rules, SOPS encrypts all the values, including the metadata.
+	delete(noRulesConfigMap.Object["sops"].(map[string]interface{}), "encrypted_regex")
+	noMACConfigMap := encryptedConfigMap(ageMetadata(ageEncryptedDataKey))
+	delete(noMACConfigMap.Object["sops"].(map[string]interface{}), "mac")
+	removedValueConfigMap := encryptedConfigMap(ageMetadata(ageEncryptedDataKey))
+	// Remove an encrypted value.
+	delete(removedValueConfigMap.Object["data"].(map[string]interface{}), "password")
+	reorderedConfigMap := encryptedConfigMap(ageMetadata(ageEncryptedDataKey))
+	// Swap the list items, each of which still decrypts at its new position.
+	tokens := reorderedConfigMap.Object["spec"].(map[string]interface{})["tokens"].([]interface{})
+	tokens[0], tokens[1] = tokens[1], tokens[0]
+	renamedConfigMap := encryptedConfigMap(ageMetadata(ageEncryptedDataKey))
+	// Change an unencrypted value.
+	renamedConfigMap.Object["metadata"].(map[string]interface{})["name"] = "other-config"
+	macOnlyEncryptedMetadata := ageMetadata(ageEncryptedDataKey)
+	macOnlyEncryptedMetadata["mac_only_encrypted"] = true
+	macOnlyEncryptedMetadata["mac"] = sopsMACForTest(t, dataKey, wantDecrypted.Object, lastModified, func(path []string) bool {
+		return path[0] == "data" || path[0] == "spec"
+	})
+	macOnlyEncryptedConfigMap := encryptedConfigMap(macOnlyEncryptedMetadata)
+	macOnlyEncryptedConfigMap.Object["metadata"].(map[string]interface{})["name"] = "other-config"
+	wantMACOnlyEncryptedDecrypted := wantDecrypted.DeepCopy()
+	wantMACOnlyEncryptedDecrypted.Object["metadata"].(map[string]interface{})["name"] = "other-config"
+	invalidLastModifiedConfigMap := encryptedConfigMap(ageMetadata(ageEncryptedDataKey))
+	invalidLastModifiedConfigMap.Object["sops"].(map[string]interface{})["lastmodified"] = "2025-01-02T03:04:06Z"
+
+	testCases := []struct {
+		name             string
+		ageKeys          string
+		dataKeyDecrypter *fakeSOPSDataKeyDecrypter
+		manifestObj      *unstructured.Unstructured
+		want             *unstructured.Unstructured
+		wantKeyTypes     []string
+		wantErrMsgSubStr string
+	}{
+		{
+			name:        "manifest not encrypted",
+			ageKeys:     identity,
+			manifestObj: plainConfigMap,
+			want:        plainConfigMap,
+		},
+		{
+			name:        "manifest encrypted with an age key",
+			ageKeys:     identity,
+			manifestObj: encryptedConfigMap(ageMetadata(otherAgeEncryptedDataKey, ageEncryptedDataKey)),
+			want:        wantDecrypted,
+		},
+		{
+			name:             "manifest encrypted with a KMS key",
+			ageKeys:          identity,
+			dataKeyDecrypter: &fakeSOPSDataKeyDecrypter{dataKeys: map[string][]byte{"kms-encrypted": dataKey}},
+			manifestObj:      encryptedConfigMap(kmsMetadata),
+			want:             wantDecrypted,
+			wantKeyTypes:     []string{"kms"},
+		},
+		{
+			name:             "manifest encrypted with an age key not provisioned locally",
+			ageKeys:          identity,
+			dataKeyDecrypter: &fakeSOPSDataKeyDecrypter{dataKeys: map[string][]byte{otherAgeEncryptedDataKey: dataKey}},
+			manifestObj:      encryptedConfigMap(ageMetadata(otherAgeEncryptedDataKey)),
+			want:             wantDecrypted,
+			wantKeyTypes:     []string{"age"},
+		},
+		{
+			name:             "no key provisioned for the master keys",
+			ageKeys:          identity,
+			manifestObj:      encryptedConfigMap(kmsMetadata),
+			wantErrMsgSubStr: "kms master key: no key has been provisioned for the master key",
+		},
+		{
+			name:             "data key decrypter fails",
+			dataKeyDecrypter: &fakeSOPSDataKeyDecrypter{},
+			manifestObj:      encryptedConfigMap(kmsMetadata),
+			wantKeyTypes:     []string{"kms"},
+			wantErrMsgSubStr: "kms master key: access denied",
+		},
+		{
+			name:             "manifest encrypted with key groups",
+			ageKeys:          identity,
+			manifestObj:      encryptedConfigMap(map[string]interface{}{"key_groups": []interface{}{map[string]interface{}{}}}),
+			wantErrMsgSubStr: "documents encrypted with key groups are not supported",
+		},
+		{
+			name:             "encrypted value moved",
+			ageKeys:          identity,
+			manifestObj:      tamperedConfigMap,
+			wantErrMsgSubStr: "failed to decrypt the value at data.other: failed to authenticate the encrypted value",
+		},
+		{
+			name:             "plaintext value mixed into the encrypted fields",
+			ageKeys:          identity,
+			manifestObj:      mixedConfigMap,
+			wantErrMsgSubStr: "the value at data.injected is not encrypted",
+		},
+		{
+			name:             "encrypted value in the fields left unencrypted",
+			ageKeys:          identity,
+			manifestObj:      misplacedConfigMap,
+			wantErrMsgSubStr: "the value at metadata.labels.app is encrypted, but the encryption rules in the SOPS metadata exclude it",
+		},
+		{
+			name:             "MAC missing",
+			ageKeys:          identity,
+			manifestObj:      noMACConfigMap,
+			wantErrMsgSubStr: "the SOPS metadata has no MAC",
+		},
+		{
+			name:             "encrypted value removed",
+			ageKeys:          identity,
+			manifestObj:      removedValueConfigMap,
+			wantErrMsgSubStr: "the MAC in the SOPS metadata does not match the values of the manifest",
+		},
+		{
+			name:             "list items reordered",
+			ageKeys:          identity,
+			manifestObj:      reorderedConfigMap,
+			wantErrMsgSubStr: "the MAC in the SOPS metadata does not match the values of the manifest",
+		},
+		{
+			name:             "unencrypted value changed",
+			ageKeys:          identity,
+			manifestObj:      renamedConfigMap,
+			wantErrMsgSubStr: "the MAC in the SOPS metadata does not match the values of the manifest",
+		},
+		{
+			name:        "unencrypted value changed, MAC covering the encrypted values only",
+			ageKeys:     identity,
+			manifestObj: macOnlyEncryptedConfigMap,
+			want:        wantMACOnlyEncryptedDecrypted,
+		},
+		{
+			name:             "time of last modification changed",
+			ageKeys:          identity,
+			manifestObj:      invalidLastModifiedConfigMap,
+			wantErrMsgSubStr: "failed to decrypt the MAC",
+		},
+		{
+			name:             "metadata not encrypted as the default rules require",
+			ageKeys:          identity,
+			manifestObj:      noRulesConfigMap,
+			wantErrMsgSubStr: "is not encrypted, but the encryption rules in the SOPS metadata require it to be",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var dataKeyDecrypter SOPSDataKeyDecrypter
+			if tc.dataKeyDecrypter != nil {
+				dataKeyDecrypter = tc.dataKeyDecrypter
+			}
+			decrypter, err := NewSOPSManifestDecrypter([]byte(tc.ageKeys), dataKeyDecrypter)
+			if err != nil {
+				t.Fatalf("NewSOPSManifestDecrypter() = %v, want no error", err)
+			}
+			original := tc.manifestObj.DeepCopy()
+
+			got, err := decrypter.Transform(context.Background(), nil, tc.manifestObj)
+			if tc.wantErrMsgSubStr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Fatalf("Transform() = %v, want error msg with sub-string %s", err, tc.wantErrMsgSubStr)
+				}
+			} else {
+				if err != nil {
+					t.Fatalf("Transform() = %v, want no error", err)
+				}
+				if diff := cmp.Diff(got, tc.want); diff != "" {
+					t.Errorf("Transform() mismatches (-got, +want):\n%s", diff)
+				}
+			}
+			if diff := cmp.Diff(tc.manifestObj, original); diff != "" {
+				t.Errorf("Transform() modified the given object (-got, +want):\n%s", diff)
+			}
+			if tc.dataKeyDecrypter != nil {
+				if diff := cmp.Diff(tc.dataKeyDecrypter.gotKeyTypes, tc.wantKeyTypes); diff != "" {
+					t.Errorf("Transform() data key decrypter calls mismatch (-got, +want):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+// TestSOPSManifestDecrypterSensitivePaths tests that the SOPS manifest decrypter marks all the
+// encrypted values as sensitive.
+func TestSOPSManifestDecrypterSensitivePaths(t *testing.T) {
+	decrypter := &sopsManifestDecrypter{}
+
+	testCases := []struct {
+		name        string
+		manifestObj *unstructured.Unstructured
+		want        []string
+	}{
+		{
+			name: "manifest not encrypted",
+			manifestObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"data":       map[string]interface{}{"key": "ENC[AES256_GCM,data:...]"},
+			}},
+		},
+		{
+			name: "manifest encrypted",
+			manifestObj: &unstructured.Unstructured{Object: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata":   map[string]interface{}{"name": "app-config"},
+				"data": map[string]interface{}{
+					"password":     "ENC[AES256_GCM,data:...]",
+					"tls.crt/data": "ENC[AES256_GCM,data:...]",
+				},
+				"spec": map[string]interface{}{
+					"tokens": []interface{}{
+						"ENC[AES256_GCM,data:...]",
+						map[string]interface{}{"value": "ENC[AES256_GCM,data:...]"},
+					},
+				},
+				"sops": map[string]interface{}{"version": "3.9.0"},
+			}},
+			want: []string{"/data/password", "/data/tls.crt~1data", "/spec/tokens/*", "/spec/tokens/*/value"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := decrypter.sensitivePaths(tc.manifestObj)
+			if diff := cmp.Diff(got, tc.want, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("sensitivePaths() mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestSOPSEncryptionRules tests that the encryption rules in the SOPS metadata are applied the same
+// way SOPS applies them.
+func TestSOPSEncryptionRules(t *testing.T) {
+	testCases := []struct {
+		name             string
+		metadata         map[string]interface{}
+		path             []string
+		want             bool
+		wantErrMsgSubStr string
+	}{
+		{
+			name:     "no rules",
+			metadata: map[string]interface{}{},
+			path:     []string{"metadata", "name"},
+			want:     true,
+		},
+		{
+			name:     "no rules, unencrypted suffix by default",
+			metadata: map[string]interface{}{},
+			path:     []string{"data", "user_unencrypted"},
+		},
+		{
+			name:     "unencrypted suffix",
+			metadata: map[string]interface{}{"unencrypted_suffix": "_plain"},
+			path:     []string{"spec_plain", "replicas"},
+		},
+		{
+			name:     "encrypted suffix",
+			metadata: map[string]interface{}{"encrypted_suffix": "_secret"},
+			path:     []string{"data", "password_secret"},
+			want:     true,
+		},
+		{
+			name:     "encrypted suffix, not matched",
+			metadata: map[string]interface{}{"encrypted_suffix": "_secret"},
+			path:     []string{"data", "user"},
+		},
+		{
+			name:     "unencrypted regex",
+			metadata: map[string]interface{}{"unencrypted_regex": "^(apiVersion|kind|metadata)$"},
+			path:     []string{"metadata", "labels", "app"},
+		},
+		{
+			name:     "encrypted regex",
+			metadata: map[string]interface{}{"encrypted_regex": "^(data|stringData)$"},
+			path:     []string{"data", "password"},
+			want:     true,
+		},
+		{
+			name:     "encrypted regex, not matched",
+			metadata: map[string]interface{}{"encrypted_regex": "^(data|stringData)$"},
+			path:     []string{"type"},
+		},
+		{
+			name:             "invalid regex",
+			metadata:         map[string]interface{}{"encrypted_regex": "^(data"},
+			wantErrMsgSubStr: "the encrypted_regex in the SOPS metadata is invalid",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rules, err := parseSOPSEncryptionRules(tc.metadata)
+			if tc.wantErrMsgSubStr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Fatalf("parseSOPSEncryptionRules() = %v, want error msg with sub-string %s", err, tc.wantErrMsgSubStr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("parseSOPSEncryptionRules() = %v, want no error", err)
+			}
+			if got := rules.shouldBeEncrypted(tc.path); got != tc.want {
+				t.Errorf("shouldBeEncrypted(%v) = %t, want %t", tc.path, got, tc.want)
+			}
+		})
+	}
+}
+
+// TestSOPSManifestDecrypterCachesDataKeys tests that the SOPS manifest decrypter consults the data
+// key decrypter only once per data key.
+func TestSOPSManifestDecrypterCachesDataKeys(t *testing.T) {
+	dataKey := randomBytesForTest(t, 32)
+	dataKeyDecrypter := &fakeSOPSDataKeyDecrypter{dataKeys: map[string][]byte{"kms-encrypted": dataKey}}
+	decrypter, err := NewSOPSManifestDecrypter(nil, dataKeyDecrypter)
+	if err != nil {
+		t.Fatalf("NewSOPSManifestDecrypter() = %v, want no error", err)
+	}
+
+	manifestObj := &unstructured.Unstructured{Object: map[string]interface{}{
+		"apiVersion": "v1",
+		"kind":       "ConfigMap",
+		"metadata":   map[string]interface{}{"name": "app-config", "namespace": "app"},
+		"data":       map[string]interface{}{"key": "value"},
+	}}
+	lastModified := "2025-01-02T03:04:05Z"
+	mac := sopsMACForTest(t, dataKey, manifestObj.Object, lastModified, nil)
+	manifestObj.Object["data"] = map[string]interface{}{"key": encryptSOPSValueForTest(t, dataKey, "data:key:", "value", "str")}
+	manifestObj.Object["sops"] = map[string]interface{}{
+		"kms":             []interface{}{map[string]interface{}{"enc": "kms-encrypted"}},
+		"encrypted_regex": "^data$",
+		"lastmodified":    lastModified,
+		"mac":             mac,
+	}
+	for i := 0; i < 3; i++ {
+		if _, err := decrypter.Transform(context.Background(), nil, manifestObj); err != nil {
+			t.Fatalf("Transform() = %v, want no error", err)
+		}
+	}
+	if dataKeyDecrypter.decryptCalls != 1 {
+		t.Errorf("data key decrypter calls = %d, want 1", dataKeyDecrypter.decryptCalls)
+	}
+}
+
+// TestNewSOPSManifestDecrypter tests the NewSOPSManifestDecrypter function.
+func TestNewSOPSManifestDecrypter(t *testing.T) {
+	testCases := []struct {
+		name             string
+		ageKeys          []byte
+		dataKeyDecrypter SOPSDataKeyDecrypter
+		wantErrMsgSubStr string
+	}{
+		{
+			name:             "data key decrypter only",
+			dataKeyDecrypter: &fakeSOPSDataKeyDecrypter{},
+		},
+		{
+			name:             "invalid age keys",
+			ageKeys:          []byte("AGE-SECRET-KEY-1INVALID"),
+			wantErrMsgSubStr: "failed to parse the age keys",
+		},
+		{
+			name:             "no keys",
+			wantErrMsgSubStr: "neither age keys nor a data key decrypter is set",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := NewSOPSManifestDecrypter(tc.ageKeys, tc.dataKeyDecrypter)
+			if tc.wantErrMsgSubStr == "" {
+				if err != nil {
+					t.Fatalf("NewSOPSManifestDecrypter() = %v, want no error", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+				t.Fatalf("NewSOPSManifestDecrypter() = %v, want error msg with sub-string %s", err, tc.wantErrMsgSubStr)
+			}
+		})
+	}
+}
+
+// TestDecryptSOPSValue tests the decryptSOPSValue function.
+func TestDecryptSOPSValue(t *testing.T) {
+	dataKey := randomBytesForTest(t, 32)
+	path := []string{"spec", "value"}
+
+	testCases := []struct {
+		name      string
+		value     string
+		want      interface{}
+		wantErred bool
+	}{
+		{
+			name:  "string",
+			value: encryptSOPSValueForTest(t, dataKey, "spec:value:", "hello", "str"),
+			want:  "hello",
+		},
+		{
+			name:  "bytes",
+			value: encryptSOPSValueForTest(t, dataKey, "spec:value:", "aGVsbG8=", "bytes"),
+			want:  "aGVsbG8=",
+		},
+		{
+			name:  "float",
+			value: encryptSOPSValueForTest(t, dataKey, "spec:value:", "1.5", "float"),
+			want:  1.5,
+		},
+		{
+			name:      "unsupported type",
+			value:     encryptSOPSValueForTest(t, dataKey, "spec:value:", "# a comment", "comment"),
+			wantErred: true,
+		},
+		{
+			name:      "wrong path",
+			value:     encryptSOPSValueForTest(t, dataKey, "spec:other:", "hello", "str"),
+			wantErred: true,
+		},
+		{
+			name:      "malformed",
+			value:     "ENC[AES256_GCM,data:abc]",
+			wantErred: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := decryptSOPSValue(tc.value, path, dataKey)
+			if tc.wantErred {
+				if err == nil {
+					t.Fatalf("decryptSOPSValue() = %v, want erred", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("decryptSOPSValue() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("decryptSOPSValue() mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestUnixSocketSOPSDataKeyDecrypter tests the socket-based SOPS data key decrypter.
+func TestUnixSocketSOPSDataKeyDecrypter(t *testing.T) {
+	dataKey := randomBytesForTest(t, 32)
+
+	testCases := []struct {
+		name             string
+		statusCode       int
+		respondWith      string
+		wantErrMsgSubStr string
+	}{
+		{
+			name:       "data key decrypter succeeds",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:             "data key decrypter fails",
+			statusCode:       http.StatusForbidden,
+			respondWith:      "access denied",
+			wantErrMsgSubStr: "data key decrypter responded with status code 403: access denied",
+		},
+		{
+			name:             "data key decrypter responds with malformed data",
+			statusCode:       http.StatusOK,
+			respondWith:      "not json",
+			wantErrMsgSubStr: "failed to decode the data key decrypter response",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			socketPath := filepath.Join(t.TempDir(), "decrypter.sock")
+			listener, err := net.Listen("unix", socketPath)
+			if err != nil {
+				t.Fatalf("failed to listen on the socket: %v", err)
+			}
+
+			server := &http.Server{
+				Handler: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+					var gotReq sopsDataKeyDecrypterRequest
+					if err := json.NewDecoder(req.Body).Decode(&gotReq); err != nil || gotReq.KeyType != "kms" || gotReq.MasterKey["enc"] != "kms-encrypted" {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					w.WriteHeader(tc.statusCode)
+					if tc.respondWith != "" {
+						_, _ = w.Write([]byte(tc.respondWith))
+						return
+					}
+					_ = json.NewEncoder(w).Encode(&sopsDataKeyDecrypterResponse{DataKey: dataKey})
+				}),
+				ReadHeaderTimeout: time.Second,
+			}
+			go func() {
+				_ = server.Serve(listener)
+			}()
+			t.Cleanup(func() {
+				_ = server.Close()
+			})
+
+			dataKeyDecrypter := NewUnixSocketSOPSDataKeyDecrypter(socketPath, 5*time.Second)
+			got, err := dataKeyDecrypter.DecryptDataKey(context.Background(), "kms", map[string]interface{}{"arn": "arn:aws:kms:...", "enc": "kms-encrypted"})
+			if tc.wantErrMsgSubStr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Fatalf("DecryptDataKey() = %v, want error msg with sub-string %s", err, tc.wantErrMsgSubStr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("DecryptDataKey() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(got, dataKey); diff != "" {
+				t.Errorf("DecryptDataKey() mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
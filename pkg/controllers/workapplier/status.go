@@ -48,7 +48,9 @@ func (r *Reconciler) refreshWorkStatus( //nolint:gocyclo
 	work *fleetv1beta1.Work,
 	bundles []*manifestProcessingBundle,
 ) error {
-	originalStatus := work.Status.DeepCopy()
+	// Keep the copy as read, so that only the status changes made in this reconciliation are sent.
+	original := work.DeepCopy()
+	originalStatus := &original.Status
 
 	// Note (chenyu1): this method can run in parallel; however, for simplicity reasons,
 	// considering that in most of the time the count of manifests would be low, currently
@@ -144,7 +146,7 @@ func (r *Reconciler) refreshWorkStatus( //nolint:gocyclo
 				ObservationTime:                   now,
 				ObservedInMemberClusterGeneration: observedInMemberClusterGen,
 				FirstDriftedObservedTime:          *firstDriftedTimestamp,
-				ObservedDrifts:                    bundle.drifts,
+				ObservedDrifts:                    obscureSensitivePathsInPatchDetails(bundle.drifts, bundle.sensitivePaths),
 			}
 		}
 
@@ -168,7 +170,7 @@ func (r *Reconciler) refreshWorkStatus( //nolint:gocyclo
 				ObservationTime:                   now,
 				ObservedInMemberClusterGeneration: observedInMemberClusterGen,
 				FirstDiffedObservedTime:           *firstDiffedTimestamp,
-				ObservedDiffs:                     bundle.diffs,
+				ObservedDiffs:                     obscureSensitivePathsInPatchDetails(bundle.diffs, bundle.sensitivePaths),
 			}
 		}
 
@@ -176,12 +178,12 @@ func (r *Reconciler) refreshWorkStatus( //nolint:gocyclo
 		if isManifestObjectApplied(bundle.applyOrReportDiffResTyp) {
 			appliedManifestsCount++
 
-			if isStatusBackReportingOn {
+			if isStatusBackReportingOn && bundle.inMemberClusterObj != nil {
 				// Back-report the status from the member cluster side, if applicable.
 				//
 				// Back-reporting is only performed when:
 				// a) the ReportBackStrategy is of the type Mirror; and
-				// b) the manifest object has been applied successfully.
+				// b) the manifest object has been applied successfully (and still exists).
 				backReportStatus(bundle.inMemberClusterObj, manifestCond, now, klog.KObj(work))
 			}
 		}
@@ -210,10 +212,15 @@ func (r *Reconciler) refreshWorkStatus( //nolint:gocyclo
 		work.Status.Conditions = []metav1.Condition{}
 	}
 	setWorkAppliedCondition(work, manifestCount, appliedManifestsCount)
-	setWorkAvailableCondition(work, manifestCount, availableAppliedObjectsCount, untrackableAppliedObjectsCount)
+	setWorkAvailableCondition(work, manifestCount, appliedManifestsCount, availableAppliedObjectsCount, untrackableAppliedObjectsCount)
 	setWorkDiffReportedCondition(work, manifestCount, diffReportedObjectsCount)
+	setWorkQuotaFitCondition(work, bundles)
+	setWorkAdmissionPreflightPassedCondition(work, bundles)
 	work.Status.ManifestConditions = rebuiltManifestConds
 
+	// Run the post-apply hooks (if any) once all the manifests have been applied and are available.
+	r.runPostApplyHooksIfNeeded(ctx, work)
+
 	// Perform a size check before the status update. If the Work object goes over the size limit, trim
 	// some data from its status to ensure that update ops can go through.
 	//
@@ -261,7 +268,7 @@ func (r *Reconciler) refreshWorkStatus( //nolint:gocyclo
 		klog.V(2).InfoS("No status change found for Work object; skip the status update", "work", klog.KObj(work))
 	} else {
 		klog.V(2).InfoS("Refreshing work object status", "work", klog.KObj(work), "isDriftedOrDiffed", isDriftedOrDiffed, "isStatusBackReportingOn", isStatusBackReportingOn)
-		if err := r.hubClient.Status().Update(ctx, work); err != nil {
+		if _, err := controller.PatchStatus(ctx, r.hubClient, work, original); err != nil {
 			return controller.NewAPIServerError(false, err)
 		}
 	}
@@ -274,7 +281,8 @@ func (r *Reconciler) refreshAppliedWorkStatus(
 	appliedWork *fleetv1beta1.AppliedWork,
 	bundles []*manifestProcessingBundle,
 ) error {
-	originalStatus := appliedWork.Status.DeepCopy()
+	original := appliedWork.DeepCopy()
+	originalStatus := &original.Status
 
 	// Note (chenyu1): this method can run in parallel; however, for simplicity reasons,
 	// considering that in most of the time the count of manifests would be low, currently
@@ -290,7 +298,9 @@ func (r *Reconciler) refreshAppliedWorkStatus(
 	for idx := range bundles {
 		bundle := bundles[idx]
 
-		if isManifestObjectApplied(bundle.applyOrReportDiffResTyp) {
+		// Note that jobs that have completed and been cleaned up per their TTLs are considered
+		// to be applied, but no longer exist in the member cluster.
+		if isManifestObjectApplied(bundle.applyOrReportDiffResTyp) && bundle.inMemberClusterObj != nil {
 			appliedResources = append(appliedResources, fleetv1beta1.AppliedResourceMeta{
 				WorkResourceIdentifier: *bundle.id,
 				UID:                    bundle.inMemberClusterObj.GetUID(),
@@ -306,7 +316,7 @@ func (r *Reconciler) refreshAppliedWorkStatus(
 		klog.V(2).InfoS("No status change found for AppliedWork object; skip the status update", "appliedWork", klog.KObj(appliedWork))
 	} else {
 		klog.V(2).InfoS("Refreshing AppliedWork object status", "appliedWork", klog.KObj(appliedWork))
-		if err := r.spokeClient.Status().Update(ctx, appliedWork); err != nil {
+		if _, err := controller.PatchStatus(ctx, r.spokeClient, appliedWork, original); err != nil {
 			klog.ErrorS(err, "Failed to update AppliedWork status",
 				"appliedWork", klog.KObj(appliedWork))
 			return controller.NewAPIServerError(false, err)
@@ -558,7 +568,8 @@ func setManifestDiffReportedCondition(
 
 // setWorkAppliedCondition sets the Applied condition on a Work object.
 //
-// A Work object is considered to be applied if all of its manifests have been successfully applied.
+// A Work object is considered to be applied if all of its manifests have been successfully applied,
+// or if the manifests that have failed to apply are within the threshold tolerated by the apply strategy.
 func setWorkAppliedCondition(
 	work *fleetv1beta1.Work,
 	manifestCount, appliedManifestCount int,
@@ -578,6 +589,15 @@ func setWorkAppliedCondition(
 			Message:            condition.AllManifestsAppliedMessage,
 			ObservedGeneration: work.Generation,
 		}
+	case areApplyFailuresTolerated(work.Spec.ApplyStrategy, manifestCount, appliedManifestCount):
+		// Not all manifests have been successfully applied, but the failures are tolerated.
+		appliedCond = &metav1.Condition{
+			Type:               fleetv1beta1.WorkConditionTypeApplied,
+			Status:             metav1.ConditionTrue,
+			Reason:             condition.WorkAppliedWithToleratedFailuresReason,
+			Message:            fmt.Sprintf(condition.AppliedWithToleratedFailuresMessage, appliedManifestCount, manifestCount, work.Spec.ApplyStrategy.MaxFailedManifestsPercent),
+			ObservedGeneration: work.Generation,
+		}
 	default:
 		// Not all manifests have been successfully applied.
 		appliedCond = &metav1.Condition{
@@ -607,9 +627,12 @@ func setWorkAppliedCondition(
 // setWorkAvailableCondition sets the Available condition on a Work object.
 //
 // A Work object is considered to be available if all of its applied manifests are available.
+//
+// Note that when some manifests have failed to apply but the failures are tolerated, only the
+// manifests that have been successfully applied are checked.
 func setWorkAvailableCondition(
 	work *fleetv1beta1.Work,
-	manifestCount, availableManifestCount, untrackableAppliedObjectsCount int,
+	manifestCount, appliedManifestCount, availableManifestCount, untrackableAppliedObjectsCount int,
 ) {
 	appliedCond := meta.FindStatusCondition(work.Status.Conditions, fleetv1beta1.WorkConditionTypeApplied)
 	var availableCond *metav1.Condition
@@ -619,7 +642,7 @@ func setWorkAvailableCondition(
 		// Fleet will not update the Available condition.
 	case !condition.IsConditionStatusTrue(appliedCond, work.Generation):
 		// Not all manifests have been applied; skip updating the Available condition.
-	case availableManifestCount == manifestCount && untrackableAppliedObjectsCount == 0:
+	case availableManifestCount == appliedManifestCount && untrackableAppliedObjectsCount == 0:
 		// All manifests are available.
 		availableCond = &metav1.Condition{
 			Type:               fleetv1beta1.WorkConditionTypeAvailable,
@@ -628,7 +651,7 @@ func setWorkAvailableCondition(
 			Message:            condition.AllAppliedObjectAvailableMessage,
 			ObservedGeneration: work.Generation,
 		}
-	case availableManifestCount == manifestCount:
+	case availableManifestCount == appliedManifestCount:
 		// Some manifests are not trackable.
 		availableCond = &metav1.Condition{
 			Type:               fleetv1beta1.WorkConditionTypeAvailable,
@@ -643,7 +666,7 @@ func setWorkAvailableCondition(
 			Type:               fleetv1beta1.WorkConditionTypeAvailable,
 			Status:             metav1.ConditionFalse,
 			Reason:             condition.WorkNotAllManifestsAvailableReason,
-			Message:            fmt.Sprintf(condition.NotAllAppliedObjectsAvailableMessage, availableManifestCount, manifestCount),
+			Message:            fmt.Sprintf(condition.NotAllAppliedObjectsAvailableMessage, availableManifestCount, appliedManifestCount),
 			ObservedGeneration: work.Generation,
 		}
 	}
@@ -665,6 +688,17 @@ func setWorkAvailableCondition(
 	}
 }
 
+// areApplyFailuresTolerated returns whether the manifests that have failed to apply are within the
+// threshold tolerated by the apply strategy.
+func areApplyFailuresTolerated(applyStrategy *fleetv1beta1.ApplyStrategy, manifestCount, appliedManifestCount int) bool {
+	if applyStrategy == nil || applyStrategy.MaxFailedManifestsPercent <= 0 || manifestCount == 0 {
+		return false
+	}
+	failedManifestCount := manifestCount - appliedManifestCount
+	// Fewer than the specified percentage of manifests must fail.
+	return failedManifestCount*100 < int(applyStrategy.MaxFailedManifestsPercent)*manifestCount
+}
+
 // setWorkDiffReportedCondition sets the DiffReported condition on a Work object.
 func setWorkDiffReportedCondition(
 	work *fleetv1beta1.Work,
@@ -1715,6 +1715,56 @@ func TestSetWorkAppliedCondition(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "not all applied, failures tolerated",
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       workName,
+					Generation: 1,
+				},
+				Spec: fleetv1beta1.WorkSpec{
+					ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+						Type:                      fleetv1beta1.ApplyStrategyTypeServerSideApply,
+						MaxFailedManifestsPercent: 10,
+					},
+				},
+			},
+			manifestCount:        200,
+			appliedManifestCount: 181,
+			wantWorkStatusConditions: []metav1.Condition{
+				{
+					Type:               fleetv1beta1.WorkConditionTypeApplied,
+					Status:             metav1.ConditionTrue,
+					Reason:             condition.WorkAppliedWithToleratedFailuresReason,
+					ObservedGeneration: 1,
+				},
+			},
+		},
+		{
+			name: "not all applied, failures at the tolerated threshold",
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       workName,
+					Generation: 1,
+				},
+				Spec: fleetv1beta1.WorkSpec{
+					ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+						Type:                      fleetv1beta1.ApplyStrategyTypeServerSideApply,
+						MaxFailedManifestsPercent: 10,
+					},
+				},
+			},
+			manifestCount:        200,
+			appliedManifestCount: 180,
+			wantWorkStatusConditions: []metav1.Condition{
+				{
+					Type:               fleetv1beta1.WorkConditionTypeApplied,
+					Status:             metav1.ConditionFalse,
+					Reason:             condition.WorkNotAllManifestsAppliedReason,
+					ObservedGeneration: 1,
+				},
+			},
+		},
 		{
 			name: "no apply op performed",
 			work: &fleetv1beta1.Work{
@@ -1753,6 +1803,7 @@ func TestSetWorkAvailableCondition(t *testing.T) {
 		name                     string
 		work                     *fleetv1beta1.Work
 		manifestCount            int
+		appliedManifestCount     int
 		availableManifestCount   int
 		untrackableManifestCount int
 		wantWorkStatusConditions []metav1.Condition
@@ -1776,6 +1827,7 @@ func TestSetWorkAvailableCondition(t *testing.T) {
 				},
 			},
 			manifestCount:            2,
+			appliedManifestCount:     2,
 			availableManifestCount:   2,
 			untrackableManifestCount: 0,
 			wantWorkStatusConditions: []metav1.Condition{
@@ -1812,6 +1864,7 @@ func TestSetWorkAvailableCondition(t *testing.T) {
 				},
 			},
 			manifestCount:            2,
+			appliedManifestCount:     2,
 			availableManifestCount:   2,
 			untrackableManifestCount: 1,
 			wantWorkStatusConditions: []metav1.Condition{
@@ -1848,6 +1901,7 @@ func TestSetWorkAvailableCondition(t *testing.T) {
 				},
 			},
 			manifestCount:            2,
+			appliedManifestCount:     2,
 			availableManifestCount:   1,
 			untrackableManifestCount: 1,
 			wantWorkStatusConditions: []metav1.Condition{
@@ -1865,6 +1919,43 @@ func TestSetWorkAvailableCondition(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "applied with tolerated failures, all applied manifests available",
+			work: &fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       workName,
+					Generation: 1,
+				},
+				Status: fleetv1beta1.WorkStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               fleetv1beta1.WorkConditionTypeApplied,
+							Status:             metav1.ConditionTrue,
+							Reason:             condition.WorkAppliedWithToleratedFailuresReason,
+							ObservedGeneration: 1,
+						},
+					},
+				},
+			},
+			manifestCount:            10,
+			appliedManifestCount:     9,
+			availableManifestCount:   9,
+			untrackableManifestCount: 0,
+			wantWorkStatusConditions: []metav1.Condition{
+				{
+					Type:               fleetv1beta1.WorkConditionTypeApplied,
+					Status:             metav1.ConditionTrue,
+					Reason:             condition.WorkAppliedWithToleratedFailuresReason,
+					ObservedGeneration: 1,
+				},
+				{
+					Type:               fleetv1beta1.WorkConditionTypeAvailable,
+					Status:             metav1.ConditionTrue,
+					Reason:             condition.WorkAllManifestsAvailableReason,
+					ObservedGeneration: 1,
+				},
+			},
+		},
 		{
 			name: "not fully applied yet",
 			work: &fleetv1beta1.Work{
@@ -1890,6 +1981,7 @@ func TestSetWorkAvailableCondition(t *testing.T) {
 				},
 			},
 			manifestCount:            2,
+			appliedManifestCount:     1,
 			availableManifestCount:   1,
 			untrackableManifestCount: 1,
 			wantWorkStatusConditions: []metav1.Condition{
@@ -1905,7 +1997,7 @@ func TestSetWorkAvailableCondition(t *testing.T) {
 
 	for _, tc := range testCases {
 		t.Run(tc.name, func(t *testing.T) {
-			setWorkAvailableCondition(tc.work, tc.manifestCount, tc.availableManifestCount, tc.untrackableManifestCount)
+			setWorkAvailableCondition(tc.work, tc.manifestCount, tc.appliedManifestCount, tc.availableManifestCount, tc.untrackableManifestCount)
 			if diff := cmp.Diff(
 				tc.work.Status.Conditions, tc.wantWorkStatusConditions,
 				ignoreFieldConditionLTTMsg, cmpopts.EquateEmpty(),
@@ -175,6 +175,27 @@ func setupResources() {
 	Expect(hubClient.Create(ctx, ns4)).To(Succeed())
 }
 
+// newCachedMemberClient returns a client for the work applier that, like the client of the member
+// agent's controller manager, reads from a cache of the member cluster with the applied resource
+// index set up.
+func newCachedMemberClient(memberCfg *rest.Config) client.Client {
+	memberCache, err := cache.New(memberCfg, cache.Options{Scheme: scheme.Scheme})
+	Expect(err).ToNot(HaveOccurred())
+	Expect(SetupAppliedResourceIndex(ctx, memberCache)).To(Succeed())
+	go func() {
+		defer GinkgoRecover()
+		Expect(memberCache.Start(ctx)).To(Succeed())
+	}()
+	Expect(memberCache.WaitForCacheSync(ctx)).To(BeTrue())
+
+	cachedMemberClient, err := client.New(memberCfg, client.Options{
+		Scheme: scheme.Scheme,
+		Cache:  &client.CacheOptions{Reader: memberCache},
+	})
+	Expect(err).ToNot(HaveOccurred())
+	return cachedMemberClient
+}
+
 // Note: each Ginkgo process must do the same setup; unlike our E2E tests, the integration
 // tests uses in-memory testing environments, and as a result cannot be shared across processes.
 var _ = BeforeSuite(func() {
@@ -316,8 +337,9 @@ var _ = BeforeSuite(func() {
 		"work-applier",
 		hubClient,
 		memberReservedNSName1,
+		DefaultTenant,
 		memberDynamicClient1,
-		memberClient1,
+		newCachedMemberClient(memberCfg1),
 		memberClient1.RESTMapper(),
 		hubMgr1.GetEventRecorderFor("work-applier"),
 		maxConcurrentReconciles,
@@ -327,6 +349,11 @@ var _ = BeforeSuite(func() {
 		usePriorityQueue,
 		nil, // Use the default priority linear equation coefficients.
 		nil, // Use the default priority linear equation coefficients.
+		nil, // Do not use any post-apply hooks.
+		nil, // Do not record an audit trail.
+		nil, // Do not shard Work objects.
+		nil, // Do not transform manifests.
+		0,   // Do not watch for deletions.
 	)
 	Expect(workApplier1.SetupWithManager(hubMgr1)).To(Succeed())
 
@@ -367,8 +394,9 @@ var _ = BeforeSuite(func() {
 		"work-applier-long-backoff",
 		hubClient,
 		memberReservedNSName2,
+		DefaultTenant,
 		memberDynamicClient2,
-		memberClient2,
+		newCachedMemberClient(memberCfg2),
 		memberClient2.RESTMapper(),
 		hubMgr2.GetEventRecorderFor("work-applier-long-backoff"),
 		maxConcurrentReconciles,
@@ -378,6 +406,11 @@ var _ = BeforeSuite(func() {
 		usePriorityQueue,
 		nil, // Use the default priority linear equation coefficients.
 		nil, // Use the default priority linear equation coefficients.
+		nil, // Do not use any post-apply hooks.
+		nil, // Do not record an audit trail.
+		nil, // Do not shard Work objects.
+		nil, // Do not transform manifests.
+		0,   // Do not watch for deletions.
 	)
 	Expect(workApplier2.SetupWithManager(hubMgr2)).To(Succeed())
 
@@ -406,8 +439,9 @@ var _ = BeforeSuite(func() {
 		"work-applier-waved-parallel-processing",
 		hubClient,
 		memberReservedNSName3,
+		DefaultTenant,
 		memberDynamicClient3,
-		memberClient3,
+		newCachedMemberClient(memberCfg3),
 		memberClient3.RESTMapper(),
 		hubMgr3.GetEventRecorderFor("work-applier"),
 		maxConcurrentReconciles,
@@ -417,6 +451,11 @@ var _ = BeforeSuite(func() {
 		usePriorityQueue,
 		nil, // Use the default priority linear equation coefficients.
 		nil, // Use the default priority linear equation coefficients.
+		nil, // Do not use any post-apply hooks.
+		nil, // Do not record an audit trail.
+		nil, // Do not shard Work objects.
+		nil, // Do not transform manifests.
+		0,   // Do not watch for deletions.
 	)
 	Expect(workApplier3.SetupWithManager(hubMgr3)).To(Succeed())
 
@@ -437,12 +476,13 @@ var _ = BeforeSuite(func() {
 
 	wrappedHubClient := NewClientWrapperWithStatusUpdateCounter(hubClient)
 	hubClientWrapperForWorkApplier4 = wrappedHubClient.(*clientWrapperWithStatusUpdateCounter)
-	wrappedMemberClient4 := NewClientWrapperWithStatusUpdateCounter(memberClient4)
+	wrappedMemberClient4 := NewClientWrapperWithStatusUpdateCounter(newCachedMemberClient(memberCfg4))
 	memberClient4Wrapper = wrappedMemberClient4.(*clientWrapperWithStatusUpdateCounter)
 	workApplier4 = NewReconciler(
 		"work-applier-wrapped-client",
 		wrappedHubClient,
 		memberReservedNSName4,
+		DefaultTenant,
 		memberDynamicClient4,
 		wrappedMemberClient4,
 		memberClient4.RESTMapper(),
@@ -454,6 +494,11 @@ var _ = BeforeSuite(func() {
 		usePriorityQueue,
 		nil, // Use the default priority linear equation coefficients.
 		nil, // Use the default priority linear equation coefficients.
+		nil, // Do not use any post-apply hooks.
+		nil, // Do not record an audit trail.
+		nil, // Do not shard Work objects.
+		nil, // Do not transform manifests.
+		0,   // Do not watch for deletions.
 	)
 	// Due to name conflicts, the third work applier must be set up manually.
 	Expect(workApplier4.SetupWithManager(hubMgr4)).To(Succeed())
@@ -0,0 +1,83 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"fmt"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// DefaultTenant is the tenant that the work applier serves when the member agent runs for
+	// a single tenant, i.e., the member cluster itself.
+	DefaultTenant = "default"
+)
+
+// TenantReconcilers are the work appliers of all the tenants that a member agent serves, each of
+// which processes the Work objects from the hub cluster namespace of its tenant.
+//
+// The work appliers join and leave the fleet together, as the member cluster (not an individual
+// tenant) is the unit of fleet membership.
+type TenantReconcilers []*Reconciler
+
+// Join marks all the work appliers as joined.
+func (rs TenantReconcilers) Join(ctx context.Context) error {
+	for _, r := range rs {
+		if err := r.Join(ctx); err != nil {
+			return fmt.Errorf("failed to join the work applier of tenant %s: %w", r.tenant, err)
+		}
+	}
+	return nil
+}
+
+// Leave marks all the work appliers as left; each work applier strips the Work objects of its
+// tenant off their finalizers.
+func (rs TenantReconcilers) Leave(ctx context.Context) error {
+	for _, r := range rs {
+		if err := r.Leave(ctx); err != nil {
+			return fmt.Errorf("failed to leave the work applier of tenant %s: %w", r.tenant, err)
+		}
+	}
+	return nil
+}
+
+// SetApplyConcurrency sets the apply concurrency of each work applier, and returns the lowest
+// concurrency in effect.
+func (rs TenantReconcilers) SetApplyConcurrency(concurrency *int32) int32 {
+	var effective int32
+	for idx, r := range rs {
+		c := r.SetApplyConcurrency(concurrency)
+		if idx == 0 || c < effective {
+			effective = c
+		}
+	}
+	return effective
+}
+
+// appliedWorkNameOf returns the name of the AppliedWork object of a Work object.
+//
+// AppliedWork objects are cluster-scoped; to keep the ones of different tenants apart, their names
+// are prefixed with the tenant names. The AppliedWork objects of the member cluster itself keep the
+// names of their Work objects, so that the ones created before tenants were introduced are reused.
+func (r *Reconciler) appliedWorkNameOf(work *fleetv1beta1.Work) string {
+	if r.tenant == "" || r.tenant == DefaultTenant {
+		return work.Name
+	}
+	return fmt.Sprintf("%s.%s", r.tenant, work.Name)
+}
@@ -0,0 +1,125 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"testing"
+
+	"go.uber.org/atomic"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// TestTenantReconcilersSetApplyConcurrency tests the SetApplyConcurrency method of TenantReconcilers.
+func TestTenantReconcilersSetApplyConcurrency(t *testing.T) {
+	testCases := []struct {
+		name        string
+		concurrency *int32
+		want        int32
+		wantLimits  []int
+	}{
+		{
+			name:       "not set",
+			want:       2,
+			wantLimits: []int{5, 2},
+		},
+		{
+			name:        "throttled",
+			concurrency: ptr.To(int32(3)),
+			want:        2,
+			wantLimits:  []int{3, 2},
+		},
+		{
+			name:        "throttled below all",
+			concurrency: ptr.To(int32(1)),
+			want:        1,
+			wantLimits:  []int{1, 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			rs := TenantReconcilers{
+				{concurrentReconciles: 5, applyLimiter: newApplyConcurrencyLimiter(5)},
+				{concurrentReconciles: 2, applyLimiter: newApplyConcurrencyLimiter(2)},
+			}
+			if got := rs.SetApplyConcurrency(tc.concurrency); got != tc.want {
+				t.Errorf("SetApplyConcurrency() = %d, want %d", got, tc.want)
+			}
+			for idx, r := range rs {
+				if got := r.applyLimiter.limit; got != tc.wantLimits[idx] {
+					t.Errorf("applyLimiter.limit of reconciler %d = %d, want %d", idx, got, tc.wantLimits[idx])
+				}
+			}
+		})
+	}
+}
+
+// TestTenantReconcilersJoin tests the Join method of TenantReconcilers.
+func TestTenantReconcilersJoin(t *testing.T) {
+	rs := TenantReconcilers{
+		{tenant: DefaultTenant, joined: atomic.NewBool(false)},
+		{tenant: "tenant-1", joined: atomic.NewBool(false)},
+	}
+	if err := rs.Join(context.Background()); err != nil {
+		t.Fatalf("Join() = %v, want no error", err)
+	}
+	for _, r := range rs {
+		if !r.joined.Load() {
+			t.Errorf("work applier of tenant %s joined = false, want true", r.tenant)
+		}
+	}
+}
+
+// TestAppliedWorkNameOf tests the appliedWorkNameOf method.
+func TestAppliedWorkNameOf(t *testing.T) {
+	work := &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workName,
+			Namespace: memberReservedNSName1,
+		},
+	}
+
+	testCases := []struct {
+		name   string
+		tenant string
+		want   string
+	}{
+		{
+			name:   "default tenant",
+			tenant: DefaultTenant,
+			want:   workName,
+		},
+		{
+			name:   "tenant",
+			tenant: "tenant-a",
+			want:   "tenant-a." + workName,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Reconciler{tenant: tc.tenant}
+			if got := r.appliedWorkNameOf(work); got != tc.want {
+				t.Errorf("appliedWorkNameOf() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,195 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// unixSocketManifestTransformerResponseBodyLimitBytes is the maximum number of bytes the work
+	// applier will read from the response body of a socket-based manifest transformer.
+	unixSocketManifestTransformerResponseBodyLimitBytes = 4 * 1024 * 1024
+)
+
+var (
+	// errInvalidTransformation is returned when a manifest transformer has returned an object that
+	// cannot be applied in place of the original one.
+	errInvalidTransformation = errors.New("invalid transformation")
+)
+
+// ManifestTransformer is a transformer that the work applier runs on each manifest object before
+// it is applied to (or compared with) the member cluster, e.g., to rewrite container image
+// registries to a local mirror.
+//
+// Transformers run in the order they are configured, each receiving the output of the previous
+// one; a transformer must not change the API group, version, kind, namespace, or name of the
+// object. Transformers run every time a Work object is processed, and as such, should be
+// deterministic.
+type ManifestTransformer interface {
+	// Name returns the name of the transformer, which is used in the Work object status.
+	Name() string
+	// Transform returns the transformed manifest object. The given object must not be modified.
+	Transform(ctx context.Context, work *fleetv1beta1.Work, manifestObj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+}
+
+// sensitiveFieldsMarker is implemented by the manifest transformers that reveal sensitive data in
+// the manifest objects (e.g., by decrypting them); the drifts and differences found in the marked
+// fields are redacted in the Work object status, so that the data is not reported back to the hub
+// cluster.
+type sensitiveFieldsMarker interface {
+	// sensitivePaths returns the JSON pointers of the fields in which the transformer reveals sensitive
+	// data, given the object before the transformation; a path segment of * matches all the items of
+	// an array.
+	sensitivePaths(manifestObj *unstructured.Unstructured) []string
+}
+
+// manifestTransformerRequest is the payload the work applier sends to a socket-based manifest transformer.
+type manifestTransformerRequest struct {
+	WorkNamespace string                     `json:"workNamespace"`
+	WorkName      string                     `json:"workName"`
+	Object        *unstructured.Unstructured `json:"object"`
+}
+
+// manifestTransformerResponse is the payload a socket-based manifest transformer responds with.
+type manifestTransformerResponse struct {
+	Object *unstructured.Unstructured `json:"object"`
+}
+
+// unixSocketManifestTransformer is a manifest transformer that delegates the transformation to a
+// local process (e.g., a local webhook), which listens on a Unix domain socket, via a HTTP POST request.
+type unixSocketManifestTransformer struct {
+	name   string
+	client *unixSocketJSONClient
+}
+
+var _ ManifestTransformer = &unixSocketManifestTransformer{}
+
+// NewUnixSocketManifestTransformer returns a manifest transformer that sends a HTTP POST request,
+// which carries a manifest object, to a local process listening on the given Unix domain socket.
+// The process is expected to respond with a 2XX status code and the transformed object within
+// the given timeout.
+func NewUnixSocketManifestTransformer(name, socketPath string, timeout time.Duration) ManifestTransformer {
+	return &unixSocketManifestTransformer{
+		name:   name,
+		client: newUnixSocketJSONClient("transformer", socketPath, timeout),
+	}
+}
+
+// Name returns the name of the transformer.
+func (t *unixSocketManifestTransformer) Name() string {
+	return t.name
+}
+
+// Transform sends the manifest object to the local process for transformation.
+func (t *unixSocketManifestTransformer) Transform(ctx context.Context, work *fleetv1beta1.Work, manifestObj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	transformed := &manifestTransformerResponse{}
+	err := t.client.post(ctx, &manifestTransformerRequest{
+		WorkNamespace: work.Namespace,
+		WorkName:      work.Name,
+		Object:        manifestObj,
+	}, transformed, unixSocketManifestTransformerResponseBodyLimitBytes)
+	var decodeErr *unixSocketJSONDecodeError
+	switch {
+	case errors.As(err, &decodeErr):
+		return nil, fmt.Errorf("%w: %w", errInvalidTransformation, err)
+	case err != nil:
+		return nil, err
+	}
+	if transformed.Object == nil {
+		return nil, fmt.Errorf("%w: the transformer response has no object", errInvalidTransformation)
+	}
+	return transformed.Object, nil
+}
+
+// transformManifestsIfApplicable runs the manifest transformers (if any) on all the manifest
+// objects; the transformed objects are what the work applier applies to (or compares with) the
+// member cluster.
+func (r *Reconciler) transformManifestsIfApplicable(ctx context.Context, bundles []*manifestProcessingBundle, work *fleetv1beta1.Work) {
+	if len(r.manifestTransformers) == 0 {
+		return
+	}
+
+	doWork := func(piece int) {
+		bundle := bundles[piece]
+		if bundle.applyOrReportDiffErr != nil || bundle.manifestObj == nil {
+			// Skip the manifests that have failed pre-processing.
+			return
+		}
+
+		transformed, sensitivePaths, err := r.transformManifest(ctx, work, bundle.manifestObj)
+		if err != nil {
+			klog.ErrorS(err, "Failed to transform the manifest", "manifestObj", klog.KObj(bundle.manifestObj), "work", klog.KObj(work))
+			bundle.applyOrReportDiffErr = err
+			bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeFailedToTransform
+			if errors.Is(err, errInvalidTransformation) {
+				bundle.applyOrReportDiffResTyp = ApplyOrReportDiffResTypeInvalidTransformation
+			}
+			return
+		}
+		bundle.manifestObj = transformed
+		bundle.sensitivePaths = sensitivePaths
+	}
+	r.parallelizer.ParallelizeUntil(ctx, len(bundles), doWork, "transformingManifests")
+}
+
+// transformManifest runs all the manifest transformers on a manifest object, and returns the
+// transformed object along with the paths of the fields in which the transformers have revealed
+// sensitive data.
+func (r *Reconciler) transformManifest(ctx context.Context, work *fleetv1beta1.Work, manifestObj *unstructured.Unstructured) (*unstructured.Unstructured, []string, error) {
+	transformed := manifestObj
+	var sensitivePaths []string
+	for _, transformer := range r.manifestTransformers {
+		output, err := transformer.Transform(ctx, work, transformed)
+		if err != nil {
+			return nil, nil, fmt.Errorf("manifest transformer %s has failed: %w", transformer.Name(), err)
+		}
+		if err := validateTransformedManifest(transformed, output); err != nil {
+			return nil, nil, fmt.Errorf("manifest transformer %s has failed: %w", transformer.Name(), err)
+		}
+		if marker, ok := transformer.(sensitiveFieldsMarker); ok {
+			sensitivePaths = append(sensitivePaths, marker.sensitivePaths(transformed)...)
+		}
+		klog.V(2).InfoS("Transformed a manifest", "manifestObj", klog.KObj(manifestObj), "work", klog.KObj(work), "transformer", transformer.Name())
+		transformed = output
+	}
+	return transformed, sensitivePaths, nil
+}
+
+// validateTransformedManifest verifies that a transformation keeps the identity (the API group,
+// version, kind, namespace, and name) of a manifest object, so that the work applier can still
+// track it.
+func validateTransformedManifest(original, transformed *unstructured.Unstructured) error {
+	originalGVK := original.GroupVersionKind()
+	transformedGVK := transformed.GroupVersionKind()
+	if originalGVK != transformedGVK ||
+		original.GetNamespace() != transformed.GetNamespace() ||
+		original.GetName() != transformed.GetName() {
+		return fmt.Errorf("%w: the object identity has changed from %s %s to %s %s",
+			errInvalidTransformation,
+			originalGVK, klog.KObj(original), transformedGVK, klog.KObj(transformed))
+	}
+	return nil
+}
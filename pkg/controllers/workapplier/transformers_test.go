@@ -0,0 +1,300 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net"
+	"net/http"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
+)
+
+// fakeManifestTransformer is a manifest transformer for testing purposes.
+type fakeManifestTransformer struct {
+	name      string
+	transform func(obj *unstructured.Unstructured) (*unstructured.Unstructured, error)
+}
+
+func (t *fakeManifestTransformer) Name() string {
+	return t.name
+}
+
+func (t *fakeManifestTransformer) Transform(_ context.Context, _ *fleetv1beta1.Work, obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	return t.transform(obj)
+}
+
+// fakeSensitiveManifestTransformer is a manifest transformer that marks the fields it reveals as
+// sensitive, for testing purposes.
+type fakeSensitiveManifestTransformer struct {
+	fakeManifestTransformer
+	paths []string
+}
+
+func (t *fakeSensitiveManifestTransformer) sensitivePaths(_ *unstructured.Unstructured) []string {
+	return t.paths
+}
+
+// withLabel returns a transform func that adds a label to the object.
+func withLabel(key, value string) func(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	return func(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+		transformed := obj.DeepCopy()
+		labels := transformed.GetLabels()
+		if labels == nil {
+			labels = map[string]string{}
+		}
+		labels[key] = value
+		transformed.SetLabels(labels)
+		return transformed, nil
+	}
+}
+
+// TestTransformManifestsIfApplicable tests the transformManifestsIfApplicable method.
+func TestTransformManifestsIfApplicable(t *testing.T) {
+	work := &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workName,
+			Namespace: memberReservedNSName1,
+		},
+	}
+
+	testCases := []struct {
+		name               string
+		transformers       []ManifestTransformer
+		wantResTypes       []ManifestProcessingApplyOrReportDiffResultType
+		wantLabels         []map[string]string
+		wantSensitivePaths [][]string
+	}{
+		{
+			name:         "no transformers",
+			wantResTypes: []ManifestProcessingApplyOrReportDiffResultType{"", ApplyOrReportDiffResTypeDecodingErred},
+			wantLabels:   []map[string]string{nil, nil},
+		},
+		{
+			name: "transformers run in order",
+			transformers: []ManifestTransformer{
+				&fakeManifestTransformer{name: "first", transform: withLabel("mirror", "first")},
+				&fakeManifestTransformer{name: "second", transform: withLabel("mirror", "second")},
+			},
+			wantResTypes: []ManifestProcessingApplyOrReportDiffResultType{"", ApplyOrReportDiffResTypeDecodingErred},
+			wantLabels:   []map[string]string{{"mirror": "second"}, nil},
+		},
+		{
+			name: "transformer reveals sensitive data",
+			transformers: []ManifestTransformer{
+				&fakeSensitiveManifestTransformer{
+					fakeManifestTransformer: fakeManifestTransformer{name: "decrypter", transform: withLabel("decrypted", "true")},
+					paths:                   []string{"/spec/template/spec/containers/*/env/*/value"},
+				},
+				&fakeManifestTransformer{name: "mirror", transform: withLabel("mirror", "local")},
+			},
+			wantResTypes:       []ManifestProcessingApplyOrReportDiffResultType{"", ApplyOrReportDiffResTypeDecodingErred},
+			wantLabels:         []map[string]string{{"decrypted": "true", "mirror": "local"}, nil},
+			wantSensitivePaths: [][]string{{"/spec/template/spec/containers/*/env/*/value"}, nil},
+		},
+		{
+			name: "transformer fails",
+			transformers: []ManifestTransformer{
+				&fakeManifestTransformer{name: "first", transform: func(_ *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					return nil, errors.New("connection refused")
+				}},
+			},
+			wantResTypes: []ManifestProcessingApplyOrReportDiffResultType{ApplyOrReportDiffResTypeFailedToTransform, ApplyOrReportDiffResTypeDecodingErred},
+			wantLabels:   []map[string]string{nil, nil},
+		},
+		{
+			name: "transformer changes the object identity",
+			transformers: []ManifestTransformer{
+				&fakeManifestTransformer{name: "first", transform: func(obj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+					transformed := obj.DeepCopy()
+					transformed.SetName("renamed")
+					return transformed, nil
+				}},
+			},
+			wantResTypes: []ManifestProcessingApplyOrReportDiffResultType{ApplyOrReportDiffResTypeInvalidTransformation, ApplyOrReportDiffResTypeDecodingErred},
+			wantLabels:   []map[string]string{nil, nil},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Reconciler{
+				parallelizer:         parallelizer.NewParallelizer(2),
+				manifestTransformers: tc.transformers,
+			}
+			bundles := []*manifestProcessingBundle{
+				{
+					manifestObj: deployUnstructured.DeepCopy(),
+				},
+				{
+					// A manifest that has failed pre-processing.
+					applyOrReportDiffErr:    errors.New("failed to decode"),
+					applyOrReportDiffResTyp: ApplyOrReportDiffResTypeDecodingErred,
+				},
+			}
+
+			r.transformManifestsIfApplicable(context.Background(), bundles, work)
+
+			gotResTypes := make([]ManifestProcessingApplyOrReportDiffResultType, 0, len(bundles))
+			gotLabels := make([]map[string]string, 0, len(bundles))
+			gotSensitivePaths := make([][]string, 0, len(bundles))
+			for _, bundle := range bundles {
+				gotResTypes = append(gotResTypes, bundle.applyOrReportDiffResTyp)
+				gotSensitivePaths = append(gotSensitivePaths, bundle.sensitivePaths)
+				if bundle.manifestObj == nil {
+					gotLabels = append(gotLabels, nil)
+					continue
+				}
+				gotLabels = append(gotLabels, bundle.manifestObj.GetLabels())
+			}
+			if diff := cmp.Diff(gotResTypes, tc.wantResTypes); diff != "" {
+				t.Errorf("transformManifestsIfApplicable() result types mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(gotLabels, tc.wantLabels); diff != "" {
+				t.Errorf("transformManifestsIfApplicable() labels mismatch (-got, +want):\n%s", diff)
+			}
+			wantSensitivePaths := tc.wantSensitivePaths
+			if wantSensitivePaths == nil {
+				wantSensitivePaths = make([][]string, len(bundles))
+			}
+			if diff := cmp.Diff(gotSensitivePaths, wantSensitivePaths); diff != "" {
+				t.Errorf("transformManifestsIfApplicable() sensitive paths mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestUnixSocketManifestTransformer tests the socket-based manifest transformer.
+func TestUnixSocketManifestTransformer(t *testing.T) {
+	work := &fleetv1beta1.Work{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      workName,
+			Namespace: memberReservedNSName1,
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		statusCode       int
+		respondWith      string
+		wantImage        string
+		wantErred        bool
+		wantInvalid      bool
+		wantErrMsgSubStr string
+	}{
+		{
+			name:       "transformer succeeds",
+			statusCode: http.StatusOK,
+			wantImage:  "mirror.example.com/nginx",
+		},
+		{
+			name:             "transformer fails",
+			statusCode:       http.StatusInternalServerError,
+			respondWith:      "registry mirror is down",
+			wantErred:        true,
+			wantErrMsgSubStr: "transformer responded with status code 500: registry mirror is down",
+		},
+		{
+			name:             "transformer responds with no object",
+			statusCode:       http.StatusOK,
+			respondWith:      "{}",
+			wantErred:        true,
+			wantInvalid:      true,
+			wantErrMsgSubStr: "the transformer response has no object",
+		},
+		{
+			name:             "transformer responds with a malformed payload",
+			statusCode:       http.StatusOK,
+			respondWith:      "not a JSON payload",
+			wantErred:        true,
+			wantInvalid:      true,
+			wantErrMsgSubStr: "invalid transformation: failed to decode the transformer response",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			socketPath := filepath.Join(t.TempDir(), "transformer.sock")
+			listener, err := net.Listen("unix", socketPath)
+			if err != nil {
+				t.Fatalf("failed to listen on the socket: %v", err)
+			}
+
+			server := &http.Server{
+				Handler: http.HandlerFunc(func(w http.ResponseWriter, req *http.Request) {
+					var gotReq manifestTransformerRequest
+					if err := json.NewDecoder(req.Body).Decode(&gotReq); err != nil || gotReq.WorkName != workName {
+						w.WriteHeader(http.StatusBadRequest)
+						return
+					}
+					w.WriteHeader(tc.statusCode)
+					if tc.respondWith != "" {
+						_, _ = w.Write([]byte(tc.respondWith))
+						return
+					}
+					// Rewrite the container image registry.
+					obj := gotReq.Object
+					containers, _, _ := unstructured.NestedSlice(obj.Object, "spec", "template", "spec", "containers")
+					container := containers[0].(map[string]interface{})
+					container["image"] = "mirror.example.com/" + container["image"].(string)
+					_ = unstructured.SetNestedSlice(obj.Object, containers, "spec", "template", "spec", "containers")
+					_ = json.NewEncoder(w).Encode(&manifestTransformerResponse{Object: obj})
+				}),
+				ReadHeaderTimeout: time.Second,
+			}
+			go func() {
+				_ = server.Serve(listener)
+			}()
+			t.Cleanup(func() {
+				_ = server.Close()
+			})
+
+			transformer := NewUnixSocketManifestTransformer("unix-socket", socketPath, 5*time.Second)
+			got, err := transformer.Transform(context.Background(), work, deployUnstructured.DeepCopy())
+			if tc.wantErred {
+				if err == nil {
+					t.Fatalf("Transform() = nil, want erred")
+				}
+				if !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Errorf("Transform() error = %v, want error msg with sub-string %s", err, tc.wantErrMsgSubStr)
+				}
+				if gotInvalid := errors.Is(err, errInvalidTransformation); gotInvalid != tc.wantInvalid {
+					t.Errorf("Transform() error is invalid transformation = %t, want %t", gotInvalid, tc.wantInvalid)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Transform() = %v, want no error", err)
+			}
+			containers, _, _ := unstructured.NestedSlice(got.Object, "spec", "template", "spec", "containers")
+			if gotImage := containers[0].(map[string]interface{})["image"]; gotImage != tc.wantImage {
+				t.Errorf("Transform() image = %v, want %s", gotImage, tc.wantImage)
+			}
+		})
+	}
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workapplier
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"time"
+)
+
+const (
+	// unixSocketErrBodyLimitBytes is the maximum number of bytes of the response body from a local
+	// process used for composing error messages.
+	unixSocketErrBodyLimitBytes = 512
+)
+
+// unixSocketJSONClient sends JSON payloads via HTTP POST requests to a local process listening on a
+// Unix domain socket; it backs the socket-based post-apply hooks, manifest transformers, and SOPS
+// data key decrypters.
+type unixSocketJSONClient struct {
+	// kind describes the local process (e.g., hook) in error messages.
+	kind       string
+	socketPath string
+	client     *http.Client
+}
+
+// unixSocketJSONDecodeError is returned when the response of the local process cannot be decoded.
+type unixSocketJSONDecodeError struct {
+	kind string
+	err  error
+}
+
+func (e *unixSocketJSONDecodeError) Error() string {
+	return fmt.Sprintf("failed to decode the %s response: %v", e.kind, e.err)
+}
+
+func (e *unixSocketJSONDecodeError) Unwrap() error {
+	return e.err
+}
+
+// newUnixSocketJSONClient returns a client that talks to the local process listening on the given
+// Unix domain socket; each request must complete within the given timeout.
+func newUnixSocketJSONClient(kind, socketPath string, timeout time.Duration) *unixSocketJSONClient {
+	dialer := &net.Dialer{}
+	return &unixSocketJSONClient{
+		kind:       kind,
+		socketPath: socketPath,
+		client: &http.Client{
+			Timeout: timeout,
+			Transport: &http.Transport{
+				DialContext: func(ctx context.Context, _, _ string) (net.Conn, error) {
+					return dialer.DialContext(ctx, "unix", socketPath)
+				},
+			},
+		},
+	}
+}
+
+// post sends the request payload to the local process, which is expected to respond with a 2XX
+// status code. If respPayload is not nil, the response body, of which at most respBodyLimitBytes
+// bytes are read, is decoded into it.
+func (c *unixSocketJSONClient) post(ctx context.Context, reqPayload, respPayload interface{}, respBodyLimitBytes int64) error {
+	payload, err := json.Marshal(reqPayload)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the %s request: %w", c.kind, err)
+	}
+
+	// The host part of the URL is ignored as the transport always dials the Unix domain socket.
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, "http://localhost/", bytes.NewReader(payload))
+	if err != nil {
+		return fmt.Errorf("failed to prepare the %s request: %w", c.kind, err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to send the %s request to socket %s: %w", c.kind, c.socketPath, err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, unixSocketErrBodyLimitBytes))
+		return fmt.Errorf("%s responded with status code %d: %s", c.kind, resp.StatusCode, string(body))
+	}
+
+	if respPayload == nil {
+		return nil
+	}
+	if err := json.NewDecoder(io.LimitReader(resp.Body, respBodyLimitBytes)).Decode(respPayload); err != nil {
+		return &unixSocketJSONDecodeError{kind: c.kind, err: err}
+	}
+	return nil
+}
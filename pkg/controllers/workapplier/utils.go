@@ -61,6 +61,28 @@ func isPlacedByFleetInDuplicate(ownerRefs []metav1.OwnerReference, expectedAppli
 	return false
 }
 
+// coOwnershipPolicyOf returns the co-ownership policy set in an apply strategy.
+func coOwnershipPolicyOf(applyStrategy *fleetv1beta1.ApplyStrategy) fleetv1beta1.CoOwnershipPolicyType {
+	if applyStrategy == nil || applyStrategy.CoOwnershipPolicy == "" {
+		return fleetv1beta1.CoOwnershipPolicyTypeDeny
+	}
+	return applyStrategy.CoOwnershipPolicy
+}
+
+// ownerRefsExcludingAppliedWorks returns the owner references that do not point to an AppliedWork
+// object.
+func ownerRefsExcludingAppliedWorks(ownerRefs []metav1.OwnerReference) []metav1.OwnerReference {
+	var res []metav1.OwnerReference
+	for idx := range ownerRefs {
+		ownerRef := ownerRefs[idx]
+		if ownerRef.APIVersion == fleetv1beta1.GroupVersion.String() && ownerRef.Kind == fleetv1beta1.AppliedWorkKind {
+			continue
+		}
+		res = append(res, ownerRef)
+	}
+	return res
+}
+
 // discardFieldsIrrelevantInComparisonFrom discards fields that are irrelevant when comparing
 // the manifest and live objects (or two manifest objects).
 //
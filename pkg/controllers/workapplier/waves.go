@@ -153,6 +153,14 @@ func organizeBundlesIntoProcessingWaves(bundles []*manifestProcessingBundle, wor
 			continue
 		}
 
+		if bundle.definingCRDBundle != nil {
+			// The manifest is a custom resource whose CRD is placed in the same Work object; it
+			// is processed after all the waves, once the CRD has been established.
+			klog.V(2).InfoS("Skipping a custom resource whose CRD is placed in the same work; no wave is assigned",
+				"manifestObj", klog.KObj(bundle.manifestObj), "work", workRef)
+			continue
+		}
+
 		waveNum := lastWave
 		defaultWaveNum, foundInDefaultWaveNumber := defaultWaveNumberByResourceType[bundle.gvr.Resource]
 		if foundInDefaultWaveNumber && knownAPIGroups.Has(bundle.gvr.Group) {
@@ -53,8 +53,10 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/events"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/informer"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/labels"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/overrider"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/resource"
 )
 
@@ -80,6 +82,19 @@ type Reconciler struct {
 	// the informer contains the cache for all the resources we need.
 	// to check the resource scope
 	InformerManager informer.Manager
+	// EnableResourcePlacement indicates whether the ResourcePlacement APIs are enabled; if so, the
+	// resource bindings are also considered when detecting co-owned resources.
+	EnableResourcePlacement bool
+	// EnableDiffReports indicates whether the complete lists of drifted and diffed placements of the
+	// bindings are written to DiffReport objects, in addition to the truncated lists in the binding status.
+	EnableDiffReports bool
+	// DiffedPlacementsSummaryThreshold is the number of diffed placements found with the ReportDiff apply
+	// strategy beyond which the binding status only keeps a summary of the diffed placements; the DiffReport
+	// objects of such bindings are then only written on demand. Zero disables the summaries.
+	DiffedPlacementsSummaryThreshold int
+	// OnDemandDiffReportTTL is how long the DiffReport objects written on demand are kept up-to-date before
+	// they are removed.
+	OnDemandDiffReportTTL time.Duration
 }
 
 // Reconcile triggers a single binding reconcile round.
@@ -132,6 +147,14 @@ func (r *Reconciler) Reconcile(ctx context.Context, req controllerruntime.Reques
 		return controllerruntime.Result{}, err
 	}
 
+	// Resolve the apply strategy to use on the target cluster; the binding spec is never written back
+	// from this point on, so the resolved apply strategy is kept on the binding object in memory for
+	// all the work generation steps to use.
+	if err := resolveApplyStrategyForCluster(resourceBinding, &cluster); err != nil {
+		klog.ErrorS(err, "Failed to resolve the apply strategy for the target cluster", "binding", bindingRef, "memberCluster", cluster.Name)
+		return controllerruntime.Result{}, err
+	}
+
 	// When the binding is in the unscheduled state, rollout controller won't update the condition anymore.
 	// We treat the unscheduled binding as bound until the rollout controller deletes the binding and here controller still
 	// updates the status for troubleshooting purpose.
@@ -152,6 +175,10 @@ func (r *Reconciler) Reconcile(ctx context.Context, req controllerruntime.Reques
 
 	workUpdated := false
 	overrideSucceeded := false
+	// the complete lists of drifted and diffed placements, which are only set if the binding status is
+	// refreshed based on the works.
+	var driftedPlacements []fleetv1beta1.DriftedResourcePlacement
+	var diffedPlacements []fleetv1beta1.DiffedResourcePlacement
 	// list all the corresponding works
 	works, syncErr := r.listAllWorksAssociated(ctx, resourceBinding)
 	if syncErr == nil {
@@ -165,6 +192,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req controllerruntime.Reques
 	resourceBinding.GetBindingStatus().FailedPlacements = nil
 	resourceBinding.GetBindingStatus().DriftedPlacements = nil
 	resourceBinding.GetBindingStatus().DiffedPlacements = nil
+	resourceBinding.GetBindingStatus().DiffedPlacementSummary = nil
+	// statusRefreshed is whether the binding status is refreshed based on the status of the works.
+	statusRefreshed := false
 	if overrideSucceeded {
 		overrideReason := condition.OverriddenSucceededReason
 		overrideMessage := "Successfully applied the override rules on the resources"
@@ -220,7 +250,9 @@ func (r *Reconciler) Reconcile(ctx context.Context, req controllerruntime.Reques
 		case !workUpdated:
 			// The Work object itself is unchanged; refresh the cluster resource binding status
 			// based on the status information reported on the Work object(s).
-			setBindingStatus(works, resourceBinding)
+			driftedPlacements, diffedPlacements = setBindingStatus(works, resourceBinding)
+			r.summarizeDiffedPlacements(resourceBinding, diffedPlacements)
+			statusRefreshed = true
 		case resourceBinding.GetBindingSpec().ApplyStrategy == nil || resourceBinding.GetBindingSpec().ApplyStrategy.Type != fleetv1beta1.ApplyStrategyTypeReportDiff:
 			// The Work object itself has changed; set a False Applied condition which signals
 			// that resources are in the process of being applied.
@@ -243,11 +275,39 @@ func (r *Reconciler) Reconcile(ctx context.Context, req controllerruntime.Reques
 			})
 		}
 	}
+	// Detect the resources that are also placed on the cluster by other placements; on failure, the
+	// condition is left as it is and the binding is requeued after its status is updated.
+	coOwnershipErr := r.syncCoOwnershipCondition(ctx, resourceBinding)
+	// Write the complete lists of drifted and diffed placements to the diff reports; on failure, the
+	// binding status does not refer to any diff report and the binding is requeued after its status is updated.
+	// If the diff reports are only written on demand, the binding is requeued when they expire.
+	var diffReportErr error
+	var diffReportExpiresIn time.Duration
+	switch {
+	case !r.EnableDiffReports:
+	case r.isDiffReportOnDemand(resourceBinding):
+		diffReportExpiresIn, diffReportErr = r.syncDiffReportsOnDemand(ctx, resourceBinding, driftedPlacements, diffedPlacements, statusRefreshed, time.Now())
+	default:
+		diffReportErr = r.syncDiffReports(ctx, resourceBinding, driftedPlacements, diffedPlacements)
+	}
+	// List the overrides in the order in which they are applied; on failure, the previously listed
+	// overrides are kept and the binding is requeued after its status is updated.
+	effectiveOverridesErr := r.syncEffectiveOverrides(ctx, resourceBinding)
+	// Compact the transitions of the binding into its rollout timeline.
+	recordBindingHistory(resourceBinding, metav1.Now())
+	// Remember the snapshots last applied on the cluster so that the rollout progress can be reported.
+	recordLastAppliedSnapshots(resourceBinding)
 
 	// update the resource binding status
 	if updateErr := r.updateBindingStatusWithRetry(ctx, resourceBinding); updateErr != nil {
 		return controllerruntime.Result{}, updateErr
 	}
+	// Remove the diff report request (if any) once the diff reports reflect the refreshed status.
+	if r.EnableDiffReports && statusRefreshed && diffReportErr == nil {
+		if err := r.removeDiffReportRequest(ctx, resourceBinding); err != nil {
+			return controllerruntime.Result{}, err
+		}
+	}
 	if errors.Is(syncErr, controller.ErrUserError) {
 		// Stop retry when the error is caused by user error
 		// For example, user provides an invalid overrides or cannot extract the resources from config map.
@@ -266,7 +326,16 @@ func (r *Reconciler) Reconcile(ctx context.Context, req controllerruntime.Reques
 	}
 	// requeue if we failed to sync the work
 	// If we update the works, their status will be changed and will be detected by the watch event.
-	return controllerruntime.Result{}, syncErr
+	if syncErr != nil {
+		return controllerruntime.Result{}, syncErr
+	}
+	if diffReportErr != nil {
+		return controllerruntime.Result{}, diffReportErr
+	}
+	if effectiveOverridesErr != nil {
+		return controllerruntime.Result{}, effectiveOverridesErr
+	}
+	return controllerruntime.Result{RequeueAfter: diffReportExpiresIn}, coOwnershipErr
 }
 
 // updateBindingStatusWithRetry sends the update request to API server with retry.
@@ -325,6 +394,18 @@ func (r *Reconciler) handleDelete(ctx context.Context, resourceBinding fleetv1be
 		return controllerruntime.Result{}, err
 	}
 
+	// Wait for the pre-delete probe (if any) to pass before removing the works, so that the traffic
+	// can be shifted away from the cluster before the resources are torn down.
+	if len(works) > 0 {
+		passed, requeueAfter, err := r.checkPreDeleteProbe(ctx, resourceBinding, works)
+		if err != nil {
+			return controllerruntime.Result{}, err
+		}
+		if !passed {
+			return controllerruntime.Result{RequeueAfter: requeueAfter}, nil
+		}
+	}
+
 	// Note: This controller cannot garbage collect all works automatically via background/foreground
 	// cascade deletion as the namespaces of work and resourceBinding are different
 	// and we don't set the ownerReference for the works.
@@ -334,6 +415,11 @@ func (r *Reconciler) handleDelete(ctx context.Context, resourceBinding fleetv1be
 			return controllerruntime.Result{}, controller.NewAPIServerError(false, err)
 		}
 	}
+	if r.EnableDiffReports {
+		if err := r.deleteDiffReports(ctx, resourceBinding); err != nil {
+			return controllerruntime.Result{}, err
+		}
+	}
 
 	// remove the work finalizer on the binding if all the work objects are deleted
 	if len(works) == 0 {
@@ -444,12 +530,23 @@ func (r *Reconciler) syncAllWork(ctx context.Context, resourceBinding fleetv1bet
 		return false, false, updateErr
 	}
 
+	placementSpec, err := r.fetchPlacementSpec(ctx, resourceBinding)
+	if err != nil {
+		return false, false, err
+	}
+	var metadataInjection *fleetv1beta1.MetadataInjectionPolicy
+	var nameTransform *fleetv1beta1.ResourceNameTransform
+	if placementSpec != nil {
+		metadataInjection = placementSpec.MetadataInjection
+		nameTransform = placementSpec.ResourceNameTransform
+	}
+
 	// the hash256 function can handle empty list https://go.dev/play/p/_4HW17fooXM
-	resourceOverrideSnapshotHash, err := resource.HashOf(resourceBinding.GetBindingSpec().ResourceOverrideSnapshots)
+	resourceOverrideSnapshotHash, err := hashOverrideSnapshots(resourceBinding.GetBindingSpec().ResourceOverrideSnapshots, resourceBinding.GetBindingSpec().ClusterPropertySnapshot, metadataInjection, nameTransform)
 	if err != nil {
 		return false, false, controller.NewUnexpectedBehaviorError(err)
 	}
-	clusterResourceOverrideSnapshotHash, err := resource.HashOf(resourceBinding.GetBindingSpec().ClusterResourceOverrideSnapshots)
+	clusterResourceOverrideSnapshotHash, err := hashOverrideSnapshots(resourceBinding.GetBindingSpec().ClusterResourceOverrideSnapshots, resourceBinding.GetBindingSpec().ClusterPropertySnapshot, metadataInjection, nameTransform)
 	if err != nil {
 		return false, false, controller.NewUnexpectedBehaviorError(err)
 	}
@@ -480,6 +577,13 @@ func (r *Reconciler) syncAllWork(ctx context.Context, resourceBinding fleetv1bet
 		return false, false, err
 	}
 
+	// The hash of the resource snapshot group is recorded on the master resource snapshot.
+	placementName := resourceBinding.GetLabels()[fleetv1beta1.PlacementTrackingLabel]
+	var resourceSnapshotHash string
+	if masterResourceSnapshot, ok := resourceSnapshots[resourceBinding.GetBindingSpec().ResourceSnapshotName]; ok {
+		resourceSnapshotHash = masterResourceSnapshot.GetAnnotations()[fleetv1beta1.ResourceGroupHashAnnotation]
+	}
+
 	// issue all the create/update requests for the corresponding works for each snapshot in parallel
 	activeWork := make(map[string]*fleetv1beta1.Work, len(resourceSnapshots))
 	errs, cctx = errgroup.WithContext(ctx)
@@ -497,7 +601,7 @@ func (r *Reconciler) syncAllWork(ctx context.Context, resourceBinding fleetv1bet
 		for j := range selectedRes {
 			selectedResource := selectedRes[j].DeepCopy()
 			// TODO: apply the override rules on the envelope resources by applying them on the work instead of the selected resource
-			resourceDeleted, overrideErr := r.applyOverrides(selectedResource, cluster, croMap, roMap)
+			resourceDeleted, overrideErr := r.applyOverrides(selectedResource, cluster, resourceBinding.GetBindingSpec().ClusterPropertySnapshot, croMap, roMap)
 			if overrideErr != nil {
 				return false, false, overrideErr
 			}
@@ -505,6 +609,21 @@ func (r *Reconciler) syncAllWork(ctx context.Context, resourceBinding fleetv1bet
 				klog.V(2).InfoS("The resource is deleted by the override rules", "snapshot", klog.KObj(snapshot), "selectedResource", selectedRes[j])
 				continue
 			}
+			// Rename the selected Job (if applicable) so that it is re-run per its re-run policy.
+			if err := applyJobRerunPolicy(selectedResource, snapshot.GetLabels()[fleetv1beta1.ResourceIndexLabel]); err != nil {
+				klog.ErrorS(err, "Failed to apply the job re-run policy", "snapshot", klog.KObj(snapshot), "selectedResource", selectedRes[j])
+				return true, false, err
+			}
+			// Inject the labels and annotations that attribute the resource to the placement (if applicable).
+			if err := injectPlacementMetadata(selectedResource, metadataInjection, placementName, resourceBinding.GetBindingSpec().TargetCluster, resourceSnapshotHash); err != nil {
+				klog.ErrorS(err, "Failed to inject the placement metadata", "snapshot", klog.KObj(snapshot), "selectedResource", selectedRes[j])
+				return true, false, err
+			}
+			// Rename the resource per the resource name transform of the placement (if applicable).
+			if err := transformResourceName(selectedResource, nameTransform, placementName, resourceBinding.GetBindingSpec().TargetCluster); err != nil {
+				klog.ErrorS(err, "Failed to transform the resource name", "snapshot", klog.KObj(snapshot), "selectedResource", selectedRes[j])
+				return true, false, err
+			}
 
 			// Process the selected resource.
 			//
@@ -649,6 +768,22 @@ func (r *Reconciler) processOneSelectedResource(
 	return newWork, simpleManifests, nil
 }
 
+// resolveApplyStrategyForCluster replaces the apply strategy on a binding object with the apply strategy
+// of the first apply strategy override that selects the target cluster, if any.
+func resolveApplyStrategyForCluster(resourceBinding fleetv1beta1.BindingObj, cluster *clusterv1beta1.MemberCluster) error {
+	bindingSpec := resourceBinding.GetBindingSpec()
+	if len(bindingSpec.ApplyStrategyOverrides) == 0 {
+		return nil
+	}
+	applyStrategy, err := overrider.PickApplyStrategyForCluster(cluster, bindingSpec.ApplyStrategy, bindingSpec.ApplyStrategyOverrides)
+	if err != nil {
+		// The cluster selectors have been validated by the webhook; normally this should never occur.
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+	bindingSpec.ApplyStrategy = applyStrategy
+	return nil
+}
+
 // syncApplyStrategy syncs the apply strategy specified on a binding object
 // to a Work object.
 func (r *Reconciler) syncApplyStrategy(
@@ -671,6 +806,23 @@ func (r *Reconciler) syncApplyStrategy(
 	return true, nil
 }
 
+// hashOverrideSnapshots returns the hash of the override snapshots associated with a binding.
+// The cluster property snapshot, the metadata injection policy and the resource name transform, if any, are
+// hashed together with the override snapshots, so that the works are regenerated when the snapshotted property
+// values, the injected metadata or the resource names change.
+func hashOverrideSnapshots(overrideSnapshots any, propertySnapshot *fleetv1beta1.ClusterPropertySnapshot,
+	metadataInjection *fleetv1beta1.MetadataInjectionPolicy, nameTransform *fleetv1beta1.ResourceNameTransform) (string, error) {
+	if propertySnapshot == nil && metadataInjection == nil && nameTransform == nil {
+		return resource.HashOf(overrideSnapshots)
+	}
+	return resource.HashOf(struct {
+		OverrideSnapshots     any                                   `json:"overrideSnapshots"`
+		PropertySnapshot      *fleetv1beta1.ClusterPropertySnapshot `json:"propertySnapshot"`
+		MetadataInjection     *fleetv1beta1.MetadataInjectionPolicy `json:"metadataInjection,omitempty"`
+		ResourceNameTransform *fleetv1beta1.ResourceNameTransform   `json:"resourceNameTransform,omitempty"`
+	}{overrideSnapshots, propertySnapshot, metadataInjection, nameTransform})
+}
+
 // areAllWorkSynced checks if all the works are synced with the resource binding.
 func areAllWorkSynced(existingWorks map[string]*fleetv1beta1.Work, resourceBinding fleetv1beta1.BindingObj, _, _ string) bool {
 	// If there is no existing work, they are not synced.
@@ -873,7 +1025,10 @@ const (
 )
 
 // setBindingStatus sets the binding status based on the works associated with the binding.
-func setBindingStatus(works map[string]*fleetv1beta1.Work, resourceBinding fleetv1beta1.BindingObj) {
+//
+// It returns the complete lists of drifted and diffed placements, of which the binding status only
+// keeps the first ones.
+func setBindingStatus(works map[string]*fleetv1beta1.Work, resourceBinding fleetv1beta1.BindingObj) ([]fleetv1beta1.DriftedResourcePlacement, []fleetv1beta1.DiffedResourcePlacement) {
 	bindingRef := klog.KObj(resourceBinding)
 
 	// Note (chenyu1): the work generator will refresh the status of a binding using
@@ -904,8 +1059,11 @@ func setBindingStatus(works map[string]*fleetv1beta1.Work, resourceBinding fleet
 		// the Applied condition is True.
 		availabilitySummarizedStatus = setAllWorkAvailableCondition(works, resourceBinding)
 	}
+	setAllWorkQuotaFitCondition(works, resourceBinding)
+	setAllWorkAdmissionPreflightPassedCondition(works, resourceBinding)
 
 	resourceBinding.GetBindingStatus().FailedPlacements = nil
+	resourceBinding.GetBindingStatus().FailedPlacementOverflowCount = 0
 	resourceBinding.GetBindingStatus().DiffedPlacements = nil
 	resourceBinding.GetBindingStatus().DriftedPlacements = nil
 	// collect and set the failed resource placements to the binding if not all the works are available
@@ -966,9 +1124,13 @@ func setBindingStatus(works map[string]*fleetv1beta1.Work, resourceBinding fleet
 			// In theory this would not happen as the Fleet work applier will always set the Applied and
 			// Available conditions together. However, Fleet can still handle this case for completeness reasons.
 			//
-			// In this case, set drifted placements; no failed or diffed placements will be set (availability
-			// check failure information might be incomplete or stale; diffs will only occur when there
-			// is an apply failure or the report diff mode is on).
+			// In this case, set drifted placements, plus failed placements of the manifests that have failed
+			// to apply if the failures are tolerated; no other failed placements or diffed placements will be set
+			// (availability check failure information might be incomplete or stale; diffs will only occur when
+			// there is an apply failure or the report diff mode is on).
+			failedManifests := extractFailedResourcePlacementsFromWork(w)
+			failedResourcePlacements = append(failedResourcePlacements, failedManifests...)
+
 			driftedManifests := extractDriftedResourcePlacementsFromWork(w)
 			driftedResourcePlacements = append(driftedResourcePlacements, driftedManifests...)
 		case availabilitySummarizedStatus == workConditionSummarizedStatusFalse:
@@ -986,22 +1148,30 @@ func setBindingStatus(works map[string]*fleetv1beta1.Work, resourceBinding fleet
 			// The ClientSideApply or ServerSideApply apply strategy is in use; all works have been applied
 			// and are available.
 			//
-			// In this case, set only drifted placements (drifts might occur even if the apply op itself
-			// completes); no failed or diffed placements will be set (apply/availability
-			// check failure and diffs will not occur when all works are applied and available).
+			// In this case, set drifted placements (drifts might occur even if the apply op itself
+			// completes), plus failed placements of the manifests that have failed to apply if the
+			// failures are tolerated; no diffed placements will be set (diffs will not occur when
+			// all works are applied and available).
+			failedManifests := extractFailedResourcePlacementsFromWork(w)
+			failedResourcePlacements = append(failedResourcePlacements, failedManifests...)
+
 			driftedManifests := extractDriftedResourcePlacementsFromWork(w)
 			driftedResourcePlacements = append(driftedResourcePlacements, driftedManifests...)
 		}
 	}
-	// cut the list to keep only the max limit
+	// cut the list to keep only the max limit, and record how many failed placements are left out
 	if len(failedResourcePlacements) > maxFailedResourcePlacementLimit {
+		resourceBinding.GetBindingStatus().FailedPlacementOverflowCount = int32(len(failedResourcePlacements) - maxFailedResourcePlacementLimit) //nolint:gosec // the number of manifests in works is bounded
 		failedResourcePlacements = failedResourcePlacements[0:maxFailedResourcePlacementLimit]
 	}
 	if len(failedResourcePlacements) > 0 {
 		resourceBinding.GetBindingStatus().FailedPlacements = failedResourcePlacements
-		klog.V(2).InfoS("Populated failed manifests", "binding", bindingRef, "numberOfFailedPlacements", len(failedResourcePlacements))
+		klog.V(2).InfoS("Populated failed manifests", "binding", bindingRef, "numberOfFailedPlacements", len(failedResourcePlacements), "failedPlacementOverflowCount", resourceBinding.GetBindingStatus().FailedPlacementOverflowCount)
 	}
 
+	// keep the complete lists before cutting them
+	allDriftedResourcePlacements, allDiffedResourcePlacements := driftedResourcePlacements, diffedResourcePlacements
+
 	// cut the list to keep only the max limit
 	if len(diffedResourcePlacements) > maxDiffedResourcePlacementLimit {
 		// Sort the slice
@@ -1027,6 +1197,7 @@ func setBindingStatus(works map[string]*fleetv1beta1.Work, resourceBinding fleet
 		resourceBinding.GetBindingStatus().DriftedPlacements = driftedResourcePlacements
 		klog.V(2).InfoS("Populated drifted manifests", "binding", bindingRef, "numberOfDriftedPlacements", len(driftedResourcePlacements))
 	}
+	return allDriftedResourcePlacements, allDiffedResourcePlacements
 }
 
 // setAllWorkAppliedCondition sets the Applied condition on a binding
@@ -1173,6 +1344,113 @@ func setAllWorkDiffReportedCondition(works map[string]*fleetv1beta1.Work, bindin
 	}
 }
 
+// setAllWorkQuotaFitCondition sets the QuotaFit condition on a binding based on the QuotaFit
+// conditions on all the related Work objects.
+//
+// The condition is set only when the quota preflight check is enabled in the apply strategy; it is
+// set to False if any of the Work objects has reported that its workloads would exceed the resource
+// quotas, and to True if all the Work objects have reported that their workloads fit. Otherwise,
+// the member agent has not completed the check yet and the condition is removed.
+func setAllWorkQuotaFitCondition(works map[string]*fleetv1beta1.Work, binding fleetv1beta1.BindingObj) {
+	applyStrategy := binding.GetBindingSpec().ApplyStrategy
+	if applyStrategy == nil || !applyStrategy.QuotaPreflightCheck || applyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff {
+		binding.RemoveCondition(string(fleetv1beta1.ResourceBindingQuotaFit))
+		return
+	}
+
+	areAllWorksChecked := true
+	var firstWorkExceedingQuota *fleetv1beta1.Work
+	for _, w := range works {
+		quotaFitCond := meta.FindStatusCondition(w.Status.Conditions, fleetv1beta1.WorkConditionTypeQuotaFit)
+		switch {
+		case condition.IsConditionStatusTrue(quotaFitCond, w.GetGeneration()):
+		case condition.IsConditionStatusFalse(quotaFitCond, w.GetGeneration()):
+			if firstWorkExceedingQuota == nil {
+				firstWorkExceedingQuota = w
+			}
+		default:
+			areAllWorksChecked = false
+		}
+	}
+
+	switch {
+	case firstWorkExceedingQuota != nil:
+		quotaFitCond := meta.FindStatusCondition(firstWorkExceedingQuota.Status.Conditions, fleetv1beta1.WorkConditionTypeQuotaFit)
+		klog.V(2).InfoS("Some works would exceed the resource quotas", "binding", klog.KObj(binding), "firstWorkExceedingQuota", klog.KObj(firstWorkExceedingQuota))
+		binding.SetConditions(metav1.Condition{
+			Status:             metav1.ConditionFalse,
+			Type:               string(fleetv1beta1.ResourceBindingQuotaFit),
+			Reason:             condition.WorkQuotaExceededReason,
+			Message:            fmt.Sprintf("Work object %s would exceed the resource quotas: %s", firstWorkExceedingQuota.Name, quotaFitCond.Message),
+			ObservedGeneration: binding.GetGeneration(),
+		})
+	case !areAllWorksChecked || len(works) == 0:
+		binding.RemoveCondition(string(fleetv1beta1.ResourceBindingQuotaFit))
+	default:
+		binding.SetConditions(metav1.Condition{
+			Status:             metav1.ConditionTrue,
+			Type:               string(fleetv1beta1.ResourceBindingQuotaFit),
+			Reason:             condition.AllWorkQuotaFitReason,
+			Message:            "The workloads of all corresponding work objects fit in the resource quotas",
+			ObservedGeneration: binding.GetGeneration(),
+		})
+	}
+}
+
+// setAllWorkAdmissionPreflightPassedCondition sets the AdmissionPreflightPassed condition on a binding
+// based on the AdmissionPreflightPassed conditions on all the related Work objects.
+//
+// The condition is set only when the admission preflight is enabled in the apply strategy; it is set to
+// False if any of the Work objects has reported that some manifests have been rejected by the admission
+// webhooks, and to True if all the Work objects have reported that their manifests pass. Otherwise,
+// the member agent has not completed the preflight yet and the condition is removed.
+func setAllWorkAdmissionPreflightPassedCondition(works map[string]*fleetv1beta1.Work, binding fleetv1beta1.BindingObj) {
+	applyStrategy := binding.GetBindingSpec().ApplyStrategy
+	if applyStrategy == nil || applyStrategy.AdmissionPreflight == "" || applyStrategy.AdmissionPreflight == fleetv1beta1.AdmissionPreflightTypeNever ||
+		applyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff {
+		binding.RemoveCondition(string(fleetv1beta1.ResourceBindingAdmissionPreflightPassed))
+		return
+	}
+
+	areAllWorksChecked := true
+	var firstRejectedWork *fleetv1beta1.Work
+	for _, w := range works {
+		preflightCond := meta.FindStatusCondition(w.Status.Conditions, fleetv1beta1.WorkConditionTypeAdmissionPreflightPassed)
+		switch {
+		case condition.IsConditionStatusTrue(preflightCond, w.GetGeneration()):
+		case condition.IsConditionStatusFalse(preflightCond, w.GetGeneration()):
+			if firstRejectedWork == nil {
+				firstRejectedWork = w
+			}
+		default:
+			areAllWorksChecked = false
+		}
+	}
+
+	switch {
+	case firstRejectedWork != nil:
+		preflightCond := meta.FindStatusCondition(firstRejectedWork.Status.Conditions, fleetv1beta1.WorkConditionTypeAdmissionPreflightPassed)
+		klog.V(2).InfoS("Some works have been rejected by the admission webhooks", "binding", klog.KObj(binding), "firstRejectedWork", klog.KObj(firstRejectedWork))
+		binding.SetConditions(metav1.Condition{
+			Status:             metav1.ConditionFalse,
+			Type:               string(fleetv1beta1.ResourceBindingAdmissionPreflightPassed),
+			Reason:             condition.WorkAdmissionPreflightFailedReason,
+			Message:            fmt.Sprintf("Work object %s has been rejected by the admission webhooks: %s", firstRejectedWork.Name, preflightCond.Message),
+			ObservedGeneration: binding.GetGeneration(),
+		})
+	case !areAllWorksChecked || len(works) == 0:
+		binding.RemoveCondition(string(fleetv1beta1.ResourceBindingAdmissionPreflightPassed))
+	default:
+		binding.SetConditions(metav1.Condition{
+			Status:             metav1.ConditionTrue,
+			Type:               string(fleetv1beta1.ResourceBindingAdmissionPreflightPassed),
+			Reason:             condition.AllWorkAdmissionPreflightPassedReason,
+			Message:            "The manifests of all corresponding work objects have passed the admission webhooks in a dry-run",
+			ObservedGeneration: binding.GetGeneration(),
+		})
+	}
+}
+
 // setAllWorkAvailableCondition sets the Available condition on a ClusterResourceBinding
 // based on the Available conditions on all the related Work objects.
 //
@@ -1296,8 +1574,14 @@ func extractFailedResourcePlacementsFromWork(work *fleetv1beta1.Work) []fleetv1b
 	// The applied condition and available condition are always updated in one call.
 	// It means the observedGeneration of these two are always the same.
 	// If IsConditionStatusFalse is true, means both are observing the latest work.
+	//
+	// Note that some manifests might have failed to apply even if the applied condition is true,
+	// when the failures are tolerated by the apply strategy.
+	areApplyFailuresTolerated := condition.IsConditionStatusTrue(appliedCond, work.Generation) &&
+		appliedCond.Reason == condition.WorkAppliedWithToleratedFailuresReason
 	if !condition.IsConditionStatusFalse(appliedCond, work.Generation) &&
-		!condition.IsConditionStatusFalse(availableCond, work.Generation) {
+		!condition.IsConditionStatusFalse(availableCond, work.Generation) &&
+		!areApplyFailuresTolerated {
 		return nil
 	}
 
@@ -1470,24 +1754,38 @@ func extractDiffedResourcePlacementsFromWork(work *fleetv1beta1.Work) []fleetv1b
 }
 
 // SetupWithManagerForClusterResourceBinding sets up the controller with the Manager.
-// It watches clusterResourceBinding events and also update/delete events for work.
+// It watches clusterResourceBinding events and also update/delete events for work, as well as the
+// co-ownership changes of the bindings that target the same clusters.
+//
+// Note that any binding spec change (e.g., a new resource snapshot name or apply strategy set by the rollout
+// controller) bumps the binding generation and thus triggers the work generator directly; there is no need to
+// route such changes through the binding watcher, which only watches the binding status.
 func (r *Reconciler) SetupWithManagerForClusterResourceBinding(mgr controllerruntime.Manager) error {
-	r.recorder = mgr.GetEventRecorderFor("cluster resource binding work generator")
-	return controllerruntime.NewControllerManagedBy(mgr).Named("cluster-resource-binding-work-generator").
+	r.recorder = events.NewRateLimitedRecorder(mgr.GetEventRecorderFor("cluster resource binding work generator"), events.DefaultDedupWindow)
+	b := controllerruntime.NewControllerManagedBy(mgr).Named("cluster-resource-binding-work-generator").
 		WithOptions(ctrl.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}). // set the max number of concurrent reconciles
-		For(&fleetv1beta1.ClusterResourceBinding{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		For(&fleetv1beta1.ClusterResourceBinding{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, diffReportRequestedPredicate))).
 		Watches(&fleetv1beta1.Work{}, workHandlerFuncs(true)).
-		Complete(r)
+		Watches(&fleetv1beta1.ClusterResourceBinding{}, r.coOwnershipHandlerFuncs(true)).
+		Watches(&fleetv1beta1.ClusterResourcePlacement{}, r.metadataInjectionHandlerFuncs())
+	if r.EnableResourcePlacement {
+		b = b.Watches(&fleetv1beta1.ResourceBinding{}, r.coOwnershipHandlerFuncs(true))
+	}
+	return b.Complete(r)
 }
 
 // SetupWithManagerForResourceBinding sets up the controller with the Manager.
-// It watches resourceBinding events and also update/delete events for work.
+// It watches resourceBinding events and also update/delete events for work, as well as the
+// co-ownership changes of the bindings that target the same clusters.
 func (r *Reconciler) SetupWithManagerForResourceBinding(mgr controllerruntime.Manager) error {
-	r.recorder = mgr.GetEventRecorderFor("resource binding work generator")
+	r.recorder = events.NewRateLimitedRecorder(mgr.GetEventRecorderFor("resource binding work generator"), events.DefaultDedupWindow)
 	return controllerruntime.NewControllerManagedBy(mgr).Named("resource-binding-work-generator").
 		WithOptions(ctrl.Options{MaxConcurrentReconciles: r.MaxConcurrentReconciles}). // set the max number of concurrent reconciles
-		For(&fleetv1beta1.ResourceBinding{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		For(&fleetv1beta1.ResourceBinding{}, builder.WithPredicates(predicate.Or(predicate.GenerationChangedPredicate{}, diffReportRequestedPredicate))).
 		Watches(&fleetv1beta1.Work{}, workHandlerFuncs(false)).
+		Watches(&fleetv1beta1.ResourceBinding{}, r.coOwnershipHandlerFuncs(false)).
+		Watches(&fleetv1beta1.ClusterResourceBinding{}, r.coOwnershipHandlerFuncs(false)).
+		Watches(&fleetv1beta1.ResourcePlacement{}, r.metadataInjectionHandlerFuncs()).
 		Complete(r)
 }
 
@@ -70,13 +70,14 @@ var (
 		utils.IgnoreConditionLTTAndMessageFields,
 		cmpopts.EquateEmpty(),
 	}
-	cmpConditionOption        = cmp.Options{cmpopts.SortSlices(utils.LessFuncFailedResourcePlacements), utils.IgnoreConditionLTTAndMessageFields, cmpopts.EquateEmpty()}
-	cmpConditionOptionWithLTT = cmp.Options{cmpopts.SortSlices(utils.LessFuncFailedResourcePlacements), cmpopts.EquateEmpty()}
+	ignoreBindingHistoryField = cmpopts.IgnoreFields(placementv1beta1.ResourceBindingStatus{}, "History")
+	cmpConditionOption        = cmp.Options{cmpopts.SortSlices(utils.LessFuncFailedResourcePlacements), utils.IgnoreConditionLTTAndMessageFields, cmpopts.EquateEmpty(), ignoreBindingHistoryField}
+	cmpConditionOptionWithLTT = cmp.Options{cmpopts.SortSlices(utils.LessFuncFailedResourcePlacements), cmpopts.EquateEmpty(), ignoreBindingHistoryField}
 
-	fakeFailedAppliedReason  = "fakeApplyFailureReason"
+	fakeFailedAppliedReason  = condition.ManifestApplyFailedReason
 	fakeFailedAppliedMessage = "fake apply failure message"
 
-	fakeFailedAvailableReason  = "fakeNotAvailableReason"
+	fakeFailedAvailableReason  = condition.WorkNotAvailableYetReason
 	fakeFailedAvailableMessage = "fake not available message"
 
 	// Define a specific time
@@ -36,6 +36,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
@@ -778,6 +779,216 @@ func TestSetAllWorkDiffReportedCondition(t *testing.T) {
 	}
 }
 
+func TestSetAllWorkQuotaFitCondition(t *testing.T) {
+	quotaFitWork := func(name string, status metav1.ConditionStatus) *fleetv1beta1.Work {
+		return &fleetv1beta1.Work{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Generation: 1,
+			},
+			Status: fleetv1beta1.WorkStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               fleetv1beta1.WorkConditionTypeQuotaFit,
+						Status:             status,
+						ObservedGeneration: 1,
+					},
+				},
+			},
+		}
+	}
+	testCases := []struct {
+		name                  string
+		applyStrategy         *fleetv1beta1.ApplyStrategy
+		works                 map[string]*fleetv1beta1.Work
+		wantQuotaFitCondition *metav1.Condition
+	}{
+		{
+			name:          "all works fit",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{QuotaPreflightCheck: true},
+			works: map[string]*fleetv1beta1.Work{
+				"work-1": quotaFitWork("work-1", metav1.ConditionTrue),
+				"work-2": quotaFitWork("work-2", metav1.ConditionTrue),
+			},
+			wantQuotaFitCondition: &metav1.Condition{
+				Status:             metav1.ConditionTrue,
+				Type:               string(fleetv1beta1.ResourceBindingQuotaFit),
+				Reason:             condition.AllWorkQuotaFitReason,
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name:          "one work exceeds, the other has not been checked yet",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{QuotaPreflightCheck: true},
+			works: map[string]*fleetv1beta1.Work{
+				"work-1": quotaFitWork("work-1", metav1.ConditionFalse),
+				"work-2": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "work-2",
+						Generation: 1,
+					},
+				},
+			},
+			wantQuotaFitCondition: &metav1.Condition{
+				Status:             metav1.ConditionFalse,
+				Type:               string(fleetv1beta1.ResourceBindingQuotaFit),
+				Reason:             condition.WorkQuotaExceededReason,
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name:          "one work has not been checked yet",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{QuotaPreflightCheck: true},
+			works: map[string]*fleetv1beta1.Work{
+				"work-1": quotaFitWork("work-1", metav1.ConditionTrue),
+				"work-2": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "work-2",
+						Generation: 2,
+					},
+					Status: quotaFitWork("work-2", metav1.ConditionTrue).Status,
+				},
+			},
+		},
+		{
+			name: "quota preflight check disabled",
+			works: map[string]*fleetv1beta1.Work{
+				"work-1": quotaFitWork("work-1", metav1.ConditionFalse),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			binding := &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "binding",
+					Generation: 1,
+				},
+				Spec: fleetv1beta1.ResourceBindingSpec{
+					ApplyStrategy: tc.applyStrategy,
+				},
+				Status: fleetv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(fleetv1beta1.ResourceBindingQuotaFit),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 0,
+						},
+					},
+				},
+			}
+			setAllWorkQuotaFitCondition(tc.works, binding)
+			quotaFitCond := meta.FindStatusCondition(binding.Status.Conditions, string(fleetv1beta1.ResourceBindingQuotaFit))
+			if diff := cmp.Diff(quotaFitCond, tc.wantQuotaFitCondition, cmpConditionOption); diff != "" {
+				t.Errorf("quota fit condition mismatches (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetAllWorkAdmissionPreflightPassedCondition(t *testing.T) {
+	preflightWork := func(name string, status metav1.ConditionStatus) *fleetv1beta1.Work {
+		return &fleetv1beta1.Work{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:       name,
+				Generation: 1,
+			},
+			Status: fleetv1beta1.WorkStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               fleetv1beta1.WorkConditionTypeAdmissionPreflightPassed,
+						Status:             status,
+						ObservedGeneration: 1,
+					},
+				},
+			},
+		}
+	}
+	enforced := &fleetv1beta1.ApplyStrategy{AdmissionPreflight: fleetv1beta1.AdmissionPreflightTypeEnforce}
+	testCases := []struct {
+		name                   string
+		applyStrategy          *fleetv1beta1.ApplyStrategy
+		works                  map[string]*fleetv1beta1.Work
+		wantPreflightCondition *metav1.Condition
+	}{
+		{
+			name:          "all works pass",
+			applyStrategy: enforced,
+			works: map[string]*fleetv1beta1.Work{
+				"work-1": preflightWork("work-1", metav1.ConditionTrue),
+				"work-2": preflightWork("work-2", metav1.ConditionTrue),
+			},
+			wantPreflightCondition: &metav1.Condition{
+				Status:             metav1.ConditionTrue,
+				Type:               string(fleetv1beta1.ResourceBindingAdmissionPreflightPassed),
+				Reason:             condition.AllWorkAdmissionPreflightPassedReason,
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name:          "one work is rejected in the report only mode",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{AdmissionPreflight: fleetv1beta1.AdmissionPreflightTypeReportOnly},
+			works: map[string]*fleetv1beta1.Work{
+				"work-1": preflightWork("work-1", metav1.ConditionFalse),
+				"work-2": preflightWork("work-2", metav1.ConditionTrue),
+			},
+			wantPreflightCondition: &metav1.Condition{
+				Status:             metav1.ConditionFalse,
+				Type:               string(fleetv1beta1.ResourceBindingAdmissionPreflightPassed),
+				Reason:             condition.WorkAdmissionPreflightFailedReason,
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name:          "one work has not been checked yet",
+			applyStrategy: enforced,
+			works: map[string]*fleetv1beta1.Work{
+				"work-1": preflightWork("work-1", metav1.ConditionTrue),
+				"work-2": {
+					ObjectMeta: metav1.ObjectMeta{
+						Name:       "work-2",
+						Generation: 1,
+					},
+				},
+			},
+		},
+		{
+			name:          "admission preflight disabled",
+			applyStrategy: &fleetv1beta1.ApplyStrategy{AdmissionPreflight: fleetv1beta1.AdmissionPreflightTypeNever},
+			works: map[string]*fleetv1beta1.Work{
+				"work-1": preflightWork("work-1", metav1.ConditionFalse),
+			},
+		},
+	}
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			binding := &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "binding",
+					Generation: 1,
+				},
+				Spec: fleetv1beta1.ResourceBindingSpec{
+					ApplyStrategy: tc.applyStrategy,
+				},
+				Status: fleetv1beta1.ResourceBindingStatus{
+					Conditions: []metav1.Condition{
+						{
+							Type:               string(fleetv1beta1.ResourceBindingAdmissionPreflightPassed),
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: 0,
+						},
+					},
+				},
+			}
+			setAllWorkAdmissionPreflightPassedCondition(tc.works, binding)
+			preflightCond := meta.FindStatusCondition(binding.Status.Conditions, string(fleetv1beta1.ResourceBindingAdmissionPreflightPassed))
+			if diff := cmp.Diff(preflightCond, tc.wantPreflightCondition, cmpConditionOption); diff != "" {
+				t.Errorf("admission preflight condition mismatches (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestSetAllWorkAvailableCondition(t *testing.T) {
 	tests := map[string]struct {
 		works                              map[string]*fleetv1beta1.Work
@@ -1140,6 +1351,7 @@ func TestSetBindingStatus(t *testing.T) {
 		applyStrategy                    *fleetv1beta1.ApplyStrategy
 		maxFailedResourcePlacementLimit  *int
 		wantFailedResourcePlacements     []fleetv1beta1.FailedResourcePlacement
+		wantFailedPlacementOverflowCount int32
 		maxDriftedResourcePlacementLimit *int
 		wantDriftedResourcePlacements    []fleetv1beta1.DriftedResourcePlacement
 		maxDiffedResourcePlacementLimit  *int
@@ -1475,7 +1687,8 @@ func TestSetBindingStatus(t *testing.T) {
 					},
 				},
 			},
-			maxFailedResourcePlacementLimit: ptr.To(1),
+			maxFailedResourcePlacementLimit:  ptr.To(1),
+			wantFailedPlacementOverflowCount: 1,
 			wantFailedResourcePlacements: []fleetv1beta1.FailedResourcePlacement{
 				{
 					ResourceIdentifier: fleetv1beta1.ResourceIdentifier{
@@ -2244,6 +2457,9 @@ func TestSetBindingStatus(t *testing.T) {
 				},
 			}
 			setBindingStatus(tt.works, binding)
+			if got := binding.Status.FailedPlacementOverflowCount; got != tt.wantFailedPlacementOverflowCount {
+				t.Errorf("setBindingStatus got FailedPlacementOverflowCount %d, want %d", got, tt.wantFailedPlacementOverflowCount)
+			}
 			got := binding.Status.FailedPlacements
 			// setBindingStatus is using map to populate the placements.
 			// There is no default order in traversing the map.
@@ -2448,6 +2664,82 @@ func TestExtractFailedResourcePlacementsFromWork(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "apply is true with tolerated failures and available is true",
+			work: fleetv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Generation: workGeneration,
+				},
+				Status: fleetv1beta1.WorkStatus{
+					ManifestConditions: []fleetv1beta1.ManifestCondition{
+						{
+							Identifier: fleetv1beta1.WorkResourceIdentifier{
+								Ordinal:   0,
+								Group:     "",
+								Version:   "v1",
+								Kind:      "ConfigMap",
+								Name:      "config-name",
+								Namespace: "config-namespace",
+							},
+							Conditions: []metav1.Condition{
+								{
+									Type:   fleetv1beta1.WorkConditionTypeApplied,
+									Status: metav1.ConditionFalse,
+								},
+							},
+						},
+						{
+							Identifier: fleetv1beta1.WorkResourceIdentifier{
+								Ordinal:   1,
+								Group:     "",
+								Version:   "v1",
+								Kind:      "Service",
+								Name:      "svc-name",
+								Namespace: "svc-namespace",
+							},
+							Conditions: []metav1.Condition{
+								{
+									Type:   fleetv1beta1.WorkConditionTypeApplied,
+									Status: metav1.ConditionTrue,
+								},
+								{
+									Type:   fleetv1beta1.WorkConditionTypeAvailable,
+									Status: metav1.ConditionTrue,
+								},
+							},
+						},
+					},
+					Conditions: []metav1.Condition{
+						{
+							Type:               fleetv1beta1.WorkConditionTypeApplied,
+							Status:             metav1.ConditionTrue,
+							Reason:             condition.WorkAppliedWithToleratedFailuresReason,
+							ObservedGeneration: workGeneration,
+						},
+						{
+							Type:               fleetv1beta1.WorkConditionTypeAvailable,
+							Status:             metav1.ConditionTrue,
+							ObservedGeneration: workGeneration,
+						},
+					},
+				},
+			},
+			want: []fleetv1beta1.FailedResourcePlacement{
+				{
+					ResourceIdentifier: fleetv1beta1.ResourceIdentifier{
+						Group:     "",
+						Version:   "v1",
+						Kind:      "ConfigMap",
+						Name:      "config-name",
+						Namespace: "config-namespace",
+					},
+					Condition: metav1.Condition{
+						Type:   fleetv1beta1.WorkConditionTypeApplied,
+						Status: metav1.ConditionFalse,
+					},
+				},
+			},
+		},
 		{
 			name: "apply is true and available is false for enveloped object",
 			work: fleetv1beta1.Work{
@@ -3628,6 +3920,96 @@ func TestUpdateBindingStatusWithRetry(t *testing.T) {
 	}
 }
 
+func TestResolveApplyStrategyForCluster(t *testing.T) {
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "prod-cluster",
+			Labels: map[string]string{
+				"ring": "prod",
+			},
+		},
+	}
+	clientSideApplyStrategy := &fleetv1beta1.ApplyStrategy{
+		Type: fleetv1beta1.ApplyStrategyTypeClientSideApply,
+	}
+	reportDiffApplyStrategy := &fleetv1beta1.ApplyStrategy{
+		Type: fleetv1beta1.ApplyStrategyTypeReportDiff,
+	}
+
+	testCases := []struct {
+		name              string
+		bindingSpec       fleetv1beta1.ResourceBindingSpec
+		wantApplyStrategy *fleetv1beta1.ApplyStrategy
+	}{
+		{
+			name: "no apply strategy overrides",
+			bindingSpec: fleetv1beta1.ResourceBindingSpec{
+				ApplyStrategy: clientSideApplyStrategy,
+			},
+			wantApplyStrategy: clientSideApplyStrategy,
+		},
+		{
+			name: "cluster selected by an override",
+			bindingSpec: fleetv1beta1.ResourceBindingSpec{
+				ApplyStrategy: clientSideApplyStrategy,
+				ApplyStrategyOverrides: []fleetv1beta1.ApplyStrategyOverride{
+					{
+						ClusterSelector: &fleetv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []fleetv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"ring": "prod"},
+									},
+								},
+							},
+						},
+						ApplyStrategy: reportDiffApplyStrategy,
+					},
+				},
+			},
+			wantApplyStrategy: reportDiffApplyStrategy,
+		},
+		{
+			name: "cluster not selected by any override",
+			bindingSpec: fleetv1beta1.ResourceBindingSpec{
+				ApplyStrategy: clientSideApplyStrategy,
+				ApplyStrategyOverrides: []fleetv1beta1.ApplyStrategyOverride{
+					{
+						ClusterSelector: &fleetv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []fleetv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"ring": "canary"},
+									},
+								},
+							},
+						},
+						ApplyStrategy: reportDiffApplyStrategy,
+					},
+				},
+			},
+			wantApplyStrategy: clientSideApplyStrategy,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			binding := &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-binding",
+				},
+				Spec: tc.bindingSpec,
+			}
+			if err := resolveApplyStrategyForCluster(binding, cluster); err != nil {
+				t.Fatalf("resolveApplyStrategyForCluster() got error %v, want nil", err)
+			}
+			if diff := cmp.Diff(binding.Spec.ApplyStrategy, tc.wantApplyStrategy); diff != "" {
+				t.Errorf("resolveApplyStrategyForCluster() apply strategy mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestSyncApplyStrategy(t *testing.T) {
 	bindingName := "test-binding-1"
 	workName := "test-work-1"
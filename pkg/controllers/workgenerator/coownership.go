@@ -0,0 +1,336 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// maxCoOwnedResourcesInMessage is the max number of co-owned resources to include in the
+	// message of the CoOwnershipResolved condition.
+	maxCoOwnedResourcesInMessage = 3
+)
+
+// coOwningPlacement describes another placement that selects some of the same resources for
+// the same cluster as the placement of the binding in reconciliation.
+type coOwningPlacement struct {
+	// key is the key of the placement, i.e., its name for a ClusterResourcePlacement, or its
+	// namespace and name for a ResourcePlacement.
+	key string
+	// policy is the co-ownership policy used by the placement.
+	policy fleetv1beta1.CoOwnershipPolicyType
+	// resources are the resources selected by both placements.
+	resources []string
+}
+
+// syncCoOwnershipCondition detects the resources that the placement of the binding selects and
+// that are also selected by other placements for the same cluster, and sets the CoOwnershipResolved
+// condition on the binding accordingly.
+//
+// The detection relies on the selected resources reported in the statuses of the placements; as
+// each binding runs the same detection, the conflicts are surfaced in the statuses of all the
+// placements involved.
+func (r *Reconciler) syncCoOwnershipCondition(ctx context.Context, binding fleetv1beta1.BindingObj) error {
+	bindingRef := klog.KObj(binding)
+	if isReportDiffBinding(binding) {
+		binding.RemoveCondition(string(fleetv1beta1.ResourceBindingCoOwnershipResolved))
+		return nil
+	}
+	placementKey := placementKeyOf(binding)
+	selectedResources, err := r.selectedResourcesOf(ctx, placementKey)
+	if err != nil {
+		klog.ErrorS(err, "Failed to get the placement of the binding", "placement", placementKey, "binding", bindingRef)
+		return err
+	}
+	if selectedResources.Len() == 0 {
+		binding.RemoveCondition(string(fleetv1beta1.ResourceBindingCoOwnershipResolved))
+		return nil
+	}
+
+	peers, err := r.listBindingsTargetingCluster(ctx, binding.GetBindingSpec().TargetCluster)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list the bindings targeting the same cluster", "binding", bindingRef)
+		return err
+	}
+	var coOwners []coOwningPlacement
+	checked := sets.New(placementKey)
+	for _, peer := range peers {
+		peerPlacementKey := placementKeyOf(peer)
+		if peerPlacementKey.Name == "" || checked.Has(peerPlacementKey) || isReportDiffBinding(peer) ||
+			(peer.GetBindingSpec().State != fleetv1beta1.BindingStateBound && peer.GetBindingSpec().State != fleetv1beta1.BindingStateUnscheduled) {
+			continue
+		}
+		checked.Insert(peerPlacementKey)
+		peerSelectedResources, err := r.selectedResourcesOf(ctx, peerPlacementKey)
+		if err != nil {
+			klog.ErrorS(err, "Failed to get the placement of a binding targeting the same cluster", "placement", peerPlacementKey, "binding", bindingRef)
+			return err
+		}
+		shared := selectedResources.Intersection(peerSelectedResources)
+		if shared.Len() == 0 {
+			continue
+		}
+		coOwners = append(coOwners, coOwningPlacement{
+			key:       controller.GetObjectKeyFromNamespaceName(peerPlacementKey.Namespace, peerPlacementKey.Name),
+			policy:    coOwnershipPolicyOf(peer),
+			resources: sets.List(shared),
+		})
+	}
+	setCoOwnershipResolvedCondition(binding, coOwners)
+	return nil
+}
+
+// setCoOwnershipResolvedCondition sets the CoOwnershipResolved condition on a binding based on
+// the other placements that select some of the same resources for the same cluster.
+//
+// The condition is removed if there is no such placement; it is set to True if all the placements
+// use the same co-ownership policy that allows co-ownership, and to False otherwise.
+func setCoOwnershipResolvedCondition(binding fleetv1beta1.BindingObj, coOwners []coOwningPlacement) {
+	if len(coOwners) == 0 {
+		binding.RemoveCondition(string(fleetv1beta1.ResourceBindingCoOwnershipResolved))
+		return
+	}
+	sort.Slice(coOwners, func(i, j int) bool {
+		return coOwners[i].key < coOwners[j].key
+	})
+
+	policy := coOwnershipPolicyOf(binding)
+	isResolved := policy != fleetv1beta1.CoOwnershipPolicyTypeDeny
+	sharedResources := sets.New[string]()
+	coOwnerDescriptions := make([]string, 0, len(coOwners))
+	for _, coOwner := range coOwners {
+		if coOwner.policy != policy {
+			isResolved = false
+		}
+		sharedResources.Insert(coOwner.resources...)
+		coOwnerDescriptions = append(coOwnerDescriptions, fmt.Sprintf("%s (%s)", coOwner.key, coOwner.policy))
+	}
+	resourceList := sets.List(sharedResources)
+	resourceDescription := strings.Join(resourceList, ", ")
+	if len(resourceList) > maxCoOwnedResourcesInMessage {
+		resourceDescription = fmt.Sprintf("%s and %d more", strings.Join(resourceList[:maxCoOwnedResourcesInMessage], ", "), len(resourceList)-maxCoOwnedResourcesInMessage)
+	}
+
+	if !isResolved {
+		klog.V(2).InfoS("Found resources placed by multiple placements that cannot be co-owned", "binding", klog.KObj(binding), "coOwners", coOwnerDescriptions)
+		binding.SetConditions(metav1.Condition{
+			Status: metav1.ConditionFalse,
+			Type:   string(fleetv1beta1.ResourceBindingCoOwnershipResolved),
+			Reason: condition.CoOwnershipConflictReason,
+			Message: fmt.Sprintf("Resources %s are also selected for the cluster by placements %s; co-ownership requires all the placements to use the same %s or %s co-ownership policy, but this placement uses %s",
+				resourceDescription, strings.Join(coOwnerDescriptions, ", "),
+				fleetv1beta1.CoOwnershipPolicyTypeLastWriterWins, fleetv1beta1.CoOwnershipPolicyTypeSharedFields, policy),
+			ObservedGeneration: binding.GetGeneration(),
+		})
+		return
+	}
+	binding.SetConditions(metav1.Condition{
+		Status:             metav1.ConditionTrue,
+		Type:               string(fleetv1beta1.ResourceBindingCoOwnershipResolved),
+		Reason:             condition.CoOwnershipResolvedReason,
+		Message:            fmt.Sprintf("Resources %s are co-owned with placements %s under the %s co-ownership policy", resourceDescription, strings.Join(coOwnerDescriptions, ", "), policy),
+		ObservedGeneration: binding.GetGeneration(),
+	})
+}
+
+// listBindingsTargetingCluster lists the live bindings (both ClusterResourceBindings and, if the
+// ResourcePlacement APIs are enabled, ResourceBindings) that target the given cluster.
+func (r *Reconciler) listBindingsTargetingCluster(ctx context.Context, clusterName string) ([]fleetv1beta1.BindingObj, error) {
+	var bindings []fleetv1beta1.BindingObj
+	crbList := &fleetv1beta1.ClusterResourceBindingList{}
+	if err := r.Client.List(ctx, crbList); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	for i := range crbList.Items {
+		bindings = append(bindings, &crbList.Items[i])
+	}
+	if r.EnableResourcePlacement {
+		rbList := &fleetv1beta1.ResourceBindingList{}
+		if err := r.Client.List(ctx, rbList); err != nil {
+			return nil, controller.NewAPIServerError(true, err)
+		}
+		for i := range rbList.Items {
+			bindings = append(bindings, &rbList.Items[i])
+		}
+	}
+
+	res := make([]fleetv1beta1.BindingObj, 0, len(bindings))
+	for _, b := range bindings {
+		if b.GetBindingSpec().TargetCluster == clusterName && b.GetDeletionTimestamp() == nil {
+			res = append(res, b)
+		}
+	}
+	return res, nil
+}
+
+// placementKeyOf returns the namespaced name of the placement of a binding.
+func placementKeyOf(binding fleetv1beta1.BindingObj) types.NamespacedName {
+	return types.NamespacedName{Namespace: binding.GetNamespace(), Name: binding.GetLabels()[fleetv1beta1.PlacementTrackingLabel]}
+}
+
+// selectedResourcesOf returns the string representations of the resources selected by a placement,
+// as reported in its status; it returns an empty set if the placement does not exist.
+func (r *Reconciler) selectedResourcesOf(ctx context.Context, placementKey types.NamespacedName) (sets.Set[string], error) {
+	placement, err := controller.FetchPlacementFromNamespacedName(ctx, r.Client, placementKey)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return sets.New[string](), nil
+		}
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	return resourceIdentifierStrings(placement.GetPlacementStatus().SelectedResources), nil
+}
+
+// coOwnershipPolicyOf returns the co-ownership policy of a binding.
+func coOwnershipPolicyOf(binding fleetv1beta1.BindingObj) fleetv1beta1.CoOwnershipPolicyType {
+	applyStrategy := binding.GetBindingSpec().ApplyStrategy
+	if applyStrategy == nil || applyStrategy.CoOwnershipPolicy == "" {
+		return fleetv1beta1.CoOwnershipPolicyTypeDeny
+	}
+	return applyStrategy.CoOwnershipPolicy
+}
+
+// isReportDiffBinding returns true if the binding uses the ReportDiff apply strategy, i.e., Fleet
+// does not apply its resources.
+func isReportDiffBinding(binding fleetv1beta1.BindingObj) bool {
+	applyStrategy := binding.GetBindingSpec().ApplyStrategy
+	return applyStrategy != nil && applyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff
+}
+
+// resourceIdentifierStrings returns the string representations of the selected resources, which
+// identify the resources by their groups, kinds, namespaces and names; versions are ignored as the
+// same resource can be selected via different versions.
+func resourceIdentifierStrings(resources []fleetv1beta1.ResourceIdentifier) sets.Set[string] {
+	res := sets.New[string]()
+	for _, r := range resources {
+		kind := r.Kind
+		if r.Group != "" {
+			kind = fmt.Sprintf("%s.%s", r.Kind, r.Group)
+		}
+		if r.Namespace != "" {
+			res.Insert(fmt.Sprintf("%s %s/%s", kind, r.Namespace, r.Name))
+		} else {
+			res.Insert(fmt.Sprintf("%s %s", kind, r.Name))
+		}
+	}
+	return res
+}
+
+// coOwnershipHandlerFuncs enqueues the co-owners of the resources placed by a binding whose
+// CoOwnershipResolved condition has changed or which has been deleted, so that they re-evaluate
+// the co-ownership and the conflicts are surfaced on all of them.
+//
+// The co-owners are the bindings that target the same cluster and either have a CoOwnershipResolved
+// condition themselves or belong to placements that select some of the same resources; the other
+// bindings targeting the cluster are not enqueued, so that a change does not trigger the detection
+// for every binding on the cluster.
+func (r *Reconciler) coOwnershipHandlerFuncs(enqueueCRB bool) handler.Funcs {
+	enqueuePeers := func(ctx context.Context, binding fleetv1beta1.BindingObj, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+		bindingRef := klog.KObj(binding)
+		peers, err := r.listBindingsTargetingCluster(ctx, binding.GetBindingSpec().TargetCluster)
+		if err != nil {
+			klog.ErrorS(err, "Failed to list the bindings targeting the same cluster", "binding", bindingRef)
+			return
+		}
+		selectedResources, err := r.selectedResourcesOf(ctx, placementKeyOf(binding))
+		if err != nil {
+			klog.ErrorS(err, "Failed to get the placement of the binding", "binding", bindingRef)
+			return
+		}
+		// The selected resources of each placement are fetched once, as a placement can have
+		// multiple bindings targeting the same cluster, e.g., during a rollout.
+		sharesResources := map[types.NamespacedName]bool{}
+		for _, peer := range peers {
+			if shouldIgnoreWork(enqueueCRB, peer.GetNamespace()) ||
+				(peer.GetNamespace() == binding.GetNamespace() && peer.GetName() == binding.GetName()) {
+				continue
+			}
+			if peer.GetCondition(string(fleetv1beta1.ResourceBindingCoOwnershipResolved)) == nil {
+				peerPlacementKey := placementKeyOf(peer)
+				shares, found := sharesResources[peerPlacementKey]
+				if !found {
+					peerSelectedResources, err := r.selectedResourcesOf(ctx, peerPlacementKey)
+					if err != nil {
+						klog.ErrorS(err, "Failed to get the placement of a binding targeting the same cluster", "placement", peerPlacementKey, "binding", bindingRef)
+						return
+					}
+					shares = selectedResources.Intersection(peerSelectedResources).Len() > 0
+					sharesResources[peerPlacementKey] = shares
+				}
+				if !shares {
+					continue
+				}
+			}
+			queue.Add(reconcile.Request{NamespacedName: types.NamespacedName{
+				Name:      peer.GetName(),
+				Namespace: peer.GetNamespace(),
+			}})
+		}
+	}
+	return handler.Funcs{
+		UpdateFunc: func(ctx context.Context, evt event.UpdateEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			oldBinding, oldOK := evt.ObjectOld.(fleetv1beta1.BindingObj)
+			newBinding, newOK := evt.ObjectNew.(fleetv1beta1.BindingObj)
+			if !oldOK || !newOK {
+				klog.ErrorS(controller.NewUnexpectedBehaviorError(fmt.Errorf("received update event %v with non-binding objects", evt)),
+					"Failed to process an update event for binding object")
+				return
+			}
+			oldCond := oldBinding.GetCondition(string(fleetv1beta1.ResourceBindingCoOwnershipResolved))
+			newCond := newBinding.GetCondition(string(fleetv1beta1.ResourceBindingCoOwnershipResolved))
+			if oldCond == nil && newCond == nil {
+				return
+			}
+			if oldCond != nil && newCond != nil && oldCond.Status == newCond.Status && oldCond.Message == newCond.Message {
+				return
+			}
+			klog.V(2).InfoS("The co-ownership of a binding has changed", "binding", klog.KObj(newBinding))
+			enqueuePeers(ctx, newBinding, queue)
+		},
+		DeleteFunc: func(ctx context.Context, evt event.DeleteEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			binding, ok := evt.Object.(fleetv1beta1.BindingObj)
+			if !ok {
+				klog.ErrorS(controller.NewUnexpectedBehaviorError(fmt.Errorf("received delete event %v with a non-binding object", evt)),
+					"Failed to process a delete event for binding object")
+				return
+			}
+			if binding.GetCondition(string(fleetv1beta1.ResourceBindingCoOwnershipResolved)) == nil {
+				return
+			}
+			klog.V(2).InfoS("A co-owning binding has been deleted", "binding", klog.KObj(binding))
+			enqueuePeers(ctx, binding, queue)
+		},
+	}
+}
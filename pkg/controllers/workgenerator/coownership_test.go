@@ -0,0 +1,314 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"context"
+	"sort"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/workqueue"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllertest"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+var (
+	configMapIdentifier = fleetv1beta1.ResourceIdentifier{Version: "v1", Kind: "ConfigMap", Namespace: "app", Name: "config"}
+	deployIdentifier    = fleetv1beta1.ResourceIdentifier{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "app", Name: "web"}
+	namespaceIdentifier = fleetv1beta1.ResourceIdentifier{Version: "v1", Kind: "Namespace", Name: "app"}
+)
+
+func coOwnershipTestBinding(name, namespace, placementName, cluster string, applyStrategy *fleetv1beta1.ApplyStrategy) fleetv1beta1.BindingObj {
+	meta := metav1.ObjectMeta{
+		Name:       name,
+		Namespace:  namespace,
+		Generation: 1,
+		Labels:     map[string]string{fleetv1beta1.PlacementTrackingLabel: placementName},
+	}
+	spec := fleetv1beta1.ResourceBindingSpec{
+		State:         fleetv1beta1.BindingStateBound,
+		TargetCluster: cluster,
+		ApplyStrategy: applyStrategy,
+	}
+	if namespace == "" {
+		return &fleetv1beta1.ClusterResourceBinding{ObjectMeta: meta, Spec: spec}
+	}
+	return &fleetv1beta1.ResourceBinding{ObjectMeta: meta, Spec: spec}
+}
+
+func TestSetCoOwnershipResolvedCondition(t *testing.T) {
+	lastWriterWins := &fleetv1beta1.ApplyStrategy{CoOwnershipPolicy: fleetv1beta1.CoOwnershipPolicyTypeLastWriterWins}
+	testCases := []struct {
+		name          string
+		applyStrategy *fleetv1beta1.ApplyStrategy
+		coOwners      []coOwningPlacement
+		wantCond      *metav1.Condition
+	}{
+		{
+			name:          "no co-owners",
+			applyStrategy: lastWriterWins,
+		},
+		{
+			name:          "all placements use the same policy",
+			applyStrategy: lastWriterWins,
+			coOwners: []coOwningPlacement{
+				{key: "ns/rp", policy: fleetv1beta1.CoOwnershipPolicyTypeLastWriterWins, resources: []string{"ConfigMap app/config"}},
+				{key: "crp-2", policy: fleetv1beta1.CoOwnershipPolicyTypeLastWriterWins, resources: []string{"ConfigMap app/config"}},
+			},
+			wantCond: &metav1.Condition{
+				Type:               string(fleetv1beta1.ResourceBindingCoOwnershipResolved),
+				Status:             metav1.ConditionTrue,
+				Reason:             condition.CoOwnershipResolvedReason,
+				Message:            "Resources ConfigMap app/config are co-owned with placements crp-2 (LastWriterWins), ns/rp (LastWriterWins) under the LastWriterWins co-ownership policy",
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name: "this placement denies co-ownership",
+			coOwners: []coOwningPlacement{
+				{key: "crp-2", policy: fleetv1beta1.CoOwnershipPolicyTypeDeny, resources: []string{"ConfigMap app/config"}},
+			},
+			wantCond: &metav1.Condition{
+				Type:               string(fleetv1beta1.ResourceBindingCoOwnershipResolved),
+				Status:             metav1.ConditionFalse,
+				Reason:             condition.CoOwnershipConflictReason,
+				Message:            "Resources ConfigMap app/config are also selected for the cluster by placements crp-2 (Deny); co-ownership requires all the placements to use the same LastWriterWins or SharedFields co-ownership policy, but this placement uses Deny",
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name:          "placements use different policies",
+			applyStrategy: lastWriterWins,
+			coOwners: []coOwningPlacement{
+				{key: "crp-2", policy: fleetv1beta1.CoOwnershipPolicyTypeSharedFields, resources: []string{"ConfigMap app/config", "Deployment.apps app/web"}},
+				{key: "crp-3", policy: fleetv1beta1.CoOwnershipPolicyTypeLastWriterWins, resources: []string{"Namespace app", "Secret app/a"}},
+			},
+			wantCond: &metav1.Condition{
+				Type:               string(fleetv1beta1.ResourceBindingCoOwnershipResolved),
+				Status:             metav1.ConditionFalse,
+				Reason:             condition.CoOwnershipConflictReason,
+				Message:            "Resources ConfigMap app/config, Deployment.apps app/web, Namespace app and 1 more are also selected for the cluster by placements crp-2 (SharedFields), crp-3 (LastWriterWins); co-ownership requires all the placements to use the same LastWriterWins or SharedFields co-ownership policy, but this placement uses LastWriterWins",
+				ObservedGeneration: 1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			binding := coOwnershipTestBinding("binding", "", "crp-1", "member-1", tc.applyStrategy)
+			binding.SetConditions(metav1.Condition{
+				Type:               string(fleetv1beta1.ResourceBindingCoOwnershipResolved),
+				Status:             metav1.ConditionTrue,
+				Reason:             "stale",
+				ObservedGeneration: 1,
+			})
+			setCoOwnershipResolvedCondition(binding, tc.coOwners)
+			got := binding.GetCondition(string(fleetv1beta1.ResourceBindingCoOwnershipResolved))
+			if diff := cmp.Diff(got, tc.wantCond, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("setCoOwnershipResolvedCondition() condition mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncCoOwnershipCondition(t *testing.T) {
+	placement := func(name, namespace string, resources ...fleetv1beta1.ResourceIdentifier) client.Object {
+		if namespace == "" {
+			return &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: name},
+				Status:     fleetv1beta1.PlacementStatus{SelectedResources: resources},
+			}
+		}
+		return &fleetv1beta1.ResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Status:     fleetv1beta1.PlacementStatus{SelectedResources: resources},
+		}
+	}
+	sharedFields := &fleetv1beta1.ApplyStrategy{
+		Type:              fleetv1beta1.ApplyStrategyTypeServerSideApply,
+		CoOwnershipPolicy: fleetv1beta1.CoOwnershipPolicyTypeSharedFields,
+	}
+	reportDiff := &fleetv1beta1.ApplyStrategy{Type: fleetv1beta1.ApplyStrategyTypeReportDiff}
+	deployV2Identifier := deployIdentifier
+	deployV2Identifier.Version = "v2"
+	objects := []client.Object{
+		placement("crp-1", "", namespaceIdentifier, configMapIdentifier, deployIdentifier),
+		// Selects the same deployment via a different version.
+		placement("crp-2", "", deployV2Identifier),
+		// Selects no shared resources.
+		placement("crp-3", "", fleetv1beta1.ResourceIdentifier{Version: "v1", Kind: "Namespace", Name: "other"}),
+		// Reports diff only.
+		placement("crp-4", "", namespaceIdentifier),
+		placement("rp-1", "app", configMapIdentifier),
+		placement("rp-2", "app", configMapIdentifier),
+	}
+	// The binding has not been bound yet.
+	scheduledBinding := coOwnershipTestBinding("rp-2-member-1", "app", "rp-2", "member-1", sharedFields)
+	scheduledBinding.GetBindingSpec().State = fleetv1beta1.BindingStateScheduled
+	bindings := []fleetv1beta1.BindingObj{
+		coOwnershipTestBinding("crp-2-member-1", "", "crp-2", "member-1", sharedFields),
+		coOwnershipTestBinding("crp-3-member-1", "", "crp-3", "member-1", nil),
+		coOwnershipTestBinding("crp-4-member-1", "", "crp-4", "member-1", reportDiff),
+		coOwnershipTestBinding("rp-1-member-1", "app", "rp-1", "member-1", sharedFields),
+		scheduledBinding,
+		// The binding targets a different cluster.
+		coOwnershipTestBinding("rp-2-member-2", "app", "rp-2", "member-2", sharedFields),
+		// The binding of a placement that has been deleted.
+		coOwnershipTestBinding("crp-5-member-1", "", "crp-5", "member-1", nil),
+	}
+	for _, b := range bindings {
+		objects = append(objects, b)
+	}
+
+	testCases := []struct {
+		name                    string
+		binding                 fleetv1beta1.BindingObj
+		enableResourcePlacement bool
+		wantCond                *metav1.Condition
+	}{
+		{
+			name:                    "co-owned with a cluster resource placement and a resource placement",
+			binding:                 coOwnershipTestBinding("crp-1-member-1", "", "crp-1", "member-1", sharedFields),
+			enableResourcePlacement: true,
+			wantCond: &metav1.Condition{
+				Type:               string(fleetv1beta1.ResourceBindingCoOwnershipResolved),
+				Status:             metav1.ConditionTrue,
+				Reason:             condition.CoOwnershipResolvedReason,
+				Message:            "Resources ConfigMap app/config, Deployment.apps app/web are co-owned with placements app/rp-1 (SharedFields), crp-2 (SharedFields) under the SharedFields co-ownership policy",
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name:    "resource placement APIs disabled",
+			binding: coOwnershipTestBinding("crp-1-member-1", "", "crp-1", "member-1", nil),
+			wantCond: &metav1.Condition{
+				Type:               string(fleetv1beta1.ResourceBindingCoOwnershipResolved),
+				Status:             metav1.ConditionFalse,
+				Reason:             condition.CoOwnershipConflictReason,
+				Message:            "Resources Deployment.apps app/web are also selected for the cluster by placements crp-2 (SharedFields); co-ownership requires all the placements to use the same LastWriterWins or SharedFields co-ownership policy, but this placement uses Deny",
+				ObservedGeneration: 1,
+			},
+		},
+		{
+			name:                    "no co-owned resources on the cluster",
+			binding:                 coOwnershipTestBinding("rp-2-member-3", "app", "rp-2", "member-3", sharedFields),
+			enableResourcePlacement: true,
+		},
+		{
+			name:                    "binding reports diff only",
+			binding:                 coOwnershipTestBinding("crp-diff-only-member-1", "", "crp-1", "member-1", reportDiff),
+			enableResourcePlacement: true,
+		},
+		{
+			name:                    "placement not found",
+			binding:                 coOwnershipTestBinding("crp-6-member-1", "", "crp-6", "member-1", nil),
+			enableResourcePlacement: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(objects...).
+				Build()
+			r := &Reconciler{
+				Client:                  fakeClient,
+				EnableResourcePlacement: tc.enableResourcePlacement,
+			}
+			tc.binding.SetConditions(metav1.Condition{
+				Type:               string(fleetv1beta1.ResourceBindingCoOwnershipResolved),
+				Status:             metav1.ConditionTrue,
+				Reason:             "stale",
+				ObservedGeneration: 1,
+			})
+			if err := r.syncCoOwnershipCondition(context.Background(), tc.binding); err != nil {
+				t.Fatalf("syncCoOwnershipCondition() = %v, want no error", err)
+			}
+			got := tc.binding.GetCondition(string(fleetv1beta1.ResourceBindingCoOwnershipResolved))
+			if diff := cmp.Diff(got, tc.wantCond, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("syncCoOwnershipCondition() condition mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestCoOwnershipHandlerFuncsUpdate(t *testing.T) {
+	placement := func(name string, resources ...fleetv1beta1.ResourceIdentifier) client.Object {
+		return &fleetv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status:     fleetv1beta1.PlacementStatus{SelectedResources: resources},
+		}
+	}
+	otherNamespaceIdentifier := fleetv1beta1.ResourceIdentifier{Version: "v1", Kind: "Namespace", Name: "other"}
+	// The binding has reported co-ownership with another placement.
+	coOwningBinding := coOwnershipTestBinding("crp-4-member-1", "", "crp-4", "member-1", nil)
+	coOwningBinding.SetConditions(metav1.Condition{
+		Type:   string(fleetv1beta1.ResourceBindingCoOwnershipResolved),
+		Status: metav1.ConditionFalse,
+		Reason: condition.CoOwnershipConflictReason,
+	})
+	objects := []client.Object{
+		placement("crp-1", namespaceIdentifier, configMapIdentifier),
+		// Selects the same config map.
+		placement("crp-2", configMapIdentifier),
+		// Selects no shared resources.
+		placement("crp-3", otherNamespaceIdentifier),
+		placement("crp-4", otherNamespaceIdentifier),
+		coOwnershipTestBinding("crp-2-member-1", "", "crp-2", "member-1", nil),
+		coOwnershipTestBinding("crp-3-member-1", "", "crp-3", "member-1", nil),
+		coOwningBinding,
+		// The binding targets a different cluster.
+		coOwnershipTestBinding("crp-2-member-2", "", "crp-2", "member-2", nil),
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(serviceScheme(t)).WithObjects(objects...).Build()
+	r := &Reconciler{Client: fakeClient}
+
+	oldBinding := coOwnershipTestBinding("crp-1-member-1", "", "crp-1", "member-1", nil)
+	newBinding := coOwnershipTestBinding("crp-1-member-1", "", "crp-1", "member-1", nil)
+	newBinding.SetConditions(metav1.Condition{
+		Type:   string(fleetv1beta1.ResourceBindingCoOwnershipResolved),
+		Status: metav1.ConditionFalse,
+		Reason: condition.CoOwnershipConflictReason,
+	})
+
+	queue := &controllertest.Queue{TypedInterface: workqueue.NewTypedRateLimitingQueue[reconcile.Request](workqueue.DefaultTypedItemBasedRateLimiter[reconcile.Request]())}
+	r.coOwnershipHandlerFuncs(true).Update(context.Background(), event.UpdateEvent{ObjectOld: oldBinding, ObjectNew: newBinding}, queue)
+
+	var got []string
+	for queue.Len() > 0 {
+		req, _ := queue.Get()
+		got = append(got, req.Name)
+		queue.Done(req)
+	}
+	sort.Strings(got)
+	// Only the bindings of the placements that share resources with the binding, and the bindings
+	// that have reported co-ownership, are enqueued.
+	want := []string{"crp-2-member-1", "crp-4-member-1"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("enqueued bindings mismatch (-got, +want):\n%s", diff)
+	}
+}
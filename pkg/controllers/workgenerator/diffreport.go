@@ -0,0 +1,383 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/resource"
+)
+
+const (
+	// maxDiffReportPageSizeBytes is the max (estimated) size of the drifted and diffed placements kept in
+	// a DiffReport page; it stays well within the object size limit of the API server (1.5 MiB by default).
+	maxDiffReportPageSizeBytes = 512 * 1024
+)
+
+// syncDiffReports writes the complete lists of drifted and diffed placements of the binding to its
+// DiffReport pages, removes the pages that are no longer needed, and refers to the pages in the binding
+// status. If there is nothing to report, all the pages of the binding are removed.
+func (r *Reconciler) syncDiffReports(
+	ctx context.Context,
+	binding fleetv1beta1.BindingObj,
+	driftedPlacements []fleetv1beta1.DriftedResourcePlacement,
+	diffedPlacements []fleetv1beta1.DiffedResourcePlacement,
+) error {
+	bindingRef := klog.KObj(binding)
+	binding.GetBindingStatus().DiffReport = nil
+
+	existing, err := r.listDiffReports(ctx, binding)
+	if err != nil {
+		return err
+	}
+	desired := buildDiffReports(binding, driftedPlacements, diffedPlacements)
+	names := make([]string, 0, len(desired))
+	for _, report := range desired {
+		names = append(names, report.Name)
+		current, found := existing[report.Name]
+		delete(existing, report.Name)
+		if !found {
+			if err := r.Client.Create(ctx, report); err != nil {
+				klog.ErrorS(err, "Failed to create the diff report", "diffReport", klog.KObj(report), "binding", bindingRef)
+				return controller.NewCreateIgnoreAlreadyExistError(err)
+			}
+			continue
+		}
+		if equality.Semantic.DeepEqual(current.Spec, report.Spec) && equality.Semantic.DeepEqual(current.Labels, report.Labels) {
+			continue
+		}
+		current.Labels = report.Labels
+		current.Spec = report.Spec
+		if err := r.Client.Update(ctx, current); err != nil {
+			klog.ErrorS(err, "Failed to update the diff report", "diffReport", klog.KObj(current), "binding", bindingRef)
+			return controller.NewUpdateIgnoreConflictError(err)
+		}
+	}
+	for _, stale := range existing {
+		if err := r.Client.Delete(ctx, stale); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete the stale diff report", "diffReport", klog.KObj(stale), "binding", bindingRef)
+			return controller.NewAPIServerError(false, err)
+		}
+	}
+
+	if len(desired) > 0 {
+		binding.GetBindingStatus().DiffReport = &fleetv1beta1.DiffReportReference{
+			Namespace:             desired[0].Namespace,
+			Names:                 names,
+			ResourceSnapshotName:  binding.GetBindingSpec().ResourceSnapshotName,
+			DriftedPlacementCount: int32(len(driftedPlacements)), //nolint:gosec // the number of manifests in works is bounded
+			DiffedPlacementCount:  int32(len(diffedPlacements)),  //nolint:gosec // the number of manifests in works is bounded
+		}
+		klog.V(2).InfoS("Synced the diff reports", "binding", bindingRef, "numberOfPages", len(desired),
+			"numberOfDriftedPlacements", len(driftedPlacements), "numberOfDiffedPlacements", len(diffedPlacements))
+	}
+	return nil
+}
+
+// isDiffReportOnDemand returns whether the DiffReport pages of the binding are only written on demand, which
+// is the case for the bindings that use the ReportDiff apply strategy when the diffed placement summaries are enabled.
+func (r *Reconciler) isDiffReportOnDemand(binding fleetv1beta1.BindingObj) bool {
+	applyStrategy := binding.GetBindingSpec().ApplyStrategy
+	return r.DiffedPlacementsSummaryThreshold > 0 && applyStrategy != nil && applyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff
+}
+
+// syncDiffReportsOnDemand writes the DiffReport pages of the binding if they have been requested (see the
+// DiffReportRequestAnnotation), and keeps them up-to-date until they expire, after which they are removed.
+// The pages are left as they are if the binding status has not been refreshed based on the works.
+//
+// It returns how long it is until the pages expire, so that the binding can be requeued then.
+func (r *Reconciler) syncDiffReportsOnDemand(
+	ctx context.Context,
+	binding fleetv1beta1.BindingObj,
+	driftedPlacements []fleetv1beta1.DriftedResourcePlacement,
+	diffedPlacements []fleetv1beta1.DiffedResourcePlacement,
+	statusRefreshed bool,
+	now time.Time,
+) (time.Duration, error) {
+	status := binding.GetBindingStatus()
+	var expirationTime *metav1.Time
+	if status.DiffReport != nil && status.DiffReport.ExpirationTime != nil && now.Before(status.DiffReport.ExpirationTime.Time) {
+		expirationTime = status.DiffReport.ExpirationTime
+	}
+	if _, requested := binding.GetAnnotations()[fleetv1beta1.DiffReportRequestAnnotation]; requested && statusRefreshed {
+		expirationTime = &metav1.Time{Time: now.Add(r.OnDemandDiffReportTTL)}
+	}
+	switch {
+	case expirationTime == nil:
+		status.DiffReport = nil
+		return 0, r.deleteDiffReports(ctx, binding)
+	case !statusRefreshed:
+		return expirationTime.Sub(now), nil
+	}
+
+	if err := r.syncDiffReports(ctx, binding, driftedPlacements, diffedPlacements); err != nil {
+		return 0, err
+	}
+	if status.DiffReport == nil {
+		// There is nothing to report.
+		return 0, nil
+	}
+	status.DiffReport.ExpirationTime = expirationTime
+	return expirationTime.Sub(now), nil
+}
+
+// removeDiffReportRequest removes the DiffReportRequestAnnotation (if any) from the binding once the request
+// has been served.
+func (r *Reconciler) removeDiffReportRequest(ctx context.Context, binding fleetv1beta1.BindingObj) error {
+	if _, requested := binding.GetAnnotations()[fleetv1beta1.DiffReportRequestAnnotation]; !requested {
+		return nil
+	}
+	patch := client.MergeFrom(binding.DeepCopyObject().(client.Object))
+	annotations := binding.GetAnnotations()
+	delete(annotations, fleetv1beta1.DiffReportRequestAnnotation)
+	binding.SetAnnotations(annotations)
+	if err := r.Client.Patch(ctx, binding, patch); err != nil {
+		klog.ErrorS(err, "Failed to remove the diff report request from the binding", "binding", klog.KObj(binding))
+		return controller.NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Served the diff report request of the binding", "binding", klog.KObj(binding))
+	return nil
+}
+
+// diffReportRequestedPredicate lets through the binding updates that request DiffReports, which do not bump
+// the binding generation.
+var diffReportRequestedPredicate = predicate.Funcs{
+	CreateFunc:  func(event.CreateEvent) bool { return false },
+	DeleteFunc:  func(event.DeleteEvent) bool { return false },
+	GenericFunc: func(event.GenericEvent) bool { return false },
+	UpdateFunc: func(e event.UpdateEvent) bool {
+		if e.ObjectOld == nil || e.ObjectNew == nil {
+			return false
+		}
+		newRequest, requested := e.ObjectNew.GetAnnotations()[fleetv1beta1.DiffReportRequestAnnotation]
+		oldRequest, previouslyRequested := e.ObjectOld.GetAnnotations()[fleetv1beta1.DiffReportRequestAnnotation]
+		return requested && (!previouslyRequested || newRequest != oldRequest)
+	},
+}
+
+// summarizeDiffedPlacements replaces the diffed placements in the binding status with a summary if there are
+// more of them than the threshold.
+func (r *Reconciler) summarizeDiffedPlacements(binding fleetv1beta1.BindingObj, diffedPlacements []fleetv1beta1.DiffedResourcePlacement) {
+	if !r.isDiffReportOnDemand(binding) || len(diffedPlacements) <= r.DiffedPlacementsSummaryThreshold {
+		return
+	}
+	summary, err := buildDiffedPlacementSummary(diffedPlacements)
+	if err != nil {
+		// This should never happen; the placements are always serializable. Keep the truncated list instead.
+		klog.ErrorS(controller.NewUnexpectedBehaviorError(err), "Failed to summarize the diffed placements", "binding", klog.KObj(binding))
+		return
+	}
+	status := binding.GetBindingStatus()
+	status.DiffedPlacements = nil
+	status.DiffedPlacementSummary = summary
+	klog.V(2).InfoS("Summarized the diffed placements", "binding", klog.KObj(binding), "numberOfDiffedPlacements", summary.Count, "hash", summary.Hash)
+}
+
+// buildDiffedPlacementSummary counts the diffed placements by their kinds and hashes their details; the
+// observation times are left out of the hash, so that it only changes when the differences change.
+func buildDiffedPlacementSummary(diffedPlacements []fleetv1beta1.DiffedResourcePlacement) (*fleetv1beta1.DiffedPlacementSummary, error) {
+	diffed := make([]fleetv1beta1.DiffedResourcePlacement, len(diffedPlacements))
+	counts := make(map[metav1.GroupVersionKind]int32)
+	for i := range diffedPlacements {
+		diffed[i] = diffedPlacements[i]
+		diffed[i].ObservationTime = metav1.Time{}
+		diffed[i].FirstDiffedObservedTime = metav1.Time{}
+		gvk := metav1.GroupVersionKind{
+			Group:   diffed[i].Group,
+			Version: diffed[i].Version,
+			Kind:    diffed[i].Kind,
+		}
+		counts[gvk]++
+	}
+	sort.Slice(diffed, func(i, j int) bool {
+		return utils.LessFuncDiffedResourcePlacements(diffed[i], diffed[j])
+	})
+	hash, err := resource.HashOf(diffed)
+	if err != nil {
+		return nil, err
+	}
+
+	countsByKind := make([]fleetv1beta1.DiffedPlacementKindCount, 0, len(counts))
+	for gvk, count := range counts {
+		countsByKind = append(countsByKind, fleetv1beta1.DiffedPlacementKindCount{
+			Group:   gvk.Group,
+			Version: gvk.Version,
+			Kind:    gvk.Kind,
+			Count:   count,
+		})
+	}
+	sort.Slice(countsByKind, func(i, j int) bool {
+		a, b := countsByKind[i], countsByKind[j]
+		if a.Group != b.Group {
+			return a.Group < b.Group
+		}
+		if a.Version != b.Version {
+			return a.Version < b.Version
+		}
+		return a.Kind < b.Kind
+	})
+	return &fleetv1beta1.DiffedPlacementSummary{
+		Count:        int32(len(diffed)), //nolint:gosec // the number of manifests in works is bounded
+		CountsByKind: countsByKind,
+		Hash:         hash,
+	}, nil
+}
+
+// deleteDiffReports removes all the DiffReport pages of the binding.
+func (r *Reconciler) deleteDiffReports(ctx context.Context, binding fleetv1beta1.BindingObj) error {
+	existing, err := r.listDiffReports(ctx, binding)
+	if err != nil {
+		return err
+	}
+	for _, report := range existing {
+		if err := r.Client.Delete(ctx, report); err != nil && !apierrors.IsNotFound(err) {
+			klog.ErrorS(err, "Failed to delete the diff report", "diffReport", klog.KObj(report), "binding", klog.KObj(binding))
+			return controller.NewAPIServerError(false, err)
+		}
+	}
+	return nil
+}
+
+// listDiffReports lists the DiffReport pages of the binding, keyed by their names.
+func (r *Reconciler) listDiffReports(ctx context.Context, binding fleetv1beta1.BindingObj) (map[string]*fleetv1beta1.DiffReport, error) {
+	reportList := &fleetv1beta1.DiffReportList{}
+	namespaceMatcher := client.InNamespace(fmt.Sprintf(utils.NamespaceNameFormat, binding.GetBindingSpec().TargetCluster))
+	if err := r.Client.List(ctx, reportList, client.MatchingLabels(diffReportLabels(binding)), namespaceMatcher); err != nil {
+		klog.ErrorS(err, "Failed to list the diff reports of the binding", "binding", klog.KObj(binding))
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	reports := make(map[string]*fleetv1beta1.DiffReport, len(reportList.Items))
+	for i := range reportList.Items {
+		reports[reportList.Items[i].Name] = &reportList.Items[i]
+	}
+	return reports, nil
+}
+
+// diffReportLabels returns the labels that associate DiffReport pages with the binding.
+func diffReportLabels(binding fleetv1beta1.BindingObj) map[string]string {
+	labels := map[string]string{
+		fleetv1beta1.ParentBindingLabel: binding.GetName(),
+	}
+	if binding.GetNamespace() != "" {
+		labels[fleetv1beta1.ParentNamespaceLabel] = binding.GetNamespace()
+	}
+	return labels
+}
+
+// buildDiffReports splits the drifted and diffed placements into DiffReport pages of bounded sizes.
+//
+// The placements are sorted first so that the pages are stable across reconciliations; a placement
+// that is larger than the page size on its own is kept in a page of its own.
+func buildDiffReports(
+	binding fleetv1beta1.BindingObj,
+	driftedPlacements []fleetv1beta1.DriftedResourcePlacement,
+	diffedPlacements []fleetv1beta1.DiffedResourcePlacement,
+) []*fleetv1beta1.DiffReport {
+	if len(driftedPlacements) == 0 && len(diffedPlacements) == 0 {
+		return nil
+	}
+	drifted := append([]fleetv1beta1.DriftedResourcePlacement(nil), driftedPlacements...)
+	sort.Slice(drifted, func(i, j int) bool {
+		return utils.LessFuncDriftedResourcePlacements(drifted[i], drifted[j])
+	})
+	diffed := append([]fleetv1beta1.DiffedResourcePlacement(nil), diffedPlacements...)
+	sort.Slice(diffed, func(i, j int) bool {
+		return utils.LessFuncDiffedResourcePlacements(diffed[i], diffed[j])
+	})
+
+	var pages []fleetv1beta1.DiffReportSpec
+	current := fleetv1beta1.DiffReportSpec{}
+	currentSize := 0
+	addToPage := func(size int, add func(spec *fleetv1beta1.DiffReportSpec)) {
+		if currentSize > 0 && currentSize+size > maxDiffReportPageSizeBytes {
+			pages = append(pages, current)
+			current = fleetv1beta1.DiffReportSpec{}
+			currentSize = 0
+		}
+		add(&current)
+		currentSize += size
+	}
+	for i := range drifted {
+		addToPage(estimatedSize(drifted[i]), func(spec *fleetv1beta1.DiffReportSpec) {
+			spec.DriftedPlacements = append(spec.DriftedPlacements, drifted[i])
+		})
+	}
+	for i := range diffed {
+		addToPage(estimatedSize(diffed[i]), func(spec *fleetv1beta1.DiffReportSpec) {
+			spec.DiffedPlacements = append(spec.DiffedPlacements, diffed[i])
+		})
+	}
+	pages = append(pages, current)
+
+	namespace := fmt.Sprintf(utils.NamespaceNameFormat, binding.GetBindingSpec().TargetCluster)
+	nameBase := binding.GetLabels()[fleetv1beta1.PlacementTrackingLabel]
+	if binding.GetNamespace() != "" {
+		nameBase = fmt.Sprintf(fleetv1beta1.WorkNameBaseFmt, binding.GetNamespace(), nameBase)
+	}
+	labels := diffReportLabels(binding)
+	labels[fleetv1beta1.PlacementTrackingLabel] = binding.GetLabels()[fleetv1beta1.PlacementTrackingLabel]
+
+	reports := make([]*fleetv1beta1.DiffReport, 0, len(pages))
+	for i := range pages {
+		spec := pages[i]
+		spec.BindingName = binding.GetName()
+		spec.BindingNamespace = binding.GetNamespace()
+		spec.TargetCluster = binding.GetBindingSpec().TargetCluster
+		spec.ResourceSnapshotName = binding.GetBindingSpec().ResourceSnapshotName
+		spec.PageIndex = int32(i)          //nolint:gosec // the number of pages is bounded
+		spec.PageCount = int32(len(pages)) //nolint:gosec // the number of pages is bounded
+
+		pageLabels := make(map[string]string, len(labels))
+		for k, v := range labels {
+			pageLabels[k] = v
+		}
+		reports = append(reports, &fleetv1beta1.DiffReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      fmt.Sprintf(fleetv1beta1.DiffReportNameFmt, nameBase, i),
+				Namespace: namespace,
+				Labels:    pageLabels,
+			},
+			Spec: spec,
+		})
+	}
+	return reports
+}
+
+// estimatedSize returns the size of the object in its JSON encoding.
+func estimatedSize(obj any) int {
+	b, err := json.Marshal(obj)
+	if err != nil {
+		// This should never happen; the placements are always serializable.
+		return 0
+	}
+	return len(b)
+}
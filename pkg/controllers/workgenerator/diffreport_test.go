@@ -0,0 +1,662 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func TestBuildDiffReports(t *testing.T) {
+	largeValue := strings.Repeat("a", maxDiffReportPageSizeBytes*3/4)
+	crb := &fleetv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "crp-binding",
+			Labels: map[string]string{
+				fleetv1beta1.PlacementTrackingLabel: "crp",
+			},
+		},
+		Spec: fleetv1beta1.ResourceBindingSpec{
+			TargetCluster:        "member-1",
+			ResourceSnapshotName: "crp-1-snapshot",
+		},
+	}
+	rb := &fleetv1beta1.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "rp-binding",
+			Namespace: "app",
+			Labels: map[string]string{
+				fleetv1beta1.PlacementTrackingLabel: "rp",
+			},
+		},
+		Spec: fleetv1beta1.ResourceBindingSpec{
+			TargetCluster:        "member-1",
+			ResourceSnapshotName: "rp-1-snapshot",
+		},
+	}
+	driftedA := fleetv1beta1.DriftedResourcePlacement{
+		ResourceIdentifier: fleetv1beta1.ResourceIdentifier{Kind: "ConfigMap", Version: "v1", Namespace: "app", Name: "a"},
+		ObservedDrifts:     []fleetv1beta1.PatchDetail{{Path: "/data/key", ValueInMember: "member", ValueInHub: "hub"}},
+	}
+	driftedB := fleetv1beta1.DriftedResourcePlacement{
+		ResourceIdentifier: fleetv1beta1.ResourceIdentifier{Kind: "ConfigMap", Version: "v1", Namespace: "app", Name: "b"},
+		ObservedDrifts:     []fleetv1beta1.PatchDetail{{Path: "/data/key", ValueInMember: "member"}},
+	}
+	diffedA := fleetv1beta1.DiffedResourcePlacement{
+		ResourceIdentifier: fleetv1beta1.ResourceIdentifier{Kind: "Secret", Version: "v1", Namespace: "app", Name: "a"},
+		ObservedDiffs:      []fleetv1beta1.PatchDetail{{Path: "/data/key", ValueInHub: "hub"}},
+	}
+	largeDriftedA := fleetv1beta1.DriftedResourcePlacement{
+		ResourceIdentifier: driftedA.ResourceIdentifier,
+		ObservedDrifts:     []fleetv1beta1.PatchDetail{{Path: "/data/key", ValueInMember: largeValue}},
+	}
+	largeDiffedA := fleetv1beta1.DiffedResourcePlacement{
+		ResourceIdentifier: diffedA.ResourceIdentifier,
+		ObservedDiffs:      []fleetv1beta1.PatchDetail{{Path: "/data/key", ValueInHub: largeValue}},
+	}
+	crbLabels := map[string]string{
+		fleetv1beta1.ParentBindingLabel:     "crp-binding",
+		fleetv1beta1.PlacementTrackingLabel: "crp",
+	}
+
+	tests := map[string]struct {
+		binding fleetv1beta1.BindingObj
+		drifted []fleetv1beta1.DriftedResourcePlacement
+		diffed  []fleetv1beta1.DiffedResourcePlacement
+		want    []*fleetv1beta1.DiffReport
+	}{
+		"nothing to report": {
+			binding: crb,
+			want:    nil,
+		},
+		"single page for a cluster resource binding with sorted placements": {
+			binding: crb,
+			drifted: []fleetv1beta1.DriftedResourcePlacement{driftedB, driftedA},
+			diffed:  []fleetv1beta1.DiffedResourcePlacement{diffedA},
+			want: []*fleetv1beta1.DiffReport{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "crp-diffreport-0",
+						Namespace: "fleet-member-member-1",
+						Labels:    crbLabels,
+					},
+					Spec: fleetv1beta1.DiffReportSpec{
+						BindingName:          "crp-binding",
+						TargetCluster:        "member-1",
+						ResourceSnapshotName: "crp-1-snapshot",
+						PageIndex:            0,
+						PageCount:            1,
+						DriftedPlacements:    []fleetv1beta1.DriftedResourcePlacement{driftedA, driftedB},
+						DiffedPlacements:     []fleetv1beta1.DiffedResourcePlacement{diffedA},
+					},
+				},
+			},
+		},
+		"single page for a resource binding": {
+			binding: rb,
+			diffed:  []fleetv1beta1.DiffedResourcePlacement{diffedA},
+			want: []*fleetv1beta1.DiffReport{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "app.rp-diffreport-0",
+						Namespace: "fleet-member-member-1",
+						Labels: map[string]string{
+							fleetv1beta1.ParentBindingLabel:     "rp-binding",
+							fleetv1beta1.ParentNamespaceLabel:   "app",
+							fleetv1beta1.PlacementTrackingLabel: "rp",
+						},
+					},
+					Spec: fleetv1beta1.DiffReportSpec{
+						BindingName:          "rp-binding",
+						BindingNamespace:     "app",
+						TargetCluster:        "member-1",
+						ResourceSnapshotName: "rp-1-snapshot",
+						PageIndex:            0,
+						PageCount:            1,
+						DiffedPlacements:     []fleetv1beta1.DiffedResourcePlacement{diffedA},
+					},
+				},
+			},
+		},
+		"large placements are split into multiple pages": {
+			binding: crb,
+			drifted: []fleetv1beta1.DriftedResourcePlacement{largeDriftedA, driftedB},
+			diffed:  []fleetv1beta1.DiffedResourcePlacement{largeDiffedA},
+			want: []*fleetv1beta1.DiffReport{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "crp-diffreport-0",
+						Namespace: "fleet-member-member-1",
+						Labels:    crbLabels,
+					},
+					Spec: fleetv1beta1.DiffReportSpec{
+						BindingName:          "crp-binding",
+						TargetCluster:        "member-1",
+						ResourceSnapshotName: "crp-1-snapshot",
+						PageIndex:            0,
+						PageCount:            2,
+						DriftedPlacements:    []fleetv1beta1.DriftedResourcePlacement{largeDriftedA, driftedB},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "crp-diffreport-1",
+						Namespace: "fleet-member-member-1",
+						Labels:    crbLabels,
+					},
+					Spec: fleetv1beta1.DiffReportSpec{
+						BindingName:          "crp-binding",
+						TargetCluster:        "member-1",
+						ResourceSnapshotName: "crp-1-snapshot",
+						PageIndex:            1,
+						PageCount:            2,
+						DiffedPlacements:     []fleetv1beta1.DiffedResourcePlacement{largeDiffedA},
+					},
+				},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			got := buildDiffReports(tt.binding, tt.drifted, tt.diffed)
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("buildDiffReports() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSyncDiffReports(t *testing.T) {
+	ctx := context.Background()
+	drifted := fleetv1beta1.DriftedResourcePlacement{
+		ResourceIdentifier: fleetv1beta1.ResourceIdentifier{Kind: "ConfigMap", Version: "v1", Namespace: "app", Name: "a"},
+		ObservedDrifts:     []fleetv1beta1.PatchDetail{{Path: "/data/key", ValueInMember: "member", ValueInHub: "hub"}},
+	}
+	labels := map[string]string{
+		fleetv1beta1.ParentBindingLabel:     "crp-binding",
+		fleetv1beta1.PlacementTrackingLabel: "crp",
+	}
+	newBinding := func() *fleetv1beta1.ClusterResourceBinding {
+		return &fleetv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "crp-binding",
+				Labels: map[string]string{fleetv1beta1.PlacementTrackingLabel: "crp"},
+			},
+			Spec: fleetv1beta1.ResourceBindingSpec{
+				TargetCluster:        "member-1",
+				ResourceSnapshotName: "crp-2-snapshot",
+			},
+			Status: fleetv1beta1.ResourceBindingStatus{
+				DiffReport: &fleetv1beta1.DiffReportReference{Namespace: "fleet-member-member-1", Names: []string{"crp-diffreport-0", "crp-diffreport-1"}},
+			},
+		}
+	}
+	newPage := func(name string, index int32) *fleetv1beta1.DiffReport {
+		return &fleetv1beta1.DiffReport{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: "fleet-member-member-1",
+				Labels:    labels,
+			},
+			Spec: fleetv1beta1.DiffReportSpec{
+				BindingName:          "crp-binding",
+				TargetCluster:        "member-1",
+				ResourceSnapshotName: "crp-1-snapshot",
+				PageIndex:            index,
+				PageCount:            2,
+			},
+		}
+	}
+	unrelated := &fleetv1beta1.DiffReport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "other-diffreport-0",
+			Namespace: "fleet-member-member-1",
+			Labels: map[string]string{
+				fleetv1beta1.ParentBindingLabel:     "other-binding",
+				fleetv1beta1.PlacementTrackingLabel: "other",
+			},
+		},
+	}
+
+	tests := map[string]struct {
+		drifted        []fleetv1beta1.DriftedResourcePlacement
+		wantReference  *fleetv1beta1.DiffReportReference
+		wantReportSpec map[string]fleetv1beta1.DiffReportSpec
+	}{
+		"stale pages are updated and removed": {
+			drifted: []fleetv1beta1.DriftedResourcePlacement{drifted},
+			wantReference: &fleetv1beta1.DiffReportReference{
+				Namespace:             "fleet-member-member-1",
+				Names:                 []string{"crp-diffreport-0"},
+				ResourceSnapshotName:  "crp-2-snapshot",
+				DriftedPlacementCount: 1,
+			},
+			wantReportSpec: map[string]fleetv1beta1.DiffReportSpec{
+				"crp-diffreport-0": {
+					BindingName:          "crp-binding",
+					TargetCluster:        "member-1",
+					ResourceSnapshotName: "crp-2-snapshot",
+					PageIndex:            0,
+					PageCount:            1,
+					DriftedPlacements:    []fleetv1beta1.DriftedResourcePlacement{drifted},
+				},
+				"other-diffreport-0": {},
+			},
+		},
+		"all pages are removed when there is nothing to report": {
+			wantReference: nil,
+			wantReportSpec: map[string]fleetv1beta1.DiffReportSpec{
+				"other-diffreport-0": {},
+			},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(newPage("crp-diffreport-0", 0), newPage("crp-diffreport-1", 1), unrelated.DeepCopy()).
+				Build()
+			r := &Reconciler{Client: fakeClient}
+			binding := newBinding()
+			if err := r.syncDiffReports(ctx, binding, tt.drifted, nil); err != nil {
+				t.Fatalf("syncDiffReports() = %v, want nil", err)
+			}
+			if diff := cmp.Diff(tt.wantReference, binding.Status.DiffReport); diff != "" {
+				t.Errorf("syncDiffReports() binding status diff report mismatch (-want, +got):\n%s", diff)
+			}
+			reportList := &fleetv1beta1.DiffReportList{}
+			if err := fakeClient.List(ctx, reportList, client.InNamespace("fleet-member-member-1")); err != nil {
+				t.Fatalf("List() = %v, want nil", err)
+			}
+			gotReportSpec := make(map[string]fleetv1beta1.DiffReportSpec, len(reportList.Items))
+			for i := range reportList.Items {
+				gotReportSpec[reportList.Items[i].Name] = reportList.Items[i].Spec
+			}
+			if diff := cmp.Diff(tt.wantReportSpec, gotReportSpec, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("syncDiffReports() diff reports mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDeleteDiffReports(t *testing.T) {
+	ctx := context.Background()
+	binding := &fleetv1beta1.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{Name: "rp-binding", Namespace: "app"},
+		Spec:       fleetv1beta1.ResourceBindingSpec{TargetCluster: "member-1"},
+	}
+	owned := &fleetv1beta1.DiffReport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "app.rp-diffreport-0",
+			Namespace: "fleet-member-member-1",
+			Labels: map[string]string{
+				fleetv1beta1.ParentBindingLabel:   "rp-binding",
+				fleetv1beta1.ParentNamespaceLabel: "app",
+			},
+		},
+	}
+	// A binding with the same name in a different namespace owns its own pages.
+	other := &fleetv1beta1.DiffReport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "other.rp-diffreport-0",
+			Namespace: "fleet-member-member-1",
+			Labels: map[string]string{
+				fleetv1beta1.ParentBindingLabel:   "rp-binding",
+				fleetv1beta1.ParentNamespaceLabel: "other",
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(serviceScheme(t)).WithObjects(owned, other).Build()
+	r := &Reconciler{Client: fakeClient}
+	if err := r.deleteDiffReports(ctx, binding); err != nil {
+		t.Fatalf("deleteDiffReports() = %v, want nil", err)
+	}
+	reportList := &fleetv1beta1.DiffReportList{}
+	if err := fakeClient.List(ctx, reportList); err != nil {
+		t.Fatalf("List() = %v, want nil", err)
+	}
+	gotNames := make([]string, 0, len(reportList.Items))
+	for i := range reportList.Items {
+		gotNames = append(gotNames, reportList.Items[i].Name)
+	}
+	if diff := cmp.Diff([]string{"other.rp-diffreport-0"}, gotNames); diff != "" {
+		t.Errorf("deleteDiffReports() remaining diff reports mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestBuildDiffedPlacementSummary(t *testing.T) {
+	deploy := fleetv1beta1.DiffedResourcePlacement{
+		ResourceIdentifier: fleetv1beta1.ResourceIdentifier{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "app", Name: "web"},
+		ObservationTime:    metav1.NewTime(time.Unix(100, 0)),
+		ObservedDiffs:      []fleetv1beta1.PatchDetail{{Path: "/spec/replicas", ValueInMember: "1", ValueInHub: "2"}},
+	}
+	configMapA := fleetv1beta1.DiffedResourcePlacement{
+		ResourceIdentifier: fleetv1beta1.ResourceIdentifier{Version: "v1", Kind: "ConfigMap", Namespace: "app", Name: "a"},
+		ObservationTime:    metav1.NewTime(time.Unix(100, 0)),
+		ObservedDiffs:      []fleetv1beta1.PatchDetail{{Path: "/data/key", ValueInMember: "member", ValueInHub: "hub"}},
+	}
+	configMapB := fleetv1beta1.DiffedResourcePlacement{
+		ResourceIdentifier: fleetv1beta1.ResourceIdentifier{Version: "v1", Kind: "ConfigMap", Namespace: "app", Name: "b"},
+		ObservationTime:    metav1.NewTime(time.Unix(100, 0)),
+		ObservedDiffs:      []fleetv1beta1.PatchDetail{{Path: "/data/key", ValueInHub: "hub"}},
+	}
+
+	got, err := buildDiffedPlacementSummary([]fleetv1beta1.DiffedResourcePlacement{deploy, configMapB, configMapA})
+	if err != nil {
+		t.Fatalf("buildDiffedPlacementSummary() = %v, want nil", err)
+	}
+	want := &fleetv1beta1.DiffedPlacementSummary{
+		Count: 3,
+		CountsByKind: []fleetv1beta1.DiffedPlacementKindCount{
+			{Version: "v1", Kind: "ConfigMap", Count: 2},
+			{Group: "apps", Version: "v1", Kind: "Deployment", Count: 1},
+		},
+	}
+	if diff := cmp.Diff(want, got, cmpopts.IgnoreFields(fleetv1beta1.DiffedPlacementSummary{}, "Hash")); diff != "" {
+		t.Errorf("buildDiffedPlacementSummary() mismatch (-want, +got):\n%s", diff)
+	}
+
+	// The hash does not depend on the order of the placements or on when they are observed.
+	reobservedA := configMapA
+	reobservedA.ObservationTime = metav1.NewTime(time.Unix(200, 0))
+	reobservedA.FirstDiffedObservedTime = metav1.NewTime(time.Unix(150, 0))
+	reobserved, err := buildDiffedPlacementSummary([]fleetv1beta1.DiffedResourcePlacement{reobservedA, configMapB, deploy})
+	if err != nil {
+		t.Fatalf("buildDiffedPlacementSummary() = %v, want nil", err)
+	}
+	if reobserved.Hash != got.Hash {
+		t.Errorf("buildDiffedPlacementSummary() hash = %s, want %s", reobserved.Hash, got.Hash)
+	}
+
+	// The hash changes when the differences change.
+	changedB := configMapB
+	changedB.ObservedDiffs = []fleetv1beta1.PatchDetail{{Path: "/data/other", ValueInHub: "hub"}}
+	changed, err := buildDiffedPlacementSummary([]fleetv1beta1.DiffedResourcePlacement{deploy, changedB, configMapA})
+	if err != nil {
+		t.Fatalf("buildDiffedPlacementSummary() = %v, want nil", err)
+	}
+	if changed.Hash == got.Hash {
+		t.Errorf("buildDiffedPlacementSummary() hash = %s, want a different hash after the differences change", changed.Hash)
+	}
+}
+
+func TestSummarizeDiffedPlacements(t *testing.T) {
+	diffed := []fleetv1beta1.DiffedResourcePlacement{
+		{ResourceIdentifier: fleetv1beta1.ResourceIdentifier{Version: "v1", Kind: "ConfigMap", Namespace: "app", Name: "a"}},
+		{ResourceIdentifier: fleetv1beta1.ResourceIdentifier{Version: "v1", Kind: "ConfigMap", Namespace: "app", Name: "b"}},
+	}
+	reportDiff := &fleetv1beta1.ApplyStrategy{Type: fleetv1beta1.ApplyStrategyTypeReportDiff}
+
+	tests := map[string]struct {
+		threshold     int
+		applyStrategy *fleetv1beta1.ApplyStrategy
+		wantSummary   bool
+	}{
+		"summaries are disabled": {
+			threshold:     0,
+			applyStrategy: reportDiff,
+		},
+		"not using the ReportDiff apply strategy": {
+			threshold:     1,
+			applyStrategy: &fleetv1beta1.ApplyStrategy{Type: fleetv1beta1.ApplyStrategyTypeClientSideApply},
+		},
+		"within the threshold": {
+			threshold:     2,
+			applyStrategy: reportDiff,
+		},
+		"beyond the threshold": {
+			threshold:     1,
+			applyStrategy: reportDiff,
+			wantSummary:   true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			binding := &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{Name: "crp-binding"},
+				Spec:       fleetv1beta1.ResourceBindingSpec{ApplyStrategy: tt.applyStrategy},
+				Status:     fleetv1beta1.ResourceBindingStatus{DiffedPlacements: diffed},
+			}
+			r := &Reconciler{DiffedPlacementsSummaryThreshold: tt.threshold}
+			r.summarizeDiffedPlacements(binding, diffed)
+			if !tt.wantSummary {
+				if binding.Status.DiffedPlacementSummary != nil || len(binding.Status.DiffedPlacements) != len(diffed) {
+					t.Errorf("summarizeDiffedPlacements() summary = %v, diffed placements = %d, want no summary and %d diffed placements",
+						binding.Status.DiffedPlacementSummary, len(binding.Status.DiffedPlacements), len(diffed))
+				}
+				return
+			}
+			if binding.Status.DiffedPlacements != nil {
+				t.Errorf("summarizeDiffedPlacements() diffed placements = %v, want nil", binding.Status.DiffedPlacements)
+			}
+			if binding.Status.DiffedPlacementSummary == nil || binding.Status.DiffedPlacementSummary.Count != int32(len(diffed)) {
+				t.Errorf("summarizeDiffedPlacements() summary = %v, want a summary of %d diffed placements", binding.Status.DiffedPlacementSummary, len(diffed))
+			}
+		})
+	}
+}
+
+func TestSyncDiffReportsOnDemand(t *testing.T) {
+	ctx := context.Background()
+	now := time.Unix(1000, 0)
+	ttl := time.Hour
+	diffed := fleetv1beta1.DiffedResourcePlacement{
+		ResourceIdentifier: fleetv1beta1.ResourceIdentifier{Version: "v1", Kind: "ConfigMap", Namespace: "app", Name: "a"},
+		ObservedDiffs:      []fleetv1beta1.PatchDetail{{Path: "/data/key", ValueInMember: "member", ValueInHub: "hub"}},
+	}
+	existingPage := &fleetv1beta1.DiffReport{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "crp-diffreport-0",
+			Namespace: "fleet-member-member-1",
+			Labels: map[string]string{
+				fleetv1beta1.ParentBindingLabel:     "crp-binding",
+				fleetv1beta1.PlacementTrackingLabel: "crp",
+			},
+		},
+		Spec: fleetv1beta1.DiffReportSpec{
+			BindingName:          "crp-binding",
+			TargetCluster:        "member-1",
+			ResourceSnapshotName: "crp-1-snapshot",
+			PageCount:            1,
+		},
+	}
+	referenceExpiringAt := func(expiration time.Time) *fleetv1beta1.DiffReportReference {
+		return &fleetv1beta1.DiffReportReference{
+			Namespace:            "fleet-member-member-1",
+			Names:                []string{"crp-diffreport-0"},
+			ResourceSnapshotName: "crp-1-snapshot",
+			DiffedPlacementCount: 1,
+			ExpirationTime:       &metav1.Time{Time: expiration},
+		}
+	}
+
+	tests := map[string]struct {
+		requested         bool
+		reference         *fleetv1beta1.DiffReportReference
+		diffed            []fleetv1beta1.DiffedResourcePlacement
+		statusRefreshed   bool
+		wantReference     *fleetv1beta1.DiffReportReference
+		wantExpiresIn     time.Duration
+		wantPageSnapshots []string
+	}{
+		"not requested": {
+			diffed:          []fleetv1beta1.DiffedResourcePlacement{diffed},
+			statusRefreshed: true,
+		},
+		"requested": {
+			requested:         true,
+			diffed:            []fleetv1beta1.DiffedResourcePlacement{diffed},
+			statusRefreshed:   true,
+			wantReference:     referenceExpiringAt(now.Add(ttl)),
+			wantExpiresIn:     ttl,
+			wantPageSnapshots: []string{"crp-1-snapshot"},
+		},
+		"requested but the status is not refreshed": {
+			requested:       true,
+			diffed:          []fleetv1beta1.DiffedResourcePlacement{diffed},
+			statusRefreshed: false,
+		},
+		"requested with nothing to report": {
+			requested:       true,
+			statusRefreshed: true,
+		},
+		"active reports are kept up-to-date": {
+			reference:         referenceExpiringAt(now.Add(time.Minute)),
+			diffed:            []fleetv1beta1.DiffedResourcePlacement{diffed},
+			statusRefreshed:   true,
+			wantReference:     referenceExpiringAt(now.Add(time.Minute)),
+			wantExpiresIn:     time.Minute,
+			wantPageSnapshots: []string{"crp-1-snapshot"},
+		},
+		"active reports are left as they are if the status is not refreshed": {
+			reference:         referenceExpiringAt(now.Add(time.Minute)),
+			statusRefreshed:   false,
+			wantReference:     referenceExpiringAt(now.Add(time.Minute)),
+			wantExpiresIn:     time.Minute,
+			wantPageSnapshots: []string{"crp-0-snapshot"},
+		},
+		"expired reports are removed": {
+			reference:       referenceExpiringAt(now.Add(-time.Minute)),
+			diffed:          []fleetv1beta1.DiffedResourcePlacement{diffed},
+			statusRefreshed: true,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			page := existingPage.DeepCopy()
+			page.Spec.ResourceSnapshotName = "crp-0-snapshot"
+			fakeClient := fake.NewClientBuilder().WithScheme(serviceScheme(t)).WithObjects(page).Build()
+			r := &Reconciler{Client: fakeClient, DiffedPlacementsSummaryThreshold: 1, OnDemandDiffReportTTL: ttl}
+			binding := &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   "crp-binding",
+					Labels: map[string]string{fleetv1beta1.PlacementTrackingLabel: "crp"},
+				},
+				Spec: fleetv1beta1.ResourceBindingSpec{
+					TargetCluster:        "member-1",
+					ResourceSnapshotName: "crp-1-snapshot",
+					ApplyStrategy:        &fleetv1beta1.ApplyStrategy{Type: fleetv1beta1.ApplyStrategyTypeReportDiff},
+				},
+				Status: fleetv1beta1.ResourceBindingStatus{DiffReport: tt.reference},
+			}
+			if tt.requested {
+				binding.Annotations = map[string]string{fleetv1beta1.DiffReportRequestAnnotation: "1"}
+			}
+
+			gotExpiresIn, err := r.syncDiffReportsOnDemand(ctx, binding, nil, tt.diffed, tt.statusRefreshed, now)
+			if err != nil {
+				t.Fatalf("syncDiffReportsOnDemand() = %v, want nil", err)
+			}
+			if gotExpiresIn != tt.wantExpiresIn {
+				t.Errorf("syncDiffReportsOnDemand() = %v, want %v", gotExpiresIn, tt.wantExpiresIn)
+			}
+			if diff := cmp.Diff(tt.wantReference, binding.Status.DiffReport); diff != "" {
+				t.Errorf("syncDiffReportsOnDemand() binding status diff report mismatch (-want, +got):\n%s", diff)
+			}
+			reportList := &fleetv1beta1.DiffReportList{}
+			if err := fakeClient.List(ctx, reportList, client.InNamespace("fleet-member-member-1")); err != nil {
+				t.Fatalf("List() = %v, want nil", err)
+			}
+			gotPageSnapshots := make([]string, 0, len(reportList.Items))
+			for i := range reportList.Items {
+				gotPageSnapshots = append(gotPageSnapshots, reportList.Items[i].Spec.ResourceSnapshotName)
+			}
+			if diff := cmp.Diff(tt.wantPageSnapshots, gotPageSnapshots, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("syncDiffReportsOnDemand() diff report snapshots mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRemoveDiffReportRequest(t *testing.T) {
+	ctx := context.Background()
+	binding := &fleetv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "crp-binding",
+			Annotations: map[string]string{
+				fleetv1beta1.DiffReportRequestAnnotation: "1",
+				"other":                                  "value",
+			},
+		},
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(serviceScheme(t)).WithObjects(binding.DeepCopy()).Build()
+	r := &Reconciler{Client: fakeClient}
+	if err := r.removeDiffReportRequest(ctx, binding); err != nil {
+		t.Fatalf("removeDiffReportRequest() = %v, want nil", err)
+	}
+	got := &fleetv1beta1.ClusterResourceBinding{}
+	if err := fakeClient.Get(ctx, client.ObjectKey{Name: "crp-binding"}, got); err != nil {
+		t.Fatalf("Get() = %v, want nil", err)
+	}
+	if diff := cmp.Diff(map[string]string{"other": "value"}, got.Annotations); diff != "" {
+		t.Errorf("removeDiffReportRequest() annotations mismatch (-want, +got):\n%s", diff)
+	}
+}
+
+func TestDiffReportRequestedPredicate(t *testing.T) {
+	bindingWithRequest := func(request *string) *fleetv1beta1.ClusterResourceBinding {
+		binding := &fleetv1beta1.ClusterResourceBinding{ObjectMeta: metav1.ObjectMeta{Name: "crp-binding"}}
+		if request != nil {
+			binding.Annotations = map[string]string{fleetv1beta1.DiffReportRequestAnnotation: *request}
+		}
+		return binding
+	}
+	first, second := "1", "2"
+
+	tests := map[string]struct {
+		oldRequest *string
+		newRequest *string
+		want       bool
+	}{
+		"no request": {},
+		"new request": {
+			newRequest: &first,
+			want:       true,
+		},
+		"repeated request": {
+			oldRequest: &first,
+			newRequest: &second,
+			want:       true,
+		},
+		"unchanged request": {
+			oldRequest: &first,
+			newRequest: &first,
+		},
+		"request removed": {
+			oldRequest: &first,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			e := event.UpdateEvent{ObjectOld: bindingWithRequest(tt.oldRequest), ObjectNew: bindingWithRequest(tt.newRequest)}
+			if got := diffReportRequestedPredicate.Update(e); got != tt.want {
+				t.Errorf("diffReportRequestedPredicate.Update() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
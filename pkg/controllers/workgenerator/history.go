@@ -0,0 +1,132 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"fmt"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+var (
+	// maxBindingHistoryLimit indicates the max number of history entries to keep in the binding status.
+	maxBindingHistoryLimit = 20
+)
+
+// recordBindingHistory compares a binding against the latest entries in its history and appends
+// entries for the significant transitions found, i.e., state changes, resource snapshot switches,
+// and rollout failures and completions; the oldest entries are dropped when the history is full.
+//
+// The transitions are only observed when the work generator reconciles the binding; as a result,
+// transitions that occur in between two reconciliations are compacted into one entry.
+func recordBindingHistory(binding fleetv1beta1.BindingObj, now metav1.Time) {
+	bindingSpec := binding.GetBindingSpec()
+	bindingStatus := binding.GetBindingStatus()
+	newEntry := func(entryType fleetv1beta1.BindingHistoryEntryType, reason, message string) fleetv1beta1.BindingHistoryEntry {
+		return fleetv1beta1.BindingHistoryEntry{
+			Type:                 entryType,
+			Time:                 now,
+			State:                bindingSpec.State,
+			ResourceSnapshotName: bindingSpec.ResourceSnapshotName,
+			ObservedGeneration:   binding.GetGeneration(),
+			Reason:               reason,
+			Message:              message,
+		}
+	}
+
+	history := bindingStatus.History
+	switch {
+	case len(history) == 0:
+		history = append(history, newEntry(fleetv1beta1.BindingHistoryEntryTypeStateChanged, "",
+			fmt.Sprintf("The binding is %s with resource snapshot %s", bindingSpec.State, bindingSpec.ResourceSnapshotName)))
+	case history[len(history)-1].State != bindingSpec.State:
+		history = append(history, newEntry(fleetv1beta1.BindingHistoryEntryTypeStateChanged, "",
+			fmt.Sprintf("The binding state has changed from %s to %s", history[len(history)-1].State, bindingSpec.State)))
+	case history[len(history)-1].ResourceSnapshotName != bindingSpec.ResourceSnapshotName:
+		history = append(history, newEntry(fleetv1beta1.BindingHistoryEntryTypeResourceSnapshotChanged, "",
+			fmt.Sprintf("The binding has switched from resource snapshot %s to %s", history[len(history)-1].ResourceSnapshotName, bindingSpec.ResourceSnapshotName)))
+	}
+
+	if entryType, reason, message, found := rolloutOutcomeOf(binding); found && !isRolloutOutcomeRecorded(history, entryType, reason) {
+		history = append(history, newEntry(entryType, reason, message))
+	}
+
+	if len(history) > maxBindingHistoryLimit {
+		history = history[len(history)-maxBindingHistoryLimit:]
+	}
+	bindingStatus.History = history
+}
+
+// recordLastAppliedSnapshots records the resource snapshot and the override snapshots of a binding
+// in its status once the resources have been applied on the target cluster for the current
+// generation of the binding; otherwise the previously recorded snapshots are kept.
+func recordLastAppliedSnapshots(binding fleetv1beta1.BindingObj) {
+	appliedCond := binding.GetCondition(string(condition.AppliedCondition.ResourceBindingConditionType()))
+	if !condition.IsConditionStatusTrue(appliedCond, binding.GetGeneration()) {
+		return
+	}
+	bindingSpec := binding.GetBindingSpec()
+	binding.GetBindingStatus().LastAppliedSnapshots = &fleetv1beta1.BindingSnapshots{
+		ResourceSnapshotName:             bindingSpec.ResourceSnapshotName,
+		ResourceOverrideSnapshots:        bindingSpec.ResourceOverrideSnapshots,
+		ClusterResourceOverrideSnapshots: bindingSpec.ClusterResourceOverrideSnapshots,
+	}
+}
+
+// rolloutOutcomeOf returns the outcome of the rollout on a binding, as dictated by its conditions; it
+// returns false if the rollout is still in progress.
+func rolloutOutcomeOf(binding fleetv1beta1.BindingObj) (fleetv1beta1.BindingHistoryEntryType, string, string, bool) {
+	condTypes := condition.CondTypesForApplyStrategies
+	applyStrategy := binding.GetBindingSpec().ApplyStrategy
+	if applyStrategy != nil && applyStrategy.Type == fleetv1beta1.ApplyStrategyTypeReportDiff {
+		condTypes = condition.CondTypesForReportDiffApplyStrategy
+	}
+
+	for _, condType := range condTypes {
+		if condType == condition.RolloutStartedCondition {
+			// A False RolloutStarted condition means that the rollout is blocked by the rollout strategy,
+			// which is not a failure.
+			continue
+		}
+		cond := binding.GetCondition(string(condType.ResourceBindingConditionType()))
+		switch {
+		case condition.IsConditionStatusTrue(cond, binding.GetGeneration()):
+			continue
+		case condition.IsConditionStatusFalse(cond, binding.GetGeneration()) &&
+			cond.Reason != condition.WorkApplyInProcess && cond.Reason != condition.WorkDiffReportInProcess:
+			return fleetv1beta1.BindingHistoryEntryTypeRolloutFailed, cond.Reason,
+				fmt.Sprintf("The %s condition is False with %d failed resource placement(s): %s", cond.Type, len(binding.GetBindingStatus().FailedPlacements), cond.Message), true
+		default:
+			// The rollout is still in progress.
+			return "", "", "", false
+		}
+	}
+	return fleetv1beta1.BindingHistoryEntryTypeRolloutCompleted, "", "The resources have been rolled out to the target cluster", true
+}
+
+// isRolloutOutcomeRecorded checks if a rollout outcome is the latest entry in the history; a state
+// change or resource snapshot switch recorded after an outcome resets the rollout.
+func isRolloutOutcomeRecorded(history []fleetv1beta1.BindingHistoryEntry, entryType fleetv1beta1.BindingHistoryEntryType, reason string) bool {
+	if len(history) == 0 {
+		return false
+	}
+	latest := history[len(history)-1]
+	return latest.Type == entryType && latest.Reason == reason
+}
@@ -0,0 +1,357 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+func TestRecordBindingHistory(t *testing.T) {
+	now := metav1.NewTime(time.Now().Truncate(time.Second))
+	earlier := metav1.NewTime(now.Add(-time.Minute))
+	snapshotName := "snapshot-1"
+	newSnapshotName := "snapshot-2"
+
+	rolledOutConds := func(generation int64) []metav1.Condition {
+		conds := make([]metav1.Condition, 0, len(condition.CondTypesForApplyStrategies))
+		for _, condType := range condition.CondTypesForApplyStrategies {
+			conds = append(conds, metav1.Condition{
+				Type:               string(condType.ResourceBindingConditionType()),
+				Status:             metav1.ConditionTrue,
+				Reason:             "Succeeded",
+				ObservedGeneration: generation,
+			})
+		}
+		return conds
+	}
+	appliedFailedConds := []metav1.Condition{
+		{
+			Type:               string(fleetv1beta1.ResourceBindingRolloutStarted),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 1,
+		},
+		{
+			Type:               string(fleetv1beta1.ResourceBindingOverridden),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 1,
+		},
+		{
+			Type:               string(fleetv1beta1.ResourceBindingWorkSynchronized),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 1,
+		},
+		{
+			Type:               string(fleetv1beta1.ResourceBindingApplied),
+			Status:             metav1.ConditionFalse,
+			Reason:             condition.WorkNotAppliedReason,
+			Message:            "failed to apply",
+			ObservedGeneration: 1,
+		},
+	}
+	applyInProgressConds := []metav1.Condition{
+		{
+			Type:               string(fleetv1beta1.ResourceBindingOverridden),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 2,
+		},
+		{
+			Type:               string(fleetv1beta1.ResourceBindingWorkSynchronized),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 2,
+		},
+		{
+			Type:               string(fleetv1beta1.ResourceBindingApplied),
+			Status:             metav1.ConditionFalse,
+			Reason:             condition.WorkApplyInProcess,
+			ObservedGeneration: 2,
+		},
+	}
+	boundEntry := fleetv1beta1.BindingHistoryEntry{
+		Type:                 fleetv1beta1.BindingHistoryEntryTypeStateChanged,
+		Time:                 earlier,
+		State:                fleetv1beta1.BindingStateBound,
+		ResourceSnapshotName: snapshotName,
+		ObservedGeneration:   1,
+		Message:              "The binding is Bound with resource snapshot snapshot-1",
+	}
+	failedEntry := fleetv1beta1.BindingHistoryEntry{
+		Type:                 fleetv1beta1.BindingHistoryEntryTypeRolloutFailed,
+		Time:                 earlier,
+		State:                fleetv1beta1.BindingStateBound,
+		ResourceSnapshotName: snapshotName,
+		ObservedGeneration:   1,
+		Reason:               condition.WorkNotAppliedReason,
+		Message:              "The Applied condition is False with 0 failed resource placement(s): failed to apply",
+	}
+
+	testCases := []struct {
+		name        string
+		generation  int64
+		spec        fleetv1beta1.ResourceBindingSpec
+		status      fleetv1beta1.ResourceBindingStatus
+		wantHistory []fleetv1beta1.BindingHistoryEntry
+	}{
+		{
+			name:       "first reconciliation, rollout in progress",
+			generation: 1,
+			spec: fleetv1beta1.ResourceBindingSpec{
+				State:                fleetv1beta1.BindingStateBound,
+				ResourceSnapshotName: snapshotName,
+			},
+			wantHistory: []fleetv1beta1.BindingHistoryEntry{
+				{
+					Type:                 fleetv1beta1.BindingHistoryEntryTypeStateChanged,
+					Time:                 now,
+					State:                fleetv1beta1.BindingStateBound,
+					ResourceSnapshotName: snapshotName,
+					ObservedGeneration:   1,
+					Message:              "The binding is Bound with resource snapshot snapshot-1",
+				},
+			},
+		},
+		{
+			name:       "rollout failed",
+			generation: 1,
+			spec: fleetv1beta1.ResourceBindingSpec{
+				State:                fleetv1beta1.BindingStateBound,
+				ResourceSnapshotName: snapshotName,
+			},
+			status: fleetv1beta1.ResourceBindingStatus{
+				History:    []fleetv1beta1.BindingHistoryEntry{boundEntry},
+				Conditions: appliedFailedConds,
+			},
+			wantHistory: []fleetv1beta1.BindingHistoryEntry{
+				boundEntry,
+				{
+					Type:                 fleetv1beta1.BindingHistoryEntryTypeRolloutFailed,
+					Time:                 now,
+					State:                fleetv1beta1.BindingStateBound,
+					ResourceSnapshotName: snapshotName,
+					ObservedGeneration:   1,
+					Reason:               condition.WorkNotAppliedReason,
+					Message:              "The Applied condition is False with 0 failed resource placement(s): failed to apply",
+				},
+			},
+		},
+		{
+			name:       "same rollout failure is not recorded again",
+			generation: 1,
+			spec: fleetv1beta1.ResourceBindingSpec{
+				State:                fleetv1beta1.BindingStateBound,
+				ResourceSnapshotName: snapshotName,
+			},
+			status: fleetv1beta1.ResourceBindingStatus{
+				History:    []fleetv1beta1.BindingHistoryEntry{boundEntry, failedEntry},
+				Conditions: appliedFailedConds,
+			},
+			wantHistory: []fleetv1beta1.BindingHistoryEntry{boundEntry, failedEntry},
+		},
+		{
+			name:       "resource snapshot switched, apply in progress",
+			generation: 2,
+			spec: fleetv1beta1.ResourceBindingSpec{
+				State:                fleetv1beta1.BindingStateBound,
+				ResourceSnapshotName: newSnapshotName,
+			},
+			status: fleetv1beta1.ResourceBindingStatus{
+				History:    []fleetv1beta1.BindingHistoryEntry{boundEntry, failedEntry},
+				Conditions: applyInProgressConds,
+			},
+			wantHistory: []fleetv1beta1.BindingHistoryEntry{
+				boundEntry,
+				failedEntry,
+				{
+					Type:                 fleetv1beta1.BindingHistoryEntryTypeResourceSnapshotChanged,
+					Time:                 now,
+					State:                fleetv1beta1.BindingStateBound,
+					ResourceSnapshotName: newSnapshotName,
+					ObservedGeneration:   2,
+					Message:              "The binding has switched from resource snapshot snapshot-1 to snapshot-2",
+				},
+			},
+		},
+		{
+			name:       "state changed and rollout completed",
+			generation: 2,
+			spec: fleetv1beta1.ResourceBindingSpec{
+				State:                fleetv1beta1.BindingStateUnscheduled,
+				ResourceSnapshotName: snapshotName,
+			},
+			status: fleetv1beta1.ResourceBindingStatus{
+				History:    []fleetv1beta1.BindingHistoryEntry{boundEntry},
+				Conditions: rolledOutConds(2),
+			},
+			wantHistory: []fleetv1beta1.BindingHistoryEntry{
+				boundEntry,
+				{
+					Type:                 fleetv1beta1.BindingHistoryEntryTypeStateChanged,
+					Time:                 now,
+					State:                fleetv1beta1.BindingStateUnscheduled,
+					ResourceSnapshotName: snapshotName,
+					ObservedGeneration:   2,
+					Message:              "The binding state has changed from Bound to Unscheduled",
+				},
+				{
+					Type:                 fleetv1beta1.BindingHistoryEntryTypeRolloutCompleted,
+					Time:                 now,
+					State:                fleetv1beta1.BindingStateUnscheduled,
+					ResourceSnapshotName: snapshotName,
+					ObservedGeneration:   2,
+					Message:              "The resources have been rolled out to the target cluster",
+				},
+			},
+		},
+		{
+			name:       "history is full",
+			generation: 1,
+			spec: fleetv1beta1.ResourceBindingSpec{
+				State:                fleetv1beta1.BindingStateBound,
+				ResourceSnapshotName: snapshotName,
+			},
+			status: fleetv1beta1.ResourceBindingStatus{
+				History: func() []fleetv1beta1.BindingHistoryEntry {
+					history := make([]fleetv1beta1.BindingHistoryEntry, 0, maxBindingHistoryLimit)
+					for i := 0; i < maxBindingHistoryLimit; i++ {
+						entry := boundEntry
+						entry.Message = fmt.Sprintf("entry-%d", i)
+						history = append(history, entry)
+					}
+					return history
+				}(),
+				Conditions: rolledOutConds(1),
+			},
+			wantHistory: func() []fleetv1beta1.BindingHistoryEntry {
+				history := make([]fleetv1beta1.BindingHistoryEntry, 0, maxBindingHistoryLimit)
+				for i := 1; i < maxBindingHistoryLimit; i++ {
+					entry := boundEntry
+					entry.Message = fmt.Sprintf("entry-%d", i)
+					history = append(history, entry)
+				}
+				return append(history, fleetv1beta1.BindingHistoryEntry{
+					Type:                 fleetv1beta1.BindingHistoryEntryTypeRolloutCompleted,
+					Time:                 now,
+					State:                fleetv1beta1.BindingStateBound,
+					ResourceSnapshotName: snapshotName,
+					ObservedGeneration:   1,
+					Message:              "The resources have been rolled out to the target cluster",
+				})
+			}(),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			binding := &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "binding-1",
+					Generation: tc.generation,
+				},
+				Spec:   tc.spec,
+				Status: tc.status,
+			}
+			recordBindingHistory(binding, now)
+			if diff := cmp.Diff(binding.Status.History, tc.wantHistory); diff != "" {
+				t.Errorf("recordBindingHistory() history mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRecordLastAppliedSnapshots(t *testing.T) {
+	spec := fleetv1beta1.ResourceBindingSpec{
+		ResourceSnapshotName: "snapshot-2",
+		ResourceOverrideSnapshots: []fleetv1beta1.NamespacedName{
+			{Namespace: "app", Name: "ro-1"},
+		},
+		ClusterResourceOverrideSnapshots: []string{"cro-1"},
+	}
+	previouslyApplied := &fleetv1beta1.BindingSnapshots{
+		ResourceSnapshotName: "snapshot-1",
+	}
+	appliedCond := func(status metav1.ConditionStatus, generation int64) []metav1.Condition {
+		return []metav1.Condition{
+			{
+				Type:               string(fleetv1beta1.ResourceBindingApplied),
+				Status:             status,
+				ObservedGeneration: generation,
+			},
+		}
+	}
+
+	testCases := []struct {
+		name   string
+		status fleetv1beta1.ResourceBindingStatus
+		want   *fleetv1beta1.BindingSnapshots
+	}{
+		{
+			name: "applied for the current generation",
+			status: fleetv1beta1.ResourceBindingStatus{
+				Conditions:           appliedCond(metav1.ConditionTrue, 2),
+				LastAppliedSnapshots: previouslyApplied,
+			},
+			want: &fleetv1beta1.BindingSnapshots{
+				ResourceSnapshotName:             "snapshot-2",
+				ResourceOverrideSnapshots:        spec.ResourceOverrideSnapshots,
+				ClusterResourceOverrideSnapshots: spec.ClusterResourceOverrideSnapshots,
+			},
+		},
+		{
+			name: "applied for a previous generation",
+			status: fleetv1beta1.ResourceBindingStatus{
+				Conditions:           appliedCond(metav1.ConditionTrue, 1),
+				LastAppliedSnapshots: previouslyApplied,
+			},
+			want: previouslyApplied,
+		},
+		{
+			name: "failed to apply",
+			status: fleetv1beta1.ResourceBindingStatus{
+				Conditions:           appliedCond(metav1.ConditionFalse, 2),
+				LastAppliedSnapshots: previouslyApplied,
+			},
+			want: previouslyApplied,
+		},
+		{
+			name: "never applied",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			binding := &fleetv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       "binding-1",
+					Generation: 2,
+				},
+				Spec:   spec,
+				Status: tc.status,
+			}
+			recordLastAppliedSnapshots(binding)
+			if diff := cmp.Diff(binding.Status.LastAppliedSnapshots, tc.want); diff != "" {
+				t.Errorf("recordLastAppliedSnapshots() mismatches (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,84 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// applyJobRerunPolicy renames a selected Job per its re-run policy, so that the Job is re-run in
+// the member cluster when a new resource snapshot is rolled out.
+//
+// Jobs cannot be updated in place (their pod templates are immutable) and run only once; placing
+// the Job under a new name for each resource snapshot makes the work applier create a new Job and
+// remove the Job from the previous resource snapshot, instead of failing to patch the existing one.
+func applyJobRerunPolicy(selectedResource *placementv1beta1.ResourceContent, resourceSnapshotIndex string) error {
+	var job unstructured.Unstructured
+	if err := job.UnmarshalJSON(selectedResource.Raw); err != nil {
+		klog.ErrorS(err, "Selected resource has invalid content", "selectedResource", selectedResource.Raw)
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+	if job.GroupVersionKind().GroupKind() != utils.JobGK {
+		return nil
+	}
+
+	policy := job.GetAnnotations()[placementv1beta1.JobRerunPolicyAnnotation]
+	switch policy {
+	case "", placementv1beta1.JobRerunPolicyNever:
+		return nil
+	case placementv1beta1.JobRerunPolicyPerResourceSnapshot:
+	default:
+		return controller.NewUserError(fmt.Errorf("job %s has an invalid re-run policy %q, must be one of %q and %q",
+			klog.KObj(&job), policy, placementv1beta1.JobRerunPolicyNever, placementv1beta1.JobRerunPolicyPerResourceSnapshot))
+	}
+
+	originalName := job.GetName()
+	annotations := job.GetAnnotations()
+	annotations[placementv1beta1.JobOriginalNameAnnotation] = originalName
+	job.SetAnnotations(annotations)
+	job.SetName(jobRerunName(originalName, resourceSnapshotIndex))
+
+	rawContent, err := job.MarshalJSON()
+	if err != nil {
+		klog.ErrorS(err, "Failed to marshal the renamed job", "job", klog.KObj(&job))
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+	selectedResource.Raw = rawContent
+	klog.V(2).InfoS("Renamed the job per its re-run policy", "originalName", originalName, "job", klog.KObj(&job))
+	return nil
+}
+
+// jobRerunName returns the name of a Job placed with the PerResourceSnapshot re-run policy.
+//
+// Job names are used as label values on the pods the Jobs create, and cannot be longer than 63
+// characters; the original name is truncated if needed.
+func jobRerunName(name, resourceSnapshotIndex string) string {
+	maxNameLen := validation.LabelValueMaxLength - len(resourceSnapshotIndex) - 1
+	if len(name) > maxNameLen {
+		name = strings.TrimRight(name[:maxNameLen], "-.")
+	}
+	return fmt.Sprintf(placementv1beta1.JobRerunNameFmt, name, resourceSnapshotIndex)
+}
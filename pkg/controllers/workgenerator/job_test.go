@@ -0,0 +1,175 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+func TestApplyJobRerunPolicy(t *testing.T) {
+	job := func(annotations map[string]string) *batchv1.Job {
+		return &batchv1.Job{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "batch/v1",
+				Kind:       "Job",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "job",
+				Namespace:   "app",
+				Annotations: annotations,
+			},
+		}
+	}
+	configMap := &corev1.ConfigMap{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "job",
+			Namespace: "app",
+			Annotations: map[string]string{
+				placementv1beta1.JobRerunPolicyAnnotation: placementv1beta1.JobRerunPolicyPerResourceSnapshot,
+			},
+		},
+	}
+
+	tests := []struct {
+		name     string
+		resource runtime.Object
+		want     runtime.Object
+		wantErr  error
+	}{
+		{
+			name:     "job without a re-run policy",
+			resource: job(nil),
+			want:     job(nil),
+		},
+		{
+			name: "job with the Never re-run policy",
+			resource: job(map[string]string{
+				placementv1beta1.JobRerunPolicyAnnotation: placementv1beta1.JobRerunPolicyNever,
+			}),
+			want: job(map[string]string{
+				placementv1beta1.JobRerunPolicyAnnotation: placementv1beta1.JobRerunPolicyNever,
+			}),
+		},
+		{
+			name: "job with the PerResourceSnapshot re-run policy",
+			resource: job(map[string]string{
+				placementv1beta1.JobRerunPolicyAnnotation: placementv1beta1.JobRerunPolicyPerResourceSnapshot,
+			}),
+			want: func() *batchv1.Job {
+				j := job(map[string]string{
+					placementv1beta1.JobRerunPolicyAnnotation:  placementv1beta1.JobRerunPolicyPerResourceSnapshot,
+					placementv1beta1.JobOriginalNameAnnotation: "job",
+				})
+				j.Name = "job-3"
+				return j
+			}(),
+		},
+		{
+			name: "job with an invalid re-run policy",
+			resource: job(map[string]string{
+				placementv1beta1.JobRerunPolicyAnnotation: "Always",
+			}),
+			wantErr: controller.ErrUserError,
+		},
+		{
+			name:     "non-job resource with the re-run policy",
+			resource: configMap,
+			want:     configMap,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := json.Marshal(tc.resource)
+			if err != nil {
+				t.Fatalf("Failed to marshal the resource: %v", err)
+			}
+			selectedResource := &placementv1beta1.ResourceContent{RawExtension: runtime.RawExtension{Raw: raw}}
+			err = applyJobRerunPolicy(selectedResource, "3")
+			if gotErr, wantErr := err != nil, tc.wantErr != nil; gotErr != wantErr || !errors.Is(err, tc.wantErr) {
+				t.Fatalf("applyJobRerunPolicy() got error %v, want error %v", err, tc.wantErr)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			var got, want map[string]interface{}
+			if err := json.Unmarshal(selectedResource.Raw, &got); err != nil {
+				t.Fatalf("Failed to unmarshal the result: %v", err)
+			}
+			wantRaw, err := json.Marshal(tc.want)
+			if err != nil {
+				t.Fatalf("Failed to marshal the wanted resource: %v", err)
+			}
+			if err := json.Unmarshal(wantRaw, &want); err != nil {
+				t.Fatalf("Failed to unmarshal the wanted resource: %v", err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("applyJobRerunPolicy() resource mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestJobRerunName(t *testing.T) {
+	tests := []struct {
+		name                  string
+		jobName               string
+		resourceSnapshotIndex string
+		want                  string
+	}{
+		{
+			name:                  "short name",
+			jobName:               "job",
+			resourceSnapshotIndex: "12",
+			want:                  "job-12",
+		},
+		{
+			name:                  "long name is truncated",
+			jobName:               strings.Repeat("a", 63),
+			resourceSnapshotIndex: "12",
+			want:                  strings.Repeat("a", 60) + "-12",
+		},
+		{
+			name:                  "trailing dashes are trimmed after truncation",
+			jobName:               strings.Repeat("a", 59) + "-bbbb",
+			resourceSnapshotIndex: "12",
+			want:                  strings.Repeat("a", 59) + "-12",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := jobRerunName(tc.jobName, tc.resourceSnapshotIndex); got != tc.want {
+				t.Errorf("jobRerunName() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
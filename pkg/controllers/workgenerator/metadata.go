@@ -0,0 +1,153 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/util/workqueue"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// fetchPlacementSpec returns the spec of the placement that owns a binding, or nil if the placement is not found.
+func (r *Reconciler) fetchPlacementSpec(ctx context.Context, resourceBinding placementv1beta1.BindingObj) (*placementv1beta1.PlacementSpec, error) {
+	placementName := resourceBinding.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+	if placementName == "" {
+		return nil, nil
+	}
+	placementKey := types.NamespacedName{Namespace: resourceBinding.GetNamespace(), Name: placementName}
+	placement, err := controller.FetchPlacementFromNamespacedName(ctx, r.Client, placementKey)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		klog.ErrorS(err, "Failed to get the placement of the binding", "placement", placementKey, "binding", klog.KObj(resourceBinding))
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	return placement.GetPlacementSpec(), nil
+}
+
+// injectPlacementMetadata injects the labels and annotations specified in the metadata injection policy
+// of a placement into a selected resource, so that the resource can be attributed to the placement in
+// the member cluster.
+//
+// Envelopes are skipped, as the resources wrapped in them are placed as they are.
+func injectPlacementMetadata(selectedResource *placementv1beta1.ResourceContent, policy *placementv1beta1.MetadataInjectionPolicy,
+	placementName, clusterName, resourceSnapshotHash string) error {
+	if policy == nil {
+		return nil
+	}
+	var uResource unstructured.Unstructured
+	if err := uResource.UnmarshalJSON(selectedResource.Raw); err != nil {
+		klog.ErrorS(err, "Selected resource has invalid content", "selectedResource", selectedResource.Raw)
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+	if gk := uResource.GroupVersionKind().GroupKind(); gk == utils.ClusterResourceEnvelopeGK || gk == utils.ResourceEnvelopeGK {
+		return nil
+	}
+
+	replacer := strings.NewReplacer(
+		placementv1beta1.MetadataInjectionPlacementNameVariable, placementName,
+		placementv1beta1.MetadataInjectionClusterNameVariable, clusterName,
+		placementv1beta1.MetadataInjectionResourceSnapshotHashVariable, resourceSnapshotHash,
+	)
+	labels := uResource.GetLabels()
+	if labels == nil {
+		labels = make(map[string]string)
+	}
+	annotations := uResource.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	if policy.Standard {
+		labels[placementv1beta1.PlacementNameMetadataKey] = placementName
+		labels[placementv1beta1.MemberClusterNameMetadataKey] = clusterName
+		annotations[placementv1beta1.ResourceSnapshotHashMetadataKey] = resourceSnapshotHash
+	}
+	for key, template := range policy.Labels {
+		value := replacer.Replace(template)
+		if errs := validation.IsValidLabelValue(value); len(errs) != 0 {
+			return controller.NewUserError(fmt.Errorf("the injected label %s has an invalid value %q: %s", key, value, strings.Join(errs, "; ")))
+		}
+		labels[key] = value
+	}
+	for key, template := range policy.Annotations {
+		annotations[key] = replacer.Replace(template)
+	}
+	if len(labels) > 0 {
+		uResource.SetLabels(labels)
+	}
+	if len(annotations) > 0 {
+		uResource.SetAnnotations(annotations)
+	}
+
+	rawContent, err := uResource.MarshalJSON()
+	if err != nil {
+		klog.ErrorS(err, "Failed to marshal the resource with injected metadata", "resource", klog.KObj(&uResource))
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+	selectedResource.Raw = rawContent
+	return nil
+}
+
+// metadataInjectionHandlerFuncs enqueues the bindings of a placement when the metadata injection policy or
+// the resource name transform of the placement changes, so that the works are regenerated with the new
+// labels, annotations and names.
+func (r *Reconciler) metadataInjectionHandlerFuncs() handler.Funcs {
+	return handler.Funcs{
+		UpdateFunc: func(ctx context.Context, evt event.UpdateEvent, queue workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+			oldPlacement, oldOK := evt.ObjectOld.(placementv1beta1.PlacementObj)
+			newPlacement, newOK := evt.ObjectNew.(placementv1beta1.PlacementObj)
+			if !oldOK || !newOK {
+				klog.ErrorS(controller.NewUnexpectedBehaviorError(fmt.Errorf("received update event %v with non-placement objects", evt)),
+					"Failed to process an update event for placement object")
+				return
+			}
+			if equality.Semantic.DeepEqual(oldPlacement.GetPlacementSpec().MetadataInjection, newPlacement.GetPlacementSpec().MetadataInjection) &&
+				equality.Semantic.DeepEqual(oldPlacement.GetPlacementSpec().ResourceNameTransform, newPlacement.GetPlacementSpec().ResourceNameTransform) {
+				return
+			}
+			placementKey := types.NamespacedName{Namespace: newPlacement.GetNamespace(), Name: newPlacement.GetName()}
+			bindings, err := controller.ListBindingsFromKey(ctx, r.Client, placementKey, true)
+			if err != nil {
+				klog.ErrorS(err, "Failed to list the bindings of the placement", "placement", placementKey)
+				return
+			}
+			klog.V(2).InfoS("The metadata injection policy or the resource name transform of a placement has changed", "placement", placementKey, "numberOfBindings", len(bindings))
+			for _, binding := range bindings {
+				queue.Add(reconcile.Request{NamespacedName: types.NamespacedName{
+					Name:      binding.GetName(),
+					Namespace: binding.GetNamespace(),
+				}})
+			}
+		},
+	}
+}
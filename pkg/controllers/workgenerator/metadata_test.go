@@ -0,0 +1,147 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"encoding/json"
+	"errors"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+func TestInjectPlacementMetadata(t *testing.T) {
+	configMap := func(labels, annotations map[string]string) *corev1.ConfigMap {
+		return &corev1.ConfigMap{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "v1",
+				Kind:       "ConfigMap",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "config",
+				Namespace:   "app",
+				Labels:      labels,
+				Annotations: annotations,
+			},
+		}
+	}
+	envelope := &placementv1beta1.ResourceEnvelope{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: placementv1beta1.GroupVersion.String(),
+			Kind:       placementv1beta1.ResourceEnvelopeKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "envelope",
+			Namespace: "app",
+		},
+	}
+
+	tests := []struct {
+		name     string
+		resource runtime.Object
+		policy   *placementv1beta1.MetadataInjectionPolicy
+		want     runtime.Object
+		wantErr  error
+	}{
+		{
+			name:     "no policy",
+			resource: configMap(nil, nil),
+			want:     configMap(nil, nil),
+		},
+		{
+			name:     "standard metadata",
+			resource: configMap(map[string]string{"app": "web"}, nil),
+			policy:   &placementv1beta1.MetadataInjectionPolicy{Standard: true},
+			want: configMap(map[string]string{
+				"app": "web",
+				placementv1beta1.PlacementNameMetadataKey:     "crp-1",
+				placementv1beta1.MemberClusterNameMetadataKey: "member-1",
+			}, map[string]string{
+				placementv1beta1.ResourceSnapshotHashMetadataKey: "abc123",
+			}),
+		},
+		{
+			name:     "custom labels and annotations with variables",
+			resource: configMap(nil, nil),
+			policy: &placementv1beta1.MetadataInjectionPolicy{
+				Labels: map[string]string{
+					"example.com/placement": "crp-${PLACEMENT-NAME}",
+				},
+				Annotations: map[string]string{
+					"example.com/source": "${PLACEMENT-NAME}/${MEMBER-CLUSTER-NAME}@${RESOURCE-SNAPSHOT-HASH}",
+				},
+			},
+			want: configMap(map[string]string{
+				"example.com/placement": "crp-crp-1",
+			}, map[string]string{
+				"example.com/source": "crp-1/member-1@abc123",
+			}),
+		},
+		{
+			name:     "rendered label value is invalid",
+			resource: configMap(nil, nil),
+			policy: &placementv1beta1.MetadataInjectionPolicy{
+				Labels: map[string]string{
+					"example.com/placement": "${PLACEMENT-NAME}/${MEMBER-CLUSTER-NAME}",
+				},
+			},
+			wantErr: controller.ErrUserError,
+		},
+		{
+			name:     "envelope is skipped",
+			resource: envelope,
+			policy:   &placementv1beta1.MetadataInjectionPolicy{Standard: true},
+			want:     envelope,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := json.Marshal(tc.resource)
+			if err != nil {
+				t.Fatalf("Failed to marshal the resource: %v", err)
+			}
+			selectedResource := &placementv1beta1.ResourceContent{RawExtension: runtime.RawExtension{Raw: raw}}
+			err = injectPlacementMetadata(selectedResource, tc.policy, "crp-1", "member-1", "abc123")
+			if gotErr, wantErr := err != nil, tc.wantErr != nil; gotErr != wantErr || !errors.Is(err, tc.wantErr) {
+				t.Fatalf("injectPlacementMetadata() got error %v, want error %v", err, tc.wantErr)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			var got, want map[string]interface{}
+			if err := json.Unmarshal(selectedResource.Raw, &got); err != nil {
+				t.Fatalf("Failed to unmarshal the result: %v", err)
+			}
+			wantRaw, err := json.Marshal(tc.want)
+			if err != nil {
+				t.Fatalf("Failed to marshal the wanted resource: %v", err)
+			}
+			if err := json.Unmarshal(wantRaw, &want); err != nil {
+				t.Fatalf("Failed to unmarshal the wanted resource: %v", err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("injectPlacementMetadata() resource mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog/v2"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// transformResourceName renames a selected cluster-scoped resource per the resource name transform of
+// its placement, so that the same resource can be placed on a member cluster by multiple placements
+// without name collisions.
+//
+// Namespaced resources, namespaces, CRDs and envelopes are left alone.
+func transformResourceName(selectedResource *placementv1beta1.ResourceContent, transform *placementv1beta1.ResourceNameTransform,
+	placementName, clusterName string) error {
+	if transform == nil || (transform.Prefix == "" && transform.Suffix == "") {
+		return nil
+	}
+	var uResource unstructured.Unstructured
+	if err := uResource.UnmarshalJSON(selectedResource.Raw); err != nil {
+		klog.ErrorS(err, "Selected resource has invalid content", "selectedResource", selectedResource.Raw)
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+	if uResource.GetNamespace() != "" {
+		return nil
+	}
+	gvk := uResource.GroupVersionKind()
+	switch {
+	case gvk.GroupKind() == utils.NamespaceGVK.GroupKind():
+		return nil
+	case gvk.Group == utils.CRDMetaGVK.Group && gvk.Kind == utils.CRDMetaGVK.Kind:
+		return nil
+	case gvk.GroupKind() == utils.ClusterResourceEnvelopeGK:
+		return nil
+	}
+
+	replacer := strings.NewReplacer(
+		placementv1beta1.MetadataInjectionPlacementNameVariable, placementName,
+		placementv1beta1.MetadataInjectionClusterNameVariable, clusterName,
+	)
+	originalName := uResource.GetName()
+	name := replacer.Replace(transform.Prefix) + originalName + replacer.Replace(transform.Suffix)
+	if len(name) > validation.DNS1123SubdomainMaxLength {
+		return controller.NewUserError(fmt.Errorf("the transformed name %s of resource %s is longer than %d characters",
+			name, klog.KObj(&uResource), validation.DNS1123SubdomainMaxLength))
+	}
+
+	annotations := uResource.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[placementv1beta1.OriginalNameAnnotation] = originalName
+	uResource.SetAnnotations(annotations)
+	uResource.SetName(name)
+
+	rawContent, err := uResource.MarshalJSON()
+	if err != nil {
+		klog.ErrorS(err, "Failed to marshal the renamed resource", "resource", klog.KObj(&uResource))
+		return controller.NewUnexpectedBehaviorError(err)
+	}
+	selectedResource.Raw = rawContent
+	klog.V(2).InfoS("Renamed the resource per the resource name transform", "originalName", originalName, "resource", klog.KObj(&uResource))
+	return nil
+}
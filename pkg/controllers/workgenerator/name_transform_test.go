@@ -0,0 +1,141 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+func TestTransformResourceName(t *testing.T) {
+	clusterRole := func(name string, annotations map[string]string) *rbacv1.ClusterRole {
+		return &rbacv1.ClusterRole{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: "rbac.authorization.k8s.io/v1",
+				Kind:       "ClusterRole",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: annotations,
+			},
+		}
+	}
+	namespace := &corev1.Namespace{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+		ObjectMeta: metav1.ObjectMeta{Name: "app"},
+	}
+	crd := &apiextensionsv1.CustomResourceDefinition{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apiextensions.k8s.io/v1", Kind: "CustomResourceDefinition"},
+		ObjectMeta: metav1.ObjectMeta{Name: "tests.example.com"},
+	}
+	configMap := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Name: "config", Namespace: "app"},
+	}
+
+	tests := []struct {
+		name      string
+		resource  runtime.Object
+		transform *placementv1beta1.ResourceNameTransform
+		want      runtime.Object
+		wantErr   error
+	}{
+		{
+			name:     "no transform",
+			resource: clusterRole("operator", nil),
+			want:     clusterRole("operator", nil),
+		},
+		{
+			name:     "cluster-scoped resource with prefix and suffix",
+			resource: clusterRole("operator", map[string]string{"team": "a"}),
+			transform: &placementv1beta1.ResourceNameTransform{
+				Prefix: "${PLACEMENT-NAME}-",
+				Suffix: "-${MEMBER-CLUSTER-NAME}",
+			},
+			want: clusterRole("crp-1-operator-member-1", map[string]string{
+				"team":                                  "a",
+				placementv1beta1.OriginalNameAnnotation: "operator",
+			}),
+		},
+		{
+			name:      "namespace is not renamed",
+			resource:  namespace,
+			transform: &placementv1beta1.ResourceNameTransform{Suffix: "-${MEMBER-CLUSTER-NAME}"},
+			want:      namespace,
+		},
+		{
+			name:      "CRD is not renamed",
+			resource:  crd,
+			transform: &placementv1beta1.ResourceNameTransform{Suffix: "-${MEMBER-CLUSTER-NAME}"},
+			want:      crd,
+		},
+		{
+			name:      "namespaced resource is not renamed",
+			resource:  configMap,
+			transform: &placementv1beta1.ResourceNameTransform{Prefix: "${PLACEMENT-NAME}-"},
+			want:      configMap,
+		},
+		{
+			name:      "transformed name is too long",
+			resource:  clusterRole(strings.Repeat("a", 250), nil),
+			transform: &placementv1beta1.ResourceNameTransform{Suffix: "-${MEMBER-CLUSTER-NAME}"},
+			wantErr:   controller.ErrUserError,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			raw, err := json.Marshal(tc.resource)
+			if err != nil {
+				t.Fatalf("Failed to marshal the resource: %v", err)
+			}
+			selectedResource := &placementv1beta1.ResourceContent{RawExtension: runtime.RawExtension{Raw: raw}}
+			err = transformResourceName(selectedResource, tc.transform, "crp-1", "member-1")
+			if gotErr, wantErr := err != nil, tc.wantErr != nil; gotErr != wantErr || !errors.Is(err, tc.wantErr) {
+				t.Fatalf("transformResourceName() got error %v, want error %v", err, tc.wantErr)
+			}
+			if tc.wantErr != nil {
+				return
+			}
+			var got, want map[string]interface{}
+			if err := json.Unmarshal(selectedResource.Raw, &got); err != nil {
+				t.Fatalf("Failed to unmarshal the result: %v", err)
+			}
+			wantRaw, err := json.Marshal(tc.want)
+			if err != nil {
+				t.Fatalf("Failed to marshal the wanted resource: %v", err)
+			}
+			if err := json.Unmarshal(wantRaw, &want); err != nil {
+				t.Fatalf("Failed to unmarshal the wanted resource: %v", err)
+			}
+			if diff := cmp.Diff(want, got); diff != "" {
+				t.Errorf("transformResourceName() resource mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -20,6 +20,7 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"sort"
 	"strings"
 
 	jsonpatch "github.com/evanphx/json-patch/v5"
@@ -106,11 +107,12 @@ func (r *Reconciler) fetchResourceOverrideSnapshots(ctx context.Context, resourc
 
 // applyOverrides applies the overrides on the selected resources.
 // The resource could be selected by both ClusterResourceOverride and ResourceOverride.
+// The property selectors of the override rules are evaluated against the property snapshot if it is not nil.
 // It returns
 //   - true if the resource is deleted by the overrides.
 //   - an error if the override rules are invalid.
 func (r *Reconciler) applyOverrides(resource *placementv1beta1.ResourceContent, cluster *clusterv1beta1.MemberCluster,
-	croMap map[placementv1beta1.ResourceIdentifier][]*placementv1beta1.ClusterResourceOverrideSnapshot, roMap map[placementv1beta1.ResourceIdentifier][]*placementv1beta1.ResourceOverrideSnapshot) (bool, error) {
+	propertySnapshot *placementv1beta1.ClusterPropertySnapshot, croMap map[placementv1beta1.ResourceIdentifier][]*placementv1beta1.ClusterResourceOverrideSnapshot, roMap map[placementv1beta1.ResourceIdentifier][]*placementv1beta1.ResourceOverrideSnapshot) (bool, error) {
 	if len(croMap) == 0 && len(roMap) == 0 {
 		return false, nil
 	}
@@ -140,22 +142,10 @@ func (r *Reconciler) applyOverrides(resource *placementv1beta1.ResourceContent,
 		}
 	}
 
-	// Apply ClusterResourceOverrideSnapshots.
-	for _, snapshot := range croMap[key] {
-		if snapshot.Spec.OverrideSpec.Policy == nil {
-			err := fmt.Errorf("invalid clusterResourceOverrideSnapshot %s: policy is nil", snapshot.Name)
-			klog.ErrorS(controller.NewUnexpectedBehaviorError(err), "Found an invalid clusterResourceOverrideSnapshot", "clusterResourceOverrideSnapshot", klog.KObj(snapshot))
-			continue // should not happen
-		}
-		if err := applyOverrideRules(resource, cluster, snapshot.Spec.OverrideSpec.Policy.OverrideRules); err != nil {
-			klog.ErrorS(err, "Failed to apply the override rules", "clusterResourceOverrideSnapshot", klog.KObj(snapshot))
-			return false, err
-		}
-	}
-	klog.V(2).InfoS("Applied clusterResourceOverrideSnapshots", "resource", klog.KObj(&uResource), "numberOfOverrides", len(croMap[key]))
-
-	// If the resource is selected by both ClusterResourceOverride and ResourceOverride, ResourceOverride will win when resolving conflicts.
-	// Apply ResourceOverrideSnapshots.
+	croSnapshots := croMap[key]
+	// If the resource is selected by both ClusterResourceOverride and ResourceOverride of the same priority tier,
+	// ResourceOverride will win when resolving conflicts.
+	var roSnapshots []*placementv1beta1.ResourceOverrideSnapshot
 	if !isClusterScopeResource {
 		key = placementv1beta1.ResourceIdentifier{
 			Group:     gvk.Group,
@@ -164,25 +154,116 @@ func (r *Reconciler) applyOverrides(resource *placementv1beta1.ResourceContent,
 			Name:      uResource.GetName(),
 			Namespace: uResource.GetNamespace(),
 		}
-		for _, snapshot := range roMap[key] {
-			if snapshot.Spec.OverrideSpec.Policy == nil {
-				err := fmt.Errorf("invalid resourceOverrideSnapshot %s: policy is nil", snapshot.Name)
-				klog.ErrorS(controller.NewUnexpectedBehaviorError(err), "Found an invalid resourceOverrideSnapshot", "resourceOverrideSnapshot", klog.KObj(snapshot))
-				continue // should not happen
+		roSnapshots = roMap[key]
+	}
+
+	for _, o := range orderOverrides(croSnapshots, roSnapshots) {
+		overrideRef := klog.KRef(o.Namespace, o.Name)
+		if o.policy == nil {
+			err := fmt.Errorf("invalid override snapshot %s: policy is nil", overrideRef)
+			klog.ErrorS(controller.NewUnexpectedBehaviorError(err), "Found an invalid override snapshot", "overrideSnapshot", overrideRef)
+			continue // should not happen
+		}
+		if err := applyOverrideRules(resource, cluster, propertySnapshot, o.policy.OverrideRules); err != nil {
+			klog.ErrorS(err, "Failed to apply the override rules", "overrideSnapshot", overrideRef)
+			return false, err
+		}
+	}
+	klog.V(2).InfoS("Applied override snapshots", "resource", klog.KObj(&uResource),
+		"numberOfClusterResourceOverrides", len(croSnapshots), "numberOfResourceOverrides", len(roSnapshots))
+	return resource.Raw == nil, nil
+}
+
+// orderedOverride is an override snapshot to be applied on the selected resources.
+type orderedOverride struct {
+	placementv1beta1.EffectiveOverride
+	policy *placementv1beta1.OverridePolicy
+}
+
+// orderOverrides lists the ClusterResourceOverride and ResourceOverride snapshots in the order in which
+// they are applied, i.e., by their priority tiers from the lowest to the highest; within the same tier, the
+// ClusterResourceOverride snapshots come before the ResourceOverride snapshots, and the snapshots of the same
+// kind are ordered by their namespaces and names.
+func orderOverrides(croSnapshots []*placementv1beta1.ClusterResourceOverrideSnapshot, roSnapshots []*placementv1beta1.ResourceOverrideSnapshot) []orderedOverride {
+	res := make([]orderedOverride, 0, len(croSnapshots)+len(roSnapshots))
+	for _, snapshot := range croSnapshots {
+		res = append(res, orderedOverride{
+			EffectiveOverride: placementv1beta1.EffectiveOverride{
+				Name:         snapshot.Name,
+				PriorityTier: snapshot.Spec.OverrideSpec.PriorityTier,
+			},
+			policy: snapshot.Spec.OverrideSpec.Policy,
+		})
+	}
+	for _, snapshot := range roSnapshots {
+		res = append(res, orderedOverride{
+			EffectiveOverride: placementv1beta1.EffectiveOverride{
+				Name:         snapshot.Name,
+				Namespace:    snapshot.Namespace,
+				PriorityTier: snapshot.Spec.OverrideSpec.PriorityTier,
+			},
+			policy: snapshot.Spec.OverrideSpec.Policy,
+		})
+	}
+	sort.SliceStable(res, func(i, j int) bool {
+		iRank, jRank := overrider.PriorityTierRank(res[i].PriorityTier), overrider.PriorityTierRank(res[j].PriorityTier)
+		if iRank != jRank {
+			return iRank < jRank
+		}
+		// ClusterResourceOverride snapshots have no namespace and thus come first.
+		if res[i].Namespace != res[j].Namespace {
+			return res[i].Namespace < res[j].Namespace
+		}
+		return res[i].Name < res[j].Name
+	})
+	return res
+}
+
+// syncEffectiveOverrides lists the override snapshots of a binding in its status, in the order in which
+// they are applied on the selected resources.
+func (r *Reconciler) syncEffectiveOverrides(ctx context.Context, resourceBinding placementv1beta1.BindingObj) error {
+	bindingSpec := resourceBinding.GetBindingSpec()
+	croSnapshots := make([]*placementv1beta1.ClusterResourceOverrideSnapshot, 0, len(bindingSpec.ClusterResourceOverrideSnapshots))
+	for _, name := range bindingSpec.ClusterResourceOverrideSnapshots {
+		snapshot := &placementv1beta1.ClusterResourceOverrideSnapshot{}
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: name}, snapshot); err != nil {
+			if errors.IsNotFound(err) {
+				// The missing snapshot has been reported in the Overridden condition.
+				continue
 			}
-			if err := applyOverrideRules(resource, cluster, snapshot.Spec.OverrideSpec.Policy.OverrideRules); err != nil {
-				klog.ErrorS(err, "Failed to apply the override rules", "resourceOverrideSnapshot", klog.KObj(snapshot))
-				return false, err
+			klog.ErrorS(err, "Failed to get the clusterResourceOverrideSnapshot",
+				"binding", klog.KObj(resourceBinding), "clusterResourceOverrideSnapshot", name)
+			return controller.NewAPIServerError(true, err)
+		}
+		croSnapshots = append(croSnapshots, snapshot)
+	}
+	roSnapshots := make([]*placementv1beta1.ResourceOverrideSnapshot, 0, len(bindingSpec.ResourceOverrideSnapshots))
+	for _, namespacedName := range bindingSpec.ResourceOverrideSnapshots {
+		snapshot := &placementv1beta1.ResourceOverrideSnapshot{}
+		if err := r.Client.Get(ctx, types.NamespacedName{Name: namespacedName.Name, Namespace: namespacedName.Namespace}, snapshot); err != nil {
+			if errors.IsNotFound(err) {
+				// The missing snapshot has been reported in the Overridden condition.
+				continue
 			}
+			klog.ErrorS(err, "Failed to get the resourceOverrideSnapshot",
+				"binding", klog.KObj(resourceBinding), "resourceOverrideSnapshot", namespacedName)
+			return controller.NewAPIServerError(true, err)
 		}
-		klog.V(2).InfoS("Applied resourceOverrideSnapshots", "resource", klog.KObj(&uResource), "numberOfOverrides", len(roMap[key]))
+		roSnapshots = append(roSnapshots, snapshot)
 	}
-	return resource.Raw == nil, nil
+
+	var effectiveOverrides []placementv1beta1.EffectiveOverride
+	for _, o := range orderOverrides(croSnapshots, roSnapshots) {
+		effectiveOverrides = append(effectiveOverrides, o.EffectiveOverride)
+	}
+	resourceBinding.GetBindingStatus().EffectiveOverrides = effectiveOverrides
+	return nil
 }
 
-func applyOverrideRules(resource *placementv1beta1.ResourceContent, cluster *clusterv1beta1.MemberCluster, rules []placementv1beta1.OverrideRule) error {
+func applyOverrideRules(resource *placementv1beta1.ResourceContent, cluster *clusterv1beta1.MemberCluster,
+	propertySnapshot *placementv1beta1.ClusterPropertySnapshot, rules []placementv1beta1.OverrideRule) error {
 	for _, rule := range rules {
-		matched, err := overrider.IsClusterMatched(cluster, rule)
+		matched, err := overrider.IsClusterMatched(cluster, rule, propertySnapshot)
 		if err != nil {
 			klog.ErrorS(controller.NewUnexpectedBehaviorError(err), "Found an invalid override rule")
 			return controller.NewUserError(err) // should not happen though and should be rejected by the webhook
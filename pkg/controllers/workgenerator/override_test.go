@@ -427,6 +427,101 @@ func TestFetchResourceOverrideSnapshot(t *testing.T) {
 	}
 }
 
+func TestSyncEffectiveOverrides(t *testing.T) {
+	croSnapshot := func(name string, tier placementv1beta1.OverridePriorityTier) *placementv1beta1.ClusterResourceOverrideSnapshot {
+		return &placementv1beta1.ClusterResourceOverrideSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: placementv1beta1.ClusterResourceOverrideSnapshotSpec{
+				OverrideSpec: placementv1beta1.ClusterResourceOverrideSpec{
+					PriorityTier: tier,
+				},
+			},
+		}
+	}
+	roSnapshot := func(namespace, name string, tier placementv1beta1.OverridePriorityTier) *placementv1beta1.ResourceOverrideSnapshot {
+		return &placementv1beta1.ResourceOverrideSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Namespace: namespace,
+				Name:      name,
+			},
+			Spec: placementv1beta1.ResourceOverrideSnapshotSpec{
+				OverrideSpec: placementv1beta1.ResourceOverrideSpec{
+					PriorityTier: tier,
+				},
+			},
+		}
+	}
+	objects := []client.Object{
+		croSnapshot("cro-1", placementv1beta1.OverridePriorityTierPlatform),
+		croSnapshot("cro-2", ""),
+		croSnapshot("cro-3", placementv1beta1.OverridePriorityTierTeam),
+		roSnapshot("ns-1", "ro-1", placementv1beta1.OverridePriorityTierTeam),
+		roSnapshot("ns-1", "ro-2", ""),
+		roSnapshot("ns-2", "ro-1", placementv1beta1.OverridePriorityTierApp),
+	}
+
+	tests := []struct {
+		name string
+		spec placementv1beta1.ResourceBindingSpec
+		want []placementv1beta1.EffectiveOverride
+	}{
+		{
+			name: "no overrides",
+		},
+		{
+			name: "overrides of different priority tiers",
+			spec: placementv1beta1.ResourceBindingSpec{
+				ClusterResourceOverrideSnapshots: []string{"cro-1", "cro-2", "cro-3"},
+				ResourceOverrideSnapshots: []placementv1beta1.NamespacedName{
+					{Namespace: "ns-2", Name: "ro-1"},
+					{Namespace: "ns-1", Name: "ro-1"},
+					{Namespace: "ns-1", Name: "ro-2"},
+				},
+			},
+			want: []placementv1beta1.EffectiveOverride{
+				{Name: "cro-2"},
+				{Namespace: "ns-1", Name: "ro-2"},
+				{Namespace: "ns-2", Name: "ro-1", PriorityTier: placementv1beta1.OverridePriorityTierApp},
+				// The cluster resource overrides come before the resource overrides of the same tier.
+				{Name: "cro-3", PriorityTier: placementv1beta1.OverridePriorityTierTeam},
+				{Namespace: "ns-1", Name: "ro-1", PriorityTier: placementv1beta1.OverridePriorityTierTeam},
+				{Name: "cro-1", PriorityTier: placementv1beta1.OverridePriorityTierPlatform},
+			},
+		},
+		{
+			name: "snapshot not found",
+			spec: placementv1beta1.ResourceBindingSpec{
+				ClusterResourceOverrideSnapshots: []string{"cro-1", "not-found"},
+			},
+			want: []placementv1beta1.EffectiveOverride{
+				{Name: "cro-1", PriorityTier: placementv1beta1.OverridePriorityTierPlatform},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(serviceScheme(t)).
+				WithObjects(objects...).
+				Build()
+			r := Reconciler{
+				Client: fakeClient,
+			}
+			binding := &placementv1beta1.ClusterResourceBinding{
+				Spec: tc.spec,
+			}
+			if err := r.syncEffectiveOverrides(context.Background(), binding); err != nil {
+				t.Fatalf("syncEffectiveOverrides() got error %v, want no error", err)
+			}
+			if diff := cmp.Diff(tc.want, binding.Status.EffectiveOverrides); diff != "" {
+				t.Errorf("syncEffectiveOverrides() effective overrides mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestApplyOverrides_clusterScopedResource(t *testing.T) {
 	fakeInformer := informer.FakeManager{
 		APIResources: map[schema.GroupVersionKind]bool{
@@ -1110,7 +1205,7 @@ func TestApplyOverrides_clusterScopedResource(t *testing.T) {
 				InformerManager: &fakeInformer,
 			}
 			rc := resource.CreateResourceContentForTest(t, tc.clusterRole)
-			gotDeleted, err := r.applyOverrides(rc, &tc.cluster, tc.croMap, nil)
+			gotDeleted, err := r.applyOverrides(rc, &tc.cluster, nil, tc.croMap, nil)
 			if gotErr, wantErr := err != nil, tc.wantErr != nil; gotErr != wantErr || !errors.Is(err, tc.wantErr) {
 				t.Fatalf("applyOverrides() got error %v, want error %v", err, tc.wantErr)
 			}
@@ -1635,6 +1730,111 @@ func TestApplyOverrides_namespacedScopeResource(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "clusterResourceOverride of a higher priority tier wins",
+			deployment: appsv1.Deployment{
+				TypeMeta: deploymentType,
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "deployment-name",
+					Namespace: "deployment-namespace",
+					Labels: map[string]string{
+						"app": "app1",
+					},
+				},
+			},
+			cluster: clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "cluster-1",
+					Labels: map[string]string{
+						"key1": "value1",
+						"key2": "value2",
+					},
+				},
+			},
+			croMap: map[placementv1beta1.ResourceIdentifier][]*placementv1beta1.ClusterResourceOverrideSnapshot{
+				{
+					Group:   utils.NamespaceMetaGVK.Group,
+					Version: utils.NamespaceMetaGVK.Version,
+					Kind:    utils.NamespaceMetaGVK.Kind,
+					Name:    "deployment-namespace",
+				}: {
+					{
+						Spec: placementv1beta1.ClusterResourceOverrideSnapshotSpec{
+							OverrideSpec: placementv1beta1.ClusterResourceOverrideSpec{
+								PriorityTier: placementv1beta1.OverridePriorityTierTeam,
+								Policy: &placementv1beta1.OverridePolicy{
+									OverrideRules: []placementv1beta1.OverrideRule{
+										{
+											ClusterSelector: &placementv1beta1.ClusterSelector{
+												ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+													{
+														LabelSelector: &metav1.LabelSelector{
+															MatchLabels: map[string]string{
+																"key1": "value1",
+															},
+														},
+													},
+												},
+											},
+											OverrideType: placementv1beta1.JSONPatchOverrideType,
+											JSONPatchOverrides: []placementv1beta1.JSONPatchOverride{
+												{
+													Operator: placementv1beta1.JSONPatchOverrideOpReplace,
+													Path:     "/metadata/labels/app",
+													Value:    apiextensionsv1.JSON{Raw: []byte(`"app2"`)},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			roMap: map[placementv1beta1.ResourceIdentifier][]*placementv1beta1.ResourceOverrideSnapshot{
+				{
+					Group:     utils.DeploymentGVK.Group,
+					Version:   utils.DeploymentGVK.Version,
+					Kind:      utils.DeploymentGVK.Kind,
+					Name:      "deployment-name",
+					Namespace: "deployment-namespace",
+				}: {
+					{
+						Spec: placementv1beta1.ResourceOverrideSnapshotSpec{
+							OverrideSpec: placementv1beta1.ResourceOverrideSpec{
+								PriorityTier: placementv1beta1.OverridePriorityTierApp,
+								Policy: &placementv1beta1.OverridePolicy{
+									OverrideRules: []placementv1beta1.OverrideRule{
+										{
+											ClusterSelector: &placementv1beta1.ClusterSelector{}, // matching all the clusters
+											OverrideType:    placementv1beta1.JSONPatchOverrideType,
+											JSONPatchOverrides: []placementv1beta1.JSONPatchOverride{
+												{
+													Operator: placementv1beta1.JSONPatchOverrideOpReplace,
+													Path:     "/metadata/labels/app",
+													Value:    apiextensionsv1.JSON{Raw: []byte(`"app3"`)},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantDeployment: appsv1.Deployment{
+				TypeMeta: deploymentType,
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "deployment-name",
+					Namespace: "deployment-namespace",
+					Labels: map[string]string{
+						"app": "app2",
+					},
+				},
+			},
+		},
 		{
 			name: "invalid json patch of clusterResourceOverride",
 			deployment: appsv1.Deployment{
@@ -2248,7 +2448,7 @@ func TestApplyOverrides_namespacedScopeResource(t *testing.T) {
 				InformerManager: &fakeInformer,
 			}
 			rc := resource.CreateResourceContentForTest(t, tc.deployment)
-			gotDeleted, err := r.applyOverrides(rc, &tc.cluster, tc.croMap, tc.roMap)
+			gotDeleted, err := r.applyOverrides(rc, &tc.cluster, nil, tc.croMap, tc.roMap)
 			if gotErr, wantErr := err != nil, tc.wantErr != nil; gotErr != wantErr || !errors.Is(err, tc.wantErr) {
 				t.Fatalf("applyOverrides() got error %v, want error %v", err, tc.wantErr)
 			}
@@ -0,0 +1,131 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// defaultPreDeleteProbeTimeoutSeconds is the timeout of a pre-delete probe if none is specified.
+	defaultPreDeleteProbeTimeoutSeconds = 300
+	// preDeleteProbeRequeueInterval is the interval between two checks of a pre-delete probe that
+	// has not passed yet. The controller also watches the work status changes, so this is only a fallback.
+	preDeleteProbeRequeueInterval = 5 * time.Second
+)
+
+// checkPreDeleteProbe checks if the pre-delete probe (if any) of the placement that owns a deleting
+// binding has passed, so that the works of the binding can be removed.
+//
+// It returns true if the works can be removed, or the duration to wait before checking again.
+func (r *Reconciler) checkPreDeleteProbe(ctx context.Context, resourceBinding fleetv1beta1.BindingObj, works map[string]*fleetv1beta1.Work) (bool, time.Duration, error) {
+	bindingRef := klog.KObj(resourceBinding)
+	placementName := resourceBinding.GetLabels()[fleetv1beta1.PlacementTrackingLabel]
+	if placementName == "" {
+		return true, 0, nil
+	}
+	placementKey := types.NamespacedName{Namespace: resourceBinding.GetNamespace(), Name: placementName}
+	placement, err := controller.FetchPlacementFromNamespacedName(ctx, r.Client, placementKey)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, 0, nil
+		}
+		klog.ErrorS(err, "Failed to get the placement of the deleting binding", "placement", placementKey, "binding", bindingRef)
+		return false, 0, controller.NewAPIServerError(true, err)
+	}
+	// The probe only sequences the removal of the resources when a cluster is no longer selected;
+	// it is not evaluated when the placement itself is being deleted.
+	if placement.GetDeletionTimestamp() != nil {
+		return true, 0, nil
+	}
+	probe := placement.GetPlacementSpec().Strategy.PreDeleteProbe
+	if probe == nil {
+		return true, 0, nil
+	}
+
+	timeoutSeconds := int32(defaultPreDeleteProbeTimeoutSeconds)
+	if probe.TimeoutSeconds != nil {
+		timeoutSeconds = *probe.TimeoutSeconds
+	}
+	deadline := resourceBinding.GetDeletionTimestamp().Add(time.Duration(timeoutSeconds) * time.Second)
+	passed, err := evaluatePreDeleteProbe(probe, works)
+	switch {
+	case err != nil:
+		// The error is caused by the user input (e.g., the back-reported status is not of the expected
+		// format); keep waiting until the timeout is reached.
+		klog.ErrorS(err, "Failed to evaluate the pre-delete probe", "binding", bindingRef, "placement", placementKey)
+	case passed:
+		klog.V(2).InfoS("The pre-delete probe has passed", "binding", bindingRef, "placement", placementKey)
+		return true, 0, nil
+	}
+	remaining := time.Until(deadline)
+	if remaining <= 0 {
+		klog.V(2).InfoS("The pre-delete probe has timed out; proceed with the deletion", "binding", bindingRef, "placement", placementKey, "timeoutSeconds", timeoutSeconds)
+		return true, 0, nil
+	}
+	klog.V(2).InfoS("Waiting for the pre-delete probe to pass", "binding", bindingRef, "placement", placementKey, "remainingTime", remaining)
+	return false, min(remaining, preDeleteProbeRequeueInterval), nil
+}
+
+// evaluatePreDeleteProbe evaluates a pre-delete probe against the status back-reported via the given works.
+func evaluatePreDeleteProbe(probe *fleetv1beta1.PreDeleteProbe, works map[string]*fleetv1beta1.Work) (bool, error) {
+	var manifestCond *fleetv1beta1.ManifestCondition
+	for _, work := range works {
+		for idx := range work.Status.ManifestConditions {
+			id := work.Status.ManifestConditions[idx].Identifier
+			if id.Group == probe.Resource.Group && id.Version == probe.Resource.Version && id.Kind == probe.Resource.Kind &&
+				id.Namespace == probe.Resource.Namespace && id.Name == probe.Resource.Name {
+				manifestCond = &work.Status.ManifestConditions[idx]
+				break
+			}
+		}
+	}
+	if manifestCond == nil {
+		// The resource is not placed on the cluster; there is nothing to wait for.
+		return true, nil
+	}
+	if manifestCond.BackReportedStatus == nil || len(manifestCond.BackReportedStatus.ObservedStatus.Raw) == 0 {
+		// The status has not been back-reported yet.
+		return false, nil
+	}
+
+	var observed map[string]interface{}
+	if err := json.Unmarshal(manifestCond.BackReportedStatus.ObservedStatus.Raw, &observed); err != nil {
+		return false, controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to unmarshal the back-reported status: %w", err))
+	}
+	jp, err := utils.ParseJSONPath(probe.JSONPath)
+	if err != nil {
+		return false, controller.NewUserError(fmt.Errorf("failed to parse the jsonPath of the pre-delete probe: %w", err))
+	}
+	var buf bytes.Buffer
+	if err := jp.Execute(&buf, observed); err != nil {
+		return false, controller.NewUserError(fmt.Errorf("failed to evaluate the jsonPath of the pre-delete probe: %w", err))
+	}
+	return buf.String() == probe.Value, nil
+}
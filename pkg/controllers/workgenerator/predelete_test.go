@@ -0,0 +1,238 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package workgenerator
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+var (
+	preDeleteProbeResource = fleetv1beta1.ResourceIdentifier{
+		Group:     "networking.fleet.azure.com",
+		Version:   "v1alpha1",
+		Kind:      "ServiceExport",
+		Namespace: "app",
+		Name:      "web",
+	}
+	preDeleteProbe = &fleetv1beta1.PreDeleteProbe{
+		Resource: preDeleteProbeResource,
+		JSONPath: "{.status.endpointCount}",
+		Value:    "0",
+	}
+)
+
+func workWithBackReportedStatus(status string) *fleetv1beta1.Work {
+	manifestCond := fleetv1beta1.ManifestCondition{
+		Identifier: fleetv1beta1.WorkResourceIdentifier{
+			Group:     preDeleteProbeResource.Group,
+			Version:   preDeleteProbeResource.Version,
+			Kind:      preDeleteProbeResource.Kind,
+			Namespace: preDeleteProbeResource.Namespace,
+			Name:      preDeleteProbeResource.Name,
+		},
+	}
+	if status != "" {
+		manifestCond.BackReportedStatus = &fleetv1beta1.BackReportedStatus{
+			ObservedStatus: runtime.RawExtension{Raw: []byte(status)},
+		}
+	}
+	return &fleetv1beta1.Work{
+		Status: fleetv1beta1.WorkStatus{
+			ManifestConditions: []fleetv1beta1.ManifestCondition{manifestCond},
+		},
+	}
+}
+
+func TestEvaluatePreDeleteProbe(t *testing.T) {
+	tests := []struct {
+		name    string
+		works   map[string]*fleetv1beta1.Work
+		want    bool
+		wantErr bool
+	}{
+		{
+			name: "resource not placed",
+			works: map[string]*fleetv1beta1.Work{
+				"work": {},
+			},
+			want: true,
+		},
+		{
+			name: "status not back-reported yet",
+			works: map[string]*fleetv1beta1.Work{
+				"work": workWithBackReportedStatus(""),
+			},
+			want: false,
+		},
+		{
+			name: "condition not met",
+			works: map[string]*fleetv1beta1.Work{
+				"work": workWithBackReportedStatus(`{"status":{"endpointCount":3}}`),
+			},
+			want: false,
+		},
+		{
+			name: "condition met",
+			works: map[string]*fleetv1beta1.Work{
+				"work":   {},
+				"work-1": workWithBackReportedStatus(`{"status":{"endpointCount":0}}`),
+			},
+			want: true,
+		},
+		{
+			name: "field missing from the status",
+			works: map[string]*fleetv1beta1.Work{
+				"work": workWithBackReportedStatus(`{"status":{}}`),
+			},
+			want: false,
+		},
+		{
+			name: "invalid back-reported status",
+			works: map[string]*fleetv1beta1.Work{
+				"work": workWithBackReportedStatus(`{"status":`),
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := evaluatePreDeleteProbe(preDeleteProbe, tc.works)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("evaluatePreDeleteProbe() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if got != tc.want {
+				t.Errorf("evaluatePreDeleteProbe() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestCheckPreDeleteProbe(t *testing.T) {
+	placement := func(probe *fleetv1beta1.PreDeleteProbe, deleting bool) *fleetv1beta1.ClusterResourcePlacement {
+		crp := &fleetv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "crp",
+			},
+			Spec: fleetv1beta1.PlacementSpec{
+				Strategy: fleetv1beta1.RolloutStrategy{
+					PreDeleteProbe: probe,
+				},
+			},
+		}
+		if deleting {
+			crp.DeletionTimestamp = &metav1.Time{Time: time.Now()}
+			crp.Finalizers = []string{fleetv1beta1.PlacementCleanupFinalizer}
+		}
+		return crp
+	}
+	binding := func(deletedAgo time.Duration) *fleetv1beta1.ClusterResourceBinding {
+		return &fleetv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "binding",
+				Labels: map[string]string{
+					fleetv1beta1.PlacementTrackingLabel: "crp",
+				},
+				DeletionTimestamp: &metav1.Time{Time: time.Now().Add(-deletedAgo)},
+			},
+		}
+	}
+	drainingWorks := map[string]*fleetv1beta1.Work{
+		"work": workWithBackReportedStatus(`{"status":{"endpointCount":3}}`),
+	}
+
+	tests := []struct {
+		name        string
+		placement   *fleetv1beta1.ClusterResourcePlacement
+		binding     *fleetv1beta1.ClusterResourceBinding
+		want        bool
+		wantRequeue bool
+	}{
+		{
+			name:    "placement not found",
+			binding: binding(0),
+			want:    true,
+		},
+		{
+			name:      "no pre-delete probe",
+			placement: placement(nil, false),
+			binding:   binding(0),
+			want:      true,
+		},
+		{
+			name:      "placement is being deleted",
+			placement: placement(preDeleteProbe, true),
+			binding:   binding(0),
+			want:      true,
+		},
+		{
+			name:        "probe not passed",
+			placement:   placement(preDeleteProbe, false),
+			binding:     binding(0),
+			want:        false,
+			wantRequeue: true,
+		},
+		{
+			name:      "probe timed out",
+			placement: placement(preDeleteProbe, false),
+			binding:   binding(time.Duration(defaultPreDeleteProbeTimeoutSeconds+1) * time.Second),
+			want:      true,
+		},
+		{
+			name: "probe not passed before the custom timeout",
+			placement: placement(&fleetv1beta1.PreDeleteProbe{
+				Resource:       preDeleteProbeResource,
+				JSONPath:       preDeleteProbe.JSONPath,
+				Value:          preDeleteProbe.Value,
+				TimeoutSeconds: ptr.To(int32(3600)),
+			}, false),
+			binding:     binding(time.Duration(defaultPreDeleteProbeTimeoutSeconds+1) * time.Second),
+			want:        false,
+			wantRequeue: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			objects := []client.Object{}
+			if tc.placement != nil {
+				objects = append(objects, tc.placement)
+			}
+			r := &Reconciler{
+				Client: fake.NewClientBuilder().WithScheme(serviceScheme(t)).WithObjects(objects...).Build(),
+			}
+			got, requeueAfter, err := r.checkPreDeleteProbe(context.Background(), tc.binding, drainingWorks)
+			if err != nil {
+				t.Fatalf("checkPreDeleteProbe() error = %v, want no error", err)
+			}
+			if got != tc.want {
+				t.Errorf("checkPreDeleteProbe() = %v, want %v", got, tc.want)
+			}
+			if gotRequeue := requeueAfter > 0; gotRequeue != tc.wantRequeue {
+				t.Errorf("checkPreDeleteProbe() requeueAfter = %v, want requeue %v", requeueAfter, tc.wantRequeue)
+			}
+		})
+	}
+}
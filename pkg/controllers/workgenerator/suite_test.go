@@ -136,14 +136,16 @@ var _ = BeforeSuite(func() {
 		IsClusterScopedResource: true,
 	}
 	err = (&Reconciler{
-		Client:          mgr.GetClient(),
-		InformerManager: &fakeInformer,
+		Client:                  mgr.GetClient(),
+		InformerManager:         &fakeInformer,
+		EnableResourcePlacement: true,
 	}).SetupWithManagerForClusterResourceBinding(mgr)
 	Expect(err).Should(Succeed())
 
 	err = (&Reconciler{
-		Client:          mgr.GetClient(),
-		InformerManager: &fakeInformer,
+		Client:                  mgr.GetClient(),
+		InformerManager:         &fakeInformer,
+		EnableResourcePlacement: true,
 	}).SetupWithManagerForResourceBinding(mgr)
 	Expect(err).Should(Succeed())
 
@@ -0,0 +1,275 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package externalmetrics features a minimal implementation of the Kubernetes external metrics API
+// (external.metrics.k8s.io), which exposes aggregated placement metrics, e.g., the fraction of
+// selected clusters on which the resources of a placement are available, so that external automation
+// (or a HorizontalPodAutoscaler) can react to the rollout health of the fleet.
+package externalmetrics
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/klog/v2"
+	externalmetricsv1beta1 "k8s.io/metrics/pkg/apis/external_metrics/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/aggregatedapi"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+const (
+	// APIPath is the path under which the external metrics API is served.
+	APIPath = "/apis/external.metrics.k8s.io/v1beta1"
+	// APIServiceName is the name of the APIService object that registers the external metrics API.
+	APIServiceName = "v1beta1.external.metrics.k8s.io"
+
+	// The metrics exposed via the external metrics API.
+
+	// SelectedClustersMetric is the number of clusters selected by a placement.
+	SelectedClustersMetric = "kubefleet-placement-selected-clusters"
+	// AvailableClustersMetric is the number of selected clusters on which the resources of a placement
+	// are available.
+	AvailableClustersMetric = "kubefleet-placement-available-clusters"
+	// AvailableClusterFractionMetric is the fraction of selected clusters on which the resources of a
+	// placement are available; it is 0 if no cluster has been selected.
+	AvailableClusterFractionMetric = "kubefleet-placement-available-cluster-fraction"
+
+	// The labels attached to each metric value, which can be used in label selectors to pick placements.
+
+	// PlacementNameMetricLabel is the name of the placement.
+	PlacementNameMetricLabel = "placement"
+	// PlacementKindMetricLabel is the kind of the placement, i.e., ClusterResourcePlacement or ResourcePlacement.
+	PlacementKindMetricLabel = "kind"
+)
+
+var (
+	supportedMetrics = []string{SelectedClustersMetric, AvailableClustersMetric, AvailableClusterFractionMetric}
+)
+
+// Handler serves the external metrics API.
+type Handler struct {
+	client                   client.Reader
+	authorizer               aggregatedapi.Authorizer
+	resourcePlacementEnabled bool
+	nowFunc                  func() time.Time
+}
+
+// NewHandler returns a new handler that serves the external metrics API, with the placement
+// metrics calculated from the placements read via the given client.
+//
+// A user must be allowed to list a metric in a namespace to query it. Metrics of
+// ResourcePlacements can only be queried from the namespace they reside in; metrics of
+// ClusterResourcePlacements can be queried from any namespace, but only by users who are also
+// allowed to list ClusterResourcePlacements.
+func NewHandler(client client.Reader, authorizer aggregatedapi.Authorizer, resourcePlacementEnabled bool) *Handler {
+	return &Handler{
+		client:                   client,
+		authorizer:               authorizer,
+		resourcePlacementEnabled: resourcePlacementEnabled,
+		nowFunc:                  time.Now,
+	}
+}
+
+// ServeHTTP serves the discovery document of the external metrics API, and the metric values.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		aggregatedapi.WriteStatus(w, apierrors.NewMethodNotSupported(externalmetricsv1beta1.SchemeGroupVersion.WithResource("").GroupResource(), r.Method))
+		return
+	}
+
+	subPath := strings.Trim(strings.TrimPrefix(r.URL.Path, APIPath), "/")
+	if subPath == "" {
+		aggregatedapi.WriteJSON(w, http.StatusOK, discoveryDocument())
+		return
+	}
+
+	// The metric values are served at the path /namespaces/{namespace}/{metric}.
+	segs := strings.Split(subPath, "/")
+	if len(segs) != 3 || segs[0] != "namespaces" {
+		aggregatedapi.WriteStatus(w, apierrors.NewNotFound(externalmetricsv1beta1.SchemeGroupVersion.WithResource(subPath).GroupResource(), ""))
+		return
+	}
+	namespace, metricName := segs[1], segs[2]
+
+	selector, err := labels.Parse(r.URL.Query().Get("labelSelector"))
+	if err != nil {
+		aggregatedapi.WriteStatus(w, apierrors.NewBadRequest(fmt.Sprintf("invalid label selector: %v", err)))
+		return
+	}
+
+	user, ok := aggregatedapi.UserFrom(r.Context())
+	if !ok {
+		aggregatedapi.WriteStatus(w, apierrors.NewUnauthorized("no authenticated user is found in the request"))
+		return
+	}
+	if err := h.authorizer.Authorize(r.Context(), user, &authorizationv1.ResourceAttributes{
+		Namespace: namespace,
+		Verb:      "list",
+		Group:     externalmetricsv1beta1.SchemeGroupVersion.Group,
+		Version:   externalmetricsv1beta1.SchemeGroupVersion.Version,
+		Resource:  metricName,
+	}); err != nil {
+		aggregatedapi.WriteStatus(w, err)
+		return
+	}
+	// ClusterResourcePlacements are cluster-scoped; their metrics are only served to users who may
+	// read them.
+	includeClusterResourcePlacements := true
+	if err := h.authorizer.Authorize(r.Context(), user, &authorizationv1.ResourceAttributes{
+		Verb:     "list",
+		Group:    placementv1beta1.GroupVersion.Group,
+		Version:  placementv1beta1.GroupVersion.Version,
+		Resource: placementv1beta1.ClusterResourcePlacementResource,
+	}); err != nil {
+		if !apierrors.IsForbidden(err) {
+			aggregatedapi.WriteStatus(w, err)
+			return
+		}
+		includeClusterResourcePlacements = false
+	}
+
+	values, err := h.metricValues(r.Context(), namespace, metricName, selector, includeClusterResourcePlacements)
+	if err != nil {
+		klog.ErrorS(err, "Failed to calculate the external metric values", "metric", metricName, "namespace", namespace)
+		aggregatedapi.WriteStatus(w, err)
+		return
+	}
+	aggregatedapi.WriteJSON(w, http.StatusOK, &externalmetricsv1beta1.ExternalMetricValueList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "ExternalMetricValueList",
+			APIVersion: externalmetricsv1beta1.SchemeGroupVersion.String(),
+		},
+		Items: values,
+	})
+}
+
+// metricValues returns the values of a metric for all the placements that match the label selector.
+func (h *Handler) metricValues(ctx context.Context, namespace, metricName string, selector labels.Selector, includeClusterResourcePlacements bool) ([]externalmetricsv1beta1.ExternalMetricValue, error) {
+	isSupported := false
+	for _, m := range supportedMetrics {
+		if m == metricName {
+			isSupported = true
+			break
+		}
+	}
+	if !isSupported {
+		return nil, apierrors.NewNotFound(externalmetricsv1beta1.SchemeGroupVersion.WithResource(metricName).GroupResource(), "")
+	}
+
+	placements := make([]placementv1beta1.PlacementObj, 0)
+	if includeClusterResourcePlacements {
+		crpList := &placementv1beta1.ClusterResourcePlacementList{}
+		if err := h.client.List(ctx, crpList); err != nil {
+			return nil, apierrors.NewInternalError(fmt.Errorf("failed to list cluster resource placements: %w", err))
+		}
+		placements = append(placements, crpList.GetPlacementObjs()...)
+	}
+	if h.resourcePlacementEnabled {
+		rpList := &placementv1beta1.ResourcePlacementList{}
+		if err := h.client.List(ctx, rpList, client.InNamespace(namespace)); err != nil {
+			return nil, apierrors.NewInternalError(fmt.Errorf("failed to list resource placements: %w", err))
+		}
+		placements = append(placements, rpList.GetPlacementObjs()...)
+	}
+
+	now := metav1.NewTime(h.nowFunc())
+	values := make([]externalmetricsv1beta1.ExternalMetricValue, 0, len(placements))
+	for _, placement := range placements {
+		kind := placementv1beta1.ClusterResourcePlacementKind
+		if placement.GetNamespace() != "" {
+			kind = placementv1beta1.ResourcePlacementKind
+		}
+		metricLabels := map[string]string{
+			PlacementNameMetricLabel: placement.GetName(),
+			PlacementKindMetricLabel: kind,
+		}
+		if !selector.Matches(labels.Set(metricLabels)) {
+			continue
+		}
+
+		selected, available := clusterCountsOf(placement)
+		var value *resource.Quantity
+		switch metricName {
+		case SelectedClustersMetric:
+			value = resource.NewQuantity(int64(selected), resource.DecimalSI)
+		case AvailableClustersMetric:
+			value = resource.NewQuantity(int64(available), resource.DecimalSI)
+		case AvailableClusterFractionMetric:
+			fraction := int64(0)
+			if selected > 0 {
+				fraction = int64(available) * 1000 / int64(selected)
+			}
+			value = resource.NewMilliQuantity(fraction, resource.DecimalSI)
+		}
+		values = append(values, externalmetricsv1beta1.ExternalMetricValue{
+			MetricName:   metricName,
+			MetricLabels: metricLabels,
+			Timestamp:    now,
+			Value:        *value,
+		})
+	}
+	return values, nil
+}
+
+// clusterCountsOf returns the number of clusters selected by a placement, and the number of selected
+// clusters on which the resources of the placement are available.
+func clusterCountsOf(placement placementv1beta1.PlacementObj) (selected, available int) {
+	for _, perClusterStatus := range placement.GetPlacementStatus().PerClusterPlacementStatuses {
+		if perClusterStatus.ClusterName == "" {
+			// The status is reported for a cluster that cannot be picked; skip it.
+			continue
+		}
+		selected++
+		availableCond := meta.FindStatusCondition(perClusterStatus.Conditions, string(placementv1beta1.PerClusterAvailableConditionType))
+		if condition.IsConditionStatusTrue(availableCond, placement.GetGeneration()) {
+			available++
+		}
+	}
+	return selected, available
+}
+
+// discoveryDocument returns the discovery document of the external metrics API.
+func discoveryDocument() *metav1.APIResourceList {
+	resources := make([]metav1.APIResource, 0, len(supportedMetrics))
+	for _, m := range supportedMetrics {
+		resources = append(resources, metav1.APIResource{
+			Name:       m,
+			Namespaced: true,
+			Kind:       "ExternalMetricValueList",
+			Verbs:      metav1.Verbs{"get"},
+		})
+	}
+	return &metav1.APIResourceList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "APIResourceList",
+			APIVersion: "v1",
+		},
+		GroupVersion: externalmetricsv1beta1.SchemeGroupVersion.String(),
+		APIResources: resources,
+	}
+}
@@ -0,0 +1,259 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalmetrics
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	externalmetricsv1beta1 "k8s.io/metrics/pkg/apis/external_metrics/v1beta1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/aggregatedapi"
+)
+
+const (
+	crpName   = "crp-1"
+	rpName    = "rp-1"
+	namespace = "work"
+)
+
+// fakeAuthorizer allows access to every resource except the forbidden ones.
+type fakeAuthorizer struct {
+	forbiddenResources []string
+}
+
+func (a *fakeAuthorizer) Authorize(_ context.Context, _ *authenticationv1.UserInfo, attrs *authorizationv1.ResourceAttributes) error {
+	for _, res := range a.forbiddenResources {
+		if res == attrs.Resource {
+			return apierrors.NewForbidden(schema.GroupResource{Group: attrs.Group, Resource: attrs.Resource}, attrs.Name, errors.New("forbidden"))
+		}
+	}
+	return nil
+}
+
+func perClusterStatus(clusterName string, available bool, generation int64) placementv1beta1.PerClusterPlacementStatus {
+	status := metav1.ConditionFalse
+	if available {
+		status = metav1.ConditionTrue
+	}
+	return placementv1beta1.PerClusterPlacementStatus{
+		ClusterName: clusterName,
+		Conditions: []metav1.Condition{
+			{
+				Type:               string(placementv1beta1.PerClusterAvailableConditionType),
+				Status:             status,
+				ObservedGeneration: generation,
+			},
+		},
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	now := time.Now().Truncate(time.Second)
+	crp := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       crpName,
+			Generation: 2,
+		},
+		Status: placementv1beta1.PlacementStatus{
+			PerClusterPlacementStatuses: []placementv1beta1.PerClusterPlacementStatus{
+				perClusterStatus("member-1", true, 2),
+				perClusterStatus("member-2", true, 2),
+				// The condition is stale.
+				perClusterStatus("member-3", true, 1),
+				perClusterStatus("member-4", false, 2),
+				// The cluster cannot be picked.
+				{},
+			},
+		},
+	}
+	rp := &placementv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       rpName,
+			Namespace:  namespace,
+			Generation: 1,
+		},
+		Status: placementv1beta1.PlacementStatus{
+			PerClusterPlacementStatuses: []placementv1beta1.PerClusterPlacementStatus{
+				perClusterStatus("member-1", true, 1),
+			},
+		},
+	}
+	crpLabels := map[string]string{PlacementNameMetricLabel: crpName, PlacementKindMetricLabel: placementv1beta1.ClusterResourcePlacementKind}
+	rpLabels := map[string]string{PlacementNameMetricLabel: rpName, PlacementKindMetricLabel: placementv1beta1.ResourcePlacementKind}
+
+	testCases := []struct {
+		name                     string
+		path                     string
+		labelSelector            string
+		resourcePlacementEnabled bool
+		unauthenticated          bool
+		forbiddenResources       []string
+		wantCode                 int
+		wantValues               []externalmetricsv1beta1.ExternalMetricValue
+	}{
+		{
+			name:     "discovery",
+			path:     APIPath,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:                     "available cluster fraction of all placements",
+			path:                     APIPath + "/namespaces/" + namespace + "/" + AvailableClusterFractionMetric,
+			resourcePlacementEnabled: true,
+			wantCode:                 http.StatusOK,
+			wantValues: []externalmetricsv1beta1.ExternalMetricValue{
+				{
+					MetricName:   AvailableClusterFractionMetric,
+					MetricLabels: crpLabels,
+					Timestamp:    metav1.NewTime(now),
+					Value:        resource.MustParse("500m"),
+				},
+				{
+					MetricName:   AvailableClusterFractionMetric,
+					MetricLabels: rpLabels,
+					Timestamp:    metav1.NewTime(now),
+					Value:        resource.MustParse("1"),
+				},
+			},
+		},
+		{
+			name:                     "selected clusters of placements picked by the label selector",
+			path:                     APIPath + "/namespaces/" + namespace + "/" + SelectedClustersMetric,
+			labelSelector:            PlacementNameMetricLabel + "=" + crpName,
+			resourcePlacementEnabled: true,
+			wantCode:                 http.StatusOK,
+			wantValues: []externalmetricsv1beta1.ExternalMetricValue{
+				{
+					MetricName:   SelectedClustersMetric,
+					MetricLabels: crpLabels,
+					Timestamp:    metav1.NewTime(now),
+					Value:        resource.MustParse("4"),
+				},
+			},
+		},
+		{
+			name:     "available clusters, resource placements in other namespaces and disabled",
+			path:     APIPath + "/namespaces/other/" + AvailableClustersMetric,
+			wantCode: http.StatusOK,
+			wantValues: []externalmetricsv1beta1.ExternalMetricValue{
+				{
+					MetricName:   AvailableClustersMetric,
+					MetricLabels: crpLabels,
+					Timestamp:    metav1.NewTime(now),
+					Value:        resource.MustParse("2"),
+				},
+			},
+		},
+		{
+			name:                     "available clusters, cluster resource placements forbidden",
+			path:                     APIPath + "/namespaces/" + namespace + "/" + AvailableClustersMetric,
+			resourcePlacementEnabled: true,
+			forbiddenResources:       []string{placementv1beta1.ClusterResourcePlacementResource},
+			wantCode:                 http.StatusOK,
+			wantValues: []externalmetricsv1beta1.ExternalMetricValue{
+				{
+					MetricName:   AvailableClustersMetric,
+					MetricLabels: rpLabels,
+					Timestamp:    metav1.NewTime(now),
+					Value:        resource.MustParse("1"),
+				},
+			},
+		},
+		{
+			name:               "metric forbidden",
+			path:               APIPath + "/namespaces/" + namespace + "/" + AvailableClustersMetric,
+			forbiddenResources: []string{AvailableClustersMetric},
+			wantCode:           http.StatusForbidden,
+		},
+		{
+			name:            "unauthenticated",
+			path:            APIPath + "/namespaces/" + namespace + "/" + AvailableClustersMetric,
+			unauthenticated: true,
+			wantCode:        http.StatusUnauthorized,
+		},
+		{
+			name:     "unknown metric",
+			path:     APIPath + "/namespaces/" + namespace + "/unknown",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "invalid path",
+			path:     APIPath + "/" + AvailableClustersMetric,
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:          "invalid label selector",
+			path:          APIPath + "/namespaces/" + namespace + "/" + AvailableClustersMetric,
+			labelSelector: "placement==,",
+			wantCode:      http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := placementv1beta1.AddToScheme(scheme); err != nil {
+				t.Fatalf("AddToScheme() = %v, want no error", err)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(crp, rp).Build()
+			h := NewHandler(fakeClient, &fakeAuthorizer{forbiddenResources: tc.forbiddenResources}, tc.resourcePlacementEnabled)
+			h.nowFunc = func() time.Time { return now }
+
+			reqURL := tc.path
+			if tc.labelSelector != "" {
+				reqURL += "?labelSelector=" + url.QueryEscape(tc.labelSelector)
+			}
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, reqURL, nil)
+			if !tc.unauthenticated {
+				req = req.WithContext(aggregatedapi.WithUser(req.Context(), &authenticationv1.UserInfo{Username: "user"}))
+			}
+			h.ServeHTTP(rec, req)
+			if rec.Code != tc.wantCode {
+				t.Fatalf("ServeHTTP() code = %d, want %d, body: %s", rec.Code, tc.wantCode, rec.Body.String())
+			}
+			if tc.wantValues == nil {
+				return
+			}
+
+			got := &externalmetricsv1beta1.ExternalMetricValueList{}
+			if err := json.Unmarshal(rec.Body.Bytes(), got); err != nil {
+				t.Fatalf("failed to unmarshal the response: %v", err)
+			}
+			if diff := cmp.Diff(got.Items, tc.wantValues); diff != "" {
+				t.Errorf("ServeHTTP() metric values mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,371 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package join generates the manifests that are needed to join a Kubernetes cluster to a fleet as a member cluster,
+// i.e., the member agent deployment along with its RBAC setup and hub credentials, which are applied to the new
+// member cluster, and the MemberCluster object, which is applied to the hub cluster.
+package join
+
+import (
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/hubconnectivity"
+)
+
+const (
+	// TokenProviderSecret is the token provider that reads the hub token from a secret in the member cluster.
+	TokenProviderSecret = "secret"
+	// TokenProviderAzure is the token provider that fetches the hub token with an Azure managed identity.
+	TokenProviderAzure = "azure"
+
+	// DefaultNamespace is the namespace in the member cluster where the member agent runs by default.
+	DefaultNamespace = "fleet-system"
+	// DefaultMemberAgentImageRepository is the default image repository of the member agent.
+	DefaultMemberAgentImageRepository = "ghcr.io/kubefleet-dev/kubefleet/member-agent"
+	// DefaultRefreshTokenImageRepository is the default image repository of the refresh token sidecar.
+	DefaultRefreshTokenImageRepository = "ghcr.io/kubefleet-dev/kubefleet/refresh-token"
+
+	// HubTokenSecretName is the name of the secret in the member cluster that keeps the hub token
+	// for the secret token provider.
+	HubTokenSecretName = "hub-kubeconfig-secret"
+	// hubTokenSecretKey is the key of the hub token in the hub token secret; it must match the key the
+	// secret token provider reads.
+	hubTokenSecretKey = "token"
+
+	memberAgentName       = "member-agent"
+	tokenVolumeName       = "provider-token"
+	tokenMountPath        = "/config"
+	tokenFilePath         = tokenMountPath + "/token"
+	memberAgentRoleName   = "fleet-member-agent-role"
+	memberAgentBinding    = "fleet-member-agent-binding"
+	memberAgentSAName     = "member-agent-sa"
+	memberAgentHubLease   = "136224848560.hub.fleet.azure.com"
+	memberAgentSpokeLease = "136224848560.member.fleet.azure.com"
+)
+
+// Options are the inputs for generating the manifests that join a cluster to a fleet.
+type Options struct {
+	// MemberClusterName is the name of the new member cluster in the fleet.
+	MemberClusterName string
+	// HubURL is the URL of the hub cluster API server.
+	HubURL string
+	// HubCAData is the PEM-encoded certificate authority data of the hub cluster API server; if not set,
+	// the member agent verifies the hub cluster with the system certificate pool.
+	HubCAData []byte
+	// TokenProvider is the provider of the token the member agent uses to authenticate with the hub cluster.
+	TokenProvider string
+	// HubToken is the token for the secret token provider.
+	HubToken string
+	// AzureClientID is the client ID of the Azure managed identity for the azure token provider.
+	AzureClientID string
+	// Identity is the identity of the member agent in the hub cluster, i.e., the subject the token above
+	// authenticates as.
+	Identity rbacv1.Subject
+	// Namespace is the namespace in the member cluster where the member agent runs.
+	Namespace string
+	// ImageTag is the tag of the member agent and refresh token images.
+	ImageTag string
+	// MemberAgentImageRepository is the image repository of the member agent.
+	MemberAgentImageRepository string
+	// RefreshTokenImageRepository is the image repository of the refresh token sidecar.
+	RefreshTokenImageRepository string
+}
+
+// Validate checks that the options are complete and consistent, and fills in the defaults.
+func (o *Options) Validate() error {
+	if errs := validation.IsDNS1123Subdomain(o.MemberClusterName); len(errs) > 0 {
+		return fmt.Errorf("invalid member cluster name %q: %v", o.MemberClusterName, errs)
+	}
+	if o.HubURL == "" {
+		return errors.New("hub URL is required")
+	}
+	if err := hubconnectivity.ValidateHubURL(o.HubURL); err != nil {
+		return fmt.Errorf("invalid hub URL: %w", err)
+	}
+	switch o.TokenProvider {
+	case TokenProviderSecret:
+		if o.HubToken == "" {
+			return errors.New("hub token is required for the secret token provider")
+		}
+	case TokenProviderAzure:
+		if o.AzureClientID == "" {
+			return errors.New("azure client ID is required for the azure token provider")
+		}
+	default:
+		return fmt.Errorf("unsupported token provider %q, must be %s or %s", o.TokenProvider, TokenProviderSecret, TokenProviderAzure)
+	}
+	switch o.Identity.Kind {
+	case rbacv1.ServiceAccountKind:
+		if o.Identity.Namespace == "" {
+			return errors.New("namespace is required for a service account identity")
+		}
+	case rbacv1.UserKind, rbacv1.GroupKind:
+	default:
+		return fmt.Errorf("unsupported identity kind %q, must be %s, %s, or %s", o.Identity.Kind, rbacv1.ServiceAccountKind, rbacv1.UserKind, rbacv1.GroupKind)
+	}
+	if o.Identity.Name == "" {
+		return errors.New("identity name is required")
+	}
+	if o.ImageTag == "" {
+		return errors.New("image tag is required")
+	}
+
+	if o.Namespace == "" {
+		o.Namespace = DefaultNamespace
+	}
+	if o.MemberAgentImageRepository == "" {
+		o.MemberAgentImageRepository = DefaultMemberAgentImageRepository
+	}
+	if o.RefreshTokenImageRepository == "" {
+		o.RefreshTokenImageRepository = DefaultRefreshTokenImageRepository
+	}
+	return nil
+}
+
+// GenerateMemberManifests returns the objects to apply to the new member cluster, i.e., the namespace,
+// the service account and RBAC setup, the hub token secret (for the secret token provider), and the member
+// agent deployment. The options must have been validated.
+//
+// Note that the AppliedWork CRD, which the member agent requires, is not included.
+func GenerateMemberManifests(o *Options) []client.Object {
+	objs := []client.Object{
+		&corev1.Namespace{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+			ObjectMeta: metav1.ObjectMeta{Name: o.Namespace},
+		},
+		&corev1.ServiceAccount{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ServiceAccount"},
+			ObjectMeta: metav1.ObjectMeta{Name: memberAgentSAName, Namespace: o.Namespace, Labels: labels()},
+		},
+		memberAgentClusterRole(),
+		&rbacv1.ClusterRoleBinding{
+			TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRoleBinding"},
+			ObjectMeta: metav1.ObjectMeta{Name: memberAgentBinding, Labels: labels()},
+			RoleRef: rbacv1.RoleRef{
+				APIGroup: rbacv1.GroupName,
+				Kind:     "ClusterRole",
+				Name:     memberAgentRoleName,
+			},
+			Subjects: []rbacv1.Subject{
+				{Kind: rbacv1.ServiceAccountKind, Name: memberAgentSAName, Namespace: o.Namespace},
+			},
+		},
+	}
+	if o.TokenProvider == TokenProviderSecret {
+		objs = append(objs, &corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Name: HubTokenSecretName, Namespace: o.Namespace, Labels: labels()},
+			Type:       corev1.SecretTypeOpaque,
+			Data:       map[string][]byte{hubTokenSecretKey: []byte(o.HubToken)},
+		})
+	}
+	return append(objs, memberAgentDeployment(o))
+}
+
+// GenerateHubManifests returns the objects to apply to the hub cluster, i.e., the MemberCluster object
+// of the new member cluster. The options must have been validated.
+func GenerateHubManifests(o *Options) []client.Object {
+	return []client.Object{
+		&clusterv1beta1.MemberCluster{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: clusterv1beta1.GroupVersion.String(),
+				Kind:       "MemberCluster",
+			},
+			ObjectMeta: metav1.ObjectMeta{Name: o.MemberClusterName},
+			Spec: clusterv1beta1.MemberClusterSpec{
+				Identity:               o.Identity,
+				HeartbeatPeriodSeconds: 60,
+			},
+		},
+	}
+}
+
+// WriteYAML writes the objects to the writer as a multi-document YAML stream, without the fields that are
+// managed by the API server, such as the creation timestamp and the status.
+func WriteYAML(w io.Writer, objs []client.Object) error {
+	for i, obj := range objs {
+		u, err := runtime.DefaultUnstructuredConverter.ToUnstructured(obj)
+		if err != nil {
+			return fmt.Errorf("failed to convert %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		delete(u, "status")
+		if metadata, ok := u["metadata"].(map[string]interface{}); ok {
+			delete(metadata, "creationTimestamp")
+		}
+		data, err := yaml.Marshal(u)
+		if err != nil {
+			return fmt.Errorf("failed to marshal %s %s: %w", obj.GetObjectKind().GroupVersionKind().Kind, obj.GetName(), err)
+		}
+		if i > 0 {
+			if _, err := io.WriteString(w, "---\n"); err != nil {
+				return err
+			}
+		}
+		if _, err := w.Write(data); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func labels() map[string]string {
+	return map[string]string{"app.kubernetes.io/name": memberAgentName}
+}
+
+// memberAgentClusterRole returns the cluster role of the member agent, which mirrors the one in the
+// member agent Helm chart.
+func memberAgentClusterRole() *rbacv1.ClusterRole {
+	return &rbacv1.ClusterRole{
+		TypeMeta:   metav1.TypeMeta{APIVersion: rbacv1.SchemeGroupVersion.String(), Kind: "ClusterRole"},
+		ObjectMeta: metav1.ObjectMeta{Name: memberAgentRoleName, Labels: labels()},
+		Rules: []rbacv1.PolicyRule{
+			{
+				APIGroups: []string{"placement.kubernetes-fleet.io"},
+				Resources: []string{"appliedworks"},
+				Verbs:     []string{"get", "list", "watch", "create", "delete"},
+			},
+			{
+				APIGroups: []string{"placement.kubernetes-fleet.io"},
+				Resources: []string{"appliedworks/status"},
+				Verbs:     []string{"update"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"nodes", "pods", "namespaces"},
+				Verbs:     []string{"get", "list", "watch"},
+			},
+			{
+				APIGroups: []string{"coordination.k8s.io"},
+				Resources: []string{"leases"},
+				Verbs:     []string{"get", "list", "watch", "create"},
+			},
+			{
+				APIGroups:     []string{"coordination.k8s.io"},
+				Resources:     []string{"leases"},
+				ResourceNames: []string{memberAgentHubLease, memberAgentSpokeLease},
+				Verbs:         []string{"update", "patch"},
+			},
+			{
+				APIGroups: []string{""},
+				Resources: []string{"events"},
+				Verbs:     []string{"create", "patch"},
+			},
+			{
+				APIGroups: []string{"*"},
+				Resources: []string{"*"},
+				Verbs:     []string{"get", "create", "update", "patch", "delete"},
+			},
+			{
+				APIGroups: []string{rbacv1.GroupName},
+				Resources: []string{"clusterroles", "roles"},
+				Verbs:     []string{"bind", "escalate"},
+			},
+			{
+				NonResourceURLs: []string{"/api", "/api/*", "/apis", "/apis/*", "/version", "/healthz", "/readyz"},
+				Verbs:           []string{"get"},
+			},
+		},
+	}
+}
+
+// memberAgentDeployment returns the deployment of the member agent, which runs the member agent along with
+// a refresh token sidecar that keeps the hub token up to date in a shared volume.
+func memberAgentDeployment(o *Options) *appsv1.Deployment {
+	env := []corev1.EnvVar{
+		{Name: "HUB_SERVER_URL", Value: o.HubURL},
+		{Name: "CONFIG_PATH", Value: tokenFilePath},
+		{Name: "MEMBER_CLUSTER_NAME", Value: o.MemberClusterName},
+	}
+	if len(o.HubCAData) > 0 {
+		env = append(env, corev1.EnvVar{Name: "HUB_CERTIFICATE_AUTHORITY", Value: base64.StdEncoding.EncodeToString(o.HubCAData)})
+	}
+
+	refreshTokenArgs := []string{o.TokenProvider}
+	switch o.TokenProvider {
+	case TokenProviderSecret:
+		refreshTokenArgs = append(refreshTokenArgs, "--name="+HubTokenSecretName, "--namespace="+o.Namespace)
+	case TokenProviderAzure:
+		refreshTokenArgs = append(refreshTokenArgs, "--clientid="+o.AzureClientID)
+	}
+
+	tokenVolumeMount := corev1.VolumeMount{Name: tokenVolumeName, MountPath: tokenMountPath}
+	return &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: appsv1.SchemeGroupVersion.String(), Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Name: memberAgentName, Namespace: o.Namespace, Labels: labels()},
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To(int32(1)),
+			Selector: &metav1.LabelSelector{MatchLabels: labels()},
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels()},
+				Spec: corev1.PodSpec{
+					ServiceAccountName: memberAgentSAName,
+					Containers: []corev1.Container{
+						{
+							Name:  memberAgentName,
+							Image: fmt.Sprintf("%s:%s", o.MemberAgentImageRepository, o.ImageTag),
+							Args:  []string{"--leader-elect=true"},
+							Env:   env,
+							Ports: []corev1.ContainerPort{
+								{Name: "hubmetrics", ContainerPort: 8080, Protocol: corev1.ProtocolTCP},
+								{Name: "hubhealthz", ContainerPort: 8081, Protocol: corev1.ProtocolTCP},
+								{Name: "membermetrics", ContainerPort: 8090, Protocol: corev1.ProtocolTCP},
+								{Name: "memberhealthz", ContainerPort: 8091, Protocol: corev1.ProtocolTCP},
+							},
+							LivenessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									HTTPGet: &corev1.HTTPGetAction{Path: "/healthz", Port: intstr.FromString("hubhealthz")},
+								},
+							},
+							ReadinessProbe: &corev1.Probe{
+								ProbeHandler: corev1.ProbeHandler{
+									HTTPGet: &corev1.HTTPGetAction{Path: "/readyz", Port: intstr.FromString("hubhealthz")},
+								},
+							},
+							VolumeMounts: []corev1.VolumeMount{tokenVolumeMount},
+						},
+						{
+							Name:         "refresh-token",
+							Image:        fmt.Sprintf("%s:%s", o.RefreshTokenImageRepository, o.ImageTag),
+							Args:         refreshTokenArgs,
+							VolumeMounts: []corev1.VolumeMount{tokenVolumeMount},
+						},
+					},
+					Volumes: []corev1.Volume{
+						{
+							Name:         tokenVolumeName,
+							VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+						},
+					},
+				},
+			},
+		},
+	}
+}
@@ -0,0 +1,242 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package join
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	clusterName = "member-1"
+	hubURL      = "https://hub.example.com:443"
+	imageTag    = "v0.1.0"
+)
+
+func validOptions() *Options {
+	return &Options{
+		MemberClusterName: clusterName,
+		HubURL:            hubURL,
+		TokenProvider:     TokenProviderSecret,
+		HubToken:          "test-token",
+		Identity: rbacv1.Subject{
+			Kind:      rbacv1.ServiceAccountKind,
+			Name:      "member-1-sa",
+			Namespace: "fleet-system",
+		},
+		ImageTag: imageTag,
+	}
+}
+
+// TestValidate tests the Validate method.
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		mutate     func(o *Options)
+		wantErrMsg string
+	}{
+		{
+			name:   "valid options",
+			mutate: func(_ *Options) {},
+		},
+		{
+			name: "valid options with the azure token provider",
+			mutate: func(o *Options) {
+				o.TokenProvider = TokenProviderAzure
+				o.HubToken = ""
+				o.AzureClientID = "client-id"
+				o.Identity = rbacv1.Subject{Kind: rbacv1.UserKind, Name: "client-id"}
+			},
+		},
+		{
+			name:       "invalid member cluster name",
+			mutate:     func(o *Options) { o.MemberClusterName = "Member_1" },
+			wantErrMsg: "invalid member cluster name",
+		},
+		{
+			name:       "missing hub URL",
+			mutate:     func(o *Options) { o.HubURL = "" },
+			wantErrMsg: "hub URL is required",
+		},
+		{
+			name:       "missing hub token",
+			mutate:     func(o *Options) { o.HubToken = "" },
+			wantErrMsg: "hub token is required",
+		},
+		{
+			name:       "missing azure client ID",
+			mutate:     func(o *Options) { o.TokenProvider = TokenProviderAzure },
+			wantErrMsg: "azure client ID is required",
+		},
+		{
+			name:       "unsupported token provider",
+			mutate:     func(o *Options) { o.TokenProvider = "aws" },
+			wantErrMsg: "unsupported token provider",
+		},
+		{
+			name:       "service account identity without namespace",
+			mutate:     func(o *Options) { o.Identity.Namespace = "" },
+			wantErrMsg: "namespace is required for a service account identity",
+		},
+		{
+			name:       "unsupported identity kind",
+			mutate:     func(o *Options) { o.Identity.Kind = "Robot" },
+			wantErrMsg: "unsupported identity kind",
+		},
+		{
+			name:       "missing image tag",
+			mutate:     func(o *Options) { o.ImageTag = "" },
+			wantErrMsg: "image tag is required",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := validOptions()
+			tc.mutate(o)
+			err := o.Validate()
+			if tc.wantErrMsg == "" {
+				if err != nil {
+					t.Fatalf("Validate() = %v, want no error", err)
+				}
+				if o.Namespace != DefaultNamespace || o.MemberAgentImageRepository != DefaultMemberAgentImageRepository {
+					t.Errorf("Validate() did not fill in the defaults: %+v", o)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErrMsg) {
+				t.Fatalf("Validate() = %v, want error containing %q", err, tc.wantErrMsg)
+			}
+		})
+	}
+}
+
+// TestGenerateMemberManifests tests the GenerateMemberManifests function.
+func TestGenerateMemberManifests(t *testing.T) {
+	testCases := []struct {
+		name                 string
+		mutate               func(o *Options)
+		wantKinds            []string
+		wantRefreshTokenArgs []string
+		wantEnv              []corev1.EnvVar
+	}{
+		{
+			name:                 "secret token provider with hub CA",
+			mutate:               func(o *Options) { o.HubCAData = []byte("ca") },
+			wantKinds:            []string{"Namespace", "ServiceAccount", "ClusterRole", "ClusterRoleBinding", "Secret", "Deployment"},
+			wantRefreshTokenArgs: []string{"secret", "--name=hub-kubeconfig-secret", "--namespace=fleet-system"},
+			wantEnv: []corev1.EnvVar{
+				{Name: "HUB_SERVER_URL", Value: hubURL},
+				{Name: "CONFIG_PATH", Value: "/config/token"},
+				{Name: "MEMBER_CLUSTER_NAME", Value: clusterName},
+				{Name: "HUB_CERTIFICATE_AUTHORITY", Value: "Y2E="},
+			},
+		},
+		{
+			name: "azure token provider",
+			mutate: func(o *Options) {
+				o.TokenProvider = TokenProviderAzure
+				o.AzureClientID = "client-id"
+			},
+			wantKinds:            []string{"Namespace", "ServiceAccount", "ClusterRole", "ClusterRoleBinding", "Deployment"},
+			wantRefreshTokenArgs: []string{"azure", "--clientid=client-id"},
+			wantEnv: []corev1.EnvVar{
+				{Name: "HUB_SERVER_URL", Value: hubURL},
+				{Name: "CONFIG_PATH", Value: "/config/token"},
+				{Name: "MEMBER_CLUSTER_NAME", Value: clusterName},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			o := validOptions()
+			tc.mutate(o)
+			if err := o.Validate(); err != nil {
+				t.Fatalf("Validate() = %v, want no error", err)
+			}
+			objs := GenerateMemberManifests(o)
+
+			gotKinds := make([]string, 0, len(objs))
+			for _, obj := range objs {
+				gotKinds = append(gotKinds, obj.GetObjectKind().GroupVersionKind().Kind)
+			}
+			if diff := cmp.Diff(gotKinds, tc.wantKinds); diff != "" {
+				t.Errorf("GenerateMemberManifests() kinds diff (-got, +want):\n%s", diff)
+			}
+
+			deploy, ok := objs[len(objs)-1].(*appsv1.Deployment)
+			if !ok {
+				t.Fatalf("GenerateMemberManifests() last object = %T, want a deployment", objs[len(objs)-1])
+			}
+			containers := deploy.Spec.Template.Spec.Containers
+			if got, want := containers[0].Image, DefaultMemberAgentImageRepository+":"+imageTag; got != want {
+				t.Errorf("member agent image = %s, want %s", got, want)
+			}
+			if diff := cmp.Diff(containers[0].Env, tc.wantEnv); diff != "" {
+				t.Errorf("member agent env diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(containers[1].Args, tc.wantRefreshTokenArgs); diff != "" {
+				t.Errorf("refresh token args diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestWriteYAML tests the WriteYAML function.
+func TestWriteYAML(t *testing.T) {
+	objs := []client.Object{
+		&corev1.Namespace{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Namespace"},
+			ObjectMeta: metav1.ObjectMeta{Name: "fleet-system"},
+		},
+		&corev1.Secret{
+			TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "Secret"},
+			ObjectMeta: metav1.ObjectMeta{Name: HubTokenSecretName, Namespace: "fleet-system"},
+			Data:       map[string][]byte{hubTokenSecretKey: []byte("test-token")},
+		},
+	}
+	want := `apiVersion: v1
+kind: Namespace
+metadata:
+  name: fleet-system
+spec: {}
+---
+apiVersion: v1
+data:
+  token: dGVzdC10b2tlbg==
+kind: Secret
+metadata:
+  name: hub-kubeconfig-secret
+  namespace: fleet-system
+`
+	var out bytes.Buffer
+	if err := WriteYAML(&out, objs); err != nil {
+		t.Fatalf("WriteYAML() = %v, want no error", err)
+	}
+	if diff := cmp.Diff(out.String(), want); diff != "" {
+		t.Errorf("WriteYAML() output mismatch (-got, +want):\n%s", diff)
+	}
+}
@@ -41,10 +41,12 @@ const (
 
 var (
 	// FleetPlacementStatusLastTimeStampSeconds is a prometheus metric which keeps track of the last placement status.
+	// The reasonCategory label groups the condition reasons by outcome, as catalogued in the condition package,
+	// so that alerting rules do not need to enumerate the reasons.
 	FleetPlacementStatusLastTimeStampSeconds = prometheus.NewGaugeVec(prometheus.GaugeOpts{
 		Name: "fleet_workload_placement_status_last_timestamp_seconds",
 		Help: "Last update timestamp of placement status in seconds",
-	}, []string{"namespace", "name", "generation", "conditionType", "status", "reason"})
+	}, []string{"namespace", "name", "generation", "conditionType", "status", "reason", "reasonCategory"})
 
 	// FleetEvictionStatus is prometheus metrics which holds the
 	// status of eviction completion.
@@ -79,6 +81,108 @@ var (
 	}, []string{"namespace", "name"})
 )
 
+// The eviction related metrics.
+var (
+	// FleetEvictionRequestTotal is a prometheus metric which counts the completed eviction requests.
+	// The result label is either granted or denied; the reason label explains why the eviction has been
+	// granted or denied, e.g., PDB for evictions allowed or blocked by a disruption budget.
+	FleetEvictionRequestTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fleet_workload_eviction_request_total",
+		Help: "Number of completed eviction requests by result and reason",
+	}, []string{"result", "reason"})
+
+	// FleetEvictionDurationSeconds tracks how long it takes from the creation of an eviction to its completion.
+	FleetEvictionDurationSeconds = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name: "fleet_workload_eviction_duration_seconds",
+		Help: "The duration from the creation of an eviction to its completion in seconds",
+		// Buckets: 1s, 5s, 15s, 30s, 1min, 5min, 15min, 1hr
+		Buckets: []float64{1, 5, 15, 30, 60, 300, 900, 3600},
+	}, []string{"result"})
+)
+
+// The orphaned resource janitor related metrics.
+var (
+	// FleetOrphanedResourceCount is a prometheus metric which holds the number of placement-owned objects
+	// (bindings, snapshots, and works) whose parent placements no longer exist, as found in the last sweep.
+	// Unreferenced ClusterResourceContent objects are reported under their own kind.
+	FleetOrphanedResourceCount = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "fleet_orphaned_resource_count",
+		Help: "Number of placement-owned objects whose parent placements no longer exist, as found in the last sweep",
+	}, []string{"kind"})
+
+	// FleetOrphanedResourceDeletedTotal is a prometheus metric which counts the orphaned placement-owned
+	// objects that have been deleted.
+	FleetOrphanedResourceDeletedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fleet_orphaned_resource_deleted_total",
+		Help: "Number of orphaned placement-owned objects that have been deleted",
+	}, []string{"kind"})
+)
+
+// The reasons for which scheduling policy snapshots are deleted.
+const (
+	// PolicySnapshotDeletedReasonRevisionLimit indicates that the snapshot is deleted as the number
+	// of snapshots has reached the revision history limit.
+	PolicySnapshotDeletedReasonRevisionLimit = "revision_limit"
+	// PolicySnapshotDeletedReasonCompacted indicates that the snapshot is deleted as it has been
+	// superseded before it is ever scheduled.
+	PolicySnapshotDeletedReasonCompacted = "compacted"
+)
+
+var (
+	// FleetPolicySnapshotCreatedTotal is a prometheus metric which counts the scheduling policy
+	// snapshots created for each placement, i.e., the churn of its scheduling policy.
+	FleetPolicySnapshotCreatedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fleet_policy_snapshot_created_total",
+		Help: "Number of scheduling policy snapshots created for a placement",
+	}, []string{"namespace", "name"})
+
+	// FleetPolicySnapshotDeletedTotal is a prometheus metric which counts the scheduling policy
+	// snapshots deleted for each placement, by the reason of the deletion.
+	FleetPolicySnapshotDeletedTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fleet_policy_snapshot_deleted_total",
+		Help: "Number of scheduling policy snapshots of a placement deleted for compaction or the revision history limit",
+	}, []string{"namespace", "name", "reason"})
+)
+
+// The placement status exporter related metrics.
+var (
+	// FleetStatusExportLagSeconds is a prometheus metric which holds the number of seconds since the placement
+	// statuses and rollout histories were last exported successfully.
+	FleetStatusExportLagSeconds = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "fleet_status_export_lag_seconds",
+		Help: "Number of seconds since the placement statuses and rollout histories were last exported successfully",
+	})
+
+	// FleetStatusExportRecordTotal is a prometheus metric which counts the records that have been exported.
+	FleetStatusExportRecordTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fleet_status_export_record_total",
+		Help: "Number of placement status and rollout history records that have been exported",
+	}, []string{"type"})
+
+	// FleetStatusExportFailureTotal is a prometheus metric which counts the failed export attempts.
+	FleetStatusExportFailureTotal = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "fleet_status_export_failure_total",
+		Help: "Number of failed attempts to export the placement statuses and rollout histories",
+	})
+)
+
+// The hub agent runtime configuration related metrics.
+var (
+	// FleetRuntimeConfigReloadCount is a prometheus metric which counts the attempts to reload the runtime
+	// configuration of the hub agent.
+	FleetRuntimeConfigReloadCount = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fleet_runtime_config_reload_count",
+		Help: "Number of attempts to reload the runtime configuration of the hub agent",
+	}, []string{"result"})
+
+	// FleetRuntimeConfigLastReloadTimestampSeconds is a prometheus metric which holds the timestamp of the
+	// last successful reload of the runtime configuration of the hub agent.
+	FleetRuntimeConfigLastReloadTimestampSeconds = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "fleet_runtime_config_last_reload_timestamp_seconds",
+		Help: "Timestamp in seconds of the last successful reload of the runtime configuration of the hub agent",
+	})
+)
+
 // The scheduler related metrics.
 var (
 	// SchedulingCycleDurationMilliseconds is a Fleet scheduler metric that tracks how long it
@@ -108,9 +212,20 @@ func init() {
 	metrics.Registry.MustRegister(
 		FleetPlacementStatusLastTimeStampSeconds,
 		FleetEvictionStatus,
+		FleetEvictionRequestTotal,
+		FleetEvictionDurationSeconds,
 		FleetUpdateRunStatusLastTimestampSeconds,
 		FleetUpdateRunApprovalRequestLatencySeconds,
 		FleetUpdateRunStageClusterUpdatingDurationSeconds,
+		FleetOrphanedResourceCount,
+		FleetOrphanedResourceDeletedTotal,
+		FleetPolicySnapshotCreatedTotal,
+		FleetPolicySnapshotDeletedTotal,
+		FleetStatusExportLagSeconds,
+		FleetStatusExportRecordTotal,
+		FleetStatusExportFailureTotal,
+		FleetRuntimeConfigReloadCount,
+		FleetRuntimeConfigLastReloadTimestampSeconds,
 		SchedulingCycleDurationMilliseconds,
 		SchedulerActiveWorkers,
 	)
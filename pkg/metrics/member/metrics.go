@@ -55,6 +55,20 @@ var (
 		Help: "Total number of processing requests of work objects, including retries and periodic checks",
 	}, []string{"apply_status", "availability_status", "diff_reporting_status"})
 
+	// FleetTenantWorkProcessingRequestsTotal is a prometheus metric which counts the
+	// total number of work object processing requests per tenant; it complements
+	// FleetWorkProcessingRequestsTotal, which counts the requests of all the tenants.
+	//
+	// The following labels are available:
+	// * tenant: the tenant that the work object belongs to; the value is "default" for
+	//   the member cluster itself.
+	// * apply_status, availability_status, diff_reporting_status: the same as the ones
+	//   of FleetWorkProcessingRequestsTotal.
+	FleetTenantWorkProcessingRequestsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fleet_tenant_work_processing_requests_total",
+		Help: "Total number of processing requests of work objects per tenant, including retries and periodic checks",
+	}, []string{"tenant", "apply_status", "availability_status", "diff_reporting_status"})
+
 	// FleetManifestProcessingRequestsTotal is a prometheus metric which counts the
 	// total number of manifest object processing requests.
 	//
@@ -78,12 +92,28 @@ var (
 		Name: "fleet_manifest_processing_requests_total",
 		Help: "Total number of processing requests of manifest objects, including retries and periodic checks",
 	}, []string{"apply_status", "availability_status", "diff_reporting_status", "drift_detection_status", "diff_detection_status"})
+
+	// FleetManifestApplyOpsTotal is a prometheus metric which counts the total number of
+	// apply ops on manifest objects that already exist in the member cluster.
+	//
+	// The following labels are available:
+	// * result: "Performed" if the apply op has been completed by the member cluster API server;
+	//   "Failed" if the apply op has been attempted but has failed; "Skipped" if the apply op
+	//   has been skipped as it would not change the object.
+	// * tenant: the tenant that the manifest object belongs to; the value is "default" unless
+	//   the member agent serves multiple tenants.
+	FleetManifestApplyOpsTotal = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "fleet_manifest_apply_ops_total",
+		Help: "Total number of apply ops on manifest objects that already exist in the member cluster, performed, failed, or skipped as no-ops",
+	}, []string{"result", "tenant"})
 )
 
 func init() {
 	metrics.Registry.MustRegister(
 		WorkApplyTime,
 		FleetWorkProcessingRequestsTotal,
+		FleetTenantWorkProcessingRequestsTotal,
 		FleetManifestProcessingRequestsTotal,
+		FleetManifestApplyOpsTotal,
 	)
 }
@@ -0,0 +1,430 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package placementview features a read-only aggregated API (views.kubernetes-fleet.io), which serves
+// denormalized views of placements, i.e., placement -> clusters -> per-resource status, from the
+// informer caches of the hub agent, so that dashboards can read the status of the fleet without
+// issuing a list call per placement and per binding against the API server.
+package placementview
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/aggregatedapi"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+const (
+	// GroupName is the name of the API group under which the placement views are served.
+	GroupName = "views.kubernetes-fleet.io"
+	// Version is the version of the API group.
+	Version = "v1alpha1"
+	// APIPath is the path under which the placement views are served.
+	APIPath = "/apis/" + GroupName + "/" + Version
+	// APIServiceName is the name of the APIService object that registers the API group.
+	APIServiceName = Version + "." + GroupName
+
+	// ClusterResourcePlacementViewResource is the resource of the ClusterResourcePlacement views.
+	ClusterResourcePlacementViewResource = "clusterresourceplacementviews"
+	// ResourcePlacementViewResource is the resource of the ResourcePlacement views.
+	ResourcePlacementViewResource = "resourceplacementviews"
+
+	clusterResourcePlacementViewKind = "ClusterResourcePlacementView"
+	resourcePlacementViewKind        = "ResourcePlacementView"
+)
+
+var (
+	// SchemeGroupVersion is the group version of the placement views.
+	SchemeGroupVersion = schema.GroupVersion{Group: GroupName, Version: Version}
+)
+
+// Handler serves the placement views.
+type Handler struct {
+	client                   client.Reader
+	authorizer               aggregatedapi.Authorizer
+	resourcePlacementEnabled bool
+}
+
+// NewHandler returns a new handler that serves the placement views, with the placements and their
+// bindings read via the given client, which is expected to be backed by informer caches.
+//
+// A user must be allowed to get (or list) the views in the namespace of a request, or at the cluster
+// scope for ClusterResourcePlacement views and for ResourcePlacement views across all namespaces.
+func NewHandler(client client.Reader, authorizer aggregatedapi.Authorizer, resourcePlacementEnabled bool) *Handler {
+	return &Handler{
+		client:                   client,
+		authorizer:               authorizer,
+		resourcePlacementEnabled: resourcePlacementEnabled,
+	}
+}
+
+// viewRequest is a parsed request for placement views.
+type viewRequest struct {
+	resource string
+	// namespace is empty for ClusterResourcePlacement views and for ResourcePlacement views across all namespaces.
+	namespace string
+	// name is empty for list requests.
+	name string
+}
+
+// ServeHTTP serves the discovery document of the API, and the placement views.
+func (h *Handler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		aggregatedapi.WriteStatus(w, apierrors.NewMethodNotSupported(SchemeGroupVersion.WithResource("").GroupResource(), r.Method))
+		return
+	}
+
+	subPath := strings.Trim(strings.TrimPrefix(r.URL.Path, APIPath), "/")
+	if subPath == "" {
+		aggregatedapi.WriteJSON(w, http.StatusOK, h.discoveryDocument())
+		return
+	}
+	req, ok := h.parsePath(subPath)
+	if !ok {
+		aggregatedapi.WriteStatus(w, apierrors.NewNotFound(SchemeGroupVersion.WithResource(subPath).GroupResource(), ""))
+		return
+	}
+	if err := h.authorize(r.Context(), req); err != nil {
+		aggregatedapi.WriteStatus(w, err)
+		return
+	}
+
+	if req.name != "" {
+		view, err := h.getView(r.Context(), req)
+		if err != nil {
+			aggregatedapi.WriteStatus(w, err)
+			return
+		}
+		aggregatedapi.WriteJSON(w, http.StatusOK, view)
+		return
+	}
+
+	query := r.URL.Query()
+	selector, err := labels.Parse(query.Get("labelSelector"))
+	if err != nil {
+		aggregatedapi.WriteStatus(w, apierrors.NewBadRequest(fmt.Sprintf("invalid label selector: %v", err)))
+		return
+	}
+	limit := 0
+	if limitStr := query.Get("limit"); limitStr != "" {
+		if limit, err = strconv.Atoi(limitStr); err != nil || limit < 0 {
+			aggregatedapi.WriteStatus(w, apierrors.NewBadRequest(fmt.Sprintf("invalid limit %q: must be a non-negative integer", limitStr)))
+			return
+		}
+	}
+	viewList, err := h.listViews(r.Context(), req, selector, limit, query.Get("continue"))
+	if err != nil {
+		aggregatedapi.WriteStatus(w, err)
+		return
+	}
+	aggregatedapi.WriteJSON(w, http.StatusOK, viewList)
+}
+
+// parsePath parses the path of a placement view request, relative to the API path.
+//
+// ClusterResourcePlacement views are served at /clusterresourceplacementviews[/{name}]; ResourcePlacement
+// views are served at /namespaces/{namespace}/resourceplacementviews[/{name}], and across all namespaces
+// at /resourceplacementviews.
+func (h *Handler) parsePath(subPath string) (viewRequest, bool) {
+	segs := strings.Split(subPath, "/")
+	var req viewRequest
+	switch {
+	case segs[0] == ClusterResourcePlacementViewResource && len(segs) <= 2:
+		req.resource = ClusterResourcePlacementViewResource
+		if len(segs) == 2 {
+			req.name = segs[1]
+		}
+	case segs[0] == ResourcePlacementViewResource && len(segs) == 1:
+		req.resource = ResourcePlacementViewResource
+	case segs[0] == "namespaces" && (len(segs) == 3 || len(segs) == 4) && segs[1] != "" && segs[2] == ResourcePlacementViewResource:
+		req.resource, req.namespace = ResourcePlacementViewResource, segs[1]
+		if len(segs) == 4 {
+			req.name = segs[3]
+		}
+	default:
+		return req, false
+	}
+	if req.resource == ResourcePlacementViewResource && !h.resourcePlacementEnabled {
+		return req, false
+	}
+	return req, true
+}
+
+// authorize checks if the user who sends a request may read the requested views.
+func (h *Handler) authorize(ctx context.Context, req viewRequest) error {
+	user, ok := aggregatedapi.UserFrom(ctx)
+	if !ok {
+		return apierrors.NewUnauthorized("no authenticated user is found in the request")
+	}
+	verb := "list"
+	if req.name != "" {
+		verb = "get"
+	}
+	return h.authorizer.Authorize(ctx, user, &authorizationv1.ResourceAttributes{
+		Namespace: req.namespace,
+		Verb:      verb,
+		Group:     GroupName,
+		Version:   Version,
+		Resource:  req.resource,
+		Name:      req.name,
+	})
+}
+
+// getView returns the view of a single placement.
+func (h *Handler) getView(ctx context.Context, req viewRequest) (*PlacementView, error) {
+	var placement placementv1beta1.PlacementObj
+	var bindingList placementv1beta1.BindingObjList
+	if req.resource == ClusterResourcePlacementViewResource {
+		placement, bindingList = &placementv1beta1.ClusterResourcePlacement{}, &placementv1beta1.ClusterResourceBindingList{}
+	} else {
+		placement, bindingList = &placementv1beta1.ResourcePlacement{}, &placementv1beta1.ResourceBindingList{}
+	}
+	if err := h.client.Get(ctx, types.NamespacedName{Namespace: req.namespace, Name: req.name}, placement); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, apierrors.NewNotFound(SchemeGroupVersion.WithResource(req.resource).GroupResource(), req.name)
+		}
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed to get placement %s: %w", req.name, err))
+	}
+	if err := h.client.List(ctx, bindingList, client.InNamespace(req.namespace), client.MatchingLabels{placementv1beta1.PlacementTrackingLabel: req.name}); err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed to list the bindings of placement %s: %w", req.name, err))
+	}
+	view := buildView(placement, bindingList.GetBindingObjs())
+	return &view, nil
+}
+
+// listViews returns a page of the views of the placements that match the label selector. The placements
+// are sorted by namespace and name; the continue token is the (encoded) key of the last placement served.
+func (h *Handler) listViews(ctx context.Context, req viewRequest, selector labels.Selector, limit int, continueToken string) (*PlacementViewList, error) {
+	startAfter := ""
+	if continueToken != "" {
+		decoded, err := base64.RawURLEncoding.DecodeString(continueToken)
+		if err != nil {
+			return nil, apierrors.NewBadRequest(fmt.Sprintf("invalid continue token %q", continueToken))
+		}
+		startAfter = string(decoded)
+	}
+
+	var placementList placementv1beta1.PlacementListItemGetter
+	var bindingList placementv1beta1.BindingObjList
+	kind := clusterResourcePlacementViewKind
+	if req.resource == ClusterResourcePlacementViewResource {
+		crpList, crbList := &placementv1beta1.ClusterResourcePlacementList{}, &placementv1beta1.ClusterResourceBindingList{}
+		if err := h.client.List(ctx, crpList, client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, apierrors.NewInternalError(fmt.Errorf("failed to list cluster resource placements: %w", err))
+		}
+		placementList, bindingList = crpList, crbList
+	} else {
+		rpList, rbList := &placementv1beta1.ResourcePlacementList{}, &placementv1beta1.ResourceBindingList{}
+		if err := h.client.List(ctx, rpList, client.InNamespace(req.namespace), client.MatchingLabelsSelector{Selector: selector}); err != nil {
+			return nil, apierrors.NewInternalError(fmt.Errorf("failed to list resource placements: %w", err))
+		}
+		placementList, bindingList, kind = rpList, rbList, resourcePlacementViewKind
+	}
+
+	placements := placementList.GetPlacementObjs()
+	sort.Slice(placements, func(i, j int) bool {
+		return placementKey(placements[i]) < placementKey(placements[j])
+	})
+	start := sort.Search(len(placements), func(i int) bool {
+		return placementKey(placements[i]) > startAfter
+	})
+	placements = placements[start:]
+	viewList := &PlacementViewList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       kind + "List",
+			APIVersion: SchemeGroupVersion.String(),
+		},
+		Items: make([]PlacementView, 0, len(placements)),
+	}
+	if limit > 0 && len(placements) > limit {
+		placements = placements[:limit]
+		viewList.Continue = base64.RawURLEncoding.EncodeToString([]byte(placementKey(placements[limit-1])))
+	}
+	if len(placements) == 0 {
+		return viewList, nil
+	}
+
+	// List all the bindings at once (from the cache), and group them by placement.
+	if err := h.client.List(ctx, bindingList, client.InNamespace(req.namespace)); err != nil {
+		return nil, apierrors.NewInternalError(fmt.Errorf("failed to list bindings: %w", err))
+	}
+	bindingsByPlacement := make(map[string][]placementv1beta1.BindingObj)
+	for _, binding := range bindingList.GetBindingObjs() {
+		key := binding.GetNamespace() + "/" + binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+		bindingsByPlacement[key] = append(bindingsByPlacement[key], binding)
+	}
+	for _, placement := range placements {
+		viewList.Items = append(viewList.Items, buildView(placement, bindingsByPlacement[placementKey(placement)]))
+	}
+	return viewList, nil
+}
+
+// placementKey returns the key by which placements are sorted and paginated.
+func placementKey(placement placementv1beta1.PlacementObj) string {
+	return placement.GetNamespace() + "/" + placement.GetName()
+}
+
+// buildView builds the view of a placement from the placement and its bindings.
+func buildView(placement placementv1beta1.PlacementObj, bindings []placementv1beta1.BindingObj) PlacementView {
+	kind := clusterResourcePlacementViewKind
+	if placement.GetNamespace() != "" {
+		kind = resourcePlacementViewKind
+	}
+	status := placement.GetPlacementStatus()
+	view := PlacementView{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       kind,
+			APIVersion: SchemeGroupVersion.String(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              placement.GetName(),
+			Namespace:         placement.GetNamespace(),
+			UID:               placement.GetUID(),
+			Generation:        placement.GetGeneration(),
+			CreationTimestamp: placement.GetCreationTimestamp(),
+			Labels:            placement.GetLabels(),
+		},
+		ObservedResourceIndex: status.ObservedResourceIndex,
+		Conditions:            status.Conditions,
+		Clusters:              make([]ClusterView, 0, len(bindings)),
+	}
+	for _, binding := range bindings {
+		view.Clusters = append(view.Clusters, buildClusterView(binding, status.SelectedResources))
+	}
+	sort.Slice(view.Clusters, func(i, j int) bool {
+		if view.Clusters[i].ClusterName != view.Clusters[j].ClusterName {
+			return view.Clusters[i].ClusterName < view.Clusters[j].ClusterName
+		}
+		return view.Clusters[i].BindingName < view.Clusters[j].BindingName
+	})
+	return view
+}
+
+// buildClusterView builds the view of a placement on the target cluster of a binding, with the status
+// of each selected resource derived from the resource placements the binding reports, and for the
+// resources not reported, from the conditions of the binding.
+func buildClusterView(binding placementv1beta1.BindingObj, selectedResources []placementv1beta1.ResourceIdentifier) ClusterView {
+	spec, status := binding.GetBindingSpec(), binding.GetBindingStatus()
+	clusterView := ClusterView{
+		ClusterName:                 spec.TargetCluster,
+		BindingName:                 binding.GetName(),
+		State:                       spec.State,
+		ResourceSnapshotName:        spec.ResourceSnapshotName,
+		Conditions:                  status.Conditions,
+		FailedResourceOverflowCount: status.FailedPlacementOverflowCount,
+	}
+
+	defaultStatus := ResourceStatusPending
+	generation := binding.GetGeneration()
+	switch {
+	case condition.IsConditionStatusTrue(binding.GetCondition(string(placementv1beta1.ResourceBindingAvailable)), generation):
+		defaultStatus = ResourceStatusAvailable
+	case condition.IsConditionStatusTrue(binding.GetCondition(string(placementv1beta1.ResourceBindingApplied)), generation):
+		defaultStatus = ResourceStatusApplied
+	}
+
+	// Failures take precedence over drifts, which take precedence over diffs.
+	reported := make(map[string]ResourceView)
+	var reportedKeys []string
+	report := func(id placementv1beta1.ResourceIdentifier, resourceStatus ResourceStatus, cond *metav1.Condition) {
+		key := resourceKey(id)
+		if _, found := reported[key]; found {
+			return
+		}
+		reported[key] = ResourceView{ResourceIdentifier: id, Status: resourceStatus, Condition: cond}
+		reportedKeys = append(reportedKeys, key)
+	}
+	for i := range status.FailedPlacements {
+		report(status.FailedPlacements[i].ResourceIdentifier, ResourceStatusFailed, &status.FailedPlacements[i].Condition)
+	}
+	for i := range status.DriftedPlacements {
+		report(status.DriftedPlacements[i].ResourceIdentifier, ResourceStatusDrifted, nil)
+	}
+	for i := range status.DiffedPlacements {
+		report(status.DiffedPlacements[i].ResourceIdentifier, ResourceStatusDiffed, nil)
+	}
+
+	clusterView.Resources = make([]ResourceView, 0, len(selectedResources))
+	for _, id := range selectedResources {
+		key := resourceKey(id)
+		if resourceView, found := reported[key]; found {
+			clusterView.Resources = append(clusterView.Resources, resourceView)
+			delete(reported, key)
+			continue
+		}
+		clusterView.Resources = append(clusterView.Resources, ResourceView{ResourceIdentifier: id, Status: defaultStatus})
+	}
+	// Resources reported by the binding but not selected by the placement, e.g., the ones wrapped in an
+	// envelope, are listed after the selected ones.
+	for _, key := range reportedKeys {
+		if resourceView, found := reported[key]; found {
+			clusterView.Resources = append(clusterView.Resources, resourceView)
+		}
+	}
+	return clusterView
+}
+
+// resourceKey returns the key that identifies a resource, including its envelope if any.
+func resourceKey(id placementv1beta1.ResourceIdentifier) string {
+	key := fmt.Sprintf("%s/%s/%s/%s/%s", id.Group, id.Version, id.Kind, id.Namespace, id.Name)
+	if id.Envelope != nil {
+		key += fmt.Sprintf("@%s/%s/%s", id.Envelope.Type, id.Envelope.Namespace, id.Envelope.Name)
+	}
+	return key
+}
+
+// discoveryDocument returns the discovery document of the API.
+func (h *Handler) discoveryDocument() *metav1.APIResourceList {
+	resources := []metav1.APIResource{
+		{
+			Name:       ClusterResourcePlacementViewResource,
+			Namespaced: false,
+			Kind:       clusterResourcePlacementViewKind,
+			Verbs:      metav1.Verbs{"get", "list"},
+		},
+	}
+	if h.resourcePlacementEnabled {
+		resources = append(resources, metav1.APIResource{
+			Name:       ResourcePlacementViewResource,
+			Namespaced: true,
+			Kind:       resourcePlacementViewKind,
+			Verbs:      metav1.Verbs{"get", "list"},
+		})
+	}
+	return &metav1.APIResourceList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "APIResourceList",
+			APIVersion: "v1",
+		},
+		GroupVersion: SchemeGroupVersion.String(),
+		APIResources: resources,
+	}
+}
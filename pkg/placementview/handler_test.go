@@ -0,0 +1,340 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementview
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/aggregatedapi"
+)
+
+const (
+	crpName   = "crp-1"
+	rpName    = "rp-1"
+	namespace = "work"
+)
+
+var (
+	deploy = placementv1beta1.ResourceIdentifier{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: namespace, Name: "app"}
+	svc    = placementv1beta1.ResourceIdentifier{Version: "v1", Kind: "Service", Namespace: namespace, Name: "app"}
+)
+
+// fakeAuthorizer allows access to the views in every namespace except the forbidden ones; the empty
+// namespace stands for the cluster scope.
+type fakeAuthorizer struct {
+	forbiddenNamespaces []string
+}
+
+func (a *fakeAuthorizer) Authorize(_ context.Context, _ *authenticationv1.UserInfo, attrs *authorizationv1.ResourceAttributes) error {
+	for _, ns := range a.forbiddenNamespaces {
+		if ns == attrs.Namespace {
+			return apierrors.NewForbidden(schema.GroupResource{Group: attrs.Group, Resource: attrs.Resource}, attrs.Name, errors.New("forbidden"))
+		}
+	}
+	return nil
+}
+
+func crp(name string) *placementv1beta1.ClusterResourcePlacement {
+	return &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Generation: 1,
+		},
+		Status: placementv1beta1.PlacementStatus{
+			SelectedResources:     []placementv1beta1.ResourceIdentifier{deploy, svc},
+			ObservedResourceIndex: "2",
+		},
+	}
+}
+
+func crb(name, placementName, cluster string, conds ...metav1.Condition) *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       name,
+			Generation: 1,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: placementName,
+			},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:                placementv1beta1.BindingStateBound,
+			TargetCluster:        cluster,
+			ResourceSnapshotName: placementName + "-2-snapshot",
+		},
+		Status: placementv1beta1.ResourceBindingStatus{
+			Conditions: conds,
+		},
+	}
+}
+
+func bindingCond(condType placementv1beta1.ResourceBindingConditionType) metav1.Condition {
+	return metav1.Condition{
+		Type:               string(condType),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: 1,
+	}
+}
+
+func TestServeHTTP(t *testing.T) {
+	failedCond := metav1.Condition{
+		Type:   string(placementv1beta1.ResourceBindingApplied),
+		Status: metav1.ConditionFalse,
+		Reason: "ManifestApplyFailed",
+	}
+	failedBinding := crb("crp-1-member-2", crpName, "member-2", bindingCond(placementv1beta1.ResourceBindingApplied))
+	failedBinding.Status.FailedPlacements = []placementv1beta1.FailedResourcePlacement{
+		{ResourceIdentifier: svc, Condition: failedCond},
+	}
+	rp := &placementv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       rpName,
+			Namespace:  namespace,
+			Generation: 1,
+		},
+	}
+	objects := []client.Object{
+		crp(crpName),
+		crp("crp-2"),
+		crp("crp-3"),
+		crb("crp-1-member-1", crpName, "member-1", bindingCond(placementv1beta1.ResourceBindingApplied), bindingCond(placementv1beta1.ResourceBindingAvailable)),
+		failedBinding,
+		crb("crp-2-member-1", "crp-2", "member-1"),
+		rp,
+	}
+
+	crp1View := PlacementView{
+		TypeMeta:              metav1.TypeMeta{Kind: clusterResourcePlacementViewKind, APIVersion: SchemeGroupVersion.String()},
+		ObjectMeta:            metav1.ObjectMeta{Name: crpName, Generation: 1},
+		ObservedResourceIndex: "2",
+		Clusters: []ClusterView{
+			{
+				ClusterName:          "member-1",
+				BindingName:          "crp-1-member-1",
+				State:                placementv1beta1.BindingStateBound,
+				ResourceSnapshotName: "crp-1-2-snapshot",
+				Conditions:           []metav1.Condition{bindingCond(placementv1beta1.ResourceBindingApplied), bindingCond(placementv1beta1.ResourceBindingAvailable)},
+				Resources: []ResourceView{
+					{ResourceIdentifier: deploy, Status: ResourceStatusAvailable},
+					{ResourceIdentifier: svc, Status: ResourceStatusAvailable},
+				},
+			},
+			{
+				ClusterName:          "member-2",
+				BindingName:          "crp-1-member-2",
+				State:                placementv1beta1.BindingStateBound,
+				ResourceSnapshotName: "crp-1-2-snapshot",
+				Conditions:           []metav1.Condition{bindingCond(placementv1beta1.ResourceBindingApplied)},
+				Resources: []ResourceView{
+					{ResourceIdentifier: deploy, Status: ResourceStatusApplied},
+					{ResourceIdentifier: svc, Status: ResourceStatusFailed, Condition: &failedCond},
+				},
+			},
+		},
+	}
+	crp2View := PlacementView{
+		TypeMeta:              metav1.TypeMeta{Kind: clusterResourcePlacementViewKind, APIVersion: SchemeGroupVersion.String()},
+		ObjectMeta:            metav1.ObjectMeta{Name: "crp-2", Generation: 1},
+		ObservedResourceIndex: "2",
+		Clusters: []ClusterView{
+			{
+				ClusterName:          "member-1",
+				BindingName:          "crp-2-member-1",
+				State:                placementv1beta1.BindingStateBound,
+				ResourceSnapshotName: "crp-2-2-snapshot",
+				Resources: []ResourceView{
+					{ResourceIdentifier: deploy, Status: ResourceStatusPending},
+					{ResourceIdentifier: svc, Status: ResourceStatusPending},
+				},
+			},
+		},
+	}
+	crp3View := PlacementView{
+		TypeMeta:              metav1.TypeMeta{Kind: clusterResourcePlacementViewKind, APIVersion: SchemeGroupVersion.String()},
+		ObjectMeta:            metav1.ObjectMeta{Name: "crp-3", Generation: 1},
+		ObservedResourceIndex: "2",
+	}
+	rpView := PlacementView{
+		TypeMeta:   metav1.TypeMeta{Kind: resourcePlacementViewKind, APIVersion: SchemeGroupVersion.String()},
+		ObjectMeta: metav1.ObjectMeta{Name: rpName, Namespace: namespace, Generation: 1},
+	}
+
+	testCases := []struct {
+		name                     string
+		path                     string
+		resourcePlacementEnabled bool
+		unauthenticated          bool
+		forbiddenNamespaces      []string
+		wantCode                 int
+		wantView                 *PlacementView
+		wantViews                []PlacementView
+		wantContinue             bool
+	}{
+		{
+			name:     "discovery",
+			path:     APIPath,
+			wantCode: http.StatusOK,
+		},
+		{
+			name:     "get a cluster resource placement view",
+			path:     APIPath + "/" + ClusterResourcePlacementViewResource + "/" + crpName,
+			wantCode: http.StatusOK,
+			wantView: &crp1View,
+		},
+		{
+			name:     "get a missing cluster resource placement view",
+			path:     APIPath + "/" + ClusterResourcePlacementViewResource + "/missing",
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:      "list cluster resource placement views",
+			path:      APIPath + "/" + ClusterResourcePlacementViewResource,
+			wantCode:  http.StatusOK,
+			wantViews: []PlacementView{crp1View, crp2View, crp3View},
+		},
+		{
+			name:         "list the first page of cluster resource placement views",
+			path:         APIPath + "/" + ClusterResourcePlacementViewResource + "?limit=2",
+			wantCode:     http.StatusOK,
+			wantViews:    []PlacementView{crp1View, crp2View},
+			wantContinue: true,
+		},
+		{
+			name:      "list the next page of cluster resource placement views",
+			path:      APIPath + "/" + ClusterResourcePlacementViewResource + "?limit=2&continue=L2NycC0y",
+			wantCode:  http.StatusOK,
+			wantViews: []PlacementView{crp3View},
+		},
+		{
+			name:     "invalid limit",
+			path:     APIPath + "/" + ClusterResourcePlacementViewResource + "?limit=-1",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:     "invalid continue token",
+			path:     APIPath + "/" + ClusterResourcePlacementViewResource + "?continue=%25",
+			wantCode: http.StatusBadRequest,
+		},
+		{
+			name:                     "list resource placement views in a namespace",
+			path:                     APIPath + "/namespaces/" + namespace + "/" + ResourcePlacementViewResource,
+			resourcePlacementEnabled: true,
+			wantCode:                 http.StatusOK,
+			wantViews:                []PlacementView{rpView},
+		},
+		{
+			name:                     "get a resource placement view",
+			path:                     APIPath + "/namespaces/" + namespace + "/" + ResourcePlacementViewResource + "/" + rpName,
+			resourcePlacementEnabled: true,
+			wantCode:                 http.StatusOK,
+			wantView:                 &rpView,
+		},
+		{
+			name:                     "get a resource placement view in a forbidden namespace",
+			path:                     APIPath + "/namespaces/" + namespace + "/" + ResourcePlacementViewResource + "/" + rpName,
+			resourcePlacementEnabled: true,
+			forbiddenNamespaces:      []string{namespace},
+			wantCode:                 http.StatusForbidden,
+		},
+		{
+			name:                     "list resource placement views across namespaces, forbidden at the cluster scope",
+			path:                     APIPath + "/" + ResourcePlacementViewResource,
+			resourcePlacementEnabled: true,
+			forbiddenNamespaces:      []string{""},
+			wantCode:                 http.StatusForbidden,
+		},
+		{
+			name:            "unauthenticated",
+			path:            APIPath + "/" + ClusterResourcePlacementViewResource,
+			unauthenticated: true,
+			wantCode:        http.StatusUnauthorized,
+		},
+		{
+			name:     "resource placement views disabled",
+			path:     APIPath + "/" + ResourcePlacementViewResource,
+			wantCode: http.StatusNotFound,
+		},
+		{
+			name:     "invalid path",
+			path:     APIPath + "/namespaces/" + namespace + "/" + ClusterResourcePlacementViewResource,
+			wantCode: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := placementv1beta1.AddToScheme(scheme); err != nil {
+				t.Fatalf("AddToScheme() = %v, want no error", err)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+			h := NewHandler(fakeClient, &fakeAuthorizer{forbiddenNamespaces: tc.forbiddenNamespaces}, tc.resourcePlacementEnabled)
+
+			rec := httptest.NewRecorder()
+			req := httptest.NewRequest(http.MethodGet, tc.path, nil)
+			if !tc.unauthenticated {
+				req = req.WithContext(aggregatedapi.WithUser(req.Context(), &authenticationv1.UserInfo{Username: "user"}))
+			}
+			h.ServeHTTP(rec, req)
+			if rec.Code != tc.wantCode {
+				t.Fatalf("ServeHTTP() code = %d, want %d, body: %s", rec.Code, tc.wantCode, rec.Body.String())
+			}
+
+			cmpOptions := []cmp.Option{
+				cmpopts.EquateEmpty(),
+				cmpopts.IgnoreFields(metav1.ObjectMeta{}, "ResourceVersion", "CreationTimestamp"),
+			}
+			if tc.wantView != nil {
+				got := &PlacementView{}
+				if err := json.Unmarshal(rec.Body.Bytes(), got); err != nil {
+					t.Fatalf("failed to unmarshal the response: %v", err)
+				}
+				if diff := cmp.Diff(got, tc.wantView, cmpOptions...); diff != "" {
+					t.Errorf("ServeHTTP() placement view mismatch (-got, +want):\n%s", diff)
+				}
+			}
+			if tc.wantViews != nil {
+				got := &PlacementViewList{}
+				if err := json.Unmarshal(rec.Body.Bytes(), got); err != nil {
+					t.Fatalf("failed to unmarshal the response: %v", err)
+				}
+				if diff := cmp.Diff(got.Items, tc.wantViews, cmpOptions...); diff != "" {
+					t.Errorf("ServeHTTP() placement views mismatch (-got, +want):\n%s", diff)
+				}
+				if gotContinue := got.Continue != ""; gotContinue != tc.wantContinue {
+					t.Errorf("ServeHTTP() continue token = %q, want set %t", got.Continue, tc.wantContinue)
+				}
+			}
+		})
+	}
+}
@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementview
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// ResourceStatus describes the status of a selected resource on a member cluster.
+type ResourceStatus string
+
+const (
+	// ResourceStatusPending means the resource has not been applied on the cluster yet, e.g., the
+	// binding has not been rolled out.
+	ResourceStatusPending ResourceStatus = "Pending"
+	// ResourceStatusApplied means the resource has been applied on the cluster, but is not available yet.
+	ResourceStatusApplied ResourceStatus = "Applied"
+	// ResourceStatusAvailable means the resource has been applied on the cluster and is available.
+	ResourceStatusAvailable ResourceStatus = "Available"
+	// ResourceStatusFailed means the resource has failed to be applied on the cluster, or it is unavailable.
+	ResourceStatusFailed ResourceStatus = "Failed"
+	// ResourceStatusDrifted means the resource has drifted from its desired state on the cluster.
+	ResourceStatusDrifted ResourceStatus = "Drifted"
+	// ResourceStatusDiffed means the resource on the cluster has configuration differences from its
+	// hub cluster manifest.
+	ResourceStatusDiffed ResourceStatus = "Diffed"
+)
+
+// PlacementView is a denormalized, read-only view of a placement, which joins the placement with
+// its bindings, i.e., placement -> clusters -> per-resource status.
+type PlacementView struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	// ObservedResourceIndex is the index of the resource snapshot that the placement currently selects.
+	ObservedResourceIndex string `json:"observedResourceIndex,omitempty"`
+
+	// Conditions are the conditions of the placement.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Clusters are the views of the clusters the placement has bindings to, sorted by cluster name.
+	Clusters []ClusterView `json:"clusters"`
+}
+
+// ClusterView is the view of a placement on a member cluster, as reported by the binding.
+type ClusterView struct {
+	// ClusterName is the name of the member cluster.
+	ClusterName string `json:"clusterName"`
+
+	// BindingName is the name of the binding to the cluster.
+	BindingName string `json:"bindingName"`
+
+	// State is the state of the binding.
+	State placementv1beta1.BindingState `json:"state"`
+
+	// ResourceSnapshotName is the name of the resource snapshot the binding points to.
+	ResourceSnapshotName string `json:"resourceSnapshotName,omitempty"`
+
+	// Conditions are the conditions of the binding.
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+
+	// Resources are the statuses of the selected resources on the cluster.
+	Resources []ResourceView `json:"resources,omitempty"`
+
+	// FailedResourceOverflowCount is the number of failed resources that the binding does not list, in
+	// which case some resources are reported with a status better than the actual one.
+	FailedResourceOverflowCount int32 `json:"failedResourceOverflowCount,omitempty"`
+}
+
+// ResourceView is the status of a selected resource on a member cluster.
+type ResourceView struct {
+	placementv1beta1.ResourceIdentifier `json:",inline"`
+
+	// Status is the status of the resource on the cluster.
+	Status ResourceStatus `json:"status"`
+
+	// Condition is the condition that explains a failure, if any.
+	Condition *metav1.Condition `json:"condition,omitempty"`
+}
+
+// PlacementViewList is a list of placement views.
+type PlacementViewList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+
+	Items []PlacementView `json:"items"`
+}
@@ -37,6 +37,19 @@ const (
 	// ClusterCertificateAuthorityProperty is a property that describes the cluster's certificate authority data (base64 encoded).
 	ClusterCertificateAuthorityProperty = "k8s.io/cluster-certificate-authority-data"
 
+	// The cluster autoscaling properties.
+	//
+	// Note that these properties are not reported by the default property provider; a property provider
+	// that integrates with the cluster autoscaler of the member cluster may report them.
+
+	// ScaleDownInProgressProperty is a property that describes whether the cluster autoscaler is removing
+	// nodes from the cluster; a value greater than 0 signals that a scale down is in progress.
+	ScaleDownInProgressProperty = "autoscaler.kubernetes-fleet.io/scale-down-in-progress"
+
+	// ProvisioningHeadroomProperty is a property that describes the number of nodes that the cluster
+	// autoscaler is provisioning for the cluster, i.e., the pending capacity expansion of the cluster.
+	ProvisioningHeadroomProperty = "autoscaler.kubernetes-fleet.io/provisioning-headroom"
+
 	// The resource properties.
 	// Total and allocatable CPU resource properties.
 	TotalCPUCapacityProperty       = "resources.kubernetes-fleet.io/total-cpu"
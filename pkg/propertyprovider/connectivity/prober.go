@@ -0,0 +1,269 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package connectivity features a prober that checks whether a member cluster can reach the
+// endpoints it depends on, e.g., the hub cluster, container registries, and internal services, and
+// reports the results as cluster properties, so that placements can require clusters with working
+// egress to a dependency.
+package connectivity
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"sync"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/yaml"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+const (
+	// PropertyNamePrefix is the prefix (also known as the subdomain) of the label name associated
+	// with all connectivity properties.
+	PropertyNamePrefix = "connectivity.kubernetes-fleet.io/"
+
+	// ReachablePropertyTmpl is the template of the property that describes whether a target is
+	// reachable from the cluster; the value is 1 if the target is reachable, and 0 otherwise.
+	ReachablePropertyTmpl = PropertyNamePrefix + "%s-reachable"
+	// LatencyPropertyTmpl is the template of the property that describes the latency, in
+	// milliseconds, of the last successful probe against a target. The property is reported only
+	// when the target is reachable.
+	LatencyPropertyTmpl = PropertyNamePrefix + "%s-latency-ms"
+
+	// maxTargetNameLength is the maximum length of a target name, so that the names of the
+	// properties, which are Kubernetes label names, do not exceed 63 characters.
+	maxTargetNameLength = 50
+)
+
+// Target is an endpoint that the member cluster probes.
+type Target struct {
+	// Name is the name of the target, which is used in the names of the reported properties; it
+	// must be a DNS label of no more than 50 characters.
+	Name string `json:"name"`
+	// Address is the address of the target. An HTTP or HTTPS URL is probed with a GET request,
+	// and the target is considered reachable as long as it responds, whatever the status code;
+	// an address of the form host:port is probed by opening a TCP connection.
+	Address string `json:"address"`
+}
+
+// targetList is the format of the targets file.
+type targetList struct {
+	Targets []Target `json:"targets"`
+}
+
+// LoadTargetsFromFile reads the targets to probe from the given file, which is a YAML or JSON
+// object, e.g.,
+//
+//	targets:
+//	- name: hub
+//	  address: https://hub.example.com:443
+//	- name: registry
+//	  address: registry.example.com:443
+func LoadTargetsFromFile(path string) ([]Target, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the connectivity probe targets file: %w", err)
+	}
+	list := targetList{}
+	if err := yaml.Unmarshal(data, &list); err != nil {
+		return nil, fmt.Errorf("failed to parse the connectivity probe targets file: %w", err)
+	}
+	if err := ValidateTargets(list.Targets); err != nil {
+		return nil, err
+	}
+	return list.Targets, nil
+}
+
+// ValidateTargets verifies that the targets have valid and unique names and valid addresses.
+func ValidateTargets(targets []Target) error {
+	names := make(map[string]bool, len(targets))
+	for _, target := range targets {
+		if errs := validation.IsDNS1123Label(target.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid connectivity probe target name %q: %v", target.Name, errs)
+		}
+		if len(target.Name) > maxTargetNameLength {
+			return fmt.Errorf("invalid connectivity probe target name %q: must be no more than %d characters", target.Name, maxTargetNameLength)
+		}
+		if names[target.Name] {
+			return fmt.Errorf("duplicate connectivity probe target name %q", target.Name)
+		}
+		names[target.Name] = true
+		if _, _, err := parseAddress(target.Address); err != nil {
+			return fmt.Errorf("invalid address of connectivity probe target %q: %w", target.Name, err)
+		}
+	}
+	return nil
+}
+
+// parseAddress returns the URL of an HTTP or HTTPS address, or the host and port of a TCP address.
+func parseAddress(address string) (*url.URL, string, error) {
+	if u, err := url.Parse(address); err == nil && (u.Scheme == "http" || u.Scheme == "https") {
+		if u.Hostname() == "" {
+			return nil, "", fmt.Errorf("host is empty in URL %q", address)
+		}
+		return u, "", nil
+	}
+	host, port, err := net.SplitHostPort(address)
+	if err != nil {
+		return nil, "", fmt.Errorf("address %q must be an HTTP or HTTPS URL, or of the form host:port: %w", address, err)
+	}
+	if host == "" || port == "" {
+		return nil, "", fmt.Errorf("address %q must be an HTTP or HTTPS URL, or of the form host:port", address)
+	}
+	return nil, address, nil
+}
+
+// result is the outcome of the last probe against a target.
+type result struct {
+	reachable bool
+	latency   time.Duration
+	time      time.Time
+}
+
+// Prober probes a list of targets periodically and keeps the latest results.
+type Prober struct {
+	targets  []Target
+	interval time.Duration
+	timeout  time.Duration
+
+	httpClient *http.Client
+
+	mu      sync.RWMutex
+	results map[string]result
+}
+
+// NewProber returns a prober that probes the given targets at the given interval; each probe
+// fails if it does not complete within the given timeout.
+func NewProber(targets []Target, interval, timeout time.Duration) *Prober {
+	return &Prober{
+		targets:  targets,
+		interval: interval,
+		timeout:  timeout,
+		httpClient: &http.Client{
+			Timeout: timeout,
+			// Do not follow redirects; a redirect response is enough to tell that the target
+			// is reachable.
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		results: make(map[string]result, len(targets)),
+	}
+}
+
+// Start probes the targets periodically until the context is cancelled.
+//
+// It allows the prober to run as a runnable of a controller manager, so that only the leader
+// member agent probes the targets.
+func (p *Prober) Start(ctx context.Context) error {
+	klog.V(2).InfoS("Starting the connectivity prober", "targets", len(p.targets), "interval", p.interval)
+	wait.UntilWithContext(ctx, p.probeAll, p.interval)
+	return nil
+}
+
+// probeAll probes all the targets in parallel and records the results.
+func (p *Prober) probeAll(ctx context.Context) {
+	var wg sync.WaitGroup
+	for idx := range p.targets {
+		target := p.targets[idx]
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			res := p.probe(ctx, target)
+			p.mu.Lock()
+			defer p.mu.Unlock()
+			p.results[target.Name] = res
+		}()
+	}
+	wg.Wait()
+}
+
+// probe probes a single target.
+func (p *Prober) probe(ctx context.Context, target Target) result {
+	childCtx, cancel := context.WithTimeout(ctx, p.timeout)
+	defer cancel()
+
+	start := time.Now()
+	err := p.dial(childCtx, target.Address)
+	res := result{
+		reachable: err == nil,
+		latency:   time.Since(start),
+		time:      time.Now(),
+	}
+	if err != nil {
+		klog.V(2).InfoS("Connectivity probe failed", "target", target.Name, "address", target.Address, "error", err)
+	}
+	return res
+}
+
+// dial connects to the address of a target.
+func (p *Prober) dial(ctx context.Context, address string) error {
+	u, hostPort, err := parseAddress(address)
+	if err != nil {
+		return err
+	}
+	if u == nil {
+		dialer := net.Dialer{}
+		conn, err := dialer.DialContext(ctx, "tcp", hostPort)
+		if err != nil {
+			return err
+		}
+		return conn.Close()
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u.String(), nil)
+	if err != nil {
+		return err
+	}
+	resp, err := p.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	return resp.Body.Close()
+}
+
+// Properties returns the connectivity properties from the latest probe results. Targets that
+// have not been probed yet are not reported.
+func (p *Prober) Properties() map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+	properties := make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue, 2*len(p.results))
+	for name, res := range p.results {
+		observationTime := metav1.NewTime(res.time)
+		reachable := "0"
+		if res.reachable {
+			reachable = "1"
+			properties[clusterv1beta1.PropertyName(fmt.Sprintf(LatencyPropertyTmpl, name))] = clusterv1beta1.PropertyValue{
+				Value:           strconv.FormatInt(res.latency.Milliseconds(), 10),
+				ObservationTime: observationTime,
+			}
+		}
+		properties[clusterv1beta1.PropertyName(fmt.Sprintf(ReachablePropertyTmpl, name))] = clusterv1beta1.PropertyValue{
+			Value:           reachable,
+			ObservationTime: observationTime,
+		}
+	}
+	return properties
+}
@@ -0,0 +1,209 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package connectivity
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+// TestValidateTargets tests the ValidateTargets function.
+func TestValidateTargets(t *testing.T) {
+	testCases := []struct {
+		name             string
+		targets          []Target
+		wantErrMsgSubStr string
+	}{
+		{
+			name: "valid targets",
+			targets: []Target{
+				{Name: "hub", Address: "https://hub.example.com"},
+				{Name: "registry", Address: "registry.example.com:443"},
+				{Name: "db", Address: "[2001:db8::1]:5432"},
+			},
+		},
+		{
+			name:             "invalid name",
+			targets:          []Target{{Name: "Hub_Server", Address: "hub.example.com:443"}},
+			wantErrMsgSubStr: "invalid connectivity probe target name",
+		},
+		{
+			name:             "name too long",
+			targets:          []Target{{Name: strings.Repeat("a", 51), Address: "hub.example.com:443"}},
+			wantErrMsgSubStr: "must be no more than 50 characters",
+		},
+		{
+			name: "duplicate names",
+			targets: []Target{
+				{Name: "hub", Address: "hub.example.com:443"},
+				{Name: "hub", Address: "https://hub.example.com"},
+			},
+			wantErrMsgSubStr: "duplicate connectivity probe target name",
+		},
+		{
+			name:             "address without a port",
+			targets:          []Target{{Name: "hub", Address: "hub.example.com"}},
+			wantErrMsgSubStr: "invalid address of connectivity probe target",
+		},
+		{
+			name:             "URL without a host",
+			targets:          []Target{{Name: "hub", Address: "https://"}},
+			wantErrMsgSubStr: "host is empty",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateTargets(tc.targets)
+			if tc.wantErrMsgSubStr == "" {
+				if err != nil {
+					t.Fatalf("ValidateTargets() = %v, want no error", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+				t.Fatalf("ValidateTargets() = %v, want error with sub-string %q", err, tc.wantErrMsgSubStr)
+			}
+		})
+	}
+}
+
+// TestLoadTargetsFromFile tests the LoadTargetsFromFile function.
+func TestLoadTargetsFromFile(t *testing.T) {
+	testCases := []struct {
+		name             string
+		content          string
+		wantTargets      []Target
+		wantErrMsgSubStr string
+	}{
+		{
+			name: "valid file",
+			content: `targets:
+- name: hub
+  address: https://hub.example.com
+- name: registry
+  address: registry.example.com:443
+`,
+			wantTargets: []Target{
+				{Name: "hub", Address: "https://hub.example.com"},
+				{Name: "registry", Address: "registry.example.com:443"},
+			},
+		},
+		{
+			name:             "malformed file",
+			content:          "targets: [",
+			wantErrMsgSubStr: "failed to parse the connectivity probe targets file",
+		},
+		{
+			name: "invalid target",
+			content: `targets:
+- name: hub
+  address: hub.example.com
+`,
+			wantErrMsgSubStr: "invalid address of connectivity probe target",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "targets.yaml")
+			if err := os.WriteFile(path, []byte(tc.content), 0600); err != nil {
+				t.Fatalf("failed to write the targets file: %v", err)
+			}
+			targets, err := LoadTargetsFromFile(path)
+			if tc.wantErrMsgSubStr != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrMsgSubStr) {
+					t.Fatalf("LoadTargetsFromFile() = %v, want error with sub-string %q", err, tc.wantErrMsgSubStr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("LoadTargetsFromFile() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(tc.wantTargets, targets); diff != "" {
+				t.Errorf("LoadTargetsFromFile() targets mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestProbeAll tests the probeAll and Properties methods.
+func TestProbeAll(t *testing.T) {
+	// An HTTP server that always responds with an error, which still counts as reachable.
+	httpServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer httpServer.Close()
+
+	// A TCP server that accepts connections.
+	listener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	defer listener.Close()
+
+	// A TCP address that refuses connections.
+	closedListener, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatalf("failed to listen: %v", err)
+	}
+	closedAddress := closedListener.Addr().String()
+	closedListener.Close()
+
+	prober := NewProber([]Target{
+		{Name: "web", Address: httpServer.URL},
+		{Name: "tcp", Address: listener.Addr().String()},
+		{Name: "closed", Address: closedAddress},
+	}, time.Minute, 5*time.Second)
+	if got := prober.Properties(); len(got) != 0 {
+		t.Fatalf("Properties() before probing = %v, want none", got)
+	}
+
+	prober.probeAll(context.Background())
+	properties := prober.Properties()
+
+	wantReachable := map[string]string{
+		"web":    "1",
+		"tcp":    "1",
+		"closed": "0",
+	}
+	for name, want := range wantReachable {
+		reachable, found := properties[clusterv1beta1.PropertyName(fmt.Sprintf(ReachablePropertyTmpl, name))]
+		if !found || reachable.Value != want {
+			t.Errorf("reachable property of target %s = %v (found: %t), want %s", name, reachable.Value, found, want)
+		}
+		_, hasLatency := properties[clusterv1beta1.PropertyName(fmt.Sprintf(LatencyPropertyTmpl, name))]
+		if wantLatency := want == "1"; hasLatency != wantLatency {
+			t.Errorf("latency property of target %s found = %t, want %t", name, hasLatency, wantLatency)
+		}
+	}
+	if len(properties) != 5 {
+		t.Errorf("Properties() returned %d properties, want 5", len(properties))
+	}
+}
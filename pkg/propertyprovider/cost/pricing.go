@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"fmt"
+	"os"
+	"time"
+
+	"sigs.k8s.io/yaml"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/azure/trackers"
+)
+
+var _ trackers.PricingProvider = &StaticPricingProvider{}
+
+// StaticPricingProvider is a pricing provider that looks up the on-demand hourly prices of node SKUs
+// (instance types) from a static table.
+type StaticPricingProvider struct {
+	prices map[string]float64
+}
+
+// NewStaticPricingProvider returns a static pricing provider with the given on-demand hourly prices,
+// keyed by the node SKUs.
+func NewStaticPricingProvider(prices map[string]float64) (*StaticPricingProvider, error) {
+	for sku, price := range prices {
+		if price < 0 {
+			return nil, fmt.Errorf("the price of SKU %s must not be negative, got %v", sku, price)
+		}
+	}
+	return &StaticPricingProvider{prices: prices}, nil
+}
+
+// NewStaticPricingProviderFromFile returns a static pricing provider with the on-demand hourly prices
+// read from the given file, which is a YAML or JSON object that maps the node SKUs to their prices, e.g.,
+//
+//	Standard_D4s_v3: 0.192
+//	m5.xlarge: 0.192
+func NewStaticPricingProviderFromFile(path string) (*StaticPricingProvider, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the pricing file: %w", err)
+	}
+	prices := make(map[string]float64)
+	if err := yaml.Unmarshal(data, &prices); err != nil {
+		return nil, fmt.Errorf("failed to parse the pricing file: %w", err)
+	}
+	return NewStaticPricingProvider(prices)
+}
+
+// OnDemandPrice returns the on-demand hourly price of a node SKU.
+func (s *StaticPricingProvider) OnDemandPrice(sku string) (float64, bool) {
+	price, found := s.prices[sku]
+	return price, found
+}
+
+// LastUpdated returns the current time, as the static pricing data never goes stale.
+func (s *StaticPricingProvider) LastUpdated() time.Time {
+	return time.Now()
+}
@@ -0,0 +1,91 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestNewStaticPricingProviderFromFile(t *testing.T) {
+	testCases := []struct {
+		name       string
+		content    string
+		wantPrices map[string]float64
+		wantErr    bool
+	}{
+		{
+			name:    "YAML pricing table",
+			content: "Standard_D4s_v3: 0.192\nm5.xlarge: 0.192\nn2-standard-4: 0.194\n",
+			wantPrices: map[string]float64{
+				nodeSKU1: 0.192,
+				nodeSKU2: 0.192,
+				nodeSKU3: 0.194,
+			},
+		},
+		{
+			name:    "JSON pricing table",
+			content: `{"Standard_D4s_v3": 0.192}`,
+			wantPrices: map[string]float64{
+				nodeSKU1: 0.192,
+			},
+		},
+		{
+			name:    "negative price",
+			content: "Standard_D4s_v3: -1\n",
+			wantErr: true,
+		},
+		{
+			name:    "malformed pricing table",
+			content: "- Standard_D4s_v3\n",
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			path := filepath.Join(t.TempDir(), "prices.yaml")
+			if err := os.WriteFile(path, []byte(tc.content), 0600); err != nil {
+				t.Fatalf("failed to write the pricing file: %v", err)
+			}
+
+			pp, err := NewStaticPricingProviderFromFile(path)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("NewStaticPricingProviderFromFile() = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewStaticPricingProviderFromFile() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(pp.prices, tc.wantPrices); diff != "" {
+				t.Errorf("prices mismatch (-got, +want):\n%s", diff)
+			}
+			if _, found := pp.OnDemandPrice("unknown"); found {
+				t.Errorf("OnDemandPrice(unknown) found a price, want none")
+			}
+		})
+	}
+
+	if _, err := NewStaticPricingProviderFromFile(filepath.Join(t.TempDir(), "absent.yaml")); err == nil {
+		t.Errorf("NewStaticPricingProviderFromFile() = nil, want error for an absent file")
+	}
+}
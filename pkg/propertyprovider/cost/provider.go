@@ -0,0 +1,193 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package cost features a sample property provider that reports the costs of a Kubernetes cluster
+// based on the on-demand prices of its node SKUs (instance types), with the prices retrieved from
+// either a static pricing table or a cloud pricing API.
+package cost
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/azure"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/azure/trackers"
+)
+
+const (
+	// A list of properties that the cost property provider collects in addition to the
+	// Fleet required ones.
+
+	// HourlyCostProperty is a property that describes the total on-demand hourly cost of all the
+	// nodes in a Kubernetes cluster.
+	HourlyCostProperty = "cost.kubernetes-fleet.io/hourly-cost"
+	// PerCPUCoreCostProperty is a property that describes the average hourly cost of a CPU core in
+	// a Kubernetes cluster.
+	PerCPUCoreCostProperty = "cost.kubernetes-fleet.io/per-cpu-core-cost"
+	// PerGBMemoryCostProperty is a property that describes the average hourly cost of one GB of
+	// memory in a Kubernetes cluster.
+	PerGBMemoryCostProperty = "cost.kubernetes-fleet.io/per-gb-memory-cost"
+)
+
+const (
+	// The condition related values in use by the cost property provider.
+	CostPropertiesCollectionSucceededCondType = "CostPropertiesCollectionSucceeded"
+)
+
+const (
+	// The names of the controllers managed by the cost property provider.
+	nodeControllerName = "cost-property-provider-node-watcher"
+	podControllerName  = "cost-property-provider-pod-watcher"
+)
+
+// PropertyProvider is the cost property provider for Fleet.
+//
+// The provider tracks nodes and pods in the same way as the Azure property provider does (with a
+// pluggable pricing provider), and reports the cost properties under cloud-agnostic names.
+type PropertyProvider struct {
+	// The pricing provider that looks up the on-demand hourly price of each node SKU.
+	pricingProvider trackers.PricingProvider
+	// The region to look up prices for when the Azure Retail Prices API is used as the
+	// pricing source.
+	region string
+
+	// The underlying property provider that tracks nodes and pods and calculates the costs.
+	underlying propertyprovider.PropertyProvider
+}
+
+// Verify that the cost property provider implements the PropertyProvider interface at compile time.
+var _ propertyprovider.PropertyProvider = &PropertyProvider{}
+
+// Start starts the cost property provider.
+func (p *PropertyProvider) Start(ctx context.Context, config *rest.Config) error {
+	klog.V(2).Info("Starting cost property provider")
+
+	if p.pricingProvider == nil {
+		// No pricing provider has been set; use the Azure Retail Prices API (via the AKS
+		// Karpenter pricing client) of the specified region.
+		klog.V(2).InfoS("Building the AKS Karpenter pricing client", "region", p.region)
+		p.pricingProvider = trackers.NewAKSKarpenterPricingClient(ctx, p.region)
+	}
+	p.underlying = azure.NewWithPricingProvider(p.pricingProvider, nodeControllerName, podControllerName, true, true)
+	return p.underlying.Start(ctx, config)
+}
+
+// Collect collects the properties of a Kubernetes cluster.
+func (p *PropertyProvider) Collect(ctx context.Context) propertyprovider.PropertyCollectionResponse {
+	res := p.underlying.Collect(ctx)
+
+	properties := make(map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue, len(res.Properties))
+	nodeCountPerSKU := make(map[string]int)
+	for name, val := range res.Properties {
+		if name == azure.PerCPUCoreCostProperty {
+			properties[PerCPUCoreCostProperty] = val
+			continue
+		}
+		if name == azure.PerGBMemoryCostProperty {
+			properties[PerGBMemoryCostProperty] = val
+			continue
+		}
+		sku, isNodeCountPerSKU := skuFromNodeCountPerSKUProperty(name)
+		if !isNodeCountPerSKU {
+			properties[name] = val
+			continue
+		}
+		// The per-SKU node counts are specific to Azure; they are used only for calculating
+		// the total hourly cost and are not reported.
+		count, err := strconv.Atoi(val.Value)
+		if err != nil {
+			// Normally this should never occur.
+			klog.ErrorS(err, "Failed to parse the node count of a SKU", "sku", sku, "count", val.Value)
+			continue
+		}
+		nodeCountPerSKU[sku] = count
+	}
+
+	conds := make([]metav1.Condition, 0, len(res.Conditions))
+	for idx := range res.Conditions {
+		cond := res.Conditions[idx]
+		if cond.Type != azure.CostPropertiesCollectionSucceededCondType {
+			conds = append(conds, cond)
+			continue
+		}
+		cond.Type = CostPropertiesCollectionSucceededCondType
+		conds = append(conds, cond)
+
+		// Report the total hourly cost only if the costs have been calculated; the per CPU core
+		// cost property is present if and only if the calculation has succeeded (possibly in a
+		// degraded mode).
+		if perCPUCost, found := properties[PerCPUCoreCostProperty]; found {
+			properties[HourlyCostProperty] = clusterv1beta1.PropertyValue{
+				Value:           fmt.Sprintf(azure.CostPrecisionTemplate, p.hourlyCost(nodeCountPerSKU)),
+				ObservationTime: perCPUCost.ObservationTime,
+			}
+		}
+	}
+
+	return propertyprovider.PropertyCollectionResponse{
+		Properties: properties,
+		Resources:  res.Resources,
+		Namespaces: res.Namespaces,
+		Conditions: conds,
+	}
+}
+
+// hourlyCost returns the total on-demand hourly cost of the given nodes.
+func (p *PropertyProvider) hourlyCost(nodeCountPerSKU map[string]int) float64 {
+	total := 0.0
+	for sku, count := range nodeCountPerSKU {
+		// SKUs with no pricing data would have failed the cost calculation, so that the total
+		// hourly cost would not be reported; they are skipped here for completeness reasons.
+		if price, found := p.pricingProvider.OnDemandPrice(sku); found && price > 0 {
+			total += price * float64(count)
+		}
+	}
+	return total
+}
+
+// skuFromNodeCountPerSKUProperty returns the SKU of a per-SKU node count property reported by
+// the Azure property provider; it returns false if the property is not such a property.
+func skuFromNodeCountPerSKUProperty(name clusterv1beta1.PropertyName) (string, bool) {
+	prefix, suffix, _ := strings.Cut(azure.NodeCountPerSKUPropertyTmpl, "%s")
+	if !strings.HasPrefix(string(name), prefix) || !strings.HasSuffix(string(name), suffix) {
+		return "", false
+	}
+	return strings.TrimSuffix(strings.TrimPrefix(string(name), prefix), suffix), true
+}
+
+// New returns a new cost property provider that uses the given pricing provider, e.g., a
+// static pricing provider.
+func New(pp trackers.PricingProvider) propertyprovider.PropertyProvider {
+	return &PropertyProvider{
+		pricingProvider: pp,
+	}
+}
+
+// NewWithAzureRetailPricing returns a new cost property provider that retrieves the prices
+// from the Azure Retail Prices API of the given region.
+func NewWithAzureRetailPricing(region string) propertyprovider.PropertyProvider {
+	return &PropertyProvider{
+		region: region,
+	}
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cost
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider/azure"
+)
+
+const (
+	nodeSKU1 = "Standard_D4s_v3"
+	nodeSKU2 = "m5.xlarge"
+	nodeSKU3 = "n2-standard-4"
+)
+
+// dummyPropertyProvider is a mock implementation that returns a fixed property collection response.
+type dummyPropertyProvider struct {
+	res propertyprovider.PropertyCollectionResponse
+}
+
+var _ propertyprovider.PropertyProvider = &dummyPropertyProvider{}
+
+func (d *dummyPropertyProvider) Collect(_ context.Context) propertyprovider.PropertyCollectionResponse {
+	return d.res
+}
+
+func (d *dummyPropertyProvider) Start(_ context.Context, _ *rest.Config) error {
+	return nil
+}
+
+func TestCollect(t *testing.T) {
+	now := metav1.Now()
+	resources := clusterv1beta1.ResourceUsage{
+		Capacity: corev1.ResourceList{
+			corev1.ResourceCPU:    resource.MustParse("12"),
+			corev1.ResourceMemory: resource.MustParse("48Gi"),
+		},
+	}
+	pp, err := NewStaticPricingProvider(map[string]float64{
+		nodeSKU1: 0.192,
+		nodeSKU2: 0.192,
+	})
+	if err != nil {
+		t.Fatalf("NewStaticPricingProvider() = %v, want no error", err)
+	}
+
+	testCases := []struct {
+		name string
+		res  propertyprovider.PropertyCollectionResponse
+		want propertyprovider.PropertyCollectionResponse
+	}{
+		{
+			name: "costs calculated",
+			res: propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					propertyprovider.NodeCountProperty:                     {Value: "3", ObservationTime: now},
+					"kubernetes.azure.com/vm-sizes/" + nodeSKU1 + "/count": {Value: "2", ObservationTime: now},
+					"kubernetes.azure.com/vm-sizes/" + nodeSKU2 + "/count": {Value: "1", ObservationTime: now},
+					azure.PerCPUCoreCostProperty:                           {Value: "0.048", ObservationTime: now},
+					azure.PerGBMemoryCostProperty:                          {Value: "0.012", ObservationTime: now},
+				},
+				Resources: resources,
+				Conditions: []metav1.Condition{
+					{
+						Type:    azure.CostPropertiesCollectionSucceededCondType,
+						Status:  metav1.ConditionTrue,
+						Reason:  azure.CostPropertiesCollectionSucceededReason,
+						Message: azure.CostPropertiesCollectionSucceededMsg,
+					},
+				},
+			},
+			want: propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					propertyprovider.NodeCountProperty: {Value: "3", ObservationTime: now},
+					HourlyCostProperty:                 {Value: "0.576", ObservationTime: now},
+					PerCPUCoreCostProperty:             {Value: "0.048", ObservationTime: now},
+					PerGBMemoryCostProperty:            {Value: "0.012", ObservationTime: now},
+				},
+				Resources: resources,
+				Conditions: []metav1.Condition{
+					{
+						Type:    CostPropertiesCollectionSucceededCondType,
+						Status:  metav1.ConditionTrue,
+						Reason:  azure.CostPropertiesCollectionSucceededReason,
+						Message: azure.CostPropertiesCollectionSucceededMsg,
+					},
+				},
+			},
+		},
+		{
+			name: "costs calculation failed",
+			res: propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					propertyprovider.NodeCountProperty:                     {Value: "1", ObservationTime: now},
+					"kubernetes.azure.com/vm-sizes/" + nodeSKU3 + "/count": {Value: "1", ObservationTime: now},
+				},
+				Resources: resources,
+				Conditions: []metav1.Condition{
+					{
+						Type:    azure.CostPropertiesCollectionSucceededCondType,
+						Status:  metav1.ConditionFalse,
+						Reason:  azure.CostPropertiesCollectionFailedReason,
+						Message: "An error has occurred when collecting cost properties: no pricing data",
+					},
+				},
+			},
+			want: propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					propertyprovider.NodeCountProperty: {Value: "1", ObservationTime: now},
+				},
+				Resources: resources,
+				Conditions: []metav1.Condition{
+					{
+						Type:    CostPropertiesCollectionSucceededCondType,
+						Status:  metav1.ConditionFalse,
+						Reason:  azure.CostPropertiesCollectionFailedReason,
+						Message: "An error has occurred when collecting cost properties: no pricing data",
+					},
+				},
+			},
+		},
+		{
+			name: "no nodes",
+			res: propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					propertyprovider.NodeCountProperty: {Value: "0", ObservationTime: now},
+					azure.PerCPUCoreCostProperty:       {Value: "0.000", ObservationTime: now},
+					azure.PerGBMemoryCostProperty:      {Value: "0.000", ObservationTime: now},
+				},
+				Conditions: []metav1.Condition{
+					{
+						Type:    azure.CostPropertiesCollectionSucceededCondType,
+						Status:  metav1.ConditionTrue,
+						Reason:  azure.CostPropertiesCollectionSucceededReason,
+						Message: azure.CostPropertiesCollectionSucceededMsg,
+					},
+				},
+			},
+			want: propertyprovider.PropertyCollectionResponse{
+				Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+					propertyprovider.NodeCountProperty: {Value: "0", ObservationTime: now},
+					HourlyCostProperty:                 {Value: "0.000", ObservationTime: now},
+					PerCPUCoreCostProperty:             {Value: "0.000", ObservationTime: now},
+					PerGBMemoryCostProperty:            {Value: "0.000", ObservationTime: now},
+				},
+				Conditions: []metav1.Condition{
+					{
+						Type:    CostPropertiesCollectionSucceededCondType,
+						Status:  metav1.ConditionTrue,
+						Reason:  azure.CostPropertiesCollectionSucceededReason,
+						Message: azure.CostPropertiesCollectionSucceededMsg,
+					},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := &PropertyProvider{
+				pricingProvider: pp,
+				underlying:      &dummyPropertyProvider{res: tc.res},
+			}
+			got := p.Collect(context.Background())
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("Collect() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSKUFromNodeCountPerSKUProperty(t *testing.T) {
+	testCases := []struct {
+		name      string
+		property  clusterv1beta1.PropertyName
+		wantSKU   string
+		wantFound bool
+	}{
+		{
+			name:      "per-SKU node count property",
+			property:  "kubernetes.azure.com/vm-sizes/Standard_D4s_v3/count",
+			wantSKU:   nodeSKU1,
+			wantFound: true,
+		},
+		{
+			name:      "per-SKU node count property with an empty SKU",
+			property:  "kubernetes.azure.com/vm-sizes//count",
+			wantSKU:   "",
+			wantFound: true,
+		},
+		{
+			name:     "other property",
+			property: propertyprovider.NodeCountProperty,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			sku, found := skuFromNodeCountPerSKUProperty(tc.property)
+			if sku != tc.wantSKU || found != tc.wantFound {
+				t.Errorf("skuFromNodeCountPerSKUProperty() = (%q, %t), want (%q, %t)", sku, found, tc.wantSKU, tc.wantFound)
+			}
+		})
+	}
+}
@@ -20,10 +20,16 @@ import (
 	"fmt"
 
 	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 )
 
 // handleTombStoneObj handles the case that the delete object is a tombStone instead of the real object
@@ -83,6 +89,10 @@ func (d *ChangeDetector) onResourceUpdated(oldObj, newObj interface{}) {
 		klog.V(3).InfoS("A resource is updated", "obj", oldObjMeta.GetName(),
 			"namespace", oldObjMeta.GetNamespace(), "gvk", runtimeObject.GetObjectKind().GroupVersionKind().String())
 		d.ResourceChangeController.Enqueue(newObj)
+		if runtimeObject.GetObjectKind().GroupVersionKind() == utils.NamespaceGVK &&
+			!labels.Equals(oldObjMeta.GetLabels(), newObjMeta.GetLabels()) {
+			d.onNamespaceLabelsUpdated(oldObjMeta.GetName(), oldObjMeta.GetLabels())
+		}
 		return
 	}
 	klog.V(4).InfoS("Received a resource updated event with no change", "obj", oldObjMeta.GetName(),
@@ -99,3 +109,57 @@ func (d *ChangeDetector) onResourceDeleted(obj interface{}) {
 	klog.V(3).InfoS("A resource is deleted", "obj", klog.KObj(clientObj), "gvk", clientObj.GetObjectKind().GroupVersionKind().String())
 	d.ResourceChangeController.Enqueue(clientObj)
 }
+
+// onNamespaceLabelsUpdated enqueues the cluster resource placements which select namespaces by labels that
+// match the old labels of an updated namespace.
+//
+// The resource change controller finds the placements to re-select resources with the current labels of the
+// namespace and the selected resources reported in the placement status; the latter might not include the
+// namespace yet if the placement has not finished reporting its status, in which case a namespace that
+// no longer matches would otherwise stay selected until the next resync.
+func (d *ChangeDetector) onNamespaceLabelsUpdated(namespace string, oldLabels map[string]string) {
+	if d.ClusterResourcePlacementControllerV1Beta1 == nil {
+		return
+	}
+	crpList, err := d.InformerManager.Lister(utils.ClusterResourcePlacementGVR).List(labels.Everything())
+	if err != nil {
+		klog.ErrorS(err, "Failed to list cluster resource placements for the namespace label change", "namespace", namespace)
+		return
+	}
+	for _, obj := range crpList {
+		uObj, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			continue
+		}
+		var crp placementv1beta1.ClusterResourcePlacement
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(uObj.Object, &crp); err != nil {
+			klog.ErrorS(err, "Failed to convert the cluster resource placement", "clusterResourcePlacement", uObj.GetName())
+			continue
+		}
+		if selectsNamespaceByLabels(crp.Spec.ResourceSelectors, oldLabels) {
+			klog.V(2).InfoS("Namespace label change triggered cluster resource placement reconcile",
+				"namespace", namespace, "clusterResourcePlacement", crp.Name)
+			d.ClusterResourcePlacementControllerV1Beta1.Enqueue(crp.Name)
+		}
+	}
+}
+
+// selectsNamespaceByLabels returns if any of the resource selectors selects namespaces by a label selector
+// that matches the given labels.
+func selectsNamespaceByLabels(selectors []placementv1beta1.ResourceSelectorTerm, nsLabels map[string]string) bool {
+	for _, selector := range selectors {
+		if selector.Group != utils.NamespaceGVK.Group || selector.Version != utils.NamespaceGVK.Version ||
+			selector.Kind != utils.NamespaceGVK.Kind || selector.Name != "" || selector.LabelSelector == nil {
+			continue
+		}
+		labelSelector, err := metav1.LabelSelectorAsSelector(selector.LabelSelector)
+		if err != nil {
+			// The selector has been validated by the webhook; skip the invalid ones just in case.
+			continue
+		}
+		if labelSelector.Matches(labels.Set(nsLabels)) {
+			return true
+		}
+	}
+	return false
+}
@@ -21,14 +21,20 @@ import (
 	"reflect"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	testinformer "github.com/kubefleet-dev/kubefleet/test/utils/informer"
 )
 
 func TestHandleTombStoneObj(t *testing.T) {
@@ -123,16 +129,109 @@ func TestHandleTombStoneObj(t *testing.T) {
 
 var _ controller.Controller = &fakeController{}
 
-// fakeController just record if there is an enqueue request or not
+// fakeController just record if there is an enqueue request or not, and the enqueued objects.
 type fakeController struct {
 	Enqueued bool
+	Objects  []interface{}
 }
 
-func (t *fakeController) Enqueue(_ interface{}) {
+func (t *fakeController) Enqueue(obj interface{}) {
 	t.Enqueued = true
+	t.Objects = append(t.Objects, obj)
 }
 
 // Run is a no-op; the fake is only used to verify that Enqueue is called.
 func (t *fakeController) Run(_ context.Context, _ int) error {
 	return nil
 }
+
+func TestOnResourceUpdated_NamespaceLabels(t *testing.T) {
+	namespaceSelectorCRP := func(name string, selector fleetv1beta1.ResourceSelectorTerm) runtime.Object {
+		crp := &fleetv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: fleetv1beta1.PlacementSpec{
+				ResourceSelectors: []fleetv1beta1.ResourceSelectorTerm{selector},
+			},
+		}
+		uMap, _ := runtime.DefaultUnstructuredConverter.ToUnstructured(crp)
+		return &unstructured.Unstructured{Object: uMap}
+	}
+	namespace := func(resourceVersion string, nsLabels map[string]string) *unstructured.Unstructured {
+		ns := &unstructured.Unstructured{}
+		ns.SetGroupVersionKind(utils.NamespaceGVK)
+		ns.SetName("app")
+		ns.SetResourceVersion(resourceVersion)
+		ns.SetLabels(nsLabels)
+		return ns
+	}
+	crps := []runtime.Object{
+		namespaceSelectorCRP("team-a", fleetv1beta1.ResourceSelectorTerm{
+			Version:       "v1",
+			Kind:          "Namespace",
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+		}),
+		namespaceSelectorCRP("team-b", fleetv1beta1.ResourceSelectorTerm{
+			Version:       "v1",
+			Kind:          "Namespace",
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "b"}},
+		}),
+		namespaceSelectorCRP("by-name", fleetv1beta1.ResourceSelectorTerm{
+			Version: "v1",
+			Kind:    "Namespace",
+			Name:    "app",
+		}),
+		namespaceSelectorCRP("configmaps", fleetv1beta1.ResourceSelectorTerm{
+			Version:       "v1",
+			Kind:          "ConfigMap",
+			LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}},
+		}),
+	}
+
+	tests := []struct {
+		name         string
+		oldObj       *unstructured.Unstructured
+		newObj       *unstructured.Unstructured
+		wantEnqueued []interface{}
+	}{
+		{
+			name:         "labels removed",
+			oldObj:       namespace("1", map[string]string{"team": "a"}),
+			newObj:       namespace("2", nil),
+			wantEnqueued: []interface{}{"team-a"},
+		},
+		{
+			name:         "labels changed",
+			oldObj:       namespace("1", map[string]string{"team": "a"}),
+			newObj:       namespace("2", map[string]string{"team": "b"}),
+			wantEnqueued: []interface{}{"team-a"},
+		},
+		{
+			name:   "labels unchanged",
+			oldObj: namespace("1", map[string]string{"team": "a"}),
+			newObj: namespace("2", map[string]string{"team": "a"}),
+		},
+		{
+			name:   "no change",
+			oldObj: namespace("1", map[string]string{"team": "a"}),
+			newObj: namespace("1", nil),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			placementController := &fakeController{}
+			d := &ChangeDetector{
+				ClusterResourcePlacementControllerV1Beta1: placementController,
+				ResourceChangeController:                  &fakeController{},
+				InformerManager: &testinformer.FakeManager{
+					Listers: map[schema.GroupVersionResource]*testinformer.FakeLister{
+						utils.ClusterResourcePlacementGVR: {Objects: crps},
+					},
+				},
+			}
+			d.onResourceUpdated(tc.oldObj, tc.newObj)
+			if diff := cmp.Diff(tc.wantEnqueued, placementController.Objects); diff != "" {
+				t.Errorf("onResourceUpdated() enqueued placements mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
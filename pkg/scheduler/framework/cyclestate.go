@@ -44,6 +44,7 @@ type CycleStatePluginReadWriter interface {
 	ListClusters() []clusterv1beta1.MemberCluster
 	HasScheduledOrBoundBindingFor(clusterName string) bool
 	HasObsoleteBindingFor(clusterName string) bool
+	BindingCountFor(clusterName string) int
 }
 
 // CycleState is, similar to its namesake in kube-scheduler, provides a way for plugins to
@@ -68,11 +69,19 @@ type CycleState struct {
 	// cycle associated with the cluster.
 	obsoleteBindings map[string]bool
 
+	// bindingCounts is a map that helps look up the number of active bindings (from all placements
+	// other than the one being scheduled) associated with a cluster.
+	bindingCounts map[string]int
+
 	// skippedFilterPlugins is a set of Filter plugins that should be skipped in the current scheduling cycle.
 	skippedFilterPlugins sets.Set[string]
 
 	// skippedScorePlugins is a set of Score plugins that should be skipped in the current scheduling cycle.
 	skippedScorePlugins sets.Set[string]
+	// scoringSettings is the scoring settings set by the scheduling profile of the placement (if any).
+	//
+	// This is set when scheduling policies of the PickN placement type.
+	scoringSettings *scoringSettings
 	// desiredBatchSize is the desired batch size for the current scheduling cycle.
 	//
 	// This is set when scheduling policies of the PickN placement type.
@@ -82,6 +91,16 @@ type CycleState struct {
 	//
 	// This is set when scheduling policies of the PickN placement type.
 	batchSizeLimit int
+
+	// filtered is the list of clusters filtered out in the Filter stage of the current scheduling cycle.
+	//
+	// This is kept for the purpose of snapshotting the scheduling cycle.
+	filtered filteredClusterWithStatusList
+	// scored is the list of clusters that have passed the Filter stage of the current scheduling cycle,
+	// along with their scores.
+	//
+	// This is kept for the purpose of snapshotting the scheduling cycle.
+	scored ScoredClusters
 }
 
 // Read retrieves a value from CycleState by a key.
@@ -137,6 +156,15 @@ func (c *CycleState) HasObsoleteBindingFor(clusterName string) bool {
 	return c.obsoleteBindings[clusterName]
 }
 
+// BindingCountFor returns the number of active bindings, i.e., bindings that are scheduled or bound
+// and not being deleted, associated with a cluster across all placements in the fleet, excluding
+// the placement being scheduled in the current cycle.
+//
+// This helps plugins learn about the overall load of a cluster without listing bindings on their own.
+func (c *CycleState) BindingCountFor(clusterName string) int {
+	return c.bindingCounts[clusterName]
+}
+
 // IsClusterObsolete
 
 // NewCycleState creates a CycleState.
@@ -20,7 +20,10 @@ import (
 	"testing"
 
 	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
@@ -167,3 +170,434 @@ func TestPrepareObsoleteBindingsMap(t *testing.T) {
 		t.Errorf("prepareObsoleteBindingsMap() obsoleteBindingsMap diff (-got, +want): %s", diff)
 	}
 }
+
+// TestPrepareBindingCountsMap tests the prepareBindingCountsMap function.
+func TestPrepareBindingCountsMap(t *testing.T) {
+	now := metav1.Now()
+	crbs := []*placementv1beta1.ClusterResourceBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: bindingName,
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "other-placement",
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: clusterName,
+				State:         placementv1beta1.BindingStateBound,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: altBindingName,
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "yet-another-placement",
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: clusterName,
+				State:         placementv1beta1.BindingStateScheduled,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: anotherBindingName,
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: crpName,
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: altClusterName,
+				State:         placementv1beta1.BindingStateBound,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "unscheduled-binding",
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "other-placement",
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: altClusterName,
+				State:         placementv1beta1.BindingStateUnscheduled,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "deleting-binding",
+				DeletionTimestamp: &now,
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "other-placement",
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: anotherClusterName,
+				State:         placementv1beta1.BindingStateBound,
+			},
+		},
+	}
+	rbs := []*placementv1beta1.ResourceBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      bindingName,
+				Namespace: "work",
+				Labels: map[string]string{
+					// A namespaced placement that happens to share the same name with the
+					// placement being scheduled.
+					placementv1beta1.PlacementTrackingLabel: crpName,
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: altClusterName,
+				State:         placementv1beta1.BindingStateBound,
+			},
+		},
+	}
+
+	want := map[string]int{
+		clusterName:    2,
+		altClusterName: 1,
+	}
+
+	got := prepareBindingCountsMap(types.NamespacedName{Name: crpName}, controller.ConvertCRBArrayToBindingObjs(crbs), controller.ConvertRBArrayToBindingObjs(rbs))
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("prepareBindingCountsMap() diff (-got, +want): %s", diff)
+	}
+}
+
+// TestPrepareReservedResourcesMap tests the prepareReservedResourcesMap function.
+func TestPrepareReservedResourcesMap(t *testing.T) {
+	now := metav1.Now()
+	requests := `{"cpu":"2","memory":"4Gi"}`
+	crbs := []*placementv1beta1.ClusterResourceBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: bindingName,
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "other-placement",
+				},
+				Annotations: map[string]string{
+					placementv1beta1.EstimatedResourceRequestsAnnotation: requests,
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: clusterName,
+				State:         placementv1beta1.BindingStateScheduled,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: altBindingName,
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "yet-another-placement",
+				},
+				Annotations: map[string]string{
+					placementv1beta1.EstimatedResourceRequestsAnnotation: `{"cpu":"500m"}`,
+				},
+				Generation: 2,
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: clusterName,
+				State:         placementv1beta1.BindingStateBound,
+			},
+			Status: placementv1beta1.ResourceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						// The applied condition is stale, as a new resource snapshot is being rolled out;
+						// the resources have been applied nonetheless.
+						Type:               string(placementv1beta1.ResourceBindingApplied),
+						Status:             metav1.ConditionTrue,
+						ObservedGeneration: 1,
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "applied-binding",
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "other-placement",
+				},
+				Annotations: map[string]string{
+					placementv1beta1.EstimatedResourceRequestsAnnotation: requests,
+				},
+				Generation: 1,
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: altClusterName,
+				State:         placementv1beta1.BindingStateBound,
+			},
+			Status: placementv1beta1.ResourceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(placementv1beta1.ResourceBindingApplied),
+						Status:             metav1.ConditionTrue,
+						ObservedGeneration: 1,
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: anotherBindingName,
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: crpName,
+				},
+				Annotations: map[string]string{
+					placementv1beta1.EstimatedResourceRequestsAnnotation: requests,
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: altClusterName,
+				State:         placementv1beta1.BindingStateScheduled,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "no-estimation-binding",
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "other-placement",
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: altClusterName,
+				State:         placementv1beta1.BindingStateScheduled,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "unscheduled-binding",
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "other-placement",
+				},
+				Annotations: map[string]string{
+					placementv1beta1.EstimatedResourceRequestsAnnotation: requests,
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: altClusterName,
+				State:         placementv1beta1.BindingStateUnscheduled,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              "deleting-binding",
+				DeletionTimestamp: &now,
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "other-placement",
+				},
+				Annotations: map[string]string{
+					placementv1beta1.EstimatedResourceRequestsAnnotation: requests,
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: anotherClusterName,
+				State:         placementv1beta1.BindingStateScheduled,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "replaced-binding",
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "rescheduled-placement",
+				},
+				Annotations: map[string]string{
+					placementv1beta1.EstimatedResourceRequestsAnnotation: requests,
+				},
+				Generation: 1,
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: anotherClusterName,
+				State:         placementv1beta1.BindingStateUnscheduled,
+			},
+			Status: placementv1beta1.ResourceBindingStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(placementv1beta1.ResourceBindingApplied),
+						Status:             metav1.ConditionTrue,
+						ObservedGeneration: 1,
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "replacing-binding",
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "rescheduled-placement",
+				},
+				Annotations: map[string]string{
+					placementv1beta1.EstimatedResourceRequestsAnnotation: requests,
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: anotherClusterName,
+				State:         placementv1beta1.BindingStateScheduled,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "duplicated-binding-1",
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "duplicated-placement",
+				},
+				Annotations: map[string]string{
+					placementv1beta1.EstimatedResourceRequestsAnnotation: `{"cpu":"1"}`,
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: altClusterName,
+				State:         placementv1beta1.BindingStateScheduled,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "duplicated-binding-2",
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "duplicated-placement",
+				},
+				Annotations: map[string]string{
+					placementv1beta1.EstimatedResourceRequestsAnnotation: `{"cpu":"1"}`,
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: altClusterName,
+				State:         placementv1beta1.BindingStateBound,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "malformed-binding",
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: "other-placement",
+				},
+				Annotations: map[string]string{
+					placementv1beta1.EstimatedResourceRequestsAnnotation: "malformed",
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: anotherClusterName,
+				State:         placementv1beta1.BindingStateScheduled,
+			},
+		},
+	}
+	rbs := []*placementv1beta1.ResourceBinding{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      bindingName,
+				Namespace: "work",
+				Labels: map[string]string{
+					// A namespaced placement that happens to share the same name with the
+					// placement being scheduled.
+					placementv1beta1.PlacementTrackingLabel: crpName,
+				},
+				Annotations: map[string]string{
+					placementv1beta1.EstimatedResourceRequestsAnnotation: `{"memory":"1Gi"}`,
+				},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				TargetCluster: clusterName,
+				State:         placementv1beta1.BindingStateScheduled,
+			},
+		},
+	}
+
+	want := map[string]corev1.ResourceList{
+		clusterName: {
+			corev1.ResourceCPU:    resource.MustParse("2"),
+			corev1.ResourceMemory: resource.MustParse("5Gi"),
+		},
+		altClusterName: {
+			corev1.ResourceCPU: resource.MustParse("1"),
+		},
+	}
+
+	got := prepareReservedResourcesMap(types.NamespacedName{Name: crpName}, controller.ConvertCRBArrayToBindingObjs(crbs), controller.ConvertRBArrayToBindingObjs(rbs))
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("prepareReservedResourcesMap() diff (-got, +want): %s", diff)
+	}
+}
+
+// TestDeductReservedResources tests the deductReservedResources function.
+func TestDeductReservedResources(t *testing.T) {
+	clusters := []clusterv1beta1.MemberCluster{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterName,
+			},
+			Status: clusterv1beta1.MemberClusterStatus{
+				ResourceUsage: clusterv1beta1.ResourceUsage{
+					Available: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("4"),
+						corev1.ResourceMemory: resource.MustParse("2Gi"),
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: altClusterName,
+			},
+			Status: clusterv1beta1.MemberClusterStatus{
+				ResourceUsage: clusterv1beta1.ResourceUsage{
+					Available: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("4"),
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: anotherClusterName,
+			},
+		},
+	}
+	reserved := map[string]corev1.ResourceList{
+		clusterName: {
+			corev1.ResourceCPU:    resource.MustParse("1500m"),
+			corev1.ResourceMemory: resource.MustParse("4Gi"),
+		},
+		anotherClusterName: {
+			corev1.ResourceCPU: resource.MustParse("1"),
+		},
+	}
+
+	want := []clusterv1beta1.MemberCluster{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterName,
+			},
+			Status: clusterv1beta1.MemberClusterStatus{
+				ResourceUsage: clusterv1beta1.ResourceUsage{
+					Available: corev1.ResourceList{
+						corev1.ResourceCPU:    resource.MustParse("2500m"),
+						corev1.ResourceMemory: resource.MustParse("0"),
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: altClusterName,
+			},
+			Status: clusterv1beta1.MemberClusterStatus{
+				ResourceUsage: clusterv1beta1.ResourceUsage{
+					Available: corev1.ResourceList{
+						corev1.ResourceCPU: resource.MustParse("4"),
+					},
+				},
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: anotherClusterName,
+			},
+		},
+	}
+
+	deductReservedResources(clusters, reserved)
+	if diff := cmp.Diff(clusters, want); diff != "" {
+		t.Errorf("deductReservedResources() diff (-got, +want): %s", diff)
+	}
+}
@@ -17,7 +17,17 @@ limitations under the License.
 package framework
 
 import (
+	"encoding/json"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
 )
 
 // prepareScheduledOrBoundBindingsMap returns a map that allows quick lookup of whether a cluster
@@ -46,3 +56,180 @@ func prepareObsoleteBindingsMap(obsoleteBindings []fleetv1beta1.BindingObj) map[
 
 	return bm
 }
+
+// prepareBindingCountsMap returns a map that allows quick lookup of the number of active bindings,
+// i.e., bindings that are scheduled or bound and not being deleted, associated with a cluster.
+//
+// Bindings that belong to the placement being scheduled are excluded, as they are already accounted
+// for by other means in the scheduling cycle.
+func prepareBindingCountsMap(placementKey types.NamespacedName, bindings ...[]fleetv1beta1.BindingObj) map[string]int {
+	bm := make(map[string]int)
+
+	for _, bindingSet := range bindings {
+		for _, binding := range bindingSet {
+			if !binding.GetDeletionTimestamp().IsZero() {
+				continue
+			}
+			if binding.GetBindingSpec().State == fleetv1beta1.BindingStateUnscheduled {
+				continue
+			}
+			if binding.GetNamespace() == placementKey.Namespace && binding.GetLabels()[fleetv1beta1.PlacementTrackingLabel] == placementKey.Name {
+				continue
+			}
+			bm[binding.GetBindingSpec().TargetCluster]++
+		}
+	}
+
+	return bm
+}
+
+// prepareReservedResourcesMap returns a map that allows quick lookup of the amount of resources reserved
+// on a cluster, i.e., the sum of the estimated resource requests of all the active bindings associated
+// with the cluster whose resources have not been applied yet.
+//
+// Bindings that belong to the placement being scheduled are excluded, as the capacity they reserve
+// should not prevent the placement from keeping the clusters it has already picked.
+//
+// A placement reserves capacity on a cluster at most once, even if it has more than one binding on the
+// cluster, e.g., when an unscheduled binding is being replaced; and it reserves no capacity at all once
+// any of its bindings on the cluster has been applied, even if the applied condition has become stale
+// since, as is the case when a new resource snapshot is being rolled out. By then the resources already
+// occupy capacity on the cluster, which is reflected in the available capacity reported by the cluster.
+func prepareReservedResourcesMap(placementKey types.NamespacedName, bindings ...[]fleetv1beta1.BindingObj) map[string]corev1.ResourceList {
+	applied := make(map[placementOnCluster]bool)
+	for _, bindingSet := range bindings {
+		for _, binding := range bindingSet {
+			appliedCond := binding.GetCondition(string(fleetv1beta1.ResourceBindingApplied))
+			if appliedCond != nil && appliedCond.Status == metav1.ConditionTrue {
+				applied[placementOnClusterOf(binding)] = true
+			}
+		}
+	}
+
+	rm := make(map[string]corev1.ResourceList)
+	reserved := make(map[placementOnCluster]bool)
+	for _, bindingSet := range bindings {
+		for _, binding := range bindingSet {
+			if !binding.GetDeletionTimestamp().IsZero() {
+				continue
+			}
+			if binding.GetBindingSpec().State == fleetv1beta1.BindingStateUnscheduled {
+				continue
+			}
+			if binding.GetNamespace() == placementKey.Namespace && binding.GetLabels()[fleetv1beta1.PlacementTrackingLabel] == placementKey.Name {
+				continue
+			}
+			key := placementOnClusterOf(binding)
+			if applied[key] || reserved[key] {
+				continue
+			}
+			requests, ok := estimatedResourceRequestsOf(binding)
+			if !ok {
+				continue
+			}
+			reserved[key] = true
+
+			clusterName := binding.GetBindingSpec().TargetCluster
+			clusterReserved, ok := rm[clusterName]
+			if !ok {
+				clusterReserved = make(corev1.ResourceList)
+				rm[clusterName] = clusterReserved
+			}
+			for name, quantity := range requests {
+				total := clusterReserved[name]
+				total.Add(quantity)
+				clusterReserved[name] = total
+			}
+		}
+	}
+
+	return rm
+}
+
+// placementOnCluster identifies the bindings of a placement on a cluster.
+type placementOnCluster struct {
+	placement types.NamespacedName
+	cluster   string
+}
+
+// placementOnClusterOf returns the placement and the cluster that a binding associates.
+func placementOnClusterOf(binding fleetv1beta1.BindingObj) placementOnCluster {
+	return placementOnCluster{
+		placement: types.NamespacedName{Namespace: binding.GetNamespace(), Name: binding.GetLabels()[fleetv1beta1.PlacementTrackingLabel]},
+		cluster:   binding.GetBindingSpec().TargetCluster,
+	}
+}
+
+// estimatedResourceRequestsOf returns the estimated resource requests recorded on a binding, if any.
+func estimatedResourceRequestsOf(binding fleetv1beta1.BindingObj) (corev1.ResourceList, bool) {
+	data, ok := binding.GetAnnotations()[fleetv1beta1.EstimatedResourceRequestsAnnotation]
+	if !ok {
+		return nil, false
+	}
+	var requests corev1.ResourceList
+	if err := json.Unmarshal([]byte(data), &requests); err != nil {
+		klog.ErrorS(err, "Failed to parse the estimated resource requests on the binding", "binding", klog.KObj(binding))
+		return nil, false
+	}
+	return requests, true
+}
+
+// deductReservedResources deducts the reserved resources from the available capacity of the clusters.
+//
+// Only resources that the cluster reports are deducted, and the available capacity never drops below zero.
+// Note that the clusters are updated in place; the caller must ensure that they are copies.
+func deductReservedResources(clusters []clusterv1beta1.MemberCluster, reservedResources map[string]corev1.ResourceList) {
+	for idx := range clusters {
+		cluster := &clusters[idx]
+		reserved, ok := reservedResources[cluster.Name]
+		if !ok {
+			continue
+		}
+		available := cluster.Status.ResourceUsage.Available
+		for name, quantity := range reserved {
+			remaining, ok := available[name]
+			if !ok {
+				continue
+			}
+			remaining.Sub(quantity)
+			if remaining.Sign() < 0 {
+				remaining.Set(0)
+			}
+			available[name] = remaining
+		}
+		klog.V(2).InfoS("Deducted reserved resources from the available capacity", "memberCluster", klog.KObj(cluster), "reserved", reserved)
+	}
+}
+
+// usesResourceProperties returns true if the cluster affinity terms of a scheduling policy select or
+// sort clusters by any of their resource properties, i.e., the only properties that the capacity
+// reserved on the clusters affects.
+func usesResourceProperties(policy fleetv1beta1.PolicySnapshotObj) bool {
+	placementPolicy := policy.GetPolicySnapshotSpec().Policy
+	if placementPolicy == nil || placementPolicy.Affinity == nil || placementPolicy.Affinity.ClusterAffinity == nil {
+		return false
+	}
+	clusterAffinity := placementPolicy.Affinity.ClusterAffinity
+
+	terms := make([]fleetv1beta1.ClusterSelectorTerm, 0, len(clusterAffinity.PreferredDuringSchedulingIgnoredDuringExecution))
+	if clusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
+		terms = append(terms, clusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution.ClusterSelectorTerms...)
+	}
+	for idx := range clusterAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		terms = append(terms, clusterAffinity.PreferredDuringSchedulingIgnoredDuringExecution[idx].Preference)
+	}
+	for idx := range terms {
+		term := &terms[idx]
+		if term.PropertySelector != nil {
+			for _, req := range term.PropertySelector.MatchExpressions {
+				if strings.HasPrefix(req.Name, propertyprovider.ResourcePropertyNamePrefix) {
+					return true
+				}
+			}
+		}
+		if term.PropertySorter != nil && strings.HasPrefix(term.PropertySorter.Name, propertyprovider.ResourcePropertyNamePrefix) {
+			return true
+		}
+	}
+	return false
+}
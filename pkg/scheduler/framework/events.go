@@ -0,0 +1,108 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/klog/v2"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// The reasons of the events emitted when the set of clusters selected for a placement changes.
+	clustersSelectedEventReason   = "ClustersSelected"
+	clustersDeselectedEventReason = "ClustersDeselected"
+
+	// noLongerSelectedReason is the reason reported for a cluster that is no longer selected when
+	// the new scheduling decisions do not explain why, e.g., the cluster has left the fleet, or the
+	// decision has been dropped as there are too many unselected clusters to report.
+	noLongerSelectedReason = "the cluster is no longer picked by the scheduler"
+)
+
+// diffSelectedClusters compares two sets of scheduling decisions, and returns the descriptions
+// (the cluster names and the reasons) of the clusters that have been newly selected and the ones
+// that are no longer selected, both sorted by cluster name.
+func diffSelectedClusters(oldDecisions, newDecisions []placementv1beta1.ClusterDecision) (added, removed []string) {
+	oldSelected := make(map[string]bool, len(oldDecisions))
+	for idx := range oldDecisions {
+		if oldDecisions[idx].Selected {
+			oldSelected[oldDecisions[idx].ClusterName] = true
+		}
+	}
+	newDecisionByCluster := make(map[string]*placementv1beta1.ClusterDecision, len(newDecisions))
+	for idx := range newDecisions {
+		d := &newDecisions[idx]
+		newDecisionByCluster[d.ClusterName] = d
+		if d.Selected && !oldSelected[d.ClusterName] {
+			added = append(added, fmt.Sprintf("%s (%s)", d.ClusterName, d.Reason))
+		}
+	}
+	for clusterName := range oldSelected {
+		d, found := newDecisionByCluster[clusterName]
+		switch {
+		case !found:
+			removed = append(removed, fmt.Sprintf("%s (%s)", clusterName, noLongerSelectedReason))
+		case !d.Selected:
+			removed = append(removed, fmt.Sprintf("%s (%s)", clusterName, d.Reason))
+		}
+	}
+	sort.Strings(added)
+	sort.Strings(removed)
+	return added, removed
+}
+
+// emitDecisionChangeEvents emits events on the placement that a policy snapshot belongs to when
+// the set of selected clusters has changed between the old and the new scheduling decisions, so
+// that changes in the placement footprint can be observed and audited with standard tooling.
+//
+// Events are emitted on a best-effort basis; failures are logged but not returned.
+func (f *framework) emitDecisionChangeEvents(
+	ctx context.Context,
+	policy placementv1beta1.PolicySnapshotObj,
+	oldDecisions, newDecisions []placementv1beta1.ClusterDecision,
+) {
+	if f.eventRecorder == nil {
+		return
+	}
+	added, removed := diffSelectedClusters(oldDecisions, newDecisions)
+	if len(added) == 0 && len(removed) == 0 {
+		return
+	}
+
+	placementKey := queue.PlacementKey(controller.GetObjectKeyFromNamespaceName(policy.GetNamespace(), policy.GetLabels()[placementv1beta1.PlacementTrackingLabel]))
+	placement, err := controller.FetchPlacementFromKey(ctx, f.client, placementKey)
+	if err != nil {
+		klog.ErrorS(err, "Failed to fetch the placement to emit scheduling decision change events", "policySnapshot", klog.KObj(policy), "placement", placementKey)
+		return
+	}
+	if len(added) > 0 {
+		f.eventRecorder.Eventf(placement, corev1.EventTypeNormal, clustersSelectedEventReason,
+			"Selected %d new cluster(s): %s", len(added), strings.Join(added, "; "))
+	}
+	if len(removed) > 0 {
+		f.eventRecorder.Eventf(placement, corev1.EventTypeNormal, clustersDeselectedEventReason,
+			"%d cluster(s) are no longer selected: %s", len(removed), strings.Join(removed, "; "))
+	}
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// TestDiffSelectedClusters tests the diffSelectedClusters function.
+func TestDiffSelectedClusters(t *testing.T) {
+	clusterName1 := fmt.Sprintf(clusterNameTemplate, 1)
+	clusterName2 := fmt.Sprintf(clusterNameTemplate, 2)
+	clusterName3 := fmt.Sprintf(clusterNameTemplate, 3)
+	clusterName4 := fmt.Sprintf(clusterNameTemplate, 4)
+
+	testCases := []struct {
+		name         string
+		oldDecisions []placementv1beta1.ClusterDecision
+		newDecisions []placementv1beta1.ClusterDecision
+		wantAdded    []string
+		wantRemoved  []string
+	}{
+		{
+			name: "no change",
+			oldDecisions: []placementv1beta1.ClusterDecision{
+				{ClusterName: clusterName1, Selected: true, Reason: "picked"},
+				{ClusterName: clusterName2, Selected: false, Reason: "filtered"},
+			},
+			newDecisions: []placementv1beta1.ClusterDecision{
+				{ClusterName: clusterName1, Selected: true, Reason: "picked again"},
+			},
+		},
+		{
+			name: "first decisions",
+			newDecisions: []placementv1beta1.ClusterDecision{
+				{ClusterName: clusterName2, Selected: true, Reason: "picked"},
+				{ClusterName: clusterName1, Selected: true, Reason: "picked"},
+				{ClusterName: clusterName3, Selected: false, Reason: "filtered"},
+			},
+			wantAdded: []string{
+				"cluster-1 (picked)",
+				"cluster-2 (picked)",
+			},
+		},
+		{
+			name: "mixed",
+			oldDecisions: []placementv1beta1.ClusterDecision{
+				{ClusterName: clusterName1, Selected: true, Reason: "picked"},
+				{ClusterName: clusterName2, Selected: true, Reason: "picked"},
+				{ClusterName: clusterName3, Selected: true, Reason: "picked"},
+			},
+			newDecisions: []placementv1beta1.ClusterDecision{
+				{ClusterName: clusterName1, Selected: true, Reason: "picked"},
+				{ClusterName: clusterName4, Selected: true, Reason: "picked"},
+				{ClusterName: clusterName2, Selected: false, Reason: "filtered"},
+			},
+			wantAdded: []string{
+				"cluster-4 (picked)",
+			},
+			wantRemoved: []string{
+				"cluster-2 (filtered)",
+				fmt.Sprintf("cluster-3 (%s)", noLongerSelectedReason),
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotAdded, gotRemoved := diffSelectedClusters(tc.oldDecisions, tc.newDecisions)
+			if diff := cmp.Diff(gotAdded, tc.wantAdded); diff != "" {
+				t.Errorf("diffSelectedClusters() added mismatch (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(gotRemoved, tc.wantRemoved); diff != "" {
+				t.Errorf("diffSelectedClusters() removed mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestEmitDecisionChangeEvents tests the emitDecisionChangeEvents method.
+func TestEmitDecisionChangeEvents(t *testing.T) {
+	clusterName1 := fmt.Sprintf(clusterNameTemplate, 1)
+	clusterName2 := fmt.Sprintf(clusterNameTemplate, 2)
+
+	crp := &placementv1beta1.ClusterResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: crpName,
+		},
+	}
+	policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyName,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: crpName,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		oldDecisions []placementv1beta1.ClusterDecision
+		newDecisions []placementv1beta1.ClusterDecision
+		wantEvents   []string
+	}{
+		{
+			name: "no change",
+			oldDecisions: []placementv1beta1.ClusterDecision{
+				{ClusterName: clusterName1, Selected: true, Reason: "picked"},
+			},
+			newDecisions: []placementv1beta1.ClusterDecision{
+				{ClusterName: clusterName1, Selected: true, Reason: "picked"},
+			},
+		},
+		{
+			name: "clusters added and removed",
+			oldDecisions: []placementv1beta1.ClusterDecision{
+				{ClusterName: clusterName1, Selected: true, Reason: "picked"},
+			},
+			newDecisions: []placementv1beta1.ClusterDecision{
+				{ClusterName: clusterName2, Selected: true, Reason: "picked"},
+				{ClusterName: clusterName1, Selected: false, Reason: "filtered"},
+			},
+			wantEvents: []string{
+				"Normal ClustersSelected Selected 1 new cluster(s): cluster-2 (picked)",
+				"Normal ClustersDeselected 1 cluster(s) are no longer selected: cluster-1 (filtered)",
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(crp).Build()
+			recorder := record.NewFakeRecorder(10)
+			// Construct framework manually instead of using NewFramework() to avoid mocking the controller manager.
+			f := &framework{
+				client:        fakeClient,
+				eventRecorder: recorder,
+			}
+			f.emitDecisionChangeEvents(context.Background(), policy, tc.oldDecisions, tc.newDecisions)
+
+			close(recorder.Events)
+			var gotEvents []string
+			for event := range recorder.Events {
+				gotEvents = append(gotEvents, event)
+			}
+			if diff := cmp.Diff(gotEvents, tc.wantEvents); diff != "" {
+				t.Errorf("emitDecisionChangeEvents() events mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,10 +23,10 @@ import (
 	"fmt"
 	"sort"
 	"strings"
-	"sync/atomic"
 	"time"
 
 	"golang.org/x/sync/errgroup"
+	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -46,6 +46,7 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/toleration"
 )
 
 const (
@@ -53,9 +54,9 @@ const (
 	eventRecorderNameTemplate = "scheduler-framework-%s"
 
 	// FullyScheduledReason is the reason string of placement condition when the placement is scheduled.
-	FullyScheduledReason = "SchedulingPolicyFulfilled"
+	FullyScheduledReason = condition.SchedulingPolicyFulfilledReason
 	// NotFullyScheduledReason is the reason string of placement condition when the placement policy cannot be fully satisfied.
-	NotFullyScheduledReason = "SchedulingPolicyUnfulfilled"
+	NotFullyScheduledReason = condition.SchedulingPolicyUnfulfilledReason
 
 	fullyScheduledMessage    = "found all cluster needed as specified by the scheduling policy, found %d cluster(s)"
 	notFullyScheduledMessage = "could not find all clusters needed as specified by the scheduling policy, found %d cluster(s) instead"
@@ -63,7 +64,10 @@ const (
 	// The reasons to use for scheduling decisions.
 	pickFixedInvalidClusterReasonTemplate  = "Cluster \"%s\" is not eligible for resource placement yet: %s"
 	pickFixedNotFoundClusterReasonTemplate = "Specified cluster \"%s\" is not found"
+	untoleratedTaintReasonTemplate         = "cluster has taint %s=%s:%s that cannot be tolerated by the placement"
 	notPickedByScoreReasonTemplate         = "Cluster \"%s\" does not score high enough (affinity score: %d, topology spread score: %d)"
+	notPickedByFilterReasonTemplate        = "Cluster \"%s\" is filtered out by the %s plugin"
+	notPickedByFilterWithDetailsTemplate   = "Cluster \"%s\" is filtered out by the %s plugin: %s"
 
 	// ClusterDecision schedule message templates.
 	resourceScheduleSucceededMessageFormat          = "Successfully scheduled resources for placement in \"%s\": picked by scheduling policy"
@@ -131,6 +135,22 @@ type framework struct {
 	//
 	// Note that all picked clusters will always have their associated decisions written to the status.
 	maxUnselectedClusterDecisionCount int
+
+	// enableResourcePlacement controls whether the scheduler framework should also take namespace-scoped
+	// bindings (ResourceBindings) into account when counting bindings per cluster.
+	enableResourcePlacement bool
+
+	// schedulingCycleSnapshotCount is the number of the latest scheduling cycles per placement whose
+	// snapshots are kept for debugging; zero disables the snapshots.
+	schedulingCycleSnapshotCount int
+
+	// pluginEvaluationTimeout is the deadline for running the filter and score plugins across all
+	// clusters in a scheduling cycle; zero disables the deadline.
+	pluginEvaluationTimeout time.Duration
+
+	// enableSchedulingProfiles controls whether the scheduler framework honors the scheduling profiles
+	// (SchedulingProfile objects) that placements refer to.
+	enableSchedulingProfiles bool
 }
 
 var (
@@ -151,6 +171,22 @@ type frameworkOptions struct {
 	// checker is the cluster eligibility checker the scheduler framework will use to check
 	// if a cluster is eligibile for resource placement.
 	clusterEligibilityChecker *clustereligibilitychecker.ClusterEligibilityChecker
+
+	// enableResourcePlacement controls whether the scheduler framework should also take namespace-scoped
+	// bindings (ResourceBindings) into account when counting bindings per cluster.
+	enableResourcePlacement bool
+
+	// schedulingCycleSnapshotCount is the number of the latest scheduling cycles per placement whose
+	// snapshots the scheduler framework will keep for debugging.
+	schedulingCycleSnapshotCount int
+
+	// pluginEvaluationTimeout is the deadline for running the filter and score plugins across all
+	// clusters in a scheduling cycle.
+	pluginEvaluationTimeout time.Duration
+
+	// enableSchedulingProfiles controls whether the scheduler framework honors the scheduling profiles
+	// that placements refer to.
+	enableSchedulingProfiles bool
 }
 
 // Option is the function for configuring a scheduler framework.
@@ -184,6 +220,41 @@ func WithClusterEligibilityChecker(checker *clustereligibilitychecker.ClusterEli
 	}
 }
 
+// WithResourcePlacementEnabled sets whether the scheduler framework should also take namespace-scoped
+// bindings into account when counting bindings per cluster.
+func WithResourcePlacementEnabled(enabled bool) Option {
+	return func(fo *frameworkOptions) {
+		fo.enableResourcePlacement = enabled
+	}
+}
+
+// WithSchedulingCycleSnapshotCount sets the number of the latest scheduling cycles per placement whose
+// snapshots, i.e., the cycle states, the filter outcomes, and the scores, are kept in config maps
+// for debugging; zero disables the snapshots.
+func WithSchedulingCycleSnapshotCount(count int) Option {
+	return func(fo *frameworkOptions) {
+		fo.schedulingCycleSnapshotCount = count
+	}
+}
+
+// WithPluginEvaluationTimeout sets the deadline for running the filter and score plugins across all
+// clusters in a scheduling cycle; a scheduling cycle that exceeds the deadline fails and will be
+// retried. Zero disables the deadline.
+func WithPluginEvaluationTimeout(timeout time.Duration) Option {
+	return func(fo *frameworkOptions) {
+		fo.pluginEvaluationTimeout = timeout
+	}
+}
+
+// WithSchedulingProfilesEnabled sets whether the scheduler framework honors the scheduling profiles
+// (SchedulingProfile objects) that placements refer to; if disabled, the scheduler always uses its
+// default scoring behavior.
+func WithSchedulingProfilesEnabled(enabled bool) Option {
+	return func(fo *frameworkOptions) {
+		fo.enableSchedulingProfiles = enabled
+	}
+}
+
 // NewFramework returns a new scheduler framework.
 func NewFramework(profile *Profile, manager ctrl.Manager, opts ...Option) Framework {
 	options := defaultFrameworkOptions
@@ -213,6 +284,10 @@ func NewFramework(profile *Profile, manager ctrl.Manager, opts ...Option) Framew
 		parallelizer:                      parallelizer.NewParallelizer(options.numOfWorkers),
 		maxUnselectedClusterDecisionCount: options.maxUnselectedClusterDecisionCount,
 		clusterEligibilityChecker:         options.clusterEligibilityChecker,
+		enableResourcePlacement:           options.enableResourcePlacement,
+		schedulingCycleSnapshotCount:      options.schedulingCycleSnapshotCount,
+		pluginEvaluationTimeout:           options.pluginEvaluationTimeout,
+		enableSchedulingProfiles:          options.enableSchedulingProfiles,
 	}
 	// initialize all the plugins
 	for _, plugin := range f.profile.registeredPlugins {
@@ -329,12 +404,55 @@ func (f *framework) RunSchedulingCycleFor(ctx context.Context, placementKey queu
 		return ctrl.Result{}, err
 	}
 
+	// Mark the scheduled/bound bindings on clusters that are no longer selected by the placement that
+	// the current placement follows (if any) as unscheduled.
+	//
+	// Placement affinity, unlike cluster affinity, is enforced during execution as well, so that
+	// the placement keeps following the scheduling decisions of the other placement.
+	scheduled, bound, followed, err := f.unscheduleBindingsNotFollowingPlacementAffinity(ctx, policy, scheduled, bound)
+	if err != nil {
+		klog.ErrorS(err, "Failed to mark bindings that do not follow the placement affinity as unscheduled", "policySnapshot", policyRef)
+		return ctrl.Result{}, err
+	}
+	if !followed {
+		// The placement to follow has not been scheduled yet (or is missing, or selects no cluster);
+		// skip the cycle, as the scheduling decisions can only be made after those of the placement
+		// to follow. The placement will be requeued once the placement to follow has been scheduled.
+		klog.V(2).InfoS("The placement to follow has not been scheduled yet; skip the scheduling cycle", "policySnapshot", policyRef)
+		return ctrl.Result{}, nil
+	}
+
+	// Count the bindings from all the other placements per cluster if any plugin reads the counts, so
+	// that plugins can learn about the overall load of each cluster; at the same time, sum up the capacity
+	// reserved by the bindings from all the other placements that have not been applied yet if the
+	// placement picks clusters by their resource properties.
+	//
+	// Note that, unlike bindings of the current placement, the bindings are collected from the cached
+	// client; the counts serve as a soft signal in scoring only and do not require strict consistency,
+	// and the reservations are released only after the resources are applied, which leaves enough
+	// time for the cache to catch up. For the same reason, a failure to collect them does not fail
+	// the scheduling cycle.
+	bindingCounts, reservedResources := f.collectBindingCountsAndReservedResources(ctx, types.NamespacedName{Namespace: namespace, Name: name}, policy)
+	// Deduct the reserved capacity from the available capacity of the clusters, so that placements
+	// scheduled at the same time do not all pick the same nearly full cluster.
+	//
+	// Note that the clusters are listed from the cache with deep copies; it is safe to update them.
+	deductReservedResources(clusters, reservedResources)
+
 	// Prepare the cycle state for this run.
 	//
 	// Note that this state is shared between all plugins and the scheduler framework itself (though some fields are reserved by
 	// the framework). These reserved fields are never accessed concurrently, as each scheduling run has its own cycle and a run
 	// is always executed in one single goroutine; plugin access to the state is guarded by sync.Map.
 	state := NewCycleState(clusters, obsolete, bound, scheduled)
+	state.bindingCounts = bindingCounts
+
+	// Keep a snapshot of the scheduling cycle for debugging if enabled.
+	if f.schedulingCycleSnapshotCount > 0 {
+		defer func() {
+			f.recordSchedulingCycleSnapshot(ctx, placementKey, policy, state, startTime, err)
+		}()
+	}
 
 	switch {
 	case policy.GetPolicySnapshotSpec().Policy == nil:
@@ -367,6 +485,111 @@ func (f *framework) collectClusters(ctx context.Context) ([]clusterv1beta1.Membe
 	return clusterList.Items, nil
 }
 
+// collectBindingCountsAndReservedResources counts the active bindings from all placements other than the
+// given one per cluster, and sums up the resources reserved by those bindings per cluster.
+//
+// The bindings are counted only if a registered plugin reads the counts, and the reserved resources are
+// summed up only if the scheduling policy picks clusters by their resource properties; the bindings are
+// not listed at all if neither applies. Nil maps are returned if the bindings cannot be listed.
+func (f *framework) collectBindingCountsAndReservedResources(
+	ctx context.Context,
+	placementKey types.NamespacedName,
+	policy placementv1beta1.PolicySnapshotObj,
+) (bindingCounts map[string]int, reservedResources map[string]corev1.ResourceList) {
+	countBindings := f.profile.usesBindingCounts()
+	reserveResources := usesResourceProperties(policy)
+	if !countBindings && !reserveResources {
+		return nil, nil
+	}
+
+	// The bindings are only read here; skip the deep copy for improved performance.
+	bindings, err := f.listAllBindings(ctx)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list the bindings of all placements; proceed without binding counts and reserved resources", "placement", placementKey)
+		return nil, nil
+	}
+	if countBindings {
+		bindingCounts = prepareBindingCountsMap(placementKey, bindings)
+	}
+	if reserveResources {
+		reservedResources = prepareReservedResourcesMap(placementKey, bindings)
+	}
+	return bindingCounts, reservedResources
+}
+
+// listAllBindings lists the bindings of all placements from the cache.
+//
+// Note that the bindings are listed without deep copies; they must not be modified.
+func (f *framework) listAllBindings(ctx context.Context) ([]placementv1beta1.BindingObj, error) {
+	crbList := &placementv1beta1.ClusterResourceBindingList{}
+	if err := f.client.List(ctx, crbList, client.UnsafeDisableDeepCopy); err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	bindings := crbList.GetBindingObjs()
+
+	if f.enableResourcePlacement {
+		rbList := &placementv1beta1.ResourceBindingList{}
+		if err := f.client.List(ctx, rbList, client.UnsafeDisableDeepCopy); err != nil {
+			return nil, controller.NewAPIServerError(true, err)
+		}
+		bindings = append(bindings, rbList.GetBindingObjs()...)
+	}
+	return bindings, nil
+}
+
+// unscheduleBindingsNotFollowingPlacementAffinity marks the scheduled and bound bindings whose target clusters
+// are not selected by the placement that the current placement follows as unscheduled; it returns the scheduled
+// and bound bindings that remain, and whether the placement can follow the other placement at this moment.
+// No binding is changed if the policy has no placement affinity.
+//
+// The placement cannot follow the other placement if the latter is missing (e.g., it is being recreated), its
+// latest policy snapshot has not been scheduled yet, or it selects no cluster; in these cases no binding is
+// changed either, as unscheduling all the bindings would remove the resources from every cluster.
+func (f *framework) unscheduleBindingsNotFollowingPlacementAffinity(
+	ctx context.Context,
+	policy placementv1beta1.PolicySnapshotObj,
+	scheduled, bound []placementv1beta1.BindingObj,
+) (remainingScheduled, remainingBound []placementv1beta1.BindingObj, followed bool, err error) {
+	placementPolicy := policy.GetPolicySnapshotSpec().Policy
+	if placementPolicy == nil || placementPolicy.Affinity == nil || placementPolicy.Affinity.PlacementAffinity == nil {
+		return scheduled, bound, true, nil
+	}
+
+	followedKey := types.NamespacedName{Namespace: policy.GetNamespace(), Name: placementPolicy.Affinity.PlacementAffinity.PlacementName}
+	selected, isScheduled, err := controller.FetchSelectedClusters(ctx, f.client, followedKey)
+	if err != nil {
+		return nil, nil, false, err
+	}
+	if !isScheduled || len(selected) == 0 {
+		return scheduled, bound, false, nil
+	}
+
+	var toUnschedule []placementv1beta1.BindingObj
+	split := func(bindings []placementv1beta1.BindingObj) []placementv1beta1.BindingObj {
+		remaining := make([]placementv1beta1.BindingObj, 0, len(bindings))
+		for _, binding := range bindings {
+			if selected[binding.GetBindingSpec().TargetCluster] {
+				remaining = append(remaining, binding)
+				continue
+			}
+			toUnschedule = append(toUnschedule, binding)
+		}
+		return remaining
+	}
+	remainingScheduled = split(scheduled)
+	remainingBound = split(bound)
+	if len(toUnschedule) == 0 {
+		return remainingScheduled, remainingBound, true, nil
+	}
+
+	klog.V(2).InfoS("Unscheduling bindings on clusters no longer selected by the followed placement",
+		"policySnapshot", klog.KObj(policy), "followedPlacement", followedKey, "count", len(toUnschedule))
+	if err := f.updateBindings(ctx, toUnschedule, markUnscheduledForAndUpdate); err != nil {
+		return nil, nil, false, err
+	}
+	return remainingScheduled, remainingBound, true, nil
+}
+
 // markAsUnscheduledForAndUpdate marks a binding as unscheduled and updates it.
 var markUnscheduledForAndUpdate = func(ctx context.Context, hubClient client.Client, binding placementv1beta1.BindingObj) error {
 	// Remember the previous unscheduledBinding state so that we might be able to revert this change if this
@@ -442,7 +665,9 @@ func (f *framework) runSchedulingCycleForPickAllPlacementType(
 	// to identify clusters that already have placements, in accordance with the latest
 	// scheduling policy, on them. Such clusters will not be scored; it will not be included
 	// as a filtered out cluster, either.
-	scored, filtered, err := f.runAllPluginsForPickAllPlacementType(ctx, state, policy, clusters)
+	//
+	// Clusters from which the placement has been preempted are not picked again until its resources are removed.
+	scored, filtered, err := f.runAllPluginsForPickAllPlacementType(ctx, state, policy, excludeClustersWithBindings(clusters, preemptedBindings(unscheduled)))
 	if err != nil {
 		klog.ErrorS(err, "Failed to run all plugins (pickAll placement type)", "policySnapshot", policyRef)
 		return ctrl.Result{}, err
@@ -536,10 +761,12 @@ func (f *framework) runAllPluginsForPickAllPlacementType(
 	// are inspected in parallel.
 	//
 	// Note that any failure would lead to the cancellation of the scheduling cycle.
-	passed, filtered, err := f.runFilterPlugins(ctx, state, policy, clusters)
+	pluginCtx, cancel := f.withPluginEvaluationDeadline(ctx)
+	defer cancel()
+	passed, filtered, err := f.runFilterPlugins(pluginCtx, state, policy, clusters)
 	if err != nil {
 		klog.ErrorS(err, "Failed to run filter plugins", "policySnapshot", policyRef)
-		return nil, nil, controller.NewUnexpectedBehaviorError(err)
+		return nil, nil, newPluginRunError(err)
 	}
 
 	// Wrap all clusters that have passed the Filter stage as scored clusters.
@@ -550,6 +777,7 @@ func (f *framework) runAllPluginsForPickAllPlacementType(
 			Score:   &ClusterScore{},
 		})
 	}
+	state.filtered, state.scored = filtered, scored
 	return scored, filtered, nil
 }
 
@@ -631,38 +859,26 @@ func (f filteredClusterWithStatusList) Swap(i, j int) {
 }
 
 // runFilterPlugins runs filter plugins on clusters in parallel.
+//
+// The results are aggregated in the same order as the given clusters, regardless of the order in
+// which the clusters are inspected.
 func (f *framework) runFilterPlugins(ctx context.Context, state *CycleState, policy placementv1beta1.PolicySnapshotObj, clusters []clusterv1beta1.MemberCluster) (passed []*clusterv1beta1.MemberCluster, filtered filteredClusterWithStatusList, err error) {
 	// Create a child context.
 	childCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
 
-	// Pre-allocate slices to avoid races.
-	passed = make([]*clusterv1beta1.MemberCluster, len(clusters))
-	var passedIdx int32 = -1
-	filtered = make([]*filteredClusterWithStatus, len(clusters))
-	var filteredIdx int32 = -1
+	// Pre-allocate the slice to avoid races; each worker writes only the status of the cluster
+	// it inspects.
+	statuses := make([]*Status, len(clusters))
 
 	errFlag := parallelizer.NewErrorFlag()
 
 	doWork := func(pieces int) {
 		cluster := clusters[pieces]
 		status := f.runFilterPluginsFor(childCtx, state, policy, &cluster)
-		switch {
-		case status.IsSuccess():
-			// Use atomic add to avoid races with minimum overhead.
-			newPassedIdx := atomic.AddInt32(&passedIdx, 1)
-			passed[newPassedIdx] = &cluster
-		case status.IsClusterUnschedulable():
-			// Use atomic add to avoid races with minimum overhead.
-			newFilteredIdx := atomic.AddInt32(&filteredIdx, 1)
-			filtered[newFilteredIdx] = &filteredClusterWithStatus{
-				cluster: &cluster,
-				status:  status,
-			}
-		case status.IsClusterAlreadySelected():
-			// Simply ignore the cluster if it is already selected; no further stages need
-			// to run for this cluster, and it should not be considered as a filtered out one
-			// either.
-		default: // An error has occurred.
+		statuses[pieces] = status
+		if !status.IsSuccess() && !status.IsClusterUnschedulable() && !status.IsClusterAlreadySelected() {
+			// An error has occurred.
 			errFlag.Raise(status.AsError())
 			// Cancel the child context, which will lead the parallelizer to stop running tasks.
 			cancel()
@@ -677,10 +893,32 @@ func (f *framework) runFilterPlugins(ctx context.Context, state *CycleState, pol
 	if err := errFlag.Lower(); err != nil {
 		return nil, nil, err
 	}
+	// The parallel run might also stop early as the parent context is done, e.g., the deadline for
+	// running plugins in the scheduling cycle has been exceeded; not all clusters have been inspected
+	// in this case.
+	if err := ctx.Err(); err != nil {
+		return nil, nil, fmt.Errorf("failed to run filter plugins on all clusters: %w", err)
+	}
 
-	// Trim the slices to the actual size.
-	passed = passed[:passedIdx+1]
-	filtered = filtered[:filteredIdx+1]
+	// Aggregate the results.
+	passed = make([]*clusterv1beta1.MemberCluster, 0, len(clusters))
+	filtered = make([]*filteredClusterWithStatus, 0, len(clusters))
+	for idx := range clusters {
+		cluster := clusters[idx]
+		status := statuses[idx]
+		switch {
+		case status.IsSuccess():
+			passed = append(passed, &cluster)
+		case status.IsClusterUnschedulable():
+			filtered = append(filtered, &filteredClusterWithStatus{
+				cluster: &cluster,
+				status:  status,
+			})
+		}
+		// Clusters that are already selected are simply ignored; no further stages need
+		// to run for these clusters, and they should not be considered as filtered out ones
+		// either.
+	}
 
 	return passed, filtered, nil
 }
@@ -831,6 +1069,7 @@ func (f *framework) updatePolicySnapshotStatusFromBindings(
 		klog.ErrorS(err, "Failed to update policy snapshot status", "schedulingPolicySnapshot", policyRef)
 		return controller.NewAPIServerError(false, err)
 	}
+	f.emitDecisionChangeEvents(ctx, policy, currentDecisions, newDecisions)
 	return nil
 }
 
@@ -855,6 +1094,17 @@ func (f *framework) runSchedulingCycleForPickNPlacementType(
 		return ctrl.Result{}, controller.NewUnexpectedBehaviorError(err)
 	}
 
+	// Select additional clusters if the rollout controller is surging a resource change to them.
+	numOfSurgeClusters, err := annotations.ExtractNumOfSurgeClustersFromPolicySnapshot(policy)
+	if err != nil {
+		klog.ErrorS(err, "Failed to extract number of surge clusters from policy snapshot", "policySnapshot", policyRef)
+		return ctrl.Result{}, controller.NewUnexpectedBehaviorError(err)
+	}
+	if numOfSurgeClusters > 0 {
+		klog.V(2).InfoS("Selecting surge clusters for resource change rollout", "policySnapshot", policyRef, "numOfClusters", numOfClusters, "numOfSurgeClusters", numOfSurgeClusters)
+		numOfClusters += numOfSurgeClusters
+	}
+
 	// Check if the scheduler should downscale, i.e., mark some scheduled/bound bindings as unscheduled and/or
 	// clean up all obsolete bindings right away.
 	//
@@ -928,18 +1178,53 @@ func (f *framework) runSchedulingCycleForPickNPlacementType(
 	// The scheduler needs to take action; enter the actual scheduling stages.
 	klog.V(2).InfoS("Scheduling is needed; entering scheduling stages", "policySnapshot", policyRef)
 
+	// Schedule the delta only.
+	//
+	// Existing bound and scheduled bindings, which are associated with the latest scheduling policy
+	// snapshot, are kept as they are; e.g., when only the number of clusters grows, the scheduler
+	// picks the additional clusters among the clusters that have not been selected yet, rather than
+	// running the plugins on all the clusters in the fleet again.
+	//
+	// Note that the cycle state still tracks all the clusters, so that plugins (e.g., the topology
+	// spread constraints plugin) can learn about the clusters that have been selected already.
+	//
+	// Clusters from which the placement has been preempted are not picked again until its resources are removed.
+	candidates := excludeClustersWithBindings(clusters, bound, scheduled, preemptedBindings(unscheduled))
+	klog.V(2).InfoS("Scheduling the delta", "policySnapshot", policyRef, "numOfClusters", numOfClusters, "existingBindings", len(bound)+len(scheduled), "candidates", len(candidates))
+
 	// Run all the plugins.
 	//
-	// Note that it is up to some plugin (by default the same placement anti-affinity plugin)
+	// Note that it is also up to some plugin (by default the same placement anti-affinity plugin)
 	// to identify clusters that already have placements, in accordance with the latest
 	// scheduling policy, on them. Such clusters will not be scored; it will not be included
 	// as a filtered out cluster, either.
-	scored, filtered, err := f.runAllPluginsForPickNPlacementType(ctx, state, policy, numOfClusters, len(bound)+len(scheduled), clusters)
+	scored, filtered, err := f.runAllPluginsForPickNPlacementType(ctx, state, policy, numOfClusters, len(bound)+len(scheduled), candidates)
 	if err != nil {
 		klog.ErrorS(err, "Failed to run all plugins", "policySnapshot", policyRef)
 		return ctrl.Result{}, err
 	}
 
+	// Leave out the clusters that score below the minimum set by the scheduling profile of the placement (if any).
+	//
+	// Such clusters are reported as not picked due to their scores; they are not preemption candidates either,
+	// as preempting other placements would not have them picked.
+	scored, belowMinimumScore := state.scoringSettings.partitionByMinimumScore(scored)
+
+	// Preempt lower-priority placements if not enough clusters are found and the priority class of the
+	// placement allows preemption.
+	//
+	// The clusters on which the placement is preempting other placements are not picked in this cycle; the
+	// scheduler checks again after the resources of the preempted placements have been removed.
+	var preempting map[string][]string
+	if shortage := state.batchSizeLimit - len(scored); shortage > 0 && len(filtered) > 0 {
+		preempting, err = f.preemptLowerPriorityPlacements(ctx, state, placementKey, policy, filtered, shortage)
+		if err != nil {
+			klog.ErrorS(err, "Failed to preempt lower-priority placements", "policySnapshot", policyRef)
+			return ctrl.Result{}, err
+		}
+		filtered = markPreemptingClusters(filtered, preempting)
+	}
+
 	// Pick the top scored clusters.
 	klog.V(2).InfoS("Picking clusters", "policySnapshot", policyRef, "filtered", filtered, "scored", scored)
 
@@ -960,6 +1245,7 @@ func (f *framework) runSchedulingCycleForPickNPlacementType(
 	// Note that at this point of the scheduling cycle, any cluster associated with a currently
 	// bound or scheduled binding should be filtered out already.
 	picked, notPicked := pickTopNScoredClusters(scored, numOfClustersToPick)
+	notPicked = append(notPicked, belowMinimumScore...)
 
 	// Cross-reference the newly picked clusters with obsolete bindings; find out
 	//
@@ -1014,6 +1300,11 @@ func (f *framework) runSchedulingCycleForPickNPlacementType(
 		return ctrl.Result{}, err
 	}
 
+	// Check again later if the placement is waiting for the resources of preempted placements to be removed.
+	if len(preempting) > 0 {
+		return ctrl.Result{Requeue: true, RequeueAfter: preemptionRequeueDelay}, nil
+	}
+
 	// The scheduling cycle has completed.
 	return ctrl.Result{}, nil
 }
@@ -1155,11 +1446,19 @@ func (f *framework) runAllPluginsForPickNPlacementType(
 	// that the cluster should not be bound, the cluster is ignored for the rest of the cycle. Note that clusters
 	// are inspected in parallel.
 	//
-	// Note that any failure would lead to the cancellation of the scheduling cycle.
-	passed, filtered, err := f.runFilterPlugins(ctx, state, policy, clusters)
+	// Note that any failure would lead to the cancellation of the scheduling cycle; the deadline for running
+	// plugins (if any) covers both the Filter and the Score stages.
+	pluginCtx, cancel := f.withPluginEvaluationDeadline(ctx)
+	defer cancel()
+	passed, filtered, err := f.runFilterPlugins(pluginCtx, state, policy, clusters)
 	if err != nil {
 		klog.ErrorS(err, "Failed to run filter plugins", "policySnapshot", policyRef)
-		return nil, nil, controller.NewUnexpectedBehaviorError(err)
+		return nil, nil, newPluginRunError(err)
+	}
+
+	// Resolve the scoring settings from the scheduling profile of the placement (if any).
+	if err := f.resolveScoringSettings(ctx, state, policy); err != nil {
+		return nil, nil, err
 	}
 
 	// Run pre-score plugins.
@@ -1175,12 +1474,13 @@ func (f *framework) runAllPluginsForPickNPlacementType(
 	//
 	// Note that at this moment, since no normalization is needed, the addition is performed directly at this step;
 	// when need for normalization materializes, this step should return a list of scores per cluster per plugin instead.
-	scored, err = f.runScorePlugins(ctx, state, policy, passed)
+	scored, err = f.runScorePlugins(pluginCtx, state, policy, passed)
 	if err != nil {
 		klog.ErrorS(err, "Failed to run score plugins", "policySnapshot", policyRef)
-		return nil, nil, controller.NewUnexpectedBehaviorError(err)
+		return nil, nil, newPluginRunError(err)
 	}
 
+	state.filtered, state.scored = filtered, scored
 	return scored, filtered, nil
 }
 
@@ -1209,6 +1509,10 @@ func (f *framework) runPostBatchPlugins(ctx context.Context, state *CycleState,
 // runPreScorePlugins runs all pre score plugins sequentially.
 func (f *framework) runPreScorePlugins(ctx context.Context, state *CycleState, policy placementv1beta1.PolicySnapshotObj) *Status {
 	for _, pl := range f.profile.preScorePlugins {
+		// Skip the plugin if it has been disabled by the scheduling profile of the placement.
+		if state.skippedScorePlugins.Has(pl.Name()) {
+			continue
+		}
 		status := pl.PreScore(ctx, state, policy)
 		switch {
 		case status.IsSuccess(): // Do nothing.
@@ -1251,8 +1555,11 @@ func (f *framework) runScorePluginsFor(ctx context.Context, state *CycleState, p
 }
 
 // runScorePlugins runs score plugins on clusters in parallel.
+//
+// The scored clusters are listed in the same order as the given clusters, regardless of the order
+// in which the clusters are scored.
 func (f *framework) runScorePlugins(ctx context.Context, state *CycleState, policy placementv1beta1.PolicySnapshotObj, clusters []*clusterv1beta1.MemberCluster) (ScoredClusters, error) {
-	// Pre-allocate slices to avoid races.
+	// Pre-allocate slices to avoid races; each worker writes only the score of the cluster it inspects.
 	scoredClusters := make(ScoredClusters, len(clusters))
 
 	// As a shortcut, return immediately if there is no available cluster.
@@ -1262,8 +1569,7 @@ func (f *framework) runScorePlugins(ctx context.Context, state *CycleState, poli
 
 	// Create a child context.
 	childCtx, cancel := context.WithCancel(ctx)
-
-	var scoredClustersIdx int32 = -1
+	defer cancel()
 
 	errFlag := parallelizer.NewErrorFlag()
 
@@ -1272,15 +1578,9 @@ func (f *framework) runScorePlugins(ctx context.Context, state *CycleState, poli
 		scoreList, status := f.runScorePluginsFor(childCtx, state, policy, cluster)
 		switch {
 		case status.IsSuccess():
-			totalScore := &ClusterScore{}
-			for _, score := range scoreList {
-				totalScore.Add(score)
-			}
-			// Use atomic add to avoid races with minimum overhead.
-			newScoredClustersIdx := atomic.AddInt32(&scoredClustersIdx, 1)
-			scoredClusters[newScoredClustersIdx] = &ScoredCluster{
+			scoredClusters[pieces] = &ScoredCluster{
 				Cluster: cluster,
-				Score:   totalScore,
+				Score:   aggregateScores(state, scoreList),
 			}
 		default: // An error has occurred.
 			errFlag.Raise(status.AsError())
@@ -1296,9 +1596,12 @@ func (f *framework) runScorePlugins(ctx context.Context, state *CycleState, poli
 	if err := errFlag.Lower(); err != nil {
 		return nil, err
 	}
-
-	// Trim the slice to its actual size.
-	scoredClusters = scoredClusters[:scoredClustersIdx+1]
+	// The parallel run might also stop early as the parent context is done, e.g., the deadline for
+	// running plugins in the scheduling cycle has been exceeded; not all clusters have been scored
+	// in this case.
+	if err := ctx.Err(); err != nil {
+		return nil, fmt.Errorf("failed to run score plugins on all clusters: %w", err)
+	}
 
 	return scoredClusters, nil
 }
@@ -1317,7 +1620,15 @@ type invalidClusterWithReason struct {
 
 // crossReferenceClustersWithTargetNames cross-references the current list of clusters in the fleet
 // and the list of target clusters user specifies in the placement policy.
-func (f *framework) crossReferenceClustersWithTargetNames(current []clusterv1beta1.MemberCluster, target []string) (valid []*clusterv1beta1.MemberCluster, invalid []*invalidClusterWithReason, notFound []string) {
+//
+// A target cluster with a taint that cannot be tolerated by the given tolerations is considered invalid,
+// unless resources have already been scheduled to it, as the taints only prevent new placements.
+func (f *framework) crossReferenceClustersWithTargetNames(
+	current []clusterv1beta1.MemberCluster,
+	target []string,
+	tolerations []placementv1beta1.Toleration,
+	scheduledOrBound map[string]bool,
+) (valid []*clusterv1beta1.MemberCluster, invalid []*invalidClusterWithReason, notFound []string) {
 	// Pre-allocate with a reasonable capacity.
 	valid = make([]*clusterv1beta1.MemberCluster, 0, len(target))
 	invalid = make([]*invalidClusterWithReason, 0, len(target))
@@ -1349,6 +1660,15 @@ func (f *framework) crossReferenceClustersWithTargetNames(current []clusterv1bet
 			continue
 		}
 
+		if taint, isUntolerated := toleration.FindUntoleratedTaint(cluster.Spec.Taints, tolerations); isUntolerated && !scheduledOrBound[targetName] {
+			// The target cluster is found, but it has a taint that the placement cannot tolerate.
+			invalid = append(invalid, &invalidClusterWithReason{
+				cluster: &cluster,
+				reason:  fmt.Sprintf(untoleratedTaintReasonTemplate, taint.Key, taint.Value, taint.Effect),
+			})
+			continue
+		}
+
 		// The target cluster is found, and it is a valid target (eligible for resource placement).
 		valid = append(valid, &cluster)
 	}
@@ -1420,6 +1740,7 @@ func (f *framework) updatePolicySnapshotStatusForPickFixedPlacementType(
 		return controller.NewAPIServerError(false, err)
 	}
 
+	f.emitDecisionChangeEvents(ctx, policy, currentDecisions, newDecisions)
 	return nil
 }
 
@@ -1450,7 +1771,17 @@ func (f *framework) runSchedulingCycleForPickFixedPlacementType(
 	//   fleet and the list of target clusters, but is not eligible for resource placement;
 	// * not found targets, i.e., cluster that is present in the list of target clusters, but
 	//   is not present in the list of current clusters in the fleet.
-	valid, invalid, notFound := f.crossReferenceClustersWithTargetNames(clusters, targetClusterNames)
+	//
+	// Clusters with taints that the placement cannot tolerate are invalid targets, unless they
+	// already have scheduled or bound bindings.
+	scheduledOrBound := make(map[string]bool, len(scheduled)+len(bound))
+	for _, binding := range scheduled {
+		scheduledOrBound[binding.GetBindingSpec().TargetCluster] = true
+	}
+	for _, binding := range bound {
+		scheduledOrBound[binding.GetBindingSpec().TargetCluster] = true
+	}
+	valid, invalid, notFound := f.crossReferenceClustersWithTargetNames(clusters, targetClusterNames, policy.GetPolicySnapshotSpec().Tolerations(), scheduledOrBound)
 
 	// Cross-reference the valid target clusters with obsolete bindings; find out
 	//
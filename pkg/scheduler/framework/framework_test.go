@@ -30,14 +30,17 @@ import (
 	crossplanetest "github.com/crossplane/crossplane-runtime/v2/pkg/test"
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
 	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/kubernetes/scheme"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
@@ -71,18 +74,9 @@ var (
 	ignoredStatusFields                       = cmpopts.IgnoreFields(Status{}, "reasons", "err")
 	ignoredBindingWithPatchFields             = cmpopts.IgnoreFields(bindingWithPatch{}, "patch")
 	ignoredCondFields                         = cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")
-	ignoreCycleStateFields                    = cmpopts.IgnoreFields(CycleState{}, "store", "clusters", "scheduledOrBoundBindings", "obsoleteBindings")
+	ignoreCycleStateFields                    = cmpopts.IgnoreFields(CycleState{}, "store", "clusters", "scheduledOrBoundBindings", "obsoleteBindings", "filtered", "scored")
 	ignoreClusterDecisionScoreAndReasonFields = cmpopts.IgnoreFields(placementv1beta1.ClusterDecision{}, "ClusterScore", "Reason")
 
-	lessFuncCluster = func(cluster1, cluster2 *clusterv1beta1.MemberCluster) bool {
-		return cluster1.Name < cluster2.Name
-	}
-	lessFuncScoredCluster = func(scored1, scored2 *ScoredCluster) bool {
-		return scored1.Cluster.Name < scored2.Cluster.Name
-	}
-	lessFuncFilteredCluster = func(filtered1, filtered2 *filteredClusterWithStatus) bool {
-		return filtered1.cluster.Name < filtered2.cluster.Name
-	}
 	lessFuncBinding = func(binding1, binding2 placementv1beta1.ClusterResourceBinding) bool {
 		return binding1.Name < binding2.Name
 	}
@@ -1040,19 +1034,75 @@ func TestRunFilterPlugins(t *testing.T) {
 				return
 			}
 
-			// The method runs in parallel; as a result the order cannot be guaranteed.
-			// Sort the results by cluster name for comparison.
-			if diff := cmp.Diff(passed, tc.wantClusters, cmpopts.SortSlices(lessFuncCluster)); diff != "" {
+			// The method runs in parallel; the results are still aggregated in the order of the clusters.
+			if diff := cmp.Diff(passed, tc.wantClusters); diff != "" {
 				t.Errorf("passed clusters diff (-got, +want): %s", diff)
 			}
 
-			if diff := cmp.Diff(filtered, tc.wantFiltered, cmpopts.SortSlices(lessFuncFilteredCluster), cmp.AllowUnexported(filteredClusterWithStatus{}, Status{})); diff != "" {
+			if diff := cmp.Diff(filtered, tc.wantFiltered, cmp.AllowUnexported(filteredClusterWithStatus{}, Status{})); diff != "" {
 				t.Errorf("filtered clusters diff (-got, +want): %s", diff)
 			}
 		})
 	}
 }
 
+// TestRunPluginsWithDeadlineExceeded tests that running plugins fails with an expected behavior error
+// when the deadline for running plugins in a scheduling cycle is exceeded.
+func TestRunPluginsWithDeadlineExceeded(t *testing.T) {
+	clusters := []clusterv1beta1.MemberCluster{
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterName,
+			},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: altClusterName,
+			},
+		},
+	}
+
+	// The plugin blocks until the deadline is exceeded.
+	dummyPlugin := &DummyAllPurposePlugin{
+		name: dummyPluginName,
+		filterRunner: func(ctx context.Context, _ CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj, _ *clusterv1beta1.MemberCluster) *Status {
+			<-ctx.Done()
+			return nil
+		},
+		scoreRunner: func(ctx context.Context, _ CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj, _ *clusterv1beta1.MemberCluster) (*ClusterScore, *Status) {
+			<-ctx.Done()
+			return &ClusterScore{}, nil
+		},
+	}
+	profile := NewProfile(dummyProfileName)
+	profile.WithFilterPlugin(dummyPlugin)
+	profile.WithScorePlugin(dummyPlugin)
+	f := &framework{
+		profile:                 profile,
+		parallelizer:            parallelizer.NewParallelizer(parallelizer.DefaultNumOfWorkers),
+		pluginEvaluationTimeout: time.Millisecond * 10,
+	}
+
+	state := NewCycleState([]clusterv1beta1.MemberCluster{}, []placementv1beta1.BindingObj{})
+	policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyName,
+		},
+	}
+
+	scored, filtered, err := f.runAllPluginsForPickAllPlacementType(context.Background(), state, policy, clusters)
+	if !errors.Is(err, controller.ErrExpectedBehavior) {
+		t.Fatalf("runAllPluginsForPickAllPlacementType() = %v, %v, %v, want an expected behavior error", scored, filtered, err)
+	}
+
+	pluginCtx, cancel := f.withPluginEvaluationDeadline(context.Background())
+	defer cancel()
+	scored, err = f.runScorePlugins(pluginCtx, state, policy, []*clusterv1beta1.MemberCluster{&clusters[0], &clusters[1]})
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("runScorePlugins() = %v, %v, want deadline exceeded error", scored, err)
+	}
+}
+
 // TestRunAllPluginsForPickAllPlacementType tests the runAllPluginsForPickAllPlacementType method.
 func TestRunAllPluginsForPickAllPlacementType(t *testing.T) {
 	dummyPreFilterPluginNameA := fmt.Sprintf(dummyAllPurposePluginNameFormat, 0)
@@ -1337,13 +1387,12 @@ func TestRunAllPluginsForPickAllPlacementType(t *testing.T) {
 				return
 			}
 
-			// The method runs in parallel; as a result the order cannot be guaranteed.
-			// Sort the results by cluster name for comparison.
-			if diff := cmp.Diff(scored, tc.wantScored, cmpopts.SortSlices(lessFuncScoredCluster), cmp.AllowUnexported(ScoredCluster{})); diff != "" {
+			// The method runs in parallel; the results are still aggregated in the order of the clusters.
+			if diff := cmp.Diff(scored, tc.wantScored, cmp.AllowUnexported(ScoredCluster{})); diff != "" {
 				t.Errorf("runAllPluginsForPickAllPlacementType() scored (-got, +want): %s", diff)
 			}
 
-			if diff := cmp.Diff(filtered, tc.wantFiltered, cmpopts.SortSlices(lessFuncFilteredCluster), cmp.AllowUnexported(filteredClusterWithStatus{}, Status{})); diff != "" {
+			if diff := cmp.Diff(filtered, tc.wantFiltered, cmp.AllowUnexported(filteredClusterWithStatus{}, Status{})); diff != "" {
 				t.Errorf("runAllPluginsForPickAllPlacementType() filtered (-got, +want): %s", diff)
 			}
 		})
@@ -2536,7 +2585,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 				{
 					ClusterName: anotherClusterName,
 					Selected:    false,
-					Reason:      filteredStatus.String(),
+					Reason:      fmt.Sprintf(notPickedByFilterWithDetailsTemplate, anotherClusterName, dummyPluginName, "filtered"),
 				},
 			},
 			wantCondition: newScheduledCondition(policyWithNoStatus, metav1.ConditionTrue, FullyScheduledReason, fmt.Sprintf(fullyScheduledMessage, 2)),
@@ -2696,7 +2745,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 				{
 					ClusterName: anotherClusterName,
 					Selected:    false,
-					Reason:      filteredStatus.String(),
+					Reason:      fmt.Sprintf(notPickedByFilterWithDetailsTemplate, anotherClusterName, dummyPluginName, "filtered"),
 				},
 			},
 			wantCondition: newScheduledCondition(policyWithNoStatus, metav1.ConditionTrue, FullyScheduledReason, fmt.Sprintf(fullyScheduledMessage, 1)),
@@ -2764,7 +2813,7 @@ func TestUpdatePolicySnapshotStatusFromBindings(t *testing.T) {
 				{
 					ClusterName: anotherClusterName,
 					Selected:    false,
-					Reason:      filteredStatus.String(),
+					Reason:      fmt.Sprintf(notPickedByFilterWithDetailsTemplate, anotherClusterName, dummyPluginName, "filtered"),
 				},
 			},
 			wantCondition: newScheduledCondition(policyWithNoStatus, metav1.ConditionTrue, FullyScheduledReason, fmt.Sprintf(fullyScheduledMessage, 1)),
@@ -3561,7 +3610,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 				{
 					ClusterName: altClusterName,
 					Selected:    false,
-					Reason:      filteredStatus.String(),
+					Reason:      fmt.Sprintf(notPickedByFilterWithDetailsTemplate, altClusterName, dummyPlugin, strings.Join(dummyReasons, "; ")),
 				},
 			},
 		},
@@ -3636,7 +3685,7 @@ func TestNewSchedulingDecisionsFromBindings(t *testing.T) {
 				{
 					ClusterName: anotherClusterName,
 					Selected:    false,
-					Reason:      filteredStatus.String(),
+					Reason:      fmt.Sprintf(notPickedByFilterWithDetailsTemplate, anotherClusterName, dummyPlugin, strings.Join(dummyReasons, "; ")),
 				},
 			},
 		},
@@ -5051,7 +5100,326 @@ func TestDownscale(t *testing.T) {
 	}
 }
 
-// TestRunScorePluginsFor tests the runScorePluginsFor method.
+// TestUnscheduleBindingsNotFollowingPlacementAffinity tests the unscheduleBindingsNotFollowingPlacementAffinity method.
+func TestUnscheduleBindingsNotFollowingPlacementAffinity(t *testing.T) {
+	followedPlacementName := "followed-crp"
+	clusterName1 := fmt.Sprintf(clusterNameTemplate, 1)
+	clusterName2 := fmt.Sprintf(clusterNameTemplate, 2)
+	clusterName3 := fmt.Sprintf(clusterNameTemplate, 3)
+
+	newBinding := func(idx int, cluster string, state placementv1beta1.BindingState) *placementv1beta1.ClusterResourceBinding {
+		return &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf(bindingNameTemplate, idx),
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				State:         state,
+				TargetCluster: cluster,
+			},
+		}
+	}
+	newFollowedPolicySnapshot := func(scheduledGeneration int64, decisions ...placementv1beta1.ClusterDecision) *placementv1beta1.ClusterSchedulingPolicySnapshot {
+		ps := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "followed-crp-0",
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: followedPlacementName,
+					placementv1beta1.IsLatestSnapshotLabel:  "true",
+				},
+				Generation: 2,
+			},
+			Status: placementv1beta1.SchedulingPolicySnapshotStatus{
+				ClusterDecisions: decisions,
+			},
+		}
+		if scheduledGeneration > 0 {
+			ps.Status.Conditions = []metav1.Condition{{
+				Type:               string(placementv1beta1.PolicySnapshotScheduled),
+				Status:             metav1.ConditionTrue,
+				ObservedGeneration: scheduledGeneration,
+				Reason:             FullyScheduledReason,
+			}}
+		}
+		return ps
+	}
+	decisions := []placementv1beta1.ClusterDecision{
+		{ClusterName: clusterName1, Selected: true},
+		{ClusterName: clusterName3, Selected: false},
+	}
+	followedPolicySnapshot := newFollowedPolicySnapshot(2, decisions...)
+	policyWithPlacementAffinity := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyName,
+		},
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					PlacementAffinity: &placementv1beta1.PlacementAffinity{PlacementName: followedPlacementName},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                   string
+		policy                 *placementv1beta1.ClusterSchedulingPolicySnapshot
+		followedPolicySnapshot *placementv1beta1.ClusterSchedulingPolicySnapshot
+		scheduled              []*placementv1beta1.ClusterResourceBinding
+		bound                  []*placementv1beta1.ClusterResourceBinding
+		wantScheduled          []*placementv1beta1.ClusterResourceBinding
+		wantBound              []*placementv1beta1.ClusterResourceBinding
+		wantUnscheduled        []*placementv1beta1.ClusterResourceBinding
+		wantFollowed           bool
+	}{
+		{
+			name:                   "no placement affinity",
+			followedPolicySnapshot: followedPolicySnapshot,
+			policy: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+				},
+			},
+			scheduled:     []*placementv1beta1.ClusterResourceBinding{newBinding(1, clusterName2, placementv1beta1.BindingStateScheduled)},
+			bound:         []*placementv1beta1.ClusterResourceBinding{newBinding(2, clusterName3, placementv1beta1.BindingStateBound)},
+			wantScheduled: []*placementv1beta1.ClusterResourceBinding{newBinding(1, clusterName2, placementv1beta1.BindingStateScheduled)},
+			wantBound:     []*placementv1beta1.ClusterResourceBinding{newBinding(2, clusterName3, placementv1beta1.BindingStateBound)},
+			wantFollowed:  true,
+		},
+		{
+			name:                   "bindings on clusters no longer selected by the followed placement",
+			policy:                 policyWithPlacementAffinity,
+			followedPolicySnapshot: followedPolicySnapshot,
+			scheduled: []*placementv1beta1.ClusterResourceBinding{
+				newBinding(1, clusterName1, placementv1beta1.BindingStateScheduled),
+				newBinding(2, clusterName2, placementv1beta1.BindingStateScheduled),
+			},
+			bound: []*placementv1beta1.ClusterResourceBinding{
+				newBinding(3, clusterName3, placementv1beta1.BindingStateBound),
+			},
+			wantScheduled: []*placementv1beta1.ClusterResourceBinding{newBinding(1, clusterName1, placementv1beta1.BindingStateScheduled)},
+			wantUnscheduled: []*placementv1beta1.ClusterResourceBinding{
+				newBinding(2, clusterName2, placementv1beta1.BindingStateUnscheduled),
+				newBinding(3, clusterName3, placementv1beta1.BindingStateUnscheduled),
+			},
+			wantFollowed: true,
+		},
+		{
+			name:                   "followed placement not scheduled yet",
+			policy:                 policyWithPlacementAffinity,
+			followedPolicySnapshot: newFollowedPolicySnapshot(0),
+			scheduled:              []*placementv1beta1.ClusterResourceBinding{newBinding(1, clusterName2, placementv1beta1.BindingStateScheduled)},
+			bound:                  []*placementv1beta1.ClusterResourceBinding{newBinding(2, clusterName3, placementv1beta1.BindingStateBound)},
+			wantScheduled:          []*placementv1beta1.ClusterResourceBinding{newBinding(1, clusterName2, placementv1beta1.BindingStateScheduled)},
+			wantBound:              []*placementv1beta1.ClusterResourceBinding{newBinding(2, clusterName3, placementv1beta1.BindingStateBound)},
+		},
+		{
+			name:                   "followed placement scheduled for an earlier policy generation",
+			policy:                 policyWithPlacementAffinity,
+			followedPolicySnapshot: newFollowedPolicySnapshot(1, decisions...),
+			scheduled:              []*placementv1beta1.ClusterResourceBinding{newBinding(1, clusterName2, placementv1beta1.BindingStateScheduled)},
+			bound:                  []*placementv1beta1.ClusterResourceBinding{newBinding(2, clusterName3, placementv1beta1.BindingStateBound)},
+			wantScheduled:          []*placementv1beta1.ClusterResourceBinding{newBinding(1, clusterName2, placementv1beta1.BindingStateScheduled)},
+			wantBound:              []*placementv1beta1.ClusterResourceBinding{newBinding(2, clusterName3, placementv1beta1.BindingStateBound)},
+		},
+		{
+			name:                   "followed placement selects no cluster",
+			policy:                 policyWithPlacementAffinity,
+			followedPolicySnapshot: newFollowedPolicySnapshot(2, placementv1beta1.ClusterDecision{ClusterName: clusterName1}),
+			scheduled:              []*placementv1beta1.ClusterResourceBinding{newBinding(1, clusterName2, placementv1beta1.BindingStateScheduled)},
+			bound:                  []*placementv1beta1.ClusterResourceBinding{newBinding(2, clusterName3, placementv1beta1.BindingStateBound)},
+			wantScheduled:          []*placementv1beta1.ClusterResourceBinding{newBinding(1, clusterName2, placementv1beta1.BindingStateScheduled)},
+			wantBound:              []*placementv1beta1.ClusterResourceBinding{newBinding(2, clusterName3, placementv1beta1.BindingStateBound)},
+		},
+		{
+			name:          "followed placement not found",
+			policy:        policyWithPlacementAffinity,
+			scheduled:     []*placementv1beta1.ClusterResourceBinding{newBinding(1, clusterName2, placementv1beta1.BindingStateScheduled)},
+			bound:         []*placementv1beta1.ClusterResourceBinding{newBinding(2, clusterName3, placementv1beta1.BindingStateBound)},
+			wantScheduled: []*placementv1beta1.ClusterResourceBinding{newBinding(1, clusterName2, placementv1beta1.BindingStateScheduled)},
+			wantBound:     []*placementv1beta1.ClusterResourceBinding{newBinding(2, clusterName3, placementv1beta1.BindingStateBound)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClientBuilder := fake.NewClientBuilder().WithScheme(scheme.Scheme)
+			if tc.followedPolicySnapshot != nil {
+				fakeClientBuilder.WithObjects(tc.followedPolicySnapshot)
+			}
+			for _, binding := range tc.scheduled {
+				fakeClientBuilder.WithObjects(binding)
+			}
+			for _, binding := range tc.bound {
+				fakeClientBuilder.WithObjects(binding)
+			}
+			fakeClient := fakeClientBuilder.Build()
+			// Construct framework manually instead of using NewFramework to avoid mocking the
+			// controller manager.
+			f := &framework{
+				client: fakeClient,
+			}
+
+			ctx := context.Background()
+			scheduled, bound, followed, err := f.unscheduleBindingsNotFollowingPlacementAffinity(ctx, tc.policy, controller.ConvertCRBArrayToBindingObjs(tc.scheduled), controller.ConvertCRBArrayToBindingObjs(tc.bound))
+			if err != nil {
+				t.Fatalf("unscheduleBindingsNotFollowingPlacementAffinity() = %v, want no error", err)
+			}
+			if followed != tc.wantFollowed {
+				t.Errorf("unscheduleBindingsNotFollowingPlacementAffinity() followed = %t, want %t", followed, tc.wantFollowed)
+			}
+			if diff := cmp.Diff(scheduled, controller.ConvertCRBArrayToBindingObjs(tc.wantScheduled), ignoreObjectMetaResourceVersionField, ignoreTypeMetaAPIVersionKindFields); diff != "" {
+				t.Errorf("unscheduleBindingsNotFollowingPlacementAffinity() scheduled diff (-got, +want) = %s", diff)
+			}
+			if diff := cmp.Diff(bound, controller.ConvertCRBArrayToBindingObjs(tc.wantBound), ignoreObjectMetaResourceVersionField, ignoreTypeMetaAPIVersionKindFields, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unscheduleBindingsNotFollowingPlacementAffinity() bound diff (-got, +want) = %s", diff)
+			}
+			for _, wantUnscheduledBinding := range tc.wantUnscheduled {
+				unscheduledBinding := &placementv1beta1.ClusterResourceBinding{}
+				if err := fakeClient.Get(ctx, types.NamespacedName{Name: wantUnscheduledBinding.Name}, unscheduledBinding); err != nil {
+					t.Errorf("Get() binding %s = %v, want no error", wantUnscheduledBinding.Name, err)
+				}
+				if diff := cmp.Diff(unscheduledBinding, wantUnscheduledBinding, ignoreObjectMetaResourceVersionField, ignoreObjectAnnotationField, ignoreTypeMetaAPIVersionKindFields); diff != "" {
+					t.Errorf("unscheduled binding %s diff (-got, +want): %s", wantUnscheduledBinding.Name, diff)
+				}
+			}
+		})
+	}
+}
+
+// bindingCountsDummyPlugin is a dummy plugin which reads the numbers of bindings per cluster.
+type bindingCountsDummyPlugin struct {
+	DummyAllPurposePlugin
+}
+
+// UsesBindingCounts implements the BindingCountsPlugin interface for the dummy plugin.
+func (p *bindingCountsDummyPlugin) UsesBindingCounts() bool {
+	return true
+}
+
+func TestCollectBindingCountsAndReservedResources(t *testing.T) {
+	binding := &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: bindingName,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: "other-placement",
+			},
+			Annotations: map[string]string{
+				placementv1beta1.EstimatedResourceRequestsAnnotation: `{"cpu":"2"}`,
+			},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			TargetCluster: clusterName,
+			State:         placementv1beta1.BindingStateScheduled,
+		},
+	}
+	policyWithoutResourceProperties := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyName,
+		},
+	}
+	policyWithResourceProperties := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyName,
+		},
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PreferredClusterSelector{
+							{
+								Weight: 10,
+								Preference: placementv1beta1.ClusterSelectorTerm{
+									PropertySorter: &placementv1beta1.PropertySorter{
+										Name:      "resources.kubernetes-fleet.io/available-cpu",
+										SortOrder: placementv1beta1.Descending,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name                  string
+		withBindingCounts     bool
+		policy                placementv1beta1.PolicySnapshotObj
+		listErr               error
+		wantBindingCounts     map[string]int
+		wantReservedResources map[string]corev1.ResourceList
+		wantListCalls         int
+	}{
+		{
+			name:   "no plugin reads the counts and the policy uses no resource properties",
+			policy: policyWithoutResourceProperties,
+		},
+		{
+			name:              "a plugin reads the counts",
+			withBindingCounts: true,
+			policy:            policyWithoutResourceProperties,
+			wantBindingCounts: map[string]int{clusterName: 1},
+			wantListCalls:     1,
+		},
+		{
+			name:   "the policy uses resource properties",
+			policy: policyWithResourceProperties,
+			wantReservedResources: map[string]corev1.ResourceList{
+				clusterName: {corev1.ResourceCPU: resource.MustParse("2")},
+			},
+			wantListCalls: 1,
+		},
+		{
+			name:              "failed to list the bindings",
+			withBindingCounts: true,
+			policy:            policyWithResourceProperties,
+			listErr:           errors.New("list failure"),
+			wantListCalls:     1,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			listCalls := 0
+			fakeClient := interceptor.NewClient(
+				fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(binding).Build(),
+				interceptor.Funcs{
+					List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+						listCalls++
+						if tc.listErr != nil {
+							return tc.listErr
+						}
+						return c.List(ctx, list, opts...)
+					},
+				},
+			)
+			profile := NewProfile(dummyProfileName)
+			if tc.withBindingCounts {
+				profile.WithScorePlugin(&bindingCountsDummyPlugin{DummyAllPurposePlugin{name: dummyPluginName}})
+			}
+			// Construct framework manually instead of using NewFramework to avoid mocking the
+			// controller manager.
+			f := &framework{
+				profile: profile,
+				client:  fakeClient,
+			}
+
+			gotBindingCounts, gotReservedResources := f.collectBindingCountsAndReservedResources(context.Background(), types.NamespacedName{Name: crpName}, tc.policy)
+			if diff := cmp.Diff(gotBindingCounts, tc.wantBindingCounts); diff != "" {
+				t.Errorf("collectBindingCountsAndReservedResources() binding counts diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(gotReservedResources, tc.wantReservedResources); diff != "" {
+				t.Errorf("collectBindingCountsAndReservedResources() reserved resources diff (-got, +want):\n%s", diff)
+			}
+			if listCalls != tc.wantListCalls {
+				t.Errorf("collectBindingCountsAndReservedResources() listed bindings %d times, want %d", listCalls, tc.wantListCalls)
+			}
+		})
+	}
+}
+
 func TestRunScorePluginsFor(t *testing.T) {
 	dummyScorePluginA := fmt.Sprintf(dummyAllPurposePluginNameFormat, 0)
 	dummyScorePluginB := fmt.Sprintf(dummyAllPurposePluginNameFormat, 1)
@@ -5426,9 +5794,8 @@ func TestRunScorePlugins(t *testing.T) {
 				t.Fatalf("runScorePlugins() = %v, want no error", err)
 			}
 
-			// The method runs in parallel; as a result the order cannot be guaranteed.
-			// Sort them by cluster name for easier comparison.
-			if diff := cmp.Diff(scoredClusters, tc.wantScoredClusters, cmpopts.SortSlices(lessFuncScoredCluster), cmp.AllowUnexported(ScoredCluster{})); diff != "" {
+			// The method runs in parallel; the results are still aggregated in the order of the clusters.
+			if diff := cmp.Diff(scoredClusters, tc.wantScoredClusters, cmp.AllowUnexported(ScoredCluster{})); diff != "" {
 				t.Errorf("runScorePlugins() scored clusters diff (-got, +want): %s", diff)
 			}
 		})
@@ -5664,7 +6031,13 @@ func TestCrossReferenceClustersWithTargetNames(t *testing.T) {
 	clusterName4 := fmt.Sprintf(clusterNameTemplate, 4)
 	clusterName5 := fmt.Sprintf(clusterNameTemplate, 5)
 	clusterName6 := fmt.Sprintf(clusterNameTemplate, 6)
+	clusterName7 := fmt.Sprintf(clusterNameTemplate, 7)
 
+	taint := clusterv1beta1.Taint{
+		Key:    "region",
+		Value:  "east",
+		Effect: corev1.TaintEffectNoSchedule,
+	}
 	current := []clusterv1beta1.MemberCluster{
 		{
 			ObjectMeta: metav1.ObjectMeta{
@@ -5725,14 +6098,43 @@ func TestCrossReferenceClustersWithTargetNames(t *testing.T) {
 				DeletionTimestamp: &deleteTime,
 			},
 		},
+		{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: clusterName7,
+			},
+			Spec: clusterv1beta1.MemberClusterSpec{
+				Taints: []clusterv1beta1.Taint{taint},
+			},
+			Status: clusterv1beta1.MemberClusterStatus{
+				AgentStatus: []clusterv1beta1.AgentStatus{
+					{
+						Type: clusterv1beta1.MemberAgent,
+						Conditions: []metav1.Condition{
+							{
+								Type:   string(clusterv1beta1.AgentJoined),
+								Status: metav1.ConditionTrue,
+							},
+							{
+								Type:               string(clusterv1beta1.AgentHealthy),
+								Status:             metav1.ConditionTrue,
+								LastTransitionTime: metav1.NewTime(time.Now()),
+							},
+						},
+						LastReceivedHeartbeat: metav1.NewTime(time.Now()),
+					},
+				},
+			},
+		},
 	}
 
 	testCases := []struct {
-		name         string
-		target       []string
-		wantValid    []*clusterv1beta1.MemberCluster
-		wantInvalid  []*invalidClusterWithReason
-		wantNotFound []string
+		name             string
+		target           []string
+		tolerations      []placementv1beta1.Toleration
+		scheduledOrBound map[string]bool
+		wantValid        []*clusterv1beta1.MemberCluster
+		wantInvalid      []*invalidClusterWithReason
+		wantNotFound     []string
 	}{
 		{
 			// This case normally should never occur.
@@ -5807,6 +6209,52 @@ func TestCrossReferenceClustersWithTargetNames(t *testing.T) {
 				clusterName5,
 			},
 		},
+		{
+			name: "tainted cluster without toleration",
+			target: []string{
+				clusterName7,
+			},
+			wantValid: []*clusterv1beta1.MemberCluster{},
+			wantInvalid: []*invalidClusterWithReason{
+				{
+					cluster: &current[4],
+					reason:  "cluster has taint region=east:NoSchedule that cannot be tolerated by the placement",
+				},
+			},
+			wantNotFound: []string{},
+		},
+		{
+			name: "tainted cluster with toleration",
+			target: []string{
+				clusterName7,
+			},
+			tolerations: []placementv1beta1.Toleration{
+				{
+					Key:      "region",
+					Operator: corev1.TolerationOpEqual,
+					Value:    "east",
+				},
+			},
+			wantValid: []*clusterv1beta1.MemberCluster{
+				&current[4],
+			},
+			wantInvalid:  []*invalidClusterWithReason{},
+			wantNotFound: []string{},
+		},
+		{
+			name: "tainted cluster without toleration, already scheduled",
+			target: []string{
+				clusterName7,
+			},
+			scheduledOrBound: map[string]bool{
+				clusterName7: true,
+			},
+			wantValid: []*clusterv1beta1.MemberCluster{
+				&current[4],
+			},
+			wantInvalid:  []*invalidClusterWithReason{},
+			wantNotFound: []string{},
+		},
 	}
 
 	for _, tc := range testCases {
@@ -5816,7 +6264,7 @@ func TestCrossReferenceClustersWithTargetNames(t *testing.T) {
 				clusterEligibilityChecker: clustereligibilitychecker.New(),
 			}
 
-			valid, invalid, notFound := f.crossReferenceClustersWithTargetNames(current, tc.target)
+			valid, invalid, notFound := f.crossReferenceClustersWithTargetNames(current, tc.target, tc.tolerations, tc.scheduledOrBound)
 
 			if diff := cmp.Diff(valid, tc.wantValid, cmp.AllowUnexported(invalidClusterWithReason{})); diff != "" {
 				t.Errorf("crossReferenceClustersWithTargetNames() valid diff (-got, +want): %s", diff)
@@ -6272,12 +6720,11 @@ func TestRunAllPluginsForPickNPlacementType(t *testing.T) {
 				t.Errorf("runAllPluginsForPickNPlacementType() state diff (-got, +want): %s", diff)
 			}
 
-			// The method runs in parallel; as a result the order cannot be guaranteed.
-			// Sort the results by cluster name for comparison.
-			if diff := cmp.Diff(scored, tc.wantScoredClusters, cmpopts.SortSlices(lessFuncScoredCluster)); diff != "" {
+			// The method runs in parallel; the results are still aggregated in the order of the clusters.
+			if diff := cmp.Diff(scored, tc.wantScoredClusters); diff != "" {
 				t.Errorf("runAllPluginsForPickNPlacementType() scored diff (-got, +want): %s", diff)
 			}
-			if diff := cmp.Diff(filtered, tc.wantFiltered, cmpopts.SortSlices(lessFuncFilteredCluster), cmp.AllowUnexported(filteredClusterWithStatus{}, Status{})); diff != "" {
+			if diff := cmp.Diff(filtered, tc.wantFiltered, cmp.AllowUnexported(filteredClusterWithStatus{}, Status{})); diff != "" {
 				t.Errorf("runAllPluginsForPickNPlacementType() filtered diff (-got, +want): %s", diff)
 			}
 		})
@@ -6642,7 +7089,6 @@ func TestRunSchedulingCycleForPickAllPlacementType_StableStatusOutputInLargeFlee
 	}
 	state := NewCycleState(clusters, nil, nil)
 	placementKey := queue.PlacementKey(crpName)
-	wantClusterUnschedulableReason := "ClusterUnschedulable"
 	policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
 		ObjectMeta: metav1.ObjectMeta{
 			Name: policyName,
@@ -6683,15 +7129,15 @@ func TestRunSchedulingCycleForPickAllPlacementType_StableStatusOutputInLargeFlee
 			ClusterDecisions: []placementv1beta1.ClusterDecision{
 				{
 					ClusterName: fmt.Sprintf(clusterNameTemplate, 1),
-					Reason:      wantClusterUnschedulableReason,
+					Reason:      fmt.Sprintf(notPickedByFilterReasonTemplate, fmt.Sprintf(clusterNameTemplate, 1), dummyLabelBasedFilterPluginName),
 				},
 				{
 					ClusterName: fmt.Sprintf(clusterNameTemplate, 2),
-					Reason:      wantClusterUnschedulableReason,
+					Reason:      fmt.Sprintf(notPickedByFilterReasonTemplate, fmt.Sprintf(clusterNameTemplate, 2), dummyLabelBasedFilterPluginName),
 				},
 				{
 					ClusterName: fmt.Sprintf(clusterNameTemplate, 3),
-					Reason:      wantClusterUnschedulableReason,
+					Reason:      fmt.Sprintf(notPickedByFilterReasonTemplate, fmt.Sprintf(clusterNameTemplate, 3), dummyLabelBasedFilterPluginName),
 				},
 			},
 		},
@@ -17,10 +17,15 @@ limitations under the License.
 package framework
 
 import (
+	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
 	"reflect"
 	"sort"
+	"strings"
 
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
@@ -211,6 +216,9 @@ func crossReferencePickedClustersAndDeDupBindings(
 			}
 			// Set the binding spec.
 			binding.SetBindingSpec(bindingSpec)
+			if err := setEstimatedResourceRequestsAnnotation(binding, policy); err != nil {
+				return nil, nil, nil, err
+			}
 			toCreate = append(toCreate, binding)
 		}
 	}
@@ -258,6 +266,35 @@ func generateBinding(placementKey queue.PlacementKey, clusterName string) (place
 	return binding, nil
 }
 
+// setEstimatedResourceRequestsAnnotation records the estimated resource requests of the scheduling policy
+// on the binding, so that the capacity can be reserved on the target cluster until the resources are applied.
+// The annotation is removed if the policy no longer specifies any estimated resource requests.
+func setEstimatedResourceRequestsAnnotation(binding placementv1beta1.BindingObj, policy placementv1beta1.PolicySnapshotObj) error {
+	annotations := binding.GetAnnotations()
+	var requests corev1.ResourceList
+	if p := policy.GetPolicySnapshotSpec().Policy; p != nil {
+		requests = p.EstimatedResourceRequests
+	}
+	if len(requests) == 0 {
+		if _, ok := annotations[placementv1beta1.EstimatedResourceRequestsAnnotation]; ok {
+			delete(annotations, placementv1beta1.EstimatedResourceRequestsAnnotation)
+			binding.SetAnnotations(annotations)
+		}
+		return nil
+	}
+
+	data, err := json.Marshal(requests)
+	if err != nil {
+		return controller.NewUnexpectedBehaviorError(fmt.Errorf("failed to marshal the estimated resource requests: %w", err))
+	}
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[placementv1beta1.EstimatedResourceRequestsAnnotation] = string(data)
+	binding.SetAnnotations(annotations)
+	return nil
+}
+
 func patchBindingFromScoredCluster(binding placementv1beta1.BindingObj, desiredState placementv1beta1.BindingState,
 	scored *ScoredCluster, policy placementv1beta1.PolicySnapshotObj) *bindingWithPatch {
 	// Create a deep copy of the binding
@@ -281,6 +318,10 @@ func patchBindingFromScoredCluster(binding placementv1beta1.BindingObj, desiredS
 		},
 		Reason: fmt.Sprintf(resourceScheduleSucceededWithScoreMessageFormat, scored.Cluster.Name, affinityScore, topologySpreadScore),
 	}
+	if err := setEstimatedResourceRequestsAnnotation(updated, policy); err != nil {
+		// Normally this should never happen; the binding is still patched without the capacity reservation.
+		klog.ErrorS(err, "Failed to set the estimated resource requests on the binding", "binding", klog.KObj(binding))
+	}
 
 	// Prepare the patch using safeguard to ensure no update in between.
 	patch := client.MergeFromWithOptions(binding, client.MergeFromWithOptimisticLock{})
@@ -309,6 +350,10 @@ func patchBindingFromFixedCluster(binding placementv1beta1.BindingObj, desiredSt
 		// Scoring does not apply in this placement type.
 		Reason: fmt.Sprintf(resourceScheduleSucceededMessageFormat, clusterName),
 	}
+	if err := setEstimatedResourceRequestsAnnotation(updated, policy); err != nil {
+		// Normally this should never happen; the binding is still patched without the capacity reservation.
+		klog.ErrorS(err, "Failed to set the estimated resource requests on the binding", "binding", klog.KObj(binding))
+	}
 
 	// Create patch with optimistic locking
 	patch := client.MergeFromWithOptions(binding, client.MergeFromWithOptimisticLock{})
@@ -396,13 +441,27 @@ func newSchedulingDecisionsFromBindings(
 		newDecisions = append(newDecisions, placementv1beta1.ClusterDecision{
 			ClusterName: clusterWithStatus.cluster.Name,
 			Selected:    false,
-			Reason:      clusterWithStatus.status.String(),
+			Reason:      notPickedByFilterReason(clusterWithStatus),
 		})
 	}
 
 	return newDecisions
 }
 
+// notPickedByFilterReason returns the reason to use in the scheduling decision for a cluster that
+// has been filtered out, which names the filter plugin that rejects the cluster along with the
+// reasons it gives, so that users can tell why a cluster is not selected without inspecting the
+// scheduler logs.
+func notPickedByFilterReason(clusterWithStatus *filteredClusterWithStatus) string {
+	clusterName := clusterWithStatus.cluster.Name
+	status := clusterWithStatus.status
+	reasons := status.Reasons()
+	if len(reasons) == 0 {
+		return fmt.Sprintf(notPickedByFilterReasonTemplate, clusterName, status.SourcePlugin())
+	}
+	return fmt.Sprintf(notPickedByFilterWithDetailsTemplate, clusterName, status.SourcePlugin(), strings.Join(reasons, "; "))
+}
+
 // newSchedulingCondition returns a new scheduling condition.
 func newScheduledCondition(policy placementv1beta1.PolicySnapshotObj, status metav1.ConditionStatus, reason, message string) metav1.Condition {
 	return metav1.Condition{
@@ -571,6 +630,32 @@ func shouldSchedule(desiredCount, existingCount int) bool {
 	return desiredCount > existingCount
 }
 
+// excludeClustersWithBindings returns the clusters that are not associated with any of the given
+// bindings.
+//
+// For scheduling policies of the PickN type, the scheduler keeps the existing bound and scheduled
+// bindings when the number of clusters grows, and only schedules the delta among the remaining clusters;
+// this avoids running the plugins on clusters that have been selected already.
+func excludeClustersWithBindings(clusters []clusterv1beta1.MemberCluster, bindings ...[]placementv1beta1.BindingObj) []clusterv1beta1.MemberCluster {
+	selected := make(map[string]bool)
+	for _, bindingSet := range bindings {
+		for _, binding := range bindingSet {
+			selected[binding.GetBindingSpec().TargetCluster] = true
+		}
+	}
+	if len(selected) == 0 {
+		return clusters
+	}
+
+	remaining := make([]clusterv1beta1.MemberCluster, 0, len(clusters))
+	for idx := range clusters {
+		if !selected[clusters[idx].Name] {
+			remaining = append(remaining, clusters[idx])
+		}
+	}
+	return remaining
+}
+
 // calcNumOfClustersToSelect calculates the number of clusters to select in a scheduling run; it
 // essentially returns the minimum among the desired number of clusters, the batch size limit,
 // and the number of scored clusters.
@@ -757,6 +842,9 @@ func crossReferenceValidTargetsWithBindings(
 				},
 			}
 			binding.SetBindingSpec(spec)
+			if err := setEstimatedResourceRequestsAnnotation(binding, policy); err != nil {
+				return nil, nil, nil, err
+			}
 			toCreate = append(toCreate, binding)
 		}
 	}
@@ -771,3 +859,21 @@ func crossReferenceValidTargetsWithBindings(
 
 	return toCreate, toDelete, toPatch, nil
 }
+
+// withPluginEvaluationDeadline returns a child context that is done when the deadline for running
+// the filter and score plugins in a scheduling cycle is exceeded, if such a deadline is set.
+func (f *framework) withPluginEvaluationDeadline(ctx context.Context) (context.Context, context.CancelFunc) {
+	if f.pluginEvaluationTimeout <= 0 {
+		return context.WithCancel(ctx)
+	}
+	return context.WithTimeout(ctx, f.pluginEvaluationTimeout)
+}
+
+// newPluginRunError wraps an error that has occurred when running plugins across clusters; exceeding
+// the deadline for running plugins is an expected behavior, as the scheduling cycle will be retried.
+func newPluginRunError(err error) error {
+	if errors.Is(err, context.DeadlineExceeded) {
+		return controller.NewExpectedBehaviorError(err)
+	}
+	return controller.NewUnexpectedBehaviorError(err)
+}
@@ -20,6 +20,12 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
 )
@@ -165,3 +171,176 @@ func TestGenerateBinding(t *testing.T) {
 		})
 	}
 }
+
+// TestNotPickedByFilterReason tests the notPickedByFilterReason function.
+func TestNotPickedByFilterReason(t *testing.T) {
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+	}
+
+	testCases := []struct {
+		name       string
+		status     *Status
+		wantReason string
+	}{
+		{
+			name:       "no reasons",
+			status:     NewNonErrorStatus(ClusterUnschedulable, dummyPlugin),
+			wantReason: "Cluster \"bravelion\" is filtered out by the dummyPlugin plugin",
+		},
+		{
+			name:       "single reason",
+			status:     NewNonErrorStatus(ClusterUnschedulable, dummyPlugin, "cluster does not match with any of the required cluster affinity terms"),
+			wantReason: "Cluster \"bravelion\" is filtered out by the dummyPlugin plugin: cluster does not match with any of the required cluster affinity terms",
+		},
+		{
+			name:       "multiple reasons",
+			status:     NewNonErrorStatus(ClusterUnschedulable, dummyPlugin, dummyReasons...),
+			wantReason: "Cluster \"bravelion\" is filtered out by the dummyPlugin plugin: reason1; reason2",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			reason := notPickedByFilterReason(&filteredClusterWithStatus{
+				cluster: cluster,
+				status:  tc.status,
+			})
+			if reason != tc.wantReason {
+				t.Errorf("notPickedByFilterReason() = %q, want %q", reason, tc.wantReason)
+			}
+		})
+	}
+}
+
+func TestSetEstimatedResourceRequestsAnnotation(t *testing.T) {
+	policyWithRequests := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickNPlacementType,
+				EstimatedResourceRequests: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+			},
+		},
+	}
+	policyWithoutRequests := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+			Policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickNPlacementType,
+			},
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		annotations     map[string]string
+		policy          placementv1beta1.PolicySnapshotObj
+		wantAnnotations map[string]string
+	}{
+		{
+			name:   "set the annotation",
+			policy: policyWithRequests,
+			wantAnnotations: map[string]string{
+				placementv1beta1.EstimatedResourceRequestsAnnotation: `{"cpu":"2","memory":"4Gi"}`,
+			},
+		},
+		{
+			name: "overwrite the annotation",
+			annotations: map[string]string{
+				"foo": "bar",
+				placementv1beta1.EstimatedResourceRequestsAnnotation: `{"cpu":"1"}`,
+			},
+			policy: policyWithRequests,
+			wantAnnotations: map[string]string{
+				"foo": "bar",
+				placementv1beta1.EstimatedResourceRequestsAnnotation: `{"cpu":"2","memory":"4Gi"}`,
+			},
+		},
+		{
+			name: "remove the annotation",
+			annotations: map[string]string{
+				"foo": "bar",
+				placementv1beta1.EstimatedResourceRequestsAnnotation: `{"cpu":"1"}`,
+			},
+			policy: policyWithoutRequests,
+			wantAnnotations: map[string]string{
+				"foo": "bar",
+			},
+		},
+		{
+			name:   "no policy",
+			policy: &placementv1beta1.ClusterSchedulingPolicySnapshot{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			binding := &placementv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        bindingName,
+					Annotations: tc.annotations,
+				},
+			}
+			if err := setEstimatedResourceRequestsAnnotation(binding, tc.policy); err != nil {
+				t.Fatalf("setEstimatedResourceRequestsAnnotation() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(binding.GetAnnotations(), tc.wantAnnotations); diff != "" {
+				t.Errorf("setEstimatedResourceRequestsAnnotation() annotations diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
+
+// TestExcludeClustersWithBindings tests the excludeClustersWithBindings function.
+func TestExcludeClustersWithBindings(t *testing.T) {
+	clusters := []clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: "cluster-1"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "cluster-2"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "cluster-3"}},
+	}
+	bindingFor := func(clusterName string) placementv1beta1.BindingObj {
+		return &placementv1beta1.ClusterResourceBinding{
+			Spec: placementv1beta1.ResourceBindingSpec{TargetCluster: clusterName},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		bound     []placementv1beta1.BindingObj
+		scheduled []placementv1beta1.BindingObj
+		want      []string
+	}{
+		{
+			name: "no bindings",
+			want: []string{"cluster-1", "cluster-2", "cluster-3"},
+		},
+		{
+			name:      "bound and scheduled bindings",
+			bound:     []placementv1beta1.BindingObj{bindingFor("cluster-1")},
+			scheduled: []placementv1beta1.BindingObj{bindingFor("cluster-3")},
+			want:      []string{"cluster-2"},
+		},
+		{
+			name:  "all clusters selected",
+			bound: []placementv1beta1.BindingObj{bindingFor("cluster-1"), bindingFor("cluster-2"), bindingFor("cluster-3")},
+			want:  []string{},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			remaining := excludeClustersWithBindings(clusters, tc.bound, tc.scheduled)
+			got := make([]string, 0, len(remaining))
+			for _, cluster := range remaining {
+				got = append(got, cluster.Name)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("excludeClustersWithBindings() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
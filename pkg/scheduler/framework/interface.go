@@ -92,6 +92,17 @@ type PreScorePlugin interface {
 	PreScore(ctx context.Context, state CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj) (status *Status)
 }
 
+// BindingCountsPlugin is the interface which all plugins that read the numbers of bindings
+// per cluster (see CycleStatePluginReadWriter.BindingCountFor) should implement; the scheduler
+// framework counts the bindings across the fleet in a scheduling cycle only if at least one such
+// plugin is registered.
+type BindingCountsPlugin interface {
+	Plugin
+
+	// UsesBindingCounts returns true if the plugin reads the numbers of bindings per cluster.
+	UsesBindingCounts() bool
+}
+
 // ScorePlugin is the interface which all plugins that would like to run at the Score
 // extension point should implement.
 type ScorePlugin interface {
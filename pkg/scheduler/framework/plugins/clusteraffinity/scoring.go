@@ -20,6 +20,8 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/klog/v2"
+
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
@@ -74,17 +76,28 @@ func (p *Plugin) Score(
 	}
 
 	score = &framework.ClusterScore{}
-	for _, t := range policy.GetPolicySnapshotSpec().Policy.Affinity.ClusterAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
-		if t.Weight != 0 {
-			cp := clusterPreference(t)
+	for idx, t := range policy.GetPolicySnapshotSpec().Policy.Affinity.ClusterAffinity.PreferredDuringSchedulingIgnoredDuringExecution {
+		// Score the cluster with the normalized weight of the term.
+		//
+		// Note that the weights are normalized in the PreScore stage; the plugin state is always
+		// prepared from the same policy snapshot.
+		cp := clusterPreference(t)
+		cp.Weight = ps.normalizedWeights[idx]
+		if cp.Weight != 0 {
 			ts, err := cp.Scores(ps, cluster)
 			if err != nil {
 				return nil, framework.FromError(fmt.Errorf("failed to calculate score for cluster %s: %w", cluster.Name, err), p.Name())
 			}
 			// Multiple preferred affinity terms are OR'd.
 			score.AffinityScore += ts
+			// Trace how each term contributes to the score, so that users can find out why a cluster
+			// is preferred over another.
+			klog.V(4).InfoS("Preferred cluster affinity term contributed to the cluster score",
+				"policySnapshot", klog.KObj(policy), "memberCluster", klog.KObj(cluster), "termIndex", idx,
+				"weight", t.Weight, "normalizedWeight", cp.Weight, "termScore", ts)
 		}
 	}
+	klog.V(4).InfoS("Calculated the affinity score of the cluster", "policySnapshot", klog.KObj(policy), "memberCluster", klog.KObj(cluster), "affinityScore", score.AffinityScore)
 
 	// All done.
 	return score, nil
@@ -122,6 +122,7 @@ func TestPreScore(t *testing.T) {
 			},
 			wantPS: &pluginState{
 				minMaxValuesByProperty: map[string]observedMinMaxValues{},
+				normalizedWeights:      []int32{100},
 			},
 		},
 		{
@@ -168,6 +169,7 @@ func TestPreScore(t *testing.T) {
 						max: ptr.To(resource.MustParse("10")),
 					},
 				},
+				normalizedWeights: []int32{100},
 			},
 		},
 		{
@@ -267,6 +269,7 @@ func TestPreScore(t *testing.T) {
 						max: ptr.To(resource.MustParse("12")),
 					},
 				},
+				normalizedWeights: []int32{100, 100},
 			},
 		},
 	}
@@ -302,6 +305,43 @@ func TestPreScore(t *testing.T) {
 	}
 }
 
+// TestNormalizeWeights tests the normalizeWeights function.
+func TestNormalizeWeights(t *testing.T) {
+	testCases := []struct {
+		name    string
+		weights []int32
+		want    []int32
+	}{
+		{
+			name:    "weights within range",
+			weights: []int32{100, -50, 0, 1},
+			want:    []int32{100, -50, 0, 1},
+		},
+		{
+			name:    "weights beyond range",
+			weights: []int32{1000, -250, 0, 1, -2},
+			want:    []int32{100, -25, 0, 1, -1},
+		},
+		{
+			name:    "negative weights beyond range",
+			weights: []int32{-1000, 500, 333},
+			want:    []int32{-100, 50, 33},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			terms := make([]placementv1beta1.PreferredClusterSelector, 0, len(tc.weights))
+			for _, w := range tc.weights {
+				terms = append(terms, placementv1beta1.PreferredClusterSelector{Weight: w})
+			}
+			if diff := cmp.Diff(normalizeWeights(terms), tc.want); diff != "" {
+				t.Errorf("normalizeWeights() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
 // TestPluginScore tests the Score extension point of this plugin.
 func TestPluginScore(t *testing.T) {
 	testCases := []struct {
@@ -314,7 +354,9 @@ func TestPluginScore(t *testing.T) {
 	}{
 		{
 			name: "single preferred term which features only label selector, matched",
-			ps:   &pluginState{},
+			ps: &pluginState{
+				normalizedWeights: []int32{50},
+			},
 			policy: &placementv1beta1.ClusterSchedulingPolicySnapshot{
 				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
 					Policy: &placementv1beta1.PlacementPolicy{
@@ -351,7 +393,9 @@ func TestPluginScore(t *testing.T) {
 		},
 		{
 			name: "single preferred term which features only label selector, not matched",
-			ps:   &pluginState{},
+			ps: &pluginState{
+				normalizedWeights: []int32{50},
+			},
 			policy: &placementv1beta1.ClusterSchedulingPolicySnapshot{
 				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
 					Policy: &placementv1beta1.PlacementPolicy{
@@ -399,6 +443,7 @@ func TestPluginScore(t *testing.T) {
 						max: ptr.To(resource.MustParse("20")),
 					},
 				},
+				normalizedWeights: []int32{50},
 			},
 			policy: &placementv1beta1.ClusterSchedulingPolicySnapshot{
 				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
@@ -447,6 +492,7 @@ func TestPluginScore(t *testing.T) {
 						max: ptr.To(resource.MustParse("20")),
 					},
 				},
+				normalizedWeights: []int32{50},
 			},
 			policy: &placementv1beta1.ClusterSchedulingPolicySnapshot{
 				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
@@ -503,6 +549,7 @@ func TestPluginScore(t *testing.T) {
 						max: ptr.To(resource.MustParse("20")),
 					},
 				},
+				normalizedWeights: []int32{50},
 			},
 			policy: &placementv1beta1.ClusterSchedulingPolicySnapshot{
 				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
@@ -559,6 +606,7 @@ func TestPluginScore(t *testing.T) {
 						max: ptr.To(resource.MustParse("25")),
 					},
 				},
+				normalizedWeights: []int32{50, 20},
 			},
 			policy: &placementv1beta1.ClusterSchedulingPolicySnapshot{
 				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
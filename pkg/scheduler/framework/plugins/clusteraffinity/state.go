@@ -17,11 +17,20 @@ limitations under the License.
 package clusteraffinity
 
 import (
+	"math"
+
 	"k8s.io/apimachinery/pkg/api/resource"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/propertyselector"
+)
+
+const (
+	// maxNormalizedWeight is the largest absolute weight of a preferred cluster affinity term
+	// after normalization.
+	maxNormalizedWeight = 100
 )
 
 type observedMinMaxValues struct {
@@ -31,20 +40,61 @@ type observedMinMaxValues struct {
 
 type pluginState struct {
 	minMaxValuesByProperty map[string]observedMinMaxValues
+
+	// normalizedWeights are the weights of the preferred cluster affinity terms, indexed in the
+	// same way as the terms, after normalization.
+	normalizedWeights []int32
+}
+
+// normalizeWeights returns the weights of the preferred cluster affinity terms after normalization.
+//
+// If the absolute value of any weight exceeds maxNormalizedWeight, all the weights are scaled down
+// proportionally, so that the largest absolute weight becomes maxNormalizedWeight; this keeps the
+// affinity scores on the same scale regardless of the range of weights users pick. A non-zero
+// weight is never scaled down to zero, so that no term is dropped silently.
+func normalizeWeights(terms []placementv1beta1.PreferredClusterSelector) []int32 {
+	var maxAbsWeight int64
+	for _, t := range terms {
+		w := int64(t.Weight)
+		if w < 0 {
+			w = -w
+		}
+		if w > maxAbsWeight {
+			maxAbsWeight = w
+		}
+	}
+
+	weights := make([]int32, len(terms))
+	for idx, t := range terms {
+		if maxAbsWeight <= maxNormalizedWeight {
+			weights[idx] = t.Weight
+			continue
+		}
+		w := int32(math.Round(float64(t.Weight) * maxNormalizedWeight / float64(maxAbsWeight)))
+		switch {
+		case w == 0 && t.Weight > 0:
+			w = 1
+		case w == 0 && t.Weight < 0:
+			w = -1
+		}
+		weights[idx] = w
+	}
+	return weights
 }
 
 // preparePluginState prepares a common state for easier queries of min. and max.
 // observed values of properties (if applicable).
 func preparePluginState(state framework.CycleStatePluginReadWriter, policy placementv1beta1.PolicySnapshotObj) (*pluginState, error) {
+	clusterAffnity := policy.GetPolicySnapshotSpec().Policy.Affinity.ClusterAffinity
 	ps := &pluginState{
 		minMaxValuesByProperty: make(map[string]observedMinMaxValues),
+		normalizedWeights:      normalizeWeights(clusterAffnity.PreferredDuringSchedulingIgnoredDuringExecution),
 	}
 
 	// Note that this function assumes that the scheduling policy must have at least one
 	// enforceable preferred cluster affinity term, as guaranteed by its caller.
 
 	var cs []clusterv1beta1.MemberCluster
-	clusterAffnity := policy.GetPolicySnapshotSpec().Policy.Affinity.ClusterAffinity
 	for tidx := range clusterAffnity.PreferredDuringSchedulingIgnoredDuringExecution {
 		t := &clusterAffnity.PreferredDuringSchedulingIgnoredDuringExecution[tidx]
 		if t.Preference.PropertySorter != nil {
@@ -59,7 +109,7 @@ func preparePluginState(state framework.CycleStatePluginReadWriter, policy place
 
 			for cidx := range cs {
 				c := &cs[cidx]
-				q, err := retrievePropertyValueFrom(c, n)
+				q, err := propertyselector.RetrievePropertyValueFrom(c, n)
 				if err != nil {
 					// An error has occurred when retrieving the property value from the cluster.
 					//
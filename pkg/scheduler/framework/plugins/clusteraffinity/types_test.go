@@ -21,7 +21,6 @@ import (
 	"math"
 	"testing"
 
-	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -37,192 +36,6 @@ const (
 	invalidNonResourcePropertyName     = "invalid-non-resource-property"
 )
 
-// TestRetrieveResourceUsageFrom tests the retrieveResourceUsageFrom function.
-func TestRetrieveResourceUsageFrom(t *testing.T) {
-	cluster := &clusterv1beta1.MemberCluster{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: clusterName1,
-		},
-		Status: clusterv1beta1.MemberClusterStatus{
-			ResourceUsage: clusterv1beta1.ResourceUsage{
-				Capacity: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("10"),
-					corev1.ResourceMemory: resource.MustParse("40Gi"),
-				},
-				Allocatable: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("8"),
-					corev1.ResourceMemory: resource.MustParse("36Gi"),
-				},
-				Available: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("2"),
-					corev1.ResourceMemory: resource.MustParse("4Gi"),
-				},
-			},
-		},
-	}
-
-	testCases := []struct {
-		name           string
-		cluster        *clusterv1beta1.MemberCluster
-		propertyName   string
-		wantQuantity   *resource.Quantity
-		expectedToFail bool
-	}{
-		{
-			name:           "invalid property name (multiple segments)",
-			propertyName:   "resources.kubernetes-fleet.io/allocatable-cpu",
-			expectedToFail: true,
-		},
-		{
-			name:           "invalid property name (no capacity type)",
-			propertyName:   "-cpu",
-			expectedToFail: true,
-		},
-		{
-			name:           "invalid property name (no resource name)",
-			propertyName:   "allocatable-",
-			expectedToFail: true,
-		},
-		{
-			name:           "invalid property name (not a known capacity type)",
-			propertyName:   "additional-",
-			expectedToFail: true,
-		},
-		{
-			name:         "resource not available",
-			propertyName: "allocatable-gpu",
-			cluster:      cluster,
-		},
-		{
-			name:         "total capacity usage",
-			propertyName: "total-cpu",
-			cluster:      cluster,
-			wantQuantity: ptr.To(resource.MustParse("10")),
-		},
-		{
-			name:         "allocatable capacity usage",
-			propertyName: "allocatable-memory",
-			cluster:      cluster,
-			wantQuantity: ptr.To(resource.MustParse("36Gi")),
-		},
-		{
-			name:         "available capacity usage",
-			propertyName: "available-cpu",
-			cluster:      cluster,
-			wantQuantity: ptr.To(resource.MustParse("2")),
-		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			q, err := retrieveResourceUsageFrom(tc.cluster, tc.propertyName)
-			if tc.expectedToFail {
-				if err == nil {
-					t.Errorf("retrieveResourceUsageFrom(), want error, got nil")
-				}
-				return
-			}
-
-			if err != nil {
-				t.Errorf("retrieveResourceUsageFrom() = %v, want nil", err)
-			}
-			if diff := cmp.Diff(q, tc.wantQuantity); diff != "" {
-				t.Errorf("retrieveResourceUsageFrom() quantity diff (-got, +want): %s\n", diff)
-			}
-		})
-	}
-}
-
-// TestRetrievePropertyValueFrom tests the retrievePropertyValueFrom function.
-func TestRetrievePropertyValueFrom(t *testing.T) {
-	cluster := &clusterv1beta1.MemberCluster{
-		ObjectMeta: metav1.ObjectMeta{
-			Name: clusterName1,
-		},
-		Status: clusterv1beta1.MemberClusterStatus{
-			ResourceUsage: clusterv1beta1.ResourceUsage{
-				Capacity: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("10"),
-					corev1.ResourceMemory: resource.MustParse("40Gi"),
-				},
-				Allocatable: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("8"),
-					corev1.ResourceMemory: resource.MustParse("36Gi"),
-				},
-				Available: corev1.ResourceList{
-					corev1.ResourceCPU:    resource.MustParse("2"),
-					corev1.ResourceMemory: resource.MustParse("4Gi"),
-				},
-			},
-			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
-				propertyprovider.NodeCountProperty: {
-					Value: "4",
-				},
-				invalidNonResourcePropertyName: {
-					Value: "invalid",
-				},
-			},
-		},
-	}
-
-	testCases := []struct {
-		name           string
-		cluster        *clusterv1beta1.MemberCluster
-		propertyName   string
-		wantQuantity   *resource.Quantity
-		expectedToFail bool
-	}{
-		{
-			name:           "invalid resource property (name format error)",
-			propertyName:   "resources.kubernetes-fleet.io/allocatable",
-			cluster:        cluster,
-			expectedToFail: true,
-		},
-		{
-			name:         "resource property retrieval",
-			propertyName: propertyprovider.AvailableMemoryCapacityProperty,
-			cluster:      cluster,
-			wantQuantity: ptr.To(resource.MustParse("4Gi")),
-		},
-		{
-			name:         "absent non-resource property",
-			propertyName: nonExistentNonResourcePropertyName,
-			cluster:      cluster,
-		},
-		{
-			name:           "invalid non-resource property (value format error)",
-			propertyName:   invalidNonResourcePropertyName,
-			cluster:        cluster,
-			expectedToFail: true,
-		},
-		{
-			name:         "non-resource property retrieval",
-			propertyName: propertyprovider.NodeCountProperty,
-			wantQuantity: ptr.To(resource.MustParse("4")),
-			cluster:      cluster,
-		},
-	}
-
-	for _, tc := range testCases {
-		t.Run(tc.name, func(t *testing.T) {
-			q, err := retrievePropertyValueFrom(tc.cluster, tc.propertyName)
-			if tc.expectedToFail {
-				if err == nil {
-					t.Errorf("retrievePropertyValueFrom(), want error, got nil")
-				}
-				return
-			}
-
-			if err != nil {
-				t.Errorf("retrievePropertyValueFrom() = %v, want nil", err)
-			}
-			if diff := cmp.Diff(q, tc.wantQuantity); diff != "" {
-				t.Errorf("retrievePropertyValueFrom() quantity diff (-got, +want): %s\n", diff)
-			}
-		})
-	}
-}
-
 // TestClusterRequirementMatches tests the Matches method on clusterRequirement pointers.
 func TestClusterRequirementMatches(t *testing.T) {
 	cluster := &clusterv1beta1.MemberCluster{
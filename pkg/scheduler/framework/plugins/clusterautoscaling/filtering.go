@@ -0,0 +1,54 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterautoscaling
+
+import (
+	"context"
+
+	"k8s.io/klog/v2"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+const (
+	scaleDownInProgressReason = "cluster is scaling down"
+)
+
+// Filter allows the plugin to connect to the Filter extension point in the scheduling framework.
+func (p *Plugin) Filter(
+	_ context.Context,
+	state framework.CycleStatePluginReadWriter,
+	policy placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+) (status *framework.Status) {
+	if state.HasScheduledOrBoundBindingFor(cluster.Name) {
+		// Do not filter out clusters that the placement has already been scheduled to or bound to;
+		// the scale down is expected to be transient and the placement should not flap.
+		return nil
+	}
+
+	if !positivePropertyValue(cluster, propertyprovider.ScaleDownInProgressProperty) {
+		// All done.
+		return nil
+	}
+
+	klog.V(2).InfoS("Cluster is unschedulable, because it is scaling down", "policySnapshot", klog.KObj(policy), "memberCluster", klog.KObj(cluster))
+	return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), scaleDownInProgressReason)
+}
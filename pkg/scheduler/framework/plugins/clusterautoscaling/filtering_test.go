@@ -0,0 +1,122 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterautoscaling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+const (
+	clusterName = "cluster-1"
+	policyName  = "policy-1"
+)
+
+var (
+	p = New()
+
+	ignoreStatusErrorField = cmpopts.IgnoreFields(framework.Status{}, "err")
+
+	policy = &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: policyName},
+	}
+)
+
+// clusterWithProperties returns a member cluster that reports the given properties.
+func clusterWithProperties(properties map[clusterv1beta1.PropertyName]string) *clusterv1beta1.MemberCluster {
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterName},
+	}
+	if len(properties) > 0 {
+		cluster.Status.Properties = map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{}
+		for name, value := range properties {
+			cluster.Status.Properties[name] = clusterv1beta1.PropertyValue{Value: value}
+		}
+	}
+	return cluster
+}
+
+// TestFilter tests the Filter extension point of the plugin.
+func TestFilter(t *testing.T) {
+	testCases := []struct {
+		name                string
+		cluster             *clusterv1beta1.MemberCluster
+		hasScheduledBinding bool
+		wantStatus          *framework.Status
+	}{
+		{
+			name:    "no autoscaling properties",
+			cluster: clusterWithProperties(nil),
+		},
+		{
+			name: "not scaling down",
+			cluster: clusterWithProperties(map[clusterv1beta1.PropertyName]string{
+				propertyprovider.ScaleDownInProgressProperty: "0",
+			}),
+		},
+		{
+			name: "invalid property value",
+			cluster: clusterWithProperties(map[clusterv1beta1.PropertyName]string{
+				propertyprovider.ScaleDownInProgressProperty: "yes",
+			}),
+		},
+		{
+			name: "scaling down",
+			cluster: clusterWithProperties(map[clusterv1beta1.PropertyName]string{
+				propertyprovider.ScaleDownInProgressProperty: "1",
+			}),
+			wantStatus: framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(), scaleDownInProgressReason),
+		},
+		{
+			name: "scaling down, with a scheduled binding",
+			cluster: clusterWithProperties(map[clusterv1beta1.PropertyName]string{
+				propertyprovider.ScaleDownInProgressProperty: "1",
+			}),
+			hasScheduledBinding: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var scheduledOrBoundBindings []placementv1beta1.BindingObj
+			if tc.hasScheduledBinding {
+				scheduledOrBoundBindings = append(scheduledOrBoundBindings, &placementv1beta1.ClusterResourceBinding{
+					ObjectMeta: metav1.ObjectMeta{Name: "binding-1"},
+					Spec: placementv1beta1.ResourceBindingSpec{
+						State:         placementv1beta1.BindingStateScheduled,
+						TargetCluster: clusterName,
+					},
+				})
+			}
+			state := framework.NewCycleState([]clusterv1beta1.MemberCluster{*tc.cluster}, nil, scheduledOrBoundBindings)
+
+			status := p.Filter(context.Background(), state, policy, tc.cluster)
+			if diff := cmp.Diff(status, tc.wantStatus, cmp.AllowUnexported(framework.Status{}), ignoreStatusErrorField); diff != "" {
+				t.Errorf("Filter() status diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
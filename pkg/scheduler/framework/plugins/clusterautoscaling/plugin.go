@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusterautoscaling features a scheduler plugin that takes the cluster autoscaling signals
+// reported by member clusters into account, so as to reduce placement flapping during autoscaling
+// events: it avoids clusters that are scaling down and prefers clusters with pending capacity expansion.
+package clusterautoscaling
+
+import (
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// Plugin is the scheduler plugin that checks the cluster autoscaling signals of member clusters.
+type Plugin struct {
+	// The name of the plugin.
+	name string
+
+	// The framework handle.
+	handle framework.Handle
+}
+
+var (
+	// Verify that Plugin can connect to relevant extension points at compile time.
+	//
+	// This plugin leverages the following the extension points:
+	// * Filter
+	// * Score
+	//
+	// Note that successful connection to any of the extension points implies that the
+	// plugin already implements the Plugin interface.
+	_ framework.FilterPlugin = &Plugin{}
+	_ framework.ScorePlugin  = &Plugin{}
+)
+
+type clusterAutoscalingPluginOptions struct {
+	// The name of the plugin.
+	name string
+}
+
+type Option func(*clusterAutoscalingPluginOptions)
+
+var defaultPluginOptions = clusterAutoscalingPluginOptions{
+	name: "ClusterAutoscaling",
+}
+
+// WithName sets the name of the plugin.
+func WithName(name string) Option {
+	return func(o *clusterAutoscalingPluginOptions) {
+		o.name = name
+	}
+}
+
+// New returns a new Plugin.
+func New(opts ...Option) Plugin {
+	options := defaultPluginOptions
+	for _, opt := range opts {
+		opt(&options)
+	}
+
+	return Plugin{
+		name: options.name,
+	}
+}
+
+// Name returns the name of the plugin.
+func (p *Plugin) Name() string {
+	return p.name
+}
+
+// SetUpWithFramework sets up this plugin with a scheduler framework.
+func (p *Plugin) SetUpWithFramework(handle framework.Handle) {
+	p.handle = handle
+}
+
+// positivePropertyValue returns whether a cluster reports a property of the given name with a
+// value greater than 0.
+//
+// Properties that are absent or cannot be parsed are treated as if they were 0, as the autoscaling
+// signals are advisory only.
+func positivePropertyValue(cluster *clusterv1beta1.MemberCluster, name clusterv1beta1.PropertyName) bool {
+	pv, ok := cluster.Status.Properties[name]
+	if !ok {
+		return false
+	}
+	q, err := resource.ParseQuantity(pv.Value)
+	if err != nil {
+		return false
+	}
+	return q.Sign() > 0
+}
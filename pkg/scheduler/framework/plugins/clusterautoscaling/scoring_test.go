@@ -0,0 +1,71 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterautoscaling
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// TestScore tests the Score extension point of the plugin.
+func TestScore(t *testing.T) {
+	testCases := []struct {
+		name      string
+		cluster   *clusterv1beta1.MemberCluster
+		wantScore *framework.ClusterScore
+	}{
+		{
+			name:      "no autoscaling properties",
+			cluster:   clusterWithProperties(nil),
+			wantScore: &framework.ClusterScore{},
+		},
+		{
+			name: "no provisioning headroom",
+			cluster: clusterWithProperties(map[clusterv1beta1.PropertyName]string{
+				propertyprovider.ProvisioningHeadroomProperty: "0",
+			}),
+			wantScore: &framework.ClusterScore{},
+		},
+		{
+			name: "with provisioning headroom",
+			cluster: clusterWithProperties(map[clusterv1beta1.PropertyName]string{
+				propertyprovider.ProvisioningHeadroomProperty: "3",
+			}),
+			wantScore: &framework.ClusterScore{AutoscalingScore: 1},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := framework.NewCycleState([]clusterv1beta1.MemberCluster{*tc.cluster}, nil)
+
+			score, status := p.Score(context.Background(), state, policy, tc.cluster)
+			if !status.IsSuccess() {
+				t.Fatalf("Score() status = %v, want success", status)
+			}
+			if diff := cmp.Diff(score, tc.wantScore); diff != "" {
+				t.Errorf("Score() score diff (-got, +want): %s", diff)
+			}
+		})
+	}
+}
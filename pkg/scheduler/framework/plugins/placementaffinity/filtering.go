@@ -0,0 +1,99 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementaffinity
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// pluginState is the state the plugin prepares in the PreFilter stage.
+type pluginState struct {
+	// anchorPlacementName is the name of the placement to follow.
+	anchorPlacementName string
+
+	// selectedClusters is the set of clusters currently selected by the placement to follow.
+	selectedClusters map[string]bool
+}
+
+// PreFilter allows the plugin to connect to the PreFilter extension point in the scheduling framework.
+func (p *Plugin) PreFilter(
+	ctx context.Context,
+	state framework.CycleStatePluginReadWriter,
+	ps placementv1beta1.PolicySnapshotObj,
+) (status *framework.Status) {
+	policy := ps.GetPolicySnapshotSpec().Policy
+	if policy == nil || policy.Affinity == nil || policy.Affinity.PlacementAffinity == nil {
+		// There is no placement affinity to enforce; consider all clusters eligible for resource
+		// placement in the scope of this plugin.
+		//
+		// Note that this will set the cluster to skip the Filter stage for all clusters.
+		return framework.NewNonErrorStatus(framework.Skip, p.Name(), "no placement affinity to enforce")
+	}
+
+	// The placement to follow always lives in the same scope as the placement itself, i.e., a
+	// ClusterResourcePlacement can only follow another ClusterResourcePlacement, and a ResourcePlacement
+	// can only follow another ResourcePlacement in the same namespace.
+	anchorKey := types.NamespacedName{Namespace: ps.GetNamespace(), Name: policy.Affinity.PlacementAffinity.PlacementName}
+	selectedClusters, scheduled, err := controller.FetchSelectedClusters(ctx, p.handle.Client(), anchorKey)
+	if err != nil {
+		return framework.FromError(err, p.Name(), "failed to retrieve the clusters selected by the placement to follow")
+	}
+	if !scheduled || len(selectedClusters) == 0 {
+		// The placement to follow is missing, has not been scheduled yet, or selects no cluster (e.g., it
+		// has just been recreated); filtering out every cluster would remove the resources from all the
+		// clusters, so abort the cycle and wait for the placement to follow to be scheduled instead.
+		//
+		// Normally the framework skips such a cycle before it reaches this stage.
+		return framework.FromError(fmt.Errorf("placement %s has not been scheduled yet", anchorKey), p.Name())
+	}
+	state.Write(framework.StateKey(p.Name()), &pluginState{
+		anchorPlacementName: anchorKey.Name,
+		selectedClusters:    selectedClusters,
+	})
+	return nil
+}
+
+// Filter allows the plugin to connect to the Filter extension point in the scheduling framework.
+func (p *Plugin) Filter(
+	_ context.Context,
+	state framework.CycleStatePluginReadWriter,
+	_ placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+) (status *framework.Status) {
+	ps, err := p.readPluginState(state)
+	if err != nil {
+		// This branch should never be reached, as a state has been set
+		// in the PreFilter stage.
+		return framework.FromError(err, p.Name(), "failed to read plugin state")
+	}
+
+	if ps.selectedClusters[cluster.Name] {
+		// The cluster is selected by the placement to follow; mark it as eligible for resource
+		// placement.
+		return nil
+	}
+	return framework.NewNonErrorStatus(framework.ClusterUnschedulable, p.Name(),
+		fmt.Sprintf("cluster is not selected by placement %s", ps.anchorPlacementName))
+}
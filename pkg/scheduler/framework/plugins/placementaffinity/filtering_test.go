@@ -0,0 +1,254 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementaffinity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+const (
+	clusterName1 = "cluster-1"
+	clusterName2 = "cluster-2"
+	pluginName   = "PlacementAffinity"
+
+	followedPlacementName = "followed-placement"
+	testNamespace         = "test-namespace"
+)
+
+var (
+	ignoreStatusErrorField = cmpopts.IgnoreFields(framework.Status{}, "err")
+)
+
+// Mock framework.Handle interface for set up the plugin.
+type MockHandle struct {
+	client client.Client
+}
+
+var (
+	_ framework.Handle = &MockHandle{}
+)
+
+func (mh *MockHandle) Client() client.Client               { return mh.client }
+func (mh *MockHandle) Manager() ctrl.Manager               { return nil }
+func (mh *MockHandle) UncachedReader() client.Reader       { return nil }
+func (mh *MockHandle) EventRecorder() record.EventRecorder { return nil }
+func (mh *MockHandle) ClusterEligibilityChecker() *clustereligibilitychecker.ClusterEligibilityChecker {
+	return nil
+}
+
+func newPolicySnapshotWithPlacementAffinity(namespace string) placementv1beta1.PolicySnapshotObj {
+	spec := placementv1beta1.SchedulingPolicySnapshotSpec{
+		Policy: &placementv1beta1.PlacementPolicy{
+			PlacementType: placementv1beta1.PickAllPlacementType,
+			Affinity: &placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{PlacementName: followedPlacementName},
+			},
+		},
+	}
+	if namespace != "" {
+		return &placementv1beta1.SchedulingPolicySnapshot{
+			ObjectMeta: metav1.ObjectMeta{Name: "follower-0", Namespace: namespace},
+			Spec:       spec,
+		}
+	}
+	return &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: "follower-0"},
+		Spec:       spec,
+	}
+}
+
+func newFollowedPolicySnapshot(namespace string, scheduled bool, selected ...string) placementv1beta1.PolicySnapshotObj {
+	meta := metav1.ObjectMeta{
+		Name:      "followed-placement-0",
+		Namespace: namespace,
+		Labels: map[string]string{
+			placementv1beta1.PlacementTrackingLabel: followedPlacementName,
+			placementv1beta1.IsLatestSnapshotLabel:  "true",
+		},
+		Generation: 1,
+	}
+	var status placementv1beta1.SchedulingPolicySnapshotStatus
+	for _, cluster := range selected {
+		status.ClusterDecisions = append(status.ClusterDecisions, placementv1beta1.ClusterDecision{ClusterName: cluster, Selected: true})
+	}
+	if scheduled {
+		status.Conditions = []metav1.Condition{{
+			Type:               string(placementv1beta1.PolicySnapshotScheduled),
+			Status:             metav1.ConditionTrue,
+			ObservedGeneration: 1,
+			Reason:             "test",
+		}}
+	}
+	if namespace != "" {
+		return &placementv1beta1.SchedulingPolicySnapshot{ObjectMeta: meta, Status: status}
+	}
+	return &placementv1beta1.ClusterSchedulingPolicySnapshot{ObjectMeta: meta, Status: status}
+}
+
+func TestPreFilter(t *testing.T) {
+	notScheduled := framework.FromError(nil, pluginName)
+
+	testCases := []struct {
+		name           string
+		policySnapshot placementv1beta1.PolicySnapshotObj
+		existing       []client.Object
+		want           *framework.Status
+	}{
+		{
+			name: "no placement policy",
+			policySnapshot: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{Name: "follower-0"},
+			},
+			want: framework.NewNonErrorStatus(framework.Skip, pluginName, "no placement affinity to enforce"),
+		},
+		{
+			name: "cluster affinity only",
+			policySnapshot: &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{Name: "follower-0"},
+				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickAllPlacementType,
+						Affinity: &placementv1beta1.Affinity{
+							ClusterAffinity: &placementv1beta1.ClusterAffinity{},
+						},
+					},
+				},
+			},
+			want: framework.NewNonErrorStatus(framework.Skip, pluginName, "no placement affinity to enforce"),
+		},
+		{
+			name:           "placement affinity",
+			policySnapshot: newPolicySnapshotWithPlacementAffinity(""),
+			existing:       []client.Object{newFollowedPolicySnapshot("", true, clusterName1)},
+		},
+		{
+			name:           "followed placement not found",
+			policySnapshot: newPolicySnapshotWithPlacementAffinity(""),
+			want:           notScheduled,
+		},
+		{
+			name:           "followed placement not scheduled yet",
+			policySnapshot: newPolicySnapshotWithPlacementAffinity(""),
+			existing:       []client.Object{newFollowedPolicySnapshot("", false, clusterName1)},
+			want:           notScheduled,
+		},
+		{
+			name:           "followed placement selects no cluster",
+			policySnapshot: newPolicySnapshotWithPlacementAffinity(""),
+			existing:       []client.Object{newFollowedPolicySnapshot("", true)},
+			want:           notScheduled,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := New()
+			p.SetUpWithFramework(&MockHandle{
+				client: fake.NewClientBuilder().WithScheme(serviceScheme(t)).WithObjects(tc.existing...).Build(),
+			})
+			state := framework.NewCycleState(nil, nil, nil)
+			status := p.PreFilter(context.Background(), state, tc.policySnapshot)
+			if diff := cmp.Diff(status, tc.want, cmp.AllowUnexported(framework.Status{}), ignoreStatusErrorField); diff != "" {
+				t.Errorf("PreFilter() status mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestFilter(t *testing.T) {
+	followedClusterPolicySnapshot := newFollowedPolicySnapshot("", true, clusterName1)
+	followedNamespacedPolicySnapshot := newFollowedPolicySnapshot(testNamespace, true, clusterName2)
+	notSelected := framework.NewNonErrorStatus(framework.ClusterUnschedulable, pluginName, "cluster is not selected by placement followed-placement")
+
+	testCases := []struct {
+		name           string
+		policySnapshot placementv1beta1.PolicySnapshotObj
+		existing       []client.Object
+		cluster        string
+		want           *framework.Status
+	}{
+		{
+			name:           "cluster selected by the followed cluster resource placement",
+			policySnapshot: newPolicySnapshotWithPlacementAffinity(""),
+			existing:       []client.Object{followedClusterPolicySnapshot, followedNamespacedPolicySnapshot},
+			cluster:        clusterName1,
+		},
+		{
+			name:           "cluster not selected by the followed cluster resource placement",
+			policySnapshot: newPolicySnapshotWithPlacementAffinity(""),
+			existing:       []client.Object{followedClusterPolicySnapshot, followedNamespacedPolicySnapshot},
+			cluster:        clusterName2,
+			want:           notSelected,
+		},
+		{
+			name:           "cluster selected by the followed resource placement",
+			policySnapshot: newPolicySnapshotWithPlacementAffinity(testNamespace),
+			existing:       []client.Object{followedClusterPolicySnapshot, followedNamespacedPolicySnapshot},
+			cluster:        clusterName2,
+		},
+		{
+			name:           "cluster not selected by the followed resource placement",
+			policySnapshot: newPolicySnapshotWithPlacementAffinity(testNamespace),
+			existing:       []client.Object{followedClusterPolicySnapshot, followedNamespacedPolicySnapshot},
+			cluster:        clusterName1,
+			want:           notSelected,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			p := New()
+			p.SetUpWithFramework(&MockHandle{
+				client: fake.NewClientBuilder().WithScheme(serviceScheme(t)).WithObjects(tc.existing...).Build(),
+			})
+			ctx := context.Background()
+			state := framework.NewCycleState(nil, nil, nil)
+			if status := p.PreFilter(ctx, state, tc.policySnapshot); status != nil {
+				t.Fatalf("PreFilter() = %v, want nil", status)
+			}
+
+			cluster := &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: tc.cluster}}
+			status := p.Filter(ctx, state, tc.policySnapshot, cluster)
+			if diff := cmp.Diff(status, tc.want, cmp.AllowUnexported(framework.Status{}), ignoreStatusErrorField); diff != "" {
+				t.Errorf("Filter() status mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func serviceScheme(t *testing.T) *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add placement v1beta1 scheme: %v", err)
+	}
+	return scheme
+}
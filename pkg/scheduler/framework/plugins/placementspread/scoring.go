@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementspread
+
+import (
+	"context"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+// pluginState is the state the plugin keeps during a scheduling cycle.
+type pluginState struct {
+	// maxBindingCount is the largest number of bindings hosted by any cluster being
+	// evaluated in the current scheduling cycle.
+	maxBindingCount int
+}
+
+// PreScore allows the plugin to connect to the PreScore extension point in the scheduling
+// framework.
+func (p *Plugin) PreScore(
+	_ context.Context,
+	state framework.CycleStatePluginReadWriter,
+	_ placementv1beta1.PolicySnapshotObj,
+) (status *framework.Status) {
+	ps := &pluginState{}
+	for _, cluster := range state.ListClusters() {
+		if count := state.BindingCountFor(cluster.Name); count > ps.maxBindingCount {
+			ps.maxBindingCount = count
+		}
+	}
+
+	if ps.maxBindingCount == 0 {
+		// None of the clusters hosts any binding from other placements; all clusters will
+		// receive the same score, skip the step.
+		//
+		// Note that this will also skip the Score() extension point for the plugin.
+		return framework.NewNonErrorStatus(framework.Skip, p.Name(), "no bindings from other placements found in the fleet")
+	}
+
+	// Save the plugin state.
+	state.Write(framework.StateKey(p.Name()), ps)
+
+	// All done.
+	return nil
+}
+
+// Score allows the plugin to connect to the Score extension point in the scheduling framework.
+func (p *Plugin) Score(
+	_ context.Context,
+	state framework.CycleStatePluginReadWriter,
+	_ placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+) (score *framework.ClusterScore, status *framework.Status) {
+	// Read the plugin state.
+	ps, err := p.readPluginState(state)
+	if err != nil {
+		// This branch should never be reached, as a state has been set
+		// in the PreScore stage.
+		return nil, framework.FromError(err, p.Name(), "failed to read plugin state")
+	}
+
+	// The fewer bindings a cluster hosts, the higher score it receives; the most loaded
+	// cluster(s) receive a score of 0.
+	count := state.BindingCountFor(cluster.Name)
+	if count > ps.maxBindingCount {
+		// This branch should never be reached, as the max. count is calculated over
+		// all clusters in the PreScore stage.
+		count = ps.maxBindingCount
+	}
+	return &framework.ClusterScore{PlacementSpreadScore: ps.maxBindingCount - count}, nil
+}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementspread
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+const (
+	clusterName1 = "cluster-1"
+	clusterName2 = "cluster-2"
+	clusterName3 = "cluster-3"
+)
+
+var (
+	p = New()
+
+	ignoreStatusErrorField = cmpopts.IgnoreFields(framework.Status{}, "err")
+
+	clusters = []clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName1}},
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName2}},
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName3}},
+	}
+)
+
+// fakeCycleState is a cycle state that reports pre-set binding counts.
+type fakeCycleState struct {
+	*framework.CycleState
+
+	bindingCounts map[string]int
+}
+
+// BindingCountFor returns the pre-set binding count for a cluster.
+func (f *fakeCycleState) BindingCountFor(clusterName string) int {
+	return f.bindingCounts[clusterName]
+}
+
+// TestPreScore tests the PreScore extension point of this plugin.
+func TestPreScore(t *testing.T) {
+	testCases := []struct {
+		name          string
+		bindingCounts map[string]int
+		wantStatus    *framework.Status
+		wantPS        *pluginState
+	}{
+		{
+			name:       "no bindings in the fleet",
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, p.Name(), "no bindings from other placements found in the fleet"),
+		},
+		{
+			name: "bindings on clusters not being evaluated",
+			bindingCounts: map[string]int{
+				"cluster-4": 10,
+			},
+			wantStatus: framework.NewNonErrorStatus(framework.Skip, p.Name(), "no bindings from other placements found in the fleet"),
+		},
+		{
+			name: "bindings in the fleet",
+			bindingCounts: map[string]int{
+				clusterName1: 3,
+				clusterName2: 7,
+			},
+			wantPS: &pluginState{
+				maxBindingCount: 7,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			state := &fakeCycleState{
+				CycleState:    framework.NewCycleState(clusters, nil, nil),
+				bindingCounts: tc.bindingCounts,
+			}
+			status := p.PreScore(ctx, state, nil)
+
+			if diff := cmp.Diff(
+				status, tc.wantStatus,
+				cmp.AllowUnexported(framework.Status{}),
+				ignoreStatusErrorField,
+			); diff != "" {
+				t.Errorf("PreScore() unexpected status (-got, +want):\n%s", diff)
+			}
+
+			if tc.wantPS != nil {
+				ps, err := p.readPluginState(state)
+				if err != nil {
+					t.Fatalf("failed to read plugin state: %v", err)
+				}
+
+				if diff := cmp.Diff(ps, tc.wantPS, cmp.AllowUnexported(pluginState{})); diff != "" {
+					t.Errorf("PreScore() unexpected plugin state (-got, +want):\n%s", diff)
+				}
+			}
+		})
+	}
+}
+
+// TestScore tests the Score extension point of this plugin.
+func TestScore(t *testing.T) {
+	bindingCounts := map[string]int{
+		clusterName1: 3,
+		clusterName2: 7,
+	}
+
+	testCases := []struct {
+		name        string
+		clusterName string
+		ps          *pluginState
+		wantScore   *framework.ClusterScore
+		wantStatus  *framework.Status
+	}{
+		{
+			name:        "no plugin state",
+			clusterName: clusterName1,
+			wantStatus:  framework.FromError(nil, p.Name(), "failed to read plugin state"),
+		},
+		{
+			name:        "lightly loaded cluster",
+			clusterName: clusterName1,
+			ps: &pluginState{
+				maxBindingCount: 7,
+			},
+			wantScore: &framework.ClusterScore{PlacementSpreadScore: 4},
+		},
+		{
+			name:        "most loaded cluster",
+			clusterName: clusterName2,
+			ps: &pluginState{
+				maxBindingCount: 7,
+			},
+			wantScore: &framework.ClusterScore{PlacementSpreadScore: 0},
+		},
+		{
+			name:        "cluster with no bindings",
+			clusterName: clusterName3,
+			ps: &pluginState{
+				maxBindingCount: 7,
+			},
+			wantScore: &framework.ClusterScore{PlacementSpreadScore: 7},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			state := &fakeCycleState{
+				CycleState:    framework.NewCycleState(clusters, nil, nil),
+				bindingCounts: bindingCounts,
+			}
+			if tc.ps != nil {
+				state.Write(framework.StateKey(p.Name()), tc.ps)
+			}
+
+			cluster := &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: tc.clusterName}}
+			score, status := p.Score(ctx, state, nil, cluster)
+			if diff := cmp.Diff(
+				status, tc.wantStatus,
+				cmp.AllowUnexported(framework.Status{}),
+				ignoreStatusErrorField,
+			); diff != "" {
+				t.Errorf("Score() unexpected status (-got, +want):\n%s", diff)
+			}
+
+			if diff := cmp.Diff(score, tc.wantScore); diff != "" {
+				t.Errorf("Score() unexpected score (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
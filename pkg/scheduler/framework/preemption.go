@@ -0,0 +1,473 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	evictionutils "github.com/kubefleet-dev/kubefleet/pkg/utils/eviction"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/priority"
+)
+
+const (
+	// preemptionSourceName is the source of the statuses that describe the clusters on which a placement is
+	// preempting lower-priority placements.
+	preemptionSourceName = "Preemption"
+
+	// preemptionRequeueDelay is the delay before the scheduler checks again a placement that is preempting
+	// lower-priority placements, i.e., waiting for their resources to be removed.
+	preemptionRequeueDelay = 15 * time.Second
+
+	// The reasons of the events emitted when a placement preempts lower-priority placements.
+	preemptingEventReason = "PreemptingLowerPriorityPlacements"
+	preemptedEventReason  = "PreemptedByHigherPriorityPlacement"
+
+	preemptingReasonTemplate = "preempting lower-priority placement(s) %s; waiting for their resources to be removed"
+)
+
+// preemptionVictim is a binding of a lower-priority placement that might be preempted.
+type preemptionVictim struct {
+	binding      placementv1beta1.BindingObj
+	placement    placementv1beta1.PlacementObj
+	placementKey types.NamespacedName
+	priority     int32
+	requests     corev1.ResourceList
+}
+
+// preemptionCandidate is a cluster that the placement being scheduled fits in if the bindings of some
+// lower-priority placements are preempted.
+type preemptionCandidate struct {
+	cluster *clusterv1beta1.MemberCluster
+	score   *ClusterScore
+	victims []*preemptionVictim
+}
+
+// preemptLowerPriorityPlacements preempts lower-priority placements for the placement being scheduled, if
+// its priority class allows so, on the clusters that have been filtered out; this mirrors the preemption
+// of pods in Kubernetes.
+//
+// A filtered cluster is a candidate if it passes all the filter plugins after the estimated resource requests
+// of some active bindings of lower-priority placements are given back to its available capacity; the fewest
+// victims are picked, starting from the lowest priority, and a victim is never picked if preempting it would
+// violate the disruption budget of its placement. The scheduler preempts on the best-scored candidates, i.e.,
+// marks the victim bindings as unscheduled, and waits for their resources to be removed before picking the
+// candidates; no more preemption is attempted for the placement in the meantime.
+//
+// It returns the clusters on which the placement is preempting lower-priority placements, along with the
+// keys of the preempted placements.
+func (f *framework) preemptLowerPriorityPlacements(
+	ctx context.Context,
+	state *CycleState,
+	placementKey queue.PlacementKey,
+	policy placementv1beta1.PolicySnapshotObj,
+	filtered []*filteredClusterWithStatus,
+	numOfClustersNeeded int,
+) (map[string][]string, error) {
+	policyRef := klog.KObj(policy)
+
+	placement, err := controller.FetchPlacementFromKey(ctx, f.client, placementKey)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	resolver, err := priority.NewResolver(ctx, f.client)
+	if err != nil {
+		return nil, controller.NewAPIServerError(true, err)
+	}
+	if resolver.PreemptionPolicyOf(placement) != placementv1beta1.PreemptLowerPriority {
+		return nil, nil
+	}
+
+	bindings, err := f.listAllBindings(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// Wait for the resources of the placements preempted earlier to be removed before preempting any more.
+	if pending := pendingPreemptions(bindings, string(placementKey)); len(pending) > 0 {
+		klog.V(2).InfoS("Waiting for the resources of preempted placements to be removed", "policySnapshot", policyRef, "preemptions", pending)
+		return pending, nil
+	}
+
+	preemptorPriority := resolver.PriorityOf(placement)
+	filteredClusters := make(map[string]*clusterv1beta1.MemberCluster, len(filtered))
+	for _, fc := range filtered {
+		filteredClusters[fc.cluster.Name] = fc.cluster
+	}
+	preemptorNamespace, preemptorName, err := controller.ExtractNamespaceNameFromKey(placementKey)
+	if err != nil {
+		return nil, controller.NewUnexpectedBehaviorError(err)
+	}
+
+	// Find the bindings of lower-priority placements on the filtered clusters.
+	placements := make(map[types.NamespacedName]placementv1beta1.PlacementObj)
+	bindingsByPlacement := make(map[types.NamespacedName][]placementv1beta1.BindingObj)
+	victimsByCluster := make(map[string][]*preemptionVictim)
+	for _, binding := range bindings {
+		key := types.NamespacedName{Namespace: binding.GetNamespace(), Name: binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]}
+		bindingsByPlacement[key] = append(bindingsByPlacement[key], binding)
+		if key.Namespace == preemptorNamespace && key.Name == preemptorName {
+			continue
+		}
+		spec := binding.GetBindingSpec()
+		if _, ok := filteredClusters[spec.TargetCluster]; !ok || !binding.GetDeletionTimestamp().IsZero() || spec.State == placementv1beta1.BindingStateUnscheduled {
+			continue
+		}
+		// Only the capacity estimated for a binding is known to be given back if the binding is preempted.
+		requests, ok := estimatedResourceRequestsOf(binding)
+		if !ok {
+			continue
+		}
+		victimPlacement, ok := placements[key]
+		if !ok {
+			victimPlacement, err = controller.FetchPlacementFromNamespacedName(ctx, f.client, key)
+			switch {
+			case apierrors.IsNotFound(err):
+				continue
+			case err != nil:
+				return nil, controller.NewAPIServerError(true, err)
+			}
+			placements[key] = victimPlacement
+		}
+		// Placements of the PickFixed type are never preempted, as the clusters are specified by the users.
+		if victimPolicy := victimPlacement.GetPlacementSpec().Policy; victimPolicy != nil && victimPolicy.PlacementType == placementv1beta1.PickFixedPlacementType {
+			continue
+		}
+		victimPriority := resolver.PriorityOf(victimPlacement)
+		if victimPriority >= preemptorPriority {
+			continue
+		}
+		victimsByCluster[spec.TargetCluster] = append(victimsByCluster[spec.TargetCluster], &preemptionVictim{
+			binding:      binding,
+			placement:    victimPlacement,
+			placementKey: key,
+			priority:     victimPriority,
+			requests:     requests,
+		})
+	}
+	if len(victimsByCluster) == 0 {
+		klog.V(2).InfoS("No lower-priority placements to preempt", "policySnapshot", policyRef)
+		return nil, nil
+	}
+
+	// Find the candidates.
+	disruptionsAllowed := make(map[types.NamespacedName]int)
+	var candidates []*preemptionCandidate
+	for _, fc := range filtered {
+		var victims []*preemptionVictim
+		for _, victim := range victimsByCluster[fc.cluster.Name] {
+			allowed, ok := disruptionsAllowed[victim.placementKey]
+			if !ok {
+				allowed, err = f.disruptionsAllowed(ctx, victim.placementKey, victim.placement, bindingsByPlacement[victim.placementKey])
+				if err != nil {
+					return nil, err
+				}
+				disruptionsAllowed[victim.placementKey] = allowed
+			}
+			if allowed > 0 {
+				victims = append(victims, victim)
+			}
+		}
+		if len(victims) == 0 {
+			continue
+		}
+		candidate, err := f.evaluatePreemptionCandidate(ctx, state, policy, fc.cluster, victims)
+		if err != nil {
+			return nil, err
+		}
+		if candidate != nil {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	// Preempt on the best-scored candidates; for candidates with the same score, prefer the ones with fewer victims,
+	// and then the ones with smaller names.
+	sort.SliceStable(candidates, func(i, j int) bool {
+		ci, cj := candidates[i], candidates[j]
+		if !ci.score.Equal(cj.score) {
+			return cj.score.Less(ci.score)
+		}
+		if len(ci.victims) != len(cj.victims) {
+			return len(ci.victims) < len(cj.victims)
+		}
+		return ci.cluster.Name < cj.cluster.Name
+	})
+	preempting := make(map[string][]string)
+	for _, candidate := range candidates {
+		if len(preempting) == numOfClustersNeeded {
+			break
+		}
+		// Multiple candidates might share the same disruption budget.
+		if !withinDisruptionBudgets(candidate.victims, disruptionsAllowed) {
+			continue
+		}
+		if err := f.preempt(ctx, placement, string(placementKey), candidate); err != nil {
+			klog.ErrorS(err, "Failed to preempt lower-priority placements", "policySnapshot", policyRef, "memberCluster", candidate.cluster.Name)
+			return nil, err
+		}
+		victimKeys := make([]string, 0, len(candidate.victims))
+		for _, victim := range candidate.victims {
+			disruptionsAllowed[victim.placementKey]--
+			victimKeys = append(victimKeys, controller.GetObjectKeyFromNamespaceName(victim.placementKey.Namespace, victim.placementKey.Name))
+		}
+		preempting[candidate.cluster.Name] = victimKeys
+	}
+	klog.V(2).InfoS("Preempted lower-priority placements", "policySnapshot", policyRef, "preemptions", preempting)
+	return preempting, nil
+}
+
+// evaluatePreemptionCandidate checks if the placement being scheduled fits in the given cluster if the given
+// victims are preempted; if so, it returns the candidate with the fewest victims needed.
+//
+// Victims are sorted by their priorities in ascending order.
+func (f *framework) evaluatePreemptionCandidate(
+	ctx context.Context,
+	state *CycleState,
+	policy placementv1beta1.PolicySnapshotObj,
+	cluster *clusterv1beta1.MemberCluster,
+	victims []*preemptionVictim,
+) (*preemptionCandidate, error) {
+	sort.SliceStable(victims, func(i, j int) bool {
+		if victims[i].priority != victims[j].priority {
+			return victims[i].priority < victims[j].priority
+		}
+		return victims[i].binding.GetName() < victims[j].binding.GetName()
+	})
+
+	// Give back the capacity of all the victims first.
+	simulated := cluster.DeepCopy()
+	for _, victim := range victims {
+		giveBackResources(simulated, victim.requests)
+	}
+	status := f.runFilterPluginsFor(ctx, state, policy, simulated)
+	switch {
+	case status.IsInteralError():
+		return nil, newPluginRunError(status.AsError())
+	case !status.IsSuccess():
+		return nil, nil
+	}
+
+	// Reprieve as many victims as possible, starting from the highest priority.
+	needed := make([]*preemptionVictim, 0, len(victims))
+	for i := len(victims) - 1; i >= 0; i-- {
+		victim := victims[i]
+		takeBackResources(simulated, victim.requests)
+		status := f.runFilterPluginsFor(ctx, state, policy, simulated)
+		switch {
+		case status.IsInteralError():
+			return nil, newPluginRunError(status.AsError())
+		case status.IsSuccess():
+			// The victim is reprieved.
+		default:
+			giveBackResources(simulated, victim.requests)
+			needed = append(needed, victim)
+		}
+	}
+
+	scoreList, status := f.runScorePluginsFor(ctx, state, policy, simulated)
+	if !status.IsSuccess() {
+		return nil, newPluginRunError(status.AsError())
+	}
+	totalScore := aggregateScores(state, scoreList)
+	// Preempting other placements does not help if the cluster still scores below the minimum.
+	if !state.scoringSettings.meetsMinimumScore(totalScore) {
+		return nil, nil
+	}
+	return &preemptionCandidate{
+		cluster: cluster,
+		score:   totalScore,
+		victims: needed,
+	}, nil
+}
+
+// preempt marks the bindings of the victims on a candidate cluster as unscheduled and emits events
+// on both the preempting and the preempted placements.
+func (f *framework) preempt(ctx context.Context, placement placementv1beta1.PlacementObj, preemptorKey string, candidate *preemptionCandidate) error {
+	toUpdate := make([]placementv1beta1.BindingObj, 0, len(candidate.victims))
+	victimKeys := make([]string, 0, len(candidate.victims))
+	for _, victim := range candidate.victims {
+		// The bindings are listed from the cache without deep copies.
+		toUpdate = append(toUpdate, victim.binding.DeepCopyObject().(placementv1beta1.BindingObj))
+		victimKeys = append(victimKeys, controller.GetObjectKeyFromNamespaceName(victim.placementKey.Namespace, victim.placementKey.Name))
+	}
+	if err := f.updateBindings(ctx, toUpdate, markPreemptedByAndUpdate(preemptorKey)); err != nil {
+		return err
+	}
+
+	f.eventRecorder.Eventf(placement, corev1.EventTypeNormal, preemptingEventReason,
+		"Preempting lower-priority placement(s) %s on cluster %s", strings.Join(victimKeys, ", "), candidate.cluster.Name)
+	for _, victim := range candidate.victims {
+		f.eventRecorder.Eventf(victim.placement, corev1.EventTypeWarning, preemptedEventReason,
+			"Preempted by higher-priority placement %s on cluster %s", preemptorKey, candidate.cluster.Name)
+	}
+	return nil
+}
+
+// disruptionsAllowed returns the number of bindings of a placement that can be preempted without violating
+// its disruption budget.
+func (f *framework) disruptionsAllowed(
+	ctx context.Context,
+	placementKey types.NamespacedName,
+	placement placementv1beta1.PlacementObj,
+	bindings []placementv1beta1.BindingObj,
+) (int, error) {
+	// Only ClusterResourcePlacements can have disruption budgets.
+	if placementKey.Namespace != "" {
+		return math.MaxInt, nil
+	}
+	var db placementv1beta1.ClusterResourcePlacementDisruptionBudget
+	if err := f.uncachedReader.Get(ctx, types.NamespacedName{Name: placementKey.Name}, &db); err != nil {
+		if apierrors.IsNotFound(err) {
+			return math.MaxInt, nil
+		}
+		return 0, controller.NewAPIServerError(false, err)
+	}
+	// A disruption budget that is misconfigured for a PickAll placement blocks any disruption, same as evictions.
+	if policy := placement.GetPlacementSpec().Policy; policy == nil || policy.PlacementType == placementv1beta1.PickAllPlacementType {
+		if db.Spec.MaxUnavailable != nil || (db.Spec.MinAvailable != nil && db.Spec.MinAvailable.Type == intstr.String) {
+			return 0, nil
+		}
+	}
+	allowed, _ := evictionutils.DisruptionsAllowed(bindings, placement, &db)
+	return allowed, nil
+}
+
+// markPreemptedByAndUpdate returns a function that marks a binding as unscheduled, as it has been preempted
+// by the given placement, and updates it.
+func markPreemptedByAndUpdate(preemptorKey string) func(ctx context.Context, hubClient client.Client, binding placementv1beta1.BindingObj) error {
+	return func(ctx context.Context, hubClient client.Client, binding placementv1beta1.BindingObj) error {
+		binding.SetAnnotations(map[string]string{
+			placementv1beta1.PreviousBindingStateAnnotation: string(binding.GetBindingSpec().State),
+			placementv1beta1.PreemptedByAnnotation:          preemptorKey,
+		})
+		binding.GetBindingSpec().State = placementv1beta1.BindingStateUnscheduled
+		err := hubClient.Update(ctx, binding, &client.UpdateOptions{})
+		if err == nil {
+			klog.V(2).InfoS("Marked binding as unscheduled as it is preempted", "binding", klog.KObj(binding), "preemptor", preemptorKey)
+		}
+		return err
+	}
+}
+
+// pendingPreemptions returns the clusters on which the resources of the placements preempted by the given
+// placement are still present, along with the keys of the preempted placements.
+func pendingPreemptions(bindings []placementv1beta1.BindingObj, preemptorKey string) map[string][]string {
+	pending := make(map[string][]string)
+	for _, binding := range bindings {
+		if binding.GetAnnotations()[placementv1beta1.PreemptedByAnnotation] != preemptorKey {
+			continue
+		}
+		clusterName := binding.GetBindingSpec().TargetCluster
+		pending[clusterName] = append(pending[clusterName],
+			controller.GetObjectKeyFromNamespaceName(binding.GetNamespace(), binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]))
+	}
+	return pending
+}
+
+// preemptedBindings returns the bindings that have been preempted by other placements and whose resources
+// are still present; the scheduler does not pick the target clusters of such bindings again, so that the
+// preempted placements do not compete with the preempting ones for the capacity being given back.
+func preemptedBindings(unscheduled []placementv1beta1.BindingObj) []placementv1beta1.BindingObj {
+	var preempted []placementv1beta1.BindingObj
+	for _, binding := range unscheduled {
+		if _, ok := binding.GetAnnotations()[placementv1beta1.PreemptedByAnnotation]; ok {
+			preempted = append(preempted, binding)
+		}
+	}
+	return preempted
+}
+
+// markPreemptingClusters updates the statuses of the filtered clusters on which the placement is preempting
+// lower-priority placements, and moves them to the front of the list so that they are reported first.
+func markPreemptingClusters(filtered []*filteredClusterWithStatus, preempting map[string][]string) []*filteredClusterWithStatus {
+	if len(preempting) == 0 {
+		return filtered
+	}
+	updated := make([]*filteredClusterWithStatus, 0, len(filtered))
+	rest := make([]*filteredClusterWithStatus, 0, len(filtered))
+	for _, fc := range filtered {
+		victimKeys, ok := preempting[fc.cluster.Name]
+		if !ok {
+			rest = append(rest, fc)
+			continue
+		}
+		updated = append(updated, &filteredClusterWithStatus{
+			cluster: fc.cluster,
+			status:  NewNonErrorStatus(ClusterUnschedulable, preemptionSourceName, fmt.Sprintf(preemptingReasonTemplate, strings.Join(victimKeys, ", "))),
+		})
+	}
+	return append(updated, rest...)
+}
+
+// withinDisruptionBudgets checks if the given victims can all be preempted without violating the
+// disruption budgets of their placements.
+func withinDisruptionBudgets(victims []*preemptionVictim, disruptionsAllowed map[types.NamespacedName]int) bool {
+	for _, victim := range victims {
+		if disruptionsAllowed[victim.placementKey] <= 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// giveBackResources adds the given resources to the available capacity of a cluster.
+//
+// Only resources that the cluster reports are added.
+func giveBackResources(cluster *clusterv1beta1.MemberCluster, resources corev1.ResourceList) {
+	available := cluster.Status.ResourceUsage.Available
+	for name, quantity := range resources {
+		current, ok := available[name]
+		if !ok {
+			continue
+		}
+		current.Add(quantity)
+		available[name] = current
+	}
+}
+
+// takeBackResources deducts the given resources from the available capacity of a cluster.
+//
+// Only resources that the cluster reports are deducted.
+func takeBackResources(cluster *clusterv1beta1.MemberCluster, resources corev1.ResourceList) {
+	available := cluster.Status.ResourceUsage.Available
+	for name, quantity := range resources {
+		current, ok := available[name]
+		if !ok {
+			continue
+		}
+		current.Sub(quantity)
+		available[name] = current
+	}
+}
@@ -0,0 +1,302 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/parallelizer"
+)
+
+// TestPreemptLowerPriorityPlacements tests the preemptLowerPriorityPlacements method.
+func TestPreemptLowerPriorityPlacements(t *testing.T) {
+	clusterName1 := fmt.Sprintf(clusterNameTemplate, 1)
+	clusterName2 := fmt.Sprintf(clusterNameTemplate, 2)
+	dummyPluginName := fmt.Sprintf(dummyAllPurposePluginNameFormat, 0)
+
+	newPriorityClass := func(name string, value int32, preemptionPolicy placementv1beta1.PlacementPreemptionPolicy) *placementv1beta1.PlacementPriorityClass {
+		return &placementv1beta1.PlacementPriorityClass{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: placementv1beta1.PlacementPriorityClassSpec{
+				Value:            value,
+				PreemptionPolicy: preemptionPolicy,
+			},
+		}
+	}
+	newCRP := func(name, priorityClassName string) *placementv1beta1.ClusterResourcePlacement {
+		return &placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: placementv1beta1.PlacementSpec{
+				Policy: &placementv1beta1.PlacementPolicy{
+					PlacementType:    placementv1beta1.PickNPlacementType,
+					NumberOfClusters: ptr.To(int32(2)),
+				},
+				PriorityClassName: priorityClassName,
+			},
+		}
+	}
+	newBinding := func(crpName, clusterName, cpu string) *placementv1beta1.ClusterResourceBinding {
+		return &placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        fmt.Sprintf("%s-%s", crpName, clusterName),
+				Labels:      map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+				Annotations: map[string]string{placementv1beta1.EstimatedResourceRequestsAnnotation: fmt.Sprintf(`{"cpu":"%s"}`, cpu)},
+			},
+			Spec: placementv1beta1.ResourceBindingSpec{
+				State:         placementv1beta1.BindingStateBound,
+				TargetCluster: clusterName,
+			},
+		}
+	}
+	newCluster := func(name, availableCPU string) *clusterv1beta1.MemberCluster {
+		return &clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Status: clusterv1beta1.MemberClusterStatus{
+				ResourceUsage: clusterv1beta1.ResourceUsage{
+					Available: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse(availableCPU)},
+				},
+			},
+		}
+	}
+
+	// The placement being scheduled fits in a cluster with at least 4 CPUs available; cluster-2 scores higher.
+	//
+	// On cluster-1, preempting low-2 alone suffices; on cluster-2, low-1 must be preempted.
+	commonObjs := []client.Object{
+		newPriorityClass("low", 0, ""),
+		newPriorityClass("medium", 100, ""),
+		newPriorityClass("high", 1000, placementv1beta1.PreemptLowerPriority),
+		newPriorityClass("high-never", 1000, placementv1beta1.PreemptNever),
+		newCRP("low-1", "low"),
+		newCRP("low-2", "medium"),
+		newCRP("high-2", "high"),
+		newBinding("low-1", clusterName1, "1"),
+		newBinding("low-2", clusterName1, "2"),
+		newBinding("high-2", clusterName1, "5"),
+		newBinding("low-1", clusterName2, "3"),
+	}
+	preemptedBinding := newBinding("low-2", clusterName2, "1")
+	preemptedBinding.Annotations[placementv1beta1.PreemptedByAnnotation] = crpName
+	preemptedBinding.Spec.State = placementv1beta1.BindingStateUnscheduled
+
+	testCases := []struct {
+		name                string
+		preemptorClass      string
+		extraObjs           []client.Object
+		numOfClustersNeeded int
+		wantPreempting      map[string][]string
+		wantPreemptedByKeys map[string]string
+		wantEvents          []string
+	}{
+		{
+			name:                "preemption not allowed",
+			preemptorClass:      "high-never",
+			numOfClustersNeeded: 1,
+		},
+		{
+			name:                "preempt on the best-scored cluster",
+			preemptorClass:      "high",
+			numOfClustersNeeded: 1,
+			wantPreempting:      map[string][]string{clusterName2: {"low-1"}},
+			wantPreemptedByKeys: map[string]string{"low-1-cluster-2": crpName},
+			wantEvents: []string{
+				"Normal PreemptingLowerPriorityPlacements Preempting lower-priority placement(s) low-1 on cluster cluster-2",
+				"Warning PreemptedByHigherPriorityPlacement Preempted by higher-priority placement test-placement on cluster cluster-2",
+			},
+		},
+		{
+			name:                "preempt the fewest lower-priority placements on multiple clusters",
+			preemptorClass:      "high",
+			numOfClustersNeeded: 2,
+			wantPreempting:      map[string][]string{clusterName1: {"low-2"}, clusterName2: {"low-1"}},
+			wantPreemptedByKeys: map[string]string{"low-1-cluster-2": crpName, "low-2-cluster-1": crpName},
+			wantEvents: []string{
+				"Normal PreemptingLowerPriorityPlacements Preempting lower-priority placement(s) low-1 on cluster cluster-2",
+				"Warning PreemptedByHigherPriorityPlacement Preempted by higher-priority placement test-placement on cluster cluster-2",
+				"Normal PreemptingLowerPriorityPlacements Preempting lower-priority placement(s) low-2 on cluster cluster-1",
+				"Warning PreemptedByHigherPriorityPlacement Preempted by higher-priority placement test-placement on cluster cluster-1",
+			},
+		},
+		{
+			name:           "disruption budget is respected",
+			preemptorClass: "high",
+			extraObjs: []client.Object{
+				&placementv1beta1.ClusterResourcePlacementDisruptionBudget{
+					ObjectMeta: metav1.ObjectMeta{Name: "low-1"},
+					Spec: placementv1beta1.PlacementDisruptionBudgetSpec{
+						MinAvailable: ptr.To(intstr.FromInt32(1)),
+					},
+				},
+			},
+			numOfClustersNeeded: 1,
+			wantPreempting:      map[string][]string{clusterName1: {"low-2"}},
+			wantPreemptedByKeys: map[string]string{"low-2-cluster-1": crpName},
+			wantEvents: []string{
+				"Normal PreemptingLowerPriorityPlacements Preempting lower-priority placement(s) low-2 on cluster cluster-1",
+				"Warning PreemptedByHigherPriorityPlacement Preempted by higher-priority placement test-placement on cluster cluster-1",
+			},
+		},
+		{
+			name:                "wait for earlier preemptions",
+			preemptorClass:      "high",
+			extraObjs:           []client.Object{preemptedBinding},
+			numOfClustersNeeded: 1,
+			wantPreempting:      map[string][]string{clusterName2: {"low-2"}},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			objs := append([]client.Object{newCRP(crpName, tc.preemptorClass)}, commonObjs...)
+			objs = append(objs, tc.extraObjs...)
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(objs...).Build()
+			profile := NewProfile(dummyProfileName).
+				WithFilterPlugin(&DummyAllPurposePlugin{
+					name: dummyPluginName,
+					filterRunner: func(_ context.Context, _ CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) *Status {
+						available := cluster.Status.ResourceUsage.Available[corev1.ResourceCPU]
+						if available.Cmp(resource.MustParse("4")) < 0 {
+							return NewNonErrorStatus(ClusterUnschedulable, dummyPluginName)
+						}
+						return nil
+					},
+				}).
+				WithScorePlugin(&DummyAllPurposePlugin{
+					name: dummyPluginName,
+					scoreRunner: func(_ context.Context, _ CycleStatePluginReadWriter, _ placementv1beta1.PolicySnapshotObj, cluster *clusterv1beta1.MemberCluster) (*ClusterScore, *Status) {
+						if cluster.Name == clusterName2 {
+							return &ClusterScore{AffinityScore: 10}, nil
+						}
+						return &ClusterScore{AffinityScore: 5}, nil
+					},
+				})
+			recorder := record.NewFakeRecorder(10)
+			// Construct framework manually instead of using NewFramework() to avoid mocking the controller manager.
+			f := &framework{
+				profile:        profile,
+				client:         fakeClient,
+				uncachedReader: fakeClient,
+				eventRecorder:  recorder,
+				parallelizer:   parallelizer.NewParallelizer(parallelizer.DefaultNumOfWorkers),
+			}
+			filtered := []*filteredClusterWithStatus{
+				{cluster: newCluster(clusterName1, "2"), status: defaultFilteredStatus},
+				{cluster: newCluster(clusterName2, "1"), status: defaultFilteredStatus},
+			}
+			policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{ObjectMeta: metav1.ObjectMeta{Name: policyName}}
+			state := NewCycleState(nil, nil)
+
+			ctx := context.Background()
+			got, err := f.preemptLowerPriorityPlacements(ctx, state, queue.PlacementKey(crpName), policy, filtered, tc.numOfClustersNeeded)
+			if err != nil {
+				t.Fatalf("preemptLowerPriorityPlacements() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(tc.wantPreempting, got, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("preemptLowerPriorityPlacements() preempting mismatch (-want, +got):\n%s", diff)
+			}
+
+			crbList := &placementv1beta1.ClusterResourceBindingList{}
+			if err := fakeClient.List(ctx, crbList); err != nil {
+				t.Fatalf("List() = %v, want no error", err)
+			}
+			gotPreemptedByKeys := make(map[string]string)
+			for _, binding := range crbList.Items {
+				if binding.Name == preemptedBinding.Name {
+					continue
+				}
+				preemptor, ok := binding.Annotations[placementv1beta1.PreemptedByAnnotation]
+				if !ok {
+					continue
+				}
+				if binding.Spec.State != placementv1beta1.BindingStateUnscheduled {
+					t.Errorf("preempted binding %s state = %s, want %s", binding.Name, binding.Spec.State, placementv1beta1.BindingStateUnscheduled)
+				}
+				gotPreemptedByKeys[binding.Name] = preemptor
+			}
+			if diff := cmp.Diff(tc.wantPreemptedByKeys, gotPreemptedByKeys, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("preempted bindings mismatch (-want, +got):\n%s", diff)
+			}
+
+			close(recorder.Events)
+			var gotEvents []string
+			for event := range recorder.Events {
+				gotEvents = append(gotEvents, event)
+			}
+			if diff := cmp.Diff(tc.wantEvents, gotEvents); diff != "" {
+				t.Errorf("preemptLowerPriorityPlacements() events mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestMarkPreemptingClusters tests the markPreemptingClusters function.
+func TestMarkPreemptingClusters(t *testing.T) {
+	filtered := generatedFilterdClusterWithStatus(3, 1)
+	got := markPreemptingClusters(filtered, map[string][]string{fmt.Sprintf(clusterNameTemplate, 2): {"low-1", "ns/low-2"}})
+
+	gotNames := make([]string, 0, len(got))
+	for _, fc := range got {
+		gotNames = append(gotNames, fc.cluster.Name)
+	}
+	wantNames := []string{"cluster-2", "cluster-1", "cluster-3"}
+	if diff := cmp.Diff(wantNames, gotNames); diff != "" {
+		t.Errorf("markPreemptingClusters() clusters mismatch (-want, +got):\n%s", diff)
+	}
+	wantReason := `Cluster "cluster-2" is filtered out by the Preemption plugin: preempting lower-priority placement(s) low-1, ns/low-2; waiting for their resources to be removed`
+	if gotReason := notPickedByFilterReason(got[0]); gotReason != wantReason {
+		t.Errorf("notPickedByFilterReason() = %q, want %q", gotReason, wantReason)
+	}
+}
+
+// TestPreemptedBindings tests the preemptedBindings function.
+func TestPreemptedBindings(t *testing.T) {
+	unscheduled := []placementv1beta1.BindingObj{
+		&placementv1beta1.ClusterResourceBinding{ObjectMeta: metav1.ObjectMeta{Name: "binding-1"}},
+		&placementv1beta1.ClusterResourceBinding{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "binding-2",
+				Annotations: map[string]string{placementv1beta1.PreemptedByAnnotation: "high"},
+			},
+		},
+	}
+	got := preemptedBindings(unscheduled)
+	gotNames := make([]types.NamespacedName, 0, len(got))
+	for _, binding := range got {
+		gotNames = append(gotNames, types.NamespacedName{Namespace: binding.GetNamespace(), Name: binding.GetName()})
+	}
+	if diff := cmp.Diff([]types.NamespacedName{{Name: "binding-2"}}, gotNames); diff != "" {
+		t.Errorf("preemptedBindings() mismatch (-want, +got):\n%s", diff)
+	}
+}
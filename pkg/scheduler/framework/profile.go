@@ -72,6 +72,17 @@ func (profile *Profile) WithScorePlugin(plugin ScorePlugin) *Profile {
 	return profile
 }
 
+// usesBindingCounts returns true if any plugin registered to the profile reads the numbers of
+// bindings per cluster.
+func (profile *Profile) usesBindingCounts() bool {
+	for _, plugin := range profile.registeredPlugins {
+		if p, ok := plugin.(BindingCountsPlugin); ok && p.UsesBindingCounts() {
+			return true
+		}
+	}
+	return false
+}
+
 // Name returns the name of the profile.
 func (profile *Profile) Name() string {
 	return profile.name
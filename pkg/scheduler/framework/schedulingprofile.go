@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// scoringSettings is the scoring settings of a placement, as set by the scheduling profile
+// (a SchedulingProfile object) that the placement refers to.
+type scoringSettings struct {
+	// profileName is the name of the scheduling profile.
+	profileName string
+	// weights is the weights of the score plugins, keyed by the plugin names; plugins that are
+	// not listed have a weight of 1.
+	weights map[string]int32
+	// minimumScore is the lowest weighted score a cluster must receive to be picked; nil means
+	// there is no minimum.
+	minimumScore *int64
+}
+
+// weightOf returns the weight of a score plugin.
+func (ss *scoringSettings) weightOf(pluginName string) int64 {
+	if weight, ok := ss.weights[pluginName]; ok {
+		return int64(weight)
+	}
+	return 1
+}
+
+// meetsMinimumScore returns if a cluster score is no lower than the minimum score set by the
+// scoring settings (if any).
+func (ss *scoringSettings) meetsMinimumScore(score *ClusterScore) bool {
+	if ss == nil || ss.minimumScore == nil {
+		return true
+	}
+	return score.WeightedScore >= *ss.minimumScore
+}
+
+// partitionByMinimumScore splits the scored clusters into the ones that meet the minimum score
+// set by the scoring settings (if any) and the ones that do not.
+func (ss *scoringSettings) partitionByMinimumScore(scored ScoredClusters) (qualified, belowMinimum ScoredClusters) {
+	if ss == nil || ss.minimumScore == nil {
+		return scored, nil
+	}
+
+	qualified = make(ScoredClusters, 0, len(scored))
+	for _, sc := range scored {
+		if ss.meetsMinimumScore(sc.Score) {
+			qualified = append(qualified, sc)
+			continue
+		}
+		belowMinimum = append(belowMinimum, sc)
+	}
+	return qualified, belowMinimum
+}
+
+// aggregateScores adds up the scores that the score plugins assign to a cluster; if the placement
+// uses a scheduling profile, the weighted sum of the scores is calculated as well.
+func aggregateScores(state *CycleState, scoreList map[string]*ClusterScore) *ClusterScore {
+	totalScore := &ClusterScore{}
+	for pluginName, score := range scoreList {
+		totalScore.Add(score)
+		if state.scoringSettings != nil {
+			totalScore.WeightedScore += state.scoringSettings.weightOf(pluginName) * score.sum()
+		}
+	}
+	return totalScore
+}
+
+// resolveScoringSettings looks up the scheduling profile that the placement refers to (if any) and
+// sets up the scoring settings in the cycle state accordingly; score plugins disabled by the
+// scheduling profile are marked as skipped.
+//
+// If the scheduling profile is not found, the scheduler uses its default scoring behavior.
+func (f *framework) resolveScoringSettings(ctx context.Context, state *CycleState, policy placementv1beta1.PolicySnapshotObj) error {
+	if !f.enableSchedulingProfiles {
+		return nil
+	}
+	placementPolicy := policy.GetPolicySnapshotSpec().Policy
+	if placementPolicy == nil || placementPolicy.SchedulingProfileName == "" {
+		return nil
+	}
+
+	policyRef := klog.KObj(policy)
+	profileName := placementPolicy.SchedulingProfileName
+	schedulingProfile := &placementv1beta1.SchedulingProfile{}
+	if err := f.client.Get(ctx, types.NamespacedName{Name: profileName}, schedulingProfile); err != nil {
+		if apierrors.IsNotFound(err) {
+			klog.V(2).InfoS("Scheduling profile is not found; using the default scoring behavior",
+				"policySnapshot", policyRef, "schedulingProfile", profileName)
+			return nil
+		}
+		klog.ErrorS(err, "Failed to get scheduling profile", "policySnapshot", policyRef, "schedulingProfile", profileName)
+		return controller.NewAPIServerError(true, err)
+	}
+
+	settings := &scoringSettings{
+		profileName:  profileName,
+		weights:      make(map[string]int32, len(schedulingProfile.Spec.ScorePlugins)),
+		minimumScore: schedulingProfile.Spec.MinimumScore,
+	}
+	for _, pluginConfig := range schedulingProfile.Spec.ScorePlugins {
+		pluginName := string(pluginConfig.Name)
+		if pluginConfig.Enabled != nil && !*pluginConfig.Enabled {
+			state.skippedScorePlugins.Insert(pluginName)
+			continue
+		}
+		if pluginConfig.Weight != nil {
+			settings.weights[pluginName] = *pluginConfig.Weight
+		}
+	}
+	state.scoringSettings = settings
+	klog.V(2).InfoS("Using scheduling profile", "policySnapshot", policyRef, "schedulingProfile", profileName,
+		"disabledScorePlugins", state.skippedScorePlugins.UnsortedList(), "weights", settings.weights)
+	return nil
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	schedulingProfileName = "test-profile"
+)
+
+// TestResolveScoringSettings tests the resolveScoringSettings method.
+func TestResolveScoringSettings(t *testing.T) {
+	schedulingProfile := &placementv1beta1.SchedulingProfile{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: schedulingProfileName,
+		},
+		Spec: placementv1beta1.SchedulingProfileSpec{
+			ScorePlugins: []placementv1beta1.ScorePluginConfig{
+				{
+					Name:    placementv1beta1.TopologySpreadConstraintsScorePlugin,
+					Enabled: ptr.To(false),
+				},
+				{
+					Name:   placementv1beta1.ClusterAffinityScorePlugin,
+					Weight: ptr.To(int32(5)),
+				},
+				{
+					Name:    placementv1beta1.SamePlacementAntiAffinityScorePlugin,
+					Enabled: ptr.To(true),
+				},
+			},
+			MinimumScore: ptr.To(int64(10)),
+		},
+	}
+
+	testCases := []struct {
+		name                    string
+		enableProfiles          bool
+		profileName             string
+		objs                    []client.Object
+		wantScoringSettings     *scoringSettings
+		wantSkippedScorePlugins []string
+	}{
+		{
+			name:           "scheduling profiles disabled",
+			enableProfiles: false,
+			profileName:    schedulingProfileName,
+			objs:           []client.Object{schedulingProfile},
+		},
+		{
+			name:           "no scheduling profile specified",
+			enableProfiles: true,
+			objs:           []client.Object{schedulingProfile},
+		},
+		{
+			name:           "scheduling profile not found",
+			enableProfiles: true,
+			profileName:    schedulingProfileName,
+		},
+		{
+			name:           "scheduling profile found",
+			enableProfiles: true,
+			profileName:    schedulingProfileName,
+			objs:           []client.Object{schedulingProfile},
+			wantScoringSettings: &scoringSettings{
+				profileName: schedulingProfileName,
+				weights: map[string]int32{
+					string(placementv1beta1.ClusterAffinityScorePlugin): 5,
+				},
+				minimumScore: ptr.To(int64(10)),
+			},
+			wantSkippedScorePlugins: []string{string(placementv1beta1.TopologySpreadConstraintsScorePlugin)},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme.Scheme).
+				WithObjects(tc.objs...).
+				Build()
+			// Construct framework manually instead of using NewFramework() to avoid mocking the controller manager.
+			f := &framework{
+				client:                   fakeClient,
+				enableSchedulingProfiles: tc.enableProfiles,
+			}
+
+			state := NewCycleState([]clusterv1beta1.MemberCluster{}, []placementv1beta1.BindingObj{})
+			policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+				},
+				Spec: placementv1beta1.SchedulingPolicySnapshotSpec{
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:         placementv1beta1.PickNPlacementType,
+						SchedulingProfileName: tc.profileName,
+					},
+				},
+			}
+
+			if err := f.resolveScoringSettings(context.Background(), state, policy); err != nil {
+				t.Fatalf("resolveScoringSettings() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(state.scoringSettings, tc.wantScoringSettings, cmp.AllowUnexported(scoringSettings{})); diff != "" {
+				t.Errorf("resolveScoringSettings() scoring settings diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(state.skippedScorePlugins.UnsortedList(), tc.wantSkippedScorePlugins, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("resolveScoringSettings() skipped score plugins diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestAggregateScores tests the aggregateScores function.
+func TestAggregateScores(t *testing.T) {
+	scoreList := map[string]*ClusterScore{
+		string(placementv1beta1.ClusterAffinityScorePlugin): {
+			AffinityScore: 10,
+		},
+		string(placementv1beta1.TopologySpreadConstraintsScorePlugin): {
+			TopologySpreadScore: -2,
+		},
+		string(placementv1beta1.SamePlacementAntiAffinityScorePlugin): {
+			ObsoletePlacementAffinityScore: 1,
+		},
+	}
+
+	testCases := []struct {
+		name            string
+		scoringSettings *scoringSettings
+		want            *ClusterScore
+	}{
+		{
+			name: "no scheduling profile",
+			want: &ClusterScore{
+				AffinityScore:                  10,
+				TopologySpreadScore:            -2,
+				ObsoletePlacementAffinityScore: 1,
+			},
+		},
+		{
+			name: "scheduling profile with weights",
+			scoringSettings: &scoringSettings{
+				profileName: schedulingProfileName,
+				weights: map[string]int32{
+					string(placementv1beta1.ClusterAffinityScorePlugin):           3,
+					string(placementv1beta1.TopologySpreadConstraintsScorePlugin): 0,
+				},
+			},
+			want: &ClusterScore{
+				// 3 * 10 + 0 * (-2) + 1 * 1.
+				WeightedScore:                  31,
+				AffinityScore:                  10,
+				TopologySpreadScore:            -2,
+				ObsoletePlacementAffinityScore: 1,
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			state := NewCycleState([]clusterv1beta1.MemberCluster{}, []placementv1beta1.BindingObj{})
+			state.scoringSettings = tc.scoringSettings
+
+			got := aggregateScores(state, scoreList)
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("aggregateScores() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestPartitionByMinimumScore tests the partitionByMinimumScore method.
+func TestPartitionByMinimumScore(t *testing.T) {
+	scored := ScoredClusters{
+		{
+			Cluster: &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: clusterName}},
+			Score:   &ClusterScore{WeightedScore: 5},
+		},
+		{
+			Cluster: &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: altClusterName}},
+			Score:   &ClusterScore{WeightedScore: 10},
+		},
+		{
+			Cluster: &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: anotherClusterName}},
+			Score:   &ClusterScore{WeightedScore: 15},
+		},
+	}
+
+	testCases := []struct {
+		name             string
+		scoringSettings  *scoringSettings
+		wantQualified    ScoredClusters
+		wantBelowMinimum ScoredClusters
+	}{
+		{
+			name:          "no scheduling profile",
+			wantQualified: scored,
+		},
+		{
+			name: "no minimum score",
+			scoringSettings: &scoringSettings{
+				profileName: schedulingProfileName,
+			},
+			wantQualified: scored,
+		},
+		{
+			name: "with minimum score",
+			scoringSettings: &scoringSettings{
+				profileName:  schedulingProfileName,
+				minimumScore: ptr.To(int64(10)),
+			},
+			wantQualified:    ScoredClusters{scored[1], scored[2]},
+			wantBelowMinimum: ScoredClusters{scored[0]},
+		},
+		{
+			name: "all below minimum score",
+			scoringSettings: &scoringSettings{
+				profileName:  schedulingProfileName,
+				minimumScore: ptr.To(int64(20)),
+			},
+			wantBelowMinimum: scored,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotQualified, gotBelowMinimum := tc.scoringSettings.partitionByMinimumScore(scored)
+			if diff := cmp.Diff(gotQualified, tc.wantQualified, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("partitionByMinimumScore() qualified diff (-got, +want):\n%s", diff)
+			}
+			if diff := cmp.Diff(gotBelowMinimum, tc.wantBelowMinimum, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("partitionByMinimumScore() below minimum diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
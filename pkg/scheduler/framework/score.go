@@ -39,15 +39,37 @@ type ClusterScore struct {
 	// a preference for already selected clusters when all the other conditions are the same,
 	// so as to minimize interruption between different scheduling runs.
 	ObsoletePlacementAffinityScore int
+	// AutoscalingScore reflects if a cluster has pending capacity expansion, as reported by the
+	// cluster autoscaler of the cluster; its value range should be [0, 1], where 1 signals that
+	// the cluster is scaling up.
+	AutoscalingScore int
+	// PlacementSpreadScore reflects how lightly loaded a cluster is in terms of the number of
+	// bindings it hosts across all placements in the fleet; a cluster hosting fewer bindings
+	// receives a higher score.
+	//
+	// Note that this score serves as the last tie-breaker when all the other scores are the same,
+	// so as to avoid piling every placement onto the same few clusters.
+	PlacementSpreadScore int
+
+	// WeightedScore is the weighted sum of the scores from all the score plugins, as set by the
+	// scheduling profile (a SchedulingProfile object) of the placement; it is always zero if
+	// the placement does not use a scheduling profile.
+	//
+	// Note that this score is set by the scheduler itself rather than by any score plugin; when
+	// present, it takes precedence over all the other scores.
+	WeightedScore int64
 }
 
 // Add adds a ClusterScore to another ClusterScore.
 //
 // Note that this will panic if either score is nil.
 func (s1 *ClusterScore) Add(s2 *ClusterScore) {
+	s1.WeightedScore += s2.WeightedScore
 	s1.TopologySpreadScore += s2.TopologySpreadScore
 	s1.AffinityScore += s2.AffinityScore
 	s1.ObsoletePlacementAffinityScore += s2.ObsoletePlacementAffinityScore
+	s1.AutoscalingScore += s2.AutoscalingScore
+	s1.PlacementSpreadScore += s2.PlacementSpreadScore
 }
 
 // Equal returns true if a ClusterScore is equal to another.
@@ -61,9 +83,12 @@ func (s1 *ClusterScore) Equal(s2 *ClusterScore) bool {
 		return false
 	default:
 		// Both are not nils.
-		return s1.TopologySpreadScore == s2.TopologySpreadScore &&
+		return s1.WeightedScore == s2.WeightedScore &&
+			s1.TopologySpreadScore == s2.TopologySpreadScore &&
 			s1.AffinityScore == s2.AffinityScore &&
-			s1.ObsoletePlacementAffinityScore == s2.ObsoletePlacementAffinityScore
+			s1.ObsoletePlacementAffinityScore == s2.ObsoletePlacementAffinityScore &&
+			s1.AutoscalingScore == s2.AutoscalingScore &&
+			s1.PlacementSpreadScore == s2.PlacementSpreadScore
 	}
 }
 
@@ -71,6 +96,10 @@ func (s1 *ClusterScore) Equal(s2 *ClusterScore) bool {
 //
 // Note that this will panic if either score is nil.
 func (s1 *ClusterScore) Less(s2 *ClusterScore) bool {
+	if s1.WeightedScore != s2.WeightedScore {
+		return s1.WeightedScore < s2.WeightedScore
+	}
+
 	if s1.TopologySpreadScore != s2.TopologySpreadScore {
 		return s1.TopologySpreadScore < s2.TopologySpreadScore
 	}
@@ -79,7 +108,24 @@ func (s1 *ClusterScore) Less(s2 *ClusterScore) bool {
 		return s1.AffinityScore < s2.AffinityScore
 	}
 
-	return s1.ObsoletePlacementAffinityScore < s2.ObsoletePlacementAffinityScore
+	if s1.ObsoletePlacementAffinityScore != s2.ObsoletePlacementAffinityScore {
+		return s1.ObsoletePlacementAffinityScore < s2.ObsoletePlacementAffinityScore
+	}
+
+	if s1.AutoscalingScore != s2.AutoscalingScore {
+		return s1.AutoscalingScore < s2.AutoscalingScore
+	}
+
+	return s1.PlacementSpreadScore < s2.PlacementSpreadScore
+}
+
+// sum returns the sum of all the scores in a ClusterScore, except for the weighted score.
+func (s *ClusterScore) sum() int64 {
+	return int64(s.TopologySpreadScore) +
+		int64(s.AffinityScore) +
+		int64(s.ObsoletePlacementAffinityScore) +
+		int64(s.AutoscalingScore) +
+		int64(s.PlacementSpreadScore)
 }
 
 // ScoredCluster is a cluster with a score.
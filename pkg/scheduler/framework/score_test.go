@@ -40,6 +40,8 @@ func TestClusterScoreAdd(t *testing.T) {
 		TopologySpreadScore:            1,
 		AffinityScore:                  5,
 		ObsoletePlacementAffinityScore: 1,
+		AutoscalingScore:               1,
+		PlacementSpreadScore:           3,
 	}
 
 	s1.Add(s2)
@@ -47,6 +49,8 @@ func TestClusterScoreAdd(t *testing.T) {
 		TopologySpreadScore:            1,
 		AffinityScore:                  5,
 		ObsoletePlacementAffinityScore: 1,
+		AutoscalingScore:               1,
+		PlacementSpreadScore:           3,
 	}
 	if diff := cmp.Diff(s1, want); diff != "" {
 		t.Fatalf("Add() diff (-got, +want): %s", diff)
@@ -126,6 +130,20 @@ func TestClusterScoreLess(t *testing.T) {
 		s2   *ClusterScore
 		want bool
 	}{
+		{
+			name: "s1 is less than s2 in weighted score",
+			s1: &ClusterScore{
+				WeightedScore:       10,
+				TopologySpreadScore: 1,
+				AffinityScore:       20,
+			},
+			s2: &ClusterScore{
+				WeightedScore:       20,
+				TopologySpreadScore: 0,
+				AffinityScore:       10,
+			},
+			want: true,
+		},
 		{
 			name: "s1 is less than s2 in topology spread score",
 			s1: &ClusterScore{
@@ -164,6 +182,40 @@ func TestClusterScoreLess(t *testing.T) {
 			},
 			want: true,
 		},
+		{
+			name: "s1 is less than s2 in autoscaling score",
+			s1: &ClusterScore{
+				TopologySpreadScore:            1,
+				AffinityScore:                  10,
+				ObsoletePlacementAffinityScore: 1,
+				AutoscalingScore:               0,
+				PlacementSpreadScore:           5,
+			},
+			s2: &ClusterScore{
+				TopologySpreadScore:            1,
+				AffinityScore:                  10,
+				ObsoletePlacementAffinityScore: 1,
+				AutoscalingScore:               1,
+				PlacementSpreadScore:           2,
+			},
+			want: true,
+		},
+		{
+			name: "s1 is less than s2 in placement spread score",
+			s1: &ClusterScore{
+				TopologySpreadScore:            1,
+				AffinityScore:                  10,
+				ObsoletePlacementAffinityScore: 1,
+				PlacementSpreadScore:           2,
+			},
+			s2: &ClusterScore{
+				TopologySpreadScore:            1,
+				AffinityScore:                  10,
+				ObsoletePlacementAffinityScore: 1,
+				PlacementSpreadScore:           5,
+			},
+			want: true,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -568,7 +620,7 @@ func TestScoredClustersString(t *testing.T) {
 					},
 				},
 			},
-			expected: "ScoredClusters{Cluster{Name: cluster-a, Score: &{1 2 0}}}",
+			expected: "ScoredClusters{Cluster{Name: cluster-a, Score: &{1 2 0 0 0 0}}}",
 		},
 		{
 			name: "multiple clusters",
@@ -598,7 +650,7 @@ func TestScoredClustersString(t *testing.T) {
 					},
 				},
 			},
-			expected: "ScoredClusters{Cluster{Name: cluster-a, Score: &{100 50 1}}, Cluster{Name: cluster-b, Score: &{0 0 0}}, Cluster{Name: cluster-c, Score: &{-10 -5 0}}}",
+			expected: "ScoredClusters{Cluster{Name: cluster-a, Score: &{100 50 1 0 0 0}}, Cluster{Name: cluster-b, Score: &{0 0 0 0 0 0}}, Cluster{Name: cluster-c, Score: &{-10 -5 0 0 0 0}}}",
 		},
 	}
 
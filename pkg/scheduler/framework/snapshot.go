@@ -0,0 +1,315 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/klog/v2"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+const (
+	// SchedulingCycleSnapshotsDataKey is the key in the data of a scheduling cycle snapshot config map
+	// under which the serialized snapshots are kept.
+	SchedulingCycleSnapshotsDataKey = "cycles.json"
+
+	// crpSchedulingCycleSnapshotsConfigMapNameFmt and rpSchedulingCycleSnapshotsConfigMapNameFmt are the
+	// formats of the names of scheduling cycle snapshot config maps; the placeholder is the name of the
+	// placement. The prefixes tell the config maps of ClusterResourcePlacements apart from the ones of
+	// ResourcePlacements in the fleet system namespace.
+	crpSchedulingCycleSnapshotsConfigMapNameFmt = "crp-%s-scheduling-cycles"
+	rpSchedulingCycleSnapshotsConfigMapNameFmt  = "rp-%s-scheduling-cycles"
+
+	// maxSchedulingCycleSnapshotsDataSize is the maximum size of the serialized snapshots kept in a
+	// config map; it stays below the 1 MiB size limit of config maps with some room left for metadata.
+	maxSchedulingCycleSnapshotsDataSize = 900 * 1024
+)
+
+// SchedulingCycleSnapshot is a snapshot of a scheduling cycle, which includes the serializable parts
+// of the cycle state, the outcomes of the Filter stage, and the outcomes of the Score stage.
+//
+// Snapshots are kept for the purpose of debugging scheduling decisions after the fact.
+type SchedulingCycleSnapshot struct {
+	// PolicySnapshot is the name of the scheduling policy snapshot that the cycle runs for.
+	PolicySnapshot string `json:"policySnapshot"`
+	// StartTime is the time when the cycle starts.
+	StartTime metav1.Time `json:"startTime"`
+	// LatencyMilliseconds is how long the cycle takes.
+	LatencyMilliseconds int64 `json:"latencyMilliseconds"`
+	// Error is the error that the cycle ends with, if any.
+	Error string `json:"error,omitempty"`
+
+	// Clusters are the names of the clusters that the cycle evaluates.
+	Clusters []string `json:"clusters,omitempty"`
+	// ScheduledOrBoundClusters are the names of the clusters that have scheduled or bound bindings
+	// when the cycle starts.
+	ScheduledOrBoundClusters []string `json:"scheduledOrBoundClusters,omitempty"`
+	// ObsoleteClusters are the names of the clusters that have obsolete bindings when the cycle starts.
+	ObsoleteClusters []string `json:"obsoleteClusters,omitempty"`
+	// BindingCounts are the numbers of active bindings from other placements per cluster.
+	BindingCounts map[string]int `json:"bindingCounts,omitempty"`
+	// SkippedFilterPlugins are the Filter plugins skipped in the cycle.
+	SkippedFilterPlugins []string `json:"skippedFilterPlugins,omitempty"`
+	// SkippedScorePlugins are the Score plugins skipped in the cycle.
+	SkippedScorePlugins []string `json:"skippedScorePlugins,omitempty"`
+	// DesiredBatchSize is the desired batch size of the cycle; it is only set for policies of the
+	// PickN placement type.
+	DesiredBatchSize int `json:"desiredBatchSize,omitempty"`
+	// BatchSizeLimit is the batch size limit set by the post-batch plugins; it is only set for
+	// policies of the PickN placement type.
+	BatchSizeLimit int `json:"batchSizeLimit,omitempty"`
+	// PluginStates are the states that plugins write to the cycle state, keyed by the state keys.
+	PluginStates map[string]string `json:"pluginStates,omitempty"`
+
+	// FilteredClusters are the clusters that are filtered out in the Filter stage.
+	FilteredClusters []FilteredClusterSnapshot `json:"filteredClusters,omitempty"`
+	// ScoredClusters are the clusters that pass the Filter stage, along with their scores.
+	ScoredClusters []ScoredClusterSnapshot `json:"scoredClusters,omitempty"`
+}
+
+// FilteredClusterSnapshot describes a cluster that is filtered out in a scheduling cycle.
+type FilteredClusterSnapshot struct {
+	// Cluster is the name of the cluster.
+	Cluster string `json:"cluster"`
+	// Plugin is the name of the Filter plugin that filters out the cluster.
+	Plugin string `json:"plugin"`
+	// Reasons are the reasons that the plugin reports.
+	Reasons []string `json:"reasons,omitempty"`
+}
+
+// ScoredClusterSnapshot describes a cluster that passes the Filter stage in a scheduling cycle.
+type ScoredClusterSnapshot struct {
+	// Cluster is the name of the cluster.
+	Cluster string `json:"cluster"`
+	// Score is the score that the cluster receives.
+	Score ClusterScore `json:"score"`
+}
+
+// SchedulingCycleSnapshotsConfigMapKey returns the namespace and name of the config map which keeps
+// the scheduling cycle snapshots of a placement.
+//
+// Snapshots of a ClusterResourcePlacement are kept in the fleet system namespace; snapshots of a
+// ResourcePlacement are kept in the namespace of the placement, so that they are garbage collected
+// along with the placement. The config maps are labeled with SchedulingCycleSnapshotsLabel, which
+// keeps them from being propagated.
+func SchedulingCycleSnapshotsConfigMapKey(placementKey queue.PlacementKey) (types.NamespacedName, error) {
+	namespace, name, err := controller.ExtractNamespaceNameFromKey(placementKey)
+	if err != nil {
+		return types.NamespacedName{}, err
+	}
+	if namespace == "" {
+		return types.NamespacedName{Namespace: utils.FleetSystemNamespace, Name: fmt.Sprintf(crpSchedulingCycleSnapshotsConfigMapNameFmt, name)}, nil
+	}
+	return types.NamespacedName{Namespace: namespace, Name: fmt.Sprintf(rpSchedulingCycleSnapshotsConfigMapNameFmt, name)}, nil
+}
+
+// ParseSchedulingCycleSnapshots returns the scheduling cycle snapshots kept in a config map, from
+// the oldest to the latest.
+func ParseSchedulingCycleSnapshots(cm *corev1.ConfigMap) ([]SchedulingCycleSnapshot, error) {
+	data, ok := cm.Data[SchedulingCycleSnapshotsDataKey]
+	if !ok || data == "" {
+		return nil, nil
+	}
+	var snapshots []SchedulingCycleSnapshot
+	if err := json.Unmarshal([]byte(data), &snapshots); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal scheduling cycle snapshots in config map %s: %w", klog.KObj(cm), err)
+	}
+	return snapshots, nil
+}
+
+// newSchedulingCycleSnapshot builds a snapshot of a scheduling cycle.
+func newSchedulingCycleSnapshot(policy placementv1beta1.PolicySnapshotObj, state *CycleState, startTime time.Time, cycleErr error) *SchedulingCycleSnapshot {
+	snapshot := &SchedulingCycleSnapshot{
+		PolicySnapshot:      policy.GetName(),
+		StartTime:           metav1.NewTime(startTime),
+		LatencyMilliseconds: time.Since(startTime).Milliseconds(),
+		DesiredBatchSize:    state.desiredBatchSize,
+		BatchSizeLimit:      state.batchSizeLimit,
+	}
+	if state.skippedFilterPlugins.Len() > 0 {
+		snapshot.SkippedFilterPlugins = sets.List(state.skippedFilterPlugins)
+	}
+	if state.skippedScorePlugins.Len() > 0 {
+		snapshot.SkippedScorePlugins = sets.List(state.skippedScorePlugins)
+	}
+	if cycleErr != nil {
+		snapshot.Error = cycleErr.Error()
+	}
+
+	for idx := range state.clusters {
+		snapshot.Clusters = append(snapshot.Clusters, state.clusters[idx].Name)
+	}
+	snapshot.ScheduledOrBoundClusters = trueKeys(state.scheduledOrBoundBindings)
+	snapshot.ObsoleteClusters = trueKeys(state.obsoleteBindings)
+	if len(state.bindingCounts) > 0 {
+		snapshot.BindingCounts = state.bindingCounts
+	}
+
+	state.store.Range(func(key, val any) bool {
+		if snapshot.PluginStates == nil {
+			snapshot.PluginStates = make(map[string]string)
+		}
+		// Plugin states are of arbitrary types; fall back to the Go representation if a state
+		// cannot be serialized as JSON.
+		serialized, err := json.Marshal(val)
+		if err != nil {
+			serialized = []byte(fmt.Sprintf("%+v", val))
+		}
+		snapshot.PluginStates[fmt.Sprintf("%v", key)] = string(serialized)
+		return true
+	})
+
+	for _, fc := range state.filtered {
+		snapshot.FilteredClusters = append(snapshot.FilteredClusters, FilteredClusterSnapshot{
+			Cluster: fc.cluster.Name,
+			Plugin:  fc.status.SourcePlugin(),
+			Reasons: fc.status.Reasons(),
+		})
+	}
+	sort.Slice(snapshot.FilteredClusters, func(i, j int) bool {
+		return snapshot.FilteredClusters[i].Cluster < snapshot.FilteredClusters[j].Cluster
+	})
+	for _, sc := range state.scored {
+		scoredCluster := ScoredClusterSnapshot{Cluster: sc.Cluster.Name}
+		if sc.Score != nil {
+			scoredCluster.Score = *sc.Score
+		}
+		snapshot.ScoredClusters = append(snapshot.ScoredClusters, scoredCluster)
+	}
+	sort.Slice(snapshot.ScoredClusters, func(i, j int) bool {
+		return snapshot.ScoredClusters[i].Cluster < snapshot.ScoredClusters[j].Cluster
+	})
+	return snapshot
+}
+
+// trueKeys returns the sorted keys in a map whose values are true.
+func trueKeys(m map[string]bool) []string {
+	keys := make([]string, 0, len(m))
+	for k, v := range m {
+		if v {
+			keys = append(keys, k)
+		}
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// appendSchedulingCycleSnapshot appends a snapshot to the serialized snapshots, and returns the
+// serialized result, which keeps at most the given number of the latest snapshots and fits in a
+// config map.
+func appendSchedulingCycleSnapshot(existing []SchedulingCycleSnapshot, snapshot *SchedulingCycleSnapshot, limit int) (string, error) {
+	snapshots := append(existing, *snapshot)
+	if len(snapshots) > limit {
+		snapshots = snapshots[len(snapshots)-limit:]
+	}
+	for {
+		data, err := json.Marshal(snapshots)
+		if err != nil {
+			return "", fmt.Errorf("failed to marshal scheduling cycle snapshots: %w", err)
+		}
+		if len(data) <= maxSchedulingCycleSnapshotsDataSize || len(snapshots) == 1 {
+			return string(data), nil
+		}
+		// Drop the oldest snapshots until the serialized result fits in a config map.
+		snapshots = snapshots[1:]
+	}
+}
+
+// recordSchedulingCycleSnapshot persists a snapshot of a scheduling cycle in the config map of
+// the placement, which keeps the snapshots of the latest scheduling cycles.
+//
+// Failures are only logged, as snapshots serve the purpose of debugging only and should never
+// block scheduling.
+func (f *framework) recordSchedulingCycleSnapshot(ctx context.Context, placementKey queue.PlacementKey, policy placementv1beta1.PolicySnapshotObj, state *CycleState, startTime time.Time, cycleErr error) {
+	policyRef := klog.KObj(policy)
+	snapshot := newSchedulingCycleSnapshot(policy, state, startTime, cycleErr)
+	cmKey, err := SchedulingCycleSnapshotsConfigMapKey(placementKey)
+	if err != nil {
+		klog.ErrorS(err, "Failed to find the config map for scheduling cycle snapshots", "policySnapshot", policyRef)
+		return
+	}
+
+	err = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := &corev1.ConfigMap{}
+		if err := f.uncachedReader.Get(ctx, cmKey, cm); err != nil {
+			if !apierrors.IsNotFound(err) {
+				return err
+			}
+			data, err := appendSchedulingCycleSnapshot(nil, snapshot, f.schedulingCycleSnapshotCount)
+			if err != nil {
+				return err
+			}
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: cmKey.Namespace,
+					Name:      cmKey.Name,
+					Labels: map[string]string{
+						placementv1beta1.SchedulingCycleSnapshotsLabel: "true",
+					},
+				},
+				Data: map[string]string{SchedulingCycleSnapshotsDataKey: data},
+			}
+			// Have the config map garbage collected along with the placement.
+			if owner := metav1.GetControllerOf(policy); owner != nil {
+				cm.OwnerReferences = []metav1.OwnerReference{*owner}
+			}
+			return f.client.Create(ctx, cm)
+		}
+
+		if _, ok := cm.Labels[placementv1beta1.SchedulingCycleSnapshotsLabel]; !ok {
+			// Never overwrite a config map that happens to have the same name but is not created by
+			// the scheduler.
+			return fmt.Errorf("config map %s is not labeled with %s", cmKey, placementv1beta1.SchedulingCycleSnapshotsLabel)
+		}
+		existing, err := ParseSchedulingCycleSnapshots(cm)
+		if err != nil {
+			// Start over if the existing snapshots are corrupted.
+			klog.ErrorS(err, "Discarding the existing scheduling cycle snapshots", "configMap", klog.KObj(cm))
+			existing = nil
+		}
+		data, err := appendSchedulingCycleSnapshot(existing, snapshot, f.schedulingCycleSnapshotCount)
+		if err != nil {
+			return err
+		}
+		if cm.Data == nil {
+			cm.Data = make(map[string]string)
+		}
+		cm.Data[SchedulingCycleSnapshotsDataKey] = data
+		return f.client.Update(ctx, cm)
+	})
+	if err != nil {
+		klog.ErrorS(err, "Failed to record the scheduling cycle snapshot", "policySnapshot", policyRef, "configMap", cmKey)
+	}
+}
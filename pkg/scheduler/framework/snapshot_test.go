@@ -0,0 +1,291 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package framework
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+)
+
+// TestSchedulingCycleSnapshotsConfigMapKey tests the SchedulingCycleSnapshotsConfigMapKey function.
+func TestSchedulingCycleSnapshotsConfigMapKey(t *testing.T) {
+	testCases := []struct {
+		name         string
+		placementKey queue.PlacementKey
+		want         types.NamespacedName
+		wantErr      bool
+	}{
+		{
+			name:         "cluster resource placement",
+			placementKey: queue.PlacementKey(crpName),
+			want:         types.NamespacedName{Namespace: "fleet-system", Name: "crp-" + crpName + "-scheduling-cycles"},
+		},
+		{
+			name:         "resource placement",
+			placementKey: queue.PlacementKey("test-ns/" + crpName),
+			want:         types.NamespacedName{Namespace: "test-ns", Name: "rp-" + crpName + "-scheduling-cycles"},
+		},
+		{
+			name:         "invalid placement key",
+			placementKey: queue.PlacementKey("a/b/c"),
+			wantErr:      true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := SchedulingCycleSnapshotsConfigMapKey(tc.placementKey)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("SchedulingCycleSnapshotsConfigMapKey() error = %v, want error %t", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("SchedulingCycleSnapshotsConfigMapKey() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestNewSchedulingCycleSnapshot tests the newSchedulingCycleSnapshot function.
+func TestNewSchedulingCycleSnapshot(t *testing.T) {
+	policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{Name: policyName},
+	}
+	clusters := []clusterv1beta1.MemberCluster{
+		{ObjectMeta: metav1.ObjectMeta{Name: clusterName}},
+		{ObjectMeta: metav1.ObjectMeta{Name: altClusterName}},
+		{ObjectMeta: metav1.ObjectMeta{Name: anotherClusterName}},
+	}
+	state := NewCycleState(clusters, nil, []placementv1beta1.BindingObj{
+		&placementv1beta1.ClusterResourceBinding{
+			Spec: placementv1beta1.ResourceBindingSpec{TargetCluster: clusterName},
+		},
+	})
+	state.bindingCounts = map[string]int{altClusterName: 2}
+	state.skippedScorePlugins.Insert(dummyPluginName)
+	state.desiredBatchSize = 2
+	state.batchSizeLimit = 1
+	state.Write("plugin-state", map[string]int{"count": 1})
+	state.filtered = filteredClusterWithStatusList{
+		{
+			cluster: &clusters[2],
+			status:  NewNonErrorStatus(ClusterUnschedulable, dummyPluginName, "cluster does not match"),
+		},
+	}
+	state.scored = ScoredClusters{
+		{Cluster: &clusters[1], Score: &ClusterScore{AffinityScore: 10}},
+		{Cluster: &clusters[0], Score: &ClusterScore{TopologySpreadScore: 1}},
+	}
+
+	startTime := time.Now().Add(-time.Second)
+	got := newSchedulingCycleSnapshot(policy, state, startTime, errors.New("cycle failed"))
+	want := &SchedulingCycleSnapshot{
+		PolicySnapshot:           policyName,
+		StartTime:                metav1.NewTime(startTime),
+		Error:                    "cycle failed",
+		Clusters:                 []string{clusterName, altClusterName, anotherClusterName},
+		ScheduledOrBoundClusters: []string{clusterName},
+		BindingCounts:            map[string]int{altClusterName: 2},
+		SkippedScorePlugins:      []string{dummyPluginName},
+		DesiredBatchSize:         2,
+		BatchSizeLimit:           1,
+		PluginStates:             map[string]string{"plugin-state": `{"count":1}`},
+		FilteredClusters: []FilteredClusterSnapshot{
+			{Cluster: anotherClusterName, Plugin: dummyPluginName, Reasons: []string{"cluster does not match"}},
+		},
+		ScoredClusters: []ScoredClusterSnapshot{
+			{Cluster: clusterName, Score: ClusterScore{TopologySpreadScore: 1}},
+			{Cluster: altClusterName, Score: ClusterScore{AffinityScore: 10}},
+		},
+	}
+	if diff := cmp.Diff(got, want, cmpopts.IgnoreFields(SchedulingCycleSnapshot{}, "LatencyMilliseconds")); diff != "" {
+		t.Errorf("newSchedulingCycleSnapshot() diff (-got, +want):\n%s", diff)
+	}
+	if got.LatencyMilliseconds < 1000 {
+		t.Errorf("newSchedulingCycleSnapshot() latency = %dms, want at least 1000ms", got.LatencyMilliseconds)
+	}
+}
+
+// TestAppendSchedulingCycleSnapshot tests the appendSchedulingCycleSnapshot function.
+func TestAppendSchedulingCycleSnapshot(t *testing.T) {
+	existing := []SchedulingCycleSnapshot{
+		{PolicySnapshot: "policy-0"},
+		{PolicySnapshot: "policy-1"},
+	}
+	testCases := []struct {
+		name     string
+		existing []SchedulingCycleSnapshot
+		snapshot *SchedulingCycleSnapshot
+		limit    int
+		want     []string
+	}{
+		{
+			name:     "no existing snapshots",
+			snapshot: &SchedulingCycleSnapshot{PolicySnapshot: "policy-2"},
+			limit:    3,
+			want:     []string{"policy-2"},
+		},
+		{
+			name:     "below the limit",
+			existing: existing,
+			snapshot: &SchedulingCycleSnapshot{PolicySnapshot: "policy-2"},
+			limit:    3,
+			want:     []string{"policy-0", "policy-1", "policy-2"},
+		},
+		{
+			name:     "over the limit",
+			existing: existing,
+			snapshot: &SchedulingCycleSnapshot{PolicySnapshot: "policy-2"},
+			limit:    2,
+			want:     []string{"policy-1", "policy-2"},
+		},
+		{
+			name:     "over the size limit",
+			existing: existing,
+			snapshot: &SchedulingCycleSnapshot{
+				PolicySnapshot: "policy-2",
+				Error:          strings.Repeat("x", maxSchedulingCycleSnapshotsDataSize),
+			},
+			limit: 3,
+			want:  []string{"policy-2"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := appendSchedulingCycleSnapshot(tc.existing, tc.snapshot, tc.limit)
+			if err != nil {
+				t.Fatalf("appendSchedulingCycleSnapshot() = %v, want no error", err)
+			}
+			snapshots, err := ParseSchedulingCycleSnapshots(&corev1.ConfigMap{
+				Data: map[string]string{SchedulingCycleSnapshotsDataKey: data},
+			})
+			if err != nil {
+				t.Fatalf("ParseSchedulingCycleSnapshots() = %v, want no error", err)
+			}
+			got := make([]string, 0, len(snapshots))
+			for _, s := range snapshots {
+				got = append(got, s.PolicySnapshot)
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("appendSchedulingCycleSnapshot() diff (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestRecordSchedulingCycleSnapshot tests the recordSchedulingCycleSnapshot method.
+func TestRecordSchedulingCycleSnapshot(t *testing.T) {
+	ownerRef := metav1.OwnerReference{
+		APIVersion: placementv1beta1.GroupVersion.String(),
+		Kind:       placementv1beta1.ClusterResourcePlacementKind,
+		Name:       crpName,
+		UID:        "test-uid",
+		Controller: ptr.To(true),
+	}
+	policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            policyName,
+			OwnerReferences: []metav1.OwnerReference{ownerRef},
+		},
+	}
+	cmKey := types.NamespacedName{Namespace: "fleet-system", Name: "crp-" + crpName + "-scheduling-cycles"}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).Build()
+	f := &framework{
+		client:                       fakeClient,
+		uncachedReader:               fakeClient,
+		schedulingCycleSnapshotCount: 2,
+	}
+	ctx := context.Background()
+	for i := 0; i < 3; i++ {
+		state := NewCycleState(nil, nil)
+		state.desiredBatchSize = i + 1
+		f.recordSchedulingCycleSnapshot(ctx, queue.PlacementKey(crpName), policy, state, time.Now(), nil)
+	}
+
+	cm := &corev1.ConfigMap{}
+	if err := fakeClient.Get(ctx, cmKey, cm); err != nil {
+		t.Fatalf("failed to get the config map: %v", err)
+	}
+	if diff := cmp.Diff(cm.OwnerReferences, []metav1.OwnerReference{ownerRef}); diff != "" {
+		t.Errorf("config map owner references diff (-got, +want):\n%s", diff)
+	}
+	if got, want := cm.Labels[placementv1beta1.SchedulingCycleSnapshotsLabel], "true"; got != want {
+		t.Errorf("config map label %s = %q, want %q", placementv1beta1.SchedulingCycleSnapshotsLabel, got, want)
+	}
+	snapshots, err := ParseSchedulingCycleSnapshots(cm)
+	if err != nil {
+		t.Fatalf("ParseSchedulingCycleSnapshots() = %v, want no error", err)
+	}
+	got := make([]int, 0, len(snapshots))
+	for _, s := range snapshots {
+		got = append(got, s.DesiredBatchSize)
+	}
+	if diff := cmp.Diff(got, []int{2, 3}); diff != "" {
+		t.Errorf("recorded snapshots diff (-got, +want):\n%s", diff)
+	}
+}
+
+// TestRecordSchedulingCycleSnapshot_UnlabeledConfigMap tests that the recordSchedulingCycleSnapshot method
+// leaves alone a config map of the same name that is not created by the scheduler.
+func TestRecordSchedulingCycleSnapshot_UnlabeledConfigMap(t *testing.T) {
+	policy := &placementv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: policyName,
+		},
+	}
+	userCM := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fleet-system",
+			Name:      "crp-" + crpName + "-scheduling-cycles",
+		},
+		Data: map[string]string{"key": "value"},
+	}
+
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme.Scheme).WithObjects(userCM).Build()
+	f := &framework{
+		client:                       fakeClient,
+		uncachedReader:               fakeClient,
+		schedulingCycleSnapshotCount: 2,
+	}
+	ctx := context.Background()
+	f.recordSchedulingCycleSnapshot(ctx, queue.PlacementKey(crpName), policy, NewCycleState(nil, nil), time.Now(), nil)
+
+	cm := &corev1.ConfigMap{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Namespace: userCM.Namespace, Name: userCM.Name}, cm); err != nil {
+		t.Fatalf("failed to get the config map: %v", err)
+	}
+	if diff := cmp.Diff(cm.Data, userCM.Data); diff != "" {
+		t.Errorf("config map data diff (-got, +want):\n%s", diff)
+	}
+}
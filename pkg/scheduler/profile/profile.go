@@ -20,8 +20,11 @@ package profile
 import (
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clusteraffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clusterautoscaling"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clustereligibility"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/namespaceaffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/placementaffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/placementspread"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/sameplacementaffinity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/tainttoleration"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/topologyspreadconstraints"
@@ -35,6 +38,18 @@ const (
 // Options holds the configuration options for creating a scheduling profile.
 type Options struct {
 	ClusterAffinityPlugin *clusteraffinity.Plugin
+
+	// EnablePlacementSpreadPlugin controls whether the profile includes the placement spread plugin, which
+	// prefers clusters hosting fewer bindings across all placements in the fleet.
+	EnablePlacementSpreadPlugin bool
+
+	// EnableScaleDownFiltering controls whether the profile filters out clusters that are scaling down,
+	// as reported by the cluster autoscaling properties of the clusters.
+	EnableScaleDownFiltering bool
+
+	// EnableProvisioningHeadroomScoring controls whether the profile prefers clusters with pending capacity
+	// expansion, as reported by the cluster autoscaling properties of the clusters.
+	EnableProvisioningHeadroomScoring bool
 }
 
 // NewDefaultProfile creates a default scheduling profile.
@@ -53,14 +68,30 @@ func NewProfile(opts Options) *framework.Profile {
 	}
 	clusterEligibilityPlugin := clustereligibility.New()
 	namespaceAffinityPlugin := namespaceaffinity.New()
+	placementAffinityPlugin := placementaffinity.New()
 	samePlacementAffinityPlugin := sameplacementaffinity.New()
 	topologySpreadConstraintsPlugin := topologyspreadconstraints.New()
 	taintTolerationPlugin := tainttoleration.New()
 
 	p.WithPostBatchPlugin(&topologySpreadConstraintsPlugin).
-		WithPreFilterPlugin(&clusterAffinityPlugin).WithPreFilterPlugin(&namespaceAffinityPlugin).WithPreFilterPlugin(&topologySpreadConstraintsPlugin).
-		WithFilterPlugin(&clusterAffinityPlugin).WithFilterPlugin(&clusterEligibilityPlugin).WithFilterPlugin(&namespaceAffinityPlugin).WithFilterPlugin(&taintTolerationPlugin).WithFilterPlugin(&samePlacementAffinityPlugin).WithFilterPlugin(&topologySpreadConstraintsPlugin).
+		WithPreFilterPlugin(&clusterAffinityPlugin).WithPreFilterPlugin(&namespaceAffinityPlugin).WithPreFilterPlugin(&placementAffinityPlugin).WithPreFilterPlugin(&topologySpreadConstraintsPlugin).
+		WithFilterPlugin(&clusterAffinityPlugin).WithFilterPlugin(&clusterEligibilityPlugin).WithFilterPlugin(&namespaceAffinityPlugin).WithFilterPlugin(&placementAffinityPlugin).WithFilterPlugin(&taintTolerationPlugin).WithFilterPlugin(&samePlacementAffinityPlugin).WithFilterPlugin(&topologySpreadConstraintsPlugin).
 		WithPreScorePlugin(&clusterAffinityPlugin).WithPreScorePlugin(&topologySpreadConstraintsPlugin).
 		WithScorePlugin(&clusterAffinityPlugin).WithScorePlugin(&samePlacementAffinityPlugin).WithScorePlugin(&topologySpreadConstraintsPlugin)
+
+	if opts.EnablePlacementSpreadPlugin {
+		placementSpreadPlugin := placementspread.New()
+		p.WithPreScorePlugin(&placementSpreadPlugin).WithScorePlugin(&placementSpreadPlugin)
+	}
+
+	if opts.EnableScaleDownFiltering || opts.EnableProvisioningHeadroomScoring {
+		clusterAutoscalingPlugin := clusterautoscaling.New()
+		if opts.EnableScaleDownFiltering {
+			p.WithFilterPlugin(&clusterAutoscalingPlugin)
+		}
+		if opts.EnableProvisioningHeadroomScoring {
+			p.WithScorePlugin(&clusterAutoscalingPlugin)
+		}
+	}
 	return p
 }
@@ -23,8 +23,11 @@ import (
 
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clusteraffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clusterautoscaling"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/clustereligibility"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/namespaceaffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/placementaffinity"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/placementspread"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/sameplacementaffinity"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/tainttoleration"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework/plugins/topologyspreadconstraints"
@@ -51,13 +54,14 @@ func TestNewDefaultProfile(t *testing.T) {
 	testClusterAffinityPlugin := clusteraffinity.New()
 	testClusterEligibilityPlugin := clustereligibility.New()
 	testNamespaceAffinityPlugin := namespaceaffinity.New()
+	testPlacementAffinityPlugin := placementaffinity.New()
 	testSamePlacementAffinityPlugin := sameplacementaffinity.New()
 	testTopologySpreadConstraintsPlugin := topologyspreadconstraints.New()
 	testTaintTolerationPlugin := tainttoleration.New()
 
 	wantProfile.WithPostBatchPlugin(&testTopologySpreadConstraintsPlugin).
-		WithPreFilterPlugin(&testClusterAffinityPlugin).WithPreFilterPlugin(&testNamespaceAffinityPlugin).WithPreFilterPlugin(&testTopologySpreadConstraintsPlugin).
-		WithFilterPlugin(&testClusterAffinityPlugin).WithFilterPlugin(&testClusterEligibilityPlugin).WithFilterPlugin(&testNamespaceAffinityPlugin).WithFilterPlugin(&testTaintTolerationPlugin).WithFilterPlugin(&testSamePlacementAffinityPlugin).WithFilterPlugin(&testTopologySpreadConstraintsPlugin).
+		WithPreFilterPlugin(&testClusterAffinityPlugin).WithPreFilterPlugin(&testNamespaceAffinityPlugin).WithPreFilterPlugin(&testPlacementAffinityPlugin).WithPreFilterPlugin(&testTopologySpreadConstraintsPlugin).
+		WithFilterPlugin(&testClusterAffinityPlugin).WithFilterPlugin(&testClusterEligibilityPlugin).WithFilterPlugin(&testNamespaceAffinityPlugin).WithFilterPlugin(&testPlacementAffinityPlugin).WithFilterPlugin(&testTaintTolerationPlugin).WithFilterPlugin(&testSamePlacementAffinityPlugin).WithFilterPlugin(&testTopologySpreadConstraintsPlugin).
 		WithPreScorePlugin(&testClusterAffinityPlugin).WithPreScorePlugin(&testTopologySpreadConstraintsPlugin).
 		WithScorePlugin(&testClusterAffinityPlugin).WithScorePlugin(&testSamePlacementAffinityPlugin).WithScorePlugin(&testTopologySpreadConstraintsPlugin)
 
@@ -67,6 +71,7 @@ func TestNewDefaultProfile(t *testing.T) {
 			clusteraffinity.Plugin{},
 			clustereligibility.Plugin{},
 			namespaceaffinity.Plugin{},
+			placementaffinity.Plugin{},
 			sameplacementaffinity.Plugin{},
 			topologyspreadconstraints.Plugin{},
 			tainttoleration.Plugin{})); diff != "" {
@@ -74,6 +79,114 @@ func TestNewDefaultProfile(t *testing.T) {
 	}
 }
 
+// TestNewProfileWithPlacementSpreadPlugin tests that the placement spread plugin is registered when enabled.
+func TestNewProfileWithPlacementSpreadPlugin(t *testing.T) {
+	profile := NewProfile(Options{EnablePlacementSpreadPlugin: true})
+
+	wantProfile := framework.NewProfile(defaultProfileName)
+
+	testClusterAffinityPlugin := clusteraffinity.New()
+	testClusterEligibilityPlugin := clustereligibility.New()
+	testNamespaceAffinityPlugin := namespaceaffinity.New()
+	testPlacementAffinityPlugin := placementaffinity.New()
+	testSamePlacementAffinityPlugin := sameplacementaffinity.New()
+	testTopologySpreadConstraintsPlugin := topologyspreadconstraints.New()
+	testTaintTolerationPlugin := tainttoleration.New()
+	testPlacementSpreadPlugin := placementspread.New()
+
+	wantProfile.WithPostBatchPlugin(&testTopologySpreadConstraintsPlugin).
+		WithPreFilterPlugin(&testClusterAffinityPlugin).WithPreFilterPlugin(&testNamespaceAffinityPlugin).WithPreFilterPlugin(&testPlacementAffinityPlugin).WithPreFilterPlugin(&testTopologySpreadConstraintsPlugin).
+		WithFilterPlugin(&testClusterAffinityPlugin).WithFilterPlugin(&testClusterEligibilityPlugin).WithFilterPlugin(&testNamespaceAffinityPlugin).WithFilterPlugin(&testPlacementAffinityPlugin).WithFilterPlugin(&testTaintTolerationPlugin).WithFilterPlugin(&testSamePlacementAffinityPlugin).WithFilterPlugin(&testTopologySpreadConstraintsPlugin).
+		WithPreScorePlugin(&testClusterAffinityPlugin).WithPreScorePlugin(&testTopologySpreadConstraintsPlugin).WithPreScorePlugin(&testPlacementSpreadPlugin).
+		WithScorePlugin(&testClusterAffinityPlugin).WithScorePlugin(&testSamePlacementAffinityPlugin).WithScorePlugin(&testTopologySpreadConstraintsPlugin).WithScorePlugin(&testPlacementSpreadPlugin)
+
+	if diff := cmp.Diff(profile, wantProfile,
+		cmp.AllowUnexported(framework.Profile{},
+			clusteraffinity.Plugin{},
+			clustereligibility.Plugin{},
+			namespaceaffinity.Plugin{},
+			placementaffinity.Plugin{},
+			placementspread.Plugin{},
+			sameplacementaffinity.Plugin{},
+			topologyspreadconstraints.Plugin{},
+			tainttoleration.Plugin{})); diff != "" {
+		t.Errorf("NewProfile() mismatch (-got +want):\n%s", diff)
+	}
+}
+
+// TestNewProfileWithClusterAutoscalingPlugin tests that the cluster autoscaling plugin is registered
+// at the extension points that are enabled.
+func TestNewProfileWithClusterAutoscalingPlugin(t *testing.T) {
+	testCases := []struct {
+		name         string
+		opts         Options
+		wantAsFilter bool
+		wantAsScorer bool
+	}{
+		{
+			name:         "scale down filtering only",
+			opts:         Options{EnableScaleDownFiltering: true},
+			wantAsFilter: true,
+		},
+		{
+			name:         "provisioning headroom scoring only",
+			opts:         Options{EnableProvisioningHeadroomScoring: true},
+			wantAsScorer: true,
+		},
+		{
+			name: "both",
+			opts: Options{
+				EnableScaleDownFiltering:          true,
+				EnableProvisioningHeadroomScoring: true,
+			},
+			wantAsFilter: true,
+			wantAsScorer: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			profile := NewProfile(tc.opts)
+
+			wantProfile := framework.NewProfile(defaultProfileName)
+
+			testClusterAffinityPlugin := clusteraffinity.New()
+			testClusterEligibilityPlugin := clustereligibility.New()
+			testNamespaceAffinityPlugin := namespaceaffinity.New()
+			testPlacementAffinityPlugin := placementaffinity.New()
+			testSamePlacementAffinityPlugin := sameplacementaffinity.New()
+			testTopologySpreadConstraintsPlugin := topologyspreadconstraints.New()
+			testTaintTolerationPlugin := tainttoleration.New()
+			testClusterAutoscalingPlugin := clusterautoscaling.New()
+
+			wantProfile.WithPostBatchPlugin(&testTopologySpreadConstraintsPlugin).
+				WithPreFilterPlugin(&testClusterAffinityPlugin).WithPreFilterPlugin(&testNamespaceAffinityPlugin).WithPreFilterPlugin(&testPlacementAffinityPlugin).WithPreFilterPlugin(&testTopologySpreadConstraintsPlugin).
+				WithFilterPlugin(&testClusterAffinityPlugin).WithFilterPlugin(&testClusterEligibilityPlugin).WithFilterPlugin(&testNamespaceAffinityPlugin).WithFilterPlugin(&testPlacementAffinityPlugin).WithFilterPlugin(&testTaintTolerationPlugin).WithFilterPlugin(&testSamePlacementAffinityPlugin).WithFilterPlugin(&testTopologySpreadConstraintsPlugin).
+				WithPreScorePlugin(&testClusterAffinityPlugin).WithPreScorePlugin(&testTopologySpreadConstraintsPlugin).
+				WithScorePlugin(&testClusterAffinityPlugin).WithScorePlugin(&testSamePlacementAffinityPlugin).WithScorePlugin(&testTopologySpreadConstraintsPlugin)
+			if tc.wantAsFilter {
+				wantProfile.WithFilterPlugin(&testClusterAutoscalingPlugin)
+			}
+			if tc.wantAsScorer {
+				wantProfile.WithScorePlugin(&testClusterAutoscalingPlugin)
+			}
+
+			if diff := cmp.Diff(profile, wantProfile,
+				cmp.AllowUnexported(framework.Profile{},
+					clusteraffinity.Plugin{},
+					clusterautoscaling.Plugin{},
+					clustereligibility.Plugin{},
+					namespaceaffinity.Plugin{},
+					placementaffinity.Plugin{},
+					sameplacementaffinity.Plugin{},
+					topologyspreadconstraints.Plugin{},
+					tainttoleration.Plugin{})); diff != "" {
+				t.Errorf("NewProfile() mismatch (-got +want):\n%s", diff)
+			}
+		})
+	}
+}
+
 // TestNewProfileWithOptions tests the creation of a scheduling profile with custom options.
 // It verifies that:
 // 1. Profile is created successfully with both empty and custom options
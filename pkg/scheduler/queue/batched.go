@@ -31,10 +31,13 @@ const (
 // interface.
 //
 // It consists of two work queues to allow processing for both immediate and batched
-// processing for scheduling related events (changes) of different responsiveness levels.
+// processing for scheduling related events (changes) of different responsiveness levels,
+// plus an unschedulable queue, which holds placement keys that cannot be fully scheduled at the moment.
 type batchedProcessingPlacementSchedulingQueue struct {
-	active  workqueue.TypedRateLimitingInterface[any]
-	batched workqueue.TypedRateLimitingInterface[any]
+	active        workqueue.TypedRateLimitingInterface[any]
+	batched       workqueue.TypedRateLimitingInterface[any]
+	rateLimiter   *perPlacementRateLimiter
+	unschedulable *unschedulablePlacements
 
 	moveNow           chan struct{}
 	movePeriodSeconds int32
@@ -71,6 +74,8 @@ func (bq *batchedProcessingPlacementSchedulingQueue) Close() {
 	// active queue might not be able to accept the key any more (which is OK and does not
 	// result in an error).
 	close(bq.moveNow)
+	// Stop moving items from the unschedulable queue; items left there are dropped.
+	bq.unschedulable.stop()
 
 	bq.batched.ShutDown()
 	bq.active.ShutDown()
@@ -82,6 +87,8 @@ func (bq *batchedProcessingPlacementSchedulingQueue) Close() {
 func (bq *batchedProcessingPlacementSchedulingQueue) CloseWithDrain() {
 	// Signal that all items in the batched queue should be moved to the active queue right away.
 	close(bq.moveNow)
+	// Stop moving items from the unschedulable queue; items left there are dropped.
+	bq.unschedulable.stop()
 
 	// Wait until all the items in the moving process from the batched queue to the active queue have completed
 	// their moves.
@@ -111,6 +118,7 @@ func (bq *batchedProcessingPlacementSchedulingQueue) Done(placementKey Placement
 //
 // Note that this bypasses the rate limiter (if any).
 func (bq *batchedProcessingPlacementSchedulingQueue) Add(placementKey PlacementKey) {
+	bq.unschedulable.remove(placementKey)
 	bq.active.Add(placementKey)
 }
 
@@ -118,12 +126,14 @@ func (bq *batchedProcessingPlacementSchedulingQueue) Add(placementKey PlacementK
 //
 // Note that this bypasses the rate limiter (if any).
 func (bq *batchedProcessingPlacementSchedulingQueue) AddAfter(placementKey PlacementKey, duration time.Duration) {
+	bq.unschedulable.remove(placementKey)
 	bq.active.AddAfter(placementKey, duration)
 }
 
 // AddRateLimited adds a PlacementKey to the work queue after the rate limiter (if any)
 // says that it is OK, for immediate processing.
 func (bq *batchedProcessingPlacementSchedulingQueue) AddRateLimited(placementKey PlacementKey) {
+	bq.unschedulable.remove(placementKey)
 	bq.active.AddRateLimited(placementKey)
 }
 
@@ -138,8 +148,32 @@ func (bq *batchedProcessingPlacementSchedulingQueue) AddBatched(placementKey Pla
 	bq.batched.Add(placementKey)
 }
 
+// AddUnschedulable adds a PlacementKey to the unschedulable queue.
+func (bq *batchedProcessingPlacementSchedulingQueue) AddUnschedulable(placementKey PlacementKey) {
+	bq.unschedulable.add(placementKey)
+}
+
+// FlushUnschedulable moves all the PlacementKeys in the unschedulable queue to the active queue.
+//
+// Note that the keys bypass the batched queue, so that the scheduler can react to new capacity
+// in the fleet right away, unless a rate limit has been set up for them, in which case they are
+// added after the rate limiter says that it is OK.
+func (bq *batchedProcessingPlacementSchedulingQueue) FlushUnschedulable() {
+	for _, key := range bq.unschedulable.popAll() {
+		requeueForFleetChange(bq.active, bq.rateLimiter, key)
+	}
+}
+
+// SetRateLimit sets up the rate limit for requeueing a PlacementKey in the active queue.
+func (bq *batchedProcessingPlacementSchedulingQueue) SetRateLimit(placementKey PlacementKey, rateLimit *PlacementRateLimit) {
+	bq.rateLimiter.setLimit(placementKey, rateLimit)
+}
+
 // Run starts the scheduling queue.
 func (bq *batchedProcessingPlacementSchedulingQueue) Run() {
+	// Spin up a goroutine to move items that have stayed in the unschedulable queue for too long to the active queue.
+	bq.unschedulable.run(func(key PlacementKey) { bq.active.Add(key) })
+
 	// Spin up a goroutine to move items periodically from the batched queue to the active queue.
 	go func() {
 		timer := time.NewTimer(time.Duration(bq.movePeriodSeconds) * time.Second)
@@ -199,7 +233,9 @@ func (bq *batchedProcessingPlacementSchedulingQueue) moveAllBatchedItemsToActive
 		// this pattern risks synchronized processing (i.e., a key is popped from the batched queue, immeidiately added to the
 		// active queue and gets marked as done by the scheduler, then added back to the batched queue again by
 		// one of the watchers before the key moving attempt is finished, which results in perpetual key moving).
-		bq.active.Add(key)
+		//
+		// Keys with a rate limit set up are added to the active queue after the rate limiter says that it is OK.
+		requeueForFleetChange(bq.active, bq.rateLimiter, key)
 		bq.batched.Done(key)
 		bq.batched.Forget(key)
 	}
@@ -220,13 +256,16 @@ func NewBatchedProcessingPlacementSchedulingQueue(name string, activeQRateLimite
 		movePeriodSeconds = defaultBatchedProcessingPlacementSchedulingQueueOptions.movePeriodSeconds
 	}
 
+	perPlacementRateLimiter := newPerPlacementRateLimiter(activeQRateLimiter)
 	return &batchedProcessingPlacementSchedulingQueue{
-		active: workqueue.NewTypedRateLimitingQueueWithConfig(activeQRateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{
+		active: workqueue.NewTypedRateLimitingQueueWithConfig[any](perPlacementRateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{
 			Name: fmt.Sprintf("%s_Active", name),
 		}),
 		batched: workqueue.NewTypedRateLimitingQueueWithConfig(batchedQRateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{
 			Name: fmt.Sprintf("%s_Batched", name),
 		}),
+		rateLimiter:       perPlacementRateLimiter,
+		unschedulable:     newUnschedulablePlacements(defaultMaxUnschedulableDuration, defaultUnschedulableFlushPeriod),
 		moveNow:           make(chan struct{}),
 		movePeriodSeconds: movePeriodSeconds,
 	}
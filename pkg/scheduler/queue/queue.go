@@ -46,6 +46,12 @@ type PlacementSchedulingQueueWriter interface {
 	//
 	// This is most helpful in cases where certain changes do not require immediate processing by the scheduler.
 	AddBatched(placementKey PlacementKey)
+	// FlushUnschedulable moves all the PlacementKeys in the unschedulable queue to the work queue
+	// for immediate processing.
+	//
+	// Sources should call this method when they observe a change that might make unschedulable
+	// placements schedulable, e.g., a cluster joins the fleet, or has its labels or taints updated.
+	FlushUnschedulable()
 }
 
 // PlacementSchedulingQueue is an interface which queues PlacementKeys for the scheduler
@@ -66,4 +72,13 @@ type PlacementSchedulingQueue interface {
 	Done(placementKey PlacementKey)
 	// Forget untracks a PlacementKey from rate limiter(s) (if any) set up with the queue.
 	Forget(placementKey PlacementKey)
+	// AddUnschedulable adds a PlacementKey, which cannot be fully scheduled at the moment, to the
+	// unschedulable queue. The key is moved back to the work queue when FlushUnschedulable is called,
+	// or when it has stayed in the unschedulable queue for too long.
+	AddUnschedulable(placementKey PlacementKey)
+	// SetRateLimit sets up the rate limit for requeueing a PlacementKey, which overrides the rate limiter
+	// set up with the queue; it applies when the key is added with AddRateLimited, and when the key is
+	// requeued as the fleet changes (i.e., with AddBatched and FlushUnschedulable). A nil rate limit
+	// removes the rate limit set up earlier (if any).
+	SetRateLimit(placementKey PlacementKey, rateLimit *PlacementRateLimit)
 }
@@ -0,0 +1,130 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"math"
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// PlacementRateLimit is the rate limit for requeueing a specific PlacementKey, which overrides the
+// rate limiter set up with the scheduling queue.
+type PlacementRateLimit struct {
+	// BaseDelay is the delay before the key is requeued for the first time after it has been forgotten;
+	// the delay doubles on each consecutive requeue.
+	BaseDelay time.Duration
+	// MaxDelay is the maximum delay before the key is requeued.
+	MaxDelay time.Duration
+}
+
+// perPlacementRateLimiter is a rate limiter that rate limits the PlacementKeys with a PlacementRateLimit
+// set up with exponential backoff per their own rate limits, and falls back to a default rate limiter
+// for all the other keys.
+type perPlacementRateLimiter struct {
+	defaultRateLimiter workqueue.TypedRateLimiter[any]
+
+	mu sync.Mutex
+	// limits are the rate limits set up for specific PlacementKeys.
+	limits map[any]PlacementRateLimit
+	// failures are the numbers of consecutive requeues of the PlacementKeys with a rate limit.
+	failures map[any]int
+}
+
+// Verify that perPlacementRateLimiter implements workqueue.TypedRateLimiter at compile time.
+var _ workqueue.TypedRateLimiter[any] = &perPlacementRateLimiter{}
+
+func newPerPlacementRateLimiter(defaultRateLimiter workqueue.TypedRateLimiter[any]) *perPlacementRateLimiter {
+	return &perPlacementRateLimiter{
+		defaultRateLimiter: defaultRateLimiter,
+		limits:             make(map[any]PlacementRateLimit),
+		failures:           make(map[any]int),
+	}
+}
+
+// When returns how long to wait before requeueing an item.
+func (rl *perPlacementRateLimiter) When(item any) time.Duration {
+	rl.mu.Lock()
+	limit, ok := rl.limits[item]
+	if !ok {
+		rl.mu.Unlock()
+		return rl.defaultRateLimiter.When(item)
+	}
+	exp := rl.failures[item]
+	rl.failures[item] = exp + 1
+	rl.mu.Unlock()
+
+	// Compute the delay as a float first to avoid overflows.
+	backoff := float64(limit.BaseDelay.Nanoseconds()) * math.Pow(2, float64(exp))
+	if backoff > math.MaxInt64 || time.Duration(backoff) > limit.MaxDelay {
+		return limit.MaxDelay
+	}
+	return time.Duration(backoff)
+}
+
+// Forget stops tracking an item, so that its next requeue is not delayed by its earlier requeues.
+func (rl *perPlacementRateLimiter) Forget(item any) {
+	rl.mu.Lock()
+	delete(rl.failures, item)
+	rl.mu.Unlock()
+	rl.defaultRateLimiter.Forget(item)
+}
+
+// NumRequeues returns how many times an item has been requeued.
+func (rl *perPlacementRateLimiter) NumRequeues(item any) int {
+	rl.mu.Lock()
+	if _, ok := rl.limits[item]; ok {
+		defer rl.mu.Unlock()
+		return rl.failures[item]
+	}
+	rl.mu.Unlock()
+	return rl.defaultRateLimiter.NumRequeues(item)
+}
+
+// setLimit sets up the rate limit for a PlacementKey; a nil rate limit removes the rate limit set up
+// earlier (if any), after which the key falls back to the default rate limiter.
+func (rl *perPlacementRateLimiter) setLimit(placementKey PlacementKey, limit *PlacementRateLimit) {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	if limit == nil {
+		delete(rl.limits, placementKey)
+		delete(rl.failures, placementKey)
+		return
+	}
+	rl.limits[placementKey] = *limit
+}
+
+// hasLimit returns if a rate limit has been set up for a PlacementKey.
+func (rl *perPlacementRateLimiter) hasLimit(placementKey PlacementKey) bool {
+	rl.mu.Lock()
+	defer rl.mu.Unlock()
+	_, ok := rl.limits[placementKey]
+	return ok
+}
+
+// requeueForFleetChange adds a PlacementKey, which is requeued as the fleet has changed (e.g., a
+// cluster has joined the fleet), to a work queue; the key is added after the rate limiter says that
+// it is OK if a rate limit has been set up for it, or right away otherwise.
+func requeueForFleetChange(q workqueue.TypedRateLimitingInterface[any], rl *perPlacementRateLimiter, placementKey PlacementKey) {
+	if rl.hasLimit(placementKey) {
+		q.AddRateLimited(placementKey)
+		return
+	}
+	q.Add(placementKey)
+}
@@ -0,0 +1,87 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/client-go/util/workqueue"
+)
+
+// TestPerPlacementRateLimiter_When tests the When, Forget and setLimit methods of a perPlacementRateLimiter.
+func TestPerPlacementRateLimiter_When(t *testing.T) {
+	rl := newPerPlacementRateLimiter(workqueue.NewTypedItemExponentialFailureRateLimiter[any](time.Millisecond, time.Second))
+	rl.setLimit("A", &PlacementRateLimit{BaseDelay: time.Minute, MaxDelay: time.Minute * 5})
+
+	got := []time.Duration{}
+	for i := 0; i < 4; i++ {
+		got = append(got, rl.When(PlacementKey("A")))
+	}
+	want := []time.Duration{time.Minute, time.Minute * 2, time.Minute * 4, time.Minute * 5}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("When() delays mismatch (-got, +want):\n%s", diff)
+	}
+	if got := rl.NumRequeues(PlacementKey("A")); got != 4 {
+		t.Errorf("NumRequeues() = %d, want %d", got, 4)
+	}
+
+	// Forgetting a key resets its delay, but keeps its rate limit.
+	rl.Forget(PlacementKey("A"))
+	if got := rl.When(PlacementKey("A")); got != time.Minute {
+		t.Errorf("When() after Forget() = %v, want %v", got, time.Minute)
+	}
+
+	// Keys without a rate limit fall back to the default rate limiter.
+	if got := rl.When(PlacementKey("B")); got != time.Millisecond {
+		t.Errorf("When() without a rate limit = %v, want %v", got, time.Millisecond)
+	}
+
+	// Removing the rate limit of a key makes it fall back to the default rate limiter.
+	rl.setLimit("A", nil)
+	if got := rl.When(PlacementKey("A")); got != time.Millisecond {
+		t.Errorf("When() after the rate limit is removed = %v, want %v", got, time.Millisecond)
+	}
+}
+
+// TestSimplePlacementSchedulingQueue_RateLimitedFleetChanges tests that keys with a rate limit set up
+// are not requeued right away as the fleet changes.
+func TestSimplePlacementSchedulingQueue_RateLimitedFleetChanges(t *testing.T) {
+	sq := NewSimplePlacementSchedulingQueue("", nil)
+	sq.Run()
+	defer sq.Close()
+
+	sq.SetRateLimit("A", &PlacementRateLimit{BaseDelay: time.Hour, MaxDelay: time.Hour})
+	sq.SetRateLimit("C", &PlacementRateLimit{BaseDelay: time.Hour, MaxDelay: time.Hour})
+	sq.AddBatched("A")
+	sq.AddBatched("B")
+	sq.AddUnschedulable("C")
+	sq.AddUnschedulable("D")
+	sq.FlushUnschedulable()
+
+	sqStruct, ok := sq.(*simplePlacementSchedulingQueue)
+	if !ok {
+		t.Fatalf("Failed to cast to simplePlacementSchedulingQueue")
+	}
+	if got := sqStruct.active.Len(); got != 2 {
+		t.Fatalf("active queue length = %d, want %d", got, 2)
+	}
+	if got := sqStruct.active.NumRequeues(PlacementKey("A")); got != 1 {
+		t.Errorf("NumRequeues(A) = %d, want %d", got, 1)
+	}
+}
@@ -26,9 +26,12 @@ import (
 // PlacementSchedulingQueue.
 //
 // This implementation is essentially a thin wrapper around one rate limiting
-// workqueue, which queues all placement keys indiscriminately for processing.
+// workqueue, which queues all placement keys indiscriminately for processing, plus an
+// unschedulable queue, which holds placement keys that cannot be fully scheduled at the moment.
 type simplePlacementSchedulingQueue struct {
-	active workqueue.TypedRateLimitingInterface[any]
+	active        workqueue.TypedRateLimitingInterface[any]
+	rateLimiter   *perPlacementRateLimiter
+	unschedulable *unschedulablePlacements
 }
 
 // Verify that simplePlacementSchedulingQueue implements
@@ -49,18 +52,25 @@ var defaultSimplePlacementSchedulingQueueOptions = simplePlacementSchedulingQueu
 
 // Run starts the scheduling queue.
 //
-// At this moment, Run is an no-op as there is only one queue present; in the future,
-// when more queues are added, Run would start goroutines that move items between queues as
-// appropriate.
-func (sq *simplePlacementSchedulingQueue) Run() {}
+// Run starts a goroutine that periodically moves placement keys which have stayed in the
+// unschedulable queue for too long to the active queue.
+func (sq *simplePlacementSchedulingQueue) Run() {
+	sq.unschedulable.run(func(key PlacementKey) { sq.active.Add(key) })
+}
 
 // Close shuts down the scheduling queue immediately.
+//
+// Note that items left in the unschedulable queue are dropped.
 func (sq *simplePlacementSchedulingQueue) Close() {
+	sq.unschedulable.stop()
 	sq.active.ShutDown()
 }
 
 // CloseWithDrain shuts down the scheduling queue and returns until all items are processed.
+//
+// Note that items left in the unschedulable queue are dropped.
 func (sq *simplePlacementSchedulingQueue) CloseWithDrain() {
+	sq.unschedulable.stop()
 	sq.active.ShutDownWithDrain()
 }
 
@@ -88,12 +98,14 @@ func (sq *simplePlacementSchedulingQueue) Done(placementKey PlacementKey) {
 //
 // Note that this bypasses the rate limiter (if any).
 func (sq *simplePlacementSchedulingQueue) Add(placementKey PlacementKey) {
+	sq.unschedulable.remove(placementKey)
 	sq.active.Add(placementKey)
 }
 
 // AddRateLimited adds a PlacementKey to the work queue after the rate limiter (if any)
 // says that it is OK.
 func (sq *simplePlacementSchedulingQueue) AddRateLimited(placementKey PlacementKey) {
+	sq.unschedulable.remove(placementKey)
 	sq.active.AddRateLimited(placementKey)
 }
 
@@ -101,14 +113,16 @@ func (sq *simplePlacementSchedulingQueue) AddRateLimited(placementKey PlacementK
 //
 // Note that this bypasses the rate limiter (if any).
 func (sq *simplePlacementSchedulingQueue) AddAfter(placementKey PlacementKey, duration time.Duration) {
+	sq.unschedulable.remove(placementKey)
 	sq.active.AddAfter(placementKey, duration)
 }
 
 // AddBatched tracks a PlacementKey and adds such keys in batch later to the work queue when appropriate.
 //
-// For the simple queue implementation, this is equivalent to Add.
+// For the simple queue implementation, this is equivalent to Add, unless a rate limit has been set up
+// for the key, in which case it is equivalent to AddRateLimited.
 func (sq *simplePlacementSchedulingQueue) AddBatched(placementKey PlacementKey) {
-	sq.active.Add(placementKey)
+	requeueForFleetChange(sq.active, sq.rateLimiter, placementKey)
 }
 
 // Forget untracks a PlacementKey from rate limiter(s) (if any) set up with the queue.
@@ -116,6 +130,25 @@ func (sq *simplePlacementSchedulingQueue) Forget(placementKey PlacementKey) {
 	sq.active.Forget(placementKey)
 }
 
+// AddUnschedulable adds a PlacementKey to the unschedulable queue.
+func (sq *simplePlacementSchedulingQueue) AddUnschedulable(placementKey PlacementKey) {
+	sq.unschedulable.add(placementKey)
+}
+
+// FlushUnschedulable moves all the PlacementKeys in the unschedulable queue to the active queue.
+//
+// Note that the keys with a rate limit set up are added after the rate limiter says that it is OK.
+func (sq *simplePlacementSchedulingQueue) FlushUnschedulable() {
+	for _, key := range sq.unschedulable.popAll() {
+		requeueForFleetChange(sq.active, sq.rateLimiter, key)
+	}
+}
+
+// SetRateLimit sets up the rate limit for requeueing a PlacementKey.
+func (sq *simplePlacementSchedulingQueue) SetRateLimit(placementKey PlacementKey, rateLimit *PlacementRateLimit) {
+	sq.rateLimiter.setLimit(placementKey, rateLimit)
+}
+
 // NewSimplePlacementSchedulingQueue returns a simplePlacementSchedulingQueue.
 func NewSimplePlacementSchedulingQueue(name string, rateLimiter workqueue.TypedRateLimiter[any]) PlacementSchedulingQueue {
 	if len(name) == 0 {
@@ -125,9 +158,12 @@ func NewSimplePlacementSchedulingQueue(name string, rateLimiter workqueue.TypedR
 		rateLimiter = defaultSimplePlacementSchedulingQueueOptions.rateLimiter
 	}
 
+	perPlacementRateLimiter := newPerPlacementRateLimiter(rateLimiter)
 	return &simplePlacementSchedulingQueue{
-		active: workqueue.NewTypedRateLimitingQueueWithConfig(rateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{
+		active: workqueue.NewTypedRateLimitingQueueWithConfig[any](perPlacementRateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{
 			Name: name,
 		}),
+		rateLimiter:   perPlacementRateLimiter,
+		unschedulable: newUnschedulablePlacements(defaultMaxUnschedulableDuration, defaultUnschedulableFlushPeriod),
 	}
 }
@@ -0,0 +1,139 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"sync"
+	"time"
+)
+
+const (
+	// defaultMaxUnschedulableDuration is the maximum amount of time a PlacementKey may stay in
+	// the unschedulable queue before it is moved back to the active queue for another attempt.
+	//
+	// This mirrors the behavior of kube-scheduler, which also flushes unschedulable pods that
+	// have stayed for too long periodically, in case that an event has been missed.
+	defaultMaxUnschedulableDuration = time.Minute * 5
+	// defaultUnschedulableFlushPeriod is the period between two checks for PlacementKeys that
+	// have stayed in the unschedulable queue for too long.
+	defaultUnschedulableFlushPeriod = time.Second * 30
+)
+
+// unschedulablePlacements keeps track of PlacementKeys that the scheduler has failed to fully
+// schedule, i.e., no (or not enough) feasible clusters can be found for the placements.
+//
+// Such PlacementKeys are not retried with backoff, as the scheduling results will not change
+// until the fleet changes (e.g., a cluster joins, or has its labels or taints updated); instead,
+// they wait in the unschedulable queue until a fleet change is observed, or they have stayed
+// for too long.
+type unschedulablePlacements struct {
+	mu sync.Mutex
+	// keys are the PlacementKeys in the unschedulable queue, along with the time they are added.
+	keys map[PlacementKey]time.Time
+
+	maxDuration time.Duration
+	flushPeriod time.Duration
+
+	stopCh   chan struct{}
+	stopOnce sync.Once
+}
+
+func newUnschedulablePlacements(maxDuration, flushPeriod time.Duration) *unschedulablePlacements {
+	return &unschedulablePlacements{
+		keys:        make(map[PlacementKey]time.Time),
+		maxDuration: maxDuration,
+		flushPeriod: flushPeriod,
+		stopCh:      make(chan struct{}),
+	}
+}
+
+// add adds a PlacementKey to the unschedulable queue; the time a key is added is not refreshed
+// if the key is already present.
+func (u *unschedulablePlacements) add(placementKey PlacementKey) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	if _, ok := u.keys[placementKey]; !ok {
+		u.keys[placementKey] = time.Now()
+	}
+}
+
+// remove removes a PlacementKey from the unschedulable queue, if it is present.
+func (u *unschedulablePlacements) remove(placementKey PlacementKey) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	delete(u.keys, placementKey)
+}
+
+// len returns the number of PlacementKeys in the unschedulable queue.
+func (u *unschedulablePlacements) len() int {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	return len(u.keys)
+}
+
+// popAll removes all the PlacementKeys from the unschedulable queue and returns them.
+func (u *unschedulablePlacements) popAll() []PlacementKey {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	keys := make([]PlacementKey, 0, len(u.keys))
+	for key := range u.keys {
+		keys = append(keys, key)
+	}
+	u.keys = make(map[PlacementKey]time.Time)
+	return keys
+}
+
+// popExpired removes the PlacementKeys that have stayed in the unschedulable queue for too long
+// and returns them.
+func (u *unschedulablePlacements) popExpired(now time.Time) []PlacementKey {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+	keys := []PlacementKey{}
+	for key, addedAt := range u.keys {
+		if now.Sub(addedAt) >= u.maxDuration {
+			keys = append(keys, key)
+			delete(u.keys, key)
+		}
+	}
+	return keys
+}
+
+// run starts a goroutine that periodically moves the PlacementKeys that have stayed in the
+// unschedulable queue for too long to the active queue, until stop is called.
+func (u *unschedulablePlacements) run(moveToActive func(PlacementKey)) {
+	go func() {
+		ticker := time.NewTicker(u.flushPeriod)
+		defer ticker.Stop()
+		for {
+			select {
+			case <-u.stopCh:
+				return
+			case now := <-ticker.C:
+				for _, key := range u.popExpired(now) {
+					moveToActive(key)
+				}
+			}
+		}
+	}()
+}
+
+// stop stops the goroutine started by run.
+func (u *unschedulablePlacements) stop() {
+	u.stopOnce.Do(func() {
+		close(u.stopCh)
+	})
+}
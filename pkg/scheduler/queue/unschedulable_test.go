@@ -0,0 +1,113 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package queue
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+)
+
+// TestUnschedulablePlacements_PopExpired tests the popExpired method.
+func TestUnschedulablePlacements_PopExpired(t *testing.T) {
+	now := time.Now()
+	u := newUnschedulablePlacements(time.Minute, time.Second)
+	u.keys = map[PlacementKey]time.Time{
+		"A": now.Add(-time.Minute * 2),
+		"B": now.Add(-time.Second * 10),
+		"C": now.Add(-time.Minute),
+	}
+
+	got := u.popExpired(now)
+	want := []PlacementKey{"A", "C"}
+	if diff := cmp.Diff(got, want, cmpopts.SortSlices(func(a, b PlacementKey) bool { return a < b })); diff != "" {
+		t.Errorf("popExpired() keys mismatch (-got, +want):\n%s", diff)
+	}
+	if got := u.len(); got != 1 {
+		t.Errorf("len() = %d, want %d", got, 1)
+	}
+}
+
+// TestSimplePlacementSchedulingQueue_UnschedulableOps tests the unschedulable ops
+// (AddUnschedulable, FlushUnschedulable) of a simplePlacementSchedulingQueue.
+func TestSimplePlacementSchedulingQueue_UnschedulableOps(t *testing.T) {
+	sq := NewSimplePlacementSchedulingQueue("", nil)
+	sq.Run()
+	defer sq.Close()
+
+	sq.AddUnschedulable("A")
+	sq.AddUnschedulable("B")
+	sq.AddUnschedulable("C")
+	// Adding a key for immediate processing removes it from the unschedulable queue.
+	sq.Add("C")
+
+	sqStruct, ok := sq.(*simplePlacementSchedulingQueue)
+	if !ok {
+		t.Fatalf("Failed to cast to simplePlacementSchedulingQueue")
+	}
+	if got := sqStruct.unschedulable.len(); got != 2 {
+		t.Fatalf("unschedulable queue length = %d, want %d", got, 2)
+	}
+	if got := sqStruct.active.Len(); got != 1 {
+		t.Fatalf("active queue length = %d, want %d", got, 1)
+	}
+
+	sq.FlushUnschedulable()
+	if got := sqStruct.unschedulable.len(); got != 0 {
+		t.Fatalf("unschedulable queue length = %d, want %d", got, 0)
+	}
+
+	keysRecved := []PlacementKey{}
+	for i := 0; i < 3; i++ {
+		key, closed := sq.NextPlacementKey()
+		if closed {
+			t.Fatalf("Queue closed unexpected")
+		}
+		keysRecved = append(keysRecved, key)
+		sq.Done(key)
+		sq.Forget(key)
+	}
+	want := []PlacementKey{"A", "B", "C"}
+	if diff := cmp.Diff(keysRecved, want, cmpopts.SortSlices(func(a, b PlacementKey) bool { return a < b })); diff != "" {
+		t.Errorf("Received keys mismatch (-got, +want):\n%s", diff)
+	}
+}
+
+// TestBatchedProcessingPlacementSchedulingQueue_UnschedulableOps tests that unschedulable
+// keys bypass the batched queue when flushed.
+func TestBatchedProcessingPlacementSchedulingQueue_UnschedulableOps(t *testing.T) {
+	// Use a long move period so that keys would not be moved from the batched queue during the test.
+	bq := NewBatchedProcessingPlacementSchedulingQueue("TestOnly", nil, nil, 3600)
+	bq.Run()
+	defer bq.Close()
+
+	bq.AddUnschedulable("A")
+	// Adding a key for batched processing does not remove it from the unschedulable queue.
+	bq.AddBatched("A")
+	bq.FlushUnschedulable()
+
+	key, closed := bq.NextPlacementKey()
+	if closed {
+		t.Fatalf("Queue closed unexpected")
+	}
+	if key != "A" {
+		t.Fatalf("NextPlacementKey() = %s, want %s", key, "A")
+	}
+	bq.Done(key)
+}
@@ -26,6 +26,7 @@ import (
 	"time"
 
 	apiErrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/types"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
@@ -39,9 +40,17 @@ import (
 	hubmetrics "github.com/kubefleet-dev/kubefleet/pkg/metrics/hub"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 )
 
+const (
+	// defaultSchedulingRateLimitMaxDelay is the maximum delay before the scheduler re-processes a placement
+	// which specifies a scheduling rate limit without a maximum delay; it matches the maximum backoff of the
+	// default rate limiter of the scheduling queue.
+	defaultSchedulingRateLimitMaxDelay = time.Second * 1000
+)
+
 // Scheduler is the scheduler for Fleet workloads.
 type Scheduler struct {
 	// name is the name of the scheduler.
@@ -143,6 +152,7 @@ func (s *Scheduler) scheduleOnce(ctx context.Context, worker int) {
 			// the work queue. Such placements needs no further processing any way though, as the absence
 			// of the cleanup finalizer implies that bindings derived from the placement are no longer present.
 			klog.ErrorS(err, "placement is already deleted", "placement", placementKey)
+			s.queue.SetRateLimit(placementKey, nil)
 			return
 		}
 		if errors.Is(err, controller.ErrUnexpectedBehavior) {
@@ -179,12 +189,16 @@ func (s *Scheduler) scheduleOnce(ctx context.Context, worker int) {
 		// additional handling is needed.
 
 		// Untrack the key from the rate limiter.
+		s.queue.SetRateLimit(placementKey, nil)
 		s.queue.Forget(placementKey)
 		return
 	}
 
 	// The placement has not been marked for deletion; run the scheduling cycle for it.
 
+	// Set up the rate limit the placement specifies (if any) for its future requeues.
+	s.queue.SetRateLimit(placementKey, schedulingRateLimitOf(placement))
+
 	// Verify that it has an active policy snapshot.
 	latestPolicySnapshot, err := s.lookupLatestPolicySnapshot(ctx, placement)
 	if err != nil {
@@ -205,6 +219,18 @@ func (s *Scheduler) scheduleOnce(ctx context.Context, worker int) {
 		return
 	}
 
+	// Skip the placement if it is scheduled by an external scheduler.
+	//
+	// Note that the scheduler cleanup finalizer is still added to such placements, so that the
+	// bindings created by the external scheduler can be cleaned up when the placement is deleted.
+	if policy := placement.GetPlacementSpec().Policy; policy != nil && policy.ExternalSchedulerName != "" {
+		klog.V(2).InfoS("Placement is scheduled by an external scheduler; skip the scheduling cycle",
+			"placement", placementKey, "externalScheduler", policy.ExternalSchedulerName)
+		// Untrack the key from the rate limiter.
+		s.queue.Forget(placementKey)
+		return
+	}
+
 	// Run the scheduling cycle.
 	//
 	// Note that the scheduler will enter this cycle as long as the placement is active and an active
@@ -251,10 +277,46 @@ func (s *Scheduler) scheduleOnce(ctx context.Context, worker int) {
 	} else {
 		// no more failure, the following queue don't need to be rate limited
 		s.queue.Forget(placementKey)
+		if isUnschedulable(latestPolicySnapshot) {
+			// The scheduler cannot find enough feasible clusters for the placement; park the key in
+			// the unschedulable queue, so that it is only processed again when the fleet changes (or
+			// after it has stayed there for too long), instead of being retried with backoff.
+			klog.V(2).InfoS("Placement cannot be fully scheduled; move it to the unschedulable queue", "placement", placementKey)
+			s.queue.AddUnschedulable(placementKey)
+		}
 		observeSchedulingCycleMetrics(cycleStartTime, false, false)
 	}
 }
 
+// isUnschedulable returns if the latest scheduling cycle has failed to fully schedule a placement,
+// per the scheduled condition the scheduling framework sets on its active policy snapshot.
+//
+// Note that the framework updates the policy snapshot in place when it runs the scheduling cycle.
+func isUnschedulable(policySnapshot fleetv1beta1.PolicySnapshotObj) bool {
+	scheduledCond := meta.FindStatusCondition(policySnapshot.GetPolicySnapshotStatus().Conditions, string(fleetv1beta1.PolicySnapshotScheduled))
+	return condition.IsConditionStatusFalse(scheduledCond, policySnapshot.GetGeneration())
+}
+
+// schedulingRateLimitOf returns the rate limit for requeueing a placement per its spec, or nil if
+// the placement does not specify one.
+func schedulingRateLimitOf(placement fleetv1beta1.PlacementObj) *queue.PlacementRateLimit {
+	rateLimit := placement.GetPlacementSpec().SchedulingRateLimit
+	if rateLimit == nil {
+		return nil
+	}
+	baseDelay := time.Duration(rateLimit.BaseDelaySeconds) * time.Second
+	maxDelay := defaultSchedulingRateLimitMaxDelay
+	if rateLimit.MaxDelaySeconds != nil {
+		maxDelay = time.Duration(*rateLimit.MaxDelaySeconds) * time.Second
+	}
+	// The API server rejects maximum delays shorter than the base delay; still, guard against them.
+	maxDelay = max(maxDelay, baseDelay)
+	return &queue.PlacementRateLimit{
+		BaseDelay: baseDelay,
+		MaxDelay:  maxDelay,
+	}
+}
+
 // Run starts the scheduler.
 //
 // Note that this is a blocking call. It will only return when the context is cancelled.
@@ -30,10 +30,12 @@ import (
 	"github.com/prometheus/client_golang/prometheus/testutil"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	hubmetrics "github.com/kubefleet-dev/kubefleet/pkg/metrics/hub"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
 )
 
 const (
@@ -591,6 +593,94 @@ func TestLookupLatestPolicySnapshot(t *testing.T) {
 	}
 }
 
+// TestIsUnschedulable tests the isUnschedulable function.
+func TestIsUnschedulable(t *testing.T) {
+	testCases := []struct {
+		name       string
+		conditions []metav1.Condition
+		want       bool
+	}{
+		{
+			name: "no scheduled condition",
+		},
+		{
+			name: "fully scheduled",
+			conditions: []metav1.Condition{
+				{Type: string(fleetv1beta1.PolicySnapshotScheduled), Status: metav1.ConditionTrue, ObservedGeneration: 2},
+			},
+		},
+		{
+			name: "not fully scheduled",
+			conditions: []metav1.Condition{
+				{Type: string(fleetv1beta1.PolicySnapshotScheduled), Status: metav1.ConditionFalse, ObservedGeneration: 2},
+			},
+			want: true,
+		},
+		{
+			name: "not fully scheduled, stale condition",
+			conditions: []metav1.Condition{
+				{Type: string(fleetv1beta1.PolicySnapshotScheduled), Status: metav1.ConditionFalse, ObservedGeneration: 1},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			policySnapshot := &fleetv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       policySnapshotName,
+					Generation: 2,
+				},
+				Status: fleetv1beta1.SchedulingPolicySnapshotStatus{
+					Conditions: tc.conditions,
+				},
+			}
+			if got := isUnschedulable(policySnapshot); got != tc.want {
+				t.Errorf("isUnschedulable() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestSchedulingRateLimitOf(t *testing.T) {
+	testCases := []struct {
+		name      string
+		rateLimit *fleetv1beta1.SchedulingRateLimit
+		want      *queue.PlacementRateLimit
+	}{
+		{
+			name: "no rate limit",
+		},
+		{
+			name:      "base delay only",
+			rateLimit: &fleetv1beta1.SchedulingRateLimit{BaseDelaySeconds: 60},
+			want:      &queue.PlacementRateLimit{BaseDelay: time.Minute, MaxDelay: defaultSchedulingRateLimitMaxDelay},
+		},
+		{
+			name:      "base and max delays",
+			rateLimit: &fleetv1beta1.SchedulingRateLimit{BaseDelaySeconds: 60, MaxDelaySeconds: ptr.To(int32(3600))},
+			want:      &queue.PlacementRateLimit{BaseDelay: time.Minute, MaxDelay: time.Hour},
+		},
+		{
+			name:      "max delay shorter than base delay",
+			rateLimit: &fleetv1beta1.SchedulingRateLimit{BaseDelaySeconds: 3600, MaxDelaySeconds: ptr.To(int32(60))},
+			want:      &queue.PlacementRateLimit{BaseDelay: time.Hour, MaxDelay: time.Hour},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := &fleetv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: crpName},
+				Spec:       fleetv1beta1.PlacementSpec{SchedulingRateLimit: tc.rateLimit},
+			}
+			if diff := cmp.Diff(tc.want, schedulingRateLimitOf(crp)); diff != "" {
+				t.Errorf("schedulingRateLimitOf() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestObserveSchedulingCycleMetrics(t *testing.T) {
 	metricMetadata := `
 		# HELP scheduling_cycle_duration_milliseconds The duration of a scheduling cycle run in milliseconds
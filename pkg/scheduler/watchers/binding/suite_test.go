@@ -18,32 +18,26 @@ package binding
 
 import (
 	"context"
-	"path/filepath"
 	"testing"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/klog/v2"
-	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
-	"sigs.k8s.io/controller-runtime/pkg/envtest"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
-	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
-	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
-	"github.com/kubefleet-dev/kubefleet/test/utils/keycollector"
+	"github.com/kubefleet-dev/kubefleet/pkg/testutils"
 )
 
 var (
-	hubTestEnv   *envtest.Environment
+	hubTestEnv   *testutils.HubEnvironment
 	hubClient    client.Client
 	ctx          context.Context
 	cancel       context.CancelFunc
-	keyCollector *keycollector.SchedulerWorkqueueKeyCollector
+	keyCollector *testutils.SchedulerWorkqueueKeyCollector
 )
 
 func TestAPIs(t *testing.T) {
@@ -59,22 +53,11 @@ var _ = BeforeSuite(func() {
 
 	By("bootstrap the test environment")
 
-	// Start the hub cluster.
-	hubTestEnv = &envtest.Environment{
-		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "..", "..", "config", "crd", "bases")},
-		ErrorIfCRDPathMissing: true,
-	}
-	hubCfg, err := hubTestEnv.Start()
+	// Start the hub cluster, with the KubeFleet CRDs installed.
+	var err error
+	hubTestEnv, err = testutils.StartHubEnvironment()
 	Expect(err).ToNot(HaveOccurred(), "Failed to start test environment")
-	Expect(hubCfg).ToNot(BeNil(), "Hub cluster configuration is nil")
-
-	// Add custom APIs to the runtime scheme.
-	Expect(fleetv1beta1.AddToScheme(scheme.Scheme)).Should(Succeed())
-
-	// Set up a client for the hub cluster.
-	hubClient, err = client.New(hubCfg, client.Options{Scheme: scheme.Scheme})
-	Expect(err).ToNot(HaveOccurred(), "Failed to create hub cluster client")
-	Expect(hubClient).ToNot(BeNil(), "Hub cluster client is nil")
+	hubClient = hubTestEnv.Client
 
 	By("creating a test namespace")
 	var ns = corev1.Namespace{
@@ -85,12 +68,7 @@ var _ = BeforeSuite(func() {
 	Expect(hubClient.Create(ctx, &ns)).Should(Succeed(), "failed to create namespace")
 
 	// Set up a controller manager and let it manage the hub cluster controller.
-	ctrlMgr, err := ctrl.NewManager(hubCfg, ctrl.Options{
-		Scheme: scheme.Scheme,
-		Metrics: metricsserver.Options{
-			BindAddress: "0",
-		},
-	})
+	ctrlMgr, err := hubTestEnv.NewManager()
 	Expect(err).NotTo(HaveOccurred(), "Failed to create controller manager")
 
 	schedulerWorkQueue := queue.NewSimplePlacementSchedulingQueue("", nil)
@@ -111,7 +89,7 @@ var _ = BeforeSuite(func() {
 	Expect(err).ToNot(HaveOccurred(), "Failed to set up rb controller with controller manager")
 
 	// Start the key collector.
-	keyCollector = keycollector.NewSchedulerWorkqueueKeyCollector(schedulerWorkQueue)
+	keyCollector = testutils.NewSchedulerWorkqueueKeyCollector(schedulerWorkQueue)
 	go func() {
 		keyCollector.Run(ctx)
 	}()
@@ -39,7 +39,7 @@ import (
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/clustereligibilitychecker"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
-	"github.com/kubefleet-dev/kubefleet/test/utils/keycollector"
+	"github.com/kubefleet-dev/kubefleet/pkg/testutils"
 )
 
 var (
@@ -47,7 +47,7 @@ var (
 	hubClient    client.Client
 	ctx          context.Context
 	cancel       context.CancelFunc
-	keyCollector *keycollector.SchedulerWorkqueueKeyCollector
+	keyCollector *testutils.SchedulerWorkqueueKeyCollector
 )
 
 var (
@@ -199,7 +199,7 @@ var _ = BeforeSuite(func() {
 	Expect(err).ToNot(HaveOccurred(), "Failed to set up controller with controller manager")
 
 	// Start the key collector.
-	keyCollector = keycollector.NewSchedulerWorkqueueKeyCollector(schedulerWorkQueue)
+	keyCollector = testutils.NewSchedulerWorkqueueKeyCollector(schedulerWorkQueue)
 	go func() {
 		keyCollector.Run(ctx)
 	}()
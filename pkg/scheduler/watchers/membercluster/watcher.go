@@ -177,6 +177,15 @@ func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Resu
 		r.SchedulerWorkQueue.AddBatched(controller.GetObjectKeyFromObj(placement))
 	}
 
+	if !isMemberClusterMissing && memberCluster.GetDeletionTimestamp().IsZero() {
+		// The cluster might have become eligible for resource placement (case 1a), 1b) and 1c));
+		// move all the placements that could not be fully scheduled earlier to the active queue
+		// right away, so that the scheduler can react to the new capacity without waiting for the
+		// batched processing.
+		klog.V(2).InfoS("Flushing unschedulable placements for scheduler processing", "memberCluster", memberClusterRef)
+		r.SchedulerWorkQueue.FlushUnschedulable()
+	}
+
 	// The reconciliation loop completes.
 	return ctrl.Result{}, nil
 }
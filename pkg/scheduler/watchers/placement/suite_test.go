@@ -35,7 +35,7 @@ import (
 
 	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
-	"github.com/kubefleet-dev/kubefleet/test/utils/keycollector"
+	"github.com/kubefleet-dev/kubefleet/pkg/testutils"
 )
 
 var (
@@ -43,7 +43,7 @@ var (
 	hubClient    client.Client
 	ctx          context.Context
 	cancel       context.CancelFunc
-	keyCollector *keycollector.SchedulerWorkqueueKeyCollector
+	keyCollector *testutils.SchedulerWorkqueueKeyCollector
 )
 
 func TestAPIs(t *testing.T) {
@@ -110,7 +110,7 @@ var _ = BeforeSuite(func() {
 	Expect(err).ToNot(HaveOccurred(), "Failed to set up rp controller with controller manager")
 
 	// Start the key collector.
-	keyCollector = keycollector.NewSchedulerWorkqueueKeyCollector(schedulerWorkQueue)
+	keyCollector = testutils.NewSchedulerWorkqueueKeyCollector(schedulerWorkQueue)
 	go func() {
 		keyCollector.Run(ctx)
 	}()
@@ -0,0 +1,201 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package placementaffinity features a controller that enqueues placement objects for the scheduler
+// to process when there is a change in the scheduling decisions of the placements they follow.
+package placementaffinity
+
+import (
+	"context"
+	"fmt"
+	"strconv"
+	"time"
+
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/klog/v2"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+// Reconciler reconciles the change in the scheduling decisions of a placement, and enqueues the
+// placements that follow it (i.e., the placements with a placement affinity to it).
+//
+// Note that the reconciler is keyed by the placement being followed, rather than by its policy
+// snapshots, as the placements that follow it refer to it by name.
+type Reconciler struct {
+	// Client is the client the controller uses to access the hub cluster.
+	client.Client
+	// SchedulerWorkQueue is the workqueue in use by the scheduler.
+	SchedulerWorkQueue queue.PlacementSchedulingQueueWriter
+}
+
+// Reconcile reconciles the placement being followed (either ClusterResourcePlacement or ResourcePlacement).
+func (r *Reconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	placementRef := klog.KRef(req.Namespace, req.Name)
+	startTime := time.Now()
+	klog.V(2).InfoS("Scheduler source reconciliation starts", "followedPlacement", placementRef)
+	defer func() {
+		latency := time.Since(startTime).Milliseconds()
+		klog.V(2).InfoS("Scheduler source reconciliation ends", "followedPlacement", placementRef, "latency", latency)
+	}()
+
+	followers, err := r.listFollowers(ctx, req.NamespacedName)
+	if err != nil {
+		klog.ErrorS(err, "Failed to list the placements that follow the placement", "followedPlacement", placementRef)
+		return ctrl.Result{}, controller.NewAPIServerError(true, err)
+	}
+	for _, follower := range followers {
+		klog.V(2).InfoS("Enqueueing the placement that follows the placement", "placement", klog.KObj(follower), "followedPlacement", placementRef)
+		r.SchedulerWorkQueue.Add(queue.PlacementKey(controller.GetObjectKeyFromNamespaceName(follower.GetNamespace(), follower.GetName())))
+	}
+
+	// The reconciliation loop ends.
+	return ctrl.Result{}, nil
+}
+
+// listFollowers lists the placements, in the same scope as the given placement, that have a placement
+// affinity to it.
+func (r *Reconciler) listFollowers(ctx context.Context, placementKey types.NamespacedName) ([]fleetv1beta1.PlacementObj, error) {
+	var placementList fleetv1beta1.PlacementObjList
+	var listOptions []client.ListOption
+	if placementKey.Namespace != "" {
+		placementList = &fleetv1beta1.ResourcePlacementList{}
+		listOptions = append(listOptions, client.InNamespace(placementKey.Namespace))
+	} else {
+		placementList = &fleetv1beta1.ClusterResourcePlacementList{}
+	}
+	if err := r.Client.List(ctx, placementList, listOptions...); err != nil {
+		return nil, err
+	}
+
+	var followers []fleetv1beta1.PlacementObj
+	for _, placement := range placementList.GetPlacementObjs() {
+		policy := placement.GetPlacementSpec().Policy
+		if policy == nil || policy.Affinity == nil || policy.Affinity.PlacementAffinity == nil {
+			continue
+		}
+		if policy.Affinity.PlacementAffinity.PlacementName != placementKey.Name || placement.GetName() == placementKey.Name {
+			continue
+		}
+		followers = append(followers, placement)
+	}
+	return followers, nil
+}
+
+// placementForPolicySnapshot maps a policy snapshot to the placement that owns it.
+func placementForPolicySnapshot(_ context.Context, obj client.Object) []reconcile.Request {
+	placementName, ok := obj.GetLabels()[fleetv1beta1.PlacementTrackingLabel]
+	if !ok {
+		// The PlacementTracking label is not present; normally this should never occur.
+		klog.ErrorS(controller.NewUnexpectedBehaviorError(fmt.Errorf("PlacementTrackingLabel is missing")),
+			"PlacementTracking label is not present",
+			"policySnapshot", klog.KObj(obj))
+		return nil
+	}
+	return []reconcile.Request{{NamespacedName: types.NamespacedName{Namespace: obj.GetNamespace(), Name: placementName}}}
+}
+
+// isLatest returns if a policy snapshot is the latest one of its placement.
+func isLatest(obj client.Object) bool {
+	isLatest, err := strconv.ParseBool(obj.GetLabels()[fleetv1beta1.IsLatestSnapshotLabel])
+	return err == nil && isLatest
+}
+
+// selectedClusters returns the names of the clusters selected in the policy snapshot.
+func selectedClusters(obj client.Object) sets.Set[string] {
+	selected := sets.New[string]()
+	policySnapshot, ok := obj.(fleetv1beta1.PolicySnapshotObj)
+	if !ok {
+		return selected
+	}
+	for _, decision := range policySnapshot.GetPolicySnapshotStatus().ClusterDecisions {
+		if decision.Selected {
+			selected.Insert(decision.ClusterName)
+		}
+	}
+	return selected
+}
+
+// isScheduled returns if the scheduler has made the scheduling decisions for the current generation of
+// a policy snapshot.
+func isScheduled(obj client.Object) bool {
+	policySnapshot, ok := obj.(fleetv1beta1.PolicySnapshotObj)
+	return ok && controller.IsPolicySnapshotScheduled(policySnapshot)
+}
+
+func buildCustomPredicate() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(e event.CreateEvent) bool {
+			// A new latest policy snapshot may come with existing decisions (e.g., when the
+			// controller restarts); process it if the decisions are up to date.
+			return isLatest(e.Object) && isScheduled(e.Object)
+		},
+		DeleteFunc: func(_ event.DeleteEvent) bool {
+			// The latest policy snapshot is deleted only when the placement is deleted (or is
+			// about to be recreated); the placements that follow it keep their current clusters
+			// until a placement of the same name is scheduled again, so there is nothing to do.
+			return false
+		},
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			// Check if the update event is valid.
+			if e.ObjectOld == nil || e.ObjectNew == nil {
+				err := controller.NewUnexpectedBehaviorError(fmt.Errorf("update event is invalid"))
+				klog.ErrorS(err, "Failed to process update event")
+				return false
+			}
+
+			// Only the decisions of the latest policy snapshot matter; a policy snapshot that is
+			// no longer the latest one is always superseded by a new one, which triggers
+			// its own events. The decisions are not final until the scheduler has processed the
+			// current generation of the policy snapshot.
+			if !isLatest(e.ObjectNew) || !isScheduled(e.ObjectNew) {
+				return false
+			}
+			if !isLatest(e.ObjectOld) || !isScheduled(e.ObjectOld) {
+				return true
+			}
+			return !selectedClusters(e.ObjectOld).Equal(selectedClusters(e.ObjectNew))
+		},
+	}
+}
+
+// SetupWithManagerForClusterResourcePlacement sets up the controller with the manager for ClusterResourcePlacement.
+func (r *Reconciler) SetupWithManagerForClusterResourcePlacement(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).Named("clusterresourceplacement-affinity-scheduler-watcher").
+		Watches(&fleetv1beta1.ClusterSchedulingPolicySnapshot{},
+			handler.EnqueueRequestsFromMapFunc(placementForPolicySnapshot),
+			builder.WithPredicates(buildCustomPredicate())).
+		Complete(r)
+}
+
+// SetupWithManagerForResourcePlacement sets up the controller with the manager for ResourcePlacement.
+func (r *Reconciler) SetupWithManagerForResourcePlacement(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).Named("resourceplacement-affinity-scheduler-watcher").
+		Watches(&fleetv1beta1.SchedulingPolicySnapshot{},
+			handler.EnqueueRequestsFromMapFunc(placementForPolicySnapshot),
+			builder.WithPredicates(buildCustomPredicate())).
+		Complete(r)
+}
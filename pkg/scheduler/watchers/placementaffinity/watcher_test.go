@@ -0,0 +1,221 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package placementaffinity
+
+import (
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	followedPlacementName = "followed"
+	testNamespace         = "test-namespace"
+)
+
+func newPolicySnapshot(isLatest string, selected ...string) *fleetv1beta1.ClusterSchedulingPolicySnapshot {
+	ps := newUnscheduledPolicySnapshot(isLatest, selected...)
+	ps.Status.Conditions = []metav1.Condition{{
+		Type:               string(fleetv1beta1.PolicySnapshotScheduled),
+		Status:             metav1.ConditionTrue,
+		ObservedGeneration: ps.Generation,
+		Reason:             "test",
+	}}
+	return ps
+}
+
+func newUnscheduledPolicySnapshot(isLatest string, selected ...string) *fleetv1beta1.ClusterSchedulingPolicySnapshot {
+	ps := &fleetv1beta1.ClusterSchedulingPolicySnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "followed-0",
+			Labels: map[string]string{
+				fleetv1beta1.PlacementTrackingLabel: followedPlacementName,
+				fleetv1beta1.IsLatestSnapshotLabel:  isLatest,
+			},
+			Generation: 1,
+		},
+	}
+	for _, cluster := range selected {
+		ps.Status.ClusterDecisions = append(ps.Status.ClusterDecisions, fleetv1beta1.ClusterDecision{ClusterName: cluster, Selected: true})
+	}
+	return ps
+}
+
+func TestBuildCustomPredicate(t *testing.T) {
+	testCases := []struct {
+		name   string
+		oldObj client.Object
+		newObj client.Object
+		want   bool
+	}{
+		{
+			name:   "selected clusters unchanged",
+			oldObj: newPolicySnapshot("true", "cluster-1"),
+			newObj: newPolicySnapshot("true", "cluster-1"),
+			want:   false,
+		},
+		{
+			name:   "selected clusters changed",
+			oldObj: newPolicySnapshot("true", "cluster-1"),
+			newObj: newPolicySnapshot("true", "cluster-1", "cluster-2"),
+			want:   true,
+		},
+		{
+			name:   "policy snapshot becomes the latest one",
+			oldObj: newPolicySnapshot("false", "cluster-1"),
+			newObj: newPolicySnapshot("true", "cluster-1"),
+			want:   true,
+		},
+		{
+			name:   "policy snapshot is no longer the latest one",
+			oldObj: newPolicySnapshot("true", "cluster-1"),
+			newObj: newPolicySnapshot("false"),
+			want:   false,
+		},
+		{
+			name:   "latest policy snapshot not scheduled yet",
+			oldObj: newUnscheduledPolicySnapshot("false"),
+			newObj: newUnscheduledPolicySnapshot("true"),
+			want:   false,
+		},
+		{
+			name:   "latest policy snapshot becomes scheduled",
+			oldObj: newUnscheduledPolicySnapshot("true"),
+			newObj: newPolicySnapshot("true", "cluster-1"),
+			want:   true,
+		},
+	}
+
+	p := buildCustomPredicate()
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := p.Update(event.UpdateEvent{ObjectOld: tc.oldObj, ObjectNew: tc.newObj}); got != tc.want {
+				t.Errorf("Update() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+
+	createTestCases := []struct {
+		name string
+		obj  client.Object
+		want bool
+	}{
+		{
+			name: "scheduled latest policy snapshot",
+			obj:  newPolicySnapshot("true", "cluster-1"),
+			want: true,
+		},
+		{
+			name: "unscheduled latest policy snapshot",
+			obj:  newUnscheduledPolicySnapshot("true"),
+			want: false,
+		},
+	}
+	for _, tc := range createTestCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := p.Create(event.CreateEvent{Object: tc.obj}); got != tc.want {
+				t.Errorf("Create() = %t, want %t", got, tc.want)
+			}
+		})
+	}
+
+	if p.Delete(event.DeleteEvent{Object: newPolicySnapshot("true", "cluster-1")}) {
+		t.Errorf("Delete() = true, want false")
+	}
+}
+
+func TestListFollowers(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := fleetv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add to scheme: %v", err)
+	}
+	withPlacementAffinity := func(name string) *fleetv1beta1.PlacementPolicy {
+		return &fleetv1beta1.PlacementPolicy{
+			Affinity: &fleetv1beta1.Affinity{
+				PlacementAffinity: &fleetv1beta1.PlacementAffinity{PlacementName: name},
+			},
+		}
+	}
+	objects := []client.Object{
+		&fleetv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: "follower"},
+			Spec:       fleetv1beta1.PlacementSpec{Policy: withPlacementAffinity(followedPlacementName)},
+		},
+		&fleetv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: "other-follower"},
+			Spec:       fleetv1beta1.PlacementSpec{Policy: withPlacementAffinity("other")},
+		},
+		&fleetv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: followedPlacementName},
+		},
+		&fleetv1beta1.ResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: "rp-follower", Namespace: testNamespace},
+			Spec:       fleetv1beta1.PlacementSpec{Policy: withPlacementAffinity(followedPlacementName)},
+		},
+		&fleetv1beta1.ResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: "rp-follower", Namespace: "other-namespace"},
+			Spec:       fleetv1beta1.PlacementSpec{Policy: withPlacementAffinity(followedPlacementName)},
+		},
+	}
+
+	testCases := []struct {
+		name         string
+		placementKey types.NamespacedName
+		want         []string
+	}{
+		{
+			name:         "followers of a cluster resource placement",
+			placementKey: types.NamespacedName{Name: followedPlacementName},
+			want:         []string{"/follower"},
+		},
+		{
+			name:         "followers of a resource placement",
+			placementKey: types.NamespacedName{Name: followedPlacementName, Namespace: testNamespace},
+			want:         []string{"test-namespace/rp-follower"},
+		},
+		{
+			name:         "no followers",
+			placementKey: types.NamespacedName{Name: "follower"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			r := &Reconciler{Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()}
+			followers, err := r.listFollowers(context.Background(), tc.placementKey)
+			if err != nil {
+				t.Fatalf("listFollowers() = %v, want nil", err)
+			}
+			var got []string
+			for _, follower := range followers {
+				got = append(got, types.NamespacedName{Namespace: follower.GetNamespace(), Name: follower.GetName()}.String())
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("listFollowers() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -139,7 +139,7 @@ func buildCustomPredicate() predicate.Predicate {
 			}
 
 			// Policy snapshot spec is immutable; however, the scheduler will have to respond
-			// to changes in the numberOfCluster & CRPGeneration annotations.
+			// to changes in the numberOfCluster, numberOfSurgeClusters & CRPGeneration annotations.
 			oldAnnotations := e.ObjectOld.GetAnnotations()
 			newAnnotations := e.ObjectNew.GetAnnotations()
 
@@ -149,6 +149,14 @@ func buildCustomPredicate() predicate.Predicate {
 				return true
 			}
 
+			// The rollout controller asks the scheduler for additional clusters when it surges
+			// a resource change.
+			oldNumOfSurgeClusters := oldAnnotations[fleetv1beta1.NumberOfSurgeClustersAnnotation]
+			newNumOfSurgeClusters := newAnnotations[fleetv1beta1.NumberOfSurgeClustersAnnotation]
+			if oldNumOfSurgeClusters != newNumOfSurgeClusters {
+				return true
+			}
+
 			// The scheduler needs to update the policy snapshot based on the latest CRP generation, when resource selector
 			// has changed and there are no policy changes.
 			oldObservedCRPGeneration := oldAnnotations[fleetv1beta1.CRPGenerationAnnotation]
@@ -0,0 +1,119 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testutils
+
+import (
+	"fmt"
+	"path/filepath"
+	goruntime "runtime"
+
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// FleetCRDDirectory returns the directory that holds the KubeFleet CRDs, resolved from the location of
+// this package, so that it can be found both in this repository and in the Go module cache.
+func FleetCRDDirectory() string {
+	_, file, _, _ := goruntime.Caller(0)
+	return filepath.Join(filepath.Dir(file), "..", "..", "config", "crd", "bases")
+}
+
+// NewFleetScheme returns a new scheme with the Kubernetes built-in APIs and the v1beta1 KubeFleet
+// placement and cluster APIs registered.
+func NewFleetScheme() (*runtime.Scheme, error) {
+	scheme := runtime.NewScheme()
+	for _, addToScheme := range []func(*runtime.Scheme) error{
+		clientgoscheme.AddToScheme,
+		placementv1beta1.AddToScheme,
+		clusterv1beta1.AddToScheme,
+	} {
+		if err := addToScheme(scheme); err != nil {
+			return nil, fmt.Errorf("failed to build the scheme: %w", err)
+		}
+	}
+	return scheme, nil
+}
+
+// HubEnvironment is a hub cluster run by envtest, i.e., a local etcd and API server, with the
+// KubeFleet CRDs installed.
+type HubEnvironment struct {
+	// Config is the configuration for accessing the hub cluster.
+	Config *rest.Config
+	// Scheme is the scheme that the client and the managers of the environment use.
+	Scheme *runtime.Scheme
+	// Client is a client of the hub cluster which does not read from caches.
+	Client client.Client
+
+	testEnv *envtest.Environment
+}
+
+// StartHubEnvironment starts a hub cluster with the KubeFleet CRDs and the CRDs in the given extra
+// directories installed, and returns the environment for accessing it. The environment must be stopped
+// with Stop after use.
+//
+// As with envtest in general, the control plane binaries are located via the KUBEBUILDER_ASSETS
+// environment variable.
+func StartHubEnvironment(extraCRDDirectoryPaths ...string) (*HubEnvironment, error) {
+	scheme, err := NewFleetScheme()
+	if err != nil {
+		return nil, err
+	}
+	testEnv := &envtest.Environment{
+		CRDDirectoryPaths:     append([]string{FleetCRDDirectory()}, extraCRDDirectoryPaths...),
+		ErrorIfCRDPathMissing: true,
+	}
+	cfg, err := testEnv.Start()
+	if err != nil {
+		return nil, fmt.Errorf("failed to start the test environment: %w", err)
+	}
+	hubClient, err := client.New(cfg, client.Options{Scheme: scheme})
+	if err != nil {
+		_ = testEnv.Stop()
+		return nil, fmt.Errorf("failed to create the hub cluster client: %w", err)
+	}
+	return &HubEnvironment{
+		Config:  cfg,
+		Scheme:  scheme,
+		Client:  hubClient,
+		testEnv: testEnv,
+	}, nil
+}
+
+// NewManager returns a new controller manager for the hub cluster, with the metrics server disabled so
+// that multiple test suites can run on the same host; the manager is not started.
+func (e *HubEnvironment) NewManager() (manager.Manager, error) {
+	return ctrl.NewManager(e.Config, ctrl.Options{
+		Scheme: e.Scheme,
+		Metrics: metricsserver.Options{
+			BindAddress: "0",
+		},
+	})
+}
+
+// Stop stops the hub cluster.
+func (e *HubEnvironment) Stop() error {
+	return e.testEnv.Stop()
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package testutils features the test harness that KubeFleet uses in its own controller and scheduler
+// integration tests, i.e., a fake placement controller, a scheduler work queue key collector, and an
+// envtest bootstrap for a hub cluster with the KubeFleet CRDs installed; it is published so that third
+// parties writing scheduler plugins or custom controllers against the KubeFleet APIs can reuse it.
+package testutils
+
+import (
+	"context"
+	"sync"
+)
+
+// FakePlacementController is a fake placement controller, which stores the last key enqueued instead
+// of reconciling it; it can be set as the PlacementController of the watchers to verify which
+// placements they enqueue.
+type FakePlacementController struct {
+	key string
+	mu  sync.RWMutex
+}
+
+// NewFakePlacementController returns a new FakePlacementController with an empty queue.
+func NewFakePlacementController() *FakePlacementController {
+	return &FakePlacementController{}
+}
+
+// ResetQueue resets the value in the queue.
+func (f *FakePlacementController) ResetQueue() {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+	f.key = ""
+}
+
+// Enqueue enqueues a string type key; keys of other types are ignored.
+func (f *FakePlacementController) Enqueue(obj interface{}) {
+	key, ok := obj.(string)
+	if !ok {
+		return
+	}
+	f.mu.Lock()
+	f.key = key
+	f.mu.Unlock()
+}
+
+// Run does nothing.
+func (f *FakePlacementController) Run(_ context.Context, _ int) error {
+	return nil
+}
+
+// Key returns the key stored in the queue.
+func (f *FakePlacementController) Key() string {
+	f.mu.RLock()
+	defer f.mu.RUnlock()
+	return f.key
+}
@@ -0,0 +1,35 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testutils
+
+import (
+	"testing"
+)
+
+func TestFakePlacementController(t *testing.T) {
+	f := NewFakePlacementController()
+	f.Enqueue("crp-1")
+	// Keys of other types are ignored.
+	f.Enqueue(1)
+	if got := f.Key(); got != "crp-1" {
+		t.Errorf("Key() = %q, want %q", got, "crp-1")
+	}
+	f.ResetQueue()
+	if got := f.Key(); got != "" {
+		t.Errorf("Key() after ResetQueue() = %q, want empty", got)
+	}
+}
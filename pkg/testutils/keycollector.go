@@ -14,8 +14,7 @@ See the License for the specific language governing permissions and
 limitations under the License.
 */
 
-// Package keycollector features a utility that is used for testing scheduler-related logic.
-package keycollector
+package testutils
 
 import (
 	"context"
@@ -34,7 +33,8 @@ type SchedulerWorkqueueKeyCollector struct {
 	collectedKeys map[string]bool
 }
 
-// NewSchedulerWorkqueueKeyCollector returns a new SchedulerWorkqueueKeyCollector.
+// NewSchedulerWorkqueueKeyCollector returns a new SchedulerWorkqueueKeyCollector, which collects the
+// keys added to the given scheduler work queue once it runs.
 func NewSchedulerWorkqueueKeyCollector(wq queue.PlacementSchedulingQueue) *SchedulerWorkqueueKeyCollector {
 	return &SchedulerWorkqueueKeyCollector{
 		schedulerWorkqueue: wq,
@@ -42,7 +42,7 @@ func NewSchedulerWorkqueueKeyCollector(wq queue.PlacementSchedulingQueue) *Sched
 	}
 }
 
-// Run runs the SchedulerWorkqueueKeyCollector.
+// Run runs the SchedulerWorkqueueKeyCollector until the context is cancelled.
 func (kc *SchedulerWorkqueueKeyCollector) Run(ctx context.Context) {
 	go func() {
 		for {
@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package testutils
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/util/wait"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+)
+
+func TestSchedulerWorkqueueKeyCollector(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	wq := queue.NewSimplePlacementSchedulingQueue("", nil)
+	go wq.Run()
+	defer wq.Close()
+	kc := NewSchedulerWorkqueueKeyCollector(wq)
+	go kc.Run(ctx)
+
+	wq.Add(queue.PlacementKey("crp-1"))
+	wq.Add(queue.PlacementKey("work/rp-1"))
+	if err := wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 5*time.Second, true, func(_ context.Context) (bool, error) {
+		return kc.Len() == 2, nil
+	}); err != nil {
+		t.Fatalf("Len() = %d, want 2", kc.Len())
+	}
+
+	isAllPresent, absentKeys := kc.IsPresent("crp-1", "work/rp-1", "crp-2")
+	if isAllPresent {
+		t.Errorf("IsPresent() = true, want false")
+	}
+	if diff := cmp.Diff(absentKeys, []string{"crp-2"}); diff != "" {
+		t.Errorf("IsPresent() absent keys mismatch (-got, +want):\n%s", diff)
+	}
+
+	kc.Reset()
+	if got := kc.Len(); got != 0 {
+		t.Errorf("Len() after Reset() = %d, want 0", got)
+	}
+}
@@ -0,0 +1,224 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/http2"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// The backoff settings for reconnecting the tunnel.
+	reconnectInitialDelay = time.Second
+	reconnectMaxDelay     = time.Minute
+
+	// maxErrorBodyBytes is the max number of bytes of a rejection from the tunnel server included in an error.
+	maxErrorBodyBytes = 512
+)
+
+// Agent is the tunnel agent, which runs in the member agent; it keeps a tunnel connected to the
+// tunnel server in the hub agent, and serves the requests it receives via the tunnel by proxying
+// them to the API server of the member cluster.
+type Agent struct {
+	clusterName string
+	tunnelURL   string
+	hubClient   *http.Client
+	handler     http.Handler
+}
+
+// NewAgent returns a tunnel agent for a member cluster, which connects to the tunnel server at the
+// given HTTPS URL with the connectivity settings (TLS, proxy, and credentials) of the hub cluster
+// config, and proxies requests with the member cluster config.
+func NewAgent(clusterName, tunnelURL string, hubCfg, memberCfg *rest.Config) (*Agent, error) {
+	// The member agent sends its credentials for the hub cluster to the tunnel server.
+	u, err := url.Parse(tunnelURL)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse the reverse tunnel URL %q: %w", tunnelURL, err)
+	}
+	if u.Scheme != "https" {
+		return nil, fmt.Errorf("the reverse tunnel URL %q must use the https scheme", tunnelURL)
+	}
+	hubClient, err := newTunnelHTTPClient(hubCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the HTTP client for the reverse tunnel: %w", err)
+	}
+	handler, err := newMemberAPIServerProxy(memberCfg)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create the member cluster API server proxy for the reverse tunnel: %w", err)
+	}
+	return &Agent{
+		clusterName: clusterName,
+		tunnelURL:   strings.TrimSuffix(tunnelURL, "/") + ConnectPath,
+		hubClient:   hubClient,
+		handler:     handler,
+	}, nil
+}
+
+// Start keeps the tunnel connected until the context is cancelled; it implements the
+// controller-runtime Runnable interface.
+func (a *Agent) Start(ctx context.Context) error {
+	backoff := newReconnectBackoff()
+	for {
+		connectedAt := time.Now()
+		err := a.connect(ctx)
+		if ctx.Err() != nil {
+			return nil
+		}
+		// Reset the backoff if the tunnel has stayed connected for a while.
+		if time.Since(connectedAt) > reconnectMaxDelay {
+			backoff = newReconnectBackoff()
+		}
+		delay := backoff.Step()
+		klog.ErrorS(err, "The reverse tunnel to the hub cluster has disconnected; will reconnect", "tunnelURL", a.tunnelURL, "delay", delay)
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-time.After(delay):
+		}
+	}
+}
+
+// newReconnectBackoff returns the backoff for reconnecting the tunnel, which grows exponentially
+// until it reaches the max delay.
+func newReconnectBackoff() wait.Backoff {
+	return wait.Backoff{
+		Duration: reconnectInitialDelay,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    math.MaxInt32,
+		Cap:      reconnectMaxDelay,
+	}
+}
+
+// connect connects the tunnel, and serves the requests from the tunnel until it disconnects.
+func (a *Agent) connect(ctx context.Context) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, a.tunnelURL, nil)
+	if err != nil {
+		return fmt.Errorf("failed to build the tunnel request: %w", err)
+	}
+	req.Header.Set("Connection", "Upgrade")
+	req.Header.Set("Upgrade", UpgradeProtocol)
+	req.Header.Set(MemberClusterNameHeader, a.clusterName)
+
+	resp, err := a.hubClient.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to connect to the tunnel server: %w", err)
+	}
+	if resp.StatusCode != http.StatusSwitchingProtocols {
+		defer resp.Body.Close()
+		body, _ := io.ReadAll(io.LimitReader(resp.Body, maxErrorBodyBytes))
+		return fmt.Errorf("the tunnel server rejected the tunnel (HTTP status %d): %s", resp.StatusCode, strings.TrimSpace(string(body)))
+	}
+	rwc, ok := resp.Body.(io.ReadWriteCloser)
+	if !ok {
+		resp.Body.Close()
+		return errors.New("the upgraded tunnel connection is not writable")
+	}
+	conn := &agentConn{ReadWriteCloser: rwc}
+	// Close the connection when the context is cancelled, which stops serving the tunnel.
+	stop := context.AfterFunc(ctx, func() { _ = conn.Close() })
+	defer stop()
+
+	klog.InfoS("The reverse tunnel to the hub cluster has connected", "tunnelURL", a.tunnelURL)
+	// ServeConn blocks until the connection closes.
+	(&http2.Server{}).ServeConn(conn, &http2.ServeConnOpts{
+		Context: ctx,
+		Handler: a.handler,
+	})
+	return errors.New("the tunnel connection has closed")
+}
+
+// newTunnelHTTPClient returns an HTTP client that connects to the tunnel server with the
+// connectivity settings of the hub cluster config.
+//
+// The client speaks only HTTP/1.1, as the tunnel is set up via an HTTP/1.1 upgrade.
+func newTunnelHTTPClient(hubCfg *rest.Config) (*http.Client, error) {
+	tlsConfig, err := rest.TLSConfigFor(hubCfg)
+	if err != nil {
+		return nil, err
+	}
+	if tlsConfig != nil {
+		tlsConfig.NextProtos = []string{"http/1.1"}
+	}
+	proxy := hubCfg.Proxy
+	if proxy == nil {
+		proxy = http.ProxyFromEnvironment
+	}
+	transport := &http.Transport{
+		Proxy:               proxy,
+		TLSClientConfig:     tlsConfig,
+		TLSHandshakeTimeout: 10 * time.Second,
+	}
+	// Add the credentials, custom headers and other wrappers of the hub cluster config.
+	rt, err := rest.HTTPWrappersForConfig(hubCfg, transport)
+	if err != nil {
+		return nil, err
+	}
+	return &http.Client{Transport: rt}, nil
+}
+
+// newMemberAPIServerProxy returns a handler that proxies the requests from the tunnel to the API
+// server of the member cluster with the credentials of the member agent, impersonating ProxyUserName.
+//
+// Only read-only requests are allowed, and headers that might change the identity of the requests,
+// such as the impersonation headers set by the sender, are removed.
+func newMemberAPIServerProxy(memberCfg *rest.Config) (http.Handler, error) {
+	target, _, err := rest.DefaultServerUrlFor(memberCfg)
+	if err != nil {
+		return nil, err
+	}
+	proxyCfg := rest.CopyConfig(memberCfg)
+	proxyCfg.Impersonate = rest.ImpersonationConfig{UserName: ProxyUserName}
+	transport, err := rest.TransportFor(proxyCfg)
+	if err != nil {
+		return nil, err
+	}
+	proxy := &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(target)
+			r.Out.Header.Del("Authorization")
+			for key := range r.Out.Header {
+				if strings.HasPrefix(http.CanonicalHeaderKey(key), "Impersonate-") {
+					r.Out.Header.Del(key)
+				}
+			}
+		},
+		Transport: transport,
+		// Flush immediately so that watches are streamed.
+		FlushInterval: -1,
+	}
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet && r.Method != http.MethodHead {
+			http.Error(w, "only read-only requests are allowed via the reverse tunnel", http.StatusMethodNotAllowed)
+			return
+		}
+		proxy.ServeHTTP(w, r)
+	}), nil
+}
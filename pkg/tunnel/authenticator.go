@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+const (
+	// serviceAccountUsernameFmt is the format of the user names of service accounts.
+	serviceAccountUsernameFmt = "system:serviceaccount:%s:%s"
+)
+
+// Authenticator verifies that a tunnel request comes from the member agent of a member cluster.
+type Authenticator interface {
+	// Authenticate returns an error if the request does not come from the member agent of the
+	// given member cluster.
+	Authenticate(ctx context.Context, req *http.Request, clusterName string) error
+}
+
+// tokenReviewAuthenticator authenticates the bearer token in a tunnel request via the TokenReview
+// API of the hub cluster, and verifies that the authenticated user matches the identity of the
+// member cluster.
+type tokenReviewAuthenticator struct {
+	hubClient client.Client
+}
+
+// NewTokenReviewAuthenticator returns an Authenticator that authenticates member agents with the
+// same bearer tokens they use to access the hub cluster.
+func NewTokenReviewAuthenticator(hubClient client.Client) Authenticator {
+	return &tokenReviewAuthenticator{hubClient: hubClient}
+}
+
+// Authenticate implements the Authenticator interface.
+func (a *tokenReviewAuthenticator) Authenticate(ctx context.Context, req *http.Request, clusterName string) error {
+	token, ok := strings.CutPrefix(req.Header.Get("Authorization"), "Bearer ")
+	if !ok || token == "" {
+		return errors.New("no bearer token is found in the request; the reverse tunnel supports only token-based authentication")
+	}
+
+	review := &authenticationv1.TokenReview{
+		Spec: authenticationv1.TokenReviewSpec{Token: token},
+	}
+	if err := a.hubClient.Create(ctx, review); err != nil {
+		return fmt.Errorf("failed to review the bearer token: %w", err)
+	}
+	if !review.Status.Authenticated {
+		return fmt.Errorf("the bearer token is not authenticated: %s", review.Status.Error)
+	}
+
+	var mc clusterv1beta1.MemberCluster
+	if err := a.hubClient.Get(ctx, client.ObjectKey{Name: clusterName}, &mc); err != nil {
+		return fmt.Errorf("failed to get member cluster %s: %w", clusterName, err)
+	}
+	if !identityMatches(mc.Spec.Identity, review.Status.User) {
+		return fmt.Errorf("user %s does not match the identity of member cluster %s", review.Status.User.Username, clusterName)
+	}
+	return nil
+}
+
+// identityMatches returns if an authenticated user matches the identity of a member cluster.
+func identityMatches(identity rbacv1.Subject, user authenticationv1.UserInfo) bool {
+	switch identity.Kind {
+	case rbacv1.UserKind:
+		return user.Username == identity.Name
+	case rbacv1.ServiceAccountKind:
+		return user.Username == fmt.Sprintf(serviceAccountUsernameFmt, identity.Namespace, identity.Name)
+	case rbacv1.GroupKind:
+		return slices.Contains(user.Groups, identity.Name)
+	default:
+		return false
+	}
+}
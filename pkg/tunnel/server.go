@@ -0,0 +1,268 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"context"
+	"crypto/tls"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/http2"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/certwatcher"
+)
+
+// ErrTunnelNotConnected is returned when the hub agent sends a request to a member cluster which has
+// no tunnel connected.
+var ErrTunnelNotConnected = errors.New("no reverse tunnel is connected for the member cluster")
+
+// Server is the tunnel server, which runs in the hub agent and accepts the tunnels initiated by
+// member agents.
+//
+// The server runs only on the leader hub agent instance, where the controllers that use the
+// tunnels run; member agents that connect to a non-leader instance are rejected and will retry.
+type Server struct {
+	bindAddress   string
+	certFile      string
+	keyFile       string
+	authenticator Authenticator
+
+	mu      sync.RWMutex
+	tunnels map[string]*tunnel
+}
+
+// tunnel is a tunnel connected by a member agent.
+type tunnel struct {
+	conn       *serverConn
+	clientConn *http2.ClientConn
+}
+
+// NewServer returns a tunnel server that listens on the given address, and serves TLS with the
+// given certificate and key files; the files are reloaded when they change.
+func NewServer(bindAddress, certFile, keyFile string, authenticator Authenticator) *Server {
+	return &Server{
+		bindAddress:   bindAddress,
+		certFile:      certFile,
+		keyFile:       keyFile,
+		authenticator: authenticator,
+		tunnels:       make(map[string]*tunnel),
+	}
+}
+
+// Start runs the tunnel server until the context is cancelled; it implements the
+// controller-runtime Runnable interface.
+func (s *Server) Start(ctx context.Context) error {
+	// The member agents authenticate themselves with bearer tokens, which must not be sent in the clear.
+	if s.certFile == "" || s.keyFile == "" {
+		return errors.New("the reverse tunnel server requires a TLS certificate and key")
+	}
+	watcher, err := certwatcher.New(s.certFile, s.keyFile)
+	if err != nil {
+		return fmt.Errorf("failed to load the serving certificate of the reverse tunnel server: %w", err)
+	}
+	go func() {
+		if err := watcher.Start(ctx); err != nil {
+			klog.ErrorS(err, "Failed to watch the serving certificate of the reverse tunnel server")
+		}
+	}()
+	listener, err := tls.Listen("tcp", s.bindAddress, &tls.Config{
+		GetCertificate: watcher.GetCertificate,
+		// The tunnel is set up via an HTTP/1.1 upgrade.
+		NextProtos: []string{"http/1.1"},
+		MinVersion: tls.VersionTLS12,
+	})
+	if err != nil {
+		return fmt.Errorf("failed to listen on %s: %w", s.bindAddress, err)
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle(ConnectPath, s)
+	srv := &http.Server{
+		Handler:           mux,
+		ReadHeaderTimeout: 10 * time.Second,
+	}
+
+	errCh := make(chan error, 1)
+	go func() {
+		klog.InfoS("Starting the reverse tunnel server", "address", s.bindAddress)
+		errCh <- srv.Serve(listener)
+	}()
+
+	select {
+	case err := <-errCh:
+		return fmt.Errorf("the reverse tunnel server has stopped: %w", err)
+	case <-ctx.Done():
+	}
+
+	klog.InfoS("Shutting down the reverse tunnel server")
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	// Hijacked connections are not tracked by the HTTP server; close the tunnels explicitly.
+	s.closeAll()
+	return srv.Shutdown(shutdownCtx)
+}
+
+// ServeHTTP accepts a tunnel connected by a member agent.
+func (s *Server) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	clusterName := r.Header.Get(MemberClusterNameHeader)
+	if errs := validation.IsDNS1123Subdomain(clusterName); len(errs) != 0 {
+		http.Error(w, fmt.Sprintf("invalid member cluster name %q in the %s header: %s", clusterName, MemberClusterNameHeader, strings.Join(errs, "; ")), http.StatusBadRequest)
+		return
+	}
+	if !headerHasToken(r.Header, "Connection", "upgrade") || !strings.EqualFold(r.Header.Get("Upgrade"), UpgradeProtocol) {
+		http.Error(w, fmt.Sprintf("expected an upgrade to %s", UpgradeProtocol), http.StatusUpgradeRequired)
+		return
+	}
+	if err := s.authenticator.Authenticate(r.Context(), r, clusterName); err != nil {
+		klog.ErrorS(err, "Rejected a reverse tunnel", "memberCluster", clusterName, "remoteAddr", r.RemoteAddr)
+		http.Error(w, err.Error(), http.StatusForbidden)
+		return
+	}
+
+	hijacker, ok := w.(http.Hijacker)
+	if !ok {
+		http.Error(w, "the connection cannot be upgraded", http.StatusInternalServerError)
+		return
+	}
+	rawConn, rw, err := hijacker.Hijack()
+	if err != nil {
+		klog.ErrorS(err, "Failed to hijack the connection for a reverse tunnel", "memberCluster", clusterName)
+		return
+	}
+	conn := newServerConn(rawConn, rw.Reader)
+	if _, err := fmt.Fprintf(rw, "HTTP/1.1 101 Switching Protocols\r\nConnection: Upgrade\r\nUpgrade: %s\r\n\r\n", UpgradeProtocol); err != nil {
+		klog.ErrorS(err, "Failed to upgrade the connection for a reverse tunnel", "memberCluster", clusterName)
+		_ = conn.Close()
+		return
+	}
+	if err := rw.Flush(); err != nil {
+		klog.ErrorS(err, "Failed to upgrade the connection for a reverse tunnel", "memberCluster", clusterName)
+		_ = conn.Close()
+		return
+	}
+
+	transport := &http2.Transport{
+		ReadIdleTimeout: pingInterval,
+		PingTimeout:     pingTimeout,
+	}
+	clientConn, err := transport.NewClientConn(conn)
+	if err != nil {
+		klog.ErrorS(err, "Failed to set up the HTTP/2 connection for a reverse tunnel", "memberCluster", clusterName)
+		_ = conn.Close()
+		return
+	}
+
+	t := &tunnel{conn: conn, clientConn: clientConn}
+	s.register(clusterName, t)
+	klog.InfoS("A reverse tunnel has connected", "memberCluster", clusterName, "remoteAddr", r.RemoteAddr)
+	go func() {
+		<-conn.closed
+		s.unregister(clusterName, t)
+		klog.InfoS("A reverse tunnel has disconnected", "memberCluster", clusterName)
+	}()
+}
+
+// register registers a tunnel for a member cluster, replacing the existing one, if any.
+func (s *Server) register(clusterName string, t *tunnel) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if existing, ok := s.tunnels[clusterName]; ok {
+		_ = existing.clientConn.Close()
+	}
+	s.tunnels[clusterName] = t
+}
+
+// unregister unregisters a tunnel for a member cluster, if it has not been replaced yet.
+func (s *Server) unregister(clusterName string, t *tunnel) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.tunnels[clusterName] == t {
+		delete(s.tunnels, clusterName)
+	}
+}
+
+// closeAll closes all the tunnels.
+func (s *Server) closeAll() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for clusterName, t := range s.tunnels {
+		_ = t.clientConn.Close()
+		delete(s.tunnels, clusterName)
+	}
+}
+
+// IsConnected returns if a tunnel is connected for a member cluster.
+func (s *Server) IsConnected(clusterName string) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	_, ok := s.tunnels[clusterName]
+	return ok
+}
+
+// RoundTripper returns a round tripper that sends requests to the API server of a member cluster
+// via its tunnel. Requests fail with ErrTunnelNotConnected if no tunnel is connected when they
+// are sent; reconnected tunnels are picked up automatically.
+func (s *Server) RoundTripper(clusterName string) http.RoundTripper {
+	return &tunnelRoundTripper{server: s, clusterName: clusterName}
+}
+
+// RESTConfigFor returns a REST config for the API server of a member cluster via its tunnel.
+//
+// The member agent serves the requests as ProxyUserName, and only read-only requests are allowed.
+func (s *Server) RESTConfigFor(clusterName string) *rest.Config {
+	return &rest.Config{
+		// The host is not used for routing; the requests are always sent via the tunnel.
+		Host:      "http://" + clusterName,
+		Transport: s.RoundTripper(clusterName),
+	}
+}
+
+type tunnelRoundTripper struct {
+	server      *Server
+	clusterName string
+}
+
+var _ http.RoundTripper = &tunnelRoundTripper{}
+
+func (rt *tunnelRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	rt.server.mu.RLock()
+	t, ok := rt.server.tunnels[rt.clusterName]
+	rt.server.mu.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("member cluster %s: %w", rt.clusterName, ErrTunnelNotConnected)
+	}
+	return t.clientConn.RoundTrip(req)
+}
+
+// headerHasToken returns if a comma-separated header contains the given token, case-insensitively.
+func headerHasToken(header http.Header, key, token string) bool {
+	for _, value := range header.Values(key) {
+		for _, v := range strings.Split(value, ",") {
+			if strings.EqualFold(strings.TrimSpace(v), token) {
+				return true
+			}
+		}
+	}
+	return false
+}
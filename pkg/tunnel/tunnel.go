@@ -0,0 +1,104 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package tunnel features the reverse tunnel subsystem, which allows the hub cluster to reach the
+// API servers of member clusters without inbound connectivity to the member clusters.
+//
+// The member agent initiates the tunnel: it sends an HTTP/1.1 upgrade request to the tunnel server
+// in the hub agent, and once the connection is upgraded, the roles reverse; the hub agent acts as an
+// HTTP/2 client over the connection, and the member agent serves the requests it receives by proxying
+// them to the API server of the member cluster, impersonating a user with read-only access.
+//
+// The tunnel is always set up over TLS, as the member agent authenticates itself with a bearer token.
+package tunnel
+
+import (
+	"bufio"
+	"io"
+	"net"
+	"sync"
+	"time"
+)
+
+const (
+	// ConnectPath is the path of the tunnel server endpoint to which the member agent connects.
+	ConnectPath = "/tunnel/v1/connect"
+
+	// UpgradeProtocol is the protocol to which the member agent asks the tunnel server to switch.
+	UpgradeProtocol = "fleet-tunnel/v1"
+
+	// MemberClusterNameHeader is the header in which the member agent specifies the name of its member cluster.
+	MemberClusterNameHeader = "Fleet-Member-Cluster"
+
+	// ProxyUserName is the user that the member agent impersonates when it proxies the requests from the
+	// tunnel to the API server of the member cluster, so that the requests are not served with the broad
+	// permissions of the member agent; the member agent chart grants the user read-only access to the
+	// member cluster, which excludes Secrets.
+	ProxyUserName = "fleet:reverse-tunnel"
+
+	// pingInterval is the interval after which the tunnel server pings the member agent if the tunnel has
+	// been idle; pingTimeout is the timeout of the ping, after which the tunnel server closes the tunnel.
+	pingInterval = 30 * time.Second
+	pingTimeout  = 15 * time.Second
+)
+
+// serverConn is the connection on the tunnel server side of a tunnel, hijacked from the HTTP server.
+type serverConn struct {
+	net.Conn
+	// reader reads any data the HTTP server has buffered before the connection is hijacked.
+	reader *bufio.Reader
+
+	closeOnce sync.Once
+	// closed is closed when the connection closes.
+	closed chan struct{}
+}
+
+func newServerConn(conn net.Conn, reader *bufio.Reader) *serverConn {
+	return &serverConn{
+		Conn:   conn,
+		reader: reader,
+		closed: make(chan struct{}),
+	}
+}
+
+func (c *serverConn) Read(b []byte) (int, error) {
+	return c.reader.Read(b)
+}
+
+func (c *serverConn) Close() error {
+	c.closeOnce.Do(func() { close(c.closed) })
+	return c.Conn.Close()
+}
+
+// agentConn is the connection on the member agent side of a tunnel, built from the body of an
+// upgraded HTTP response, which is writable.
+type agentConn struct {
+	io.ReadWriteCloser
+}
+
+var _ net.Conn = &agentConn{}
+
+// tunnelAddr is the address of both ends of a tunnel, which the member agent does not have access to.
+type tunnelAddr struct{}
+
+func (tunnelAddr) Network() string { return "tunnel" }
+func (tunnelAddr) String() string  { return "tunnel" }
+
+func (c *agentConn) LocalAddr() net.Addr                { return tunnelAddr{} }
+func (c *agentConn) RemoteAddr() net.Addr               { return tunnelAddr{} }
+func (c *agentConn) SetDeadline(_ time.Time) error      { return nil }
+func (c *agentConn) SetReadDeadline(_ time.Time) error  { return nil }
+func (c *agentConn) SetWriteDeadline(_ time.Time) error { return nil }
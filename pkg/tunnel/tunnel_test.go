@@ -0,0 +1,225 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package tunnel
+
+import (
+	"context"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	memberClusterName = "member-1"
+)
+
+// fakeAuthenticator accepts the requests with the expected bearer token.
+type fakeAuthenticator struct {
+	token string
+}
+
+func (a *fakeAuthenticator) Authenticate(_ context.Context, req *http.Request, clusterName string) error {
+	if clusterName != memberClusterName || req.Header.Get("Authorization") != "Bearer "+a.token {
+		return errors.New("not authenticated")
+	}
+	return nil
+}
+
+// startTunnel starts a member cluster API server, a tunnel server, and a tunnel agent that connects to
+// the tunnel server with the given token.
+func startTunnel(t *testing.T, token string) *Server {
+	t.Helper()
+
+	memberAPIServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		fmt.Fprintf(w, "%s %s %s %s", r.Method, r.URL.Path, r.Header.Get("Authorization"), r.Header.Get("Impersonate-User"))
+	}))
+	t.Cleanup(memberAPIServer.Close)
+
+	server := NewServer("", "", "", &fakeAuthenticator{token: "hub-token"})
+	tunnelServer := httptest.NewTLSServer(server)
+	t.Cleanup(tunnelServer.Close)
+	caData := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: tunnelServer.Certificate().Raw})
+
+	agent, err := NewAgent(memberClusterName, tunnelServer.URL,
+		&rest.Config{Host: tunnelServer.URL, BearerToken: token, TLSClientConfig: rest.TLSClientConfig{CAData: caData}},
+		&rest.Config{Host: memberAPIServer.URL, BearerToken: "member-token"})
+	if err != nil {
+		t.Fatalf("NewAgent() = %v, want no error", err)
+	}
+	ctx, cancel := context.WithCancel(context.Background())
+	t.Cleanup(cancel)
+	go func() {
+		_ = agent.Start(ctx)
+	}()
+	return server
+}
+
+func TestTunnel(t *testing.T) {
+	server := startTunnel(t, "hub-token")
+	if err := wait.PollUntilContextTimeout(context.Background(), 10*time.Millisecond, 10*time.Second, true, func(_ context.Context) (bool, error) {
+		return server.IsConnected(memberClusterName), nil
+	}); err != nil {
+		t.Fatalf("IsConnected() = false, want true")
+	}
+
+	client := &http.Client{Transport: server.RoundTripper(memberClusterName)}
+	tests := []struct {
+		name           string
+		method         string
+		headers        map[string]string
+		wantStatusCode int
+		wantBody       string
+	}{
+		{
+			name:           "read-only request",
+			method:         http.MethodGet,
+			wantStatusCode: http.StatusOK,
+			wantBody:       "GET /api/v1/namespaces Bearer member-token fleet:reverse-tunnel",
+		},
+		{
+			name:   "credentials and impersonation headers are replaced",
+			method: http.MethodGet,
+			headers: map[string]string{
+				"Authorization":    "Bearer hub-token",
+				"Impersonate-User": "admin",
+			},
+			wantStatusCode: http.StatusOK,
+			wantBody:       "GET /api/v1/namespaces Bearer member-token fleet:reverse-tunnel",
+		},
+		{
+			name:           "write request",
+			method:         http.MethodPost,
+			wantStatusCode: http.StatusMethodNotAllowed,
+			wantBody:       "only read-only requests are allowed via the reverse tunnel\n",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			req, err := http.NewRequest(tc.method, "http://"+memberClusterName+"/api/v1/namespaces", nil)
+			if err != nil {
+				t.Fatalf("failed to build request: %v", err)
+			}
+			for k, v := range tc.headers {
+				req.Header.Set(k, v)
+			}
+			resp, err := client.Do(req)
+			if err != nil {
+				t.Fatalf("Do() = %v, want no error", err)
+			}
+			defer resp.Body.Close()
+			body, err := io.ReadAll(resp.Body)
+			if err != nil {
+				t.Fatalf("failed to read response: %v", err)
+			}
+			if resp.StatusCode != tc.wantStatusCode {
+				t.Errorf("Do() status code = %d, want %d", resp.StatusCode, tc.wantStatusCode)
+			}
+			if string(body) != tc.wantBody {
+				t.Errorf("Do() body = %q, want %q", string(body), tc.wantBody)
+			}
+		})
+	}
+
+	t.Run("no tunnel connected", func(t *testing.T) {
+		req, err := http.NewRequest(http.MethodGet, "http://member-2/api", nil)
+		if err != nil {
+			t.Fatalf("failed to build request: %v", err)
+		}
+		if _, err := server.RoundTripper("member-2").RoundTrip(req); !errors.Is(err, ErrTunnelNotConnected) {
+			t.Errorf("RoundTrip() = %v, want %v", err, ErrTunnelNotConnected)
+		}
+	})
+}
+
+func TestTunnelNotAuthenticated(t *testing.T) {
+	server := startTunnel(t, "wrong-token")
+	// Give the agent time to attempt the connection.
+	time.Sleep(500 * time.Millisecond)
+	if server.IsConnected(memberClusterName) {
+		t.Errorf("IsConnected() = true, want false")
+	}
+}
+
+func TestNewAgentInsecureURL(t *testing.T) {
+	if _, err := NewAgent(memberClusterName, "http://tunnel.example.com", &rest.Config{Host: "https://hub.example.com"}, &rest.Config{Host: "https://member.example.com"}); err == nil {
+		t.Errorf("NewAgent() = nil, want error")
+	}
+}
+
+func TestServerStartWithoutTLS(t *testing.T) {
+	server := NewServer(":0", "", "", &fakeAuthenticator{token: "hub-token"})
+	if err := server.Start(context.Background()); err == nil {
+		t.Errorf("Start() = nil, want error")
+	}
+}
+
+func TestIdentityMatches(t *testing.T) {
+	tests := []struct {
+		name     string
+		identity rbacv1.Subject
+		user     authenticationv1.UserInfo
+		want     bool
+	}{
+		{
+			name:     "user",
+			identity: rbacv1.Subject{Kind: rbacv1.UserKind, Name: "member-1-agent"},
+			user:     authenticationv1.UserInfo{Username: "member-1-agent"},
+			want:     true,
+		},
+		{
+			name:     "different user",
+			identity: rbacv1.Subject{Kind: rbacv1.UserKind, Name: "member-1-agent"},
+			user:     authenticationv1.UserInfo{Username: "member-2-agent"},
+			want:     false,
+		},
+		{
+			name:     "service account",
+			identity: rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Namespace: "fleet-system", Name: "member-1"},
+			user:     authenticationv1.UserInfo{Username: "system:serviceaccount:fleet-system:member-1"},
+			want:     true,
+		},
+		{
+			name:     "service account in a different namespace",
+			identity: rbacv1.Subject{Kind: rbacv1.ServiceAccountKind, Namespace: "fleet-system", Name: "member-1"},
+			user:     authenticationv1.UserInfo{Username: "system:serviceaccount:default:member-1"},
+			want:     false,
+		},
+		{
+			name:     "group",
+			identity: rbacv1.Subject{Kind: rbacv1.GroupKind, Name: "member-agents"},
+			user:     authenticationv1.UserInfo{Username: "member-1-agent", Groups: []string{"system:authenticated", "member-agents"}},
+			want:     true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := identityMatches(tc.identity, tc.user); got != tc.want {
+				t.Errorf("identityMatches() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
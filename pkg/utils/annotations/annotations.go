@@ -42,6 +42,22 @@ func ExtractNumOfClustersFromPolicySnapshot(policy fleetv1beta1.PolicySnapshotOb
 	return numOfClusters, nil
 }
 
+// ExtractNumOfSurgeClustersFromPolicySnapshot extracts the number of surge clusters from the annotations
+// on a policy snapshot; it returns 0 if the annotation is absent.
+func ExtractNumOfSurgeClustersFromPolicySnapshot(policy fleetv1beta1.PolicySnapshotObj) (int, error) {
+	numOfSurgeClustersStr, ok := policy.GetAnnotations()[fleetv1beta1.NumberOfSurgeClustersAnnotation]
+	if !ok {
+		return 0, nil
+	}
+
+	numOfSurgeClusters, err := strconv.Atoi(numOfSurgeClustersStr)
+	if err != nil || numOfSurgeClusters < 0 {
+		return 0, fmt.Errorf("invalid annotation %s: %s is not a valid count: %w", fleetv1beta1.NumberOfSurgeClustersAnnotation, numOfSurgeClustersStr, err)
+	}
+
+	return numOfSurgeClusters, nil
+}
+
 // ExtractSubindexFromResourceSnapshot is a helper function to extract subindex from ResourceSnapshot objects.
 func ExtractSubindexFromResourceSnapshot(snapshot fleetv1beta1.ResourceSnapshotObj) (doesExist bool, subindex int, err error) {
 	annotations := snapshot.GetAnnotations()
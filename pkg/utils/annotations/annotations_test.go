@@ -102,6 +102,78 @@ func TestExtractNumOfClustersFromPolicySnapshot(t *testing.T) {
 	}
 }
 
+// TestExtractNumOfSurgeClustersFromPolicySnapshot tests the ExtractNumOfSurgeClustersFromPolicySnapshot function.
+func TestExtractNumOfSurgeClustersFromPolicySnapshot(t *testing.T) {
+	testCases := []struct {
+		name                   string
+		policy                 *fleetv1beta1.ClusterSchedulingPolicySnapshot
+		wantNumOfSurgeClusters int
+		expectedToFail         bool
+	}{
+		{
+			name: "valid annotation",
+			policy: &fleetv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+					Annotations: map[string]string{
+						fleetv1beta1.NumberOfSurgeClustersAnnotation: "2",
+					},
+				},
+			},
+			wantNumOfSurgeClusters: 2,
+		},
+		{
+			name: "no annotation",
+			policy: &fleetv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+				},
+			},
+			wantNumOfSurgeClusters: 0,
+		},
+		{
+			name: "invalid annotation: not an integer",
+			policy: &fleetv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+					Annotations: map[string]string{
+						fleetv1beta1.NumberOfSurgeClustersAnnotation: "abc",
+					},
+				},
+			},
+			expectedToFail: true,
+		},
+		{
+			name: "invalid annotation: negative integer",
+			policy: &fleetv1beta1.ClusterSchedulingPolicySnapshot{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: policyName,
+					Annotations: map[string]string{
+						fleetv1beta1.NumberOfSurgeClustersAnnotation: "-1",
+					},
+				},
+			},
+			expectedToFail: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			numOfSurgeClusters, err := ExtractNumOfSurgeClustersFromPolicySnapshot(tc.policy)
+			if tc.expectedToFail {
+				if err == nil {
+					t.Fatalf("ExtractNumOfSurgeClustersFromPolicySnapshot() = %v, %v, want error", numOfSurgeClusters, err)
+				}
+				return
+			}
+
+			if err != nil || numOfSurgeClusters != tc.wantNumOfSurgeClusters {
+				t.Fatalf("ExtractNumOfSurgeClustersFromPolicySnapshot() = %v, %v, want %v, nil", numOfSurgeClusters, err, tc.wantNumOfSurgeClusters)
+			}
+		})
+	}
+}
+
 // TestExtractObservedCRPGenerationFromPolicySnapshot tests the ExtractObservedCRPGenerationFromPolicySnapshot function.
 func TestExtractObservedCRPGenerationFromPolicySnapshot(t *testing.T) {
 	testCases := []struct {
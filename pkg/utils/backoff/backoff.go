@@ -0,0 +1,184 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package backoff provides the shared retry, backoff and jitter utilities used by the Fleet controllers.
+package backoff
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"math/rand/v2"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+// Jitter randomizes a delay so that multiple callers do not retry in lockstep.
+type Jitter func(d time.Duration) time.Duration
+
+// NoJitter keeps the delay as it is.
+func NoJitter() Jitter {
+	return func(d time.Duration) time.Duration {
+		return d
+	}
+}
+
+// SymmetricJitter spreads the delay evenly around its original value; the spread is the given percentage
+// of the delay, e.g., 10 picks a delay within [-5%, +5%) of the original one.
+func SymmetricJitter(percent int) Jitter {
+	return func(d time.Duration) time.Duration {
+		jitterRange := int64(d) * int64(percent) / 100
+		if jitterRange <= 0 {
+			return d
+		}
+		return d + time.Duration(rand.Int64N(jitterRange)-jitterRange/2)
+	}
+}
+
+// ProportionalJitter adds a random delay of at most the given factor of the original one, e.g., 0.1
+// picks a delay within [d, 1.1d).
+func ProportionalJitter(factor float64) Jitter {
+	return func(d time.Duration) time.Duration {
+		if factor <= 0 || d <= 0 {
+			return d
+		}
+		return d + time.Duration(rand.Float64()*factor*float64(d))
+	}
+}
+
+// FullJitter picks a delay uniformly within [0, d).
+func FullJitter() Jitter {
+	return func(d time.Duration) time.Duration {
+		if d <= 0 {
+			return d
+		}
+		return time.Duration(rand.Int64N(int64(d)))
+	}
+}
+
+// EqualJitter keeps half of the delay and randomizes the other half, i.e., it picks a delay within [d/2, d).
+func EqualJitter() Jitter {
+	return func(d time.Duration) time.Duration {
+		half := d / 2
+		if half <= 0 {
+			return d
+		}
+		return half + time.Duration(rand.Int64N(int64(half)))
+	}
+}
+
+// Backoff describes an exponential backoff with an optional jitter.
+type Backoff struct {
+	// Initial is the delay after the first attempt.
+	Initial time.Duration
+	// Factor multiplies the delay after every attempt; a factor no greater than 1 keeps the delay fixed.
+	Factor float64
+	// Max caps the delay before the jitter is applied; zero means no cap. Like the cap of the client-go
+	// wait package, it also ends the retries of Retry once the delay grows past it.
+	Max time.Duration
+	// Steps is the maximum number of attempts Retry makes; a non-positive value allows a single attempt.
+	Steps int
+	// Jitter randomizes every delay; nil means no jitter.
+	Jitter Jitter
+}
+
+// DefaultAPIRetry is the backoff used when retrying requests to the API server; it mirrors
+// the default backoff of the client-go retry package.
+var DefaultAPIRetry = Backoff{
+	Initial: 10 * time.Millisecond,
+	Factor:  5.0,
+	Steps:   4,
+	Jitter:  ProportionalJitter(0.1),
+}
+
+// WithMax returns a copy of the backoff with the delay capped at the given value.
+func (b Backoff) WithMax(maxDelay time.Duration) Backoff {
+	b.Max = maxDelay
+	return b
+}
+
+// Delay returns the delay after the given attempt; attempts are counted from 0.
+func (b Backoff) Delay(attempt int) time.Duration {
+	d := b.uncappedDelay(attempt)
+	if b.Max > 0 && d > b.Max {
+		d = b.Max
+	}
+	if b.Jitter != nil {
+		d = b.Jitter(d)
+	}
+	return d
+}
+
+// uncappedDelay returns the delay after the given attempt before the cap and the jitter are applied;
+// the delay saturates at the longest duration instead of overflowing.
+func (b Backoff) uncappedDelay(attempt int) time.Duration {
+	if b.Factor <= 1 || attempt <= 0 || b.Initial <= 0 {
+		return b.Initial
+	}
+	// Check the exponent before growing the delay, as a float that is out of the range of a duration
+	// cannot be converted (and checked) reliably afterwards.
+	maxExp := math.Log(float64(math.MaxInt64)/float64(b.Initial)) / math.Log(b.Factor)
+	if float64(attempt) >= maxExp {
+		return time.Duration(math.MaxInt64)
+	}
+	d := float64(b.Initial) * math.Pow(b.Factor, float64(attempt))
+	if d >= float64(math.MaxInt64) {
+		// The delay might still round up to the limit.
+		return time.Duration(math.MaxInt64)
+	}
+	return time.Duration(d)
+}
+
+// Grow returns the delay that follows the given one when backing off by the given factor; the result is
+// capped at the given maximum unless the maximum is non-positive.
+func Grow(d time.Duration, factor float64, maxDelay time.Duration) time.Duration {
+	next := time.Duration(float64(d) * factor)
+	if maxDelay > 0 && next > maxDelay {
+		return maxDelay
+	}
+	return next
+}
+
+// Retry runs fn until it succeeds, fails with an error that is not retriable, runs out of attempts, or
+// the context is done; it returns the last error fn returns. Retries also stop once the delay grows past
+// the cap (if any), as they do with the client-go wait package.
+func Retry(ctx context.Context, b Backoff, retriable func(error) bool, fn func() error) error {
+	steps := max(b.Steps, 1)
+	var err error
+	for attempt := 0; attempt < steps; attempt++ {
+		if err = fn(); err == nil || !retriable(err) {
+			return err
+		}
+		if attempt == steps-1 || (b.Max > 0 && b.uncappedDelay(attempt) > b.Max) {
+			break
+		}
+		timer := time.NewTimer(b.Delay(attempt))
+		select {
+		case <-ctx.Done():
+			timer.Stop()
+			return fmt.Errorf("%w, last error: %w", ctx.Err(), err)
+		case <-timer.C:
+		}
+	}
+	return err
+}
+
+// IsTransientAPIError returns true if the error returned by the API server is expected to go away
+// when the request is retried after a while.
+func IsTransientAPIError(err error) bool {
+	return apierrors.IsServiceUnavailable(err) || apierrors.IsServerTimeout(err) || apierrors.IsTooManyRequests(err)
+}
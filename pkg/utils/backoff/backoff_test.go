@@ -0,0 +1,377 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backoff
+
+import (
+	"context"
+	"errors"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func TestJitter(t *testing.T) {
+	tests := []struct {
+		name    string
+		jitter  Jitter
+		delay   time.Duration
+		wantMin time.Duration
+		wantMax time.Duration
+	}{
+		{
+			name:    "no jitter",
+			jitter:  NoJitter(),
+			delay:   time.Second,
+			wantMin: time.Second,
+			wantMax: time.Second,
+		},
+		{
+			name:    "symmetric jitter",
+			jitter:  SymmetricJitter(10),
+			delay:   time.Second,
+			wantMin: 950 * time.Millisecond,
+			wantMax: 1050 * time.Millisecond,
+		},
+		{
+			name:    "symmetric jitter with a range too small to randomize",
+			jitter:  SymmetricJitter(10),
+			delay:   time.Nanosecond,
+			wantMin: time.Nanosecond,
+			wantMax: time.Nanosecond,
+		},
+		{
+			name:    "proportional jitter",
+			jitter:  ProportionalJitter(0.1),
+			delay:   time.Second,
+			wantMin: time.Second,
+			wantMax: 1100 * time.Millisecond,
+		},
+		{
+			name:    "full jitter",
+			jitter:  FullJitter(),
+			delay:   time.Second,
+			wantMin: 0,
+			wantMax: time.Second,
+		},
+		{
+			name:    "equal jitter",
+			jitter:  EqualJitter(),
+			delay:   time.Second,
+			wantMin: 500 * time.Millisecond,
+			wantMax: time.Second,
+		},
+		{
+			name:    "full jitter on zero delay",
+			jitter:  FullJitter(),
+			delay:   0,
+			wantMin: 0,
+			wantMax: 0,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for i := 0; i < 100; i++ {
+				got := tc.jitter(tc.delay)
+				if got < tc.wantMin || got > tc.wantMax {
+					t.Fatalf("jitter(%v) = %v, want within [%v, %v]", tc.delay, got, tc.wantMin, tc.wantMax)
+				}
+			}
+		})
+	}
+}
+
+func TestBackoffDelay(t *testing.T) {
+	tests := []struct {
+		name    string
+		backoff Backoff
+		want    []time.Duration
+	}{
+		{
+			name: "exponential",
+			backoff: Backoff{
+				Initial: 10 * time.Millisecond,
+				Factor:  2,
+			},
+			want: []time.Duration{10 * time.Millisecond, 20 * time.Millisecond, 40 * time.Millisecond, 80 * time.Millisecond},
+		},
+		{
+			name: "exponential with a cap",
+			backoff: Backoff{
+				Initial: 10 * time.Millisecond,
+				Factor:  5,
+				Max:     100 * time.Millisecond,
+			},
+			want: []time.Duration{10 * time.Millisecond, 50 * time.Millisecond, 100 * time.Millisecond, 100 * time.Millisecond},
+		},
+		{
+			name: "fixed",
+			backoff: Backoff{
+				Initial: 10 * time.Millisecond,
+				Factor:  1,
+			},
+			want: []time.Duration{10 * time.Millisecond, 10 * time.Millisecond, 10 * time.Millisecond},
+		},
+		{
+			name: "jitter is applied after the cap",
+			backoff: Backoff{
+				Initial: 10 * time.Millisecond,
+				Factor:  10,
+				Max:     50 * time.Millisecond,
+				Jitter:  func(d time.Duration) time.Duration { return d + time.Millisecond },
+			},
+			want: []time.Duration{11 * time.Millisecond, 51 * time.Millisecond},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got := make([]time.Duration, 0, len(tc.want))
+			for attempt := range tc.want {
+				got = append(got, tc.backoff.Delay(attempt))
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Delay() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestBackoffDelayOverflow(t *testing.T) {
+	tests := []struct {
+		name    string
+		backoff Backoff
+		attempt int
+		want    time.Duration
+	}{
+		{
+			name:    "large factor",
+			backoff: Backoff{Initial: time.Second, Factor: 10},
+			attempt: 100,
+			want:    time.Duration(math.MaxInt64),
+		},
+		{
+			name:    "small factor, many attempts",
+			backoff: Backoff{Initial: time.Millisecond, Factor: 1.5},
+			attempt: 10000,
+			want:    time.Duration(math.MaxInt64),
+		},
+		{
+			name:    "largest attempt",
+			backoff: Backoff{Initial: time.Nanosecond, Factor: 2},
+			attempt: math.MaxInt,
+			want:    time.Duration(math.MaxInt64),
+		},
+		{
+			name:    "capped",
+			backoff: Backoff{Initial: time.Second, Factor: 10, Max: time.Hour},
+			attempt: 1000,
+			want:    time.Hour,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := tc.backoff.Delay(tc.attempt); got != tc.want {
+				t.Errorf("Delay(%d) = %v, want %v", tc.attempt, got, tc.want)
+			}
+		})
+	}
+}
+
+func TestGrow(t *testing.T) {
+	tests := []struct {
+		name     string
+		delay    time.Duration
+		factor   float64
+		maxDelay time.Duration
+		want     time.Duration
+	}{
+		{
+			name:     "grows by the factor",
+			delay:    2 * time.Second,
+			factor:   1.5,
+			maxDelay: time.Minute,
+			want:     3 * time.Second,
+		},
+		{
+			name:     "capped",
+			delay:    50 * time.Second,
+			factor:   1.5,
+			maxDelay: time.Minute,
+			want:     time.Minute,
+		},
+		{
+			name:   "no cap",
+			delay:  time.Hour,
+			factor: 2,
+			want:   2 * time.Hour,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := Grow(tc.delay, tc.factor, tc.maxDelay); got != tc.want {
+				t.Errorf("Grow() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestRetry(t *testing.T) {
+	errTransient := errors.New("transient")
+	errPermanent := errors.New("permanent")
+	retriable := func(err error) bool { return errors.Is(err, errTransient) }
+	b := Backoff{Initial: time.Millisecond, Factor: 1, Steps: 3}
+
+	tests := []struct {
+		name         string
+		backoff      Backoff
+		errs         []error
+		wantErr      error
+		wantAttempts int
+	}{
+		{
+			name:         "succeeds at first",
+			backoff:      b,
+			errs:         []error{nil},
+			wantAttempts: 1,
+		},
+		{
+			name:         "succeeds after retries",
+			backoff:      b,
+			errs:         []error{errTransient, errTransient, nil},
+			wantAttempts: 3,
+		},
+		{
+			name:         "runs out of attempts",
+			backoff:      b,
+			errs:         []error{errTransient, errTransient, errTransient, nil},
+			wantErr:      errTransient,
+			wantAttempts: 3,
+		},
+		{
+			name:         "stops at an error that is not retriable",
+			backoff:      b,
+			errs:         []error{errTransient, errPermanent, nil},
+			wantErr:      errPermanent,
+			wantAttempts: 2,
+		},
+		{
+			name:         "stops once the delay grows past the cap",
+			backoff:      Backoff{Initial: time.Millisecond, Factor: 2, Max: 3 * time.Millisecond, Steps: 10},
+			errs:         []error{errTransient, errTransient, errTransient, nil},
+			wantErr:      errTransient,
+			wantAttempts: 3,
+		},
+		{
+			name:         "fixed delay within the cap",
+			backoff:      Backoff{Initial: time.Millisecond, Factor: 1, Max: time.Millisecond, Steps: 3},
+			errs:         []error{errTransient, errTransient, nil},
+			wantAttempts: 3,
+		},
+		{
+			name:         "non-positive steps allow a single attempt",
+			backoff:      Backoff{Initial: time.Millisecond},
+			errs:         []error{errTransient, nil},
+			wantErr:      errTransient,
+			wantAttempts: 1,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			attempts := 0
+			err := Retry(context.Background(), tc.backoff, retriable, func() error {
+				err := tc.errs[attempts]
+				attempts++
+				return err
+			})
+			if !errors.Is(err, tc.wantErr) || (err == nil) != (tc.wantErr == nil) {
+				t.Errorf("Retry() = %v, want %v", err, tc.wantErr)
+			}
+			if attempts != tc.wantAttempts {
+				t.Errorf("Retry() made %d attempts, want %d", attempts, tc.wantAttempts)
+			}
+		})
+	}
+}
+
+func TestRetryContextCancelled(t *testing.T) {
+	errTransient := errors.New("transient")
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	attempts := 0
+	err := Retry(ctx, Backoff{Initial: time.Hour, Steps: 3}, func(error) bool { return true }, func() error {
+		attempts++
+		return errTransient
+	})
+	if !errors.Is(err, context.Canceled) || !errors.Is(err, errTransient) {
+		t.Errorf("Retry() = %v, want an error wrapping both %v and %v", err, context.Canceled, errTransient)
+	}
+	if attempts != 1 {
+		t.Errorf("Retry() made %d attempts, want 1", attempts)
+	}
+}
+
+func TestIsTransientAPIError(t *testing.T) {
+	gr := schema.GroupResource{Group: "cluster.kubernetes-fleet.io", Resource: "memberclusters"}
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{
+			name: "service unavailable",
+			err:  apierrors.NewServiceUnavailable("unavailable"),
+			want: true,
+		},
+		{
+			name: "server timeout",
+			err:  apierrors.NewServerTimeout(gr, "update", 1),
+			want: true,
+		},
+		{
+			name: "too many requests",
+			err:  apierrors.NewTooManyRequests("slow down", 1),
+			want: true,
+		},
+		{
+			name: "conflict",
+			err:  apierrors.NewConflict(gr, "member-1", errors.New("conflict")),
+			want: false,
+		},
+		{
+			name: "not an API error",
+			err:  errors.New("boom"),
+			want: false,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := IsTransientAPIError(tc.err); got != tc.want {
+				t.Errorf("IsTransientAPIError() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,68 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backoff
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// itemRateLimiter adapts a Backoff to a work queue rate limiter that backs off every item independently.
+type itemRateLimiter[T comparable] struct {
+	mu       sync.Mutex
+	backoff  Backoff
+	failures map[T]int
+}
+
+var _ workqueue.TypedRateLimiter[string] = &itemRateLimiter[string]{}
+
+// NewRateLimiter returns a work queue rate limiter that delays the requeues of an item according to
+// the given backoff; the number of attempts is counted per item until the item is forgotten.
+func NewRateLimiter[T comparable](b Backoff) workqueue.TypedRateLimiter[T] {
+	return &itemRateLimiter[T]{
+		backoff:  b,
+		failures: make(map[T]int),
+	}
+}
+
+// When returns the delay before the item is requeued.
+func (r *itemRateLimiter[T]) When(item T) time.Duration {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	attempt := r.failures[item]
+	r.failures[item] = attempt + 1
+	return r.backoff.Delay(attempt)
+}
+
+// Forget stops tracking the item.
+func (r *itemRateLimiter[T]) Forget(item T) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	delete(r.failures, item)
+}
+
+// NumRequeues returns the number of times the item has been requeued.
+func (r *itemRateLimiter[T]) NumRequeues(item T) int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	return r.failures[item]
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package backoff
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestRateLimiter(t *testing.T) {
+	rl := NewRateLimiter[string](Backoff{Initial: time.Second, Factor: 2, Max: 5 * time.Second})
+
+	got := []time.Duration{rl.When("a"), rl.When("a"), rl.When("b"), rl.When("a"), rl.When("a")}
+	want := []time.Duration{time.Second, 2 * time.Second, time.Second, 4 * time.Second, 5 * time.Second}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("When() mismatch (-want, +got):\n%s", diff)
+	}
+	if n := rl.NumRequeues("a"); n != 4 {
+		t.Errorf("NumRequeues(a) = %d, want 4", n)
+	}
+
+	rl.Forget("a")
+	if n := rl.NumRequeues("a"); n != 0 {
+		t.Errorf("NumRequeues(a) after Forget() = %d, want 0", n)
+	}
+	if d := rl.When("a"); d != time.Second {
+		t.Errorf("When(a) after Forget() = %v, want %v", d, time.Second)
+	}
+	if n := rl.NumRequeues("b"); n != 1 {
+		t.Errorf("NumRequeues(b) = %d, want 1", n)
+	}
+}
@@ -37,6 +37,7 @@ import (
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/util/jsonpath"
 	"k8s.io/client-go/util/retry"
 	"k8s.io/klog/v2"
 
@@ -384,6 +385,12 @@ var (
 		Resource: "jobs",
 	}
 
+	CronJobGVR = schema.GroupVersionResource{
+		Group:    batchv1.GroupName,
+		Version:  batchv1.SchemeGroupVersion.Version,
+		Resource: "cronjobs",
+	}
+
 	ConfigMapGVR = schema.GroupVersionResource{
 		Group:    corev1.GroupName,
 		Version:  corev1.SchemeGroupVersion.Version,
@@ -447,6 +454,17 @@ var (
 		Group: placementv1beta1.GroupVersion.Group,
 		Kind:  placementv1beta1.ResourceEnvelopeKind,
 	}
+
+	ResourceEnvelopeGVR = schema.GroupVersionResource{
+		Group:    placementv1beta1.GroupVersion.Group,
+		Version:  placementv1beta1.GroupVersion.Version,
+		Resource: "resourceenvelopes",
+	}
+
+	JobGK = schema.GroupKind{
+		Group: batchv1.GroupName,
+		Kind:  JobKind,
+	}
 )
 
 // RandSecureInt returns a uniform random value in [1, max] or panic.
@@ -740,3 +758,12 @@ func IsFleetAnnotationPresent(annotations map[string]string) bool {
 	}
 	return false
 }
+
+// ParseJSONPath parses a JSONPath expression in the format used by kubectl, e.g., `{.status.replicas}`.
+func ParseJSONPath(expr string) (*jsonpath.JSONPath, error) {
+	jp := jsonpath.New("fleet").AllowMissingKeys(true)
+	if err := jp.Parse(expr); err != nil {
+		return nil, err
+	}
+	return jp, nil
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package condition
+
+// ReasonCategory is the category of a condition reason, which groups the reasons reported by
+// KubeFleet controllers by the outcome they describe. Unlike the reasons themselves, the set of
+// categories is small and fixed, which makes it suitable for alerting rules.
+type ReasonCategory string
+
+const (
+	// ReasonCategorySucceeded is the category of the reasons which report that the desired state
+	// has been reached.
+	ReasonCategorySucceeded ReasonCategory = "Succeeded"
+
+	// ReasonCategoryInProgress is the category of the reasons which report that the desired state
+	// is being worked on or waited for, or that the outcome is not known yet.
+	ReasonCategoryInProgress ReasonCategory = "InProgress"
+
+	// ReasonCategoryUserError is the category of the reasons which report a failure caused by the
+	// configuration of the user, e.g., an invalid resource selector or an override that fails to
+	// render; such failures can only be fixed by the user.
+	ReasonCategoryUserError ReasonCategory = "UserError"
+
+	// ReasonCategoryFailed is the category of the reasons which report any other failure, which
+	// may require investigation.
+	ReasonCategoryFailed ReasonCategory = "Failed"
+
+	// ReasonCategoryUnrecognized is the category of the reasons which are not in the catalog.
+	ReasonCategoryUnrecognized ReasonCategory = "Unrecognized"
+)
+
+// reasonCatalog keeps the category of every condition reason that KubeFleet controllers report.
+//
+// The reasons in the catalog are part of the KubeFleet API: once added, a reason must not be
+// renamed or moved to another category, as users may have built alerting rules on top of them.
+var reasonCatalog = map[string]ReasonCategory{
+	// The placement condition reasons.
+	InvalidResourceSelectorsReason:              ReasonCategoryUserError,
+	SchedulingUnknownReason:                     ReasonCategoryInProgress,
+	ResourceScheduleSucceededReason:             ReasonCategorySucceeded,
+	ResourceScheduleFailedReason:                ReasonCategoryFailed,
+	SchedulingPolicyFulfilledReason:             ReasonCategorySucceeded,
+	SchedulingPolicyUnfulfilledReason:           ReasonCategoryFailed,
+	RolloutStartedUnknownReason:                 ReasonCategoryInProgress,
+	RolloutControlledByExternalControllerReason: ReasonCategoryInProgress,
+	RolloutNotStartedYetReason:                  ReasonCategoryInProgress,
+	RolloutStartedReason:                        ReasonCategorySucceeded,
+	RolloutAnalysisInProgressReason:             ReasonCategoryInProgress,
+	RolloutAnalysisSucceededReason:              ReasonCategorySucceeded,
+	RolloutAnalysisFailedReason:                 ReasonCategoryFailed,
+	RolloutWaitingForClusterRolloutPolicyReason: ReasonCategoryInProgress,
+	RolloutWaitingForDependenciesReason:         ReasonCategoryInProgress,
+	RolloutErrorBudgetDepletedReason:            ReasonCategoryFailed,
+	WorkCleanupGracePeriodPendingReason:         ReasonCategoryInProgress,
+	OverriddenPendingReason:                     ReasonCategoryInProgress,
+	OverrideNotSpecifiedReason:                  ReasonCategorySucceeded,
+	OverriddenFailedReason:                      ReasonCategoryUserError,
+	OverriddenSucceededReason:                   ReasonCategorySucceeded,
+	WorkSynchronizedUnknownReason:               ReasonCategoryInProgress,
+	WorkNotSynchronizedYetReason:                ReasonCategoryInProgress,
+	WorkSynchronizedReason:                      ReasonCategorySucceeded,
+	ApplyPendingReason:                          ReasonCategoryInProgress,
+	ApplyFailedReason:                           ReasonCategoryFailed,
+	ApplySucceededReason:                        ReasonCategorySucceeded,
+	AvailableUnknownReason:                      ReasonCategoryInProgress,
+	NotAvailableYetReason:                       ReasonCategoryInProgress,
+	AvailableReason:                             ReasonCategorySucceeded,
+	DiffReportedStatusUnknownReason:             ReasonCategoryInProgress,
+	DiffReportedStatusFalseReason:               ReasonCategoryFailed,
+	DiffReportedStatusTrueReason:                ReasonCategorySucceeded,
+	StatusSyncFailedReason:                      ReasonCategoryFailed,
+	StatusSyncSucceededReason:                   ReasonCategorySucceeded,
+
+	// The per-cluster placement condition reasons.
+	ScheduleSucceededReason:               ReasonCategorySucceeded,
+	AllWorkSyncedReason:                   ReasonCategorySucceeded,
+	SyncWorkFailedReason:                  ReasonCategoryFailed,
+	WorkApplyInProcess:                    ReasonCategoryInProgress,
+	WorkDiffReportInProcess:               ReasonCategoryInProgress,
+	WorkNotAppliedReason:                  ReasonCategoryFailed,
+	AllWorkAppliedReason:                  ReasonCategorySucceeded,
+	WorkNotAvailableReason:                ReasonCategoryInProgress,
+	WorkNotAvailabilityTrackableReason:    ReasonCategorySucceeded,
+	AllWorkAvailableReason:                ReasonCategorySucceeded,
+	AllWorkDiffReportedReason:             ReasonCategorySucceeded,
+	WorkNotDiffReportedReason:             ReasonCategoryFailed,
+	AllWorkQuotaFitReason:                 ReasonCategorySucceeded,
+	WorkQuotaExceededReason:               ReasonCategoryUserError,
+	AllWorkAdmissionPreflightPassedReason: ReasonCategorySucceeded,
+	WorkAdmissionPreflightFailedReason:    ReasonCategoryUserError,
+	CoOwnershipResolvedReason:             ReasonCategorySucceeded,
+	CoOwnershipConflictReason:             ReasonCategoryUserError,
+
+	// The staged update run condition reasons.
+	UpdateRunInitializeSucceededReason:     ReasonCategorySucceeded,
+	UpdateRunInitializeFailedReason:        ReasonCategoryUserError,
+	UpdateRunProgressingReason:             ReasonCategoryInProgress,
+	UpdateRunFailedReason:                  ReasonCategoryFailed,
+	UpdateRunStuckReason:                   ReasonCategoryFailed,
+	UpdateRunWaitingReason:                 ReasonCategoryInProgress,
+	UpdateRunStoppingReason:                ReasonCategoryInProgress,
+	UpdateRunStoppedReason:                 ReasonCategorySucceeded,
+	UpdateRunSucceededReason:               ReasonCategorySucceeded,
+	StageUpdatingStartedReason:             ReasonCategoryInProgress,
+	StageUpdatingWaitingReason:             ReasonCategoryInProgress,
+	StageUpdatingStoppingReason:            ReasonCategoryInProgress,
+	StageUpdatingStoppedReason:             ReasonCategorySucceeded,
+	StageUpdatingFailedReason:              ReasonCategoryFailed,
+	StageUpdatingSucceededReason:           ReasonCategorySucceeded,
+	StageUpdatingSkippedNoClustersReason:   ReasonCategorySucceeded,
+	ClusterUpdatingStartedReason:           ReasonCategoryInProgress,
+	ClusterUpdatingFailedReason:            ReasonCategoryFailed,
+	ClusterUpdatingSucceededReason:         ReasonCategorySucceeded,
+	StageTaskApprovalRequestApprovedReason: ReasonCategorySucceeded,
+	StageTaskApprovalRequestCreatedReason:  ReasonCategoryInProgress,
+	AfterStageTaskWaitTimeElapsedReason:    ReasonCategorySucceeded,
+	ApprovalRequestApprovalAcceptedReason:  ReasonCategorySucceeded,
+
+	// The eviction condition reasons.
+	ClusterResourcePlacementEvictionValidReason:       ReasonCategorySucceeded,
+	ClusterResourcePlacementEvictionInvalidReason:     ReasonCategoryUserError,
+	ClusterResourcePlacementEvictionExecutedReason:    ReasonCategorySucceeded,
+	ClusterResourcePlacementEvictionNotExecutedReason: ReasonCategoryFailed,
+
+	// The external rollout progress, bulk placement operation, and override condition reasons.
+	ExternalRolloutProgressSyncedReason:           ReasonCategorySucceeded,
+	ExternalRolloutProgressNotSyncedReason:        ReasonCategoryInProgress,
+	ExternalRolloutProgressInvalidPlacementReason: ReasonCategoryUserError,
+	BulkPlacementOperationSucceededReason:         ReasonCategorySucceeded,
+	BulkPlacementOperationFailedReason:            ReasonCategoryFailed,
+	OverrideRenderSucceededReason:                 ReasonCategorySucceeded,
+	OverrideRenderFailedReason:                    ReasonCategoryUserError,
+
+	// The work condition reasons.
+	WorkAppliedFailedReason:                     ReasonCategoryFailed,
+	WorkAppliedCompletedReason:                  ReasonCategorySucceeded,
+	WorkNotAvailableYetReason:                   ReasonCategoryInProgress,
+	WorkAvailabilityUnknownReason:               ReasonCategoryInProgress,
+	WorkAvailableReason:                         ReasonCategorySucceeded,
+	WorkNotTrackableReason:                      ReasonCategorySucceeded,
+	ManifestApplyFailedReason:                   ReasonCategoryFailed,
+	ApplyConflictBetweenPlacementsReason:        ReasonCategoryUserError,
+	ManifestsAlreadyOwnedByOthersReason:         ReasonCategoryUserError,
+	ManifestAlreadyUpToDateReason:               ReasonCategorySucceeded,
+	ManifestNeedsUpdateReason:                   ReasonCategoryInProgress,
+	ManifestAppliedCondPreparingToProcessReason: ReasonCategoryInProgress,
+	WorkNotAllManifestsTrackableReasonNew:       ReasonCategorySucceeded,
+	WorkAllManifestsAppliedReason:               ReasonCategorySucceeded,
+	WorkAllManifestsAvailableReason:             ReasonCategorySucceeded,
+	WorkAllManifestsDiffReportedReason:          ReasonCategorySucceeded,
+	WorkNotAllManifestsAppliedReason:            ReasonCategoryFailed,
+	WorkNotAllManifestsAvailableReason:          ReasonCategoryInProgress,
+	WorkNotAllManifestsDiffReportedReason:       ReasonCategoryFailed,
+	WorkAppliedWithToleratedFailuresReason:      ReasonCategorySucceeded,
+}
+
+// CategoryOf returns the category of a condition reason; it returns ReasonCategoryUnrecognized if
+// the reason is not in the catalog.
+func CategoryOf(reason string) ReasonCategory {
+	if category, ok := reasonCatalog[reason]; ok {
+		return category
+	}
+	return ReasonCategoryUnrecognized
+}
+
+// IsCataloguedReason returns whether a condition reason is in the catalog.
+func IsCataloguedReason(reason string) bool {
+	_, ok := reasonCatalog[reason]
+	return ok
+}
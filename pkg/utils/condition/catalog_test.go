@@ -0,0 +1,90 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package condition
+
+import (
+	"regexp"
+	"testing"
+)
+
+// conditionReasonRegexp is the pattern that the Kubernetes API server enforces on the reasons of
+// metav1.Condition.
+var conditionReasonRegexp = regexp.MustCompile(`^[A-Za-z]([A-Za-z0-9_,:]*[A-Za-z0-9_])?$`)
+
+func TestReasonCatalog(t *testing.T) {
+	for catalogued, category := range reasonCatalog {
+		if !conditionReasonRegexp.MatchString(catalogued) {
+			t.Errorf("catalogued reason %q is not a valid condition reason", catalogued)
+		}
+		switch category {
+		case ReasonCategorySucceeded, ReasonCategoryInProgress, ReasonCategoryUserError, ReasonCategoryFailed:
+		default:
+			t.Errorf("catalogued reason %q has category %q, want one of Succeeded, InProgress, UserError, or Failed", catalogued, category)
+		}
+	}
+}
+
+func TestCategoryOf(t *testing.T) {
+	tests := map[string]struct {
+		reason         string
+		want           ReasonCategory
+		wantCatalogued bool
+	}{
+		"succeeded": {
+			reason:         AvailableReason,
+			want:           ReasonCategorySucceeded,
+			wantCatalogued: true,
+		},
+		"in progress": {
+			reason:         RolloutWaitingForDependenciesReason,
+			want:           ReasonCategoryInProgress,
+			wantCatalogued: true,
+		},
+		"user error": {
+			reason:         InvalidResourceSelectorsReason,
+			want:           ReasonCategoryUserError,
+			wantCatalogued: true,
+		},
+		"failed": {
+			reason:         SchedulingPolicyUnfulfilledReason,
+			want:           ReasonCategoryFailed,
+			wantCatalogued: true,
+		},
+		"alias of a catalogued reason": {
+			reason:         WorkNotAllManifestsTrackableReason,
+			want:           ReasonCategorySucceeded,
+			wantCatalogued: true,
+		},
+		"unrecognized": {
+			reason: "fakeFailedAvailableReason",
+			want:   ReasonCategoryUnrecognized,
+		},
+		"empty": {
+			want: ReasonCategoryUnrecognized,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			if got := CategoryOf(tt.reason); got != tt.want {
+				t.Errorf("CategoryOf(%q) = %q, want %q", tt.reason, got, tt.want)
+			}
+			if got := IsCataloguedReason(tt.reason); got != tt.wantCatalogued {
+				t.Errorf("IsCataloguedReason(%q) = %t, want %t", tt.reason, got, tt.wantCatalogued)
+			}
+		})
+	}
+}
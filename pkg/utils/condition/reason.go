@@ -32,6 +32,14 @@ const (
 	// ResourceScheduleFailedReason is the reason string of placement condition when the scheduler failed to schedule the selected resources.
 	ResourceScheduleFailedReason = "ScheduleFailed"
 
+	// SchedulingPolicyFulfilledReason is the reason string of the scheduling policy snapshot condition when
+	// the scheduler has found all the clusters needed by the scheduling policy.
+	SchedulingPolicyFulfilledReason = "SchedulingPolicyFulfilled"
+
+	// SchedulingPolicyUnfulfilledReason is the reason string of the scheduling policy snapshot condition when
+	// the scheduler cannot find all the clusters needed by the scheduling policy.
+	SchedulingPolicyUnfulfilledReason = "SchedulingPolicyUnfulfilled"
+
 	// RolloutStartedUnknownReason is the reason string of placement condition if rollout status is
 	// unknown.
 	RolloutStartedUnknownReason = "RolloutStartedUnknown"
@@ -47,6 +55,31 @@ const (
 	// RolloutStartedReason is the reason string of placement condition if rollout status is started.
 	RolloutStartedReason = "RolloutStarted"
 
+	// RolloutAnalysisInProgressReason is the reason string of binding condition if the rollout analysis is still in progress.
+	RolloutAnalysisInProgressReason = "AnalysisInProgress"
+
+	// RolloutAnalysisSucceededReason is the reason string of binding condition if the rollout analysis has succeeded.
+	RolloutAnalysisSucceededReason = "AnalysisSucceeded"
+
+	// RolloutAnalysisFailedReason is the reason string of binding condition if the rollout analysis has failed.
+	RolloutAnalysisFailedReason = "AnalysisFailed"
+
+	// RolloutWaitingForClusterRolloutPolicyReason is the reason string of binding condition if the rollout has not
+	// started because the target cluster has reached its limit of concurrent rollouts set by the cluster rollout policies.
+	RolloutWaitingForClusterRolloutPolicyReason = "WaitingForClusterRolloutPolicy"
+
+	// RolloutWaitingForDependenciesReason is the reason string of binding condition if the rollout has not
+	// started because the placements that the placement depends on are not available on the target cluster yet.
+	RolloutWaitingForDependenciesReason = "WaitingForDependencies"
+
+	// RolloutErrorBudgetDepletedReason is the reason string of placement condition if the rollout has been paused
+	// because too many of the clusters updated to the latest resources have failed to apply them or make them available.
+	RolloutErrorBudgetDepletedReason = "ErrorBudgetDepleted"
+
+	// WorkCleanupGracePeriodPendingReason is the reason string of binding condition if the binding has become
+	// unscheduled and is waiting for the work cleanup grace period to elapse before it gets deleted.
+	WorkCleanupGracePeriodPendingReason = "WorkCleanupGracePeriodPending"
+
 	// OverriddenPendingReason is the reason string of placement condition when the selected resources are pending to override.
 	OverriddenPendingReason = "OverriddenPending"
 
@@ -148,6 +181,30 @@ const (
 
 	// WorkNotDiffReportedReason is the reason string of placement condition if some works failed to have diff reported.
 	WorkNotDiffReportedReason = "NotAllWorkHaveDiffReported"
+
+	// AllWorkQuotaFitReason is the reason string of placement condition if the workloads of all works fit in
+	// the resource quotas on the member cluster.
+	AllWorkQuotaFitReason = "AllWorkFitInResourceQuotas"
+
+	// WorkQuotaExceededReason is the reason string of placement condition if the workloads of some works
+	// would exceed the resource quotas on the member cluster.
+	WorkQuotaExceededReason = "WorkExceedsResourceQuotas"
+
+	// AllWorkAdmissionPreflightPassedReason is the reason string of placement condition if the manifests of
+	// all works have passed the admission webhooks on the member cluster in a dry-run.
+	AllWorkAdmissionPreflightPassedReason = "AllWorkPassedAdmissionPreflight"
+
+	// WorkAdmissionPreflightFailedReason is the reason string of placement condition if the manifests of some
+	// works have been rejected by the admission webhooks on the member cluster in a dry-run.
+	WorkAdmissionPreflightFailedReason = "WorkRejectedByAdmissionWebhooks"
+
+	// CoOwnershipResolvedReason is the reason string of placement condition if the resources that are also
+	// selected by other placements for the member cluster are co-owned per the co-ownership policy.
+	CoOwnershipResolvedReason = "ResourcesCoOwned"
+
+	// CoOwnershipConflictReason is the reason string of placement condition if the resources that are also
+	// selected by other placements for the member cluster cannot be co-owned per the co-ownership policies.
+	CoOwnershipConflictReason = "CoOwnershipConflict"
 )
 
 // A group of condition reason string which is used to populate the ClusterStagedUpdateRun condition.
@@ -279,6 +336,83 @@ const (
 	EvictionBlockedPDBSpecifiedMessageFmt = "Eviction is blocked by specified ClusterResourcePlacementDisruptionBudget, availablePlacements: %d, totalPlacements: %d"
 )
 
+// A group of reason strings which explain why a ClusterResourcePlacementEviction has been granted or denied;
+// they are reported in the eviction metrics and the events emitted on the placement targeted by the eviction.
+const (
+	// EvictionReasonMissingPlacement is the reason string when the placement targeted by the eviction is not found.
+	EvictionReasonMissingPlacement = "MissingPlacement"
+
+	// EvictionReasonDeletingPlacement is the reason string when the placement targeted by the eviction is being deleted.
+	EvictionReasonDeletingPlacement = "DeletingPlacement"
+
+	// EvictionReasonPickFixedPlacement is the reason string when the placement targeted by the eviction is of the PickFixed placement type.
+	EvictionReasonPickFixedPlacement = "PickFixedPlacement"
+
+	// EvictionReasonMissingBinding is the reason string when no binding is found for the cluster targeted by the eviction.
+	EvictionReasonMissingBinding = "MissingBinding"
+
+	// EvictionReasonMultipleBindings is the reason string when more than one binding is found for the cluster targeted by the eviction.
+	EvictionReasonMultipleBindings = "MultipleBindings"
+
+	// EvictionReasonResourcesNotPlaced is the reason string when resources are yet to be placed on the cluster targeted by the eviction.
+	EvictionReasonResourcesNotPlaced = "ResourcesNotPlaced"
+
+	// EvictionReasonPlacementRemoved is the reason string when the resources are being removed from the cluster targeted by the eviction.
+	EvictionReasonPlacementRemoved = "PlacementRemoved"
+
+	// EvictionReasonPlacementFailed is the reason string when the placement has failed on the cluster targeted by the eviction.
+	EvictionReasonPlacementFailed = "PlacementFailed"
+
+	// EvictionReasonNoPDB is the reason string when no disruption budget is specified for the placement.
+	EvictionReasonNoPDB = "NoPDB"
+
+	// EvictionReasonPDB is the reason string when the eviction is allowed or blocked by the disruption budget of the placement.
+	EvictionReasonPDB = "PDB"
+
+	// EvictionReasonMisconfiguredPDB is the reason string when the disruption budget of the placement is misconfigured.
+	EvictionReasonMisconfiguredPDB = "MisconfiguredPDB"
+
+	// EvictionReasonUnknown is the reason string when it is unknown why the eviction has been granted or denied.
+	EvictionReasonUnknown = "Unknown"
+)
+
+// A group of condition reason string which is used to populate the external rollout progress condition.
+const (
+	// ExternalRolloutProgressSyncedReason is the reason string of condition if the bindings of all the reported
+	// clusters run the reported resource snapshots.
+	ExternalRolloutProgressSyncedReason = "ExternalRolloutProgressSynced"
+
+	// ExternalRolloutProgressNotSyncedReason is the reason string of condition if some of the reported clusters
+	// cannot run the reported resource snapshots yet.
+	ExternalRolloutProgressNotSyncedReason = "ExternalRolloutProgressNotSynced"
+
+	// ExternalRolloutProgressInvalidPlacementReason is the reason string of condition if the placement of the
+	// external rollout progress is missing, being deleted, or not of the External rollout strategy type.
+	ExternalRolloutProgressInvalidPlacementReason = "ExternalRolloutProgressInvalidPlacement"
+)
+
+// A group of condition reason string which is used to populate the bulk placement operation condition.
+const (
+	// BulkPlacementOperationSucceededReason is the reason string of condition if the operation has been run
+	// on all the selected placements without any failure.
+	BulkPlacementOperationSucceededReason = "BulkPlacementOperationSucceeded"
+
+	// BulkPlacementOperationFailedReason is the reason string of condition if the operation has been run on
+	// all the selected placements, but has failed on some of them.
+	BulkPlacementOperationFailedReason = "BulkPlacementOperationFailed"
+)
+
+// A group of condition reason string which is used to populate the override condition.
+const (
+	// OverrideRenderSucceededReason is the reason string of condition if all the override rules have been rendered
+	// on the selected resources successfully.
+	OverrideRenderSucceededReason = "OverrideRenderSucceeded"
+
+	// OverrideRenderFailedReason is the reason string of condition if some override rules fail to render on the
+	// selected resources.
+	OverrideRenderFailedReason = "OverrideRenderFailed"
+)
+
 // A group of condition reason string which is used for Work condition.
 const (
 	// WorkCondition condition reasons
@@ -342,6 +476,10 @@ const (
 	WorkNotAllManifestsAppliedReason      = "SomeManifestsAreNotApplied"
 	WorkNotAllManifestsAvailableReason    = "SomeManifestsAreNotAvailable"
 	WorkNotAllManifestsDiffReportedReason = "SomeManifestsHaveNotReportedDiff"
+	// WorkAppliedWithToleratedFailuresReason is the reason for the Applied condition when some
+	// manifests have failed to apply, but the failures are within the tolerated threshold as
+	// set in the apply strategy.
+	WorkAppliedWithToleratedFailuresReason = "AppliedWithToleratedFailures"
 
 	// Some condition messages for Work object conditions.
 	AllManifestsAppliedMessage           = "All the specified manifests have been applied"
@@ -349,6 +487,7 @@ const (
 	AllAppliedObjectAvailableMessage     = "All of the applied manifests are available"
 	SomeAppliedObjectUntrackableMessage  = "Some of the applied manifests cannot be tracked for availability"
 	NotAllManifestsAppliedMessage        = "Failed to apply all manifests (%d of %d manifests are applied)"
+	AppliedWithToleratedFailuresMessage  = "Failed to apply some manifests (%d of %d manifests are applied); the failures are tolerated as they are within %d%% of all manifests"
 	NotAllAppliedObjectsAvailableMessage = "Some manifests are not available (%d of %d manifests are available)"
 	NotAllManifestsHaveReportedDiff      = "Failed to report diff on all manifests (%d of %d manifests have reported diff)"
 )
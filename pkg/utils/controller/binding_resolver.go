@@ -84,6 +84,47 @@ func ListBindingsFromKey(ctx context.Context, c client.Reader, placementKey type
 	return bindingObjs, nil
 }
 
+// ListPlacementsOnCluster returns the keys of the placements (both ClusterResourcePlacements and ResourcePlacements)
+// that have their resources scheduled or bound to the given member cluster, i.e., the placements that would lose
+// the cluster if it left the fleet. The keys are sorted and de-duplicated.
+// The fromCache parameter indicates whether the client is a cached client (true) or an uncached client (false).
+func ListPlacementsOnCluster(ctx context.Context, c client.Reader, clusterName string, fromCache bool) ([]types.NamespacedName, error) {
+	crbList := &placementv1beta1.ClusterResourceBindingList{}
+	if err := c.List(ctx, crbList); err != nil {
+		return nil, NewAPIServerError(fromCache, err)
+	}
+	rbList := &placementv1beta1.ResourceBindingList{}
+	if err := c.List(ctx, rbList); err != nil {
+		return nil, NewAPIServerError(fromCache, err)
+	}
+
+	seen := make(map[types.NamespacedName]bool)
+	placementKeys := make([]types.NamespacedName, 0)
+	for _, binding := range append(crbList.GetBindingObjs(), rbList.GetBindingObjs()...) {
+		spec := binding.GetBindingSpec()
+		if spec.TargetCluster != clusterName || !binding.GetDeletionTimestamp().IsZero() ||
+			(spec.State != placementv1beta1.BindingStateScheduled && spec.State != placementv1beta1.BindingStateBound) {
+			continue
+		}
+		placementName, ok := binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+		if !ok {
+			continue
+		}
+		key := types.NamespacedName{Namespace: binding.GetNamespace(), Name: placementName}
+		if !seen[key] {
+			seen[key] = true
+			placementKeys = append(placementKeys, key)
+		}
+	}
+	sort.Slice(placementKeys, func(i, j int) bool {
+		if placementKeys[i].Namespace != placementKeys[j].Namespace {
+			return placementKeys[i].Namespace < placementKeys[j].Namespace
+		}
+		return placementKeys[i].Name < placementKeys[j].Name
+	})
+	return placementKeys, nil
+}
+
 // ConvertCRBObjsToBindingObjs converts a slice of ClusterResourceBinding items to BindingObj array.
 // This helper is needed when working with List.Items which are value types, not pointers.
 func ConvertCRBObjsToBindingObjs(bindings []placementv1beta1.ClusterResourceBinding) []placementv1beta1.BindingObj {
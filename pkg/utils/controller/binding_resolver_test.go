@@ -552,6 +552,88 @@ func TestListBindingsFromKey_ClientError(t *testing.T) {
 	}
 }
 
+func TestListPlacementsOnCluster(t *testing.T) {
+	newBinding := func(namespace, name, placementName, cluster string, state placementv1beta1.BindingState) client.Object {
+		meta := metav1.ObjectMeta{
+			Name:      name,
+			Namespace: namespace,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: placementName,
+			},
+		}
+		spec := placementv1beta1.ResourceBindingSpec{
+			State:         state,
+			TargetCluster: cluster,
+		}
+		if namespace == "" {
+			return &placementv1beta1.ClusterResourceBinding{ObjectMeta: meta, Spec: spec}
+		}
+		return &placementv1beta1.ResourceBinding{ObjectMeta: meta, Spec: spec}
+	}
+
+	tests := []struct {
+		name    string
+		objects []client.Object
+		want    []types.NamespacedName
+	}{
+		{
+			name:    "no bindings",
+			objects: []client.Object{},
+			want:    []types.NamespacedName{},
+		},
+		{
+			name: "scheduled and bound bindings on the cluster",
+			objects: []client.Object{
+				newBinding("", "crp-b-member-1", "crp-b", "member-1", placementv1beta1.BindingStateBound),
+				newBinding("", "crp-a-member-1", "crp-a", "member-1", placementv1beta1.BindingStateScheduled),
+				newBinding("app", "rp-member-1", "rp", "member-1", placementv1beta1.BindingStateBound),
+			},
+			want: []types.NamespacedName{
+				{Name: "crp-a"},
+				{Name: "crp-b"},
+				{Namespace: "app", Name: "rp"},
+			},
+		},
+		{
+			name: "skips unscheduled bindings and bindings on other clusters",
+			objects: []client.Object{
+				newBinding("", "crp-a-member-1", "crp-a", "member-1", placementv1beta1.BindingStateUnscheduled),
+				newBinding("", "crp-b-member-2", "crp-b", "member-2", placementv1beta1.BindingStateBound),
+				newBinding("app", "rp-member-2", "rp", "member-2", placementv1beta1.BindingStateBound),
+			},
+			want: []types.NamespacedName{},
+		},
+		{
+			name: "de-duplicates placements with multiple bindings on the cluster",
+			objects: []client.Object{
+				newBinding("", "crp-a-member-1-a", "crp-a", "member-1", placementv1beta1.BindingStateBound),
+				newBinding("", "crp-a-member-1-b", "crp-a", "member-1", placementv1beta1.BindingStateScheduled),
+			},
+			want: []types.NamespacedName{
+				{Name: "crp-a"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := placementv1beta1.AddToScheme(scheme); err != nil {
+				t.Fatalf("AddToScheme() = %v", err)
+			}
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(tt.objects...).Build()
+
+			got, err := ListPlacementsOnCluster(context.Background(), fakeClient, "member-1", false)
+			if err != nil {
+				t.Fatalf("ListPlacementsOnCluster() got error %v, want no error", err)
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("ListPlacementsOnCluster() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestFetchBindingFromKey(t *testing.T) {
 	ctx := context.Background()
 
@@ -181,17 +181,35 @@ type controller struct {
 
 	// queue allowing parallel processing of resources.
 	queue workqueue.TypedRateLimitingInterface[any]
+
+	// workerLimiter, if set, limits the number of items the workers process at the same time.
+	workerLimiter *WorkerLimiter
+}
+
+// Option configures a controller.
+type Option func(*controller)
+
+// WithWorkerLimiter limits the number of items the controller processes at the same time with the given
+// limiter, in addition to the number of workers it runs.
+func WithWorkerLimiter(limiter *WorkerLimiter) Option {
+	return func(c *controller) {
+		c.workerLimiter = limiter
+	}
 }
 
 // NewController returns a controller which can process resource periodically. We create the queue during the creation
 // of the controller which means it can only be run once. We can move that to the run if we need to run it multiple times
-func NewController(Name string, KeyFunc KeyFunc, ReconcileFunc ReconcileFunc, rateLimiter workqueue.TypedRateLimiter[any]) Controller {
-	return &controller{
+func NewController(Name string, KeyFunc KeyFunc, ReconcileFunc ReconcileFunc, rateLimiter workqueue.TypedRateLimiter[any], opts ...Option) Controller {
+	c := &controller{
 		name:          Name,
 		keyFunc:       KeyFunc,
 		reconcileFunc: ReconcileFunc,
 		queue:         workqueue.NewTypedRateLimitingQueueWithConfig[any](rateLimiter, workqueue.TypedRateLimitingQueueConfig[any]{Name: Name}),
 	}
+	for _, opt := range opts {
+		opt(c)
+	}
+	return c
 }
 
 func (w *controller) Enqueue(obj interface{}) {
@@ -242,6 +260,14 @@ func (w *controller) Run(ctx context.Context, workerNumber int) error {
 }
 
 func (w *controller) processNextWorkItem(ctx context.Context) bool {
+	// Wait for a slot before picking up an item, so that the items that cannot be processed yet stay
+	// in the queue.
+	if err := w.workerLimiter.acquire(ctx); err != nil {
+		// Stop working
+		return false
+	}
+	defer w.workerLimiter.release()
+
 	key, shutdown := w.queue.Get()
 	if shutdown {
 		// Stop working
@@ -350,6 +376,9 @@ func CollectResourceIdentifiersFromResourceSnapshot(
 	// Look for the master resourceSnapshot.
 	var masterResourceSnapshot fleetv1beta1.ResourceSnapshotObj
 	for i, resourceSnapshot := range items {
+		if err := ResolveResourceSnapshotContents(ctx, k8Client, resourceSnapshot); err != nil {
+			return nil, err
+		}
 		allResourceSnapshots[resourceSnapshot.GetName()] = resourceSnapshot
 		// only master has this annotation
 		if len(resourceSnapshot.GetAnnotations()[fleetv1beta1.ResourceGroupHashAnnotation]) != 0 {
@@ -418,3 +447,10 @@ type MemberController interface {
 	// For example, delete all the resources created by the member controller.
 	Leave(ctx context.Context) error
 }
+
+// ApplyConcurrencyTuner is a member controller whose apply concurrency can be tuned at runtime.
+type ApplyConcurrencyTuner interface {
+	// SetApplyConcurrency sets the maximum number of objects the controller applies at the same time,
+	// and returns the concurrency that is in effect. A nil concurrency restores the configured one.
+	SetApplyConcurrency(concurrency *int32) int32
+}
@@ -140,6 +140,43 @@ func FetchLatestPolicySnapshot(ctx context.Context, k8Client client.Reader, plac
 	return policySnapshotList, nil
 }
 
+// FetchSelectedClusters returns the set of clusters currently selected by a given placement, as recorded
+// in the scheduling decisions of its latest policy snapshot, and whether the scheduler has processed that
+// policy snapshot yet (see IsPolicySnapshotScheduled).
+// A placement that does not exist (or has no policy snapshot yet) selects no cluster and is not scheduled.
+func FetchSelectedClusters(ctx context.Context, k8Client client.Reader, placementKey types.NamespacedName) (map[string]bool, bool, error) {
+	policySnapshotList, err := FetchLatestPolicySnapshot(ctx, k8Client, placementKey)
+	if err != nil {
+		return nil, false, NewAPIServerError(true, err)
+	}
+	policySnapshots := policySnapshotList.GetPolicySnapshotObjs()
+	if len(policySnapshots) > 1 {
+		// There are multiple active policy snapshots; normally this should never occur.
+		return nil, false, NewUnexpectedBehaviorError(fmt.Errorf("found %d latest policy snapshots for placement %s", len(policySnapshots), placementKey))
+	}
+
+	selected := make(map[string]bool)
+	if len(policySnapshots) == 0 {
+		return selected, false, nil
+	}
+	for _, decision := range policySnapshots[0].GetPolicySnapshotStatus().ClusterDecisions {
+		if decision.Selected {
+			selected[decision.ClusterName] = true
+		}
+	}
+	return selected, IsPolicySnapshotScheduled(policySnapshots[0]), nil
+}
+
+// IsPolicySnapshotScheduled returns if the scheduler has run a scheduling cycle for the current generation
+// of a policy snapshot, i.e., if its scheduling decisions are up to date; the placement may or may not
+// be fully scheduled.
+func IsPolicySnapshotScheduled(policySnapshot fleetv1beta1.PolicySnapshotObj) bool {
+	scheduledCondition := policySnapshot.GetCondition(string(fleetv1beta1.PolicySnapshotScheduled))
+	return scheduledCondition != nil &&
+		scheduledCondition.ObservedGeneration == policySnapshot.GetGeneration() &&
+		scheduledCondition.Status != metav1.ConditionUnknown
+}
+
 // ListPolicySnapshots lists all policy snapshots associated with a placement key.
 // For cluster-scoped placements, it lists ClusterSchedulingPolicySnapshot.
 // For namespaced placements, it lists SchedulingPolicySnapshot.
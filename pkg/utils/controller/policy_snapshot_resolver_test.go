@@ -676,6 +676,185 @@ func TestFetchLatestPolicySnapshot(t *testing.T) {
 	}
 }
 
+func TestFetchSelectedClusters(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := fleetv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add to scheme: %v", err)
+	}
+	decisions := []fleetv1beta1.ClusterDecision{
+		{ClusterName: "cluster-1", Selected: true},
+		{ClusterName: "cluster-2", Selected: false},
+		{ClusterName: "cluster-3", Selected: true},
+	}
+	scheduledCondition := func(status metav1.ConditionStatus, generation int64) []metav1.Condition {
+		return []metav1.Condition{{
+			Type:               string(fleetv1beta1.PolicySnapshotScheduled),
+			Status:             status,
+			ObservedGeneration: generation,
+			Reason:             "test",
+		}}
+	}
+
+	testCases := []struct {
+		name              string
+		placementKey      types.NamespacedName
+		existingSnapshots []client.Object
+		want              map[string]bool
+		wantScheduled     bool
+		wantErr           bool
+	}{
+		{
+			name:         "selected clusters of a cluster scoped placement",
+			placementKey: types.NamespacedName{Name: placementName},
+			existingSnapshots: []client.Object{
+				&fleetv1beta1.ClusterSchedulingPolicySnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-placement-0",
+						Labels: map[string]string{
+							fleetv1beta1.PlacementTrackingLabel: placementName,
+							fleetv1beta1.IsLatestSnapshotLabel:  "false",
+						},
+					},
+					Status: fleetv1beta1.SchedulingPolicySnapshotStatus{
+						ClusterDecisions: []fleetv1beta1.ClusterDecision{{ClusterName: "cluster-4", Selected: true}},
+					},
+				},
+				&fleetv1beta1.ClusterSchedulingPolicySnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-placement-1",
+						Labels: map[string]string{
+							fleetv1beta1.PlacementTrackingLabel: placementName,
+							fleetv1beta1.IsLatestSnapshotLabel:  "true",
+						},
+						Generation: 1,
+					},
+					Status: fleetv1beta1.SchedulingPolicySnapshotStatus{
+						ClusterDecisions: decisions,
+						Conditions:       scheduledCondition(metav1.ConditionTrue, 1),
+					},
+				},
+			},
+			want:          map[string]bool{"cluster-1": true, "cluster-3": true},
+			wantScheduled: true,
+		},
+		{
+			name:         "selected clusters of a namespaced placement",
+			placementKey: types.NamespacedName{Name: placementName, Namespace: policySnapshotNamespace},
+			existingSnapshots: []client.Object{
+				&fleetv1beta1.SchedulingPolicySnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "test-placement-0",
+						Namespace: policySnapshotNamespace,
+						Labels: map[string]string{
+							fleetv1beta1.PlacementTrackingLabel: placementName,
+							fleetv1beta1.IsLatestSnapshotLabel:  "true",
+						},
+						Generation: 1,
+					},
+					Status: fleetv1beta1.SchedulingPolicySnapshotStatus{
+						ClusterDecisions: decisions,
+						Conditions:       scheduledCondition(metav1.ConditionFalse, 1),
+					},
+				},
+			},
+			want:          map[string]bool{"cluster-1": true, "cluster-3": true},
+			wantScheduled: true,
+		},
+		{
+			name:         "latest policy snapshot not scheduled yet",
+			placementKey: types.NamespacedName{Name: placementName},
+			existingSnapshots: []client.Object{
+				&fleetv1beta1.ClusterSchedulingPolicySnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-placement-0",
+						Labels: map[string]string{
+							fleetv1beta1.PlacementTrackingLabel: placementName,
+							fleetv1beta1.IsLatestSnapshotLabel:  "true",
+						},
+						Generation: 1,
+					},
+				},
+			},
+			want: map[string]bool{},
+		},
+		{
+			name:         "latest policy snapshot scheduled for an earlier generation",
+			placementKey: types.NamespacedName{Name: placementName},
+			existingSnapshots: []client.Object{
+				&fleetv1beta1.ClusterSchedulingPolicySnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-placement-0",
+						Labels: map[string]string{
+							fleetv1beta1.PlacementTrackingLabel: placementName,
+							fleetv1beta1.IsLatestSnapshotLabel:  "true",
+						},
+						Generation: 2,
+					},
+					Status: fleetv1beta1.SchedulingPolicySnapshotStatus{
+						ClusterDecisions: decisions,
+						Conditions:       scheduledCondition(metav1.ConditionTrue, 1),
+					},
+				},
+			},
+			want: map[string]bool{"cluster-1": true, "cluster-3": true},
+		},
+		{
+			name:         "placement not found",
+			placementKey: types.NamespacedName{Name: placementName},
+			want:         map[string]bool{},
+		},
+		{
+			name:         "multiple latest policy snapshots",
+			placementKey: types.NamespacedName{Name: placementName},
+			existingSnapshots: []client.Object{
+				&fleetv1beta1.ClusterSchedulingPolicySnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-placement-0",
+						Labels: map[string]string{
+							fleetv1beta1.PlacementTrackingLabel: placementName,
+							fleetv1beta1.IsLatestSnapshotLabel:  "true",
+						},
+					},
+				},
+				&fleetv1beta1.ClusterSchedulingPolicySnapshot{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "test-placement-1",
+						Labels: map[string]string{
+							fleetv1beta1.PlacementTrackingLabel: placementName,
+							fleetv1beta1.IsLatestSnapshotLabel:  "true",
+						},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(tc.existingSnapshots...).
+				Build()
+
+			got, gotScheduled, err := FetchSelectedClusters(ctx, fakeClient, tc.placementKey)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("FetchSelectedClusters() = %v, want error %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FetchSelectedClusters() mismatch (-want, +got):\n%s", diff)
+			}
+			if gotScheduled != tc.wantScheduled {
+				t.Errorf("FetchSelectedClusters() scheduled = %t, want %t", gotScheduled, tc.wantScheduled)
+			}
+		})
+	}
+}
+
 func TestListPolicySnapshots(t *testing.T) {
 	scheme := runtime.NewScheme()
 	if err := fleetv1beta1.AddToScheme(scheme); err != nil {
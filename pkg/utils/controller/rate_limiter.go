@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"sync"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// ReloadableRateLimiter is a work queue rate limiter that delegates to another rate limiter, which can
+// be swapped at runtime (e.g., when the hub agent runtime configuration is reloaded).
+//
+// Note that the delegate keeps track of the failures of each item; after a swap, items that have failed
+// earlier start over with the base delay of the new delegate.
+type ReloadableRateLimiter struct {
+	mu       sync.RWMutex
+	delegate workqueue.TypedRateLimiter[any]
+}
+
+var _ workqueue.TypedRateLimiter[any] = &ReloadableRateLimiter{}
+
+// NewReloadableRateLimiter returns a ReloadableRateLimiter that delegates to the given rate limiter.
+func NewReloadableRateLimiter(delegate workqueue.TypedRateLimiter[any]) *ReloadableRateLimiter {
+	return &ReloadableRateLimiter{delegate: delegate}
+}
+
+// Set swaps the rate limiter in use.
+func (r *ReloadableRateLimiter) Set(delegate workqueue.TypedRateLimiter[any]) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.delegate = delegate
+}
+
+// When returns how long to wait before the item is processed again.
+func (r *ReloadableRateLimiter) When(item any) time.Duration {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.delegate.When(item)
+}
+
+// Forget stops tracking the item.
+func (r *ReloadableRateLimiter) Forget(item any) {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	r.delegate.Forget(item)
+}
+
+// NumRequeues returns how many times the item has been requeued.
+func (r *ReloadableRateLimiter) NumRequeues(item any) int {
+	r.mu.RLock()
+	defer r.mu.RUnlock()
+	return r.delegate.NumRequeues(item)
+}
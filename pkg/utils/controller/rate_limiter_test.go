@@ -0,0 +1,54 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"testing"
+	"time"
+
+	"k8s.io/client-go/util/workqueue"
+)
+
+// TestReloadableRateLimiter tests the ReloadableRateLimiter.
+func TestReloadableRateLimiter(t *testing.T) {
+	item := "test-item"
+	r := NewReloadableRateLimiter(workqueue.NewTypedItemExponentialFailureRateLimiter[any](time.Millisecond, time.Second))
+
+	if got, want := r.When(item), time.Millisecond; got != want {
+		t.Errorf("When() = %v, want %v", got, want)
+	}
+	if got, want := r.When(item), 2*time.Millisecond; got != want {
+		t.Errorf("When() = %v, want %v", got, want)
+	}
+	if got, want := r.NumRequeues(item), 2; got != want {
+		t.Errorf("NumRequeues() = %d, want %d", got, want)
+	}
+
+	// After the swap, the item starts over with the base delay of the new rate limiter.
+	r.Set(workqueue.NewTypedItemExponentialFailureRateLimiter[any](10*time.Millisecond, time.Second))
+	if got, want := r.NumRequeues(item), 0; got != want {
+		t.Errorf("NumRequeues() after Set() = %d, want %d", got, want)
+	}
+	if got, want := r.When(item), 10*time.Millisecond; got != want {
+		t.Errorf("When() after Set() = %v, want %v", got, want)
+	}
+
+	r.Forget(item)
+	if got, want := r.NumRequeues(item), 0; got != want {
+		t.Errorf("NumRequeues() after Forget() = %d, want %d", got, want)
+	}
+}
@@ -0,0 +1,145 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"crypto/sha256"
+	"fmt"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// resourceContentNamePrefix is the prefix of the names of ClusterResourceContent objects.
+	resourceContentNamePrefix = "rc-"
+)
+
+// resourceContentReferenceRefreshInterval is the minimum interval between two refreshes of the last referenced time
+// of a ClusterResourceContent object. It must be (well) below the grace period the orphaned resource janitor waits
+// before deleting an unreferenced ClusterResourceContent object, so that an object that is about to be referenced by
+// a new resource snapshot is never deleted.
+var resourceContentReferenceRefreshInterval = time.Minute
+
+// ResourceContentName returns the name of the ClusterResourceContent object that keeps the given resource content;
+// the name is derived from the hash of the content so that the same content is always kept in the same object.
+func ResourceContentName(content *fleetv1beta1.ResourceContent) string {
+	return fmt.Sprintf("%s%x", resourceContentNamePrefix, sha256.Sum256(content.Raw))
+}
+
+// deduplicateResourceSnapshotContents makes sure that a ClusterResourceContent object exists for each selected
+// resource of the given resource snapshot, and replaces the selected resources in the snapshot with the names of
+// these objects.
+func (r *ResourceSnapshotResolver) deduplicateResourceSnapshotContents(ctx context.Context, resourceSnapshot fleetv1beta1.ResourceSnapshotObj) error {
+	spec := resourceSnapshot.GetResourceSnapshotSpec()
+	names := make([]string, 0, len(spec.SelectedResources))
+	for i := range spec.SelectedResources {
+		name, err := r.ensureResourceContent(ctx, &spec.SelectedResources[i])
+		if err != nil {
+			klog.ErrorS(err, "Failed to ensure the resource content of a selected resource", "resourceSnapshot", klog.KObj(resourceSnapshot), "resourceContent", name)
+			return err
+		}
+		names = append(names, name)
+	}
+	spec.SelectedResources = []fleetv1beta1.ResourceContent{}
+	spec.SelectedResourceContentNames = names
+	return nil
+}
+
+// ensureResourceContent makes sure that the ClusterResourceContent object keeping the given content exists and
+// has been referenced recently, and returns its name.
+func (r *ResourceSnapshotResolver) ensureResourceContent(ctx context.Context, content *fleetv1beta1.ResourceContent) (string, error) {
+	name := ResourceContentName(content)
+	now := time.Now()
+	existing := &fleetv1beta1.ClusterResourceContent{}
+	err := r.Client.Get(ctx, types.NamespacedName{Name: name}, existing)
+	switch {
+	case err == nil:
+		lastReferencedTime, parseErr := time.Parse(time.RFC3339, existing.GetAnnotations()[fleetv1beta1.ResourceContentLastReferencedTimeAnnotation])
+		if parseErr == nil && now.Sub(lastReferencedTime) < resourceContentReferenceRefreshInterval {
+			return name, nil
+		}
+		// Refresh the last referenced time so that the janitor does not delete the object while
+		// the new resource snapshot that refers to it is being created.
+		annotations := existing.GetAnnotations()
+		if annotations == nil {
+			annotations = map[string]string{}
+		}
+		annotations[fleetv1beta1.ResourceContentLastReferencedTimeAnnotation] = now.Format(time.RFC3339)
+		existing.SetAnnotations(annotations)
+		if err := r.Client.Update(ctx, existing); err != nil {
+			return name, NewUpdateIgnoreConflictError(err)
+		}
+		return name, nil
+	case !apierrors.IsNotFound(err):
+		return name, NewAPIServerError(true, err)
+	}
+
+	resourceContent := &fleetv1beta1.ClusterResourceContent{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+			Annotations: map[string]string{
+				fleetv1beta1.ResourceContentLastReferencedTimeAnnotation: now.Format(time.RFC3339),
+			},
+		},
+		Content: *content.DeepCopy(),
+	}
+	if err := r.Client.Create(ctx, resourceContent); err != nil {
+		if apierrors.IsAlreadyExists(err) {
+			// The object is created by another placement but is not in the cache yet; retry so that
+			// its last referenced time can be refreshed.
+			return name, NewExpectedBehaviorError(fmt.Errorf("resource content %s is not found in the cache yet: %w", name, err))
+		}
+		return name, NewAPIServerError(false, err)
+	}
+	klog.V(2).InfoS("Created a resource content", "resourceContent", name)
+	return name, nil
+}
+
+// ResolveResourceSnapshotContents fills in the selected resources of the given resource snapshot with the contents
+// kept in the ClusterResourceContent objects it refers to, if any; the snapshot is updated in memory only.
+//
+// It returns an expected behavior error if a ClusterResourceContent object is not found, which could happen when
+// the object has just been created and is not in the cache yet.
+func ResolveResourceSnapshotContents(ctx context.Context, k8Client client.Reader, resourceSnapshot fleetv1beta1.ResourceSnapshotObj) error {
+	spec := resourceSnapshot.GetResourceSnapshotSpec()
+	if len(spec.SelectedResourceContentNames) == 0 {
+		return nil
+	}
+	selectedResources := make([]fleetv1beta1.ResourceContent, 0, len(spec.SelectedResourceContentNames))
+	for _, name := range spec.SelectedResourceContentNames {
+		resourceContent := &fleetv1beta1.ClusterResourceContent{}
+		if err := k8Client.Get(ctx, types.NamespacedName{Name: name}, resourceContent); err != nil {
+			klog.ErrorS(err, "Failed to get the resource content referred to by the resource snapshot", "resourceSnapshot", klog.KObj(resourceSnapshot), "resourceContent", name)
+			if apierrors.IsNotFound(err) {
+				return NewExpectedBehaviorError(fmt.Errorf("resource content %s referred to by resource snapshot %s is not found: %w", name, resourceSnapshot.GetName(), err))
+			}
+			return NewAPIServerError(true, err)
+		}
+		selectedResources = append(selectedResources, resourceContent.Content)
+	}
+	spec.SelectedResources = selectedResources
+	spec.SelectedResourceContentNames = nil
+	return nil
+}
@@ -0,0 +1,283 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/test/utils/resource"
+)
+
+// resourceNames returns the names of the resources kept in the given resource contents.
+func resourceNames(t *testing.T, contents []fleetv1beta1.ResourceContent) []string {
+	names := make([]string, 0, len(contents))
+	for _, content := range contents {
+		var u unstructured.Unstructured
+		if err := u.UnmarshalJSON(content.Raw); err != nil {
+			t.Fatalf("failed to unmarshal the resource content: %v", err)
+		}
+		names = append(names, u.GetKind()+"/"+u.GetName())
+	}
+	return names
+}
+
+func TestGetOrCreateResourceSnapshotWithContentDeduplication(t *testing.T) {
+	serviceResourceContent := *resource.ServiceResourceContentForTest(t)
+	secretResourceContent := *resource.SecretResourceContentForTest(t)
+	deploymentResourceContent := *resource.DeploymentResourceContentForTest(t)
+
+	ctx := context.Background()
+	crp := clusterResourcePlacementForTest()
+	anotherCRP := clusterResourcePlacementForTest()
+	anotherCRP.Name = "another-crp"
+	scheme := serviceScheme(t)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(crp, anotherCRP).Build()
+	resolver := NewResourceSnapshotResolver(fakeClient, scheme)
+	resolver.EnableContentDeduplication = true
+
+	// Both placements select the service and the secret; only one of them selects the deployment.
+	specs := map[*fleetv1beta1.ClusterResourcePlacement]*fleetv1beta1.ResourceSnapshotSpec{
+		crp:        {SelectedResources: []fleetv1beta1.ResourceContent{serviceResourceContent, secretResourceContent}},
+		anotherCRP: {SelectedResources: []fleetv1beta1.ResourceContent{serviceResourceContent, secretResourceContent, deploymentResourceContent}},
+	}
+	for placement, spec := range specs {
+		_, got, err := resolver.GetOrCreateResourceSnapshot(ctx, placement, 0, spec, 10)
+		if err != nil {
+			t.Fatalf("GetOrCreateResourceSnapshot(%s) = %v, want no error", placement.Name, err)
+		}
+
+		// The snapshot refers to the contents by name instead of keeping copies of them.
+		stored := &fleetv1beta1.ClusterResourceSnapshot{}
+		if err := fakeClient.Get(ctx, types.NamespacedName{Name: got.GetName()}, stored); err != nil {
+			t.Fatalf("failed to get the resource snapshot %s: %v", got.GetName(), err)
+		}
+		if len(stored.Spec.SelectedResources) != 0 {
+			t.Errorf("resource snapshot %s keeps %d selected resources, want none", stored.Name, len(stored.Spec.SelectedResources))
+		}
+		wantContentNames := make([]string, 0, len(spec.SelectedResources))
+		for i := range spec.SelectedResources {
+			wantContentNames = append(wantContentNames, ResourceContentName(&spec.SelectedResources[i]))
+		}
+		if diff := cmp.Diff(wantContentNames, stored.Spec.SelectedResourceContentNames); diff != "" {
+			t.Errorf("resource snapshot %s content names mismatch (-want, +got):\n%s", stored.Name, diff)
+		}
+
+		// The selected resources are resolved when the snapshots are fetched.
+		resourceSnapshots, err := FetchAllResourceSnapshotsAlongWithMaster(ctx, fakeClient, placement.Name, stored)
+		if err != nil {
+			t.Fatalf("FetchAllResourceSnapshotsAlongWithMaster(%s) = %v, want no error", placement.Name, err)
+		}
+		if diff := cmp.Diff(resourceNames(t, spec.SelectedResources), resourceNames(t, resourceSnapshots[stored.Name].GetResourceSnapshotSpec().SelectedResources)); diff != "" {
+			t.Errorf("resolved selected resources of %s mismatch (-want, +got):\n%s", stored.Name, diff)
+		}
+	}
+
+	resourceContentList := &fleetv1beta1.ClusterResourceContentList{}
+	if err := fakeClient.List(ctx, resourceContentList); err != nil {
+		t.Fatalf("failed to list the resource contents: %v", err)
+	}
+	if got, want := len(resourceContentList.Items), 3; got != want {
+		t.Errorf("got %d resource contents, want %d", got, want)
+	}
+}
+
+func TestGetOrCreateResourceSnapshotWithContentDeduplicationForResourcePlacement(t *testing.T) {
+	serviceResourceContent := *resource.ServiceResourceContentForTest(t)
+
+	ctx := context.Background()
+	rp := &fleetv1beta1.ResourcePlacement{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:       "test-placement",
+			Namespace:  "test-namespace",
+			Generation: placementGeneration,
+		},
+	}
+	scheme := serviceScheme(t)
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(rp).Build()
+	resolver := NewResourceSnapshotResolver(fakeClient, scheme)
+	resolver.EnableContentDeduplication = true
+
+	spec := &fleetv1beta1.ResourceSnapshotSpec{SelectedResources: []fleetv1beta1.ResourceContent{serviceResourceContent}}
+	_, got, err := resolver.GetOrCreateResourceSnapshot(ctx, rp, 0, spec, 10)
+	if err != nil {
+		t.Fatalf("GetOrCreateResourceSnapshot() = %v, want no error", err)
+	}
+
+	// The cluster-scoped resource contents are not shared with namespaced snapshots.
+	stored := &fleetv1beta1.ResourceSnapshot{}
+	if err := fakeClient.Get(ctx, types.NamespacedName{Namespace: "test-namespace", Name: got.GetName()}, stored); err != nil {
+		t.Fatalf("failed to get the resource snapshot %s: %v", got.GetName(), err)
+	}
+	if diff := cmp.Diff(resourceNames(t, spec.SelectedResources), resourceNames(t, stored.Spec.SelectedResources)); diff != "" {
+		t.Errorf("selected resources of %s mismatch (-want, +got):\n%s", stored.Name, diff)
+	}
+	if len(stored.Spec.SelectedResourceContentNames) != 0 {
+		t.Errorf("resource snapshot %s refers to %d resource contents, want none", stored.Name, len(stored.Spec.SelectedResourceContentNames))
+	}
+
+	resourceContentList := &fleetv1beta1.ClusterResourceContentList{}
+	if err := fakeClient.List(ctx, resourceContentList); err != nil {
+		t.Fatalf("failed to list the resource contents: %v", err)
+	}
+	if len(resourceContentList.Items) != 0 {
+		t.Errorf("got %d resource contents, want none", len(resourceContentList.Items))
+	}
+}
+
+func TestEnsureResourceContent(t *testing.T) {
+	secretResourceContent := *resource.SecretResourceContentForTest(t)
+	contentName := ResourceContentName(&secretResourceContent)
+	recentTime := time.Now().Add(-10 * time.Second).Format(time.RFC3339)
+	staleTime := time.Now().Add(-time.Hour).Format(time.RFC3339)
+
+	tests := []struct {
+		name                   string
+		existing               *fleetv1beta1.ClusterResourceContent
+		wantLastReferencedTime func(string) bool
+	}{
+		{
+			name:                   "content does not exist",
+			wantLastReferencedTime: func(s string) bool { return s != "" && s != staleTime },
+		},
+		{
+			name: "content was referenced recently",
+			existing: &fleetv1beta1.ClusterResourceContent{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        contentName,
+					Annotations: map[string]string{fleetv1beta1.ResourceContentLastReferencedTimeAnnotation: recentTime},
+				},
+				Content: secretResourceContent,
+			},
+			wantLastReferencedTime: func(s string) bool { return s == recentTime },
+		},
+		{
+			name: "content was not referenced recently",
+			existing: &fleetv1beta1.ClusterResourceContent{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        contentName,
+					Annotations: map[string]string{fleetv1beta1.ResourceContentLastReferencedTimeAnnotation: staleTime},
+				},
+				Content: secretResourceContent,
+			},
+			wantLastReferencedTime: func(s string) bool { return s != recentTime && s != staleTime },
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			scheme := serviceScheme(t)
+			builder := fake.NewClientBuilder().WithScheme(scheme)
+			if tc.existing != nil {
+				builder = builder.WithObjects(tc.existing)
+			}
+			fakeClient := builder.Build()
+			resolver := NewResourceSnapshotResolver(fakeClient, scheme)
+
+			name, err := resolver.ensureResourceContent(ctx, &secretResourceContent)
+			if err != nil {
+				t.Fatalf("ensureResourceContent() = %v, want no error", err)
+			}
+			if name != contentName {
+				t.Errorf("ensureResourceContent() = %s, want %s", name, contentName)
+			}
+			got := &fleetv1beta1.ClusterResourceContent{}
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: contentName}, got); err != nil {
+				t.Fatalf("failed to get the resource content: %v", err)
+			}
+			if lastReferencedTime := got.Annotations[fleetv1beta1.ResourceContentLastReferencedTimeAnnotation]; !tc.wantLastReferencedTime(lastReferencedTime) {
+				t.Errorf("resource content has an unexpected last referenced time %q", lastReferencedTime)
+			}
+		})
+	}
+}
+
+func TestResolveResourceSnapshotContents(t *testing.T) {
+	serviceResourceContent := *resource.ServiceResourceContentForTest(t)
+	secretResourceContent := *resource.SecretResourceContentForTest(t)
+	serviceContent := &fleetv1beta1.ClusterResourceContent{
+		ObjectMeta: metav1.ObjectMeta{Name: ResourceContentName(&serviceResourceContent)},
+		Content:    serviceResourceContent,
+	}
+
+	tests := []struct {
+		name                  string
+		spec                  fleetv1beta1.ResourceSnapshotSpec
+		objects               []client.Object
+		wantSelectedResources []string
+		wantErr               error
+	}{
+		{
+			name:                  "snapshot keeps the contents",
+			spec:                  fleetv1beta1.ResourceSnapshotSpec{SelectedResources: []fleetv1beta1.ResourceContent{secretResourceContent}},
+			wantSelectedResources: []string{"Secret/secret-name"},
+		},
+		{
+			name: "snapshot refers to the contents",
+			spec: fleetv1beta1.ResourceSnapshotSpec{
+				SelectedResources:            []fleetv1beta1.ResourceContent{},
+				SelectedResourceContentNames: []string{serviceContent.Name},
+			},
+			objects:               []client.Object{serviceContent},
+			wantSelectedResources: []string{"Service/svc-name"},
+		},
+		{
+			name: "referred content is not found",
+			spec: fleetv1beta1.ResourceSnapshotSpec{
+				SelectedResources:            []fleetv1beta1.ResourceContent{},
+				SelectedResourceContentNames: []string{serviceContent.Name, ResourceContentName(&secretResourceContent)},
+			},
+			objects: []client.Object{serviceContent},
+			wantErr: ErrExpectedBehavior,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeClient := fake.NewClientBuilder().WithScheme(serviceScheme(t)).WithObjects(tc.objects...).Build()
+			snapshot := &fleetv1beta1.ClusterResourceSnapshot{
+				ObjectMeta: metav1.ObjectMeta{Name: "crp-0-snapshot"},
+				Spec:       tc.spec,
+			}
+			err := ResolveResourceSnapshotContents(context.Background(), fakeClient, snapshot)
+			if tc.wantErr != nil {
+				if !errors.Is(err, tc.wantErr) {
+					t.Fatalf("ResolveResourceSnapshotContents() = %v, want %v", err, tc.wantErr)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("ResolveResourceSnapshotContents() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(tc.wantSelectedResources, resourceNames(t, snapshot.Spec.SelectedResources)); diff != "" {
+				t.Errorf("ResolveResourceSnapshotContents() selected resources mismatch (-want, +got):\n%s", diff)
+			}
+			if len(snapshot.Spec.SelectedResourceContentNames) != 0 {
+				t.Errorf("ResolveResourceSnapshotContents() left content names %v, want none", snapshot.Spec.SelectedResourceContentNames)
+			}
+		})
+	}
+}
@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -36,6 +37,7 @@ import (
 	"k8s.io/klog/v2"
 	"k8s.io/kubectl/pkg/util/deployment"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
 
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
@@ -124,16 +126,20 @@ func (rs *ResourceSelectorResolver) SelectResourcesForPlacement(placementObj pla
 	resources := make([]placementv1beta1.ResourceContent, len(selectedObjects))
 	resourcesIDs := make([]placementv1beta1.ResourceIdentifier, len(selectedObjects))
 	for i, unstructuredObj := range selectedObjects {
-		rc, err := generateResourceContent(unstructuredObj)
-		if err != nil {
-			return 0, nil, nil, err
-		}
 		uGVK := unstructuredObj.GetObjectKind().GroupVersionKind().GroupKind()
 		switch uGVK {
 		case utils.ClusterResourceEnvelopeGK:
 			envelopeObjCount++
 		case utils.ResourceEnvelopeGK:
 			envelopeObjCount++
+			// Resolve the manifests kept in Secrets so that they are wrapped in the snapshot of the envelope.
+			if unstructuredObj, err = rs.resolveEnvelopeSecretRefs(unstructuredObj); err != nil {
+				return 0, nil, nil, err
+			}
+		}
+		rc, err := generateResourceContent(unstructuredObj)
+		if err != nil {
+			return 0, nil, nil, err
 		}
 		resources[i] = *rc
 		ri := placementv1beta1.ResourceIdentifier{
@@ -148,6 +154,78 @@ func (rs *ResourceSelectorResolver) SelectResourcesForPlacement(placementObj pla
 	return envelopeObjCount, resources, resourcesIDs, nil
 }
 
+// resolveEnvelopeSecretRefs returns a copy of the given ResourceEnvelope object with the manifests
+// referenced in its secretRefs field inlined in its data field; the secretRefs field is removed from
+// the copy. The object is returned as it is if it references no Secrets. Only the Secrets that list the
+// envelope in their EnvelopeSecretRefAllowedEnvelopesAnnotation are resolved.
+func (rs *ResourceSelectorResolver) resolveEnvelopeSecretRefs(envelopeObj *unstructured.Unstructured) (*unstructured.Unstructured, error) {
+	var envelope placementv1beta1.ResourceEnvelope
+	if err := runtime.DefaultUnstructuredConverter.FromUnstructured(envelopeObj.Object, &envelope); err != nil {
+		return nil, NewUnexpectedBehaviorError(fmt.Errorf("failed to convert the resource envelope %s: %w", klog.KObj(envelopeObj), err))
+	}
+	if len(envelope.SecretRefs) == 0 {
+		return envelopeObj, nil
+	}
+	if !rs.InformerManager.IsInformerSynced(utils.SecretGVR) {
+		return nil, NewExpectedBehaviorError(fmt.Errorf("informer cache for secrets is not synced yet"))
+	}
+
+	resolvedObj := envelopeObj.DeepCopy()
+	// Resolve the references in a deterministic order so that the same error is reported each time.
+	refKeys := make([]string, 0, len(envelope.SecretRefs))
+	for k := range envelope.SecretRefs {
+		refKeys = append(refKeys, k)
+	}
+	sort.Strings(refKeys)
+	for _, k := range refKeys {
+		ref := envelope.SecretRefs[k]
+		secretObj, err := rs.InformerManager.Lister(utils.SecretGVR).ByNamespace(envelope.Namespace).Get(ref.Name)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return nil, NewAPIServerError(true, err)
+		}
+		// The hub agent reads the Secret with its own privileges, so only the Secrets that have opted in can be
+		// referenced; a missing Secret is reported the same way so that its existence is not revealed either.
+		if err != nil || !isSecretReferableByEnvelope(secretObj.(*unstructured.Unstructured), envelope.Name) {
+			return nil, NewUserError(fmt.Errorf("secret %s referenced by manifest %s of resource envelope %s is not found or does not allow the envelope to reference it in its %s annotation",
+				ref.Name, k, klog.KObj(envelopeObj), placementv1beta1.EnvelopeSecretRefAllowedEnvelopesAnnotation))
+		}
+		var secret corev1.Secret
+		if err := runtime.DefaultUnstructuredConverter.FromUnstructured(secretObj.(*unstructured.Unstructured).Object, &secret); err != nil {
+			return nil, NewUnexpectedBehaviorError(fmt.Errorf("failed to convert the secret %s/%s: %w", envelope.Namespace, ref.Name, err))
+		}
+		raw, found := secret.Data[ref.Key]
+		if !found {
+			return nil, NewUserError(fmt.Errorf("key %s is not found in secret %s referenced by manifest %s of resource envelope %s", ref.Key, ref.Name, k, klog.KObj(envelopeObj)))
+		}
+		manifest := map[string]interface{}{}
+		if err := yaml.Unmarshal(raw, &manifest); err != nil || len(manifest) == 0 {
+			if err == nil {
+				err = errors.New("the manifest is empty")
+			}
+			return nil, NewUserError(fmt.Errorf("key %s in secret %s referenced by manifest %s of resource envelope %s is not a valid manifest: %w", ref.Key, ref.Name, k, klog.KObj(envelopeObj), err))
+		}
+		if err := unstructured.SetNestedField(resolvedObj.Object, manifest, "data", k); err != nil {
+			return nil, NewUnexpectedBehaviorError(fmt.Errorf("failed to inline manifest %s of resource envelope %s: %w", k, klog.KObj(envelopeObj), err))
+		}
+	}
+	unstructured.RemoveNestedField(resolvedObj.Object, "secretRefs")
+	return resolvedObj, nil
+}
+
+// isSecretReferableByEnvelope returns whether the Secret lists the envelope in its allowed envelopes annotation.
+func isSecretReferableByEnvelope(secretObj *unstructured.Unstructured, envelopeName string) bool {
+	allowedEnvelopes, found := secretObj.GetAnnotations()[placementv1beta1.EnvelopeSecretRefAllowedEnvelopesAnnotation]
+	if !found {
+		return false
+	}
+	for _, name := range strings.Split(allowedEnvelopes, ",") {
+		if strings.TrimSpace(name) == envelopeName {
+			return true
+		}
+	}
+	return false
+}
+
 // generateResourceContent creates a resource content from the unstructured obj.
 func generateResourceContent(object *unstructured.Unstructured) (*placementv1beta1.ResourceContent, error) {
 	rawContent, err := generateRawContent(object)
@@ -237,6 +315,9 @@ func generateRawContent(object *unstructured.Unstructured) ([]byte, error) {
 func (rs *ResourceSelectorResolver) gatherSelectedResource(placementKey types.NamespacedName, selectors []placementv1beta1.ResourceSelectorTerm) ([]*unstructured.Unstructured, error) {
 	var resources []*unstructured.Unstructured
 	var resourceMap = make(map[placementv1beta1.ResourceIdentifier]bool)
+	// dependents are the resources declared as dependents by the selected cluster-scoped resources; unlike
+	// the resources selected directly, they might overlap with each other or with the selected resources.
+	var dependents []runtime.Object
 
 	// First pass: check if there's a namespace selector with NamespaceWithResourceSelectors mode
 	// If found, collect the selected namespace (must be exactly one) for filtering resources in the second pass
@@ -309,6 +390,11 @@ func (rs *ResourceSelectorResolver) gatherSelectedResource(placementKey types.Na
 			} else {
 				objs, err = rs.fetchResources(selector, placementKey)
 			}
+			if err == nil && isCRP && !isNamespaceScoped && selector.IncludeDependents {
+				var dependentObjs []runtime.Object
+				dependentObjs, err = rs.fetchDependentResources(objs, placementKey.Name)
+				dependents = append(dependents, dependentObjs...)
+			}
 		}
 
 		if err != nil {
@@ -333,6 +419,21 @@ func (rs *ResourceSelectorResolver) gatherSelectedResource(placementKey types.Na
 			resources = append(resources, uObj)
 		}
 	}
+	for _, obj := range dependents {
+		uObj := obj.(*unstructured.Unstructured)
+		ri := placementv1beta1.ResourceIdentifier{
+			Group:     obj.GetObjectKind().GroupVersionKind().Group,
+			Version:   obj.GetObjectKind().GroupVersionKind().Version,
+			Kind:      obj.GetObjectKind().GroupVersionKind().Kind,
+			Name:      uObj.GetName(),
+			Namespace: uObj.GetNamespace(),
+		}
+		if resourceMap[ri] {
+			continue
+		}
+		resourceMap[ri] = true
+		resources = append(resources, uObj)
+	}
 	// sort the resources in strict order so that we will get the stable list of manifest so that
 	// the generated work object doesn't change between reconcile loops.
 	sortResources(resources)
@@ -391,7 +492,7 @@ func (rs *ResourceSelectorResolver) fetchNamespaceResources(selector placementv1
 
 	if len(selector.Name) != 0 {
 		// just a single namespace
-		objs, err := rs.fetchAllResourcesInOneNamespace(selector.Name, placementName)
+		objs, err := rs.fetchAllResourcesInOneNamespace(selector.Name, placementName, selector.FieldManager)
 		if err != nil {
 			klog.ErrorS(err, "failed to fetch all the selected resource in a namespace", "namespace", selector.Name)
 			return nil, err
@@ -421,7 +522,7 @@ func (rs *ResourceSelectorResolver) fetchNamespaceResources(selector placementv1
 		if err != nil {
 			return nil, NewUnexpectedBehaviorError(fmt.Errorf("cannot get the name of a namespace object: %w", err))
 		}
-		objs, err := rs.fetchAllResourcesInOneNamespace(ns.GetName(), placementName)
+		objs, err := rs.fetchAllResourcesInOneNamespace(ns.GetName(), placementName, selector.FieldManager)
 		if err != nil {
 			klog.ErrorS(err, "failed to fetch all the selected resource in a namespace", "namespace", ns.GetName())
 			return nil, err
@@ -432,7 +533,8 @@ func (rs *ResourceSelectorResolver) fetchNamespaceResources(selector placementv1
 }
 
 // fetchAllResourcesInOneNamespace retrieves all the objects inside a single namespace which includes the namespace itself.
-func (rs *ResourceSelectorResolver) fetchAllResourcesInOneNamespace(namespaceName string, placeName string) ([]runtime.Object, error) {
+// If a field manager is given, only the objects inside the namespace that have fields managed by it are retrieved.
+func (rs *ResourceSelectorResolver) fetchAllResourcesInOneNamespace(namespaceName string, placeName string, fieldManager string) ([]runtime.Object, error) {
 	var resources []runtime.Object
 
 	if !utils.ShouldPropagateNamespace(namespaceName, rs.SkippedNamespaces) {
@@ -475,7 +577,7 @@ func (rs *ResourceSelectorResolver) fetchAllResourcesInOneNamespace(namespaceNam
 			if err != nil {
 				return nil, err
 			}
-			if shouldInclude {
+			if shouldInclude && isManagedBy(obj, fieldManager) {
 				resources = append(resources, obj)
 			}
 		}
@@ -543,7 +645,7 @@ func (rs *ResourceSelectorResolver) fetchResources(selector placementv1beta1.Res
 		if err != nil {
 			return nil, err
 		}
-		if shouldInclude {
+		if shouldInclude && isManagedBy(obj, selector.FieldManager) {
 			return []runtime.Object{obj}, nil
 		}
 		return []runtime.Object{}, nil
@@ -579,7 +681,7 @@ func (rs *ResourceSelectorResolver) fetchResources(selector placementv1beta1.Res
 		if err != nil {
 			return nil, err
 		}
-		if shouldInclude {
+		if shouldInclude && isManagedBy(objects[i], selector.FieldManager) {
 			selectedObjs = append(selectedObjs, objects[i])
 		}
 	}
@@ -587,6 +689,106 @@ func (rs *ResourceSelectorResolver) fetchResources(selector placementv1beta1.Res
 	return selectedObjs, nil
 }
 
+// isManagedBy returns if an object has fields managed by the given field manager, as recorded in its
+// managed fields; all objects are considered to be so if no field manager is given.
+func isManagedBy(obj runtime.Object, fieldManager string) bool {
+	if fieldManager == "" {
+		return true
+	}
+	metaObj, err := meta.Accessor(obj)
+	if err != nil {
+		return false
+	}
+	for _, entry := range metaObj.GetManagedFields() {
+		if entry.Manager == fieldManager {
+			return true
+		}
+	}
+	return false
+}
+
+// fetchDependentResources retrieves the dependents that the given cluster-scoped objects declare via the
+// dependent namespaces and dependent resources annotations. Each dependent namespace expands to the namespace
+// and all the resources within it; dependents that do not exist are skipped.
+func (rs *ResourceSelectorResolver) fetchDependentResources(objs []runtime.Object, placementName string) ([]runtime.Object, error) {
+	var dependents []runtime.Object
+	for _, obj := range objs {
+		uObj := obj.(*unstructured.Unstructured)
+		annotations := uObj.GetAnnotations()
+		for _, namespace := range splitDependentList(annotations[placementv1beta1.DependentNamespacesAnnotation]) {
+			klog.V(2).InfoS("Fetch the dependent namespace", "namespace", namespace, "object", klog.KObj(uObj), "placement", placementName)
+			nsObjs, err := rs.fetchAllResourcesInOneNamespace(namespace, placementName, "")
+			if err != nil {
+				return nil, err
+			}
+			dependents = append(dependents, nsObjs...)
+		}
+		for _, entry := range splitDependentList(annotations[placementv1beta1.DependentResourcesAnnotation]) {
+			selector, namespace, err := parseDependentResource(entry)
+			if err != nil {
+				err = fmt.Errorf("invalid clusterResourcePlacement %s: invalid dependent resource declared by %s %s: %w", placementName, uObj.GetKind(), uObj.GetName(), err)
+				klog.ErrorS(err, "Failed to parse the dependent resources annotation", "object", klog.KObj(uObj))
+				return nil, NewUserError(err)
+			}
+			gvk := schema.GroupVersionKind{Group: selector.Group, Version: selector.Version, Kind: selector.Kind}
+			if rs.ResourceConfig.IsResourceDisabled(gvk) {
+				klog.V(2).InfoS("Skip the dependent resource", "group version kind", gvk.String(), "object", klog.KObj(uObj))
+				continue
+			}
+			if namespace != "" && !utils.ShouldPropagateNamespace(namespace, rs.SkippedNamespaces) {
+				err := fmt.Errorf("invalid clusterResourcePlacement %s: namespace %s of dependent resource %s is not allowed to propagate", placementName, namespace, entry)
+				return nil, NewUserError(err)
+			}
+			resObjs, err := rs.fetchResources(selector, types.NamespacedName{Name: placementName, Namespace: namespace})
+			if err != nil {
+				return nil, err
+			}
+			dependents = append(dependents, resObjs...)
+		}
+	}
+	return dependents, nil
+}
+
+// splitDependentList splits a comma-separated list of dependents, skipping empty entries.
+func splitDependentList(s string) []string {
+	var entries []string
+	for _, entry := range strings.Split(s, ",") {
+		if entry = strings.TrimSpace(entry); entry != "" {
+			entries = append(entries, entry)
+		}
+	}
+	return entries
+}
+
+// parseDependentResource parses a dependent resource in the format of {kind}.{version}.{group}/{name} or
+// {kind}.{version}.{group}/{namespace}/{name} into a name-based resource selector and the namespace.
+func parseDependentResource(entry string) (placementv1beta1.ResourceSelectorTerm, string, error) {
+	parts := strings.Split(entry, "/")
+	if len(parts) != 2 && len(parts) != 3 {
+		return placementv1beta1.ResourceSelectorTerm{}, "", fmt.Errorf("%q is not in the format of {kind}.{version}.{group}/[{namespace}/]{name}", entry)
+	}
+	gvkParts := strings.SplitN(parts[0], ".", 3)
+	if len(gvkParts) < 2 || gvkParts[0] == "" || gvkParts[1] == "" {
+		return placementv1beta1.ResourceSelectorTerm{}, "", fmt.Errorf("%q does not specify a kind and a version", entry)
+	}
+	selector := placementv1beta1.ResourceSelectorTerm{
+		Kind:    gvkParts[0],
+		Version: gvkParts[1],
+		Name:    parts[len(parts)-1],
+	}
+	if len(gvkParts) == 3 {
+		selector.Group = gvkParts[2]
+	}
+	var namespace string
+	if len(parts) == 3 {
+		namespace = parts[1]
+	}
+	if selector.Name == "" || (len(parts) == 3 && namespace == "") {
+		return placementv1beta1.ResourceSelectorTerm{}, "", fmt.Errorf("%q does not specify a name or a namespace", entry)
+	}
+	return selector, namespace, nil
+}
+
 // fetchSelectedNamespace retrieves the namespace name based on the namespace selector.
 // This function assumes the selector is a name-based selector (not label-based) as guaranteed by CEL validation.
 // Returns the namespace name if found and not skipped, or empty string if not found/skipped.
@@ -663,6 +865,10 @@ func ShouldPropagateObj(informerManager informer.Manager, uObj *unstructured.Uns
 		if uObj.GetName() == "kube-root-ca.crt" {
 			return false, nil
 		}
+		// Skip the scheduling cycle snapshots kept by the scheduler, which change with every scheduling cycle.
+		if _, ok := uObj.GetLabels()[placementv1beta1.SchedulingCycleSnapshotsLabel]; ok {
+			return false, nil
+		}
 	case corev1.SchemeGroupVersion.WithKind("ServiceAccount"):
 		// Skip the default service account created in the namespace.
 		if uObj.GetName() == "default" {
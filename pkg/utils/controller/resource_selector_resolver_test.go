@@ -17,6 +17,7 @@ limitations under the License.
 package controller
 
 import (
+	"encoding/base64"
 	"errors"
 	"math/rand"
 	"strings"
@@ -391,6 +392,37 @@ func TestGatherSelectedResource(t *testing.T) {
 	}
 	testBackendDeployment.SetGroupVersionKind(utils.DeploymentGVK)
 
+	// Common test objects with fields managed by a CI pipeline and an operator respectively.
+	testCIDeployment := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "apps/v1",
+			"kind":       "Deployment",
+			"metadata": map[string]interface{}{
+				"name":      "ci-deployment",
+				"namespace": "test-ns",
+				"managedFields": []interface{}{
+					map[string]interface{}{"manager": "ci-pipeline", "operation": "Apply"},
+					map[string]interface{}{"manager": "kube-controller-manager", "operation": "Update"},
+				},
+			},
+		},
+	}
+	testCIDeployment.SetGroupVersionKind(utils.DeploymentGVK)
+	testOperatorConfigMap := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "ConfigMap",
+			"metadata": map[string]interface{}{
+				"name":      "operator-configmap",
+				"namespace": "test-ns",
+				"managedFields": []interface{}{
+					map[string]interface{}{"manager": "some-operator", "operation": "Update"},
+				},
+			},
+		},
+	}
+	testOperatorConfigMap.SetGroupVersionKind(utils.ConfigMapGVK)
+
 	// Common test namespace object (cluster-scoped).
 	testNamespace := &unstructured.Unstructured{
 		Object: map[string]interface{}{
@@ -473,6 +505,41 @@ func TestGatherSelectedResource(t *testing.T) {
 	}
 	kubeSystemNamespace.SetGroupVersionKind(utils.NamespaceGVK)
 
+	// Common test cluster role object (cluster-scoped) that declares its dependents.
+	testOwnerClusterRole := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "rbac.authorization.k8s.io/v1",
+			"kind":       "ClusterRole",
+			"metadata": map[string]interface{}{
+				"name": "operator-cluster-role",
+				"annotations": map[string]interface{}{
+					fleetv1beta1.DependentNamespacesAnnotation: "test-ns, non-existent-ns",
+					fleetv1beta1.DependentResourcesAnnotation:  "ClusterRole.v1.rbac.authorization.k8s.io/test-cluster-role,ClusterRole.v1.rbac.authorization.k8s.io/non-existent-role",
+				},
+			},
+		},
+	}
+	testOwnerClusterRole.SetGroupVersionKind(utils.ClusterRoleGVK)
+
+	dependentsInformerManager := func(ownerClusterRole *unstructured.Unstructured) *testinformer.FakeManager {
+		return &testinformer.FakeManager{
+			IsClusterScopedResource: true,
+			APIResources: map[schema.GroupVersionKind]bool{
+				utils.NamespaceGVK:   true,  // cluster-scoped
+				utils.ClusterRoleGVK: true,  // cluster-scoped
+				utils.DeploymentGVK:  false, // namespace-scoped
+				utils.ConfigMapGVK:   false, // namespace-scoped
+			},
+			Listers: map[schema.GroupVersionResource]*testinformer.FakeLister{
+				utils.NamespaceGVR:   {Objects: []runtime.Object{testNamespace, kubeSystemNamespace}},
+				utils.ClusterRoleGVR: {Objects: []runtime.Object{ownerClusterRole, testClusterRole, testClusterRole2}},
+				utils.DeploymentGVR:  {Objects: []runtime.Object{testDeployment}},
+				utils.ConfigMapGVR:   {Objects: []runtime.Object{testConfigMap}},
+			},
+			NamespaceScopedResources: []schema.GroupVersionResource{utils.DeploymentGVR, utils.ConfigMapGVR},
+		}
+	}
+
 	tests := []struct {
 		name            string
 		placementName   types.NamespacedName
@@ -688,6 +755,51 @@ func TestGatherSelectedResource(t *testing.T) {
 			want:      []*unstructured.Unstructured{testFrontendDeployment},
 			wantError: nil,
 		},
+		{
+			name:          "should select only the resources managed by the field manager",
+			placementName: types.NamespacedName{Name: "test-placement", Namespace: "test-ns"},
+			selectors: []fleetv1beta1.ResourceSelectorTerm{
+				{
+					Group:        "apps",
+					Version:      "v1",
+					Kind:         "Deployment",
+					FieldManager: "ci-pipeline",
+				},
+			},
+			resourceConfig: utils.NewResourceConfig(false), // default deny list
+			informerManager: func() *testinformer.FakeManager {
+				return &testinformer.FakeManager{
+					IsClusterScopedResource: true,
+					Listers: map[schema.GroupVersionResource]*testinformer.FakeLister{
+						utils.DeploymentGVR: {Objects: []runtime.Object{testCIDeployment, testFrontendDeployment}},
+					},
+				}
+			}(),
+			want: []*unstructured.Unstructured{testCIDeployment},
+		},
+		{
+			name:          "should return empty result when the resource selected by name is not managed by the field manager",
+			placementName: types.NamespacedName{Name: "test-placement", Namespace: "test-ns"},
+			selectors: []fleetv1beta1.ResourceSelectorTerm{
+				{
+					Group:        "apps",
+					Version:      "v1",
+					Kind:         "Deployment",
+					Name:         "frontend-deployment",
+					FieldManager: "ci-pipeline",
+				},
+			},
+			resourceConfig: utils.NewResourceConfig(false), // default deny list
+			informerManager: func() *testinformer.FakeManager {
+				return &testinformer.FakeManager{
+					IsClusterScopedResource: true,
+					Listers: map[schema.GroupVersionResource]*testinformer.FakeLister{
+						utils.DeploymentGVR: {Objects: []runtime.Object{testCIDeployment, testFrontendDeployment}},
+					},
+				}
+			}(),
+			want: nil,
+		},
 		{
 			name:          "should handle label selector with MatchExpressions",
 			placementName: types.NamespacedName{Name: "test-placement", Namespace: "test-ns"},
@@ -889,6 +1001,34 @@ func TestGatherSelectedResource(t *testing.T) {
 			// Should select only non-reserved namespaces with matching labels and their children resources
 			want: []*unstructured.Unstructured{testNamespace, testConfigMap, testDeployment},
 		},
+		{
+			name:          "should select namespaces and only their children resources managed by the field manager for cluster scoped placement",
+			placementName: types.NamespacedName{Name: "test-placement"},
+			selectors: []fleetv1beta1.ResourceSelectorTerm{
+				{
+					Group:          "",
+					Version:        "v1",
+					Kind:           "Namespace",
+					Name:           "test-ns",
+					SelectionScope: fleetv1beta1.NamespaceWithResources,
+					FieldManager:   "ci-pipeline",
+				},
+			},
+			resourceConfig: utils.NewResourceConfig(false), // default deny list
+			informerManager: func() *testinformer.FakeManager {
+				return &testinformer.FakeManager{
+					IsClusterScopedResource: false,
+					Listers: map[schema.GroupVersionResource]*testinformer.FakeLister{
+						utils.NamespaceGVR:  {Objects: []runtime.Object{testNamespace}},
+						utils.DeploymentGVR: {Objects: []runtime.Object{testCIDeployment, testDeployment}},
+						utils.ConfigMapGVR:  {Objects: []runtime.Object{testOperatorConfigMap, testConfigMap}},
+					},
+					NamespaceScopedResources: []schema.GroupVersionResource{utils.DeploymentGVR, utils.ConfigMapGVR},
+				}
+			}(),
+			// The namespace itself is selected even though it is not managed by the field manager.
+			want: []*unstructured.Unstructured{testNamespace, testCIDeployment},
+		},
 		{
 			name:          "should skip the resource for cluster scoped placement",
 			placementName: types.NamespacedName{Name: "test-placement"},
@@ -1524,6 +1664,101 @@ func TestGatherSelectedResource(t *testing.T) {
 			// Should error because no namespaces match the selector
 			wantError: ErrUserError,
 		},
+		{
+			name:          "should select the declared dependents of a cluster-scoped resource with IncludeDependents",
+			placementName: types.NamespacedName{Name: "test-placement"},
+			selectors: []fleetv1beta1.ResourceSelectorTerm{
+				{
+					Group:             "rbac.authorization.k8s.io",
+					Version:           "v1",
+					Kind:              "ClusterRole",
+					Name:              "operator-cluster-role",
+					IncludeDependents: true,
+				},
+			},
+			resourceConfig:  utils.NewResourceConfig(false), // default deny list
+			informerManager: dependentsInformerManager(testOwnerClusterRole),
+			// Should select the cluster role, the dependent namespace with its resources, and the dependent cluster role;
+			// the dependents that do not exist are skipped.
+			want: []*unstructured.Unstructured{testNamespace, testConfigMap, testOwnerClusterRole, testClusterRole, testDeployment},
+		},
+		{
+			name:          "should not select the declared dependents of a cluster-scoped resource without IncludeDependents",
+			placementName: types.NamespacedName{Name: "test-placement"},
+			selectors: []fleetv1beta1.ResourceSelectorTerm{
+				{
+					Group:   "rbac.authorization.k8s.io",
+					Version: "v1",
+					Kind:    "ClusterRole",
+					Name:    "operator-cluster-role",
+				},
+			},
+			resourceConfig:  utils.NewResourceConfig(false), // default deny list
+			informerManager: dependentsInformerManager(testOwnerClusterRole),
+			want:            []*unstructured.Unstructured{testOwnerClusterRole},
+		},
+		{
+			name:          "should not report the dependents that are also selected directly as duplicates",
+			placementName: types.NamespacedName{Name: "test-placement"},
+			selectors: []fleetv1beta1.ResourceSelectorTerm{
+				{
+					Group:   "",
+					Version: "v1",
+					Kind:    "Namespace",
+					Name:    "test-ns",
+				},
+				{
+					Group:             "rbac.authorization.k8s.io",
+					Version:           "v1",
+					Kind:              "ClusterRole",
+					LabelSelector:     &metav1.LabelSelector{},
+					IncludeDependents: true,
+				},
+			},
+			resourceConfig:  utils.NewResourceConfig(false), // default deny list
+			informerManager: dependentsInformerManager(testOwnerClusterRole),
+			want:            []*unstructured.Unstructured{testNamespace, testConfigMap, testOwnerClusterRole, testClusterRole, testClusterRole2, testDeployment},
+		},
+		{
+			name:          "should return error when a dependent namespace is not allowed to propagate",
+			placementName: types.NamespacedName{Name: "test-placement"},
+			selectors: []fleetv1beta1.ResourceSelectorTerm{
+				{
+					Group:             "rbac.authorization.k8s.io",
+					Version:           "v1",
+					Kind:              "ClusterRole",
+					Name:              "operator-cluster-role",
+					IncludeDependents: true,
+				},
+			},
+			resourceConfig: utils.NewResourceConfig(false), // default deny list
+			informerManager: func() *testinformer.FakeManager {
+				ownerClusterRole := testOwnerClusterRole.DeepCopy()
+				ownerClusterRole.SetAnnotations(map[string]string{fleetv1beta1.DependentNamespacesAnnotation: "kube-system"})
+				return dependentsInformerManager(ownerClusterRole)
+			}(),
+			wantError: ErrUserError,
+		},
+		{
+			name:          "should return error when a dependent resource is malformed",
+			placementName: types.NamespacedName{Name: "test-placement"},
+			selectors: []fleetv1beta1.ResourceSelectorTerm{
+				{
+					Group:             "rbac.authorization.k8s.io",
+					Version:           "v1",
+					Kind:              "ClusterRole",
+					Name:              "operator-cluster-role",
+					IncludeDependents: true,
+				},
+			},
+			resourceConfig: utils.NewResourceConfig(false), // default deny list
+			informerManager: func() *testinformer.FakeManager {
+				ownerClusterRole := testOwnerClusterRole.DeepCopy()
+				ownerClusterRole.SetAnnotations(map[string]string{fleetv1beta1.DependentResourcesAnnotation: "ConfigMap/test-configmap"})
+				return dependentsInformerManager(ownerClusterRole)
+			}(),
+			wantError: ErrUserError,
+		},
 	}
 
 	for _, tt := range tests {
@@ -1548,6 +1783,75 @@ func TestGatherSelectedResource(t *testing.T) {
 	}
 }
 
+func TestParseDependentResource(t *testing.T) {
+	tests := []struct {
+		name          string
+		entry         string
+		wantSelector  fleetv1beta1.ResourceSelectorTerm
+		wantNamespace string
+		wantErr       bool
+	}{
+		{
+			name:  "cluster-scoped resource",
+			entry: "ClusterRole.v1.rbac.authorization.k8s.io/admin",
+			wantSelector: fleetv1beta1.ResourceSelectorTerm{
+				Group:   "rbac.authorization.k8s.io",
+				Version: "v1",
+				Kind:    "ClusterRole",
+				Name:    "admin",
+			},
+		},
+		{
+			name:  "namespace-scoped resource in the core API group",
+			entry: "ConfigMap.v1/operator-system/operator-config",
+			wantSelector: fleetv1beta1.ResourceSelectorTerm{
+				Version: "v1",
+				Kind:    "ConfigMap",
+				Name:    "operator-config",
+			},
+			wantNamespace: "operator-system",
+		},
+		{
+			name:    "missing version",
+			entry:   "ConfigMap/operator-config",
+			wantErr: true,
+		},
+		{
+			name:    "missing name",
+			entry:   "ClusterRole.v1.rbac.authorization.k8s.io/",
+			wantErr: true,
+		},
+		{
+			name:    "missing namespace",
+			entry:   "ConfigMap.v1//operator-config",
+			wantErr: true,
+		},
+		{
+			name:    "too many segments",
+			entry:   "ConfigMap.v1/a/b/c",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotSelector, gotNamespace, err := parseDependentResource(tt.entry)
+			if gotErr := err != nil; gotErr != tt.wantErr {
+				t.Fatalf("parseDependentResource() = %v, want error %t", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tt.wantSelector, gotSelector); diff != "" {
+				t.Errorf("parseDependentResource() selector mismatch (-want +got):\n%s", diff)
+			}
+			if gotNamespace != tt.wantNamespace {
+				t.Errorf("parseDependentResource() namespace = %q, want %q", gotNamespace, tt.wantNamespace)
+			}
+		})
+	}
+}
+
 // fakeRESTMapper is a minimal RESTMapper implementation for testing
 type fakeRESTMapper struct {
 	mappings map[schema.GroupKind]*meta.RESTMapping
@@ -2418,6 +2722,162 @@ func TestGatherSelectedResource_ErrorCases(t *testing.T) {
 	}
 }
 
+func TestResolveEnvelopeSecretRefs(t *testing.T) {
+	newEnvelope := func(secretRefs map[string]interface{}) *unstructured.Unstructured {
+		obj := &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": fleetv1beta1.GroupVersion.String(),
+				"kind":       fleetv1beta1.ResourceEnvelopeKind,
+				"metadata": map[string]interface{}{
+					"name":      "test-envelope",
+					"namespace": "test-ns",
+				},
+				"data": map[string]interface{}{
+					"cm.yaml": map[string]interface{}{
+						"apiVersion": "v1",
+						"kind":       "ConfigMap",
+						"metadata":   map[string]interface{}{"name": "test-cm"},
+					},
+				},
+			},
+		}
+		if secretRefs != nil {
+			obj.Object["secretRefs"] = secretRefs
+		}
+		return obj
+	}
+	testSecret := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": "v1",
+			"kind":       "Secret",
+			"metadata": map[string]interface{}{
+				"name":      "test-secret",
+				"namespace": "test-ns",
+				"annotations": map[string]interface{}{
+					fleetv1beta1.EnvelopeSecretRefAllowedEnvelopesAnnotation: "other-envelope, test-envelope",
+				},
+			},
+			"data": map[string]interface{}{
+				"db.yaml": base64.StdEncoding.EncodeToString([]byte("apiVersion: v1\nkind: Secret\nmetadata:\n  name: db-credentials\nstringData:\n  password: p@ss\n")),
+				"empty":   "",
+			},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		envelope   *unstructured.Unstructured
+		secrets    []runtime.Object
+		want       *unstructured.Unstructured
+		wantError  error
+		wantErrMsg string
+	}{
+		{
+			name:     "no secret references",
+			envelope: newEnvelope(nil),
+			want:     newEnvelope(nil),
+		},
+		{
+			name: "secret reference resolved",
+			envelope: newEnvelope(map[string]interface{}{
+				"db.yaml": map[string]interface{}{"name": "test-secret", "key": "db.yaml"},
+			}),
+			secrets: []runtime.Object{testSecret},
+			want: func() *unstructured.Unstructured {
+				obj := newEnvelope(nil)
+				obj.Object["data"].(map[string]interface{})["db.yaml"] = map[string]interface{}{
+					"apiVersion": "v1",
+					"kind":       "Secret",
+					"metadata":   map[string]interface{}{"name": "db-credentials"},
+					"stringData": map[string]interface{}{"password": "p@ss"},
+				}
+				return obj
+			}(),
+		},
+		{
+			name: "secret not found",
+			envelope: newEnvelope(map[string]interface{}{
+				"db.yaml": map[string]interface{}{"name": "other-secret", "key": "db.yaml"},
+			}),
+			secrets:    []runtime.Object{testSecret},
+			wantError:  ErrUserError,
+			wantErrMsg: "secret other-secret referenced by manifest db.yaml of resource envelope test-ns/test-envelope is not found or does not allow the envelope to reference it",
+		},
+		{
+			name: "secret not opted in",
+			envelope: newEnvelope(map[string]interface{}{
+				"db.yaml": map[string]interface{}{"name": "test-secret", "key": "db.yaml"},
+			}),
+			secrets: []runtime.Object{func() *unstructured.Unstructured {
+				secret := testSecret.DeepCopy()
+				secret.SetAnnotations(nil)
+				return secret
+			}()},
+			wantError:  ErrUserError,
+			wantErrMsg: "secret test-secret referenced by manifest db.yaml of resource envelope test-ns/test-envelope is not found or does not allow the envelope to reference it",
+		},
+		{
+			name: "secret allows other envelopes only",
+			envelope: newEnvelope(map[string]interface{}{
+				"db.yaml": map[string]interface{}{"name": "test-secret", "key": "db.yaml"},
+			}),
+			secrets: []runtime.Object{func() *unstructured.Unstructured {
+				secret := testSecret.DeepCopy()
+				secret.SetAnnotations(map[string]string{fleetv1beta1.EnvelopeSecretRefAllowedEnvelopesAnnotation: "test-envelope-2"})
+				return secret
+			}()},
+			wantError:  ErrUserError,
+			wantErrMsg: "does not allow the envelope to reference it",
+		},
+		{
+			name: "key not found",
+			envelope: newEnvelope(map[string]interface{}{
+				"db.yaml": map[string]interface{}{"name": "test-secret", "key": "other.yaml"},
+			}),
+			secrets:    []runtime.Object{testSecret},
+			wantError:  ErrUserError,
+			wantErrMsg: "key other.yaml is not found in secret test-secret",
+		},
+		{
+			name: "empty manifest",
+			envelope: newEnvelope(map[string]interface{}{
+				"db.yaml": map[string]interface{}{"name": "test-secret", "key": "empty"},
+			}),
+			secrets:    []runtime.Object{testSecret},
+			wantError:  ErrUserError,
+			wantErrMsg: "is not a valid manifest",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rsr := &ResourceSelectorResolver{
+				ResourceConfig: utils.NewResourceConfig(false),
+				InformerManager: &testinformer.FakeManager{
+					Listers: map[schema.GroupVersionResource]*testinformer.FakeLister{
+						utils.SecretGVR: {Objects: tt.secrets},
+					},
+				},
+				RestMapper: newFakeRESTMapper(),
+			}
+
+			got, err := rsr.resolveEnvelopeSecretRefs(tt.envelope)
+			if gotErr, wantErr := err != nil, tt.wantError != nil; gotErr != wantErr || !errors.Is(err, tt.wantError) {
+				t.Fatalf("resolveEnvelopeSecretRefs() error = %v, wantError %v", err, tt.wantError)
+			}
+			if tt.wantError != nil {
+				if !strings.Contains(err.Error(), tt.wantErrMsg) {
+					t.Errorf("resolveEnvelopeSecretRefs() error = %v, wantErrMsg %v", err, tt.wantErrMsg)
+				}
+				return
+			}
+			if diff := cmp.Diff(tt.want, got); diff != "" {
+				t.Errorf("resolveEnvelopeSecretRefs() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 func TestShouldPropagateObj(t *testing.T) {
 	tests := []struct {
 		name            string
@@ -2654,6 +3114,33 @@ func TestShouldPropagateObj(t *testing.T) {
 			},
 			want: false,
 		},
+		{
+			name: "scheduling cycle snapshot configmap should NOT propagate",
+			obj: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]interface{}{
+					"name":      "rp-test-rp-scheduling-cycles",
+					"namespace": "default",
+					"labels": map[string]interface{}{
+						fleetv1beta1.SchedulingCycleSnapshotsLabel: "true",
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name: "user configmap should propagate",
+			obj: map[string]interface{}{
+				"apiVersion": "v1",
+				"kind":       "ConfigMap",
+				"metadata": map[string]interface{}{
+					"name":      "test-configmap",
+					"namespace": "default",
+				},
+			},
+			want: true,
+		},
 	}
 
 	for _, tt := range tests {
@@ -21,6 +21,7 @@ import (
 	"fmt"
 	"sort"
 	"strconv"
+	"sync"
 	"time"
 
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
@@ -53,6 +54,12 @@ type ResourceSnapshotResolver struct {
 	// Config provides configuration functions for snapshot behavior.
 	// If nil, default behavior (no timing restrictions) is used.
 	Config *ResourceSnapshotConfig
+
+	// EnableContentDeduplication, if set, makes the resolver keep the contents of the selected resources in
+	// content-addressed ClusterResourceContent objects, which are shared across placements, and have the new
+	// resource snapshots refer to them by name instead of keeping copies of the contents. It applies to the
+	// (cluster-scoped) resource snapshots of ClusterResourcePlacements only.
+	EnableContentDeduplication bool
 }
 
 // NewResourceSnapshotResolver creates a new ResourceSnapshotResolver with the universal fields
@@ -64,7 +71,12 @@ func NewResourceSnapshotResolver(client client.Client, scheme *runtime.Scheme) R
 }
 
 // ResourceSnapshotConfig defines timing parameters for resource snapshot management.
+//
+// The parameters can be updated at runtime with the Update method; do not modify the fields directly
+// once the config is in use.
 type ResourceSnapshotConfig struct {
+	mu sync.RWMutex
+
 	// ResourceSnapshotCreationMinimumInterval is the minimum interval to create a new resourcesnapshot
 	// to avoid too frequent updates.
 	ResourceSnapshotCreationMinimumInterval time.Duration
@@ -81,6 +93,21 @@ func NewResourceSnapshotConfig(creationInterval, collectionDuration time.Duratio
 	}
 }
 
+// Update sets the timing parameters in use.
+func (c *ResourceSnapshotConfig) Update(creationInterval, collectionDuration time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.ResourceSnapshotCreationMinimumInterval = creationInterval
+	c.ResourceChangesCollectionDuration = collectionDuration
+}
+
+// intervals returns the minimum creation interval and the changes collection duration in use.
+func (c *ResourceSnapshotConfig) intervals() (time.Duration, time.Duration) {
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	return c.ResourceSnapshotCreationMinimumInterval, c.ResourceChangesCollectionDuration
+}
+
 // GetOrCreateResourceSnapshot gets or creates a resource snapshot for the given placement.
 // It returns the latest resource snapshot if it exists and is up to date, otherwise it creates a new one.
 // It also returns the ctrl.Result to indicate whether the request should be requeued or not.
@@ -148,7 +175,7 @@ func (r *ResourceSnapshotResolver) GetOrCreateResourceSnapshot(ctx context.Conte
 	if latestResourceSnapshot != nil && latestResourceSnapshotHash != resourceHash && latestResourceSnapshot.GetLabels()[fleetv1beta1.IsLatestSnapshotLabel] == strconv.FormatBool(true) {
 		// When the latest resource snapshot without the isLastest label, it means it fails to create the new
 		// resource snapshot in the last reconcile and we don't need to check and delay the request.
-		res, error := r.shouldCreateNewResourceSnapshotNow(ctx, latestResourceSnapshot)
+		res, error := r.shouldCreateNewResourceSnapshotNow(ctx, placement, latestResourceSnapshot)
 		if error != nil {
 			return ctrl.Result{}, nil, error
 		}
@@ -341,11 +368,19 @@ func (r *ResourceSnapshotResolver) ensureLatestResourceSnapshot(ctx context.Cont
 }
 
 // shouldCreateNewResourceSnapshotNow checks whether it is ready to create the new resource snapshot to avoid too frequent creation
-// based on the configured resourceSnapshotCreationMinimumInterval and resourceChangesCollectionDuration.
-func (r *ResourceSnapshotResolver) shouldCreateNewResourceSnapshotNow(ctx context.Context, latestResourceSnapshot fleetv1beta1.ResourceSnapshotObj) (ctrl.Result, error) {
-	// If Config is nil (no restrictions) or both intervals are non-positive (effectively disabled),
-	// there is no delay needed — create immediately.
-	if r.Config == nil || (r.Config.ResourceSnapshotCreationMinimumInterval <= 0 && r.Config.ResourceChangesCollectionDuration <= 0) {
+// based on the configured resourceSnapshotCreationMinimumInterval and resourceChangesCollectionDuration, as well as the
+// minimum snapshot interval of the placement.
+func (r *ResourceSnapshotResolver) shouldCreateNewResourceSnapshotNow(ctx context.Context, placement fleetv1beta1.PlacementObj, latestResourceSnapshot fleetv1beta1.ResourceSnapshotObj) (ctrl.Result, error) {
+	// If Config is nil (no restrictions), there is no delay needed — create immediately.
+	if r.Config == nil {
+		return ctrl.Result{}, nil
+	}
+	creationMinimumInterval, collectionDuration := r.Config.intervals()
+	if placementInterval := placement.GetPlacementSpec().MinSnapshotIntervalSeconds; placementInterval != nil {
+		creationMinimumInterval = max(creationMinimumInterval, time.Duration(*placementInterval)*time.Second)
+	}
+	// If both intervals are non-positive (effectively disabled), there is no delay needed either.
+	if creationMinimumInterval <= 0 && collectionDuration <= 0 {
 		return ctrl.Result{}, nil
 	}
 
@@ -369,12 +404,12 @@ func (r *ResourceSnapshotResolver) shouldCreateNewResourceSnapshotNow(ctx contex
 		nextResourceSnapshotCandidateDetectionTime = now
 		klog.V(2).InfoS("Updated the NextResourceSnapshotCandidateDetectionTime annotation", "resourceSnapshot", snapshotKObj, "nextResourceSnapshotCandidateDetectionTimeAnnotation", now.Format(time.RFC3339))
 	}
-	nextCreationTime := fleettime.MaxTime(nextResourceSnapshotCandidateDetectionTime.Add(r.Config.ResourceChangesCollectionDuration), latestResourceSnapshot.GetCreationTimestamp().Add(r.Config.ResourceSnapshotCreationMinimumInterval))
+	nextCreationTime := fleettime.MaxTime(nextResourceSnapshotCandidateDetectionTime.Add(collectionDuration), latestResourceSnapshot.GetCreationTimestamp().Add(creationMinimumInterval))
 	if now.Before(nextCreationTime) {
 		// If the next resource snapshot creation time is not reached, we requeue the request to avoid too frequent update.
 		klog.V(2).InfoS("Delaying the new resourceSnapshot creation",
 			"resourceSnapshot", snapshotKObj, "nextCreationTime", nextCreationTime, "latestResourceSnapshotCreationTime", latestResourceSnapshot.GetCreationTimestamp(),
-			"resourceSnapshotCreationMinimumInterval", r.Config.ResourceSnapshotCreationMinimumInterval, "resourceChangesCollectionDuration", r.Config.ResourceChangesCollectionDuration,
+			"resourceSnapshotCreationMinimumInterval", creationMinimumInterval, "resourceChangesCollectionDuration", collectionDuration,
 			"afterDuration", nextCreationTime.Sub(now))
 		return ctrl.Result{RequeueAfter: nextCreationTime.Sub(now)}, nil
 	}
@@ -399,6 +434,15 @@ func (r *ResourceSnapshotResolver) deleteRedundantResourceSnapshots(ctx context.
 	placementKObj := klog.KObj(placementObj)
 	lastGroupIndex := -1
 	groupCounter := 0
+	// The resource snapshot that a rolled back placement is pinned to is always kept, and does not count
+	// towards the limit.
+	pinnedIndex := -1
+	if v, pinned := placementObj.GetAnnotations()[fleetv1beta1.PinnedResourceSnapshotIndexAnnotation]; pinned {
+		if pinnedIndex, err = strconv.Atoi(v); err != nil {
+			klog.ErrorS(err, "The placement is pinned to an invalid resource snapshot index", "placement", placementKObj, "resourceSnapshotIndex", v)
+			pinnedIndex = -1
+		}
+	}
 
 	// delete the snapshots from the end as there are could be multiple snapshots in a group in order to keep the latest
 	// snapshots from the end.
@@ -409,6 +453,9 @@ func (r *ResourceSnapshotResolver) deleteRedundantResourceSnapshots(ctx context.
 			klog.ErrorS(err, "Failed to parse the resource index label", "placement", placementKObj, "resourceSnapshot", snapshotKObj)
 			return NewUnexpectedBehaviorError(err)
 		}
+		if ii == pinnedIndex {
+			continue
+		}
 		if ii != lastGroupIndex {
 			groupCounter++
 			lastGroupIndex = ii
@@ -439,6 +486,13 @@ func (r *ResourceSnapshotResolver) createResourceSnapshot(ctx context.Context, p
 		// should never happen
 		return NewUnexpectedBehaviorError(err)
 	}
+	// The contents of namespaced resource snapshots are never kept in the cluster-scoped ClusterResourceContent
+	// objects, so that they stay in the namespace of their placement.
+	if r.EnableContentDeduplication && resourceSnapshot.GetNamespace() == "" {
+		if err := r.deduplicateResourceSnapshotContents(ctx, resourceSnapshot); err != nil {
+			return err
+		}
+	}
 	if err := r.Client.Create(ctx, resourceSnapshot); err != nil {
 		klog.ErrorS(err, "Failed to create new resourceSnapshot", "resourceSnapshot", resourceSnapshotKObj)
 		return NewAPIServerError(false, err)
@@ -486,6 +540,12 @@ func FetchAllResourceSnapshotsAlongWithMaster(ctx context.Context, k8Client clie
 		}
 	}
 
+	for _, resourceSnapshot := range resourceSnapshots {
+		if err := ResolveResourceSnapshotContents(ctx, k8Client, resourceSnapshot); err != nil {
+			return nil, err
+		}
+	}
+
 	// check if all the resource snapshots are created since that may take a while but the rollout controller may update the resource binding on master snapshot creation
 	if len(resourceSnapshots) != snapshotCount {
 		misMatchErr := fmt.Errorf("%w: resource snapshots are still being created for the masterResourceSnapshot %s, total snapshot in the index group = %d, num Of existing snapshot in the group= %d, placement = %s",
@@ -526,6 +586,23 @@ func FetchLatestMasterResourceSnapshot(ctx context.Context, k8Client client.Read
 	return masterResourceSnapshot, nil
 }
 
+// FetchMasterResourceSnapshotWithAnIndex fetches the master ResourceSnapshot of the given index for a given
+// placement key; it returns nil if the resource snapshot does not exist.
+func FetchMasterResourceSnapshotWithAnIndex(ctx context.Context, k8Client client.Reader, resourceSnapshotIndex string, placementKey types.NamespacedName) (fleetv1beta1.ResourceSnapshotObj, error) {
+	resourceSnapshotList, err := ListAllResourceSnapshotWithAnIndex(ctx, k8Client, resourceSnapshotIndex, placementKey.Name, placementKey.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	for _, resourceSnapshot := range resourceSnapshotList.GetResourceSnapshotObjs() {
+		// only master has this annotation
+		if len(resourceSnapshot.GetAnnotations()[fleetv1beta1.ResourceGroupHashAnnotation]) != 0 {
+			return resourceSnapshot, nil
+		}
+	}
+	klog.V(2).InfoS("No masterResourceSnapshot found for the placement with the index", "placement", placementKey, "resourceSnapshotIndex", resourceSnapshotIndex)
+	return nil, nil
+}
+
 // ListLatestResourceSnapshots lists the latest resource snapshots associated with a placement key.
 // For cluster-scoped placements, it lists ClusterResourceSnapshots.
 // For namespaced placements, it lists ResourceSnapshots.
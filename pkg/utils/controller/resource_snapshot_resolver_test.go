@@ -1872,6 +1872,72 @@ func TestDeleteResourceSnapshots(t *testing.T) {
 	}
 }
 
+func TestDeleteRedundantResourceSnapshots(t *testing.T) {
+	snapshotWithIndex := func(index int) *fleetv1beta1.ClusterResourceSnapshot {
+		return &fleetv1beta1.ClusterResourceSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, index),
+				Labels: map[string]string{
+					fleetv1beta1.ResourceIndexLabel:     fmt.Sprint(index),
+					fleetv1beta1.PlacementTrackingLabel: testCRPName,
+				},
+			},
+		}
+	}
+	tests := []struct {
+		name        string
+		annotations map[string]string
+		wantNames   []string
+	}{
+		{
+			name:      "placement is not pinned",
+			wantNames: []string{fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, 3)},
+		},
+		{
+			name:        "placement is pinned to a resource snapshot",
+			annotations: map[string]string{fleetv1beta1.PinnedResourceSnapshotIndexAnnotation: "0"},
+			wantNames: []string{
+				fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, 0),
+				fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, 3),
+			},
+		},
+		{
+			name:        "placement is pinned to an invalid resource snapshot index",
+			annotations: map[string]string{fleetv1beta1.PinnedResourceSnapshotIndexAnnotation: "invalid"},
+			wantNames:   []string{fmt.Sprintf(fleetv1beta1.ResourceSnapshotNameFmt, testCRPName, 3)},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			crp := clusterResourcePlacementForTest()
+			crp.Annotations = tc.annotations
+			objects := []client.Object{crp}
+			for i := 0; i < 4; i++ {
+				objects = append(objects, snapshotWithIndex(i))
+			}
+			scheme := serviceScheme(t)
+			fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build()
+			resolver := NewResourceSnapshotResolver(fakeClient, scheme)
+			if err := resolver.deleteRedundantResourceSnapshots(ctx, crp, 2); err != nil {
+				t.Fatalf("deleteRedundantResourceSnapshots() = %v, want no error", err)
+			}
+
+			var snapshotList fleetv1beta1.ClusterResourceSnapshotList
+			if err := fakeClient.List(ctx, &snapshotList); err != nil {
+				t.Fatalf("List() = %v, want no error", err)
+			}
+			gotNames := make([]string, 0, len(snapshotList.Items))
+			for i := range snapshotList.Items {
+				gotNames = append(gotNames, snapshotList.Items[i].Name)
+			}
+			if diff := cmp.Diff(tc.wantNames, gotNames, cmpopts.SortSlices(func(a, b string) bool { return a < b })); diff != "" {
+				t.Errorf("deleteRedundantResourceSnapshots() remaining snapshots mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
 // errorClient is a mock client that returns errors on List operations
 type errorClient struct {
 	client.Client
@@ -3941,6 +4007,7 @@ func TestShouldCreateNewResourceSnapshotNow(t *testing.T) {
 		nilConfig          bool
 		creationInterval   time.Duration
 		collectionDuration time.Duration
+		placementInterval  *int32
 		creationTime       time.Time
 		annotationValue    string
 		wantAnnoation      bool
@@ -4013,6 +4080,31 @@ func TestShouldCreateNewResourceSnapshotNow(t *testing.T) {
 			wantAnnoation:      true,
 			wantRequeue:        ctrl.Result{RequeueAfter: 60 * time.Second},
 		},
+		{
+			name:               "placement minimum snapshot interval is longer than the creation interval",
+			creationInterval:   60 * time.Second,
+			collectionDuration: 30 * time.Second,
+			placementInterval:  ptr.To(int32(600)),
+			creationTime:       now.Add(-100 * time.Second),
+			wantAnnoation:      true,
+			wantRequeue:        ctrl.Result{RequeueAfter: 500 * time.Second},
+		},
+		{
+			name:               "placement minimum snapshot interval is shorter than the creation interval",
+			creationInterval:   300 * time.Second,
+			collectionDuration: 30 * time.Second,
+			placementInterval:  ptr.To(int32(60)),
+			creationTime:       now.Add(-100 * time.Second),
+			wantAnnoation:      true,
+			wantRequeue:        ctrl.Result{RequeueAfter: 200 * time.Second},
+		},
+		{
+			name:              "placement minimum snapshot interval with both intervals of the hub agent disabled",
+			placementInterval: ptr.To(int32(120)),
+			creationTime:      now.Add(-20 * time.Second),
+			wantAnnoation:     true,
+			wantRequeue:       ctrl.Result{RequeueAfter: 100 * time.Second},
+		},
 	}
 
 	for _, tc := range cases {
@@ -4045,7 +4137,10 @@ func TestShouldCreateNewResourceSnapshotNow(t *testing.T) {
 			if err := client.Get(ctx, types.NamespacedName{Name: snapshot.Name}, snapshot); err != nil {
 				t.Fatalf("Failed to get snapshot: %v", err)
 			}
-			got, err := resolver.shouldCreateNewResourceSnapshotNow(ctx, snapshot)
+			placement := &fleetv1beta1.ClusterResourcePlacement{
+				Spec: fleetv1beta1.PlacementSpec{MinSnapshotIntervalSeconds: tc.placementInterval},
+			}
+			got, err := resolver.shouldCreateNewResourceSnapshotNow(ctx, placement, snapshot)
 			if err != nil {
 				t.Fatalf("shouldCreateNewResourceSnapshotNow() failed: %v", err)
 			}
@@ -0,0 +1,63 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// PatchStatus writes the status changes made to an object since the given original copy of it with
+// a JSON merge patch on the status subresource; it returns false if the status has not changed, in
+// which case no request is sent.
+//
+// The patch carries the changed status fields only, so that the unchanged fields are not re-sent, along
+// with the resource version of the original copy. A merge patch replaces lists as a whole; without the
+// resource version, a patch computed from a stale copy would silently overwrite the list entries that
+// other writers have added since (e.g., the agent statuses of a member cluster). With it, the patch fails
+// with a conflict, as an update would, and the caller should re-read the object and try again.
+func PatchStatus(ctx context.Context, c client.Client, obj, original client.Object) (bool, error) {
+	data, err := client.MergeFromWithOptions(original, client.MergeFromWithOptimisticLock{}).Data(obj)
+	if err != nil {
+		return false, NewUnexpectedBehaviorError(fmt.Errorf("failed to compute the status patch: %w", err))
+	}
+	var patch map[string]json.RawMessage
+	if err := json.Unmarshal(data, &patch); err != nil {
+		return false, NewUnexpectedBehaviorError(fmt.Errorf("failed to decode the status patch: %w", err))
+	}
+	status, found := patch["status"]
+	if !found {
+		return false, nil
+	}
+	// Drop the changes outside the status (e.g., the labels), which the status subresource ignores
+	// anyway, except for the resource version.
+	data, err = json.Marshal(map[string]interface{}{
+		"metadata": map[string]string{"resourceVersion": original.GetResourceVersion()},
+		"status":   status,
+	})
+	if err != nil {
+		return false, NewUnexpectedBehaviorError(fmt.Errorf("failed to encode the status patch: %w", err))
+	}
+	if err := c.Status().Patch(ctx, obj, client.RawPatch(types.MergePatchType, data)); err != nil {
+		return false, err
+	}
+	return true, nil
+}
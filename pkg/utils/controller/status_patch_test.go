@@ -0,0 +1,170 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func TestPatchStatus(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	appliedCondition := metav1.Condition{
+		Type:               string(placementv1beta1.ResourceBindingApplied),
+		Status:             metav1.ConditionTrue,
+		Reason:             "Applied",
+		LastTransitionTime: metav1.Now(),
+	}
+
+	availableCondition := metav1.Condition{
+		Type:               string(placementv1beta1.ResourceBindingAvailable),
+		Status:             metav1.ConditionTrue,
+		Reason:             "Available",
+		LastTransitionTime: metav1.Now(),
+	}
+
+	tests := []struct {
+		name           string
+		mutate         func(binding *placementv1beta1.ClusterResourceBinding)
+		concurrent     func(binding *placementv1beta1.ClusterResourceBinding)
+		wantPatched    bool
+		wantConflict   bool
+		wantConditions []metav1.Condition
+	}{
+		{
+			name: "status changed",
+			mutate: func(binding *placementv1beta1.ClusterResourceBinding) {
+				binding.Status.Conditions = []metav1.Condition{appliedCondition}
+				// The changes outside the status are not sent.
+				binding.Labels = map[string]string{"foo": "bar"}
+			},
+			wantPatched:    true,
+			wantConditions: []metav1.Condition{appliedCondition},
+		},
+		{
+			name: "status changed by another writer since the original copy was read",
+			mutate: func(binding *placementv1beta1.ClusterResourceBinding) {
+				binding.Status.Conditions = []metav1.Condition{appliedCondition}
+			},
+			concurrent: func(binding *placementv1beta1.ClusterResourceBinding) {
+				binding.Status.Conditions = []metav1.Condition{availableCondition}
+			},
+			wantConflict:   true,
+			wantConditions: []metav1.Condition{availableCondition},
+		},
+		{
+			name: "only the metadata changed",
+			mutate: func(binding *placementv1beta1.ClusterResourceBinding) {
+				binding.Labels = map[string]string{"foo": "bar"}
+			},
+		},
+		{
+			name:   "nothing changed",
+			mutate: func(_ *placementv1beta1.ClusterResourceBinding) {},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := context.Background()
+			binding := &placementv1beta1.ClusterResourceBinding{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-binding",
+				},
+			}
+			var gotPatch []byte
+			fakeClient := interceptor.NewClient(
+				fake.NewClientBuilder().WithScheme(scheme).WithObjects(binding).WithStatusSubresource(binding).Build(),
+				interceptor.Funcs{
+					SubResourcePatch: func(ctx context.Context, c client.Client, subResourceName string, obj client.Object, patch client.Patch, opts ...client.SubResourcePatchOption) error {
+						data, err := patch.Data(obj)
+						if err != nil {
+							return err
+						}
+						gotPatch = data
+						return c.SubResource(subResourceName).Patch(ctx, obj, patch, opts...)
+					},
+				},
+			)
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: binding.Name}, binding); err != nil {
+				t.Fatalf("failed to get the binding: %v", err)
+			}
+			original := binding.DeepCopy()
+			if tc.concurrent != nil {
+				latest := binding.DeepCopy()
+				tc.concurrent(latest)
+				if err := fakeClient.Status().Update(ctx, latest); err != nil {
+					t.Fatalf("failed to update the binding status: %v", err)
+				}
+			}
+			tc.mutate(binding)
+
+			gotPatched, err := PatchStatus(ctx, fakeClient, binding, original)
+			if tc.wantConflict {
+				if !apierrors.IsConflict(err) {
+					t.Fatalf("PatchStatus() got error %v, want a conflict", err)
+				}
+			} else if err != nil {
+				t.Fatalf("PatchStatus() got error %v, want no error", err)
+			}
+			if gotPatched != tc.wantPatched {
+				t.Errorf("PatchStatus() = %v, want %v", gotPatched, tc.wantPatched)
+			}
+			if !tc.wantPatched && !tc.wantConflict {
+				if gotPatch != nil {
+					t.Errorf("PatchStatus() sent patch %s, want no request", gotPatch)
+				}
+				return
+			}
+
+			var sent map[string]interface{}
+			if err := json.Unmarshal(gotPatch, &sent); err != nil {
+				t.Fatalf("failed to decode the sent patch: %v", err)
+			}
+			wantMetadata := map[string]interface{}{"resourceVersion": original.ResourceVersion}
+			if _, found := sent["status"]; !found || len(sent) != 2 || !cmp.Equal(sent["metadata"], wantMetadata) {
+				t.Errorf("PatchStatus() sent patch %s, want a patch on the status with the original resource version only", gotPatch)
+			}
+			got := &placementv1beta1.ClusterResourceBinding{}
+			if err := fakeClient.Get(ctx, types.NamespacedName{Name: binding.Name}, got); err != nil {
+				t.Fatalf("failed to get the binding: %v", err)
+			}
+			if diff := cmp.Diff(tc.wantConditions, got.Status.Conditions, cmpopts.EquateApproxTime(time.Second)); diff != "" {
+				t.Errorf("binding conditions mismatch (-want, +got):\n%s", diff)
+			}
+			if len(got.Labels) != 0 {
+				t.Errorf("binding labels = %v, want no labels", got.Labels)
+			}
+		})
+	}
+}
@@ -0,0 +1,101 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"sync"
+)
+
+// WorkerLimiter limits the number of items that a controller processes at the same time. Unlike the
+// number of workers, which is fixed when the controller starts running, the limit can be changed at
+// runtime (e.g., when the hub agent runtime configuration is reloaded).
+type WorkerLimiter struct {
+	mu       sync.Mutex
+	limit    int
+	inFlight int
+	// changed is closed (and replaced) whenever a slot is released or the limit is changed, so that
+	// waiters can re-check if they can proceed.
+	changed chan struct{}
+}
+
+// NewWorkerLimiter returns a WorkerLimiter with the given limit.
+func NewWorkerLimiter(limit int) *WorkerLimiter {
+	return &WorkerLimiter{
+		limit:   limit,
+		changed: make(chan struct{}),
+	}
+}
+
+// acquire blocks until a slot is available or the context is cancelled. A nil limiter does not
+// limit anything.
+func (l *WorkerLimiter) acquire(ctx context.Context) error {
+	if l == nil {
+		return nil
+	}
+	for {
+		l.mu.Lock()
+		if l.inFlight < l.limit {
+			l.inFlight++
+			l.mu.Unlock()
+			return nil
+		}
+		changed := l.changed
+		l.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-changed:
+		}
+	}
+}
+
+// release frees a slot acquired earlier.
+func (l *WorkerLimiter) release() {
+	if l == nil {
+		return
+	}
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.inFlight--
+	l.notifyLocked()
+}
+
+// SetLimit changes the limit; slots that are in use are not affected. The limit is at least 1.
+func (l *WorkerLimiter) SetLimit(limit int) {
+	limit = max(limit, 1)
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if l.limit == limit {
+		return
+	}
+	l.limit = limit
+	l.notifyLocked()
+}
+
+// Limit returns the limit in effect.
+func (l *WorkerLimiter) Limit() int {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	return l.limit
+}
+
+func (l *WorkerLimiter) notifyLocked() {
+	close(l.changed)
+	l.changed = make(chan struct{})
+}
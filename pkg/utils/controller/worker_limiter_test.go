@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controller
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+)
+
+// TestWorkerLimiter tests the WorkerLimiter.
+func TestWorkerLimiter(t *testing.T) {
+	l := NewWorkerLimiter(1)
+	if err := l.acquire(context.Background()); err != nil {
+		t.Fatalf("acquire() = %v, want no error", err)
+	}
+
+	// The limit has been reached; acquire should block until the context expires.
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+	if err := l.acquire(ctx); !errors.Is(err, context.DeadlineExceeded) {
+		t.Fatalf("acquire() = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	// Raising the limit should unblock the waiters.
+	acquired := make(chan error, 1)
+	go func() {
+		acquired <- l.acquire(context.Background())
+	}()
+	l.SetLimit(2)
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatalf("acquire() = %v, want no error", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("acquire() did not return after the limit is raised")
+	}
+
+	// Lowering the limit should block new acquirers until enough slots are released.
+	l.SetLimit(1)
+	go func() {
+		acquired <- l.acquire(context.Background())
+	}()
+	l.release()
+	select {
+	case <-acquired:
+		t.Fatalf("acquire() returned before enough slots are released")
+	case <-time.After(50 * time.Millisecond):
+	}
+	l.release()
+	select {
+	case err := <-acquired:
+		if err != nil {
+			t.Fatalf("acquire() = %v, want no error", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatalf("acquire() did not return after the slots are released")
+	}
+
+	// The limit is at least 1.
+	l.SetLimit(0)
+	if got := l.Limit(); got != 1 {
+		t.Errorf("Limit() = %d, want 1", got)
+	}
+
+	// A nil limiter does not limit anything.
+	var nilLimiter *WorkerLimiter
+	if err := nilLimiter.acquire(context.Background()); err != nil {
+		t.Fatalf("acquire() on a nil limiter = %v, want no error", err)
+	}
+	nilLimiter.release()
+}
@@ -42,8 +42,9 @@ const (
 	DefaultRevisionHistoryLimitValue = 10
 )
 
-// SetPlacementDefaults sets the default values for placement.
+// SetPlacementDefaults sets the default values for placement, per the default profile recorded on it.
 func SetPlacementDefaults(obj fleetv1beta1.PlacementObj) {
+	profile := ProfileFor(obj)
 	spec := obj.GetPlacementSpec()
 	if spec.Policy == nil {
 		spec.Policy = &fleetv1beta1.PlacementPolicy{
@@ -54,7 +55,7 @@ func SetPlacementDefaults(obj fleetv1beta1.PlacementObj) {
 	if spec.Policy.TopologySpreadConstraints != nil {
 		for i := range spec.Policy.TopologySpreadConstraints {
 			if spec.Policy.TopologySpreadConstraints[i].MaxSkew == nil {
-				spec.Policy.TopologySpreadConstraints[i].MaxSkew = ptr.To(int32(DefaultMaxSkewValue))
+				spec.Policy.TopologySpreadConstraints[i].MaxSkew = ptr.To(profile.MaxSkew)
 			}
 			if spec.Policy.TopologySpreadConstraints[i].WhenUnsatisfiable == "" {
 				spec.Policy.TopologySpreadConstraints[i].WhenUnsatisfiable = fleetv1beta1.DoNotSchedule
@@ -79,13 +80,13 @@ func SetPlacementDefaults(obj fleetv1beta1.PlacementObj) {
 			strategy.RollingUpdate = &fleetv1beta1.RollingUpdateConfig{}
 		}
 		if strategy.RollingUpdate.MaxUnavailable == nil {
-			strategy.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromString(DefaultMaxUnavailableValue))
+			strategy.RollingUpdate.MaxUnavailable = ptr.To(intstr.FromString(profile.MaxUnavailable))
 		}
 		if strategy.RollingUpdate.MaxSurge == nil {
-			strategy.RollingUpdate.MaxSurge = ptr.To(intstr.FromString(DefaultMaxSurgeValue))
+			strategy.RollingUpdate.MaxSurge = ptr.To(intstr.FromString(profile.MaxSurge))
 		}
 		if strategy.RollingUpdate.UnavailablePeriodSeconds == nil {
-			strategy.RollingUpdate.UnavailablePeriodSeconds = ptr.To(DefaultUnavailablePeriodSeconds)
+			strategy.RollingUpdate.UnavailablePeriodSeconds = ptr.To(profile.UnavailablePeriodSeconds)
 		}
 	}
 
@@ -93,9 +94,15 @@ func SetPlacementDefaults(obj fleetv1beta1.PlacementObj) {
 		spec.Strategy.ApplyStrategy = &fleetv1beta1.ApplyStrategy{}
 	}
 	SetDefaultsApplyStrategy(spec.Strategy.ApplyStrategy)
+	for i := range spec.Strategy.ApplyStrategyOverrides {
+		if spec.Strategy.ApplyStrategyOverrides[i].ApplyStrategy == nil {
+			spec.Strategy.ApplyStrategyOverrides[i].ApplyStrategy = &fleetv1beta1.ApplyStrategy{}
+		}
+		SetDefaultsApplyStrategy(spec.Strategy.ApplyStrategyOverrides[i].ApplyStrategy)
+	}
 
 	if spec.RevisionHistoryLimit == nil {
-		spec.RevisionHistoryLimit = ptr.To(int32(DefaultRevisionHistoryLimitValue))
+		spec.RevisionHistoryLimit = ptr.To(profile.RevisionHistoryLimit)
 	}
 }
 
@@ -164,6 +164,55 @@ func TestSetDefaultsClusterResourcePlacement(t *testing.T) {
 				},
 			},
 		},
+		"ClusterResourcePlacement with apply strategy overrides": {
+			obj: &fleetv1beta1.ClusterResourcePlacement{
+				Spec: fleetv1beta1.PlacementSpec{
+					Strategy: fleetv1beta1.RolloutStrategy{
+						ApplyStrategyOverrides: []fleetv1beta1.ApplyStrategyOverride{
+							{
+								ClusterSelector: &fleetv1beta1.ClusterSelector{},
+								ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+									Type: fleetv1beta1.ApplyStrategyTypeReportDiff,
+								},
+							},
+						},
+					},
+				},
+			},
+			wantObj: &fleetv1beta1.ClusterResourcePlacement{
+				Spec: fleetv1beta1.PlacementSpec{
+					Policy: &fleetv1beta1.PlacementPolicy{
+						PlacementType: fleetv1beta1.PickAllPlacementType,
+					},
+					Strategy: fleetv1beta1.RolloutStrategy{
+						Type: fleetv1beta1.RollingUpdateRolloutStrategyType,
+						RollingUpdate: &fleetv1beta1.RollingUpdateConfig{
+							MaxUnavailable:           ptr.To(intstr.FromString(DefaultMaxUnavailableValue)),
+							MaxSurge:                 ptr.To(intstr.FromString(DefaultMaxSurgeValue)),
+							UnavailablePeriodSeconds: ptr.To(DefaultUnavailablePeriodSeconds),
+						},
+						ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+							Type:             fleetv1beta1.ApplyStrategyTypeClientSideApply,
+							ComparisonOption: fleetv1beta1.ComparisonOptionTypePartialComparison,
+							WhenToApply:      fleetv1beta1.WhenToApplyTypeAlways,
+							WhenToTakeOver:   fleetv1beta1.WhenToTakeOverTypeAlways,
+						},
+						ApplyStrategyOverrides: []fleetv1beta1.ApplyStrategyOverride{
+							{
+								ClusterSelector: &fleetv1beta1.ClusterSelector{},
+								ApplyStrategy: &fleetv1beta1.ApplyStrategy{
+									Type:             fleetv1beta1.ApplyStrategyTypeReportDiff,
+									ComparisonOption: fleetv1beta1.ComparisonOptionTypePartialComparison,
+									WhenToApply:      fleetv1beta1.WhenToApplyTypeAlways,
+									WhenToTakeOver:   fleetv1beta1.WhenToTakeOverTypeAlways,
+								},
+							},
+						},
+					},
+					RevisionHistoryLimit: ptr.To(int32(DefaultRevisionHistoryLimitValue)),
+				},
+			},
+		},
 	}
 	for name, tt := range tests {
 		t.Run(name, func(t *testing.T) {
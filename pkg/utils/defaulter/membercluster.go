@@ -0,0 +1,26 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaulter
+
+import clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+
+// SetMemberClusterDefaults sets the default values for member cluster, per the default profile recorded on it.
+func SetMemberClusterDefaults(mc *clusterv1beta1.MemberCluster) {
+	if mc.Spec.HeartbeatPeriodSeconds == 0 {
+		mc.Spec.HeartbeatPeriodSeconds = ProfileFor(mc).HeartbeatPeriodSeconds
+	}
+}
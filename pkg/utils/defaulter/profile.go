@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaulter
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	fleetv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// DefaultProfileAnnotation is the annotation that records the version of the default profile
+	// applied to an object.
+	//
+	// The mutating webhooks stamp the latest profile version on objects when they are created, so
+	// that changes to the default values in later profile versions never alter existing objects;
+	// users may opt into a newer profile by updating the annotation.
+	DefaultProfileAnnotation = fleetv1beta1.FleetPrefix + "default-profile"
+
+	// DefaultProfileV1 is the version of the first default profile, which is also the profile
+	// applied to objects created before default profiles were introduced.
+	DefaultProfileV1 = "v1"
+
+	// LatestDefaultProfile is the version of the default profile applied to newly created objects.
+	LatestDefaultProfile = DefaultProfileV1
+
+	// DefaultHeartbeatPeriodSeconds is the default heartbeat period of member clusters.
+	DefaultHeartbeatPeriodSeconds = 60
+)
+
+// Profile is a versioned set of default values.
+type Profile struct {
+	// Version is the version of the profile.
+	Version string
+
+	// MaxUnavailable is the default value of MaxUnavailable in the rolling update config.
+	MaxUnavailable string
+	// MaxSurge is the default value of MaxSurge in the rolling update config.
+	MaxSurge string
+	// UnavailablePeriodSeconds is the default value of UnavailablePeriodSeconds in the rolling update config.
+	UnavailablePeriodSeconds int
+	// MaxSkew is the default value of MaxSkew in topology spread constraints.
+	MaxSkew int32
+	// RevisionHistoryLimit is the default value of RevisionHistoryLimit of placements.
+	RevisionHistoryLimit int32
+	// HeartbeatPeriodSeconds is the default value of HeartbeatPeriodSeconds of member clusters.
+	HeartbeatPeriodSeconds int32
+}
+
+// profiles are all the known default profiles, keyed by their versions; profiles must never change
+// once released, new default values are introduced by adding new profile versions.
+var profiles = map[string]*Profile{
+	DefaultProfileV1: {
+		Version:                  DefaultProfileV1,
+		MaxUnavailable:           DefaultMaxUnavailableValue,
+		MaxSurge:                 DefaultMaxSurgeValue,
+		UnavailablePeriodSeconds: DefaultUnavailablePeriodSeconds,
+		MaxSkew:                  DefaultMaxSkewValue,
+		RevisionHistoryLimit:     DefaultRevisionHistoryLimitValue,
+		HeartbeatPeriodSeconds:   DefaultHeartbeatPeriodSeconds,
+	},
+}
+
+// ProfileFor returns the default profile recorded on an object. Objects without a (known) recorded
+// profile are considered to be created before default profiles were introduced and use the v1 profile.
+func ProfileFor(obj metav1.Object) *Profile {
+	if p, ok := profiles[obj.GetAnnotations()[DefaultProfileAnnotation]]; ok {
+		return p
+	}
+	return profiles[DefaultProfileV1]
+}
+
+// RecordDefaultProfile records the default profile to apply on an object, if the object does not
+// have a known profile recorded yet. Newly created objects get the latest profile; existing objects
+// get the v1 profile, which is what they have been defaulted with.
+func RecordDefaultProfile(obj metav1.Object, isCreate bool) {
+	annotations := obj.GetAnnotations()
+	if _, ok := profiles[annotations[DefaultProfileAnnotation]]; ok {
+		return
+	}
+	if annotations == nil {
+		annotations = make(map[string]string, 1)
+	}
+	annotations[DefaultProfileAnnotation] = DefaultProfileV1
+	if isCreate {
+		annotations[DefaultProfileAnnotation] = LatestDefaultProfile
+	}
+	obj.SetAnnotations(annotations)
+}
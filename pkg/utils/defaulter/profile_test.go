@@ -0,0 +1,88 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package defaulter
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+func TestRecordDefaultProfile(t *testing.T) {
+	tests := map[string]struct {
+		annotations     map[string]string
+		isCreate        bool
+		wantAnnotations map[string]string
+	}{
+		"new object": {
+			isCreate:        true,
+			wantAnnotations: map[string]string{DefaultProfileAnnotation: LatestDefaultProfile},
+		},
+		"existing object without a recorded profile": {
+			annotations:     map[string]string{"foo": "bar"},
+			wantAnnotations: map[string]string{"foo": "bar", DefaultProfileAnnotation: DefaultProfileV1},
+		},
+		"object with a recorded profile": {
+			annotations:     map[string]string{DefaultProfileAnnotation: DefaultProfileV1},
+			isCreate:        true,
+			wantAnnotations: map[string]string{DefaultProfileAnnotation: DefaultProfileV1},
+		},
+		"new object with an unknown profile": {
+			annotations:     map[string]string{DefaultProfileAnnotation: "v0"},
+			isCreate:        true,
+			wantAnnotations: map[string]string{DefaultProfileAnnotation: LatestDefaultProfile},
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			obj := &metav1.ObjectMeta{Annotations: tt.annotations}
+			RecordDefaultProfile(obj, tt.isCreate)
+			if diff := cmp.Diff(obj.Annotations, tt.wantAnnotations); diff != "" {
+				t.Errorf("RecordDefaultProfile() annotations mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestSetMemberClusterDefaults(t *testing.T) {
+	tests := map[string]struct {
+		heartbeatPeriodSeconds     int32
+		wantHeartbeatPeriodSeconds int32
+	}{
+		"heartbeat period not set": {
+			wantHeartbeatPeriodSeconds: DefaultHeartbeatPeriodSeconds,
+		},
+		"heartbeat period set": {
+			heartbeatPeriodSeconds:     30,
+			wantHeartbeatPeriodSeconds: 30,
+		},
+	}
+	for name, tt := range tests {
+		t.Run(name, func(t *testing.T) {
+			mc := &clusterv1beta1.MemberCluster{
+				Spec: clusterv1beta1.MemberClusterSpec{HeartbeatPeriodSeconds: tt.heartbeatPeriodSeconds},
+			}
+			SetMemberClusterDefaults(mc)
+			if got := mc.Spec.HeartbeatPeriodSeconds; got != tt.wantHeartbeatPeriodSeconds {
+				t.Errorf("SetMemberClusterDefaults() heartbeatPeriodSeconds = %d, want %d", got, tt.wantHeartbeatPeriodSeconds)
+			}
+		})
+	}
+}
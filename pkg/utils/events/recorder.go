@@ -0,0 +1,143 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package events features an event recorder that drops identical events emitted within a
+// short window, so that flapping objects do not flood the API server (and etcd) with events.
+package events
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/record"
+	"k8s.io/klog/v2"
+	"k8s.io/utils/clock"
+)
+
+const (
+	// DefaultDedupWindow is the default window within which identical events are emitted only once.
+	DefaultDedupWindow = time.Minute
+
+	// pruneThreshold is the number of tracked events after which the recorder removes the
+	// expired entries; it keeps the memory footprint of the recorder bounded.
+	pruneThreshold = 1024
+)
+
+// eventKey identifies an event; two events are considered identical if they share the same key.
+type eventKey struct {
+	objectKey string
+	eventType string
+	reason    string
+	message   string
+}
+
+// rateLimitedRecorder is a record.EventRecorder that emits identical events at most once
+// within the dedup window.
+type rateLimitedRecorder struct {
+	recorder record.EventRecorder
+	window   time.Duration
+	clock    clock.PassiveClock
+
+	mu sync.Mutex
+	// lastEmitted tracks when each event was last emitted.
+	lastEmitted map[eventKey]time.Time
+}
+
+var _ record.EventRecorder = &rateLimitedRecorder{}
+
+// NewRateLimitedRecorder returns an event recorder which wraps the given recorder and drops the
+// events that are identical (same object, type, reason and message) to one emitted within the
+// given window.
+func NewRateLimitedRecorder(recorder record.EventRecorder, window time.Duration) record.EventRecorder {
+	return newRateLimitedRecorder(recorder, window, clock.RealClock{})
+}
+
+func newRateLimitedRecorder(recorder record.EventRecorder, window time.Duration, clock clock.PassiveClock) *rateLimitedRecorder {
+	return &rateLimitedRecorder{
+		recorder:    recorder,
+		window:      window,
+		clock:       clock,
+		lastEmitted: make(map[eventKey]time.Time),
+	}
+}
+
+// Event implements the record.EventRecorder interface.
+func (r *rateLimitedRecorder) Event(object runtime.Object, eventtype, reason, message string) {
+	if !r.shouldEmit(object, eventtype, reason, message) {
+		return
+	}
+	r.recorder.Event(object, eventtype, reason, message)
+}
+
+// Eventf implements the record.EventRecorder interface.
+func (r *rateLimitedRecorder) Eventf(object runtime.Object, eventtype, reason, messageFmt string, args ...interface{}) {
+	r.Event(object, eventtype, reason, fmt.Sprintf(messageFmt, args...))
+}
+
+// AnnotatedEventf implements the record.EventRecorder interface.
+func (r *rateLimitedRecorder) AnnotatedEventf(object runtime.Object, annotations map[string]string, eventtype, reason, messageFmt string, args ...interface{}) {
+	message := fmt.Sprintf(messageFmt, args...)
+	if !r.shouldEmit(object, eventtype, reason, message) {
+		return
+	}
+	r.recorder.AnnotatedEventf(object, annotations, eventtype, reason, "%s", message)
+}
+
+// shouldEmit returns true if no identical event has been emitted within the dedup window; it
+// also records the event as emitted if so.
+func (r *rateLimitedRecorder) shouldEmit(object runtime.Object, eventtype, reason, message string) bool {
+	key := eventKey{
+		objectKey: objectKey(object),
+		eventType: eventtype,
+		reason:    reason,
+		message:   message,
+	}
+	now := r.clock.Now()
+
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	if last, ok := r.lastEmitted[key]; ok && now.Sub(last) < r.window {
+		klog.V(4).InfoS("Dropped a duplicate event", "object", key.objectKey, "eventType", eventtype, "reason", reason)
+		return false
+	}
+	if len(r.lastEmitted) >= pruneThreshold {
+		for k, last := range r.lastEmitted {
+			if now.Sub(last) >= r.window {
+				delete(r.lastEmitted, k)
+			}
+		}
+	}
+	r.lastEmitted[key] = now
+	return true
+}
+
+// objectKey returns a string that identifies the object an event is about.
+func objectKey(object runtime.Object) string {
+	gvk := object.GetObjectKind().GroupVersionKind()
+	accessor, err := meta.Accessor(object)
+	if err != nil {
+		// Fall back to the object type; such events are deduplicated by type, reason and message.
+		return fmt.Sprintf("%T", object)
+	}
+	if uid := accessor.GetUID(); uid != "" {
+		return string(uid)
+	}
+	return fmt.Sprintf("%s/%T/%s", gvk.GroupKind(), object, types.NamespacedName{Namespace: accessor.GetNamespace(), Name: accessor.GetName()})
+}
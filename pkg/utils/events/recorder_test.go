@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package events
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	clocktesting "k8s.io/utils/clock/testing"
+)
+
+func TestRateLimitedRecorder(t *testing.T) {
+	cluster := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster-1",
+			UID:  "uid-1",
+		},
+	}
+	otherCluster := &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster-2",
+			UID:  "uid-2",
+		},
+	}
+
+	type emit struct {
+		object  *corev1.Namespace
+		reason  string
+		message string
+		// after is the time elapsed since the previous event.
+		after time.Duration
+	}
+	tests := []struct {
+		name   string
+		events []emit
+		want   []string
+	}{
+		{
+			name: "identical events within the window",
+			events: []emit{
+				{object: cluster, reason: "Joined", message: "joined"},
+				{object: cluster, reason: "Joined", message: "joined", after: 10 * time.Second},
+				{object: cluster, reason: "Joined", message: "joined", after: 10 * time.Second},
+			},
+			want: []string{"Normal Joined joined"},
+		},
+		{
+			name: "identical events out of the window",
+			events: []emit{
+				{object: cluster, reason: "Joined", message: "joined"},
+				{object: cluster, reason: "Joined", message: "joined", after: time.Minute},
+			},
+			want: []string{"Normal Joined joined", "Normal Joined joined"},
+		},
+		{
+			name: "different events",
+			events: []emit{
+				{object: cluster, reason: "Joined", message: "joined"},
+				{object: cluster, reason: "Left", message: "left"},
+				{object: cluster, reason: "Left", message: "left again"},
+				{object: otherCluster, reason: "Joined", message: "joined"},
+			},
+			want: []string{"Normal Joined joined", "Normal Left left", "Normal Left left again", "Normal Joined joined"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			fakeRecorder := record.NewFakeRecorder(len(tc.events))
+			fakeClock := clocktesting.NewFakePassiveClock(time.Now())
+			r := newRateLimitedRecorder(fakeRecorder, DefaultDedupWindow, fakeClock)
+			for _, e := range tc.events {
+				fakeClock.SetTime(fakeClock.Now().Add(e.after))
+				r.Eventf(e.object, corev1.EventTypeNormal, e.reason, "%s", e.message)
+			}
+			close(fakeRecorder.Events)
+			got := []string{}
+			for e := range fakeRecorder.Events {
+				got = append(got, e)
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("emitted events mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ Licensed under the MIT license.
 package eviction
 
 import (
+	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/klog/v2"
 
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
@@ -40,3 +41,50 @@ func IsPlacementPresent(binding *placementv1beta1.ClusterResourceBinding) bool {
 	}
 	return false
 }
+
+// DisruptionsAllowed calculates the number of bindings of the given placement that can be disrupted, i.e., have their
+// resources removed from the target clusters, without violating the given disruption budget; it also returns the
+// number of available bindings.
+//
+// The given bindings must be all the bindings of the placement.
+func DisruptionsAllowed(bindings []placementv1beta1.BindingObj, placement placementv1beta1.PlacementObj, db *placementv1beta1.ClusterResourcePlacementDisruptionBudget) (int, int) {
+	availableBindings := 0
+	for i := range bindings {
+		availableCondition := bindings[i].GetCondition(string(placementv1beta1.ResourceBindingAvailable))
+		if condition.IsConditionStatusTrue(availableCondition, bindings[i].GetGeneration()) {
+			availableBindings++
+		}
+	}
+
+	var desiredBindings int
+	placementType := placementv1beta1.PickAllPlacementType
+	if policy := placement.GetPlacementSpec().Policy; policy != nil {
+		placementType = policy.PlacementType
+		// we don't know the desired bindings for PickAll and we won't evict a binding for PickFixed placement.
+		if placementType == placementv1beta1.PickNPlacementType && policy.NumberOfClusters != nil {
+			desiredBindings = int(*policy.NumberOfClusters)
+		}
+	}
+
+	var disruptionsAllowed int
+	switch {
+	// For PickAll placements, MaxUnavailable won't be specified in DB.
+	case db.Spec.MaxUnavailable != nil:
+		maxUnavailable, _ := intstr.GetScaledValueFromIntOrPercent(db.Spec.MaxUnavailable, desiredBindings, true)
+		unavailableBindings := len(bindings) - availableBindings
+		disruptionsAllowed = maxUnavailable - unavailableBindings
+	case db.Spec.MinAvailable != nil:
+		var minAvailable int
+		if placementType == placementv1beta1.PickAllPlacementType {
+			// MinAvailable will be an Integer value for PickAll placement.
+			minAvailable = db.Spec.MinAvailable.IntValue()
+		} else {
+			minAvailable, _ = intstr.GetScaledValueFromIntOrPercent(db.Spec.MinAvailable, desiredBindings, true)
+		}
+		disruptionsAllowed = availableBindings - minAvailable
+	}
+	if disruptionsAllowed < 0 {
+		disruptionsAllowed = 0
+	}
+	return disruptionsAllowed, availableBindings
+}
@@ -0,0 +1,285 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package faultinjection features a wrapper around the transport of the member agent's hub client
+// that injects faults, i.e., latencies, network partitions, and clock skews, into the communication
+// with the hub cluster.
+//
+// The package is meant for testing purposes only, so that E2E and integration tests can verify how
+// Fleet behaves under each fault; the member agent turns it on only when one of the fault injection
+// environment variables is set, which should never be the case in production.
+package faultinjection
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+)
+
+const (
+	// HubLatencyEnvVarName is the name of the environment variable that sets the latency added to each
+	// request sent to the hub cluster, e.g., "500ms".
+	HubLatencyEnvVarName = "FAULT_INJECTION_HUB_LATENCY"
+	// HubPartitionsEnvVarName is the name of the environment variable that sets the periods in which
+	// the member agent is partitioned from the hub cluster; the periods are comma-separated, each
+	// specified by its start and end offsets since the member agent starts, e.g., "1m-2m,5m-5m30s".
+	HubPartitionsEnvVarName = "FAULT_INJECTION_HUB_PARTITIONS"
+	// ClockSkewEnvVarName is the name of the environment variable that sets the skew of the member
+	// agent's clock against the hub cluster's, e.g., "-10m" for a member agent clock that lags
+	// behind by 10 minutes.
+	ClockSkewEnvVarName = "FAULT_INJECTION_CLOCK_SKEW"
+)
+
+var (
+	// skewedTimeFields are the fields in the objects sent to the hub cluster whose values are set
+	// from the member agent's clock at the time of sending.
+	//
+	// Note that condition transition times are not skewed, as the unchanged ones are sent back as
+	// they are read from the hub cluster (conditions are kept in lists, which merge patches replace
+	// as a whole), and skewing them would shift them further with each request.
+	skewedTimeFields = map[string]bool{
+		"lastReceivedHeartbeat": true,
+		"observationTime":       true,
+	}
+)
+
+// Period is a period of time, specified by its start and end offsets since the faults are injected.
+type Period struct {
+	Start time.Duration
+	End   time.Duration
+}
+
+// Faults describes the faults injected into the communication with the hub cluster.
+type Faults struct {
+	// Latency is the latency added to each request sent to the hub cluster.
+	Latency time.Duration
+
+	// Partitions are the periods in which the requests sent to the hub cluster fail as if the
+	// connection were refused.
+	Partitions []Period
+
+	// ClockSkew is the skew of the member agent's clock; it is added to the timestamps the member
+	// agent reports in the objects it writes to the hub cluster.
+	ClockSkew time.Duration
+}
+
+// IsEmpty returns true if no fault is injected.
+func (f *Faults) IsEmpty() bool {
+	return f.Latency == 0 && len(f.Partitions) == 0 && f.ClockSkew == 0
+}
+
+// FaultsFromEnv reads the faults to inject from the fault injection environment variables.
+func FaultsFromEnv() (Faults, error) {
+	faults := Faults{}
+	if v, ok := os.LookupEnv(HubLatencyEnvVarName); ok && len(v) > 0 {
+		latency, err := time.ParseDuration(v)
+		if err != nil || latency < 0 {
+			return Faults{}, fmt.Errorf("invalid %s %q: must be a non-negative duration", HubLatencyEnvVarName, v)
+		}
+		faults.Latency = latency
+	}
+	if v, ok := os.LookupEnv(HubPartitionsEnvVarName); ok && len(v) > 0 {
+		partitions, err := parsePeriods(v)
+		if err != nil {
+			return Faults{}, fmt.Errorf("invalid %s %q: %w", HubPartitionsEnvVarName, v, err)
+		}
+		faults.Partitions = partitions
+	}
+	if v, ok := os.LookupEnv(ClockSkewEnvVarName); ok && len(v) > 0 {
+		skew, err := time.ParseDuration(v)
+		if err != nil {
+			return Faults{}, fmt.Errorf("invalid %s %q: must be a duration", ClockSkewEnvVarName, v)
+		}
+		faults.ClockSkew = skew
+	}
+	return faults, nil
+}
+
+// parsePeriods parses a comma-separated list of periods in the format of START-END.
+func parsePeriods(s string) ([]Period, error) {
+	var periods []Period
+	for _, p := range strings.Split(s, ",") {
+		startStr, endStr, found := strings.Cut(strings.TrimSpace(p), "-")
+		if !found {
+			return nil, fmt.Errorf("period %q is not in the format of START-END", p)
+		}
+		start, err := time.ParseDuration(startStr)
+		if err != nil {
+			return nil, fmt.Errorf("period %q has an invalid start: %w", p, err)
+		}
+		end, err := time.ParseDuration(endStr)
+		if err != nil {
+			return nil, fmt.Errorf("period %q has an invalid end: %w", p, err)
+		}
+		if end <= start {
+			return nil, fmt.Errorf("period %q must end after it starts", p)
+		}
+		periods = append(periods, Period{Start: start, End: end})
+	}
+	return periods, nil
+}
+
+// Injector injects faults into the requests sent to the hub cluster.
+type Injector struct {
+	mu      sync.Mutex
+	faults  Faults
+	startAt time.Time
+}
+
+// NewInjector returns an Injector which injects the given faults; the partition periods are
+// counted from now.
+func NewInjector(faults Faults) *Injector {
+	return &Injector{
+		faults:  faults,
+		startAt: time.Now(),
+	}
+}
+
+// SetFaults replaces the faults injected; it is safe to call while requests are being sent. The
+// partition periods are still counted from when the Injector is created.
+func (i *Injector) SetFaults(faults Faults) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	i.faults = faults
+}
+
+// Faults returns the faults currently injected.
+func (i *Injector) Faults() Faults {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	return i.faults
+}
+
+// IsPartitioned returns true if the member agent is partitioned from the hub cluster at the moment.
+func (i *Injector) IsPartitioned() bool {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+	elapsed := time.Since(i.startAt)
+	for _, p := range i.faults.Partitions {
+		if elapsed >= p.Start && elapsed < p.End {
+			return true
+		}
+	}
+	return false
+}
+
+// WrapTransport wraps a round tripper so that the faults are injected into the requests it sends;
+// it can be used as the WrapTransport function of a REST config.
+//
+// To have the injected partitions tracked as connectivity failures, the round tripper should be
+// wrapped before the hub connectivity tracker is.
+func (i *Injector) WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return &faultInjectingRoundTripper{injector: i, delegatedRoundTripper: rt}
+}
+
+type faultInjectingRoundTripper struct {
+	injector              *Injector
+	delegatedRoundTripper http.RoundTripper
+}
+
+var _ http.RoundTripper = &faultInjectingRoundTripper{}
+
+func (rt *faultInjectingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	faults := rt.injector.Faults()
+	if faults.Latency > 0 {
+		timer := time.NewTimer(faults.Latency)
+		select {
+		case <-timer.C:
+		case <-req.Context().Done():
+			timer.Stop()
+			return nil, req.Context().Err()
+		}
+	}
+
+	// Check for partitions after the latency, so that no request gets through once a
+	// partition starts.
+	if rt.injector.IsPartitioned() {
+		return nil, &net.OpError{Op: "dial", Net: "tcp", Err: os.NewSyscallError("connect", syscall.ECONNREFUSED)}
+	}
+
+	if faults.ClockSkew != 0 && req.Body != nil && req.Body != http.NoBody {
+		skewedReq, err := skewTimestampsIn(req, faults.ClockSkew)
+		if err != nil {
+			return nil, err
+		}
+		req = skewedReq
+	}
+	return rt.delegatedRoundTripper.RoundTrip(req)
+}
+
+// skewTimestampsIn returns a copy of a request whose JSON body has the timestamps set from the
+// member agent's clock skewed; requests of other content types are returned as they are.
+func skewTimestampsIn(req *http.Request, skew time.Duration) (*http.Request, error) {
+	if !strings.Contains(req.Header.Get("Content-Type"), "json") {
+		return req, nil
+	}
+	body, err := io.ReadAll(req.Body)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the request body: %w", err)
+	}
+	_ = req.Body.Close()
+
+	var obj interface{}
+	if err := json.Unmarshal(body, &obj); err == nil {
+		if skewTimestamps(obj, skew) {
+			if skewedBody, err := json.Marshal(obj); err == nil {
+				body = skewedBody
+			}
+		}
+	}
+
+	skewedReq := req.Clone(req.Context())
+	skewedReq.Body = io.NopCloser(bytes.NewReader(body))
+	skewedReq.ContentLength = int64(len(body))
+	skewedReq.GetBody = func() (io.ReadCloser, error) {
+		return io.NopCloser(bytes.NewReader(body)), nil
+	}
+	return skewedReq, nil
+}
+
+// skewTimestamps skews the timestamps set from the member agent's clock in a decoded JSON value;
+// it returns true if any timestamp has been skewed.
+func skewTimestamps(v interface{}, skew time.Duration) bool {
+	skewed := false
+	switch typedV := v.(type) {
+	case map[string]interface{}:
+		for k, fieldV := range typedV {
+			if s, ok := fieldV.(string); ok && skewedTimeFields[k] {
+				t, err := time.Parse(time.RFC3339, s)
+				if err != nil {
+					continue
+				}
+				typedV[k] = t.Add(skew).UTC().Format(time.RFC3339)
+				skewed = true
+				continue
+			}
+			skewed = skewTimestamps(fieldV, skew) || skewed
+		}
+	case []interface{}:
+		for _, item := range typedV {
+			skewed = skewTimestamps(item, skew) || skewed
+		}
+	}
+	return skewed
+}
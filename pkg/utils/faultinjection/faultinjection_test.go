@@ -0,0 +1,187 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package faultinjection
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/hubconnectivity"
+)
+
+func TestFaultsFromEnv(t *testing.T) {
+	tests := []struct {
+		name    string
+		env     map[string]string
+		want    Faults
+		wantErr bool
+	}{
+		{
+			name: "no faults",
+			want: Faults{},
+		},
+		{
+			name: "all faults",
+			env: map[string]string{
+				HubLatencyEnvVarName:    "500ms",
+				HubPartitionsEnvVarName: "1m-2m, 5m-5m30s",
+				ClockSkewEnvVarName:     "-10m",
+			},
+			want: Faults{
+				Latency: 500 * time.Millisecond,
+				Partitions: []Period{
+					{Start: time.Minute, End: 2 * time.Minute},
+					{Start: 5 * time.Minute, End: 5*time.Minute + 30*time.Second},
+				},
+				ClockSkew: -10 * time.Minute,
+			},
+		},
+		{
+			name:    "negative latency",
+			env:     map[string]string{HubLatencyEnvVarName: "-1s"},
+			wantErr: true,
+		},
+		{
+			name:    "partition without an end",
+			env:     map[string]string{HubPartitionsEnvVarName: "1m"},
+			wantErr: true,
+		},
+		{
+			name:    "partition ending before it starts",
+			env:     map[string]string{HubPartitionsEnvVarName: "2m-1m"},
+			wantErr: true,
+		},
+		{
+			name:    "invalid clock skew",
+			env:     map[string]string{ClockSkewEnvVarName: "ten minutes"},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, name := range []string{HubLatencyEnvVarName, HubPartitionsEnvVarName, ClockSkewEnvVarName} {
+				t.Setenv(name, tc.env[name])
+			}
+			got, err := FaultsFromEnv()
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("FaultsFromEnv() error = %v, want error %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("FaultsFromEnv() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestRoundTrip(t *testing.T) {
+	var gotBody string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		gotBody = string(body)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	heartbeatBody := `{"status":{"agentStatus":[{"lastReceivedHeartbeat":"2025-01-01T10:00:00Z","type":"MemberAgent"}]}}`
+	tests := []struct {
+		name            string
+		faults          Faults
+		contentType     string
+		wantFailure     string
+		wantMinDuration time.Duration
+		wantBody        string
+	}{
+		{
+			name:     "no faults",
+			wantBody: heartbeatBody,
+		},
+		{
+			name:            "latency",
+			faults:          Faults{Latency: 100 * time.Millisecond},
+			wantMinDuration: 100 * time.Millisecond,
+			wantBody:        heartbeatBody,
+		},
+		{
+			name:        "partitioned",
+			faults:      Faults{Partitions: []Period{{Start: 0, End: time.Hour}}},
+			wantFailure: hubconnectivity.ConnectionRefusedReason,
+		},
+		{
+			name:     "partition over",
+			faults:   Faults{Partitions: []Period{{Start: -time.Hour, End: 0}}},
+			wantBody: heartbeatBody,
+		},
+		{
+			name:     "clock skew",
+			faults:   Faults{ClockSkew: -10 * time.Minute},
+			wantBody: `{"status":{"agentStatus":[{"lastReceivedHeartbeat":"2025-01-01T09:50:00Z","type":"MemberAgent"}]}}`,
+		},
+		{
+			name:        "clock skew on a request that is not JSON",
+			faults:      Faults{ClockSkew: -10 * time.Minute},
+			contentType: "application/vnd.kubernetes.protobuf",
+			wantBody:    heartbeatBody,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotBody = ""
+			rt := NewInjector(tc.faults).WrapTransport(http.DefaultTransport)
+			req, err := http.NewRequestWithContext(context.Background(), http.MethodPatch, server.URL, strings.NewReader(heartbeatBody))
+			if err != nil {
+				t.Fatalf("failed to create the request: %v", err)
+			}
+			contentType := tc.contentType
+			if contentType == "" {
+				contentType = "application/merge-patch+json"
+			}
+			req.Header.Set("Content-Type", contentType)
+
+			startAt := time.Now()
+			resp, err := rt.RoundTrip(req)
+			if tc.wantFailure != "" {
+				if err == nil {
+					t.Fatalf("RoundTrip() = %v, want error", resp.StatusCode)
+				}
+				if got := hubconnectivity.ClassifyError(err).Reason; got != tc.wantFailure {
+					t.Errorf("RoundTrip() failure reason = %s, want %s", got, tc.wantFailure)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("RoundTrip() error = %v, want no error", err)
+			}
+			_ = resp.Body.Close()
+			if d := time.Since(startAt); d < tc.wantMinDuration {
+				t.Errorf("RoundTrip() took %v, want at least %v", d, tc.wantMinDuration)
+			}
+			if diff := cmp.Diff(tc.wantBody, gotBody); diff != "" {
+				t.Errorf("RoundTrip() request body mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
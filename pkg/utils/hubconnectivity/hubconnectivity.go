@@ -0,0 +1,272 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package hubconnectivity features utilities that help the member agent connect to the hub cluster
+// in restricted networks, e.g., via a proxy, and diagnose the failures it encounters when doing so.
+package hubconnectivity
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"syscall"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+)
+
+const (
+	// The reasons of dial failures.
+	DNSResolutionFailedReason   = "DNSResolutionFailed"
+	ProxyConnectFailedReason    = "ProxyConnectFailed"
+	ConnectionRefusedReason     = "ConnectionRefused"
+	NetworkUnreachableReason    = "NetworkUnreachable"
+	DialTimeoutReason           = "DialTimeout"
+	TLSVerificationFailedReason = "TLSVerificationFailed"
+	TLSHandshakeFailedReason    = "TLSHandshakeFailed"
+	DialFailedReason            = "DialFailed"
+
+	// preflightCheckInterval is the interval between two attempts of the preflight check.
+	preflightCheckInterval = 5 * time.Second
+)
+
+var (
+	// supportedProxySchemes are the proxy URL schemes supported by the Go HTTP transport.
+	supportedProxySchemes = map[string]bool{
+		"http":    true,
+		"https":   true,
+		"socks5":  true,
+		"socks5h": true,
+	}
+)
+
+// ParseProxyURL parses and validates the URL of a proxy through which the member agent connects to
+// the hub cluster; HTTP, HTTPS and SOCKS5 proxies are supported.
+//
+// As the URL might carry the credentials for the proxy, the errors quote the URL with the password
+// redacted, or not at all if it cannot be parsed.
+func ParseProxyURL(proxyURL string) (*url.URL, error) {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		// The parse error quotes the URL.
+		return nil, errors.New("invalid proxy URL: the URL cannot be parsed")
+	}
+	if !supportedProxySchemes[u.Scheme] {
+		return nil, fmt.Errorf("invalid proxy URL %q: unsupported scheme %q, must be one of http, https, socks5, or socks5h", u.Redacted(), u.Scheme)
+	}
+	if err := validateHost(u); err != nil {
+		return nil, fmt.Errorf("invalid proxy URL %q: %w", u.Redacted(), err)
+	}
+	return u, nil
+}
+
+// RedactProxyURL returns the URL of a proxy with the password redacted, or an empty string if the URL
+// cannot be parsed.
+func RedactProxyURL(proxyURL string) string {
+	u, err := url.Parse(proxyURL)
+	if err != nil {
+		return ""
+	}
+	return u.Redacted()
+}
+
+// ValidateHubURL validates the URL of the hub cluster API server.
+func ValidateHubURL(hubURL string) error {
+	u, err := url.Parse(hubURL)
+	if err != nil {
+		return fmt.Errorf("invalid hub server URL %q: %w", hubURL, err)
+	}
+	if u.Scheme != "https" && u.Scheme != "http" {
+		return fmt.Errorf("invalid hub server URL %q: unsupported scheme %q, must be https or http", hubURL, u.Scheme)
+	}
+	if err := validateHost(u); err != nil {
+		return fmt.Errorf("invalid hub server URL %q: %w", hubURL, err)
+	}
+	return nil
+}
+
+// validateHost verifies that a URL has a host, and that IPv6 literals in the host are enclosed in
+// square brackets; without the brackets, part of an IPv6 address might be mistaken for the port.
+func validateHost(u *url.URL) error {
+	hostname := u.Hostname()
+	if hostname == "" {
+		return errors.New("host is empty")
+	}
+	if strings.Contains(hostname, ":") && !strings.HasPrefix(u.Host, "[") {
+		return fmt.Errorf("IPv6 address in host %q must be enclosed in square brackets, e.g., [2001:db8::1]:443", u.Host)
+	}
+	return nil
+}
+
+// Failure is a failure the member agent encounters when connecting to the hub cluster.
+type Failure struct {
+	// Time is when the failure occurs.
+	Time time.Time
+	// Reason is a brief, CamelCase description of the failure.
+	Reason string
+	// Message is a human-readable description of the failure.
+	Message string
+}
+
+// ClassifyError returns a Failure that describes the given error from a request to the hub cluster.
+func ClassifyError(err error) Failure {
+	reason, hint := classify(err)
+	return Failure{
+		Time:    time.Now(),
+		Reason:  reason,
+		Message: fmt.Sprintf("%s: %v", hint, err),
+	}
+}
+
+// classify returns the reason and a troubleshooting hint for the given error.
+func classify(err error) (reason, hint string) {
+	var opErr *net.OpError
+	if errors.As(err, &opErr) && (opErr.Op == "proxyconnect" || strings.HasPrefix(opErr.Op, "socks")) {
+		return ProxyConnectFailedReason, "failed to connect to the hub cluster via the proxy; check the proxy URL and that the proxy allows connections to the hub cluster"
+	}
+
+	var unknownAuthorityErr x509.UnknownAuthorityError
+	var hostnameErr x509.HostnameError
+	var certInvalidErr x509.CertificateInvalidError
+	var certVerificationErr *tls.CertificateVerificationError
+	switch {
+	case errors.As(err, &unknownAuthorityErr), errors.As(err, &certVerificationErr), errors.As(err, &certInvalidErr):
+		return TLSVerificationFailedReason, "failed to verify the certificate of the hub cluster; check the CA bundle in use, and whether a proxy intercepts TLS connections"
+	case errors.As(err, &hostnameErr):
+		return TLSVerificationFailedReason, "the certificate of the hub cluster is not valid for the server name in use; check the TLS server name (SNI) setting"
+	}
+	var recordHeaderErr tls.RecordHeaderError
+	var alertErr tls.AlertError
+	if errors.As(err, &recordHeaderErr) || errors.As(err, &alertErr) {
+		return TLSHandshakeFailedReason, "failed to complete the TLS handshake with the hub cluster; check that the hub server URL points to an HTTPS endpoint"
+	}
+
+	var dnsErr *net.DNSError
+	switch {
+	case errors.As(err, &dnsErr):
+		return DNSResolutionFailedReason, "failed to resolve the host name of the hub cluster; check the DNS settings of the member cluster"
+	case errors.Is(err, syscall.ECONNREFUSED):
+		return ConnectionRefusedReason, "the connection to the hub cluster is refused; check the hub server URL and port"
+	case errors.Is(err, syscall.ENETUNREACH), errors.Is(err, syscall.EHOSTUNREACH):
+		return NetworkUnreachableReason, "the hub cluster is unreachable from the member cluster; check the routes for the address family (IPv4 or IPv6) in use"
+	}
+
+	var netErr net.Error
+	if errors.As(err, &netErr) && netErr.Timeout() {
+		return DialTimeoutReason, "timed out when connecting to the hub cluster; check whether a firewall blocks the connection or a proxy is required"
+	}
+	return DialFailedReason, "failed to connect to the hub cluster"
+}
+
+// Tracker keeps track of the failures the member agent encounters when connecting to the hub cluster.
+type Tracker struct {
+	mu          sync.Mutex
+	lastFailure *Failure
+}
+
+// NewTracker returns a new Tracker.
+func NewTracker() *Tracker {
+	return &Tracker{}
+}
+
+// LastFailure returns the last failure the member agent encounters, or nil if there is none.
+func (t *Tracker) LastFailure() *Failure {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	if t.lastFailure == nil {
+		return nil
+	}
+	failure := *t.lastFailure
+	return &failure
+}
+
+// Record records a failure from the given error.
+func (t *Tracker) Record(err error) {
+	failure := ClassifyError(err)
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	t.lastFailure = &failure
+}
+
+// WrapTransport wraps a round tripper so that the Tracker records the failures of the requests
+// it sends; it can be used as the WrapTransport function of a REST config.
+func (t *Tracker) WrapTransport(rt http.RoundTripper) http.RoundTripper {
+	return &trackingRoundTripper{tracker: t, delegatedRoundTripper: rt}
+}
+
+type trackingRoundTripper struct {
+	tracker               *Tracker
+	delegatedRoundTripper http.RoundTripper
+}
+
+var _ http.RoundTripper = &trackingRoundTripper{}
+
+func (rt *trackingRoundTripper) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := rt.delegatedRoundTripper.RoundTrip(req)
+	// Requests cancelled by the agent itself, e.g., watches that end on shutdown, are not failures.
+	if err != nil && req.Context().Err() == nil {
+		rt.tracker.Record(err)
+	}
+	return resp, err
+}
+
+// Preflight verifies that the hub cluster is reachable with the given REST config, retrying
+// until the timeout expires. Any response from the hub cluster API server, including errors such
+// as Unauthorized, proves the connectivity; such errors are left for the controllers to handle.
+func Preflight(ctx context.Context, cfg *rest.Config, timeout time.Duration) error {
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return fmt.Errorf("failed to create the discovery client for the hub cluster: %w", err)
+	}
+
+	var lastErr error
+	pollErr := wait.PollUntilContextTimeout(ctx, preflightCheckInterval, timeout, true, func(ctx context.Context) (bool, error) {
+		_, err := discoveryClient.RESTClient().Get().AbsPath("/version").Do(ctx).Raw()
+		if err == nil || isResponseFromServer(err) {
+			return true, nil
+		}
+		lastErr = err
+		failure := ClassifyError(err)
+		klog.ErrorS(err, "Failed to connect to the hub cluster; will retry", "reason", failure.Reason, "message", failure.Message)
+		return false, nil
+	})
+	if pollErr != nil {
+		if lastErr != nil {
+			failure := ClassifyError(lastErr)
+			return fmt.Errorf("hub cluster at %s is not reachable (%s): %s", cfg.Host, failure.Reason, failure.Message)
+		}
+		return fmt.Errorf("hub cluster at %s is not reachable: %w", cfg.Host, pollErr)
+	}
+	return nil
+}
+
+// isResponseFromServer returns if an error from the REST client is built from a response of the
+// server, as opposed to a failure to send the request.
+func isResponseFromServer(err error) bool {
+	var apiStatus apierrors.APIStatus
+	return errors.As(err, &apiStatus)
+}
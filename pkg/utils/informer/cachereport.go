@@ -0,0 +1,103 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package informer
+
+import (
+	"encoding/json"
+	"net/http"
+	"sort"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog/v2"
+)
+
+// CacheStats is the usage of the informer cache of a resource.
+type CacheStats struct {
+	// GroupVersionResource is the gvr of the resource.
+	GroupVersionResource schema.GroupVersionResource `json:"groupVersionResource"`
+
+	// Objects is the number of objects in the cache.
+	Objects int `json:"objects"`
+
+	// EstimatedBytes is an estimate of the memory in use by the objects in the cache, which is
+	// the size of the objects in their JSON encoding.
+	EstimatedBytes int64 `json:"estimatedBytes"`
+
+	// StatusStripped indicates if the status of the objects is stripped from the cache.
+	StatusStripped bool `json:"statusStripped"`
+}
+
+// CacheReport is the usage of the informer caches of an informer manager.
+type CacheReport struct {
+	// Objects is the total number of objects in the caches.
+	Objects int `json:"objects"`
+
+	// EstimatedBytes is the total estimated size of the objects in the caches.
+	EstimatedBytes int64 `json:"estimatedBytes"`
+
+	// Resources are the usage of the cache of each resource, sorted by their estimated size
+	// in descending order.
+	Resources []CacheStats `json:"resources"`
+}
+
+func (s *informerManagerImpl) CacheReport() []CacheStats {
+	s.resourcesLock.RLock()
+	defer s.resourcesLock.RUnlock()
+
+	res := make([]CacheStats, 0, len(s.apiResources))
+	for _, resource := range s.apiResources {
+		gvr := resource.GroupVersionResource
+		stats := CacheStats{
+			GroupVersionResource: gvr,
+			StatusStripped:       s.statusStrippedResources.Has(gvr.GroupResource()),
+		}
+		for _, obj := range s.informerFactory.ForResource(gvr).Informer().GetStore().List() {
+			stats.Objects++
+			// Encoding every object is expensive on large fleets; the report is only built on demand.
+			data, err := json.Marshal(obj)
+			if err != nil {
+				klog.V(2).InfoS("Failed to encode a cached object for the cache report", "gvr", gvr, "err", err)
+				continue
+			}
+			stats.EstimatedBytes += int64(len(data))
+		}
+		res = append(res, stats)
+	}
+	return res
+}
+
+// NewCacheReportHandler returns an HTTP handler which serves the usage of the informer caches of
+// the given informer manager in JSON, to help find out the resources that use up the memory.
+func NewCacheReportHandler(mgr Manager) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		report := CacheReport{Resources: mgr.CacheReport()}
+		for _, stats := range report.Resources {
+			report.Objects += stats.Objects
+			report.EstimatedBytes += stats.EstimatedBytes
+		}
+		sort.Slice(report.Resources, func(i, j int) bool {
+			if report.Resources[i].EstimatedBytes != report.Resources[j].EstimatedBytes {
+				return report.Resources[i].EstimatedBytes > report.Resources[j].EstimatedBytes
+			}
+			return report.Resources[i].GroupVersionResource.String() < report.Resources[j].GroupVersionResource.String()
+		})
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			klog.ErrorS(err, "Failed to write the cache report")
+		}
+	})
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package informer
+
+import (
+	"encoding/json"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/dynamic/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+
+	testresource "github.com/kubefleet-dev/kubefleet/test/utils/resource"
+)
+
+func TestCacheReportHandler(t *testing.T) {
+	deploy := &appsv1.Deployment{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "apps/v1", Kind: "Deployment"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "app"},
+		Status:     appsv1.DeploymentStatus{Replicas: 3},
+	}
+	cm1 := &corev1.ConfigMap{
+		TypeMeta:   metav1.TypeMeta{APIVersion: "v1", Kind: "ConfigMap"},
+		ObjectMeta: metav1.ObjectMeta{Namespace: "app", Name: "cm-1"},
+	}
+	cm2 := cm1.DeepCopy()
+	cm2.Name = "cm-2"
+	fakeClient := fake.NewSimpleDynamicClient(scheme.Scheme, deploy, cm1, cm2)
+	stopCh := make(chan struct{})
+	defer close(stopCh)
+
+	mgr := NewInformerManager(fakeClient, 0, stopCh, schema.GroupResource{Group: "apps", Resource: "deployments"})
+	mgr.CreateInformerForResource(APIResourceMeta{
+		GroupVersionKind:     testresource.GVKDeployment(),
+		GroupVersionResource: testresource.GVRDeployment(),
+	})
+	mgr.CreateInformerForResource(APIResourceMeta{
+		GroupVersionKind:     testresource.GVKConfigMap(),
+		GroupVersionResource: testresource.GVRConfigMap(),
+	})
+	mgr.Start()
+	mgr.WaitForCacheSync()
+
+	implMgr := mgr.(*informerManagerImpl)
+	cachedDeploy, _, err := implMgr.informerFactory.ForResource(testresource.GVRDeployment()).Informer().GetStore().GetByKey("app/app")
+	if err != nil {
+		t.Fatalf("failed to get the cached deployment: %v", err)
+	}
+	if _, found := cachedDeploy.(*unstructured.Unstructured).Object["status"]; found {
+		t.Errorf("cached deployment has the status, want the status stripped")
+	}
+	wantBytes := func(objs ...interface{}) int64 {
+		var total int64
+		for _, obj := range objs {
+			data, err := json.Marshal(obj)
+			if err != nil {
+				t.Fatalf("failed to encode the object: %v", err)
+			}
+			total += int64(len(data))
+		}
+		return total
+	}
+	cmStore := implMgr.informerFactory.ForResource(testresource.GVRConfigMap()).Informer().GetStore()
+	cmBytes := wantBytes(cmStore.List()...)
+	deployBytes := wantBytes(cachedDeploy)
+
+	rec := httptest.NewRecorder()
+	NewCacheReportHandler(mgr).ServeHTTP(rec, httptest.NewRequest("GET", "/debug/cache", nil))
+	var got CacheReport
+	if err := json.Unmarshal(rec.Body.Bytes(), &got); err != nil {
+		t.Fatalf("failed to decode the cache report: %v", err)
+	}
+	configMapStats := CacheStats{GroupVersionResource: testresource.GVRConfigMap(), Objects: 2, EstimatedBytes: cmBytes}
+	deploymentStats := CacheStats{GroupVersionResource: testresource.GVRDeployment(), Objects: 1, EstimatedBytes: deployBytes, StatusStripped: true}
+	want := CacheReport{
+		Objects:        3,
+		EstimatedBytes: cmBytes + deployBytes,
+		Resources:      []CacheStats{configMapStats, deploymentStats},
+	}
+	if deployBytes > cmBytes {
+		want.Resources = []CacheStats{deploymentStats, configMapStats}
+	}
+	if diff := cmp.Diff(want, got); diff != "" {
+		t.Errorf("cache report mismatch (-want, +got):\n%s", diff)
+	}
+}
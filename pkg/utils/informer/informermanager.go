@@ -22,7 +22,9 @@ import (
 	"sync"
 	"time"
 
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/client-go/dynamic"
 	"k8s.io/client-go/dynamic/dynamicinformer"
 	"k8s.io/client-go/tools/cache"
@@ -77,20 +79,26 @@ type Manager interface {
 	// This is used by InformerPopulator to create informers on all pods (leader and followers) so they have
 	// synced caches for webhook validation. The leader's ChangeDetector will add event handlers later.
 	CreateInformerForResource(resource APIResourceMeta)
+
+	// CacheReport reports the number of objects and an estimate of their size in the informer cache
+	// of each resource we are watching.
+	CacheReport() []CacheStats
 }
 
 // NewInformerManager constructs a new instance of informerManagerImpl.
 // defaultResync with value '0' means no re-sync.
-func NewInformerManager(client dynamic.Interface, defaultResync time.Duration, parentCh <-chan struct{}) Manager {
+// The status of the objects of the given statusStrippedResources is not kept in the informer caches.
+func NewInformerManager(client dynamic.Interface, defaultResync time.Duration, parentCh <-chan struct{}, statusStrippedResources ...schema.GroupResource) Manager {
 	// TODO: replace this with plain context
 	ctx, cancel := ContextForChannel(parentCh)
 	return &informerManagerImpl{
-		dynamicClient:      client,
-		ctx:                ctx,
-		cancel:             cancel,
-		informerFactory:    dynamicinformer.NewDynamicSharedInformerFactory(client, defaultResync),
-		apiResources:       make(map[schema.GroupVersionKind]*APIResourceMeta),
-		registeredHandlers: make(map[schema.GroupVersionResource]bool),
+		dynamicClient:           client,
+		ctx:                     ctx,
+		cancel:                  cancel,
+		informerFactory:         dynamicinformer.NewDynamicSharedInformerFactory(client, defaultResync),
+		apiResources:            make(map[schema.GroupVersionKind]*APIResourceMeta),
+		registeredHandlers:      make(map[schema.GroupVersionResource]bool),
+		statusStrippedResources: sets.New(statusStrippedResources...),
 	}
 }
 
@@ -132,6 +140,9 @@ type informerManagerImpl struct {
 	// registeredHandlers tracks which GVRs already have event handlers registered
 	// to prevent duplicate registrations and goroutine leaks
 	registeredHandlers map[schema.GroupVersionResource]bool
+
+	// statusStrippedResources are the resources whose status is stripped from the objects in the informer caches.
+	statusStrippedResources sets.Set[schema.GroupResource]
 }
 
 func (s *informerManagerImpl) AddStaticResource(resource APIResourceMeta, handler cache.ResourceEventHandler) {
@@ -280,20 +291,46 @@ func ContextForChannel(parentCh <-chan struct{}) (context.Context, context.Cance
 }
 
 // getOrCreateInformerWithTransform gets or creates an informer for the given resource and ensures
-// the ManagedFields transform is set. This is idempotent - if the informer exists, we get the same
-// instance.
+// the ManagedFields transform (and the status transform, if the status of the resource is to be
+// stripped) is set. This is idempotent - if the informer exists, we get the same instance.
 func (s *informerManagerImpl) getOrCreateInformerWithTransform(resource schema.GroupVersionResource) cache.SharedIndexInformer {
 	// Get or create the informer (this is idempotent - if it exists, we get the same instance)
 	// The idempotent behavior is important because this method may be called multiple times,
 	// potentially concurrently, and relies on the shared informer instance from the factory.
 	informer := s.informerFactory.ForResource(resource).Informer()
 
-	// Set the transform to strip ManagedFields. This is safe to call even if
+	// Set the transform to trim ManagedFields. This is safe to call even if
 	// already set, since we get the same informer instance. If the informer has already
 	// started, this will fail silently (which is fine).
-	if err := informer.SetTransform(ctrlcache.TransformStripManagedFields()); err != nil {
+	if err := informer.SetTransform(newCacheTransform(s.statusStrippedResources.Has(resource.GroupResource()))); err != nil {
 		klog.V(4).InfoS("Transform already set or informer started", "gvr", resource, "err", err)
 	}
 
 	return informer
 }
+
+// newCacheTransform returns the transform that trims the objects before they are kept in an informer cache.
+//
+// The managed fields of an object are trimmed down to the field managers, i.e., the sets of the fields
+// managed by each field manager are dropped, as only the field managers are used for resource selection.
+func newCacheTransform(stripStatus bool) cache.TransformFunc {
+	stripManagedFields := ctrlcache.TransformStripManagedFields()
+	return func(obj interface{}) (interface{}, error) {
+		u, ok := obj.(*unstructured.Unstructured)
+		if !ok {
+			return stripManagedFields(obj)
+		}
+		if managedFields, found, _ := unstructured.NestedFieldNoCopy(u.Object, "metadata", "managedFields"); found {
+			entries, _ := managedFields.([]interface{})
+			for _, entry := range entries {
+				if entry, ok := entry.(map[string]interface{}); ok {
+					delete(entry, "fieldsV1")
+				}
+			}
+		}
+		if stripStatus {
+			unstructured.RemoveNestedField(u.Object, "status")
+		}
+		return u, nil
+	}
+}
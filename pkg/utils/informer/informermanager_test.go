@@ -19,6 +19,8 @@ package informer
 import (
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/dynamic/fake"
 	"k8s.io/client-go/kubernetes/scheme"
@@ -412,3 +414,79 @@ func TestCreateInformerForResource_IsIdempotent(t *testing.T) {
 		t.Error("Expected resource to be marked as present")
 	}
 }
+
+func TestNewCacheTransform(t *testing.T) {
+	newObj := func() *unstructured.Unstructured {
+		return &unstructured.Unstructured{
+			Object: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"name": "app",
+					"managedFields": []interface{}{
+						map[string]interface{}{
+							"manager":  "kubectl",
+							"fieldsV1": map[string]interface{}{"f:spec": map[string]interface{}{}},
+						},
+					},
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(1),
+				},
+				"status": map[string]interface{}{
+					"replicas": int64(1),
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name        string
+		stripStatus bool
+		want        map[string]interface{}
+	}{
+		{
+			name: "trim the managed fields only",
+			want: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"name":          "app",
+					"managedFields": []interface{}{map[string]interface{}{"manager": "kubectl"}},
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(1),
+				},
+				"status": map[string]interface{}{
+					"replicas": int64(1),
+				},
+			},
+		},
+		{
+			name:        "trim the managed fields and strip the status",
+			stripStatus: true,
+			want: map[string]interface{}{
+				"apiVersion": "apps/v1",
+				"kind":       "Deployment",
+				"metadata": map[string]interface{}{
+					"name":          "app",
+					"managedFields": []interface{}{map[string]interface{}{"manager": "kubectl"}},
+				},
+				"spec": map[string]interface{}{
+					"replicas": int64(1),
+				},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := newCacheTransform(tc.stripStatus)(newObj())
+			if err != nil {
+				t.Fatalf("newCacheTransform() got error %v, want no error", err)
+			}
+			if diff := cmp.Diff(tc.want, got.(*unstructured.Unstructured).Object); diff != "" {
+				t.Errorf("newCacheTransform() transformed object mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -19,11 +19,14 @@ package overrider
 
 import (
 	"context"
+	"encoding/json"
 	"errors"
 	"fmt"
 	"sort"
 	"strconv"
+	"strings"
 
+	jsonpatch "github.com/evanphx/json-patch/v5"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
@@ -37,6 +40,7 @@ import (
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/informer"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/propertyselector"
 )
 
 // FetchAllMatchingOverridesForResourceSnapshot fetches all the matching overrides which are attached to the selected resources.
@@ -165,40 +169,48 @@ func FetchAllMatchingOverridesForResourceSnapshot(
 }
 
 // PickFromResourceMatchedOverridesForTargetCluster filter the overrides that are matched with resources to the target cluster.
+// It also returns a snapshot of the target cluster properties referenced by the property selectors of the picked
+// overrides, which is nil if none of the picked overrides has a property selector.
 func PickFromResourceMatchedOverridesForTargetCluster(
 	ctx context.Context,
 	c client.Reader,
 	targetCluster string,
 	croList []*placementv1beta1.ClusterResourceOverrideSnapshot,
 	roList []*placementv1beta1.ResourceOverrideSnapshot,
-) ([]string, []placementv1beta1.NamespacedName, error) {
+) ([]string, []placementv1beta1.NamespacedName, *placementv1beta1.ClusterPropertySnapshot, error) {
 	if len(croList) == 0 && len(roList) == 0 {
-		return nil, nil, nil
+		return nil, nil, nil, nil
 	}
 
 	cluster := clusterv1beta1.MemberCluster{}
 	if err := c.Get(ctx, types.NamespacedName{Name: targetCluster}, &cluster); err != nil {
 		if apierrors.IsNotFound(err) {
 			klog.V(2).InfoS("MemberCluster has been deleted and we expect that scheduler will update the spec of binding to unscheduled", "memberCluster", targetCluster)
-			return nil, nil, controller.NewExpectedBehaviorError(err)
+			return nil, nil, nil, controller.NewExpectedBehaviorError(err)
 		}
 		klog.ErrorS(err, "Failed to get the memberCluster", "memberCluster", targetCluster)
-		return nil, nil, controller.NewAPIServerError(true, err)
+		return nil, nil, nil, controller.NewAPIServerError(true, err)
 	}
 
+	var propertySelectors []*placementv1beta1.PropertySelector
 	croFiltered := make([]*placementv1beta1.ClusterResourceOverrideSnapshot, 0, len(croList))
 	for i, cro := range croList {
 		matched, err := isClusterMatched(&cluster, cro.Spec.OverrideSpec.Policy)
 		if err != nil {
 			klog.ErrorS(err, "Invalid clusterResourceOverride", "clusterResourceOverride", klog.KObj(cro))
-			return nil, nil, controller.NewUnexpectedBehaviorError(err)
+			return nil, nil, nil, controller.NewUnexpectedBehaviorError(err)
 		}
 		if matched {
 			croFiltered = append(croFiltered, croList[i])
+			propertySelectors = append(propertySelectors, collectPropertySelectors(cro.Spec.OverrideSpec.Policy)...)
 		}
 	}
-	// There are no priority for now and sort the cro list by its name.
+	// Sort the cro list in the order in which the overrides are applied, i.e., by the priority tier and then the name.
 	sort.SliceStable(croFiltered, func(i, j int) bool {
+		iRank, jRank := PriorityTierRank(croFiltered[i].Spec.OverrideSpec.PriorityTier), PriorityTierRank(croFiltered[j].Spec.OverrideSpec.PriorityTier)
+		if iRank != jRank {
+			return iRank < jRank
+		}
 		return croFiltered[i].Name < croFiltered[j].Name
 	})
 
@@ -207,14 +219,20 @@ func PickFromResourceMatchedOverridesForTargetCluster(
 		matched, err := isClusterMatched(&cluster, ro.Spec.OverrideSpec.Policy)
 		if err != nil {
 			klog.ErrorS(err, "Invalid resourceOverride", "resourceOverride", klog.KObj(ro))
-			return nil, nil, controller.NewUnexpectedBehaviorError(err)
+			return nil, nil, nil, controller.NewUnexpectedBehaviorError(err)
 		}
 		if matched {
 			roFiltered = append(roFiltered, roList[i])
+			propertySelectors = append(propertySelectors, collectPropertySelectors(ro.Spec.OverrideSpec.Policy)...)
 		}
 	}
-	// There are no priority for now and sort the ro list by its namespace and then name.
+	// Sort the ro list in the order in which the overrides are applied, i.e., by the priority tier, the namespace
+	// and then the name.
 	sort.SliceStable(roFiltered, func(i, j int) bool {
+		iRank, jRank := PriorityTierRank(roFiltered[i].Spec.OverrideSpec.PriorityTier), PriorityTierRank(roFiltered[j].Spec.OverrideSpec.PriorityTier)
+		if iRank != jRank {
+			return iRank < jRank
+		}
 		if roFiltered[i].Namespace == roFiltered[j].Namespace {
 			return roFiltered[i].Name < roFiltered[j].Name
 		}
@@ -228,8 +246,33 @@ func PickFromResourceMatchedOverridesForTargetCluster(
 	for i, o := range roFiltered {
 		roNames[i] = placementv1beta1.NamespacedName{Name: o.Name, Namespace: o.Namespace}
 	}
+
+	var propertySnapshot *placementv1beta1.ClusterPropertySnapshot
+	if len(propertySelectors) > 0 {
+		var err error
+		if propertySnapshot, err = propertyselector.TakeSnapshot(&cluster, propertySelectors); err != nil {
+			klog.ErrorS(err, "Failed to take the property snapshot of the memberCluster", "memberCluster", targetCluster)
+			return nil, nil, nil, controller.NewUnexpectedBehaviorError(err)
+		}
+	}
 	klog.V(2).InfoS("Found matched overrides for the target cluster", "memberCluster", targetCluster, "matchedCROCount", len(croNames), "matchedROCount", len(roNames))
-	return croNames, roNames, nil
+	return croNames, roNames, propertySnapshot, nil
+}
+
+// PriorityTierRank returns the rank of an override priority tier; the overrides selecting the same resource
+// are applied in the ascending order of the ranks of their tiers, so that the override of the highest rank wins.
+// Overrides without a priority tier have the lowest rank.
+func PriorityTierRank(tier placementv1beta1.OverridePriorityTier) int {
+	switch tier {
+	case placementv1beta1.OverridePriorityTierApp:
+		return 1
+	case placementv1beta1.OverridePriorityTierTeam:
+		return 2
+	case placementv1beta1.OverridePriorityTierPlatform:
+		return 3
+	default:
+		return 0
+	}
 }
 
 func isClusterMatched(cluster *clusterv1beta1.MemberCluster, policy *placementv1beta1.OverridePolicy) (bool, error) {
@@ -237,7 +280,7 @@ func isClusterMatched(cluster *clusterv1beta1.MemberCluster, policy *placementv1
 		return false, errors.New("policy is nil")
 	}
 	for _, rule := range policy.OverrideRules {
-		matched, err := IsClusterMatched(cluster, rule)
+		matched, err := IsClusterMatched(cluster, rule, nil)
 		if err != nil {
 			return false, err
 		}
@@ -248,24 +291,160 @@ func isClusterMatched(cluster *clusterv1beta1.MemberCluster, policy *placementv1
 	return false, nil
 }
 
+// collectPropertySelectors returns all the property selectors used in the override rules of a policy.
+func collectPropertySelectors(policy *placementv1beta1.OverridePolicy) []*placementv1beta1.PropertySelector {
+	var selectors []*placementv1beta1.PropertySelector
+	for _, rule := range policy.OverrideRules {
+		if rule.ClusterSelector == nil {
+			continue
+		}
+		for i := range rule.ClusterSelector.ClusterSelectorTerms {
+			if selector := rule.ClusterSelector.ClusterSelectorTerms[i].PropertySelector; selector != nil {
+				selectors = append(selectors, selector)
+			}
+		}
+	}
+	return selectors
+}
+
 // IsClusterMatched checks if the cluster is matched with the override rules.
-func IsClusterMatched(cluster *clusterv1beta1.MemberCluster, rule placementv1beta1.OverrideRule) (bool, error) {
-	if rule.ClusterSelector == nil { // it means matching no member clusters
+//
+// The property selectors of the rules are evaluated against the given property snapshot if it is not nil,
+// or the live properties of the cluster otherwise.
+func IsClusterMatched(cluster *clusterv1beta1.MemberCluster, rule placementv1beta1.OverrideRule, propertySnapshot *placementv1beta1.ClusterPropertySnapshot) (bool, error) {
+	return isClusterMatchedBySelector(cluster, rule.ClusterSelector, propertySnapshot)
+}
+
+// PickApplyStrategyForCluster returns the apply strategy of the first apply strategy override that
+// selects the cluster, or the given default apply strategy if no override selects the cluster.
+//
+// The property selectors of the overrides are evaluated against the live properties of the cluster.
+func PickApplyStrategyForCluster(
+	cluster *clusterv1beta1.MemberCluster,
+	defaultApplyStrategy *placementv1beta1.ApplyStrategy,
+	overrides []placementv1beta1.ApplyStrategyOverride,
+) (*placementv1beta1.ApplyStrategy, error) {
+	for i := range overrides {
+		matched, err := isClusterMatchedBySelector(cluster, overrides[i].ClusterSelector, nil)
+		if err != nil {
+			return nil, fmt.Errorf("failed to match the cluster against apply strategy override %d: %w", i, err)
+		}
+		if matched {
+			return overrides[i].ApplyStrategy, nil
+		}
+	}
+	return defaultApplyStrategy, nil
+}
+
+// isClusterMatchedBySelector checks if the cluster is matched by any of the terms of a cluster selector.
+func isClusterMatchedBySelector(cluster *clusterv1beta1.MemberCluster, clusterSelector *placementv1beta1.ClusterSelector, propertySnapshot *placementv1beta1.ClusterPropertySnapshot) (bool, error) {
+	if clusterSelector == nil { // it means matching no member clusters
 		return false, nil
 	}
 
-	if len(rule.ClusterSelector.ClusterSelectorTerms) == 0 {
+	if len(clusterSelector.ClusterSelectorTerms) == 0 {
 		return true, nil // it means matching all member clusters
 	}
 
-	for _, term := range rule.ClusterSelector.ClusterSelectorTerms {
-		selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+	for _, term := range clusterSelector.ClusterSelectorTerms {
+		matched, err := isClusterMatchedByTerm(cluster, term, propertySnapshot)
 		if err != nil {
-			return false, fmt.Errorf("invalid cluster label selector %v: %w", term.LabelSelector, err)
+			return false, err
 		}
-		if selector.Matches(labels.Set(cluster.Labels)) {
+		if matched {
 			return true, nil
 		}
 	}
 	return false, nil
 }
+
+// isClusterMatchedByTerm checks if the cluster is matched with both the label selector and the property
+// selector of a cluster selector term.
+func isClusterMatchedByTerm(cluster *clusterv1beta1.MemberCluster, term placementv1beta1.ClusterSelectorTerm, propertySnapshot *placementv1beta1.ClusterPropertySnapshot) (bool, error) {
+	if term.LabelSelector == nil && term.PropertySelector == nil {
+		// A term without any selector matches no member clusters.
+		return false, nil
+	}
+	if term.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(term.LabelSelector)
+		if err != nil {
+			return false, fmt.Errorf("invalid cluster label selector %v: %w", term.LabelSelector, err)
+		}
+		if !selector.Matches(labels.Set(cluster.Labels)) {
+			return false, nil
+		}
+	}
+	if term.PropertySelector == nil {
+		return true, nil
+	}
+
+	var matched bool
+	var err error
+	if propertySnapshot != nil {
+		matched, err = propertyselector.MatchesSnapshot(propertySnapshot, term.PropertySelector)
+	} else {
+		matched, err = propertyselector.Matches(cluster, term.PropertySelector)
+	}
+	if err != nil {
+		return false, fmt.Errorf("invalid cluster property selector %v: %w", term.PropertySelector, err)
+	}
+	return matched, nil
+}
+
+// DryRunJSONPatchOverrides applies the JSON patch overrides on the raw content of a resource without keeping
+// the result, to find out whether the overrides can be rendered on the resource, e.g., whether the patch paths
+// exist and the values are of the expected types.
+//
+// As the actual values of the built-in variables are only known when the overrides are applied for a specific
+// member cluster, the variables are replaced with a placeholder value instead.
+func DryRunJSONPatchOverrides(raw []byte, overrides []placementv1beta1.JSONPatchOverride) error {
+	if len(overrides) == 0 {
+		return nil
+	}
+	patches := make([]placementv1beta1.JSONPatchOverride, len(overrides))
+	for i := range overrides {
+		// Copy the overrides so that the variable replacement does not modify the overrides passed in.
+		overrides[i].DeepCopyInto(&patches[i])
+		jsonStr := strings.ReplaceAll(string(patches[i].Value.Raw), placementv1beta1.OverrideClusterNameVariable, dryRunVariableValue)
+		jsonStr, err := replaceClusterLabelKeyVariablesForDryRun(jsonStr)
+		if err != nil {
+			return err
+		}
+		patches[i].Value.Raw = []byte(jsonStr)
+	}
+
+	jsonPatchBytes, err := json.Marshal(patches)
+	if err != nil {
+		return fmt.Errorf("failed to marshal the JSON patch overrides: %w", err)
+	}
+	patch, err := jsonpatch.DecodePatch(jsonPatchBytes)
+	if err != nil {
+		return fmt.Errorf("failed to decode the JSON patch overrides as an RFC 6902 patch: %w", err)
+	}
+	if _, err := patch.Apply(raw); err != nil {
+		return fmt.Errorf("failed to apply the JSON patch overrides: %w", err)
+	}
+	return nil
+}
+
+// dryRunVariableValue is the placeholder value of the built-in variables in dry runs.
+const dryRunVariableValue = "dry-run"
+
+// replaceClusterLabelKeyVariablesForDryRun replaces all occurrences of the OverrideClusterLabelKeyVariablePrefix
+// pattern (e.g. ${MEMBER-CLUSTER-LABEL-KEY-region}) in the input string with the placeholder value.
+func replaceClusterLabelKeyVariablesForDryRun(input string) (string, error) {
+	prefixLen := len(placementv1beta1.OverrideClusterLabelKeyVariablePrefix)
+	result := input
+	for {
+		startIdx := strings.Index(result, placementv1beta1.OverrideClusterLabelKeyVariablePrefix)
+		if startIdx == -1 {
+			return result, nil
+		}
+		endIdx := strings.Index(result[startIdx+prefixLen:], "}")
+		if endIdx == -1 {
+			return "", fmt.Errorf("input %s is missing the closing bracket `}`", input)
+		}
+		endIdx += startIdx + prefixLen
+		result = result[:startIdx] + dryRunVariableValue + result[endIdx+1:]
+	}
+}
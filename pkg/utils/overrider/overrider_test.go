@@ -24,6 +24,7 @@ import (
 
 	"github.com/google/go-cmp/cmp"
 	"github.com/google/go-cmp/cmp/cmpopts"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -1537,8 +1538,133 @@ func TestPickFromResourceMatchedOverridesForTargetCluster(t *testing.T) {
 		roList  []*placementv1beta1.ResourceOverrideSnapshot
 		wantCRO []string
 		wantRO  []placementv1beta1.NamespacedName
-		wantErr error
+		// wantPropertySnapshot is nil unless specified.
+		wantPropertySnapshot *placementv1beta1.ClusterPropertySnapshot
+		wantErr              error
 	}{
+		{
+			name: "overrides with property selectors",
+			cluster: &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterName,
+				},
+				Status: clusterv1beta1.MemberClusterStatus{
+					Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+						"region":                         {Value: "eu-west"},
+						"kubernetes-fleet.io/node-count": {Value: "60"},
+					},
+				},
+			},
+			croList: []*placementv1beta1.ClusterResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cro-1",
+					},
+					Spec: placementv1beta1.ClusterResourceOverrideSnapshotSpec{
+						OverrideSpec: placementv1beta1.ClusterResourceOverrideSpec{
+							Policy: &placementv1beta1.OverridePolicy{
+								OverrideRules: []placementv1beta1.OverrideRule{
+									{
+										ClusterSelector: &placementv1beta1.ClusterSelector{
+											ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+												{
+													PropertySelector: &placementv1beta1.PropertySelector{
+														MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+															{
+																Name:     "kubernetes-fleet.io/node-count",
+																Operator: placementv1beta1.PropertySelectorGreaterThan,
+																Values:   []string{"50"},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cro-2",
+					},
+					Spec: placementv1beta1.ClusterResourceOverrideSnapshotSpec{
+						OverrideSpec: placementv1beta1.ClusterResourceOverrideSpec{
+							Policy: &placementv1beta1.OverridePolicy{
+								OverrideRules: []placementv1beta1.OverrideRule{
+									{
+										ClusterSelector: &placementv1beta1.ClusterSelector{
+											ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+												{
+													PropertySelector: &placementv1beta1.PropertySelector{
+														MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+															{
+																Name:     "region",
+																Operator: placementv1beta1.PropertySelectorNotIn,
+																Values:   []string{"eu-*"},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			roList: []*placementv1beta1.ResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ro-1",
+						Namespace: "svc-namespace",
+					},
+					Spec: placementv1beta1.ResourceOverrideSnapshotSpec{
+						OverrideSpec: placementv1beta1.ResourceOverrideSpec{
+							Policy: &placementv1beta1.OverridePolicy{
+								OverrideRules: []placementv1beta1.OverrideRule{
+									{
+										ClusterSelector: &placementv1beta1.ClusterSelector{
+											ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+												{
+													PropertySelector: &placementv1beta1.PropertySelector{
+														MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+															{
+																Name:     "region",
+																Operator: placementv1beta1.PropertySelectorIn,
+																Values:   []string{"eu-*"},
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantCRO: []string{"cro-1"},
+			wantRO: []placementv1beta1.NamespacedName{
+				{
+					Name:      "ro-1",
+					Namespace: "svc-namespace",
+				},
+			},
+			// The property used by the unmatched override is not snapshotted; the node count is bucketed.
+			wantPropertySnapshot: &placementv1beta1.ClusterPropertySnapshot{
+				Values: map[string]string{
+					"kubernetes-fleet.io/node-count": "51",
+					"region":                         "eu-west",
+				},
+			},
+		},
 		{
 			name: "empty overrides",
 			cluster: &clusterv1beta1.MemberCluster{
@@ -1549,6 +1675,136 @@ func TestPickFromResourceMatchedOverridesForTargetCluster(t *testing.T) {
 			wantCRO: nil,
 			wantRO:  nil,
 		},
+		{
+			name: "matched overrides ordered by priority tier",
+			cluster: &clusterv1beta1.MemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: clusterName,
+				},
+			},
+			croList: []*placementv1beta1.ClusterResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cro-a",
+					},
+					Spec: placementv1beta1.ClusterResourceOverrideSnapshotSpec{
+						OverrideSpec: placementv1beta1.ClusterResourceOverrideSpec{
+							Policy: &placementv1beta1.OverridePolicy{
+								OverrideRules: []placementv1beta1.OverrideRule{
+									{
+										ClusterSelector: &placementv1beta1.ClusterSelector{},
+									},
+								},
+							},
+							PriorityTier: placementv1beta1.OverridePriorityTierPlatform,
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cro-b",
+					},
+					Spec: placementv1beta1.ClusterResourceOverrideSnapshotSpec{
+						OverrideSpec: placementv1beta1.ClusterResourceOverrideSpec{
+							Policy: &placementv1beta1.OverridePolicy{
+								OverrideRules: []placementv1beta1.OverrideRule{
+									{
+										ClusterSelector: &placementv1beta1.ClusterSelector{},
+									},
+								},
+							},
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "cro-c",
+					},
+					Spec: placementv1beta1.ClusterResourceOverrideSnapshotSpec{
+						OverrideSpec: placementv1beta1.ClusterResourceOverrideSpec{
+							Policy: &placementv1beta1.OverridePolicy{
+								OverrideRules: []placementv1beta1.OverrideRule{
+									{
+										ClusterSelector: &placementv1beta1.ClusterSelector{},
+									},
+								},
+							},
+							PriorityTier: placementv1beta1.OverridePriorityTierApp,
+						},
+					},
+				},
+			},
+			roList: []*placementv1beta1.ResourceOverrideSnapshot{
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ro-a",
+						Namespace: "test",
+					},
+					Spec: placementv1beta1.ResourceOverrideSnapshotSpec{
+						OverrideSpec: placementv1beta1.ResourceOverrideSpec{
+							Policy: &placementv1beta1.OverridePolicy{
+								OverrideRules: []placementv1beta1.OverrideRule{
+									{
+										ClusterSelector: &placementv1beta1.ClusterSelector{},
+									},
+								},
+							},
+							PriorityTier: placementv1beta1.OverridePriorityTierTeam,
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ro-b",
+						Namespace: "app",
+					},
+					Spec: placementv1beta1.ResourceOverrideSnapshotSpec{
+						OverrideSpec: placementv1beta1.ResourceOverrideSpec{
+							Policy: &placementv1beta1.OverridePolicy{
+								OverrideRules: []placementv1beta1.OverrideRule{
+									{
+										ClusterSelector: &placementv1beta1.ClusterSelector{},
+									},
+								},
+							},
+							PriorityTier: placementv1beta1.OverridePriorityTierTeam,
+						},
+					},
+				},
+				{
+					ObjectMeta: metav1.ObjectMeta{
+						Name:      "ro-c",
+						Namespace: "test",
+					},
+					Spec: placementv1beta1.ResourceOverrideSnapshotSpec{
+						OverrideSpec: placementv1beta1.ResourceOverrideSpec{
+							Policy: &placementv1beta1.OverridePolicy{
+								OverrideRules: []placementv1beta1.OverrideRule{
+									{
+										ClusterSelector: &placementv1beta1.ClusterSelector{},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantCRO: []string{"cro-b", "cro-c", "cro-a"},
+			wantRO: []placementv1beta1.NamespacedName{
+				{
+					Namespace: "test",
+					Name:      "ro-c",
+				},
+				{
+					Namespace: "app",
+					Name:      "ro-b",
+				},
+				{
+					Namespace: "test",
+					Name:      "ro-a",
+				},
+			},
+		},
 		{
 			name: "non-latest override snapshots",
 			cluster: &clusterv1beta1.MemberCluster{
@@ -2035,7 +2291,7 @@ func TestPickFromResourceMatchedOverridesForTargetCluster(t *testing.T) {
 				WithScheme(scheme).
 				WithObjects(objects...).
 				Build()
-			gotCRO, gotRO, err := PickFromResourceMatchedOverridesForTargetCluster(context.Background(), fakeClient, clusterName, tc.croList, tc.roList)
+			gotCRO, gotRO, gotPropertySnapshot, err := PickFromResourceMatchedOverridesForTargetCluster(context.Background(), fakeClient, clusterName, tc.croList, tc.roList)
 			if gotErr, wantErr := err != nil, tc.wantErr != nil; gotErr != wantErr || !errors.Is(err, tc.wantErr) {
 				t.Fatalf("pickFromResourceMatchedOverridesForTargetCluster() got error %v, want error %v", err, tc.wantErr)
 			}
@@ -2045,17 +2301,95 @@ func TestPickFromResourceMatchedOverridesForTargetCluster(t *testing.T) {
 			if diff := cmp.Diff(tc.wantRO, gotRO); diff != "" {
 				t.Errorf("pickFromResourceMatchedOverridesForTargetCluster() returned resourceOverrides mismatch (-want, +got):\n%s", diff)
 			}
+			if diff := cmp.Diff(tc.wantPropertySnapshot, gotPropertySnapshot); diff != "" {
+				t.Errorf("pickFromResourceMatchedOverridesForTargetCluster() returned property snapshot mismatch (-want, +got):\n%s", diff)
+			}
 		})
 	}
 }
 
 func TestIsClusterMatched(t *testing.T) {
+	regionPropertySelector := &placementv1beta1.PropertySelector{
+		MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+			{
+				Name:     "region",
+				Operator: placementv1beta1.PropertySelectorIn,
+				Values:   []string{"eu-*"},
+			},
+		},
+	}
+	clusterInEU := clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster-1",
+			Labels: map[string]string{
+				"key1": "value1",
+			},
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				"region": {Value: "eu-west"},
+			},
+		},
+	}
 	tests := []struct {
-		name    string
-		cluster clusterv1beta1.MemberCluster
-		rule    placementv1beta1.OverrideRule
-		want    bool
+		name             string
+		cluster          clusterv1beta1.MemberCluster
+		rule             placementv1beta1.OverrideRule
+		propertySnapshot *placementv1beta1.ClusterPropertySnapshot
+		want             bool
 	}{
+		{
+			name:    "rule with matched property selector",
+			cluster: clusterInEU,
+			rule: placementv1beta1.OverrideRule{
+				ClusterSelector: &placementv1beta1.ClusterSelector{
+					ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+						{
+							PropertySelector: regionPropertySelector,
+						},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name:    "rule with matched property selector but unmatched label selector",
+			cluster: clusterInEU,
+			rule: placementv1beta1.OverrideRule{
+				ClusterSelector: &placementv1beta1.ClusterSelector{
+					ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+						{
+							LabelSelector: &metav1.LabelSelector{
+								MatchLabels: map[string]string{
+									"key1": "value2",
+								},
+							},
+							PropertySelector: regionPropertySelector,
+						},
+					},
+				},
+			},
+			want: false,
+		},
+		{
+			name:    "rule with property selector evaluated against the property snapshot",
+			cluster: clusterInEU,
+			rule: placementv1beta1.OverrideRule{
+				ClusterSelector: &placementv1beta1.ClusterSelector{
+					ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+						{
+							PropertySelector: regionPropertySelector,
+						},
+					},
+				},
+			},
+			propertySnapshot: &placementv1beta1.ClusterPropertySnapshot{
+				Values: map[string]string{
+					"region": "us-east",
+				},
+			},
+			want: false,
+		},
 		{
 			name: "matched overrides with nil cluster selector",
 			cluster: clusterv1beta1.MemberCluster{
@@ -2215,7 +2549,7 @@ func TestIsClusterMatched(t *testing.T) {
 	}
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			got, err := IsClusterMatched(&tc.cluster, tc.rule)
+			got, err := IsClusterMatched(&tc.cluster, tc.rule, tc.propertySnapshot)
 			if err != nil {
 				t.Fatalf("IsClusterMatched() got error %v, want nil", err)
 			}
@@ -2226,3 +2560,206 @@ func TestIsClusterMatched(t *testing.T) {
 		})
 	}
 }
+
+func TestPickApplyStrategyForCluster(t *testing.T) {
+	prodCluster := clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "cluster-1",
+			Labels: map[string]string{
+				"ring": "prod",
+			},
+		},
+	}
+	defaultApplyStrategy := &placementv1beta1.ApplyStrategy{
+		Type: placementv1beta1.ApplyStrategyTypeClientSideApply,
+	}
+	reportDiffApplyStrategy := &placementv1beta1.ApplyStrategy{
+		Type: placementv1beta1.ApplyStrategyTypeReportDiff,
+	}
+	serverSideApplyStrategy := &placementv1beta1.ApplyStrategy{
+		Type: placementv1beta1.ApplyStrategyTypeServerSideApply,
+	}
+	prodSelector := &placementv1beta1.ClusterSelector{
+		ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+			{
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"ring": "prod",
+					},
+				},
+			},
+		},
+	}
+	testSelector := &placementv1beta1.ClusterSelector{
+		ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+			{
+				LabelSelector: &metav1.LabelSelector{
+					MatchLabels: map[string]string{
+						"ring": "test",
+					},
+				},
+			},
+		},
+	}
+	tests := []struct {
+		name      string
+		overrides []placementv1beta1.ApplyStrategyOverride
+		want      *placementv1beta1.ApplyStrategy
+		wantErr   bool
+	}{
+		{
+			name: "no overrides",
+			want: defaultApplyStrategy,
+		},
+		{
+			name: "no override selects the cluster",
+			overrides: []placementv1beta1.ApplyStrategyOverride{
+				{
+					ClusterSelector: testSelector,
+					ApplyStrategy:   reportDiffApplyStrategy,
+				},
+			},
+			want: defaultApplyStrategy,
+		},
+		{
+			name: "the first matching override wins",
+			overrides: []placementv1beta1.ApplyStrategyOverride{
+				{
+					ClusterSelector: testSelector,
+					ApplyStrategy:   serverSideApplyStrategy,
+				},
+				{
+					ClusterSelector: prodSelector,
+					ApplyStrategy:   reportDiffApplyStrategy,
+				},
+				{
+					ClusterSelector: &placementv1beta1.ClusterSelector{},
+					ApplyStrategy:   serverSideApplyStrategy,
+				},
+			},
+			want: reportDiffApplyStrategy,
+		},
+		{
+			name: "nil cluster selector selects no clusters",
+			overrides: []placementv1beta1.ApplyStrategyOverride{
+				{
+					ApplyStrategy: reportDiffApplyStrategy,
+				},
+			},
+			want: defaultApplyStrategy,
+		},
+		{
+			name: "invalid label selector",
+			overrides: []placementv1beta1.ApplyStrategyOverride{
+				{
+					ClusterSelector: &placementv1beta1.ClusterSelector{
+						ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+							{
+								LabelSelector: &metav1.LabelSelector{
+									MatchExpressions: []metav1.LabelSelectorRequirement{
+										{
+											Key:      "ring",
+											Operator: "invalid",
+										},
+									},
+								},
+							},
+						},
+					},
+					ApplyStrategy: reportDiffApplyStrategy,
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := PickApplyStrategyForCluster(&prodCluster, defaultApplyStrategy, tc.overrides)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("PickApplyStrategyForCluster() got error %v, want error %v", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(got, tc.want); diff != "" {
+				t.Errorf("PickApplyStrategyForCluster() mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestDryRunJSONPatchOverrides(t *testing.T) {
+	raw := []byte(`{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"app","labels":{"app":"demo"}},"data":{"replicas":"1"}}`)
+	tests := []struct {
+		name      string
+		overrides []placementv1beta1.JSONPatchOverride
+		wantErr   bool
+	}{
+		{
+			name: "no overrides",
+		},
+		{
+			name: "valid overrides with variables",
+			overrides: []placementv1beta1.JSONPatchOverride{
+				{
+					Operator: placementv1beta1.JSONPatchOverrideOpAdd,
+					Path:     "/metadata/labels/cluster",
+					Value:    apiextensionsv1.JSON{Raw: []byte(`"${MEMBER-CLUSTER-NAME}-${MEMBER-CLUSTER-LABEL-KEY-region}"`)},
+				},
+				{
+					Operator: placementv1beta1.JSONPatchOverrideOpReplace,
+					Path:     "/data/replicas",
+					Value:    apiextensionsv1.JSON{Raw: []byte(`"3"`)},
+				},
+			},
+		},
+		{
+			name: "path does not exist",
+			overrides: []placementv1beta1.JSONPatchOverride{
+				{
+					Operator: placementv1beta1.JSONPatchOverrideOpReplace,
+					Path:     "/spec/replicas",
+					Value:    apiextensionsv1.JSON{Raw: []byte(`3`)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "type mismatch",
+			overrides: []placementv1beta1.JSONPatchOverride{
+				{
+					Operator: placementv1beta1.JSONPatchOverrideOpAdd,
+					Path:     "/data/replicas/0",
+					Value:    apiextensionsv1.JSON{Raw: []byte(`"3"`)},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "malformed label key variable",
+			overrides: []placementv1beta1.JSONPatchOverride{
+				{
+					Operator: placementv1beta1.JSONPatchOverrideOpAdd,
+					Path:     "/metadata/labels/region",
+					Value:    apiextensionsv1.JSON{Raw: []byte(`"${MEMBER-CLUSTER-LABEL-KEY-region"`)},
+				},
+			},
+			wantErr: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			original := make([]placementv1beta1.JSONPatchOverride, len(tc.overrides))
+			for i := range tc.overrides {
+				tc.overrides[i].DeepCopyInto(&original[i])
+			}
+			err := DryRunJSONPatchOverrides(raw, tc.overrides)
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("DryRunJSONPatchOverrides() got error %v, want error %v", err, tc.wantErr)
+			}
+			if diff := cmp.Diff(original, tc.overrides, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("DryRunJSONPatchOverrides() modified the overrides (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package priority features utilities for resolving the priorities of placements.
+package priority
+
+import (
+	"context"
+
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	// DefaultPriority is the priority of placements that do not specify a priority class
+	// when no PlacementPriorityClass is marked as the global default, or that specify a
+	// priority class which does not exist.
+	DefaultPriority int32 = 0
+)
+
+// Resolver resolves the priorities of placements with a snapshot of all the
+// PlacementPriorityClass objects.
+type Resolver struct {
+	values                  map[string]int32
+	preemptionPolicies      map[string]placementv1beta1.PlacementPreemptionPolicy
+	defaultPriority         int32
+	defaultPreemptionPolicy placementv1beta1.PlacementPreemptionPolicy
+}
+
+// NewResolver lists all the PlacementPriorityClass objects and returns a resolver.
+func NewResolver(ctx context.Context, reader client.Reader) (*Resolver, error) {
+	var classList placementv1beta1.PlacementPriorityClassList
+	if err := reader.List(ctx, &classList); err != nil {
+		return nil, err
+	}
+	return NewResolverFromClasses(classList.Items), nil
+}
+
+// NewResolverFromClasses returns a resolver with the given PlacementPriorityClass objects.
+func NewResolverFromClasses(classes []placementv1beta1.PlacementPriorityClass) *Resolver {
+	r := &Resolver{
+		values:                  make(map[string]int32, len(classes)),
+		preemptionPolicies:      make(map[string]placementv1beta1.PlacementPreemptionPolicy, len(classes)),
+		defaultPriority:         DefaultPriority,
+		defaultPreemptionPolicy: placementv1beta1.PreemptNever,
+	}
+	hasGlobalDefault := false
+	for i := range classes {
+		class := &classes[i]
+		r.values[class.Name] = class.Spec.Value
+		r.preemptionPolicies[class.Name] = class.Spec.PreemptionPolicy
+		if !class.Spec.GlobalDefault {
+			continue
+		}
+		// If multiple classes are marked as the global default, the one with the lowest value applies.
+		if !hasGlobalDefault || class.Spec.Value < r.defaultPriority {
+			r.defaultPriority = class.Spec.Value
+			r.defaultPreemptionPolicy = class.Spec.PreemptionPolicy
+			hasGlobalDefault = true
+		}
+	}
+	return r
+}
+
+// PriorityOf returns the priority of the given placement.
+func (r *Resolver) PriorityOf(placement placementv1beta1.PlacementObj) int32 {
+	className := placement.GetPlacementSpec().PriorityClassName
+	if className == "" {
+		return r.defaultPriority
+	}
+	value, ok := r.values[className]
+	if !ok {
+		klog.V(2).InfoS("The priority class of the placement does not exist, use the default priority", "placement", klog.KObj(placement), "priorityClass", className)
+		return DefaultPriority
+	}
+	return value
+}
+
+// PreemptionPolicyOf returns the preemption policy of the given placement.
+//
+// Placements that specify a priority class which does not exist never preempt other placements.
+func (r *Resolver) PreemptionPolicyOf(placement placementv1beta1.PlacementObj) placementv1beta1.PlacementPreemptionPolicy {
+	className := placement.GetPlacementSpec().PriorityClassName
+	policy, ok := r.defaultPreemptionPolicy, true
+	if className != "" {
+		policy, ok = r.preemptionPolicies[className]
+	}
+	if !ok || policy != placementv1beta1.PreemptLowerPriority {
+		return placementv1beta1.PreemptNever
+	}
+	return policy
+}
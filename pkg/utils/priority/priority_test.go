@@ -0,0 +1,183 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package priority
+
+import (
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func priorityClass(name string, value int32, globalDefault bool) placementv1beta1.PlacementPriorityClass {
+	return placementv1beta1.PlacementPriorityClass{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: name,
+		},
+		Spec: placementv1beta1.PlacementPriorityClassSpec{
+			Value:         value,
+			GlobalDefault: globalDefault,
+		},
+	}
+}
+
+func TestResolverPriorityOf(t *testing.T) {
+	tests := []struct {
+		name              string
+		classes           []placementv1beta1.PlacementPriorityClass
+		priorityClassName string
+		want              int32
+	}{
+		{
+			name:              "no priority classes",
+			priorityClassName: "",
+			want:              DefaultPriority,
+		},
+		{
+			name: "priority class specified",
+			classes: []placementv1beta1.PlacementPriorityClass{
+				priorityClass("high", 1000, false),
+				priorityClass("low", -100, true),
+			},
+			priorityClassName: "high",
+			want:              1000,
+		},
+		{
+			name: "priority class not found",
+			classes: []placementv1beta1.PlacementPriorityClass{
+				priorityClass("low", -100, true),
+			},
+			priorityClassName: "high",
+			want:              DefaultPriority,
+		},
+		{
+			name: "global default applies",
+			classes: []placementv1beta1.PlacementPriorityClass{
+				priorityClass("high", 1000, false),
+				priorityClass("medium", 100, true),
+			},
+			want: 100,
+		},
+		{
+			name: "lowest global default applies",
+			classes: []placementv1beta1.PlacementPriorityClass{
+				priorityClass("high", 1000, true),
+				priorityClass("medium", 100, true),
+			},
+			want: 100,
+		},
+		{
+			name: "no global default",
+			classes: []placementv1beta1.PlacementPriorityClass{
+				priorityClass("high", 1000, false),
+			},
+			want: DefaultPriority,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.PlacementSpec{
+					PriorityClassName: tc.priorityClassName,
+				},
+			}
+			r := NewResolverFromClasses(tc.classes)
+			if got := r.PriorityOf(crp); got != tc.want {
+				t.Errorf("PriorityOf() = %d, want %d", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestResolverPreemptionPolicyOf(t *testing.T) {
+	preemptingClass := func(name string, value int32, globalDefault bool) placementv1beta1.PlacementPriorityClass {
+		class := priorityClass(name, value, globalDefault)
+		class.Spec.PreemptionPolicy = placementv1beta1.PreemptLowerPriority
+		return class
+	}
+
+	tests := []struct {
+		name              string
+		classes           []placementv1beta1.PlacementPriorityClass
+		priorityClassName string
+		want              placementv1beta1.PlacementPreemptionPolicy
+	}{
+		{
+			name: "no priority classes",
+			want: placementv1beta1.PreemptNever,
+		},
+		{
+			name: "priority class allows preemption",
+			classes: []placementv1beta1.PlacementPriorityClass{
+				preemptingClass("high", 1000, false),
+			},
+			priorityClassName: "high",
+			want:              placementv1beta1.PreemptLowerPriority,
+		},
+		{
+			name: "priority class does not set the preemption policy",
+			classes: []placementv1beta1.PlacementPriorityClass{
+				priorityClass("high", 1000, false),
+			},
+			priorityClassName: "high",
+			want:              placementv1beta1.PreemptNever,
+		},
+		{
+			name: "priority class not found",
+			classes: []placementv1beta1.PlacementPriorityClass{
+				preemptingClass("medium", 100, true),
+			},
+			priorityClassName: "high",
+			want:              placementv1beta1.PreemptNever,
+		},
+		{
+			name: "global default applies",
+			classes: []placementv1beta1.PlacementPriorityClass{
+				preemptingClass("medium", 100, true),
+			},
+			want: placementv1beta1.PreemptLowerPriority,
+		},
+		{
+			name: "lowest global default applies",
+			classes: []placementv1beta1.PlacementPriorityClass{
+				preemptingClass("high", 1000, true),
+				priorityClass("medium", 100, true),
+			},
+			want: placementv1beta1.PreemptNever,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			crp := &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.PlacementSpec{
+					PriorityClassName: tc.priorityClassName,
+				},
+			}
+			r := NewResolverFromClasses(tc.classes)
+			if got := r.PreemptionPolicyOf(crp); got != tc.want {
+				t.Errorf("PreemptionPolicyOf() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
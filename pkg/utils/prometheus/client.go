@@ -0,0 +1,196 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package prometheus features a minimal client of the Prometheus HTTP API, which evaluates
+// PromQL queries that return a single value.
+package prometheus
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8s.io/klog/v2"
+)
+
+const (
+	// queryPath is the path of the Prometheus HTTP API endpoint for instant queries.
+	queryPath = "/api/v1/query"
+
+	// queryTimeout is the timeout of a query.
+	queryTimeout = 10 * time.Second
+
+	// maxResponseBodyBytes is the max number of bytes of a response body that is read.
+	maxResponseBodyBytes = 1 << 20
+)
+
+// ErrAddressNotAllowed is returned when a query is sent to a Prometheus server whose address is not
+// in the list of the allowed addresses.
+var ErrAddressNotAllowed = errors.New("the Prometheus address is not allowed")
+
+// Querier evaluates PromQL queries.
+type Querier interface {
+	// Query evaluates a PromQL query against the Prometheus server at the given address, and returns
+	// the result; the query must return a scalar or a vector with exactly one sample.
+	Query(ctx context.Context, address, query string) (float64, error)
+}
+
+// querier queries the Prometheus HTTP API.
+type querier struct {
+	httpClient       *http.Client
+	allowedAddresses map[string]bool
+}
+
+// NewQuerier returns a Querier that queries the Prometheus HTTP API with the given HTTP client.
+//
+// Queries are only sent to the Prometheus servers at the given addresses, as the addresses in the
+// queries come from users; a querier without allowed addresses rejects all queries. If no HTTP client
+// is given, the querier uses one that does not follow redirects, so that a server cannot send it
+// elsewhere.
+func NewQuerier(httpClient *http.Client, allowedAddresses []string) Querier {
+	if httpClient == nil {
+		httpClient = &http.Client{
+			Timeout: queryTimeout,
+			CheckRedirect: func(_ *http.Request, _ []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		}
+	}
+	allowed := make(map[string]bool, len(allowedAddresses))
+	for _, address := range allowedAddresses {
+		if address = normalizeAddress(address); address != "" {
+			allowed[address] = true
+		}
+	}
+	return &querier{httpClient: httpClient, allowedAddresses: allowed}
+}
+
+// normalizeAddress returns the address of a Prometheus server in the form used for comparisons.
+func normalizeAddress(address string) string {
+	return strings.TrimSuffix(strings.TrimSpace(address), "/")
+}
+
+// queryResponse is the response of the Prometheus HTTP API for instant queries.
+type queryResponse struct {
+	Status    string    `json:"status"`
+	Data      queryData `json:"data"`
+	ErrorType string    `json:"errorType,omitempty"`
+	Error     string    `json:"error,omitempty"`
+}
+
+type queryData struct {
+	ResultType string          `json:"resultType"`
+	Result     json.RawMessage `json:"result"`
+}
+
+// sample is a sample of an instant vector.
+type sample struct {
+	Metric map[string]string `json:"metric"`
+	Value  []interface{}     `json:"value"`
+}
+
+// Query implements the Querier interface.
+//
+// Note that the returned errors may end up in the status of user-facing objects; they never include
+// the contents of the responses, which are logged instead.
+func (q *querier) Query(ctx context.Context, address, query string) (float64, error) {
+	address = normalizeAddress(address)
+	if !q.allowedAddresses[address] {
+		return 0, fmt.Errorf("%w: %q", ErrAddressNotAllowed, address)
+	}
+	endpoint, err := url.Parse(address + queryPath)
+	if err != nil {
+		return 0, fmt.Errorf("invalid Prometheus address %q: %w", address, err)
+	}
+	endpoint.RawQuery = url.Values{"query": []string{query}}.Encode()
+
+	ctx, cancel := context.WithTimeout(ctx, queryTimeout)
+	defer cancel()
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, endpoint.String(), nil)
+	if err != nil {
+		return 0, fmt.Errorf("failed to build the Prometheus query request: %w", err)
+	}
+	resp, err := q.httpClient.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to query Prometheus: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= http.StatusMultipleChoices && resp.StatusCode < http.StatusBadRequest {
+		return 0, fmt.Errorf("prometheus redirected the query (HTTP status %d), which is not followed", resp.StatusCode)
+	}
+	body, err := io.ReadAll(io.LimitReader(resp.Body, maxResponseBodyBytes))
+	if err != nil {
+		return 0, fmt.Errorf("failed to read the Prometheus query response: %w", err)
+	}
+
+	var queryResp queryResponse
+	if err := json.Unmarshal(body, &queryResp); err != nil {
+		klog.V(2).InfoS("Received an invalid Prometheus query response", "address", address, "statusCode", resp.StatusCode, "err", err)
+		return 0, fmt.Errorf("failed to parse the Prometheus query response (HTTP status %d)", resp.StatusCode)
+	}
+	if queryResp.Status != "success" {
+		klog.V(2).InfoS("Prometheus query failed", "address", address, "statusCode", resp.StatusCode,
+			"errorType", queryResp.ErrorType, "error", queryResp.Error)
+		return 0, fmt.Errorf("prometheus query failed (HTTP status %d)", resp.StatusCode)
+	}
+	return parseResult(queryResp.Data)
+}
+
+// parseResult returns the single value in the result of an instant query.
+func parseResult(data queryData) (float64, error) {
+	switch data.ResultType {
+	case "scalar":
+		var value []interface{}
+		if err := json.Unmarshal(data.Result, &value); err != nil {
+			return 0, fmt.Errorf("failed to parse the scalar result: %w", err)
+		}
+		return parseValue(value)
+	case "vector":
+		var samples []sample
+		if err := json.Unmarshal(data.Result, &samples); err != nil {
+			return 0, fmt.Errorf("failed to parse the vector result: %w", err)
+		}
+		if len(samples) != 1 {
+			return 0, fmt.Errorf("the query returned %d samples, want exactly 1", len(samples))
+		}
+		return parseValue(samples[0].Value)
+	default:
+		return 0, fmt.Errorf("unsupported result type %q, want scalar or vector", data.ResultType)
+	}
+}
+
+// parseValue parses a value in the format of [<unix_time>, "<value>"].
+func parseValue(value []interface{}) (float64, error) {
+	if len(value) != 2 {
+		return 0, fmt.Errorf("invalid value %v", value)
+	}
+	s, ok := value[1].(string)
+	if !ok {
+		return 0, fmt.Errorf("invalid value %v", value)
+	}
+	f, err := strconv.ParseFloat(s, 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value %v: %w", value, err)
+	}
+	return f, nil
+}
@@ -0,0 +1,152 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package prometheus
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+)
+
+func TestQuery(t *testing.T) {
+	tests := []struct {
+		name       string
+		statusCode int
+		response   string
+		want       float64
+		wantErr    bool
+	}{
+		{
+			name:       "vector with one sample",
+			statusCode: http.StatusOK,
+			response:   `{"status":"success","data":{"resultType":"vector","result":[{"metric":{"cluster":"member-1"},"value":[1700000000.1,"0.05"]}]}}`,
+			want:       0.05,
+		},
+		{
+			name:       "scalar",
+			statusCode: http.StatusOK,
+			response:   `{"status":"success","data":{"resultType":"scalar","result":[1700000000.1,"42"]}}`,
+			want:       42,
+		},
+		{
+			name:       "empty vector",
+			statusCode: http.StatusOK,
+			response:   `{"status":"success","data":{"resultType":"vector","result":[]}}`,
+			wantErr:    true,
+		},
+		{
+			name:       "vector with multiple samples",
+			statusCode: http.StatusOK,
+			response:   `{"status":"success","data":{"resultType":"vector","result":[{"metric":{},"value":[1,"1"]},{"metric":{},"value":[1,"2"]}]}}`,
+			wantErr:    true,
+		},
+		{
+			name:       "matrix",
+			statusCode: http.StatusOK,
+			response:   `{"status":"success","data":{"resultType":"matrix","result":[]}}`,
+			wantErr:    true,
+		},
+		{
+			name:       "query error",
+			statusCode: http.StatusBadRequest,
+			response:   `{"status":"error","errorType":"bad_data","error":"invalid parameter \"query\""}`,
+			wantErr:    true,
+		},
+		{
+			name:       "not a Prometheus response",
+			statusCode: http.StatusBadGateway,
+			response:   `bad gateway`,
+			wantErr:    true,
+		},
+		{
+			name:       "redirect",
+			statusCode: http.StatusFound,
+			response:   `{"status":"success","data":{"resultType":"scalar","result":[1700000000.1,"42"]}}`,
+			wantErr:    true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var gotQuery string
+			server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != queryPath {
+					http.NotFound(w, r)
+					return
+				}
+				gotQuery = r.URL.Query().Get("query")
+				if tc.statusCode == http.StatusFound {
+					w.Header().Set("Location", "http://169.254.169.254/")
+				}
+				w.WriteHeader(tc.statusCode)
+				_, _ = w.Write([]byte(tc.response))
+			}))
+			defer server.Close()
+
+			query := `sum(rate(http_requests_total{cluster="member-1",code=~"5.."}[5m]))`
+			got, err := NewQuerier(nil, []string{server.URL}).Query(context.Background(), server.URL+"/", query)
+			if (err != nil) != tc.wantErr {
+				t.Fatalf("Query() error = %v, wantErr %v", err, tc.wantErr)
+			}
+			if err != nil && strings.Contains(err.Error(), tc.response) {
+				t.Errorf("Query() error = %v, want no response body in the error", err)
+			}
+			if gotQuery != query {
+				t.Errorf("Query() sent query %q, want %q", gotQuery, query)
+			}
+			if got != tc.want {
+				t.Errorf("Query() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+func TestQuery_AddressNotAllowed(t *testing.T) {
+	var queried bool
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		queried = true
+		_, _ = w.Write([]byte(`{"status":"success","data":{"resultType":"scalar","result":[1700000000.1,"42"]}}`))
+	}))
+	defer server.Close()
+
+	tests := []struct {
+		name             string
+		allowedAddresses []string
+	}{
+		{
+			name: "no allowed addresses",
+		},
+		{
+			name:             "address not in the allowed addresses",
+			allowedAddresses: []string{"http://prometheus.monitoring:9090"},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			queried = false
+			_, err := NewQuerier(nil, tc.allowedAddresses).Query(context.Background(), server.URL, "up")
+			if !errors.Is(err, ErrAddressNotAllowed) {
+				t.Errorf("Query() error = %v, want %v", err, ErrAddressNotAllowed)
+			}
+			if queried {
+				t.Errorf("Query() sent the query to an address that is not allowed")
+			}
+		})
+	}
+}
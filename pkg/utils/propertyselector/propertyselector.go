@@ -0,0 +1,394 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package propertyselector features utilities for matching member clusters against property selectors,
+// which are shared by the scheduler and the override logic.
+package propertyselector
+
+import (
+	"fmt"
+	"math/big"
+	"path"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+)
+
+// RetrieveResourceUsageFrom retrieves a resource property value from a member cluster.
+//
+// Note that it will return nil if the property is not available for the cluster;
+// the zero value of resource.Quantity, i.e., resource.Quantity{}, is a valid
+// quantity.
+func RetrieveResourceUsageFrom(cluster *clusterv1beta1.MemberCluster, name string) (*resource.Quantity, error) {
+	// Split the name into two segments, the capacity type, and the resource name.
+	//
+	// As a pre-defined rule, all the resource properties are assigned a label name of the format
+	// `[PREFIX]/[CAPACITY_TYPE]-[RESOURCE_NAME]`; for example, the allocatable CPU capacity of a
+	// a cluster has the label name, `resources.kubernetes-fleet.io/allocatable-cpu`. Note that at
+	// this point of process, the prefix has been removed.
+	segs := strings.Split(name, "-")
+	if len(segs) != 2 || len(segs[0]) == 0 || len(segs[1]) == 0 {
+		return nil, fmt.Errorf("invalid resource property name: %s", name)
+	}
+	cn, tn := segs[0], segs[1]
+
+	// Query the resource usage data.
+	var q resource.Quantity
+	var found bool
+	switch cn {
+	case propertyprovider.TotalCapacityName:
+		// The property concerns the total capacity of a resource.
+		q, found = cluster.Status.ResourceUsage.Capacity[corev1.ResourceName(tn)]
+	case propertyprovider.AllocatableCapacityName:
+		// The property concerns the allocatable capacity of a resource.
+		q, found = cluster.Status.ResourceUsage.Allocatable[corev1.ResourceName(tn)]
+	case propertyprovider.AvailableCapacityName:
+		// The property concerns the available capacity of a resource.
+		q, found = cluster.Status.ResourceUsage.Available[corev1.ResourceName(tn)]
+	default:
+		// The property concerns a capacity type that cannot be recognized.
+		return nil, fmt.Errorf("invalid capacity type %s in resource property name %s", cn, name)
+	}
+
+	if !found {
+		// The property concerns a resource that is not present in the resource usage data.
+		//
+		// It could be that the resource is not available in the cluster; consequently Fleet
+		// does not consider this as an error.
+		return nil, nil
+	}
+	return &q, nil
+}
+
+// RetrievePropertyValueFrom retrieves a property value, resource or non-resource,
+// from a member cluster.
+//
+// Note that it will return nil if the property is not available for the cluster;
+// the zero value of resource.Quantity, i.e., resource.Quantity{}, is a valid
+// quantity.
+func RetrievePropertyValueFrom(cluster *clusterv1beta1.MemberCluster, name string) (*resource.Quantity, error) {
+	// Check if the expression concerns a resource property.
+	var q *resource.Quantity
+	var err error
+	if strings.HasPrefix(name, propertyprovider.ResourcePropertyNamePrefix) {
+		name, _ := strings.CutPrefix(name, propertyprovider.ResourcePropertyNamePrefix)
+
+		// Retrieve the property value from the cluster resource usage data.
+		q, err = RetrieveResourceUsageFrom(cluster, name)
+		if err != nil {
+			return nil, fmt.Errorf("failed to retrieve resource property value for %s from cluster %s: %w", name, cluster.Name, err)
+		}
+	} else {
+		v, found := cluster.Status.Properties[clusterv1beta1.PropertyName(name)]
+		if !found {
+			// The property is not available for the cluster.
+			//
+			// Note that this is not considered an error.
+			return nil, nil
+		}
+		qv, err := resource.ParseQuantity(v.Value)
+		if err != nil {
+			return nil, fmt.Errorf("value %s of property %s from cluster %s is not a valid quantity: %w", v.Value, name, cluster.Name, err)
+		}
+		q = &qv
+	}
+	return q, nil
+}
+
+// retrieveRawPropertyValueFrom retrieves a property value, resource or non-resource, from a member
+// cluster in its string form, without parsing it as a quantity.
+//
+// Note that it will return false if the property is not available for the cluster.
+func retrieveRawPropertyValueFrom(cluster *clusterv1beta1.MemberCluster, name string) (string, bool, error) {
+	if strings.HasPrefix(name, propertyprovider.ResourcePropertyNamePrefix) {
+		q, err := RetrievePropertyValueFrom(cluster, name)
+		if err != nil || q == nil {
+			return "", false, err
+		}
+		return q.String(), true, nil
+	}
+
+	v, found := cluster.Status.Properties[clusterv1beta1.PropertyName(name)]
+	if !found {
+		return "", false, nil
+	}
+	return v.Value, true, nil
+}
+
+// Matches checks if a member cluster matches a property selector.
+//
+// A cluster matches a property selector if its observed values satisfy all the match expressions;
+// if a property is not available for the cluster, the cluster does not match.
+func Matches(cluster *clusterv1beta1.MemberCluster, selector *placementv1beta1.PropertySelector) (bool, error) {
+	return matches(selector, func(name string) (string, bool, error) {
+		return retrieveRawPropertyValueFrom(cluster, name)
+	})
+}
+
+// MatchesSnapshot checks if a snapshot of property values, as taken by TakeSnapshot, matches a
+// property selector.
+//
+// Properties that are not present in the snapshot are considered as not available.
+func MatchesSnapshot(snapshot *placementv1beta1.ClusterPropertySnapshot, selector *placementv1beta1.PropertySelector) (bool, error) {
+	return matches(selector, func(name string) (string, bool, error) {
+		v, found := snapshot.Values[name]
+		return v, found, nil
+	})
+}
+
+// TakeSnapshot takes a snapshot of the values of all the properties referenced by the given property
+// selectors from a member cluster.
+//
+// To keep the snapshot stable as the observed values fluctuate, e.g., the available CPU capacity of a
+// cluster, a property that the selectors compare with quantities only is bucketed: the snapshot keeps a
+// representative value that satisfies the same match expressions as the observed one, so that it changes
+// only when the observed value crosses one of the quantities specified in the selectors. Properties that
+// are matched against glob patterns are kept as observed.
+//
+// Properties that are not available for the cluster are absent from the snapshot.
+func TakeSnapshot(cluster *clusterv1beta1.MemberCluster, selectors []*placementv1beta1.PropertySelector) (*placementv1beta1.ClusterPropertySnapshot, error) {
+	// Group the match expressions by the properties they reference.
+	var names []string
+	expsByName := make(map[string][]placementv1beta1.PropertySelectorRequirement)
+	for _, selector := range selectors {
+		if selector == nil {
+			continue
+		}
+		for _, exp := range selector.MatchExpressions {
+			if _, found := expsByName[exp.Name]; !found {
+				names = append(names, exp.Name)
+			}
+			expsByName[exp.Name] = append(expsByName[exp.Name], exp)
+		}
+	}
+
+	snapshot := &placementv1beta1.ClusterPropertySnapshot{}
+	for _, name := range names {
+		v, found, err := retrieveRawPropertyValueFrom(cluster, name)
+		if err != nil {
+			return nil, err
+		}
+		if !found {
+			continue
+		}
+		if snapshot.Values == nil {
+			snapshot.Values = make(map[string]string)
+		}
+		snapshot.Values[name] = bucketPropertyValue(v, expsByName[name])
+	}
+	return snapshot, nil
+}
+
+// bucketPropertyValue returns a representative value of an observed property value, which satisfies the
+// same given match expressions as the observed one; all the observed values that fall in the same range
+// between the quantities specified in the expressions share the same representative value.
+//
+// The observed value is returned as it is if any of the expressions matches it against glob patterns, or
+// if no representative value can be found.
+func bucketPropertyValue(v string, exps []placementv1beta1.PropertySelectorRequirement) string {
+	q, err := resource.ParseQuantity(v)
+	if err != nil {
+		// The observed value is not a quantity; matching reports the error.
+		return v
+	}
+
+	var thresholds []resource.Quantity
+	for _, exp := range exps {
+		if exp.Operator == placementv1beta1.PropertySelectorIn || exp.Operator == placementv1beta1.PropertySelectorNotIn {
+			return v
+		}
+		for _, ev := range exp.Values {
+			t, err := resource.ParseQuantity(ev)
+			if err != nil {
+				// The expected value is not a quantity; matching reports the error.
+				return v
+			}
+			thresholds = append(thresholds, t)
+		}
+	}
+	if len(thresholds) == 0 {
+		return v
+	}
+	slices.SortFunc(thresholds, func(a, b resource.Quantity) int {
+		return a.Cmp(b)
+	})
+
+	// Find the first threshold that is greater than or equal to the observed value.
+	idx, _ := slices.BinarySearchFunc(thresholds, q, func(t, target resource.Quantity) int {
+		return t.Cmp(target)
+	})
+	var rep resource.Quantity
+	switch {
+	case idx < len(thresholds) && thresholds[idx].Cmp(q) == 0:
+		rep = thresholds[idx]
+	case idx == 0:
+		rep = thresholds[0].DeepCopy()
+		rep.Sub(resource.MustParse("1"))
+	case idx == len(thresholds):
+		rep = thresholds[idx-1].DeepCopy()
+		rep.Add(resource.MustParse("1"))
+	default:
+		mid, err := midpoint(thresholds[idx-1], thresholds[idx])
+		if err != nil {
+			return v
+		}
+		rep = mid
+	}
+
+	// Verify that the representative value satisfies the same expressions as the observed one, which
+	// might not be the case if the two adjacent thresholds are too close for a value in between.
+	repV := rep.String()
+	for _, exp := range exps {
+		selector := &placementv1beta1.PropertySelector{MatchExpressions: []placementv1beta1.PropertySelectorRequirement{exp}}
+		observedMatched, err := matches(selector, func(string) (string, bool, error) { return v, true, nil })
+		if err != nil {
+			return v
+		}
+		repMatched, err := matches(selector, func(string) (string, bool, error) { return repV, true, nil })
+		if err != nil || repMatched != observedMatched {
+			return v
+		}
+	}
+	return repV
+}
+
+// midpoint returns the quantity halfway between two quantities.
+func midpoint(a, b resource.Quantity) (resource.Quantity, error) {
+	ra, ok := new(big.Rat).SetString(a.AsDec().String())
+	if !ok {
+		return resource.Quantity{}, fmt.Errorf("failed to convert quantity %s to a rational number", a.String())
+	}
+	rb, ok := new(big.Rat).SetString(b.AsDec().String())
+	if !ok {
+		return resource.Quantity{}, fmt.Errorf("failed to convert quantity %s to a rational number", b.String())
+	}
+	mid := new(big.Rat).Add(ra, rb)
+	mid.Quo(mid, big.NewRat(2, 1))
+	// Quantities are of nano precision at most.
+	return resource.ParseQuantity(mid.FloatString(9))
+}
+
+// matches checks if the property values, retrieved with the given function, match a property selector.
+func matches(selector *placementv1beta1.PropertySelector, retrieve func(name string) (string, bool, error)) (bool, error) {
+	if selector == nil {
+		return true, nil
+	}
+
+	for _, exp := range selector.MatchExpressions {
+		v, found, err := retrieve(exp.Name)
+		if err != nil {
+			return false, err
+		}
+		if !found {
+			// The property is not available for the cluster.
+			return false, nil
+		}
+
+		switch exp.Operator {
+		case placementv1beta1.PropertySelectorIn, placementv1beta1.PropertySelectorNotIn:
+			matched, err := matchesAnyPattern(v, exp.Values)
+			if err != nil {
+				return false, err
+			}
+			if matched != (exp.Operator == placementv1beta1.PropertySelectorIn) {
+				// The observed value matches none of the patterns (In is expected) or matches one
+				// of the patterns (NotIn is expected).
+				return false, nil
+			}
+			continue
+		}
+
+		q, err := resource.ParseQuantity(v)
+		if err != nil {
+			return false, fmt.Errorf("value %s of property %s is not a valid quantity: %w", v, exp.Name, err)
+		}
+
+		// With the current set of comparison operators, only one expected value can be specified.
+		if len(exp.Values) != 1 {
+			// The property selector expression is invalid, as there are too many expected
+			// values.
+			//
+			// Normally this should never happen.
+			return false, fmt.Errorf("more than one value in the property selector expression")
+		}
+		expectedQ, err := resource.ParseQuantity(exp.Values[0])
+		if err != nil {
+			return false, fmt.Errorf("value specified in property selector %s is not a valid resource quantity: %w", exp.Values[0], err)
+		}
+
+		switch exp.Operator {
+		case placementv1beta1.PropertySelectorEqualTo:
+			if !q.Equal(expectedQ) {
+				// The observed value is not equal to the expected one (equality is expected)
+				return false, nil
+			}
+		case placementv1beta1.PropertySelectorNotEqualTo:
+			if q.Equal(expectedQ) {
+				// The observed value is equal to the expected one (inequality is expected).
+				return false, nil
+			}
+		case placementv1beta1.PropertySelectorGreaterThan:
+			if q.Cmp(expectedQ) <= 0 {
+				// The observed value is less than or equal to the expected one (expected to be
+				// greater than the value).
+				return false, nil
+			}
+		case placementv1beta1.PropertySelectorGreaterThanOrEqualTo:
+			if q.Cmp(expectedQ) < 0 {
+				// The observed value is less than the expected one (expected to be greater
+				// than or equal to the value).
+				return false, nil
+			}
+		case placementv1beta1.PropertySelectorLessThan:
+			if q.Cmp(expectedQ) >= 0 {
+				// The observed value is greater than or equal to the expected one (expected to be
+				// less than the value).
+				return false, nil
+			}
+		case placementv1beta1.PropertySelectorLessThanOrEqualTo:
+			if q.Cmp(expectedQ) > 0 {
+				// The observed value is greater than the expected one (expected to be less than
+				// or equal to the value).
+				return false, nil
+			}
+		default:
+			// The operator is not recognized; normally this should never happen.
+			return false, fmt.Errorf("invalid operator: %s", exp.Operator)
+		}
+	}
+	// The cluster matches the property selector.
+	return true, nil
+}
+
+// matchesAnyPattern checks if a value matches any of the given glob patterns.
+func matchesAnyPattern(value string, patterns []string) (bool, error) {
+	for _, pattern := range patterns {
+		matched, err := path.Match(pattern, value)
+		if err != nil {
+			return false, fmt.Errorf("value specified in property selector %s is not a valid pattern: %w", pattern, err)
+		}
+		if matched {
+			return true, nil
+		}
+	}
+	return false, nil
+}
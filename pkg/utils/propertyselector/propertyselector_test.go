@@ -0,0 +1,554 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package propertyselector
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
+)
+
+const (
+	clusterName                        = "cluster-1"
+	regionPropertyName                 = "region"
+	nonExistentNonResourcePropertyName = "non-existent-non-resource-property"
+	invalidNonResourcePropertyName     = "invalid-non-resource-property"
+)
+
+var (
+	testCluster = &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			ResourceUsage: clusterv1beta1.ResourceUsage{
+				Available: corev1.ResourceList{
+					corev1.ResourceCPU: resource.MustParse("2"),
+				},
+			},
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				propertyprovider.NodeCountProperty: {
+					Value: "60",
+				},
+				regionPropertyName: {
+					Value: "eu-west",
+				},
+				invalidNonResourcePropertyName: {
+					Value: "invalid",
+				},
+			},
+		},
+	}
+)
+
+// TestRetrieveResourceUsageFrom tests the RetrieveResourceUsageFrom function.
+func TestRetrieveResourceUsageFrom(t *testing.T) {
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			ResourceUsage: clusterv1beta1.ResourceUsage{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("10"),
+					corev1.ResourceMemory: resource.MustParse("40Gi"),
+				},
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("8"),
+					corev1.ResourceMemory: resource.MustParse("36Gi"),
+				},
+				Available: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		cluster        *clusterv1beta1.MemberCluster
+		propertyName   string
+		wantQuantity   *resource.Quantity
+		expectedToFail bool
+	}{
+		{
+			name:           "invalid property name (multiple segments)",
+			propertyName:   "resources.kubernetes-fleet.io/allocatable-cpu",
+			expectedToFail: true,
+		},
+		{
+			name:           "invalid property name (no capacity type)",
+			propertyName:   "-cpu",
+			expectedToFail: true,
+		},
+		{
+			name:           "invalid property name (no resource name)",
+			propertyName:   "allocatable-",
+			expectedToFail: true,
+		},
+		{
+			name:           "invalid property name (not a known capacity type)",
+			propertyName:   "additional-",
+			expectedToFail: true,
+		},
+		{
+			name:         "resource not available",
+			propertyName: "allocatable-gpu",
+			cluster:      cluster,
+		},
+		{
+			name:         "total capacity usage",
+			propertyName: "total-cpu",
+			cluster:      cluster,
+			wantQuantity: ptr.To(resource.MustParse("10")),
+		},
+		{
+			name:         "allocatable capacity usage",
+			propertyName: "allocatable-memory",
+			cluster:      cluster,
+			wantQuantity: ptr.To(resource.MustParse("36Gi")),
+		},
+		{
+			name:         "available capacity usage",
+			propertyName: "available-cpu",
+			cluster:      cluster,
+			wantQuantity: ptr.To(resource.MustParse("2")),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			q, err := RetrieveResourceUsageFrom(tc.cluster, tc.propertyName)
+			if tc.expectedToFail {
+				if err == nil {
+					t.Errorf("RetrieveResourceUsageFrom(), want error, got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("RetrieveResourceUsageFrom() = %v, want nil", err)
+			}
+			if diff := cmp.Diff(q, tc.wantQuantity); diff != "" {
+				t.Errorf("RetrieveResourceUsageFrom() quantity diff (-got, +want): %s\n", diff)
+			}
+		})
+	}
+}
+
+// TestRetrievePropertyValueFrom tests the RetrievePropertyValueFrom function.
+func TestRetrievePropertyValueFrom(t *testing.T) {
+	cluster := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+		},
+		Status: clusterv1beta1.MemberClusterStatus{
+			ResourceUsage: clusterv1beta1.ResourceUsage{
+				Capacity: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("10"),
+					corev1.ResourceMemory: resource.MustParse("40Gi"),
+				},
+				Allocatable: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("8"),
+					corev1.ResourceMemory: resource.MustParse("36Gi"),
+				},
+				Available: corev1.ResourceList{
+					corev1.ResourceCPU:    resource.MustParse("2"),
+					corev1.ResourceMemory: resource.MustParse("4Gi"),
+				},
+			},
+			Properties: map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue{
+				propertyprovider.NodeCountProperty: {
+					Value: "4",
+				},
+				invalidNonResourcePropertyName: {
+					Value: "invalid",
+				},
+			},
+		},
+	}
+
+	testCases := []struct {
+		name           string
+		cluster        *clusterv1beta1.MemberCluster
+		propertyName   string
+		wantQuantity   *resource.Quantity
+		expectedToFail bool
+	}{
+		{
+			name:           "invalid resource property (name format error)",
+			propertyName:   "resources.kubernetes-fleet.io/allocatable",
+			cluster:        cluster,
+			expectedToFail: true,
+		},
+		{
+			name:         "resource property retrieval",
+			propertyName: propertyprovider.AvailableMemoryCapacityProperty,
+			cluster:      cluster,
+			wantQuantity: ptr.To(resource.MustParse("4Gi")),
+		},
+		{
+			name:         "absent non-resource property",
+			propertyName: nonExistentNonResourcePropertyName,
+			cluster:      cluster,
+		},
+		{
+			name:           "invalid non-resource property (value format error)",
+			propertyName:   invalidNonResourcePropertyName,
+			cluster:        cluster,
+			expectedToFail: true,
+		},
+		{
+			name:         "non-resource property retrieval",
+			propertyName: propertyprovider.NodeCountProperty,
+			wantQuantity: ptr.To(resource.MustParse("4")),
+			cluster:      cluster,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			q, err := RetrievePropertyValueFrom(tc.cluster, tc.propertyName)
+			if tc.expectedToFail {
+				if err == nil {
+					t.Errorf("RetrievePropertyValueFrom(), want error, got nil")
+				}
+				return
+			}
+
+			if err != nil {
+				t.Errorf("RetrievePropertyValueFrom() = %v, want nil", err)
+			}
+			if diff := cmp.Diff(q, tc.wantQuantity); diff != "" {
+				t.Errorf("RetrievePropertyValueFrom() quantity diff (-got, +want): %s\n", diff)
+			}
+		})
+	}
+}
+
+// TestMatches tests the Matches function.
+func TestMatches(t *testing.T) {
+	testCases := []struct {
+		name     string
+		selector *placementv1beta1.PropertySelector
+		want     bool
+		wantErr  bool
+	}{
+		{
+			name: "nil selector",
+			want: true,
+		},
+		{
+			name: "non-resource property, Gt, matched",
+			selector: &placementv1beta1.PropertySelector{
+				MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+					{
+						Name:     propertyprovider.NodeCountProperty,
+						Operator: placementv1beta1.PropertySelectorGreaterThan,
+						Values:   []string{"50"},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "resource property, Ge, not matched",
+			selector: &placementv1beta1.PropertySelector{
+				MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+					{
+						Name:     propertyprovider.AvailableCPUCapacityProperty,
+						Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
+						Values:   []string{"4"},
+					},
+				},
+			},
+		},
+		{
+			name: "In, matched by a glob pattern",
+			selector: &placementv1beta1.PropertySelector{
+				MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+					{
+						Name:     regionPropertyName,
+						Operator: placementv1beta1.PropertySelectorIn,
+						Values:   []string{"us-*", "eu-*"},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "In, not matched",
+			selector: &placementv1beta1.PropertySelector{
+				MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+					{
+						Name:     regionPropertyName,
+						Operator: placementv1beta1.PropertySelectorIn,
+						Values:   []string{"us-*"},
+					},
+				},
+			},
+		},
+		{
+			name: "NotIn, matched",
+			selector: &placementv1beta1.PropertySelector{
+				MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+					{
+						Name:     regionPropertyName,
+						Operator: placementv1beta1.PropertySelectorNotIn,
+						Values:   []string{"us-*", "ap-*"},
+					},
+				},
+			},
+			want: true,
+		},
+		{
+			name: "NotIn, not matched",
+			selector: &placementv1beta1.PropertySelector{
+				MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+					{
+						Name:     regionPropertyName,
+						Operator: placementv1beta1.PropertySelectorNotIn,
+						Values:   []string{"eu-west"},
+					},
+				},
+			},
+		},
+		{
+			name: "multiple expressions, one not matched",
+			selector: &placementv1beta1.PropertySelector{
+				MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+					{
+						Name:     regionPropertyName,
+						Operator: placementv1beta1.PropertySelectorIn,
+						Values:   []string{"eu-*"},
+					},
+					{
+						Name:     propertyprovider.NodeCountProperty,
+						Operator: placementv1beta1.PropertySelectorLessThan,
+						Values:   []string{"50"},
+					},
+				},
+			},
+		},
+		{
+			name: "absent property",
+			selector: &placementv1beta1.PropertySelector{
+				MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+					{
+						Name:     nonExistentNonResourcePropertyName,
+						Operator: placementv1beta1.PropertySelectorNotIn,
+						Values:   []string{"*"},
+					},
+				},
+			},
+		},
+		{
+			name: "invalid glob pattern",
+			selector: &placementv1beta1.PropertySelector{
+				MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+					{
+						Name:     regionPropertyName,
+						Operator: placementv1beta1.PropertySelectorIn,
+						Values:   []string{"[eu"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid quantity for comparison",
+			selector: &placementv1beta1.PropertySelector{
+				MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+					{
+						Name:     invalidNonResourcePropertyName,
+						Operator: placementv1beta1.PropertySelectorEqualTo,
+						Values:   []string{"1"},
+					},
+				},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Matches(testCluster, tc.selector)
+			if tc.wantErr {
+				if err == nil {
+					t.Fatalf("Matches() = %v, want error", got)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Matches() = %v, want no error", err)
+			}
+			if got != tc.want {
+				t.Errorf("Matches() = %v, want %v", got, tc.want)
+			}
+		})
+	}
+}
+
+// TestTakeSnapshot tests the TakeSnapshot function.
+func TestTakeSnapshot(t *testing.T) {
+	selectors := []*placementv1beta1.PropertySelector{
+		{
+			MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+				{
+					Name:     regionPropertyName,
+					Operator: placementv1beta1.PropertySelectorIn,
+					Values:   []string{"eu-*"},
+				},
+			},
+		},
+		nil,
+		{
+			MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+				{
+					Name:     propertyprovider.AvailableCPUCapacityProperty,
+					Operator: placementv1beta1.PropertySelectorGreaterThan,
+					Values:   []string{"1"},
+				},
+				{
+					Name:     nonExistentNonResourcePropertyName,
+					Operator: placementv1beta1.PropertySelectorIn,
+					Values:   []string{"*"},
+				},
+			},
+		},
+	}
+
+	got, err := TakeSnapshot(testCluster, selectors)
+	if err != nil {
+		t.Fatalf("TakeSnapshot() = %v, want no error", err)
+	}
+	want := &placementv1beta1.ClusterPropertySnapshot{
+		Values: map[string]string{
+			regionPropertyName: "eu-west",
+			propertyprovider.AvailableCPUCapacityProperty: "2",
+		},
+	}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("TakeSnapshot() snapshot mismatch (-got, +want):\n%s", diff)
+	}
+
+	// The snapshot should keep matching the selectors the same way after the cluster properties change.
+	changedCluster := testCluster.DeepCopy()
+	changedCluster.Status.Properties[regionPropertyName] = clusterv1beta1.PropertyValue{Value: "us-east"}
+	if matched, err := Matches(changedCluster, selectors[0]); err != nil || matched {
+		t.Fatalf("Matches() = %v, %v, want false, no error", matched, err)
+	}
+	if matched, err := MatchesSnapshot(got, selectors[0]); err != nil || !matched {
+		t.Errorf("MatchesSnapshot() = %v, %v, want true, no error", matched, err)
+	}
+}
+
+func TestBucketPropertyValue(t *testing.T) {
+	testCases := []struct {
+		name string
+		v    string
+		exps []placementv1beta1.PropertySelectorRequirement
+		want string
+	}{
+		{
+			name: "below the lowest threshold",
+			v:    "500m",
+			exps: []placementv1beta1.PropertySelectorRequirement{
+				{Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo, Values: []string{"2"}},
+				{Operator: placementv1beta1.PropertySelectorLessThan, Values: []string{"8"}},
+			},
+			want: "1",
+		},
+		{
+			name: "between two thresholds",
+			v:    "3700m",
+			exps: []placementv1beta1.PropertySelectorRequirement{
+				{Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo, Values: []string{"2"}},
+				{Operator: placementv1beta1.PropertySelectorLessThan, Values: []string{"8"}},
+			},
+			want: "5",
+		},
+		{
+			name: "between two thresholds, another observed value",
+			v:    "6",
+			exps: []placementv1beta1.PropertySelectorRequirement{
+				{Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo, Values: []string{"2"}},
+				{Operator: placementv1beta1.PropertySelectorLessThan, Values: []string{"8"}},
+			},
+			want: "5",
+		},
+		{
+			name: "at a threshold",
+			v:    "8",
+			exps: []placementv1beta1.PropertySelectorRequirement{
+				{Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo, Values: []string{"2"}},
+				{Operator: placementv1beta1.PropertySelectorLessThan, Values: []string{"8000m"}},
+			},
+			want: "8",
+		},
+		{
+			name: "above the highest threshold",
+			v:    "100Gi",
+			exps: []placementv1beta1.PropertySelectorRequirement{
+				{Operator: placementv1beta1.PropertySelectorGreaterThan, Values: []string{"10Gi"}},
+			},
+			want: "10737418241",
+		},
+		{
+			name: "close thresholds",
+			v:    "1500000001n",
+			exps: []placementv1beta1.PropertySelectorRequirement{
+				{Operator: placementv1beta1.PropertySelectorGreaterThan, Values: []string{"1500000000n"}},
+				{Operator: placementv1beta1.PropertySelectorLessThan, Values: []string{"1500000002n"}},
+			},
+			want: "1.500000001",
+		},
+		{
+			name: "glob patterns",
+			v:    "eu-west",
+			exps: []placementv1beta1.PropertySelectorRequirement{
+				{Operator: placementv1beta1.PropertySelectorIn, Values: []string{"eu-*"}},
+			},
+			want: "eu-west",
+		},
+		{
+			name: "not a quantity",
+			v:    "abc",
+			exps: []placementv1beta1.PropertySelectorRequirement{
+				{Operator: placementv1beta1.PropertySelectorGreaterThan, Values: []string{"1"}},
+			},
+			want: "abc",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			if got := bucketPropertyValue(tc.v, tc.exps); got != tc.want {
+				t.Errorf("bucketPropertyValue() = %s, want %s", got, tc.want)
+			}
+		})
+	}
+}
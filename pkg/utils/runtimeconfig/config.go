@@ -0,0 +1,120 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package runtimeconfig provides utilities to load, validate, and watch the runtime configuration of
+// the KubeFleet hub agent, i.e., the set of tunables that can be changed without restarting the agent.
+package runtimeconfig
+
+import (
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/yaml"
+)
+
+// Config is the runtime configuration of the KubeFleet hub agent.
+//
+// All the fields are optional; a field that is not set falls back to the value the corresponding
+// command-line flag specifies.
+type Config struct {
+	// PlacementControllerWorkers is the maximum number of placements that each placement controller
+	// processes at the same time. The value is capped by the number of workers the placement controllers
+	// start with (the placement-controller-workers flag), as workers cannot be added at runtime.
+	PlacementControllerWorkers *int `json:"placementControllerWorkers,omitempty"`
+
+	// PlacementControllerRateLimiter is the rate limiter for the work queues of the placement controllers.
+	PlacementControllerRateLimiter *RateLimiterConfig `json:"placementControllerRateLimiter,omitempty"`
+
+	// ResourceSnapshotCreationMinimumInterval is the minimum interval between resource snapshot creations.
+	ResourceSnapshotCreationMinimumInterval *metav1.Duration `json:"resourceSnapshotCreationMinimumInterval,omitempty"`
+
+	// ResourceChangesCollectionDuration is the duration for collecting resource changes into one snapshot.
+	ResourceChangesCollectionDuration *metav1.Duration `json:"resourceChangesCollectionDuration,omitempty"`
+}
+
+// RateLimiterConfig is the configuration of a work queue rate limiter, which combines a per-item
+// exponential backoff with an overall token bucket.
+type RateLimiterConfig struct {
+	// BaseDelay is the base delay of the per-item exponential backoff.
+	BaseDelay metav1.Duration `json:"baseDelay"`
+
+	// MaxDelay is the max delay of the per-item exponential backoff.
+	MaxDelay metav1.Duration `json:"maxDelay"`
+
+	// QPS is the QPS of the token bucket.
+	QPS int `json:"qps"`
+
+	// BucketSize is the bucket size of the token bucket.
+	BucketSize int `json:"bucketSize"`
+}
+
+// LoadFromFile loads the runtime configuration from a file given the file path. An empty file yields
+// an empty configuration.
+func LoadFromFile(filePath string) (*Config, error) {
+	contents, err := os.ReadFile(filePath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read runtime config file: %w, file path: %s", err, filePath)
+	}
+	return Parse(contents)
+}
+
+// Parse parses and validates the runtime configuration in the YAML or JSON format. Unknown fields are
+// rejected, so that typos do not go unnoticed.
+func Parse(contents []byte) (*Config, error) {
+	config := &Config{}
+	if err := yaml.UnmarshalStrict(contents, config); err != nil {
+		return nil, fmt.Errorf("failed to unmarshal runtime config: %w", err)
+	}
+	if err := config.validate(); err != nil {
+		return nil, fmt.Errorf("failed to validate runtime config: %w", err)
+	}
+	return config, nil
+}
+
+// validate checks the configuration against the same constraints as the corresponding command-line flags.
+func (c *Config) validate() error {
+	var errs []error
+	if c.PlacementControllerWorkers != nil && (*c.PlacementControllerWorkers < 1 || *c.PlacementControllerWorkers > 1000) {
+		errs = append(errs, fmt.Errorf("placementControllerWorkers must be an integer value in the range [1, 1000], got %d", *c.PlacementControllerWorkers))
+	}
+	if rl := c.PlacementControllerRateLimiter; rl != nil {
+		if rl.BaseDelay.Duration < time.Millisecond || rl.BaseDelay.Duration > 200*time.Millisecond {
+			errs = append(errs, fmt.Errorf("placementControllerRateLimiter.baseDelay must be a value between [1ms, 200ms], got %s", rl.BaseDelay.Duration))
+		}
+		if rl.MaxDelay.Duration < time.Second || rl.MaxDelay.Duration > 5*time.Minute {
+			errs = append(errs, fmt.Errorf("placementControllerRateLimiter.maxDelay must be a value between [1s, 5m], got %s", rl.MaxDelay.Duration))
+		}
+		if rl.QPS < 1 || rl.QPS > 1000 {
+			errs = append(errs, fmt.Errorf("placementControllerRateLimiter.qps must be a positive integer in the range [1, 1000], got %d", rl.QPS))
+		}
+		if rl.BucketSize < 1 || rl.BucketSize > 10000 {
+			errs = append(errs, fmt.Errorf("placementControllerRateLimiter.bucketSize must be a positive integer in the range [1, 10000], got %d", rl.BucketSize))
+		}
+		if rl.QPS > rl.BucketSize {
+			errs = append(errs, fmt.Errorf("placementControllerRateLimiter.qps must be less than or equal to its bucket size"))
+		}
+	}
+	if d := c.ResourceSnapshotCreationMinimumInterval; d != nil && (d.Duration < 0 || d.Duration > 5*time.Minute) {
+		errs = append(errs, fmt.Errorf("resourceSnapshotCreationMinimumInterval must be a duration in the range [0s, 5m], got %s", d.Duration))
+	}
+	if d := c.ResourceChangesCollectionDuration; d != nil && (d.Duration < 0 || d.Duration > time.Minute) {
+		errs = append(errs, fmt.Errorf("resourceChangesCollectionDuration must be a duration in the range [0s, 1m], got %s", d.Duration))
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtimeconfig
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+// TestParse tests the Parse function.
+func TestParse(t *testing.T) {
+	testCases := []struct {
+		name     string
+		contents string
+		want     *Config
+		wantErr  bool
+	}{
+		{
+			name:     "empty",
+			contents: "",
+			want:     &Config{},
+		},
+		{
+			name: "all specified",
+			contents: `
+placementControllerWorkers: 5
+placementControllerRateLimiter:
+  baseDelay: 10ms
+  maxDelay: 2m
+  qps: 20
+  bucketSize: 200
+resourceSnapshotCreationMinimumInterval: 1m
+resourceChangesCollectionDuration: 30s
+`,
+			want: &Config{
+				PlacementControllerWorkers: ptr.To(5),
+				PlacementControllerRateLimiter: &RateLimiterConfig{
+					BaseDelay:  metav1.Duration{Duration: 10 * time.Millisecond},
+					MaxDelay:   metav1.Duration{Duration: 2 * time.Minute},
+					QPS:        20,
+					BucketSize: 200,
+				},
+				ResourceSnapshotCreationMinimumInterval: &metav1.Duration{Duration: time.Minute},
+				ResourceChangesCollectionDuration:       &metav1.Duration{Duration: 30 * time.Second},
+			},
+		},
+		{
+			name:     "JSON",
+			contents: `{"placementControllerWorkers": 3}`,
+			want: &Config{
+				PlacementControllerWorkers: ptr.To(3),
+			},
+		},
+		{
+			name:     "unknown field",
+			contents: "placementControllerWorker: 5",
+			wantErr:  true,
+		},
+		{
+			name:     "malformed",
+			contents: "placementControllerWorkers: [",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid workers",
+			contents: "placementControllerWorkers: 0",
+			wantErr:  true,
+		},
+		{
+			name: "invalid rate limiter",
+			contents: `
+placementControllerRateLimiter:
+  baseDelay: 1s
+  maxDelay: 10m
+  qps: 200
+  bucketSize: 100
+`,
+			wantErr: true,
+		},
+		{
+			name:     "invalid resource snapshot creation minimum interval",
+			contents: "resourceSnapshotCreationMinimumInterval: 10m",
+			wantErr:  true,
+		},
+		{
+			name:     "invalid resource changes collection duration",
+			contents: "resourceChangesCollectionDuration: -1s",
+			wantErr:  true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := Parse([]byte(tc.contents))
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Fatalf("Parse() error = %v, wantErr %t", err, tc.wantErr)
+			}
+			if tc.wantErr {
+				return
+			}
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("Parse() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,137 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtimeconfig
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"sync/atomic"
+	"time"
+
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+
+	hubmetrics "github.com/kubefleet-dev/kubefleet/pkg/metrics/hub"
+)
+
+const (
+	reloadResultSucceeded = "succeeded"
+	reloadResultFailed    = "failed"
+)
+
+// Applier applies a runtime configuration to a component of the hub agent. Appliers are only invoked
+// with configurations that have passed validation.
+type Applier func(config *Config)
+
+// Watcher watches the runtime configuration file, and applies the configuration whenever the file changes.
+//
+// The file is polled rather than watched with inotify, as the kubelet updates files projected from a
+// ConfigMap by swapping symbolic links, which file system notifications do not reliably report. A file
+// that does not exist is treated as an empty configuration, i.e., all the tunables fall back to the
+// values of their command-line flags; a file that cannot be parsed or validated is ignored, and the
+// configuration in use stays in effect.
+type Watcher struct {
+	path     string
+	interval time.Duration
+	appliers []Applier
+
+	// current is the configuration in use; it is swapped only after the new configuration passes validation.
+	current atomic.Pointer[Config]
+	// lastContents is the contents of the file at the last reload attempt, and attempted is whether there
+	// has been any attempt yet; both are only accessed by the goroutine that polls the file.
+	lastContents []byte
+	attempted    bool
+}
+
+var _ manager.Runnable = &Watcher{}
+var _ manager.LeaderElectionRunnable = &Watcher{}
+
+// NewWatcher returns a Watcher that polls the file at the given path at the given interval.
+func NewWatcher(path string, interval time.Duration, appliers ...Applier) *Watcher {
+	w := &Watcher{
+		path:     path,
+		interval: interval,
+		appliers: appliers,
+	}
+	w.current.Store(&Config{})
+	return w
+}
+
+// Start loads the runtime configuration and keeps polling the file until the context is cancelled.
+func (w *Watcher) Start(ctx context.Context) error {
+	klog.InfoS("Starting the runtime config watcher", "path", w.path, "interval", w.interval)
+	ticker := time.NewTicker(w.interval)
+	defer ticker.Stop()
+	for {
+		if err := w.Reload(); err != nil {
+			klog.ErrorS(err, "Failed to reload the runtime config; the config in use stays in effect", "path", w.path)
+		}
+		select {
+		case <-ctx.Done():
+			klog.InfoS("Stopping the runtime config watcher")
+			return nil
+		case <-ticker.C:
+		}
+	}
+}
+
+// NeedLeaderElection implements the LeaderElectionRunnable interface. The runtime configuration concerns
+// the process itself, so the watcher runs on every replica regardless of leadership.
+func (w *Watcher) NeedLeaderElection() bool {
+	return false
+}
+
+// Current returns the runtime configuration in use.
+func (w *Watcher) Current() *Config {
+	return w.current.Load()
+}
+
+// Reload reads the runtime configuration file and, if it has changed since the last reload, validates
+// the new configuration, swaps it in, and applies it.
+func (w *Watcher) Reload() error {
+	contents, err := os.ReadFile(w.path)
+	switch {
+	case errors.Is(err, fs.ErrNotExist):
+		contents = nil
+	case err != nil:
+		hubmetrics.FleetRuntimeConfigReloadCount.WithLabelValues(reloadResultFailed).Inc()
+		return fmt.Errorf("failed to read runtime config file: %w, file path: %s", err, w.path)
+	}
+	if w.attempted && bytes.Equal(contents, w.lastContents) {
+		return nil
+	}
+	w.attempted = true
+	w.lastContents = contents
+
+	config, err := Parse(contents)
+	if err != nil {
+		hubmetrics.FleetRuntimeConfigReloadCount.WithLabelValues(reloadResultFailed).Inc()
+		return err
+	}
+	w.current.Store(config)
+	for _, apply := range w.appliers {
+		apply(config)
+	}
+	hubmetrics.FleetRuntimeConfigReloadCount.WithLabelValues(reloadResultSucceeded).Inc()
+	hubmetrics.FleetRuntimeConfigLastReloadTimestampSeconds.SetToCurrentTime()
+	klog.InfoS("Applied the runtime config", "path", w.path)
+	return nil
+}
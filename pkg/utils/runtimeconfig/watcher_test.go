@@ -0,0 +1,98 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package runtimeconfig
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"k8s.io/utils/ptr"
+)
+
+// TestWatcherReload tests the Reload method of the Watcher.
+func TestWatcherReload(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "config.yaml")
+	var applied []*Config
+	w := NewWatcher(path, 0, func(config *Config) {
+		applied = append(applied, config)
+	})
+
+	steps := []struct {
+		name        string
+		contents    *string
+		wantErr     bool
+		wantCurrent *Config
+		wantApplied int
+	}{
+		{
+			name:        "file does not exist",
+			wantCurrent: &Config{},
+			wantApplied: 1,
+		},
+		{
+			name:        "file created",
+			contents:    ptr.To("placementControllerWorkers: 5"),
+			wantCurrent: &Config{PlacementControllerWorkers: ptr.To(5)},
+			wantApplied: 2,
+		},
+		{
+			name:        "file unchanged",
+			contents:    ptr.To("placementControllerWorkers: 5"),
+			wantCurrent: &Config{PlacementControllerWorkers: ptr.To(5)},
+			wantApplied: 2,
+		},
+		{
+			name:        "invalid config is not applied",
+			contents:    ptr.To("placementControllerWorkers: -1"),
+			wantErr:     true,
+			wantCurrent: &Config{PlacementControllerWorkers: ptr.To(5)},
+			wantApplied: 2,
+		},
+		{
+			name:        "invalid config is reported once",
+			contents:    ptr.To("placementControllerWorkers: -1"),
+			wantCurrent: &Config{PlacementControllerWorkers: ptr.To(5)},
+			wantApplied: 2,
+		},
+		{
+			name:        "file fixed",
+			contents:    ptr.To("placementControllerWorkers: 2"),
+			wantCurrent: &Config{PlacementControllerWorkers: ptr.To(2)},
+			wantApplied: 3,
+		},
+	}
+
+	for _, step := range steps {
+		if step.contents != nil {
+			if err := os.WriteFile(path, []byte(*step.contents), 0600); err != nil {
+				t.Fatalf("%s: failed to write the runtime config file: %v", step.name, err)
+			}
+		}
+		err := w.Reload()
+		if gotErr := err != nil; gotErr != step.wantErr {
+			t.Fatalf("%s: Reload() error = %v, wantErr %t", step.name, err, step.wantErr)
+		}
+		if diff := cmp.Diff(step.wantCurrent, w.Current()); diff != "" {
+			t.Errorf("%s: Current() mismatch (-want, +got):\n%s", step.name, diff)
+		}
+		if got := len(applied); got != step.wantApplied {
+			t.Errorf("%s: number of applied configs = %d, want %d", step.name, got, step.wantApplied)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package toleration features utilities for matching the taints on member clusters against the
+// tolerations on placements.
+package toleration
+
+import (
+	corev1 "k8s.io/api/core/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+// FindUntoleratedTaint returns the first taint that cannot be tolerated by any of the given tolerations,
+// and whether such a taint has been found.
+func FindUntoleratedTaint(taints []clusterv1beta1.Taint, tolerations []placementv1beta1.Toleration) (*clusterv1beta1.Taint, bool) {
+	for _, taint := range taints {
+		if !tolerationsTolerateTaint(taint, tolerations) {
+			return &taint, true
+		}
+	}
+	return nil, false
+}
+
+func tolerationsTolerateTaint(taint clusterv1beta1.Taint, tolerations []placementv1beta1.Toleration) bool {
+	for _, toleration := range tolerations {
+		if canTolerationTolerateTaint(taint, toleration) {
+			return true
+		}
+	}
+	return false
+}
+
+func canTolerationTolerateTaint(taint clusterv1beta1.Taint, toleration placementv1beta1.Toleration) bool {
+	if toleration.Operator == corev1.TolerationOpExists {
+		if toleration.Key == "" || toleration.Key == taint.Key {
+			return toleration.Effect == taint.Effect || toleration.Effect == ""
+		}
+	}
+	if toleration.Operator == corev1.TolerationOpEqual {
+		if toleration.Key == taint.Key && toleration.Value == taint.Value {
+			return toleration.Effect == taint.Effect || toleration.Effect == ""
+		}
+	}
+	return false
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package toleration
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+func TestFindUntoleratedTaint(t *testing.T) {
+	regionTaint := clusterv1beta1.Taint{Key: "region", Value: "east", Effect: corev1.TaintEffectNoSchedule}
+	envTaint := clusterv1beta1.Taint{Key: "env", Value: "prod", Effect: corev1.TaintEffectNoSchedule}
+	tests := []struct {
+		name            string
+		taints          []clusterv1beta1.Taint
+		tolerations     []placementv1beta1.Toleration
+		wantTaint       *clusterv1beta1.Taint
+		wantUntolerated bool
+	}{
+		{
+			name: "no taints",
+			tolerations: []placementv1beta1.Toleration{
+				{Key: "region", Operator: corev1.TolerationOpExists},
+			},
+		},
+		{
+			name:            "taint without tolerations",
+			taints:          []clusterv1beta1.Taint{regionTaint},
+			wantTaint:       &regionTaint,
+			wantUntolerated: true,
+		},
+		{
+			name:   "taint tolerated by an Equal toleration",
+			taints: []clusterv1beta1.Taint{regionTaint},
+			tolerations: []placementv1beta1.Toleration{
+				{Key: "region", Operator: corev1.TolerationOpEqual, Value: "east", Effect: corev1.TaintEffectNoSchedule},
+			},
+		},
+		{
+			name:   "taint tolerated by an Exists toleration with an empty key",
+			taints: []clusterv1beta1.Taint{regionTaint, envTaint},
+			tolerations: []placementv1beta1.Toleration{
+				{Operator: corev1.TolerationOpExists},
+			},
+		},
+		{
+			name:   "taint not tolerated due to a value mismatch",
+			taints: []clusterv1beta1.Taint{regionTaint},
+			tolerations: []placementv1beta1.Toleration{
+				{Key: "region", Operator: corev1.TolerationOpEqual, Value: "west"},
+			},
+			wantTaint:       &regionTaint,
+			wantUntolerated: true,
+		},
+		{
+			name:   "one of the taints not tolerated",
+			taints: []clusterv1beta1.Taint{regionTaint, envTaint},
+			tolerations: []placementv1beta1.Toleration{
+				{Key: "region", Operator: corev1.TolerationOpExists},
+			},
+			wantTaint:       &envTaint,
+			wantUntolerated: true,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			gotTaint, gotUntolerated := FindUntoleratedTaint(tc.taints, tc.tolerations)
+			if gotUntolerated != tc.wantUntolerated {
+				t.Errorf("FindUntoleratedTaint() untolerated = %t, want %t", gotUntolerated, tc.wantUntolerated)
+			}
+			if diff := cmp.Diff(tc.wantTaint, gotTaint); diff != "" {
+				t.Errorf("FindUntoleratedTaint() taint mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
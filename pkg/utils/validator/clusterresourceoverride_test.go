@@ -443,14 +443,9 @@ func TestValidateClusterResourceOverride(t *testing.T) {
 								ClusterSelector: &placementv1beta1.ClusterSelector{
 									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
 										{
-											PropertySelector: &placementv1beta1.PropertySelector{
-												MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-													{
-														Name:     "example",
-														Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
-														Values:   []string{"1"},
-													},
-												},
+											PropertySorter: &placementv1beta1.PropertySorter{
+												Name:      "example",
+												SortOrder: placementv1beta1.Descending,
 											},
 										},
 									},
@@ -461,7 +456,7 @@ func TestValidateClusterResourceOverride(t *testing.T) {
 				},
 			},
 			croList:    &placementv1beta1.ClusterResourceOverrideList{},
-			wantErrMsg: errors.New("only labelSelector is supported"),
+			wantErrMsg: errors.New("propertySorter is not supported"),
 		},
 		"invalid cluster resource override - fail validateClusterResourceOverridePolicy with nil label selector": {
 			cro: placementv1beta1.ClusterResourceOverride{
@@ -481,7 +476,7 @@ func TestValidateClusterResourceOverride(t *testing.T) {
 					},
 				},
 			},
-			wantErrMsg: errors.New("labelSelector is required"),
+			wantErrMsg: errors.New("labelSelector or propertySelector is required"),
 		},
 		"valid cluster resource override - empty cluster selector terms": {
 			cro: placementv1beta1.ClusterResourceOverride{
@@ -618,14 +613,9 @@ func TestValidateClusterResourceOverride(t *testing.T) {
 								ClusterSelector: &placementv1beta1.ClusterSelector{
 									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
 										{
-											PropertySelector: &placementv1beta1.PropertySelector{
-												MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-													{
-														Name:     "example",
-														Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
-														Values:   []string{"1"},
-													},
-												},
+											PropertySorter: &placementv1beta1.PropertySorter{
+												Name:      "example",
+												SortOrder: placementv1beta1.Descending,
 											},
 										},
 									},
@@ -635,7 +625,7 @@ func TestValidateClusterResourceOverride(t *testing.T) {
 					},
 				},
 			},
-			wantErrMsg: errors.New("only labelSelector is supported"),
+			wantErrMsg: errors.New("propertySorter is not supported"),
 		},
 		"valid cluster resource override - policy with no cluster selector": {
 			cro: placementv1beta1.ClusterResourceOverride{
@@ -697,7 +687,7 @@ func TestValidateClusterResourceOverride(t *testing.T) {
 					},
 				},
 			},
-			wantErrMsg: errors.New("only labelSelector is supported"),
+			wantErrMsg: errors.New("propertySorter is not supported"),
 		},
 		"valid cluster resource override - policy with nil label selector": {
 			cro: placementv1beta1.ClusterResourceOverride{
@@ -717,7 +707,7 @@ func TestValidateClusterResourceOverride(t *testing.T) {
 					},
 				},
 			},
-			wantErrMsg: errors.New("labelSelector is required"),
+			wantErrMsg: errors.New("labelSelector or propertySelector is required"),
 		},
 		"invalid cluster resource override - multiple invalid override paths, 1 valid": {
 			cro: placementv1beta1.ClusterResourceOverride{
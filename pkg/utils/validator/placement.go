@@ -22,11 +22,15 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"path"
 	"sort"
+	"strconv"
 	"strings"
+	"time"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	corev1 "k8s.io/api/core/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
 	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -36,14 +40,25 @@ import (
 	"k8s.io/apimachinery/pkg/util/intstr"
 	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/propertyprovider"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/informer"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/toleration"
+)
+
+const (
+	// minPreferredClusterSelectorWeight and maxPreferredClusterSelectorWeight are the bounds of the weight
+	// of a preferred cluster selector.
+	minPreferredClusterSelectorWeight = -1000
+	maxPreferredClusterSelectorWeight = 1000
 )
 
 var ResourceInformer informer.Manager
@@ -61,6 +76,11 @@ var (
 	DenyCreateUpdateInvalidFmt = "deny create/update v1beta1 %s has invalid fields %s"
 	AllowModifyFmt             = "any user is allowed to modify v1beta1 %s"
 
+	// Webhook warning message format strings for the clusters listed in PickFixed placement policies.
+	pickFixedClusterNotFoundWarningFmt         = "cluster %q listed in the PickFixed placement policy is not found in the fleet; no resources will be placed on it until it joins"
+	pickFixedClusterNotJoinedWarningFmt        = "cluster %q listed in the PickFixed placement policy has not joined the fleet yet; no resources will be placed on it until it joins"
+	pickFixedClusterUntoleratedTaintWarningFmt = "cluster %q listed in the PickFixed placement policy has taint %s=%s:%s that cannot be tolerated by the placement; no resources will be placed on it"
+
 	// Below is the map of supported capacity types.
 	supportedResourceCapacityTypesMap = map[string]bool{propertyprovider.AllocatableCapacityName: true, propertyprovider.AvailableCapacityName: true, propertyprovider.TotalCapacityName: true}
 	resourceCapacityTypes             = supportedResourceCapacityTypes()
@@ -79,7 +99,7 @@ func hasNamespaceWithResourceSelectorsMode(resourceSelectors []placementv1beta1.
 }
 
 // validatePlacement validates a placement object (either ClusterResourcePlacement or ResourcePlacement).
-func validatePlacement(name string, resourceSelectors []placementv1beta1.ResourceSelectorTerm, policy *placementv1beta1.PlacementPolicy, strategy placementv1beta1.RolloutStrategy, isClusterScoped bool) error {
+func validatePlacement(name string, resourceSelectors []placementv1beta1.ResourceSelectorTerm, policy *placementv1beta1.PlacementPolicy, strategy placementv1beta1.RolloutStrategy, readinessPolicy *placementv1beta1.ReadinessPolicy, metadataInjection *placementv1beta1.MetadataInjectionPolicy, nameTransform *placementv1beta1.ResourceNameTransform, isClusterScoped bool) error {
 	allErr := make([]error, 0)
 
 	if len(name) > validation.DNS1035LabelMaxLength {
@@ -132,12 +152,35 @@ func validatePlacement(name string, resourceSelectors []placementv1beta1.Resourc
 		if err := validatePlacementPolicy(policy); err != nil {
 			allErr = append(allErr, fmt.Errorf("the placement policy field is invalid: %w", err))
 		}
+		if policy.Affinity != nil && policy.Affinity.PlacementAffinity != nil && policy.Affinity.PlacementAffinity.PlacementName == name {
+			allErr = append(allErr, fmt.Errorf("the placement policy field is invalid: the placement affinity cannot refer to the placement itself"))
+		}
 	}
 
 	if err := validateRolloutStrategy(strategy); err != nil {
 		allErr = append(allErr, fmt.Errorf("the rollout Strategy field  is invalid: %w", err))
 	}
 
+	if readinessPolicy != nil {
+		if err := validateReadinessPolicy(readinessPolicy, strategy); err != nil {
+			allErr = append(allErr, fmt.Errorf("the readiness policy field is invalid: %w", err))
+		}
+	}
+
+	if metadataInjection != nil {
+		if err := validateMetadataInjectionPolicy(metadataInjection); err != nil {
+			allErr = append(allErr, fmt.Errorf("the metadata injection field is invalid: %w", err))
+		}
+	}
+
+	if nameTransform != nil {
+		if !isClusterScoped {
+			allErr = append(allErr, errors.New("the resource name transform field is only supported for ClusterResourcePlacements"))
+		} else if err := validateResourceNameTransform(nameTransform); err != nil {
+			allErr = append(allErr, fmt.Errorf("the resource name transform field is invalid: %w", err))
+		}
+	}
+
 	return apiErrors.NewAggregate(allErr)
 }
 
@@ -148,6 +191,9 @@ func ValidateClusterResourcePlacement(clusterResourcePlacement *placementv1beta1
 		clusterResourcePlacement.Spec.ResourceSelectors,
 		clusterResourcePlacement.Spec.Policy,
 		clusterResourcePlacement.Spec.Strategy,
+		clusterResourcePlacement.Spec.ReadinessPolicy,
+		clusterResourcePlacement.Spec.MetadataInjection,
+		clusterResourcePlacement.Spec.ResourceNameTransform,
 		true, // isClusterScoped
 	)
 }
@@ -159,6 +205,9 @@ func ValidateResourcePlacement(resourcePlacement *placementv1beta1.ResourcePlace
 		resourcePlacement.Spec.ResourceSelectors,
 		resourcePlacement.Spec.Policy,
 		resourcePlacement.Spec.Strategy,
+		resourcePlacement.Spec.ReadinessPolicy,
+		resourcePlacement.Spec.MetadataInjection,
+		resourcePlacement.Spec.ResourceNameTransform,
 		false, // isClusterScoped
 	)
 }
@@ -179,6 +228,18 @@ func IsPlacementPolicyTypeUpdated(oldPolicy, currentPolicy *placementv1beta1.Pla
 	return false
 }
 
+// IsExternalSchedulerNameUpdated returns if the external scheduler name in the placement policy has been updated.
+func IsExternalSchedulerNameUpdated(oldPolicy, currentPolicy *placementv1beta1.PlacementPolicy) bool {
+	oldName, currentName := "", ""
+	if oldPolicy != nil {
+		oldName = oldPolicy.ExternalSchedulerName
+	}
+	if currentPolicy != nil {
+		currentName = currentPolicy.ExternalSchedulerName
+	}
+	return oldName != currentName
+}
+
 func validatePlacementPolicy(policy *placementv1beta1.PlacementPolicy) error {
 	allErr := make([]error, 0)
 	switch policy.PlacementType {
@@ -231,6 +292,12 @@ func validatePolicyForPickFixedPlacementType(policy *placementv1beta1.PlacementP
 	if policy.Tolerations != nil {
 		allErr = append(allErr, fmt.Errorf("tolerations needs to be empty for policy type %s, only valid for PickAll/PickN", placementv1beta1.PickFixedPlacementType))
 	}
+	if policy.ExternalSchedulerName != "" {
+		allErr = append(allErr, fmt.Errorf("external scheduler name needs to be empty for policy type %s, only valid for PickAll/PickN", placementv1beta1.PickFixedPlacementType))
+	}
+	if policy.SchedulingProfileName != "" {
+		allErr = append(allErr, fmt.Errorf("scheduling profile name needs to be empty for policy type %s, only valid for PickN", placementv1beta1.PickFixedPlacementType))
+	}
 
 	return apiErrors.NewAggregate(allErr)
 }
@@ -286,6 +353,7 @@ func validateClusterAffinity(clusterAffinity *placementv1beta1.ClusterAffinity,
 	case placementv1beta1.PickAllPlacementType:
 		if clusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
 			allErr = append(allErr, validateClusterSelector(clusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution))
+			allErr = append(allErr, validateNoPatternOperators(clusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution))
 		}
 		if len(clusterAffinity.PreferredDuringSchedulingIgnoredDuringExecution) > 0 {
 			allErr = append(allErr, fmt.Errorf("PreferredDuringSchedulingIgnoredDuringExecution will be ignored for placement policy type %s", placementType))
@@ -293,6 +361,7 @@ func validateClusterAffinity(clusterAffinity *placementv1beta1.ClusterAffinity,
 	case placementv1beta1.PickNPlacementType:
 		if clusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution != nil {
 			allErr = append(allErr, validateClusterSelector(clusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution))
+			allErr = append(allErr, validateNoPatternOperators(clusterAffinity.RequiredDuringSchedulingIgnoredDuringExecution))
 		}
 		if len(clusterAffinity.PreferredDuringSchedulingIgnoredDuringExecution) > 0 {
 			allErr = append(allErr, validatePreferredClusterSelectors(clusterAffinity.PreferredDuringSchedulingIgnoredDuringExecution))
@@ -373,10 +442,31 @@ func validateClusterSelector(clusterSelector *placementv1beta1.ClusterSelector)
 	return apiErrors.NewAggregate(allErr)
 }
 
+// validateNoPatternOperators checks that the property selectors of a cluster selector do not use the
+// In or NotIn operators, which are only supported in the property selectors of override rules.
+func validateNoPatternOperators(clusterSelector *placementv1beta1.ClusterSelector) error {
+	allErr := make([]error, 0)
+	for _, clusterSelectorTerm := range clusterSelector.ClusterSelectorTerms {
+		if clusterSelectorTerm.PropertySelector == nil {
+			continue
+		}
+		for _, req := range clusterSelectorTerm.PropertySelector.MatchExpressions {
+			if req.Operator == placementv1beta1.PropertySelectorIn || req.Operator == placementv1beta1.PropertySelectorNotIn {
+				allErr = append(allErr, fmt.Errorf("operator %s of property %s is only supported in the property selectors of override rules", req.Operator, req.Name))
+			}
+		}
+	}
+	return apiErrors.NewAggregate(allErr)
+}
+
 func validatePreferredClusterSelectors(preferredClusterSelectors []placementv1beta1.PreferredClusterSelector) error {
 	allErr := make([]error, 0)
 	for _, preferredClusterSelector := range preferredClusterSelectors {
-		// API server validation on object occurs before webhook is triggered hence not validating weight.
+		// The API server validates the weight as well; the check here guards against CRDs installed
+		// from an older version, which may feature a different weight range.
+		if w := preferredClusterSelector.Weight; w < minPreferredClusterSelectorWeight || w > maxPreferredClusterSelectorWeight {
+			allErr = append(allErr, fmt.Errorf("the weight %d of the preferred cluster selector is not in the range [%d, %d]", w, minPreferredClusterSelectorWeight, maxPreferredClusterSelectorWeight))
+		}
 		allErr = append(allErr, validateLabelSelector(preferredClusterSelector.Preference.LabelSelector, "preferred cluster selector"))
 
 		// Affinity is PreferredDuringSchedulingIgnoredDuringExecution, so check that PropertySelector is nil.
@@ -430,19 +520,173 @@ func validateRolloutStrategy(rolloutStrategy placementv1beta1.RolloutStrategy) e
 			if value < 0 {
 				allErr = append(allErr, fmt.Errorf("maxSurge must be greater than or equal to 0, got `%+v`", rolloutStrategy.RollingUpdate.MaxSurge))
 			}
+			if value == 0 && rolloutStrategy.RollingUpdate.ResourceUpdateStrategy == placementv1beta1.ResourceUpdateStrategyTypeSurgeClusters {
+				allErr = append(allErr, fmt.Errorf("maxSurge must be greater than 0 when resourceUpdateStrategy is %s", placementv1beta1.ResourceUpdateStrategyTypeSurgeClusters))
+			}
+		}
+		switch rolloutStrategy.RollingUpdate.ResourceUpdateStrategy {
+		case "", placementv1beta1.ResourceUpdateStrategyTypeInPlace, placementv1beta1.ResourceUpdateStrategyTypeSurgeClusters:
+		default:
+			allErr = append(allErr, fmt.Errorf("unsupported resource update strategy type `%s`", rolloutStrategy.RollingUpdate.ResourceUpdateStrategy))
 		}
 	}
 
-	// server-side apply strategy type is only valid for server-side apply strategy type
 	if rolloutStrategy.ApplyStrategy != nil {
-		if rolloutStrategy.ApplyStrategy.Type != placementv1beta1.ApplyStrategyTypeServerSideApply && rolloutStrategy.ApplyStrategy.ServerSideApplyConfig != nil {
-			allErr = append(allErr, errors.New("serverSideApplyConfig is only valid for ServerSideApply strategy type"))
+		allErr = append(allErr, validateApplyStrategy(rolloutStrategy.ApplyStrategy))
+	}
+
+	for i, override := range rolloutStrategy.ApplyStrategyOverrides {
+		if override.ClusterSelector == nil {
+			allErr = append(allErr, fmt.Errorf("applyStrategyOverrides[%d]: clusterSelector must be specified", i))
+		} else if err := validateClusterSelector(override.ClusterSelector); err != nil {
+			allErr = append(allErr, fmt.Errorf("applyStrategyOverrides[%d]: %w", i, err))
+		}
+		if override.ApplyStrategy == nil {
+			allErr = append(allErr, fmt.Errorf("applyStrategyOverrides[%d]: applyStrategy must be specified", i))
+		} else if err := validateApplyStrategy(override.ApplyStrategy); err != nil {
+			allErr = append(allErr, fmt.Errorf("applyStrategyOverrides[%d]: %w", i, err))
+		}
+	}
+
+	if rolloutStrategy.PreDeleteProbe != nil {
+		allErr = append(allErr, validatePreDeleteProbe(rolloutStrategy.PreDeleteProbe, rolloutStrategy.ReportBackStrategy))
+	}
+
+	if rolloutStrategy.Analysis != nil {
+		if rolloutStrategy.Type == placementv1beta1.ExternalRolloutStrategyType {
+			allErr = append(allErr, fmt.Errorf("analysis is not valid for ExternalRollout strategy type"))
+		}
+		if _, err := strconv.ParseFloat(rolloutStrategy.Analysis.Threshold, 64); err != nil {
+			allErr = append(allErr, fmt.Errorf("analysis threshold `%s` is invalid: %w", rolloutStrategy.Analysis.Threshold, err))
 		}
 	}
 
 	return apiErrors.NewAggregate(allErr)
 }
 
+// validateApplyStrategy validates the combination of the apply strategy settings.
+func validateApplyStrategy(applyStrategy *placementv1beta1.ApplyStrategy) error {
+	allErr := make([]error, 0)
+	// server-side apply strategy type is only valid for server-side apply strategy type
+	if applyStrategy.Type != placementv1beta1.ApplyStrategyTypeServerSideApply && applyStrategy.ServerSideApplyConfig != nil {
+		allErr = append(allErr, errors.New("serverSideApplyConfig is only valid for ServerSideApply strategy type"))
+	}
+	// the SharedFields co-ownership policy relies on server-side apply field managers to share the resources.
+	if applyStrategy.CoOwnershipPolicy == placementv1beta1.CoOwnershipPolicyTypeSharedFields {
+		if applyStrategy.Type != placementv1beta1.ApplyStrategyTypeServerSideApply {
+			allErr = append(allErr, errors.New("coOwnershipPolicy SharedFields is only valid for ServerSideApply strategy type"))
+		} else if applyStrategy.ServerSideApplyConfig != nil && applyStrategy.ServerSideApplyConfig.ForceConflicts {
+			allErr = append(allErr, errors.New("coOwnershipPolicy SharedFields cannot be used with forced server-side apply"))
+		}
+	}
+	for _, ignoreField := range applyStrategy.IgnoreFields {
+		if err := validateIgnoreFieldPath(ignoreField); err != nil {
+			allErr = append(allErr, fmt.Errorf("invalid ignoreFields path %s: %w", ignoreField, err))
+		}
+	}
+	for i, window := range applyStrategy.DriftRemediationWindows {
+		if window.TimeZone == "" {
+			continue
+		}
+		if _, err := time.LoadLocation(window.TimeZone); err != nil {
+			allErr = append(allErr, fmt.Errorf("driftRemediationWindows[%d]: invalid time zone %s: %w", i, window.TimeZone, err))
+		}
+	}
+
+	return apiErrors.NewAggregate(allErr)
+}
+
+// validatePreDeleteProbe validates that a pre-delete probe has a valid JSONPath expression, and that
+// the status it checks is back-reported via the Work API.
+func validatePreDeleteProbe(probe *placementv1beta1.PreDeleteProbe, reportBackStrategy *placementv1beta1.ReportBackStrategy) error {
+	allErr := make([]error, 0)
+	if reportBackStrategy == nil || reportBackStrategy.Type != placementv1beta1.ReportBackStrategyTypeMirror ||
+		reportBackStrategy.Destination == nil || *reportBackStrategy.Destination != placementv1beta1.ReportBackDestinationWorkAPI {
+		allErr = append(allErr, fmt.Errorf("preDeleteProbe requires the %s report back strategy type with the %s destination",
+			placementv1beta1.ReportBackStrategyTypeMirror, placementv1beta1.ReportBackDestinationWorkAPI))
+	}
+	if _, err := utils.ParseJSONPath(probe.JSONPath); err != nil {
+		allErr = append(allErr, fmt.Errorf("preDeleteProbe jsonPath `%s` is invalid: %w", probe.JSONPath, err))
+	}
+	return apiErrors.NewAggregate(allErr)
+}
+
+// validateReadinessPolicy validates that the ready condition of a readiness policy is one of the
+// conditions expected by the apply strategy in use.
+func validateReadinessPolicy(readinessPolicy *placementv1beta1.ReadinessPolicy, rolloutStrategy placementv1beta1.RolloutStrategy) error {
+	isReportDiff := rolloutStrategy.ApplyStrategy != nil && rolloutStrategy.ApplyStrategy.Type == placementv1beta1.ApplyStrategyTypeReportDiff
+	switch readinessPolicy.ReadyConditionType {
+	case "":
+		return nil
+	case placementv1beta1.ReadyConditionTypeApplied, placementv1beta1.ReadyConditionTypeAvailable:
+		if isReportDiff {
+			return fmt.Errorf("ready condition type %s is not supported for the %s apply strategy type", readinessPolicy.ReadyConditionType, placementv1beta1.ApplyStrategyTypeReportDiff)
+		}
+	case placementv1beta1.ReadyConditionTypeDiffReported:
+		if !isReportDiff {
+			return fmt.Errorf("ready condition type %s is only supported for the %s apply strategy type", readinessPolicy.ReadyConditionType, placementv1beta1.ApplyStrategyTypeReportDiff)
+		}
+	default:
+		return fmt.Errorf("unsupported ready condition type `%s`", readinessPolicy.ReadyConditionType)
+	}
+	return nil
+}
+
+// validateMetadataInjectionPolicy validates that the labels and annotations of a metadata injection policy
+// have qualified keys outside of the reserved fleet prefix, and that the labels render to valid label values.
+func validateMetadataInjectionPolicy(metadataInjection *placementv1beta1.MetadataInjectionPolicy) error {
+	allErr := make([]error, 0)
+	// Render the label values with a sample name to check their syntax; the rendered values are validated
+	// again by the work generator, as their lengths depend on the actual names.
+	sampleName := "sample"
+	replacer := strings.NewReplacer(
+		placementv1beta1.MetadataInjectionPlacementNameVariable, sampleName,
+		placementv1beta1.MetadataInjectionClusterNameVariable, sampleName,
+	)
+	validateKey := func(kind, key string) {
+		if errs := validation.IsQualifiedName(key); len(errs) != 0 {
+			allErr = append(allErr, fmt.Errorf("%s key %s is invalid: %s", kind, key, strings.Join(errs, "; ")))
+		}
+		if strings.HasPrefix(key, placementv1beta1.FleetPrefix) {
+			allErr = append(allErr, fmt.Errorf("%s key %s uses the reserved prefix %s", kind, key, placementv1beta1.FleetPrefix))
+		}
+	}
+	for key, value := range metadataInjection.Labels {
+		validateKey("label", key)
+		if strings.Contains(value, placementv1beta1.MetadataInjectionResourceSnapshotHashVariable) {
+			allErr = append(allErr, fmt.Errorf("label %s cannot use the variable %s, which is only supported in annotations", key, placementv1beta1.MetadataInjectionResourceSnapshotHashVariable))
+			continue
+		}
+		if errs := validation.IsValidLabelValue(replacer.Replace(value)); len(errs) != 0 {
+			allErr = append(allErr, fmt.Errorf("label %s has an invalid value `%s`: %s", key, value, strings.Join(errs, "; ")))
+		}
+	}
+	for key := range metadataInjection.Annotations {
+		validateKey("annotation", key)
+	}
+	return apiErrors.NewAggregate(allErr)
+}
+
+// validateResourceNameTransform validates that the prefix and the suffix of a resource name transform render
+// to valid name segments.
+func validateResourceNameTransform(nameTransform *placementv1beta1.ResourceNameTransform) error {
+	if nameTransform.Prefix == "" && nameTransform.Suffix == "" {
+		return errors.New("at least one of the prefix and the suffix must be specified")
+	}
+	// Render the templates with a sample name to check their syntax; the lengths of the transformed names
+	// are validated again by the work generator, as they depend on the actual names.
+	sampleName := "sample"
+	replacer := strings.NewReplacer(
+		placementv1beta1.MetadataInjectionPlacementNameVariable, sampleName,
+		placementv1beta1.MetadataInjectionClusterNameVariable, sampleName,
+	)
+	name := replacer.Replace(nameTransform.Prefix) + sampleName + replacer.Replace(nameTransform.Suffix)
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) != 0 {
+		return fmt.Errorf("the prefix `%s` and the suffix `%s` do not render to a valid name: %s", nameTransform.Prefix, nameTransform.Suffix, strings.Join(errs, "; "))
+	}
+	return nil
+}
+
 // validatePropertySelector validates the property selector
 func validatePropertySelector(propertySelector *placementv1beta1.PropertySelector) error {
 	return validatePropertySelectorRequirements(propertySelector.MatchExpressions)
@@ -457,6 +701,15 @@ func validatePropertySelectorRequirements(propertySelectorRequirements []placeme
 		if err := validateOperator(req.Operator, req.Values); err != nil {
 			allErr = append(allErr, err)
 		}
+		if req.Operator == placementv1beta1.PropertySelectorIn || req.Operator == placementv1beta1.PropertySelectorNotIn {
+			if strings.HasPrefix(req.Name, propertyprovider.ResourcePropertyNamePrefix) {
+				allErr = append(allErr, fmt.Errorf("operator %s is not supported for resource property %s", req.Operator, req.Name))
+			}
+			if err := validatePatterns(req.Values); err != nil {
+				allErr = append(allErr, fmt.Errorf("invalid values for property %s: %w", req.Name, err))
+			}
+			continue
+		}
 		if err := validateValues(req.Values); err != nil {
 			allErr = append(allErr, fmt.Errorf("invalid values for property %s: %w", req.Name, err))
 		}
@@ -543,6 +796,18 @@ func validateOperator(op placementv1beta1.PropertySelectorOperator, values []str
 	if validOperators[op] && len(values) != 1 {
 		return fmt.Errorf("operator %s requires exactly one value, got %d", op, len(values))
 	}
+	if (op == placementv1beta1.PropertySelectorIn || op == placementv1beta1.PropertySelectorNotIn) && len(values) == 0 {
+		return fmt.Errorf("operator %s requires at least one value", op)
+	}
+	return nil
+}
+
+func validatePatterns(patterns []string) error {
+	for _, pattern := range patterns {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return fmt.Errorf("value %s is not a valid glob pattern: %w", pattern, err)
+		}
+	}
 	return nil
 }
 
@@ -576,6 +841,7 @@ func HandlePlacementValidation(
 	decodeFunc func(admission.Request, webhook.AdmissionDecoder) (placementv1beta1.PlacementObj, error),
 	decodeOldFunc func(admission.Request, webhook.AdmissionDecoder) (placementv1beta1.PlacementObj, error),
 	validateFunc func(placementv1beta1.PlacementObj) error,
+	warnFunc func(context.Context, placementv1beta1.PlacementObj) admission.Warnings,
 ) admission.Response {
 	if req.Operation == admissionv1.Create || req.Operation == admissionv1.Update {
 		klog.V(2).InfoS("handling placement", "resourceType", resourceType, "operation", req.Operation, "namespacedName", types.NamespacedName{Name: req.Name, Namespace: req.Namespace})
@@ -606,6 +872,11 @@ func HandlePlacementValidation(
 				return admission.Denied("placement type is immutable")
 			}
 
+			// Handle update case where the external scheduler name should be immutable.
+			if IsExternalSchedulerNameUpdated(oldPlacement.GetPlacementSpec().Policy, placement.GetPlacementSpec().Policy) {
+				return admission.Denied("external scheduler name is immutable")
+			}
+
 			// Handle update case where existing tolerations were updated/deleted
 			if IsTolerationsUpdatedOrDeleted(oldPlacement.GetPlacementSpec().Tolerations(), placement.GetPlacementSpec().Tolerations()) {
 				return admission.Denied("tolerations have been updated/deleted, only additions to tolerations are allowed")
@@ -616,7 +887,167 @@ func HandlePlacementValidation(
 			klog.V(2).InfoS("v1beta1 placement has invalid fields, request is denied", "resourceType", resourceType, "operation", req.Operation, "namespacedName", types.NamespacedName{Name: placement.GetName(), Namespace: req.Namespace})
 			return admission.Denied(fmt.Sprintf(DenyCreateUpdateInvalidFmt, resourceType, err))
 		}
+		return admission.Allowed(fmt.Sprintf(AllowModifyFmt, resourceType)).WithWarnings(warnFunc(ctx, placement)...)
 	}
 
 	return admission.Allowed(fmt.Sprintf(AllowModifyFmt, resourceType))
 }
+
+// PickFixedClusterWarnings returns the admission warnings on the clusters listed in a PickFixed placement policy
+// that cannot receive resources from the placement for now, i.e., the clusters that are not found in the fleet,
+// have not joined the fleet yet, or have taints that the placement cannot tolerate. The scheduler reports the same
+// clusters as not selected in the scheduling decisions.
+//
+// The clusters are not rejected at admission time, as they might join the fleet (or get their taints removed) later.
+func PickFixedClusterWarnings(ctx context.Context, c client.Reader, placement placementv1beta1.PlacementObj) admission.Warnings {
+	policy := placement.GetPlacementSpec().Policy
+	if policy == nil || policy.PlacementType != placementv1beta1.PickFixedPlacementType {
+		return nil
+	}
+
+	var warnings admission.Warnings
+	for _, name := range policy.ClusterNames {
+		var mc clusterv1beta1.MemberCluster
+		if err := c.Get(ctx, types.NamespacedName{Name: name}, &mc); err != nil {
+			if k8serrors.IsNotFound(err) {
+				warnings = append(warnings, fmt.Sprintf(pickFixedClusterNotFoundWarningFmt, name))
+				continue
+			}
+			// The warnings are best-effort; do not block the request for transient errors.
+			klog.ErrorS(err, "Failed to get the member cluster listed in the PickFixed placement policy", "memberCluster", name, "placement", klog.KObj(placement))
+			continue
+		}
+		if !condition.IsConditionStatusTrue(mc.GetCondition(string(clusterv1beta1.ConditionTypeMemberClusterJoined)), mc.GetGeneration()) {
+			warnings = append(warnings, fmt.Sprintf(pickFixedClusterNotJoinedWarningFmt, name))
+			continue
+		}
+		if taint, isUntolerated := toleration.FindUntoleratedTaint(mc.Spec.Taints, policy.Tolerations); isUntolerated {
+			warnings = append(warnings, fmt.Sprintf(pickFixedClusterUntoleratedTaintWarningFmt, name, taint.Key, taint.Value, taint.Effect))
+		}
+	}
+	return warnings
+}
+
+// ValidatePlacementDependencies validates that a placement does not depend on itself, either directly or through
+// the placements it depends on (see the DependsOn field), as the rollouts of the placements on such a dependency
+// cycle would wait for each other forever.
+func ValidatePlacementDependencies(ctx context.Context, c client.Reader, placement placementv1beta1.PlacementObj) error {
+	dependsOn := placement.GetPlacementSpec().DependsOn
+	if len(dependsOn) == 0 {
+		return nil
+	}
+	name := placement.GetName()
+	for _, dep := range dependsOn {
+		if dep == name {
+			return fmt.Errorf("the placement cannot depend on itself")
+		}
+	}
+
+	var placementList placementv1beta1.PlacementObjList
+	var listOptions []client.ListOption
+	if placement.GetNamespace() != "" {
+		placementList = &placementv1beta1.ResourcePlacementList{}
+		listOptions = append(listOptions, client.InNamespace(placement.GetNamespace()))
+	} else {
+		placementList = &placementv1beta1.ClusterResourcePlacementList{}
+	}
+	if err := c.List(ctx, placementList, listOptions...); err != nil {
+		return fmt.Errorf("failed to list the placements to check for dependency cycles: %w", err)
+	}
+	dependencies := make(map[string][]string, len(placementList.GetPlacementObjs())+1)
+	for _, p := range placementList.GetPlacementObjs() {
+		dependencies[p.GetName()] = p.GetPlacementSpec().DependsOn
+	}
+	// The placement being admitted replaces its existing version (if any).
+	dependencies[name] = dependsOn
+
+	// Run a depth-first search from the placement; a cycle is found if the placement is reached again.
+	visited := make(map[string]bool, len(dependencies))
+	var findPathBack func(current string) []string
+	findPathBack = func(current string) []string {
+		for _, dep := range dependencies[current] {
+			if dep == name {
+				return []string{dep}
+			}
+			if visited[dep] {
+				continue
+			}
+			visited[dep] = true
+			if path := findPathBack(dep); path != nil {
+				return append([]string{dep}, path...)
+			}
+		}
+		return nil
+	}
+	if path := findPathBack(name); path != nil {
+		return fmt.Errorf("the placement has a dependency cycle: %s", strings.Join(append([]string{name}, path...), " -> "))
+	}
+	return nil
+}
+
+// ValidatePlacementAffinityCycle validates that a placement does not follow itself, either directly or through
+// the placements it follows (see the PlacementAffinity field), as the placements on such a cycle would wait
+// for each other to be scheduled forever.
+func ValidatePlacementAffinityCycle(ctx context.Context, c client.Reader, placement placementv1beta1.PlacementObj) error {
+	followedName := placementAffinityOf(placement)
+	if followedName == "" {
+		return nil
+	}
+	name := placement.GetName()
+
+	// A placement follows at most one other placement; walk the chain of the followed placements until
+	// it ends or reaches the placement being admitted.
+	path := []string{name}
+	visited := map[string]bool{name: true}
+	for followedName != "" {
+		path = append(path, followedName)
+		if followedName == name {
+			return fmt.Errorf("the placement affinity has a cycle: %s", strings.Join(path, " -> "))
+		}
+		if visited[followedName] {
+			// The chain has a cycle that does not involve the placement being admitted.
+			return nil
+		}
+		visited[followedName] = true
+
+		var followed placementv1beta1.PlacementObj
+		if placement.GetNamespace() != "" {
+			followed = &placementv1beta1.ResourcePlacement{}
+		} else {
+			followed = &placementv1beta1.ClusterResourcePlacement{}
+		}
+		if err := c.Get(ctx, types.NamespacedName{Namespace: placement.GetNamespace(), Name: followedName}, followed); err != nil {
+			if k8serrors.IsNotFound(err) {
+				return nil
+			}
+			return fmt.Errorf("failed to get the placement %q to check for placement affinity cycles: %w", followedName, err)
+		}
+		followedName = placementAffinityOf(followed)
+	}
+	return nil
+}
+
+// placementAffinityOf returns the name of the placement that a placement follows, if any.
+func placementAffinityOf(placement placementv1beta1.PlacementObj) string {
+	policy := placement.GetPlacementSpec().Policy
+	if policy == nil || policy.Affinity == nil || policy.Affinity.PlacementAffinity == nil {
+		return ""
+	}
+	return policy.Affinity.PlacementAffinity.PlacementName
+}
+
+// validateIgnoreFieldPath validates a field path that the apply strategy ignores; the same restrictions
+// as those on the JSON patch override paths apply, as Fleet cannot leave the metadata of a resource,
+// except for its labels and annotations, to other agents.
+func validateIgnoreFieldPath(path string) error {
+	if err := validateJSONPatchOverridePath(path); err != nil {
+		return err
+	}
+	// Labels and annotations can only be ignored one by one, as Fleet sets its own labels and
+	// annotations on the resources.
+	parts := strings.Split(path, "/")[1:]
+	if parts[0] == "metadata" && (len(parts) != 3 || parts[2] == "*") {
+		return fmt.Errorf("only individual labels and annotations can be ignored")
+	}
+	return nil
+}
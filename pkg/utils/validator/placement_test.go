@@ -17,14 +17,21 @@ limitations under the License.
 package validator
 
 import (
+	"context"
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
 
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/informer"
@@ -193,6 +200,53 @@ func TestValidateClusterResourcePlacement(t *testing.T) {
 				IsClusterScopedResource: true},
 			wantErrMsg: "the name field cannot have length exceeding 63",
 		},
+		"CRP with placement affinity to another CRP": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.PlacementSpec{
+					ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickAllPlacementType,
+						Affinity: &placementv1beta1.Affinity{
+							PlacementAffinity: &placementv1beta1.PlacementAffinity{PlacementName: "other-crp"},
+						},
+					},
+					Strategy: placementv1beta1.RolloutStrategy{
+						Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+					},
+				},
+			},
+			resourceInformer: &testinformer.FakeManager{
+				APIResources:            map[schema.GroupVersionKind]bool{utils.ClusterRoleGVK: true},
+				IsClusterScopedResource: true},
+			wantErr: false,
+		},
+		"CRP with placement affinity to itself": {
+			crp: &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: "test-crp",
+				},
+				Spec: placementv1beta1.PlacementSpec{
+					ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{resourceSelector},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType: placementv1beta1.PickAllPlacementType,
+						Affinity: &placementv1beta1.Affinity{
+							PlacementAffinity: &placementv1beta1.PlacementAffinity{PlacementName: "test-crp"},
+						},
+					},
+					Strategy: placementv1beta1.RolloutStrategy{
+						Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+					},
+				},
+			},
+			resourceInformer: &testinformer.FakeManager{
+				APIResources:            map[schema.GroupVersionKind]bool{utils.ClusterRoleGVK: true},
+				IsClusterScopedResource: true},
+			wantErr:    true,
+			wantErrMsg: "the placement affinity cannot refer to the placement itself",
+		},
 		"invalid Resource Selector with name & label selector": {
 			crp: &placementv1beta1.ClusterResourcePlacement{
 				ObjectMeta: metav1.ObjectMeta{
@@ -494,6 +548,38 @@ func TestValidateClusterResourcePlacement_RolloutStrategy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "maxSurge must be greater than or equal to 0, got `-10`",
 		},
+		"valid rollout strategy - SurgeClusters resource update strategy": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				RollingUpdate: &placementv1beta1.RollingUpdateConfig{
+					MaxUnavailable:         ptr.To(intstr.FromInt(0)),
+					MaxSurge:               ptr.To(intstr.FromInt(1)),
+					ResourceUpdateStrategy: placementv1beta1.ResourceUpdateStrategyTypeSurgeClusters,
+				},
+			},
+			wantErr: false,
+		},
+		"invalid rollout strategy - zero MaxSurge with SurgeClusters resource update strategy": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				RollingUpdate: &placementv1beta1.RollingUpdateConfig{
+					MaxSurge:               ptr.To(intstr.FromString("0%")),
+					ResourceUpdateStrategy: placementv1beta1.ResourceUpdateStrategyTypeSurgeClusters,
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "maxSurge must be greater than 0 when resourceUpdateStrategy is SurgeClusters",
+		},
+		"invalid rollout strategy - unsupported resource update strategy": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				RollingUpdate: &placementv1beta1.RollingUpdateConfig{
+					ResourceUpdateStrategy: "BlueGreen",
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "unsupported resource update strategy type `BlueGreen`",
+		},
 		"invalid rollout strategy - ServerSideApplyConfig not valid when type is not serversideApply": {
 			strategy: placementv1beta1.RolloutStrategy{
 				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
@@ -507,6 +593,276 @@ func TestValidateClusterResourcePlacement_RolloutStrategy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "serverSideApplyConfig is only valid for ServerSideApply strategy type",
 		},
+		"valid rollout strategy - SharedFields co-ownership policy with server-side apply": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					Type:              placementv1beta1.ApplyStrategyTypeServerSideApply,
+					CoOwnershipPolicy: placementv1beta1.CoOwnershipPolicyTypeSharedFields,
+				},
+			},
+			wantErr: false,
+		},
+		"invalid rollout strategy - SharedFields co-ownership policy with client-side apply": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					Type:              placementv1beta1.ApplyStrategyTypeClientSideApply,
+					CoOwnershipPolicy: placementv1beta1.CoOwnershipPolicyTypeSharedFields,
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "coOwnershipPolicy SharedFields is only valid for ServerSideApply strategy type",
+		},
+		"invalid rollout strategy - SharedFields co-ownership policy with forced server-side apply": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					Type:              placementv1beta1.ApplyStrategyTypeServerSideApply,
+					CoOwnershipPolicy: placementv1beta1.CoOwnershipPolicyTypeSharedFields,
+					ServerSideApplyConfig: &placementv1beta1.ServerSideApplyConfig{
+						ForceConflicts: true,
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "coOwnershipPolicy SharedFields cannot be used with forced server-side apply",
+		},
+		"valid rollout strategy - apply strategy overrides": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					Type: placementv1beta1.ApplyStrategyTypeClientSideApply,
+				},
+				ApplyStrategyOverrides: []placementv1beta1.ApplyStrategyOverride{
+					{
+						ClusterSelector: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"ring": "prod"},
+									},
+								},
+							},
+						},
+						ApplyStrategy: &placementv1beta1.ApplyStrategy{
+							Type: placementv1beta1.ApplyStrategyTypeReportDiff,
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid rollout strategy - apply strategy override without cluster selector": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategyOverrides: []placementv1beta1.ApplyStrategyOverride{
+					{
+						ApplyStrategy: &placementv1beta1.ApplyStrategy{
+							Type: placementv1beta1.ApplyStrategyTypeReportDiff,
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "applyStrategyOverrides[0]: clusterSelector must be specified",
+		},
+		"invalid rollout strategy - apply strategy override with invalid apply strategy": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategyOverrides: []placementv1beta1.ApplyStrategyOverride{
+					{
+						ClusterSelector: &placementv1beta1.ClusterSelector{},
+						ApplyStrategy: &placementv1beta1.ApplyStrategy{
+							Type: placementv1beta1.ApplyStrategyTypeReportDiff,
+							ServerSideApplyConfig: &placementv1beta1.ServerSideApplyConfig{
+								ForceConflicts: true,
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "applyStrategyOverrides[0]: serverSideApplyConfig is only valid for ServerSideApply strategy type",
+		},
+		"invalid rollout strategy - apply strategy override with invalid cluster selector": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategyOverrides: []placementv1beta1.ApplyStrategyOverride{
+					{
+						ClusterSelector: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchExpressions: []metav1.LabelSelectorRequirement{
+											{
+												Key:      "ring",
+												Operator: "invalid",
+											},
+										},
+									},
+								},
+							},
+						},
+						ApplyStrategy: &placementv1beta1.ApplyStrategy{
+							Type: placementv1beta1.ApplyStrategyTypeReportDiff,
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "applyStrategyOverrides[0]: the labelSelector in cluster selector",
+		},
+		"valid rollout strategy - ignore fields": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					IgnoreFields: []string{
+						"/spec/replicas",
+						"/spec/template/spec/containers/*/resources",
+						"/metadata/annotations/deployment.kubernetes.io~1revision",
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid rollout strategy - ignore status fields": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					IgnoreFields: []string{"/status/replicas"},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "invalid ignoreFields path /status/replicas: cannot override status fields",
+		},
+		"invalid rollout strategy - ignore all annotations": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					IgnoreFields: []string{"/metadata/annotations/*"},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "invalid ignoreFields path /metadata/annotations/*: only individual labels and annotations can be ignored",
+		},
+		"valid rollout strategy - drift remediation windows": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					DriftRemediationWindows: []placementv1beta1.DriftRemediationWindow{
+						{
+							Action:   placementv1beta1.DriftRemediationActionTypeReportOnly,
+							Days:     []placementv1beta1.DayOfWeek{placementv1beta1.Monday, placementv1beta1.Friday},
+							Start:    "09:00",
+							End:      "17:00",
+							TimeZone: "Europe/Berlin",
+						},
+						{
+							Action: placementv1beta1.DriftRemediationActionTypeAutoRevert,
+							Start:  "17:00",
+							End:    "09:00",
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid rollout strategy - drift remediation window with unknown time zone": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					DriftRemediationWindows: []placementv1beta1.DriftRemediationWindow{
+						{
+							Action:   placementv1beta1.DriftRemediationActionTypeReportOnly,
+							Start:    "09:00",
+							End:      "17:00",
+							TimeZone: "Europe/Atlantis",
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "driftRemediationWindows[0]: invalid time zone Europe/Atlantis",
+		},
+		"valid rollout strategy - pre-delete probe": {
+			strategy: placementv1beta1.RolloutStrategy{
+				ReportBackStrategy: &placementv1beta1.ReportBackStrategy{
+					Type:        placementv1beta1.ReportBackStrategyTypeMirror,
+					Destination: ptr.To(placementv1beta1.ReportBackDestinationWorkAPI),
+				},
+				PreDeleteProbe: &placementv1beta1.PreDeleteProbe{
+					JSONPath: "{.status.conditions[?(@.type==\"Drained\")].status}",
+					Value:    "True",
+				},
+			},
+			wantErr: false,
+		},
+		"invalid rollout strategy - pre-delete probe without status back-reporting via the Work API": {
+			strategy: placementv1beta1.RolloutStrategy{
+				ReportBackStrategy: &placementv1beta1.ReportBackStrategy{
+					Type:        placementv1beta1.ReportBackStrategyTypeMirror,
+					Destination: ptr.To(placementv1beta1.ReportBackDestinationOriginalResource),
+				},
+				PreDeleteProbe: &placementv1beta1.PreDeleteProbe{
+					JSONPath: "{.status.endpointCount}",
+					Value:    "0",
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "preDeleteProbe requires the Mirror report back strategy type with the WorkAPI destination",
+		},
+		"invalid rollout strategy - pre-delete probe with invalid jsonPath": {
+			strategy: placementv1beta1.RolloutStrategy{
+				ReportBackStrategy: &placementv1beta1.ReportBackStrategy{
+					Type:        placementv1beta1.ReportBackStrategyTypeMirror,
+					Destination: ptr.To(placementv1beta1.ReportBackDestinationWorkAPI),
+				},
+				PreDeleteProbe: &placementv1beta1.PreDeleteProbe{
+					JSONPath: "{.status.endpointCount",
+					Value:    "0",
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "preDeleteProbe jsonPath `{.status.endpointCount` is invalid",
+		},
+		"valid rollout strategy - analysis": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.RollingUpdateRolloutStrategyType,
+				Analysis: &placementv1beta1.RolloutAnalysis{
+					PrometheusAddress: "http://prometheus.monitoring:9090",
+					Query:             `sum(rate(http_requests_total{cluster="{{cluster}}",code=~"5.."}[5m]))`,
+					Operator:          placementv1beta1.AnalysisOperatorLessThan,
+					Threshold:         "0.01",
+				},
+			},
+			wantErr: false,
+		},
+		"invalid rollout strategy - analysis with External strategy type": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Type: placementv1beta1.ExternalRolloutStrategyType,
+				Analysis: &placementv1beta1.RolloutAnalysis{
+					PrometheusAddress: "http://prometheus.monitoring:9090",
+					Query:             "up",
+					Operator:          placementv1beta1.AnalysisOperatorGreaterThan,
+					Threshold:         "0",
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "analysis is not valid for ExternalRollout strategy type",
+		},
+		"invalid rollout strategy - analysis with invalid threshold": {
+			strategy: placementv1beta1.RolloutStrategy{
+				Analysis: &placementv1beta1.RolloutAnalysis{
+					PrometheusAddress: "http://prometheus.monitoring:9090",
+					Query:             "up",
+					Operator:          placementv1beta1.AnalysisOperatorGreaterThan,
+					Threshold:         "one",
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "analysis threshold `one` is invalid",
+		},
 	}
 
 	for testName, testCase := range tests {
@@ -522,6 +878,206 @@ func TestValidateClusterResourcePlacement_RolloutStrategy(t *testing.T) {
 	}
 }
 
+func TestValidateClusterResourcePlacement_ReadinessPolicy(t *testing.T) {
+	reportDiffStrategy := placementv1beta1.RolloutStrategy{
+		ApplyStrategy: &placementv1beta1.ApplyStrategy{
+			Type: placementv1beta1.ApplyStrategyTypeReportDiff,
+		},
+	}
+
+	tests := map[string]struct {
+		strategy        placementv1beta1.RolloutStrategy
+		readinessPolicy *placementv1beta1.ReadinessPolicy
+		wantErr         bool
+		wantErrMsg      string
+	}{
+		"empty readiness policy": {
+			readinessPolicy: &placementv1beta1.ReadinessPolicy{},
+			wantErr:         false,
+		},
+		"valid readiness policy - Applied": {
+			readinessPolicy: &placementv1beta1.ReadinessPolicy{
+				ReadyConditionType: placementv1beta1.ReadyConditionTypeApplied,
+			},
+			wantErr: false,
+		},
+		"valid readiness policy - Available": {
+			strategy: placementv1beta1.RolloutStrategy{
+				ApplyStrategy: &placementv1beta1.ApplyStrategy{
+					Type: placementv1beta1.ApplyStrategyTypeServerSideApply,
+				},
+			},
+			readinessPolicy: &placementv1beta1.ReadinessPolicy{
+				ReadyConditionType: placementv1beta1.ReadyConditionTypeAvailable,
+			},
+			wantErr: false,
+		},
+		"valid readiness policy - DiffReported with ReportDiff": {
+			strategy: reportDiffStrategy,
+			readinessPolicy: &placementv1beta1.ReadinessPolicy{
+				ReadyConditionType: placementv1beta1.ReadyConditionTypeDiffReported,
+			},
+			wantErr: false,
+		},
+		"invalid readiness policy - Applied with ReportDiff": {
+			strategy: reportDiffStrategy,
+			readinessPolicy: &placementv1beta1.ReadinessPolicy{
+				ReadyConditionType: placementv1beta1.ReadyConditionTypeApplied,
+			},
+			wantErr:    true,
+			wantErrMsg: "ready condition type Applied is not supported for the ReportDiff apply strategy type",
+		},
+		"invalid readiness policy - DiffReported without ReportDiff": {
+			readinessPolicy: &placementv1beta1.ReadinessPolicy{
+				ReadyConditionType: placementv1beta1.ReadyConditionTypeDiffReported,
+			},
+			wantErr:    true,
+			wantErrMsg: "ready condition type DiffReported is only supported for the ReportDiff apply strategy type",
+		},
+		"invalid readiness policy - unknown type": {
+			readinessPolicy: &placementv1beta1.ReadinessPolicy{
+				ReadyConditionType: "Ready",
+			},
+			wantErr:    true,
+			wantErrMsg: "unsupported ready condition type `Ready`",
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			gotErr := validateReadinessPolicy(testCase.readinessPolicy, testCase.strategy)
+			if (gotErr != nil) != testCase.wantErr {
+				t.Errorf("validateReadinessPolicy() error = %v, wantErr %v", gotErr, testCase.wantErr)
+			}
+			if testCase.wantErr && !strings.Contains(gotErr.Error(), testCase.wantErrMsg) {
+				t.Errorf("validateReadinessPolicy() got %v, should contain want %s", gotErr, testCase.wantErrMsg)
+			}
+		})
+	}
+}
+
+func TestValidateClusterResourcePlacement_MetadataInjection(t *testing.T) {
+	tests := map[string]struct {
+		metadataInjection *placementv1beta1.MetadataInjectionPolicy
+		wantErr           bool
+		wantErrMsg        string
+	}{
+		"standard metadata only": {
+			metadataInjection: &placementv1beta1.MetadataInjectionPolicy{
+				Standard: true,
+			},
+			wantErr: false,
+		},
+		"valid labels and annotations with variables": {
+			metadataInjection: &placementv1beta1.MetadataInjectionPolicy{
+				Labels: map[string]string{
+					"example.com/placement": "crp-${PLACEMENT-NAME}",
+					"team":                  "blue",
+				},
+				Annotations: map[string]string{
+					"example.com/source": "${PLACEMENT-NAME}/${MEMBER-CLUSTER-NAME}@${RESOURCE-SNAPSHOT-HASH}",
+				},
+			},
+			wantErr: false,
+		},
+		"invalid label key": {
+			metadataInjection: &placementv1beta1.MetadataInjectionPolicy{
+				Labels: map[string]string{
+					"bad key": "value",
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "label key bad key is invalid",
+		},
+		"reserved annotation key": {
+			metadataInjection: &placementv1beta1.MetadataInjectionPolicy{
+				Annotations: map[string]string{
+					placementv1beta1.ResourceSnapshotHashMetadataKey: "value",
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "uses the reserved prefix kubernetes-fleet.io/",
+		},
+		"resource snapshot hash in label value": {
+			metadataInjection: &placementv1beta1.MetadataInjectionPolicy{
+				Labels: map[string]string{
+					"example.com/hash": "${RESOURCE-SNAPSHOT-HASH}",
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "label example.com/hash cannot use the variable ${RESOURCE-SNAPSHOT-HASH}",
+		},
+		"invalid label value": {
+			metadataInjection: &placementv1beta1.MetadataInjectionPolicy{
+				Labels: map[string]string{
+					"example.com/placement": "${PLACEMENT-NAME}/invalid",
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "label example.com/placement has an invalid value `${PLACEMENT-NAME}/invalid`",
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			gotErr := validateMetadataInjectionPolicy(testCase.metadataInjection)
+			if (gotErr != nil) != testCase.wantErr {
+				t.Errorf("validateMetadataInjectionPolicy() error = %v, wantErr %v", gotErr, testCase.wantErr)
+			}
+			if testCase.wantErr && !strings.Contains(gotErr.Error(), testCase.wantErrMsg) {
+				t.Errorf("validateMetadataInjectionPolicy() got %v, should contain want %s", gotErr, testCase.wantErrMsg)
+			}
+		})
+	}
+}
+
+func TestValidateClusterResourcePlacement_ResourceNameTransform(t *testing.T) {
+	tests := map[string]struct {
+		nameTransform *placementv1beta1.ResourceNameTransform
+		wantErr       bool
+		wantErrMsg    string
+	}{
+		"valid prefix and suffix with variables": {
+			nameTransform: &placementv1beta1.ResourceNameTransform{
+				Prefix: "${PLACEMENT-NAME}-",
+				Suffix: ".${MEMBER-CLUSTER-NAME}",
+			},
+			wantErr: false,
+		},
+		"neither prefix nor suffix": {
+			nameTransform: &placementv1beta1.ResourceNameTransform{},
+			wantErr:       true,
+			wantErrMsg:    "at least one of the prefix and the suffix must be specified",
+		},
+		"unsupported variable": {
+			nameTransform: &placementv1beta1.ResourceNameTransform{
+				Suffix: "-${RESOURCE-SNAPSHOT-HASH}",
+			},
+			wantErr:    true,
+			wantErrMsg: "the prefix `` and the suffix `-${RESOURCE-SNAPSHOT-HASH}` do not render to a valid name",
+		},
+		"invalid characters": {
+			nameTransform: &placementv1beta1.ResourceNameTransform{
+				Prefix: "Team_A-",
+			},
+			wantErr:    true,
+			wantErrMsg: "the prefix `Team_A-` and the suffix `` do not render to a valid name",
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			gotErr := validateResourceNameTransform(testCase.nameTransform)
+			if (gotErr != nil) != testCase.wantErr {
+				t.Errorf("validateResourceNameTransform() error = %v, wantErr %v", gotErr, testCase.wantErr)
+			}
+			if testCase.wantErr && !strings.Contains(gotErr.Error(), testCase.wantErrMsg) {
+				t.Errorf("validateResourceNameTransform() got %v, should contain want %s", gotErr, testCase.wantErrMsg)
+			}
+		})
+	}
+}
+
 func TestValidateClusterResourcePlacement_PickFixedPlacementPolicy(t *testing.T) {
 	tests := map[string]struct {
 		policy     *placementv1beta1.PlacementPolicy
@@ -609,46 +1165,313 @@ func TestValidateClusterResourcePlacement_PickFixedPlacementPolicy(t *testing.T)
 			wantErr:    true,
 			wantErrMsg: "topology spread constraints needs to be empty for policy type PickFixed, only valid for PickN policy type",
 		},
-		"valid placement policy, PickFixed placementType, empty toleration, nil error": {
+		"valid placement policy, PickFixed placementType, empty toleration, nil error": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickFixedPlacementType,
+				ClusterNames:  []string{"test-cluster"},
+			},
+			wantErr: false,
+		},
+		"invalid placement policy - PickFixed placementType, non empty valid tolerations, error": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickFixedPlacementType,
+				Tolerations: []placementv1beta1.Toleration{
+					{
+						Key:      "key1",
+						Operator: corev1.TolerationOpEqual,
+						Value:    "value1",
+						Effect:   corev1.TaintEffectNoSchedule,
+					},
+					{
+						Key:      "key2",
+						Operator: corev1.TolerationOpExists,
+						Effect:   corev1.TaintEffectNoSchedule,
+					},
+					{
+						Operator: corev1.TolerationOpExists,
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "tolerations needs to be empty for policy type PickFixed, only valid for PickAll/PickN",
+		},
+		"invalid placement policy - PickFixed with external scheduler name": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:         placementv1beta1.PickFixedPlacementType,
+				ClusterNames:          []string{"test-cluster"},
+				ExternalSchedulerName: "custom-scheduler",
+			},
+			wantErr:    true,
+			wantErrMsg: "external scheduler name needs to be empty for policy type PickFixed, only valid for PickAll/PickN",
+		},
+		"invalid placement policy - PickFixed with scheduling profile name": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:         placementv1beta1.PickFixedPlacementType,
+				ClusterNames:          []string{"test-cluster"},
+				SchedulingProfileName: "test-profile",
+			},
+			wantErr:    true,
+			wantErrMsg: "scheduling profile name needs to be empty for policy type PickFixed, only valid for PickN",
+		},
+	}
+
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			gotErr := validatePlacementPolicy(testCase.policy)
+			if (gotErr != nil) != testCase.wantErr {
+				t.Errorf("validatePlacementPolicy() error = %v, wantErr %v", gotErr, testCase.wantErr)
+			}
+			if testCase.wantErr && !strings.Contains(gotErr.Error(), testCase.wantErrMsg) {
+				t.Errorf("validatePlacementPolicy() got %v, should contain want %s", gotErr, testCase.wantErrMsg)
+			}
+		})
+	}
+}
+
+func TestPickFixedClusterWarnings(t *testing.T) {
+	joinedCluster := func(name string, taints ...clusterv1beta1.Taint) *clusterv1beta1.MemberCluster {
+		return &clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1},
+			Spec:       clusterv1beta1.MemberClusterSpec{Taints: taints},
+			Status: clusterv1beta1.MemberClusterStatus{
+				Conditions: []metav1.Condition{
+					{
+						Type:               string(clusterv1beta1.ConditionTypeMemberClusterJoined),
+						Status:             metav1.ConditionTrue,
+						ObservedGeneration: 1,
+					},
+				},
+			},
+		}
+	}
+	regionTaint := clusterv1beta1.Taint{Key: "region", Value: "east", Effect: corev1.TaintEffectNoSchedule}
+	clusters := []*clusterv1beta1.MemberCluster{
+		joinedCluster("joined"),
+		joinedCluster("tainted", regionTaint),
+		{ObjectMeta: metav1.ObjectMeta{Name: "not-joined", Generation: 1}},
+	}
+	tests := map[string]struct {
+		policy       *placementv1beta1.PlacementPolicy
+		wantWarnings admission.Warnings
+	}{
+		"no policy": {},
+		"PickAll policy": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+			},
+		},
+		"all listed clusters can receive resources": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickFixedPlacementType,
+				ClusterNames:  []string{"joined"},
+			},
+		},
+		"listed clusters cannot receive resources": {
 			policy: &placementv1beta1.PlacementPolicy{
 				PlacementType: placementv1beta1.PickFixedPlacementType,
-				ClusterNames:  []string{"test-cluster"},
+				ClusterNames:  []string{"joined", "tainted", "not-joined", "missing"},
+			},
+			wantWarnings: admission.Warnings{
+				`cluster "tainted" listed in the PickFixed placement policy has taint region=east:NoSchedule that cannot be tolerated by the placement; no resources will be placed on it`,
+				`cluster "not-joined" listed in the PickFixed placement policy has not joined the fleet yet; no resources will be placed on it until it joins`,
+				`cluster "missing" listed in the PickFixed placement policy is not found in the fleet; no resources will be placed on it until it joins`,
 			},
-			wantErr: false,
 		},
-		"invalid placement policy - PickFixed placementType, non empty valid tolerations, error": {
+		"taint tolerated": {
 			policy: &placementv1beta1.PlacementPolicy{
 				PlacementType: placementv1beta1.PickFixedPlacementType,
+				ClusterNames:  []string{"tainted"},
 				Tolerations: []placementv1beta1.Toleration{
-					{
-						Key:      "key1",
-						Operator: corev1.TolerationOpEqual,
-						Value:    "value1",
-						Effect:   corev1.TaintEffectNoSchedule,
-					},
-					{
-						Key:      "key2",
-						Operator: corev1.TolerationOpExists,
-						Effect:   corev1.TaintEffectNoSchedule,
-					},
-					{
-						Operator: corev1.TolerationOpExists,
-					},
+					{Key: "region", Operator: corev1.TolerationOpExists},
 				},
 			},
-			wantErr:    true,
-			wantErrMsg: "tolerations needs to be empty for policy type PickFixed, only valid for PickAll/PickN",
 		},
 	}
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add cluster v1beta1 scheme: %v", err)
+	}
+	builder := fake.NewClientBuilder().WithScheme(scheme)
+	for _, mc := range clusters {
+		builder = builder.WithObjects(mc)
+	}
+	fakeClient := builder.Build()
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			crp := &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{Name: "test-crp"},
+				Spec: placementv1beta1.PlacementSpec{
+					Policy: testCase.policy,
+				},
+			}
+			gotWarnings := PickFixedClusterWarnings(context.Background(), fakeClient, crp)
+			if diff := cmp.Diff(testCase.wantWarnings, gotWarnings); diff != "" {
+				t.Errorf("PickFixedClusterWarnings() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestValidatePlacementDependencies(t *testing.T) {
+	crp := func(name string, dependsOn ...string) *placementv1beta1.ClusterResourcePlacement {
+		return &placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec: placementv1beta1.PlacementSpec{
+				DependsOn: dependsOn,
+			},
+		}
+	}
+	rp := func(namespace, name string, dependsOn ...string) *placementv1beta1.ResourcePlacement {
+		return &placementv1beta1.ResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec: placementv1beta1.PlacementSpec{
+				DependsOn: dependsOn,
+			},
+		}
+	}
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add placement v1beta1 scheme: %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		crp("crp-a"),
+		crp("crp-b", "crp-a"),
+		crp("crp-c", "crp-b", "crp-missing"),
+		rp("ns-1", "rp-a", "rp-b"),
+		// The RP in another namespace is not a dependency of the RPs in ns-1.
+		rp("ns-2", "rp-b", "rp-a"),
+	).Build()
 
+	tests := map[string]struct {
+		placement  placementv1beta1.PlacementObj
+		wantErrMsg string
+	}{
+		"no dependencies": {
+			placement: crp("crp-new"),
+		},
+		"depends on itself": {
+			placement:  crp("crp-new", "crp-a", "crp-new"),
+			wantErrMsg: "the placement cannot depend on itself",
+		},
+		"depends on a chain of placements": {
+			placement: crp("crp-new", "crp-c"),
+		},
+		"depends on a placement that does not exist": {
+			placement: crp("crp-new", "crp-missing"),
+		},
+		"update that closes a cycle": {
+			placement:  crp("crp-a", "crp-c"),
+			wantErrMsg: "the placement has a dependency cycle: crp-a -> crp-c -> crp-b -> crp-a",
+		},
+		"update that does not close a cycle": {
+			placement: crp("crp-b", "crp-a", "crp-missing"),
+		},
+		"resource placements in another namespace": {
+			placement: rp("ns-3", "rp-b", "rp-a"),
+		},
+		"update of a resource placement that closes a cycle": {
+			placement:  rp("ns-2", "rp-a", "rp-b"),
+			wantErrMsg: "the placement has a dependency cycle: rp-a -> rp-b -> rp-a",
+		},
+	}
 	for testName, testCase := range tests {
 		t.Run(testName, func(t *testing.T) {
-			gotErr := validatePlacementPolicy(testCase.policy)
-			if (gotErr != nil) != testCase.wantErr {
-				t.Errorf("validatePlacementPolicy() error = %v, wantErr %v", gotErr, testCase.wantErr)
+			gotErr := ValidatePlacementDependencies(context.Background(), fakeClient, testCase.placement)
+			if testCase.wantErrMsg == "" {
+				if gotErr != nil {
+					t.Errorf("ValidatePlacementDependencies() = %v, want no error", gotErr)
+				}
+				return
 			}
-			if testCase.wantErr && !strings.Contains(gotErr.Error(), testCase.wantErrMsg) {
-				t.Errorf("validatePlacementPolicy() got %v, should contain want %s", gotErr, testCase.wantErrMsg)
+			if gotErr == nil || gotErr.Error() != testCase.wantErrMsg {
+				t.Errorf("ValidatePlacementDependencies() = %v, want %s", gotErr, testCase.wantErrMsg)
+			}
+		})
+	}
+}
+
+func TestValidatePlacementAffinityCycle(t *testing.T) {
+	following := func(name string) *placementv1beta1.PlacementPolicy {
+		if name == "" {
+			return nil
+		}
+		return &placementv1beta1.PlacementPolicy{
+			Affinity: &placementv1beta1.Affinity{
+				PlacementAffinity: &placementv1beta1.PlacementAffinity{PlacementName: name},
+			},
+		}
+	}
+	crp := func(name, follows string) *placementv1beta1.ClusterResourcePlacement {
+		return &placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: name},
+			Spec:       placementv1beta1.PlacementSpec{Policy: following(follows)},
+		}
+	}
+	rp := func(namespace, name, follows string) *placementv1beta1.ResourcePlacement {
+		return &placementv1beta1.ResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Namespace: namespace, Name: name},
+			Spec:       placementv1beta1.PlacementSpec{Policy: following(follows)},
+		}
+	}
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("Failed to add placement v1beta1 scheme: %v", err)
+	}
+	fakeClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		crp("crp-a", ""),
+		crp("crp-b", "crp-a"),
+		crp("crp-c", "crp-b"),
+		crp("crp-d", "crp-missing"),
+		crp("crp-x", "crp-y"),
+		crp("crp-y", "crp-x"),
+		rp("ns-1", "rp-a", "rp-b"),
+		// The RP in another namespace is not followed by the RPs in ns-1.
+		rp("ns-2", "rp-b", "rp-a"),
+	).Build()
+
+	tests := map[string]struct {
+		placement  placementv1beta1.PlacementObj
+		wantErrMsg string
+	}{
+		"no placement affinity": {
+			placement: crp("crp-new", ""),
+		},
+		"follows a chain of placements": {
+			placement: crp("crp-new", "crp-c"),
+		},
+		"follows a placement that does not exist": {
+			placement: crp("crp-new", "crp-d"),
+		},
+		"follows a chain with a cycle that does not involve the placement": {
+			placement: crp("crp-new", "crp-x"),
+		},
+		"mutual follow": {
+			placement:  crp("crp-a", "crp-b"),
+			wantErrMsg: "the placement affinity has a cycle: crp-a -> crp-b -> crp-a",
+		},
+		"update that closes a longer cycle": {
+			placement:  crp("crp-a", "crp-c"),
+			wantErrMsg: "the placement affinity has a cycle: crp-a -> crp-c -> crp-b -> crp-a",
+		},
+		"resource placements in another namespace": {
+			placement: rp("ns-3", "rp-b", "rp-a"),
+		},
+		"update of a resource placement that closes a cycle": {
+			placement:  rp("ns-2", "rp-a", "rp-b"),
+			wantErrMsg: "the placement affinity has a cycle: rp-a -> rp-b -> rp-a",
+		},
+	}
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			gotErr := ValidatePlacementAffinityCycle(context.Background(), fakeClient, testCase.placement)
+			if testCase.wantErrMsg == "" {
+				if gotErr != nil {
+					t.Errorf("ValidatePlacementAffinityCycle() = %v, want no error", gotErr)
+				}
+				return
+			}
+			if gotErr == nil || gotErr.Error() != testCase.wantErrMsg {
+				t.Errorf("ValidatePlacementAffinityCycle() = %v, want %s", gotErr, testCase.wantErrMsg)
 			}
 		})
 	}
@@ -836,6 +1659,110 @@ func TestValidateClusterResourcePlacement_PickAllPlacementPolicy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "operator Eq requires exactly one value, got 2",
 		},
+		"invalid placement policy - PickAll with In property selector": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "region",
+												Operator: placementv1beta1.PropertySelectorIn,
+												Values:   []string{"eu-*", "us-east"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "operator In of property region is only supported in the property selectors of override rules",
+		},
+		"invalid placement policy - PickAll with In property selector, no values": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "region",
+												Operator: placementv1beta1.PropertySelectorIn,
+												Values:   []string{},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "operator In requires at least one value",
+		},
+		"invalid placement policy - PickAll with NotIn property selector, invalid pattern": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "region",
+												Operator: placementv1beta1.PropertySelectorNotIn,
+												Values:   []string{"[eu"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "value [eu is not a valid glob pattern",
+		},
+		"invalid placement policy - PickAll with In property selector on resource property": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						RequiredDuringSchedulingIgnoredDuringExecution: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "resources.kubernetes-fleet.io/available-cpu",
+												Operator: placementv1beta1.PropertySelectorIn,
+												Values:   []string{"1"},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "operator In is not supported for resource property resources.kubernetes-fleet.io/available-cpu",
+		},
 		"invalid placement policy - PickAll with invalid property selector name, invalid capacity type": {
 			policy: &placementv1beta1.PlacementPolicy{
 				PlacementType: placementv1beta1.PickAllPlacementType,
@@ -1162,6 +2089,57 @@ func TestValidateClusterResourcePlacement_PickNPlacementPolicy(t *testing.T) {
 			wantErr:    true,
 			wantErrMsg: "invalid property sort order random-order",
 		},
+		"valid placement policy - PickN with weights beyond 100 in PreferredDuringSchedulingIgnoredDuringExecution affinity": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PreferredClusterSelector{
+							{
+								Weight: 1000,
+								Preference: placementv1beta1.ClusterSelectorTerm{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"test-key1": "test-value1"},
+									},
+								},
+							},
+							{
+								Weight: -1000,
+								Preference: placementv1beta1.ClusterSelectorTerm{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"test-key2": "test-value2"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr: false,
+		},
+		"invalid placement policy - PickN with out of range weight in PreferredDuringSchedulingIgnoredDuringExecution affinity": {
+			policy: &placementv1beta1.PlacementPolicy{
+				PlacementType:    placementv1beta1.PickNPlacementType,
+				NumberOfClusters: &positiveNumberOfClusters,
+				Affinity: &placementv1beta1.Affinity{
+					ClusterAffinity: &placementv1beta1.ClusterAffinity{
+						PreferredDuringSchedulingIgnoredDuringExecution: []placementv1beta1.PreferredClusterSelector{
+							{
+								Weight: 1001,
+								Preference: placementv1beta1.ClusterSelectorTerm{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"test-key1": "test-value1"},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErr:    true,
+			wantErrMsg: "the weight 1001 of the preferred cluster selector is not in the range [-1000, 1000]",
+		},
 		"invalid placement policy - PickN with invalid topology constraint with unknown unsatisfiable type": {
 			policy: &placementv1beta1.PlacementPolicy{
 				PlacementType:    placementv1beta1.PickNPlacementType,
@@ -1631,6 +2609,76 @@ func TestIsPlacementPolicyUpdateValid(t *testing.T) {
 	}
 }
 
+func TestIsExternalSchedulerNameUpdated(t *testing.T) {
+	tests := map[string]struct {
+		oldPolicy     *placementv1beta1.PlacementPolicy
+		currentPolicy *placementv1beta1.PlacementPolicy
+		want          bool
+	}{
+		"old policy is nil, current policy is nil": {
+			oldPolicy:     nil,
+			currentPolicy: nil,
+			want:          false,
+		},
+		"old policy is nil, current policy has no external scheduler name": {
+			oldPolicy: nil,
+			currentPolicy: &placementv1beta1.PlacementPolicy{
+				PlacementType: placementv1beta1.PickAllPlacementType,
+			},
+			want: false,
+		},
+		"old policy is nil, current policy has external scheduler name": {
+			oldPolicy: nil,
+			currentPolicy: &placementv1beta1.PlacementPolicy{
+				PlacementType:         placementv1beta1.PickAllPlacementType,
+				ExternalSchedulerName: "custom-scheduler",
+			},
+			want: true,
+		},
+		"old policy has external scheduler name, current policy is nil": {
+			oldPolicy: &placementv1beta1.PlacementPolicy{
+				PlacementType:         placementv1beta1.PickAllPlacementType,
+				ExternalSchedulerName: "custom-scheduler",
+			},
+			currentPolicy: nil,
+			want:          true,
+		},
+		"external scheduler name is unchanged": {
+			oldPolicy: &placementv1beta1.PlacementPolicy{
+				PlacementType:         placementv1beta1.PickNPlacementType,
+				NumberOfClusters:      &positiveNumberOfClusters,
+				ExternalSchedulerName: "custom-scheduler",
+			},
+			currentPolicy: &placementv1beta1.PlacementPolicy{
+				PlacementType:         placementv1beta1.PickNPlacementType,
+				NumberOfClusters:      &positiveNumberOfClusters,
+				ExternalSchedulerName: "custom-scheduler",
+			},
+			want: false,
+		},
+		"external scheduler name is changed": {
+			oldPolicy: &placementv1beta1.PlacementPolicy{
+				PlacementType:         placementv1beta1.PickNPlacementType,
+				NumberOfClusters:      &positiveNumberOfClusters,
+				ExternalSchedulerName: "custom-scheduler",
+			},
+			currentPolicy: &placementv1beta1.PlacementPolicy{
+				PlacementType:         placementv1beta1.PickNPlacementType,
+				NumberOfClusters:      &positiveNumberOfClusters,
+				ExternalSchedulerName: "another-scheduler",
+			},
+			want: true,
+		},
+	}
+	for testName, testCase := range tests {
+		t.Run(testName, func(t *testing.T) {
+			if got := IsExternalSchedulerNameUpdated(testCase.oldPolicy, testCase.currentPolicy); got != testCase.want {
+				t.Errorf("IsExternalSchedulerNameUpdated() got = %v, want %v", got, testCase.want)
+			}
+		})
+	}
+}
+
 func TestValidateTolerations(t *testing.T) {
 	tests := map[string]struct {
 		tolerations []placementv1beta1.Toleration
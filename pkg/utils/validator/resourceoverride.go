@@ -101,15 +101,24 @@ func validateOverridePolicy(policy *placementv1beta1.OverridePolicy) error {
 	for _, rule := range policy.OverrideRules {
 		if rule.ClusterSelector != nil {
 			for _, selector := range rule.ClusterSelector.ClusterSelectorTerms {
-				// Check that only label selector is supported
-				if selector.PropertySelector != nil || selector.PropertySorter != nil {
-					allErr = append(allErr, fmt.Errorf("invalid clusterSelector %+v: only labelSelector is supported", selector))
+				// Check that only label selector and property selector are supported
+				if selector.PropertySorter != nil {
+					allErr = append(allErr, fmt.Errorf("invalid clusterSelector %+v: propertySorter is not supported", selector))
 					continue
 				}
-				if selector.LabelSelector == nil {
-					allErr = append(allErr, fmt.Errorf("invalid clusterSelector %+v: labelSelector is required", selector))
-				} else if err := validateLabelSelector(selector.LabelSelector, "cluster selector"); err != nil {
-					allErr = append(allErr, err)
+				if selector.LabelSelector == nil && selector.PropertySelector == nil {
+					allErr = append(allErr, fmt.Errorf("invalid clusterSelector %+v: labelSelector or propertySelector is required", selector))
+					continue
+				}
+				if selector.LabelSelector != nil {
+					if err := validateLabelSelector(selector.LabelSelector, "cluster selector"); err != nil {
+						allErr = append(allErr, err)
+					}
+				}
+				if selector.PropertySelector != nil {
+					if err := validatePropertySelector(selector.PropertySelector); err != nil {
+						allErr = append(allErr, err)
+					}
 				}
 			}
 		}
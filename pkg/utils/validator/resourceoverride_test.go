@@ -380,14 +380,9 @@ func TestValidateResourceOverride(t *testing.T) {
 								ClusterSelector: &placementv1beta1.ClusterSelector{
 									ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
 										{
-											PropertySelector: &placementv1beta1.PropertySelector{
-												MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
-													{
-														Name:     "example",
-														Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
-														Values:   []string{"1"},
-													},
-												},
+											PropertySorter: &placementv1beta1.PropertySorter{
+												Name:      "example",
+												SortOrder: placementv1beta1.Descending,
 											},
 										},
 									},
@@ -398,7 +393,7 @@ func TestValidateResourceOverride(t *testing.T) {
 				},
 			},
 			roList:     &placementv1beta1.ResourceOverrideList{},
-			wantErrMsg: fmt.Errorf("only labelSelector is supported"),
+			wantErrMsg: fmt.Errorf("propertySorter is not supported"),
 		},
 		"invalid resource override - fail validateResourceOverridePolicy with nil label selector": {
 			ro: placementv1beta1.ResourceOverride{
@@ -418,7 +413,7 @@ func TestValidateResourceOverride(t *testing.T) {
 					},
 				},
 			},
-			wantErrMsg: errors.New("labelSelector is required"),
+			wantErrMsg: errors.New("labelSelector or propertySelector is required"),
 		},
 		"valid resource override - empty cluster selector": {
 			ro: placementv1beta1.ResourceOverride{
@@ -624,7 +619,26 @@ func TestValidateOverridePolicy(t *testing.T) {
 			},
 			wantErrMsg: nil,
 		},
-		"unsupported selector type - property selector": {
+		"unsupported selector type - property sorter": {
+			policy: &placementv1beta1.OverridePolicy{
+				OverrideRules: []placementv1beta1.OverrideRule{
+					{
+						ClusterSelector: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									PropertySorter: &placementv1beta1.PropertySorter{
+										Name:      "example",
+										SortOrder: placementv1beta1.Descending,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			wantErrMsg: fmt.Errorf("propertySorter is not supported"),
+		},
+		"valid property selector": {
 			policy: &placementv1beta1.OverridePolicy{
 				OverrideRules: []placementv1beta1.OverrideRule{
 					{
@@ -634,19 +648,53 @@ func TestValidateOverridePolicy(t *testing.T) {
 									PropertySelector: &placementv1beta1.PropertySelector{
 										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
 											{
-												Name:     "example",
-												Operator: placementv1beta1.PropertySelectorGreaterThanOrEqualTo,
-												Values:   []string{"1"},
+												Name:     "kubernetes-fleet.io/node-count",
+												Operator: placementv1beta1.PropertySelectorGreaterThan,
+												Values:   []string{"50"},
+											},
+											{
+												Name:     "region",
+												Operator: placementv1beta1.PropertySelectorIn,
+												Values:   []string{"eu-*"},
 											},
 										},
 									},
 								},
 							},
 						},
+						JSONPatchOverrides: validJSONPatchOverrides,
+					},
+				},
+			},
+			wantErrMsg: nil,
+		},
+		"invalid property selector": {
+			policy: &placementv1beta1.OverridePolicy{
+				OverrideRules: []placementv1beta1.OverrideRule{
+					{
+						ClusterSelector: &placementv1beta1.ClusterSelector{
+							ClusterSelectorTerms: []placementv1beta1.ClusterSelectorTerm{
+								{
+									LabelSelector: &metav1.LabelSelector{
+										MatchLabels: map[string]string{"key": "value"},
+									},
+									PropertySelector: &placementv1beta1.PropertySelector{
+										MatchExpressions: []placementv1beta1.PropertySelectorRequirement{
+											{
+												Name:     "region",
+												Operator: placementv1beta1.PropertySelectorIn,
+												Values:   []string{"[eu"},
+											},
+										},
+									},
+								},
+							},
+						},
+						JSONPatchOverrides: validJSONPatchOverrides,
 					},
 				},
 			},
-			wantErrMsg: fmt.Errorf("only labelSelector is supported"),
+			wantErrMsg: errors.New("value [eu is not a valid glob pattern"),
 		},
 		"no cluster selector": {
 			policy: &placementv1beta1.OverridePolicy{
@@ -681,7 +729,7 @@ func TestValidateOverridePolicy(t *testing.T) {
 					},
 				},
 			},
-			wantErrMsg: errors.New("labelSelector is required"),
+			wantErrMsg: errors.New("labelSelector or propertySelector is required"),
 		},
 		"nil JSONPatchOverride": {
 			policy: &placementv1beta1.OverridePolicy{
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package version features the build information of the KubeFleet agents.
+package version
+
+import (
+	"runtime/debug"
+)
+
+var (
+	// Version is the build version of the agent. It is set at build time via
+	// -ldflags "-X github.com/kubefleet-dev/kubefleet/pkg/version.Version=<version>".
+	Version = ""
+	// GitCommit is the git commit from which the agent is built. It is set at build time via
+	// -ldflags "-X github.com/kubefleet-dev/kubefleet/pkg/version.GitCommit=<commit>".
+	GitCommit = ""
+)
+
+const (
+	// unknownVersion is reported when the build version is not set at build time.
+	unknownVersion = "unknown"
+
+	vcsRevisionSettingKey = "vcs.revision"
+)
+
+// Info is the build information of an agent.
+type Info struct {
+	// Version is the build version of the agent.
+	Version string
+	// GitCommit is the git commit from which the agent is built.
+	GitCommit string
+}
+
+// Get returns the build information of the running agent.
+//
+// If the git commit is not set at build time, it falls back to the VCS revision that the Go
+// toolchain embeds in the binary, if any.
+func Get() Info {
+	return buildInfo(Version, GitCommit, debug.ReadBuildInfo)
+}
+
+func buildInfo(v, commit string, readBuildInfo func() (*debug.BuildInfo, bool)) Info {
+	if v == "" {
+		v = unknownVersion
+	}
+	if commit == "" {
+		if bi, ok := readBuildInfo(); ok {
+			for _, setting := range bi.Settings {
+				if setting.Key == vcsRevisionSettingKey {
+					commit = setting.Value
+					break
+				}
+			}
+		}
+	}
+	return Info{
+		Version:   v,
+		GitCommit: commit,
+	}
+}
@@ -0,0 +1,74 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package version
+
+import (
+	"runtime/debug"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestBuildInfo(t *testing.T) {
+	withRevision := func() (*debug.BuildInfo, bool) {
+		return &debug.BuildInfo{
+			Settings: []debug.BuildSetting{
+				{Key: "vcs.time", Value: "2025-01-01T00:00:00Z"},
+				{Key: vcsRevisionSettingKey, Value: "0123456789abcdef"},
+			},
+		}, true
+	}
+	noBuildInfo := func() (*debug.BuildInfo, bool) {
+		return nil, false
+	}
+
+	testCases := []struct {
+		name          string
+		version       string
+		commit        string
+		readBuildInfo func() (*debug.BuildInfo, bool)
+		want          Info
+	}{
+		{
+			name:          "set at build time",
+			version:       "v0.15.0",
+			commit:        "abcdef0",
+			readBuildInfo: withRevision,
+			want:          Info{Version: "v0.15.0", GitCommit: "abcdef0"},
+		},
+		{
+			name:          "commit falls back to the vcs revision",
+			version:       "v0.15.0",
+			readBuildInfo: withRevision,
+			want:          Info{Version: "v0.15.0", GitCommit: "0123456789abcdef"},
+		},
+		{
+			name:          "nothing set",
+			readBuildInfo: noBuildInfo,
+			want:          Info{Version: unknownVersion},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			got := buildInfo(tc.version, tc.commit, tc.readBuildInfo)
+			if diff := cmp.Diff(tc.want, got); diff != "" {
+				t.Errorf("buildInfo() mismatch (-want, +got):\n%s", diff)
+			}
+		})
+	}
+}
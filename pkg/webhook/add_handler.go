@@ -1,10 +1,14 @@
 package webhook
 
 import (
+	"github.com/kubefleet-dev/kubefleet/pkg/webhook/binding"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/clusterresourceoverride"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/clusterresourceplacement"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/clusterresourceplacementdisruptionbudget"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/clusterresourceplacementeviction"
+	"github.com/kubefleet-dev/kubefleet/pkg/webhook/conversion"
+	"github.com/kubefleet-dev/kubefleet/pkg/webhook/envelope"
+	"github.com/kubefleet-dev/kubefleet/pkg/webhook/externalrolloutprogress"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/fleetresourcehandler"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/membercluster"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/pdb"
@@ -18,10 +22,14 @@ func init() {
 	// AddToManagerFleetResourceValidator is a function to register fleet guard rail resource validator to the webhook server
 	AddToManagerFleetResourceValidator = fleetresourcehandler.Add
 	AddToManagerMemberclusterValidator = membercluster.Add
+	AddToManagerBindingValidator = binding.Add
+	AddToManagerEnvelopeValidator = envelope.Add
 	// AddToManagerFuncs is a list of functions to register webhook validators and mutators to the webhook server
 	AddToManagerFuncs = append(AddToManagerFuncs, clusterresourceplacement.AddMutating)
 	AddToManagerFuncs = append(AddToManagerFuncs, clusterresourceplacement.Add)
+	AddToManagerFuncs = append(AddToManagerFuncs, resourceplacement.AddMutating)
 	AddToManagerFuncs = append(AddToManagerFuncs, resourceplacement.Add)
+	AddToManagerFuncs = append(AddToManagerFuncs, membercluster.AddMutating)
 	AddToManagerFuncs = append(AddToManagerFuncs, pod.Add)
 	AddToManagerFuncs = append(AddToManagerFuncs, replicaset.Add)
 	AddToManagerFuncs = append(AddToManagerFuncs, pdb.Add)
@@ -29,4 +37,6 @@ func init() {
 	AddToManagerFuncs = append(AddToManagerFuncs, resourceoverride.Add)
 	AddToManagerFuncs = append(AddToManagerFuncs, clusterresourceplacementeviction.Add)
 	AddToManagerFuncs = append(AddToManagerFuncs, clusterresourceplacementdisruptionbudget.Add)
+	AddToManagerFuncs = append(AddToManagerFuncs, externalrolloutprogress.Add)
+	AddToManagerFuncs = append(AddToManagerFuncs, conversion.Add)
 }
@@ -0,0 +1,265 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package binding provides a validating webhook for the clusterresourcebinding and resourcebinding custom resources
+// in the KubeFleet API group.
+package binding
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"slices"
+	"strings"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	"github.com/kubefleet-dev/kubefleet/pkg/webhook/validation"
+)
+
+var (
+	// ValidationPath is the webhook service path which admission requests are routed to for validating
+	// clusterresourcebinding and resourcebinding resources.
+	ValidationPath = fmt.Sprintf(utils.ValidationPathFmt, placementv1beta1.GroupVersion.Group, placementv1beta1.GroupVersion.Version, "binding")
+)
+
+const (
+	denyMissingPolicySnapshotFmt = "binding %s belongs to placement %s, which is scheduled by the external scheduler %s, but it does not refer to any scheduling policy snapshot"
+	denyStalePolicySnapshotFmt   = "binding %s belongs to placement %s, which is scheduled by the external scheduler %s, but it refers to scheduling policy snapshot %s instead of the latest one %s"
+	denyNoPolicySnapshotFmt      = "binding %s belongs to placement %s, which is scheduled by the external scheduler %s, but the placement does not have a latest scheduling policy snapshot yet"
+	denyUnauthorizedUserFmt      = "user %s in groups %s is not allowed to %s binding %s in state %s; only the fleet scheduler and rollout controller can create bindings or change their states"
+	denyInvalidInitialStateFmt   = "binding %s must be created in state %s, got state %q"
+	denyInvalidTransitionFmt     = "binding %s cannot transition from state %s to state %q"
+	denyTrackingFieldChangeFmt   = "user %s in groups %s is not allowed to change the %s of binding %s; only the fleet system components can change the fields the fleet controllers use to track bindings"
+)
+
+var (
+	// validStateTransitions are the binding state transitions performed by the scheduler and the rollout
+	// controller:
+	//   - the rollout controller binds scheduled bindings;
+	//   - the scheduler unschedules scheduled or bound bindings when their clusters are no longer picked;
+	//   - the scheduler restores unscheduled bindings to their previous states when their clusters are picked again.
+	validStateTransitions = map[placementv1beta1.BindingState][]placementv1beta1.BindingState{
+		placementv1beta1.BindingStateScheduled:   {placementv1beta1.BindingStateBound, placementv1beta1.BindingStateUnscheduled},
+		placementv1beta1.BindingStateBound:       {placementv1beta1.BindingStateUnscheduled},
+		placementv1beta1.BindingStateUnscheduled: {placementv1beta1.BindingStateScheduled, placementv1beta1.BindingStateBound},
+	}
+)
+
+type bindingValidator struct {
+	client           client.Client
+	decoder          webhook.AdmissionDecoder
+	whiteListedUsers []string
+}
+
+// Add registers the webhook for K8s built-in object types.
+func Add(mgr manager.Manager, whiteListedUsers []string) error {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register(ValidationPath, &webhook.Admission{Handler: &bindingValidator{mgr.GetClient(), admission.NewDecoder(mgr.GetScheme()), whiteListedUsers}})
+	return nil
+}
+
+// Handle bindingValidator checks that bindings are only created or moved between states by authorized
+// identities along the binding state machine, that their tracking fields are only changed by authorized
+// identities, and that bindings of placements scheduled by an external
+// scheduler refer to the latest scheduling policy snapshot of their placements.
+func (v *bindingValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("binding operation is not subject to validation")
+	}
+	klog.V(2).InfoS("Validating webhook handling binding", "operation", req.Operation, "kind", req.Kind.Kind, "namespacedName", types.NamespacedName{Name: req.Name, Namespace: req.Namespace})
+
+	binding, oldBinding, err := v.decodeBindings(req)
+	if err != nil {
+		klog.ErrorS(err, "Failed to decode binding object for validating fields", "userName", req.UserInfo.Username, "groups", req.UserInfo.Groups, "kind", req.Kind.Kind, "binding", req.Name)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	if resp := v.validateStateTransition(req, binding, oldBinding); resp != nil {
+		return *resp
+	}
+	if resp := v.validateTrackingFields(req, binding, oldBinding); resp != nil {
+		return *resp
+	}
+
+	// Bindings are only checked when they are created or when their policy snapshot references change,
+	// so that existing bindings can still be updated (e.g., by the rollout controller) after a new
+	// policy snapshot is created.
+	if oldBinding != nil && oldBinding.GetBindingSpec().SchedulingPolicySnapshotName == binding.GetBindingSpec().SchedulingPolicySnapshotName {
+		return admission.Allowed("binding policy snapshot reference is not changed")
+	}
+
+	placementName := binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+	if placementName == "" {
+		return admission.Allowed("binding is not associated with any placement")
+	}
+	placementKey := types.NamespacedName{Namespace: binding.GetNamespace(), Name: placementName}
+	placement, err := controller.FetchPlacementFromNamespacedName(ctx, v.client, placementKey)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return admission.Allowed("associated placement of the binding is not found")
+		}
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to get placement %s for binding %s: %w", placementKey, binding.GetName(), err))
+	}
+
+	policy := placement.GetPlacementSpec().Policy
+	if policy == nil || policy.ExternalSchedulerName == "" {
+		return admission.Allowed("associated placement of the binding is scheduled by the built-in scheduler")
+	}
+
+	bindingRef := klog.KObj(binding).String()
+	placementRef := klog.KRef(placementKey.Namespace, placementKey.Name).String()
+	policySnapshotName := binding.GetBindingSpec().SchedulingPolicySnapshotName
+	if policySnapshotName == "" {
+		return admission.Denied(fmt.Sprintf(denyMissingPolicySnapshotFmt, bindingRef, placementRef, policy.ExternalSchedulerName))
+	}
+
+	policySnapshotList, err := controller.FetchLatestPolicySnapshot(ctx, v.client, placementKey)
+	if err != nil {
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to get the latest policy snapshot of placement %s for binding %s: %w", placementKey, binding.GetName(), err))
+	}
+	policySnapshots := policySnapshotList.GetPolicySnapshotObjs()
+	if len(policySnapshots) != 1 {
+		return admission.Denied(fmt.Sprintf(denyNoPolicySnapshotFmt, bindingRef, placementRef, policy.ExternalSchedulerName))
+	}
+	if latestName := policySnapshots[0].GetName(); latestName != policySnapshotName {
+		return admission.Denied(fmt.Sprintf(denyStalePolicySnapshotFmt, bindingRef, placementRef, policy.ExternalSchedulerName, policySnapshotName, latestName))
+	}
+
+	klog.V(2).InfoS("Binding refers to the latest policy snapshot", "binding", bindingRef, "placement", placementRef)
+	return admission.Allowed("binding refers to the latest policy snapshot of its placement")
+}
+
+// validateStateTransition checks that a binding is created in the Scheduled state, and that its state
+// only changes along the valid state transitions. As the scheduler and the rollout controller rely on
+// the binding states to make decisions, bindings can only be created or have their states changed by
+// the fleet system components (or white listed users and cluster admins, e.g., an external scheduler
+// whose identity is white listed). It returns nil if the request passes the check.
+func (v *bindingValidator) validateStateTransition(req admission.Request, binding, oldBinding placementv1beta1.BindingObj) *admission.Response {
+	state := binding.GetBindingSpec().State
+	if oldBinding != nil && oldBinding.GetBindingSpec().State == state {
+		return nil
+	}
+
+	bindingRef := klog.KObj(binding).String()
+	userInfo := req.UserInfo
+	if !validation.IsFleetSystemUser(v.whiteListedUsers, userInfo) {
+		klog.V(2).InfoS("Denied binding state change by an unauthorized user", "user", userInfo.Username, "groups", userInfo.Groups, "operation", req.Operation, "binding", bindingRef, "state", state)
+		resp := admission.Denied(fmt.Sprintf(denyUnauthorizedUserFmt, userInfo.Username, utils.GenerateGroupString(userInfo.Groups), req.Operation, bindingRef, state))
+		return &resp
+	}
+
+	if oldBinding == nil {
+		if state != placementv1beta1.BindingStateScheduled {
+			resp := admission.Denied(fmt.Sprintf(denyInvalidInitialStateFmt, bindingRef, placementv1beta1.BindingStateScheduled, state))
+			return &resp
+		}
+		return nil
+	}
+	oldState := oldBinding.GetBindingSpec().State
+	if !slices.Contains(validStateTransitions[oldState], state) {
+		resp := admission.Denied(fmt.Sprintf(denyInvalidTransitionFmt, bindingRef, oldState, state))
+		return &resp
+	}
+	return nil
+}
+
+// validateTrackingFields checks that the fields the fleet controllers use to track a binding, i.e., its
+// placement tracking label, its scheduler cleanup finalizer, and its snapshot references, are only changed
+// by the fleet system components (or white listed users and cluster admins); as the watchers key off these
+// fields, changing them otherwise silently detaches the binding from the scheduling and the rollout of its
+// placement. It returns nil if the request passes the check.
+func (v *bindingValidator) validateTrackingFields(req admission.Request, binding, oldBinding placementv1beta1.BindingObj) *admission.Response {
+	if oldBinding == nil {
+		// Bindings can only be created by the fleet system components; see validateStateTransition.
+		return nil
+	}
+	changed := changedTrackingFields(binding, oldBinding)
+	if len(changed) == 0 {
+		return nil
+	}
+
+	userInfo := req.UserInfo
+	if validation.IsFleetSystemUser(v.whiteListedUsers, userInfo) {
+		return nil
+	}
+	bindingRef := klog.KObj(binding).String()
+	klog.V(2).InfoS("Denied binding tracking field change by an unauthorized user", "user", userInfo.Username, "groups", userInfo.Groups, "binding", bindingRef, "fields", changed)
+	resp := admission.Denied(fmt.Sprintf(denyTrackingFieldChangeFmt, userInfo.Username, utils.GenerateGroupString(userInfo.Groups), strings.Join(changed, ", "), bindingRef))
+	return &resp
+}
+
+// changedTrackingFields returns the descriptions of the tracking fields that differ between two versions
+// of a binding.
+func changedTrackingFields(binding, oldBinding placementv1beta1.BindingObj) []string {
+	var changed []string
+	placementName, hasPlacement := binding.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+	oldPlacementName, oldHasPlacement := oldBinding.GetLabels()[placementv1beta1.PlacementTrackingLabel]
+	if hasPlacement != oldHasPlacement || placementName != oldPlacementName {
+		changed = append(changed, fmt.Sprintf("label %s", placementv1beta1.PlacementTrackingLabel))
+	}
+	if controllerutil.ContainsFinalizer(binding, placementv1beta1.SchedulerBindingCleanupFinalizer) !=
+		controllerutil.ContainsFinalizer(oldBinding, placementv1beta1.SchedulerBindingCleanupFinalizer) {
+		changed = append(changed, fmt.Sprintf("finalizer %s", placementv1beta1.SchedulerBindingCleanupFinalizer))
+	}
+	spec, oldSpec := binding.GetBindingSpec(), oldBinding.GetBindingSpec()
+	if spec.ResourceSnapshotName != oldSpec.ResourceSnapshotName {
+		changed = append(changed, "resource snapshot reference")
+	}
+	if spec.SchedulingPolicySnapshotName != oldSpec.SchedulingPolicySnapshotName {
+		changed = append(changed, "scheduling policy snapshot reference")
+	}
+	return changed
+}
+
+// decodeBindings decodes the binding (and the old binding, for updates) in an admission request.
+func (v *bindingValidator) decodeBindings(req admission.Request) (binding, oldBinding placementv1beta1.BindingObj, err error) {
+	switch req.Kind.Kind {
+	case placementv1beta1.ClusterResourceBindingKind:
+		binding = &placementv1beta1.ClusterResourceBinding{}
+		if req.Operation == admissionv1.Update {
+			oldBinding = &placementv1beta1.ClusterResourceBinding{}
+		}
+	case placementv1beta1.ResourceBindingKind:
+		binding = &placementv1beta1.ResourceBinding{}
+		if req.Operation == admissionv1.Update {
+			oldBinding = &placementv1beta1.ResourceBinding{}
+		}
+	default:
+		return nil, nil, fmt.Errorf("unexpected binding kind %s", req.Kind.Kind)
+	}
+
+	if err := v.decoder.Decode(req, binding); err != nil {
+		return nil, nil, err
+	}
+	if oldBinding != nil {
+		if err := v.decoder.DecodeRaw(req.OldObject, oldBinding); err != nil {
+			return nil, nil, err
+		}
+	}
+	return binding, oldBinding, nil
+}
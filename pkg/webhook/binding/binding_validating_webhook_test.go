@@ -0,0 +1,436 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package binding
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+const (
+	crpName                = "test-crp"
+	builtInCRPName         = "test-crp-built-in"
+	rpName                 = "test-rp"
+	testNamespace          = "test-ns"
+	externalSchedulerName  = "test-scheduler"
+	latestPolicySnapshot   = "test-crp-1"
+	obsoletePolicySnapshot = "test-crp-0"
+	bindingName            = "test-binding"
+	hubAgentUser           = "system:serviceaccount:fleet-system:hub-agent-sa"
+	whiteListedUser        = "test-external-scheduler"
+)
+
+func crbWith(placementName, policySnapshotName string) *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: bindingName,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: placementName,
+			},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:                        placementv1beta1.BindingStateScheduled,
+			SchedulingPolicySnapshotName: policySnapshotName,
+			TargetCluster:                "member-1",
+		},
+	}
+}
+
+func rbWith(placementName, policySnapshotName string) *placementv1beta1.ResourceBinding {
+	return &placementv1beta1.ResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      bindingName,
+			Namespace: testNamespace,
+			Labels: map[string]string{
+				placementv1beta1.PlacementTrackingLabel: placementName,
+			},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:                        placementv1beta1.BindingStateScheduled,
+			SchedulingPolicySnapshotName: policySnapshotName,
+			TargetCluster:                "member-1",
+		},
+	}
+}
+
+func admissionRequestFor(t *testing.T, op admissionv1.Operation, kind string, obj, oldObj client.Object) admission.Request {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("failed to marshal object: %v", err)
+	}
+	req := admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+			Object:    runtime.RawExtension{Raw: raw},
+			Kind:      metav1.GroupVersionKind{Group: placementv1beta1.GroupVersion.Group, Version: placementv1beta1.GroupVersion.Version, Kind: kind},
+			Operation: op,
+			UserInfo:  authenticationv1.UserInfo{Username: hubAgentUser},
+		},
+	}
+	if oldObj != nil {
+		oldRaw, err := json.Marshal(oldObj)
+		if err != nil {
+			t.Fatalf("failed to marshal old object: %v", err)
+		}
+		req.OldObject = runtime.RawExtension{Raw: oldRaw}
+	}
+	return req
+}
+
+func TestHandle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	objects := []client.Object{
+		&placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: crpName},
+			Spec: placementv1beta1.PlacementSpec{
+				Policy: &placementv1beta1.PlacementPolicy{
+					PlacementType:         placementv1beta1.PickAllPlacementType,
+					ExternalSchedulerName: externalSchedulerName,
+				},
+			},
+		},
+		&placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: builtInCRPName},
+			Spec: placementv1beta1.PlacementSpec{
+				Policy: &placementv1beta1.PlacementPolicy{
+					PlacementType: placementv1beta1.PickAllPlacementType,
+				},
+			},
+		},
+		&placementv1beta1.ClusterSchedulingPolicySnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: latestPolicySnapshot,
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: crpName,
+					placementv1beta1.IsLatestSnapshotLabel:  "true",
+				},
+			},
+		},
+		&placementv1beta1.ClusterSchedulingPolicySnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: obsoletePolicySnapshot,
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: crpName,
+					placementv1beta1.IsLatestSnapshotLabel:  "false",
+				},
+			},
+		},
+		&placementv1beta1.ResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: rpName, Namespace: testNamespace},
+			Spec: placementv1beta1.PlacementSpec{
+				Policy: &placementv1beta1.PlacementPolicy{
+					PlacementType:         placementv1beta1.PickAllPlacementType,
+					ExternalSchedulerName: externalSchedulerName,
+				},
+			},
+		},
+	}
+	validator := &bindingValidator{
+		client:  fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+		decoder: admission.NewDecoder(scheme),
+	}
+
+	crbKind := placementv1beta1.ClusterResourceBindingKind
+	rbKind := placementv1beta1.ResourceBindingKind
+	testCases := []struct {
+		name         string
+		req          admission.Request
+		wantResponse admission.Response
+	}{
+		{
+			name:         "allow delete",
+			req:          admissionRequestFor(t, admissionv1.Delete, crbKind, crbWith(crpName, obsoletePolicySnapshot), nil),
+			wantResponse: admission.Allowed("binding operation is not subject to validation"),
+		},
+		{
+			name:         "allow binding without placement label",
+			req:          admissionRequestFor(t, admissionv1.Create, crbKind, crbWith("", obsoletePolicySnapshot), nil),
+			wantResponse: admission.Allowed("binding is not associated with any placement"),
+		},
+		{
+			name:         "allow binding of a placement that does not exist",
+			req:          admissionRequestFor(t, admissionv1.Create, crbKind, crbWith("not-found", obsoletePolicySnapshot), nil),
+			wantResponse: admission.Allowed("associated placement of the binding is not found"),
+		},
+		{
+			name:         "allow binding of a placement scheduled by the built-in scheduler",
+			req:          admissionRequestFor(t, admissionv1.Create, crbKind, crbWith(builtInCRPName, obsoletePolicySnapshot), nil),
+			wantResponse: admission.Allowed("associated placement of the binding is scheduled by the built-in scheduler"),
+		},
+		{
+			name:         "allow binding that refers to the latest policy snapshot",
+			req:          admissionRequestFor(t, admissionv1.Create, crbKind, crbWith(crpName, latestPolicySnapshot), nil),
+			wantResponse: admission.Allowed("binding refers to the latest policy snapshot of its placement"),
+		},
+		{
+			name:         "deny binding that does not refer to any policy snapshot",
+			req:          admissionRequestFor(t, admissionv1.Create, crbKind, crbWith(crpName, ""), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyMissingPolicySnapshotFmt, bindingName, crpName, externalSchedulerName)),
+		},
+		{
+			name:         "deny binding that refers to an obsolete policy snapshot",
+			req:          admissionRequestFor(t, admissionv1.Create, crbKind, crbWith(crpName, obsoletePolicySnapshot), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyStalePolicySnapshotFmt, bindingName, crpName, externalSchedulerName, obsoletePolicySnapshot, latestPolicySnapshot)),
+		},
+		{
+			name:         "allow update that does not change the policy snapshot reference",
+			req:          admissionRequestFor(t, admissionv1.Update, crbKind, crbWith(crpName, obsoletePolicySnapshot), crbWith(crpName, obsoletePolicySnapshot)),
+			wantResponse: admission.Allowed("binding policy snapshot reference is not changed"),
+		},
+		{
+			name:         "deny update that changes the policy snapshot reference to an obsolete one",
+			req:          admissionRequestFor(t, admissionv1.Update, crbKind, crbWith(crpName, obsoletePolicySnapshot), crbWith(crpName, latestPolicySnapshot)),
+			wantResponse: admission.Denied(fmt.Sprintf(denyStalePolicySnapshotFmt, bindingName, crpName, externalSchedulerName, obsoletePolicySnapshot, latestPolicySnapshot)),
+		},
+		{
+			name: "deny resource binding of a placement without a latest policy snapshot",
+			req:  admissionRequestFor(t, admissionv1.Create, rbKind, rbWith(rpName, latestPolicySnapshot), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyNoPolicySnapshotFmt,
+				fmt.Sprintf("%s/%s", testNamespace, bindingName), fmt.Sprintf("%s/%s", testNamespace, rpName), externalSchedulerName)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotResponse := validator.Handle(context.Background(), tc.req)
+			if diff := cmp.Diff(tc.wantResponse, gotResponse); diff != "" {
+				t.Errorf("bindingValidator Handle() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHandle_StateTransition(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	validator := &bindingValidator{
+		client:           fake.NewClientBuilder().WithScheme(scheme).Build(),
+		decoder:          admission.NewDecoder(scheme),
+		whiteListedUsers: []string{whiteListedUser},
+	}
+
+	crbInState := func(state placementv1beta1.BindingState) *placementv1beta1.ClusterResourceBinding {
+		crb := crbWith("", latestPolicySnapshot)
+		crb.Spec.State = state
+		return crb
+	}
+	requestBy := func(userInfo authenticationv1.UserInfo, op admissionv1.Operation, obj, oldObj client.Object) admission.Request {
+		req := admissionRequestFor(t, op, placementv1beta1.ClusterResourceBindingKind, obj, oldObj)
+		req.UserInfo = userInfo
+		return req
+	}
+	hubAgent := authenticationv1.UserInfo{Username: hubAgentUser, Groups: []string{"system:serviceaccounts"}}
+	admin := authenticationv1.UserInfo{Username: "admin", Groups: []string{"system:masters"}}
+	externalScheduler := authenticationv1.UserInfo{Username: whiteListedUser}
+	otherUser := authenticationv1.UserInfo{Username: "test-user", Groups: []string{"system:authenticated"}}
+	allowedCreate := admission.Allowed("binding is not associated with any placement")
+	allowedUpdate := admission.Allowed("binding policy snapshot reference is not changed")
+
+	testCases := []struct {
+		name         string
+		req          admission.Request
+		wantResponse admission.Response
+	}{
+		{
+			name:         "allow the hub agent to create a scheduled binding",
+			req:          requestBy(hubAgent, admissionv1.Create, crbInState(placementv1beta1.BindingStateScheduled), nil),
+			wantResponse: allowedCreate,
+		},
+		{
+			name:         "allow a white listed user to create a scheduled binding",
+			req:          requestBy(externalScheduler, admissionv1.Create, crbInState(placementv1beta1.BindingStateScheduled), nil),
+			wantResponse: allowedCreate,
+		},
+		{
+			name:         "deny other users to create a binding",
+			req:          requestBy(otherUser, admissionv1.Create, crbInState(placementv1beta1.BindingStateScheduled), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyUnauthorizedUserFmt, otherUser.Username, utils.GenerateGroupString(otherUser.Groups), admissionv1.Create, bindingName, placementv1beta1.BindingStateScheduled)),
+		},
+		{
+			name:         "deny creating a bound binding",
+			req:          requestBy(hubAgent, admissionv1.Create, crbInState(placementv1beta1.BindingStateBound), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyInvalidInitialStateFmt, bindingName, placementv1beta1.BindingStateScheduled, placementv1beta1.BindingStateBound)),
+		},
+		{
+			name:         "allow the hub agent to bind a scheduled binding",
+			req:          requestBy(hubAgent, admissionv1.Update, crbInState(placementv1beta1.BindingStateBound), crbInState(placementv1beta1.BindingStateScheduled)),
+			wantResponse: allowedUpdate,
+		},
+		{
+			name:         "allow the hub agent to unschedule a bound binding",
+			req:          requestBy(hubAgent, admissionv1.Update, crbInState(placementv1beta1.BindingStateUnscheduled), crbInState(placementv1beta1.BindingStateBound)),
+			wantResponse: allowedUpdate,
+		},
+		{
+			name:         "allow the hub agent to restore an unscheduled binding",
+			req:          requestBy(hubAgent, admissionv1.Update, crbInState(placementv1beta1.BindingStateBound), crbInState(placementv1beta1.BindingStateUnscheduled)),
+			wantResponse: allowedUpdate,
+		},
+		{
+			name:         "allow cluster admins to unschedule a scheduled binding",
+			req:          requestBy(admin, admissionv1.Update, crbInState(placementv1beta1.BindingStateUnscheduled), crbInState(placementv1beta1.BindingStateScheduled)),
+			wantResponse: allowedUpdate,
+		},
+		{
+			name:         "deny moving a bound binding back to scheduled",
+			req:          requestBy(hubAgent, admissionv1.Update, crbInState(placementv1beta1.BindingStateScheduled), crbInState(placementv1beta1.BindingStateBound)),
+			wantResponse: admission.Denied(fmt.Sprintf(denyInvalidTransitionFmt, bindingName, placementv1beta1.BindingStateBound, placementv1beta1.BindingStateScheduled)),
+		},
+		{
+			name:         "deny moving a binding to an unknown state",
+			req:          requestBy(hubAgent, admissionv1.Update, crbInState("Unknown"), crbInState(placementv1beta1.BindingStateScheduled)),
+			wantResponse: admission.Denied(fmt.Sprintf(denyInvalidTransitionFmt, bindingName, placementv1beta1.BindingStateScheduled, "Unknown")),
+		},
+		{
+			name:         "deny other users to change the binding state",
+			req:          requestBy(otherUser, admissionv1.Update, crbInState(placementv1beta1.BindingStateBound), crbInState(placementv1beta1.BindingStateScheduled)),
+			wantResponse: admission.Denied(fmt.Sprintf(denyUnauthorizedUserFmt, otherUser.Username, utils.GenerateGroupString(otherUser.Groups), admissionv1.Update, bindingName, placementv1beta1.BindingStateBound)),
+		},
+		{
+			name:         "allow other users to update a binding without changing its state",
+			req:          requestBy(otherUser, admissionv1.Update, crbInState(placementv1beta1.BindingStateBound), crbInState(placementv1beta1.BindingStateBound)),
+			wantResponse: allowedUpdate,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotResponse := validator.Handle(context.Background(), tc.req)
+			if diff := cmp.Diff(tc.wantResponse, gotResponse); diff != "" {
+				t.Errorf("bindingValidator Handle() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestHandle_TrackingFields(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	validator := &bindingValidator{
+		client:           fake.NewClientBuilder().WithScheme(scheme).Build(),
+		decoder:          admission.NewDecoder(scheme),
+		whiteListedUsers: []string{whiteListedUser},
+	}
+
+	trackedCRB := func() *placementv1beta1.ClusterResourceBinding {
+		crb := crbWith(builtInCRPName, latestPolicySnapshot)
+		crb.Finalizers = []string{placementv1beta1.SchedulerBindingCleanupFinalizer}
+		crb.Spec.ResourceSnapshotName = "test-crp-1-snapshot"
+		return crb
+	}
+	requestBy := func(userInfo authenticationv1.UserInfo, obj, oldObj client.Object) admission.Request {
+		req := admissionRequestFor(t, admissionv1.Update, placementv1beta1.ClusterResourceBindingKind, obj, oldObj)
+		req.UserInfo = userInfo
+		return req
+	}
+	hubAgent := authenticationv1.UserInfo{Username: hubAgentUser, Groups: []string{"system:serviceaccounts"}}
+	admin := authenticationv1.UserInfo{Username: "admin", Groups: []string{"system:masters"}}
+	otherUser := authenticationv1.UserInfo{Username: "test-user", Groups: []string{"system:authenticated"}}
+	otherUserGroups := utils.GenerateGroupString(otherUser.Groups)
+	allowedUpdate := admission.Allowed("binding policy snapshot reference is not changed")
+
+	relabeled := trackedCRB()
+	relabeled.Labels[placementv1beta1.PlacementTrackingLabel] = crpName
+	unlabeled := trackedCRB()
+	unlabeled.Labels = nil
+	unfinalized := trackedCRB()
+	unfinalized.Finalizers = nil
+	resnapshotted := trackedCRB()
+	resnapshotted.Spec.ResourceSnapshotName = "test-crp-2-snapshot"
+	repolicied := trackedCRB()
+	repolicied.Spec.SchedulingPolicySnapshotName = obsoletePolicySnapshot
+	annotated := trackedCRB()
+	annotated.Annotations = map[string]string{"test-key": "test-value"}
+
+	testCases := []struct {
+		name         string
+		req          admission.Request
+		wantResponse admission.Response
+	}{
+		{
+			name:         "allow other users to update a binding without changing its tracking fields",
+			req:          requestBy(otherUser, annotated, trackedCRB()),
+			wantResponse: allowedUpdate,
+		},
+		{
+			name:         "allow the hub agent to change the resource snapshot reference",
+			req:          requestBy(hubAgent, resnapshotted, trackedCRB()),
+			wantResponse: allowedUpdate,
+		},
+		{
+			name:         "allow cluster admins to remove the scheduler cleanup finalizer",
+			req:          requestBy(admin, unfinalized, trackedCRB()),
+			wantResponse: allowedUpdate,
+		},
+		{
+			name:         "deny other users to change the placement tracking label",
+			req:          requestBy(otherUser, relabeled, trackedCRB()),
+			wantResponse: admission.Denied(fmt.Sprintf(denyTrackingFieldChangeFmt, otherUser.Username, otherUserGroups, "label "+placementv1beta1.PlacementTrackingLabel, bindingName)),
+		},
+		{
+			name:         "deny other users to remove the placement tracking label",
+			req:          requestBy(otherUser, unlabeled, trackedCRB()),
+			wantResponse: admission.Denied(fmt.Sprintf(denyTrackingFieldChangeFmt, otherUser.Username, otherUserGroups, "label "+placementv1beta1.PlacementTrackingLabel, bindingName)),
+		},
+		{
+			name:         "deny other users to remove the scheduler cleanup finalizer",
+			req:          requestBy(otherUser, unfinalized, trackedCRB()),
+			wantResponse: admission.Denied(fmt.Sprintf(denyTrackingFieldChangeFmt, otherUser.Username, otherUserGroups, "finalizer "+placementv1beta1.SchedulerBindingCleanupFinalizer, bindingName)),
+		},
+		{
+			name:         "deny other users to change the resource snapshot reference",
+			req:          requestBy(otherUser, resnapshotted, trackedCRB()),
+			wantResponse: admission.Denied(fmt.Sprintf(denyTrackingFieldChangeFmt, otherUser.Username, otherUserGroups, "resource snapshot reference", bindingName)),
+		},
+		{
+			name:         "deny other users to change the scheduling policy snapshot reference",
+			req:          requestBy(otherUser, repolicied, trackedCRB()),
+			wantResponse: admission.Denied(fmt.Sprintf(denyTrackingFieldChangeFmt, otherUser.Username, otherUserGroups, "scheduling policy snapshot reference", bindingName)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotResponse := validator.Handle(context.Background(), tc.req)
+			if diff := cmp.Diff(tc.wantResponse, gotResponse); diff != "" {
+				t.Errorf("bindingValidator Handle() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -23,6 +23,7 @@ import (
 	"fmt"
 	"net/http"
 
+	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/klog/v2"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
@@ -58,7 +59,8 @@ func (m *clusterResourcePlacementMutator) Handle(_ context.Context, req admissio
 		return admission.Errored(http.StatusBadRequest, err)
 	}
 
-	// Apply default values to the CRP object.
+	// Record the default profile and apply its default values to the CRP object.
+	defaulter.RecordDefaultProfile(&crp, req.Operation == admissionv1.Create)
 	defaulter.SetPlacementDefaults(&crp)
 	marshaled, err := json.Marshal(crp)
 	if err != nil {
@@ -41,9 +41,11 @@ import (
 )
 
 func TestMutatingHandle(t *testing.T) {
+	profileAnnotations := map[string]string{defaulter.DefaultProfileAnnotation: defaulter.LatestDefaultProfile}
 	crpWithNoRevisionHistoryLimit := &placementv1beta1.ClusterResourcePlacement{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "test-crp-no-revisionhistory",
+			Name:        "test-crp-no-revisionhistory",
+			Annotations: profileAnnotations,
 		},
 		Spec: placementv1beta1.PlacementSpec{
 			ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{resourceSelector},
@@ -70,7 +72,8 @@ func TestMutatingHandle(t *testing.T) {
 
 	crpWithNoPolicy := &placementv1beta1.ClusterResourcePlacement{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "test-crp-no-policy",
+			Name:        "test-crp-no-policy",
+			Annotations: profileAnnotations,
 		},
 		Spec: placementv1beta1.PlacementSpec{
 			ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{resourceSelector},
@@ -95,7 +98,8 @@ func TestMutatingHandle(t *testing.T) {
 
 	crpWithNoStrategy := &placementv1beta1.ClusterResourcePlacement{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "test-crp-no-strategy",
+			Name:        "test-crp-no-strategy",
+			Annotations: profileAnnotations,
 		},
 		Spec: placementv1beta1.PlacementSpec{
 			ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{resourceSelector},
@@ -109,7 +113,8 @@ func TestMutatingHandle(t *testing.T) {
 
 	crpWithNoApplyStrategy := &placementv1beta1.ClusterResourcePlacement{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "test-crp-no-apply-strategy",
+			Name:        "test-crp-no-apply-strategy",
+			Annotations: profileAnnotations,
 		},
 		Spec: placementv1beta1.PlacementSpec{
 			ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{resourceSelector},
@@ -131,7 +136,8 @@ func TestMutatingHandle(t *testing.T) {
 
 	crpWithNoServerSideApplyConfig := &placementv1beta1.ClusterResourcePlacement{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "test-crp-no-serverside-apply-config",
+			Name:        "test-crp-no-serverside-apply-config",
+			Annotations: profileAnnotations,
 		},
 		Spec: placementv1beta1.PlacementSpec{
 			ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{resourceSelector},
@@ -159,7 +165,8 @@ func TestMutatingHandle(t *testing.T) {
 
 	crpWithNoRollingUpdateConfig := &placementv1beta1.ClusterResourcePlacement{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "test-crp-no-rolling-update-config",
+			Name:        "test-crp-no-rolling-update-config",
+			Annotations: profileAnnotations,
 		},
 		Spec: placementv1beta1.PlacementSpec{
 			ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{resourceSelector},
@@ -183,7 +190,8 @@ func TestMutatingHandle(t *testing.T) {
 
 	crpWithNoTolerationOperator := &placementv1beta1.ClusterResourcePlacement{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "test-crp-no-toleration-operator",
+			Name:        "test-crp-no-toleration-operator",
+			Annotations: profileAnnotations,
 		},
 		Spec: placementv1beta1.PlacementSpec{
 			ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{resourceSelector},
@@ -212,7 +220,8 @@ func TestMutatingHandle(t *testing.T) {
 
 	crpWithTopologySpreadConstraints := &placementv1beta1.ClusterResourcePlacement{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "test-crp-topology-spread-constraints",
+			Name:        "test-crp-topology-spread-constraints",
+			Annotations: profileAnnotations,
 		},
 		Spec: placementv1beta1.PlacementSpec{
 			ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{resourceSelector},
@@ -240,7 +249,8 @@ func TestMutatingHandle(t *testing.T) {
 
 	crpWithAllFields := &placementv1beta1.ClusterResourcePlacement{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "test-crp-all-fields",
+			Name:        "test-crp-all-fields",
+			Annotations: profileAnnotations,
 		},
 		Spec: placementv1beta1.PlacementSpec{
 			ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{resourceSelector},
@@ -289,13 +299,17 @@ func TestMutatingHandle(t *testing.T) {
 	crpWithNoServerSideApplyConfigBytes, _ := json.Marshal(crpWithNoServerSideApplyConfig)
 	crpWithTopologySpreadConstraintsBytes, _ := json.Marshal(crpWithTopologySpreadConstraints)
 	crpWithAllFieldsBytes, _ := json.Marshal(crpWithAllFields)
+	crpWithNoProfile := crpWithAllFields.DeepCopy()
+	crpWithNoProfile.Annotations = nil
+	crpWithNoProfileBytes, _ := json.Marshal(crpWithNoProfile)
 
 	// Update cases
 	crpUpdateMissingFieldsOld := crpWithAllFields.DeepCopy()
 	crpUpdateMissingFieldsOld.ObjectMeta.Name = "test-crp-update-missing"
 	crpUpdateMissingFieldsNew := &placementv1beta1.ClusterResourcePlacement{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "test-crp-update-missing",
+			Name:        "test-crp-update-missing",
+			Annotations: profileAnnotations,
 		},
 		Spec: placementv1beta1.PlacementSpec{
 			ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{resourceSelector},
@@ -310,7 +324,8 @@ func TestMutatingHandle(t *testing.T) {
 	crpUpdateChangeFieldOld.ObjectMeta.Name = "test-crp-update-change-field"
 	crpUpdateChangeFieldNew := &placementv1beta1.ClusterResourcePlacement{
 		ObjectMeta: metav1.ObjectMeta{
-			Name: "test-crp-update-change-field",
+			Name:        "test-crp-update-change-field",
+			Annotations: profileAnnotations,
 		},
 		Spec: placementv1beta1.PlacementSpec{
 			ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{resourceSelector},
@@ -650,6 +665,36 @@ func TestMutatingHandle(t *testing.T) {
 				Patches: []jsonpatch.JsonPatchOperation{},
 			},
 		},
+		"should record the latest default profile (CREATE)": {
+			req: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Name: "test-crp-all-fields",
+					Object: runtime.RawExtension{
+						Raw:    crpWithNoProfileBytes,
+						Object: crpWithNoProfile,
+					},
+					UserInfo: authenticationv1.UserInfo{
+						Username: "test-user",
+						Groups:   []string{"system:masters"},
+					},
+					RequestKind: &utils.ClusterResourcePlacementMetaGVK,
+					Operation:   admissionv1.Create,
+				},
+			},
+			wantResponse: admission.Response{
+				Patches: []jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations",
+						Value:     map[string]any{defaulter.DefaultProfileAnnotation: defaulter.LatestDefaultProfile},
+					},
+				},
+				AdmissionResponse: admissionv1.AdmissionResponse{
+					Allowed:   true,
+					PatchType: ptr.To(admissionv1.PatchTypeJSONPatch),
+				},
+			},
+		},
 		// Update cases
 		"should default missing fields (UPDATE)": {
 			req: admission.Request{
@@ -736,6 +781,36 @@ func TestMutatingHandle(t *testing.T) {
 				Patches: []jsonpatch.JsonPatchOperation{},
 			},
 		},
+		"should record the v1 default profile (UPDATE)": {
+			req: admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Name: "test-crp-all-fields",
+					Object: runtime.RawExtension{
+						Raw:    crpWithNoProfileBytes,
+						Object: crpWithNoProfile,
+					},
+					UserInfo: authenticationv1.UserInfo{
+						Username: "test-user",
+						Groups:   []string{"system:masters"},
+					},
+					RequestKind: &utils.ClusterResourcePlacementMetaGVK,
+					Operation:   admissionv1.Update,
+				},
+			},
+			wantResponse: admission.Response{
+				Patches: []jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations",
+						Value:     map[string]any{defaulter.DefaultProfileAnnotation: defaulter.DefaultProfileV1},
+					},
+				},
+				AdmissionResponse: admissionv1.AdmissionResponse{
+					Allowed:   true,
+					PatchType: ptr.To(admissionv1.PatchTypeJSONPatch),
+				},
+			},
+		},
 		"should patch default if a field is changed (UPDATE)": {
 			req: admission.Request{
 				AdmissionRequest: admissionv1.AdmissionRequest{
@@ -21,6 +21,8 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -36,24 +38,31 @@ var (
 )
 
 type clusterResourcePlacementValidator struct {
+	client  client.Reader
 	decoder webhook.AdmissionDecoder
 }
 
 // Add registers the webhook for K8s built-in object types.
 func Add(mgr manager.Manager) error {
 	hookServer := mgr.GetWebhookServer()
-	hookServer.Register(ValidationPath, &webhook.Admission{Handler: &clusterResourcePlacementValidator{admission.NewDecoder(mgr.GetScheme())}})
+	hookServer.Register(ValidationPath, &webhook.Admission{Handler: &clusterResourcePlacementValidator{
+		client:  mgr.GetClient(),
+		decoder: admission.NewDecoder(mgr.GetScheme()),
+	}})
 	return nil
 }
 
 // Handle clusterResourcePlacementValidator handles create, update CRP requests.
 func (v *clusterResourcePlacementValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
-	return validator.HandlePlacementValidation(ctx, req, v.decoder,
+	// placement is the CRP object being created or updated, which is captured when it is decoded.
+	var placement placementv1beta1.PlacementObj
+	resp := validator.HandlePlacementValidation(ctx, req, v.decoder,
 		"CRP",
 		// decodeFunc
 		func(req admission.Request, decoder webhook.AdmissionDecoder) (placementv1beta1.PlacementObj, error) {
 			var crp placementv1beta1.ClusterResourcePlacement
 			err := decoder.Decode(req, &crp)
+			placement = &crp
 			return &crp, err
 		},
 		// decodeOldFunc
@@ -65,5 +74,23 @@ func (v *clusterResourcePlacementValidator) Handle(ctx context.Context, req admi
 		// validateFunc
 		func(obj placementv1beta1.PlacementObj) error {
 			return validator.ValidateClusterResourcePlacement(obj.(*placementv1beta1.ClusterResourcePlacement))
+		},
+		// warnFunc
+		func(ctx context.Context, obj placementv1beta1.PlacementObj) admission.Warnings {
+			return validator.PickFixedClusterWarnings(ctx, v.client, obj)
 		})
+	if !resp.Allowed || placement == nil || placement.GetDeletionTimestamp() != nil {
+		return resp
+	}
+	// Reject the dependency cycles, which can only be found with the other CRPs in the fleet.
+	if err := validator.ValidatePlacementDependencies(ctx, v.client, placement); err != nil {
+		klog.V(2).InfoS("v1beta1 placement has invalid dependencies, request is denied", "resourceType", "CRP", "operation", req.Operation, "placement", klog.KObj(placement))
+		return admission.Denied(fmt.Sprintf(validator.DenyCreateUpdateInvalidFmt, "CRP", err))
+	}
+	// Reject the placement affinity cycles, which can only be found with the other CRPs in the fleet.
+	if err := validator.ValidatePlacementAffinityCycle(ctx, v.client, placement); err != nil {
+		klog.V(2).InfoS("v1beta1 placement has an invalid placement affinity, request is denied", "resourceType", "CRP", "operation", req.Operation, "placement", klog.KObj(placement))
+		return admission.Denied(fmt.Sprintf(validator.DenyCreateUpdateInvalidFmt, "CRP", err))
+	}
+	return resp
 }
@@ -0,0 +1,36 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package conversion features the webhook that converts Fleet API objects between API versions.
+package conversion
+
+import (
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
+)
+
+const (
+	// ConversionPath is the webhook service path which conversion requests are routed to.
+	ConversionPath = "/convert"
+)
+
+// Add registers the conversion webhook, which converts the objects of all the API versions
+// registered with the manager's scheme.
+func Add(mgr manager.Manager) error {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register(ConversionPath, conversion.NewWebhookHandler(mgr.GetScheme()))
+	return nil
+}
@@ -0,0 +1,218 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package envelope provides a validating webhook for the clusterresourceenvelope and resourceenvelope
+// custom resources in the KubeFleet API group.
+package envelope
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+)
+
+var (
+	// ValidationPath is the webhook service path which admission requests are routed to for validating
+	// clusterresourceenvelope and resourceenvelope resources.
+	ValidationPath = fmt.Sprintf(utils.ValidationPathFmt, placementv1beta1.GroupVersion.Group, placementv1beta1.GroupVersion.Version, "envelope")
+)
+
+const (
+	denyTooManyManifestsFmt         = "envelope %s wraps %d manifests, which exceeds the limit of %d manifests (%s); split the manifests into multiple envelopes"
+	denyTooLargeFmt                 = "envelope %s wraps %d bytes of manifests in its data, which exceeds the limit of %d bytes (%s); split the manifests into multiple envelopes"
+	invalidNamespaceLimitWarningFmt = "namespace %s has an invalid value %q in annotation %s, which must be a positive integer; the hub-wide limit of %d applies instead"
+
+	hubWideLimitSource   = "hub-wide limit"
+	namespaceLimitSource = "set by annotation %s on namespace %s"
+)
+
+type envelopeValidator struct {
+	client  client.Client
+	decoder webhook.AdmissionDecoder
+
+	// maxManifests is the hub-wide limit on the number of manifests an envelope may wrap.
+	maxManifests int
+	// maxSizeBytes is the hub-wide limit on the total size (in bytes) of the manifests an envelope
+	// wraps in its data.
+	maxSizeBytes int
+}
+
+// envelopeLimit is a limit that applies to an envelope, along with where the limit comes from.
+type envelopeLimit struct {
+	value  int
+	source string
+}
+
+// Add registers the webhook for the envelope resources with the given hub-wide limits.
+func Add(mgr manager.Manager, maxManifests, maxSizeBytes int) error {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register(ValidationPath, &webhook.Admission{Handler: &envelopeValidator{
+		client:       mgr.GetClient(),
+		decoder:      admission.NewDecoder(mgr.GetScheme()),
+		maxManifests: maxManifests,
+		maxSizeBytes: maxSizeBytes,
+	}})
+	return nil
+}
+
+// Handle envelopeValidator checks that envelopes do not wrap more manifests, or manifests of a larger total
+// size, than the limits allow, so that oversized envelopes are rejected at admission rather than failing
+// the resource snapshots or the works of the placements that select them mid-rollout.
+//
+// The limits of a ResourceEnvelope can be overridden by the annotations on its namespace.
+func (v *envelopeValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("envelope operation is not subject to validation")
+	}
+	klog.V(2).InfoS("Validating webhook handling envelope", "operation", req.Operation, "kind", req.Kind.Kind, "namespacedName", types.NamespacedName{Name: req.Name, Namespace: req.Namespace})
+
+	envelope, oldEnvelope, err := v.decodeEnvelopes(req)
+	if err != nil {
+		klog.ErrorS(err, "Failed to decode envelope object for validating fields", "userName", req.UserInfo.Username, "groups", req.UserInfo.Groups, "kind", req.Kind.Kind, "envelope", req.Name)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	// Updates that do not change the wrapped manifests (e.g., label changes) are always allowed, so that
+	// envelopes created before the limits are lowered can still be managed.
+	if oldEnvelope != nil && equality.Semantic.DeepEqual(oldEnvelope.manifests, envelope.manifests) {
+		return admission.Allowed("envelope manifests are not changed")
+	}
+
+	maxManifests := envelopeLimit{value: v.maxManifests, source: hubWideLimitSource}
+	maxSizeBytes := envelopeLimit{value: v.maxSizeBytes, source: hubWideLimitSource}
+	var warnings []string
+	if envelope.namespace != "" {
+		namespace := &corev1.Namespace{}
+		if err := v.client.Get(ctx, types.NamespacedName{Name: envelope.namespace}, namespace); err != nil {
+			return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to get namespace %s of envelope %s: %w", envelope.namespace, envelope.ref, err))
+		}
+		var warning string
+		if maxManifests, warning = namespaceLimit(namespace, placementv1beta1.EnvelopeMaxManifestsAnnotation, maxManifests); warning != "" {
+			warnings = append(warnings, warning)
+		}
+		if maxSizeBytes, warning = namespaceLimit(namespace, placementv1beta1.EnvelopeMaxSizeBytesAnnotation, maxSizeBytes); warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+
+	if envelope.manifestCount > maxManifests.value {
+		return admission.Denied(fmt.Sprintf(denyTooManyManifestsFmt, envelope.ref, envelope.manifestCount, maxManifests.value, maxManifests.source)).WithWarnings(warnings...)
+	}
+	if envelope.sizeBytes > maxSizeBytes.value {
+		return admission.Denied(fmt.Sprintf(denyTooLargeFmt, envelope.ref, envelope.sizeBytes, maxSizeBytes.value, maxSizeBytes.source)).WithWarnings(warnings...)
+	}
+
+	klog.V(2).InfoS("Envelope is within the limits", "envelope", envelope.ref, "manifestCount", envelope.manifestCount, "sizeBytes", envelope.sizeBytes)
+	return admission.Allowed("envelope is within the limits").WithWarnings(warnings...)
+}
+
+// namespaceLimit returns the limit set by the given annotation on a namespace, or the hub-wide limit if
+// the annotation is absent; an invalid annotation value is reported as a warning.
+func namespaceLimit(namespace *corev1.Namespace, annotation string, hubWideLimit envelopeLimit) (envelopeLimit, string) {
+	raw, ok := namespace.Annotations[annotation]
+	if !ok {
+		return hubWideLimit, ""
+	}
+	value, err := strconv.Atoi(raw)
+	if err != nil || value <= 0 {
+		return hubWideLimit, fmt.Sprintf(invalidNamespaceLimitWarningFmt, namespace.Name, raw, annotation, hubWideLimit.value)
+	}
+	return envelopeLimit{value: value, source: fmt.Sprintf(namespaceLimitSource, annotation, namespace.Name)}, ""
+}
+
+// envelopeSummary is the part of an envelope that is subject to the limits.
+type envelopeSummary struct {
+	ref       string
+	namespace string
+	// manifestCount is the number of manifests the envelope wraps, both inline and in Secrets.
+	manifestCount int
+	// sizeBytes is the total size of the manifests the envelope wraps inline.
+	//
+	// Note that the manifests kept in Secrets are not counted, as they are only resolved when a resource
+	// snapshot is taken of the envelope.
+	sizeBytes int
+	// manifests is kept for telling if an update changes the wrapped manifests.
+	manifests interface{}
+}
+
+// decodeEnvelopes decodes the envelope (and the old one, for updates) in an admission request.
+func (v *envelopeValidator) decodeEnvelopes(req admission.Request) (envelope, oldEnvelope *envelopeSummary, err error) {
+	switch req.Kind.Kind {
+	case placementv1beta1.ClusterResourceEnvelopeKind:
+		envelope, err = v.decodeClusterResourceEnvelope(req.Object)
+		if err == nil && req.Operation == admissionv1.Update {
+			oldEnvelope, err = v.decodeClusterResourceEnvelope(req.OldObject)
+		}
+	case placementv1beta1.ResourceEnvelopeKind:
+		envelope, err = v.decodeResourceEnvelope(req.Object)
+		if err == nil && req.Operation == admissionv1.Update {
+			oldEnvelope, err = v.decodeResourceEnvelope(req.OldObject)
+		}
+	default:
+		return nil, nil, fmt.Errorf("unexpected envelope kind %s", req.Kind.Kind)
+	}
+	if err != nil {
+		return nil, nil, err
+	}
+	return envelope, oldEnvelope, nil
+}
+
+func (v *envelopeValidator) decodeClusterResourceEnvelope(raw runtime.RawExtension) (*envelopeSummary, error) {
+	envelope := &placementv1beta1.ClusterResourceEnvelope{}
+	if err := v.decoder.DecodeRaw(raw, envelope); err != nil {
+		return nil, err
+	}
+	summary := &envelopeSummary{
+		ref:           klog.KObj(envelope).String(),
+		manifestCount: len(envelope.Data),
+		manifests:     envelope.Data,
+	}
+	for _, manifest := range envelope.Data {
+		summary.sizeBytes += len(manifest.Raw)
+	}
+	return summary, nil
+}
+
+func (v *envelopeValidator) decodeResourceEnvelope(raw runtime.RawExtension) (*envelopeSummary, error) {
+	envelope := &placementv1beta1.ResourceEnvelope{}
+	if err := v.decoder.DecodeRaw(raw, envelope); err != nil {
+		return nil, err
+	}
+	summary := &envelopeSummary{
+		ref:           klog.KObj(envelope).String(),
+		namespace:     envelope.Namespace,
+		manifestCount: len(envelope.Data) + len(envelope.SecretRefs),
+		manifests:     []interface{}{envelope.Data, envelope.SecretRefs},
+	}
+	for _, manifest := range envelope.Data {
+		summary.sizeBytes += len(manifest.Raw)
+	}
+	return summary, nil
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package envelope
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	admissionv1 "k8s.io/api/admission/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	defaultNamespace     = "default-ns"
+	overriddenNamespace  = "overridden-ns"
+	invalidNamespace     = "invalid-ns"
+	clusterEnvelopeName  = "test-cluster-envelope"
+	envelopeName         = "test-envelope"
+	testMaxManifests     = 2
+	testMaxSizeBytes     = 200
+	manifestTemplateJSON = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"%s"}}`
+)
+
+func manifestsFor(names ...string) map[string]runtime.RawExtension {
+	data := make(map[string]runtime.RawExtension, len(names))
+	for _, name := range names {
+		data[name] = runtime.RawExtension{Raw: []byte(fmt.Sprintf(manifestTemplateJSON, name))}
+	}
+	return data
+}
+
+func manifestSizeOf(names ...string) int {
+	size := 0
+	for _, name := range names {
+		size += len(fmt.Sprintf(manifestTemplateJSON, name))
+	}
+	return size
+}
+
+func clusterEnvelopeWith(names ...string) *placementv1beta1.ClusterResourceEnvelope {
+	return &placementv1beta1.ClusterResourceEnvelope{
+		ObjectMeta: metav1.ObjectMeta{Name: clusterEnvelopeName},
+		Data:       manifestsFor(names...),
+	}
+}
+
+func envelopeWith(namespace string, secretRefCount int, names ...string) *placementv1beta1.ResourceEnvelope {
+	envelope := &placementv1beta1.ResourceEnvelope{
+		ObjectMeta: metav1.ObjectMeta{Name: envelopeName, Namespace: namespace},
+		Data:       manifestsFor(names...),
+	}
+	for i := 0; i < secretRefCount; i++ {
+		if envelope.SecretRefs == nil {
+			envelope.SecretRefs = map[string]placementv1beta1.EnvelopeSecretReference{}
+		}
+		envelope.SecretRefs[fmt.Sprintf("secret-manifest-%d", i)] = placementv1beta1.EnvelopeSecretReference{Name: "secret", Key: fmt.Sprintf("key-%d", i)}
+	}
+	return envelope
+}
+
+func admissionRequestFor(t *testing.T, op admissionv1.Operation, kind string, obj, oldObj client.Object) admission.Request {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("failed to marshal object: %v", err)
+	}
+	req := admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+			Object:    runtime.RawExtension{Raw: raw},
+			Kind:      metav1.GroupVersionKind{Group: placementv1beta1.GroupVersion.Group, Version: placementv1beta1.GroupVersion.Version, Kind: kind},
+			Operation: op,
+		},
+	}
+	if oldObj != nil {
+		oldRaw, err := json.Marshal(oldObj)
+		if err != nil {
+			t.Fatalf("failed to marshal old object: %v", err)
+		}
+		req.OldObject = runtime.RawExtension{Raw: oldRaw}
+	}
+	return req
+}
+
+func TestHandle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement scheme: %v", err)
+	}
+	if err := clientgoscheme.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add client-go scheme: %v", err)
+	}
+	objects := []client.Object{
+		&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: defaultNamespace}},
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: overriddenNamespace,
+				Annotations: map[string]string{
+					placementv1beta1.EnvelopeMaxManifestsAnnotation: "3",
+					placementv1beta1.EnvelopeMaxSizeBytesAnnotation: "1000",
+				},
+			},
+		},
+		&corev1.Namespace{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: invalidNamespace,
+				Annotations: map[string]string{
+					placementv1beta1.EnvelopeMaxManifestsAnnotation: "-1",
+				},
+			},
+		},
+	}
+	validator := &envelopeValidator{
+		client:       fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+		decoder:      admission.NewDecoder(scheme),
+		maxManifests: testMaxManifests,
+		maxSizeBytes: testMaxSizeBytes,
+	}
+
+	creKind := placementv1beta1.ClusterResourceEnvelopeKind
+	reKind := placementv1beta1.ResourceEnvelopeKind
+	overriddenManifestsSource := fmt.Sprintf(namespaceLimitSource, placementv1beta1.EnvelopeMaxManifestsAnnotation, overriddenNamespace)
+	overriddenSizeSource := fmt.Sprintf(namespaceLimitSource, placementv1beta1.EnvelopeMaxSizeBytesAnnotation, overriddenNamespace)
+	invalidNamespaceWarning := fmt.Sprintf(invalidNamespaceLimitWarningFmt, invalidNamespace, "-1", placementv1beta1.EnvelopeMaxManifestsAnnotation, testMaxManifests)
+	testCases := []struct {
+		name         string
+		req          admission.Request
+		wantResponse admission.Response
+	}{
+		{
+			name:         "allow delete",
+			req:          admissionRequestFor(t, admissionv1.Delete, creKind, clusterEnvelopeWith("a", "b", "c"), nil),
+			wantResponse: admission.Allowed("envelope operation is not subject to validation"),
+		},
+		{
+			name:         "allow cluster envelope within the hub-wide limits",
+			req:          admissionRequestFor(t, admissionv1.Create, creKind, clusterEnvelopeWith("a", "b"), nil),
+			wantResponse: admission.Allowed("envelope is within the limits"),
+		},
+		{
+			name:         "deny cluster envelope with too many manifests",
+			req:          admissionRequestFor(t, admissionv1.Create, creKind, clusterEnvelopeWith("a", "b", "c"), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyTooManyManifestsFmt, clusterEnvelopeName, 3, testMaxManifests, hubWideLimitSource)),
+		},
+		{
+			name:         "deny cluster envelope with too large manifests",
+			req:          admissionRequestFor(t, admissionv1.Create, creKind, clusterEnvelopeWith(fmt.Sprintf("%0100d", 0), fmt.Sprintf("%0100d", 1)), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyTooLargeFmt, clusterEnvelopeName, manifestSizeOf(fmt.Sprintf("%0100d", 0), fmt.Sprintf("%0100d", 1)), testMaxSizeBytes, hubWideLimitSource)),
+		},
+		{
+			name:         "allow update that does not change the manifests",
+			req:          admissionRequestFor(t, admissionv1.Update, creKind, clusterEnvelopeWith("a", "b", "c"), clusterEnvelopeWith("a", "b", "c")),
+			wantResponse: admission.Allowed("envelope manifests are not changed"),
+		},
+		{
+			name:         "deny update that adds manifests beyond the limit",
+			req:          admissionRequestFor(t, admissionv1.Update, creKind, clusterEnvelopeWith("a", "b", "c"), clusterEnvelopeWith("a", "b")),
+			wantResponse: admission.Denied(fmt.Sprintf(denyTooManyManifestsFmt, clusterEnvelopeName, 3, testMaxManifests, hubWideLimitSource)),
+		},
+		{
+			name: "deny envelope whose manifests in data and secrets exceed the hub-wide limit",
+			req:  admissionRequestFor(t, admissionv1.Create, reKind, envelopeWith(defaultNamespace, 2, "a"), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyTooManyManifestsFmt,
+				fmt.Sprintf("%s/%s", defaultNamespace, envelopeName), 3, testMaxManifests, hubWideLimitSource)),
+		},
+		{
+			name:         "allow envelope within the limits overridden by the namespace",
+			req:          admissionRequestFor(t, admissionv1.Create, reKind, envelopeWith(overriddenNamespace, 1, "a-very-long-config-map-name", "another-very-long-config-map-name"), nil),
+			wantResponse: admission.Allowed("envelope is within the limits"),
+		},
+		{
+			name: "deny envelope beyond the manifest count limit overridden by the namespace",
+			req:  admissionRequestFor(t, admissionv1.Create, reKind, envelopeWith(overriddenNamespace, 2, "a", "b"), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyTooManyManifestsFmt,
+				fmt.Sprintf("%s/%s", overriddenNamespace, envelopeName), 4, 3, overriddenManifestsSource)),
+		},
+		{
+			name: "deny envelope beyond the size limit overridden by the namespace",
+			req: admissionRequestFor(t, admissionv1.Create, reKind, envelopeWith(overriddenNamespace, 0,
+				fmt.Sprintf("%0300d", 0), fmt.Sprintf("%0300d", 1), fmt.Sprintf("%0300d", 2)), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyTooLargeFmt,
+				fmt.Sprintf("%s/%s", overriddenNamespace, envelopeName),
+				manifestSizeOf(fmt.Sprintf("%0300d", 0), fmt.Sprintf("%0300d", 1), fmt.Sprintf("%0300d", 2)), 1000, overriddenSizeSource)),
+		},
+		{
+			name: "fall back to the hub-wide limit with a warning when the namespace annotation is invalid",
+			req:  admissionRequestFor(t, admissionv1.Create, reKind, envelopeWith(invalidNamespace, 0, "a", "b", "c"), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyTooManyManifestsFmt,
+				fmt.Sprintf("%s/%s", invalidNamespace, envelopeName), 3, testMaxManifests, hubWideLimitSource)).WithWarnings(invalidNamespaceWarning),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotResponse := validator.Handle(context.Background(), tc.req)
+			if diff := cmp.Diff(tc.wantResponse, gotResponse); diff != "" {
+				t.Errorf("envelopeValidator Handle() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
+
+func TestNamespaceLimit(t *testing.T) {
+	hubWideLimit := envelopeLimit{value: 50, source: hubWideLimitSource}
+	testCases := []struct {
+		name        string
+		annotations map[string]string
+		wantLimit   envelopeLimit
+		wantWarning string
+	}{
+		{
+			name:      "no annotation",
+			wantLimit: hubWideLimit,
+		},
+		{
+			name:        "valid annotation",
+			annotations: map[string]string{placementv1beta1.EnvelopeMaxManifestsAnnotation: "10"},
+			wantLimit: envelopeLimit{
+				value:  10,
+				source: fmt.Sprintf(namespaceLimitSource, placementv1beta1.EnvelopeMaxManifestsAnnotation, defaultNamespace),
+			},
+		},
+		{
+			name:        "non-numeric annotation",
+			annotations: map[string]string{placementv1beta1.EnvelopeMaxManifestsAnnotation: "ten"},
+			wantLimit:   hubWideLimit,
+			wantWarning: fmt.Sprintf(invalidNamespaceLimitWarningFmt, defaultNamespace, "ten", placementv1beta1.EnvelopeMaxManifestsAnnotation, 50),
+		},
+		{
+			name:        "zero annotation",
+			annotations: map[string]string{placementv1beta1.EnvelopeMaxManifestsAnnotation: "0"},
+			wantLimit:   hubWideLimit,
+			wantWarning: fmt.Sprintf(invalidNamespaceLimitWarningFmt, defaultNamespace, "0", placementv1beta1.EnvelopeMaxManifestsAnnotation, 50),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: defaultNamespace, Annotations: tc.annotations}}
+			gotLimit, gotWarning := namespaceLimit(namespace, placementv1beta1.EnvelopeMaxManifestsAnnotation, hubWideLimit)
+			if diff := cmp.Diff(tc.wantLimit, gotLimit, cmp.AllowUnexported(envelopeLimit{})); diff != "" {
+				t.Errorf("namespaceLimit() limit mismatch (-want +got):\n%s", diff)
+			}
+			if gotWarning != tc.wantWarning {
+				t.Errorf("namespaceLimit() warning = %q, want %q", gotWarning, tc.wantWarning)
+			}
+		})
+	}
+}
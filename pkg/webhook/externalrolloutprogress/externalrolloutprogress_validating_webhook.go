@@ -0,0 +1,144 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package externalrolloutprogress provides a validating webhook for the clusterexternalrolloutprogress and
+// externalrolloutprogress custom resources in the KubeFleet API group.
+package externalrolloutprogress
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	k8serrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+)
+
+var (
+	// ValidationPath is the webhook service path which admission requests are routed to for validating
+	// clusterexternalrolloutprogress and externalrolloutprogress resources.
+	ValidationPath = fmt.Sprintf(utils.ValidationPathFmt, placementv1beta1.GroupVersion.Group, placementv1beta1.GroupVersion.Version, "externalrolloutprogress")
+)
+
+const (
+	denyMissingPlacementFmt        = "external rollout progress %s must have the same name (and namespace) as its placement, but placement %s is not found"
+	denyNonExternalPlacementFmt    = "external rollout progress %s belongs to placement %s of the %s rollout strategy type, only placements of the External rollout strategy type accept external rollout progresses"
+	denyMissingResourceSnapshotFmt = "external rollout progress %s reports resource snapshot index %s for cluster %s, but placement %s does not have a resource snapshot of the index"
+)
+
+type externalRolloutProgressValidator struct {
+	client  client.Client
+	decoder webhook.AdmissionDecoder
+}
+
+// Add registers the webhook for K8s built-in object types.
+func Add(mgr manager.Manager) error {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register(ValidationPath, &webhook.Admission{Handler: &externalRolloutProgressValidator{mgr.GetClient(), admission.NewDecoder(mgr.GetScheme())}})
+	return nil
+}
+
+// Handle externalRolloutProgressValidator checks that external rollout progresses belong to placements of the
+// External rollout strategy type and only report resource snapshots that exist.
+func (v *externalRolloutProgressValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	if req.Operation != admissionv1.Create && req.Operation != admissionv1.Update {
+		return admission.Allowed("external rollout progress operation is not subject to validation")
+	}
+	klog.V(2).InfoS("Validating webhook handling external rollout progress", "operation", req.Operation, "kind", req.Kind.Kind, "namespacedName", types.NamespacedName{Name: req.Name, Namespace: req.Namespace})
+
+	progress, oldProgress, err := v.decodeProgresses(req)
+	if err != nil {
+		klog.ErrorS(err, "Failed to decode external rollout progress object for validating fields", "userName", req.UserInfo.Username, "groups", req.UserInfo.Groups, "kind", req.Kind.Kind, "externalRolloutProgress", req.Name)
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+	// Updates that do not change the reported progress (e.g., label changes) are always allowed, so that the
+	// progresses can still be cleaned up after their placements are gone.
+	if oldProgress != nil && equality.Semantic.DeepEqual(oldProgress.GetExternalRolloutProgressSpec(), progress.GetExternalRolloutProgressSpec()) {
+		return admission.Allowed("external rollout progress spec is not changed")
+	}
+
+	progressRef := klog.KObj(progress).String()
+	placementKey := types.NamespacedName{Namespace: progress.GetNamespace(), Name: progress.GetName()}
+	placementRef := klog.KRef(placementKey.Namespace, placementKey.Name).String()
+	placement, err := controller.FetchPlacementFromNamespacedName(ctx, v.client, placementKey)
+	if err != nil {
+		if k8serrors.IsNotFound(err) {
+			return admission.Denied(fmt.Sprintf(denyMissingPlacementFmt, progressRef, placementRef))
+		}
+		return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to get placement %s for external rollout progress %s: %w", placementRef, progressRef, err))
+	}
+	if strategyType := placement.GetPlacementSpec().Strategy.Type; strategyType != placementv1beta1.ExternalRolloutStrategyType {
+		return admission.Denied(fmt.Sprintf(denyNonExternalPlacementFmt, progressRef, placementRef, strategyType))
+	}
+
+	// Different clusters may run the same resource snapshot, so each index is checked only once.
+	checkedIndices := make(map[string]bool)
+	for _, target := range progress.GetExternalRolloutProgressSpec().Clusters {
+		if checkedIndices[target.ResourceSnapshotIndex] {
+			continue
+		}
+		snapshotList, err := controller.ListAllResourceSnapshotWithAnIndex(ctx, v.client, target.ResourceSnapshotIndex, placementKey.Name, placementKey.Namespace)
+		if err != nil {
+			return admission.Errored(http.StatusBadRequest, fmt.Errorf("failed to list the resource snapshots of index %s of placement %s: %w", target.ResourceSnapshotIndex, placementRef, err))
+		}
+		if len(snapshotList.GetResourceSnapshotObjs()) == 0 {
+			return admission.Denied(fmt.Sprintf(denyMissingResourceSnapshotFmt, progressRef, target.ResourceSnapshotIndex, target.ClusterName, placementRef))
+		}
+		checkedIndices[target.ResourceSnapshotIndex] = true
+	}
+
+	klog.V(2).InfoS("External rollout progress has valid fields", "externalRolloutProgress", progressRef, "placement", placementRef)
+	return admission.Allowed("external rollout progress has valid fields")
+}
+
+// decodeProgresses decodes the external rollout progress (and the old one, for updates) in an admission request.
+func (v *externalRolloutProgressValidator) decodeProgresses(req admission.Request) (progress, oldProgress placementv1beta1.ExternalRolloutProgressObj, err error) {
+	switch req.Kind.Kind {
+	case placementv1beta1.ClusterExternalRolloutProgressKind:
+		progress = &placementv1beta1.ClusterExternalRolloutProgress{}
+		if req.Operation == admissionv1.Update {
+			oldProgress = &placementv1beta1.ClusterExternalRolloutProgress{}
+		}
+	case placementv1beta1.ExternalRolloutProgressKind:
+		progress = &placementv1beta1.ExternalRolloutProgress{}
+		if req.Operation == admissionv1.Update {
+			oldProgress = &placementv1beta1.ExternalRolloutProgress{}
+		}
+	default:
+		return nil, nil, fmt.Errorf("unexpected external rollout progress kind %s", req.Kind.Kind)
+	}
+
+	if err := v.decoder.Decode(req, progress); err != nil {
+		return nil, nil, err
+	}
+	if oldProgress != nil {
+		if err := v.decoder.DecodeRaw(req.OldObject, oldProgress); err != nil {
+			return nil, nil, err
+		}
+	}
+	return progress, oldProgress, nil
+}
@@ -0,0 +1,184 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalrolloutprogress
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	admissionv1 "k8s.io/api/admission/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+)
+
+const (
+	crpName              = "test-crp"
+	rollingUpdateCRPName = "test-crp-rolling-update"
+	rpName               = "test-rp"
+	testNamespace        = "test-ns"
+	clusterName          = "member-1"
+)
+
+func cerpWith(name, index string) *placementv1beta1.ClusterExternalRolloutProgress {
+	return &placementv1beta1.ClusterExternalRolloutProgress{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: placementv1beta1.ExternalRolloutProgressSpec{
+			Clusters: []placementv1beta1.ClusterRolloutTarget{{ClusterName: clusterName, ResourceSnapshotIndex: index}},
+		},
+	}
+}
+
+func erpWith(name, index string) *placementv1beta1.ExternalRolloutProgress {
+	return &placementv1beta1.ExternalRolloutProgress{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Spec: placementv1beta1.ExternalRolloutProgressSpec{
+			Clusters: []placementv1beta1.ClusterRolloutTarget{{ClusterName: clusterName, ResourceSnapshotIndex: index}},
+		},
+	}
+}
+
+func admissionRequestFor(t *testing.T, op admissionv1.Operation, kind string, obj, oldObj client.Object) admission.Request {
+	raw, err := json.Marshal(obj)
+	if err != nil {
+		t.Fatalf("failed to marshal object: %v", err)
+	}
+	req := admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{
+			Name:      obj.GetName(),
+			Namespace: obj.GetNamespace(),
+			Object:    runtime.RawExtension{Raw: raw},
+			Kind:      metav1.GroupVersionKind{Group: placementv1beta1.GroupVersion.Group, Version: placementv1beta1.GroupVersion.Version, Kind: kind},
+			Operation: op,
+		},
+	}
+	if oldObj != nil {
+		oldRaw, err := json.Marshal(oldObj)
+		if err != nil {
+			t.Fatalf("failed to marshal old object: %v", err)
+		}
+		req.OldObject = runtime.RawExtension{Raw: oldRaw}
+	}
+	return req
+}
+
+func TestHandle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	objects := []client.Object{
+		&placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: crpName},
+			Spec: placementv1beta1.PlacementSpec{
+				Strategy: placementv1beta1.RolloutStrategy{Type: placementv1beta1.ExternalRolloutStrategyType},
+			},
+		},
+		&placementv1beta1.ClusterResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: rollingUpdateCRPName},
+			Spec: placementv1beta1.PlacementSpec{
+				Strategy: placementv1beta1.RolloutStrategy{Type: placementv1beta1.RollingUpdateRolloutStrategyType},
+			},
+		},
+		&placementv1beta1.ClusterResourceSnapshot{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: fmt.Sprintf(placementv1beta1.ResourceSnapshotNameFmt, crpName, 0),
+				Labels: map[string]string{
+					placementv1beta1.PlacementTrackingLabel: crpName,
+					placementv1beta1.ResourceIndexLabel:     "0",
+				},
+			},
+		},
+		&placementv1beta1.ResourcePlacement{
+			ObjectMeta: metav1.ObjectMeta{Name: rpName, Namespace: testNamespace},
+			Spec: placementv1beta1.PlacementSpec{
+				Strategy: placementv1beta1.RolloutStrategy{Type: placementv1beta1.ExternalRolloutStrategyType},
+			},
+		},
+	}
+	validator := &externalRolloutProgressValidator{
+		client:  fake.NewClientBuilder().WithScheme(scheme).WithObjects(objects...).Build(),
+		decoder: admission.NewDecoder(scheme),
+	}
+
+	cerpKind := placementv1beta1.ClusterExternalRolloutProgressKind
+	erpKind := placementv1beta1.ExternalRolloutProgressKind
+	testCases := []struct {
+		name         string
+		req          admission.Request
+		wantResponse admission.Response
+	}{
+		{
+			name:         "allow delete",
+			req:          admissionRequestFor(t, admissionv1.Delete, cerpKind, cerpWith("not-found", "0"), nil),
+			wantResponse: admission.Allowed("external rollout progress operation is not subject to validation"),
+		},
+		{
+			name:         "allow progress that reports existing resource snapshots",
+			req:          admissionRequestFor(t, admissionv1.Create, cerpKind, cerpWith(crpName, "0"), nil),
+			wantResponse: admission.Allowed("external rollout progress has valid fields"),
+		},
+		{
+			name:         "deny progress of a placement that does not exist",
+			req:          admissionRequestFor(t, admissionv1.Create, cerpKind, cerpWith("not-found", "0"), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyMissingPlacementFmt, "not-found", "not-found")),
+		},
+		{
+			name: "deny progress of a placement of the RollingUpdate rollout strategy type",
+			req:  admissionRequestFor(t, admissionv1.Create, cerpKind, cerpWith(rollingUpdateCRPName, "0"), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyNonExternalPlacementFmt,
+				rollingUpdateCRPName, rollingUpdateCRPName, placementv1beta1.RollingUpdateRolloutStrategyType)),
+		},
+		{
+			name:         "deny progress that reports a resource snapshot that does not exist",
+			req:          admissionRequestFor(t, admissionv1.Create, cerpKind, cerpWith(crpName, "1"), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyMissingResourceSnapshotFmt, crpName, "1", clusterName, crpName)),
+		},
+		{
+			name:         "allow update that does not change the spec",
+			req:          admissionRequestFor(t, admissionv1.Update, cerpKind, cerpWith("not-found", "1"), cerpWith("not-found", "1")),
+			wantResponse: admission.Allowed("external rollout progress spec is not changed"),
+		},
+		{
+			name:         "deny update that reports a resource snapshot that does not exist",
+			req:          admissionRequestFor(t, admissionv1.Update, cerpKind, cerpWith(crpName, "1"), cerpWith(crpName, "0")),
+			wantResponse: admission.Denied(fmt.Sprintf(denyMissingResourceSnapshotFmt, crpName, "1", clusterName, crpName)),
+		},
+		{
+			name: "deny namespaced progress that reports a resource snapshot that does not exist",
+			req:  admissionRequestFor(t, admissionv1.Create, erpKind, erpWith(rpName, "0"), nil),
+			wantResponse: admission.Denied(fmt.Sprintf(denyMissingResourceSnapshotFmt,
+				fmt.Sprintf("%s/%s", testNamespace, rpName), "0", clusterName, fmt.Sprintf("%s/%s", testNamespace, rpName))),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			gotResponse := validator.Handle(context.Background(), tc.req)
+			if diff := cmp.Diff(tc.wantResponse, gotResponse); diff != "" {
+				t.Errorf("externalRolloutProgressValidator Handle() mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
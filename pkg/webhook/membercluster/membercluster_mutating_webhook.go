@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package membercluster
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
+)
+
+var (
+	// MutatingPath is the webhook service path for mutating v1beta1 MemberCluster resources.
+	MutatingPath = fmt.Sprintf(utils.MutatingPathFmt, clusterv1beta1.GroupVersion.Group, clusterv1beta1.GroupVersion.Version, "membercluster")
+)
+
+type memberClusterMutator struct {
+	decoder webhook.AdmissionDecoder
+}
+
+// AddMutating registers the mutating webhook for v1beta1 MemberCluster.
+func AddMutating(mgr manager.Manager) error {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register(MutatingPath, &webhook.Admission{Handler: &memberClusterMutator{admission.NewDecoder(mgr.GetScheme())}})
+	return nil
+}
+
+// Handle mutates MemberCluster objects on create and update.
+func (m *memberClusterMutator) Handle(_ context.Context, req admission.Request) admission.Response {
+	klog.V(2).InfoS("handling member cluster", "operation", req.Operation, "memberCluster", req.Name)
+	var mc clusterv1beta1.MemberCluster
+	if err := m.decoder.Decode(req, &mc); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	// Record the default profile and apply its default values to the MemberCluster object.
+	defaulter.RecordDefaultProfile(&mc, req.Operation == admissionv1.Create)
+	defaulter.SetMemberClusterDefaults(&mc)
+	marshaled, err := json.Marshal(mc)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	klog.V(2).InfoS("mutating member cluster", "operation", req.Operation, "memberCluster", req.Name)
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
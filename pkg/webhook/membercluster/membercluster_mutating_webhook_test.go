@@ -0,0 +1,147 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package membercluster
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"gomodules.xyz/jsonpatch/v2"
+	admissionv1 "k8s.io/api/admission/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
+)
+
+func TestMutatingHandle(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add scheme: %v", err)
+	}
+	mutator := &memberClusterMutator{decoder: admission.NewDecoder(scheme)}
+
+	memberClusterWith := func(annotations map[string]string, heartbeatPeriodSeconds int32) []byte {
+		mc := &clusterv1beta1.MemberCluster{
+			TypeMeta: metav1.TypeMeta{
+				APIVersion: clusterv1beta1.GroupVersion.String(),
+				Kind:       "MemberCluster",
+			},
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "member-1",
+				Annotations: annotations,
+			},
+			Spec: clusterv1beta1.MemberClusterSpec{
+				Identity: rbacv1.Subject{
+					Name:      "member-agent",
+					Kind:      rbacv1.ServiceAccountKind,
+					Namespace: "fleet-system",
+				},
+				HeartbeatPeriodSeconds: heartbeatPeriodSeconds,
+			},
+		}
+		raw, err := json.Marshal(mc)
+		if err != nil {
+			t.Fatalf("failed to marshal member cluster: %v", err)
+		}
+		return raw
+	}
+	latestProfile := map[string]string{defaulter.DefaultProfileAnnotation: defaulter.LatestDefaultProfile}
+
+	testCases := map[string]struct {
+		operation    admissionv1.Operation
+		raw          []byte
+		wantResponse admission.Response
+	}{
+		"should record the latest default profile and default heartbeat period (CREATE)": {
+			operation: admissionv1.Create,
+			raw:       memberClusterWith(nil, 0),
+			wantResponse: admission.Response{
+				Patches: []jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations",
+						Value:     map[string]any{defaulter.DefaultProfileAnnotation: defaulter.LatestDefaultProfile},
+					},
+					{
+						Operation: "add",
+						Path:      "/spec/heartbeatPeriodSeconds",
+						Value:     float64(defaulter.DefaultHeartbeatPeriodSeconds),
+					},
+				},
+				AdmissionResponse: admissionv1.AdmissionResponse{
+					Allowed:   true,
+					PatchType: ptr.To(admissionv1.PatchTypeJSONPatch),
+				},
+			},
+		},
+		"should record the v1 default profile (UPDATE)": {
+			operation: admissionv1.Update,
+			raw:       memberClusterWith(nil, 30),
+			wantResponse: admission.Response{
+				Patches: []jsonpatch.JsonPatchOperation{
+					{
+						Operation: "add",
+						Path:      "/metadata/annotations",
+						Value:     map[string]any{defaulter.DefaultProfileAnnotation: defaulter.DefaultProfileV1},
+					},
+				},
+				AdmissionResponse: admissionv1.AdmissionResponse{
+					Allowed:   true,
+					PatchType: ptr.To(admissionv1.PatchTypeJSONPatch),
+				},
+			},
+		},
+		"should not patch if all fields present (UPDATE)": {
+			operation: admissionv1.Update,
+			raw:       memberClusterWith(latestProfile, 30),
+			wantResponse: admission.Response{
+				Patches: []jsonpatch.JsonPatchOperation{},
+				AdmissionResponse: admissionv1.AdmissionResponse{
+					Allowed: true,
+				},
+			},
+		},
+	}
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			req := admission.Request{
+				AdmissionRequest: admissionv1.AdmissionRequest{
+					Name:      "member-1",
+					Object:    runtime.RawExtension{Raw: tc.raw},
+					Operation: tc.operation,
+				},
+			}
+			resp := mutator.Handle(context.Background(), req)
+			cmpOptions := []cmp.Option{
+				cmpopts.SortSlices(func(a, b jsonpatch.JsonPatchOperation) bool {
+					return a.Path < b.Path
+				}),
+			}
+			if diff := cmp.Diff(tc.wantResponse, resp, cmpOptions...); diff != "" {
+				t.Errorf("Handle() mismatch (-want, got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"strings"
 
 	admissionv1 "k8s.io/api/admission/v1"
 	"k8s.io/apimachinery/pkg/types"
@@ -15,6 +16,7 @@ import (
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/validator"
 
 	fleetnetworkingv1alpha1 "go.goms.io/fleet-networking/api/v1alpha1"
@@ -25,19 +27,29 @@ var (
 	ValidationPath = fmt.Sprintf(utils.ValidationPathFmt, clusterv1beta1.GroupVersion.Group, clusterv1beta1.GroupVersion.Version, "membercluster")
 )
 
+const (
+	// maxPlacementsInDeleteWarning is the max number of placements listed in the warning returned when
+	// a member cluster that still hosts placed resources is deleted.
+	maxPlacementsInDeleteWarning = 10
+)
+
 type memberClusterValidator struct {
 	client                  client.Client
 	decoder                 webhook.AdmissionDecoder
 	networkingAgentsEnabled bool
+	// denyDeleteWithPlacements blocks the deletion of member clusters that still host placed resources,
+	// unless the deletion validation is skipped.
+	denyDeleteWithPlacements bool
 }
 
 // Add registers the webhook for K8s built-in object types.
-func Add(mgr manager.Manager, networkingAgentsEnabled bool) {
+func Add(mgr manager.Manager, networkingAgentsEnabled, denyDeleteWithPlacements bool) {
 	hookServer := mgr.GetWebhookServer()
 	hookServer.Register(ValidationPath, &webhook.Admission{Handler: &memberClusterValidator{
-		client:                  mgr.GetClient(),
-		decoder:                 admission.NewDecoder(mgr.GetScheme()),
-		networkingAgentsEnabled: networkingAgentsEnabled,
+		client:                   mgr.GetClient(),
+		decoder:                  admission.NewDecoder(mgr.GetScheme()),
+		networkingAgentsEnabled:  networkingAgentsEnabled,
+		denyDeleteWithPlacements: denyDeleteWithPlacements,
 	}})
 }
 
@@ -53,13 +65,28 @@ func (v *memberClusterValidator) Handle(ctx context.Context, req admission.Reque
 			return admission.Errored(http.StatusBadRequest, err)
 		}
 
+		// Report the placements that would lose the member cluster, so that the workload owners are not surprised.
+		placementKeys, err := controller.ListPlacementsOnCluster(ctx, v.client, mc.Name, true)
+		if err != nil {
+			klog.ErrorS(err, "Failed to list the placements on the member cluster when validating", "memberCluster", mcObjectName)
+			if v.denyDeleteWithPlacements {
+				return admission.Errored(http.StatusInternalServerError, fmt.Errorf("failed to list the placements on the member cluster, please retry the request: %w", err))
+			}
+		}
+		warnings := buildPlacementImpactWarnings(mc.Name, placementKeys)
+
 		if mc.Spec.DeleteOptions != nil && mc.Spec.DeleteOptions.ValidationMode == clusterv1beta1.DeleteValidationModeSkip {
 			klog.V(2).InfoS("Skipping validation for member cluster DELETE when the validation mode is set to skip", "memberCluster", mcObjectName)
-			return admission.Allowed("Skipping validation for member cluster DELETE when the validation mode is set to skip")
+			return admission.Allowed("Skipping validation for member cluster DELETE when the validation mode is set to skip").WithWarnings(warnings...)
+		}
+		if v.denyDeleteWithPlacements && len(placementKeys) > 0 {
+			klog.V(2).InfoS("Member cluster still hosts placed resources, request is denied", "memberCluster", mcObjectName, "numberOfPlacements", len(placementKeys))
+			return admission.Denied(fmt.Sprintf("%s; set spec.deleteOptions.validationMode to %s to force the deletion, request is denied",
+				placementImpactMessage(mc.Name, placementKeys), clusterv1beta1.DeleteValidationModeSkip))
 		}
 		if !v.networkingAgentsEnabled {
 			klog.V(2).InfoS("Networking agents disabled; skipping ServiceExport validation", "memberCluster", mcObjectName)
-			return admission.Allowed("Networking agents disabled; skipping ServiceExport validation")
+			return admission.Allowed("Networking agents disabled; skipping ServiceExport validation").WithWarnings(warnings...)
 		}
 
 		klog.V(2).InfoS("Validating webhook member cluster DELETE", "memberCluster", mcObjectName)
@@ -75,7 +102,7 @@ func (v *memberClusterValidator) Handle(ctx context.Context, req admission.Reque
 				return admission.Denied(fmt.Sprintf("Please delete serviceExport %s in the member cluster before leaving, request is denied", internalServiceExport.Spec.ServiceReference.NamespacedName))
 			}
 		}
-		return admission.Allowed("Member cluster is ready to leave")
+		return admission.Allowed("Member cluster is ready to leave").WithWarnings(warnings...)
 	}
 
 	if err := v.decoder.Decode(req, &mc); err != nil {
@@ -89,3 +116,26 @@ func (v *memberClusterValidator) Handle(ctx context.Context, req admission.Reque
 	}
 	return admission.Allowed("Member cluster has valid fields")
 }
+
+// buildPlacementImpactWarnings returns the admission warnings that report the placements which would lose
+// the member cluster.
+func buildPlacementImpactWarnings(mcName string, placementKeys []types.NamespacedName) []string {
+	if len(placementKeys) == 0 {
+		return nil
+	}
+	return []string{placementImpactMessage(mcName, placementKeys)}
+}
+
+// placementImpactMessage describes the placements which would lose the member cluster.
+func placementImpactMessage(mcName string, placementKeys []types.NamespacedName) string {
+	names := make([]string, 0, min(len(placementKeys), maxPlacementsInDeleteWarning))
+	for i, key := range placementKeys {
+		if i == maxPlacementsInDeleteWarning {
+			names = append(names, fmt.Sprintf("and %d more", len(placementKeys)-maxPlacementsInDeleteWarning))
+			break
+		}
+		names = append(names, controller.GetObjectKeyFromNamespaceName(key.Namespace, key.Name))
+	}
+	return fmt.Sprintf("member cluster %s still hosts the resources of %d placement(s), which will be removed from it: %s",
+		mcName, len(placementKeys), strings.Join(names, ", "))
+}
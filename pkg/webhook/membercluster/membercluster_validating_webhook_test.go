@@ -7,12 +7,14 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/google/go-cmp/cmp"
 	admissionv1 "k8s.io/api/admission/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
 
 	fleetnetworkingv1alpha1 "go.goms.io/fleet-networking/api/v1alpha1"
@@ -76,6 +78,123 @@ func TestHandleDelete(t *testing.T) {
 	}
 }
 
+func TestHandleDeleteWithPlacements(t *testing.T) {
+	t.Parallel()
+
+	mcName := "member-1"
+	bindings := []client.Object{
+		newClusterResourceBinding("crp-1-binding", "crp-1", mcName, placementv1beta1.BindingStateBound),
+		newClusterResourceBinding("crp-2-binding", "crp-2", mcName, placementv1beta1.BindingStateScheduled),
+		newClusterResourceBinding("crp-3-binding", "crp-3", mcName, placementv1beta1.BindingStateUnscheduled),
+		newClusterResourceBinding("crp-4-binding", "crp-4", "member-2", placementv1beta1.BindingStateBound),
+	}
+	wantWarning := "member cluster member-1 still hosts the resources of 2 placement(s), which will be removed from it: crp-1, crp-2"
+
+	testCases := map[string]struct {
+		denyDeleteWithPlacements bool
+		validationMode           clusterv1beta1.DeleteValidationMode
+		objs                     []client.Object
+		wantAllowed              bool
+		wantWarnings             []string
+		wantMessageSubstr        string
+	}{
+		"no placements on the cluster": {
+			denyDeleteWithPlacements: true,
+			validationMode:           clusterv1beta1.DeleteValidationModeStrict,
+			objs:                     []client.Object{bindings[3]},
+			wantAllowed:              true,
+		},
+		"placements on the cluster are reported as warnings": {
+			validationMode: clusterv1beta1.DeleteValidationModeStrict,
+			objs:           bindings,
+			wantAllowed:    true,
+			wantWarnings:   []string{wantWarning},
+		},
+		"placements on the cluster block the deletion": {
+			denyDeleteWithPlacements: true,
+			validationMode:           clusterv1beta1.DeleteValidationModeStrict,
+			objs:                     bindings,
+			wantAllowed:              false,
+			wantMessageSubstr:        wantWarning,
+		},
+		"forced deletion is allowed with warnings": {
+			denyDeleteWithPlacements: true,
+			validationMode:           clusterv1beta1.DeleteValidationModeSkip,
+			objs:                     bindings,
+			wantAllowed:              true,
+			wantWarnings:             []string{wantWarning},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			validator := newMemberClusterValidatorForTest(t, false, tc.objs...)
+			validator.denyDeleteWithPlacements = tc.denyDeleteWithPlacements
+			mc := &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: mcName}}
+			mc.Spec.DeleteOptions = &clusterv1beta1.DeleteOptions{ValidationMode: tc.validationMode}
+			req := buildDeleteRequestFromObject(t, mc)
+
+			resp := validator.Handle(context.Background(), req)
+			if resp.Allowed != tc.wantAllowed {
+				t.Fatalf("Handle() got response: %+v, want allowed %t", resp, tc.wantAllowed)
+			}
+			if diff := cmp.Diff(tc.wantWarnings, resp.Warnings); diff != "" {
+				t.Errorf("Handle() warnings mismatch (-want, +got):\n%s", diff)
+			}
+			if tc.wantMessageSubstr != "" {
+				if resp.Result == nil || !strings.Contains(resp.Result.Message, tc.wantMessageSubstr) {
+					t.Fatalf("Handle()  got response result: %v,  want contain: %q", resp.Result, tc.wantMessageSubstr)
+				}
+			}
+		})
+	}
+}
+
+func TestPlacementImpactMessage(t *testing.T) {
+	manyKeys := make([]types.NamespacedName, 0, maxPlacementsInDeleteWarning+2)
+	for i := range maxPlacementsInDeleteWarning + 2 {
+		manyKeys = append(manyKeys, types.NamespacedName{Name: fmt.Sprintf("crp-%02d", i)})
+	}
+
+	testCases := map[string]struct {
+		placementKeys []types.NamespacedName
+		want          string
+	}{
+		"cluster and namespaced placements": {
+			placementKeys: []types.NamespacedName{{Name: "crp-1"}, {Namespace: "app", Name: "rp-1"}},
+			want:          "member cluster member-1 still hosts the resources of 2 placement(s), which will be removed from it: crp-1, app/rp-1",
+		},
+		"too many placements are truncated": {
+			placementKeys: manyKeys,
+			want: "member cluster member-1 still hosts the resources of 12 placement(s), which will be removed from it: " +
+				"crp-00, crp-01, crp-02, crp-03, crp-04, crp-05, crp-06, crp-07, crp-08, crp-09, and 2 more",
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			if got := placementImpactMessage("member-1", tc.placementKeys); got != tc.want {
+				t.Errorf("placementImpactMessage() = %q, want %q", got, tc.want)
+			}
+		})
+	}
+}
+
+func newClusterResourceBinding(name, crpName, clusterName string, state placementv1beta1.BindingState) *placementv1beta1.ClusterResourceBinding {
+	return &placementv1beta1.ClusterResourceBinding{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{placementv1beta1.PlacementTrackingLabel: crpName},
+		},
+		Spec: placementv1beta1.ResourceBindingSpec{
+			State:         state,
+			TargetCluster: clusterName,
+		},
+	}
+}
+
 func newMemberClusterValidatorForTest(t *testing.T, networkingEnabled bool, objs ...client.Object) *memberClusterValidator {
 	t.Helper()
 
@@ -83,6 +202,9 @@ func newMemberClusterValidatorForTest(t *testing.T, networkingEnabled bool, objs
 	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
 		t.Fatalf("failed to add member cluster scheme: %v", err)
 	}
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement scheme: %v", err)
+	}
 	if err := fleetnetworkingv1alpha1.AddToScheme(scheme); err != nil {
 		t.Fatalf("failed to add fleet networking scheme: %v", err)
 	}
@@ -0,0 +1,69 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package resourceplacement
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/defaulter"
+)
+
+var (
+	// MutatingPath is the webhook service path for mutating v1beta1 RP resources.
+	MutatingPath = fmt.Sprintf(utils.MutatingPathFmt, placementv1beta1.GroupVersion.Group, placementv1beta1.GroupVersion.Version, "resourceplacement")
+)
+
+type resourcePlacementMutator struct {
+	decoder webhook.AdmissionDecoder
+}
+
+// AddMutating registers the mutating webhook for v1beta1 RP.
+func AddMutating(mgr manager.Manager) error {
+	hookServer := mgr.GetWebhookServer()
+	hookServer.Register(MutatingPath, &webhook.Admission{Handler: &resourcePlacementMutator{admission.NewDecoder(mgr.GetScheme())}})
+	return nil
+}
+
+// Handle mutates RP objects on create and update.
+func (m *resourcePlacementMutator) Handle(_ context.Context, req admission.Request) admission.Response {
+	klog.V(2).InfoS("handling RP", "operation", req.Operation, "rp", klog.KRef(req.Namespace, req.Name))
+	var rp placementv1beta1.ResourcePlacement
+	if err := m.decoder.Decode(req, &rp); err != nil {
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	// Record the default profile and apply its default values to the RP object.
+	defaulter.RecordDefaultProfile(&rp, req.Operation == admissionv1.Create)
+	defaulter.SetPlacementDefaults(&rp)
+	marshaled, err := json.Marshal(rp)
+	if err != nil {
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	klog.V(2).InfoS("mutating RP", "operation", req.Operation, "rp", klog.KRef(req.Namespace, req.Name))
+	return admission.PatchResponseFromRaw(req.Object.Raw, marshaled)
+}
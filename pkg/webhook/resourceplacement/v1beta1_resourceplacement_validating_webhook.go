@@ -21,6 +21,8 @@ import (
 	"context"
 	"fmt"
 
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 	"sigs.k8s.io/controller-runtime/pkg/webhook"
 	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
@@ -36,19 +38,25 @@ var (
 )
 
 type resourcePlacementValidator struct {
+	client  client.Reader
 	decoder webhook.AdmissionDecoder
 }
 
 // Add registers the webhook for K8s built-in object types.
 func Add(mgr manager.Manager) error {
 	hookServer := mgr.GetWebhookServer()
-	hookServer.Register(ValidationPath, &webhook.Admission{Handler: &resourcePlacementValidator{admission.NewDecoder(mgr.GetScheme())}})
+	hookServer.Register(ValidationPath, &webhook.Admission{Handler: &resourcePlacementValidator{
+		client:  mgr.GetClient(),
+		decoder: admission.NewDecoder(mgr.GetScheme()),
+	}})
 	return nil
 }
 
 // Handle resourcePlacementValidator handles create, update RP requests.
 func (v *resourcePlacementValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
-	return validator.HandlePlacementValidation(
+	// placement is the RP object being created or updated, which is captured when it is decoded.
+	var placement placementv1beta1.PlacementObj
+	resp := validator.HandlePlacementValidation(
 		ctx,
 		req,
 		v.decoder,
@@ -57,6 +65,7 @@ func (v *resourcePlacementValidator) Handle(ctx context.Context, req admission.R
 		func(req admission.Request, decoder webhook.AdmissionDecoder) (placementv1beta1.PlacementObj, error) {
 			var rp placementv1beta1.ResourcePlacement
 			err := decoder.Decode(req, &rp)
+			placement = &rp
 			return &rp, err
 		},
 		// decodeOldFunc
@@ -69,5 +78,23 @@ func (v *resourcePlacementValidator) Handle(ctx context.Context, req admission.R
 		func(obj placementv1beta1.PlacementObj) error {
 			return validator.ValidateResourcePlacement(obj.(*placementv1beta1.ResourcePlacement))
 		},
+		// warnFunc
+		func(ctx context.Context, obj placementv1beta1.PlacementObj) admission.Warnings {
+			return validator.PickFixedClusterWarnings(ctx, v.client, obj)
+		},
 	)
+	if !resp.Allowed || placement == nil || placement.GetDeletionTimestamp() != nil {
+		return resp
+	}
+	// Reject the dependency cycles, which can only be found with the other RPs in the namespace.
+	if err := validator.ValidatePlacementDependencies(ctx, v.client, placement); err != nil {
+		klog.V(2).InfoS("v1beta1 placement has invalid dependencies, request is denied", "resourceType", "RP", "operation", req.Operation, "placement", klog.KObj(placement))
+		return admission.Denied(fmt.Sprintf(validator.DenyCreateUpdateInvalidFmt, "RP", err))
+	}
+	// Reject the placement affinity cycles, which can only be found with the other RPs in the namespace.
+	if err := validator.ValidatePlacementAffinityCycle(ctx, v.client, placement); err != nil {
+		klog.V(2).InfoS("v1beta1 placement has an invalid placement affinity, request is denied", "resourceType", "RP", "operation", req.Operation, "placement", klog.KObj(placement))
+		return admission.Denied(fmt.Sprintf(validator.DenyCreateUpdateInvalidFmt, "RP", err))
+	}
+	return resp
 }
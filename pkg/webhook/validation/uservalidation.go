@@ -132,6 +132,13 @@ func isAdminGroupUserOrWhiteListedUser(whiteListedUsers []string, userInfo authe
 	return slices.Contains(whiteListedUsers, userInfo.Username) || slices.Contains(userInfo.Groups, mastersGroup) || slices.Contains(userInfo.Groups, kubeadmClusterAdminsGroup)
 }
 
+// IsFleetSystemUser returns true if user is a fleet system component (i.e., a service account in the
+// fleet-system namespace, such as the hub agent), belongs to white listed users, or belongs to
+// system:masters/kubeadm:cluster-admins group.
+func IsFleetSystemUser(whiteListedUsers []string, userInfo authenticationv1.UserInfo) bool {
+	return strings.HasPrefix(userInfo.Username, fmt.Sprintf(serviceAccountFmt, "")) || isAdminGroupUserOrWhiteListedUser(whiteListedUsers, userInfo)
+}
+
 // isUserAuthenticatedServiceAccount returns true if user is a valid service account.
 func isUserAuthenticatedServiceAccount(userInfo authenticationv1.UserInfo) bool {
 	return slices.Contains(userInfo.Groups, serviceAccountsGroup)
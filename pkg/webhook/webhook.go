@@ -47,24 +47,31 @@ import (
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog/v2"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
+	ctrlconversion "sigs.k8s.io/controller-runtime/pkg/webhook/conversion"
 
 	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/cmd/hubagent/options"
+	"github.com/kubefleet-dev/kubefleet/pkg/webhook/binding"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/clusterresourceoverride"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/clusterresourceplacement"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/clusterresourceplacementdisruptionbudget"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/clusterresourceplacementeviction"
+	"github.com/kubefleet-dev/kubefleet/pkg/webhook/conversion"
+	"github.com/kubefleet-dev/kubefleet/pkg/webhook/envelope"
+	"github.com/kubefleet-dev/kubefleet/pkg/webhook/externalrolloutprogress"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/fleetresourcehandler"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/membercluster"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/pdb"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/pod"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/replicaset"
 	"github.com/kubefleet-dev/kubefleet/pkg/webhook/resourceoverride"
+	"github.com/kubefleet-dev/kubefleet/pkg/webhook/resourceplacement"
 
 	fleetnetworkingv1alpha1 "go.goms.io/fleet-networking/api/v1alpha1"
 )
@@ -124,6 +131,12 @@ const (
 	resourceOverrideName                 = "resourceoverrides"
 	evictionName                         = "clusterresourceplacementevictions"
 	disruptionBudgetName                 = "clusterresourceplacementdisruptionbudgets"
+	clusterResourceBindingName           = "clusterresourcebindings"
+	resourceBindingName                  = "resourcebindings"
+	clusterExternalRolloutProgressName   = "clusterexternalrolloutprogresses"
+	externalRolloutProgressName          = "externalrolloutprogresses"
+	clusterResourceEnvelopeName          = "clusterresourceenvelopes"
+	resourceEnvelopeName                 = "resourceenvelopes"
 )
 
 var (
@@ -140,7 +153,9 @@ var (
 
 var AddToManagerFuncs []func(manager.Manager) error
 var AddToManagerFleetResourceValidator func(manager.Manager, []string, bool) error
-var AddToManagerMemberclusterValidator func(manager.Manager, bool)
+var AddToManagerMemberclusterValidator func(manager.Manager, bool, bool)
+var AddToManagerBindingValidator func(manager.Manager, []string) error
+var AddToManagerEnvelopeValidator func(manager.Manager, int, int) error
 
 // AddToManager adds all Controllers to the Manager
 func AddToManager(m manager.Manager, config *Config) error {
@@ -149,7 +164,13 @@ func AddToManager(m manager.Manager, config *Config) error {
 			return err
 		}
 	}
-	AddToManagerMemberclusterValidator(m, config.networkingAgentsEnabled)
+	AddToManagerMemberclusterValidator(m, config.networkingAgentsEnabled, config.denyMemberClusterDeleteWithPlacements)
+	if err := AddToManagerBindingValidator(m, config.whiteListedUsers); err != nil {
+		return err
+	}
+	if err := AddToManagerEnvelopeValidator(m, config.envelopeMaxManifests, config.envelopeMaxSizeBytes); err != nil {
+		return err
+	}
 	return AddToManagerFleetResourceValidator(m, config.whiteListedUsers, config.denyModifyMemberClusterLabels)
 }
 
@@ -181,6 +202,16 @@ type Config struct {
 	whiteListedUsers []string
 	// networkingAgentsEnabled indicates whether networking agents are enabled
 	networkingAgentsEnabled bool
+	// enableConversionWebhook indicates whether the conversion webhook is set up for the KubeFleet CRDs
+	// that serve multiple API versions.
+	enableConversionWebhook bool
+	// denyMemberClusterDeleteWithPlacements indicates whether the deletion of member clusters that still
+	// host placed resources is blocked unless forced.
+	denyMemberClusterDeleteWithPlacements bool
+	// envelopeMaxManifests is the hub-wide limit on the number of manifests an envelope may wrap.
+	envelopeMaxManifests int
+	// envelopeMaxSizeBytes is the hub-wide limit on the total size (in bytes) of the manifests an envelope may wrap.
+	envelopeMaxSizeBytes int
 }
 
 func NewWebhookConfig(
@@ -197,6 +228,10 @@ func NewWebhookConfig(
 	webhookCertName string,
 	whiteListedUsers []string,
 	networkingAgentsEnabled bool,
+	enableConversionWebhook bool,
+	denyMemberClusterDeleteWithPlacements bool,
+	envelopeMaxManifests int,
+	envelopeMaxSizeBytes int,
 ) (*Config, error) {
 	// We assume the Pod namespace should be passed to env through downward API in the Pod spec.
 	namespace := os.Getenv("POD_NAMESPACE")
@@ -204,20 +239,24 @@ func NewWebhookConfig(
 		return nil, errors.New("fail to obtain Pod namespace from POD_NAMESPACE")
 	}
 	w := Config{
-		mgr:                           mgr,
-		servicePort:                   port,
-		serviceNamespace:              namespace,
-		serviceName:                   webhookServiceName,
-		serviceURL:                    fmt.Sprintf("https://%s.%s.svc.cluster.local:%d", webhookServiceName, namespace, port),
-		clientConnectionType:          clientConnectionType,
-		enableGuardRail:               enableGuardRail,
-		denyModifyMemberClusterLabels: denyModifyMemberClusterLabels,
-		enableWorkload:                enableWorkload,
-		enablePDBs:                    enablePDBs,
-		useCertManager:                useCertManager,
-		webhookCertName:               webhookCertName,
-		whiteListedUsers:              whiteListedUsers,
-		networkingAgentsEnabled:       networkingAgentsEnabled,
+		mgr:                                   mgr,
+		servicePort:                           port,
+		serviceNamespace:                      namespace,
+		serviceName:                           webhookServiceName,
+		serviceURL:                            fmt.Sprintf("https://%s.%s.svc.cluster.local:%d", webhookServiceName, namespace, port),
+		clientConnectionType:                  clientConnectionType,
+		enableGuardRail:                       enableGuardRail,
+		denyModifyMemberClusterLabels:         denyModifyMemberClusterLabels,
+		enableWorkload:                        enableWorkload,
+		enablePDBs:                            enablePDBs,
+		useCertManager:                        useCertManager,
+		webhookCertName:                       webhookCertName,
+		whiteListedUsers:                      whiteListedUsers,
+		networkingAgentsEnabled:               networkingAgentsEnabled,
+		enableConversionWebhook:               enableConversionWebhook,
+		denyMemberClusterDeleteWithPlacements: denyMemberClusterDeleteWithPlacements,
+		envelopeMaxManifests:                  envelopeMaxManifests,
+		envelopeMaxSizeBytes:                  envelopeMaxSizeBytes,
 	}
 
 	if useCertManager {
@@ -259,7 +298,11 @@ func NewWebhookConfigFromOptions(mgr manager.Manager, opts *options.Options, web
 		opts.WebhookOpts.UseCertManager,
 		FleetWebhookCertName,
 		whiteListedUsers,
-		opts.ClusterMgmtOpts.NetworkingAgentsEnabled)
+		opts.ClusterMgmtOpts.NetworkingAgentsEnabled,
+		opts.WebhookOpts.EnableConversionWebhook,
+		opts.WebhookOpts.DenyMemberClusterDeleteWithPlacements,
+		opts.WebhookOpts.EnvelopeMaxManifests,
+		opts.WebhookOpts.EnvelopeMaxSizeBytes)
 }
 
 func (w *Config) Start(ctx context.Context) error {
@@ -269,9 +312,22 @@ func (w *Config) Start(ctx context.Context) error {
 		return err
 	}
 	klog.V(2).InfoS("webhook configurations created successfully")
+	if w.enableConversionWebhook {
+		if err := w.setUpCRDConversion(ctx); err != nil {
+			klog.ErrorS(err, "unable to set up the conversion webhook for CRDs")
+			return err
+		}
+		klog.V(2).InfoS("CRD conversion webhook set up successfully")
+	}
 	return nil
 }
 
+// CABundle returns the CA bundle of the self-signed serving certificate, or nil if the serving certificate
+// is managed by cert-manager.
+func (w *Config) CABundle() []byte {
+	return w.caPEM
+}
+
 // CheckCAInjection verifies that cert-manager has injected the CA bundle into all webhook configurations.
 // This is used as a readiness check when useCertManager is enabled.
 // Returns nil when CA bundles are injected, or an error if they are missing.
@@ -348,6 +404,82 @@ func (w *Config) createFleetWebhookConfiguration(ctx context.Context) error {
 	return nil
 }
 
+// setUpCRDConversion sets up the conversion webhook for all the KubeFleet CRDs that serve multiple
+// API versions, so that the API server converts the objects between the API versions via the webhook.
+func (w *Config) setUpCRDConversion(ctx context.Context) error {
+	var crdList apiextensionsv1.CustomResourceDefinitionList
+	if err := w.mgr.GetClient().List(ctx, &crdList); err != nil {
+		return fmt.Errorf("failed to list CRDs: %w", err)
+	}
+	for i := range crdList.Items {
+		crd := &crdList.Items[i]
+		if !w.isConvertibleCRD(crd) {
+			continue
+		}
+		updatedCRD := crd.DeepCopy()
+		updatedCRD.Spec.Conversion = w.buildCRDConversion()
+		for k, v := range w.buildWebhookAnnotations() {
+			if updatedCRD.Annotations == nil {
+				updatedCRD.Annotations = map[string]string{}
+			}
+			updatedCRD.Annotations[k] = v
+		}
+		if err := w.mgr.GetClient().Patch(ctx, updatedCRD, client.MergeFrom(crd)); err != nil {
+			return fmt.Errorf("failed to set up the conversion webhook for CRD %s: %w", crd.Name, err)
+		}
+		klog.V(2).InfoS("successfully set up the conversion webhook for CRD", "crd", crd.Name)
+	}
+	return nil
+}
+
+// isConvertibleCRD returns true if a CRD is a KubeFleet CRD that serves multiple API versions, and
+// all of its API versions can be converted via the conversion webhook.
+func (w *Config) isConvertibleCRD(crd *apiextensionsv1.CustomResourceDefinition) bool {
+	if crd.Spec.Group != placementv1beta1.GroupVersion.Group && crd.Spec.Group != clusterv1beta1.GroupVersion.Group {
+		return false
+	}
+	if len(crd.Spec.Versions) < 2 {
+		return false
+	}
+	scheme := w.mgr.GetClient().Scheme()
+	for _, version := range crd.Spec.Versions {
+		obj, err := scheme.New(schema.GroupVersionKind{Group: crd.Spec.Group, Version: version.Name, Kind: crd.Spec.Names.Kind})
+		if err != nil {
+			klog.V(2).InfoS("Skip setting up the conversion webhook for a CRD with an unknown API version", "crd", crd.Name, "version", version.Name)
+			return false
+		}
+		if ok, err := ctrlconversion.IsConvertible(scheme, obj); err != nil || !ok {
+			klog.V(2).InfoS("Skip setting up the conversion webhook for a CRD that is not convertible", "crd", crd.Name, "error", err)
+			return false
+		}
+	}
+	return true
+}
+
+// buildCRDConversion returns the conversion settings of the KubeFleet CRDs.
+func (w *Config) buildCRDConversion() *apiextensionsv1.CustomResourceConversion {
+	clientConfig := w.createClientConfig(conversion.ConversionPath)
+	crdClientConfig := &apiextensionsv1.WebhookClientConfig{
+		URL:      clientConfig.URL,
+		CABundle: clientConfig.CABundle,
+	}
+	if clientConfig.Service != nil {
+		crdClientConfig.Service = &apiextensionsv1.ServiceReference{
+			Namespace: clientConfig.Service.Namespace,
+			Name:      clientConfig.Service.Name,
+			Path:      clientConfig.Service.Path,
+			Port:      clientConfig.Service.Port,
+		}
+	}
+	return &apiextensionsv1.CustomResourceConversion{
+		Strategy: apiextensionsv1.WebhookConverter,
+		Webhook: &apiextensionsv1.WebhookConversion{
+			ClientConfig:             crdClientConfig,
+			ConversionReviewVersions: []string{apiextensionsv1.SchemeGroupVersion.Version},
+		},
+	}
+}
+
 // buildWebhookAnnotations creates annotations for webhook configurations.
 // When using cert-manager, adds the inject-ca-from annotation to automatically inject the CA bundle.
 func (w *Config) buildWebhookAnnotations() map[string]string {
@@ -413,6 +545,40 @@ func (w *Config) buildFleetMutatingWebhooks() []admv1.MutatingWebhook {
 			},
 			TimeoutSeconds: longWebhookTimeout,
 		},
+		{
+			Name:                    "fleet.resourceplacementv1beta1.mutating",
+			ClientConfig:            w.createClientConfig(resourceplacement.MutatingPath),
+			FailurePolicy:           &ignoreFailurePolicy,
+			SideEffects:             &sideEffortsNone,
+			AdmissionReviewVersions: admissionReviewVersions,
+			Rules: []admv1.RuleWithOperations{
+				{
+					Operations: []admv1.OperationType{
+						admv1.Create,
+						admv1.Update,
+					},
+					Rule: createRule([]string{placementv1beta1.GroupVersion.Group}, []string{placementv1beta1.GroupVersion.Version}, []string{placementv1beta1.ResourcePlacementResource}, &namespacedScope),
+				},
+			},
+			TimeoutSeconds: longWebhookTimeout,
+		},
+		{
+			Name:                    "fleet.membercluster.mutating",
+			ClientConfig:            w.createClientConfig(membercluster.MutatingPath),
+			FailurePolicy:           &ignoreFailurePolicy,
+			SideEffects:             &sideEffortsNone,
+			AdmissionReviewVersions: admissionReviewVersions,
+			Rules: []admv1.RuleWithOperations{
+				{
+					Operations: []admv1.OperationType{
+						admv1.Create,
+						admv1.Update,
+					},
+					Rule: createRule([]string{clusterv1beta1.GroupVersion.Group}, []string{clusterv1beta1.GroupVersion.Version}, []string{memberClusterResourceName}, &clusterScope),
+				},
+			},
+			TimeoutSeconds: longWebhookTimeout,
+		},
 	}
 	return webHooks
 }
@@ -586,6 +752,60 @@ func (w *Config) buildFleetValidatingWebhooks() []admv1.ValidatingWebhook {
 			}},
 			TimeoutSeconds: longWebhookTimeout,
 		},
+		admv1.ValidatingWebhook{
+			Name:                    "fleet.binding.validating",
+			ClientConfig:            w.createClientConfig(binding.ValidationPath),
+			FailurePolicy:           &failFailurePolicy,
+			SideEffects:             &sideEffortsNone,
+			AdmissionReviewVersions: admissionReviewVersions,
+			Rules: []admv1.RuleWithOperations{
+				{
+					Operations: []admv1.OperationType{admv1.Create, admv1.Update},
+					Rule:       createRule([]string{placementv1beta1.GroupVersion.Group}, []string{placementv1beta1.GroupVersion.Version}, []string{clusterResourceBindingName}, &clusterScope),
+				},
+				{
+					Operations: []admv1.OperationType{admv1.Create, admv1.Update},
+					Rule:       createRule([]string{placementv1beta1.GroupVersion.Group}, []string{placementv1beta1.GroupVersion.Version}, []string{resourceBindingName}, &namespacedScope),
+				},
+			},
+			TimeoutSeconds: longWebhookTimeout,
+		},
+		admv1.ValidatingWebhook{
+			Name:                    "fleet.externalrolloutprogress.validating",
+			ClientConfig:            w.createClientConfig(externalrolloutprogress.ValidationPath),
+			FailurePolicy:           &failFailurePolicy,
+			SideEffects:             &sideEffortsNone,
+			AdmissionReviewVersions: admissionReviewVersions,
+			Rules: []admv1.RuleWithOperations{
+				{
+					Operations: []admv1.OperationType{admv1.Create, admv1.Update},
+					Rule:       createRule([]string{placementv1beta1.GroupVersion.Group}, []string{placementv1beta1.GroupVersion.Version}, []string{clusterExternalRolloutProgressName}, &clusterScope),
+				},
+				{
+					Operations: []admv1.OperationType{admv1.Create, admv1.Update},
+					Rule:       createRule([]string{placementv1beta1.GroupVersion.Group}, []string{placementv1beta1.GroupVersion.Version}, []string{externalRolloutProgressName}, &namespacedScope),
+				},
+			},
+			TimeoutSeconds: longWebhookTimeout,
+		},
+		admv1.ValidatingWebhook{
+			Name:                    "fleet.envelope.validating",
+			ClientConfig:            w.createClientConfig(envelope.ValidationPath),
+			FailurePolicy:           &failFailurePolicy,
+			SideEffects:             &sideEffortsNone,
+			AdmissionReviewVersions: admissionReviewVersions,
+			Rules: []admv1.RuleWithOperations{
+				{
+					Operations: []admv1.OperationType{admv1.Create, admv1.Update},
+					Rule:       createRule([]string{placementv1beta1.GroupVersion.Group}, []string{placementv1beta1.GroupVersion.Version}, []string{clusterResourceEnvelopeName}, &clusterScope),
+				},
+				{
+					Operations: []admv1.OperationType{admv1.Create, admv1.Update},
+					Rule:       createRule([]string{placementv1beta1.GroupVersion.Group}, []string{placementv1beta1.GroupVersion.Version}, []string{resourceEnvelopeName}, &namespacedScope),
+				},
+			},
+			TimeoutSeconds: longWebhookTimeout,
+		},
 	)
 
 	return webHooks
@@ -9,14 +9,21 @@ import (
 	"github.com/google/go-cmp/cmp/cmpopts"
 	"github.com/stretchr/testify/assert"
 	admv1 "k8s.io/api/admissionregistration/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/client/fake"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
+	clusterv1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1"
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/cmd/hubagent/options"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/webhook/conversion"
 	testmanager "github.com/kubefleet-dev/kubefleet/test/utils/manager"
 )
 
@@ -33,7 +40,7 @@ func TestBuildFleetMutatingWebhooks(t *testing.T) {
 				serviceURL:           "test-url",
 				clientConnectionType: &url,
 			},
-			wantLength: 1,
+			wantLength: 3,
 		},
 	}
 
@@ -60,7 +67,7 @@ func TestBuildFleetValidatingWebhooks(t *testing.T) {
 				serviceURL:           "test-url",
 				clientConnectionType: &url,
 			},
-			wantLength: 9,
+			wantLength: 12,
 		},
 		"enable workload": {
 			config: Config{
@@ -70,7 +77,7 @@ func TestBuildFleetValidatingWebhooks(t *testing.T) {
 				clientConnectionType: &url,
 				enableWorkload:       true,
 			},
-			wantLength: 7,
+			wantLength: 10,
 		},
 		"enable PDBs": {
 			config: Config{
@@ -80,7 +87,7 @@ func TestBuildFleetValidatingWebhooks(t *testing.T) {
 				clientConnectionType: &url,
 				enablePDBs:           true,
 			},
-			wantLength: 8,
+			wantLength: 11,
 		},
 	}
 
@@ -188,7 +195,7 @@ func TestNewWebhookConfig(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			t.Setenv("POD_NAMESPACE", "test-namespace")
 
-			got, err := NewWebhookConfig(tt.mgr, tt.webhookServiceName, tt.port, tt.clientConnectionType, tt.certDir, tt.enableGuardRail, tt.denyModifyMemberClusterLabels, tt.enableWorkload, tt.enablePDBs, tt.useCertManager, "fleet-webhook-server-cert", nil, false)
+			got, err := NewWebhookConfig(tt.mgr, tt.webhookServiceName, tt.port, tt.clientConnectionType, tt.certDir, tt.enableGuardRail, tt.denyModifyMemberClusterLabels, tt.enableWorkload, tt.enablePDBs, tt.useCertManager, "fleet-webhook-server-cert", nil, false, false, false, 50, 1048576)
 			if (err != nil) != tt.wantErr {
 				t.Errorf("NewWebhookConfig() error = %v, wantErr %v", err, tt.wantErr)
 				return
@@ -232,6 +239,10 @@ func TestNewWebhookConfig_SelfSignedCertError(t *testing.T) {
 		"fleet-webhook-server-cert", // webhookCertName
 		nil,                         // whiteListedUsers
 		false,                       // networkingAgentsEnabled
+		false,                       // enableConversionWebhook
+		false,                       // denyMemberClusterDeleteWithPlacements
+		50,                          // envelopeMaxManifests
+		1048576,                     // envelopeMaxSizeBytes
 	)
 
 	if err == nil {
@@ -707,3 +718,139 @@ func TestCheckCAInjection(t *testing.T) {
 		})
 	}
 }
+
+func TestSetUpCRDConversion(t *testing.T) {
+	serviceConnectionType := options.Service
+	crd := func(name, group, kind string, versions ...string) *apiextensionsv1.CustomResourceDefinition {
+		crd := &apiextensionsv1.CustomResourceDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: name,
+			},
+			Spec: apiextensionsv1.CustomResourceDefinitionSpec{
+				Group: group,
+				Names: apiextensionsv1.CustomResourceDefinitionNames{
+					Kind: kind,
+				},
+			},
+		}
+		for _, v := range versions {
+			crd.Spec.Versions = append(crd.Spec.Versions, apiextensionsv1.CustomResourceDefinitionVersion{Name: v})
+		}
+		return crd
+	}
+	wantConversion := func(caBundle []byte) *apiextensionsv1.CustomResourceConversion {
+		return &apiextensionsv1.CustomResourceConversion{
+			Strategy: apiextensionsv1.WebhookConverter,
+			Webhook: &apiextensionsv1.WebhookConversion{
+				ClientConfig: &apiextensionsv1.WebhookClientConfig{
+					Service: &apiextensionsv1.ServiceReference{
+						Namespace: "fleet-system",
+						Name:      "fleet-webhook-service",
+						Path:      ptr.To(conversion.ConversionPath),
+						Port:      ptr.To(int32(443)),
+					},
+					CABundle: caBundle,
+				},
+				ConversionReviewVersions: []string{"v1"},
+			},
+		}
+	}
+
+	testCases := map[string]struct {
+		useCertManager  bool
+		wantConversions map[string]*apiextensionsv1.CustomResourceConversion
+		wantAnnotations map[string]map[string]string
+	}{
+		"self-signed certificates": {
+			wantConversions: map[string]*apiextensionsv1.CustomResourceConversion{
+				"clusterresourceplacements.placement.kubernetes-fleet.io": wantConversion([]byte("test-ca-bundle")),
+				"memberclusters.cluster.kubernetes-fleet.io":              wantConversion([]byte("test-ca-bundle")),
+				"resourceplacements.placement.kubernetes-fleet.io":        nil,
+				"clusterresourceenvelopes.placement.kubernetes-fleet.io":  nil,
+				"foos.example.com": nil,
+			},
+			wantAnnotations: map[string]map[string]string{},
+		},
+		"cert-manager": {
+			useCertManager: true,
+			wantConversions: map[string]*apiextensionsv1.CustomResourceConversion{
+				"clusterresourceplacements.placement.kubernetes-fleet.io": wantConversion(nil),
+				"memberclusters.cluster.kubernetes-fleet.io":              wantConversion(nil),
+				"resourceplacements.placement.kubernetes-fleet.io":        nil,
+				"clusterresourceenvelopes.placement.kubernetes-fleet.io":  nil,
+				"foos.example.com": nil,
+			},
+			wantAnnotations: map[string]map[string]string{
+				"clusterresourceplacements.placement.kubernetes-fleet.io": {
+					"cert-manager.io/inject-ca-from": "fleet-system/fleet-webhook-server-cert",
+				},
+				"memberclusters.cluster.kubernetes-fleet.io": {
+					"cert-manager.io/inject-ca-from": "fleet-system/fleet-webhook-server-cert",
+				},
+			},
+		},
+	}
+
+	for name, tc := range testCases {
+		t.Run(name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			for _, addToScheme := range []func(*runtime.Scheme) error{
+				apiextensionsv1.AddToScheme,
+				placementv1.AddToScheme,
+				placementv1beta1.AddToScheme,
+				clusterv1.AddToScheme,
+				clusterv1beta1.AddToScheme,
+			} {
+				if err := addToScheme(scheme); err != nil {
+					t.Fatalf("failed to add to scheme: %v", err)
+				}
+			}
+			fakeClient := fake.NewClientBuilder().
+				WithScheme(scheme).
+				WithObjects(
+					crd("clusterresourceplacements.placement.kubernetes-fleet.io", placementv1beta1.GroupVersion.Group, "ClusterResourcePlacement", "v1", "v1beta1"),
+					crd("memberclusters.cluster.kubernetes-fleet.io", clusterv1beta1.GroupVersion.Group, "MemberCluster", "v1", "v1beta1"),
+					// Served in a single API version only.
+					crd("resourceplacements.placement.kubernetes-fleet.io", placementv1beta1.GroupVersion.Group, "ResourcePlacement", "v1beta1"),
+					// Not registered in the v1 API version.
+					crd("clusterresourceenvelopes.placement.kubernetes-fleet.io", placementv1beta1.GroupVersion.Group, "ClusterResourceEnvelope", "v1", "v1beta1"),
+					// Not a KubeFleet CRD.
+					crd("foos.example.com", "example.com", "Foo", "v1", "v1beta1"),
+				).
+				Build()
+			config := Config{
+				mgr:                  &testmanager.FakeManager{Client: fakeClient},
+				useCertManager:       tc.useCertManager,
+				caPEM:                []byte("test-ca-bundle"),
+				serviceNamespace:     "fleet-system",
+				serviceName:          "fleet-webhook-service",
+				servicePort:          443,
+				clientConnectionType: &serviceConnectionType,
+				webhookCertName:      "fleet-webhook-server-cert",
+			}
+
+			if err := config.setUpCRDConversion(context.Background()); err != nil {
+				t.Fatalf("setUpCRDConversion() error = %v, want no error", err)
+			}
+
+			var crdList apiextensionsv1.CustomResourceDefinitionList
+			if err := fakeClient.List(context.Background(), &crdList); err != nil {
+				t.Fatalf("failed to list CRDs: %v", err)
+			}
+			gotConversions := map[string]*apiextensionsv1.CustomResourceConversion{}
+			gotAnnotations := map[string]map[string]string{}
+			for _, crd := range crdList.Items {
+				gotConversions[crd.Name] = crd.Spec.Conversion
+				if len(crd.Annotations) > 0 {
+					gotAnnotations[crd.Name] = crd.Annotations
+				}
+			}
+			if diff := cmp.Diff(tc.wantConversions, gotConversions); diff != "" {
+				t.Errorf("setUpCRDConversion() CRD conversions mismatch (-want +got):\n%s", diff)
+			}
+			if diff := cmp.Diff(tc.wantAnnotations, gotAnnotations); diff != "" {
+				t.Errorf("setUpCRDConversion() CRD annotations mismatch (-want +got):\n%s", diff)
+			}
+		})
+	}
+}
@@ -0,0 +1,359 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package chaos features a test harness that simulates member agents against a real hub cluster.
+//
+// Each FakeMemberAgent plays the hub-facing part of a member agent: it reports heartbeats on its
+// InternalMemberCluster object and marks the Work objects in its reserved namespace as applied and
+// available, without running any member cluster at all. Failure modes (slow applies, heartbeat loss,
+// and status update conflicts) can be injected at any time, so that tests can validate how the hub
+// controllers behave with a large number of flaky member clusters.
+//
+// The package is meant to be reused by downstream extension authors; see Fleet for a helper that
+// joins and runs a group of fake member agents.
+package chaos
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand/v2"
+	"strconv"
+	"sync"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/klog/v2"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+const (
+	// FakeAgentJoinedReason is the reason of the Joined condition reported by fake member agents.
+	FakeAgentJoinedReason = "FakeAgentJoined"
+	// FakeAgentLeftReason is the reason of the Joined condition reported by fake member agents after leaving.
+	FakeAgentLeftReason = "FakeAgentLeft"
+	// FakeAgentHealthyReason is the reason of the Healthy condition reported by fake member agents.
+	FakeAgentHealthyReason = "FakeAgentHealthy"
+
+	// ConflictBumpAnnotation is the annotation fake member agents update on an object to make their
+	// next status update of the object run into a conflict.
+	ConflictBumpAnnotation = "chaos.kubernetes-fleet.io/conflict-bump"
+
+	defaultHeartbeatPeriod = 5 * time.Second
+	defaultWorkSyncPeriod  = time.Second
+)
+
+// Faults describes the failure modes injected into a fake member agent.
+type Faults struct {
+	// ApplyDelay is the minimum time a fake member agent waits after observing a new generation
+	// of a Work object before it reports the Work as applied and available.
+	ApplyDelay time.Duration
+
+	// ApplyDelayJitter is the maximum random time added on top of ApplyDelay.
+	ApplyDelayJitter time.Duration
+
+	// HeartbeatLoss, if set, stops the fake member agent from reporting heartbeats; the hub will
+	// consider the member cluster unhealthy once the heartbeat expires.
+	HeartbeatLoss bool
+
+	// StatusConflictProbability is the probability, in the range [0, 1], that a status update made by
+	// the fake member agent runs into a conflict. The conflict is produced by the hub itself, as the
+	// agent bumps the object right before the update; the agent retries in its next sync.
+	StatusConflictProbability float64
+}
+
+// FakeMemberAgent simulates the hub-facing part of a member agent.
+type FakeMemberAgent struct {
+	// HubClient is the client the fake member agent uses to talk to the hub cluster.
+	HubClient client.Client
+
+	// ClusterName is the name of the member cluster the fake member agent represents.
+	ClusterName string
+
+	// HeartbeatPeriod is the period between two heartbeats; it defaults to 5 seconds.
+	HeartbeatPeriod time.Duration
+
+	// WorkSyncPeriod is the period between two scans of the Work objects in the reserved namespace
+	// of the member cluster; it defaults to 1 second.
+	WorkSyncPeriod time.Duration
+
+	// Properties are the properties reported by the fake member agent.
+	Properties map[clusterv1beta1.PropertyName]clusterv1beta1.PropertyValue
+
+	mu     sync.Mutex
+	faults Faults
+	// firstSeen tracks when the fake member agent first observed each generation of a Work object,
+	// which is used to simulate slow applies.
+	firstSeen map[types.NamespacedName]workGenerationSeen
+	// conflicts counts the status update conflicts the fake member agent has run into.
+	conflicts int
+}
+
+type workGenerationSeen struct {
+	generation int64
+	at         time.Time
+	delay      time.Duration
+}
+
+// SetFaults replaces the failure modes injected into the fake member agent; it is safe to call
+// while the agent is running.
+func (a *FakeMemberAgent) SetFaults(faults Faults) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	a.faults = faults
+}
+
+// Faults returns the failure modes currently injected into the fake member agent.
+func (a *FakeMemberAgent) Faults() Faults {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.faults
+}
+
+// Conflicts returns the number of status update conflicts the fake member agent has run into.
+func (a *FakeMemberAgent) Conflicts() int {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	return a.conflicts
+}
+
+// Start runs the fake member agent until the context is cancelled.
+func (a *FakeMemberAgent) Start(ctx context.Context) error {
+	klog.InfoS("Starting the fake member agent", "cluster", a.ClusterName)
+	defer klog.InfoS("The fake member agent is stopped", "cluster", a.ClusterName)
+
+	heartbeatPeriod := a.HeartbeatPeriod
+	if heartbeatPeriod <= 0 {
+		heartbeatPeriod = defaultHeartbeatPeriod
+	}
+	workSyncPeriod := a.WorkSyncPeriod
+	if workSyncPeriod <= 0 {
+		workSyncPeriod = defaultWorkSyncPeriod
+	}
+
+	var wg sync.WaitGroup
+	wg.Add(2)
+	go func() {
+		defer wg.Done()
+		wait.UntilWithContext(ctx, func(ctx context.Context) {
+			if err := a.heartbeat(ctx); err != nil {
+				klog.V(2).InfoS("Failed to report heartbeat", "cluster", a.ClusterName, "error", err)
+			}
+		}, heartbeatPeriod)
+	}()
+	go func() {
+		defer wg.Done()
+		wait.UntilWithContext(ctx, func(ctx context.Context) {
+			if err := a.syncWorks(ctx); err != nil {
+				klog.V(2).InfoS("Failed to sync works", "cluster", a.ClusterName, "error", err)
+			}
+		}, workSyncPeriod)
+	}()
+	wg.Wait()
+	return nil
+}
+
+// heartbeat reports the status of the fake member agent on its InternalMemberCluster object.
+func (a *FakeMemberAgent) heartbeat(ctx context.Context) error {
+	if a.Faults().HeartbeatLoss {
+		klog.V(2).InfoS("Skipping heartbeat as heartbeat loss is injected", "cluster", a.ClusterName)
+		return nil
+	}
+
+	imc := &clusterv1beta1.InternalMemberCluster{}
+	imcKey := types.NamespacedName{Namespace: fmt.Sprintf(utils.NamespaceNameFormat, a.ClusterName), Name: a.ClusterName}
+	if err := a.HubClient.Get(ctx, imcKey, imc); err != nil {
+		return fmt.Errorf("failed to get internalMemberCluster %s: %w", imcKey, err)
+	}
+
+	joinedCond := metav1.Condition{
+		Type:               string(clusterv1beta1.AgentJoined),
+		Status:             metav1.ConditionTrue,
+		Reason:             FakeAgentJoinedReason,
+		ObservedGeneration: imc.Generation,
+	}
+	if imc.Spec.State == clusterv1beta1.ClusterStateLeave {
+		joinedCond.Status = metav1.ConditionFalse
+		joinedCond.Reason = FakeAgentLeftReason
+	}
+	imc.SetConditionsWithType(clusterv1beta1.MemberAgent, joinedCond, metav1.Condition{
+		Type:               string(clusterv1beta1.AgentHealthy),
+		Status:             metav1.ConditionTrue,
+		Reason:             FakeAgentHealthyReason,
+		ObservedGeneration: imc.Generation,
+	})
+	imc.GetAgentStatus(clusterv1beta1.MemberAgent).LastReceivedHeartbeat = metav1.Now()
+	if a.Properties != nil {
+		imc.Status.Properties = a.Properties
+	}
+	return a.updateStatus(ctx, imc)
+}
+
+// syncWorks reports the status of the Work objects in the reserved namespace of the member cluster.
+func (a *FakeMemberAgent) syncWorks(ctx context.Context) error {
+	workList := &placementv1beta1.WorkList{}
+	if err := a.HubClient.List(ctx, workList, client.InNamespace(fmt.Sprintf(utils.NamespaceNameFormat, a.ClusterName))); err != nil {
+		return fmt.Errorf("failed to list works: %w", err)
+	}
+
+	var errs []error
+	seen := make(map[types.NamespacedName]bool, len(workList.Items))
+	for i := range workList.Items {
+		work := &workList.Items[i]
+		seen[client.ObjectKeyFromObject(work)] = true
+		if work.DeletionTimestamp != nil || !a.isApplyDue(work) {
+			continue
+		}
+		if condition.IsConditionStatusTrue(meta.FindStatusCondition(work.Status.Conditions, placementv1beta1.WorkConditionTypeAvailable), work.Generation) {
+			continue
+		}
+		if err := a.markWorkAvailable(ctx, work); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	// Forget the works that no longer exist.
+	a.mu.Lock()
+	for key := range a.firstSeen {
+		if !seen[key] {
+			delete(a.firstSeen, key)
+		}
+	}
+	a.mu.Unlock()
+	return errors.Join(errs...)
+}
+
+// isApplyDue returns true if the simulated apply of the current generation of a Work object has completed.
+func (a *FakeMemberAgent) isApplyDue(work *placementv1beta1.Work) bool {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+	if a.firstSeen == nil {
+		a.firstSeen = make(map[types.NamespacedName]workGenerationSeen)
+	}
+	key := client.ObjectKeyFromObject(work)
+	seen, ok := a.firstSeen[key]
+	if !ok || seen.generation != work.Generation {
+		delay := a.faults.ApplyDelay
+		if a.faults.ApplyDelayJitter > 0 {
+			delay += rand.N(a.faults.ApplyDelayJitter)
+		}
+		seen = workGenerationSeen{generation: work.Generation, at: time.Now(), delay: delay}
+		a.firstSeen[key] = seen
+	}
+	return time.Since(seen.at) >= seen.delay
+}
+
+// markWorkAvailable reports a Work object, and all of its manifests, as applied and available.
+func (a *FakeMemberAgent) markWorkAvailable(ctx context.Context, work *placementv1beta1.Work) error {
+	manifestConds := make([]placementv1beta1.ManifestCondition, 0, len(work.Spec.Workload.Manifests))
+	for i, manifest := range work.Spec.Workload.Manifests {
+		identifier, err := manifestIdentifier(i, manifest)
+		if err != nil {
+			return fmt.Errorf("failed to decode manifest %d of work %s: %w", i, klog.KObj(work), err)
+		}
+		manifestConds = append(manifestConds, placementv1beta1.ManifestCondition{
+			Identifier: identifier,
+			Conditions: []metav1.Condition{
+				{
+					Type:               placementv1beta1.WorkConditionTypeApplied,
+					Status:             metav1.ConditionTrue,
+					Reason:             string(placementv1beta1.WorkConditionTypeApplied),
+					ObservedGeneration: work.Generation,
+					LastTransitionTime: metav1.Now(),
+				},
+				{
+					Type:               placementv1beta1.WorkConditionTypeAvailable,
+					Status:             metav1.ConditionTrue,
+					Reason:             string(placementv1beta1.WorkConditionTypeAvailable),
+					ObservedGeneration: work.Generation,
+					LastTransitionTime: metav1.Now(),
+				},
+			},
+		})
+	}
+	work.Status.ManifestConditions = manifestConds
+	meta.SetStatusCondition(&work.Status.Conditions, metav1.Condition{
+		Type:               placementv1beta1.WorkConditionTypeApplied,
+		Status:             metav1.ConditionTrue,
+		Reason:             condition.WorkAllManifestsAppliedReason,
+		ObservedGeneration: work.Generation,
+	})
+	meta.SetStatusCondition(&work.Status.Conditions, metav1.Condition{
+		Type:               placementv1beta1.WorkConditionTypeAvailable,
+		Status:             metav1.ConditionTrue,
+		Reason:             condition.WorkAllManifestsAvailableReason,
+		ObservedGeneration: work.Generation,
+	})
+	return a.updateStatus(ctx, work)
+}
+
+// updateStatus updates the status of an object on the hub cluster, running into a conflict first
+// if status update conflicts are injected.
+func (a *FakeMemberAgent) updateStatus(ctx context.Context, obj client.Object) error {
+	if p := a.Faults().StatusConflictProbability; p > 0 && rand.Float64() < p {
+		// Bump the object via an annotation so that the update below carries a stale resource version
+		// and gets rejected by the hub.
+		bumped, ok := obj.DeepCopyObject().(client.Object)
+		if !ok {
+			return fmt.Errorf("failed to copy object %s", klog.KObj(obj))
+		}
+		annotations := bumped.GetAnnotations()
+		if annotations == nil {
+			annotations = make(map[string]string)
+		}
+		annotations[ConflictBumpAnnotation] = strconv.FormatInt(time.Now().UnixNano(), 10)
+		bumped.SetAnnotations(annotations)
+		if err := a.HubClient.Update(ctx, bumped); err != nil {
+			return fmt.Errorf("failed to bump object %s: %w", klog.KObj(obj), err)
+		}
+	}
+
+	err := a.HubClient.Status().Update(ctx, obj)
+	if apierrors.IsConflict(err) {
+		a.mu.Lock()
+		a.conflicts++
+		a.mu.Unlock()
+	}
+	return err
+}
+
+// manifestIdentifier builds the identifier of a manifest in a Work object.
+func manifestIdentifier(ordinal int, manifest placementv1beta1.Manifest) (placementv1beta1.WorkResourceIdentifier, error) {
+	var obj unstructured.Unstructured
+	if err := json.Unmarshal(manifest.Raw, &obj.Object); err != nil {
+		return placementv1beta1.WorkResourceIdentifier{}, err
+	}
+	gvk := obj.GroupVersionKind()
+	return placementv1beta1.WorkResourceIdentifier{
+		Ordinal:   ordinal,
+		Group:     gvk.Group,
+		Version:   gvk.Version,
+		Kind:      gvk.Kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+	}, nil
+}
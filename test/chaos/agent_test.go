@@ -0,0 +1,215 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+const (
+	clusterName  = "member-1"
+	reservedNS   = "fleet-member-member-1"
+	workName     = "work-1"
+	configMapRaw = `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"cm","namespace":"app"}}`
+)
+
+func newTestClient(t *testing.T, objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add cluster scheme: %v", err)
+	}
+	if err := placementv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add placement scheme: %v", err)
+	}
+	return fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&clusterv1beta1.InternalMemberCluster{}, &placementv1beta1.Work{}).
+		Build()
+}
+
+// TestHeartbeat tests the heartbeat method.
+func TestHeartbeat(t *testing.T) {
+	testCases := []struct {
+		name           string
+		state          clusterv1beta1.ClusterState
+		faults         Faults
+		wantConditions []metav1.Condition
+		wantConflicts  int
+		wantErred      bool
+	}{
+		{
+			name:  "joined",
+			state: clusterv1beta1.ClusterStateJoin,
+			wantConditions: []metav1.Condition{
+				{Type: string(clusterv1beta1.AgentJoined), Status: metav1.ConditionTrue, Reason: FakeAgentJoinedReason, ObservedGeneration: 1},
+				{Type: string(clusterv1beta1.AgentHealthy), Status: metav1.ConditionTrue, Reason: FakeAgentHealthyReason, ObservedGeneration: 1},
+			},
+		},
+		{
+			name:  "left",
+			state: clusterv1beta1.ClusterStateLeave,
+			wantConditions: []metav1.Condition{
+				{Type: string(clusterv1beta1.AgentJoined), Status: metav1.ConditionFalse, Reason: FakeAgentLeftReason, ObservedGeneration: 1},
+				{Type: string(clusterv1beta1.AgentHealthy), Status: metav1.ConditionTrue, Reason: FakeAgentHealthyReason, ObservedGeneration: 1},
+			},
+		},
+		{
+			name:   "heartbeat loss",
+			state:  clusterv1beta1.ClusterStateJoin,
+			faults: Faults{HeartbeatLoss: true},
+		},
+		{
+			name:          "status update conflict",
+			state:         clusterv1beta1.ClusterStateJoin,
+			faults:        Faults{StatusConflictProbability: 1},
+			wantConflicts: 1,
+			wantErred:     true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			imc := &clusterv1beta1.InternalMemberCluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       clusterName,
+					Namespace:  reservedNS,
+					Generation: 1,
+				},
+				Spec: clusterv1beta1.InternalMemberClusterSpec{
+					State:                  tc.state,
+					HeartbeatPeriodSeconds: 5,
+				},
+			}
+			hubClient := newTestClient(t, imc)
+			agent := &FakeMemberAgent{HubClient: hubClient, ClusterName: clusterName}
+			agent.SetFaults(tc.faults)
+
+			err := agent.heartbeat(context.Background())
+			if gotErred := err != nil; gotErred != tc.wantErred {
+				t.Fatalf("heartbeat() = %v, want erred %t", err, tc.wantErred)
+			}
+			if tc.wantErred && !apierrors.IsConflict(err) {
+				t.Fatalf("heartbeat() = %v, want a conflict error", err)
+			}
+			if got := agent.Conflicts(); got != tc.wantConflicts {
+				t.Errorf("Conflicts() = %d, want %d", got, tc.wantConflicts)
+			}
+
+			gotIMC := &clusterv1beta1.InternalMemberCluster{}
+			if err := hubClient.Get(context.Background(), types.NamespacedName{Namespace: reservedNS, Name: clusterName}, gotIMC); err != nil {
+				t.Fatalf("failed to get internalMemberCluster: %v", err)
+			}
+			var gotConditions []metav1.Condition
+			if agentStatus := gotIMC.GetAgentStatus(clusterv1beta1.MemberAgent); agentStatus != nil {
+				gotConditions = agentStatus.Conditions
+				if len(gotConditions) > 0 && agentStatus.LastReceivedHeartbeat.IsZero() {
+					t.Errorf("heartbeat() did not set the last received heartbeat")
+				}
+			}
+			if diff := cmp.Diff(gotConditions, tc.wantConditions, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime"), cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("agent conditions mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
+
+// TestSyncWorks tests the syncWorks method.
+func TestSyncWorks(t *testing.T) {
+	availableConditions := []metav1.Condition{
+		{Type: placementv1beta1.WorkConditionTypeApplied, Status: metav1.ConditionTrue, Reason: condition.WorkAllManifestsAppliedReason, ObservedGeneration: 2},
+		{Type: placementv1beta1.WorkConditionTypeAvailable, Status: metav1.ConditionTrue, Reason: condition.WorkAllManifestsAvailableReason, ObservedGeneration: 2},
+	}
+
+	testCases := []struct {
+		name                   string
+		faults                 Faults
+		wantConditions         []metav1.Condition
+		wantManifestIdentifier *placementv1beta1.WorkResourceIdentifier
+	}{
+		{
+			name:           "work applied",
+			wantConditions: availableConditions,
+			wantManifestIdentifier: &placementv1beta1.WorkResourceIdentifier{
+				Version:   "v1",
+				Kind:      "ConfigMap",
+				Namespace: "app",
+				Name:      "cm",
+			},
+		},
+		{
+			name:   "slow apply",
+			faults: Faults{ApplyDelay: time.Hour},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			work := &placementv1beta1.Work{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:       workName,
+					Namespace:  reservedNS,
+					Generation: 2,
+				},
+				Spec: placementv1beta1.WorkSpec{
+					Workload: placementv1beta1.WorkloadTemplate{
+						Manifests: []placementv1beta1.Manifest{
+							{RawExtension: runtime.RawExtension{Raw: []byte(configMapRaw)}},
+						},
+					},
+				},
+			}
+			hubClient := newTestClient(t, work)
+			agent := &FakeMemberAgent{HubClient: hubClient, ClusterName: clusterName}
+			agent.SetFaults(tc.faults)
+
+			if err := agent.syncWorks(context.Background()); err != nil {
+				t.Fatalf("syncWorks() = %v, want no error", err)
+			}
+
+			gotWork := &placementv1beta1.Work{}
+			if err := hubClient.Get(context.Background(), types.NamespacedName{Namespace: reservedNS, Name: workName}, gotWork); err != nil {
+				t.Fatalf("failed to get work: %v", err)
+			}
+			if diff := cmp.Diff(gotWork.Status.Conditions, tc.wantConditions, cmpopts.IgnoreFields(metav1.Condition{}, "LastTransitionTime")); diff != "" {
+				t.Errorf("work conditions mismatch (-got, +want):\n%s", diff)
+			}
+			var gotManifestIdentifier *placementv1beta1.WorkResourceIdentifier
+			if len(gotWork.Status.ManifestConditions) > 0 {
+				gotManifestIdentifier = &gotWork.Status.ManifestConditions[0].Identifier
+			}
+			if diff := cmp.Diff(gotManifestIdentifier, tc.wantManifestIdentifier); diff != "" {
+				t.Errorf("manifest identifier mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+}
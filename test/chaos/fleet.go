@@ -0,0 +1,181 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package chaos
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+)
+
+const (
+	// FakeMemberClusterLabel is the label added to the MemberCluster objects created by Fleet.
+	FakeMemberClusterLabel = "chaos.kubernetes-fleet.io/fake-member-cluster"
+
+	defaultIdentityName      = "fleet-chaos-agent"
+	defaultIdentityNamespace = "fleet-system"
+)
+
+// FleetOptions configures a Fleet.
+type FleetOptions struct {
+	// NamePrefix is the prefix of the names of the member clusters; the clusters are named
+	// <NamePrefix>-<index>.
+	NamePrefix string
+
+	// Size is the number of member clusters.
+	Size int
+
+	// HeartbeatPeriod is the heartbeat period of the member clusters; it defaults to 5 seconds.
+	HeartbeatPeriod time.Duration
+
+	// WorkSyncPeriod is the period between two scans of the Work objects by each fake member agent;
+	// it defaults to 1 second.
+	WorkSyncPeriod time.Duration
+
+	// Labels are the labels added to the MemberCluster objects.
+	Labels map[string]string
+}
+
+// Fleet joins a group of member clusters to a hub cluster and runs a fake member agent for each of them.
+type Fleet struct {
+	hubClient client.Client
+	opts      FleetOptions
+	agents    []*FakeMemberAgent
+
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewFleet returns a Fleet of fake member agents; the agents are not started until Start is called.
+func NewFleet(hubClient client.Client, opts FleetOptions) *Fleet {
+	if opts.HeartbeatPeriod <= 0 {
+		opts.HeartbeatPeriod = defaultHeartbeatPeriod
+	}
+	f := &Fleet{
+		hubClient: hubClient,
+		opts:      opts,
+		agents:    make([]*FakeMemberAgent, 0, opts.Size),
+	}
+	for i := 0; i < opts.Size; i++ {
+		f.agents = append(f.agents, &FakeMemberAgent{
+			HubClient:       hubClient,
+			ClusterName:     fmt.Sprintf("%s-%d", opts.NamePrefix, i),
+			HeartbeatPeriod: opts.HeartbeatPeriod,
+			WorkSyncPeriod:  opts.WorkSyncPeriod,
+		})
+	}
+	return f
+}
+
+// Agents returns the fake member agents of the fleet.
+func (f *Fleet) Agents() []*FakeMemberAgent {
+	return f.agents
+}
+
+// ClusterNames returns the names of the member clusters of the fleet.
+func (f *Fleet) ClusterNames() []string {
+	names := make([]string, 0, len(f.agents))
+	for _, a := range f.agents {
+		names = append(names, a.ClusterName)
+	}
+	return names
+}
+
+// SetFaults injects the same failure modes into all the fake member agents of the fleet.
+func (f *Fleet) SetFaults(faults Faults) {
+	for _, a := range f.agents {
+		a.SetFaults(faults)
+	}
+}
+
+// Start creates the MemberCluster objects of the fleet on the hub cluster, and runs the fake member
+// agents in the background until Stop is called or the context is cancelled.
+func (f *Fleet) Start(ctx context.Context) error {
+	for _, a := range f.agents {
+		if err := f.createMemberCluster(ctx, a.ClusterName); err != nil {
+			return err
+		}
+	}
+
+	ctx, f.cancel = context.WithCancel(ctx)
+	for _, a := range f.agents {
+		f.wg.Add(1)
+		go func(a *FakeMemberAgent) {
+			defer f.wg.Done()
+			_ = a.Start(ctx)
+		}(a)
+	}
+	return nil
+}
+
+// Stop stops all the fake member agents of the fleet; the MemberCluster objects are left as they are.
+func (f *Fleet) Stop() {
+	if f.cancel != nil {
+		f.cancel()
+	}
+	f.wg.Wait()
+}
+
+// Delete deletes the MemberCluster objects of the fleet from the hub cluster. The fake member agents
+// should still be running so that they can leave the fleet.
+func (f *Fleet) Delete(ctx context.Context) error {
+	var errs []error
+	for _, a := range f.agents {
+		mc := &clusterv1beta1.MemberCluster{ObjectMeta: metav1.ObjectMeta{Name: a.ClusterName}}
+		if err := f.hubClient.Delete(ctx, mc); err != nil && !apierrors.IsNotFound(err) {
+			errs = append(errs, fmt.Errorf("failed to delete memberCluster %s: %w", a.ClusterName, err))
+		}
+	}
+	return errors.Join(errs...)
+}
+
+// createMemberCluster creates a MemberCluster object on the hub cluster, if it does not exist yet.
+func (f *Fleet) createMemberCluster(ctx context.Context, name string) error {
+	labels := make(map[string]string, len(f.opts.Labels)+1)
+	for k, v := range f.opts.Labels {
+		labels[k] = v
+	}
+	labels[FakeMemberClusterLabel] = "true"
+
+	mc := &clusterv1beta1.MemberCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: labels,
+		},
+		Spec: clusterv1beta1.MemberClusterSpec{
+			Identity: rbacv1.Subject{
+				Name:      defaultIdentityName,
+				Kind:      rbacv1.ServiceAccountKind,
+				Namespace: defaultIdentityNamespace,
+			},
+			HeartbeatPeriodSeconds: int32(f.opts.HeartbeatPeriod / time.Second),
+		},
+	}
+	if err := f.hubClient.Create(ctx, mc); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create memberCluster %s: %w", name, err)
+	}
+	return nil
+}
@@ -50,7 +50,7 @@ var _ = Describe("validating RP for taint and toleration features", Label("resou
 		ensureCRPAndRelatedResourcesDeleted(crpName, allMemberClusters)
 	})
 
-	Describe("placing resource using a resource placement with pickFixed placement policy specified, taint clusters, pick all specified clusters", Serial, Ordered, func() {
+	Describe("placing resource using a resource placement with pickFixed placement policy specified, taint clusters, tolerate taints, pick all specified clusters", Serial, Ordered, func() {
 		It("should wait for namespace collection to sync on all member clusters", func() {
 			waitForNamespaceCollectionOnClusters(appNamespace().Name, allMemberClusterNames)
 		})
@@ -75,6 +75,7 @@ var _ = Describe("validating RP for taint and toleration features", Label("resou
 					Policy: &placementv1beta1.PlacementPolicy{
 						PlacementType: placementv1beta1.PickFixedPlacementType,
 						ClusterNames:  allMemberClusterNames,
+						Tolerations:   buildTolerations(allMemberClusterNames),
 					},
 					Strategy: placementv1beta1.RolloutStrategy{
 						Type: placementv1beta1.RollingUpdateRolloutStrategyType,
@@ -29,6 +29,12 @@ export USE_PREDEFINED_REGIONS="${USE_PREDEFINED_REGIONS:-false}"
 export RESOURCE_SNAPSHOT_CREATION_MINIMUM_INTERVAL="${RESOURCE_SNAPSHOT_CREATION_MINIMUM_INTERVAL:-0m}"
 export RESOURCE_CHANGES_COLLECTION_DURATION="${RESOURCE_CHANGES_COLLECTION_DURATION:-0m}"
 export CERT_MANAGER_VERSION="${CERT_MANAGER_VERSION:-v1.16.2}"
+# The faults injected into the communication between the member agents and the hub cluster; no
+# fault is injected by default. See the member agent chart for the format of each setting; note that
+# the commas in the partition periods are escaped when passed to Helm.
+export FAULT_INJECTION_HUB_LATENCY="${FAULT_INJECTION_HUB_LATENCY:-}"
+export FAULT_INJECTION_HUB_PARTITIONS="${FAULT_INJECTION_HUB_PARTITIONS:-}"
+export FAULT_INJECTION_CLOCK_SKEW="${FAULT_INJECTION_CLOCK_SKEW:-}"
 
 # The pre-defined regions; if the AKS property provider is used.
 #
@@ -227,6 +233,9 @@ do
             --set propertyProvider=$PROPERTY_PROVIDER \
             --set region=${REGIONS[$i]} \
             --set enableNamespaceCollectionInPropertyProvider=true \
+            --set-string faultInjection.hubLatency="$FAULT_INJECTION_HUB_LATENCY" \
+            --set-string faultInjection.hubPartitions="${FAULT_INJECTION_HUB_PARTITIONS//,/\\,}" \
+            --set-string faultInjection.clockSkew="$FAULT_INJECTION_CLOCK_SKEW" \
             $( [ "$PROPERTY_PROVIDER" = "azure" ] && echo "-f azure_valid_config.yaml" )
     else
         helm install member-agent ../../charts/member-agent/ \
@@ -248,6 +257,9 @@ do
             --set workApplierRequeueRateLimiterMaxFastBackoffDelaySeconds=5 \
             --set propertyProvider=$PROPERTY_PROVIDER \
             --set enableNamespaceCollectionInPropertyProvider=true \
+            --set-string faultInjection.hubLatency="$FAULT_INJECTION_HUB_LATENCY" \
+            --set-string faultInjection.hubPartitions="${FAULT_INJECTION_HUB_PARTITIONS//,/\\,}" \
+            --set-string faultInjection.clockSkew="$FAULT_INJECTION_CLOCK_SKEW" \
             $( [ "$PROPERTY_PROVIDER" = "azure" ] && echo "-f azure_valid_config.yaml" )
     fi
 done
@@ -208,7 +208,7 @@ var (
 	ignoreConditionObservedGenerationField      = cmpopts.IgnoreFields(metav1.Condition{}, "ObservedGeneration")
 
 	ignoreConditionReasonField                                  = cmpopts.IgnoreFields(metav1.Condition{}, "Reason")
-	ignoreAgentStatusHeartbeatField                             = cmpopts.IgnoreFields(clusterv1beta1.AgentStatus{}, "LastReceivedHeartbeat")
+	ignoreAgentStatusHeartbeatField                             = cmpopts.IgnoreFields(clusterv1beta1.AgentStatus{}, "LastReceivedHeartbeat", "Version", "GitCommit")
 	ignoreNamespaceStatusField                                  = cmpopts.IgnoreFields(corev1.Namespace{}, "Status")
 	ignoreNamespaceSpecField                                    = cmpopts.IgnoreFields(corev1.Namespace{}, "Spec")
 	ignoreClusterNameField                                      = cmpopts.IgnoreFields(placementv1beta1.PerClusterPlacementStatus{}, "ClusterName")
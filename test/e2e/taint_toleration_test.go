@@ -28,7 +28,7 @@ import (
 	"github.com/kubefleet-dev/kubefleet/test/e2e/framework"
 )
 
-var _ = Describe("placing resource using a cluster resource placement with pickFixed placement policy specified, taint clusters, pick all specified clusters", Serial, Ordered, func() {
+var _ = Describe("placing resource using a cluster resource placement with pickFixed placement policy specified, taint clusters, tolerate taints, pick all specified clusters", Serial, Ordered, func() {
 	crpName := fmt.Sprintf(crpNameTemplate, GinkgoParallelProcess())
 
 	BeforeAll(func() {
@@ -50,6 +50,7 @@ var _ = Describe("placing resource using a cluster resource placement with pickF
 				Policy: &placementv1beta1.PlacementPolicy{
 					PlacementType: placementv1beta1.PickFixedPlacementType,
 					ClusterNames:  allMemberClusterNames,
+					Tolerations:   buildTolerations(allMemberClusterNames),
 				},
 			},
 		}
@@ -0,0 +1,194 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package perf
+
+import (
+	"fmt"
+	"math"
+	"slices"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	dto "github.com/prometheus/client_model/go"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+)
+
+const (
+	appNamespaceNamePrefix = "perf-app"
+	configMapName          = "perf-config"
+	crpNamePrefix          = "perf-crp"
+
+	schedulingCycleDurationMetricName = "scheduling_cycle_duration_milliseconds"
+)
+
+var _ = Describe("performance budgets", Ordered, func() {
+	BeforeAll(func() {
+		By("creating the resources and the placements")
+		for i := 0; i < placementCount; i++ {
+			ns := &corev1.Namespace{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf("%s-%d", appNamespaceNamePrefix, i),
+				},
+			}
+			Expect(hubClient.Create(ctx, ns)).To(Succeed(), "Failed to create namespace %s", ns.Name)
+			cm := &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Namespace: ns.Name,
+					Name:      configMapName,
+				},
+				Data: map[string]string{
+					"data": "test",
+				},
+			}
+			Expect(hubClient.Create(ctx, cm)).To(Succeed(), "Failed to create config map %s/%s", cm.Namespace, cm.Name)
+
+			crp := &placementv1beta1.ClusterResourcePlacement{
+				ObjectMeta: metav1.ObjectMeta{
+					Name: fmt.Sprintf("%s-%d", crpNamePrefix, i),
+				},
+				Spec: placementv1beta1.PlacementSpec{
+					ResourceSelectors: []placementv1beta1.ResourceSelectorTerm{
+						{
+							Group:   "",
+							Version: "v1",
+							Kind:    "Namespace",
+							Name:    ns.Name,
+						},
+					},
+					Policy: &placementv1beta1.PlacementPolicy{
+						PlacementType:    placementv1beta1.PickNPlacementType,
+						NumberOfClusters: ptr.To(int32(clustersPerPlacement)),
+					},
+				},
+			}
+			Expect(hubClient.Create(ctx, crp)).To(Succeed(), "Failed to create CRP %s", crp.Name)
+		}
+	})
+
+	It("should apply all placements within the end-to-end latency budget", func() {
+		Eventually(allPlacementsAppliedActual, appliedLatencyP99Limit*2, eventuallyInterval).Should(Succeed(), "Failed to apply all placements")
+
+		crpList := &placementv1beta1.ClusterResourcePlacementList{}
+		Expect(hubClient.List(ctx, crpList)).To(Succeed(), "Failed to list CRPs")
+		latencies := make([]time.Duration, 0, len(crpList.Items))
+		for i := range crpList.Items {
+			crp := &crpList.Items[i]
+			appliedCond := crp.GetCondition(string(placementv1beta1.ClusterResourcePlacementAppliedConditionType))
+			Expect(appliedCond).NotTo(BeNil(), "CRP %s has no applied condition", crp.Name)
+			latencies = append(latencies, appliedCond.LastTransitionTime.Sub(crp.CreationTimestamp.Time))
+		}
+		slices.Sort(latencies)
+		p50, p99 := durationQuantile(latencies, 0.5), durationQuantile(latencies, 0.99)
+		AddReportEntry("end-to-end applied latency", fmt.Sprintf("p50=%s, p99=%s", p50, p99))
+		Expect(p99).To(BeNumerically("<=", appliedLatencyP99Limit), "The p99 end-to-end applied latency exceeds the budget")
+	})
+
+	It("should run scheduling cycles within the latency budget", func() {
+		p99, err := schedulingCycleDurationQuantile(0.99)
+		Expect(err).ToNot(HaveOccurred(), "Failed to compute the p99 scheduling cycle duration")
+		AddReportEntry("scheduling cycle duration", fmt.Sprintf("p99<=%s", p99))
+		Expect(p99).To(BeNumerically("<=", schedulingCycleP99Limit), "The p99 scheduling cycle duration exceeds the budget")
+	})
+
+	It("should keep the hub agent memory usage within the budget", func() {
+		peakHeapInUseMu.Lock()
+		peak := peakHeapInUseBytes
+		peakHeapInUseMu.Unlock()
+		AddReportEntry("peak heap memory in use", resource.NewQuantity(int64(peak), resource.BinarySI).String())
+		Expect(peak).To(BeNumerically("<=", hubMemoryLimit.Value()), "The peak heap memory in use exceeds the budget")
+	})
+})
+
+// allPlacementsAppliedActual checks that all the placements have been applied on their selected clusters.
+func allPlacementsAppliedActual() error {
+	crpList := &placementv1beta1.ClusterResourcePlacementList{}
+	if err := hubClient.List(ctx, crpList); err != nil {
+		return fmt.Errorf("failed to list CRPs: %w", err)
+	}
+	applied := 0
+	for i := range crpList.Items {
+		crp := &crpList.Items[i]
+		appliedCond := meta.FindStatusCondition(crp.Status.Conditions, string(placementv1beta1.ClusterResourcePlacementAppliedConditionType))
+		if condition.IsConditionStatusTrue(appliedCond, crp.Generation) {
+			applied++
+		}
+	}
+	if applied != placementCount {
+		return fmt.Errorf("%d out of %d CRPs have been applied", applied, placementCount)
+	}
+	return nil
+}
+
+// durationQuantile returns the q-quantile of the given durations, which must be sorted.
+func durationQuantile(sorted []time.Duration, q float64) time.Duration {
+	if len(sorted) == 0 {
+		return 0
+	}
+	idx := int(math.Ceil(q*float64(len(sorted)))) - 1
+	return sorted[max(idx, 0)]
+}
+
+// schedulingCycleDurationQuantile returns the upper bound of the histogram bucket in which the
+// q-quantile of the scheduling cycle durations falls, across all the label values.
+func schedulingCycleDurationQuantile(q float64) (time.Duration, error) {
+	mfs, err := metrics.Registry.Gather()
+	if err != nil {
+		return 0, fmt.Errorf("failed to gather metrics: %w", err)
+	}
+	idx := slices.IndexFunc(mfs, func(mf *dto.MetricFamily) bool {
+		return mf.GetName() == schedulingCycleDurationMetricName
+	})
+	if idx < 0 {
+		return 0, fmt.Errorf("metric %s is not found", schedulingCycleDurationMetricName)
+	}
+
+	var total uint64
+	cumulativeCounts := map[float64]uint64{}
+	for _, m := range mfs[idx].GetMetric() {
+		h := m.GetHistogram()
+		total += h.GetSampleCount()
+		for _, b := range h.GetBucket() {
+			cumulativeCounts[b.GetUpperBound()] += b.GetCumulativeCount()
+		}
+	}
+	if total == 0 {
+		return 0, fmt.Errorf("metric %s has no samples", schedulingCycleDurationMetricName)
+	}
+
+	upperBounds := make([]float64, 0, len(cumulativeCounts))
+	for ub := range cumulativeCounts {
+		upperBounds = append(upperBounds, ub)
+	}
+	slices.Sort(upperBounds)
+	rank := uint64(math.Ceil(q * float64(total)))
+	for _, ub := range upperBounds {
+		if cumulativeCounts[ub] >= rank {
+			return time.Duration(ub * float64(time.Millisecond)), nil
+		}
+	}
+	// The quantile falls in the +Inf bucket.
+	return time.Duration(math.MaxInt64), nil
+}
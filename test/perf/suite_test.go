@@ -0,0 +1,278 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package perf features a performance test suite that verifies the hub agent stays within its
+// performance budgets (scheduling cycle latency, memory usage, and end-to-end placement latency)
+// with a large, simulated fleet.
+//
+// The suite runs the hub agent controllers against a test environment hub cluster, and simulates the
+// member clusters with the fake member agents from the chaos test harness, which report heartbeats
+// and mark Work objects as applied and available without running any member cluster at all.
+package perf
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"path/filepath"
+	goruntime "runtime"
+	"strconv"
+	"sync"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/apimachinery/pkg/util/wait"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	"k8s.io/klog/v2"
+	clusterinventory "sigs.k8s.io/cluster-inventory-api/apis/v1alpha1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/cluster"
+	"sigs.k8s.io/controller-runtime/pkg/envtest"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1alpha1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1alpha1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/cmd/hubagent/options"
+	"github.com/kubefleet-dev/kubefleet/cmd/hubagent/workload"
+	mcv1beta1 "github.com/kubefleet-dev/kubefleet/pkg/controllers/membercluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
+	"github.com/kubefleet-dev/kubefleet/test/chaos"
+)
+
+const (
+	// perfTestEnvVar is the environment variable that enables the performance tests; the tests
+	// are skipped by default as they take a while to complete.
+	perfTestEnvVar = "KUBEFLEET_PERF_TEST"
+
+	memberClusterNamePrefix = "perf-cluster"
+
+	// The fake member agents report heartbeats and scan Work objects less frequently than the
+	// defaults, to keep the load they put on the hub cluster in line with that of real member agents.
+	heartbeatPeriod = time.Second * 30
+	workSyncPeriod  = time.Second * 5
+
+	memorySamplingInterval = time.Second
+
+	eventuallyInterval = time.Second * 5
+)
+
+// The scale of the simulated fleet and the performance budgets; each can be overridden with the
+// corresponding environment variable.
+var (
+	memberClusterCount      = envIntOrDefault("PERF_MEMBER_CLUSTER_COUNT", 1000)
+	placementCount          = envIntOrDefault("PERF_PLACEMENT_COUNT", 500)
+	clustersPerPlacement    = envIntOrDefault("PERF_CLUSTERS_PER_PLACEMENT", 10)
+	joinTimeout             = envDurationOrDefault("PERF_JOIN_TIMEOUT", time.Minute*10)
+	schedulingCycleP99Limit = envDurationOrDefault("PERF_SCHEDULING_CYCLE_P99_BUDGET", time.Second*5)
+	appliedLatencyP99Limit  = envDurationOrDefault("PERF_APPLIED_LATENCY_P99_BUDGET", time.Minute*5)
+	hubMemoryLimit          = envQuantityOrDefault("PERF_HUB_MEMORY_BUDGET", resource.MustParse("4Gi"))
+)
+
+var (
+	scheme = runtime.NewScheme()
+
+	hubTestEnv *envtest.Environment
+	hubClient  client.Client
+	fleet      *chaos.Fleet
+	ctx        context.Context
+	cancel     context.CancelFunc
+	hubAgentWG sync.WaitGroup
+
+	// peakHeapInUseBytes is the peak heap memory in use by the test process, which runs the hub
+	// agent controllers, sampled every memorySamplingInterval.
+	peakHeapInUseBytes uint64
+	peakHeapInUseMu    sync.Mutex
+)
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(placementv1beta1.AddToScheme(scheme))
+	utilruntime.Must(clusterv1beta1.AddToScheme(scheme))
+	utilruntime.Must(placementv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(clusterinventory.AddToScheme(scheme))
+}
+
+func envIntOrDefault(name string, defaultValue int) int {
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return defaultValue
+	}
+	i, err := strconv.Atoi(v)
+	if err != nil {
+		log.Fatalf("environment variable %s=%q is not an integer: %v", name, v, err)
+	}
+	return i
+}
+
+func envDurationOrDefault(name string, defaultValue time.Duration) time.Duration {
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return defaultValue
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		log.Fatalf("environment variable %s=%q is not a duration: %v", name, v, err)
+	}
+	return d
+}
+
+func envQuantityOrDefault(name string, defaultValue resource.Quantity) resource.Quantity {
+	v, ok := os.LookupEnv(name)
+	if !ok {
+		return defaultValue
+	}
+	q, err := resource.ParseQuantity(v)
+	if err != nil {
+		log.Fatalf("environment variable %s=%q is not a quantity: %v", name, v, err)
+	}
+	return q
+}
+
+func TestPerformanceBudgets(t *testing.T) {
+	if os.Getenv(perfTestEnvVar) != "true" {
+		t.Skipf("Skipping the performance tests; set %s=true to run them", perfTestEnvVar)
+	}
+	RegisterFailHandler(Fail)
+
+	RunSpecs(t, "Performance Budget Test Suite")
+}
+
+var _ = BeforeSuite(func() {
+	ctx, cancel = context.WithCancel(context.TODO())
+
+	klog.InitFlags(nil)
+	Expect(flag.Set("v", "1")).To(Succeed(), "Failed to set verbosity flag")
+	logger := zap.New(zap.WriteTo(GinkgoWriter), zap.UseDevMode(true))
+	klog.SetLogger(logger)
+	ctrl.SetLogger(logger)
+
+	By("bootstrapping the test environment")
+	hubTestEnv = &envtest.Environment{
+		CRDDirectoryPaths:     []string{filepath.Join("..", "..", "config", "crd", "bases")},
+		ErrorIfCRDPathMissing: true,
+	}
+	hubCfg, err := hubTestEnv.Start()
+	Expect(err).ToNot(HaveOccurred(), "Failed to start test environment")
+	// Lift the client-side rate limits of the test environment; the hub agent sets its own below.
+	hubCfg.QPS, hubCfg.Burst = 1000, 2000
+
+	hubClient, err = client.New(hubCfg, client.Options{Scheme: scheme})
+	Expect(err).ToNot(HaveOccurred(), "Failed to create hub cluster client")
+
+	By("starting the hub agent controllers")
+	opts := options.NewOptions()
+	flags := flag.NewFlagSet("hubagent", flag.ContinueOnError)
+	opts.AddFlags(flags)
+	Expect(flags.Parse([]string{
+		fmt.Sprintf("--max-fleet-size=%d", memberClusterCount),
+	})).To(Succeed(), "Failed to parse hub agent options")
+	hubAgentCfg := rest.CopyConfig(hubCfg)
+	hubAgentCfg.QPS, hubAgentCfg.Burst = float32(opts.CtrlMgrOpts.HubQPS), opts.CtrlMgrOpts.HubBurst
+
+	mgr, err := ctrl.NewManager(hubAgentCfg, ctrl.Options{
+		Scheme: scheme,
+		Metrics: metricsserver.Options{
+			BindAddress: "0",
+		},
+	})
+	Expect(err).ToNot(HaveOccurred(), "Failed to create controller manager")
+	Expect((&mcv1beta1.Reconciler{
+		Client:                  mgr.GetClient(),
+		MaxConcurrentReconciles: (memberClusterCount + 99) / 100,
+		ForceDeleteWaitTime:     opts.ClusterMgmtOpts.ForceDeleteWaitTime.Duration,
+	}).SetupWithManager(mgr, "membercluster-controller")).To(Succeed(), "Failed to set up member cluster controller")
+	Expect(workload.SetupControllers(ctx, &hubAgentWG, mgr, hubAgentCfg, opts)).To(Succeed(), "Failed to set up hub agent controllers")
+	go func() {
+		defer GinkgoRecover()
+		Expect(mgr.Start(ctx)).To(Succeed(), "Failed to start controller manager")
+	}()
+	go sampleMemoryUsage(ctx)
+
+	By("joining the simulated member clusters")
+	// The fake member agents read from a cache of their own, so that the scans of Work objects
+	// do not hit the API server directly.
+	agentCluster, err := cluster.New(hubCfg, func(o *cluster.Options) { o.Scheme = scheme })
+	Expect(err).ToNot(HaveOccurred(), "Failed to create the cluster for fake member agents")
+	go func() {
+		defer GinkgoRecover()
+		Expect(agentCluster.Start(ctx)).To(Succeed(), "Failed to start the cluster for fake member agents")
+	}()
+	Expect(agentCluster.GetCache().WaitForCacheSync(ctx)).To(BeTrue(), "Failed to sync the cache for fake member agents")
+
+	fleet = chaos.NewFleet(agentCluster.GetClient(), chaos.FleetOptions{
+		NamePrefix:      memberClusterNamePrefix,
+		Size:            memberClusterCount,
+		HeartbeatPeriod: heartbeatPeriod,
+		WorkSyncPeriod:  workSyncPeriod,
+	})
+	Expect(fleet.Start(ctx)).To(Succeed(), "Failed to start the fake member agents")
+	Eventually(allMemberClustersJoinedActual, joinTimeout, eventuallyInterval).Should(Succeed(), "Failed to join all member clusters")
+})
+
+var _ = AfterSuite(func() {
+	defer klog.Flush()
+
+	fleet.Stop()
+	cancel()
+	hubAgentWG.Wait()
+
+	By("tearing down the test environment")
+	Expect(hubTestEnv.Stop()).To(Succeed(), "Failed to stop test environment")
+})
+
+// allMemberClustersJoinedActual checks that all the simulated member clusters have joined the fleet.
+func allMemberClustersJoinedActual() error {
+	mcList := &clusterv1beta1.MemberClusterList{}
+	if err := hubClient.List(ctx, mcList, client.MatchingLabels{chaos.FakeMemberClusterLabel: "true"}); err != nil {
+		return fmt.Errorf("failed to list member clusters: %w", err)
+	}
+	joined := 0
+	for i := range mcList.Items {
+		mc := &mcList.Items[i]
+		if condition.IsConditionStatusTrue(meta.FindStatusCondition(mc.Status.Conditions, string(clusterv1beta1.ConditionTypeMemberClusterJoined)), mc.Generation) {
+			joined++
+		}
+	}
+	if joined != memberClusterCount {
+		return fmt.Errorf("%d out of %d member clusters have joined", joined, memberClusterCount)
+	}
+	return nil
+}
+
+// sampleMemoryUsage samples the heap memory in use by the test process until the context is cancelled.
+func sampleMemoryUsage(ctx context.Context) {
+	wait.UntilWithContext(ctx, func(_ context.Context) {
+		var ms goruntime.MemStats
+		goruntime.ReadMemStats(&ms)
+		peakHeapInUseMu.Lock()
+		defer peakHeapInUseMu.Unlock()
+		if ms.HeapInuse > peakHeapInUseBytes {
+			peakHeapInUseBytes = ms.HeapInuse
+		}
+	}, memorySamplingInterval)
+}
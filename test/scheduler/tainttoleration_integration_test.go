@@ -44,19 +44,20 @@ var (
 
 var _ = Describe("scheduling CRPs on member clusters with taints & tolerations", func() {
 	// This is a serial test as adding taints can affect other tests
-	Context("pickFixed, valid target clusters with taints", Serial, Ordered, func() {
+	Context("pickFixed, ignore target clusters with taints, placement with no matching toleration", Serial, Ordered, func() {
 		crpName := fmt.Sprintf(crpNameTemplate, GinkgoParallelProcess())
 		crpKey := types.NamespacedName{Name: crpName}
 		policySnapshotName := fmt.Sprintf(policySnapshotNameTemplate, crpName, 1)
 		targetClusters := []string{memberCluster1EastProd, memberCluster4CentralProd, memberCluster6WestProd}
-		taintClusters := targetClusters
+		taintClusters := []string{memberCluster1EastProd, memberCluster4CentralProd}
+		validClusters := []string{memberCluster6WestProd}
 
 		BeforeAll(func() {
 			// Ensure that no bindings have been created so far.
 			noBindingsCreatedActual := noBindingsCreatedForPlacementActual(crpKey)
 			Consistently(noBindingsCreatedActual, consistentlyDuration, consistentlyInterval).Should(Succeed(), "Some bindings have been created unexpectedly")
 
-			// Add taints to some member clusters 1, 4, 6 from all regions.
+			// Add taints to some member clusters 1, 4 from the east and central regions.
 			addTaintsToMemberClusters(taintClusters, buildTaints(taintClusters))
 
 			// Create the CRP and its associated policy snapshot.
@@ -68,14 +69,14 @@ var _ = Describe("scheduling CRPs on member clusters with taints & tolerations",
 			Eventually(finalizerAddedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to add scheduler cleanup finalizer to CRP")
 		})
 
-		It("should create scheduled bindings for valid target clusters", func() {
-			scheduledBindingsCreatedActual := scheduledBindingsCreatedOrUpdatedForClustersActual(targetClusters, nilScoreByCluster, crpKey, policySnapshotName)
+		It("should create scheduled bindings for valid target clusters only", func() {
+			scheduledBindingsCreatedActual := scheduledBindingsCreatedOrUpdatedForClustersActual(validClusters, nilScoreByCluster, crpKey, policySnapshotName)
 			Eventually(scheduledBindingsCreatedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to create the expected set of bindings")
 			Consistently(scheduledBindingsCreatedActual, consistentlyDuration, consistentlyInterval).Should(Succeed(), "Failed to create the expected set of bindings")
 		})
 
 		It("should report status correctly", func() {
-			statusUpdatedActual := pickFixedPolicySnapshotStatusUpdatedActual(targetClusters, []string{}, types.NamespacedName{Name: policySnapshotName})
+			statusUpdatedActual := pickFixedPolicySnapshotStatusUpdatedActual(validClusters, taintClusters, types.NamespacedName{Name: policySnapshotName})
 			Eventually(statusUpdatedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to report correct policy snapshot status")
 			Consistently(statusUpdatedActual, consistentlyDuration, consistentlyInterval).Should(Succeed(), "Failed to report correct policy snapshot status")
 		})
@@ -902,20 +903,21 @@ var _ = Describe("scheduling CRPs on member clusters with taints & tolerations",
 
 var _ = Describe("scheduling RPs on member clusters with taints & tolerations", func() {
 	// This is a serial test as adding taints can affect other tests
-	Context("pickFixed, valid target clusters with taints", Serial, Ordered, func() {
+	Context("pickFixed, ignore target clusters with taints, placement with no matching toleration", Serial, Ordered, func() {
 		rpName := fmt.Sprintf(rpNameTemplate, GinkgoParallelProcess())
 		rpKey := types.NamespacedName{Namespace: testNamespace, Name: rpName}
 		policySnapshotName := fmt.Sprintf(policySnapshotNameTemplate, rpName, 1)
 		policySnapshotKey := types.NamespacedName{Namespace: testNamespace, Name: policySnapshotName}
 		targetClusters := []string{memberCluster1EastProd, memberCluster4CentralProd, memberCluster6WestProd}
-		taintClusters := targetClusters
+		taintClusters := []string{memberCluster1EastProd, memberCluster4CentralProd}
+		validClusters := []string{memberCluster6WestProd}
 
 		BeforeAll(func() {
 			// Ensure that no bindings have been created so far.
 			noBindingsCreatedActual := noBindingsCreatedForPlacementActual(rpKey)
 			Consistently(noBindingsCreatedActual, consistentlyDuration, consistentlyInterval).Should(Succeed(), "Some bindings have been created unexpectedly")
 
-			// Add taints to some member clusters 1, 4, 6 from all regions.
+			// Add taints to some member clusters 1, 4 from the east and central regions.
 			addTaintsToMemberClusters(taintClusters, buildTaints(taintClusters))
 
 			// Create the RP and its associated policy snapshot.
@@ -927,14 +929,14 @@ var _ = Describe("scheduling RPs on member clusters with taints & tolerations",
 			Eventually(finalizerAddedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to add scheduler cleanup finalizer to RP")
 		})
 
-		It("should create scheduled bindings for valid target clusters", func() {
-			scheduledBindingsCreatedActual := scheduledBindingsCreatedOrUpdatedForClustersActual(targetClusters, nilScoreByCluster, rpKey, policySnapshotName)
+		It("should create scheduled bindings for valid target clusters only", func() {
+			scheduledBindingsCreatedActual := scheduledBindingsCreatedOrUpdatedForClustersActual(validClusters, nilScoreByCluster, rpKey, policySnapshotName)
 			Eventually(scheduledBindingsCreatedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to create the expected set of bindings")
 			Consistently(scheduledBindingsCreatedActual, consistentlyDuration, consistentlyInterval).Should(Succeed(), "Failed to create the expected set of bindings")
 		})
 
 		It("should report status correctly", func() {
-			statusUpdatedActual := pickFixedPolicySnapshotStatusUpdatedActual(targetClusters, []string{}, policySnapshotKey)
+			statusUpdatedActual := pickFixedPolicySnapshotStatusUpdatedActual(validClusters, taintClusters, policySnapshotKey)
 			Eventually(statusUpdatedActual, eventuallyDuration, eventuallyInterval).Should(Succeed(), "Failed to report correct policy snapshot status")
 			Consistently(statusUpdatedActual, consistentlyDuration, consistentlyInterval).Should(Succeed(), "Failed to report correct policy snapshot status")
 		})
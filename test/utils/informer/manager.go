@@ -192,3 +192,7 @@ func (m *FakeManager) AddEventHandlerToInformer(_ schema.GroupVersionResource, _
 func (m *FakeManager) CreateInformerForResource(_ informer.APIResourceMeta) {
 	// No-op for testing
 }
+
+func (m *FakeManager) CacheReport() []informer.CacheStats {
+	return nil
+}
@@ -0,0 +1,353 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adopt
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/spf13/cobra"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	"k8s.io/client-go/dynamic"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/adopt"
+	"github.com/kubefleet-dev/kubefleet/pkg/join"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils"
+	toolsutils "github.com/kubefleet-dev/kubefleet/tools/utils"
+)
+
+const (
+	hubManifestsFileName = "hub.yaml"
+)
+
+// adoptOptions wraps the options of the adopt command.
+type adoptOptions struct {
+	adopt.Options
+
+	hubClusterContext     string
+	memberClusterContexts map[string]string
+	dryRun                bool
+	outputDir             string
+
+	hubClient client.Client
+}
+
+// NewCmdAdopt creates a new adopt command.
+func NewCmdAdopt() *cobra.Command {
+	o := &adoptOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "adopt",
+		Short: "Generate a placement that adopts the existing resources in a namespace on member clusters",
+		Long: `Generate a ClusterResourcePlacement that adopts the resources that already exist in a namespace on
+member clusters (e.g., deployed by legacy tooling), so that Fleet takes them over without redeploying them.
+
+The command reads the namespace and its resources from the given member clusters, and generates the hub
+manifests, i.e., the namespace and its resources to create in the hub cluster, the ResourceOverrides that
+keep the per-cluster differences, and the ClusterResourcePlacement that places the namespace to the member
+clusters. The placement only takes over the existing objects that match the hub manifests (with the
+overrides applied). Secret data are never kept as overrides; a Secret whose data differ from the hub
+manifest on a member cluster is not taken over there.
+
+The overrides select the member clusters by the label given with --cluster-name-label, which must be set on
+the MemberCluster objects with the member cluster names as the values.
+
+With --dry-run, the command only reports what the adoption changes on each member cluster: the objects
+that are taken over, along with the overrides that keep their differences, and the objects that are
+created as they do not exist on the member cluster yet; Secret data are never printed.
+
+If --output-dir is set, the hub manifests are written to the hub.yaml file in the directory; otherwise
+they are printed.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.validate(); err != nil {
+				return err
+			}
+			if err := o.setupClient(); err != nil {
+				return err
+			}
+			return o.run(cmd.Context(), cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().StringVar(&o.hubClusterContext, "hub-cluster-context", "", "kubectl context for the hub cluster (required)")
+	cmd.Flags().StringToStringVar(&o.memberClusterContexts, "member-cluster-contexts", nil, "member cluster names and their kubectl contexts, e.g., member-1=ctx-1,member-2=ctx-2 (required)")
+	cmd.Flags().StringVarP(&o.Namespace, "namespace", "n", "", "namespace whose resources are adopted (required)")
+	cmd.Flags().StringVar(&o.PlacementName, "placement-name", "", "name of the ClusterResourcePlacement to generate (required)")
+	cmd.Flags().StringVar(&o.ClusterNameLabel, "cluster-name-label", adopt.DefaultClusterNameLabel, "label on the MemberCluster objects whose value is the member cluster name")
+	cmd.Flags().BoolVar(&o.dryRun, "dry-run", false, "only report what the adoption changes on each member cluster")
+	cmd.Flags().StringVar(&o.outputDir, "output-dir", "", "directory to write the hub.yaml file to; print the manifests if not set")
+
+	// Mark required flags
+	_ = cmd.MarkFlagRequired("hub-cluster-context")
+	_ = cmd.MarkFlagRequired("member-cluster-contexts")
+	_ = cmd.MarkFlagRequired("namespace")
+	_ = cmd.MarkFlagRequired("placement-name")
+
+	return cmd
+}
+
+// validate checks that the options are valid.
+func (o *adoptOptions) validate() error {
+	if len(o.memberClusterContexts) == 0 {
+		return fmt.Errorf("at least one member cluster is required")
+	}
+	if utils.IsReservedNamespace(o.Namespace) {
+		return fmt.Errorf("namespace %s is reserved and cannot be adopted", o.Namespace)
+	}
+	return o.Validate()
+}
+
+// setupClient creates and configures the Kubernetes client
+func (o *adoptOptions) setupClient() error {
+	scheme := runtime.NewScheme()
+
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		return fmt.Errorf("failed to add custom APIs (cluster) to the runtime scheme: %w", err)
+	}
+
+	hubClient, err := toolsutils.GetClusterClientFromClusterContext(o.hubClusterContext, scheme)
+	if err != nil {
+		return fmt.Errorf("failed to create hub cluster client: %w", err)
+	}
+
+	o.hubClient = hubClient
+	return nil
+}
+
+func (o *adoptOptions) run(ctx context.Context, out io.Writer) error {
+	unlabeled, err := o.checkMemberClusters(ctx)
+	if err != nil {
+		return err
+	}
+
+	observed := make(map[string][]*unstructured.Unstructured, len(o.memberClusterContexts))
+	for clusterName, clusterContext := range o.memberClusterContexts {
+		objs, err := o.collectObjects(ctx, clusterContext)
+		if err != nil {
+			return fmt.Errorf("failed to read the resources in namespace %s from member cluster %s: %w", o.Namespace, clusterName, err)
+		}
+		observed[clusterName] = objs
+	}
+
+	plan, err := adopt.BuildPlan(&o.Options, observed)
+	if err != nil {
+		return err
+	}
+
+	if o.dryRun {
+		return printChanges(out, plan.Changes)
+	}
+
+	objs := make([]client.Object, 0, len(plan.HubObjects)+len(plan.Overrides)+1)
+	for _, obj := range plan.HubObjects {
+		objs = append(objs, obj)
+	}
+	for _, ro := range plan.Overrides {
+		objs = append(objs, ro)
+	}
+	objs = append(objs, plan.Placement)
+
+	if len(unlabeled) > 0 && len(plan.Overrides) > 0 {
+		if _, err := fmt.Fprintf(out, "# Label the member clusters before applying the manifests below, so that the overrides select them:\n"); err != nil {
+			return err
+		}
+		for _, clusterName := range unlabeled {
+			if _, err := fmt.Fprintf(out, "#   kubectl label membercluster %s %s=%s\n", clusterName, o.ClusterNameLabel, clusterName); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.outputDir == "" {
+		if _, err := fmt.Fprint(out, "# Apply the manifests below to the hub cluster.\n"); err != nil {
+			return err
+		}
+		return join.WriteYAML(out, objs)
+	}
+
+	if err := os.MkdirAll(o.outputDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create the output directory: %w", err)
+	}
+	path := filepath.Join(o.outputDir, hubManifestsFileName)
+	if err := writeFile(path, objs); err != nil {
+		return err
+	}
+	_, err = fmt.Fprintf(out, "Apply %s to the hub cluster.\n", path)
+	return err
+}
+
+// checkMemberClusters checks that the member clusters have joined the fleet, and returns the ones that
+// do not have the cluster name label.
+func (o *adoptOptions) checkMemberClusters(ctx context.Context) ([]string, error) {
+	var unlabeled []string
+	for clusterName := range o.memberClusterContexts {
+		var mc clusterv1beta1.MemberCluster
+		if err := o.hubClient.Get(ctx, client.ObjectKey{Name: clusterName}, &mc); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, fmt.Errorf("member cluster %s is not found in the hub cluster", clusterName)
+			}
+			return nil, fmt.Errorf("failed to get member cluster %s: %w", clusterName, err)
+		}
+		if mc.Labels[o.ClusterNameLabel] != clusterName {
+			unlabeled = append(unlabeled, clusterName)
+		}
+	}
+	sort.Strings(unlabeled)
+	return unlabeled, nil
+}
+
+// collectObjects reads the namespace and the adoptable objects in it from a member cluster.
+func (o *adoptOptions) collectObjects(ctx context.Context, clusterContext string) ([]*unstructured.Unstructured, error) {
+	restConfig, err := toolsutils.GetRestConfigFromClusterContext(clusterContext)
+	if err != nil {
+		return nil, err
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+	dynamicClient, err := dynamic.NewForConfig(restConfig)
+	if err != nil {
+		return nil, err
+	}
+
+	var objs []*unstructured.Unstructured
+	ns, err := dynamicClient.Resource(utils.NamespaceGVR).Get(ctx, o.Namespace, metav1.GetOptions{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return nil, nil
+	case err != nil:
+		return nil, err
+	}
+	objs = append(objs, ns)
+
+	resourceLists, err := discoveryClient.ServerPreferredNamespacedResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, err
+	}
+	resourceConfig := utils.NewResourceConfig(false)
+	var namespacedObjs []*unstructured.Unstructured
+	for _, list := range resourceLists {
+		gv, err := schema.ParseGroupVersion(list.GroupVersion)
+		if err != nil {
+			return nil, err
+		}
+		for _, r := range list.APIResources {
+			if strings.Contains(r.Name, "/") || !hasVerb(r.Verbs, "list") {
+				// Skip the subresources and the resources that cannot be listed.
+				continue
+			}
+			if resourceConfig.IsResourceDisabled(gv.WithKind(r.Kind)) {
+				continue
+			}
+			res, err := dynamicClient.Resource(gv.WithResource(r.Name)).Namespace(o.Namespace).List(ctx, metav1.ListOptions{})
+			if err != nil {
+				return nil, fmt.Errorf("failed to list %s: %w", gv.WithResource(r.Name), err)
+			}
+			for i := range res.Items {
+				item := res.Items[i]
+				// The list responses do not carry the kinds of the items in some cases.
+				item.SetGroupVersionKind(gv.WithKind(r.Kind))
+				namespacedObjs = append(namespacedObjs, &item)
+			}
+		}
+	}
+	return append(objs, adopt.SelectAdoptableObjects(namespacedObjs)...), nil
+}
+
+func hasVerb(verbs []string, verb string) bool {
+	for _, v := range verbs {
+		if v == verb {
+			return true
+		}
+	}
+	return false
+}
+
+// printChanges prints the changes that the adoption makes on each member cluster.
+func printChanges(out io.Writer, changes []adopt.ResourceChange) error {
+	sorted := make([]adopt.ResourceChange, len(changes))
+	copy(sorted, changes)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return sorted[i].Cluster < sorted[j].Cluster
+	})
+
+	var b strings.Builder
+	lastCluster := ""
+	for _, c := range sorted {
+		if c.Cluster != lastCluster {
+			if lastCluster != "" {
+				b.WriteString("\n")
+			}
+			fmt.Fprintf(&b, "Member cluster %s:\n", c.Cluster)
+			lastCluster = c.Cluster
+		}
+		id := c.Resource
+		kind := id.Kind
+		if id.Group != "" {
+			kind = id.Kind + "." + id.Group
+		}
+		name := id.Name
+		if id.Namespace != "" {
+			name = id.Namespace + "/" + id.Name
+		}
+		switch c.Type {
+		case adopt.ChangeTypeCreate:
+			fmt.Fprintf(&b, "  + %s %s: created\n", kind, name)
+			continue
+		case adopt.ChangeTypeSkipTakeOver:
+			// The Secret data are not printed, as they differ on the member cluster.
+			fmt.Fprintf(&b, "  ! %s %s: not taken over, as its data differ from the hub manifest\n", kind, name)
+		default:
+			fmt.Fprintf(&b, "  = %s %s: taken over without changes\n", kind, name)
+		}
+		for _, patch := range c.Overrides {
+			if len(patch.Value.Raw) == 0 {
+				fmt.Fprintf(&b, "      override: %s %s\n", patch.Operator, patch.Path)
+				continue
+			}
+			fmt.Fprintf(&b, "      override: %s %s %s\n", patch.Operator, patch.Path, string(patch.Value.Raw))
+		}
+	}
+	_, err := io.WriteString(out, b.String())
+	return err
+}
+
+// writeFile writes the manifests to the file; the file is only readable by the owner as it may contain secrets.
+func writeFile(path string, objs []client.Object) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+	if err := join.WriteYAML(f, objs); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return f.Close()
+}
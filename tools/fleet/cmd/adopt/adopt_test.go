@@ -0,0 +1,173 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package adopt
+
+import (
+	"bytes"
+	"context"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	clusterv1beta1 "github.com/kubefleet-dev/kubefleet/apis/cluster/v1beta1"
+	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
+	"github.com/kubefleet-dev/kubefleet/pkg/adopt"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name    string
+		o       *adoptOptions
+		wantErr bool
+	}{
+		{
+			name: "valid options",
+			o: &adoptOptions{
+				Options: adopt.Options{
+					PlacementName:    "crp-app",
+					Namespace:        "app",
+					ClusterNameLabel: adopt.DefaultClusterNameLabel,
+				},
+				memberClusterContexts: map[string]string{"member-1": "ctx-1"},
+			},
+		},
+		{
+			name: "no member clusters",
+			o: &adoptOptions{
+				Options: adopt.Options{
+					PlacementName:    "crp-app",
+					Namespace:        "app",
+					ClusterNameLabel: adopt.DefaultClusterNameLabel,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "reserved namespace",
+			o: &adoptOptions{
+				Options: adopt.Options{
+					PlacementName:    "crp-app",
+					Namespace:        "kube-system",
+					ClusterNameLabel: adopt.DefaultClusterNameLabel,
+				},
+				memberClusterContexts: map[string]string{"member-1": "ctx-1"},
+			},
+			wantErr: true,
+		},
+		{
+			name: "invalid placement name",
+			o: &adoptOptions{
+				Options: adopt.Options{
+					PlacementName:    "CRP_App",
+					Namespace:        "app",
+					ClusterNameLabel: adopt.DefaultClusterNameLabel,
+				},
+				memberClusterContexts: map[string]string{"member-1": "ctx-1"},
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.o.validate()
+			if gotErr := err != nil; gotErr != tc.wantErr {
+				t.Errorf("validate() = %v, want error %t", err, tc.wantErr)
+			}
+		})
+	}
+}
+
+func TestCheckMemberClusters(t *testing.T) {
+	scheme := runtime.NewScheme()
+	if err := clusterv1beta1.AddToScheme(scheme); err != nil {
+		t.Fatalf("failed to add cluster APIs to the scheme: %v", err)
+	}
+	hubClient := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		&clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   "member-1",
+				Labels: map[string]string{adopt.DefaultClusterNameLabel: "member-1"},
+			},
+		},
+		&clusterv1beta1.MemberCluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "member-2"},
+		},
+	).Build()
+
+	o := &adoptOptions{
+		Options:               adopt.Options{ClusterNameLabel: adopt.DefaultClusterNameLabel},
+		memberClusterContexts: map[string]string{"member-1": "ctx-1", "member-2": "ctx-2"},
+		hubClient:             hubClient,
+	}
+	unlabeled, err := o.checkMemberClusters(context.Background())
+	if err != nil {
+		t.Fatalf("checkMemberClusters() = %v, want no error", err)
+	}
+	if diff := cmp.Diff(unlabeled, []string{"member-2"}); diff != "" {
+		t.Errorf("checkMemberClusters() mismatch (-got, +want):\n%s", diff)
+	}
+
+	o.memberClusterContexts["member-3"] = "ctx-3"
+	if _, err := o.checkMemberClusters(context.Background()); err == nil {
+		t.Errorf("checkMemberClusters() = nil, want error for a member cluster that is not found")
+	}
+}
+
+func TestPrintChanges(t *testing.T) {
+	deployID := placementv1beta1.ResourceIdentifier{Group: "apps", Version: "v1", Kind: "Deployment", Namespace: "app", Name: "web"}
+	namespaceID := placementv1beta1.ResourceIdentifier{Version: "v1", Kind: "Namespace", Name: "app"}
+	secretID := placementv1beta1.ResourceIdentifier{Version: "v1", Kind: "Secret", Namespace: "app", Name: "creds"}
+	changes := []adopt.ResourceChange{
+		{Cluster: "member-1", Resource: namespaceID, Type: adopt.ChangeTypeTakeOver},
+		{Cluster: "member-2", Resource: namespaceID, Type: adopt.ChangeTypeCreate},
+		{Cluster: "member-1", Resource: deployID, Type: adopt.ChangeTypeTakeOver},
+		{Cluster: "member-2", Resource: deployID, Type: adopt.ChangeTypeTakeOver, Overrides: []placementv1beta1.JSONPatchOverride{
+			{Operator: placementv1beta1.JSONPatchOverrideOpReplace, Path: "/spec/replicas", Value: apiextensionsv1.JSON{Raw: []byte("5")}},
+			{Operator: placementv1beta1.JSONPatchOverrideOpRemove, Path: "/metadata/labels/tier"},
+		}},
+		{Cluster: "member-1", Resource: secretID, Type: adopt.ChangeTypeTakeOver},
+		{Cluster: "member-2", Resource: secretID, Type: adopt.ChangeTypeSkipTakeOver, Overrides: []placementv1beta1.JSONPatchOverride{
+			{Operator: placementv1beta1.JSONPatchOverrideOpAdd, Path: "/metadata/labels/tier", Value: apiextensionsv1.JSON{Raw: []byte(`"web"`)}},
+		}},
+	}
+
+	var out bytes.Buffer
+	if err := printChanges(&out, changes); err != nil {
+		t.Fatalf("printChanges() = %v, want no error", err)
+	}
+	want := `Member cluster member-1:
+  = Namespace app: taken over without changes
+  = Deployment.apps app/web: taken over without changes
+  = Secret app/creds: taken over without changes
+
+Member cluster member-2:
+  + Namespace app: created
+  = Deployment.apps app/web: taken over without changes
+      override: replace /spec/replicas 5
+      override: remove /metadata/labels/tier
+  ! Secret app/creds: not taken over, as its data differ from the hub manifest
+      override: add /metadata/labels/tier "web"
+`
+	if diff := cmp.Diff(out.String(), want); diff != "" {
+		t.Errorf("printChanges() mismatch (-got, +want):\n%s", diff)
+	}
+}
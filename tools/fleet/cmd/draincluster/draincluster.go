@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"sort"
 
 	"github.com/spf13/cobra"
 	k8errors "k8s.io/apimachinery/pkg/api/errors"
@@ -36,6 +37,7 @@ import (
 	placementv1beta1 "github.com/kubefleet-dev/kubefleet/apis/placement/v1beta1"
 	"github.com/kubefleet-dev/kubefleet/pkg/utils/condition"
 	evictionutils "github.com/kubefleet-dev/kubefleet/pkg/utils/eviction"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/priority"
 	toolsutils "github.com/kubefleet-dev/kubefleet/tools/utils"
 )
 
@@ -60,7 +62,7 @@ func NewCmdDrainCluster() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "draincluster",
 		Short: "Drain a member cluster",
-		Long:  "Drain a member cluster by cordoning it and removing propagated resources",
+		Long:  "Drain a member cluster by cordoning it and removing propagated resources; resources of lower-priority placements are removed first",
 		RunE: func(command *cobra.Command, args []string) error {
 			if err := o.setupClient(); err != nil {
 				return err
@@ -145,9 +147,15 @@ func (o *drainOptions) drain(ctx context.Context) (bool, error) {
 		return true, nil
 	}
 
+	// evict the resources of lower-priority CRPs first.
+	crpNames, err := o.sortClusterResourcePlacementNamesByPriority(ctx, crpNameMap)
+	if err != nil {
+		return false, err
+	}
+
 	isDrainSuccessful := true
 	// create eviction objects for all <crpName, targetCluster>.
-	for crpName := range crpNameMap {
+	for _, crpName := range crpNames {
 		evictionName, err := generateDrainEvictionName(crpName, o.clusterName)
 		if err != nil {
 			return false, err
@@ -263,6 +271,39 @@ func (o *drainOptions) fetchClusterResourcePlacementNamesToEvict(ctx context.Con
 	return crpNameMap, nil
 }
 
+// sortClusterResourcePlacementNamesByPriority sorts the CRP names in the ascending order of the CRP priorities,
+// so that the resources of lower-priority CRPs are evicted first; CRPs of the same priority are sorted by name.
+func (o *drainOptions) sortClusterResourcePlacementNamesByPriority(ctx context.Context, crpNameMap map[string]bool) ([]string, error) {
+	resolver, err := priority.NewResolver(ctx, o.hubClient)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list placement priority classes: %w", err)
+	}
+
+	crpPriorities := make(map[string]int32, len(crpNameMap))
+	crpNames := make([]string, 0, len(crpNameMap))
+	for crpName := range crpNameMap {
+		var crp placementv1beta1.ClusterResourcePlacement
+		if err := o.hubClient.Get(ctx, types.NamespacedName{Name: crpName}, &crp); err != nil {
+			if !k8errors.IsNotFound(err) {
+				return nil, fmt.Errorf("failed to get ClusterResourcePlacement %s: %w", crpName, err)
+			}
+			// the eviction for a missing CRP is invalid, but drain will still succeed.
+			crpPriorities[crpName] = priority.DefaultPriority
+		} else {
+			crpPriorities[crpName] = resolver.PriorityOf(&crp)
+		}
+		crpNames = append(crpNames, crpName)
+	}
+
+	sort.Slice(crpNames, func(i, j int) bool {
+		if crpPriorities[crpNames[i]] != crpPriorities[crpNames[j]] {
+			return crpPriorities[crpNames[i]] < crpPriorities[crpNames[j]]
+		}
+		return crpNames[i] < crpNames[j]
+	})
+	return crpNames, nil
+}
+
 func (o *drainOptions) collectClusterScopedResourcesSelectedByCRP(ctx context.Context, crpName string) ([]placementv1beta1.ResourceIdentifier, error) {
 	var crp placementv1beta1.ClusterResourcePlacement
 	if err := o.hubClient.Get(ctx, types.NamespacedName{Name: crpName}, &crp); err != nil {
@@ -175,6 +175,62 @@ func TestFetchClusterResourcePlacementNamesToEvict(t *testing.T) {
 	}
 }
 
+func TestSortClusterResourcePlacementNamesByPriority(t *testing.T) {
+	priorityClasses := []placementv1beta1.PlacementPriorityClass{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "high"},
+			Spec:       placementv1beta1.PlacementPriorityClassSpec{Value: 1000},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "low"},
+			Spec:       placementv1beta1.PlacementPriorityClassSpec{Value: -1000},
+		},
+	}
+	crps := []placementv1beta1.ClusterResourcePlacement{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "crp-a"},
+			Spec:       placementv1beta1.PlacementSpec{PriorityClassName: "high"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "crp-b"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "crp-c"},
+			Spec:       placementv1beta1.PlacementSpec{PriorityClassName: "low"},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "crp-d"},
+			Spec:       placementv1beta1.PlacementSpec{PriorityClassName: "low"},
+		},
+	}
+
+	var objects []client.Object
+	for i := range priorityClasses {
+		objects = append(objects, &priorityClasses[i])
+	}
+	for i := range crps {
+		objects = append(objects, &crps[i])
+	}
+	fakeClient := fake.NewClientBuilder().
+		WithScheme(serviceScheme(t)).
+		WithObjects(objects...).
+		Build()
+	h := &drainOptions{
+		hubClient:   fakeClient,
+		clusterName: "test-cluster-1",
+	}
+
+	crpNameMap := map[string]bool{"crp-a": true, "crp-b": true, "crp-c": true, "crp-d": true, "crp-missing": true}
+	got, err := h.sortClusterResourcePlacementNamesByPriority(context.Background(), crpNameMap)
+	if err != nil {
+		t.Fatalf("sortClusterResourcePlacementNamesByPriority() got error %v, want no error", err)
+	}
+	want := []string{"crp-c", "crp-d", "crp-b", "crp-missing", "crp-a"}
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("sortClusterResourcePlacementNamesByPriority() mismatch (-got +want):\n%s", diff)
+	}
+}
+
 func TestCollectClusterScopedResourcesSelectedByCRP(t *testing.T) {
 	tests := []struct {
 		name          string
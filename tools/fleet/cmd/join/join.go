@@ -0,0 +1,165 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package join
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/spf13/cobra"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/join"
+)
+
+const (
+	memberManifestsFileName = "member.yaml"
+	hubManifestsFileName    = "hub.yaml"
+)
+
+// joinOptions wraps the options of the join command.
+type joinOptions struct {
+	join.Options
+
+	hubCAFile    string
+	hubTokenFile string
+	outputDir    string
+}
+
+// NewCmdJoin creates a new join command.
+func NewCmdJoin() *cobra.Command {
+	o := &joinOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "join",
+		Short: "Generate the manifests to join a cluster to the fleet",
+		Long: `Generate the manifests to join a cluster to the fleet as a member cluster, including the member agent
+deployment along with its RBAC setup and hub credentials, which are applied to the new member cluster, and
+the MemberCluster object, which is applied to the hub cluster.
+
+The member agent authenticates with the hub cluster using a token from the given token provider:
+
+  * secret: the token (e.g., of a service account in the hub cluster) is kept in a secret in the member cluster;
+  * azure: the token is fetched with the Azure managed identity of the given client ID.
+
+The identity flags specify who the token authenticates as in the hub cluster; the hub agent grants this
+identity access to the resources of the member cluster.
+
+If --output-dir is set, the manifests are written to the member.yaml and hub.yaml files in the directory;
+otherwise both are printed. The AppliedWork CRD (charts/member-agent/crdbases) must also be installed in the
+member cluster.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.complete(); err != nil {
+				return err
+			}
+			if err := o.Validate(); err != nil {
+				return err
+			}
+			return o.run(cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().StringVar(&o.MemberClusterName, "cluster-name", "", "name of the new member cluster (required)")
+	cmd.Flags().StringVar(&o.HubURL, "hub-url", "", "URL of the hub cluster API server (required)")
+	cmd.Flags().StringVar(&o.hubCAFile, "hub-ca-file", "", "path to the PEM-encoded certificate authority of the hub cluster API server")
+	cmd.Flags().StringVar(&o.TokenProvider, "token-provider", join.TokenProviderSecret, "provider of the hub token, secret or azure")
+	cmd.Flags().StringVar(&o.hubTokenFile, "hub-token-file", "", "path to the hub token (required for the secret token provider)")
+	cmd.Flags().StringVar(&o.AzureClientID, "azure-client-id", "", "client ID of the Azure managed identity (required for the azure token provider)")
+	cmd.Flags().StringVar(&o.Identity.Kind, "identity-kind", rbacv1.ServiceAccountKind, "kind of the member agent identity in the hub cluster, ServiceAccount, User, or Group")
+	cmd.Flags().StringVar(&o.Identity.Name, "identity-name", "", "name of the member agent identity in the hub cluster (required)")
+	cmd.Flags().StringVar(&o.Identity.Namespace, "identity-namespace", "", "namespace of the member agent identity in the hub cluster (required for service accounts)")
+	cmd.Flags().StringVar(&o.Namespace, "namespace", join.DefaultNamespace, "namespace in the member cluster where the member agent runs")
+	cmd.Flags().StringVar(&o.ImageTag, "image-tag", "", "tag of the member agent and refresh token images (required)")
+	cmd.Flags().StringVar(&o.MemberAgentImageRepository, "member-agent-image", join.DefaultMemberAgentImageRepository, "image repository of the member agent")
+	cmd.Flags().StringVar(&o.RefreshTokenImageRepository, "refresh-token-image", join.DefaultRefreshTokenImageRepository, "image repository of the refresh token sidecar")
+	cmd.Flags().StringVar(&o.outputDir, "output-dir", "", "directory to write the member.yaml and hub.yaml files to; print the manifests if not set")
+
+	// Mark required flags
+	_ = cmd.MarkFlagRequired("cluster-name")
+	_ = cmd.MarkFlagRequired("hub-url")
+	_ = cmd.MarkFlagRequired("identity-name")
+	_ = cmd.MarkFlagRequired("image-tag")
+
+	return cmd
+}
+
+// complete reads the hub certificate authority and the hub token from the given files.
+func (o *joinOptions) complete() error {
+	if o.hubCAFile != "" {
+		data, err := os.ReadFile(o.hubCAFile)
+		if err != nil {
+			return fmt.Errorf("failed to read the hub certificate authority: %w", err)
+		}
+		o.HubCAData = data
+	}
+	if o.hubTokenFile != "" {
+		data, err := os.ReadFile(o.hubTokenFile)
+		if err != nil {
+			return fmt.Errorf("failed to read the hub token: %w", err)
+		}
+		o.HubToken = strings.TrimSpace(string(data))
+	}
+	return nil
+}
+
+func (o *joinOptions) run(out io.Writer) error {
+	memberObjs := join.GenerateMemberManifests(&o.Options)
+	hubObjs := join.GenerateHubManifests(&o.Options)
+
+	if o.outputDir == "" {
+		if _, err := fmt.Fprintf(out, "# Apply the manifests below to the member cluster %s.\n", o.MemberClusterName); err != nil {
+			return err
+		}
+		if err := join.WriteYAML(out, memberObjs); err != nil {
+			return err
+		}
+		if _, err := fmt.Fprint(out, "---\n# Apply the manifests below to the hub cluster.\n"); err != nil {
+			return err
+		}
+		return join.WriteYAML(out, hubObjs)
+	}
+
+	if err := os.MkdirAll(o.outputDir, 0o755); err != nil {
+		return fmt.Errorf("failed to create the output directory: %w", err)
+	}
+	if err := writeFile(filepath.Join(o.outputDir, memberManifestsFileName), memberObjs); err != nil {
+		return err
+	}
+	if err := writeFile(filepath.Join(o.outputDir, hubManifestsFileName), hubObjs); err != nil {
+		return err
+	}
+	_, err := fmt.Fprintf(out, "Apply %s to the member cluster %s and %s to the hub cluster.\n",
+		filepath.Join(o.outputDir, memberManifestsFileName), o.MemberClusterName, filepath.Join(o.outputDir, hubManifestsFileName))
+	return err
+}
+
+// writeFile writes the manifests to the file; the file is only readable by the owner as it may contain the hub token.
+func writeFile(path string, objs []client.Object) error {
+	f, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, 0o600)
+	if err != nil {
+		return fmt.Errorf("failed to open %s: %w", path, err)
+	}
+	defer f.Close()
+	if err := join.WriteYAML(f, objs); err != nil {
+		return fmt.Errorf("failed to write %s: %w", path, err)
+	}
+	return f.Close()
+}
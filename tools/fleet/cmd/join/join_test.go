@@ -0,0 +1,109 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package join
+
+import (
+	"bytes"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	rbacv1 "k8s.io/api/rbac/v1"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/join"
+)
+
+func TestComplete(t *testing.T) {
+	dir := t.TempDir()
+	tokenFile := filepath.Join(dir, "token")
+	if err := os.WriteFile(tokenFile, []byte("test-token\n"), 0o600); err != nil {
+		t.Fatalf("failed to write the token file: %v", err)
+	}
+
+	o := &joinOptions{hubTokenFile: tokenFile}
+	if err := o.complete(); err != nil {
+		t.Fatalf("complete() = %v, want no error", err)
+	}
+	if got, want := o.HubToken, "test-token"; got != want {
+		t.Errorf("complete() hub token = %q, want %q", got, want)
+	}
+
+	o = &joinOptions{hubCAFile: filepath.Join(dir, "missing")}
+	if err := o.complete(); err == nil || !strings.Contains(err.Error(), "failed to read the hub certificate authority") {
+		t.Errorf("complete() = %v, want error reading the hub certificate authority", err)
+	}
+}
+
+func TestRun(t *testing.T) {
+	newOptions := func(outputDir string) *joinOptions {
+		o := &joinOptions{
+			Options: join.Options{
+				MemberClusterName: "member-1",
+				HubURL:            "https://hub.example.com:443",
+				TokenProvider:     join.TokenProviderSecret,
+				HubToken:          "test-token",
+				Identity:          rbacv1.Subject{Kind: rbacv1.UserKind, Name: "member-1"},
+				ImageTag:          "v0.1.0",
+			},
+			outputDir: outputDir,
+		}
+		if err := o.Validate(); err != nil {
+			t.Fatalf("Validate() = %v, want no error", err)
+		}
+		return o
+	}
+
+	t.Run("print manifests", func(t *testing.T) {
+		var out bytes.Buffer
+		if err := newOptions("").run(&out); err != nil {
+			t.Fatalf("run() = %v, want no error", err)
+		}
+		for _, want := range []string{
+			"# Apply the manifests below to the member cluster member-1.",
+			"kind: Deployment",
+			"# Apply the manifests below to the hub cluster.",
+			"kind: MemberCluster",
+		} {
+			if !strings.Contains(out.String(), want) {
+				t.Errorf("run() output does not contain %q:\n%s", want, out.String())
+			}
+		}
+	})
+
+	t.Run("write manifests to files", func(t *testing.T) {
+		dir := filepath.Join(t.TempDir(), "member-1")
+		var out bytes.Buffer
+		if err := newOptions(dir).run(&out); err != nil {
+			t.Fatalf("run() = %v, want no error", err)
+		}
+		member, err := os.ReadFile(filepath.Join(dir, memberManifestsFileName))
+		if err != nil {
+			t.Fatalf("failed to read the member manifests: %v", err)
+		}
+		if !strings.Contains(string(member), "kind: Secret") || strings.Contains(string(member), "kind: MemberCluster") {
+			t.Errorf("member manifests = %s, want the hub token secret and no MemberCluster", member)
+		}
+		hub, err := os.ReadFile(filepath.Join(dir, hubManifestsFileName))
+		if err != nil {
+			t.Fatalf("failed to read the hub manifests: %v", err)
+		}
+		if !strings.Contains(string(hub), "kind: MemberCluster") {
+			t.Errorf("hub manifests = %s, want a MemberCluster", hub)
+		}
+	})
+}
@@ -0,0 +1,210 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedulingcycles
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/spf13/cobra"
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/queue"
+	"github.com/kubefleet-dev/kubefleet/pkg/utils/controller"
+	toolsutils "github.com/kubefleet-dev/kubefleet/tools/utils"
+)
+
+const (
+	outputText = "text"
+	outputJSON = "json"
+)
+
+// schedulingCyclesOptions wraps the options of the schedulingcycles command.
+type schedulingCyclesOptions struct {
+	hubClusterContext string
+	name              string
+	namespace         string
+	last              int
+	output            string
+
+	hubClient client.Client
+}
+
+// NewCmdSchedulingCycles creates a new schedulingcycles command.
+func NewCmdSchedulingCycles() *cobra.Command {
+	o := &schedulingCyclesOptions{}
+
+	cmd := &cobra.Command{
+		Use:   "schedulingcycles",
+		Short: "Show the snapshots of the latest scheduling cycles of a placement",
+		Long: `Show the snapshots of the latest scheduling cycles of a placement, including the cycle state,
+the clusters filtered out along with the reasons, and the scores of the clusters that pass the filters.
+
+The snapshots are only available if the hub agent runs with the --scheduling-cycle-snapshot-count flag
+set to a positive value.
+
+Specify the --namespace flag to show the scheduling cycles of a ResourcePlacement; otherwise the
+scheduling cycles of the ClusterResourcePlacement with the given name are shown.`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if err := o.validate(); err != nil {
+				return err
+			}
+			if err := o.setupClient(); err != nil {
+				return err
+			}
+			return o.run(cmd.Context(), cmd.OutOrStdout())
+		},
+	}
+
+	cmd.Flags().StringVar(&o.hubClusterContext, "hubClusterContext", "", "kubectl context for the hub cluster (required)")
+	cmd.Flags().StringVar(&o.name, "name", "", "name of the placement (required)")
+	cmd.Flags().StringVarP(&o.namespace, "namespace", "n", "", "namespace of the placement (required for ResourcePlacements)")
+	cmd.Flags().IntVar(&o.last, "last", 0, "show only the given number of the latest scheduling cycles; show all kept cycles if not set")
+	cmd.Flags().StringVarP(&o.output, "output", "o", outputText, "output format, text or json")
+
+	// Mark required flags
+	_ = cmd.MarkFlagRequired("hubClusterContext")
+	_ = cmd.MarkFlagRequired("name")
+
+	return cmd
+}
+
+// validate checks that the options are valid.
+func (o *schedulingCyclesOptions) validate() error {
+	if o.name == "" {
+		return fmt.Errorf("placement name is required")
+	}
+	if o.last < 0 {
+		return fmt.Errorf("the number of scheduling cycles to show must not be negative")
+	}
+	if o.output != outputText && o.output != outputJSON {
+		return fmt.Errorf("unsupported output format %q, must be %s or %s", o.output, outputText, outputJSON)
+	}
+	return nil
+}
+
+// setupClient creates and configures the Kubernetes client
+func (o *schedulingCyclesOptions) setupClient() error {
+	scheme := runtime.NewScheme()
+
+	if err := corev1.AddToScheme(scheme); err != nil {
+		return fmt.Errorf("failed to add core APIs to the runtime scheme: %w", err)
+	}
+
+	hubClient, err := toolsutils.GetClusterClientFromClusterContext(o.hubClusterContext, scheme)
+	if err != nil {
+		return fmt.Errorf("failed to create hub cluster client: %w", err)
+	}
+
+	o.hubClient = hubClient
+	return nil
+}
+
+func (o *schedulingCyclesOptions) run(ctx context.Context, out io.Writer) error {
+	snapshots, err := o.fetchSnapshots(ctx)
+	if err != nil {
+		return err
+	}
+	if o.last > 0 && len(snapshots) > o.last {
+		snapshots = snapshots[len(snapshots)-o.last:]
+	}
+
+	if o.output == outputJSON {
+		data, err := json.MarshalIndent(snapshots, "", "  ")
+		if err != nil {
+			return fmt.Errorf("failed to marshal scheduling cycle snapshots: %w", err)
+		}
+		_, err = fmt.Fprintln(out, string(data))
+		return err
+	}
+	return printSnapshots(out, snapshots)
+}
+
+// fetchSnapshots retrieves the scheduling cycle snapshots of the placement, from the oldest to the latest.
+func (o *schedulingCyclesOptions) fetchSnapshots(ctx context.Context) ([]framework.SchedulingCycleSnapshot, error) {
+	placementKey := queue.PlacementKey(controller.GetObjectKeyFromNamespaceName(o.namespace, o.name))
+	cmKey, err := framework.SchedulingCycleSnapshotsConfigMapKey(placementKey)
+	if err != nil {
+		return nil, err
+	}
+
+	var cm corev1.ConfigMap
+	if err := o.hubClient.Get(ctx, cmKey, &cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, fmt.Errorf("no scheduling cycle snapshots are found for placement %s; make sure that the hub agent runs with a positive --scheduling-cycle-snapshot-count", placementKey)
+		}
+		return nil, fmt.Errorf("failed to get scheduling cycle snapshots for placement %s: %w", placementKey, err)
+	}
+	return framework.ParseSchedulingCycleSnapshots(&cm)
+}
+
+// printSnapshots prints the scheduling cycle snapshots in a human-readable format.
+func printSnapshots(out io.Writer, snapshots []framework.SchedulingCycleSnapshot) error {
+	var b strings.Builder
+	for i, s := range snapshots {
+		if i > 0 {
+			b.WriteString("\n")
+		}
+		fmt.Fprintf(&b, "Scheduling cycle at %s (policy snapshot: %s, latency: %dms)\n",
+			s.StartTime.UTC().Format("2006-01-02T15:04:05Z"), s.PolicySnapshot, s.LatencyMilliseconds)
+		if s.Error != "" {
+			fmt.Fprintf(&b, "  Error: %s\n", s.Error)
+		}
+		fmt.Fprintf(&b, "  Clusters evaluated: %d\n", len(s.Clusters))
+		if len(s.ScheduledOrBoundClusters) > 0 {
+			fmt.Fprintf(&b, "  Clusters with scheduled or bound bindings: %s\n", strings.Join(s.ScheduledOrBoundClusters, ", "))
+		}
+		if len(s.ObsoleteClusters) > 0 {
+			fmt.Fprintf(&b, "  Clusters with obsolete bindings: %s\n", strings.Join(s.ObsoleteClusters, ", "))
+		}
+		if s.DesiredBatchSize > 0 {
+			fmt.Fprintf(&b, "  Desired batch size: %d, batch size limit: %d\n", s.DesiredBatchSize, s.BatchSizeLimit)
+		}
+		if len(s.SkippedFilterPlugins) > 0 {
+			fmt.Fprintf(&b, "  Skipped filter plugins: %s\n", strings.Join(s.SkippedFilterPlugins, ", "))
+		}
+		if len(s.SkippedScorePlugins) > 0 {
+			fmt.Fprintf(&b, "  Skipped score plugins: %s\n", strings.Join(s.SkippedScorePlugins, ", "))
+		}
+		if len(s.FilteredClusters) > 0 {
+			b.WriteString("  Filtered out clusters:\n")
+			for _, fc := range s.FilteredClusters {
+				fmt.Fprintf(&b, "    %s: rejected by %s", fc.Cluster, fc.Plugin)
+				if len(fc.Reasons) > 0 {
+					fmt.Fprintf(&b, " (%s)", strings.Join(fc.Reasons, "; "))
+				}
+				b.WriteString("\n")
+			}
+		}
+		if len(s.ScoredClusters) > 0 {
+			b.WriteString("  Scored clusters:\n")
+			for _, sc := range s.ScoredClusters {
+				fmt.Fprintf(&b, "    %s: affinity score %d, topology spread score %d, placement spread score %d, autoscaling score %d, obsolete placement affinity score %d\n",
+					sc.Cluster, sc.Score.AffinityScore, sc.Score.TopologySpreadScore, sc.Score.PlacementSpreadScore, sc.Score.AutoscalingScore, sc.Score.ObsoletePlacementAffinityScore)
+			}
+		}
+	}
+	_, err := io.WriteString(out, b.String())
+	return err
+}
@@ -0,0 +1,212 @@
+/*
+Copyright 2025 The KubeFleet Authors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedulingcycles
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/kubefleet-dev/kubefleet/pkg/scheduler/framework"
+)
+
+func TestValidate(t *testing.T) {
+	testCases := []struct {
+		name       string
+		opts       schedulingCyclesOptions
+		wantErrMsg string
+	}{
+		{
+			name: "valid options",
+			opts: schedulingCyclesOptions{name: "crp", output: outputText},
+		},
+		{
+			name:       "missing name",
+			opts:       schedulingCyclesOptions{output: outputText},
+			wantErrMsg: "placement name is required",
+		},
+		{
+			name:       "negative count",
+			opts:       schedulingCyclesOptions{name: "crp", last: -1, output: outputText},
+			wantErrMsg: "must not be negative",
+		},
+		{
+			name:       "unsupported output format",
+			opts:       schedulingCyclesOptions{name: "crp", output: "yaml"},
+			wantErrMsg: "unsupported output format",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.opts.validate()
+			if tc.wantErrMsg == "" {
+				if err != nil {
+					t.Fatalf("validate() = %v, want no error", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.wantErrMsg) {
+				t.Fatalf("validate() = %v, want error containing %q", err, tc.wantErrMsg)
+			}
+		})
+	}
+}
+
+func TestRun(t *testing.T) {
+	startTime := metav1.NewTime(time.Date(2025, 1, 2, 3, 4, 5, 0, time.UTC))
+	snapshots := []framework.SchedulingCycleSnapshot{
+		{
+			PolicySnapshot:      "crp-0",
+			StartTime:           startTime,
+			LatencyMilliseconds: 5,
+			Error:               "failed to update bindings",
+			Clusters:            []string{"member-1"},
+		},
+		{
+			PolicySnapshot:           "crp-1",
+			StartTime:                startTime,
+			LatencyMilliseconds:      12,
+			Clusters:                 []string{"member-1", "member-2", "member-3"},
+			ScheduledOrBoundClusters: []string{"member-1"},
+			DesiredBatchSize:         1,
+			BatchSizeLimit:           1,
+			SkippedScorePlugins:      []string{"TopologySpread"},
+			FilteredClusters: []framework.FilteredClusterSnapshot{
+				{Cluster: "member-3", Plugin: "ClusterAffinity", Reasons: []string{"cluster does not match"}},
+			},
+			ScoredClusters: []framework.ScoredClusterSnapshot{
+				{Cluster: "member-2", Score: framework.ClusterScore{AffinityScore: 10}},
+			},
+		},
+	}
+	data, err := json.Marshal(snapshots)
+	if err != nil {
+		t.Fatalf("failed to marshal snapshots: %v", err)
+	}
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace: "fleet-system",
+			Name:      "crp-crp-scheduling-cycles",
+		},
+		Data: map[string]string{framework.SchedulingCycleSnapshotsDataKey: string(data)},
+	}
+	rpCM := cm.DeepCopy()
+	rpCM.Namespace = "test-ns"
+	rpCM.Name = "rp-rp-scheduling-cycles"
+
+	wantLatestText := `Scheduling cycle at 2025-01-02T03:04:05Z (policy snapshot: crp-1, latency: 12ms)
+  Clusters evaluated: 3
+  Clusters with scheduled or bound bindings: member-1
+  Desired batch size: 1, batch size limit: 1
+  Skipped score plugins: TopologySpread
+  Filtered out clusters:
+    member-3: rejected by ClusterAffinity (cluster does not match)
+  Scored clusters:
+    member-2: affinity score 10, topology spread score 0, placement spread score 0, autoscaling score 0, obsolete placement affinity score 0
+`
+	wantAllText := `Scheduling cycle at 2025-01-02T03:04:05Z (policy snapshot: crp-0, latency: 5ms)
+  Error: failed to update bindings
+  Clusters evaluated: 1
+
+` + wantLatestText
+
+	testCases := []struct {
+		name       string
+		opts       schedulingCyclesOptions
+		wantOutput string
+		wantErrMsg string
+	}{
+		{
+			name:       "all cycles of a cluster resource placement",
+			opts:       schedulingCyclesOptions{name: "crp", output: outputText},
+			wantOutput: wantAllText,
+		},
+		{
+			name:       "latest cycle only",
+			opts:       schedulingCyclesOptions{name: "crp", last: 1, output: outputText},
+			wantOutput: wantLatestText,
+		},
+		{
+			name:       "resource placement",
+			opts:       schedulingCyclesOptions{name: "rp", namespace: "test-ns", last: 1, output: outputText},
+			wantOutput: wantLatestText,
+		},
+		{
+			name:       "no snapshots",
+			opts:       schedulingCyclesOptions{name: "other-crp", output: outputText},
+			wantErrMsg: "no scheduling cycle snapshots are found for placement other-crp",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			scheme := runtime.NewScheme()
+			if err := corev1.AddToScheme(scheme); err != nil {
+				t.Fatalf("failed to add core APIs to the scheme: %v", err)
+			}
+			tc.opts.hubClient = fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm, rpCM).Build()
+
+			var out bytes.Buffer
+			err := tc.opts.run(context.Background(), &out)
+			if tc.wantErrMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tc.wantErrMsg) {
+					t.Fatalf("run() = %v, want error containing %q", err, tc.wantErrMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("run() = %v, want no error", err)
+			}
+			if diff := cmp.Diff(out.String(), tc.wantOutput); diff != "" {
+				t.Errorf("run() output mismatch (-got, +want):\n%s", diff)
+			}
+		})
+	}
+
+	t.Run("json output", func(t *testing.T) {
+		scheme := runtime.NewScheme()
+		if err := corev1.AddToScheme(scheme); err != nil {
+			t.Fatalf("failed to add core APIs to the scheme: %v", err)
+		}
+		o := schedulingCyclesOptions{
+			name:      "crp",
+			output:    outputJSON,
+			hubClient: fake.NewClientBuilder().WithScheme(scheme).WithObjects(cm).Build(),
+		}
+		var out bytes.Buffer
+		if err := o.run(context.Background(), &out); err != nil {
+			t.Fatalf("run() = %v, want no error", err)
+		}
+		var got []framework.SchedulingCycleSnapshot
+		if err := json.Unmarshal(out.Bytes(), &got); err != nil {
+			t.Fatalf("failed to unmarshal output: %v", err)
+		}
+		if diff := cmp.Diff(got, snapshots); diff != "" {
+			t.Errorf("run() output mismatch (-got, +want):\n%s", diff)
+		}
+	})
+}
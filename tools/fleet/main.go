@@ -21,8 +21,11 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/kubefleet-dev/kubefleet/tools/fleet/cmd/adopt"
 	"github.com/kubefleet-dev/kubefleet/tools/fleet/cmd/approve"
 	"github.com/kubefleet-dev/kubefleet/tools/fleet/cmd/draincluster"
+	"github.com/kubefleet-dev/kubefleet/tools/fleet/cmd/join"
+	"github.com/kubefleet-dev/kubefleet/tools/fleet/cmd/schedulingcycles"
 	"github.com/kubefleet-dev/kubefleet/tools/fleet/cmd/uncordoncluster"
 )
 
@@ -37,6 +40,9 @@ func main() {
 	rootCmd.AddCommand(approve.NewCmdApprove())
 	rootCmd.AddCommand(draincluster.NewCmdDrainCluster())
 	rootCmd.AddCommand(uncordoncluster.NewCmdUncordonCluster())
+	rootCmd.AddCommand(schedulingcycles.NewCmdSchedulingCycles())
+	rootCmd.AddCommand(join.NewCmdJoin())
+	rootCmd.AddCommand(adopt.NewCmdAdopt())
 
 	if err := rootCmd.Execute(); err != nil {
 		log.Fatalf("Error executing command: %v", err)
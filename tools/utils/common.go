@@ -9,6 +9,7 @@ import (
 	"os"
 
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -24,15 +25,19 @@ var (
 	}
 )
 
-// GetClusterClientFromClusterContext creates a new client.Client for the given cluster context and scheme.
-func GetClusterClientFromClusterContext(clusterContext string, scheme *runtime.Scheme) (client.Client, error) {
+// GetRestConfigFromClusterContext returns the rest.Config for the given cluster context.
+func GetRestConfigFromClusterContext(clusterContext string) (*rest.Config, error) {
 	clusterConfig := clientcmd.NewNonInteractiveDeferredLoadingClientConfig(
 		&clientcmd.ClientConfigLoadingRules{ExplicitPath: kubeConfigPath},
 		&clientcmd.ConfigOverrides{
 			CurrentContext: clusterContext,
 		})
+	return clusterConfig.ClientConfig()
+}
 
-	restConfig, err := clusterConfig.ClientConfig()
+// GetClusterClientFromClusterContext creates a new client.Client for the given cluster context and scheme.
+func GetClusterClientFromClusterContext(clusterContext string, scheme *runtime.Scheme) (client.Client, error) {
+	restConfig, err := GetRestConfigFromClusterContext(clusterContext)
 	if err != nil {
 		return nil, err
 	}